@@ -20,6 +20,54 @@ const (
 	Gremlin = "gremlin"
 )
 
+// Feature is a capability that may be supported by a dialect.
+type Feature string
+
+// Dialect features that can be checked at runtime.
+const (
+	// Returning is the support for returning the affected rows from
+	// mutation statements (e.g. INSERT ... RETURNING).
+	Returning Feature = "returning"
+	// Upsert is the support for inserting or updating an entity in a single statement.
+	Upsert Feature = "upsert"
+	// FullText is the support for full-text search indexes and predicates.
+	FullText Feature = "fulltext"
+	// Savepoints is the support for nested transactions using savepoints.
+	Savepoints Feature = "savepoints"
+)
+
+// features holds the features that are supported by each dialect.
+var features = map[string]map[Feature]bool{
+	MySQL: {
+		Upsert:     true,
+		FullText:   true,
+		Savepoints: true,
+	},
+	SQLite: {
+		Upsert:     true,
+		Savepoints: true,
+	},
+	Gremlin: {
+		Upsert: true,
+	},
+}
+
+// Name is the name of a dialect, as returned by the Dialect method of a Driver.
+//
+//	if client.Dialect().Supports(dialect.Savepoints) {
+//		// ...
+//	}
+//
+type Name string
+
+// Supports reports if the dialect supports the given feature.
+func (n Name) Supports(f Feature) bool {
+	return features[string(n)][f]
+}
+
+// String implements the fmt.Stringer interface.
+func (n Name) String() string { return string(n) }
+
 // ExecQuerier wraps the 2 database operations.
 type ExecQuerier interface {
 	// Exec executes a query that doesn't return rows. For example, in SQL, INSERT or UPDATE.
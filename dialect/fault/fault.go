@@ -0,0 +1,257 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package fault provides a driver wrapper that injects faults (latency, connection drops
+// and dialect errors) to the operations of the underlying driver. It's used for testing the
+// retry, hook and transaction logic of applications deterministically.
+//
+//	drv := fault.New(drv)
+//	drv.Inject(fault.Fault{
+//		Match: fault.Contains("INSERT INTO `users`"),
+//		Err:   fault.DuplicateError(dialect.MySQL),
+//		Times: 1,
+//	})
+//	client := ent.NewClient(ent.Driver(drv))
+//
+package fault
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// Op is a driver operation that faults can be injected to.
+type Op string
+
+// Driver operations.
+const (
+	OpExec     Op = "exec"
+	OpQuery    Op = "query"
+	OpTx       Op = "tx"
+	OpCommit   Op = "commit"
+	OpRollback Op = "rollback"
+)
+
+// A Matcher reports if a fault should be injected to the given operation. The query
+// is empty for operations without statements (e.g. Tx or Commit).
+type Matcher func(op Op, query string) bool
+
+// Contains returns a matcher for statements that contain the given substring.
+func Contains(s string) Matcher {
+	return func(_ Op, query string) bool {
+		return query != "" && strings.Contains(query, s)
+	}
+}
+
+// Regexp returns a matcher for statements that match the given regular expression.
+func Regexp(expr string) Matcher {
+	re := regexp.MustCompile(expr)
+	return func(_ Op, query string) bool {
+		return query != "" && re.MatchString(query)
+	}
+}
+
+// Ops returns a matcher for the given operations.
+func Ops(ops ...Op) Matcher {
+	return func(op Op, _ string) bool {
+		for i := range ops {
+			if ops[i] == op {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// And returns a matcher that matches only if all the given matchers match.
+func And(ms ...Matcher) Matcher {
+	return func(op Op, query string) bool {
+		for _, m := range ms {
+			if !m(op, query) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Fault describes a fault that is injected to the matched operations.
+type Fault struct {
+	// Match reports if the fault is injected to the operation.
+	// A nil matcher matches all operations.
+	Match Matcher
+	// Delay is the latency that is injected before the operation. The
+	// operation fails with the context error, if it's done before.
+	Delay time.Duration
+	// Err is returned instead of executing the operation. A nil error
+	// means that the operation is only delayed.
+	Err error
+	// Times is the number of times the fault is injected.
+	// Zero means that it's injected until the driver is reset.
+	Times int
+}
+
+// ErrBadConn is the error of dropped connections. It's an alias to driver.ErrBadConn, that
+// causes the database/sql package to retry the operation on a new connection, when possible.
+var ErrBadConn = driver.ErrBadConn
+
+// DuplicateError returns an error of the given dialect for a violation of a unique constraint.
+func DuplicateError(name string) error {
+	switch name {
+	case dialect.MySQL:
+		return &mysql.MySQLError{Number: 1062, Message: "Duplicate entry (injected)"}
+	default:
+		return errors.New("UNIQUE constraint failed (injected)")
+	}
+}
+
+// Driver is a driver that injects faults to the operations of the underlying driver.
+type Driver struct {
+	dialect.Driver
+	mu     sync.Mutex
+	faults []*Fault
+	calls  map[Op]int
+}
+
+// New returns a new fault-injection driver that wraps the given driver.
+func New(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv, calls: make(map[Op]int)}
+}
+
+// Inject adds the given fault to the driver. Faults are matched in their injection order,
+// and only the first matched fault is injected to an operation.
+func (d *Driver) Inject(f Fault) *Driver {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.faults = append(d.faults, &f)
+	return d
+}
+
+// Reset removes all faults from the driver, and resets its counters.
+func (d *Driver) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.faults = nil
+	d.calls = make(map[Op]int)
+}
+
+// Injected returns the number of faults that were injected to the given operation.
+func (d *Driver) Injected(op Op) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.calls[op]
+}
+
+// inject injects the first matched fault to the operation.
+func (d *Driver) inject(ctx context.Context, op Op, query string) error {
+	d.mu.Lock()
+	var f *Fault
+	for i, fault := range d.faults {
+		if fault.Match == nil || fault.Match(op, query) {
+			f = fault
+			if f.Times > 0 {
+				if f.Times--; f.Times == 0 {
+					d.faults = append(d.faults[:i:i], d.faults[i+1:]...)
+				}
+			}
+			d.calls[op]++
+			break
+		}
+	}
+	d.mu.Unlock()
+	if f == nil {
+		return nil
+	}
+	if f.Delay > 0 {
+		timer := time.NewTimer(f.Delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return f.Err
+}
+
+// Exec injects the matched fault, and calls the underlying driver Exec method.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if err := d.inject(ctx, OpExec, query); err != nil {
+		return err
+	}
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// Query injects the matched fault, and calls the underlying driver Query method.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	if err := d.inject(ctx, OpQuery, query); err != nil {
+		return err
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// Tx injects the matched fault, and calls the underlying driver Tx method.
+// The operations of the returned transaction are subject to faults as well.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	if err := d.inject(ctx, OpTx, ""); err != nil {
+		return nil, err
+	}
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, ctx: ctx, drv: d}, nil
+}
+
+// Tx is a transaction that injects faults to the operations of the underlying transaction.
+type Tx struct {
+	dialect.Tx
+	ctx context.Context
+	drv *Driver
+}
+
+// Exec injects the matched fault, and calls the underlying transaction Exec method.
+func (t *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if err := t.drv.inject(ctx, OpExec, query); err != nil {
+		return err
+	}
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+// Query injects the matched fault, and calls the underlying transaction Query method.
+func (t *Tx) Query(ctx context.Context, query string, args, v interface{}) error {
+	if err := t.drv.inject(ctx, OpQuery, query); err != nil {
+		return err
+	}
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+// Commit injects the matched fault, and calls the underlying transaction Commit method.
+// Note that, the transaction is rolled back if a fault was injected to its commit.
+func (t *Tx) Commit() error {
+	if err := t.drv.inject(t.ctx, OpCommit, ""); err != nil {
+		_ = t.Tx.Rollback()
+		return err
+	}
+	return t.Tx.Commit()
+}
+
+// Rollback calls the underlying transaction Rollback method, and returns the
+// matched fault if it was injected. The transaction is rolled back in any case.
+func (t *Tx) Rollback() error {
+	err := t.drv.inject(t.ctx, OpRollback, "")
+	if rerr := t.Tx.Rollback(); err == nil {
+		err = rerr
+	}
+	return err
+}
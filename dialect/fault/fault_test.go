@@ -0,0 +1,88 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package fault
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_Inject(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := New(sql.OpenDB(dialect.MySQL, db))
+	ctx := context.Background()
+	var res sql.Result
+
+	t.Log("inject an error once to matched statements")
+	dup := DuplicateError(dialect.MySQL)
+	drv.Inject(Fault{Match: Contains("INSERT INTO `users`"), Err: dup, Times: 1})
+	err = drv.Exec(ctx, "INSERT INTO `users` (`name`) VALUES (?)", []interface{}{"a8m"}, &res)
+	require.Equal(t, dup, err)
+	require.Equal(t, uint16(1062), err.(*mysql.MySQLError).Number)
+	mock.ExpectExec("INSERT INTO `users`").WillReturnResult(sqlmock.NewResult(1, 1))
+	err = drv.Exec(ctx, "INSERT INTO `users` (`name`) VALUES (?)", []interface{}{"a8m"}, &res)
+	require.NoError(t, err)
+	require.Equal(t, 1, drv.Injected(OpExec))
+
+	t.Log("unmatched statements are executed")
+	drv.Inject(Fault{Match: And(Ops(OpQuery), Regexp("FROM `pets`")), Err: ErrBadConn})
+	mock.ExpectExec("UPDATE `pets`").WillReturnResult(sqlmock.NewResult(0, 1))
+	err = drv.Exec(ctx, "UPDATE `pets` SET `name` = ?", []interface{}{"pedro"}, &res)
+	require.NoError(t, err)
+	rows := &sql.Rows{}
+	err = drv.Query(ctx, "SELECT * FROM `pets`", []interface{}{}, rows)
+	require.Equal(t, ErrBadConn, err)
+	err = drv.Query(ctx, "SELECT * FROM `pets`", []interface{}{}, rows)
+	require.Equal(t, ErrBadConn, err, "fault should be injected until reset")
+	drv.Reset()
+	require.Zero(t, drv.Injected(OpQuery))
+
+	t.Log("inject latency")
+	drv.Inject(Fault{Delay: time.Second})
+	tctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err = drv.Exec(tctx, "UPDATE `pets` SET `name` = ?", []interface{}{"pedro"}, &res)
+	require.Equal(t, context.DeadlineExceeded, err)
+	drv.Reset()
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDriver_InjectTx(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := New(sql.OpenDB(dialect.SQLite, db))
+	ctx := context.Background()
+
+	t.Log("inject an error to the commit")
+	drv.Inject(Fault{Match: Ops(OpCommit), Err: ErrBadConn, Times: 1})
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `users`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	var res sql.Result
+	require.NoError(t, tx.Exec(ctx, "DELETE FROM `users`", []interface{}{}, &res))
+	require.Equal(t, ErrBadConn, tx.Commit())
+
+	t.Log("inject an error to statements in transactions")
+	drv.Inject(Fault{Match: Contains("DELETE"), Err: DuplicateError(dialect.SQLite), Times: 1})
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	tx, err = drv.Tx(ctx)
+	require.NoError(t, err)
+	err = tx.Exec(ctx, "DELETE FROM `users`", []interface{}{}, &res)
+	require.EqualError(t, err, "UNIQUE constraint failed (injected)")
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,97 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// WithCredentials configures the client to authenticate with the given username and password.
+// HTTP requests are sent with basic authentication, and SASL challenges of the server (responses
+// with StatusAuthenticate) are answered with the PLAIN mechanism.
+//
+//	c, err := gremlin.NewClient(cfg,
+//		gremlin.WithCredentials(os.Getenv("GREMLIN_USER"), os.Getenv("GREMLIN_PASSWORD")),
+//		gremlin.WithTLSConfig(&tls.Config{RootCAs: pool}),
+//	)
+//
+func WithCredentials(username, password string) Option {
+	return func(opts *options) {
+		opts.creds = &Credentials{Username: username, Password: password}
+	}
+}
+
+// WithTLSConfig configures the TLS of the client connections (e.g. root CAs or client certificates).
+// It requires the transport of the http client (if it was set) to be an *http.Transport.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(opts *options) {
+		opts.tls = cfg
+	}
+}
+
+// Authenticate returns an interceptor that answers the SASL challenges of the server with the given
+// credentials, and re-sends them on the same request id as required by the authentication protocol.
+func Authenticate(username, password string) Interceptor {
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, req *Request) (*Response, error) {
+			rsp, err := next.RoundTrip(ctx, req)
+			if err != nil || rsp.Status.Code != StatusAuthenticate {
+				return rsp, err
+			}
+			return next.RoundTrip(ctx, NewAuthRequest(req.RequestID, username, password))
+		})
+	}
+}
+
+// basicAuthTransport is an http.RoundTripper that adds basic authentication to the requests.
+type basicAuthTransport struct {
+	creds Credentials
+	next  http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper interface.
+func (t *basicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.SetBasicAuth(t.creds.Username, t.creds.Password)
+	return t.next.RoundTrip(req)
+}
+
+// withBasicAuth returns a copy of the http client that authenticates its requests with the given credentials.
+func withBasicAuth(client *http.Client, creds Credentials) *http.Client {
+	var c http.Client
+	if client != nil {
+		c = *client
+	}
+	next := c.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	c.Transport = &basicAuthTransport{creds: creds, next: next}
+	return &c
+}
+
+// withTLS returns a copy of the http client that uses the given TLS configuration.
+func withTLS(client *http.Client, cfg *tls.Config) (*http.Client, error) {
+	var c http.Client
+	if client != nil {
+		c = *client
+	}
+	next := c.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	t, ok := next.(*http.Transport)
+	if !ok {
+		return nil, errors.Errorf("gremlin: tls config requires an *http.Transport, got: %T", next)
+	}
+	t = t.Clone()
+	t.TLSClientConfig = cfg
+	c.Transport = t
+	return &c, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const okResponse = `{
+	"requestId": "f679127f-8701-425c-af55-049a44720db6",
+	"result": {"data": null, "meta": {"@type": "g:Map", "@value": []}},
+	"status": {"attributes": {"@type": "g:Map", "@value": []}, "code": 204, "message": ""}
+}`
+
+func TestBuildWithCredentials(t *testing.T) {
+	var user, pass string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ok bool
+		user, pass, ok = r.BasicAuth()
+		assert.True(t, ok)
+		_, err := io.WriteString(w, okResponse)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	client, err := Config{Endpoint: Endpoint{u}}.Build(WithCredentials("a8m", "secret"))
+	require.NoError(t, err)
+	_, err = client.Query(context.Background(), "g.V()")
+	require.NoError(t, err)
+	assert.Equal(t, "a8m", user)
+	assert.Equal(t, "secret", pass)
+
+	client, err = Config{Endpoint: Endpoint{u}, Username: "nati", Password: "pass"}.Build()
+	require.NoError(t, err)
+	_, err = client.Query(context.Background(), "g.V()")
+	require.NoError(t, err)
+	assert.Equal(t, "nati", user)
+	assert.Equal(t, "pass", pass)
+
+	u, err = url.Parse(srv.URL)
+	require.NoError(t, err)
+	u.User = url.UserPassword("ariel", "userinfo")
+	client, err = Config{Endpoint: Endpoint{u}}.Build()
+	require.NoError(t, err)
+	_, err = client.Query(context.Background(), "g.V()")
+	require.NoError(t, err)
+	assert.Equal(t, "ariel", user)
+	assert.Equal(t, "userinfo", pass)
+	assert.NotNil(t, u.User, "endpoint should not be changed")
+}
+
+func TestBuildWithTLSConfig(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := io.WriteString(w, okResponse)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	client, err := Config{Endpoint: Endpoint{u}}.Build()
+	require.NoError(t, err)
+	_, err = client.Query(context.Background(), "g.V()")
+	require.Error(t, err, "server certificate should not be trusted")
+
+	pool := srv.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs
+	client, err = Config{Endpoint: Endpoint{u}}.Build(WithTLSConfig(&tls.Config{RootCAs: pool}))
+	require.NoError(t, err)
+	_, err = client.Query(context.Background(), "g.V()")
+	require.NoError(t, err)
+
+	_, err = Config{Endpoint: Endpoint{u}}.Build(
+		WithHTTPClient(&http.Client{Transport: &testRoundTripper{}}),
+		WithTLSConfig(&tls.Config{RootCAs: pool}),
+	)
+	require.Error(t, err)
+}
+
+func TestAuthenticate(t *testing.T) {
+	var reqs []*Request
+	transport := Authenticate("a8m", "secret")(RoundTripperFunc(func(_ context.Context, req *Request) (*Response, error) {
+		reqs = append(reqs, req)
+		rsp := &Response{RequestID: req.RequestID}
+		rsp.Status.Code = StatusSuccess
+		if req.Operation != OpsAuthentication {
+			rsp.Status.Code = StatusAuthenticate
+		}
+		return rsp, nil
+	}))
+	req := NewEvalRequest("g.V()")
+	rsp, err := transport.RoundTrip(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, StatusSuccess, rsp.Status.Code)
+	require.Len(t, reqs, 2)
+	assert.Equal(t, req.RequestID, reqs[1].RequestID)
+	assert.Equal(t, OpsAuthentication, reqs[1].Operation)
+	assert.Equal(t, Credentials{Username: "a8m", Password: "secret"}, reqs[1].Arguments[ArgsSasl])
+	assert.Equal(t, "PLAIN", reqs[1].Arguments[ArgsSaslMechanism])
+}
@@ -0,0 +1,268 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/gremlin/encoding/graphson"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// Bytecode is the bytecode representation of a traversal, as defined by the GraphSON 3 format.
+	// It's used for submitting traversals to servers that have script evaluation disabled.
+	Bytecode struct {
+		Steps []Instruction
+	}
+
+	// Instruction is a single step of a bytecode traversal (e.g. has("name", "a8m")).
+	Instruction struct {
+		Operator  string
+		Arguments []interface{}
+	}
+
+	// Predicate is a bytecode predicate (e.g. gt(30)). The predicates of text values
+	// (e.g. startingWith and regex) are encoded as TextP predicates.
+	Predicate struct {
+		Operator string
+		Value    interface{}
+	}
+
+	// Enum is a bytecode enum value, like cardinality, order or T (e.g. single).
+	Enum struct {
+		Type  graphson.Type
+		Value string
+	}
+)
+
+// MarshalGraphson implements graphson.Marshaler interface.
+func (b *Bytecode) MarshalGraphson() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(`{"@type":"g:Bytecode","@value":{"step":[`)
+	for i, s := range b.Steps {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('[')
+		buf.WriteString(strconv.Quote(s.Operator))
+		for _, arg := range s.Arguments {
+			data, err := graphson.Marshal(arg)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "marshal argument of step %s", s.Operator)
+			}
+			buf.WriteByte(',')
+			buf.Write(data)
+		}
+		buf.WriteByte(']')
+	}
+	buf.WriteString("]}}")
+	return buf.Bytes(), nil
+}
+
+// MarshalGraphson implements graphson.Marshaler interface.
+func (p *Predicate) MarshalGraphson() ([]byte, error) {
+	data, err := graphson.Marshal(p.Value)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "marshal value of predicate %s", p.Operator)
+	}
+	typ := pType
+	if textP[p.Operator] {
+		typ = textPType
+	}
+	var buf bytes.Buffer
+	buf.WriteString(`{"@type":` + strconv.Quote(typ.String()) + `,"@value":{"predicate":`)
+	buf.WriteString(strconv.Quote(p.Operator))
+	buf.WriteString(`,"value":`)
+	buf.Write(data)
+	buf.WriteString("}}")
+	return buf.Bytes(), nil
+}
+
+// MarshalGraphson implements graphson.Marshaler interface.
+func (e *Enum) MarshalGraphson() ([]byte, error) {
+	return []byte(`{"@type":` + strconv.Quote(e.Type.String()) + `,"@value":` + strconv.Quote(e.Value) + `}`), nil
+}
+
+// bytecode enum and predicate types.
+const (
+	cardinalityType graphson.Type = "g:Cardinality"
+	columnType      graphson.Type = "g:Column"
+	orderType       graphson.Type = "g:Order"
+	scopeType       graphson.Type = "g:Scope"
+	tType           graphson.Type = "g:T"
+	pType           graphson.Type = "g:P"
+	textPType       graphson.Type = "g:TextP"
+)
+
+// textP holds the predicates of the TextP class. The rest are encoded as P predicates.
+var textP = map[string]bool{
+	"startingWith":    true,
+	"endingWith":      true,
+	"containing":      true,
+	"notStartingWith": true,
+	"notEndingWith":   true,
+	"notContaining":   true,
+	"regex":           true,
+	"notRegex":        true,
+}
+
+// NewBytecode returns the bytecode representation of the given traversal. Traversals that join
+// multiple traversals (using dsl.Join) are encoded as one traversal that executes the joined ones
+// as side effects, and emits the results of the last one. Therefore, they're submitted in one request
+// and executed atomically. Note that, variables and Groovy closures (e.g. dsl.Group and dsl.Each) are
+// not supported.
+//
+//	bc, err := NewBytecode(g.V().HasLabel("user").Count())
+//
+func NewBytecode(t *dsl.Traversal) (*Bytecode, error) {
+	nodes := t.Nodes()
+	if len(nodes) == 1 {
+		if b, ok := nodes[0].(dsl.Block); ok {
+			return join(b)
+		}
+	}
+	return traversal(nodes)
+}
+
+// join encodes the traversals of a dsl.Join block as one traversal.
+func join(b dsl.Block) (*Bytecode, error) {
+	trs := make([]*Bytecode, 0, len(b.Nodes))
+	for _, n := range b.Nodes {
+		t, ok := n.(*dsl.Traversal)
+		if !ok {
+			return nil, errors.Errorf("gremlin: unsupported node %T in bytecode", n)
+		}
+		bc, err := traversal(t.Nodes())
+		if err != nil {
+			return nil, err
+		}
+		trs = append(trs, bc)
+	}
+	if len(trs) == 1 {
+		return trs[0], nil
+	}
+	// g.inject(1).sideEffect(t1)...sideEffect(tn-1).flatMap(tn).
+	bc := &Bytecode{Steps: []Instruction{{Operator: "inject", Arguments: []interface{}{1}}}}
+	for _, tr := range trs[:len(trs)-1] {
+		bc.Steps = append(bc.Steps, Instruction{Operator: "sideEffect", Arguments: []interface{}{tr}})
+	}
+	bc.Steps = append(bc.Steps, Instruction{Operator: "flatMap", Arguments: []interface{}{trs[len(trs)-1]}})
+	return bc, nil
+}
+
+// traversal encodes the steps of a traversal that starts with the graph traversal source
+// or with an anonymous traversal.
+func traversal(nodes []dsl.Node) (*Bytecode, error) {
+	if len(nodes) == 0 || nodes[0] != dsl.G && nodes[0] != dsl.Token("__") {
+		return nil, errors.New("gremlin: bytecode traversals must start with g or __")
+	}
+	bc := &Bytecode{}
+	for i := 1; i < len(nodes); i += 2 {
+		f, ok := step(nodes[i:])
+		if !ok {
+			return nil, errors.Errorf("gremlin: unsupported node %T in bytecode", nodes[i])
+		}
+		args, err := arguments(f.Args)
+		if err != nil {
+			return nil, err
+		}
+		switch f.Name {
+		// terminal steps are implicit in bytecode, as the server iterates the traversal.
+		case "next", "toList", "iterate":
+		case "hasNext":
+			bc.Steps = append(bc.Steps,
+				Instruction{Operator: "fold"},
+				Instruction{Operator: "coalesce", Arguments: []interface{}{
+					&Bytecode{Steps: []Instruction{{Operator: "unfold"}, {Operator: "limit", Arguments: []interface{}{1}}, {Operator: "constant", Arguments: []interface{}{true}}}},
+					&Bytecode{Steps: []Instruction{{Operator: "constant", Arguments: []interface{}{false}}}},
+				}},
+			)
+		default:
+			bc.Steps = append(bc.Steps, Instruction{Operator: f.Name, Arguments: args})
+		}
+	}
+	return bc, nil
+}
+
+// step returns the function call of a traversal step (a dot followed by a function).
+func step(nodes []dsl.Node) (*dsl.Func, bool) {
+	if len(nodes) < 2 || nodes[0] != dsl.Dot {
+		return nil, false
+	}
+	f, ok := nodes[1].(*dsl.Func)
+	return f, ok
+}
+
+// arguments encodes the arguments of a step or a predicate.
+func arguments(args []interface{}) ([]interface{}, error) {
+	vs := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		v, err := argument(arg)
+		if err != nil {
+			return nil, err
+		}
+		vs = append(vs, v)
+	}
+	if len(vs) == 0 {
+		return nil, nil
+	}
+	return vs, nil
+}
+
+// argument encodes a single argument. Nested traversals are encoded as bytecode,
+// predicates as P or TextP, and tokens as enums.
+func argument(arg interface{}) (interface{}, error) {
+	switch arg := arg.(type) {
+	case *dsl.Traversal:
+		nodes := arg.Nodes()
+		if len(nodes) == 1 {
+			if f, ok := nodes[0].(*dsl.Func); ok {
+				return predicate(f)
+			}
+		}
+		return traversal(nodes)
+	case *dsl.List:
+		return arguments(arg.Elements)
+	case dsl.Cardinality:
+		return &Enum{Type: cardinalityType, Value: string(arg)}, nil
+	case dsl.Order:
+		return &Enum{Type: orderType, Value: string(arg)}, nil
+	case dsl.Column:
+		return &Enum{Type: columnType, Value: string(arg)}, nil
+	case dsl.Scope:
+		return &Enum{Type: scopeType, Value: string(arg)}, nil
+	case dsl.T:
+		return &Enum{Type: tType, Value: strings.TrimPrefix(string(arg), "T.")}, nil
+	case time.Time:
+		// similar to the bindings of script requests.
+		return arg.UnixNano(), nil
+	case dsl.Node:
+		return nil, errors.Errorf("gremlin: unsupported node %T in bytecode", arg)
+	default:
+		return arg, nil
+	}
+}
+
+// predicate returns a bytecode predicate from its function call.
+func predicate(f *dsl.Func) (*Predicate, error) {
+	args, err := arguments(f.Args)
+	if err != nil {
+		return nil, err
+	}
+	p := &Predicate{Operator: f.Name}
+	switch {
+	case f.Name == "within" || f.Name == "without" || len(args) > 1:
+		p.Value = args
+	case len(args) == 1:
+		p.Value = args[0]
+	}
+	return p, nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect/gremlin/encoding/graphson"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/g"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBytecode(t *testing.T) {
+	tests := []struct {
+		input   *dsl.Traversal
+		want    *Bytecode
+		wantErr bool
+	}{
+		{
+			input: g.V().HasLabel("user").Count(),
+			want:  &Bytecode{Steps: []Instruction{{Operator: "V"}, {Operator: "hasLabel", Arguments: []interface{}{"user"}}, {Operator: "count"}}},
+		},
+		{
+			input: g.V(1).Has("user", "age", p.GT(30)).OutE("knows").InV().ValueMap(true),
+			want: &Bytecode{Steps: []Instruction{
+				{Operator: "V", Arguments: []interface{}{1}},
+				{Operator: "has", Arguments: []interface{}{"user", "age", &Predicate{Operator: "gt", Value: 30}}},
+				{Operator: "outE", Arguments: []interface{}{"knows"}},
+				{Operator: "inV"},
+				{Operator: "valueMap", Arguments: []interface{}{true}},
+			}},
+		},
+		{
+			input: g.V().Has("name", p.Within("a8m", "nati")).Order().By("age", dsl.Incr),
+			want: &Bytecode{Steps: []Instruction{
+				{Operator: "V"},
+				{Operator: "has", Arguments: []interface{}{"name", &Predicate{Operator: "within", Value: []interface{}{"a8m", "nati"}}}},
+				{Operator: "order"},
+				{Operator: "by", Arguments: []interface{}{"age", &Enum{Type: orderType, Value: "incr"}}},
+			}},
+		},
+		{
+			input: g.V().HasLabel("user").Order().By(dsl.ID, dsl.Incr).Range(0, 10),
+			want: &Bytecode{Steps: []Instruction{
+				{Operator: "V"},
+				{Operator: "hasLabel", Arguments: []interface{}{"user"}},
+				{Operator: "order"},
+				{Operator: "by", Arguments: []interface{}{&Enum{Type: tType, Value: "id"}, &Enum{Type: orderType, Value: "incr"}}},
+				{Operator: "range", Arguments: []interface{}{0, 10}},
+			}},
+		},
+		{
+			input: g.V().Has("name", p.Regex("^a8")).Has("nick", p.EQ("a8m")),
+			want: &Bytecode{Steps: []Instruction{
+				{Operator: "V"},
+				{Operator: "has", Arguments: []interface{}{"name", &Predicate{Operator: "regex", Value: "^a8"}}},
+				{Operator: "has", Arguments: []interface{}{"nick", &Predicate{Operator: "eq", Value: "a8m"}}},
+			}},
+		},
+		{
+			input: g.V().Has("user", "name", "a8m").Fold().Coalesce(__.Unfold(), __.AddV("user").Property(dsl.Single, "name", "a8m")),
+			want: &Bytecode{Steps: []Instruction{
+				{Operator: "V"},
+				{Operator: "has", Arguments: []interface{}{"user", "name", "a8m"}},
+				{Operator: "fold"},
+				{Operator: "coalesce", Arguments: []interface{}{
+					&Bytecode{Steps: []Instruction{{Operator: "unfold"}}},
+					&Bytecode{Steps: []Instruction{
+						{Operator: "addV", Arguments: []interface{}{"user"}},
+						{Operator: "property", Arguments: []interface{}{&Enum{Type: cardinalityType, Value: "single"}, "name", "a8m"}},
+					}},
+				}},
+			}},
+		},
+		{
+			input: dsl.Join(g.V(1).OutE("knows").Drop().Iterate(), g.V(1).ValueMap(true)),
+			want: &Bytecode{Steps: []Instruction{
+				{Operator: "inject", Arguments: []interface{}{1}},
+				{Operator: "sideEffect", Arguments: []interface{}{
+					&Bytecode{Steps: []Instruction{{Operator: "V", Arguments: []interface{}{1}}, {Operator: "outE", Arguments: []interface{}{"knows"}}, {Operator: "drop"}}},
+				}},
+				{Operator: "flatMap", Arguments: []interface{}{
+					&Bytecode{Steps: []Instruction{{Operator: "V", Arguments: []interface{}{1}}, {Operator: "valueMap", Arguments: []interface{}{true}}}},
+				}},
+			}},
+		},
+		{
+			input: dsl.Join(g.V(1).ValueMap(true)),
+			want:  &Bytecode{Steps: []Instruction{{Operator: "V", Arguments: []interface{}{1}}, {Operator: "valueMap", Arguments: []interface{}{true}}}},
+		},
+		{
+			input: g.V().HasNext(),
+			want: &Bytecode{Steps: []Instruction{
+				{Operator: "V"},
+				{Operator: "fold"},
+				{Operator: "coalesce", Arguments: []interface{}{
+					&Bytecode{Steps: []Instruction{{Operator: "unfold"}, {Operator: "limit", Arguments: []interface{}{1}}, {Operator: "constant", Arguments: []interface{}{true}}}},
+					&Bytecode{Steps: []Instruction{{Operator: "constant", Arguments: []interface{}{false}}}},
+				}},
+			}},
+		},
+		{
+			input:   dsl.Group(g.V().Count()),
+			wantErr: true,
+		},
+		{
+			input: dsl.Each(g.V(), func(it *dsl.Traversal) *dsl.Traversal {
+				return it.Drop()
+			}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		query, _ := tt.input.Query()
+		t.Run(query, func(t *testing.T) {
+			bc, err := NewBytecode(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, bc)
+		})
+	}
+}
+
+func TestBytecodeEncode(t *testing.T) {
+	bc := &Bytecode{Steps: []Instruction{
+		{Operator: "V"},
+		{Operator: "has", Arguments: []interface{}{"name", &Predicate{Operator: "eq", Value: "a8m"}}},
+		{Operator: "has", Arguments: []interface{}{"nick", &Predicate{Operator: "regex", Value: "^a8"}}},
+		{Operator: "property", Arguments: []interface{}{&Enum{Type: cardinalityType, Value: "single"}, "age", 30}},
+	}}
+	data, err := graphson.Marshal(bc)
+	require.NoError(t, err)
+
+	var got map[string]interface{}
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"@type": "g:Bytecode",
+		"@value": map[string]interface{}{
+			"step": []interface{}{
+				[]interface{}{"V"},
+				[]interface{}{"has", "name", map[string]interface{}{
+					"@type": "g:P",
+					"@value": map[string]interface{}{
+						"predicate": "eq",
+						"value":     "a8m",
+					},
+				}},
+				[]interface{}{"has", "nick", map[string]interface{}{
+					"@type": "g:TextP",
+					"@value": map[string]interface{}{
+						"predicate": "regex",
+						"value":     "^a8",
+					},
+				}},
+				[]interface{}{"property", map[string]interface{}{
+					"@type":  "g:Cardinality",
+					"@value": "single",
+				}, "age", map[string]interface{}{
+					"@type":  "g:Int64",
+					"@value": float64(30),
+				}},
+			},
+		},
+	}, got)
+}
+
+func TestBytecodeRequestEncode(t *testing.T) {
+	req := NewBytecodeRequest(&Bytecode{Steps: []Instruction{{Operator: "V"}}})
+	assert.Equal(t, OpsBytecode, req.Operation)
+	assert.Equal(t, ProcessorTraversal, req.Processor)
+
+	data, err := graphson.Marshal(req)
+	require.NoError(t, err)
+	var got map[string]interface{}
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	args := got["args"].(map[string]interface{})
+	assert.Equal(t, "g:Map", args["@type"])
+	assert.Contains(t, args["@value"], map[string]interface{}{
+		"@type": "g:Bytecode",
+		"@value": map[string]interface{}{
+			"step": []interface{}{[]interface{}{"V"}},
+		},
+	})
+}
+
+func TestDriverBytecode(t *testing.T) {
+	var reqs []*Request
+	transport := RoundTripperFunc(func(_ context.Context, req *Request) (*Response, error) {
+		reqs = append(reqs, req)
+		rsp := &Response{}
+		rsp.Status.Code = StatusSuccess
+		return rsp, nil
+	})
+	drv := NewDriver(&Client{Transport: transport})
+	drv.Bytecode = true
+	tr := dsl.Join(g.V(1).OutE("knows").Drop().Iterate(), g.V(1).ValueMap(true))
+	query, _ := tr.Query()
+	err := drv.Exec(context.Background(), query, tr, &Response{})
+	require.NoError(t, err)
+	require.Len(t, reqs, 1, "joined traversals are submitted in one request")
+	assert.Equal(t, OpsBytecode, reqs[0].Operation)
+	bc := reqs[0].Arguments[ArgsGremlin].(*Bytecode)
+	assert.Equal(t, "inject", bc.Steps[0].Operator)
+
+	err = drv.Exec(context.Background(), query, dsl.Bindings{}, &Response{})
+	assert.Error(t, err, "bytecode requests expect a traversal")
+	assert.Len(t, reqs, 1)
+}
@@ -32,6 +32,10 @@ type Client struct {
 	// Transport specifies the mechanism by which individual
 	// Gremlin requests are made.
 	Transport RoundTripper
+
+	// Flavor is the flavor of the gremlin server. The queries
+	// of the driver are adjusted to it before they are sent.
+	Flavor Flavor
 }
 
 // MaxResponseSize defines the maximum response size allowed.
@@ -48,7 +52,7 @@ func NewHTTPClient(url string, client *http.Client) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{transport}, nil
+	return &Client{Transport: transport}, nil
 }
 
 // Do sends a gremlin request and returns a gremlin response.
@@ -40,7 +40,7 @@ func TestClientRequest(t *testing.T) {
 		Once()
 	defer m.AssertExpectations(t)
 
-	response, err := Client{&m}.Do(context.Background(), req)
+	response, err := Client{Transport: &m}.Do(context.Background(), req)
 	assert.NoError(t, err)
 	assert.Equal(t, rsp, response)
 }
@@ -55,7 +55,7 @@ func TestClientResponseError(t *testing.T) {
 		Once()
 	defer m.AssertExpectations(t)
 
-	_, err := Client{&m}.Do(context.Background(), nil)
+	_, err := Client{Transport: &m}.Do(context.Background(), nil)
 	assert.Error(t, err)
 }
 
@@ -69,7 +69,7 @@ func TestClientCanceledContext(t *testing.T) {
 		Once()
 	defer m.AssertExpectations(t)
 
-	_, err := Client{&m}.Query(ctx, "g.E()")
+	_, err := Client{Transport: &m}.Query(ctx, "g.E()")
 	assert.EqualError(t, err, context.Canceled.Error())
 }
 
@@ -87,7 +87,84 @@ func TestClientQuery(t *testing.T) {
 		Once()
 	defer m.AssertExpectations(t)
 
-	rsp, err := Client{&m}.Queryf(context.Background(), "g.V(%d)", 1)
+	rsp, err := Client{Transport: &m}.Queryf(context.Background(), "g.V(%d)", 1)
 	assert.NotNil(t, rsp)
 	assert.NoError(t, err)
 }
+
+func TestClientStream(t *testing.T) {
+	transport := RoundTripperFunc(func(ctx context.Context, req *Request) (*Response, error) {
+		h := FrameHandlerFromContext(ctx)
+		assert.NotNil(t, h)
+		for i := 0; i < 2; i++ {
+			rsp := &Response{}
+			rsp.Status.Code = StatusPartialContent
+			if err := h(rsp); err != nil {
+				return nil, err
+			}
+		}
+		rsp := &Response{}
+		rsp.Status.Code = StatusSuccess
+		return rsp, nil
+	})
+	it := Client{Transport: transport}.Stream(context.Background(), NewEvalRequest("g.V()"))
+	var codes []int
+	for it.Next() {
+		codes = append(codes, it.Response().Status.Code)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{StatusPartialContent, StatusPartialContent, StatusSuccess}, codes)
+	assert.False(t, it.Next())
+}
+
+func TestClientStreamSingleFrame(t *testing.T) {
+	rsp := &Response{}
+	rsp.Status.Code = StatusSuccess
+
+	var m mockRoundTripper
+	m.On("RoundTrip", mock.Anything, mock.Anything).
+		Return(rsp, nil).
+		Once()
+	defer m.AssertExpectations(t)
+
+	it := Client{Transport: &m}.Stream(context.Background(), NewEvalRequest("g.V()"))
+	assert.True(t, it.Next())
+	assert.Equal(t, rsp, it.Response())
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+}
+
+func TestClientStreamError(t *testing.T) {
+	rsp := &Response{}
+	rsp.Status.Code = StatusServerError
+
+	var m mockRoundTripper
+	m.On("RoundTrip", mock.Anything, mock.Anything).
+		Return(rsp, nil).
+		Once()
+	defer m.AssertExpectations(t)
+
+	it := Client{Transport: &m}.Stream(context.Background(), NewEvalRequest("g.V()"))
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
+
+func TestClientStreamClose(t *testing.T) {
+	done := make(chan error, 1)
+	transport := RoundTripperFunc(func(ctx context.Context, req *Request) (*Response, error) {
+		h := FrameHandlerFromContext(ctx)
+		for {
+			rsp := &Response{}
+			rsp.Status.Code = StatusPartialContent
+			if err := h(rsp); err != nil {
+				done <- err
+				return nil, err
+			}
+		}
+	})
+	it := Client{Transport: transport}.Stream(context.Background(), NewEvalRequest("g.V()"))
+	assert.True(t, it.Next())
+	assert.NoError(t, it.Close())
+	assert.EqualError(t, <-done, context.Canceled.Error())
+	assert.False(t, it.Next())
+}
@@ -5,9 +5,11 @@
 package gremlin
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 type (
@@ -15,6 +17,8 @@ type (
 	Config struct {
 		Endpoint         Endpoint `env:"ENDPOINT" long:"endpoint" default:"" description:"gremlin endpoint to connect to"`
 		DisableExpansion bool     `env:"DISABLE_EXPANSION" long:"disable-expansion" description:"disable bindings expansion"`
+		Username         string   `env:"USERNAME" long:"username" description:"username for authenticating to the server"`
+		Password         string   `env:"PASSWORD" long:"password" description:"password for authenticating to the server"`
 	}
 
 	// An Option configured client.
@@ -23,6 +27,11 @@ type (
 	options struct {
 		interceptors []Interceptor
 		httpClient   *http.Client
+		flavor       Flavor
+		sigv4        *AWSCredentials
+		creds        *Credentials
+		tls          *tls.Config
+		evalTimeout  time.Duration
 	}
 
 	// Endpoint wraps a url to add flag unmarshaling.
@@ -45,30 +54,61 @@ func WithHTTPClient(client *http.Client) Option {
 	}
 }
 
-// Build constructs a client from Config.
+// Build constructs a client from Config. The credentials of the client are taken from
+// the WithCredentials option, the Username and Password fields or the endpoint userinfo,
+// in this order.
 func (cfg Config) Build(opt ...Option) (c *Client, err error) {
 	opts := cfg.buildOptions(opt)
 	switch cfg.Endpoint.Scheme {
 	case "http", "https":
-		c, err = NewHTTPClient(cfg.Endpoint.String(), opts.httpClient)
+		client := opts.httpClient
+		if opts.tls != nil {
+			if client, err = withTLS(client, opts.tls); err != nil {
+				return nil, err
+			}
+		}
+		if opts.sigv4 != nil {
+			client = signed(client, *opts.sigv4)
+		}
+		u := *cfg.Endpoint.URL
+		u.User = nil
+		// Requests that are signed by SigV4 are not authenticated using basic authentication.
+		if opts.creds != nil && opts.sigv4 == nil {
+			client = withBasicAuth(client, *opts.creds)
+		}
+		c, err = NewHTTPClient(u.String(), client)
 	default:
 		err = fmt.Errorf("unsupported endpoint scheme: %s", cfg.Endpoint.Scheme)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if opts.creds != nil {
+		c.Transport = Authenticate(opts.creds.Username, opts.creds.Password)(c.Transport)
+	}
 
 	for i := len(opts.interceptors) - 1; i >= 0; i-- {
 		c.Transport = opts.interceptors[i](c.Transport)
 	}
-	if !cfg.DisableExpansion {
+	if opts.evalTimeout > 0 {
+		c.Transport = EvaluationTimeout(opts.evalTimeout)(c.Transport)
+	}
+	if !cfg.DisableExpansion || opts.flavor.expansion() {
 		c.Transport = ExpandBindings(c.Transport)
 	}
+	c.Flavor = opts.flavor
 	return c, nil
 }
 
-func (Config) buildOptions(opts []Option) options {
+func (cfg Config) buildOptions(opts []Option) options {
 	var o options
+	switch {
+	case cfg.Username != "" || cfg.Password != "":
+		o.creds = &Credentials{Username: cfg.Username, Password: cfg.Password}
+	case cfg.Endpoint.URL != nil && cfg.Endpoint.User != nil:
+		password, _ := cfg.Endpoint.User.Password()
+		o.creds = &Credentials{Username: cfg.Endpoint.User.Username(), Password: password}
+	}
 	for _, opt := range opts {
 		opt(&o)
 	}
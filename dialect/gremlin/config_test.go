@@ -137,6 +137,20 @@ func TestBuildWithHTTPClient(t *testing.T) {
 	_, _ = client.Do(context.Background(), NewEvalRequest("g.V()"))
 }
 
+func TestBuildWithFlavor(t *testing.T) {
+	u, err := url.Parse("http://gremlin-server:8182/gremlin")
+	require.NoError(t, err)
+
+	client, err := Config{Endpoint: Endpoint{u}}.Build(WithFlavor(Neptune))
+	require.NoError(t, err)
+	assert.Equal(t, Neptune, client.Flavor)
+
+	client, err = Config{Endpoint: Endpoint{u}}.
+		Build(WithFlavor(Neptune), WithSigV4(AWSCredentials{Region: "us-east-1"}))
+	require.NoError(t, err)
+	assert.Equal(t, Neptune, client.Flavor)
+}
+
 func TestExpandOrdering(t *testing.T) {
 	var cfg Config
 	cfg.Endpoint.URL, _ = url.Parse("http://gremlin-server/gremlin")
@@ -15,27 +15,55 @@ import (
 // Driver is a dialect.Driver implementation for TinkerPop gremlin.
 type Driver struct {
 	*Client
+	// Bytecode indicates if queries are submitted to the server as bytecode
+	// requests instead of script evaluation requests. It is required for
+	// servers that have script evaluation disabled.
+	Bytecode bool
 }
 
 // NewDriver returns a new dialect.Driver implementation for gremlin.
 func NewDriver(c *Client) *Driver {
 	c.Transport = ExpandBindings(c.Transport)
-	return &Driver{c}
+	return &Driver{Client: c}
 }
 
 // Dialect implements the dialect.Dialect method.
 func (Driver) Dialect() string { return dialect.Gremlin }
 
-// Exec implements the dialect.Exec method.
+// Exec implements the dialect.Exec method. The args are either the bindings of the
+// query, or the traversal the query was generated from. Bytecode requests can be
+// submitted only for traversals, as they're encoded from the traversal structure.
 func (c *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
 	vr, ok := v.(*Response)
 	if !ok {
 		return fmt.Errorf("dialect/gremlin: invalid type %T. expect *gremlin.Response", v)
 	}
-	bindings, ok := args.(dsl.Bindings)
-	if !ok {
+	var (
+		tr       *dsl.Traversal
+		bindings dsl.Bindings
+	)
+	switch args := args.(type) {
+	case dsl.Bindings:
+		bindings = args
+	case *dsl.Traversal:
+		tr = args
+		query, bindings = tr.Query()
+	default:
 		return fmt.Errorf("dialect/gremlin: invalid type %T. expect map[string]interface{} for bindings", args)
 	}
+	query, err := c.Flavor.rewrite(query)
+	if err != nil {
+		return err
+	}
+	if c.Bytecode {
+		switch {
+		case c.Flavor == CosmosDB:
+			return fmt.Errorf("dialect/gremlin: %s does not support bytecode requests", c.Flavor)
+		case tr == nil:
+			return fmt.Errorf("dialect/gremlin: bytecode requests expect a *dsl.Traversal. got query: %q", query)
+		}
+		return c.execBytecode(ctx, tr, vr)
+	}
 	res, err := c.Do(ctx, NewEvalRequest(query, WithBindings(bindings)))
 	if err != nil {
 		return err
@@ -44,6 +72,21 @@ func (c *Driver) Exec(ctx context.Context, query string, args, v interface{}) er
 	return nil
 }
 
+// execBytecode executes the traversal as a bytecode request. Joined traversals
+// are encoded as one traversal, and therefore, submitted in one request.
+func (c *Driver) execBytecode(ctx context.Context, tr *dsl.Traversal, vr *Response) error {
+	bc, err := NewBytecode(tr)
+	if err != nil {
+		return err
+	}
+	res, err := c.Do(ctx, NewBytecodeRequest(bc))
+	if err != nil {
+		return err
+	}
+	*vr = *res
+	return nil
+}
+
 // Query implements the dialect.Query method.
 func (c *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
 	return c.Exec(ctx, query, args, v)
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"time"
 	"unsafe"
 
 	"github.com/json-iterator/go"
@@ -114,6 +115,8 @@ func (efaceDecoder) reflectType(typ Type) reflect2.Type {
 		return reflect2.TypeOf(int64(0))
 	case byteBufferType:
 		return reflect2.TypeOf([]byte{})
+	case Timestamp, Date:
+		return reflect2.TypeOf(time.Time{})
 	default:
 		return nil
 	}
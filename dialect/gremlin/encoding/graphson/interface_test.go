@@ -7,6 +7,7 @@ package graphson
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -45,6 +46,22 @@ func TestDecodeInterface(t *testing.T) {
 			}`,
 			want: float32(-22.567),
 		},
+		{
+			name: "Timestamp",
+			in: `{
+				"@type": "g:Timestamp",
+				"@value": 1481750076295
+			}`,
+			want: time.Unix(0, 1481750076295*time.Millisecond.Nanoseconds()),
+		},
+		{
+			name: "Date",
+			in: `{
+				"@type": "g:Date",
+				"@value": 1481750076295
+			}`,
+			want: time.Unix(0, 1481750076295*time.Millisecond.Nanoseconds()),
+		},
 		{
 			name: "Int32",
 			in: `{
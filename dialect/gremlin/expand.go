@@ -6,7 +6,10 @@ package gremlin
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/json-iterator/go"
@@ -33,7 +36,7 @@ func ExpandBindings(rt RoundTripper) RoundTripper {
 			sort.Sort(sort.Reverse(keys))
 			kv := make([]string, 0, len(bindings)*2)
 			for _, k := range keys {
-				s, err := jsoniter.MarshalToString(bindings[k])
+				s, err := expandValue(bindings[k])
 				if err != nil {
 					return nil, errors.WithMessagef(err, "marshal bindings value for key %s", k)
 				}
@@ -45,3 +48,26 @@ func ExpandBindings(rt RoundTripper) RoundTripper {
 		return rt.RoundTrip(ctx, r)
 	})
 }
+
+// expandValue returns the Gremlin (Groovy) literal of a bindings value. Sized numbers are suffixed
+// with their type, in order to keep their GraphSON types (e.g. g:Int64 or g:Double) and precision
+// when they are stored in the graph, and byte slices are decoded from their base64 representation
+// (as gx:ByteBuffer). Other values (e.g. int, used for limits and ranges) are encoded as JSON.
+func expandValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10) + "L", nil
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10) + "L", nil
+	case uint64:
+		return strconv.FormatUint(v, 10) + "G", nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64) + "d", nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32) + "f", nil
+	case []byte:
+		return fmt.Sprintf("%q.decodeBase64()", base64.StdEncoding.EncodeToString(v)), nil
+	default:
+		return jsoniter.MarshalToString(v)
+	}
+}
@@ -46,6 +46,22 @@ func TestExpandBindings(t *testing.T) {
 			req:       NewEvalRequest("g.V().has($1, $11)", WithBindings(map[string]interface{}{"$1": "active", "$11": true})),
 			wantQuery: "g.V().has(\"active\", true)",
 		},
+		{
+			req:       NewEvalRequest("g.V().has($0, $1)", WithBindings(map[string]interface{}{"$0": "size", "$1": int64(9007199254740993)})),
+			wantQuery: "g.V().has(\"size\", 9007199254740993L)",
+		},
+		{
+			req:       NewEvalRequest("g.V().has($0, $1)", WithBindings(map[string]interface{}{"$0": "size", "$1": uint64(1<<63 + 1)})),
+			wantQuery: "g.V().has(\"size\", 9223372036854775809G)",
+		},
+		{
+			req:       NewEvalRequest("g.V().has($0, $1).has($2, $3)", WithBindings(map[string]interface{}{"$0": "f64", "$1": 1.5, "$2": "f32", "$3": float32(0.1)})),
+			wantQuery: "g.V().has(\"f64\", 1.5d).has(\"f32\", 0.1f)",
+		},
+		{
+			req:       NewEvalRequest("g.V().has($0, $1)", WithBindings(map[string]interface{}{"$0": "blob", "$1": []byte("ent")})),
+			wantQuery: "g.V().has(\"blob\", \"ZW50\".decodeBase64())",
+		},
 	}
 	for i, tt := range tests {
 		tt := tt
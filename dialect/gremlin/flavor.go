@@ -0,0 +1,90 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Flavor is the flavor of the gremlin server. It's used for adjusting the
+// requests and the traversals to the servers that partially support the
+// TinkerPop gremlin API.
+type Flavor string
+
+// Gremlin server flavors.
+const (
+	// TinkerPop is the default flavor, and it's compatible with the Apache TinkerPop gremlin server.
+	TinkerPop Flavor = ""
+	// Neptune is the flavor of AWS Neptune. It does not support Groovy closures and variables.
+	Neptune Flavor = "neptune"
+	// CosmosDB is the flavor of Azure Cosmos DB. In addition to Neptune, it does not support
+	// bindings, bytecode requests, terminal steps (e.g. next() or hasNext()) and regex predicates.
+	CosmosDB Flavor = "cosmosdb"
+)
+
+// WithFlavor configures the client to be compatible with the given server flavor.
+//
+//	c, err := gremlin.NewClient(cfg, gremlin.WithFlavor(gremlin.Neptune))
+//
+func WithFlavor(f Flavor) Option {
+	return func(opts *options) {
+		opts.flavor = f
+	}
+}
+
+// String implements fmt.Stringer interface.
+func (f Flavor) String() string {
+	if f == TinkerPop {
+		return "tinkerpop"
+	}
+	return string(f)
+}
+
+// expansion reports if the bindings must be expanded into the query.
+func (f Flavor) expansion() bool {
+	return f == CosmosDB
+}
+
+// unsupported holds the query tokens that are not supported by the flavors.
+var unsupported = map[Flavor][]string{
+	Neptune:  {" = ", " { "},
+	CosmosDB: {" = ", " { "},
+}
+
+// noRegex holds the regex predicates tokens for the flavors that do not support them.
+var noRegex = map[Flavor][]string{
+	CosmosDB: {"regex(", "notRegex("},
+}
+
+// rewrite adjusts a query, as generated by the dsl package, to the flavor. It fails if
+// the query uses features that are not supported by the flavor. Note that, the query
+// must be rewritten before its bindings are expanded.
+func (f Flavor) rewrite(query string) (string, error) {
+	for _, tok := range unsupported[f] {
+		if strings.Contains(query, tok) {
+			return "", errors.Errorf("gremlin: %s does not support Groovy variables and closures: %q", f, query)
+		}
+	}
+	for _, tok := range noRegex[f] {
+		if strings.Contains(query, tok) {
+			return "", errors.Errorf("gremlin: %s does not support regex predicates (e.g. EqualFold or ContainsFold): %q", f, query)
+		}
+	}
+	if f != CosmosDB {
+		return query, nil
+	}
+	return terminals.Replace(query), nil
+}
+
+// terminals replaces the terminal steps of the queries with steps that are supported by
+// the Cosmos DB, as the server iterates the traversals and returns all of their results.
+var terminals = strings.NewReplacer(
+	".next()", ".limit(1)",
+	".toList()", "",
+	".iterate()", "",
+	".hasNext()", ".fold().coalesce(__.unfold().limit(1).constant(true), __.constant(false))",
+)
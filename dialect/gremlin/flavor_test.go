@@ -0,0 +1,85 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/g"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlavorRewrite(t *testing.T) {
+	tests := []struct {
+		flavor  Flavor
+		input   dsl.Querier
+		want    string
+		wantErr bool
+	}{
+		{
+			flavor: TinkerPop,
+			input:  g.V().HasNext(),
+			want:   "g.V().hasNext()",
+		},
+		{
+			flavor: TinkerPop,
+			input:  dsl.Group(g.V().Count()),
+			want:   "t0 = g.V().count(); t0",
+		},
+		{
+			flavor: Neptune,
+			input:  g.V().HasNext(),
+			want:   "g.V().hasNext()",
+		},
+		{
+			flavor:  Neptune,
+			input:   dsl.Group(g.V().Count()),
+			wantErr: true,
+		},
+		{
+			flavor: CosmosDB,
+			input:  g.V().HasLabel("user").HasNext(),
+			want:   "g.V().hasLabel($0).fold().coalesce(__.unfold().limit(1).constant(true), __.constant(false))",
+		},
+		{
+			flavor: CosmosDB,
+			input:  dsl.Join(g.V(1).Drop().Iterate(), g.V(2).Next()),
+			want:   "g.V($0).drop(); g.V($1).limit(1)",
+		},
+		{
+			flavor: CosmosDB,
+			input: dsl.Each(g.V(), func(it *dsl.Traversal) *dsl.Traversal {
+				return it.Drop()
+			}),
+			wantErr: true,
+		},
+		{
+			flavor: Neptune,
+			input:  g.V().Has("name", p.EqualFold("a8m")).Count(),
+			want:   "g.V().has($0, regex($1)).count()",
+		},
+		{
+			flavor:  CosmosDB,
+			input:   g.V().Has("name", p.ContainsFold("a8m")).Count(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		query, _ := tt.input.Query()
+		t.Run(tt.flavor.String()+"/"+query, func(t *testing.T) {
+			got, err := tt.flavor.rewrite(query)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -39,6 +39,9 @@ func OutE(args ...interface{}) *dsl.Traversal { return New().OutE(args...) }
 // InE is the api for calling __.InE().
 func InE(args ...interface{}) *dsl.Traversal { return New().InE(args...) }
 
+// BothE is the api for calling __.BothE().
+func BothE(args ...interface{}) *dsl.Traversal { return New().BothE(args...) }
+
 // InV is the api for calling __.InV().
 func InV(args ...interface{}) *dsl.Traversal { return New().InV(args...) }
 
@@ -72,4 +75,16 @@ func Drop() *dsl.Traversal { return New().Drop() }
 // Fold is the api for calling __.Fold().
 func Fold() *dsl.Traversal { return New().Fold() }
 
+// Unfold is the api for calling __.Unfold().
+func Unfold() *dsl.Traversal { return New().Unfold() }
+
+// Select is the api for calling __.Select().
+func Select(args ...interface{}) *dsl.Traversal { return New().Select(args...) }
+
+// AddV is the api for calling __.AddV().
+func AddV(args ...interface{}) *dsl.Traversal { return New().AddV(args...) }
+
+// Repeat is the api for calling __.Repeat().
+func Repeat(args ...interface{}) *dsl.Traversal { return New().Repeat(args...) }
+
 func New() *dsl.Traversal { return new(dsl.Traversal).Add(dsl.Token("__")) }
@@ -135,6 +135,18 @@ const (
 // Code implements the Node interface.
 func (o Order) Code() (string, []interface{}) { return string(o), nil }
 
+// T holds the tokens of the graph elements (vertices and edges).
+type T string
+
+// T options.
+const (
+	ID    T = "T.id"
+	Label T = "T.label"
+)
+
+// Code implements the Node interface.
+func (t T) Code() (string, []interface{}) { return string(t), nil }
+
 // Column references a particular type of column in a complex data structure such as a Map, a Map.Entry, or a Path.
 type Column string
 
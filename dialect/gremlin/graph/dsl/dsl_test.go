@@ -27,6 +27,11 @@ func TestTraverse(t *testing.T) {
 			wantQuery: "g.V($0)",
 			wantBinds: dsl.Bindings{"$0": 5},
 		},
+		{
+			input:     g.Inject(1),
+			wantQuery: "g.inject($0)",
+			wantBinds: dsl.Bindings{"$0": 1},
+		},
 		{
 			input:     g.V(2).Both("knows"),
 			wantQuery: "g.V($0).both($1)",
@@ -124,6 +129,11 @@ func TestTraverse(t *testing.T) {
 			wantQuery: `g.V().has($0, containing($1)).has($2, startingWith($3))`,
 			wantBinds: dsl.Bindings{"$0": "name", "$1": "le", "$2": "name", "$3": "A"},
 		},
+		{
+			input:     g.V().Has("name", p.EqualFold("a.m")).Has("name", p.ContainsFold("(x)")),
+			wantQuery: `g.V().has($0, regex($1)).has($2, regex($3))`,
+			wantBinds: dsl.Bindings{"$0": "name", "$1": `(?iu)^a\.m\z`, "$2": "name", "$3": `(?iu)\(x\)`},
+		},
 		{
 			input:     g.AddV().Property(dsl.Single, "age", 32).ValueMap(),
 			wantQuery: "g.addV().property(single, $0, $1).valueMap()",
@@ -188,6 +198,11 @@ func TestTraverse(t *testing.T) {
 			wantQuery: "g.V().order().by($0, incr)",
 			wantBinds: dsl.Bindings{"$0": "name"},
 		},
+		{
+			input:     g.V().Order().By("name", dsl.Incr).By(dsl.ID, dsl.Incr),
+			wantQuery: "g.V().order().by($0, incr).by(T.id, incr)",
+			wantBinds: dsl.Bindings{"$0": "name"},
+		},
 		{
 			input:     g.V().Order().By("name", dsl.Incr).Undo(),
 			wantQuery: "g.V().order()",
@@ -221,6 +236,24 @@ func TestTraverse(t *testing.T) {
 			wantQuery: "g.V().has($0).property($1, __.union(__.values($2), __.constant($3)).sum()).valueMap()",
 			wantBinds: dsl.Bindings{"$0": "age", "$1": "age", "$2": "age", "$3": 10},
 		},
+		{
+			input: g.V().Has("person", "phone", "972").Fold().Coalesce(
+				__.Unfold(),
+				__.AddV("person").Property("phone", "972"),
+			).Property("name", "a8m").ValueMap(true),
+			wantQuery: "g.V().has($0, $1, $2).fold().coalesce(__.unfold(), __.addV($3).property($4, $5)).property($6, $7).valueMap($8)",
+			wantBinds: dsl.Bindings{"$0": "person", "$1": "phone", "$2": "972", "$3": "person", "$4": "phone", "$5": "972", "$6": "name", "$7": "a8m", "$8": true},
+		},
+		{
+			input:     g.V().Where(__.Repeat(__.Out("parent").SimplePath()).Emit().Has("name", "a8m")).ValueMap(),
+			wantQuery: "g.V().where(__.repeat(__.out($0).simplePath()).emit().has($1, $2)).valueMap()",
+			wantBinds: dsl.Bindings{"$0": "parent", "$1": "name", "$2": "a8m"},
+		},
+		{
+			input:     g.V().Repeat(__.In("next")).Until(__.HasNot("prev")),
+			wantQuery: "g.V().repeat(__.in($0)).until(__.hasNot($1))",
+			wantBinds: dsl.Bindings{"$0": "next", "$1": "prev"},
+		},
 		{
 			input:     g.V().Has("age").SideEffect(__.Properties("name").Drop()).ValueMap(),
 			wantQuery: "g.V().has($0).sideEffect(__.properties($1).drop()).valueMap()",
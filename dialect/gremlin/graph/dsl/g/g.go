@@ -17,3 +17,6 @@ func AddV(args ...interface{}) *dsl.Traversal { return dsl.NewTraversal().AddV(a
 
 // AddE is the api for calling g.AddE().
 func AddE(args ...interface{}) *dsl.Traversal { return dsl.NewTraversal().AddE(args...) }
+
+// Inject is the api for calling g.Inject().
+func Inject(args ...interface{}) *dsl.Traversal { return dsl.NewTraversal().Inject(args...) }
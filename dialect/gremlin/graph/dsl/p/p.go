@@ -5,6 +5,8 @@
 package p
 
 import (
+	"strings"
+
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 )
 
@@ -73,6 +75,39 @@ func NotContaining(substr string) *dsl.Traversal {
 	return op("notContaining", substr)
 }
 
+// Regex is the regular expression test predicate (TextP.regex). Note that the pattern is
+// matched using the regex engine of the server, and it's not supported by all servers.
+func Regex(pattern string) *dsl.Traversal {
+	return op("regex", pattern)
+}
+
+// NotRegex is the negation of Regex.
+func NotRegex(pattern string) *dsl.Traversal {
+	return op("notRegex", pattern)
+}
+
+// EqualFold is the case-insensitive equality predicate. It's implemented using Regex.
+func EqualFold(s string) *dsl.Traversal {
+	return Regex(`(?iu)^` + quoteRegex(s) + `\z`)
+}
+
+// ContainsFold is the case-insensitive sub string test predicate. It's implemented using Regex.
+func ContainsFold(substr string) *dsl.Traversal {
+	return Regex("(?iu)" + quoteRegex(substr))
+}
+
+// quoteRegex escapes the special characters of the (Java) regex syntax in s.
+func quoteRegex(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`\.+*?()|[]{}^$`, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
 // Within Determines if a value is within the specified list of values.
 func Within(args ...interface{}) *dsl.Traversal {
 	return op("within", args...)
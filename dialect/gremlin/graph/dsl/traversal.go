@@ -72,6 +72,12 @@ func (t *Traversal) E(args ...interface{}) *Traversal {
 	return t
 }
 
+// Inject step starts a traversal with the given objects.
+func (t *Traversal) Inject(args ...interface{}) *Traversal {
+	t.Add(Dot, NewFunc("inject", args...))
+	return t
+}
+
 // AddV adds a vertex.
 func (t *Traversal) AddV(args ...interface{}) *Traversal {
 	t.Add(Dot, NewFunc("addV", args...))
@@ -339,6 +345,28 @@ func (t *Traversal) Union(args ...interface{}) *Traversal {
 	return t.Add(Dot, NewFunc("union", args...))
 }
 
+// Repeat loops over the given traversal. It's used with the Emit and Until steps to
+// control which traversers are emitted by the loop, and when it stops.
+func (t *Traversal) Repeat(args ...interface{}) *Traversal {
+	return t.Add(Dot, NewFunc("repeat", args...))
+}
+
+// Emit emits the traversers of the repeat loop. If it's placed after the Repeat step,
+// the traversers are emitted after each iteration of the loop.
+func (t *Traversal) Emit(args ...interface{}) *Traversal {
+	return t.Add(Dot, NewFunc("emit", args...))
+}
+
+// Until sets the break predicate of the repeat loop.
+func (t *Traversal) Until(args ...interface{}) *Traversal {
+	return t.Add(Dot, NewFunc("until", args...))
+}
+
+// SimplePath filters traversers that their path has repeated objects (cycles).
+func (t *Traversal) SimplePath() *Traversal {
+	return t.Add(Dot, NewFunc("simplePath"))
+}
+
 // SideEffect allows the traverser to proceed unchanged, but yield some computational
 // sideEffect in the process.
 func (t *Traversal) SideEffect(args ...interface{}) *Traversal {
@@ -368,6 +396,12 @@ func (t *Traversal) Add(n ...Node) *Traversal {
 	return t
 }
 
+// Nodes returns the nodes of the traversal. It's used for encoding the traversal
+// in other representations (e.g. bytecode), and should not be modified.
+func (t *Traversal) Nodes() []Node {
+	return t.nodes
+}
+
 // Query returns the query-representation and its binding of this traversal object.
 func (t *Traversal) Query() (string, Bindings) {
 	var (
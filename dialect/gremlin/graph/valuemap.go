@@ -6,6 +6,7 @@ package graph
 
 import (
 	"reflect"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
@@ -14,8 +15,47 @@ import (
 // ValueMap models a .valueMap() gremlin response.
 type ValueMap []map[string]interface{}
 
+// DecodeHook is a function for converting the values of a value map before they are decoded into
+// their target type. It gets the type of the value, the target type and the value itself, and
+// returns the converted value. For example, decoding string properties into a custom type:
+//
+//	vm.Decode(&v, graph.WithDecodeHook(func(from, to reflect.Type, data interface{}) (interface{}, error) {
+//		if from.Kind() == reflect.String && to == reflect.TypeOf(Color{}) {
+//			return ParseColor(data.(string))
+//		}
+//		return data, nil
+//	}))
+type DecodeHook func(from, to reflect.Type, data interface{}) (interface{}, error)
+
+// DecodeOption configures the decoding of a value map.
+type DecodeOption func(*mapstructure.DecoderConfig)
+
+// WithDecodeHook adds a decode hook to the decoding. Hooks are called after the
+// built-in hooks (that unwrap single-valued properties and convert timestamps).
+func WithDecodeHook(hook DecodeHook) DecodeOption {
+	return func(cfg *mapstructure.DecoderConfig) {
+		cfg.DecodeHook = mapstructure.ComposeDecodeHookFunc(cfg.DecodeHook, mapstructure.DecodeHookFuncType(hook))
+	}
+}
+
+// StrictDecode fails the decoding if the value map has keys
+// that are not mapped to fields in the target structs.
+func StrictDecode() DecodeOption {
+	return func(cfg *mapstructure.DecoderConfig) {
+		cfg.ErrorUnused = true
+	}
+}
+
+// LenientDecode enables weak conversions between the types of the values and their
+// targets. For example, numbers can be decoded into strings and strings into numbers.
+func LenientDecode() DecodeOption {
+	return func(cfg *mapstructure.DecoderConfig) {
+		cfg.WeaklyTypedInput = true
+	}
+}
+
 // Decode decodes a value map into v.
-func (m ValueMap) Decode(v interface{}) error {
+func (m ValueMap) Decode(v interface{}, opts ...DecodeOption) error {
 	rv := reflect.ValueOf(v)
 	if rv.Kind() != reflect.Ptr {
 		return errors.New("cannot unmarshal into a non pointer")
@@ -27,22 +67,17 @@ func (m ValueMap) Decode(v interface{}) error {
 	if rv.Elem().Kind() != reflect.Slice {
 		v = &[]interface{}{v}
 	}
-	return m.decode(v)
+	return m.decode(v, opts)
 }
 
-func (m ValueMap) decode(v interface{}) error {
+func (m ValueMap) decode(v interface{}, opts []DecodeOption) error {
 	cfg := mapstructure.DecoderConfig{
-		DecodeHook: func(f, t reflect.Kind, data interface{}) (interface{}, error) {
-			if f == reflect.Slice && t != reflect.Slice {
-				rv := reflect.ValueOf(data)
-				if rv.Len() == 1 {
-					data = rv.Index(0).Interface()
-				}
-			}
-			return data, nil
-		},
-		Result:  v,
-		TagName: "json",
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(unwrapHook, timeHook),
+		Result:     v,
+		TagName:    "json",
+	}
+	for _, opt := range opts {
+		opt(&cfg)
 	}
 
 	dec, err := mapstructure.NewDecoder(&cfg)
@@ -54,3 +89,48 @@ func (m ValueMap) decode(v interface{}) error {
 	}
 	return nil
 }
+
+// unwrapHook unwraps the lists of single-valued properties that are decoded into non-list types,
+// or into byte slices (the values of properties that are stored as gx:ByteBuffer).
+func unwrapHook(f, t reflect.Type, data interface{}) (interface{}, error) {
+	if f.Kind() != reflect.Slice || f == bytesType {
+		return data, nil
+	}
+	rv := reflect.ValueOf(data)
+	if rv.Len() != 1 {
+		return data, nil
+	}
+	switch v := rv.Index(0).Interface(); {
+	case t.Kind() != reflect.Slice:
+		return v, nil
+	case t == bytesType:
+		if b, ok := v.([]byte); ok {
+			return b, nil
+		}
+	}
+	return data, nil
+}
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	bytesType = reflect.TypeOf([]byte(nil))
+)
+
+// timeHook converts timestamps (milliseconds since epoch) and RFC 3339 strings to time.Time.
+func timeHook(f, t reflect.Type, data interface{}) (interface{}, error) {
+	if t != timeType {
+		return data, nil
+	}
+	switch v := reflect.ValueOf(data); f.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return time.Unix(0, v.Int()*time.Millisecond.Nanoseconds()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return time.Unix(0, int64(v.Uint())*time.Millisecond.Nanoseconds()), nil
+	case reflect.Float32, reflect.Float64:
+		return time.Unix(0, int64(v.Float())*time.Millisecond.Nanoseconds()), nil
+	case reflect.String:
+		return time.Parse(time.RFC3339Nano, v.String())
+	default:
+		return data, nil
+	}
+}
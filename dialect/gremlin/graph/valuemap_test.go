@@ -5,7 +5,10 @@
 package graph
 
 import (
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -72,3 +75,86 @@ func TestValueMapDecodeBadInput(t *testing.T) {
 	err = ValueMap{}.Decode((*s)(nil))
 	assert.Error(t, err)
 }
+
+func TestValueMapDecodeNested(t *testing.T) {
+	ts := time.Unix(0, 1481750076295*time.Millisecond.Nanoseconds())
+	vm := ValueMap{map[string]interface{}{
+		"name":     []interface{}{"a8m"},
+		"created":  []interface{}{ts},
+		"updated":  []interface{}{int64(1481750076295)},
+		"deleted":  []interface{}{"2016-12-14T21:14:36.295Z"},
+		"address":  []interface{}{map[string]interface{}{"city": []interface{}{"TLV"}}},
+		"previous": []interface{}{map[string]interface{}{"city": "NYC"}},
+	}}
+	type address struct {
+		City string `json:"city"`
+	}
+	var ent struct {
+		Name     string    `json:"name"`
+		Created  time.Time `json:"created"`
+		Updated  time.Time `json:"updated"`
+		Deleted  time.Time `json:"deleted"`
+		Address  address   `json:"address"`
+		Previous *address  `json:"previous"`
+	}
+	err := vm.Decode(&ent)
+	require.NoError(t, err)
+	assert.Equal(t, "a8m", ent.Name)
+	assert.True(t, ts.Equal(ent.Created))
+	assert.True(t, ts.Equal(ent.Updated))
+	assert.True(t, ts.Equal(ent.Deleted))
+	assert.Equal(t, "TLV", ent.Address.City)
+	require.NotNil(t, ent.Previous)
+	assert.Equal(t, "NYC", ent.Previous.City)
+}
+
+func TestValueMapDecodeOptions(t *testing.T) {
+	vm := ValueMap{map[string]interface{}{
+		"name": []interface{}{"a8m"},
+		"age":  []interface{}{"30"},
+	}}
+	var ent struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+	err := vm.Decode(&ent)
+	assert.Error(t, err, "string cannot be decoded into int")
+	err = vm.Decode(&ent, LenientDecode())
+	require.NoError(t, err)
+	assert.Equal(t, 30, ent.Age)
+
+	var name struct {
+		Name string `json:"name"`
+	}
+	err = vm.Decode(&name, StrictDecode())
+	assert.Error(t, err, "age is not mapped to a field")
+	err = vm.Decode(&name, WithDecodeHook(func(from, to reflect.Type, data interface{}) (interface{}, error) {
+		if s, ok := data.(string); ok && to.Kind() == reflect.String {
+			return strings.ToUpper(s), nil
+		}
+		return data, nil
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "A8M", name.Name)
+}
+
+func TestValueMapDecodeTyped(t *testing.T) {
+	vm := ValueMap{map[string]interface{}{
+		"blob":  []interface{}{[]byte("ent")},
+		"size":  []interface{}{int64(1<<62 + 1)},
+		"bytes": []byte("raw"),
+		"tags":  []interface{}{"a"},
+	}}
+	var ent struct {
+		Blob  []byte   `json:"blob"`
+		Size  int64    `json:"size"`
+		Bytes []byte   `json:"bytes"`
+		Tags  []string `json:"tags"`
+	}
+	err := vm.Decode(&ent)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("ent"), ent.Blob)
+	assert.Equal(t, int64(1<<62+1), ent.Size)
+	assert.Equal(t, []byte("raw"), ent.Bytes)
+	assert.Equal(t, []string{"a"}, ent.Tags)
+}
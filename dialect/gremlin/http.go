@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/facebookincubator/ent/dialect/gremlin/encoding"
 	"github.com/facebookincubator/ent/dialect/gremlin/encoding/graphson"
 
 	jsoniter "github.com/json-iterator/go"
@@ -36,7 +37,7 @@ func NewHTTPTransport(urlStr string, client *http.Client) (RoundTripper, error)
 
 // RoundTrip implements RouterTripper interface.
 func (t *httpTransport) RoundTrip(ctx context.Context, req *Request) (*Response, error) {
-	if req.Operation != OpsEval {
+	if req.Operation != OpsEval && req.Operation != OpsBytecode {
 		return nil, errors.Errorf("gremlin/http: unsupported operation: %q", req.Operation)
 	}
 	if _, ok := req.Arguments[ArgsGremlin]; !ok {
@@ -45,8 +46,18 @@ func (t *httpTransport) RoundTrip(ctx context.Context, req *Request) (*Response,
 
 	pr, pw := io.Pipe()
 	defer pr.Close()
+	contentType := "application/json"
+	if req.Operation == OpsBytecode {
+		// bytecode requests are sent as GraphSON 3 request messages.
+		contentType = encoding.GraphSON3Mime.String()
+	}
 	go func() {
-		err := jsoniter.NewEncoder(pw).Encode(req.Arguments)
+		var err error
+		if req.Operation == OpsBytecode {
+			err = graphson.NewEncoder(pw).Encode(req)
+		} else {
+			err = jsoniter.NewEncoder(pw).Encode(req.Arguments)
+		}
 		_ = pw.CloseWithError(errors.Wrap(err, "gremlin/http: encoding request"))
 	}()
 
@@ -56,7 +67,7 @@ func (t *httpTransport) RoundTrip(ctx context.Context, req *Request) (*Response,
 		if err != nil {
 			return nil, errors.Wrap(err, "gremlin/http: creating http request")
 		}
-		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Type", contentType)
 
 		rsp, err := t.client.Do(req.WithContext(ctx))
 		if err != nil {
@@ -66,10 +66,22 @@ type (
 		// partially received data
 		frags []graphson.RawMessage
 
+		// queue of responses of streamed requests
+		queue *queue
+
 		// response channel
 		result chan<- result
 	}
 
+	// queue is an unbounded queue of the responses of a streamed request. Responses are
+	// passed to the frame handler of the request by its own goroutine, and therefore,
+	// a slow (or blocked) handler does not block the receiver.
+	queue struct {
+		sync.Mutex
+		results []result
+		ready   chan struct{}
+	}
+
 	// represents an execution result.
 	result struct {
 		rsp *gremlin.Response
@@ -123,15 +135,28 @@ func (d *Dialer) DialContext(ctx context.Context, uri string) (*Conn, error) {
 	return conn, nil
 }
 
-// Execute executes a request against a Gremlin server.
+// Execute executes a request against a Gremlin server. Partial responses of requests that their
+// context carries a gremlin.FrameHandler are passed to the handler instead of being reassembled.
 func (c *Conn) Execute(ctx context.Context, req *gremlin.Request) (*gremlin.Response, error) {
 	// buffered result channel prevents receiver block on context cancellation
 	result := make(chan result, 1)
 
 	// request id must be unique across inflight request
-	if _, loaded := c.inflight.LoadOrStore(req.RequestID, &inflight{result: result}); loaded {
+	ifr := &inflight{result: result}
+	h := gremlin.FrameHandlerFromContext(ctx)
+	if h != nil {
+		ifr.queue = &queue{ready: make(chan struct{}, 1)}
+	}
+	if _, loaded := c.inflight.LoadOrStore(req.RequestID, ifr); loaded {
 		return nil, ErrDuplicateRequest
 	}
+	if h != nil {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		// frames that arrive after the handler failed are ignored.
+		defer c.inflight.Delete(req.RequestID)
+		go ifr.queue.stream(ctx, h, result)
+	}
 
 	pr, pw := io.Pipe()
 	defer pr.Close()
@@ -230,7 +255,7 @@ func (c *Conn) receiver() error {
 
 	// complete all in flight requests on termination
 	defer c.inflight.Range(func(id, ifr interface{}) bool {
-		ifr.(*inflight).result <- result{err: ErrConnClosed}
+		ifr.(*inflight).complete(result{err: ErrConnClosed})
 		c.inflight.Delete(id)
 		return true
 	})
@@ -273,6 +298,12 @@ func (c *Conn) receive(ifr *inflight, rsp *gremlin.Response) bool {
 		// handle fragment
 		fallthrough
 	case gremlin.StatusPartialContent:
+		// queue fragment for the request handler
+		if ifr.queue != nil {
+			ifr.queue.push(result)
+			return false
+		}
+
 		// append received fragment
 		var frag []graphson.RawMessage
 		if err := graphson.Unmarshal(rsp.Result.Data, &frag); err != nil {
@@ -308,6 +339,60 @@ func (c *Conn) receive(ifr *inflight, rsp *gremlin.Response) bool {
 		return false
 	}
 
-	ifr.result <- result
+	ifr.complete(result)
 	return true
 }
+
+// complete completes the request with the given result. The results of streamed
+// requests are queued after their partial responses.
+func (ifr *inflight) complete(r result) {
+	if ifr.queue != nil {
+		ifr.queue.push(r)
+		return
+	}
+	ifr.result <- r
+}
+
+// push adds a result to the queue, and never blocks.
+func (q *queue) push(r result) {
+	q.Lock()
+	q.results = append(q.results, r)
+	q.Unlock()
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// pop removes the first result from the queue, if there is any.
+func (q *queue) pop() (r result, ok bool) {
+	q.Lock()
+	defer q.Unlock()
+	if len(q.results) == 0 {
+		return r, false
+	}
+	r, q.results[0] = q.results[0], result{}
+	q.results = q.results[1:]
+	return r, true
+}
+
+// stream passes the queued partial responses to the handler in order, and sends
+// the final result (or the error of the handler) to the request result channel.
+func (q *queue) stream(ctx context.Context, h gremlin.FrameHandler, done chan<- result) {
+	for {
+		select {
+		case <-q.ready:
+		case <-ctx.Done():
+			return
+		}
+		for r, ok := q.pop(); ok; r, ok = q.pop() {
+			if r.err == nil && r.rsp.Status.Code == gremlin.StatusPartialContent {
+				if r.err = h(r.rsp); r.err == nil {
+					continue
+				}
+			}
+			done <- r
+			return
+		}
+	}
+}
@@ -346,6 +346,107 @@ func TestPartialResponse(t *testing.T) {
 	assert.Equal(t, kvs, result)
 }
 
+func TestStreamedPartialResponse(t *testing.T) {
+	srv := serve(func(conn conn) {
+		req, err := conn.ReadRequest()
+		require.NoError(t, err)
+
+		for i := 1; i <= 3; i++ {
+			data, err := graphson.Marshal([]int{i})
+			require.NoError(t, err)
+
+			rsp := gremlin.Response{RequestID: req.RequestID}
+			rsp.Result.Data = graphson.RawMessage(data)
+			rsp.Status.Code = gremlin.StatusPartialContent
+			if i == 3 {
+				rsp.Status.Code = gremlin.StatusSuccess
+			}
+
+			err = conn.WriteResponse(&rsp)
+			require.NoError(t, err)
+		}
+	})
+	defer srv.Close()
+
+	conn, err := DefaultDialer.Dial("ws://" + srv.Listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var frames [][]int
+	ctx := gremlin.WithFrameHandler(context.Background(), func(rsp *gremlin.Response) error {
+		var frame []int
+		if err := graphson.Unmarshal(rsp.Result.Data, &frame); err != nil {
+			return err
+		}
+		frames = append(frames, frame)
+		return nil
+	})
+	rsp, err := conn.Execute(ctx, gremlin.NewEvalRequest("g.V().id()"))
+	require.NoError(t, err)
+	assert.Equal(t, [][]int{{1}, {2}}, frames)
+
+	var last []int
+	err = graphson.Unmarshal(rsp.Result.Data, &last)
+	require.NoError(t, err)
+	assert.Equal(t, []int{3}, last)
+}
+
+func TestConcurrentStreams(t *testing.T) {
+	srv := serve(func(conn conn) {
+		var ids []string
+		for i := 0; i < 2; i++ {
+			req, err := conn.ReadRequest()
+			require.NoError(t, err)
+			ids = append(ids, req.RequestID)
+		}
+		// interleave the frames of the two requests.
+		for i := 1; i <= 3; i++ {
+			for _, id := range ids {
+				data, err := graphson.Marshal([]int{i})
+				require.NoError(t, err)
+				rsp := gremlin.Response{RequestID: id}
+				rsp.Result.Data = graphson.RawMessage(data)
+				rsp.Status.Code = gremlin.StatusPartialContent
+				if i == 3 {
+					rsp.Status.Code = gremlin.StatusSuccess
+				}
+				err = conn.WriteResponse(&rsp)
+				require.NoError(t, err)
+			}
+		}
+	})
+	defer srv.Close()
+
+	conn, err := DefaultDialer.Dial("ws://" + srv.Listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the handler of the first stream is never drained.
+	blocked, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go conn.Execute(gremlin.WithFrameHandler(blocked, func(*gremlin.Response) error {
+		<-blocked.Done()
+		return blocked.Err()
+	}), gremlin.NewEvalRequest("g.V()"))
+
+	var frames [][]int
+	ctx := gremlin.WithFrameHandler(context.Background(), func(rsp *gremlin.Response) error {
+		var frame []int
+		if err := graphson.Unmarshal(rsp.Result.Data, &frame); err != nil {
+			return err
+		}
+		frames = append(frames, frame)
+		return nil
+	})
+	rsp, err := conn.Execute(ctx, gremlin.NewEvalRequest("g.E()"))
+	require.NoError(t, err)
+	assert.Equal(t, [][]int{{1}, {2}}, frames)
+	var last []int
+	err = graphson.Unmarshal(rsp.Result.Data, &last)
+	require.NoError(t, err)
+	assert.Equal(t, []int{3}, last)
+}
+
 func TestAuthentication(t *testing.T) {
 	user, pass := "username", "password"
 	srv := serve(func(conn conn) {
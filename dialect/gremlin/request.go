@@ -45,6 +45,23 @@ func NewEvalRequest(query string, opts ...RequestOption) *Request {
 	return r
 }
 
+// NewBytecodeRequest returns a new bytecode request for the given traversal.
+func NewBytecodeRequest(bc *Bytecode, opts ...RequestOption) *Request {
+	r := &Request{
+		RequestID: uuid.New().String(),
+		Operation: OpsBytecode,
+		Processor: ProcessorTraversal,
+		Arguments: map[string]interface{}{
+			ArgsGremlin: bc,
+			ArgsAliases: map[string]interface{}{"g": "g"},
+		},
+	}
+	for i := range opts {
+		opts[i](r)
+	}
+	return r
+}
+
 // NewAuthRequest returns a new auth request.
 func NewAuthRequest(requestID, username, password string) *Request {
 	return &Request{
@@ -67,6 +84,13 @@ func WithBindings(bindings map[string]interface{}) RequestOption {
 	}
 }
 
+// WithBatchSize sets the number of results that are returned in each frame (partial response) of a request.
+func WithBatchSize(size int) RequestOption {
+	return func(r *Request) {
+		r.Arguments[ArgsBatchSize] = size
+	}
+}
+
 // WithEvalTimeout sets script evaluation timeout.
 func WithEvalTimeout(timeout time.Duration) RequestOption {
 	return func(r *Request) {
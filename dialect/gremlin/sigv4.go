@@ -0,0 +1,156 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AWSCredentials holds the credentials that are used for signing the requests to
+// AWS Neptune, when IAM database authentication is enabled.
+type AWSCredentials struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// WithSigV4 signs the http requests of the client using the AWS Signature Version 4
+// process, as required by Neptune clusters with IAM database authentication.
+//
+//	c, err := gremlin.NewClient(cfg,
+//		gremlin.WithFlavor(gremlin.Neptune),
+//		gremlin.WithSigV4(gremlin.AWSCredentials{
+//			Region:          "us-east-1",
+//			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+//			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+//		}),
+//	)
+//
+func WithSigV4(creds AWSCredentials) Option {
+	return func(opts *options) {
+		opts.sigv4 = &creds
+	}
+}
+
+// sigV4Transport is an http.RoundTripper that signs the requests before sending them.
+type sigV4Transport struct {
+	creds AWSCredentials
+	next  http.RoundTripper
+	now   func() time.Time
+}
+
+// signed returns a copy of the http client that signs its requests with the given credentials.
+func signed(client *http.Client, creds AWSCredentials) *http.Client {
+	var c http.Client
+	if client != nil {
+		c = *client
+	}
+	next := c.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	c.Transport = &sigV4Transport{creds: creds, next: next, now: time.Now}
+	return &c
+}
+
+// AWS signature version 4 constants.
+const (
+	sigV4Algorithm = "AWS4-HMAC-SHA256"
+	sigV4Service   = "neptune-db"
+	sigV4Request   = "aws4_request"
+	amzDateFormat  = "20060102T150405Z"
+)
+
+// RoundTrip implements http.RoundTripper interface.
+func (t *sigV4Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, errors.Wrap(err, "gremlin/sigv4: reading request body")
+		}
+		_ = req.Body.Close()
+	}
+	req = req.Clone(req.Context())
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	t.sign(req, body)
+	return t.next.RoundTrip(req)
+}
+
+// sign adds the signature headers to the request.
+func (t *sigV4Transport) sign(req *http.Request, body []byte) {
+	now := t.now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	scope := strings.Join([]string{amzDate[:8], t.creds.Region, sigV4Service, sigV4Request}, "/")
+	req.Header.Set("X-Amz-Date", amzDate)
+	if t.creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", t.creds.SessionToken)
+	}
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for k := range req.Header {
+		if k := strings.ToLower(k); k == "content-type" || strings.HasPrefix(k, "x-amz-") {
+			headers[k] = strings.TrimSpace(req.Header.Get(k))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonical strings.Builder
+	for _, k := range names {
+		canonical.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	request := strings.Join([]string{
+		req.Method,
+		path,
+		strings.Replace(req.URL.Query().Encode(), "+", "%20", -1),
+		canonical.String(),
+		signedHeaders,
+		hashHex(body),
+	}, "\n")
+	stringToSign := strings.Join([]string{sigV4Algorithm, amzDate, scope, hashHex([]byte(request))}, "\n")
+	key := hmacSHA256([]byte("AWS4"+t.creds.SecretAccessKey), amzDate[:8])
+	for _, s := range []string{t.creds.Region, sigV4Service, sigV4Request} {
+		key = hmacSHA256(key, s)
+	}
+	req.Header.Set("Authorization", sigV4Algorithm+
+		" Credential="+t.creds.AccessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+
+		", Signature="+hex.EncodeToString(hmacSHA256(key, stringToSign)),
+	)
+}
+
+func hashHex(b []byte) string {
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,52 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestSigV4Transport(t *testing.T) {
+	var got *http.Request
+	tr := &sigV4Transport{
+		creds: AWSCredentials{Region: "us-east-1", AccessKeyID: "AKID", SecretAccessKey: "secret", SessionToken: "token"},
+		next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			got = req
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}),
+		now: func() time.Time { return time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC) },
+	}
+	sign := func(body string) string {
+		req, err := http.NewRequest(http.MethodPost, "https://neptune:8182/gremlin", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		_, err = tr.RoundTrip(req)
+		require.NoError(t, err)
+		return got.Header.Get("Authorization")
+	}
+
+	auth := sign(`{"gremlin":"g.V()"}`)
+	assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20191001/us-east-1/neptune-db/aws4_request, "))
+	assert.Contains(t, auth, "SignedHeaders=content-type;host;x-amz-date;x-amz-security-token, Signature=")
+	assert.Equal(t, "20191001T120000Z", got.Header.Get("X-Amz-Date"))
+	assert.Equal(t, "token", got.Header.Get("X-Amz-Security-Token"))
+	body, err := ioutil.ReadAll(got.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"gremlin":"g.V()"}`, string(body), "body should be passed to the next transport")
+
+	assert.Equal(t, auth, sign(`{"gremlin":"g.V()"}`), "signature should be deterministic")
+	assert.NotEqual(t, auth, sign(`{"gremlin":"g.E()"}`), "signature should depend on the payload")
+}
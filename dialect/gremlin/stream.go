@@ -0,0 +1,117 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"context"
+	"sync"
+)
+
+// FrameHandler is called by transports that support multi-frame responses (e.g. websocket
+// connections) with each partial response frame (206) of a request, in order. The final frame
+// of the response is returned by the transport, and an error returned by the handler aborts the
+// request. Handlers are not called by the connection reader, and may block.
+type FrameHandler func(*Response) error
+
+type frameKey struct{}
+
+// WithFrameHandler returns a copy of the parent context that carries the given frame handler.
+// Requests that are executed with this context are not accumulated into a single response by
+// transports that support multi-frame responses, and their partial frames are passed to the handler.
+func WithFrameHandler(parent context.Context, h FrameHandler) context.Context {
+	return context.WithValue(parent, frameKey{}, h)
+}
+
+// FrameHandlerFromContext returns the frame handler of the context, or nil if there is none.
+func FrameHandlerFromContext(ctx context.Context) FrameHandler {
+	h, _ := ctx.Value(frameKey{}).(FrameHandler)
+	return h
+}
+
+// An Iterator iterates over the frames of a streamed response.
+//
+//	it := client.Stream(ctx, gremlin.NewEvalRequest("g.V()", gremlin.WithBatchSize(64)))
+//	defer it.Close()
+//	for it.Next() {
+//		vs, err := it.Response().ReadVertices()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+//
+type Iterator struct {
+	frames <-chan *Response // partial frames.
+	final  <-chan result    // final frame, or the error of the request.
+	cancel func()           // cancels the request.
+	once   sync.Once
+	rsp    *Response
+	err    error
+	done   bool
+}
+
+// result holds the final frame of a response, or the error of its request.
+type result struct {
+	rsp *Response
+	err error
+}
+
+// Stream sends a gremlin request and returns an iterator over the frames of its response. The frames
+// of transports that support multi-frame responses are passed to the iterator as they arrive, and it
+// holds only one frame at a time. These transports queue the frames of each request separately, and
+// therefore, an iterator that is not consumed does not block the other requests. Other transports
+// return the response as a single frame.
+func (c Client) Stream(ctx context.Context, req *Request) *Iterator {
+	ctx, cancel := context.WithCancel(ctx)
+	frames, final := make(chan *Response), make(chan result, 1)
+	go func() {
+		rsp, err := c.Do(WithFrameHandler(ctx, func(rsp *Response) error {
+			select {
+			case frames <- rsp:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}), req)
+		final <- result{rsp, err}
+	}()
+	return &Iterator{frames: frames, final: final, cancel: cancel}
+}
+
+// Next prepares the next response frame for reading with the Response method. It returns
+// false when there are no more frames, or an error occurred, and the iterator is closed.
+func (it *Iterator) Next() bool {
+	if it.done {
+		it.Close()
+		return false
+	}
+	select {
+	case rsp := <-it.frames:
+		it.rsp = rsp
+	case r := <-it.final:
+		it.rsp, it.err, it.done = r.rsp, r.err, true
+		if r.err != nil || r.rsp.Status.Code == StatusNoContent {
+			it.rsp = nil
+			it.Close()
+			return false
+		}
+	}
+	return true
+}
+
+// Response returns the current response frame.
+func (it *Iterator) Response() *Response { return it.rsp }
+
+// Err returns the error, if any, that was encountered during iteration.
+func (it *Iterator) Err() error { return it.err }
+
+// Close closes the iterator, and aborts its request if it was not completed. Close is idempotent.
+func (it *Iterator) Close() error {
+	it.once.Do(func() {
+		it.done = true
+		it.cancel()
+	})
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"context"
+	"time"
+)
+
+// WithEvaluationTimeout configures the client to limit the evaluation time of its requests on the server.
+// See EvaluationTimeout for details.
+//
+//	c, err := gremlin.NewClient(cfg, gremlin.WithEvaluationTimeout(10*time.Second))
+//
+func WithEvaluationTimeout(d time.Duration) Option {
+	return func(opts *options) {
+		opts.evalTimeout = d
+	}
+}
+
+// EvaluationTimeout returns an interceptor that sets the evaluation timeout of the requests on the
+// server (the ArgsEvalTimeout argument). The timeout is shortened to the deadline of the request
+// context, in order to stop the server from evaluating requests that were abandoned by the client.
+// A zero duration sets only the timeouts of requests with a context deadline, and requests that set
+// their own timeout (using WithEvalTimeout) are not changed.
+func EvaluationTimeout(d time.Duration) Interceptor {
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, req *Request) (*Response, error) {
+			if _, ok := req.Arguments[ArgsEvalTimeout]; ok || req.Operation == OpsAuthentication {
+				return next.RoundTrip(ctx, req)
+			}
+			timeout := d
+			if deadline, ok := ctx.Deadline(); ok {
+				left := time.Until(deadline)
+				if left <= 0 {
+					return nil, context.DeadlineExceeded
+				}
+				if timeout <= 0 || left < timeout {
+					timeout = left
+				}
+			}
+			if timeout > 0 {
+				// the server timeout is in milliseconds, and zero disables it.
+				ms := int64(timeout / time.Millisecond)
+				if ms == 0 {
+					ms = 1
+				}
+				req.Arguments[ArgsEvalTimeout] = ms
+			}
+			return next.RoundTrip(ctx, req)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluationTimeout(t *testing.T) {
+	var reqs []*Request
+	transport := EvaluationTimeout(time.Second)(RoundTripperFunc(func(_ context.Context, req *Request) (*Response, error) {
+		reqs = append(reqs, req)
+		return &Response{RequestID: req.RequestID}, nil
+	}))
+
+	_, err := transport.RoundTrip(context.Background(), NewEvalRequest("g.V()"))
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	assert.Equal(t, int64(1000), reqs[0].Arguments[ArgsEvalTimeout])
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = transport.RoundTrip(ctx, NewEvalRequest("g.V()"))
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	timeout, ok := reqs[1].Arguments[ArgsEvalTimeout].(int64)
+	require.True(t, ok)
+	assert.True(t, timeout > 0 && timeout <= 100, "timeout is limited by the context deadline")
+
+	_, err = transport.RoundTrip(context.Background(), NewEvalRequest("g.V()", WithEvalTimeout(5*time.Second)))
+	require.NoError(t, err)
+	require.Len(t, reqs, 3)
+	assert.Equal(t, int64(5000), reqs[2].Arguments[ArgsEvalTimeout], "request timeout is not changed")
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	_, err = transport.RoundTrip(ctx, NewEvalRequest("g.V()"))
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.Len(t, reqs, 3, "expired requests are not sent")
+}
+
+func TestBuildWithEvaluationTimeout(t *testing.T) {
+	var req *Request
+	interceptor := func(RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(_ context.Context, r *Request) (*Response, error) {
+			req = r
+			return &Response{RequestID: r.RequestID}, nil
+		})
+	}
+	u, err := url.Parse("http://gremlin-server/gremlin")
+	require.NoError(t, err)
+	client, err := Config{Endpoint: Endpoint{u}}.Build(
+		WithEvaluationTimeout(time.Second),
+		WithInterceptor(interceptor),
+	)
+	require.NoError(t, err)
+	_, err = client.Transport.RoundTrip(context.Background(), NewEvalRequest("g.V()"))
+	require.NoError(t, err)
+	require.NotNil(t, req)
+	assert.Equal(t, int64(1000), req.Arguments[ArgsEvalTimeout])
+}
@@ -0,0 +1,85 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package dialect
+
+import (
+	"context"
+	"time"
+)
+
+// Hooks holds the transaction lifecycle hooks of a HookDriver. All hooks are optional, and
+// they get the context of the transaction (the one that was passed to the Tx method).
+type Hooks struct {
+	// OnTxBegin is called after a transaction was started, with the error of
+	// its start. It's called also if the transaction failed to start.
+	OnTxBegin func(ctx context.Context, err error)
+	// OnTxCommit is called after a transaction was committed, with the
+	// time that passed since it was started, and the error of the commit.
+	OnTxCommit func(ctx context.Context, d time.Duration, err error)
+	// OnTxRollback is called after a transaction was rolled back, with the
+	// time that passed since it was started, and the error of the rollback.
+	OnTxRollback func(ctx context.Context, d time.Duration, err error)
+}
+
+// HookDriver is a driver that calls lifecycle hooks on the transactions of its underlying driver.
+type HookDriver struct {
+	Driver       // underlying driver.
+	hooks  Hooks // lifecycle hooks.
+}
+
+// WithHooks gets a driver and its lifecycle hooks, and returns a new driver that calls them on its
+// transactions. It's used for collecting transaction metrics in one place, for all clients that
+// share the driver. For example:
+//
+//	drv = dialect.WithHooks(drv, dialect.Hooks{
+//		OnTxCommit: func(ctx context.Context, d time.Duration, err error) {
+//			txDuration.WithLabelValues("commit").Observe(d.Seconds())
+//		},
+//	})
+//	client := ent.NewClient(ent.Driver(drv))
+//
+// See sql.OnConnect for the hooks of new connections.
+func WithHooks(d Driver, hooks Hooks) Driver {
+	return &HookDriver{d, hooks}
+}
+
+// Tx calls the underlying driver Tx command, and returns a transaction that calls the hooks.
+func (d *HookDriver) Tx(ctx context.Context) (Tx, error) {
+	start := time.Now()
+	tx, err := d.Driver.Tx(ctx)
+	if d.hooks.OnTxBegin != nil {
+		d.hooks.OnTxBegin(ctx, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &HookTx{Tx: tx, ctx: ctx, start: start, hooks: d.hooks}, nil
+}
+
+// HookTx is a transaction implementation that calls the lifecycle hooks of its driver.
+type HookTx struct {
+	Tx                    // underlying transaction.
+	ctx   context.Context // context of the transaction.
+	start time.Time       // start time of the transaction.
+	hooks Hooks           // lifecycle hooks.
+}
+
+// Commit calls the underlying transaction Commit method, and then the OnTxCommit hook.
+func (t *HookTx) Commit() error {
+	err := t.Tx.Commit()
+	if t.hooks.OnTxCommit != nil {
+		t.hooks.OnTxCommit(t.ctx, time.Since(t.start), err)
+	}
+	return err
+}
+
+// Rollback calls the underlying transaction Rollback method, and then the OnTxRollback hook.
+func (t *HookTx) Rollback() error {
+	err := t.Tx.Rollback()
+	if t.hooks.OnTxRollback != nil {
+		t.hooks.OnTxRollback(t.ctx, time.Since(t.start), err)
+	}
+	return err
+}
@@ -0,0 +1,67 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package dialect
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type nopDriver struct {
+	Driver
+	err error
+}
+
+func (d nopDriver) Tx(context.Context) (Tx, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	return rollbackTx{NopTx(d)}, nil
+}
+
+type rollbackTx struct{ Tx }
+
+func (rollbackTx) Rollback() error { return errors.New("rollback error") }
+
+func TestWithHooks(t *testing.T) {
+	type key struct{}
+	var calls []string
+	hooks := Hooks{
+		OnTxBegin: func(ctx context.Context, err error) {
+			require.Equal(t, "tx", ctx.Value(key{}))
+			calls = append(calls, "begin")
+		},
+		OnTxCommit: func(ctx context.Context, d time.Duration, err error) {
+			require.Equal(t, "tx", ctx.Value(key{}))
+			require.NoError(t, err)
+			calls = append(calls, "commit")
+		},
+		OnTxRollback: func(_ context.Context, _ time.Duration, err error) {
+			require.EqualError(t, err, "rollback error")
+			calls = append(calls, "rollback")
+		},
+	}
+	ctx := context.WithValue(context.Background(), key{}, "tx")
+	drv := WithHooks(nopDriver{}, hooks)
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	tx, err = drv.Tx(ctx)
+	require.NoError(t, err)
+	require.Error(t, tx.Rollback())
+	require.Equal(t, []string{"begin", "commit", "begin", "rollback"}, calls)
+
+	var begin error
+	drv = WithHooks(nopDriver{err: errors.New("begin error")}, Hooks{
+		OnTxBegin: func(_ context.Context, err error) { begin = err },
+	})
+	_, err = drv.Tx(ctx)
+	require.EqualError(t, err, "begin error")
+	require.Equal(t, err, begin)
+}
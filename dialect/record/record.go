@@ -0,0 +1,189 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package record provides a driver wrapper that records the statements (SQL or Gremlin) that are
+// executed by the generated builders, and test helpers for asserting them against golden files.
+// It's used for protecting applications against regressions in the generated queries.
+//
+//	drv := record.New(drv)
+//	client := ent.NewClient(ent.Driver(drv))
+//	client.User.Query().Where(user.Name("a8m")).AllX(ctx)
+//	drv.AssertGolden(t, "testdata/query_users.golden")
+//
+package record
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// Update indicates if the golden files should be written with the recorded
+// statements, instead of being asserted. It's commonly bound to a test flag:
+//
+//	func init() {
+//		flag.BoolVar(&record.Update, "update", false, "update golden files")
+//	}
+//
+var Update = false
+
+// Record is a driver operation that was recorded.
+type Record struct {
+	Op    string      // operation name (exec, query, begin, commit or rollback).
+	Query string      // statement of the operation. Empty for transaction steps.
+	Args  interface{} // arguments of the statement.
+	Tx    int         // sequence number of the transaction, or 0 if executed outside of a transaction.
+}
+
+// String implements the fmt.Stringer interface.
+func (r Record) String() string {
+	var b strings.Builder
+	if r.Tx > 0 {
+		fmt.Fprintf(&b, "tx(%d).", r.Tx)
+	}
+	b.WriteString(r.Op)
+	if r.Query != "" {
+		fmt.Fprintf(&b, ": %s", r.Query)
+	}
+	if r.Args != nil {
+		fmt.Fprintf(&b, "\n\targs: %v", r.Args)
+	}
+	return b.String()
+}
+
+// Driver is a driver that records the operations of the underlying driver.
+type Driver struct {
+	dialect.Driver
+	mu      sync.Mutex
+	txs     int
+	records []Record
+}
+
+// New returns a new recording driver that wraps the given driver.
+func New(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv}
+}
+
+// Records returns a copy of the recorded operations.
+func (d *Driver) Records() []Record {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]Record(nil), d.records...)
+}
+
+// Reset removes all recorded operations.
+func (d *Driver) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.txs = 0
+	d.records = nil
+}
+
+// String returns the recorded operations in the golden file format, one per line.
+func (d *Driver) String() string {
+	var b strings.Builder
+	for _, r := range d.Records() {
+		b.WriteString(r.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// AssertGolden asserts that the recorded operations match the content of the golden file
+// in the given path, and resets the driver. If Update is true, the golden file is written
+// with the recorded operations instead.
+func (d *Driver) AssertGolden(t testing.TB, path string) {
+	t.Helper()
+	defer d.Reset()
+	got := d.String()
+	if Update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("record: create golden file directory: %v", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatalf("record: write golden file: %v", err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("record: read golden file (run with record.Update to create it): %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("record: recorded operations do not match golden file %q\n--- got:\n%s--- want:\n%s", path, got, want)
+	}
+}
+
+// record records the given operation.
+func (d *Driver) record(r Record) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.records = append(d.records, r)
+}
+
+// Exec records its params and calls the underlying driver Exec method.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	d.record(Record{Op: "exec", Query: query, Args: args})
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// Query records its params and calls the underlying driver Query method.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	d.record(Record{Op: "query", Query: query, Args: args})
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// Tx records the transaction start and calls the underlying driver Tx method.
+// Transactions are numbered by their start order, in order to keep the records
+// deterministic.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	d.txs++
+	id := d.txs
+	d.records = append(d.records, Record{Op: "begin", Tx: id})
+	d.mu.Unlock()
+	return &Tx{Tx: tx, id: id, drv: d}, nil
+}
+
+// Tx is a transaction that records the operations of the underlying transaction.
+type Tx struct {
+	dialect.Tx
+	id  int
+	drv *Driver
+}
+
+// Exec records its params and calls the underlying transaction Exec method.
+func (t *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	t.drv.record(Record{Op: "exec", Query: query, Args: args, Tx: t.id})
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+// Query records its params and calls the underlying transaction Query method.
+func (t *Tx) Query(ctx context.Context, query string, args, v interface{}) error {
+	t.drv.record(Record{Op: "query", Query: query, Args: args, Tx: t.id})
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+// Commit records this step and calls the underlying transaction Commit method.
+func (t *Tx) Commit() error {
+	t.drv.record(Record{Op: "commit", Tx: t.id})
+	return t.Tx.Commit()
+}
+
+// Rollback records this step and calls the underlying transaction Rollback method.
+func (t *Tx) Rollback() error {
+	t.drv.record(Record{Op: "rollback", Tx: t.id})
+	return t.Tx.Rollback()
+}
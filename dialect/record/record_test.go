@@ -0,0 +1,84 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package record
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := New(sql.OpenDB(dialect.SQLite, db))
+	exec(t, mock, drv)
+	require.Len(t, drv.Records(), 4)
+	require.Equal(t, Record{Op: "exec", Query: "INSERT INTO `users` (`name`) VALUES (?)", Args: []interface{}{"a8m"}, Tx: 1}, drv.Records()[1])
+	drv.AssertGolden(t, "testdata/driver.golden")
+	require.Empty(t, drv.Records(), "driver should be reset after assertion")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDriver_Update(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := New(sql.OpenDB(dialect.SQLite, db))
+	dir, err := ioutil.TempDir("", "record")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "testdata", "driver.golden")
+
+	Update = true
+	exec(t, mock, drv)
+	drv.AssertGolden(t, path)
+	Update = false
+	golden, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	expected, err := ioutil.ReadFile("testdata/driver.golden")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(golden))
+
+	t.Log("golden file mismatch")
+	mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	require.NoError(t, drv.Query(context.Background(), "SELECT `id` FROM `users`", []interface{}{}, &sql.Rows{}))
+	rt := &recordT{TB: t}
+	drv.AssertGolden(rt, path)
+	require.Len(t, rt.errors, 1)
+}
+
+func exec(t *testing.T, mock sqlmock.Sqlmock, drv *Driver) {
+	ctx := context.Background()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `users`").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT `id`, `name` FROM `users`").WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m"))
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, "INSERT INTO `users` (`name`) VALUES (?)", []interface{}{"a8m"}, new(sql.Result)))
+	require.NoError(t, tx.Commit())
+	rows := &sql.Rows{}
+	require.NoError(t, drv.Query(ctx, "SELECT `id`, `name` FROM `users` WHERE `name` = ?", []interface{}{"a8m"}, rows))
+	require.NoError(t, rows.Close())
+}
+
+// recordT records the errors of the assertion.
+type recordT struct {
+	testing.TB
+	errors []string
+}
+
+func (t *recordT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
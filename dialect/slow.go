@@ -0,0 +1,99 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package dialect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// SlowDriver is a driver that logs the operations that exceeded a duration threshold.
+type SlowDriver struct {
+	Driver                         // underlying driver.
+	threshold time.Duration        // minimum duration of logged operations.
+	log       func(...interface{}) // log function. defaults to log.Println.
+}
+
+// Slow gets a driver, a duration threshold and an optional logging function, and returns a new
+// driver that logs the operations that took longer than the threshold, with their arguments and
+// their origin (the first caller outside of the dialect packages, e.g. the generated builder).
+// Note that the duration of a Query is measured until its rows are returned.
+func Slow(d Driver, threshold time.Duration, logger ...func(...interface{})) Driver {
+	drv := &SlowDriver{d, threshold, log.Println}
+	if len(logger) == 1 {
+		drv.log = logger[0]
+	}
+	return drv
+}
+
+// Exec calls the underlying driver Exec method, and logs it if it exceeded the threshold.
+func (d *SlowDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	defer slowLog(d.log, d.threshold, "driver.Exec", query, args)()
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// Query calls the underlying driver Query method, and logs it if it exceeded the threshold.
+func (d *SlowDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	defer slowLog(d.log, d.threshold, "driver.Query", query, args)()
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// Tx calls the underlying driver Tx command, and returns a transaction that logs its slow operations.
+func (d *SlowDriver) Tx(ctx context.Context) (Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &SlowTx{tx, d.threshold, d.log}, nil
+}
+
+// SlowTx is a transaction implementation that logs the operations that exceeded a duration threshold.
+type SlowTx struct {
+	Tx                             // underlying transaction.
+	threshold time.Duration        // minimum duration of logged operations.
+	log       func(...interface{}) // log function.
+}
+
+// Exec calls the underlying transaction Exec method, and logs it if it exceeded the threshold.
+func (d *SlowTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	defer slowLog(d.log, d.threshold, "Tx.Exec", query, args)()
+	return d.Tx.Exec(ctx, query, args, v)
+}
+
+// Query calls the underlying transaction Query method, and logs it if it exceeded the threshold.
+func (d *SlowTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	defer slowLog(d.log, d.threshold, "Tx.Query", query, args)()
+	return d.Tx.Query(ctx, query, args, v)
+}
+
+// slowLog starts measuring an operation, and returns the function that logs it when it's done.
+func slowLog(logf func(...interface{}), threshold time.Duration, op, query string, args interface{}) func() {
+	start := time.Now()
+	return func() {
+		if elapsed := time.Since(start); elapsed >= threshold {
+			logf(fmt.Sprintf("%s: slow query: duration=%v origin=%s query=%v args=%v", op, elapsed, origin(), query, args))
+		}
+	}
+}
+
+// origin returns the name of the first function in the call stack that is not part of the drivers.
+func origin() string {
+	pc := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(3, pc)])
+	for {
+		frame, more := frames.Next()
+		// skip the wrappers of the drivers (e.g. DebugDriver), and the transaction driver of the generated code.
+		if !strings.HasPrefix(frame.Function, "github.com/facebookincubator/ent/dialect") && !strings.Contains(frame.Function, ".(*txDriver).") {
+			return frame.Function[strings.LastIndexByte(frame.Function, '/')+1:]
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
@@ -6,6 +6,7 @@ package sql
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -48,7 +49,7 @@ func (b *Builder) Append(s string) *Builder {
 	switch {
 	case len(s) == 0:
 	case s != "*" && s[0] != '`' && !isFunc(s) && !isModifier(s):
-		fmt.Fprintf(b, "`%s`", s)
+		b.WriteString(quote(s))
 	default:
 		b.WriteString(s)
 	}
@@ -137,8 +138,8 @@ func (b *Builder) Nested(f func(*Builder)) *Builder {
 
 // clone returns a shallow clone of a builder.
 func (b Builder) clone() Builder {
-	c := Builder{args: append([]interface{}{}, b.args...)}
-	c.Buffer.Write(c.Bytes())
+	c := Builder{args: append([]interface{}{}, b.args...), dialect: b.dialect}
+	c.Buffer.Write(b.Bytes())
 	return c
 }
 
@@ -359,6 +360,13 @@ func (t *TableAlter) ModifyColumn(c *ColumnBuilder) *TableAlter {
 	return t
 }
 
+// ChangeColumn appends the `CHANGE COLUMN` clause to the given `ALTER TABLE` statement,
+// for renaming the given column and changing its definition.
+func (t *TableAlter) ChangeColumn(name string, c *ColumnBuilder) *TableAlter {
+	t.Queriers = append(t.Queriers, &Wrapper{"CHANGE COLUMN " + quote(name) + " %s", c})
+	return t
+}
+
 // DropColumn appends the `DROP COLUMN` clause to the given `ALTER TABLE` statement.
 func (t *TableAlter) DropColumn(c *ColumnBuilder) *TableAlter {
 	t.Queriers = append(t.Queriers, &Wrapper{"DROP COLUMN %s", c})
@@ -488,11 +496,13 @@ func (r *ReferenceBuilder) Query() (string, []interface{}) {
 
 // IndexBuilder is a builder for `CREATE INDEX` statement.
 type IndexBuilder struct {
-	b       Builder
-	name    string
-	unique  bool
-	table   string
-	columns []string
+	b        Builder
+	name     string
+	unique   bool
+	typ      string
+	table    string
+	columns  []string
+	prefixes map[string]int
 }
 
 // CreateIndex creates a builder for the `CREATE INDEX` statement.
@@ -519,6 +529,35 @@ func (i *IndexBuilder) Unique() *IndexBuilder {
 	return i
 }
 
+// Type sets the type of the index. The FULLTEXT and SPATIAL types are added as index
+// modifiers (e.g. `CREATE FULLTEXT INDEX`), and others (e.g. BTREE or HASH) are added
+// in the USING clause of the statement.
+//
+//	CreateIndex("index_name").
+//		Type("FULLTEXT").
+//		Table("users").
+//		Column("bio")
+//
+func (i *IndexBuilder) Type(typ string) *IndexBuilder {
+	i.typ = strings.ToUpper(typ)
+	return i
+}
+
+// Prefix sets the prefix length of the given column in the index (key part).
+//
+//	CreateIndex("index_name").
+//		Table("users").
+//		Column("description").
+//		Prefix("description", 100)
+//
+func (i *IndexBuilder) Prefix(column string, length int) *IndexBuilder {
+	if i.prefixes == nil {
+		i.prefixes = make(map[string]int)
+	}
+	i.prefixes[column] = length
+	return i
+}
+
 // Table defines the table for the index.
 func (i *IndexBuilder) Table(table string) *IndexBuilder {
 	i.table = table
@@ -543,12 +582,27 @@ func (i *IndexBuilder) Query() (string, []interface{}) {
 	if i.unique {
 		i.b.WriteString("UNIQUE ")
 	}
+	modifier := i.typ == "FULLTEXT" || i.typ == "SPATIAL"
+	if modifier {
+		i.b.WriteString(i.typ + " ")
+	}
 	i.b.WriteString("INDEX ")
 	i.b.Append(i.name)
 	i.b.WriteString(" ON ")
 	i.b.Append(i.table).Nested(func(b *Builder) {
-		b.AppendComma(i.columns...)
+		for j, c := range i.columns {
+			if j > 0 {
+				b.Comma()
+			}
+			b.Append(c)
+			if n, ok := i.prefixes[c]; ok {
+				b.WriteString(fmt.Sprintf("(%d)", n))
+			}
+		}
 	})
+	if i.typ != "" && !modifier {
+		i.b.WriteString(" USING " + i.typ)
+	}
 	return i.b.String(), nil
 }
 
@@ -598,6 +652,14 @@ type InsertBuilder struct {
 	columns  []string
 	defaults string
 	values   [][]interface{}
+	conflict *conflict
+}
+
+// conflict holds the conflict clause (upsert) of an insert statement.
+type conflict struct {
+	dialect string
+	target  []string
+	update  []string
 }
 
 // Insert creates a builder for the `INSERT INTO` statement.
@@ -644,6 +706,28 @@ func (i *InsertBuilder) Default(d string) *InsertBuilder {
 	return i
 }
 
+// OnConflict sets the conflict target (the unique columns) of the insert statement, and the
+// columns that are updated with the inserted values in case of a conflict (upsert). The clause
+// is built based on the dialect type:
+//
+//	MySQL:
+//
+//		Insert("users").
+//			Columns("phone", "name").
+//			Values("972", "a8m").
+//			OnConflict(dialect.MySQL, []string{"phone"}, "name")
+//		// INSERT INTO `users` (`phone`, `name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`)
+//
+//	SQLite:
+//
+//		// INSERT INTO `users` (`phone`, `name`) VALUES (?, ?) ON CONFLICT (`phone`) DO UPDATE SET `name` = `excluded`.`name`
+//
+// Note that MySQL does not use the conflict target, and applies the update on any unique key conflict.
+func (i *InsertBuilder) OnConflict(d string, target []string, update ...string) *InsertBuilder {
+	i.conflict = &conflict{dialect: d, target: target, update: update}
+	return i
+}
+
 // Query returns query representation of an `INSERT INTO` statement.
 func (i *InsertBuilder) Query() (string, []interface{}) {
 	i.b.WriteString("INSERT INTO ")
@@ -662,9 +746,52 @@ func (i *InsertBuilder) Query() (string, []interface{}) {
 			b.Args(v...)
 		})
 	}
+	if c := i.conflict; c != nil {
+		c.build(&i.b)
+	}
 	return i.b.String(), i.b.args
 }
 
+// build writes the conflict clause to the given builder.
+func (c *conflict) build(b *Builder) {
+	if c.dialect == dialect.MySQL {
+		b.WriteString(" ON DUPLICATE KEY UPDATE ")
+		if len(c.update) == 0 && len(c.target) > 0 {
+			// a nop update, in order to ignore the conflict.
+			b.Append(c.target[0])
+			b.WriteString(" = ")
+			b.Append(c.target[0])
+		}
+		for j, column := range c.update {
+			if j > 0 {
+				b.Comma()
+			}
+			b.Append(column)
+			b.WriteString(" = VALUES(")
+			b.Append(column)
+			b.WriteString(")")
+		}
+		return
+	}
+	b.WriteString(" ON CONFLICT ")
+	b.Nested(func(b *Builder) {
+		b.AppendComma(c.target...)
+	})
+	if len(c.update) == 0 {
+		b.WriteString(" DO NOTHING")
+		return
+	}
+	b.WriteString(" DO UPDATE SET ")
+	for j, column := range c.update {
+		if j > 0 {
+			b.Comma()
+		}
+		b.Append(column)
+		b.WriteString(" = `excluded`.")
+		b.Append(column)
+	}
+}
+
 // UpdateBuilder is a builder for `UPDATE` statement.
 type UpdateBuilder struct {
 	b       Builder
@@ -904,6 +1031,21 @@ func (p *Predicate) EQ(col string, arg interface{}) *Predicate {
 	return p
 }
 
+// ColumnsEQ returns a "=" predicate between 2 columns.
+//
+//	ColumnsEQ(t1.C("id"), t2.C("user_id"))
+//
+func ColumnsEQ(col1, col2 string) *Predicate {
+	return (&Predicate{}).ColumnsEQ(col1, col2)
+}
+
+// ColumnsEQ appends a "=" predicate between 2 columns.
+func (p *Predicate) ColumnsEQ(col1, col2 string) *Predicate {
+	p.b.Append(col1).WriteString(" = ")
+	p.b.Append(col2)
+	return p
+}
+
 // NEQ returns a "<>" predicate.
 func NEQ(col string, value interface{}) *Predicate {
 	return (&Predicate{}).NEQ(col, value)
@@ -964,6 +1106,20 @@ func (p *Predicate) GTE(col string, arg interface{}) *Predicate {
 	return p
 }
 
+// Between returns the `BETWEEN` predicate. The range is inclusive.
+func Between(col string, lower, upper interface{}) *Predicate {
+	return (&Predicate{}).Between(col, lower, upper)
+}
+
+// Between appends the `BETWEEN` predicate.
+func (p *Predicate) Between(col string, lower, upper interface{}) *Predicate {
+	p.b.Append(col).WriteString(" BETWEEN ")
+	p.b.Arg(lower)
+	p.b.WriteString(" AND ")
+	p.b.Arg(upper)
+	return p
+}
+
 // NotNull returns the `IS NOT NULL` predicate.
 func NotNull(col string) *Predicate {
 	return (&Predicate{}).NotNull(col)
@@ -986,7 +1142,11 @@ func (p *Predicate) IsNull(col string) *Predicate {
 	return p
 }
 
-// In returns the `IN` predicate.
+// In returns the `IN` predicate. The arguments can also be a single
+// selector, that is used as a subquery of the predicate:
+//
+//	In("owner_id", Select("id").From(Table("users")).Where(EQ("name", "a8m")))
+//
 func In(col string, args ...interface{}) *Predicate {
 	return (&Predicate{}).In(col, args...)
 }
@@ -1007,6 +1167,38 @@ func (p *Predicate) In(col string, args ...interface{}) *Predicate {
 	return p
 }
 
+// InTuple returns the row-value `IN` predicate of the given columns. Each tuple
+// holds the values of the columns, in their order. Note that, it's not supported
+// by SQLite, that requires a subquery in the right-hand side of the operator.
+//
+//	InTuple([]string{"name", "phone"}, []interface{}{"a8m", "102"}, []interface{}{"nati", "103"})
+//
+func InTuple(columns []string, tuples ...[]interface{}) *Predicate {
+	return (&Predicate{}).InTuple(columns, tuples...)
+}
+
+// InTuple appends the row-value `IN` predicate.
+func (p *Predicate) InTuple(columns []string, tuples ...[]interface{}) *Predicate {
+	if len(tuples) == 0 {
+		return p
+	}
+	p.b.Nested(func(b *Builder) {
+		b.AppendComma(columns...)
+	})
+	p.b.WriteString(" IN ")
+	p.b.Nested(func(b *Builder) {
+		for i := range tuples {
+			if i > 0 {
+				b.Comma()
+			}
+			b.Nested(func(b *Builder) {
+				b.Args(tuples[i]...)
+			})
+		}
+	})
+	return p
+}
+
 // InInts returns the `IN` predicate for ints.
 func InInts(col string, args ...int) *Predicate {
 	return (&Predicate{}).InInts(col, args...)
@@ -1021,7 +1213,7 @@ func (p *Predicate) InInts(col string, args ...int) *Predicate {
 	return p.In(col, iface...)
 }
 
-// NotIn returns the `Not IN` predicate.
+// NotIn returns the `Not IN` predicate. Like In, the arguments can also be a single selector.
 func NotIn(col string, args ...interface{}) *Predicate {
 	return (&Predicate{}).NotIn(col, args...)
 }
@@ -1030,6 +1222,12 @@ func NotIn(col string, args ...interface{}) *Predicate {
 func (p *Predicate) NotIn(col string, args ...interface{}) *Predicate {
 	p.b.Append(col).WriteString(" NOT IN ")
 	p.b.Nested(func(b *Builder) {
+		if len(args) == 1 {
+			if s, ok := args[0].(*Selector); ok {
+				b.Join(s)
+				return
+			}
+		}
 		b.Args(args...)
 	})
 	return p
@@ -1184,6 +1382,148 @@ func Distinct(columns ...string) string {
 	return b.String()
 }
 
+// WindowBuilder is a builder for window function calls (`fn OVER (...)`).
+// Note that window functions are supported by MySQL 8 and SQLite 3.25 or above.
+type WindowBuilder struct {
+	fn        string
+	partition []string
+	order     []string
+	frame     string
+}
+
+// Window returns a new window builder for the given function call. For example, getting
+// the top 3 pets of each owner using a window function in a nested query:
+//
+//	t := Select("*", As(RowNumber().PartitionBy("owner_id").OrderBy(Desc("age")).String(), "rn")).
+//		From(Table("pets")).
+//		As("t")
+//	Select("*").From(t).Where(LTE(t.C("rn"), 3))
+//
+func Window(fn string) *WindowBuilder {
+	return &WindowBuilder{fn: fn}
+}
+
+// RowNumber returns a window builder for the ROW_NUMBER function.
+func RowNumber() *WindowBuilder {
+	return Window("ROW_NUMBER()")
+}
+
+// Rank returns a window builder for the RANK function.
+func Rank() *WindowBuilder {
+	return Window("RANK()")
+}
+
+// DenseRank returns a window builder for the DENSE_RANK function.
+func DenseRank() *WindowBuilder {
+	return Window("DENSE_RANK()")
+}
+
+// PartitionBy appends the `PARTITION BY` clause to the window.
+func (w *WindowBuilder) PartitionBy(columns ...string) *WindowBuilder {
+	w.partition = append(w.partition, columns...)
+	return w
+}
+
+// OrderBy appends the `ORDER BY` clause to the window.
+func (w *WindowBuilder) OrderBy(columns ...string) *WindowBuilder {
+	w.order = append(w.order, columns...)
+	return w
+}
+
+// Frame bounds of window frames.
+const (
+	UnboundedPreceding = "UNBOUNDED PRECEDING"
+	UnboundedFollowing = "UNBOUNDED FOLLOWING"
+	CurrentRow         = "CURRENT ROW"
+)
+
+// Preceding returns the frame bound of the n rows (or values) that precede the current row.
+func Preceding(n int) string {
+	return fmt.Sprintf("%d PRECEDING", n)
+}
+
+// Following returns the frame bound of the n rows (or values) that follow the current row.
+func Following(n int) string {
+	return fmt.Sprintf("%d FOLLOWING", n)
+}
+
+// Rows sets the frame of the window to `ROWS BETWEEN start AND end`.
+//
+//	Window(Sum("amount")).OrderBy("created_at").Rows(Preceding(6), CurrentRow)
+//
+func (w *WindowBuilder) Rows(start, end string) *WindowBuilder {
+	w.frame = fmt.Sprintf("ROWS BETWEEN %s AND %s", start, end)
+	return w
+}
+
+// Range sets the frame of the window to `RANGE BETWEEN start AND end`.
+func (w *WindowBuilder) Range(start, end string) *WindowBuilder {
+	w.frame = fmt.Sprintf("RANGE BETWEEN %s AND %s", start, end)
+	return w
+}
+
+// String returns the window function call, that can be used as a column of a selector.
+func (w *WindowBuilder) String() string {
+	var b Builder
+	b.WriteString(w.fn)
+	b.WriteString(" OVER ")
+	b.Nested(func(b *Builder) {
+		if len(w.partition) > 0 {
+			b.WriteString("PARTITION BY ")
+			b.AppendComma(w.partition...)
+		}
+		if len(w.order) > 0 {
+			if len(w.partition) > 0 {
+				b.Pad()
+			}
+			b.WriteString("ORDER BY ")
+			b.AppendComma(w.order...)
+		}
+		if w.frame != "" {
+			if len(w.partition)+len(w.order) > 0 {
+				b.Pad()
+			}
+			b.WriteString(w.frame)
+		}
+	})
+	return b.String()
+}
+
+// PartitionLimit returns a selector that applies the LIMIT and the OFFSET of the given selector on
+// each partition of its rows (i.e. the rows that have the same values in the partition columns), and
+// not on all of them. The rows of each partition are numbered by the ROW_NUMBER window function, that
+// is ordered by the ORDER BY clause of the selector, and the returned selector selects the named columns
+// of the rows that are in the range, ordered by their numbers. For example, the 2 oldest pets of each owner:
+//
+//	t := Table("pets")
+//	s := Select(As(t.C("owner_id"), "owner_id"), As(t.C("id"), "id")).
+//		From(t).
+//		OrderBy(Desc(t.C("age"))).
+//		Limit(2)
+//	PartitionLimit(s, []string{t.C("owner_id")}, "owner_id", "id")
+//
+func PartitionLimit(s *Selector, partition []string, columns ...string) *Selector {
+	inner := s.Clone()
+	w := RowNumber().PartitionBy(partition...).OrderBy(inner.order...)
+	inner.columns = append(inner.columns, As(w.String(), "row_number"))
+	inner.order, inner.limit, inner.offset = nil, nil, nil
+	inner.As("partition")
+	rn := inner.C("row_number")
+	outer := Select(inner.Columns(columns...)...).
+		From(inner).
+		OrderBy(rn).
+		SetDialect(s.dialect)
+	offset := 0
+	if s.offset != nil {
+		offset = *s.offset
+		outer.Where(GT(rn, offset))
+	}
+	if s.limit != nil {
+		outer.Where(LTE(rn, offset+*s.limit))
+	}
+	return outer
+}
+
 // SelectTable is a table selector.
 type SelectTable struct {
 	quote bool
@@ -1212,7 +1552,7 @@ func (s *SelectTable) C(column string) string {
 	if s.as != "" {
 		name = s.as
 	}
-	return fmt.Sprintf("`%s`.`%s`", name, column)
+	return fmt.Sprintf("%s.`%s`", quote(name), column)
 }
 
 // Columns returns a list of formatted strings for the table columns.
@@ -1238,9 +1578,9 @@ func (s *SelectTable) ref() string {
 	case !s.quote:
 		return s.name
 	case s.as == "":
-		return fmt.Sprintf("`%s`", s.name)
+		return quote(s.name)
 	default:
-		return fmt.Sprintf("`%s` AS `%s`", s.name, s.as)
+		return fmt.Sprintf("%s AS `%s`", quote(s.name), s.as)
 	}
 }
 
@@ -1249,7 +1589,7 @@ func (*SelectTable) view() {}
 
 // join table option.
 type join struct {
-	on    string
+	on    *Predicate
 	kind  string
 	table TableView
 }
@@ -1269,6 +1609,19 @@ type Selector struct {
 	limit    *int
 	offset   *int
 	distinct bool
+	lock     bool
+	hints    []Hint
+	dialect  string
+	with     *WithBuilder
+	unions   []union
+	ctx      context.Context
+	errs     []error
+}
+
+// union is a compound `UNION` operation of the selector.
+type union struct {
+	all bool
+	s   *Selector
 }
 
 // Select returns a new selector for the `SELECT` statement.
@@ -1297,6 +1650,71 @@ func (s *Selector) From(t TableView) *Selector {
 	return s
 }
 
+// SetDialect sets the dialect of the selector. It's used by predicates that
+// are rendered differently by the dialects (e.g. row-value comparisons).
+func (s *Selector) SetDialect(dialect string) *Selector {
+	s.dialect = dialect
+	return s
+}
+
+// Dialect returns the dialect of the selector, or an empty string if it was not set.
+func (s *Selector) Dialect() string {
+	return s.dialect
+}
+
+// WithContext sets the context of the selector. It's used by predicates that
+// execute code on building (e.g. the interceptors of subqueries).
+func (s *Selector) WithContext(ctx context.Context) *Selector {
+	s.ctx = ctx
+	return s
+}
+
+// Context returns the context of the selector, or the background context if it was not set.
+func (s *Selector) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+// AddError appends an error to the selector. It's used by predicates that fail on
+// building, and the error is returned by the Err method of the selector.
+func (s *Selector) AddError(err error) *Selector {
+	if err != nil {
+		s.errs = append(s.errs, err)
+	}
+	return s
+}
+
+// Err returns the errors that were added to the selector, and to the
+// selectors of its FROM and JOIN clauses, or nil if there are none.
+func (s *Selector) Err() error {
+	errs := append([]error{}, s.errs...)
+	views := []TableView{s.from}
+	for _, j := range s.joins {
+		views = append(views, j.table)
+	}
+	for _, v := range views {
+		if s2, ok := v.(*Selector); ok {
+			if err := s2.Err(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		msgs := make([]string, len(errs))
+		for i := range errs {
+			msgs[i] = errs[i].Error()
+		}
+		return fmt.Errorf("sql: %s", strings.Join(msgs, "; "))
+	}
+}
+
 // Distinct adds the DISTINCT keyword to the `SELECT` statement.
 func (s *Selector) Distinct() *Selector {
 	s.distinct = true
@@ -1358,8 +1776,36 @@ func (s *Selector) Table() *SelectTable {
 
 // Join appends a `JOIN` clause to the statement.
 func (s *Selector) Join(t TableView) *Selector {
+	return s.join("JOIN", t)
+}
+
+// LeftJoin appends a `LEFT JOIN` clause to the statement.
+//
+//	t1 := Table("users")
+//	t2 := Table("pets")
+//	Select(t1.C("name"), t2.C("name")).
+//		From(t1).
+//		LeftJoin(t2).
+//		On(t1.C("id"), t2.C("owner_id"))
+//
+func (s *Selector) LeftJoin(t TableView) *Selector {
+	return s.join("LEFT JOIN", t)
+}
+
+// RightJoin appends a `RIGHT JOIN` clause to the statement.
+func (s *Selector) RightJoin(t TableView) *Selector {
+	return s.join("RIGHT JOIN", t)
+}
+
+// InnerJoin appends an `INNER JOIN` clause to the statement.
+func (s *Selector) InnerJoin(t TableView) *Selector {
+	return s.join("INNER JOIN", t)
+}
+
+// join adds a join table to the selector with the given kind.
+func (s *Selector) join(kind string, t TableView) *Selector {
 	s.joins = append(s.joins, join{
-		kind:  "JOIN",
+		kind:  kind,
 		table: t,
 	})
 	switch view := t.(type) {
@@ -1394,8 +1840,25 @@ func (s *Selector) Columns(columns ...string) []string {
 
 // On sets the `ON` clause for the `JOIN` operation.
 func (s *Selector) On(c1, c2 string) *Selector {
+	return s.OnP(ColumnsEQ(c1, c2))
+}
+
+// OnP sets or appends the given predicate for the `ON` clause of the `JOIN` operation.
+//
+//	t1, t2 := Table("users"), Table("pets")
+//	Select().
+//		From(t1).
+//		LeftJoin(t2).
+//		OnP(And(ColumnsEQ(t1.C("id"), t2.C("owner_id")), EQ(t2.C("name"), "pedro")))
+//
+func (s *Selector) OnP(p *Predicate) *Selector {
 	if len(s.joins) > 0 {
-		s.joins[len(s.joins)-1].on = fmt.Sprintf("%s = %s", c1, c2)
+		join := &s.joins[len(s.joins)-1]
+		if join.on == nil {
+			join.on = p
+		} else {
+			join.on.merge(p)
+		}
 	}
 	return s
 }
@@ -1432,6 +1895,13 @@ func (s *Selector) Clone() *Selector {
 		limit:    s.limit,
 		offset:   s.offset,
 		distinct: s.distinct,
+		lock:     s.lock,
+		dialect:  s.dialect,
+		with:     s.with,
+		ctx:      s.ctx,
+		errs:     append([]error{}, s.errs...),
+		hints:    append([]Hint{}, s.hints...),
+		unions:   append([]union{}, s.unions...),
 		where:    s.where.clone(),
 		having:   s.having.clone(),
 		joins:    append([]join{}, s.joins...),
@@ -1455,6 +1925,41 @@ func Desc(column string) string {
 	return b.String()
 }
 
+// AscNullsFirst returns the ordering terms for sorting the given column in ASC
+// order, with NULL values first. It's emulated using the IS NULL expression, because
+// MySQL and SQLite do not support the NULLS FIRST/LAST modifiers.
+//
+//	Select().From(Table("users")).OrderBy(AscNullsFirst("name"))
+//
+func AscNullsFirst(column string) string {
+	return nulls(column, "DESC", "ASC")
+}
+
+// AscNullsLast returns the ordering terms for sorting the given column in ASC order, with NULL values last.
+func AscNullsLast(column string) string {
+	return nulls(column, "ASC", "ASC")
+}
+
+// DescNullsFirst returns the ordering terms for sorting the given column in DESC order, with NULL values first.
+func DescNullsFirst(column string) string {
+	return nulls(column, "DESC", "DESC")
+}
+
+// DescNullsLast returns the ordering terms for sorting the given column in DESC order, with NULL values last.
+func DescNullsLast(column string) string {
+	return nulls(column, "ASC", "DESC")
+}
+
+// nulls returns the ordering terms of a column with an explicit placement of NULL values.
+func nulls(column, nulls, order string) string {
+	var b Builder
+	b.Append(column)
+	b.WriteString(" IS NULL " + nulls)
+	b.Comma().Append(column)
+	b.WriteString(" " + order)
+	return b.String()
+}
+
 // OrderBy appends the `ORDER BY` clause to the `SELECT` statement.
 func (s *Selector) OrderBy(columns ...string) *Selector {
 	s.order = append(s.order, columns...)
@@ -1473,13 +1978,164 @@ func (s *Selector) Having(p *Predicate) *Selector {
 	return s
 }
 
+// ForUpdate appends the `FOR UPDATE` clause to the `SELECT` statement, in order to lock
+// the selected rows until the end of the transaction. Note that, it's not supported by SQLite.
+func (s *Selector) ForUpdate() *Selector {
+	s.lock = true
+	return s
+}
+
+// With prefixes the `SELECT` statement with the given `WITH` clause. Unlike the Queries type, that
+// joins the 2 statements, the clause is part of the selector, and it can be used in subqueries.
+//
+//	w := WithRecursive("ancestors", "id")
+//	w.As(
+//		Select("parent_id").From(Table("users")).Where(EQ("id", 1)).
+//			UnionAll(Select(Table("users").C("parent_id")).From(Table("users")).Join(w).On(Table("users").C("id"), w.C("id"))),
+//	)
+//	Select().From(Table("users")).Where(In("id", Select("id").From(w).With(w)))
+//
+func (s *Selector) With(w *WithBuilder) *Selector {
+	s.with = w
+	return s
+}
+
+// Union appends the given selector to the `SELECT` statement using the `UNION` operator.
+// Duplicate rows are removed from the result. Note that, the `ORDER BY` and `LIMIT` clauses
+// of the selector are applied on the compound statement.
+func (s *Selector) Union(t *Selector) *Selector {
+	s.unions = append(s.unions, union{s: t})
+	return s
+}
+
+// UnionAll appends the given selector to the `SELECT` statement using the `UNION ALL` operator.
+func (s *Selector) UnionAll(t *Selector) *Selector {
+	s.unions = append(s.unions, union{all: true, s: t})
+	return s
+}
+
+// Hint adds query hints to the `SELECT` statement. The hints are written in their position in
+// the statement, as expected by the dialect of the selector (MySQL, if the dialect was not set).
+// Hints that are not supported by the dialect are ignored, since they don't change the results
+// of the query.
+//
+//	Select().From(Table("users")).Where(EQ("name", "a8m")).Hint(UseIndex("user_name"), StraightJoin())
+//	// SELECT STRAIGHT_JOIN * FROM `users` USE INDEX (`user_name`) WHERE `name` = ?
+//
+func (s *Selector) Hint(hints ...Hint) *Selector {
+	s.hints = append(s.hints, hints...)
+	return s
+}
+
+// Hint is a query hint of the `SELECT` statement. See the Selector.Hint method.
+type Hint struct {
+	kind  hintKind
+	value []string
+}
+
+type hintKind int
+
+const (
+	hintUseIndex hintKind = iota + 1
+	hintForceIndex
+	hintIgnoreIndex
+	hintStraightJoin
+	hintOptimizer
+)
+
+// UseIndex returns a hint for using one of the given indexes for reading the table of the
+// selector. For MySQL, it's the USE INDEX hint, and for SQLite, it's the INDEXED BY clause
+// of the first index.
+func UseIndex(indexes ...string) Hint {
+	return Hint{kind: hintUseIndex, value: indexes}
+}
+
+// ForceIndex returns a hint for forcing the use of one of the given indexes for reading the
+// table of the selector (the FORCE INDEX hint of MySQL, and the INDEXED BY clause of SQLite).
+func ForceIndex(indexes ...string) Hint {
+	return Hint{kind: hintForceIndex, value: indexes}
+}
+
+// IgnoreIndex returns a hint for ignoring the given indexes when reading the table of the
+// selector (the IGNORE INDEX hint of MySQL). It's not supported by SQLite.
+func IgnoreIndex(indexes ...string) Hint {
+	return Hint{kind: hintIgnoreIndex, value: indexes}
+}
+
+// StraightJoin returns a hint for joining the tables of the selector in the order they're
+// listed in the statement (the SELECT STRAIGHT_JOIN modifier of MySQL). It's not supported
+// by SQLite.
+func StraightJoin() Hint {
+	return Hint{kind: hintStraightJoin}
+}
+
+// OptimizerHint returns a MySQL optimizer hint, that is written in a hint comment after the
+// SELECT keyword. For example, OptimizerHint("MAX_EXECUTION_TIME(1000)"). Hints that contain
+// the end of the comment ("*/") are ignored. It's not supported by SQLite.
+func OptimizerHint(hint string) Hint {
+	return Hint{kind: hintOptimizer, value: []string{hint}}
+}
+
+// selectHints writes the hints that are placed after the SELECT keyword, and the DISTINCT modifier.
+func (s *Selector) selectHints(b *Builder) {
+	var (
+		opt      []string
+		straight bool
+	)
+	for _, h := range s.hints {
+		switch {
+		case s.dialect == dialect.SQLite:
+		case h.kind == hintOptimizer && !strings.Contains(h.value[0], "*/"):
+			opt = append(opt, h.value[0])
+		case h.kind == hintStraightJoin:
+			straight = true
+		}
+	}
+	if len(opt) > 0 {
+		b.WriteString("/*+ " + strings.Join(opt, " ") + " */ ")
+	}
+	if s.distinct {
+		b.WriteString("DISTINCT ")
+	}
+	if straight {
+		b.WriteString("STRAIGHT_JOIN ")
+	}
+}
+
+// indexHints writes the hints that are placed after the table reference of the FROM clause.
+func (s *Selector) indexHints(b *Builder) {
+	for _, h := range s.hints {
+		if len(h.value) == 0 {
+			continue
+		}
+		indexes := make([]string, len(h.value))
+		for i, name := range h.value {
+			indexes[i] = "`" + strings.Replace(name, "`", "``", -1) + "`"
+		}
+		switch {
+		case s.dialect == dialect.SQLite && (h.kind == hintUseIndex || h.kind == hintForceIndex):
+			// SQLite supports only one index per table.
+			b.WriteString(" INDEXED BY " + indexes[0])
+			return
+		case s.dialect == dialect.SQLite:
+		case h.kind == hintUseIndex:
+			b.WriteString(" USE INDEX (" + strings.Join(indexes, ", ") + ")")
+		case h.kind == hintForceIndex:
+			b.WriteString(" FORCE INDEX (" + strings.Join(indexes, ", ") + ")")
+		case h.kind == hintIgnoreIndex:
+			b.WriteString(" IGNORE INDEX (" + strings.Join(indexes, ", ") + ")")
+		}
+	}
+}
+
 // Query returns query representation of a `SELECT` statement.
 func (s *Selector) Query() (string, []interface{}) {
 	var b Builder
-	b.WriteString("SELECT ")
-	if s.distinct {
-		b.WriteString("DISTINCT ")
+	if s.with != nil {
+		b.Join(s.with).Pad()
 	}
+	b.WriteString("SELECT ")
+	s.selectHints(&b)
 	if len(s.columns) > 0 {
 		b.AppendComma(s.columns...)
 	} else {
@@ -1489,10 +2145,13 @@ func (s *Selector) Query() (string, []interface{}) {
 	switch t := s.from.(type) {
 	case *SelectTable:
 		b.WriteString(t.ref())
+		s.indexHints(&b)
 	case *Selector:
 		query, args := t.Query()
 		b.WriteString(fmt.Sprintf("(%s) AS `%s`", query, t.as))
 		b.args = append(b.args, args...)
+	case *WithBuilder:
+		b.WriteString(quote(t.Name()))
 	}
 	for _, join := range s.joins {
 		b.WriteString(fmt.Sprintf(" %s ", join.kind))
@@ -1503,10 +2162,12 @@ func (s *Selector) Query() (string, []interface{}) {
 			query, args := view.Query()
 			b.WriteString(fmt.Sprintf("(%s) AS `%s`", query, view.as))
 			b.args = append(b.args, args...)
+		case *WithBuilder:
+			b.WriteString(quote(view.Name()))
 		}
-		if join.on != "" {
+		if join.on != nil {
 			b.WriteString(" ON ")
-			b.WriteString(join.on)
+			b.Join(join.on)
 		}
 	}
 	if s.where != nil {
@@ -1521,10 +2182,17 @@ func (s *Selector) Query() (string, []interface{}) {
 	}
 	if s.having != nil {
 		b.WriteString(" HAVING ")
-		query, args := s.where.Query()
+		query, args := s.having.Query()
 		b.WriteString(query)
 		b.args = append(b.args, args...)
 	}
+	for _, u := range s.unions {
+		b.WriteString(" UNION ")
+		if u.all {
+			b.WriteString("ALL ")
+		}
+		b.Join(u.s)
+	}
 	if len(s.order) > 0 {
 		b.WriteString(" ORDER BY ")
 		b.AppendComma(s.order...)
@@ -1537,6 +2205,9 @@ func (s *Selector) Query() (string, []interface{}) {
 		b.WriteString(" OFFSET ")
 		b.Arg(*s.offset)
 	}
+	if s.lock {
+		b.WriteString(" FOR UPDATE")
+	}
 	return b.String(), b.args
 }
 
@@ -1545,9 +2216,15 @@ func (*Selector) view() {}
 
 // WithBuilder is the builder for the `WITH` statement.
 type WithBuilder struct {
-	b    Builder
-	name string
-	s    *Selector
+	recursive bool
+	ctes      []cte
+}
+
+// cte is a common table expression of the `WITH` clause.
+type cte struct {
+	name    string
+	columns []string
+	s       *Selector
 }
 
 // With returns a new builder for the `WITH` statement.
@@ -1555,27 +2232,70 @@ type WithBuilder struct {
 //	n := Queries{With("users_view").As(Select().From(Table("users"))), Select().From(Table("users_view"))}
 //	return n.Query()
 //
-func With(name string) *WithBuilder {
-	return &WithBuilder{name: name}
+func With(name string, columns ...string) *WithBuilder {
+	return (&WithBuilder{}).With(name, columns...)
+}
+
+// WithRecursive returns a new builder for the `WITH RECURSIVE` statement. The sub query of a recursive
+// view is usually a compound statement (Union or UnionAll), that its second part references the view.
+//
+//	w := WithRecursive("ancestors", "id")
+//	w.As(
+//		Select("parent_id").From(Table("users")).Where(EQ("id", 1)).
+//			Union(Select(Table("users").C("parent_id")).From(Table("users")).Join(w).On(Table("users").C("id"), w.C("id"))),
+//	)
+//	return Queries{w, Select().From(w)}.Query()
+//
+func WithRecursive(name string, columns ...string) *WithBuilder {
+	w := With(name, columns...)
+	w.recursive = true
+	return w
+}
+
+// With adds another view to the `WITH` statement. The added view can reference the views
+// that were added before it.
+func (w *WithBuilder) With(name string, columns ...string) *WithBuilder {
+	w.ctes = append(w.ctes, cte{name: name, columns: columns})
+	return w
 }
 
 // Name returns the name of the view.
-func (w *WithBuilder) Name() string { return w.name }
+func (w *WithBuilder) Name() string { return w.ctes[len(w.ctes)-1].name }
+
+// C returns a formatted string for a column of the view.
+func (w *WithBuilder) C(column string) string {
+	return fmt.Sprintf("%s.`%s`", quote(w.Name()), column)
+}
 
 // As sets the view sub query.
 func (w *WithBuilder) As(s *Selector) *WithBuilder {
-	w.s = s
+	w.ctes[len(w.ctes)-1].s = s
 	return w
 }
 
 // Query returns query representation of a `WITH` clause.
 func (w *WithBuilder) Query() (string, []interface{}) {
-	w.b.WriteString("WITH " + w.name)
-	w.b.WriteString(" AS ")
-	w.b.Nested(func(b *Builder) {
-		b.Join(w.s)
-	})
-	return w.b.String(), w.b.args
+	var b Builder
+	b.WriteString("WITH ")
+	if w.recursive {
+		b.WriteString("RECURSIVE ")
+	}
+	for i, c := range w.ctes {
+		if i > 0 {
+			b.Comma()
+		}
+		b.WriteString(c.name)
+		if len(c.columns) > 0 {
+			b.Nested(func(b *Builder) {
+				b.AppendComma(c.columns...)
+			})
+		}
+		b.WriteString(" AS ")
+		b.Nested(func(b *Builder) {
+			b.Join(c.s)
+		})
+	}
+	return b.String(), b.args
 }
 
 // implement the table view interface.
@@ -1601,6 +2321,12 @@ type raw struct{ s string }
 
 func (r *raw) Query() (string, []interface{}) { return r.s, nil }
 
+// quote quotes the given identifier. Identifiers that are qualified with
+// a schema (database) name, are quoted separately. e.g. `billing`.`users`.
+func quote(ident string) string {
+	return "`" + strings.Replace(ident, ".", "`.`", -1) + "`"
+}
+
 func isFunc(s string) bool {
 	return strings.Contains(s, "(") && strings.Contains(s, ")")
 }
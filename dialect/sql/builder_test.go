@@ -5,9 +5,13 @@
 package sql
 
 import (
+	"context"
+	"errors"
 	"strconv"
 	"testing"
 
+	"github.com/facebookincubator/ent/dialect"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -116,6 +120,11 @@ func TestBuilder(t *testing.T) {
 				DropColumn(Column("name")),
 			wantQuery: "ALTER TABLE `users` MODIFY COLUMN `age` int, DROP COLUMN `name`",
 		},
+		{
+			input: AlterTable("users").
+				ChangeColumn("name", Column("display_name").Type("varchar(255)")),
+			wantQuery: "ALTER TABLE `users` CHANGE COLUMN `name` `display_name` varchar(255)",
+		},
 		{
 			input:     Insert("users").Columns("age").Values(1),
 			wantQuery: "INSERT INTO `users` (`age`) VALUES (?)",
@@ -131,6 +140,26 @@ func TestBuilder(t *testing.T) {
 			wantQuery: "INSERT INTO `users` (`name`, `age`) VALUES (?, ?), (?, ?)",
 			wantArgs:  []interface{}{"a8m", 10, "foo", 20},
 		},
+		{
+			input:     Insert("users").Columns("phone", "name").Values("972", "a8m").OnConflict(dialect.MySQL, []string{"phone"}, "name"),
+			wantQuery: "INSERT INTO `users` (`phone`, `name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`)",
+			wantArgs:  []interface{}{"972", "a8m"},
+		},
+		{
+			input:     Insert("users").Columns("phone").Values("972").OnConflict(dialect.MySQL, []string{"phone"}),
+			wantQuery: "INSERT INTO `users` (`phone`) VALUES (?) ON DUPLICATE KEY UPDATE `phone` = `phone`",
+			wantArgs:  []interface{}{"972"},
+		},
+		{
+			input:     Insert("users").Columns("phone", "name", "age").Values("972", "a8m", 30).OnConflict(dialect.SQLite, []string{"phone"}, "name", "age"),
+			wantQuery: "INSERT INTO `users` (`phone`, `name`, `age`) VALUES (?, ?, ?) ON CONFLICT (`phone`) DO UPDATE SET `name` = `excluded`.`name`, `age` = `excluded`.`age`",
+			wantArgs:  []interface{}{"972", "a8m", 30},
+		},
+		{
+			input:     Insert("users").Columns("phone").Values("972").OnConflict(dialect.SQLite, []string{"phone"}),
+			wantQuery: "INSERT INTO `users` (`phone`) VALUES (?) ON CONFLICT (`phone`) DO NOTHING",
+			wantArgs:  []interface{}{"972"},
+		},
 		{
 			input:     Update("users").Set("name", "foo"),
 			wantQuery: "UPDATE `users` SET `name` = ?",
@@ -261,6 +290,12 @@ func TestBuilder(t *testing.T) {
 				Where(False().And().False()),
 			wantQuery: "DELETE FROM `users` WHERE FALSE AND FALSE",
 		},
+		{
+			input: Delete("users").
+				Where(Between("age", 10, 20).And().NotNull("parent_id")),
+			wantQuery: "DELETE FROM `users` WHERE `age` BETWEEN ? AND ? AND `parent_id` IS NOT NULL",
+			wantArgs:  []interface{}{10, 20},
+		},
 		{
 			input: Delete("users").
 				Where(NotNull("parent_id").Or().EQ("parent_id", 10)),
@@ -350,6 +385,46 @@ func TestBuilder(t *testing.T) {
 			wantQuery: "SELECT `u`.`id`, `g`.`name` FROM `users` AS `u` JOIN `groups` AS `g` ON `u`.`id` = `g`.`user_id` WHERE `u`.`name` = ? AND `g`.`name` IS NOT NULL",
 			wantArgs:  []interface{}{"bar"},
 		},
+		{
+			input: func() Querier {
+				t1 := Table("users").As("u")
+				t2 := Table("pets").As("p")
+				return Select(t1.C("name"), t2.C("name")).
+					From(t1).
+					LeftJoin(t2).
+					On(t1.C("id"), t2.C("owner_id"))
+			}(),
+			wantQuery: "SELECT `u`.`name`, `p`.`name` FROM `users` AS `u` LEFT JOIN `pets` AS `p` ON `u`.`id` = `p`.`owner_id`",
+		},
+		{
+			input: func() Querier {
+				t1 := Table("users").As("u")
+				t2 := Table("pets").As("p")
+				t3 := Table("groups").As("g")
+				return Select(t1.C("name")).
+					From(t1).
+					RightJoin(t2).
+					On(t1.C("id"), t2.C("owner_id")).
+					InnerJoin(t3).
+					OnP(ColumnsEQ(t1.C("group_id"), t3.C("id")).And().EQ(t3.C("active"), true))
+			}(),
+			wantQuery: "SELECT `u`.`name` FROM `users` AS `u` RIGHT JOIN `pets` AS `p` ON `u`.`id` = `p`.`owner_id` INNER JOIN `groups` AS `g` ON `u`.`group_id` = `g`.`id` AND `g`.`active` = ?",
+			wantArgs:  []interface{}{true},
+		},
+		{
+			input: func() Querier {
+				t1 := Table("users").As("u")
+				t2 := Select().From(Table("pets")).Where(EQ("name", "pedro")).As("p")
+				return Select(t1.C("name")).
+					From(t1).
+					LeftJoin(t2).
+					On(t1.C("id"), t2.C("owner_id")).
+					OnP(GT(t2.C("age"), 1)).
+					Where(EQ(t1.C("name"), "a8m"))
+			}(),
+			wantQuery: "SELECT `u`.`name` FROM `users` AS `u` LEFT JOIN (SELECT * FROM `pets` WHERE `name` = ?) AS `p` ON `u`.`id` = `p`.`owner_id` AND `p`.`age` > ? WHERE `u`.`name` = ?",
+			wantArgs:  []interface{}{"pedro", 1, "a8m"},
+		},
 		{
 			input: func() Querier {
 				t1 := Table("users").As("u")
@@ -440,6 +515,16 @@ func TestBuilder(t *testing.T) {
 			wantQuery: "SELECT * FROM `users` WHERE NOT (`users`.`id` IN (SELECT `owner_id` FROM `pets` WHERE `name` = ?))",
 			wantArgs:  []interface{}{"pedro"},
 		},
+		{
+			input: func() Querier {
+				t1 := Table("users")
+				return Select().
+					From(t1).
+					Where(NotIn(t1.C("id"), Select("owner_id").From(Table("pets")).Where(EQ("name", "pedro"))).And().EQ("age", 30))
+			}(),
+			wantQuery: "SELECT * FROM `users` WHERE `users`.`id` NOT IN (SELECT `owner_id` FROM `pets` WHERE `name` = ?) AND `age` = ?",
+			wantArgs:  []interface{}{"pedro", 30},
+		},
 		{
 			input:     Select().Count().From(Table("users")),
 			wantQuery: "SELECT COUNT(*) FROM `users`",
@@ -488,11 +573,76 @@ func TestBuilder(t *testing.T) {
 				OrderBy(Desc("name"), "age"),
 			wantQuery: "SELECT `name`, `age`, COUNT(*) FROM `users` GROUP BY `name`, `age` ORDER BY `name` DESC, `age`",
 		},
+		{
+			input: Select("name", Count("*")).
+				From(Table("users")).
+				Where(EQ("active", true)).
+				GroupBy("name").
+				Having(GT(Count("*"), 5)),
+			wantQuery: "SELECT `name`, COUNT(*) FROM `users` WHERE `active` = ? GROUP BY `name` HAVING COUNT(*) > ?",
+			wantArgs:  []interface{}{true, 5},
+		},
+		{
+			input:     Select("name").From(Table("users")).OrderBy(AscNullsLast("name"), DescNullsFirst("age")),
+			wantQuery: "SELECT `name` FROM `users` ORDER BY `name` IS NULL ASC, `name` ASC, `age` IS NULL DESC, `age` DESC",
+		},
+		{
+			input:     Select("name").From(Table("users")).OrderBy(AscNullsFirst(Table("users").C("name")), DescNullsLast("LENGTH(`name`)")),
+			wantQuery: "SELECT `name` FROM `users` ORDER BY `users`.`name` IS NULL DESC, `users`.`name` ASC, LENGTH(`name`) IS NULL ASC, LENGTH(`name`) DESC",
+		},
 		{
 			input:     Select("*").From(Table("users")).Limit(1),
 			wantQuery: "SELECT * FROM `users` LIMIT ?",
 			wantArgs:  []interface{}{1},
 		},
+		{
+			input: Select("name", As(RowNumber().PartitionBy("owner_id").OrderBy(Desc("age"), "name").String(), "rn")).
+				From(Table("pets")),
+			wantQuery: "SELECT `name`, ROW_NUMBER() OVER (PARTITION BY `owner_id` ORDER BY `age` DESC, `name`) AS `rn` FROM `pets`",
+		},
+		{
+			input: func() Querier {
+				t := Select("*", As(Rank().PartitionBy("owner_id").OrderBy(Desc("age")).String(), "rank")).
+					From(Table("pets")).
+					As("t")
+				return Select("*").From(t).Where(LTE(t.C("rank"), 3))
+			}(),
+			wantQuery: "SELECT * FROM (SELECT *, RANK() OVER (PARTITION BY `owner_id` ORDER BY `age` DESC) AS `rank` FROM `pets`) AS `t` WHERE `t`.`rank` <= ?",
+			wantArgs:  []interface{}{3},
+		},
+		{
+			input: Select(
+				As(Window(Sum("amount")).OrderBy("created_at").Rows(Preceding(6), CurrentRow).String(), "weekly"),
+				As(Window(Avg("amount")).Range(UnboundedPreceding, UnboundedFollowing).String(), "avg"),
+				DenseRank().String(),
+			).From(Table("orders")),
+			wantQuery: "SELECT SUM(`amount`) OVER (ORDER BY `created_at` ROWS BETWEEN 6 PRECEDING AND CURRENT ROW) AS `weekly`, AVG(`amount`) OVER (RANGE BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING) AS `avg`, DENSE_RANK() OVER () FROM `orders`",
+		},
+		{
+			input: func() Querier {
+				t := Table("pets")
+				s := Select(As(t.C("owner_id"), "owner_id"), As(t.C("id"), "id")).
+					From(t).
+					Where(In(t.C("owner_id"), 1, 2)).
+					OrderBy(Desc(t.C("age"))).
+					Offset(1).
+					Limit(2)
+				return PartitionLimit(s, []string{t.C("owner_id")}, "owner_id", "id")
+			}(),
+			wantQuery: "SELECT `partition`.`owner_id`, `partition`.`id` FROM (SELECT `pets`.`owner_id` AS `owner_id`, `pets`.`id` AS `id`, ROW_NUMBER() OVER (PARTITION BY `pets`.`owner_id` ORDER BY `pets`.`age` DESC) AS `row_number` FROM `pets` WHERE `pets`.`owner_id` IN (?, ?)) AS `partition` WHERE `partition`.`row_number` > ? AND `partition`.`row_number` <= ? ORDER BY `partition`.`row_number`",
+			wantArgs:  []interface{}{1, 2, 1, 3},
+		},
+		{
+			input: func() Querier {
+				t := Table("pets")
+				s := Select(As(t.C("owner_id"), "owner_id"), As(t.C("id"), "id")).
+					From(t).
+					Limit(1)
+				return PartitionLimit(s, []string{t.C("owner_id")}, "owner_id", "id")
+			}(),
+			wantQuery: "SELECT `partition`.`owner_id`, `partition`.`id` FROM (SELECT `pets`.`owner_id` AS `owner_id`, `pets`.`id` AS `id`, ROW_NUMBER() OVER (PARTITION BY `pets`.`owner_id`) AS `row_number` FROM `pets`) AS `partition` WHERE `partition`.`row_number` <= ? ORDER BY `partition`.`row_number`",
+			wantArgs:  []interface{}{1},
+		},
 		{
 			input:     Select("age").Distinct().From(Table("users")),
 			wantQuery: "SELECT DISTINCT `age` FROM `users`",
@@ -501,6 +651,36 @@ func TestBuilder(t *testing.T) {
 			input:     Select("age", "name").From(Table("users")).Distinct().OrderBy("name"),
 			wantQuery: "SELECT DISTINCT `age`, `name` FROM `users` ORDER BY `name`",
 		},
+		{
+			input:     Select("id", "doc").From(Table("users")).Where(InInts("id", 1, 2)).ForUpdate(),
+			wantQuery: "SELECT `id`, `doc` FROM `users` WHERE `id` IN (?, ?) FOR UPDATE",
+			wantArgs:  []interface{}{1, 2},
+		},
+		{
+			input:     Select("id").From(Table("users")).Where(EQ("name", "a8m")).Hint(UseIndex("user_name"), StraightJoin()),
+			wantQuery: "SELECT STRAIGHT_JOIN `id` FROM `users` USE INDEX (`user_name`) WHERE `name` = ?",
+			wantArgs:  []interface{}{"a8m"},
+		},
+		{
+			input: Select("age").Distinct().From(Table("users").As("u")).
+				Hint(ForceIndex("a", "b"), IgnoreIndex("c`d"), OptimizerHint("MAX_EXECUTION_TIME(1000)"), OptimizerHint("NO_ICP(u) */ DROP")),
+			wantQuery: "SELECT /*+ MAX_EXECUTION_TIME(1000) */ DISTINCT `age` FROM `users` AS `u` FORCE INDEX (`a`, `b`) IGNORE INDEX (`c``d`)",
+		},
+		{
+			input: Select("age").Distinct().From(Table("users")).SetDialect(dialect.SQLite).
+				Hint(IgnoreIndex("c"), StraightJoin(), OptimizerHint("NO_ICP(users)"), UseIndex("a", "b")),
+			wantQuery: "SELECT DISTINCT `age` FROM `users` INDEXED BY `a`",
+		},
+		{
+			input:     Select("age").From(Select().From(Table("users")).As("t")).Hint(UseIndex("a")),
+			wantQuery: "SELECT `age` FROM (SELECT * FROM `users`) AS `t`",
+		},
+		{
+			input: Select().From(Table("users")).
+				Where(InTuple([]string{"name", "phone"}, []interface{}{"a8m", "102"}, []interface{}{"nati", "103"})),
+			wantQuery: "SELECT * FROM `users` WHERE (`name`, `phone`) IN ((?, ?), (?, ?))",
+			wantArgs:  []interface{}{"a8m", "102", "nati", "103"},
+		},
 		{
 			input:     Select("age").From(Table("users")).Where(EQ("name", "foo")).Or().Where(EQ("name", "bar")),
 			wantQuery: "SELECT `age` FROM `users` WHERE (`name` = ?) OR (`name` = ?)",
@@ -518,6 +698,46 @@ func TestBuilder(t *testing.T) {
 			wantQuery: "WITH groups AS (SELECT * FROM `groups` WHERE `name` = ?) SELECT `age` FROM `groups`",
 			wantArgs:  []interface{}{"bar"},
 		},
+		{
+			input: func() Querier {
+				w := With("a8m").As(Select("id").From(Table("users")).Where(EQ("name", "a8m"))).
+					With("pets", "id", "name").As(Select("id", "name").From(Table("pets")).Where(EQ("owner_id", 1)))
+				return Queries{w, Select().From(w)}
+			}(),
+			wantQuery: "WITH a8m AS (SELECT `id` FROM `users` WHERE `name` = ?), pets(`id`, `name`) AS (SELECT `id`, `name` FROM `pets` WHERE `owner_id` = ?) SELECT * FROM `pets`",
+			wantArgs:  []interface{}{"a8m", 1},
+		},
+		{
+			input: func() Querier {
+				t1 := Table("users")
+				w := WithRecursive("ancestors", "id")
+				w.As(
+					Select("parent_id").From(t1).Where(EQ("id", 1)).
+						Union(Select(t1.C("parent_id")).From(t1).Join(w).On(t1.C("id"), w.C("id"))),
+				)
+				return Queries{w, Select().From(w)}
+			}(),
+			wantQuery: "WITH RECURSIVE ancestors(`id`) AS (SELECT `parent_id` FROM `users` WHERE `id` = ? UNION SELECT `users`.`parent_id` FROM `users` JOIN `ancestors` ON `users`.`id` = `ancestors`.`id`) SELECT * FROM `ancestors`",
+			wantArgs:  []interface{}{1},
+		},
+		{
+			input: func() Querier {
+				t1 := Table("nodes")
+				w := WithRecursive("list", "id")
+				w.As(
+					Select("id").From(t1).Where(EQ("value", 1)).
+						UnionAll(Select(t1.C("id")).From(t1).Join(w).On(t1.C("prev_id"), w.C("id"))),
+				)
+				return Select().From(Table("nodes")).Where(In("id", Select("id").From(w).With(w))).Limit(1)
+			}(),
+			wantQuery: "SELECT * FROM `nodes` WHERE `id` IN (WITH RECURSIVE list(`id`) AS (SELECT `id` FROM `nodes` WHERE `value` = ? UNION ALL SELECT `nodes`.`id` FROM `nodes` JOIN `list` ON `nodes`.`prev_id` = `list`.`id`) SELECT `id` FROM `list`) LIMIT ?",
+			wantArgs:  []interface{}{1, 1},
+		},
+		{
+			input:     Select("name").From(Table("users")).Where(EQ("age", 30)).UnionAll(Select("name").From(Table("pets"))).OrderBy("name"),
+			wantQuery: "SELECT `name` FROM `users` WHERE `age` = ? UNION ALL SELECT `name` FROM `pets` ORDER BY `name`",
+			wantArgs:  []interface{}{30},
+		},
 		{
 			input:     CreateIndex("name_index").Table("users").Column("name"),
 			wantQuery: "CREATE INDEX `name_index` ON `users`(`name`)",
@@ -526,6 +746,14 @@ func TestBuilder(t *testing.T) {
 			input:     CreateIndex("unique_name").Unique().Table("users").Columns("first", "last"),
 			wantQuery: "CREATE UNIQUE INDEX `unique_name` ON `users`(`first`, `last`)",
 		},
+		{
+			input:     CreateIndex("bio_index").Type("fulltext").Table("users").Column("bio"),
+			wantQuery: "CREATE FULLTEXT INDEX `bio_index` ON `users`(`bio`)",
+		},
+		{
+			input:     CreateIndex("name_index").Type("HASH").Table("users").Columns("first", "last").Prefix("last", 100),
+			wantQuery: "CREATE INDEX `name_index` ON `users`(`first`, `last`(100)) USING HASH",
+		},
 		{
 			input:     DropIndex("name_index"),
 			wantQuery: "DROP INDEX `name_index`",
@@ -534,6 +762,37 @@ func TestBuilder(t *testing.T) {
 			input:     DropIndex("name_index").Table("users"),
 			wantQuery: "DROP INDEX `name_index` ON `users`",
 		},
+		{
+			input: func() Querier {
+				t1 := Table("billing.invoices")
+				t2 := Table("users").As("u")
+				return Select(t1.C("id"), t2.C("name")).
+					From(t1).
+					Join(t2).
+					On(t1.C("user_id"), t2.C("id"))
+			}(),
+			wantQuery: "SELECT `billing`.`invoices`.`id`, `u`.`name` FROM `billing`.`invoices` JOIN `users` AS `u` ON `billing`.`invoices`.`user_id` = `u`.`id`",
+		},
+		{
+			input:     Select().From(Table("billing.invoices").As("i")).Where(EQ("paid", true)),
+			wantQuery: "SELECT * FROM `billing`.`invoices` AS `i` WHERE `paid` = ?",
+			wantArgs:  []interface{}{true},
+		},
+		{
+			input:     Insert("billing.invoices").Columns("total").Values(10),
+			wantQuery: "INSERT INTO `billing`.`invoices` (`total`) VALUES (?)",
+			wantArgs:  []interface{}{10},
+		},
+		{
+			input:     Update("billing.invoices").Set("total", 20).Where(EQ("id", 1)),
+			wantQuery: "UPDATE `billing`.`invoices` SET `total` = ? WHERE `id` = ?",
+			wantArgs:  []interface{}{20, 1},
+		},
+		{
+			input:     Delete("billing.invoices").Where(EQ("id", 1)),
+			wantQuery: "DELETE FROM `billing`.`invoices` WHERE `id` = ?",
+			wantArgs:  []interface{}{1},
+		},
 	}
 	for i, tt := range tests {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
@@ -543,3 +802,23 @@ func TestBuilder(t *testing.T) {
 		})
 	}
 }
+
+func TestSelector_ContextErr(t *testing.T) {
+	type key struct{}
+	s := Select().From(Table("users"))
+	require.Equal(t, context.Background(), s.Context())
+	require.NoError(t, s.Err())
+	ctx := context.WithValue(context.Background(), key{}, "a8m")
+	s.WithContext(ctx).AddError(nil)
+	require.Equal(t, "a8m", s.Context().Value(key{}))
+	require.NoError(t, s.Err())
+
+	s.AddError(errors.New("missing tenant"))
+	require.EqualError(t, s.Err(), "missing tenant")
+	require.EqualError(t, s.Clone().Err(), "missing tenant")
+	require.Equal(t, "a8m", s.Clone().Context().Value(key{}))
+
+	t1 := Select("id").From(Table("pets")).AddError(errors.New("missing owner"))
+	s2 := Select().From(s.As("u")).Join(t1).On("u.id", "owner_id")
+	require.EqualError(t, s2.Err(), "sql: missing tenant; missing owner", "errors of FROM and JOIN subqueries")
+}
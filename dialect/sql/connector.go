@@ -0,0 +1,115 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+// OpenConnector wraps the database/sql.OpenDB function, and returns a Driver for a database that
+// is opened using a connector instead of a DSN. For example, connectors that dial the database using
+// a custom network, or that authenticate using short-lived credentials. The name is the name of the
+// underlying driver, and it's used for detecting the dialect of the database.
+func OpenConnector(name string, c driver.Connector) *Driver {
+	return OpenDB(name, sql.OpenDB(c))
+}
+
+// Connector returns a driver.Connector for the given driver name and DSN. It's used for wrapping
+// the connections of databases that are opened using a DSN, with OnConnect for example.
+func Connector(name, source string) (driver.Connector, error) {
+	db, err := sql.Open(name, source)
+	if err != nil {
+		return nil, err
+	}
+	drv := db.Driver()
+	if err := db.Close(); err != nil {
+		return nil, err
+	}
+	if dc, ok := drv.(driver.DriverContext); ok {
+		return dc.OpenConnector(source)
+	}
+	return dsnConnector{drv: drv, dsn: source}, nil
+}
+
+// dsnConnector is a driver.Connector for drivers that don't implement the driver.DriverContext interface.
+type dsnConnector struct {
+	drv driver.Driver
+	dsn string
+}
+
+// Connect implements the driver.Connector interface.
+func (c dsnConnector) Connect(context.Context) (driver.Conn, error) { return c.drv.Open(c.dsn) }
+
+// Driver implements the driver.Connector interface.
+func (c dsnConnector) Driver() driver.Driver { return c.drv }
+
+// OnConnect returns a connector that calls the given hook on each new connection of the given connector,
+// before the connection is used by the database. It's used for setting session variables of the connections,
+// like the time zone or the search path. Connections that failed in the hook are closed, and their error is
+// returned to the caller. For example:
+//
+//	c, err := sql.Connector("mysql", dsn)
+//	if err != nil {
+//		return err
+//	}
+//	drv := sql.OpenConnector(dialect.MySQL, sql.OnConnect(c, func(ctx context.Context, conn driver.Conn) error {
+//		return sql.ExecConn(ctx, conn, "SET time_zone = '+00:00'")
+//	}))
+//
+func OnConnect(c driver.Connector, hook func(context.Context, driver.Conn) error) driver.Connector {
+	return &hookConnector{Connector: c, hook: hook}
+}
+
+// hookConnector is a driver.Connector that calls a hook on its new connections.
+type hookConnector struct {
+	driver.Connector
+	hook func(context.Context, driver.Conn) error
+}
+
+// Connect implements the driver.Connector interface.
+func (c *hookConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.hook(ctx, conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("dialect/sql: connect hook: %v", err)
+	}
+	return conn, nil
+}
+
+// ExecConn executes a statement on the given driver connection. It's used by OnConnect
+// hooks for executing statements on the connection before it's used by the database.
+func ExecConn(ctx context.Context, conn driver.Conn, query string, args ...interface{}) error {
+	named := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	if execer, ok := conn.(driver.ExecerContext); ok {
+		_, err := execer.ExecContext(ctx, query, named)
+		if err != driver.ErrSkip {
+			return err
+		}
+	}
+	stmt, err := conn.Prepare(query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	if s, ok := stmt.(driver.StmtExecContext); ok {
+		_, err = s.ExecContext(ctx, named)
+		return err
+	}
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg
+	}
+	_, err = stmt.Exec(values)
+	return err
+}
@@ -0,0 +1,47 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnConnect(t *testing.T) {
+	c, err := Connector("sqlite3", "file:onconnect?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	var connected int
+	drv := OpenConnector(dialect.SQLite, OnConnect(c, func(ctx context.Context, conn driver.Conn) error {
+		connected++
+		return ExecConn(ctx, conn, "PRAGMA user_version = 2")
+	}))
+	defer drv.Close()
+	rows := &Rows{}
+	require.NoError(t, drv.Query(context.Background(), "PRAGMA user_version", []interface{}{}, rows))
+	defer rows.Close()
+	require.True(t, rows.Next())
+	var version int
+	require.NoError(t, rows.Scan(&version))
+	require.Equal(t, 2, version)
+	require.Equal(t, 1, connected)
+}
+
+func TestOnConnect_Error(t *testing.T) {
+	c, err := Connector("sqlite3", "file:onconnect-error?mode=memory")
+	require.NoError(t, err)
+	drv := OpenConnector(dialect.SQLite, OnConnect(c, func(ctx context.Context, conn driver.Conn) error {
+		return ExecConn(ctx, conn, "SET time_zone = ?", "+00:00")
+	}))
+	defer drv.Close()
+	err = drv.DB().PingContext(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "dialect/sql: connect hook:")
+}
@@ -27,12 +27,12 @@ func Open(driver, source string) (*Driver, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Driver{conn{db}, driver}, nil
+	return &Driver{conn{ExecQuerier: db}, driver}, nil
 }
 
 // OpenDB wraps the given database/sql.DB method with a Driver.
 func OpenDB(driver string, db *sql.DB) *Driver {
-	return &Driver{conn{db}, driver}
+	return &Driver{conn{ExecQuerier: db}, driver}
 }
 
 // DB returns the underlying *sql.DB instance.
@@ -57,7 +57,15 @@ func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Tx{conn{tx}}, nil
+	if d.killer == nil {
+		return &Tx{conn{ExecQuerier: tx}}, nil
+	}
+	k, err := d.killer.tx(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	return &Tx{conn{ExecQuerier: tx, killer: k}}, nil
 }
 
 // Close closes the underlying connection.
@@ -83,6 +91,9 @@ type ExecQuerier interface {
 // shared connection ExecQuerier between Driver and Tx.
 type conn struct {
 	ExecQuerier
+	// killer is used for killing statements on context
+	// cancellation. See Driver.WithKillQuery for details.
+	killer *killer
 }
 
 // Exec implements the dialect.Exec method.
@@ -95,7 +106,15 @@ func (c *conn) Exec(ctx context.Context, query string, args, v interface{}) erro
 	if !ok {
 		return fmt.Errorf("dialect/sql: invalid type %T. expect []interface{} for args", v)
 	}
-	res, err := c.ExecContext(ctx, query, argv...)
+	exec := c.ExecContext
+	if c.killer != nil {
+		exec = func(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+			return c.killer.exec(ctx, c.ExecQuerier, query, args)
+		}
+	}
+	ctx, cancel := stmtContext(ctx)
+	defer cancel()
+	res, err := exec(ctx, query, argv...)
 	if err != nil {
 		return err
 	}
@@ -113,11 +132,27 @@ func (c *conn) Query(ctx context.Context, query string, args, v interface{}) err
 	if !ok {
 		return fmt.Errorf("dialect/sql: invalid type %T. expect []interface{} for args", args)
 	}
-	rows, err := c.QueryContext(ctx, query, argv...)
+	ctx, cancel := stmtContext(ctx)
+	var (
+		rows    *sql.Rows
+		release = cancel
+		err     error
+	)
+	if c.killer != nil {
+		var stop func()
+		rows, stop, err = c.killer.query(ctx, c.ExecQuerier, query, argv)
+		release = func() {
+			stop()
+			cancel()
+		}
+	} else {
+		rows, err = c.QueryContext(ctx, query, argv...)
+	}
 	if err != nil {
+		cancel()
 		return err
 	}
-	*vr = Rows{rows}
+	*vr = Rows{Rows: rows, release: release}
 	return nil
 }
 
@@ -125,7 +160,12 @@ var _ dialect.Driver = (*Driver)(nil)
 
 type (
 	// Rows wraps the sql.Rows to avoid locks copy.
-	Rows struct{ *sql.Rows }
+	Rows struct {
+		*sql.Rows
+		// release releases the resources of the statement
+		// (e.g. its timeout), when the rows are closed.
+		release func()
+	}
 	// Result is an alias to sql.Result.
 	Result = sql.Result
 	// NullBool is an alias to sql.NullBool.
@@ -138,6 +178,16 @@ type (
 	NullFloat64 = sql.NullFloat64
 )
 
+// Close closes the rows, and releases the resources of their statement.
+func (r *Rows) Close() error {
+	err := r.Rows.Close()
+	if r.release != nil {
+		r.release()
+		r.release = nil
+	}
+	return err
+}
+
 // Note:
 // NullTime is a modified copy of database/sql.NullTime from Go 1.13,
 // It should be replaced with standard library code when Go 1.13 is released.
@@ -0,0 +1,142 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// KillTimeout is the timeout for executing the KILL QUERY statements.
+var KillTimeout = 5 * time.Second
+
+// WithKillQuery returns a copy of the driver that kills the running statements on the server
+// when their context is canceled (or its deadline exceeded) before they complete. The KILL QUERY
+// statement is executed on a side connection, in order to stop long-running statements from
+// consuming server resources after the client abandoned them.
+//
+// Note that, it's supported only by MySQL, and it costs an extra round-trip per statement (or
+// transaction) for getting the id of the connection it's executed on.
+//
+//	drv, err := sql.Open("mysql", dsn)
+//	if err != nil {
+//		return err
+//	}
+//	client := ent.NewClient(ent.Driver(drv.WithKillQuery()))
+//
+func (d *Driver) WithKillQuery() *Driver {
+	if d.Dialect() != dialect.MySQL {
+		return d
+	}
+	return &Driver{conn{ExecQuerier: d.ExecQuerier, killer: &killer{db: d.DB()}}, d.dialect}
+}
+
+// killer kills the statements of a connection when their context is canceled.
+type killer struct {
+	db *sql.DB
+	// id of the connection. It's set only for transactions. Otherwise,
+	// each statement is executed on a dedicated connection.
+	id int64
+}
+
+// tx returns a killer for the given transaction.
+func (k *killer) tx(ctx context.Context, tx *sql.Tx) (*killer, error) {
+	id, err := connID(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return &killer{db: k.db, id: id}, nil
+}
+
+// exec executes the given statement using the killer connection.
+func (k *killer) exec(ctx context.Context, eq ExecQuerier, query string, args []interface{}) (sql.Result, error) {
+	id, eq, release, err := k.conn(ctx, eq)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	stop := k.watch(ctx, id)
+	defer stop()
+	return eq.ExecContext(ctx, query, args...)
+}
+
+// query executes the given query using the killer connection. The returned function stops
+// the watch of the killer and releases the connection. It's called when the rows are closed.
+func (k *killer) query(ctx context.Context, eq ExecQuerier, query string, args []interface{}) (*sql.Rows, func(), error) {
+	id, eq, release, err := k.conn(ctx, eq)
+	if err != nil {
+		return nil, nil, err
+	}
+	stop := k.watch(ctx, id)
+	rows, err := eq.QueryContext(ctx, query, args...)
+	if err != nil {
+		stop()
+		release()
+		return nil, nil, err
+	}
+	return rows, func() {
+		stop()
+		release()
+	}, nil
+}
+
+// conn returns the connection for executing a statement and its id.
+func (k *killer) conn(ctx context.Context, eq ExecQuerier) (int64, ExecQuerier, func(), error) {
+	if k.id != 0 {
+		return k.id, eq, func() {}, nil
+	}
+	c, err := k.db.Conn(ctx)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	id, err := connID(ctx, c)
+	if err != nil {
+		c.Close()
+		return 0, nil, nil, err
+	}
+	return id, c, func() { c.Close() }, nil
+}
+
+// watch kills the running statement of the given connection if the context is done before
+// the returned function is called. The returned function waits for the KILL statement to
+// complete, in order to not release the connection before its statement was killed.
+func (k *killer) watch(ctx context.Context, id int64) func() {
+	done, exited := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(exited)
+		select {
+		case <-ctx.Done():
+			kctx, cancel := context.WithTimeout(context.Background(), KillTimeout)
+			defer cancel()
+			_, _ = k.db.ExecContext(kctx, fmt.Sprintf("KILL QUERY %d", id))
+		case <-done:
+		}
+	}()
+	return func() {
+		close(done)
+		<-exited
+	}
+}
+
+// connID returns the id of the connection of the given ExecQuerier.
+func connID(ctx context.Context, eq ExecQuerier) (int64, error) {
+	rows, err := eq.QueryContext(ctx, "SELECT CONNECTION_ID()")
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	var ids []int64
+	if err := ScanSlice(rows, &ids); err != nil {
+		return 0, fmt.Errorf("dialect/sql: scanning connection id: %v", err)
+	}
+	if len(ids) != 1 {
+		return 0, fmt.Errorf("dialect/sql: unexpected number of connection ids: %d", len(ids))
+	}
+	return ids[0], nil
+}
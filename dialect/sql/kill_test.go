@@ -0,0 +1,80 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_WithKillQuery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.MatchExpectationsInOrder(false)
+	drv := OpenDB(dialect.MySQL, db).WithKillQuery()
+	require.NotNil(t, drv.killer)
+
+	t.Log("statement completed")
+	mock.ExpectQuery("SELECT CONNECTION_ID()").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	mock.ExpectExec("UPDATE `users`").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	var res Result
+	err = drv.Exec(context.Background(), "UPDATE `users` SET `age` = 1", []interface{}{}, &res)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	t.Log("statement canceled")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	mock.ExpectQuery("SELECT CONNECTION_ID()").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+	mock.ExpectExec("UPDATE `users`").
+		WillDelayFor(time.Second).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("KILL QUERY 20").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	err = drv.Exec(ctx, "UPDATE `users` SET `age` = 1", []interface{}{}, &res)
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDriver_WithKillQueryTx(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.MatchExpectationsInOrder(false)
+	drv := OpenDB(dialect.MySQL, db).WithKillQuery()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT CONNECTION_ID()").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(30))
+	tx, err := drv.Tx(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	mock.ExpectQuery("SELECT").
+		WillDelayFor(time.Second).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec("KILL QUERY 30").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := &Rows{}
+	err = tx.Query(ctx, "SELECT `id` FROM `users`", []interface{}{}, rows)
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDriver_WithKillQueryDialect(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := OpenDB(dialect.SQLite, db)
+	require.Equal(t, drv, drv.WithKillQuery(), "kill query is supported only by MySQL")
+}
@@ -0,0 +1,160 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package mysqlx opens MySQL databases using a mysql.Config and options that can't be set
+// in a DSN (e.g. a custom dialer), for the SQL driver of ent. It's a separate package, in
+// order to not import the MySQL driver in the dialect/sql package.
+package mysqlx
+
+import (
+	"context"
+	"crypto/tls"
+	"database/sql/driver"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// Option configures the connections of the database that is opened by Open.
+type Option func(*connector)
+
+// WithTLS sets the TLS configuration of the connections. Unlike the "tls" parameter of
+// the DSN, it doesn't require registering the configuration using mysql.RegisterTLSConfig.
+func WithTLS(cfg *tls.Config) Option {
+	return func(c *connector) {
+		c.tls = cfg
+	}
+}
+
+// WithDialer sets the function that dials the network connections of the database, instead of the
+// default net.Dialer. For example, dialing through a proxy or a tunnel. The dialer gets the network
+// and the address of the configuration.
+func WithDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error)) Option {
+	return func(c *connector) {
+		c.dial = dial
+	}
+}
+
+// WithPasswordFunc sets a function that returns the password of each new connection. It's used
+// for authenticating with short-lived tokens that must be refreshed, like RDS IAM authentication.
+func WithPasswordFunc(fn func(context.Context) (string, error)) Option {
+	return func(c *connector) {
+		c.password = fn
+	}
+}
+
+// Open opens a MySQL database with the given configuration and options, and returns
+// a Driver for it. The configuration is copied, and it's not changed by the options.
+//
+//	cfg := mysql.NewConfig()
+//	cfg.User, cfg.Net, cfg.Addr, cfg.DBName = "ent", "tcp", endpoint, "test"
+//	cfg.AllowCleartextPasswords, cfg.ParseTime = true, true
+//	drv, err := mysqlx.Open(cfg, mysqlx.WithTLS(tlsConfig), mysqlx.WithPasswordFunc(func(ctx context.Context) (string, error) {
+//		return rdsutils.BuildAuthToken(endpoint, region, "ent", creds)
+//	}))
+//	if err != nil {
+//		return err
+//	}
+//	client := ent.NewClient(ent.Driver(drv))
+//
+func Open(cfg *mysql.Config, opts ...Option) (*sql.Driver, error) {
+	c := &connector{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	cfg = cfg.Clone()
+	if c.tls != nil {
+		c.tlsName = fmt.Sprintf("ent-tls-%d", atomic.AddUint64(&registered, 1))
+		if err := mysql.RegisterTLSConfig(c.tlsName, c.tls); err != nil {
+			return nil, err
+		}
+		cfg.TLSConfig = c.tlsName
+	}
+	if c.dial != nil {
+		dialOnce.Do(func() { mysql.RegisterDialContext(dialNet, dialContext) })
+		c.network, cfg.Net = cfg.Net, dialNet
+	}
+	// check the configuration before the first connection.
+	conn, err := mysql.NewConnector(cfg)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	c.cfg, c.conn = cfg, conn
+	return sql.OpenConnector(dialect.MySQL, c), nil
+}
+
+// registered is the sequence of the TLS configurations that are
+// registered by Open, for giving them unique names.
+var registered uint64
+
+// dialNet is the network of the dialer that is registered once by Open, for all connectors
+// that were opened with WithDialer. It calls the dialer of the connector of the connection,
+// that is passed to it in the context of the connection.
+const dialNet = "ent-dial"
+
+var dialOnce sync.Once
+
+// dialKey is the context key of the connector that dials a connection.
+type dialKey struct{}
+
+// dialContext is the dialer of the dialNet network.
+func dialContext(ctx context.Context, addr string) (net.Conn, error) {
+	c, ok := ctx.Value(dialKey{}).(*connector)
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql/mysqlx: missing dialer for %q", addr)
+	}
+	return c.dial(ctx, c.network, addr)
+}
+
+// connector is a driver.Connector for MySQL databases that are opened by Open.
+type connector struct {
+	cfg      *mysql.Config
+	conn     driver.Connector
+	tls      *tls.Config
+	tlsName  string
+	dial     func(context.Context, string, string) (net.Conn, error)
+	network  string
+	password func(context.Context) (string, error)
+}
+
+// Connect implements the driver.Connector interface.
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn := c.conn
+	if c.password != nil {
+		password, err := c.password(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("dialect/sql/mysqlx: get password: %v", err)
+		}
+		cfg := c.cfg.Clone()
+		cfg.Passwd = password
+		if conn, err = mysql.NewConnector(cfg); err != nil {
+			return nil, err
+		}
+	}
+	if c.dial != nil {
+		ctx = context.WithValue(ctx, dialKey{}, c)
+	}
+	return conn.Connect(ctx)
+}
+
+// Driver implements the driver.Connector interface.
+func (c *connector) Driver() driver.Driver {
+	return mysql.MySQLDriver{}
+}
+
+// Close implements the io.Closer interface. It's called when the database is closed,
+// and it deregisters the TLS configuration of the connector.
+func (c *connector) Close() error {
+	if c.tlsName != "" {
+		mysql.DeregisterTLSConfig(c.tlsName)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package mysqlx
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	cfg := mysql.NewConfig()
+	cfg.Net, cfg.Addr, cfg.User = "tcp", "db.example.com:3306", "ent"
+	var (
+		dialed   []string
+		password int
+	)
+	drv, err := Open(cfg,
+		WithTLS(&tls.Config{}),
+		WithDialer(func(_ context.Context, network, addr string) (net.Conn, error) {
+			dialed = append(dialed, network+"/"+addr)
+			return nil, errors.New("dial error")
+		}),
+		WithPasswordFunc(func(context.Context) (string, error) {
+			password++
+			return "token", nil
+		}),
+	)
+	require.NoError(t, err)
+	defer drv.Close()
+	require.Equal(t, dialect.MySQL, drv.Dialect())
+	require.Equal(t, "tcp", cfg.Net, "configuration should not be changed")
+	require.Empty(t, cfg.TLSConfig)
+
+	err = drv.DB().PingContext(context.Background())
+	require.EqualError(t, err, "dial error")
+	require.Equal(t, []string{"tcp/db.example.com:3306"}, dialed)
+	require.Equal(t, 1, password)
+}
+
+func TestOpen_Password(t *testing.T) {
+	cfg := mysql.NewConfig()
+	cfg.Net, cfg.Addr = "tcp", "db.example.com:3306"
+	drv, err := Open(cfg,
+		WithDialer(func(context.Context, string, string) (net.Conn, error) {
+			t.Fatal("unexpected dial")
+			return nil, nil
+		}),
+		WithPasswordFunc(func(context.Context) (string, error) {
+			return "", errors.New("expired credentials")
+		}),
+	)
+	require.NoError(t, err)
+	defer drv.Close()
+	err = drv.DB().PingContext(context.Background())
+	require.EqualError(t, err, "dialect/sql/mysqlx: get password: expired credentials")
+}
+
+func TestOpen_InvalidConfig(t *testing.T) {
+	cfg := mysql.NewConfig()
+	cfg.Net = "unix"
+	cfg.Addr = "/tmp/mysql.sock"
+	cfg.ServerPubKey = "unknown"
+	_, err := Open(cfg)
+	require.Error(t, err)
+}
+
+func TestOpen_Close(t *testing.T) {
+	var dialed []string
+	open := func(name string) *sql.Driver {
+		cfg := mysql.NewConfig()
+		cfg.Net, cfg.Addr = "tcp", name+":3306"
+		drv, err := Open(cfg, WithTLS(&tls.Config{}), WithDialer(func(_ context.Context, _, addr string) (net.Conn, error) {
+			dialed = append(dialed, name+"/"+addr)
+			return nil, errors.New("dial error")
+		}))
+		require.NoError(t, err)
+		return drv
+	}
+	drv1, drv2 := open("db1"), open("db2")
+	require.Error(t, drv1.DB().PingContext(context.Background()))
+	require.Error(t, drv2.DB().PingContext(context.Background()))
+	require.Equal(t, []string{"db1/db1:3306", "db2/db2:3306"}, dialed, "each connector uses its own dialer")
+
+	cfg := mysql.NewConfig()
+	cfg.TLSConfig = fmt.Sprintf("ent-tls-%d", registered)
+	_, err := mysql.NewConnector(cfg)
+	require.NoError(t, err)
+	require.NoError(t, drv2.Close())
+	_, err = mysql.NewConnector(cfg)
+	require.Error(t, err, "the TLS configuration is deregistered when the driver is closed")
+	require.NoError(t, drv1.Close())
+}
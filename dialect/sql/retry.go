@@ -0,0 +1,207 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"math/rand"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// The errors of the MySQL driver (github.com/go-sql-driver/mysql) are matched by their messages,
+// in order to not import the driver in this package. Deadlocks (error 1213) are formatted as
+// "Error 1213: <message>" (or "Error 1213 (40001): <message>" in newer versions of the driver),
+// and mysql.ErrInvalidConn is returned when the connection was dropped during an operation.
+const (
+	mysqlDeadlock    = "Error 1213"
+	mysqlInvalidConn = "invalid connection"
+)
+
+// IsSerializationFailure reports if the error is a serialization failure (SQLSTATE 40001), that
+// aborts the transaction it was raised in, and requires the client to retry it. For example, MySQL
+// deadlocks (error 1213), or the transaction conflicts of databases that run in serializable
+// isolation (e.g. CockroachDB), reported by drivers whose errors implement SQLState() string.
+func IsSerializationFailure(err error) bool {
+	var stateErr interface{ SQLState() string }
+	if errors.As(err, &stateErr) {
+		return stateErr.SQLState() == "40001"
+	}
+	return matchChain(err, func(err error) bool {
+		msg := err.Error()
+		return strings.HasPrefix(msg, mysqlDeadlock+":") || strings.HasPrefix(msg, mysqlDeadlock+" (")
+	})
+}
+
+// RetryTx executes fn in a transaction of the driver, and commits it. If fn or the commit failed
+// with a serialization failure, the transaction is rolled back, and fn is executed again in a new
+// transaction, up to the given number of attempts. Hence, fn should not have side effects outside
+// of its transaction. Other errors roll back the transaction, and are returned as is, and the last
+// serialization failure is returned if all attempts failed.
+//
+//	err := sql.RetryTx(ctx, drv, 3, func(tx dialect.Tx) error {
+//		return tx.Exec(ctx, "UPDATE `accounts` SET `balance` = `balance` - 10 WHERE `id` = ?", []interface{}{1}, new(sql.Result))
+//	})
+//
+func RetryTx(ctx context.Context, drv dialect.Driver, attempts int, fn func(dialect.Tx) error) error {
+	if attempts < 1 {
+		attempts = 1
+	}
+	var err error
+	for i := 0; i < attempts; i++ {
+		if err = runTx(ctx, drv, fn); !IsSerializationFailure(err) || ctx.Err() != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// runTx executes fn in a new transaction, and commits it if fn succeeded.
+func runTx(ctx context.Context, drv dialect.Driver, fn func(dialect.Tx) error) error {
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// RetryPolicy configures the retries of a RetryDriver. The zero value is a valid policy.
+type RetryPolicy struct {
+	// Attempts is the maximum number of attempts of each operation (including
+	// the first one). Defaults to 3.
+	Attempts int
+	// MinBackoff is the delay before the first retry. It's doubled on each
+	// further retry, and randomized by up to 50%. Defaults to 10ms.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay between attempts. Defaults to 1s.
+	MaxBackoff time.Duration
+}
+
+// RetryDriver is a driver that retries the operations that failed with transient errors.
+type RetryDriver struct {
+	dialect.Driver             // underlying driver.
+	policy         RetryPolicy // retry policy. defaults are set.
+}
+
+// Retry gets a driver and a retry policy, and returns a new driver that retries the operations that
+// failed with transient errors, with an exponential backoff between the attempts. Only operations that
+// are safe to retry are retried. Queries of read-only statements (SELECT) are retried on serialization
+// failures (e.g. MySQL deadlocks) and on connection errors (e.g. driver.ErrBadConn or connection resets).
+// Other statements are retried only on serialization failures, because the database rolled them back,
+// and their connection errors are returned as is, because the statement may have been applied.
+// Transactions are retried only on connection errors of their BEGIN statement, and their statements
+// are never retried by the driver. Use RetryTx for retrying whole transactions.
+//
+//	drv, err := sql.Open("mysql", "<dsn>")
+//	if err != nil {
+//		return err
+//	}
+//	client := ent.NewClient(ent.Driver(sql.Retry(drv, sql.RetryPolicy{Attempts: 5})))
+//
+func Retry(drv dialect.Driver, policy RetryPolicy) dialect.Driver {
+	if policy.Attempts < 1 {
+		policy.Attempts = 3
+	}
+	if policy.MinBackoff <= 0 {
+		policy.MinBackoff = 10 * time.Millisecond
+	}
+	if policy.MaxBackoff < policy.MinBackoff {
+		policy.MaxBackoff = time.Second
+		if policy.MaxBackoff < policy.MinBackoff {
+			policy.MaxBackoff = policy.MinBackoff
+		}
+	}
+	return &RetryDriver{drv, policy}
+}
+
+// Exec calls the underlying driver Exec method, and retries it on serialization failures.
+func (d *RetryDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return d.retry(ctx, IsSerializationFailure, func() error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// Query calls the underlying driver Query method, and retries it on transient errors if the statement
+// is read-only. Other statements (e.g. INSERT ... RETURNING) are retried as in Exec.
+func (d *RetryDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	retryable := IsSerializationFailure
+	if readOnly(query) {
+		retryable = isTransient
+	}
+	return d.retry(ctx, retryable, func() error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// Tx calls the underlying driver Tx command, and retries it on connection errors.
+func (d *RetryDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	var tx dialect.Tx
+	err := d.retry(ctx, isConnError, func() (err error) {
+		tx, err = d.Driver.Tx(ctx)
+		return err
+	})
+	return tx, err
+}
+
+// retry executes fn until it succeeded, failed with an error that is not retryable, the context
+// was canceled, or it exceeded the number of attempts. The last error of fn is returned.
+func (d *RetryDriver) retry(ctx context.Context, retryable func(error) bool, fn func() error) error {
+	backoff := d.policy.MinBackoff
+	for i := 1; ; i++ {
+		err := fn()
+		if err == nil || i >= d.policy.Attempts || !retryable(err) {
+			return err
+		}
+		// randomize the delay, to prevent conflicting clients from retrying at the same time.
+		timer := time.NewTimer(backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		if backoff *= 2; backoff > d.policy.MaxBackoff {
+			backoff = d.policy.MaxBackoff
+		}
+	}
+}
+
+// readOnly reports if the statement is a SELECT statement.
+func readOnly(query string) bool {
+	query = strings.TrimLeft(query, " \t\n(")
+	return len(query) >= 6 && strings.EqualFold(query[:6], "SELECT")
+}
+
+// isTransient reports if the error is a serialization failure or a connection error.
+func isTransient(err error) bool {
+	return IsSerializationFailure(err) || isConnError(err)
+}
+
+// isConnError reports if the error is a connection error, that is returned
+// when the connection to the database was dropped or reset.
+func isConnError(err error) bool {
+	return errors.Is(err, driver.ErrBadConn) || errors.Is(err, syscall.ECONNRESET) || matchChain(err, func(err error) bool {
+		return err.Error() == mysqlInvalidConn
+	})
+}
+
+// matchChain reports if one of the errors in the chain of err matches the given function.
+func matchChain(err error, match func(error) bool) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if match(err) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+type stateError string
+
+func (e stateError) Error() string    { return "state error: " + string(e) }
+func (e stateError) SQLState() string { return string(e) }
+
+func TestIsSerializationFailure(t *testing.T) {
+	require.True(t, IsSerializationFailure(&mysql.MySQLError{Number: 1213}))
+	require.True(t, IsSerializationFailure(fmt.Errorf("wrapped: %w", &mysql.MySQLError{Number: 1213})))
+	require.True(t, IsSerializationFailure(stateError("40001")))
+	require.False(t, IsSerializationFailure(stateError("23505")))
+	require.False(t, IsSerializationFailure(&mysql.MySQLError{Number: 1062}))
+	require.False(t, IsSerializationFailure(errors.New("40001")))
+	require.False(t, IsSerializationFailure(&mysql.MySQLError{Number: 12130}))
+	require.True(t, isConnError(fmt.Errorf("wrapped: %w", mysql.ErrInvalidConn)))
+	require.False(t, isConnError(errors.New("connection refused")))
+	require.False(t, IsSerializationFailure(nil))
+}
+
+func TestRetryTx(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := OpenDB(dialect.MySQL, db)
+	ctx := context.Background()
+	update := func(tx dialect.Tx) error {
+		return tx.Exec(ctx, "UPDATE `users` SET `age` = 1", []interface{}{}, new(Result))
+	}
+
+	t.Log("serialization failures are retried in a new transaction")
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `users`").WillReturnError(&mysql.MySQLError{Number: 1213})
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `users`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, RetryTx(ctx, drv, 3, update))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	t.Log("the last failure is returned when all attempts failed")
+	for i := 0; i < 2; i++ {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `users`").WillReturnError(&mysql.MySQLError{Number: 1213})
+		mock.ExpectRollback()
+	}
+	err = RetryTx(ctx, drv, 2, update)
+	require.True(t, IsSerializationFailure(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	t.Log("other errors are not retried")
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `users`").WillReturnError(&mysql.MySQLError{Number: 1062})
+	mock.ExpectRollback()
+	require.Error(t, RetryTx(ctx, drv, 3, update))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// errDriver is a driver that fails its operations with the given errors, in order.
+type errDriver struct {
+	dialect.Driver
+	errs  []error
+	calls int
+}
+
+func (d *errDriver) err() error {
+	d.calls++
+	if len(d.errs) == 0 {
+		return nil
+	}
+	err := d.errs[0]
+	d.errs = d.errs[1:]
+	return err
+}
+
+func (d *errDriver) Exec(context.Context, string, interface{}, interface{}) error  { return d.err() }
+func (d *errDriver) Query(context.Context, string, interface{}, interface{}) error { return d.err() }
+func (d *errDriver) Tx(context.Context) (dialect.Tx, error) {
+	if err := d.err(); err != nil {
+		return nil, err
+	}
+	return dialect.NopTx(d), nil
+}
+
+func TestRetry(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		deadlock = &mysql.MySQLError{Number: 1213}
+		reset    = &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+		policy   = RetryPolicy{Attempts: 3, MinBackoff: time.Millisecond}
+	)
+	tests := []struct {
+		name  string
+		errs  []error
+		op    func(dialect.Driver) error
+		calls int
+		err   error
+	}{
+		{
+			name:  "query/deadlock",
+			errs:  []error{deadlock, deadlock},
+			op:    func(d dialect.Driver) error { return d.Query(ctx, "SELECT * FROM `users`", []interface{}{}, new(Rows)) },
+			calls: 3,
+		},
+		{
+			name: "query/conn",
+			errs: []error{driver.ErrBadConn, reset},
+			op: func(d dialect.Driver) error {
+				return d.Query(ctx, "  SELECT * FROM `users`", []interface{}{}, new(Rows))
+			},
+			calls: 3,
+		},
+		{
+			name:  "query/attempts",
+			errs:  []error{deadlock, deadlock, deadlock, deadlock},
+			op:    func(d dialect.Driver) error { return d.Query(ctx, "SELECT * FROM `users`", []interface{}{}, new(Rows)) },
+			calls: 3,
+			err:   deadlock,
+		},
+		{
+			name:  "query/other",
+			errs:  []error{errors.New("syntax error")},
+			op:    func(d dialect.Driver) error { return d.Query(ctx, "SELECT * FROM `users`", []interface{}{}, new(Rows)) },
+			calls: 1,
+			err:   errors.New("syntax error"),
+		},
+		{
+			name: "query/write",
+			errs: []error{reset},
+			op: func(d dialect.Driver) error {
+				return d.Query(ctx, "INSERT INTO `users` DEFAULT VALUES RETURNING `id`", []interface{}{}, new(Rows))
+			},
+			calls: 1,
+			err:   reset,
+		},
+		{
+			name: "exec/deadlock",
+			errs: []error{deadlock},
+			op: func(d dialect.Driver) error {
+				return d.Exec(ctx, "UPDATE `users` SET `age` = 1", []interface{}{}, new(Result))
+			},
+			calls: 2,
+		},
+		{
+			name: "exec/conn",
+			errs: []error{driver.ErrBadConn},
+			op: func(d dialect.Driver) error {
+				return d.Exec(ctx, "UPDATE `users` SET `age` = 1", []interface{}{}, new(Result))
+			},
+			calls: 1,
+			err:   driver.ErrBadConn,
+		},
+		{
+			name: "tx/conn",
+			errs: []error{mysql.ErrInvalidConn},
+			op: func(d dialect.Driver) error {
+				_, err := d.Tx(ctx)
+				return err
+			},
+			calls: 2,
+		},
+		{
+			name: "tx/deadlock",
+			errs: []error{deadlock},
+			op: func(d dialect.Driver) error {
+				_, err := d.Tx(ctx)
+				return err
+			},
+			calls: 1,
+			err:   deadlock,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drv := &errDriver{errs: tt.errs}
+			err := tt.op(Retry(drv, policy))
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.calls, drv.calls)
+		})
+	}
+}
+
+func TestRetry_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	drv := &errDriver{errs: []error{driver.ErrBadConn, driver.ErrBadConn}}
+	err := Retry(drv, RetryPolicy{MinBackoff: time.Minute}).Query(ctx, "SELECT 1", []interface{}{}, new(Rows))
+	require.Equal(t, driver.ErrBadConn, err)
+	require.Equal(t, 1, drv.calls, "backoff is stopped when the context is canceled")
+}
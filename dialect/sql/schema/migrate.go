@@ -19,6 +19,9 @@ import (
 const (
 	// TypeTable defines the table name holding the type information.
 	TypeTable = "ent_types"
+	// VersionTable defines the table name holding the schema versions
+	// that were recorded by the migration.
+	VersionTable = "ent_versions"
 	// MaxTypes defines the max number of types can be created when
 	// defining universal ids. The left 16-bits are reserved.
 	MaxTypes = math.MaxUint16
@@ -51,18 +54,125 @@ func WithDropIndex(b bool) MigrateOption {
 	}
 }
 
+// WithVersion sets the schema version (e.g. a hash of the schema) that is
+// recorded in the versions table after the migration. Defaults to "", which
+// means that no version is recorded. In ModeExpand, the version is recorded with
+// the "expand" phase, and both the previous version and the new one are considered
+// compatible with the database (see Versions) until the contract phase succeeds.
+func WithVersion(v string) MigrateOption {
+	return func(m *Migrate) {
+		m.version = v
+	}
+}
+
+// WithForeignKeys sets the foreign-keys creation option to the migration. Disabling
+// it is useful for databases that do not support foreign-key constraints (e.g. Vitess).
+// In this case, the references can be checked by the generated code, by enabling the
+// "softfk" codegen feature. Defaults to true.
+func WithForeignKeys(b bool) MigrateOption {
+	return func(m *Migrate) {
+		m.withForeignKeys = b
+	}
+}
+
+// WithForce sets the option for applying lossy column changes, like shrinking the size of
+// varchar columns, narrowing integer types or removing enum values. Without it, the migration
+// fails on such changes, and only the safe ones (e.g. widening column types) are applied.
+// Only changes between types of the same family (e.g. integers, floats, or strings and enums)
+// are forced, and changing a column to a type of another family fails the migration.
+// Lossy changes are destructive, and therefore, they are applied only in the contract phase
+// of the migration. Defaults to false.
+func WithForce(b bool) MigrateOption {
+	return func(m *Migrate) {
+		m.force = b
+	}
+}
+
+// Creator is the interface that wraps the Create method.
+type Creator interface {
+	// Create creates the given tables in the database. The statements are
+	// executed using the given transaction, which is the transaction of the
+	// migration.
+	Create(context.Context, dialect.Tx, ...*Table) error
+}
+
+// The CreateFunc type is an adapter to allow the use of ordinary functions as Creator.
+// If f is a function with the appropriate signature, CreateFunc(f) is a Creator that calls f.
+type CreateFunc func(context.Context, dialect.Tx, ...*Table) error
+
+// Create calls f(ctx, tx, tables...).
+func (f CreateFunc) Create(ctx context.Context, tx dialect.Tx, tables ...*Table) error {
+	return f(ctx, tx, tables...)
+}
+
+// Hook defines the "create middleware". A function that gets a Creator and returns a Creator.
+// Hooks can execute custom statements (e.g. triggers or data backfills) before or after the
+// schema changes, in the transaction of the migration, or change the tables that are created.
+// For example:
+//
+//	hook := func(next schema.Creator) schema.Creator {
+//		return schema.CreateFunc(func(ctx context.Context, tx dialect.Tx, tables ...*schema.Table) error {
+//			if err := next.Create(ctx, tx, tables...); err != nil {
+//				return err
+//			}
+//			return tx.Exec(ctx, "UPDATE `users` SET `role` = 'user' WHERE `role` IS NULL", []interface{}{}, new(sql.Result))
+//		})
+//	}
+//
+type Hook func(Creator) Creator
+
+// WithHooks adds a list of hooks to the schema migration. The hooks are executed
+// in their order, and the first hook is the outermost one.
+func WithHooks(hooks ...Hook) MigrateOption {
+	return func(m *Migrate) {
+		m.hooks = append(m.hooks, hooks...)
+	}
+}
+
+// MigrateMode defines the phase of changes that are executed by the migration.
+type MigrateMode uint
+
+const (
+	// ModeFull runs all changes of the migration at once. It's the default mode.
+	ModeFull MigrateMode = iota
+	// ModeExpand runs only the additive (expand) changes of the migration. For example, creating
+	// tables, columns, indexes and foreign-keys, or extending column types. New columns that were
+	// added to existing tables are created as nullable, since they are unknown to the code that is
+	// currently running. Destructive changes are deferred to the ModeContract phase.
+	ModeExpand
+	// ModeContract runs only the destructive (contract) changes of the migration. For example,
+	// dropping columns and indexes that were removed from the schema (without the need for the
+	// WithDropColumn and WithDropIndex options), changing nullable columns to NOT NULL, or changing
+	// the uniqueness of columns and indexes. It should run after the previous version of the code
+	// is no longer deployed.
+	ModeContract
+)
+
+// WithMode sets the migration mode. Using ModeExpand and ModeContract makes it
+// possible to run zero-downtime (blue/green) deployments. Defaults to ModeFull.
+func WithMode(mode MigrateMode) MigrateOption {
+	return func(m *Migrate) {
+		m.mode = mode
+	}
+}
+
 // Migrate runs the migrations logic for the SQL dialects.
 type Migrate struct {
 	sqlDialect
-	universalID bool     // global unique ids.
-	dropColumn  bool     // drop deleted columns.
-	dropIndex   bool     // drop deleted indexes.
-	typeRanges  []string // types order by their range.
+	universalID     bool        // global unique ids.
+	dropColumn      bool        // drop deleted columns.
+	dropIndex       bool        // drop deleted indexes.
+	withForeignKeys bool        // create foreign-keys.
+	force           bool        // apply lossy column changes.
+	version         string      // schema version to record.
+	mode            MigrateMode // migration mode (phase).
+	typeRanges      []string    // types order by their range.
+	hooks           []Hook      // hooks to apply on the creation.
 }
 
 // NewMigrate create a migration structure for the given SQL driver.
 func NewMigrate(d dialect.Driver, opts ...MigrateOption) (*Migrate, error) {
-	m := &Migrate{}
+	m := &Migrate{withForeignKeys: true}
 	switch d.Dialect() {
 	case dialect.MySQL:
 		m.sqlDialect = &MySQL{Driver: d}
@@ -99,12 +209,48 @@ func (m *Migrate) Create(ctx context.Context, tables ...*Table) error {
 			return rollback(tx, err)
 		}
 	}
-	if err := m.create(ctx, tx, tables...); err != nil {
+	var creator Creator = CreateFunc(m.create)
+	for i := len(m.hooks) - 1; i >= 0; i-- {
+		creator = m.hooks[i](creator)
+	}
+	if err := creator.Create(ctx, tx, tables...); err != nil {
 		return rollback(tx, err)
 	}
+	if m.version != "" {
+		if err := m.setVersion(ctx, tx); err != nil {
+			return rollback(tx, err)
+		}
+	}
 	return tx.Commit()
 }
 
+// Version returns the last schema version that was recorded by the migration.
+// An empty string is returned if no version was recorded in the database.
+func (m *Migrate) Version(ctx context.Context) (string, error) {
+	versions, err := m.Versions(ctx)
+	if err != nil || len(versions) == 0 {
+		return "", err
+	}
+	return versions[0], nil
+}
+
+// Versions returns the schema versions that are compatible with the database, starting
+// with the last recorded one. After a migration in ModeExpand, the schema is compatible
+// with both the expanded version and the versions that preceded it, until the migration
+// in ModeContract (or ModeFull) is executed. An empty slice is returned if no version was
+// recorded in the database.
+func (m *Migrate) Versions(ctx context.Context) ([]string, error) {
+	tx, err := m.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := m.recordedVersions(ctx, tx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	return versions, tx.Commit()
+}
+
 func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) error {
 	for _, t := range tables {
 		t.setup()
@@ -116,6 +262,11 @@ func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) e
 			if err != nil {
 				return err
 			}
+			// dialects that do not support loading tables (i.e. SQLite),
+			// do not support altering them as well.
+			if curr == nil {
+				continue
+			}
 			change, err := m.changeSet(curr, t)
 			if err != nil {
 				return err
@@ -123,8 +274,10 @@ func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) e
 			if err := m.apply(ctx, tx, t.Name, change); err != nil {
 				return err
 			}
+		// new tables are created in the expand phase.
+		case m.mode == ModeContract:
 		default: // !exist
-			query, args := m.tBuilder(t).Query()
+			query, args := m.tBuilder(m.fkTable(t)).Query()
 			if err := tx.Exec(ctx, query, args, new(sql.Result)); err != nil {
 				return fmt.Errorf("create table %q: %v", t.Name, err)
 			}
@@ -137,7 +290,7 @@ func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) e
 			}
 			// indexes.
 			for _, idx := range t.Indexes {
-				query, args := idx.Builder(t.Name).Query()
+				query, args := m.iBuilder(idx, t.Name).Query()
 				if err := tx.Exec(ctx, query, args, new(sql.Result)); err != nil {
 					return fmt.Errorf("create index %q: %v", idx.Name, err)
 				}
@@ -146,6 +299,9 @@ func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) e
 	}
 	// create foreign keys after tables were created/altered,
 	// because circular foreign-key constraints are possible.
+	if m.mode == ModeContract || !m.withForeignKeys {
+		return nil
+	}
 	for _, t := range tables {
 		if len(t.ForeignKeys) == 0 {
 			continue
@@ -153,7 +309,7 @@ func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) e
 		fks := make([]*ForeignKey, 0, len(t.ForeignKeys))
 		for _, fk := range t.ForeignKeys {
 			fk.Symbol = symbol(fk.Symbol)
-			exist, err := m.fkExist(ctx, tx, fk.Symbol)
+			exist, err := m.fkExist(ctx, tx, t.Name, fk.Symbol)
 			if err != nil {
 				return err
 			}
@@ -176,12 +332,23 @@ func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) e
 	return nil
 }
 
+// fkTable returns the table definition that is used for the table creation.
+// Foreign keys are omitted if they are disabled for the migration.
+func (m *Migrate) fkTable(t *Table) *Table {
+	if m.withForeignKeys || len(t.ForeignKeys) == 0 {
+		return t
+	}
+	nt := *t
+	nt.ForeignKeys = nil
+	return &nt
+}
+
 // apply applies changes on the given table.
 func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change *changes) error {
 	// constraints should be dropped before dropping columns, because if a column
 	// is a part of multi-column constraints (like, unique index), ALTER TABLE
 	// might fail if the intermediate state violates the constraints.
-	if m.dropIndex {
+	if m.dropIndex || m.mode == ModeContract {
 		for _, idx := range change.index.drop {
 			query, args := idx.DropBuilder(table).Query()
 			if err := tx.Exec(ctx, query, args, new(sql.Result)); err != nil {
@@ -196,7 +363,10 @@ func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change
 	for _, c := range change.column.modify {
 		b.ModifyColumn(m.cBuilder(c))
 	}
-	if m.dropColumn {
+	for _, r := range change.column.rename {
+		b.ChangeColumn(r.from, m.cBuilder(r.to))
+	}
+	if m.dropColumn || m.mode == ModeContract {
 		for _, c := range change.column.drop {
 			b.DropColumn(sql.Column(c.Name))
 		}
@@ -209,7 +379,7 @@ func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change
 		}
 	}
 	for _, idx := range change.index.add {
-		query, args := idx.Builder(table).Query()
+		query, args := m.iBuilder(idx, table).Query()
 		if err := tx.Exec(ctx, query, args, new(sql.Result)); err != nil {
 			return fmt.Errorf("create index %q: %v", table, err)
 		}
@@ -224,6 +394,7 @@ type changes struct {
 		add    []*Column
 		drop   []*Column
 		modify []*Column
+		rename []rename
 	}
 	// index changes.
 	index struct {
@@ -232,10 +403,18 @@ type changes struct {
 	}
 }
 
+// rename describes a column that is renamed from one of its previous names.
+type rename struct {
+	from string
+	to   *Column
+}
+
 // changeSet returns a changes object to be applied on existing table.
 // It fails if one of the changes is invalid.
 func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 	change := &changes{}
+	// the phases of changes that are executed by the migration.
+	expand, contract := m.mode != ModeContract, m.mode != ModeExpand
 	// pks.
 	if len(curr.PrimaryKey) != len(new.PrimaryKey) {
 		return nil, fmt.Errorf("cannot change primary key for table: %q", curr.Name)
@@ -253,11 +432,35 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 		if c1.PrimaryKey() {
 			continue
 		}
+		// rename columns from their previous names.
+		if c2 := renamed(curr, c1); c2 != nil {
+			// renaming a column breaks the code that uses its previous name,
+			// and therefore, it can't be split into expand and contract phases.
+			if !expand || !contract {
+				return nil, fmt.Errorf("renaming column %q to %q is supported only in the full migration mode", c2.Name, c1.Name)
+			}
+			if m.cType(c1) != m.cType(c2) && !c2.ConvertibleTo(c1) && !(m.force && c2.sameFamily(c1)) {
+				return nil, fmt.Errorf("changing column type for %q is invalid (%s != %s)", c1.Name, m.cType(c1), m.cType(c2))
+			}
+			change.column.rename = append(change.column.rename, rename{from: c2.Name, to: c1})
+			continue
+		}
 		switch c2, ok := curr.column(c1.Name); {
 		case !ok:
+			if !expand {
+				break
+			}
+			// the running code is not aware of the new column, and therefore, it's added
+			// as nullable in the expand phase. The NOT NULL constraint is applied later.
+			if !contract && !c1.Nullable && c1.Default == nil {
+				c1 = nullable(c1)
+			}
 			change.column.add = append(change.column.add, c1)
 		// modify a non-unique column to unique.
 		case c1.Unique && !c2.Unique:
+			if !contract {
+				break
+			}
 			change.index.add.append(&Index{
 				Name:    c1.Name,
 				Unique:  true,
@@ -266,6 +469,9 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 			})
 		// modify a unique column to non-unique.
 		case !c1.Unique && c2.Unique:
+			if !contract {
+				break
+			}
 			idx, ok := curr.index(c2.Name)
 			if !ok {
 				return nil, fmt.Errorf("missing index to drop for column %q", c2.Name)
@@ -274,22 +480,47 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 		// extending column types.
 		case m.cType(c1) != m.cType(c2):
 			if !c2.ConvertibleTo(c1) {
-				return nil, fmt.Errorf("changing column type for %q is invalid (%s != %s)", c1.Name, m.cType(c1), m.cType(c2))
+				switch {
+				case !c2.sameFamily(c1):
+					return nil, fmt.Errorf("changing column type for %q is invalid (%s != %s)", c1.Name, m.cType(c1), m.cType(c2))
+				case !m.force:
+					return nil, fmt.Errorf("changing column type for %q is lossy (%s != %s), and it requires the WithForce option", c1.Name, m.cType(c1), m.cType(c2))
+				}
+				if contract {
+					change.column.modify = append(change.column.modify, c1)
+				}
+				break
 			}
 			fallthrough
 		// change nullability of a column.
 		case c1.Nullable != c2.Nullable:
-			change.column.modify = append(change.column.modify, c1)
+			// extending column types and dropping the NOT NULL constraint are additive changes,
+			// but changing a nullable column to NOT NULL is a destructive one.
+			switch notnull := !c1.Nullable && c2.Nullable; {
+			// existing rows may hold NULL references, and therefore, the foreign-key
+			// columns of edges that became required are kept nullable in the database.
+			case notnull && new.foreignKey(c1.Name):
+			case contract && (expand || notnull):
+				change.column.modify = append(change.column.modify, c1)
+			case expand && !notnull:
+				change.column.modify = append(change.column.modify, c1)
+			case expand && m.cType(c1) != m.cType(c2):
+				change.column.modify = append(change.column.modify, nullable(c1))
+			}
 		}
 	}
 
 	// drop columns.
+	renames := make(map[string]bool, len(change.column.rename))
+	for _, r := range change.column.rename {
+		renames[r.from] = true
+	}
 	for _, c1 := range curr.Columns {
 		// if a column was dropped, multi-columns indexes that are associated with this column will
 		// no longer behave the same. Therefore, these indexes should be dropped too. There's no need
 		// to do it explicitly (here), because entc will remove them from the schema specification,
 		// and they will be dropped in the block below.
-		if _, ok := new.column(c1.Name); !ok {
+		if _, ok := new.column(c1.Name); !ok && contract && !renames[c1.Name] {
 			change.column.drop = append(change.column.drop, c1)
 		}
 	}
@@ -298,23 +529,48 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 	for _, idx1 := range new.Indexes {
 		switch idx2, ok := curr.index(idx1.Name); {
 		case !ok:
-			change.index.add.append(idx1)
+			if expand {
+				change.index.add.append(idx1)
+			}
 		// changing index cardinality require drop and create.
 		case idx1.Unique != idx2.Unique:
-			change.index.drop.append(idx2)
-			change.index.add.append(idx1)
+			if contract {
+				change.index.drop.append(idx2)
+				change.index.add.append(idx1)
+			}
 		}
 	}
 
 	// drop indexes.
 	for _, idx1 := range curr.Indexes {
-		if _, ok := new.index(idx1.Name); !ok {
+		if _, ok := new.index(idx1.Name); !ok && contract {
 			change.index.drop.append(idx1)
 		}
 	}
 	return change, nil
 }
 
+// renamed returns the column of the current table that the given column is renamed from,
+// or nil if the column already exists, or none of its previous names exists in the table.
+func renamed(curr *Table, c *Column) *Column {
+	if _, ok := curr.column(c.Name); ok {
+		return nil
+	}
+	for i := len(c.Renamed) - 1; i >= 0; i-- {
+		if c2, ok := curr.column(c.Renamed[i]); ok {
+			return c2
+		}
+	}
+	return nil
+}
+
+// nullable returns a nullable copy of the given column.
+func nullable(c *Column) *Column {
+	nc := *c
+	nc.Nullable = true
+	return &nc
+}
+
 // types loads the type list from the database.
 // If the table does not create, it will create one.
 func (m *Migrate) types(ctx context.Context, tx dialect.Tx) error {
@@ -341,6 +597,131 @@ func (m *Migrate) types(ctx context.Context, tx dialect.Tx) error {
 	return sql.ScanSlice(rows, &m.typeRanges)
 }
 
+// phaseExpand is the phase of versions that were recorded in ModeExpand.
+const phaseExpand = "expand"
+
+// versionRow is a row in the versions table.
+type versionRow struct {
+	version, phase string
+}
+
+// setVersion records the configured schema version and the phase of the migration
+// in the versions table, if they are different from the last ones. If the table does
+// not exist, it will create one.
+func (m *Migrate) setVersion(ctx context.Context, tx dialect.Tx) error {
+	var phase string
+	if m.mode == ModeExpand {
+		phase = phaseExpand
+	}
+	exist, err := m.tableExist(ctx, tx, VersionTable)
+	if err != nil {
+		return err
+	}
+	column := &Column{Name: "phase", Type: field.TypeString, Nullable: true}
+	if !exist {
+		t := NewTable(VersionTable).
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			AddColumn(&Column{Name: "version", Type: field.TypeString}).
+			AddColumn(column)
+		query, args := m.tBuilder(t).Query()
+		if err := tx.Exec(ctx, query, args, new(sql.Result)); err != nil {
+			return fmt.Errorf("create versions table: %v", err)
+		}
+	} else {
+		rows, hasPhase, err := m.versionRows(ctx, tx)
+		if err != nil {
+			return err
+		}
+		// versions tables that were created before the
+		// phase was recorded, are extended with its column.
+		if !hasPhase {
+			query, args := sql.AlterTable(VersionTable).AddColumn(m.cBuilder(column)).Query()
+			if err := tx.Exec(ctx, query, args, new(sql.Result)); err != nil {
+				return fmt.Errorf("add phase column to versions table: %v", err)
+			}
+		}
+		if len(rows) > 0 && rows[0] == (versionRow{version: m.version, phase: phase}) {
+			return nil
+		}
+	}
+	query, args := sql.Insert(VersionTable).Columns("version", "phase").Values(m.version, phase).Query()
+	if err := tx.Exec(ctx, query, args, new(sql.Result)); err != nil {
+		return fmt.Errorf("insert into versions: %v", err)
+	}
+	return nil
+}
+
+// recordedVersions returns the recorded versions that are compatible with the database,
+// starting with the last one. The last version is compatible with the database, and if it
+// was recorded in ModeExpand, the versions that preceded it are compatible as well, up to
+// and including the last version that was not recorded in ModeExpand.
+func (m *Migrate) recordedVersions(ctx context.Context, tx dialect.Tx) ([]string, error) {
+	exist, err := m.tableExist(ctx, tx, VersionTable)
+	if err != nil || !exist {
+		return nil, err
+	}
+	rows, _, err := m.versionRows(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	var versions []string
+	for _, r := range rows {
+		if len(versions) == 0 || versions[len(versions)-1] != r.version {
+			versions = append(versions, r.version)
+		}
+		if r.phase != phaseExpand {
+			break
+		}
+	}
+	return versions, nil
+}
+
+// versionRows returns the rows of the versions table, starting with the last one, and
+// reports if the table has the phase column. Tables that were created before the phase
+// was recorded do not have one.
+func (m *Migrate) versionRows(ctx context.Context, tx dialect.Tx) ([]versionRow, bool, error) {
+	rows := &sql.Rows{}
+	query, args := sql.Select().From(sql.Table(VersionTable)).OrderBy(sql.Desc("id")).Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return nil, false, fmt.Errorf("query versions table: %v", err)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, false, fmt.Errorf("query versions table columns: %v", err)
+	}
+	var (
+		hasPhase bool
+		vs       []versionRow
+	)
+	for _, c := range columns {
+		hasPhase = hasPhase || c == "phase"
+	}
+	for rows.Next() {
+		var (
+			v       versionRow
+			phase   sql.NullString
+			scanner = make([]interface{}, len(columns))
+		)
+		for i, c := range columns {
+			switch c {
+			case "version":
+				scanner[i] = &v.version
+			case "phase":
+				scanner[i] = &phase
+			default:
+				scanner[i] = new(interface{})
+			}
+		}
+		if err := rows.Scan(scanner...); err != nil {
+			return nil, false, fmt.Errorf("scan versions table: %v", err)
+		}
+		v.phase = phase.String
+		vs = append(vs, v)
+	}
+	return vs, hasPhase, rows.Err()
+}
+
 func (m *Migrate) allocPKRange(ctx context.Context, tx dialect.Tx, t *Table) error {
 	id := -1
 	// if the table re-created, re-use its range from the past.
@@ -404,11 +785,13 @@ type sqlDialect interface {
 	dialect.Driver
 	init(context.Context, dialect.Tx) error
 	table(context.Context, dialect.Tx, string) (*Table, error)
+	columns(context.Context, dialect.Tx, string) ([]*Column, error)
 	tableExist(context.Context, dialect.Tx, string) (bool, error)
-	fkExist(context.Context, dialect.Tx, string) (bool, error)
+	fkExist(context.Context, dialect.Tx, string, string) (bool, error)
 	setRange(context.Context, dialect.Tx, string, int) error
 	// table, column and index builder per dialect.
 	cType(*Column) string
 	tBuilder(*Table) *sql.TableBuilder
 	cBuilder(*Column) *sql.ColumnBuilder
+	iBuilder(*Index, string) *sql.IndexBuilder
 }
@@ -7,9 +7,12 @@ package schema
 import (
 	"context"
 	"fmt"
+	"math"
+	"strings"
 
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/schema/field"
 )
 
 // MySQL is a mysql migration driver.
@@ -38,13 +41,14 @@ func (d *MySQL) init(ctx context.Context, tx dialect.Tx) error {
 
 func (d *MySQL) tableExist(ctx context.Context, tx dialect.Tx, name string) (bool, error) {
 	query, args := sql.Select(sql.Count("*")).From(sql.Table("INFORMATION_SCHEMA.TABLES").Unquote()).
-		Where(sql.EQ("TABLE_SCHEMA", sql.Raw("(SELECT DATABASE())")).And().EQ("TABLE_NAME", name)).Query()
+		Where(matchTable(name)).Query()
 	return exist(ctx, tx, query, args...)
 }
 
-func (d *MySQL) fkExist(ctx context.Context, tx dialect.Tx, name string) (bool, error) {
+func (d *MySQL) fkExist(ctx context.Context, tx dialect.Tx, table, name string) (bool, error) {
+	schema, _ := splitTable(table)
 	query, args := sql.Select(sql.Count("*")).From(sql.Table("INFORMATION_SCHEMA.TABLE_CONSTRAINTS").Unquote()).
-		Where(sql.EQ("TABLE_SCHEMA", sql.Raw("(SELECT DATABASE())")).And().EQ("CONSTRAINT_TYPE", "FOREIGN KEY").And().EQ("CONSTRAINT_NAME", name)).Query()
+		Where(matchSchema(schema).And().EQ("CONSTRAINT_TYPE", "FOREIGN KEY").And().EQ("CONSTRAINT_NAME", name)).Query()
 	return exist(ctx, tx, query, args...)
 }
 
@@ -53,7 +57,7 @@ func (d *MySQL) table(ctx context.Context, tx dialect.Tx, name string) (*Table,
 	rows := &sql.Rows{}
 	query, args := sql.Select("column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name").
 		From(sql.Table("INFORMATION_SCHEMA.COLUMNS").Unquote()).
-		Where(sql.EQ("TABLE_SCHEMA", sql.Raw("(SELECT DATABASE())")).And().EQ("TABLE_NAME", name)).Query()
+		Where(matchTable(name)).Query()
 	if err := tx.Query(ctx, query, args, rows); err != nil {
 		return nil, fmt.Errorf("mysql: reading table description %v", err)
 	}
@@ -65,6 +69,11 @@ func (d *MySQL) table(ctx context.Context, tx dialect.Tx, name string) (*Table,
 		if err := c.ScanMySQL(rows); err != nil {
 			return nil, fmt.Errorf("mysql: %v", err)
 		}
+		// string literals in the column defaults of MariaDB are quoted, in order
+		// to distinguish them from expressions (e.g. 'unknown' and current_timestamp()).
+		if v, ok := c.Default.(string); ok && d.mariadb() {
+			c.Default = unquote(v)
+		}
 		if c.PrimaryKey() {
 			t.PrimaryKey = append(t.PrimaryKey, c)
 		}
@@ -73,6 +82,12 @@ func (d *MySQL) table(ctx context.Context, tx dialect.Tx, name string) (*Table,
 	if err := rows.Close(); err != nil {
 		return nil, fmt.Errorf("mysql: closing rows %v", err)
 	}
+	// JSON columns of MariaDB are reported as longtext columns with a json_valid check.
+	if v, ok := parseMariaDB(d.version); ok && compareVersions(v, "10.2.22") != -1 {
+		if err := d.jsonColumns(ctx, tx, t); err != nil {
+			return nil, err
+		}
+	}
 	indexes, err := d.indexes(ctx, tx, name)
 	if err != nil {
 		return nil, err
@@ -84,12 +99,66 @@ func (d *MySQL) table(ctx context.Context, tx dialect.Tx, name string) (*Table,
 	return t, nil
 }
 
+// columns loads the columns of the given table from the database. The raw types of
+// the columns are normalized to the types that are used by the migration.
+func (d *MySQL) columns(ctx context.Context, tx dialect.Tx, name string) ([]*Column, error) {
+	t, err := d.table(ctx, tx, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range t.Columns {
+		if c.Type.Valid() {
+			c.typ = d.cType(c)
+		}
+	}
+	return t.Columns, nil
+}
+
+// jsonColumns loads the check constraints of the given MariaDB table, and sets the
+// type of its longtext columns that are validated using json_valid to JSON.
+func (d *MySQL) jsonColumns(ctx context.Context, tx dialect.Tx, t *Table) error {
+	rows := &sql.Rows{}
+	schema, table := splitTable(t.Name)
+	match := sql.EQ("CONSTRAINT_SCHEMA", sql.Raw("(SELECT DATABASE())"))
+	if schema != "" {
+		match = sql.EQ("CONSTRAINT_SCHEMA", schema)
+	}
+	query, args := sql.Select("check_clause").
+		From(sql.Table("INFORMATION_SCHEMA.CHECK_CONSTRAINTS").Unquote()).
+		Where(match.And().EQ("TABLE_NAME", table)).Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("mysql: reading check constraints %v", err)
+	}
+	defer rows.Close()
+	var checks []string
+	if err := sql.ScanSlice(rows, &checks); err != nil {
+		return fmt.Errorf("mysql: scanning check constraints %v", err)
+	}
+	for _, check := range checks {
+		name := strings.TrimPrefix(check, "json_valid(")
+		if name == check {
+			continue
+		}
+		c, ok := t.column(strings.Trim(strings.TrimSuffix(name, ")"), "`"))
+		if ok && c.Type == field.TypeString && c.Size == math.MaxInt32 {
+			c.Type, c.Size = field.TypeJSON, 0
+		}
+	}
+	return nil
+}
+
+// mariadb reports if the database is a MariaDB server.
+func (d *MySQL) mariadb() bool {
+	_, ok := parseMariaDB(d.version)
+	return ok
+}
+
 // table loads the table indexes from the database.
 func (d *MySQL) indexes(ctx context.Context, tx dialect.Tx, name string) ([]*Index, error) {
 	rows := &sql.Rows{}
 	query, args := sql.Select("index_name", "column_name", "non_unique", "seq_in_index").
 		From(sql.Table("INFORMATION_SCHEMA.STATISTICS").Unquote()).
-		Where(sql.EQ("TABLE_SCHEMA", sql.Raw("(SELECT DATABASE())")).And().EQ("TABLE_NAME", name)).Query()
+		Where(matchTable(name)).Query()
 	if err := tx.Query(ctx, query, args, rows); err != nil {
 		return nil, fmt.Errorf("mysql: reading index description %v", err)
 	}
@@ -102,9 +171,55 @@ func (d *MySQL) indexes(ctx context.Context, tx dialect.Tx, name string) ([]*Ind
 }
 
 func (d *MySQL) setRange(ctx context.Context, tx dialect.Tx, name string, value int) error {
-	return tx.Exec(ctx, fmt.Sprintf("ALTER TABLE `%s` AUTO_INCREMENT = %d", name, value), []interface{}{}, new(sql.Result))
+	b := &sql.Builder{}
+	b.WriteString("ALTER TABLE ")
+	b.Append(name)
+	fmt.Fprintf(b, " AUTO_INCREMENT = %d", value)
+	return tx.Exec(ctx, b.String(), []interface{}{}, new(sql.Result))
+}
+
+// matchTable returns a predicate for matching the given table in the INFORMATION_SCHEMA tables.
+// Tables that are qualified with a schema name (e.g. "billing.users") are matched in their schema,
+// and the others are matched in the current database.
+func matchTable(name string) *sql.Predicate {
+	schema, table := splitTable(name)
+	return matchSchema(schema).And().EQ("TABLE_NAME", table)
+}
+
+// matchSchema returns a predicate for matching the given schema, or the current database if it's empty.
+func matchSchema(schema string) *sql.Predicate {
+	if schema == "" {
+		return sql.EQ("TABLE_SCHEMA", sql.Raw("(SELECT DATABASE())"))
+	}
+	return sql.EQ("TABLE_SCHEMA", schema)
+}
+
+// parseMariaDB reports if the given version is of a MariaDB server (e.g. "10.4.8-MariaDB-1:10.4.8+maria~bionic"),
+// and returns it without the "5.5.5-" prefix that is added by old servers for the compatibility of replication.
+func parseMariaDB(version string) (string, bool) {
+	if !strings.Contains(version, "MariaDB") {
+		return version, false
+	}
+	return strings.TrimPrefix(version, "5.5.5-"), true
+}
+
+// unquote returns the value of a quoted SQL string literal, or the given string if it's not quoted.
+func unquote(s string) string {
+	if len(s) < 2 || s[0] != '\'' || s[len(s)-1] != '\'' {
+		return s
+	}
+	return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+}
+
+// splitTable splits a table name into its schema and table parts.
+func splitTable(name string) (schema, table string) {
+	if i := strings.IndexByte(name, '.'); i != -1 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
 }
 
-func (d *MySQL) cType(c *Column) string                { return c.MySQLType(d.version) }
-func (d *MySQL) tBuilder(t *Table) *sql.TableBuilder   { return t.MySQL(d.version) }
-func (d *MySQL) cBuilder(c *Column) *sql.ColumnBuilder { return c.MySQL(d.version) }
+func (d *MySQL) cType(c *Column) string                        { return c.MySQLType(d.version) }
+func (d *MySQL) tBuilder(t *Table) *sql.TableBuilder           { return t.MySQL(d.version) }
+func (d *MySQL) cBuilder(c *Column) *sql.ColumnBuilder         { return c.MySQL(d.version) }
+func (d *MySQL) iBuilder(i *Index, t string) *sql.IndexBuilder { return i.MySQL(t) }
@@ -155,6 +155,18 @@ func (t *Table) column(name string) (*Column, bool) {
 	return nil, false
 }
 
+// foreignKey reports if the given column is a column of one of the table foreign-keys.
+func (t *Table) foreignKey(name string) bool {
+	for _, fk := range t.ForeignKeys {
+		for _, c := range fk.Columns {
+			if c.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // index returns a table index by its name.
 // faster than map lookup for most cases.
 func (t *Table) index(name string) (*Index, bool) {
@@ -184,6 +196,9 @@ type Column struct {
 	Default   interface{} // default value.
 	indexes   Indexes     // linked indexes.
 	Enums     []string    // enum values.
+	Precision int64       // fractional seconds precision for time columns.
+	DateOnly  bool        // time column that stores only the date part.
+	Renamed   []string    // previous names of the column.
 }
 
 // UniqueKey returns boolean indicates if this column is a unique key.
@@ -257,7 +272,8 @@ func (c *Column) MySQLType(version string) (t string) {
 		}
 	case field.TypeJSON:
 		t = "json"
-		if compareVersions(version, "5.7.8") == -1 {
+		// JSON is supported since MySQL 5.7.8, and it's an alias of longtext since MariaDB 10.2.7.
+		if v, ok := parseMariaDB(version); ok && compareVersions(v, "10.2.7") == -1 || !ok && compareVersions(version, "5.7.8") == -1 {
 			t = "longblob"
 		}
 	case field.TypeString:
@@ -270,10 +286,23 @@ func (c *Column) MySQLType(version string) (t string) {
 		} else {
 			t = "longtext"
 		}
+	case field.TypeUUID:
+		t = "binary(16)"
+	case field.TypeIP:
+		t = "varchar(45)"
+	case field.TypeMAC:
+		t = "varchar(64)"
 	case field.TypeFloat32, field.TypeFloat64:
 		t = "double"
 	case field.TypeTime:
+		if c.DateOnly {
+			t = "date"
+			break
+		}
 		t = "timestamp"
+		if c.Precision > 0 {
+			t = fmt.Sprintf("timestamp(%d)", c.Precision)
+		}
 		// in MySQL timestamp columns are `NOT NULL by default, and assigning NULL
 		// assigns the current_timestamp(). We avoid this if not set otherwise.
 		c.Nullable = true
@@ -299,8 +328,12 @@ func (c *Column) SQLiteType() (t string) {
 		t = "integer"
 	case field.TypeInt64, field.TypeUint64:
 		t = "bigint"
-	case field.TypeBytes:
+	case field.TypeBytes, field.TypeUUID:
 		t = "blob"
+	case field.TypeIP:
+		t = "varchar(45)"
+	case field.TypeMAC:
+		t = "varchar(64)"
 	case field.TypeString, field.TypeEnum:
 		size := c.Size
 		if size == 0 {
@@ -312,6 +345,9 @@ func (c *Column) SQLiteType() (t string) {
 		t = "real"
 	case field.TypeTime:
 		t = "datetime"
+		if c.DateOnly {
+			t = "date"
+		}
 	case field.TypeJSON:
 		t = "json"
 	default:
@@ -365,6 +401,16 @@ func (c *Column) ScanMySQL(rows *sql.Rows) error {
 		c.Type = field.TypeFloat64
 	case "timestamp", "datetime":
 		c.Type = field.TypeTime
+		if len(parts) == 2 { // timestamp(6).
+			precision, err := strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("converting timestamp precision to int: %v", err)
+			}
+			c.Precision = precision
+		}
+	case "date":
+		c.Type = field.TypeTime
+		c.DateOnly = true
 	case "tinyblob":
 		c.Size = math.MaxUint8
 		c.Type = field.TypeBytes
@@ -377,6 +423,11 @@ func (c *Column) ScanMySQL(rows *sql.Rows) error {
 	case "longblob":
 		c.Size = math.MaxUint32
 		c.Type = field.TypeBytes
+	case "binary":
+		// binary(16) is the column type of UUID fields.
+		if len(parts) == 2 && parts[1] == "16" {
+			c.Type = field.TypeUUID
+		}
 	case "varchar":
 		c.Type = field.TypeString
 		size, err := strconv.ParseInt(parts[1], 10, 64)
@@ -405,6 +456,21 @@ func (c *Column) ScanMySQL(rows *sql.Rows) error {
 // ConvertibleTo reports whether a column can be converted to the new column without altering its data.
 func (c *Column) ConvertibleTo(d *Column) bool {
 	switch {
+	case c.Type == field.TypeTime && d.Type == field.TypeTime:
+		// truncating the fractional seconds or the time part is lossy.
+		return c.Precision <= d.Precision && (c.DateOnly || !d.DateOnly)
+	case c.Type == field.TypeEnum && d.Type == field.TypeEnum:
+		// enum values can be added, but removing them is lossy.
+		values := make(map[string]bool, len(d.Enums))
+		for _, e := range d.Enums {
+			values[e] = true
+		}
+		for _, e := range c.Enums {
+			if !values[e] {
+				return false
+			}
+		}
+		return true
 	case c.Type == d.Type:
 		return c.Size <= d.Size
 	case c.IntType() && d.IntType() || c.UintType() && d.UintType():
@@ -416,6 +482,24 @@ func (c *Column) ConvertibleTo(d *Column) bool {
 	return c.FloatType() && d.FloatType()
 }
 
+// sameFamily reports whether the column types are of the same family (e.g. integers, floats, or
+// strings and enums). Lossy changes between types of the same family are applied by the migration
+// if the WithForce option is set, but changes between families are never applied.
+func (c *Column) sameFamily(d *Column) bool {
+	switch {
+	case c.Type == d.Type:
+		return true
+	case (c.IntType() || c.UintType()) && (d.IntType() || d.UintType()):
+		return true
+	case c.FloatType() && d.FloatType():
+		return true
+	}
+	return c.textType() && d.textType()
+}
+
+// textType reports whether the column is a string or an enum column.
+func (c Column) textType() bool { return c.Type == field.TypeString || c.Type == field.TypeEnum }
+
 // IntType reports whether the column is an int type (int8 ... int64).
 func (c Column) IntType() bool { return c.Type >= field.TypeInt8 && c.Type <= field.TypeInt64 }
 
@@ -523,9 +607,9 @@ func (c *Column) nullable(b *sql.ColumnBuilder) {
 // prefix key limit (767).
 func (c *Column) defaultSize(version string) int64 {
 	size := DefaultStringLen
-	switch {
-	// version is >= 5.7.
-	case compareVersions(version, "5.7.0") != -1:
+	switch v, ok := parseMariaDB(version); {
+	// version is >= 5.7, or MariaDB >= 10.2.2 (large index prefixes are enabled by default).
+	case ok && compareVersions(v, "10.2.2") != -1, !ok && compareVersions(version, "5.7.0") != -1:
 	// non-unique, or not part of any index (reaching the error 1071).
 	case !c.Unique && len(c.indexes) == 0:
 	default:
@@ -544,7 +628,9 @@ type ForeignKey struct {
 	OnDelete   ReferenceOption // action on delete.
 }
 
-// DSL returns a default DSL query for a foreign-key.
+// DSL returns a default DSL query for a foreign-key. A SET NULL delete action is omitted for non-nullable
+// columns (e.g. the unique columns of required O2O edges), since it's rejected by the databases (MySQL and
+// CockroachDB fail the creation of the constraint), and the default action (NO ACTION) is used instead.
 func (fk ForeignKey) DSL() *sql.ForeignKeyBuilder {
 	cols := make([]string, len(fk.Columns))
 	refs := make([]string, len(fk.RefColumns))
@@ -557,7 +643,7 @@ func (fk ForeignKey) DSL() *sql.ForeignKeyBuilder {
 	dsl := sql.ForeignKey().Symbol(fk.Symbol).
 		Columns(cols...).
 		Reference(sql.Reference().Table(fk.RefTable.Name).Columns(refs...))
-	if action := string(fk.OnDelete); action != "" {
+	if action := string(fk.OnDelete); action != "" && (fk.OnDelete != SetNull || fk.nullable()) {
 		dsl.OnDelete(action)
 	}
 	if action := string(fk.OnUpdate); action != "" {
@@ -566,6 +652,16 @@ func (fk ForeignKey) DSL() *sql.ForeignKeyBuilder {
 	return dsl
 }
 
+// nullable reports if all columns of the foreign-key are nullable.
+func (fk ForeignKey) nullable() bool {
+	for _, c := range fk.Columns {
+		if !c.Nullable {
+			return false
+		}
+	}
+	return true
+}
+
 // ReferenceOption for constraint actions.
 type ReferenceOption string
 
@@ -588,17 +684,20 @@ func (r ReferenceOption) ConstName() string {
 
 // Index definition for table index.
 type Index struct {
-	Name    string    // index name.
-	Unique  bool      // uniqueness.
-	Columns []*Column // actual table columns.
-	columns []string  // columns loaded from query scan.
+	Name     string         // index name.
+	Unique   bool           // uniqueness.
+	Type     string         // index type (e.g. BTREE, HASH or FULLTEXT). MySQL only.
+	Prefixes map[string]int // prefix lengths of the index columns (key parts). MySQL only.
+	Columns  []*Column      // actual table columns.
+	columns  []string       // columns loaded from query scan.
 }
 
 // Primary indicates if this index is a primary key.
 // Used by the migration tool when parsing the `DESCRIBE TABLE` output Go objects.
 func (i *Index) Primary() bool { return i.Name == "PRIMARY" }
 
-// Builder returns the query builder for index creation. The DSL is identical in all dialects.
+// Builder returns the query builder for index creation. The DSL is identical in all dialects,
+// and the dialect-specific options of the index (e.g. type or prefix lengths) are ignored.
 func (i *Index) Builder(table string) *sql.IndexBuilder {
 	idx := sql.CreateIndex(i.Name).Table(table)
 	if i.Unique {
@@ -610,6 +709,20 @@ func (i *Index) Builder(table string) *sql.IndexBuilder {
 	return idx
 }
 
+// MySQL returns the MySQL query builder for index creation, including its type and prefix lengths.
+func (i *Index) MySQL(table string) *sql.IndexBuilder {
+	idx := i.Builder(table)
+	if i.Type != "" {
+		idx.Type(i.Type)
+	}
+	for _, c := range i.Columns {
+		if n, ok := i.Prefixes[c.Name]; ok {
+			idx.Prefix(c.Name, n)
+		}
+	}
+	return idx
+}
+
 // DropBuilder returns the query builder for the drop index.
 func (i *Index) DropBuilder(table string) *sql.DropIndexBuilder {
 	idx := sql.DropIndex(i.Name).Table(table)
@@ -52,6 +52,37 @@ func TestColumn_ConvertibleTo(t *testing.T) {
 	require.False(t, c1.ConvertibleTo(&Column{Type: field.TypeUint16}))
 	require.False(t, c1.ConvertibleTo(&Column{Type: field.TypeUint32}))
 	require.False(t, c1.ConvertibleTo(&Column{Type: field.TypeString}))
+
+	c1 = &Column{Type: field.TypeTime, DateOnly: true}
+	require.True(t, c1.ConvertibleTo(&Column{Type: field.TypeTime}))
+	require.True(t, c1.ConvertibleTo(&Column{Type: field.TypeTime, Precision: 6}))
+	c1 = &Column{Type: field.TypeTime, Precision: 3}
+	require.True(t, c1.ConvertibleTo(&Column{Type: field.TypeTime, Precision: 6}))
+	require.False(t, c1.ConvertibleTo(&Column{Type: field.TypeTime}))
+	require.False(t, c1.ConvertibleTo(&Column{Type: field.TypeTime, DateOnly: true}))
+
+	c1 = &Column{Type: field.TypeEnum, Enums: []string{"a", "b"}}
+	require.True(t, c1.ConvertibleTo(&Column{Type: field.TypeEnum, Enums: []string{"b", "a"}}))
+	require.True(t, c1.ConvertibleTo(&Column{Type: field.TypeEnum, Enums: []string{"a", "b", "c"}}))
+	require.False(t, c1.ConvertibleTo(&Column{Type: field.TypeEnum, Enums: []string{"a", "c"}}))
+}
+
+func TestColumn_sameFamily(t *testing.T) {
+	c1 := &Column{Type: field.TypeInt64}
+	require.True(t, c1.sameFamily(&Column{Type: field.TypeInt8}))
+	require.True(t, c1.sameFamily(&Column{Type: field.TypeUint32}))
+	require.False(t, c1.sameFamily(&Column{Type: field.TypeFloat64}))
+	require.False(t, c1.sameFamily(&Column{Type: field.TypeString}))
+
+	c1 = &Column{Type: field.TypeString, Size: 255}
+	require.True(t, c1.sameFamily(&Column{Type: field.TypeString, Size: 100}))
+	require.True(t, c1.sameFamily(&Column{Type: field.TypeEnum, Enums: []string{"a"}}))
+	require.False(t, c1.sameFamily(&Column{Type: field.TypeTime}))
+	require.False(t, c1.sameFamily(&Column{Type: field.TypeBytes}))
+
+	c1 = &Column{Type: field.TypeFloat64}
+	require.True(t, c1.sameFamily(&Column{Type: field.TypeFloat32}))
+	require.False(t, c1.sameFamily(&Column{Type: field.TypeBool}))
 }
 
 func TestColumn_ScanDefault(t *testing.T) {
@@ -109,4 +140,41 @@ func TestColumn_MySQLType(t *testing.T) {
 	require.Equal(t, "json", c1.MySQLType("5.7.8-log"))
 	require.Equal(t, "longblob", c1.MySQLType("5.5"))
 	require.Equal(t, "longblob", c1.MySQLType("5.7"))
+
+	require.Equal(t, "binary(16)", (&Column{Type: field.TypeUUID}).MySQLType("5.7"))
+	require.Equal(t, "varchar(45)", (&Column{Type: field.TypeIP}).MySQLType("5.7"))
+	require.Equal(t, "varchar(64)", (&Column{Type: field.TypeMAC}).MySQLType("5.7"))
+	require.Equal(t, "blob", (&Column{Type: field.TypeUUID}).SQLiteType())
+}
+
+func TestColumn_TimeType(t *testing.T) {
+	c := &Column{Type: field.TypeTime}
+	require.Equal(t, "timestamp", c.MySQLType("5.7"))
+	require.Equal(t, "datetime", c.SQLiteType())
+	c = &Column{Type: field.TypeTime, Precision: 6}
+	require.Equal(t, "timestamp(6)", c.MySQLType("5.7"))
+	c = &Column{Type: field.TypeTime, DateOnly: true}
+	require.Equal(t, "date", c.MySQLType("5.7"))
+	require.Equal(t, "date", c.SQLiteType())
+}
+
+func TestForeignKey_DSL(t *testing.T) {
+	users := &Table{Name: "users", PrimaryKey: []*Column{{Name: "id", Type: field.TypeInt}}}
+	fk := &ForeignKey{
+		Symbol:     "cards_users_card",
+		Columns:    []*Column{{Name: "user_card", Type: field.TypeInt, Unique: true, Nullable: true}},
+		RefTable:   users,
+		RefColumns: users.PrimaryKey,
+		OnDelete:   SetNull,
+	}
+	query, _ := fk.DSL().Query()
+	require.Equal(t, "`cards_users_card` FOREIGN KEY(`user_card`) REFERENCES `users`(`id`) ON DELETE SET NULL", query)
+
+	fk.Columns[0].Nullable = false
+	query, _ = fk.DSL().Query()
+	require.Equal(t, "`cards_users_card` FOREIGN KEY(`user_card`) REFERENCES `users`(`id`)", query, "SET NULL is omitted for non-nullable columns")
+
+	fk.OnDelete = Cascade
+	query, _ = fk.DSL().Query()
+	require.Equal(t, "`cards_users_card` FOREIGN KEY(`user_card`) REFERENCES `users`(`id`) ON DELETE CASCADE", query)
 }
@@ -61,10 +61,40 @@ func (d *SQLite) setRange(ctx context.Context, tx dialect.Tx, name string, value
 	return tx.Exec(ctx, query, args, new(sql.Result))
 }
 
-func (*SQLite) cType(c *Column) string                { return c.SQLiteType() }
-func (*SQLite) tBuilder(t *Table) *sql.TableBuilder   { return t.SQLite() }
-func (*SQLite) cBuilder(c *Column) *sql.ColumnBuilder { return c.SQLite() }
+// columns loads the columns of the given table from the database.
+func (d *SQLite) columns(ctx context.Context, tx dialect.Tx, name string) ([]*Column, error) {
+	rows := &sql.Rows{}
+	if err := tx.Query(ctx, fmt.Sprintf("PRAGMA table_info(`%s`)", name), []interface{}{}, rows); err != nil {
+		return nil, fmt.Errorf("sqlite: reading table description %v", err)
+	}
+	defer rows.Close()
+	var columns []*Column
+	for rows.Next() {
+		var (
+			cid, notnull, pk int
+			defaults         sql.NullString
+			c                = &Column{}
+		)
+		if err := rows.Scan(&cid, &c.Name, &c.typ, &notnull, &defaults, &pk); err != nil {
+			return nil, fmt.Errorf("sqlite: scanning column description: %v", err)
+		}
+		c.Nullable = notnull == 0
+		if pk > 0 {
+			c.Key = PrimaryKey
+		}
+		columns = append(columns, c)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, fmt.Errorf("sqlite: closing rows %v", err)
+	}
+	return columns, nil
+}
+
+func (*SQLite) cType(c *Column) string                        { return c.SQLiteType() }
+func (*SQLite) tBuilder(t *Table) *sql.TableBuilder           { return t.SQLite() }
+func (*SQLite) cBuilder(c *Column) *sql.ColumnBuilder         { return c.SQLite() }
+func (*SQLite) iBuilder(i *Index, t string) *sql.IndexBuilder { return i.Builder(t) }
 
 // fkExist returns always tru to disable foreign-keys creation after the table was created.
-func (d *SQLite) fkExist(context.Context, dialect.Tx, string) (bool, error) { return true, nil }
+func (d *SQLite) fkExist(context.Context, dialect.Tx, string, string) (bool, error) { return true, nil }
 func (d *SQLite) table(context.Context, dialect.Tx, string) (*Table, error) { return nil, nil }
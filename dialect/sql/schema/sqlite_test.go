@@ -6,8 +6,10 @@ package schema
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/schema/field"
 
@@ -227,6 +229,64 @@ func TestSQLite_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "hooks",
+			tables: []*Table{
+				{Name: "users", Columns: []*Column{{Name: "id", Type: field.TypeInt, Increment: true}}},
+				{Name: "pets", Columns: []*Column{{Name: "id", Type: field.TypeInt, Increment: true}}},
+			},
+			options: []MigrateOption{
+				WithHooks(
+					func(next Creator) Creator {
+						return CreateFunc(func(ctx context.Context, tx dialect.Tx, tables ...*Table) error {
+							if err := next.Create(ctx, tx, tables...); err != nil {
+								return err
+							}
+							return tx.Exec(ctx, "CREATE TRIGGER `users_audit`", []interface{}{}, new(sql.Result))
+						})
+					},
+					// skip the creation of the "pets" table.
+					func(next Creator) Creator {
+						return CreateFunc(func(ctx context.Context, tx dialect.Tx, tables ...*Table) error {
+							return next.Create(ctx, tx, tables[0])
+						})
+					},
+				),
+			},
+			before: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("PRAGMA foreign_keys").
+					WillReturnRows(sqlmock.NewRows([]string{"foreign_keys"}).AddRow(1))
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+					WithArgs("table", "users").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec(escape("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("CREATE TRIGGER `users_audit`")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "hook failed",
+			tables: []*Table{
+				{Name: "users", Columns: []*Column{{Name: "id", Type: field.TypeInt, Increment: true}}},
+			},
+			options: []MigrateOption{
+				WithHooks(func(Creator) Creator {
+					return CreateFunc(func(context.Context, dialect.Tx, ...*Table) error {
+						return errors.New("backfill failed")
+					})
+				}),
+			},
+			before: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("PRAGMA foreign_keys").
+					WillReturnRows(sqlmock.NewRows([]string{"foreign_keys"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -237,6 +297,125 @@ func TestSQLite_Create(t *testing.T) {
 			require.NoError(t, err)
 			err = migrate.Create(context.Background(), tt.tables...)
 			require.Equal(t, tt.wantErr, err != nil, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLite_Verify(t *testing.T) {
+	users := &Table{
+		Name:       "users",
+		PrimaryKey: []*Column{{Name: "id", Type: field.TypeInt, Increment: true}},
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "name", Type: field.TypeString, Nullable: true},
+			{Name: "age", Type: field.TypeInt},
+			{Name: "doc", Type: field.TypeJSON, Nullable: true},
+		},
+	}
+	pets := NewTable("pets").AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true})
+	columns := []string{"cid", "name", "type", "notnull", "dflt_value", "pk"}
+	tests := []struct {
+		name    string
+		options []MigrateOption
+		before  func(sqlmock.Sqlmock)
+		drifts  []Drift
+	}{
+		{
+			name: "no drift",
+			before: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+					WithArgs("table", "users").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(escape("PRAGMA table_info(`users`)")).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(0, "id", "integer", 1, nil, 1).
+						AddRow(1, "name", "varchar(255)", 0, nil, 0).
+						AddRow(2, "age", "INTEGER", 1, nil, 0).
+						AddRow(3, "doc", "json", 0, nil, 0).
+						AddRow(4, "old", "bool", 0, nil, 0))
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+					WithArgs("table", "pets").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(escape("PRAGMA table_info(`pets`)")).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(0, "id", "integer", 1, nil, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "drift",
+			options: []MigrateOption{WithVersion("v2")},
+			before: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+					WithArgs("table", VersionTable).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(escape("SELECT * FROM `ent_versions` ORDER BY `id` DESC")).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "version", "phase"}).AddRow(1, "v1", nil))
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+					WithArgs("table", "users").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(escape("PRAGMA table_info(`users`)")).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(0, "id", "integer", 1, nil, 1).
+						AddRow(1, "name", "varchar(255)", 1, nil, 0).
+						AddRow(2, "age", "varchar(255)", 1, nil, 0))
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+					WithArgs("table", "pets").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectCommit()
+			},
+			drifts: []Drift{
+				{Table: VersionTable, Desc: `recorded version "v1" does not match "v2"`},
+				{Table: "users", Column: "name", Desc: "mismatched nullability (nullable=false, expected true)"},
+				{Table: "users", Column: "age", Desc: "mismatched type varchar(255) (expected integer)"},
+				{Table: "users", Column: "doc", Desc: "missing column"},
+				{Table: "pets", Desc: "missing table"},
+			},
+		},
+		{
+			name:    "expanded version",
+			options: []MigrateOption{WithVersion("v1")},
+			before: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+					WithArgs("table", VersionTable).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(escape("SELECT * FROM `ent_versions` ORDER BY `id` DESC")).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "version", "phase"}).
+						AddRow(2, "v2", "expand").
+						AddRow(1, "v1", nil))
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+					WithArgs("table", "users").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+					WithArgs("table", "pets").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(escape("PRAGMA table_info(`pets`)")).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(0, "id", "integer", 1, nil, 1))
+				mock.ExpectCommit()
+			},
+			drifts: []Drift{
+				{Table: "users", Desc: "missing table"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.before(mock)
+			migrate, err := NewMigrate(sql.OpenDB("sqlite3", db), tt.options...)
+			require.NoError(t, err)
+			err = migrate.Verify(context.Background(), users, pets)
+			if tt.drifts == nil {
+				require.NoError(t, err)
+			} else {
+				require.IsType(t, &DriftError{}, err)
+				require.Equal(t, tt.drifts, err.(*DriftError).Drifts)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
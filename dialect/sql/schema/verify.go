@@ -0,0 +1,121 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+)
+
+// Drift describes a difference between the expected schema and the schema of the database.
+type Drift struct {
+	Table  string // table name.
+	Column string // column name. Empty for table drifts.
+	Desc   string // description of the drift.
+}
+
+// String implements the fmt.Stringer interface.
+func (d Drift) String() string {
+	if d.Column == "" {
+		return fmt.Sprintf("table %q: %s", d.Table, d.Desc)
+	}
+	return fmt.Sprintf("column %q of table %q: %s", d.Column, d.Table, d.Desc)
+}
+
+// DriftError is returned by Verify when the schema of the database drifted from the expected one.
+type DriftError struct {
+	Drifts []Drift
+}
+
+// Error implements the error interface.
+func (e *DriftError) Error() string {
+	drifts := make([]string, len(e.Drifts))
+	for i := range e.Drifts {
+		drifts[i] = e.Drifts[i].String()
+	}
+	return fmt.Sprintf("sql/schema: schema drift detected: %s", strings.Join(drifts, "; "))
+}
+
+// Verify verifies that the schema of the database matches the given tables, without changing it.
+// It returns a *DriftError that describes the differences (e.g. missing tables or columns, or columns
+// with mismatched types) if the database drifted from the expected schema. If the WithVersion option
+// was provided, it is checked to be one of the versions that are compatible with the database as well
+// (see Versions).
+//
+// Note that, columns that exist in the database and were removed from the schema are not reported,
+// since they are not dropped by the migration by default (see WithDropColumn).
+func (m *Migrate) Verify(ctx context.Context, tables ...*Table) error {
+	tx, err := m.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	var drifts []Drift
+	if m.version != "" {
+		versions, err := m.recordedVersions(ctx, tx)
+		if err != nil {
+			return rollback(tx, err)
+		}
+		if len(versions) > 0 && !compatible(versions, m.version) {
+			drifts = append(drifts, Drift{Table: VersionTable, Desc: fmt.Sprintf("recorded version %q does not match %q", versions[0], m.version)})
+		}
+	}
+	for _, t := range tables {
+		t.setup()
+		exist, err := m.tableExist(ctx, tx, t.Name)
+		if err != nil {
+			return rollback(tx, err)
+		}
+		if !exist {
+			drifts = append(drifts, Drift{Table: t.Name, Desc: "missing table"})
+			continue
+		}
+		columns, err := m.columns(ctx, tx, t.Name)
+		if err != nil {
+			return rollback(tx, err)
+		}
+		drifts = append(drifts, m.columnDrifts(t, columns)...)
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	if len(drifts) > 0 {
+		return &DriftError{Drifts: drifts}
+	}
+	return nil
+}
+
+// compatible reports if the given version is one of the compatible versions.
+func compatible(versions []string, version string) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// columnDrifts returns the drifts between the columns of the given table and the columns
+// that were loaded from the database. The types of the loaded columns are in their raw form.
+func (m *Migrate) columnDrifts(t *Table, columns []*Column) []Drift {
+	var drifts []Drift
+	curr := make(map[string]*Column, len(columns))
+	for _, c := range columns {
+		curr[c.Name] = c
+	}
+	for _, c1 := range t.Columns {
+		c2, ok := curr[c1.Name]
+		switch {
+		case !ok:
+			drifts = append(drifts, Drift{Table: t.Name, Column: c1.Name, Desc: "missing column"})
+		case !strings.EqualFold(m.cType(c1), c2.typ):
+			drifts = append(drifts, Drift{Table: t.Name, Column: c1.Name, Desc: fmt.Sprintf("mismatched type %s (expected %s)", c2.typ, m.cType(c1))})
+		case c1.Nullable != c2.Nullable && !c1.PrimaryKey():
+			drifts = append(drifts, Drift{Table: t.Name, Column: c1.Name, Desc: fmt.Sprintf("mismatched nullability (nullable=%t, expected %t)", c2.Nullable, c1.Nullable)})
+		}
+	}
+	return drifts
+}
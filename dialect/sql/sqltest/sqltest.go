@@ -0,0 +1,62 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqltest provides a SQL driver for testing the generated builders against the
+// expectations of sqlmock, without a database. The statements of the driver are matched
+// exactly (ignoring whitespace), instead of the regular expressions of sqlmock, in order
+// to avoid quoting the statements that are generated by the builders.
+//
+//	drv, mock, err := sqltest.New(dialect.MySQL)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	mock.ExpectQuery("SELECT DISTINCT `users`.`id`, `users`.`name` FROM `users` WHERE `users`.`name` = ?").
+//		WithArgs("a8m").
+//		WillReturnRows(sqltest.Rows([]string{"id", "name"}, []driver.Value{1, "a8m"}))
+//	client := ent.NewClient(ent.Driver(drv))
+//
+package sqltest
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// QueryMatcher matches the expected and the actual statements exactly, after their whitespace
+// was normalized.
+var QueryMatcher = sqlmock.QueryMatcherFunc(func(expected, actual string) error {
+	if e, a := normalize(expected), normalize(actual); e != a {
+		return fmt.Errorf("sqltest: statement %q does not match the expected statement %q", a, e)
+	}
+	return nil
+})
+
+// New returns a new sql.Driver for the given dialect that is backed by sqlmock, and its
+// Sqlmock for setting up the expectations of the test.
+func New(dialect string) (*sql.Driver, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(QueryMatcher))
+	if err != nil {
+		return nil, nil, err
+	}
+	return sql.OpenDB(dialect, db), mock, nil
+}
+
+// Rows returns the sqlmock rows with the given columns and values.
+func Rows(columns []string, values ...[]driver.Value) *sqlmock.Rows {
+	rows := sqlmock.NewRows(columns)
+	for _, v := range values {
+		rows.AddRow(v...)
+	}
+	return rows
+}
+
+// normalize collapses the whitespace of the given statement.
+func normalize(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
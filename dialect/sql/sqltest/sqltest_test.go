@@ -0,0 +1,55 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqltest
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	drv, mock, err := New(dialect.MySQL)
+	require.NoError(t, err)
+	require.Equal(t, dialect.MySQL, drv.Dialect())
+
+	mock.ExpectQuery("SELECT `id`, `name` FROM `users` WHERE `age` > ?").
+		WithArgs(30).
+		WillReturnRows(Rows([]string{"id", "name"}, []driver.Value{1, "a8m"}, []driver.Value{2, "nati"}))
+	query, args := sql.Select("id", "name").
+		From(sql.Table("users")).
+		Where(sql.GT("age", 30)).
+		Query()
+	rows := &sql.Rows{}
+	require.NoError(t, drv.Query(context.Background(), query, args, rows))
+	var names []string
+	for rows.Next() {
+		var (
+			id   int
+			name string
+		)
+		require.NoError(t, rows.Scan(&id, &name))
+		names = append(names, name)
+	}
+	require.NoError(t, rows.Close())
+	require.Equal(t, []string{"a8m", "nati"}, names)
+
+	mock.ExpectExec("DELETE FROM `users`  WHERE `age` > ?").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	var res sql.Result
+	err = drv.Exec(context.Background(), "DELETE FROM `users` WHERE `age` > ?", []interface{}{30}, &res)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectExec("DELETE FROM `users`")
+	err = drv.Exec(context.Background(), "DELETE FROM `pets`", []interface{}{}, &res)
+	require.Error(t, err, "statement should not match the prefix of the expectation")
+}
@@ -0,0 +1,41 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"time"
+)
+
+// timeoutKey is the context key of the statements timeout.
+type timeoutKey struct{}
+
+// WithTimeout returns a copy of the context that limits the execution time of each statement that
+// is executed with it. Unlike context.WithTimeout, that limits the whole operation (e.g. a mutation
+// that executes several statements), the timeout is applied on each statement separately. Rows of
+// queries that exceeded their timeout are closed, and in MySQL, the statements are also killed on
+// the server when the driver was created with WithKillQuery.
+//
+//	ctx = sql.WithTimeout(ctx, 5*time.Second)
+//	users, err := client.User.Query().All(ctx)
+//
+func WithTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey{}, d)
+}
+
+// TimeoutFromContext returns the statements timeout of the context, if it was set using WithTimeout.
+func TimeoutFromContext(ctx context.Context) (time.Duration, bool) {
+	d, ok := ctx.Value(timeoutKey{}).(time.Duration)
+	return d, ok && d > 0
+}
+
+// stmtContext returns the context for executing a statement, and the function that releases
+// its resources. The returned function must be called when the statement (or its rows) is done.
+func stmtContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if d, ok := TimeoutFromContext(ctx); ok {
+		return context.WithTimeout(ctx, d)
+	}
+	return ctx, func() {}
+}
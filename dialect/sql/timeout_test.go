@@ -0,0 +1,87 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTimeout(t *testing.T) {
+	ctx := context.Background()
+	_, ok := TimeoutFromContext(ctx)
+	require.False(t, ok)
+	d, ok := TimeoutFromContext(WithTimeout(ctx, time.Second))
+	require.True(t, ok)
+	require.Equal(t, time.Second, d)
+	_, ok = TimeoutFromContext(WithTimeout(ctx, 0))
+	require.False(t, ok, "zero timeout is ignored")
+}
+
+func TestDriver_Timeout(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := OpenDB(dialect.SQLite, db)
+	ctx := WithTimeout(context.Background(), 10*time.Millisecond)
+
+	t.Log("statements that exceeded their timeout")
+	mock.ExpectExec("UPDATE `users`").
+		WillDelayFor(time.Second).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	var res Result
+	err = drv.Exec(ctx, "UPDATE `users` SET `age` = 1", []interface{}{}, &res)
+	require.Error(t, err)
+	mock.ExpectQuery("SELECT `id` FROM `users`").
+		WillDelayFor(time.Second).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	rows := &Rows{}
+	err = drv.Query(ctx, "SELECT `id` FROM `users`", []interface{}{}, rows)
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	t.Log("the timeout is applied on each statement")
+	for i := 0; i < 3; i++ {
+		mock.ExpectExec("UPDATE `users`").
+			WillDelayFor(5 * time.Millisecond).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		err = drv.Exec(ctx, "UPDATE `users` SET `age` = 1", []interface{}{}, &res)
+		require.NoError(t, err)
+	}
+	mock.ExpectQuery("SELECT `id` FROM `users`").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	err = drv.Query(ctx, "SELECT `id` FROM `users`", []interface{}{}, rows)
+	require.NoError(t, err)
+	require.NotNil(t, rows.release)
+	var ids []int
+	require.NoError(t, ScanSlice(rows, &ids))
+	require.Equal(t, []int{1, 2}, ids)
+	require.NoError(t, rows.Close())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDriver_TimeoutKillQuery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.MatchExpectationsInOrder(false)
+	drv := OpenDB(dialect.MySQL, db).WithKillQuery()
+	ctx := WithTimeout(context.Background(), 10*time.Millisecond)
+	mock.ExpectQuery("SELECT CONNECTION_ID()").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	mock.ExpectExec("UPDATE `users`").
+		WillDelayFor(time.Second).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("KILL QUERY 10").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	var res Result
+	err = drv.Exec(ctx, "UPDATE `users` SET `age` = 1", []interface{}{}, &res)
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -47,6 +47,9 @@ type (
 		// Mixin returns an optional list of Mixin to extends
 		// the schema.
 		Mixin() []Mixin
+		// Validators returns an optional list of validators
+		// for the mutations of the schema.
+		Validators() []Validator
 	}
 
 	// A Field interface returns a field descriptor for vertex fields/properties.
@@ -101,6 +104,109 @@ type (
 	Config struct {
 		// A Table is an optional table name defined for the schema.
 		Table string
+		// A Schema is an optional database (schema) name for the table of the
+		// schema. If it's set, the table is qualified with the schema name in
+		// the generated queries and in the migration (e.g. "billing.users").
+		// Note that, it's supported only by MySQL.
+		Schema string
+		// ReadOnly indicates that the schema is backed by a read-only storage
+		// (e.g. a SQL view or an external table). Only the query builders are
+		// generated for read-only schemas, and their tables are not migrated.
+		//
+		//	func (UserStats) Config() ent.Config {
+		//		return ent.Config{
+		//			Table:    "user_stats_view",
+		//			ReadOnly: true,
+		//		}
+		//	}
+		//
+		ReadOnly bool
+		// SkipMigration indicates that the table of the schema is managed outside
+		// of the migration (e.g. owned by another service, or managed by DBAs).
+		// Unlike ReadOnly schemas, all builders are generated for the schema, but
+		// its table is not created nor changed by the migration.
+		//
+		//	func (Account) Config() ent.Config {
+		//		return ent.Config{
+		//			Table:         "billing_accounts",
+		//			SkipMigration: true,
+		//		}
+		//	}
+		//
+		SkipMigration bool
+		// Order is an optional default order of the schema queries. It's applied
+		// on the queries (and the edge traversals) that were not ordered explicitly
+		// using their Order method. For example, sorting users by their creation
+		// time, and then by their names:
+		//
+		//	func (User) Config() ent.Config {
+		//		return ent.Config{
+		//			Order: []ent.OrderField{
+		//				{Field: "created_at", Desc: true},
+		//				{Field: "name"},
+		//			},
+		//		}
+		//	}
+		//
+		Order []OrderField
+		// ColumnOrder is an optional order of the table columns, that is used by the migration
+		// and by the Columns slice of the generated package. It holds the column names of the
+		// id, the fields (their storage keys) and the foreign keys of the edges, and columns
+		// that are not listed are placed after the listed ones, in their default order (the id,
+		// the fields in their definition order, and then the foreign keys). For example, keeping
+		// the positions of the foreign-key columns when new fields are added to the schema:
+		//
+		//	func (Pet) Config() ent.Config {
+		//		return ent.Config{
+		//			ColumnOrder: []string{"id", "name", "owner_id"},
+		//		}
+		//	}
+		//
+		ColumnOrder []string
+		// Scopes is an optional list of named scopes of the schema queries. Each scope is generated
+		// as a function of the schema package (e.g. user.Active), that returns a scope that can be
+		// applied on the queries using their Scope method. For example:
+		//
+		//	func (User) Config() ent.Config {
+		//		return ent.Config{
+		//			Scopes: []ent.Scope{
+		//				{Name: "active", Where: map[string]interface{}{"active": true}},
+		//				{Name: "newest", Order: []ent.OrderField{{Field: "created_at", Desc: true}}, Limit: 10},
+		//			},
+		//		}
+		//	}
+		//
+		//	users, err := client.User.Query().Scope(user.Active(), user.Newest()).All(ctx)
+		//
+		Scopes []Scope
+	}
+
+	// OrderField is a field in the default order of a schema. The "id" field
+	// can be used for ordering the schema queries by their ids.
+	OrderField struct {
+		// Field is the name of the ordered field.
+		Field string
+		// Desc indicates if the order of the field is descending.
+		Desc bool
+	}
+
+	// Scope is a named scope of the schema queries. It bundles predicates, orders
+	// and a limit in one place, and it's declared using the Scopes option of the
+	// schema config.
+	Scope struct {
+		// Name is the name of the scope. Its PascalCase form is used as
+		// the name of its function in the generated package.
+		Name string
+		// Where holds the predicates of the scope, in the format of the FilterMap function
+		// of the generated package. That is, the keys are storage keys of fields with an
+		// optional operator suffix (e.g. "age.gte"), and the values are their operands.
+		Where map[string]interface{}
+		// Order holds the orders of the scope, that are added after the
+		// orders of the queries that the scope is applied on.
+		Order []OrderField
+		// Limit is an optional limit of the scope. If it's not zero, it
+		// replaces the limit of the queries that the scope is applied on.
+		Limit int
 	}
 
 	// The Mixin type describes a set of methods that can extend
@@ -135,6 +241,152 @@ type (
 		Fields() []Field
 	}
 
+	// A Validator is a schema-level validator for invariants that span multiple fields. It's called
+	// by the create, update and upsert builders with their mutation in Save, after the field validators.
+	// Note that, the mutation of an update builder holds only the fields that are set in it, and the
+	// mutation of an upsert builder is a creation (OpCreate) of the fields that are set in it.
+	//
+	//	func (Event) Validators() []ent.Validator {
+	//		return []ent.Validator{
+	//			func(m ent.Mutation) error {
+	//				start, ok1 := m.Field("start_date")
+	//				end, ok2 := m.Field("end_date")
+	//				if ok1 && ok2 && !start.(time.Time).Before(end.(time.Time)) {
+	//					return errors.New("start_date must be before end_date")
+	//				}
+	//				return nil
+	//			},
+	//		}
+	//	}
+	//
+	Validator func(Mutation) error
+
+	// Value represents a value of a field or an edge id in a Mutation.
+	Value interface{}
+
+	// Mutation represents an operation that mutates the graph. The generated
+	// builders expose their mutation using the Mutation method, in order to allow
+	// generic code (e.g. middleware) to operate on the builders of all types.
+	//
+	//	func Normalize(m ent.Mutation) error {
+	//		if v, ok := m.Field("name"); ok {
+	//			return m.SetField("name", strings.TrimSpace(v.(string)))
+	//		}
+	//		return nil
+	//	}
+	//
+	Mutation interface {
+		// Op returns the operation of the mutation.
+		Op() Op
+		// Type returns the schema type name of the mutation (e.g. "User").
+		Type() string
+		// Fields returns the names of the fields that were set in the mutation.
+		Fields() []string
+		// Field returns the value of the field with the given name. The second
+		// value reports if the field was set in the mutation.
+		Field(name string) (Value, bool)
+		// SetField sets the value of the field with the given name. It fails if the
+		// field is not defined in the schema, or if the value type does not match it.
+		SetField(name string, value Value) error
+		// ClearedFields returns the names of the nullable fields that were cleared.
+		ClearedFields() []string
+		// AddedEdges returns the names of the edges that were added in the mutation.
+		AddedEdges() []string
+		// AddedIDs returns the ids that were added to the edge with the given name.
+		AddedIDs(name string) []Value
+		// RemovedEdges returns the names of the edges that ids were removed from.
+		RemovedEdges() []string
+		// ClearedEdges returns the names of the edges that were cleared.
+		ClearedEdges() []string
+	}
+
+	// Op is the operation of a Mutation.
+	Op string
+
+	// CallOption configures a single call of the Save, Exec or All methods of the generated
+	// builders, without affecting other calls of the builder or the client.
+	//
+	//	_, err := client.User.Create().
+	//		SetName("a8m").
+	//		Save(ctx, ent.WithValidationOnly())
+	//
+	CallOption func(*CallOptions)
+
+	// CallOptions holds the options of a single builder call. The generated
+	// code resolves them from the given list using NewCallOptions.
+	CallOptions struct {
+		// ValidationOnly indicates that the mutation runs its defaults, transformers, validators
+		// and the checks of its required fields and edges, but it's not applied to the storage.
+		ValidationOnly bool
+		// SkipHooks indicates that the hooks of the call are not executed. That is, the change
+		// events of mutations are not published to the watchers of the node type, and the results
+		// of queries are not compared with the shadow storage in dual-write mode.
+		SkipHooks bool
+	}
+
+	// TypeDescriptor describes a generated node type at runtime. The generated packages of the types
+	// return their descriptor using the Descriptor function, in order to allow generic code (e.g. admin
+	// tooling, form builders or API bridges) to operate on all types without using their schemas.
+	//
+	//	for _, f := range user.Descriptor().Fields {
+	//		fmt.Println(f.Name, f.Type, f.Optional)
+	//	}
+	//
+	// Descriptors are shared by all callers, and they must not be modified.
+	TypeDescriptor struct {
+		// Name is the schema type name (e.g. "User").
+		Name string
+		// Label is the label of the type in the database (e.g. its table name in SQL).
+		Label string
+		// ID describes the id field of the type.
+		ID *FieldDescriptor
+		// Fields describes the fields of the type (excluding the id), in their schema order.
+		Fields []*FieldDescriptor
+		// Edges describes the edges of the type, in their schema order.
+		Edges []*EdgeDescriptor
+	}
+
+	// FieldDescriptor describes a field of a generated node type.
+	FieldDescriptor struct {
+		// Name is the name of the field in the schema.
+		Name string
+		// StorageKey is the name of the field in the database (i.e. its column or property name).
+		StorageKey string
+		// Type is the schema type of the field.
+		Type field.Type
+		// GoType is the Go type of the field in the generated code (e.g. "string" or "time.Time").
+		// Nillable fields are stored in pointers of this type in the generated entities.
+		GoType string
+		// Enums holds the values of enum fields.
+		Enums []string
+		// Unique, Optional, Nillable, Immutable and Sensitive report the
+		// modifiers of the field, as defined in the schema.
+		Unique, Optional, Nillable, Immutable, Sensitive bool
+		// Default reports if the field has a default value on creation.
+		Default bool
+		// Validator runs the validators of the field (and the check of the enum values) on the given
+		// value, and it fails if the type of the value is not the field type. It's nil if the field
+		// has no validators.
+		Validator func(Value) error
+	}
+
+	// EdgeDescriptor describes an edge of a generated node type.
+	EdgeDescriptor struct {
+		// Name is the name of the edge in the schema.
+		Name string
+		// Type is the schema type name of the edge (e.g. "Pet").
+		Type string
+		// Rel is the relation type of the edge (i.e. O2O, O2M, M2O or M2M).
+		Rel string
+		// Unique, Optional and Inverse report the modifiers of the
+		// edge, and if it's an inverse edge (edge.From).
+		Unique, Optional, Inverse bool
+		// Table and Columns are the SQL table and columns that hold the relation of the edge.
+		// That is, the foreign-key column of O2O, O2M and M2O edges, or the join table of M2M edges.
+		Table   string
+		Columns []string
+	}
+
 	// Schema is the default implementation for the schema Interface.
 	// It can be embedded in end-user schemas as follows:
 	//
@@ -147,6 +399,39 @@ type (
 	}
 )
 
+// Mutation operations.
+const (
+	OpCreate    Op = "create"     // node creation.
+	OpUpdate    Op = "update"     // update nodes by predicates.
+	OpUpdateOne Op = "update_one" // update a single node.
+	OpDelete    Op = "delete"     // delete nodes by predicates.
+	OpDeleteOne Op = "delete_one" // delete a single node.
+)
+
+// NewCallOptions returns the call options that are configured by the given list.
+func NewCallOptions(opts ...CallOption) *CallOptions {
+	o := &CallOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithValidationOnly is a call option for validating mutations without applying them.
+// The Save method of the validated builder returns a nil node (or 0) on success.
+func WithValidationOnly() CallOption {
+	return func(o *CallOptions) {
+		o.ValidationOnly = true
+	}
+}
+
+// WithoutHooks is a call option for skipping the hooks of the call (see CallOptions).
+func WithoutHooks() CallOption {
+	return func(o *CallOptions) {
+		o.SkipHooks = true
+	}
+}
+
 // Fields of the schema.
 func (Schema) Fields() []Field { return nil }
 
@@ -161,3 +446,6 @@ func (Schema) Config() Config { return Config{} }
 
 // Mixin of the schema.
 func (Schema) Mixin() []Mixin { return nil }
+
+// Validators of the schema.
+func (Schema) Validators() []Validator { return nil }
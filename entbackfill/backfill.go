@@ -0,0 +1,160 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entbackfill provides a helper for running data backfills over
+// entities in batches, that usually accompany schema changes.
+//
+//	err := entbackfill.Run(ctx, client.User.Query().Where(user.NicknameIsNil()), 100,
+//		func(ctx context.Context, b *entbackfill.Batch) error {
+//			...
+//		},
+//		entbackfill.WithInterval(time.Second),
+//	)
+//
+package entbackfill
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Query is the interface that wraps the methods of the generated query builders that
+// are used for estimating the number of rows and loading the ids of the next batch.
+type Query interface {
+	// Count returns the number of entities that match the query.
+	Count(context.Context) (int, error)
+	// BatchIDs returns the ids of up to limit entities that come after the given id,
+	// or from the start if it is nil, ordered by their ids.
+	BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error)
+}
+
+// Batch holds the information of a batch that is passed to the backfill function.
+type Batch struct {
+	// Index is the index of the batch, starting from 0.
+	Index int
+	// IDs holds the ids of the entities in the batch, ordered ascending.
+	IDs []interface{}
+}
+
+// Progress describes the progress of the backfill and it is reported after each batch.
+type Progress struct {
+	// Batches is the number of batches that were processed.
+	Batches int
+	// Done is the number of entities that were processed.
+	Done int
+	// Total is the estimated number of entities, counted when the backfill started.
+	// Note that it's an estimation, because the table can be changed during the backfill.
+	Total int
+	// Elapsed is the time since the backfill started.
+	Elapsed time.Duration
+}
+
+// Percent returns the estimated completion in percentages.
+func (p Progress) Percent() float64 {
+	if p.Total == 0 {
+		return 100
+	}
+	if p.Done >= p.Total {
+		return 100
+	}
+	return float64(p.Done) / float64(p.Total) * 100
+}
+
+// Option allows configuring the backfill using functional options.
+type Option func(*backfill)
+
+// WithProgress sets the function that is called with the progress after each batch.
+func WithProgress(f func(Progress)) Option {
+	return func(b *backfill) {
+		b.progress = f
+	}
+}
+
+// WithInterval sets the minimum duration between the start of two consecutive batches.
+// It's used for rate limiting the backfill, in order to reduce the load on the database.
+func WithInterval(d time.Duration) Option {
+	return func(b *backfill) {
+		b.interval = d
+	}
+}
+
+// WithoutEstimation disables the row estimation (the count query) at the start of the backfill.
+func WithoutEstimation() Option {
+	return func(b *backfill) {
+		b.noestimate = true
+	}
+}
+
+type backfill struct {
+	interval   time.Duration
+	noestimate bool
+	progress   func(Progress)
+}
+
+// Run iterates the entities that match the given query in batches of batchSize, ordered
+// by their ids, and calls fn with each batch. It stops on the first error returned by fn,
+// or when the context is done.
+func Run(ctx context.Context, query Query, batchSize int, fn func(context.Context, *Batch) error, opts ...Option) error {
+	if batchSize <= 0 {
+		return errors.New("entbackfill: batch size must be positive")
+	}
+	b := &backfill{}
+	for _, opt := range opts {
+		opt(b)
+	}
+	var (
+		err   error
+		after interface{}
+		p     = Progress{}
+		start = time.Now()
+	)
+	if !b.noestimate {
+		if p.Total, err = query.Count(ctx); err != nil {
+			return err
+		}
+	}
+	for next := start; ; {
+		if err := wait(ctx, next); err != nil {
+			return err
+		}
+		next = time.Now().Add(b.interval)
+		ids, err := query.BatchIDs(ctx, after, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := fn(ctx, &Batch{Index: p.Batches, IDs: ids}); err != nil {
+			return err
+		}
+		after = ids[len(ids)-1]
+		p.Batches++
+		p.Done += len(ids)
+		p.Elapsed = time.Since(start)
+		if b.progress != nil {
+			b.progress(p)
+		}
+		if len(ids) < batchSize {
+			return nil
+		}
+	}
+}
+
+// wait blocks until the given time, or until the context is done.
+func wait(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entbackfill
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type query []int
+
+func (q query) Count(context.Context) (int, error) { return len(q), nil }
+
+func (q query) BatchIDs(_ context.Context, after interface{}, limit int) ([]interface{}, error) {
+	var ids []interface{}
+	for _, id := range q {
+		if (after == nil || id > after.(int)) && len(ids) < limit {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func TestRun(t *testing.T) {
+	var (
+		ids      []interface{}
+		progress []Progress
+		ctx      = context.Background()
+	)
+	err := Run(ctx, query{1, 2, 3, 4, 5}, 2, func(_ context.Context, b *Batch) error {
+		require.Equal(t, len(progress), b.Index)
+		ids = append(ids, b.IDs...)
+		return nil
+	}, WithProgress(func(p Progress) { progress = append(progress, p) }))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{1, 2, 3, 4, 5}, ids)
+	require.Len(t, progress, 3)
+	require.Equal(t, 5, progress[2].Total)
+	require.Equal(t, 5, progress[2].Done)
+	require.Equal(t, 3, progress[2].Batches)
+	require.Equal(t, float64(40), progress[0].Percent())
+	require.Equal(t, float64(100), progress[2].Percent())
+
+	err = Run(ctx, query{1, 2, 3}, 1, func(context.Context, *Batch) error {
+		return errors.New("boring")
+	})
+	require.EqualError(t, err, "boring")
+	require.Error(t, Run(ctx, query{1}, 0, nil))
+}
+
+func TestRun_Interval(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var n int
+	err := Run(ctx, query{1, 2, 3}, 1, func(context.Context, *Batch) error {
+		n++
+		return nil
+	}, WithInterval(time.Minute), WithoutEstimation())
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.Equal(t, 1, n)
+}
@@ -6,6 +6,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -61,24 +62,43 @@ func main() {
 			cmd.Flags().StringVar(&path, "target", "ent/schema", "target directory for schemas")
 			return cmd
 		}(),
-		&cobra.Command{
-			Use:   "describe [flags] path",
-			Short: "print a description of the graph schema",
-			Example: examples(
-				"entc describe ./ent/schema",
-				"entc describe github.com/a8m/x",
-			),
-			Args: cobra.ExactArgs(1),
-			Run: func(cmd *cobra.Command, path []string) {
-				graph, err := loadGraph(path[0], gen.Config{})
-				failOnErr(err)
-				graph.Describe(os.Stdout)
-			},
-		},
+		func() *cobra.Command {
+			var (
+				asJSON bool
+				cmd    = &cobra.Command{
+					Use:   "describe [flags] path",
+					Short: "print a description of the graph schema",
+					Example: examples(
+						"entc describe ./ent/schema",
+						"entc describe github.com/a8m/x",
+						"entc describe --json ./ent/schema",
+					),
+					Args: cobra.ExactArgs(1),
+					Run: func(cmd *cobra.Command, path []string) {
+						// the snapshot uses the defaults of the generate command.
+						storage, err := gen.NewStorage("sql")
+						failOnErr(err)
+						graph, err := loadGraph(path[0], gen.Config{Storage: []*gen.Storage{storage}, IDType: &field.TypeInfo{Type: field.TypeInt}})
+						failOnErr(err)
+						if !asJSON {
+							graph.Describe(os.Stdout)
+							return
+						}
+						enc := json.NewEncoder(os.Stdout)
+						enc.SetIndent("", "  ")
+						failOnErr(enc.Encode(graph.Snapshot()))
+					},
+				}
+			)
+			cmd.Flags().BoolVar(&asJSON, "json", false, "print the graph snapshot (types, relations and tables) as JSON")
+			return cmd
+		}(),
 		func() *cobra.Command {
 			var (
 				cfg      gen.Config
 				storage  []string
+				features []string
+				inflects []string
 				template []string
 				idtype   = idType(field.TypeInt)
 				cmd      = &cobra.Command{
@@ -87,6 +107,7 @@ func main() {
 					Example: examples(
 						"entc generate ./ent/schema",
 						"entc generate github.com/a8m/x",
+						"entc generate --package github.com/a8m/x/ent ./ent/schema.yaml",
 					),
 					Args: cobra.ExactArgs(1),
 					Run: func(cmd *cobra.Command, path []string) {
@@ -100,10 +121,23 @@ func main() {
 							failOnErr(err)
 							cfg.Storage = append(cfg.Storage, sr)
 						}
+						for _, name := range features {
+							f, err := gen.NewFeature(name)
+							failOnErr(err)
+							cfg.Features = append(cfg.Features, f)
+						}
+						for _, s := range inflects {
+							in, err := gen.NewInflection(s)
+							failOnErr(err)
+							cfg.Inflections = append(cfg.Inflections, in)
+						}
 						if len(template) > 0 {
 							cfg.Template = loadTemplate(template)
 						}
 						cfg.IDType = &field.TypeInfo{Type: field.Type(idtype)}
+						if isDocument(path[0]) && cfg.Package == "" {
+							failOnErr(errors.New("missing --package flag for generating a schema document"))
+						}
 						graph, err := loadGraph(path[0], cfg)
 						failOnErr(err)
 						failOnErr(graph.Gen())
@@ -113,8 +147,11 @@ func main() {
 			cmd.Flags().Var(&idtype, "idtype", "type of the id field")
 			cmd.Flags().StringVar(&cfg.Header, "header", "", "override codegen header")
 			cmd.Flags().StringVar(&cfg.Target, "target", "", "target directory for codegen")
+			cmd.Flags().StringVar(&cfg.Package, "package", "", "package path of the generated code (schema documents only)")
 			cmd.Flags().StringSliceVarP(&template, "template", "", nil, "external templates to execute")
 			cmd.Flags().StringSliceVarP(&storage, "storage", "", []string{"sql"}, "list of storage drivers to support")
+			cmd.Flags().StringSliceVarP(&features, "feature", "", nil, "list of optional features to enable (e.g. audit, upsert)")
+			cmd.Flags().StringSliceVarP(&inflects, "inflection", "", nil, "list of custom inflections (e.g. status:statuses, fish)")
 			return cmd
 		}(),
 	)
@@ -123,10 +160,18 @@ func main() {
 
 // loadGraph loads the given schema package from the given path
 // and construct a *gen.Graph. The path can be either a package
-// path (e.g github.com/a8m/x) or a filepath.
+// path (e.g github.com/a8m/x), a filepath, or the path of a schema
+// document (JSON or YAML).
 //
 // The second argument is an optional config for the graph creation.
 func loadGraph(path string, cfg gen.Config) (*gen.Graph, error) {
+	if isDocument(path) {
+		schemas, err := load.LoadDocument(path)
+		if err != nil {
+			return nil, err
+		}
+		return gen.NewGraph(cfg, schemas...)
+	}
 	spec, err := (&load.Config{Path: path}).Load()
 	if err != nil {
 		return nil, err
@@ -136,6 +181,16 @@ func loadGraph(path string, cfg gen.Config) (*gen.Graph, error) {
 	return gen.NewGraph(cfg, spec.Schemas...)
 }
 
+// isDocument reports if the given path is a schema document.
+func isDocument(path string) bool {
+	switch filepath.Ext(path) {
+	case ".json", ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
 // loadTemplate loads templates from files or directory.
 func loadTemplate(paths []string) *template.Template {
 	t := template.New("external").
@@ -0,0 +1,127 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import "fmt"
+
+// Feature is an optional subsystem of the generated code, that can be enabled per
+// project. Templates check the status of a feature using the FeatureEnabled method
+// of the graph (or the type), in order to keep the generated output minimal.
+//
+//	{{ if $.FeatureEnabled "upsert" }}
+//		...
+//	{{ end }}
+//
+// The core of the generated code (the client, the builders, the predicates and the migrate
+// package) is always generated. So are the parts that are generated only for schemas that
+// declare them (e.g. count fields and named scopes), since they're opted-in by the schema.
+//
+type Feature struct {
+	// Name of the feature, as used by the --feature flag and the templates.
+	Name string
+	// Description of the feature.
+	Description string
+}
+
+var (
+	// FeatureAudit enables the generation of the mutation audit log. When enabled, every
+	// mutation that is executed on SQL storage is recorded in the "audit_logs" table in
+	// the same transaction of the mutation.
+	FeatureAudit = Feature{
+		Name:        "audit",
+		Description: "record mutations in the audit log table",
+	}
+
+	// FeatureUpsert enables the generation of the upsert builders (CreateOrUpdateByX)
+	// and the get-or-create builders (GetOrCreate) for types with unique fields.
+	FeatureUpsert = Feature{
+		Name:        "upsert",
+		Description: "generate upsert and get-or-create builders for unique fields",
+	}
+
+	// FeatureSoftFK enables the application-level checks of the edge references in SQL
+	// storage. It's used for catching integrity bugs during development, when the foreign
+	// keys are disabled in the database (e.g. Vitess), using migrate.WithForeignKeys(false).
+	FeatureSoftFK = Feature{
+		Name:        "softfk",
+		Description: "check edge references in the application when foreign keys are disabled",
+	}
+
+	// FeatureDualWrite enables the dual-write mode of the client (see the Shadow option). In this
+	// mode, mutations are mirrored to a shadow storage, and the results of the queries are compared
+	// with it. It's used for migrating safely between storages (e.g. from Gremlin to SQL).
+	FeatureDualWrite = Feature{
+		Name:        "dualwrite",
+		Description: "mirror mutations to a shadow storage and compare query results",
+	}
+
+	// FeatureREST enables the generation of the rest package, that provides an http.Handler
+	// for the CRUD API of the entities (list with filters and pagination, get, create, patch
+	// and delete), with JSON (de)serialization of the entities.
+	FeatureREST = Feature{
+		Name:        "rest",
+		Description: "generate net/http handlers for the CRUD API of the entities",
+	}
+
+	// FeatureWatch enables the change streams of the entities (see the Watch method of the
+	// entity clients). When enabled, the mutations of the client are published in-process
+	// to the watchers of their node type, after they were applied (or committed).
+	FeatureWatch = Feature{
+		Name:        "watch",
+		Description: "generate in-process change streams for the mutations of the entities",
+	}
+
+	// FeatureFixture enables the loading of fixtures files (YAML or JSON) into the graph, using the
+	// LoadFixtures method of the client. It's used for setting up the data of tests and development
+	// environments, and requires the gopkg.in/yaml.v2 package.
+	FeatureFixture = Feature{
+		Name:        "fixture",
+		Description: "generate a loader of YAML or JSON fixtures files for tests",
+	}
+
+	// FeatureEntCache enables the invalidation of the query results that are cached by the entcache
+	// driver per type. When enabled, the mutations of each type invalidate only the cached results
+	// of the tables they change (see the Tables variable of the type package), instead of all results.
+	FeatureEntCache = Feature{
+		Name:        "entcache",
+		Description: "invalidate the results of the entcache driver per type on mutations",
+	}
+
+	// AllFeatures holds the list of all features that are supported by the code generation.
+	AllFeatures = []Feature{
+		FeatureAudit,
+		FeatureUpsert,
+		FeatureSoftFK,
+		FeatureDualWrite,
+		FeatureREST,
+		FeatureWatch,
+		FeatureFixture,
+		FeatureEntCache,
+	}
+)
+
+// NewFeature returns the feature with the given name. It fails if there is no such feature.
+func NewFeature(name string) (Feature, error) {
+	for _, f := range AllFeatures {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+	return Feature{}, fmt.Errorf("entc/gen: unknown feature %q", name)
+}
+
+// FeatureEnabled reports if the given feature is enabled in the config.
+// It fails if the feature name is unknown, in order to catch typos in templates.
+func (c Config) FeatureEnabled(name string) (bool, error) {
+	if _, err := NewFeature(name); err != nil {
+		return false, err
+	}
+	for _, f := range c.Features {
+		if f.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
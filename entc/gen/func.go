@@ -25,6 +25,7 @@ var (
 	// functions used by the codegen.
 	Funcs = template.FuncMap{
 		"ops":         ops,
+		"storageOps":  storageOps,
 		"add":         add,
 		"append":      reflect.AppendSlice,
 		"order":       order,
@@ -50,19 +51,35 @@ var (
 		"xtemplate":   xtemplate,
 		"hasTemplate": hasTemplate,
 	}
-	rules   = ruleset()
+	rules   = newRuleset()
 	acronym = make(map[string]bool)
 )
 
+// initialisms are the common initialisms of the codegen. copied from golint.
+var initialisms = []string{
+	"API", "ASCII", "CPU", "CSS", "DNS", "GUID", "UID", "UI",
+	"RHS", "RPC", "SLA", "SMTP", "SSH", "TLS", "TTL", "HTML",
+	"HTTP", "HTTPS", "ID", "IP", "JSON", "LHS", "QPS", "RAM",
+	"UUID", "URI", "URL", "UTF8", "VM", "XML", "XSRF", "XSS",
+}
+
+func init() {
+	for _, w := range initialisms {
+		acronym[w] = true
+	}
+}
+
 // ops returns all operations for given field.
 func ops(f *Field) (op []Op) {
 	switch t := f.Type.Type; {
 	case t == field.TypeJSON:
+	case f.HasGoType():
+		op = numericOps
 	case t == field.TypeBool:
 		op = boolOps
 	case t == field.TypeString && strings.ToLower(f.Name) != "id":
 		op = stringOps
-	case t == field.TypeEnum:
+	case t == field.TypeEnum || f.HasStorageValue():
 		op = enumOps
 	default:
 		op = numericOps
@@ -83,6 +100,11 @@ func xrange(n int) (a []int) {
 
 // plural a name.
 func plural(name string) string {
+	return pluralize(rules, name)
+}
+
+// pluralize a name using the given rules.
+func pluralize(rules *inflect.Ruleset, name string) string {
 	p := rules.Pluralize(name)
 	if p == name {
 		p += "Slice"
@@ -189,6 +211,14 @@ func extend(v interface{}, kv ...interface{}) (interface{}, error) {
 	switch v := v.(type) {
 	case *Type:
 		return &typeScope{Type: v, Scope: scope}, nil
+	case *typeScope:
+		// nested scopes inherit the values of their parent.
+		for k, pv := range v.Scope {
+			if _, ok := scope[k]; !ok {
+				scope[k] = pv
+			}
+		}
+		return &typeScope{Type: v.Type, Scope: scope}, nil
 	case *Graph:
 		return &graphScope{Graph: v, Scope: scope}, nil
 	default:
@@ -204,21 +234,67 @@ func add(xs ...int) (n int) {
 	return
 }
 
-func ruleset() *inflect.Ruleset {
+// newRuleset returns the default inflection rules of the codegen.
+func newRuleset() *inflect.Ruleset {
 	rules := inflect.NewDefaultRuleset()
-	// add common initialisms. copied from golint.
-	for _, w := range []string{
-		"API", "ASCII", "CPU", "CSS", "DNS", "GUID", "UID", "UI",
-		"RHS", "RPC", "SLA", "SMTP", "SSH", "TLS", "TTL", "HTML",
-		"HTTP", "HTTPS", "ID", "IP", "JSON", "LHS", "QPS", "RAM",
-		"UUID", "URI", "URL", "UTF8", "VM", "XML", "XSRF", "XSS",
-	} {
-		acronym[w] = true
+	for _, w := range initialisms {
 		rules.AddAcronym(w)
 	}
 	return rules
 }
 
+// ruleset returns the inflection rules of the config, or the default rules
+// of the codegen if the config was not used for creating a graph.
+func (c Config) ruleset() *inflect.Ruleset {
+	if c.rules != nil {
+		return c.rules
+	}
+	return rules
+}
+
+// Inflection is a custom pluralization rule of a word, that overrides the default rules of the
+// codegen (e.g. "status" and "statuses"). A word with an empty Plural is uncountable, and its
+// plural form is the same as its singular form (e.g. "fish").
+type Inflection struct {
+	Singular string
+	Plural   string
+}
+
+// NewInflection returns the inflection of the given "singular:plural" pair, or of the given
+// uncountable word.
+func NewInflection(s string) (Inflection, error) {
+	parts := strings.Split(s, ":")
+	switch {
+	case parts[0] == "" || len(parts) > 2 || len(parts) == 2 && parts[1] == "":
+		return Inflection{}, fmt.Errorf("entc/gen: invalid inflection %q", s)
+	case len(parts) == 2:
+		return Inflection{Singular: parts[0], Plural: parts[1]}, nil
+	default:
+		return Inflection{Singular: parts[0]}, nil
+	}
+}
+
+// addInflections adds the given inflections to the given rules. The words are added in their
+// lowercase and capitalized forms, in order to match them as suffixes of type and edge names
+// (e.g. "UserStatus").
+func addInflections(rules *inflect.Ruleset, ins []Inflection) error {
+	for _, in := range ins {
+		if in.Singular == "" {
+			return fmt.Errorf("entc/gen: missing singular form for inflection %q", in.Plural)
+		}
+		plural := in.Plural
+		if plural == "" {
+			plural = in.Singular
+		}
+		for _, f := range []func(string) string{strings.ToLower, rules.Capitalize} {
+			singular, plural := f(in.Singular), f(plural)
+			rules.AddIrregular(singular, plural)
+			rules.AddSingular(singular, singular)
+		}
+	}
+	return nil
+}
+
 // order returns a map of sort orders.
 // The key is the function name, and the value its database keyword.
 func order() map[string]string {
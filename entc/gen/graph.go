@@ -7,18 +7,23 @@ package gen
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"text/template"
 	"text/template/parse"
 
 	"github.com/facebookincubator/ent/dialect/sql/schema"
 	"github.com/facebookincubator/ent/entc/load"
+	"github.com/facebookincubator/ent/schema/edge"
 	"github.com/facebookincubator/ent/schema/field"
 
+	"github.com/go-openapi/inflect"
 	"golang.org/x/tools/imports"
 )
 
@@ -44,6 +49,16 @@ type (
 		// Note that, additional templates are executed on the Graph object and
 		// the execution output is stored in a file derived by the template name.
 		Template *template.Template
+		// Features defines the optional features that are enabled for the codegen
+		// (e.g. audit log or upsert builders). See AllFeatures for the full list.
+		Features []Feature
+		// Inflections defines custom pluralization rules for words that are not handled
+		// well by the default rules. The rules are used for naming the tables, the edge
+		// columns and the generated identifiers of the graph that is created with this config.
+		Inflections []Inflection
+		// rules are the inflection rules of the graph. They're
+		// the default rules, extended with the Inflections above.
+		rules *inflect.Ruleset
 	}
 	// Graph holds the nodes/entities of the loaded graph schema. Note that, it doesn't
 	// hold the edges of the graph. Instead, each Type holds the edges for other Types.
@@ -60,6 +75,8 @@ type (
 // It fails if one of the schemas is invalid.
 func NewGraph(c Config, schemas ...*load.Schema) (g *Graph, err error) {
 	defer catch(&err)
+	c.rules = newRuleset()
+	check(addInflections(c.rules, c.Inflections), "add inflections")
 	g = &Graph{c, make([]*Type, 0, len(schemas)), schemas}
 	for _, schema := range schemas {
 		g.addNode(schema)
@@ -70,9 +87,31 @@ func NewGraph(c Config, schemas ...*load.Schema) (g *Graph, err error) {
 	for _, t := range g.Nodes {
 		check(g.resolve(t), "resolve %q relations", t.Name)
 	}
+	for _, t := range g.Nodes {
+		check(checkOnDelete(t), "invalid delete action for %q edges", t.Name)
+	}
+	for _, t := range g.Nodes {
+		check(resolveCounters(t), "invalid count field for %q edges", t.Name)
+	}
+	for _, t := range g.Nodes {
+		resolveReferences(t)
+	}
+	for _, t := range g.Nodes {
+		check(checkReadOnly(t), "invalid read-only type %q", t.Name)
+	}
+	for _, t := range g.Nodes {
+		check(t.renameReserved(), "resolve %q field names", t.Name)
+	}
+	for _, t := range g.Nodes {
+		check(t.resolveScopes(), "invalid scopes of %q", t.Name)
+	}
+	check(g.checkColumnOrder(), "invalid column order")
 	for _, schema := range schemas {
 		g.addIndexes(schema)
 	}
+	if enabled, _ := g.FeatureEnabled(FeatureSoftFK.Name); enabled {
+		g.resolveSoftRefs()
+	}
 	return
 }
 
@@ -84,6 +123,9 @@ func (g *Graph) Gen() (err error) {
 		path := filepath.Join(g.Config.Target, n.Package())
 		check(os.MkdirAll(path, os.ModePerm), "create dir %q", path)
 		for _, tmpl := range Templates {
+			if tmpl.Skip != nil && tmpl.Skip(n) {
+				continue
+			}
 			b := bytes.NewBuffer(nil)
 			check(templates.ExecuteTemplate(b, tmpl.Name, n), "execute template %q", tmpl.Name)
 			target := filepath.Join(g.Config.Target, tmpl.Format(n))
@@ -134,16 +176,20 @@ func (g *Graph) addEdges(schema *load.Schema) {
 	for _, e := range schema.Edges {
 		typ, ok := g.typ(e.Type)
 		expect(ok, "type %q does not exist for edge", e.Type)
+		check(validStructTag(e.Tag), "invalid struct tag for edge %q", e.Name)
 		switch {
 		// assoc only.
 		case !e.Inverse:
 			t.Edges = append(t.Edges, &Edge{
-				Type:      typ,
-				Name:      e.Name,
-				Owner:     t,
-				Unique:    e.Unique,
-				Optional:  !e.Required,
-				StructTag: e.Tag,
+				Type:       typ,
+				Name:       e.Name,
+				Owner:      t,
+				Unique:     e.Unique,
+				Optional:   !e.Required,
+				StructTag:  e.Tag,
+				OnDelete:   e.OnDelete,
+				StorageKey: e.StorageKey,
+				countField: e.CountField,
 			})
 		// inverse only.
 		case e.Inverse && e.Ref == nil:
@@ -170,13 +216,17 @@ func (g *Graph) addEdges(schema *load.Schema) {
 				Unique:    e.Unique,
 				Optional:  !e.Required,
 				StructTag: e.Tag,
+				OnDelete:  ref.OnDelete,
 			}, &Edge{
-				Type:      typ,
-				Owner:     t,
-				Name:      ref.Name,
-				Unique:    ref.Unique,
-				Optional:  !ref.Required,
-				StructTag: e.Tag,
+				Type:       typ,
+				Owner:      t,
+				Name:       ref.Name,
+				Unique:     ref.Unique,
+				Optional:   !ref.Required,
+				StructTag:  e.Tag,
+				OnDelete:   ref.OnDelete,
+				StorageKey: ref.StorageKey,
+				countField: ref.CountField,
 			})
 		default:
 			panic(graphError{"edge must be either an assoc or inverse edge"})
@@ -242,29 +292,34 @@ func (g *Graph) resolve(t *Type) error {
 
 			case !a && !b:
 				e.Rel.Type, ref.Rel.Type = M2M, M2M
-				table = e.Type.Label() + "_" + ref.Name
+				table = e.Type.qualify(e.Type.Label() + "_" + ref.Name)
 				c1, c2 := ref.Owner.Label()+"_id", ref.Type.Label()+"_id"
 				// if the relation is from the same type: User has Friends ([]User).
 				// give the second column a different name (the relation name).
 				if c1 == c2 {
-					c2 = rules.Singularize(e.Name) + "_id"
+					c2 = g.ruleset().Singularize(e.Name) + "_id"
 				}
 				e.Rel.Columns = []string{c1, c2}
 				ref.Rel.Columns = []string{c1, c2}
 			}
 			e.Rel.Table, ref.Rel.Table = table, table
+			e.OnDelete = ref.OnDelete
 			if !e.M2M() {
 				e.Rel.Columns = []string{column}
 				ref.Rel.Columns = []string{column}
 			}
+			if err := ref.setStorageKey(); err != nil {
+				return err
+			}
+			e.Rel.Table, e.Rel.Columns = ref.Rel.Table, ref.Rel.Columns
 		// assoc with uninitialized relation.
 		case !e.IsInverse() && e.Rel.Type == Unk:
 			switch {
 			case !e.Unique && e.Type == t:
 				e.Rel.Type = M2M
 				e.SelfRef = true
-				e.Rel.Table = t.Label() + "_" + e.Name
-				c1, c2 := e.Owner.Label()+"_id", rules.Singularize(e.Name)+"_id"
+				e.Rel.Table = t.qualify(t.Label() + "_" + e.Name)
+				c1, c2 := e.Owner.Label()+"_id", g.ruleset().Singularize(e.Name)+"_id"
 				e.Rel.Columns = append(e.Rel.Columns, c1, c2)
 			case e.Unique && e.Type == t:
 				e.Rel.Type = O2O
@@ -280,17 +335,203 @@ func (g *Graph) resolve(t *Type) error {
 			if !e.M2M() {
 				// Unlike assoc edges with inverse, we need to choose a unique name for the
 				// column in order to no conflict with other types that point to this type.
-				e.Rel.Columns = []string{fmt.Sprintf("%s_%s_id", t.Label(), snake(rules.Singularize(e.Name)))}
+				e.Rel.Columns = []string{fmt.Sprintf("%s_%s_id", t.Label(), snake(g.ruleset().Singularize(e.Name)))}
+			}
+			// the storage key of edges with inverse is set when their inverse is resolved.
+			if inverseOf(t, e) == nil {
+				if err := e.setStorageKey(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// setStorageKey overrides the table and the columns of the edge relation with its storage key.
+func (e *Edge) setStorageKey() error {
+	key := e.StorageKey
+	if key == nil {
+		return nil
+	}
+	switch {
+	case key.Table != "" && !e.M2M():
+		return fmt.Errorf("storage key table is supported only by M2M edges: %s.%s", e.Owner.Name, e.Name)
+	case len(key.Columns) == 2 && !e.M2M():
+		return fmt.Errorf("storage key of %s edge %s.%s expects one column", e.Rel.Type, e.Owner.Name, e.Name)
+	case len(key.Columns) == 1 && e.M2M():
+		return fmt.Errorf("storage key of M2M edge %s.%s expects two columns", e.Owner.Name, e.Name)
+	case (len(key.Indexes) > 0 || key.ReverseIndex) && !e.M2M():
+		return fmt.Errorf("storage key indexes are supported only by M2M edges: %s.%s", e.Owner.Name, e.Name)
+	}
+	if key.Table != "" {
+		e.Rel.Table = e.Owner.qualify(key.Table)
+	}
+	if len(key.Columns) > 0 {
+		e.Rel.Columns = key.Columns
+	}
+	for _, columns := range key.Indexes {
+		if len(columns) == 0 {
+			return fmt.Errorf("missing columns for storage key index of edge %s.%s", e.Owner.Name, e.Name)
+		}
+		for _, c := range columns {
+			if c != e.Rel.Columns[0] && c != e.Rel.Columns[1] {
+				return fmt.Errorf("unknown column %q for storage key index of edge %s.%s", c, e.Owner.Name, e.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// checkOnDelete checks that the delete actions of the assoc edges are valid for their relation type.
+func checkOnDelete(t *Type) error {
+	for _, e := range t.Edges {
+		if e.IsInverse() || e.OnDelete == "" {
+			continue
+		}
+		switch a := edge.Action(e.OnDelete); {
+		case a != edge.SetNull && a != edge.Cascade && a != edge.Restrict && a != edge.NoAction && a != edge.AppCascade:
+			return fmt.Errorf("unknown delete action %q for edge %s.%s", a, t.Name, e.Name)
+		case e.M2M() && (a == edge.SetNull || a == edge.AppCascade):
+			return fmt.Errorf("delete action %q is not supported for M2M edge %s.%s", a, t.Name, e.Name)
+		case a == edge.AppCascade && e.SelfRef:
+			return fmt.Errorf("delete action %q is not supported for self-reference edge %s.%s", a, t.Name, e.Name)
+		case a == edge.AppCascade && e.M2O():
+			if _, ok := e.Type.HasInverse(e.Name); !ok {
+				return fmt.Errorf("delete action %q of M2O edge %s.%s requires an inverse edge in %s", a, t.Name, e.Name, e.Type.Name)
 			}
 		}
 	}
 	return nil
 }
 
+// resolveCounters resolves the counter fields of the assoc edges, and sets them on their inverse edges.
+func resolveCounters(t *Type) error {
+	for _, e := range t.Edges {
+		if e.countField == "" {
+			continue
+		}
+		f, ok := t.fields[e.countField]
+		switch {
+		case e.Unique:
+			return fmt.Errorf("count field is supported only by non-unique edges: %s.%s", t.Name, e.Name)
+		case !ok:
+			return fmt.Errorf("count field %q of edge %s.%s does not exist", e.countField, t.Name, e.Name)
+		case !f.Type.Numeric() || f.Type.Type == field.TypeFloat32 || f.Type.Type == field.TypeFloat64 || f.Nillable:
+			return fmt.Errorf("count field %q of edge %s.%s must be a non-nillable integer field", e.countField, t.Name, e.Name)
+		case e.O2M() && e.Type == t:
+			// counters are synced by an UPDATE statement that counts the rows of the
+			// relation table, and MySQL does not allow selecting from the updated table.
+			return fmt.Errorf("count field %q of edge %s.%s is not supported by O2M edges to the same type", e.countField, t.Name, e.Name)
+		case !intID(t) || !intID(e.Type):
+			// counters are synced using the integer primary-keys of the tables.
+			return fmt.Errorf("count field %q of edge %s.%s requires integer (or string) ids, got %s", e.countField, t.Name, e.Name, t.ID.Type)
+		}
+		e.Counter = f
+		if ref := inverseOf(t, e); ref != nil {
+			ref.Counter = f
+		}
+		e.Type.countedBy = append(e.Type.countedBy, e)
+	}
+	return nil
+}
+
+// intID reports if the ids of the type are stored in an integer column. String
+// ids are also stored in integer columns, and converted by the generated code.
+func intID(t *Type) bool {
+	typ := t.ID.Type
+	return typ != nil && (typ.Type == field.TypeString || typ.Numeric() && typ.Type != field.TypeFloat32 && typ.Type != field.TypeFloat64)
+}
+
+// resolveReferences adds the edges of the type to the types they point to.
+func resolveReferences(t *Type) {
+	for _, e := range t.Edges {
+		e.Type.referencedBy = append(e.Type.referencedBy, e)
+	}
+}
+
+// resolveSoftRefs resolves the foreign-keys that reference the tables of the types.
+func (g *Graph) resolveSoftRefs() {
+	types := make(map[string]*Type, len(g.Nodes))
+	for _, n := range g.Nodes {
+		types[n.Table()] = n
+	}
+	for _, t := range g.Tables() {
+		for _, fk := range t.ForeignKeys {
+			n, ok := types[fk.RefTable.Name]
+			if !ok {
+				continue
+			}
+			action := fk.OnDelete
+			if action == schema.SetNull && !fk.Columns[0].Nullable {
+				action = schema.NoAction
+			}
+			n.softRefs = append(n.softRefs, &SoftRef{Table: t.Name, Column: fk.Columns[0].Name, Type: types[t.Name], Action: action})
+		}
+	}
+}
+
+// checkColumnOrder checks that the column orders of the types list the columns
+// of their tables (or their fields, for read-only types) without duplicates.
+func (g *Graph) checkColumnOrder() error {
+	var tables map[string]*schema.Table
+	for _, n := range g.Nodes {
+		if n.schema == nil || len(n.schema.Config.ColumnOrder) == 0 {
+			continue
+		}
+		if tables == nil {
+			tables = make(map[string]*schema.Table)
+			for _, t := range g.Tables() {
+				tables[t.Name] = t
+			}
+		}
+		columns := make(map[string]bool)
+		if t, ok := tables[n.Table()]; ok {
+			for _, c := range t.Columns {
+				columns[c.Name] = true
+			}
+		} else {
+			for _, f := range n.ColumnFields() {
+				columns[f.StorageKey()] = true
+			}
+		}
+		seen := make(map[string]bool)
+		for _, c := range n.schema.Config.ColumnOrder {
+			switch {
+			case !columns[c]:
+				return fmt.Errorf("unknown column %q in the column order of type %q", c, n.Name)
+			case seen[c]:
+				return fmt.Errorf("column %q appears twice in the column order of type %q", c, n.Name)
+			}
+			seen[c] = true
+		}
+	}
+	return nil
+}
+
+// checkReadOnly checks that the edges of the type do not connect it with read-only types,
+// because the edges of read-only types can't be mutated.
+func checkReadOnly(t *Type) error {
+	for _, e := range t.Edges {
+		switch {
+		case t.ReadOnly():
+			return fmt.Errorf("edges are not supported for read-only types, got: %s.%s", t.Name, e.Name)
+		case e.Type.ReadOnly():
+			return fmt.Errorf("edge %s.%s references the read-only type %s", t.Name, e.Name, e.Type.Name)
+		}
+	}
+	return nil
+}
+
 // Tables returns the schema definitions of SQL tables for the graph.
+// The tables of read-only types are not included, because they are
+// not managed by the migration (e.g. SQL views).
 func (g *Graph) Tables() (all []*schema.Table) {
 	tables := make(map[string]*schema.Table)
 	for _, n := range g.Nodes {
+		if n.ReadOnly() {
+			continue
+		}
 		table := schema.NewTable(n.Table()).AddPrimary(n.ID.Column())
 		for _, f := range n.Fields {
 			table.AddColumn(f.Column())
@@ -309,64 +550,196 @@ func (g *Graph) Tables() (all []*schema.Table) {
 				// "owner" is the table that owns the relations (we set the foreign-key on)
 				// and "ref" is the referenced table.
 				owner, ref := tables[e.Rel.Table], tables[n.Table()]
-				column := &schema.Column{Name: e.Rel.Column(), Type: field.TypeInt, Unique: e.Rel.Type == O2O, Nullable: true}
+				column := &schema.Column{Name: e.Rel.Column(), Type: field.TypeInt, Unique: e.Rel.Type == O2O, Nullable: !requiredRef(n, e)}
 				owner.AddColumn(column)
 				owner.AddForeignKey(&schema.ForeignKey{
 					RefTable:   ref,
-					OnDelete:   schema.SetNull,
+					OnDelete:   onDelete(e, nullAction(column)),
 					Columns:    []*schema.Column{column},
 					RefColumns: []*schema.Column{ref.PrimaryKey[0]},
-					Symbol:     fmt.Sprintf("%s_%s_%s", owner.Name, ref.Name, e.Name),
+					Symbol:     fmt.Sprintf("%s_%s_%s", unqualify(owner.Name), unqualify(ref.Name), e.Name),
 				})
 			case M2O:
 				ref, owner := tables[e.Type.Table()], tables[e.Rel.Table]
-				column := &schema.Column{Name: e.Rel.Column(), Type: field.TypeInt, Nullable: true}
+				column := &schema.Column{Name: e.Rel.Column(), Type: field.TypeInt, Nullable: e.Optional}
 				owner.AddColumn(column)
 				owner.AddForeignKey(&schema.ForeignKey{
 					RefTable:   ref,
-					OnDelete:   schema.SetNull,
+					OnDelete:   onDelete(e, nullAction(column)),
 					Columns:    []*schema.Column{column},
 					RefColumns: []*schema.Column{ref.PrimaryKey[0]},
-					Symbol:     fmt.Sprintf("%s_%s_%s", owner.Name, ref.Name, e.Name),
+					Symbol:     fmt.Sprintf("%s_%s_%s", unqualify(owner.Name), unqualify(ref.Name), e.Name),
 				})
 			case M2M:
 				t1, t2 := tables[n.Table()], tables[e.Type.Table()]
 				c1 := &schema.Column{Name: e.Rel.Columns[0], Type: field.TypeInt}
 				c2 := &schema.Column{Name: e.Rel.Columns[1], Type: field.TypeInt}
-				all = append(all, &schema.Table{
-					Name:       e.Rel.Table,
-					Columns:    []*schema.Column{c1, c2},
-					PrimaryKey: []*schema.Column{c1, c2},
-					ForeignKeys: []*schema.ForeignKey{
-						{
-							RefTable:   t1,
-							OnDelete:   schema.Cascade,
-							Columns:    []*schema.Column{c1},
-							RefColumns: []*schema.Column{t1.PrimaryKey[0]},
-							Symbol:     fmt.Sprintf("%s_%s", e.Rel.Table, c1.Name),
-						},
-						{
-							RefTable:   t2,
-							OnDelete:   schema.Cascade,
-							Columns:    []*schema.Column{c2},
-							RefColumns: []*schema.Column{t2.PrimaryKey[0]},
-							Symbol:     fmt.Sprintf("%s_%s", e.Rel.Table, c2.Name),
-						},
-					},
-				})
+				table := schema.NewTable(e.Rel.Table).
+					AddColumn(c1).
+					AddColumn(c2).
+					AddForeignKey(&schema.ForeignKey{
+						RefTable:   t1,
+						OnDelete:   onDelete(e, schema.Cascade),
+						Columns:    []*schema.Column{c1},
+						RefColumns: []*schema.Column{t1.PrimaryKey[0]},
+						Symbol:     fmt.Sprintf("%s_%s", unqualify(e.Rel.Table), c1.Name),
+					}).
+					AddForeignKey(&schema.ForeignKey{
+						RefTable:   t2,
+						OnDelete:   onDelete(e, schema.Cascade),
+						Columns:    []*schema.Column{c2},
+						RefColumns: []*schema.Column{t2.PrimaryKey[0]},
+						Symbol:     fmt.Sprintf("%s_%s", unqualify(e.Rel.Table), c2.Name),
+					})
+				table.PrimaryKey = []*schema.Column{c1, c2}
+				// index names are prefixed with the table name, because in some
+				// dialects (e.g. SQLite), they are unique in the whole database.
+				if key := e.StorageKey; key != nil {
+					indexes := append([][]string{}, key.Indexes...)
+					if key.ReverseIndex {
+						indexes = append(indexes, []string{c2.Name, c1.Name})
+					}
+					for _, columns := range indexes {
+						table.AddIndex(fmt.Sprintf("%s_%s", unqualify(table.Name), strings.Join(columns, "_")), false, columns)
+					}
+				}
+				all = append(all, table)
 			}
 		}
 	}
-	// append indexes to tables after all columns were added (including relation columns).
+	// append indexes to tables after all columns were added (including relation columns),
+	// and order the columns by the column order of the type.
 	for _, n := range g.Nodes {
-		table := tables[n.Table()]
+		table, ok := tables[n.Table()]
+		if !ok {
+			continue
+		}
+		sort.SliceStable(table.Columns, func(i, j int) bool {
+			return n.columnRank(table.Columns[i].Name) < n.columnRank(table.Columns[j].Name)
+		})
 		for _, idx := range n.Indexes {
 			table.AddIndex(idx.Name, idx.Unique, idx.Columns)
+			added := table.Indexes[len(table.Indexes)-1]
+			added.Type, added.Prefixes = idx.Type, idx.Prefixes
 		}
 	}
+	if enabled, _ := g.FeatureEnabled(FeatureAudit.Name); enabled {
+		all = append(all, auditTable())
+	}
 	return
 }
 
+// unqualify returns the table name without its schema (database) qualifier. Constraint and index
+// names are not qualified by the dialects, and are looked up in the schema of their table.
+func unqualify(table string) string {
+	if i := strings.IndexByte(table, '.'); i != -1 {
+		return table[i+1:]
+	}
+	return table
+}
+
+// onDelete returns the reference option of the foreign-keys of the edge. def is returned
+// if the delete action was not set, or if it's executed by the generated code.
+func onDelete(e *Edge, def schema.ReferenceOption) schema.ReferenceOption {
+	switch a := edge.Action(e.OnDelete); a {
+	case "", edge.AppCascade:
+		return def
+	default:
+		return schema.ReferenceOption(a)
+	}
+}
+
+// nullAction returns the default action of a foreign-key on deletion of its referenced row. The
+// columns of required edges are not nullable, and therefore, their references can't be set to NULL.
+func nullAction(c *schema.Column) schema.ReferenceOption {
+	if c.Nullable {
+		return schema.SetNull
+	}
+	return schema.NoAction
+}
+
+// requiredRef reports if the inverse edge of the given assoc edge is required. In this case,
+// the foreign-key of the relation is held by the inverse type, and its column is not nullable.
+func requiredRef(n *Type, e *Edge) bool {
+	ref := inverseOf(n, e)
+	return ref != nil && !ref.Optional
+}
+
+// inverseOf returns the inverse edge of the given assoc edge, or nil if it has no inverse.
+func inverseOf(n *Type, e *Edge) *Edge {
+	for _, ref := range e.Type.Edges {
+		if ref.Owner == n && ref.Inverse == e.Name {
+			return ref
+		}
+	}
+	return nil
+}
+
+// auditTable returns the schema definition of the mutation audit log table.
+func auditTable() *schema.Table {
+	id := &schema.Column{Name: "id", Type: field.TypeInt, Increment: true}
+	return schema.NewTable("audit_logs").
+		AddPrimary(id).
+		AddColumn(&schema.Column{Name: "type", Type: field.TypeString}).
+		AddColumn(&schema.Column{Name: "entity_id", Type: field.TypeString}).
+		AddColumn(&schema.Column{Name: "op", Type: field.TypeString}).
+		AddColumn(&schema.Column{Name: "actor", Type: field.TypeString, Nullable: true}).
+		AddColumn(&schema.Column{Name: "changes", Type: field.TypeJSON, Nullable: true}).
+		AddColumn(&schema.Column{Name: "created_at", Type: field.TypeTime}).
+		AddIndex("auditlog_type_entity_id", false, []string{"type", "entity_id"})
+}
+
+// Hash returns a fingerprint of the SQL schema (tables, columns, indexes and foreign-keys)
+// of the graph. It's recorded by the migration, and used for checking that the database
+// was migrated by the same version of the generated code. The tables of types that skip the
+// migration are not included, since they are not changed by it.
+func (g *Graph) Hash() string {
+	skipped := make(map[string]bool)
+	for _, n := range g.Nodes {
+		if n.SkipMigration() {
+			skipped[n.Table()] = true
+		}
+	}
+	h := sha256.New()
+	for _, t := range g.Tables() {
+		if skipped[t.Name] {
+			continue
+		}
+		fmt.Fprintf(h, "table %s\n", t.Name)
+		for _, c := range t.Columns {
+			fmt.Fprintf(h, "column %s %s %d %t %t %t %v %v\n", c.Name, c.Type, c.Size, c.Unique, c.Nullable, c.Increment, c.Enums, c.Default != nil)
+			if c.Precision > 0 || c.DateOnly {
+				fmt.Fprintf(h, "time column %d %t\n", c.Precision, c.DateOnly)
+			}
+		}
+		for _, c := range t.PrimaryKey {
+			fmt.Fprintf(h, "pk %s\n", c.Name)
+		}
+		for _, fk := range t.ForeignKeys {
+			fmt.Fprintf(h, "fk %s %s %s %s\n", fk.Symbol, fk.RefTable.Name, fk.OnUpdate, fk.OnDelete)
+			for _, c := range fk.Columns {
+				fmt.Fprintf(h, "fk column %s\n", c.Name)
+			}
+		}
+		for _, idx := range t.Indexes {
+			fmt.Fprintf(h, "index %s %t\n", idx.Name, idx.Unique)
+			for _, c := range idx.Columns {
+				fmt.Fprintf(h, "index column %s\n", c.Name)
+			}
+			// options are added only if they were set, in order to keep the hash of existing schemas.
+			if idx.Type != "" {
+				fmt.Fprintf(h, "index type %s\n", idx.Type)
+			}
+			for _, c := range idx.Columns {
+				if n, ok := idx.Prefixes[c.Name]; ok {
+					fmt.Fprintf(h, "index prefix %s %d\n", c.Name, n)
+				}
+			}
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
 // migrateSupport reports if the codegen needs to support schema migratio.
 func (g *Graph) migrateSupport() bool {
 	for _, storage := range g.Storage {
@@ -389,7 +762,13 @@ func (g *Graph) typ(name string) (*Type, bool) {
 // templates returns the template.Template for the code and external templates
 // to execute on the Graph object if provided.
 func (g *Graph) templates() (*template.Template, []GraphTemplate) {
-	templates = template.Must(templates.Clone())
+	templates := template.Must(templates.Clone())
+	// override the inflection functions with the rules of the graph.
+	rules := g.ruleset()
+	templates.Funcs(template.FuncMap{
+		"plural":   func(name string) string { return pluralize(rules, name) },
+		"singular": rules.Singularize,
+	})
 	if g.Template == nil {
 		return templates, nil
 	}
@@ -11,7 +11,10 @@ import (
 	"testing"
 	"text/template"
 
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/dialect/sql/schema"
 	"github.com/facebookincubator/ent/entc/load"
+	"github.com/facebookincubator/ent/schema/edge"
 	"github.com/facebookincubator/ent/schema/field"
 
 	"github.com/stretchr/testify/require"
@@ -170,6 +173,587 @@ func TestNewGraphBadInverse(t *testing.T) {
 	require.Errorf(t, err, "mismatch type for back-reference")
 }
 
+func TestNewGraphBadStructTag(t *testing.T) {
+	_, err := NewGraph(Config{Package: "entc/gen", Storage: drivers},
+		&load.Schema{
+			Name: "User",
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet", Tag: `json:pets`},
+			},
+		},
+		&load.Schema{Name: "Pet"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `invalid struct tag for edge "pets"`)
+}
+
+func TestNewGraphColumnOrder(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet"},
+		},
+	}
+	pet := &load.Schema{
+		Name: "Pet",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "nickname", Info: &field.TypeInfo{Type: field.TypeString}, StorageKey: "nick"},
+		},
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", RefName: "pets", Unique: true, Inverse: true},
+		},
+		Config: ent.Config{ColumnOrder: []string{"id", "owner_id", "nick"}},
+	}
+	cfg := Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}
+	graph, err := NewGraph(cfg, user, pet)
+	require.NoError(err)
+	var columns []string
+	for _, f := range graph.Nodes[1].ColumnFields() {
+		columns = append(columns, f.Name)
+	}
+	require.Equal([]string{"id", "nickname", "name"}, columns)
+	tables := graph.Tables()
+	columns = nil
+	for _, c := range tables[1].Columns {
+		columns = append(columns, c.Name)
+	}
+	require.Equal([]string{"id", "owner_id", "nick", "name"}, columns)
+	require.Equal("id", tables[1].PrimaryKey[0].Name)
+	columns = nil
+	for _, f := range graph.Nodes[0].ColumnFields() {
+		columns = append(columns, f.Name)
+	}
+	require.Equal([]string{"id", "name", "age"}, columns, "default order should not be changed")
+
+	pet.Config.ColumnOrder = []string{"id", "nickname"}
+	_, err = NewGraph(cfg, user, pet)
+	require.EqualError(err, `entc/gen: invalid column order: unknown column "nickname" in the column order of type "Pet"`)
+	pet.Config.ColumnOrder = []string{"nick", "id", "nick"}
+	_, err = NewGraph(cfg, user, pet)
+	require.Error(err)
+}
+
+func TestNewGraphScopes(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}, StorageKey: "user_age"},
+		},
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "User"},
+		},
+		Config: ent.Config{
+			Scopes: []ent.Scope{
+				{Name: "adults", Where: map[string]interface{}{"user_age.gte": 18, "id.gt": 0}},
+				{Name: "oldest", Order: []ent.OrderField{{Field: "age", Desc: true}}, Limit: 1},
+			},
+		},
+	}
+	cfg := Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}
+	graph, err := NewGraph(cfg, user)
+	require.NoError(err)
+	scopes := graph.Nodes[0].Scopes
+	require.Len(scopes, 2)
+	require.Equal([]string{"id.gt", "user_age.gte"}, scopes[0].Where)
+	require.Equal(graph.Nodes[0].Fields[1], scopes[1].Order[0].Field)
+	require.True(scopes[1].Order[0].Desc)
+	require.Equal(1, scopes[1].Limit)
+
+	user.Config.Scopes = []ent.Scope{{Name: "name"}}
+	_, err = NewGraph(cfg, user)
+	require.EqualError(err, `entc/gen: invalid scopes of "User": scope "name" collides with the generated code of the user package`)
+	user.Config.Scopes = []ent.Scope{{Name: "has_pets"}}
+	_, err = NewGraph(cfg, user)
+	require.Error(err)
+	user.Config.Scopes = []ent.Scope{{Name: "adults", Where: map[string]interface{}{"age": 18}}}
+	_, err = NewGraph(cfg, user)
+	require.EqualError(err, `entc/gen: invalid scopes of "User": unknown field "age" in the predicates of scope "adults"`)
+	user.Config.Scopes = []ent.Scope{{Name: "a"}, {Name: "a"}}
+	_, err = NewGraph(cfg, user)
+	require.Error(err)
+}
+
+func TestNewGraphOnDelete(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet", OnDelete: "APP CASCADE"},
+			{Name: "groups", Type: "Group", OnDelete: "RESTRICT"},
+		},
+	}
+	pet := &load.Schema{
+		Name: "Pet",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", Unique: true, RefName: "pets", Inverse: true},
+		},
+	}
+	group := &load.Schema{
+		Name: "Group",
+		Edges: []*load.Edge{
+			{Name: "users", Type: "User", RefName: "groups", Inverse: true},
+		},
+	}
+	graph, err := NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.NoError(err)
+	u, p := graph.Nodes[0], graph.Nodes[1]
+	require.Equal([]*Edge{u.Edges[0]}, u.CascadeEdges())
+	require.Equal("APP CASCADE", p.Edges[0].OnDelete)
+	require.Empty(p.CascadeEdges())
+	tables := graph.Tables()
+	require.Equal(schema.SetNull, tables[1].ForeignKeys[0].OnDelete, "app-level cascade keeps the default action")
+	require.Equal(schema.Restrict, tables[3].ForeignKeys[0].OnDelete)
+	require.Equal(schema.Restrict, tables[3].ForeignKeys[1].OnDelete)
+
+	user.Edges[1].OnDelete = "SET NULL"
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.Error(err, "set null is not supported for m2m edges")
+
+	user.Edges[1].OnDelete = "CASCADE"
+	user.Edges = append(user.Edges, &load.Edge{Name: "friends", Type: "User", OnDelete: "APP CASCADE"})
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.Error(err, "app-level cascade is not supported for m2m edges")
+
+	user.Edges[2] = &load.Edge{Name: "group", Type: "Group", Unique: true, OnDelete: "DROP"}
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.Error(err, "unknown action")
+
+	user.Edges[2].OnDelete = "APP CASCADE"
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.Error(err, "app-level cascade of m2o edges requires an inverse edge")
+}
+
+func TestNewGraphCountField(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "pets_count", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet", CountField: "pets_count"},
+		},
+	}
+	pet := &load.Schema{
+		Name: "Pet",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", Unique: true, RefName: "pets", Inverse: true},
+		},
+	}
+	graph, err := NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet)
+	require.NoError(err)
+	u, p := graph.Nodes[0], graph.Nodes[1]
+	require.Equal(u.Fields[0], u.Edges[0].Counter)
+	require.Equal(u.Fields[0], p.Edges[0].Counter, "inverse edges share the counter of their assoc")
+	require.Equal([]*Edge{u.Edges[0]}, p.CountedBy())
+	require.Empty(u.CountedBy())
+
+	user.Edges[0].CountField = "name"
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet)
+	require.Error(err, "count field must be an integer field")
+
+	user.Edges[0].CountField = "count"
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet)
+	require.Error(err, "count field does not exist")
+
+	user.Edges[0].CountField = "pets_count"
+	user.Edges = append(user.Edges, &load.Edge{Name: "pet", Type: "Pet", Unique: true, CountField: "pets_count"})
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet)
+	require.Error(err, "count field is not supported by unique edges")
+
+	user.Edges = append(user.Edges[:1],
+		&load.Edge{Name: "children", Type: "User", CountField: "pets_count"},
+		&load.Edge{Name: "parent", Type: "User", Unique: true, RefName: "children", Inverse: true},
+	)
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet)
+	require.EqualError(err, `entc/gen: invalid count field for "User" edges: count field "pets_count" of edge User.children is not supported by O2M edges to the same type`)
+
+	user.Edges = user.Edges[:1]
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeFloat64}}, user, pet)
+	require.EqualError(err, `entc/gen: invalid count field for "User" edges: count field "pets_count" of edge User.pets requires integer (or string) ids, got float64`)
+}
+
+func TestType_MutatedTables(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "followers_count", Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet"},
+			{Name: "following", Type: "Group", CountField: "followers_count"},
+		},
+	}
+	pet := &load.Schema{Name: "Pet"}
+	group := &load.Schema{Name: "Group"}
+	graph, err := NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.NoError(err)
+	u, p, g := graph.Nodes[0], graph.Nodes[1], graph.Nodes[2]
+	require.Equal([]string{"users", "pets", "groups"}, u.MutatedTables())
+	require.Equal([]string{"pets"}, p.MutatedTables(), "the foreign-key of the pets edge is in the pets table")
+	require.Equal([]string{"groups", "users"}, g.MutatedTables(), "the counters of the edge owners are in the users table")
+
+	graph, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}, Features: []Feature{FeatureAudit}}, user, pet, group)
+	require.NoError(err)
+	require.Equal([]string{"pets", "audit_logs"}, graph.Nodes[1].MutatedTables())
+}
+
+func TestGraph_RequiredEdges(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "card", Type: "Card", Unique: true},
+			{Name: "pets", Type: "Pet"},
+			{Name: "group", Type: "Group", Unique: true, Required: true},
+		},
+	}
+	card := &load.Schema{
+		Name: "Card",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", Unique: true, RefName: "card", Inverse: true, Required: true},
+		},
+	}
+	pet := &load.Schema{
+		Name: "Pet",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", Unique: true, RefName: "pets", Inverse: true},
+		},
+	}
+	group := &load.Schema{Name: "Group"}
+	graph, err := NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, card, pet, group)
+	require.NoError(err)
+	u := graph.Nodes[0]
+	require.True(u.Edges[2].OwnFK())
+	require.False(u.Edges[0].OwnFK())
+	require.True(graph.Nodes[1].Edges[0].OwnFK())
+	tables := graph.Tables()
+	require.Equal("users", tables[0].Name)
+	require.False(tables[0].Columns[1].Nullable, "fk of a required m2o edge")
+	require.Equal("cards", tables[1].Name)
+	require.False(tables[1].Columns[1].Nullable, "fk of a required inverse o2o edge")
+	require.Equal("pets", tables[2].Name)
+	require.True(tables[2].Columns[1].Nullable, "fk of an optional edge")
+	require.Equal(schema.NoAction, tables[0].ForeignKeys[0].OnDelete, "not nullable columns can't be set to null")
+	require.Equal(schema.NoAction, tables[1].ForeignKeys[0].OnDelete)
+	require.Equal(schema.SetNull, tables[2].ForeignKeys[0].OnDelete)
+
+	user.Edges[2].OnDelete = "CASCADE"
+	graph, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, card, pet, group)
+	require.NoError(err)
+	require.Equal(schema.Cascade, graph.Tables()[0].ForeignKeys[0].OnDelete)
+}
+
+func TestGraph_EdgeStorageKey(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet", StorageKey: &edge.StorageKey{Columns: []string{"owner_id"}}},
+			{Name: "groups", Type: "Group", StorageKey: &edge.StorageKey{Table: "user_groups", Columns: []string{"user_id", "group_id"}}},
+		},
+	}
+	pet := &load.Schema{
+		Name: "Pet",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", Unique: true, RefName: "pets", Inverse: true},
+		},
+	}
+	group := &load.Schema{
+		Name: "Group",
+		Edges: []*load.Edge{
+			{Name: "users", Type: "User", RefName: "groups", Inverse: true},
+		},
+	}
+	graph, err := NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.NoError(err)
+	u, p, g := graph.Nodes[0], graph.Nodes[1], graph.Nodes[2]
+	require.Equal([]string{"owner_id"}, u.Edges[0].Rel.Columns)
+	require.Equal([]string{"owner_id"}, p.Edges[0].Rel.Columns)
+	require.Equal("user_groups", u.Edges[1].Rel.Table)
+	require.Equal("user_groups", g.Edges[0].Rel.Table)
+	require.Equal([]string{"user_id", "group_id"}, g.Edges[0].Rel.Columns)
+	tables := graph.Tables()
+	require.Equal("owner_id", tables[1].Columns[1].Name)
+	require.Equal("user_groups", tables[3].Name)
+
+	user.Edges[0].StorageKey = &edge.StorageKey{Table: "pets"}
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.Error(err, "table is supported only by m2m edges")
+	user.Edges[0].StorageKey = &edge.StorageKey{Columns: []string{"owner_id"}}
+	user.Edges[1].StorageKey = &edge.StorageKey{Columns: []string{"user_id"}}
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.Error(err, "m2m edges expect two columns")
+
+	user.Edges[1].StorageKey = &edge.StorageKey{Indexes: [][]string{{"group_id"}}, ReverseIndex: true}
+	graph, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.NoError(err)
+	tables = graph.Tables()
+	require.Equal("user_groups", tables[3].Name)
+	require.Len(tables[3].Indexes, 2)
+	require.Equal("user_groups_group_id", tables[3].Indexes[0].Name)
+	require.Equal("group_id", tables[3].Indexes[0].Columns[0].Name)
+	require.Equal("user_groups_group_id_user_id", tables[3].Indexes[1].Name)
+	require.Equal("group_id", tables[3].Indexes[1].Columns[0].Name)
+	require.Equal("user_id", tables[3].Indexes[1].Columns[1].Name)
+	user.Edges[1].StorageKey = &edge.StorageKey{Indexes: [][]string{{"unknown"}}}
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.Error(err, "index columns must be columns of the join table")
+	user.Edges[1].StorageKey = nil
+	user.Edges[0].StorageKey = &edge.StorageKey{ReverseIndex: true}
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.Error(err, "indexes are supported only by m2m edges")
+}
+
+func TestGraph_TableSchema(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name:   "User",
+		Config: ent.Config{Schema: "auth"},
+		Edges: []*load.Edge{
+			{Name: "groups", Type: "Group", StorageKey: &edge.StorageKey{ReverseIndex: true}},
+		},
+	}
+	invoice := &load.Schema{
+		Name:   "Invoice",
+		Config: ent.Config{Schema: "billing"},
+		Edges: []*load.Edge{
+			{Name: "user", Type: "User", Unique: true},
+			{Name: "items", Type: "Item"},
+		},
+	}
+	item := &load.Schema{Name: "Item", Config: ent.Config{Schema: "billing"}}
+	group := &load.Schema{
+		Name:   "Group",
+		Config: ent.Config{Schema: "auth"},
+		Edges: []*load.Edge{
+			{Name: "users", Type: "User", RefName: "groups", Inverse: true},
+		},
+	}
+	graph, err := NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, invoice, item, group)
+	require.NoError(err)
+	tables := graph.Tables()
+	require.Equal("billing.invoices", tables[1].Name)
+	require.Equal("invoices_users_user", tables[1].ForeignKeys[0].Symbol, "symbols are not qualified")
+	require.Equal("billing.items", tables[2].Name)
+	require.Equal("items_invoices_items", tables[2].ForeignKeys[0].Symbol)
+	require.Equal("auth.user_groups", tables[4].Name)
+	require.Equal("user_groups_user_id", tables[4].ForeignKeys[0].Symbol)
+	require.Equal("user_groups_group_id_user_id", tables[4].Indexes[0].Name)
+}
+
+func TestGraph_TimeColumns(t *testing.T) {
+	require := require.New(t)
+	var fields []*load.Field
+	for _, fd := range []*field.Descriptor{
+		field.Time("created_at").Precision(6).Descriptor(),
+		field.Time("birthday").DateOnly().Descriptor(),
+	} {
+		f, err := load.NewField(fd)
+		require.NoError(err)
+		fields = append(fields, f)
+	}
+	graph, err := NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, &load.Schema{Name: "User", Fields: fields})
+	require.NoError(err)
+	columns := graph.Tables()[0].Columns
+	require.Equal("timestamp(6)", columns[1].MySQLType("5.7"))
+	require.Equal("date", columns[2].MySQLType("5.7"))
+	require.Equal("date", columns[2].SQLiteType())
+}
+
+func TestNewGraphSelfM2M(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			// edge.To("following", User.Type).From("followers").
+			{Name: "followers", Type: "User", Inverse: true, Ref: &load.Edge{Name: "following", Type: "User"}},
+		},
+	}
+	cfg := Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}
+	graph, err := NewGraph(cfg, user)
+	require.NoError(err)
+	followers, following := graph.Nodes[0].Edges[0], graph.Nodes[0].Edges[1]
+	require.Equal("following", following.Name)
+	require.False(following.IsInverse())
+	require.Equal("followers", followers.Name)
+	require.True(followers.IsInverse())
+	require.True(following.M2M())
+	require.True(followers.M2M())
+	require.Equal("user_following", following.Rel.Table)
+	require.Equal(following.Rel.Table, followers.Rel.Table)
+	require.Equal([]string{"user_id", "follower_id"}, following.Rel.Columns)
+	require.Equal(following.Rel.Columns, followers.Rel.Columns)
+
+	t.Log("the storage key of the assoc edge applies to both directions")
+	user.Edges[0].Ref.StorageKey = &edge.StorageKey{Table: "follows", Columns: []string{"follower_id", "followee_id"}, ReverseIndex: true}
+	graph, err = NewGraph(cfg, user)
+	require.NoError(err)
+	followers = graph.Nodes[0].Edges[0]
+	require.Equal("follows", followers.Rel.Table)
+	require.Equal([]string{"follower_id", "followee_id"}, followers.Rel.Columns)
+	tables := graph.Tables()
+	require.Equal("follows", tables[1].Name)
+	require.Len(tables[1].Indexes, 1)
+	require.Equal("followee_id", tables[1].Indexes[0].Columns[0].Name)
+}
+
+func TestNewGraphReadOnly(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	}
+	stats := &load.Schema{
+		Name:   "UserStats",
+		Config: ent.Config{Table: "user_stats_view", ReadOnly: true},
+		Fields: []*load.Field{
+			{Name: "pets", Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+	}
+	graph, err := NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, stats)
+	require.NoError(err)
+	require.False(graph.Nodes[0].ReadOnly())
+	require.True(graph.Nodes[1].ReadOnly())
+	require.Equal("user_stats_view", graph.Nodes[1].Table())
+	tables := graph.Tables()
+	require.Len(tables, 1, "read-only tables should not be migrated")
+	require.Equal("users", tables[0].Name)
+
+	stats.Edges = []*load.Edge{{Name: "user", Type: "User", Unique: true}}
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, stats)
+	require.Error(err, "edges are not supported for read-only types")
+
+	stats.Edges = nil
+	user.Edges = []*load.Edge{{Name: "stats", Type: "UserStats", Unique: true}}
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, stats)
+	require.Error(err, "edges of mutable types should not reference read-only types")
+}
+
+func TestNewGraphSkipMigration(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+		Edges: []*load.Edge{
+			{Name: "account", Type: "Account", Unique: true},
+		},
+	}
+	account := &load.Schema{
+		Name:   "Account",
+		Config: ent.Config{Table: "billing_accounts", SkipMigration: true},
+		Fields: []*load.Field{
+			{Name: "plan", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	}
+	graph, err := NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, account)
+	require.NoError(err)
+	require.False(graph.Nodes[0].SkipMigration())
+	require.True(graph.Nodes[1].SkipMigration())
+	require.False(graph.Nodes[1].ReadOnly(), "builders should be generated for the type")
+	tables := graph.Tables()
+	require.Len(tables, 2, "tables of skipped types are referenced by foreign-keys")
+	require.Equal("billing_accounts", tables[1].Name)
+	require.Len(tables[0].ForeignKeys, 1)
+	require.Equal(tables[1], tables[0].ForeignKeys[0].RefTable)
+}
+
+func TestNewGraphInflections(t *testing.T) {
+	require := require.New(t)
+	schemas := []*load.Schema{
+		{
+			Name: "UserStatus",
+			Edges: []*load.Edge{
+				{Name: "status", Type: "UserStatus", Unique: true},
+				{Name: "fish", Type: "Fish"},
+			},
+		},
+		{Name: "Fish"},
+	}
+	_, err := NewGraph(Config{Package: "entc/gen", Storage: drivers, Inflections: []Inflection{{Plural: "fish"}}}, schemas...)
+	require.Error(err, "missing singular form")
+
+	graph, err := NewGraph(Config{
+		Package:     "entc/gen",
+		Storage:     drivers,
+		Inflections: []Inflection{{Singular: "status", Plural: "statuses"}, {Singular: "fish"}},
+	}, schemas...)
+	require.NoError(err)
+	require.Equal("user_statuses", graph.Nodes[0].Table())
+	require.Equal("fish", graph.Nodes[1].Table())
+	require.Equal([]string{"user_status_status_id"}, graph.Nodes[0].Edges[0].Rel.Columns)
+	require.Equal("Statuses", pluralize(graph.ruleset(), "Status"))
+	require.Equal("status", graph.ruleset().Singularize("statuses"))
+	require.Equal("FishSlice", pluralize(graph.ruleset(), "Fish"))
+
+	// inflections are scoped to their graph.
+	graph, err = NewGraph(Config{Package: "entc/gen", Storage: drivers}, schemas...)
+	require.NoError(err)
+	require.Equal("user_status", graph.Nodes[0].Table())
+	require.Equal("StatusSlice", plural("Status"))
+
+	for s, in := range map[string]Inflection{
+		"status:statuses": {Singular: "status", Plural: "statuses"},
+		"fish":            {Singular: "fish"},
+	} {
+		got, err := NewInflection(s)
+		require.NoError(err)
+		require.Equal(in, got)
+	}
+	for _, s := range []string{"", ":fish", "fish:", "a:b:c"} {
+		_, err := NewInflection(s)
+		require.Error(err, s)
+	}
+}
+
+func TestNewGraphReservedNames(t *testing.T) {
+	require := require.New(t)
+	graph, err := NewGraph(Config{Package: "entc/gen", Storage: drivers}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "update", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "label", Info: &field.TypeInfo{Type: field.TypeString}, StorageKey: "t_label"},
+			{Name: "owner_id", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "type", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "T", Unique: true},
+		},
+	})
+	require.NoError(err)
+	fields := graph.Nodes[0].Fields
+	for i, name := range []string{"update_field", "label_field", "owner_id_field", "type"} {
+		require.Equal(name, fields[i].Name)
+	}
+	for i, key := range []string{"update", "t_label", "owner_id", "type"} {
+		require.Equal(key, fields[i].StorageKey())
+	}
+	require.Equal(`json:"update,omitempty"`, fields[0].StructTag)
+	require.Equal("_type", fields[3].StructField())
+
+	_, err = NewGraph(Config{Package: "entc/gen", Storage: drivers}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "update", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "update_field", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.Error(err, "field cannot be renamed")
+}
+
 func TestRelation(t *testing.T) {
 	require := require.New(t)
 	_, err := NewGraph(Config{Package: "entc/gen", Storage: drivers}, T1)
@@ -230,7 +814,7 @@ func TestGraph_Gen(t *testing.T) {
 	require.NotNil(graph)
 	require.NoError(graph.Gen())
 	// ensure graph files were generated.
-	for _, name := range []string{"ent", "client", "config", "example_test"} {
+	for _, name := range []string{"ent", "client", "config", "example_test", "migrate/migrate", "enttest/enttest"} {
 		_, err := os.Stat(fmt.Sprintf("%s/%s.go", target, name))
 		require.NoError(err)
 	}
@@ -242,3 +826,22 @@ func TestGraph_Gen(t *testing.T) {
 	_, err = os.Stat(target + "/external.go")
 	require.NoError(err)
 }
+
+func TestConfig_FeatureEnabled(t *testing.T) {
+	require := require.New(t)
+	c := Config{Features: []Feature{FeatureAudit}}
+	enabled, err := c.FeatureEnabled(FeatureAudit.Name)
+	require.NoError(err)
+	require.True(enabled)
+	enabled, err = c.FeatureEnabled(FeatureUpsert.Name)
+	require.NoError(err)
+	require.False(enabled)
+	_, err = c.FeatureEnabled("unknown")
+	require.Error(err)
+
+	f, err := NewFeature("upsert")
+	require.NoError(err)
+	require.Equal(FeatureUpsert, f)
+	_, err = NewFeature("unknown")
+	require.Error(err)
+}
@@ -1,11 +1,15 @@
 // Package internal Code generated by go-bindata. (@generated) DO NOT EDIT.
 // sources:
+// template/audit.tmpl
 // template/base.tmpl
 // template/builder/create.tmpl
 // template/builder/delete.tmpl
+// template/builder/getorcreate.tmpl
+// template/builder/mutation.tmpl
 // template/builder/query.tmpl
 // template/builder/setter.tmpl
 // template/builder/update.tmpl
+// template/builder/upsert.tmpl
 // template/client.tmpl
 // template/config.tmpl
 // template/context.tmpl
@@ -20,7 +24,9 @@
 // template/dialect/gremlin/predicate.tmpl
 // template/dialect/gremlin/query.tmpl
 // template/dialect/gremlin/select.tmpl
+// template/dialect/gremlin/touch.tmpl
 // template/dialect/gremlin/update.tmpl
+// template/dialect/gremlin/upsert.tmpl
 // template/dialect/sql/by.tmpl
 // template/dialect/sql/create.tmpl
 // template/dialect/sql/decode.tmpl
@@ -32,16 +38,23 @@
 // template/dialect/sql/predicate.tmpl
 // template/dialect/sql/query.tmpl
 // template/dialect/sql/select.tmpl
+// template/dialect/sql/touch.tmpl
 // template/dialect/sql/update.tmpl
+// template/dialect/sql/upsert.tmpl
 // template/ent.tmpl
+// template/enttest.tmpl
 // template/example.tmpl
+// template/fixture.tmpl
 // template/header.tmpl
 // template/import.tmpl
 // template/meta.tmpl
 // template/migrate/migrate.tmpl
 // template/migrate/schema.tmpl
 // template/predicate.tmpl
+// template/rest.tmpl
+// template/shadow.tmpl
 // template/tx.tmpl
+// template/watch.tmpl
 // template/where.tmpl
 package internal
 
@@ -119,7 +132,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templateBaseTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xdf\x6f\xdb\x38\x12\x7e\xb6\xfe\x8a\x81\xe0\x1e\xa4\x42\x95\xd2\xbc\x5d\x80\x3c\x64\xbb\xcd\x21\xc0\x5d\x6f\x81\xf4\xb0\x0f\x8b\x45\xc0\x48\x23\x99\xb0\x44\xaa\x24\xe5\xc4\x10\xf4\xbf\x1f\x86\xa4\x7e\xd8\x4e\x1a\x6f\xaf\xf7\x12\x44\x24\x35\xf3\xcd\x37\xdf\xcc\x50\xee\xfb\xec\x7d\xf0\x49\xb6\x7b\xc5\xab\x8d\x81\xcb\x8b\x8f\x7f\xff\xd0\x2a\xd4\x28\x0c\xdc\xb2\x1c\x1f\xa5\xdc\xc2\x9d\xc8\x53\xb8\xa9\x6b\xb0\x87\x34\xd0\xbe\xda\x61\x91\x06\x5f\x37\x5c\x83\x96\x9d\xca\x11\x72\x59\x20\x70\x0d\x35\xcf\x51\x68\x2c\xa0\x13\x05\x2a\x30\x1b\x84\x9b\x96\xe5\x1b\x84\xcb\xf4\x62\xdc\x85\x52\x76\xa2\x08\xb8\xb0\xfb\xff\xbc\xfb\xf4\xf9\xcb\xfd\x67\x28\x79\x8d\xe0\xd7\x94\x94\x06\x0a\xae\x30\x37\x52\xed\x41\x96\x60\x16\xce\x8c\x42\x4c\x83\xf7\xd9\x30\x04\x41\xdf\x43\x81\x25\x17\x08\xe1\x23\xd3\x18\x82\x5f\x5c\xb7\xdb\x0a\xae\xae\x81\x16\x61\x9d\x7e\x92\xa2\xe4\x55\xfa\x1b\xcb\xb7\xac\x42\x3a\xd4\xf7\x60\xb0\x69\x6b\x66\x10\xc2\x0d\xb2\x02\x55\x08\xeb\xf1\xf5\x79\x8b\x37\xad\x54\x66\xdc\xca\x32\xf8\xb7\xa2\xc8\x58\xdb\xd6\x1c\x35\x30\x01\x92\x16\xb8\xa8\x40\x0a\x40\x6e\x36\xa8\xa0\x52\xac\xdd\x80\x51\x6c\x87\x4a\xb3\x1a\xa4\x02\xfd\xad\x06\x8d\xb5\x8d\x28\x0d\xcc\xbe\x45\x6f\xa9\xec\x44\x1e\xf5\x3d\xf0\x12\x2a\x03\x51\x8d\x02\xd6\xe9\xbd\x91\x8a\x55\x18\xc3\x47\x18\x06\x2e\x0c\xaa\x92\xe5\xd8\x0f\x7d\x0f\x58\x6b\x0a\xa0\xef\x21\xe2\xa2\xc0\xe7\xf9\x34\x5c\xc4\xe9\x2f\x1d\xaf\xc9\xaa\x3d\x80\xa2\x80\x61\x88\x6d\x44\xaf\x9b\x9f\x82\xfa\x0d\xd5\xaf\x9c\x11\x44\xc8\xa5\xd0\x46\x75\xb9\xb1\xe9\x08\x6d\x88\xf0\xb8\x0f\x21\xaf\x59\x67\x33\x78\x12\xa4\xb6\x5c\x17\xc4\x42\xe1\xad\x50\x94\x69\x40\x01\x1e\x3b\xa0\x80\x15\x13\x15\xc2\x9a\x27\xb0\xd6\x3e\x80\xab\xeb\x45\x34\x36\x04\x5e\xc2\x9a\xc3\x30\x24\x53\x38\x25\x65\x97\x96\x26\xe6\xc6\xd7\x17\xc1\xc7\x73\xf4\x9e\xe6\x3e\x58\x29\x34\x9d\x12\xee\x39\xb2\xa8\xa2\x1d\x2c\xc8\x8d\xe9\xd0\x4a\x3f\x71\x93\x6f\x60\x47\x60\x76\x69\x44\x31\xb8\x8d\xbe\xff\x70\x06\xe6\x60\xb5\xca\x49\x73\x2f\xe3\xba\x0a\x56\xab\xd5\x14\x41\xb4\x8b\xbd\x5d\x87\x35\x58\xad\x0a\x2c\x59\x57\x1b\x7b\xae\x65\x82\xe7\x51\xd9\x98\xf4\xbe\x55\x5c\x98\x32\x0a\x3b\xb1\x15\xf2\x49\x58\x66\x6d\x12\x6c\x66\xae\xe0\xdd\xd7\x30\x81\x5d\x4c\xe6\x86\x60\x35\xc4\x81\x15\xb8\xb7\x1a\xcc\x64\x97\x09\xac\x5d\x32\xaf\xae\xdd\xbb\xd6\x2d\x01\x2a\xe1\x1a\x5a\xa6\x73\x56\xd3\xff\xb4\x9a\x65\xe0\x36\x86\x61\xd2\x3b\xc9\xa1\xe2\x3b\x14\x50\x72\xac\x0b\x4d\x15\xdb\xf7\xd0\xb5\x2d\x2a\x7f\xd4\x9a\x4d\x83\x95\x65\x78\x34\x10\xf9\xe3\x69\x9a\x6a\x43\xd5\xb2\xc8\xcb\x41\x62\xbe\x2b\xd5\x59\x40\x53\x74\x11\x31\x35\x07\xf8\xf0\x5a\x66\x3e\x58\x7a\x1d\xdb\x4f\xdc\x6c\x00\x9f\x0d\x59\x98\x8a\xe8\x8b\x2c\x50\xc3\x45\x0c\xe1\x6d\x27\xf2\x90\x60\x87\x16\x51\x38\x52\x36\x9a\xb0\x74\x99\xa6\xad\xc9\x83\xcb\x0c\x84\x5e\xf3\xd9\x3b\x9d\x49\xff\x96\x5e\x8a\xc2\xbb\x7e\x9e\x3a\x8b\xb3\x90\x92\xb6\x3d\x30\x1b\x91\x77\x72\xf0\x14\x07\xab\x83\x7c\x66\x19\xdc\x54\x95\xc2\x8a\xec\x2c\x3a\x11\xf3\x8b\x5c\x0a\xd0\x06\x5b\xaa\x45\x9b\x30\x25\xbb\xf6\xc3\xe3\x7e\x2e\xd6\xec\xa8\x15\xcd\xe6\x7c\xd9\xf7\xc1\xf9\xa4\xbe\x41\x87\xf5\x9e\x69\x5e\x09\x66\x3a\x85\xc7\xc4\xbc\xc6\x4a\xb0\xe4\x60\x08\x5c\xd4\x9a\x86\x0c\x83\x56\x63\x57\xc8\x83\x78\x49\x6d\xee\x1f\xa9\x40\xa1\x60\x0d\xb5\x64\x26\xa4\x6d\xc8\xee\xef\x78\x46\x3b\x05\xe4\x9d\x36\xb2\x01\xc1\x1a\xd4\x29\xdc\x4a\x05\xf8\xcc\x9a\xb6\xc6\xab\x20\xcb\x82\x2c\x5b\xfd\x83\x90\xff\xb2\x77\xda\xfd\x98\x38\xc9\x5f\xc6\x29\xed\x4d\x8c\x45\xe3\xb4\x19\x86\xf4\x46\x2f\x9f\xee\xbb\xc6\xbf\x1a\x27\x10\xea\xae\x79\x70\x4f\x61\x9c\xc0\x19\x6f\x5d\x1e\xbc\x75\x19\xc6\xce\xf1\x7d\xce\x44\x94\x9b\xe7\x04\xfe\xb6\x8b\x09\xa8\xad\xb3\x1b\x1d\x95\x62\x4e\x63\x62\x99\x1b\x2b\x6d\xce\xee\xdc\x05\xa7\x35\xd7\xda\xce\xad\x9f\xb3\x72\xcd\xf4\x89\xfa\x29\xcb\x53\x3f\xbc\x2b\x50\x98\x2f\xac\xa1\xcd\x2b\x6a\x11\xaf\x55\xc5\xb2\x0a\xac\x08\x66\xa0\x94\xb5\x04\xd6\x94\xc8\x5b\xe2\x87\x00\xb1\x29\xce\xa9\xa9\x09\x0b\xd4\xb7\x35\xe1\x5c\xfe\x6c\x69\xdb\x61\x79\x2a\x6b\xea\x63\x1b\xa6\xbf\x1e\x86\x36\xd1\xf8\x46\x13\x22\x7a\x42\x0f\x79\xea\x48\x62\xd1\xc6\x5e\x29\x9a\x89\xb4\x11\xc5\xf8\xff\xa2\x7f\x4c\x9d\x5d\x1c\xb7\xf6\xbe\x87\x6f\x9d\x34\x38\x71\xf5\x72\x8d\x49\xdb\xf4\x69\x36\xcf\xfc\x0f\xc3\xd1\x6c\xa0\x6b\xdb\xe4\x14\x59\xbe\x71\x7d\xe8\x60\x32\x10\x80\xe8\x05\x53\xce\x80\xd3\xef\x62\x94\x1f\x08\xf9\x44\xc9\x76\xf1\xff\x34\x0b\x04\x84\xbf\x8f\xf8\xc2\x25\xd6\x73\x87\x82\x93\x4a\xe9\xcc\xbd\x34\x19\xfe\x7a\x75\x7c\x77\x66\x0c\xa7\x33\xe3\xb3\x52\x5f\xa4\xb9\xa5\xcb\x37\x38\xee\x34\x3c\x6d\x50\x80\x51\x7b\x6a\x96\x46\x42\x89\x74\xf7\x61\xa0\x5b\xcc\x79\xc9\x73\x40\x61\xb8\xd9\x03\x13\x05\x70\x03\x4f\x4c\x83\x90\xc6\x5d\xe0\xc7\xcb\x7a\xc1\x0c\xa3\xab\x9f\x1f\x25\x4b\x2f\xf3\x30\xa9\xd9\x23\xd6\x3e\xa1\xc1\x04\x47\x2a\xe0\xd4\x70\x1b\x14\xc6\x09\x10\xdd\xe2\x78\x29\xf3\xb7\xc7\x08\xe1\xfd\xc2\x6e\xec\xde\x8d\x62\x6f\x70\xd1\xd6\x0e\x6e\x4b\x73\x57\xbd\x82\x77\x0b\xe4\x61\x02\x98\x5a\x44\xb1\xc7\x72\xa7\x4f\x98\x61\xf0\x28\x65\x8d\x4c\x00\x17\x05\xcf\x99\x21\x47\x4f\x1b\xb4\x93\x64\x01\x95\x4e\xce\x9c\xd8\x45\x8f\x7a\x36\x1a\xa1\x52\x6e\x2b\xb6\x56\x09\xf0\x43\x02\x72\x4b\x62\x41\xa5\xd2\xe8\x20\xbc\x29\x1a\xb9\xf5\xf8\xfe\xc5\xf4\x76\x42\xd8\x30\xbd\xa5\x68\xd4\x0b\x3e\x97\x07\x97\x5e\x1d\xd8\x3e\x58\xf1\xf2\x08\x57\xbc\xac\x25\xc1\x6b\xd2\xcd\xf8\x88\x4a\x05\x4b\xed\xdc\x73\x51\x75\x35\x53\x6f\xca\x67\x3c\xb7\x90\x4f\x23\x15\x12\x6f\xd4\x3d\xd0\x2a\xe9\x6d\x15\x4d\xfe\x7e\xbe\x90\x46\xd3\xff\x83\x96\xc6\x28\x5f\x91\xd3\x09\x59\x7f\x55\x51\x33\x8b\xc7\xa2\x1a\x4d\x9f\xad\xab\x29\xda\x23\x69\x79\xfa\x3e\xd9\xcf\x3d\xc6\x85\xb9\x65\xbc\xc6\x57\xdb\x43\xae\x90\x19\xcc\xba\xb6\xa0\x6e\x44\x79\x94\xca\x25\xd6\x26\xda\x5d\x43\x0b\x32\xba\xdc\xb3\x9f\xef\xc8\x95\xff\xaa\x24\x37\x1a\x4a\xeb\xe8\xe0\xda\x95\xc0\x8e\xcb\xda\x8d\x1a\x59\x02\x16\x95\xb5\xe1\x66\x41\x27\xf8\xb7\x0e\x05\x6a\x3d\x2b\xe4\x04\xf6\x2c\x93\x46\x57\xa3\x48\x56\x4f\x8a\xb5\x8e\xa5\x1f\x12\xcc\x0b\x8e\x7e\x44\x34\x2e\x80\x05\x07\x9e\x02\x92\x93\x55\x50\xa3\xab\x51\x3f\xff\x11\x16\xf3\x4b\x08\x75\xfa\xbb\x62\xf6\x6b\xeb\x15\x6d\x9f\x62\x75\xd6\xa2\x45\x13\x18\xcb\x3b\xa5\x8d\x49\xb3\x87\x6f\x76\x0a\x7f\x48\xb9\x47\x01\x76\x0a\x27\xe5\x9e\x38\x38\x4f\xbf\x27\x11\x1d\x89\xf8\xdc\x89\xff\xc6\x84\x76\xf4\x1e\x4d\xe7\xef\x5e\xe3\x5e\x98\xcd\xeb\xa3\xbb\xd6\x62\x26\xdb\x9f\xa5\xb8\xbd\xa8\x9e\x5c\x34\xd2\xbb\x5f\xd3\xaf\xa4\x6a\xf7\x73\xcc\x16\xf7\x7a\x22\x9f\xf8\xa5\x85\x8c\x17\x1a\x4a\x25\x1b\xc7\x38\x55\x47\xc3\x5a\x4f\x2e\x1d\x88\x1a\x5a\xf8\xc3\xbb\x19\x86\x3f\x5d\x39\xf4\x43\x0c\x7f\xfc\x39\xad\x12\xc7\x9a\x40\x34\x6c\x8b\xd1\x62\x23\x81\x8b\x04\x6a\x14\x51\x13\xc7\xc1\x8a\x3e\xa3\x78\x91\xc0\x03\x1d\x75\xf4\x36\xee\xf7\x11\xb8\xa6\x2b\x23\x8a\x22\xd2\x09\xf0\x22\x5e\x0e\x0c\x7d\xf0\xc3\xc3\x7f\x03\x00\x00\xff\xff\xa9\x1b\xe5\x1c\x61\x14\x00\x00")
+var _templateAuditTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x57\x6d\x6f\x1b\x37\xf2\x7f\xbd\xfc\x14\xd3\x45\xfe\xfa\xef\x06\x3a\x2a\xd7\x77\x97\xc2\x07\xf8\x6c\x07\x30\xda\x8b\xaf\xb5\x7b\x7d\x11\x04\x29\xb5\x1c\x49\xbc\x50\xe4\x86\xcb\xb5\x25\x08\xfa\xee\x87\xe1\xc3\xae\xd6\x52\x0f\x68\x80\x00\x26\x67\x38\x0f\xbf\xf9\xcd\xcc\xea\x70\x58\xbc\x65\x37\xb6\xdd\x3b\xb5\xde\x78\xf8\xfe\xdd\x5f\xff\xf6\x97\xd6\x61\x87\xc6\xc3\x07\xd1\xe0\xd2\xda\xaf\x70\x6f\x1a\x0e\xd7\x5a\x43\x50\xea\x80\xe4\xee\x19\x25\x67\x4f\x1b\xd5\x41\x67\x7b\xd7\x20\x34\x56\x22\xa8\x0e\xb4\x6a\xd0\x74\x28\xa1\x37\x12\x1d\xf8\x0d\xc2\x75\x2b\x9a\x0d\xc2\xf7\xfc\x5d\x96\xc2\xca\xf6\x46\x32\x65\x82\xfc\xa7\xfb\x9b\xbb\x8f\x8f\x77\xb0\x52\x1a\x21\xdd\x39\x6b\x3d\x48\xe5\xb0\xf1\xd6\xed\xc1\xae\xc0\x9f\x38\xf3\x0e\x91\xb3\xb7\x8b\xe3\x91\xb1\xc3\x01\x24\xae\x94\x41\x28\x45\x2f\x95\x2f\x21\xdd\xbe\x69\xbf\xae\xe1\xfd\x15\x2c\x45\x87\xf0\x86\xdf\x58\xb3\x52\x6b\xfe\x2f\xd1\x7c\x15\x6b\x24\xa5\xc3\x01\x3c\x6e\x5b\x2d\x3c\x42\xb9\x41\x21\xd1\x95\xf0\x86\x24\x4c\x6d\x5b\xeb\x3c\x54\xac\x28\x1b\x6b\x3c\xee\x7c\xc9\x8a\x12\x4d\x63\xa5\x32\xeb\xc5\x7f\x3a\x6b\xe8\x62\xb5\x0d\xf7\x5e\x6d\xb1\x64\xac\x28\xd7\xca\x6f\xfa\x25\x6f\xec\x76\xb1\x4a\xf0\x29\xd3\xf4\x4b\xe1\xad\x5b\xa0\xf1\x0b\xa9\x84\xc6\xc6\x97\x7f\x42\x77\xd1\x7d\xd3\x25\xab\x19\x5b\x2c\xe0\x9a\x12\x04\x6d\xd7\xe0\xc5\x52\x23\x08\x23\xa1\xb1\xba\xdf\x9a\x8e\xb3\xc6\x9a\x2e\x84\x1c\xb4\x9e\x82\xc2\xf0\xef\x2a\xa1\xf3\x45\xdb\x75\x57\x26\x9d\x0f\x0a\xb5\xbc\xbf\x3d\xd1\x51\x72\x22\x7b\xda\xb7\xc9\xc6\x15\x94\x7e\xdf\xe2\x44\x7a\x67\xbc\xf2\x7b\x7a\x7f\x05\x25\x86\xc3\x97\x57\x06\x1e\xda\x13\xe3\xb6\x9d\xc8\xae\xa9\xb4\x59\x26\xe8\x30\x11\xdf\x6c\x84\x59\x63\x17\xc5\x4d\x3c\x4c\x15\x1c\x0a\x8f\xf2\xda\x07\x85\x78\xf8\x22\xfc\x39\x56\xb6\x45\x27\xbc\xb2\xe7\x20\xdd\x84\x57\xe3\xfb\x6c\xff\xd7\x56\xa6\xfb\xbe\x95\x27\xf7\xb7\xa8\x31\xde\xcb\xf0\xd7\xc4\xd7\x4f\x76\x4d\x0d\x20\xc0\x61\x63\x9d\x24\xce\x0a\xd8\xf6\x3e\xb8\xce\xbc\x16\x39\x2a\xce\x08\xcf\xf1\x65\xe7\x5d\xdf\x78\x38\xb0\x62\xb1\x80\xfb\x5b\x7a\x4d\xfa\xd1\x16\x67\xc5\xfd\x2d\x28\xe3\xe1\x77\xa2\xde\xfb\x52\xc9\xb9\xdd\x2a\x62\xaf\xdf\x97\xbf\x87\x37\xa1\x56\xaa\x0b\xdd\xa3\xc5\x12\x75\x36\x11\x42\x40\x09\xb1\x42\x40\x6e\x39\x2b\x82\x7a\xe7\x9d\x32\xeb\x6c\x94\x24\x67\x66\x87\x22\x27\xd3\x4a\x66\xbb\xd9\xde\x46\x78\x78\x11\x5d\xf6\xc3\x59\x31\xbc\x99\xda\x1f\x28\x72\xe6\xe4\xa1\xcd\xe6\x07\xbc\x86\xa2\x41\x15\x6b\x33\x87\x58\x0b\xb0\x0e\x22\xfa\x35\x67\xc5\x43\xfb\xca\x89\x6d\xcf\xac\x47\xa2\x25\x07\x81\x68\x63\xd0\x9d\xb7\x0e\x65\xae\x4e\xea\xf5\x09\x72\xca\x1a\xce\x8a\x68\x63\xea\x2a\x98\x3a\xf3\x96\x79\xbb\xb1\x5a\x46\x97\x91\xbc\x12\x56\xd4\x6e\xdd\x05\xe3\xf9\xc9\xa7\xcf\x27\xcc\xcf\x5e\x12\xf5\xcf\xfd\x0c\xf4\x4f\x99\xd1\x14\x9a\x58\x0e\x55\x89\x04\x0a\x65\x19\x5f\x90\x2a\x7f\x52\xdb\xd1\xc9\xd0\x3e\x13\x3f\x47\x16\x59\x1a\x32\xfd\x11\xf7\x89\xa5\x87\x63\x98\x46\xbf\x29\xbf\x89\xb8\x38\xf4\xbd\x33\xc4\x7d\x83\x2f\x03\x8a\x2f\xca\x6f\x42\x40\x6b\xf5\x8c\x26\x1a\x01\xe1\x3d\x6d\x03\xc9\xc9\xc2\xd3\x50\x10\x35\x46\x7a\xd6\x2a\x84\x58\xce\xa9\x03\xdc\x61\xd3\x7b\x94\xd1\xbc\xf2\x9c\xad\x7a\xd3\x8c\xc1\x54\xad\x70\xb4\xbf\x52\x14\x34\xf7\x29\x9a\x79\x72\x14\x4b\x58\xbf\x16\x53\xe3\xc5\x2c\x06\x09\x59\xfc\xb7\xd0\x3d\x26\x8b\xc9\xc2\x8f\xb8\x3f\x1c\xd3\xdf\x35\x8b\x48\x04\xc7\x1f\x9c\xdd\x66\x6b\x19\x90\x91\x71\x23\xd1\x44\xf6\x30\x27\x2a\x0b\x03\xa1\x8b\x33\xb7\x54\x60\x87\xa3\x5d\x6a\xfe\xdf\x83\x35\x98\x12\x7c\xed\xa3\x6a\xfc\x6e\x88\x35\xdd\xd5\xd9\xca\x81\x15\xc1\xed\x1c\xbe\xd0\x0a\x6c\xfc\x8e\xc7\x54\xc6\x14\x6a\x5e\x25\x2c\x86\xcc\x83\x90\x1d\x27\x23\xad\x9b\xe6\x32\xd4\x24\x56\xab\x7b\x35\x0c\x5e\x95\x3c\x90\x27\x0c\x23\x42\x89\xb6\x95\x92\x94\xb5\x44\x6a\xba\xe5\x9e\x54\x95\x83\xd0\xe0\xa1\xcf\x42\xa6\x55\x03\x6f\x6f\xb4\x42\xe3\xeb\x31\x8c\x4b\xe9\xce\x69\x98\xa5\x94\xe7\xa0\xa8\x8d\x3d\x3a\xda\xa6\x87\x63\x0d\xd5\xa7\xcf\x6f\xf3\xf3\x39\xa0\x73\xd6\xd5\xa1\xcc\xf6\xa5\x23\x50\x66\xdd\x37\xcd\x7f\xb1\x2f\xdd\xe1\xc8\x8a\x6f\x3d\xba\xfd\x1c\x84\x5b\x07\x19\x89\x1e\x91\x56\x75\x35\x2e\x9c\xfb\xdb\x39\x8c\x27\x1a\xa0\xa7\xe7\x3c\xf4\x4e\xef\x1e\xda\xd3\x53\xa8\xe0\xe9\x45\xea\xfb\xc9\x55\xee\x51\x1a\x6e\x05\x51\xaa\xa2\x58\xc2\x36\xaf\xc6\xc5\x5e\x07\xf1\x6f\x1b\x74\x18\xe4\x77\x3f\x9f\xc4\x19\x23\xf3\xfb\xb6\xe6\xd7\x46\x56\xf5\x54\x3a\xc6\xb9\xda\x7a\xfe\xd8\x3a\x65\x7c\xa5\x64\x1d\x4d\x3e\x38\x89\xee\x1f\xfb\x13\xfd\xfb\xdb\x20\xf8\x99\x00\xaa\x6a\x56\xa8\x15\x61\x49\x20\x35\x5c\x3a\xf5\x8c\x8e\x47\x59\xe3\x77\x73\x38\xc1\x71\x0e\xce\xbe\x74\xf5\x0f\x41\xfd\xbb\x2b\x30\x4a\x13\xfc\x99\x6c\x46\xe9\x50\x15\x56\x1c\x59\x21\x71\x85\x2e\xe8\xf3\x1b\x6d\x3b\x24\x47\xcf\xc2\x11\xd3\x3a\x38\xa9\x23\x2b\x56\x36\xe9\x7d\xc4\x9d\xaf\x42\x41\x83\x66\xc5\x8a\xa2\xd0\xc3\xc7\xc6\x2c\xbf\xa0\xe2\x16\xb1\x19\x00\x80\xb0\xfa\xd8\x6b\xfd\x18\x38\x43\x92\x34\x5e\xe1\xd3\xe7\xe5\xde\x23\x2b\x8a\x9a\x15\x27\x39\x06\x57\x8f\x8d\x30\xd5\x4c\x73\x02\x6d\xa6\x79\xc4\x77\xa6\xf9\x08\xe5\x4c\x73\xaa\xf5\x2c\x35\xdd\x2c\x0f\x6d\x98\x69\x3e\x8c\xdd\x73\x28\x26\x58\x50\x39\xee\x88\xa5\xab\xaa\xcc\x9f\xaf\xc7\xe3\x7b\x58\x09\xa5\x51\x42\xd7\x08\x63\xa8\xb7\x9d\x7d\x21\xa2\xdb\xa1\x37\xde\xc3\xff\x3d\x97\x01\x4b\x8a\x9d\xf2\xd5\x3c\x70\x0d\xae\xe2\x98\xe2\x43\xba\x6a\x05\x1a\x4d\x95\xc2\xab\xe1\xef\xf0\x2e\x20\x78\x92\x31\x6d\x1d\xfe\xab\xd9\x0a\xd7\x6d\x84\xce\xaa\x21\xc5\xc4\xd7\x0b\x79\xfc\x99\x44\xfa\x6c\x9b\x92\x89\x43\x3e\x39\x99\x26\x42\xbc\x08\xff\x03\x09\xae\x40\xb4\x2d\x1a\x59\xd1\x69\x0e\xba\x0e\xbc\x49\x6e\xe3\x5d\xa8\xd5\x9d\x73\x55\x1e\xcb\xd1\x78\x9e\x54\x93\xd5\x98\xc6\x56\xdc\x4c\x69\x78\x9d\xed\x9d\xbe\xa3\x10\xe9\x9d\x77\xc2\x74\xa2\x39\x7d\x9a\x4d\xa5\xa1\x15\x7c\xfd\xc1\x90\xda\x41\xfa\x96\xe7\x4f\xbb\xff\x35\xb3\xe6\x60\x47\x51\x73\xe9\xa3\xa0\x26\x70\xac\xa3\x9a\x2d\xfb\xd5\x7c\x52\xb3\x7f\x4e\x2b\x36\xb6\xea\x49\xa1\x12\x5e\xb9\xed\x96\xbd\xd2\xf4\x2b\x2d\x4d\xbc\x7b\xd3\xa1\x4b\x13\x2f\x8e\x19\xea\xfc\x47\xf4\x97\x87\xcb\x99\xec\x0f\x47\xcb\xb9\x19\xea\x16\x7b\xc9\xc6\x30\x13\x97\xfd\xea\x92\x38\x37\xd3\x3c\x7e\xc4\x7c\xb4\x2f\x55\x1d\x53\x0d\x54\xa7\xb9\x74\x69\x53\xd6\x3f\x24\xf9\x77\x57\x50\x96\x01\x8b\x94\x3c\x9f\x3a\x48\x43\x3a\xad\x77\x02\x89\xa6\x8b\xc3\x2e\x20\xf4\x0b\x76\xbd\xf6\x67\xeb\x22\x9b\x1a\x86\x64\xc2\xd9\xef\xf8\xdd\x0e\x9b\x0b\xa3\x71\xe6\xa8\x42\xf1\x87\x2a\x1a\x09\xc7\x23\xfb\xef\x00\xc8\x55\xa2\xcc\x8d\x0f\x00\x00")
+
+func templateAuditTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateAuditTmpl,
+		"template/audit.tmpl",
+	)
+}
+
+func templateAuditTmpl() (*asset, error) {
+	bytes, err := templateAuditTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/audit.tmpl", size: 3981, mode: os.FileMode(420), modTime: time.Unix(1791974922, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateBaseTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x5a\x5b\x6f\xe3\xb6\x12\x7e\xb6\x7f\x05\x21\x24\xad\xbc\x70\x94\x6c\xce\xd3\x09\x90\x87\x34\x97\x9e\x00\xd9\x74\x4f\x93\x9e\x3e\x2c\x16\x5b\x5a\xa2\x6c\x9e\xe8\x16\x52\xb2\x63\x04\xf9\xef\x9d\x19\x92\x12\x25\x3b\x97\x4d\x77\x51\x6c\x25\x71\x38\x9c\xcb\x37\x37\x7a\x1f\x1f\xf7\x3f\x8c\x4f\xcb\x6a\xad\xe4\x7c\x51\xb3\xc3\x83\x8f\xff\xde\xab\x94\xd0\xa2\xa8\xd9\x05\x8f\xc5\xac\x2c\xef\xd8\x65\x11\x47\xec\x24\xcb\x18\x11\x69\x86\xeb\x6a\x29\x92\x68\x7c\xbb\x90\x9a\xe9\xb2\x51\xb1\x60\x71\x99\x08\x06\xaf\x99\x8c\x45\xa1\x45\xc2\x9a\x22\x11\x8a\xd5\x0b\xc1\x4e\x2a\x1e\xc3\xff\x0e\xa3\x03\xb7\xca\xd2\x12\x96\xc7\xb2\xa0\xf5\xab\xcb\xd3\xf3\xeb\x9b\x73\x96\xca\x0c\x58\x98\x6f\xaa\x2c\x6b\x96\x48\x25\xe2\xba\x54\x6b\x56\xa6\xf0\xb5\x3b\xac\x56\x42\x44\xe3\x0f\xfb\x4f\x4f\xe3\xf1\xe3\x23\x4b\x44\x2a\x0b\xc1\x82\x19\xd7\x22\x60\xf6\xe3\x4e\x75\x37\x67\x47\xc7\x0c\x3f\xb2\x9d\xe8\xb4\x2c\x52\x39\x8f\x3e\xf3\xf8\x8e\xcf\x05\x12\x01\x4d\x2d\xf2\x2a\xe3\x35\x6c\x5d\x08\x0e\xe2\x06\x6c\xc7\x6d\xef\x96\x64\x5e\x95\xaa\x76\x4b\xfb\xfb\xec\x37\x85\x9a\xf1\xaa\xca\xa4\xd0\x8c\x17\xac\xc4\x0f\xb2\x98\xb3\xb2\x60\x42\x82\xf8\x8a\xcd\x15\xaf\x16\x20\x27\x5f\x0a\xa5\x79\x06\x24\x4c\xdf\x67\x4c\x8b\x8c\x34\x8a\xc6\xf5\xba\x12\x96\x53\xda\x14\x71\x08\x47\xca\x94\xcd\x6b\x16\x66\xa2\x00\x79\x6f\x80\x0a\x04\x9d\xb0\x8f\x70\xac\x2c\x6a\xa1\x52\x70\xc8\xe3\x13\xd0\x89\x4c\xa3\x02\xf0\x14\x4a\x30\xf2\x43\x47\xcd\x0e\x26\xd1\x2f\x8d\xcc\x90\x2b\x11\x88\x22\x81\x87\x09\x69\xf4\x3c\xfb\x56\xa9\xcf\x42\x9d\x49\x8e\x22\x82\x3f\x0b\x5d\xab\x06\x9e\xd0\x1d\x01\xa9\xc8\x66\xeb\x80\xc5\x19\x6f\xc8\x83\x1b\x4a\x6a\xb2\x75\x82\x56\x48\x2c\x17\xd4\x32\x1a\xa3\x82\xc3\x03\x50\x61\xc5\x0b\x90\x79\x47\x4e\xd9\x8e\xb6\x0a\x80\xc3\x3a\x6d\x48\x05\x10\x7b\x47\xc2\xe3\xb4\x55\x27\x45\xef\xe2\xa7\xd6\x72\x6e\xbb\xa7\xfc\xa4\xd3\xde\x9a\xf9\x71\x3c\x52\xa2\x6e\x54\x61\xde\x43\x92\x2a\x5c\x32\xcf\xb8\x13\x24\x1a\xe9\x95\xac\xe3\x05\x5b\xa2\x30\xcb\x28\x44\x1d\xcc\xc2\xe3\xe3\xde\x1b\x64\x06\xc2\x18\x31\xb7\x5d\xae\x23\x58\x1e\xb5\x1a\x84\xcb\x89\xe5\x6b\x64\x85\x17\x00\x33\x6f\xb2\x9a\xe8\x2a\x5e\xc8\x38\x4c\xf3\x3a\xba\xa9\x00\x60\x75\x1a\x06\x4d\x71\x57\x94\xab\x82\x2c\x4b\x4e\x20\xcf\x1c\xb1\xdd\xdb\x60\xca\x96\x13\x64\x07\x5c\xc0\xe5\x04\x70\xcb\x75\xdc\x19\x3b\x05\xc1\x8d\x33\x41\x6c\xf3\x80\xc7\x7a\xde\xc0\x85\x07\xf3\xf2\x2f\x23\x12\x4a\x5b\xf0\x9c\x34\xad\xb8\x8e\x01\xcf\x3b\xa9\x5b\xda\x43\x0f\x89\x7b\xdc\x78\x60\xbe\x8d\x00\x4e\xed\x16\xf0\x92\x8b\x14\x04\xd2\x5c\x2e\x01\x7f\xa9\x14\x59\xa2\x31\xd6\x81\xae\xa9\x2a\x10\x82\x18\x1a\x81\x22\x67\x12\x83\x73\xe4\x48\xec\x9a\x0c\x20\x06\x22\x04\x17\x52\xe9\x3a\x68\xe1\x61\x0e\x3f\x34\xef\x96\x0c\xa8\xae\x78\x4b\xd4\x1a\xb7\x53\x05\x34\x41\x8b\xda\xb7\xe0\x1a\x77\x05\x6e\xf7\x0f\x51\x63\x0a\xa9\x21\x61\x90\x44\x62\xd8\x74\xfd\xc7\xd5\x15\x5b\xf2\xac\x81\x67\x20\xce\xca\x15\x12\xbb\xd3\x22\x23\x5a\x8b\xae\x6f\xaf\x45\x04\xaa\xec\xb0\x75\x4d\x0a\xcc\x95\xc8\x33\x59\x04\x56\x76\x14\xfe\xba\x84\xf4\x55\x2f\x78\x3d\x25\x99\x49\x92\x1c\x53\x3b\x64\x53\x5f\x1e\xc8\xab\x05\x64\x5b\xa3\x1f\x85\xef\xaf\x86\xd9\x94\xad\x16\x12\x22\x41\x89\xfb\x06\x52\xb1\x51\xdd\x2a\x5d\x97\x4c\x3c\x48\x5d\xb7\xa2\x1b\x1b\xfb\xc6\xee\xc1\x9a\xa2\xcd\x33\x67\x68\xf9\x44\x51\x04\x79\x06\x92\xa7\x17\xa6\xa3\x5e\xa0\xbe\x98\xba\xba\x84\xd2\x9e\x1c\x92\xfe\x1d\xa0\x9f\x35\xe6\x9e\x35\x15\x09\x0a\x31\xbf\x00\x8d\x6a\xe4\xd1\xa6\xd5\x6b\x28\x6a\x1a\x92\x2a\x40\x0e\xc4\x0f\x1c\x58\x48\xac\xc0\xc5\x51\xcb\x86\xc0\x55\x43\xd9\xa0\x38\xa1\x78\x65\x81\xcd\x84\xfb\xbb\x7a\xbf\xb4\xdb\xb4\x9f\x2a\xdc\xf9\x0f\x6d\xc1\x31\x2c\x22\x4c\x79\x4e\x3c\xd2\xcc\x9d\xd3\x7f\xb5\x01\xdf\xd9\xdd\x8b\x7a\xc0\xc0\xc9\x1c\x80\x31\x47\xb6\x5e\xbd\xe2\xf6\xa3\x04\x57\xeb\x5a\x54\xe8\x72\x42\xb5\x2a\x9b\x6a\x6f\xb6\xee\x52\xfa\xfe\xa0\x60\x75\xec\x6c\x71\x78\x1c\xbf\xdd\xd2\xaf\x98\x87\x4e\xdf\xd7\x72\x5e\x70\x70\xbe\x18\x1a\xea\x39\x23\x8d\x7d\x83\x80\xd2\xa4\x35\x81\x9a\xb3\x4a\x8b\x26\x29\x7b\xfa\x22\x0e\xcd\x03\xe4\x4e\x25\xc0\xa1\x58\xb8\x39\x04\x00\x96\x6d\xf3\xb7\xa3\xd1\x06\x15\x71\x03\x82\xe4\x0c\x7d\xaf\x23\x76\x01\xfb\xc4\x03\x87\xe3\xc5\x11\x9c\x05\xff\x8d\x7e\x45\xc9\x7f\x59\x1b\x48\x7f\x9c\x9a\x10\x39\x9c\x44\xb8\xd6\x5a\x2c\x74\x3d\x09\x84\xfb\x89\xf6\xdf\x6e\x9a\xdc\x6e\x9d\x4c\x59\xa0\x9b\xfc\x9b\x79\x0b\xe0\xf5\x0d\xbb\x0e\x7b\xbb\x0e\x83\x89\x39\xf8\x26\xe6\x45\x18\xd7\x0f\x53\xf6\x13\x14\x19\x10\x94\x22\x10\x78\xa4\x45\xe7\xc6\x29\x59\xce\x05\x60\xe7\xdd\xae\x56\xb6\xdf\x4c\x01\x7c\x6b\x50\xbd\xc9\xd7\x5c\x6f\x44\x03\x7a\xb9\xcd\x6c\x97\x09\x64\xab\x6b\x93\x2f\x8e\x30\x79\x3c\x17\x24\x7e\x48\x10\x08\x3a\x41\xd1\x6b\x20\x2b\x3a\xf2\x02\xed\x83\x02\xf1\x56\x4f\x0b\x9f\x1d\xb0\x89\x57\xdb\x6c\x8a\xfa\xd1\xd0\xa6\x96\x6a\x13\xd6\x98\xdd\x16\x5c\xdf\xf6\x55\x6b\xcd\xf8\x4a\x62\x42\xf3\xb4\x89\xc9\x66\x29\xd0\xc6\x4b\x16\xdb\x83\x66\x34\xea\xa7\xea\xf6\xd9\xcb\x1f\xae\x02\x02\xbf\x41\xfd\x83\xaf\xf7\x0d\x96\x97\xae\x3a\x6e\x8b\xb1\x92\x2a\x23\x76\x70\x9d\xfd\x9f\x9e\x06\x05\x14\xcb\x51\x7b\xa8\x80\x51\xc1\xe4\x21\x28\x2c\x6d\xcd\x20\x01\xc2\x2d\xac\x0c\x03\x83\x5f\xaf\xe1\xeb\x01\x79\x03\xc9\xa6\xc4\x7c\x47\x81\x78\x7b\x7d\x00\x41\x83\x3f\x9d\x7c\x81\x2f\xab\x57\x6c\xde\x00\x95\xd4\xb0\x1b\x56\x8a\xf7\x45\xc7\xb0\x64\xf4\xde\x28\x62\xfa\x35\x83\x04\x3e\x5d\x90\x71\x40\xbf\x58\xc9\x19\xd6\x0d\x16\x9b\x4f\xe0\x2e\x6e\x3d\x47\x0d\x44\x2f\x25\x52\xab\x81\x4c\x6c\x6f\xe1\x11\x63\x27\xc2\xb8\x02\x9e\x32\x4d\x85\xc2\x46\x64\x26\xea\x95\x00\x1c\xd4\x2b\xe8\x26\x8a\x5a\xd6\x80\x2f\x5b\x69\x7c\x21\xba\x5a\x73\xe1\xf9\x9b\xd1\x9f\xbf\xfe\xaf\xcb\xe2\x28\xa0\x23\x82\xbf\xc6\xa3\xdf\x60\x9d\xf9\x3d\xbd\xa3\x28\xb3\x64\x5a\xe6\x12\x6d\x54\xaf\x91\xf2\x5a\xac\xb6\x53\x16\x62\xd5\xa3\x34\x56\xf9\x5d\x24\x3c\xae\xa1\x3f\x52\xc2\xf6\x74\x88\xe3\x4e\x4d\x18\x9a\x35\x28\xb0\x14\x5e\x5b\x68\x14\x37\x76\xd3\x2c\x14\xd1\x3c\xa2\x5d\xbc\x49\x64\x8d\x4c\xb3\x72\x4e\x5d\x22\x7e\xd4\x0b\x9e\x94\x2b\x96\x4b\x9d\x73\x98\x3f\x84\x86\xbc\xae\x65\x81\xe3\xee\x42\x48\xe5\x8e\xd2\x8b\xb2\x01\xa6\x85\x80\x0a\xcd\x32\xc1\xef\x50\x85\x12\x39\x81\xe9\x28\xc5\x74\xa2\x42\x17\xfc\xe5\xf7\xf3\xb3\x93\xd3\xdb\xf3\xb3\xaf\x01\xa9\x71\xae\xd4\x29\x8d\x76\x1c\xbb\x5f\x69\xb4\xc0\x81\x1f\x26\xe8\x8c\x09\xa5\x70\xa4\x48\xed\xf8\x47\x34\x29\x97\x19\xd4\x63\x6d\x2e\x01\xbc\x05\x22\xd6\x8c\xa4\x65\x46\x9f\x46\xa3\x63\xcc\x42\x74\xa9\xa7\x0c\x23\x4e\x25\x99\xd0\xda\xcc\xf0\xe8\x4c\x83\xe9\x44\xc9\x25\x5d\x10\x00\x2a\xc0\xa4\x30\x62\x0b\xb2\x43\xbe\xb5\xc4\x62\xcf\xeb\xb8\x86\xf0\x34\x65\x5e\x29\xec\xe9\x84\xa3\x1a\x6c\x70\x51\xaf\x6b\xe8\x26\x34\x92\x94\x2a\xc4\x4b\x0a\x1d\x9d\x64\x0a\xa6\xfd\xf5\x39\xb6\xaf\x20\x22\x70\xb3\xcb\x13\xac\x93\x23\x1c\x87\xc7\x4b\xae\x06\xa6\x3a\x76\x02\x00\x6e\xc2\xa0\x3b\xfd\x68\x68\x2b\x91\x04\x13\x67\x6a\x68\xc1\x2f\xf0\xaa\x83\x19\x69\xa0\xa1\x58\x20\xdc\xd5\x1a\xcd\x04\x5e\x4b\x05\xda\x8e\x33\x5d\x89\x58\xa6\x32\x36\x51\xb0\x26\x4c\xc8\x9a\xad\xb8\x69\xcd\xe9\xba\xc4\x5d\x8d\x24\xbc\xe6\x38\x68\xdb\x40\xf1\x4f\xe9\x02\x25\xe3\x33\x70\xa7\x09\x14\x0b\x60\xd2\x91\x49\xb4\x2a\x4e\x01\xc6\xf3\xc6\xe1\x6d\x10\xd8\x59\x3d\x14\xec\x83\xc7\x77\xc2\xac\x7d\x5c\xe4\x75\xed\x41\x6f\x36\xf5\x8d\xb2\xeb\x49\x0e\x93\xa9\x88\x48\xa2\x89\x95\xe5\x52\x6f\x58\x86\xb3\x59\x59\x02\x9e\x0b\x90\x26\x91\x31\x54\x12\x38\x08\xcc\x45\x1d\x99\x27\x2a\x52\x76\x36\xa1\x8f\x51\xab\x9e\x36\x78\x5a\x29\x5e\x6d\x92\xb1\xd0\xc0\x13\x99\xed\xae\x18\xa0\x6f\x36\xa1\x84\xc4\x33\x5d\xb6\x18\xb4\x26\xe8\x24\x44\xb8\x19\x06\x13\x12\x11\xb5\x47\x78\xf4\x6d\xd4\x5a\xc4\xa2\xe4\xc4\xc2\xf4\x27\xe1\x74\xfe\xc4\xf5\x5d\xab\x75\x0e\x2f\x68\x21\xd5\xd7\x83\x8e\xf6\x09\xfd\xc3\x8d\x12\x70\x3a\x04\x43\x5f\xbc\x89\x5f\xe7\x0a\x99\x61\x4e\xf7\xe4\xe9\x00\x00\x9b\x6e\xc0\x04\x4d\xc6\xd5\xab\x90\x74\x74\x1e\x24\xf3\x52\x51\x36\xc2\xca\x2e\x08\x9d\xaf\x23\xb3\x3d\xef\xc7\x83\xd3\xb1\xfe\x07\xf8\x74\x5a\x3e\x03\xd1\x0d\x63\x7d\x2f\x4a\x3b\x2b\xbe\x06\xd4\x3e\xe5\xf7\x63\xd5\x89\xfa\x2a\x5c\x1d\xe1\xeb\x88\x05\xfa\x1b\x28\x44\x39\xff\x1f\x0c\x83\xd8\xcf\xf5\x21\xe3\x79\x9c\x69\xa2\x23\x48\xe4\x72\xae\x38\x26\x73\x18\x24\xb9\x57\xec\x97\x96\x89\xa9\x03\xc8\x7f\x2e\x0a\x61\x48\x31\x31\x0f\xfa\x08\x3a\x03\x2f\x57\xa1\xd8\xcd\x64\xc1\xd5\x9a\xa9\x06\x3d\x30\x87\x4c\x0b\x45\x8e\x77\x87\xf7\x4f\x84\xf2\x8d\xf0\xec\x40\xd8\xd7\xa1\x83\x21\x48\x70\xe6\x58\xb8\x42\x68\xd4\x70\xa2\x1a\x1f\x71\xbc\xf3\x8e\x71\x7a\xa7\x13\x90\x2e\xe3\x20\x81\x39\x16\xe8\xa0\x55\x6d\x19\x59\x54\x23\xf3\x53\x68\x97\xbd\x1a\xdb\x67\x6d\xab\x61\xdf\x04\x2c\xb4\xaa\x44\xff\xe1\x7a\x01\x73\xdc\xc8\xf2\xf8\x87\xb1\xd2\xb3\xc0\x7b\xa2\x65\x20\xbc\xeb\x51\x8e\x5a\x1f\x1c\xef\xde\x4f\x59\x4c\xc2\xc2\x23\x45\x93\x33\x09\x3e\x1b\x35\xba\xd0\xda\x0e\xab\xef\x09\xae\x67\x24\xea\x25\xd2\xc1\x39\x2f\x47\x46\x8f\xf4\x4d\xb1\x71\x99\xe7\x0d\xa8\x98\x09\xd3\x95\x3e\x93\x4f\xb5\xa8\x11\xc6\xd2\x11\xdb\xa6\x50\x12\xb8\x9b\x2a\xc1\x7e\xbd\xac\x84\x41\x52\xd7\x43\xb5\xe0\x80\xa6\x00\x77\xd2\x3c\x75\xf2\xf9\x92\x85\x9f\xec\x5b\x74\x23\x6a\x3a\xb9\xed\x13\x2d\xb7\x99\xb9\x6c\xd6\x2c\x29\x8b\x9f\x6b\x98\x2d\x97\x14\x6d\x20\x48\x8d\x5f\x53\x02\x4f\x4f\x1a\xe8\xed\x2e\x07\x5f\x58\x0c\xe2\xcd\x04\x89\x5f\x16\xd9\x1a\x67\xb9\x18\xfa\x26\x83\x77\x17\x5a\x03\x13\xf4\x62\xeb\x16\x69\x2c\xf8\x69\x4a\xb4\x90\x2f\x10\xe8\xe6\x87\x81\x11\xd1\x78\x21\x63\xd8\x6c\xd9\x34\x10\x38\xea\x8f\x02\xef\x0e\x8c\xbe\xfc\xef\x89\x0c\xe3\xcd\xdd\x7b\x14\x74\x97\xae\x9d\x5a\x51\x29\x0a\x88\x31\x3e\xa0\xaa\x5d\x00\x3c\x83\x9d\xef\x88\x80\x4d\x48\xf5\xa1\xdf\x3f\xe1\x65\xec\xf7\x69\x5f\x07\x7f\xd7\x16\x1b\x93\x3f\x03\x7d\xc2\x8b\xd8\x77\x20\x87\x96\x01\x68\xa9\x87\x70\xc3\x1e\x76\x15\xc8\xd0\x5f\x33\x1e\x87\x81\xa7\xeb\xab\xb5\x6d\xac\x07\x25\x62\x29\xcb\xcc\x04\x06\xec\x11\xc9\x9c\x78\x18\x5b\x34\x85\xbc\x6f\x20\x7c\xb4\x9b\x27\x87\x22\x77\x50\xcd\xf5\xbc\x97\xb5\x37\xa6\x23\x1f\x87\x46\x0e\xbf\xe5\xa7\xea\x60\xc7\x17\x5b\x1d\xda\xc2\x64\x26\x3e\xe3\x4d\xf1\x40\xec\x89\x19\x04\xff\xa7\xf5\xcd\x7f\xaf\xa6\x28\x30\xee\x30\x6e\x74\x93\x60\x5c\x66\x4d\x8e\x9b\x18\xd0\xc0\x1c\x3a\x81\xf0\x84\x72\xc3\xd7\x18\x90\x34\x95\xe2\x45\x88\xac\x7f\xd6\xcc\xfe\x1c\x84\x95\xa2\x13\xc9\xd3\xe6\x4f\x68\x30\x2a\xd1\x86\x94\x1d\xba\x0c\x8a\xa8\xba\xc5\xf8\x6b\x5e\xd2\xea\x06\x83\xde\x14\x99\x23\xe0\x28\x7a\xa9\x92\x8e\x1c\x1b\xda\xd8\x45\x5b\x77\xe6\x85\xb1\x8b\x3d\x06\x52\x4c\x0e\xc7\x38\xc3\x0d\x6c\x1f\x99\x99\x8e\x9d\x89\x0a\xea\x2a\x16\xbf\x23\x86\x3f\x29\x0e\x5d\x64\x6d\xd3\x1f\xc3\xda\x51\x15\x9b\x00\x18\xe3\xba\x5c\xb4\x21\xcc\xf1\x90\xe3\x3b\x92\xc4\x80\xc3\x7b\x32\x84\x81\xe2\xe6\x94\x88\x3d\x28\xa5\x08\x00\xa0\x0b\xac\x3f\x0a\xea\x08\xb7\x49\xa7\x23\xe3\x84\xed\xb9\x6c\x43\x4e\xc3\x29\xf4\xa6\x06\x17\xd4\x96\x4f\xd2\xe6\x22\x8b\x5f\xdd\x4b\x34\x35\x57\x73\xd1\x86\xc0\x76\x1f\xbc\x74\x3e\x8c\xe9\x96\xc5\x20\xeb\x58\x31\xec\xe2\xf1\x71\x9f\x77\x2b\x54\xdf\x9b\x00\xcb\x77\x75\x09\x9b\xd7\x18\x6d\x7e\xdc\x38\x60\x5b\x8a\xec\x27\x42\x77\xf5\xd0\xbf\x6f\xe8\xdf\x37\xbf\x7c\x99\xf8\xca\xe5\x9f\x39\x67\x70\xf1\xf7\xe2\x0d\xf1\x96\x6b\xbf\x9d\xc1\x35\xae\x77\xdd\x47\xff\x2e\x42\xd2\x1d\xf8\xc6\x1d\x66\x74\x79\x46\x35\xca\xfe\x7b\x80\x3b\xb1\xd6\xad\xc9\xd1\xaa\xf8\x61\x5f\x42\x63\x90\xaa\x32\x37\x76\xc6\x8c\x9b\xf3\xca\x9a\x14\x09\xc2\x1c\x3f\x7c\xb1\xc7\x3c\x3d\x7d\x35\x69\x16\x7f\x59\xff\xf2\xb5\xfd\x8a\x96\xa5\x5f\x72\x73\x7e\x27\x42\x6f\x61\xca\x0e\xa6\x2c\x13\x45\x98\xe3\x0f\xd9\xd4\xa8\x40\xed\xfc\x86\xa4\xc6\xbc\xb9\xf9\x81\x1e\x42\x1b\x21\x0c\xc3\xaf\x86\x6c\x95\x4c\xfc\x79\x57\xf7\x7e\xf9\xfe\x1b\x4f\xc0\x72\x33\xe2\x22\x00\x00")
 
 func templateBaseTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -134,12 +167,12 @@ func templateBaseTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/base.tmpl", size: 5217, mode: os.FileMode(420), modTime: time.Unix(1567330572, 0)}
+	info := bindataFileInfo{name: "template/base.tmpl", size: 8930, mode: os.FileMode(420), modTime: time.Unix(1792022221, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x5d\x6f\xdb\x36\x14\x7d\x96\x7e\xc5\xad\xa0\x0e\x96\x91\xc8\x6d\xdf\xe6\x21\x03\xba\x34\x05\x02\x0c\xd9\x80\x64\x43\x81\x75\x18\x18\xea\xca\xe6\x42\x93\x2a\x49\x39\x09\x04\xfd\xf7\x81\x1f\x92\x28\xe7\x03\xee\x9e\x2c\x53\xe4\xb9\x87\xe7\x1c\x5d\xb2\xeb\x56\xcb\xf4\x5c\x36\x8f\x8a\x6d\xb6\x06\x3e\xbc\x7b\xff\xe3\x69\xa3\x50\xa3\x30\xf0\x99\x50\xbc\x95\xf2\x0e\x2e\x05\x2d\xe1\x23\xe7\xe0\x26\x69\xb0\xef\xd5\x1e\xab\x32\xbd\xd9\x32\x0d\x5a\xb6\x8a\x22\x50\x59\x21\x30\x0d\x9c\x51\x14\x1a\x2b\x68\x45\x85\x0a\xcc\x16\xe1\x63\x43\xe8\x16\xe1\x43\xf9\x6e\x78\x0b\xb5\x6c\x45\x95\x32\xe1\xde\xff\x7a\x79\x7e\x71\x75\x7d\x01\x35\xe3\x08\x61\x4c\x49\x69\xa0\x62\x0a\xa9\x91\xea\x11\x64\x0d\x26\x2a\x66\x14\x62\x99\x2e\x57\x7d\x9f\xa6\x5d\x07\x15\xd6\x4c\x20\x64\x54\x21\x31\x98\x41\xdf\xdb\xd1\xbc\xb9\xdb\xc0\xfa\x0c\x6e\x89\x46\xc8\xcb\x73\x29\x6a\xb6\x29\x7f\x27\xf4\x8e\x6c\x10\xc2\x52\x83\xbb\x86\x13\x83\x90\x6d\x91\x54\xa8\x32\xc8\x9f\xbe\x62\xbb\x46\x2a\x13\xbd\xca\x6f\x5b\xc6\xed\xf6\xd6\x67\xd0\x28\x26\x0c\x2c\x1a\xa2\x29\xe1\x90\x97\x57\x64\x87\x05\x64\xe7\x73\x2e\x0a\x29\xb2\xbd\x5f\x31\x3e\x8f\x30\x16\x76\xb5\x82\x18\xb9\xef\xad\x9a\x56\x8a\x61\xa4\x96\x0a\xdc\x0e\x99\xd8\x00\x71\x93\x5d\x31\x3b\x15\x85\x61\xe6\xb1\x4c\xcd\x63\x83\x87\x30\xda\xa8\x96\x1a\xe8\xd2\x84\x3a\x09\xd2\xa4\xeb\x40\x11\xb1\x41\xc8\xff\x39\x81\xbc\xb6\x9c\xf2\xf2\x33\x43\x5e\x69\x4b\x25\x49\xba\xee\x14\xf2\xba\xbc\x76\x2b\xdd\x0b\x0b\xb4\xb4\xc0\x75\x79\x63\x6b\xd8\x69\x5d\x07\x28\xaa\xf0\x78\x1a\x43\xa2\x87\xbc\xa8\x36\x18\x23\xe2\x21\xe2\x8e\x34\x7f\xb9\x7d\x5c\x7e\x1a\x60\xff\xf6\x74\xbb\x09\xff\xb4\xef\x53\x2f\xfb\x3d\x33\x5b\xc0\x07\x63\x47\x73\xc8\x7e\xf1\x7b\xcc\x66\x3a\x26\x33\xe7\x34\x1a\x63\x67\x94\xc1\x87\xc0\xd7\x8a\x7d\x4d\xf6\xe8\xf5\x44\xaf\xf3\x4c\xd0\x10\xc3\x8a\x18\x62\xf3\x53\xa6\x75\x2b\x28\x2c\x66\x56\x0e\x92\x4c\xd5\x0b\x87\xba\xa0\xe6\x01\xa8\x14\x06\x1f\x8c\x8d\x9d\xfd\x2d\x60\xb1\x8c\x0b\x9c\x00\x2a\x25\x55\x61\x6d\x79\xcd\x8e\xd3\x51\x3d\x56\x83\x54\x56\xff\x4f\x58\x93\x96\x1b\x58\x08\x69\xec\xff\xdf\x1a\xc3\xa4\x20\xbc\x08\x93\x13\x56\xc3\x01\xcf\xd2\x3b\x77\xa0\xfe\xd9\x19\x08\xc6\x2d\x83\xc4\x96\xb0\x15\x22\xf8\x00\x96\x24\x7b\x4b\xc8\x71\x9f\xbe\x9d\x00\x18\xe6\x86\x3d\x8d\x10\x97\xfa\x86\xb9\x91\x45\x11\x65\x24\x54\x39\x82\x17\xfc\xb0\x1f\x38\x21\xd7\x38\x51\x51\x68\x5a\x25\x2c\xeb\xa0\x9f\x2e\xaf\xf0\x7e\x91\x0d\x5f\x7b\xdf\xaf\x61\xc7\xb4\xb6\x5f\x88\xc2\x6f\x2d\x53\x58\x41\xed\x70\xbf\x66\xbe\x56\xe0\xfa\x35\xcb\x8a\xb1\x46\x08\x59\x92\x24\x5e\xec\x68\x64\x48\x9d\x97\xfe\x4f\xc2\x59\x45\x8c\x54\xda\x6f\xf3\x42\xb4\xbb\x61\xa9\xed\xa5\x40\xaa\x0a\x44\xcb\x39\xb9\xe5\x08\x74\x8b\xf4\x0e\xa4\xe0\x8f\xee\xdb\x95\xc1\x27\x4f\x48\x3b\x5c\xd9\x1a\xdb\xbd\x9c\xe0\x7b\xc2\x5b\x84\xe5\x6a\x02\x84\x7c\xc4\x5a\x9f\x01\xb1\xa9\x9f\xec\x1e\xfd\x0f\x26\x14\xd3\x3a\x17\x96\x69\xad\x8d\xf3\x91\x91\x78\x13\x22\x01\x73\x59\x6c\xa4\x50\xa9\x97\x83\x30\x0a\x63\x4d\x5f\x1e\x53\xaa\xf8\xc9\x21\xbe\x89\x33\x38\xf3\xb7\xde\x99\xf2\xc2\x7a\x5c\xcf\xfd\xdd\x8f\xa5\x6a\xc2\xb8\xf5\xd7\x3e\x3e\xef\xf1\x1a\xde\xee\x33\x17\x15\x6f\xf6\x8b\xfa\xf4\x10\x07\x35\xde\xfc\xfc\xf9\x88\x2e\x67\xa1\xb1\xfc\x43\xb0\x6f\x2d\x46\x5f\x24\x47\x71\xd8\x3d\x9c\x2e\x87\x3d\xb1\x80\x9f\xe1\x7d\xd0\xe3\xa8\xb8\xb7\xdc\xb0\x86\x23\x10\xad\xd9\x46\xec\x50\x18\x0d\x52\x00\x81\xd6\x53\xc0\x6a\x83\x41\x19\x3c\x4c\xff\x33\x71\x77\x1b\x70\xc9\xc2\x29\x6a\xaf\x37\x96\x27\x6d\x7d\xd6\x58\xfe\xd7\x37\xfb\x3d\xa4\x0f\x1d\x62\x35\x6c\x0c\x2c\x38\x0a\xc8\xcb\x6b\x23\x15\xd9\x60\x01\xef\xc3\x26\xf4\x3d\x33\x74\xfb\x64\x1f\x95\xb2\x4f\xe5\x27\x46\x38\x52\xb3\x70\x7d\xf9\xd0\x6f\xed\xb1\xbc\xeb\x01\xd8\xfb\x4e\xed\x15\xa3\xeb\xe0\x5f\xc9\xc4\x38\x6f\x00\xd3\x90\x9d\x80\xbd\x08\xac\xd3\x49\x8e\xe7\x74\xd4\x23\xe4\x70\x8a\x14\x81\xc4\x98\xcc\xd0\x2b\x62\xa4\x57\x85\x6d\x85\x6e\x1b\x7b\x79\xc1\x0a\x2a\x4f\xc7\x89\x18\xa4\x8a\xba\xeb\xcb\xbc\x98\xa8\xf0\x21\xda\xf1\xbb\x39\xc1\x88\xdf\x74\xb0\x7e\x01\x4a\x38\xd7\xfe\x90\xb5\x8d\xab\x21\x82\x51\x6d\xbd\x71\x43\xbe\x9a\x06\x22\x3c\xf5\xef\x3a\x5f\xbf\x3c\x7f\xc0\xce\xce\x57\xeb\xdf\xfe\x24\x6e\x5a\xf1\xae\x22\xfa\xa1\xb3\x45\x7d\xc8\x51\x5d\xf8\x9e\xd1\xa7\x83\x30\x7b\x7f\x07\x39\x2e\x10\xc7\xde\x55\x5c\x8f\x37\xbb\x86\x8f\x17\xc9\x1a\xb2\xe0\xd3\xea\xad\x5e\x0d\x17\xda\x28\x1a\x7e\xd1\xc3\x78\xc5\xf1\xcb\xcb\x83\x0b\x59\x74\xd5\x99\x1e\xff\x0b\x00\x00\xff\xff\x83\xbc\xd5\x3d\xee\x0b\x00\x00")
+var _templateBuilderCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x58\xdf\x6f\xdb\x36\x10\x7e\x96\xfe\x8a\xab\xe0\x66\xb2\xe1\xc8\x4d\xdf\xe6\xa1\x03\xba\xc4\x45\x03\x74\xc9\x00\x77\x43\x1f\x0a\x0c\xb4\x44\xd9\x5c\x68\x4a\x15\x25\x27\x81\xe1\xff\x7d\x77\x24\x25\x4b\xb2\x9d\x39\x7b\x49\x24\x91\xbc\x1f\xdf\x7d\x77\xbc\xf3\x76\x3b\x19\xf9\xd7\x59\xfe\x5c\x88\xe5\xaa\x84\xf7\xef\xae\x7e\xbe\xcc\x0b\xae\xb9\x2a\xe1\x13\x8b\xf9\x22\xcb\x1e\xe0\x56\xc5\x11\x7c\x94\x12\xcc\x26\x0d\xb4\x5e\x6c\x78\x12\xf9\x5f\x57\x42\x83\xce\xaa\x22\xe6\x10\x67\x09\x07\x7c\x95\x22\xe6\x4a\xf3\x04\x2a\x95\xf0\x02\xca\x15\x87\x8f\x39\x8b\xf1\xdf\xfb\xe8\x5d\xbd\x0a\x69\x86\xcb\xbe\x50\x66\xfd\xcb\xed\xf5\xec\x6e\x3e\x83\x54\x48\x14\x61\xbf\x15\x59\x56\x42\x22\x0a\x1e\x97\x59\xf1\x0c\x59\x8a\x5f\xf7\xca\xca\x82\xf3\xc8\x1f\x4d\x76\x3b\xdf\xdf\x6e\x21\xe1\xa9\x50\x1c\x82\xb8\xe0\xac\xe4\x01\xe0\x67\xfc\x3a\xc8\x1f\x96\x30\xfd\x00\x0b\x86\x0a\x07\xd1\x75\xa6\x52\xb1\x8c\xfe\x60\xf1\x03\x5b\x72\x70\x47\x4b\xbe\xce\x25\x1e\x82\x60\xc5\x19\x1a\x1c\xc0\xe0\x70\x49\xac\xf3\xac\x28\x5b\x4b\x83\x45\x25\x24\xb9\x87\xe2\xf3\x42\x20\x5a\x61\xce\x74\xcc\x24\xea\xb9\x63\x6b\x3e\x84\xe0\xba\x6b\x0b\x3a\xc2\xc5\xc6\x9e\x68\x9e\x1b\x31\x24\x76\x32\x81\xb6\xe4\xdd\x8e\xd0\x24\x28\xea\x2f\x69\x56\x80\xf1\x50\xa8\x25\x30\xb3\xd9\x28\xa3\xad\x18\x30\x51\x3e\x47\x7e\xf9\x9c\xf3\xbe\x18\x5d\x16\x55\x5c\xc2\xd6\xf7\x62\x03\x81\xef\x99\xa3\x7b\x20\x7e\xaf\x4a\x14\x9a\x29\x5a\xb8\x04\x91\xe2\xe2\x27\x54\x53\x15\x7c\xa6\xd8\x42\x62\x2c\x83\xa4\x62\xf2\xb1\x10\xce\x21\xcf\x43\x63\x45\x62\xa3\xc2\x41\x61\xe8\xc7\x74\x4e\x94\x3f\x69\x58\x8b\xa2\xc8\x0a\x3c\x94\x16\xd9\xda\xac\x23\x42\x6b\x86\x41\xd4\x18\x4a\xd4\x18\xe1\x79\x3c\x3c\x32\x56\xdc\xde\x44\x5f\xc9\x66\x92\x4a\xda\xb9\x4a\xe8\xd9\xe2\x51\x1b\x86\x88\xa1\x35\xca\xc2\xb1\xae\x3f\x3a\xed\xce\xd3\xc8\x4f\x2b\x15\x43\xd8\x01\x1b\xbd\x1f\x75\xd1\x18\x36\x42\xc3\xa1\x59\xeb\xc4\xad\x05\x06\xe1\x65\xd5\xc2\xc5\x0b\xdb\xb6\x40\x56\x1f\x43\x73\x6a\xce\xb5\x6d\x89\x4e\xe0\x3e\x86\x2c\x9f\x52\x08\xa3\xfb\xdc\xd2\xc6\x00\x80\xbb\x1f\x45\xb9\x02\xfe\x54\x12\x2a\x03\x08\x7e\xb3\x6e\x04\x1d\xe2\x78\x1d\xaa\x6a\x5e\x96\xb4\x23\x72\xc4\x73\x78\x12\x9a\x73\xb6\xe1\x96\x40\xdc\x22\xd9\x61\x90\xcb\xbb\x84\x95\x8c\x12\xe6\x6c\x38\x49\x6a\x18\x97\x4f\x58\x00\x54\x89\xa6\x52\x9e\xd1\x7f\x72\x0a\x8b\x45\x14\x45\xe4\xd8\x35\x93\xf2\x3e\x27\x67\x87\x10\x8e\xda\x8a\xc7\xc0\x89\x2f\x43\xc2\x3b\x33\x3b\x34\xe5\x08\x1d\xba\xe3\x8f\xfb\x73\x3a\x24\x79\x28\x6e\x68\x3c\x2e\x98\x42\x0c\x07\x7f\x8f\x61\x90\xd2\x7e\xa4\xac\xe0\x32\xd1\x70\x69\xf8\xe9\x88\x8c\x19\x33\x48\xa3\x1b\x9e\xb2\x4a\x62\x8e\x2a\xac\x29\xf8\x6e\x05\x32\x39\x74\x9b\x3d\xdc\x79\x2c\x54\x69\x34\x37\xa9\x63\x24\x93\xf3\x1f\x3e\x80\x12\x12\x97\x28\x47\xd2\xe8\x4e\x48\x49\xf9\x31\xb7\xbc\xa6\x1d\x17\x17\xf0\xa6\x2f\x2a\x96\x9c\x15\xc7\xe4\x35\xe1\x21\xdf\x3d\x32\xda\x09\xae\x0d\x76\xe6\x79\xde\x86\x5c\xec\xb1\xc7\x99\xe8\xf6\x3a\x34\xfb\x22\x3e\x51\x10\x77\xbb\x70\xb8\xa7\x82\xe7\x54\x9d\xe1\x2e\x5c\x6c\x6a\xc3\xb8\xc4\x22\xda\xd8\xe3\x12\x03\xc1\x70\xe1\xd3\x14\xac\x30\xa8\xab\xee\x6e\x37\xc5\x3a\xa0\x35\x55\xaa\x82\xff\xa8\x84\xa9\x07\x46\xee\xf7\xc0\xea\x72\x06\x7f\x0f\x82\x61\xa3\x03\x0d\x74\x2a\x6c\x0c\x5b\x5f\xea\x64\xc0\x93\x5f\x31\xf6\x1a\x8b\xe1\x9a\x17\xfa\xb5\x11\x7c\x63\x22\xe8\xf0\x7e\x01\xd5\x96\x0e\x82\x6f\x74\x8e\xf0\xc6\x8f\xf3\x91\x3d\xe9\xa6\x25\xee\x5f\x4c\x0a\x4c\x48\x84\x97\xde\x6e\xf5\x4c\x55\xeb\xda\xe3\x2d\x5e\xdd\xc0\x92\x04\x54\x65\x59\x08\x78\xc7\xc6\x0f\x90\x29\xf9\x6c\xae\x8a\xcc\xb1\xdc\xe2\xae\x8d\xdc\xac\x2a\xe9\xb2\x34\xe4\xda\x30\x59\x71\x18\x4d\xf6\x02\x61\xd0\xc8\x42\x60\x18\xd5\x9c\x7d\xb2\x40\x88\x32\xeb\x0c\x72\xec\x1a\x1e\xc9\x82\xe1\x5e\x9e\xbd\x4b\x1a\x99\x54\x64\x5e\x19\x26\xe8\xb2\x82\xc2\x8c\x74\x3b\x1d\xb6\x06\xb0\xf3\x83\xf6\x8b\x91\xd8\xe1\x45\x87\xde\xe9\xba\x8c\x66\x44\xf1\xb4\x4b\xef\x4d\xa3\x2a\x65\x82\xee\x48\xc2\xfc\x04\xc5\xa7\xf0\x76\x13\x98\x4c\xb1\x1c\x39\x89\xcf\x0e\xda\x79\xda\x76\xbe\xfb\x7c\xd9\xae\x7f\xdc\xd6\xbf\x59\xb2\xc4\xba\xde\xae\x7e\x03\x1e\xfd\xa9\xc4\x8f\x8a\xb7\xb2\x44\x72\x15\x1e\xc3\x85\xf7\x71\x81\x5f\xe1\xca\xe1\x71\x56\xb6\x23\x1d\x44\x8e\x5e\x30\xcc\xfa\xa5\x5a\x63\xfd\xd6\xc8\x45\xec\x53\x2a\x6b\x02\x47\xf3\x1c\x32\xbc\x9f\xfc\x47\xd2\xc0\x38\x60\xd8\xc6\xf7\x14\x7c\x39\xd9\xf9\xf1\x72\xfd\x1a\x27\xfa\x25\xeb\x35\x46\xf7\x23\x64\xfa\xa8\xcf\x4c\xcf\x31\x2f\xd7\xac\x95\xca\xd6\x0b\xa2\x0b\x46\x2f\x55\xa6\x23\x34\xd1\xec\x73\xba\x75\x66\xeb\x77\xd8\x9f\x1e\x06\x71\xdf\xd6\x1c\xa3\xf4\xff\x66\x34\x6e\x90\xd9\x23\xf5\xaa\xb5\xff\x3d\x32\x93\x2f\x3d\xef\xe9\xe2\xb5\x77\x76\xed\x02\x3e\xdf\x53\x5d\x22\x63\xda\xa6\xe0\x1f\xdf\x9d\xfe\xaf\xb6\xd3\xa2\x76\x24\xf4\x7a\xc5\x92\xec\xb1\xe3\xae\x53\xd1\xdf\x49\xd2\xea\x76\xc5\xb6\x27\xb6\x9d\xf0\x4e\x45\xaf\x6f\xce\x23\x2b\xe3\x55\x6d\xca\x66\xdc\xae\x45\x1d\x8b\x9c\x8e\xa1\xdf\xc4\xec\x88\x75\x35\x13\xad\x7e\xda\xf8\xa6\x46\x6d\xfe\x20\xf2\xcf\x38\x7a\xb9\xb8\xf7\xe5\x2f\x2a\x1d\xe5\xd5\x42\x0a\xbd\x0a\x2f\x66\x1b\x4c\xb5\xed\x7d\xaf\x81\x1c\x03\x75\xd5\xd3\x03\x4a\x7d\x61\x0b\x8e\x6a\x6f\x6f\x30\xdc\xd8\x7b\x8f\xe1\x0e\x7b\x77\x7c\xc6\x87\x29\x5c\xed\x1c\x18\xb5\x89\x1b\x1b\x21\xdb\x3e\xea\x73\xda\x47\xd7\xe2\xd7\xbd\x79\x2c\x05\x8d\x92\x89\x60\x12\x07\xb9\xb3\x7b\x4a\x7d\xa2\xa7\x7c\xa9\x77\x3c\x12\xc0\x25\xb6\x7c\x58\xef\x70\x7f\x73\x37\x5d\xb9\xe0\x69\xbc\x0d\xe3\xd5\x21\x43\x0a\x7a\x8a\x6e\xac\xbd\xa1\x11\xdc\x2f\xb7\xb5\x8b\xa6\xe8\xee\x5b\x3f\xdc\x18\xd3\xa0\x89\x22\xff\xc9\x10\x8d\x7a\x5f\x2d\x4c\x03\x26\x0d\x4d\x4f\xd3\x17\x18\x4a\xef\xba\x11\x39\x6f\xf1\xa8\x35\x1b\x79\x9e\xbb\xc2\xa7\xfe\x99\x75\xad\x52\xba\xca\x69\x84\x45\x12\xbb\x58\x04\x0d\xef\x2f\xdb\xbd\xdd\x69\xbb\x04\x8e\xf3\x4f\x2d\x8f\xdf\x75\x0d\x3c\x98\xdd\x68\xed\x1b\xe0\xcc\x24\xb5\x9d\x3c\xa8\x9f\xc8\x99\x12\xb1\xa6\xd8\x98\x4f\xf5\x58\xc7\x94\x35\xfd\x55\x43\xc7\xb7\xd7\x4d\x1d\x1d\xe2\x50\x5c\x4f\xe7\xef\xb1\x1a\x71\x98\xc7\xc6\x97\xd0\x96\xc1\x5d\x33\x2b\x6e\xec\xe4\x76\x1e\x63\xce\x9d\xf0\x4c\x05\x28\x71\xc4\x6b\x7e\x6f\x48\xb1\x36\xda\x40\x4e\xde\xea\x49\xfd\xbb\x47\x8b\x3b\xf6\xd0\x53\x33\x18\xda\xe3\x11\xb4\x6e\xaa\xee\x80\x68\x4b\x30\x05\x29\x3c\x2c\x7c\x55\xae\x79\x81\x9c\x41\xfb\x6d\x47\xe1\x86\xad\x83\xf1\xd3\x6d\xb4\xbf\x94\x74\x97\x96\x1c\x8d\x6b\x2c\x3d\xd4\xee\x1e\xff\x05\xe8\x5b\x78\x81\x93\x12\x00\x00")
 
 func templateBuilderCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -154,12 +187,12 @@ func templateBuilderCreateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/create.tmpl", size: 3054, mode: os.FileMode(420), modTime: time.Unix(1570030748, 0)}
+	info := bindataFileInfo{name: "template/builder/create.tmpl", size: 4755, mode: os.FileMode(420), modTime: time.Unix(1792022061, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x41\x6f\xe3\x36\x17\x3c\x9b\xbf\x62\x3e\xc1\x5f\x61\x07\x09\x9d\xdd\x5b\x03\xf8\xb0\xcd\x66\x81\x05\x16\x69\xd1\x2d\xd0\x00\x45\x51\x30\xe4\x93\xcd\x46\x21\x55\x92\x8a\x6d\x08\xfa\xef\x05\x45\x49\x96\x92\x78\x37\x87\x9e\x22\x89\xe4\x70\xde\xbc\x79\x13\xd7\xf5\xea\x8c\x5d\xdb\xf2\xe0\xf4\x66\x1b\xf0\xfe\xf2\xdd\x8f\x17\xa5\x23\x4f\x26\xe0\x93\x90\x74\x6f\xed\x03\x3e\x1b\xc9\xf1\xa1\x28\xd0\x6e\xf2\x88\xeb\xee\x89\x14\x67\xbf\x6d\xb5\x87\xb7\x95\x93\x04\x69\x15\x41\x7b\x14\x5a\x92\xf1\xa4\x50\x19\x45\x0e\x61\x4b\xf8\x50\x0a\xb9\x25\xbc\xe7\x97\xfd\x2a\x72\x5b\x19\xc5\xb4\x69\xd7\xbf\x7c\xbe\xbe\xb9\xfd\x7a\x83\x5c\x17\x84\xee\x9b\xb3\x36\x40\x69\x47\x32\x58\x77\x80\xcd\x11\x46\x97\x05\x47\xc4\xd9\xd9\xaa\x69\x18\xab\x6b\x28\xca\xb5\x21\x64\x8a\x0a\x0a\x94\xa1\x69\xe2\xd7\x79\xf9\xb0\xc1\xd5\x1a\xf7\xc2\x13\xe6\xfc\xda\x9a\x5c\x6f\xf8\x2f\x42\x3e\x88\x0d\xa1\x3b\x1a\xe8\xb1\x2c\x44\x20\x64\x5b\x12\x8a\x5c\x86\xf9\xcb\x25\xfd\x58\x5a\x17\x46\x4b\xf3\xfb\x4a\x17\xb1\xbc\xab\x35\x4a\xa7\x4d\xc0\xa2\x14\x5e\x8a\x02\x73\x7e\x2b\x1e\x69\x89\xec\xe3\x94\x8b\x23\x49\xfa\x29\x9d\x18\x9e\x07\x98\xa6\x61\xab\x15\xc6\xc0\x4d\x13\xc5\x8c\x4a\xf4\x5f\x72\xeb\xd0\x16\xa8\xcd\x06\x22\x6e\x9e\x5c\x19\x4f\x90\x09\x3a\x1c\x38\x0b\x87\x92\x9e\xa3\xf9\xe0\x2a\x19\x50\xb3\x99\x6c\x85\x60\xb3\xd2\x91\xd2\x52\x04\xf2\xf8\xe3\xcf\xe1\x85\xc7\x73\x3d\x22\x6b\x18\x8b\xcc\x7e\xdf\x92\x23\x08\xa5\x3c\x04\x0c\xed\x30\x6c\x47\xb0\x2d\xcb\x24\x7d\x4f\x96\xb3\xbc\x32\x12\x8b\x71\xe5\x4d\x83\xb3\x29\xa7\x65\xc2\x5d\x94\x1e\x9c\xf3\xd7\x19\x2c\x9f\x1f\x8a\x15\x4c\x61\xf9\xa8\x90\x35\x44\x59\x92\x51\x8b\x93\x5b\xce\x51\x7a\xce\xf9\x92\xcd\x1c\x85\xca\x19\x4c\xba\x93\x4a\x5e\xad\x70\xb3\x27\x09\xda\x93\xac\x22\xec\x50\xa1\xb6\x06\xff\x54\xe4\x0e\x10\x46\x21\x21\x78\x6c\xed\x0e\x8f\xc2\x1c\xf0\x44\x2e\x68\x49\x1e\xbb\xa8\x57\xd2\x44\xbd\x55\x8c\x78\xe5\x42\x86\x3d\xa4\x35\x81\xf6\x21\x3a\x36\xfe\x5d\x62\xa1\x4d\x38\x07\x39\x67\xdd\x32\xd5\x7f\x01\x9d\x63\x13\xb0\x28\xc8\x60\xce\xbf\x06\xeb\xc4\x86\x96\x78\x87\x8b\xa6\x61\xb3\x99\xdf\xe9\x20\xb7\xcf\x6b\xe3\xca\xc5\x27\xfe\x51\x8b\x82\x64\x58\xb4\x60\x2d\x9a\x13\x66\x43\x98\xff\x75\x8e\xb9\x4f\x58\xd1\xa8\x03\x30\x5a\x50\x19\x07\xa9\xae\xf1\xb7\xd5\x66\xd8\xd7\x83\x79\x64\xe7\x88\x76\xbf\x62\xb3\xd9\x09\x6d\xdb\xce\xfa\x01\xb2\x2f\x78\xd9\x91\x20\xa3\xd2\x45\x8a\x72\x51\x15\x61\x8c\x74\xd9\xd5\xef\xf9\x2d\xed\x16\x59\x3f\xde\x4d\x73\x85\xca\xf8\xaa\x8c\x03\x4a\x0a\x2a\x91\xc9\x22\x64\x93\x84\xa2\xc2\x53\xa7\xca\x69\x56\xda\x28\xda\x8f\xea\xbd\x9c\xd2\x1b\xb1\x3b\xda\xe3\x2e\xc5\xdd\x03\xb5\x6f\xe7\xb8\xaf\x02\x4a\x61\xb4\xf4\xb1\x39\xc2\x24\xc2\xb0\x52\x56\xce\xbf\x66\x02\x9c\x72\xc1\xdd\xeb\x36\x88\x39\x53\xb3\x99\x69\xa5\x88\xfd\x79\x5e\xc8\x88\xb1\xce\xdb\x4d\xff\x5b\xc3\xe8\xa2\x6d\x73\x4b\x6d\x41\xce\x2d\x59\x94\xa6\xd3\xc2\xb0\x36\xd1\xde\xe6\x80\xba\xc6\x4e\x87\x2d\x68\x1f\xa2\x1a\x73\x64\x3f\x25\xe6\xd9\x24\xca\x66\xed\x80\x86\xc7\xb2\x18\xf2\x31\x47\xd6\xb5\x66\xf5\x7f\xbf\xea\x73\x7a\xe4\x85\x74\x68\x3f\x64\x6e\x3a\xce\xfb\x6b\x3b\xf1\x8f\x4f\x6d\xa8\x5a\x43\x2f\x82\x78\x20\x92\xfd\x6c\x8e\xf1\x6b\x0d\xfd\xfa\x6a\x02\x8f\x20\x9a\xd4\xda\x67\xc0\xdf\x0d\x62\xaf\xcd\xa6\x48\x71\x7b\x3a\x88\xa7\x80\xc7\x2c\xfe\x8e\x1d\xde\x18\x46\x63\x73\x8d\x2b\xed\x01\x27\xb7\x7f\x2b\x68\x92\x63\x5f\x78\x6c\x8a\xc9\xbf\x61\xbb\x3e\x77\x58\x0a\x8b\xa3\x05\xaf\x8e\xe3\x47\xce\x75\xcb\x06\xeb\x35\x2e\x47\x4b\x3f\xdc\x38\x77\x6b\xc3\xa7\xf8\xeb\xa0\x4e\x92\x1e\xff\x5f\xf3\x2f\xe2\x9e\x8a\x86\x8d\xe3\xa1\x77\xb1\x2e\xa2\xa9\xff\x83\xd9\x7c\xa3\x7c\x27\x26\xb4\xeb\xe8\x1b\xf4\xba\x4b\x82\x25\x23\x77\x9e\xfe\x37\x00\x00\xff\xff\x24\x2d\x08\xf6\x8e\x09\x00\x00")
+var _templateBuilderDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x57\xdb\x6e\xdb\x46\x10\x7d\x16\xbf\x62\x42\xa8\xae\x64\xc8\x94\x92\xb7\xba\xf0\x43\x6a\xcb\xa8\x81\xc0\x0e\xe2\xa0\x2d\x5a\x14\xc5\x8a\x1c\x59\x5b\xd3\xbb\xec\xee\xd2\xb2\xa0\xf8\xdf\x3b\xb3\x4b\x52\xa4\x24\x3b\x4a\xd1\x97\x98\xda\xcb\x99\x99\xb3\x67\x2e\x59\xaf\xc7\xc7\xd1\xb9\x2e\x56\x46\xde\x2d\x1c\xbc\x9b\xbc\xfd\xe1\xa4\x30\x68\x51\x39\xb8\x14\x29\xce\xb4\xbe\x87\x2b\x95\x26\xf0\x3e\xcf\xc1\x1f\xb2\xc0\xfb\xe6\x11\xb3\x24\xfa\xbc\x90\x16\xac\x2e\x4d\x8a\x90\xea\x0c\x81\x7e\xe6\x32\x45\x65\x31\x83\x52\x65\x68\xc0\x2d\x10\xde\x17\x22\xa5\x3f\xef\x92\x49\xbd\x0b\x73\x4d\xdb\x91\x54\x7e\xff\xc3\xd5\xf9\xf4\xfa\x76\x0a\x73\x99\x13\x44\x58\x33\x5a\x3b\xc8\xa4\xc1\xd4\x69\xb3\x02\x3d\xa7\xd5\x8d\x31\x67\x10\x93\xe8\x78\xfc\xfc\x1c\x45\xeb\x35\x64\x38\x97\x0a\x21\xce\x30\x47\x87\x31\xd0\x32\xad\xf6\x8b\xfb\x3b\x38\x3d\x83\x99\x20\x83\xfd\xe4\x5c\xab\xb9\xbc\x4b\x3e\x8a\xf4\x5e\xdc\x21\x54\x57\x1d\x3e\x14\xb9\x70\x74\x79\x81\x82\x1c\x8e\xa1\xbf\xbb\x25\x1f\x0a\x6d\x5c\x6b\xab\x3f\x2b\x65\xce\xe1\x11\x7c\x61\x24\xb1\x35\x28\x84\x4d\x45\x4e\x76\xae\xc5\x03\x0e\x21\xbe\xe8\xfa\x42\x81\xa0\x7c\x0c\x37\x9a\xef\x06\x86\x0e\x8d\xc7\xd0\x06\x7e\x7e\x66\x32\x99\x89\x7a\x65\xae\x0d\xf8\x00\xa5\xba\x03\xc1\x87\x3b\x26\xf9\x06\x3d\x9b\x74\xab\x24\x72\xab\x02\xb7\xd1\xac\x33\x65\xea\x60\x1d\xf5\x52\x4f\x44\xd4\xa3\x87\xce\x64\x4a\x01\x5a\xf8\xe3\xcf\xe6\x47\xc2\xf7\x6a\xc4\xa8\xb7\x5e\x9f\x80\x9c\xd3\xca\x25\x0a\x57\x1a\x9c\x2a\x31\xcb\xe9\x75\xe3\xa5\x70\xe9\xc2\x87\xd7\xeb\x91\xef\x32\x0b\x6f\x84\xa0\x48\x08\x23\xbe\x23\xdd\xf7\x36\x78\xcc\x6a\xb0\x8d\xdb\x2d\xaf\x6e\x54\x13\x5f\x42\x38\x04\x72\xec\xcd\x5f\x5d\x24\x9f\x39\x86\xda\x03\x54\x19\x7f\x13\xf9\xcc\xd3\xaf\x0b\x34\x08\x22\xcb\x2c\x01\x2a\x5c\x42\xe3\x3c\x38\xed\x7d\x08\x56\x37\xd0\xf3\x52\xa5\x30\x68\xbf\x03\x31\x72\xdc\xf5\x65\x18\x70\x07\x85\x85\x24\x49\xf6\xf3\x31\xdc\xbe\xc4\x7c\x76\x61\x93\x16\xad\x67\x20\x8a\x82\x7c\x1f\xbc\x78\x64\x04\x85\x25\x6b\xc3\xa8\x67\x90\xe8\x55\xd0\xd1\x4a\x08\x99\x22\x9e\x3e\x61\x0a\x48\xff\x94\x0c\xdb\x44\x28\xb5\x82\x7f\x4a\xa4\xfc\x10\x44\x50\x40\xb0\xb0\xd0\x4b\x78\x10\x6a\x05\x04\xe1\x28\xdf\x2c\x2c\x99\xaf\xea\x25\x0e\x25\x83\x4d\x0e\x52\xf7\x44\x89\xad\x1c\x3e\x39\xce\x1f\xfe\x3b\x02\x5d\x38\x4f\x11\xa9\x2d\x39\x17\x79\x7e\x53\xb0\x23\x43\x18\x50\x1e\x8c\x00\x8d\xd1\x66\xc8\xbc\x68\xbf\x6e\x59\xf1\x7c\xf4\x1a\x97\x9b\xd3\x76\xc0\x28\x21\x72\x92\x4a\x75\x34\xf9\x45\xe4\x32\x13\xfc\x7d\xa3\xf2\x15\x83\xd4\xbc\x4c\x46\xa0\x64\x1e\xf5\xbc\x20\xf6\x2b\x32\x2b\x45\xbe\x34\x92\x93\xee\xc4\xcb\x92\x4e\x6d\xd1\x99\xd8\x85\xc8\x88\x9e\x37\x67\x8c\xe6\xf1\x5f\x20\x3e\x61\xb4\x9a\x83\x10\x73\xf0\xb6\xf2\x80\x15\x79\x72\x48\x82\x04\x57\x94\x27\x86\xa9\xd8\xb6\x83\x95\x8d\x61\x70\x98\x4f\x55\xde\x7d\xf9\x02\x0a\xce\xce\x60\xc2\x5f\x35\x43\xb7\xf7\xb2\xf8\x99\xaa\xb2\xed\x38\x1f\xe0\x83\x73\x3d\x64\x33\x47\xd3\x47\x22\x7d\x7d\x53\x9c\x7a\xf2\x6f\x8a\x50\x8f\x46\xc0\x79\x75\xea\xbd\x68\x95\xc2\xe4\x83\x98\x61\x3e\x82\xeb\x53\x50\x2f\x30\x47\xb9\xd9\x66\x0d\x09\x92\x8c\x52\xa6\xc2\x59\xc7\x02\xa5\xf4\x28\xa8\xa9\x7b\xbb\x72\x6e\x7b\x63\x56\xda\xa4\x28\x67\xb9\xb4\x8b\x01\x0e\xa3\x76\x44\xfc\xde\x41\xfe\xcc\x51\xa5\xdf\xa0\xfe\x76\x4e\xfa\x82\x63\x61\x6e\xf4\x83\xdf\xb3\xd4\x30\x38\xaa\xaa\x1c\xa5\xb9\xe4\x6e\x96\x49\x91\x53\x2f\xd9\xa7\x7e\xd8\x27\x7f\x7c\x41\xfe\xbb\x32\xdf\x16\xc3\x52\xba\x05\x5d\x77\xbc\xd8\x87\xf8\x53\x65\x25\x6e\x19\x8c\x7f\x47\xa3\x49\xeb\x25\x49\x35\x9e\x54\x45\x94\xef\x6e\x5a\x4e\xe5\xcb\x98\x8c\x21\x75\xbc\x82\xba\x4f\xb2\x5d\x0e\x6b\xed\xdd\x51\xfb\xc9\x51\x11\x25\xb7\x21\xf6\x21\xbc\xad\x74\x67\xc9\x9b\x74\xb1\x2b\x6e\xc3\x5f\xc9\x45\x20\x65\xe0\xe3\xf0\x68\x46\x28\xa2\xae\xff\xd7\x08\xfa\x35\x8f\x24\xa6\x06\x38\x78\x9a\x72\x43\x25\xc8\xbf\x35\x35\xeb\xfa\x5c\x0d\x66\x21\x1e\x01\x87\x74\xfa\x4a\x72\xf1\x6f\xdb\x40\x4e\x5b\x29\xd0\x0e\xaf\x47\x7d\x5d\x94\xb9\x6b\x23\x4d\x2a\xea\x2d\xd7\x93\x41\x5c\xb7\x79\x32\x47\x03\x87\x2d\x0b\x6e\xd4\x94\x7d\xd5\x73\xc7\x4d\xc2\x12\x6a\x4e\x5e\x07\x56\x5e\xf6\x4a\xd2\xd0\xf2\xd4\x8a\x77\xd2\x75\xaf\xdb\x8b\xaa\xc2\xfc\x5b\x18\x7b\xee\xd1\xff\x1a\x51\xd7\x71\xd4\x9a\x95\x4c\x2d\x3f\x8e\x50\xc1\x61\xd0\x69\x5a\x92\xdb\x87\x0a\xd0\x23\x7f\x5b\x01\xe6\x39\x84\x1e\xf2\xe5\x5a\xb3\xaf\x9e\x75\x6b\x0e\xeb\xc0\xfb\x3e\xa0\xc5\xa1\x2f\xb7\x75\x42\x46\x7e\xf4\x39\x4c\x22\x64\x78\x2b\x0d\x7e\x0a\xa1\xc5\x9d\x99\x27\x94\x03\x47\x9a\x6f\x06\xa9\x39\xd5\xf1\xf0\x76\xe3\xef\xec\xb8\x1e\xe8\x5a\x62\x09\x97\x9e\x9a\x4c\x09\xd7\xeb\xdc\xa8\x5f\x67\xf3\xe5\xa7\x2f\xad\x70\x67\x62\x6b\x1c\x89\xa9\x64\x35\x73\x1a\x9d\xfc\xb4\x77\x54\x6b\x41\x3c\x87\xb7\xdf\x02\xfe\xea\xc4\xc6\x13\x50\xbe\x55\xbb\x76\x26\xb6\x2e\xe0\x66\x68\xfb\x8a\x5e\x0e\x9c\x13\xda\xea\x6b\x47\x5a\x03\x76\xac\xff\x97\x19\x20\x48\x7d\x47\x84\x5d\x5b\xc9\x21\xba\xac\x2b\x57\x14\xca\xcd\x46\xa3\xa7\x9b\x04\xf6\x3d\xcf\x6f\x57\x8d\xf2\xe8\x08\xde\xbc\x32\x6b\x6c\xcd\x17\x2d\xa4\xa3\xa9\x31\xd7\xda\x5d\xf2\x7f\x4b\xd6\xfb\xbb\x23\xe9\xab\x55\x8f\xea\xac\x08\x33\xc9\xff\x50\x0c\x0e\x7c\x8e\x6f\x2c\x09\xeb\x26\xc1\xf7\xbf\xc4\x2e\xf3\x3f\xbe\x5e\x0e\x42\x42\x55\xb9\xf5\x2f\x4b\x37\x76\xb3\x3f\x0e\x00\x00")
 
 func templateBuilderDeleteTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -174,12 +207,52 @@ func templateBuilderDeleteTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/delete.tmpl", size: 2446, mode: os.FileMode(420), modTime: time.Unix(1568645716, 0)}
+	info := bindataFileInfo{name: "template/builder/delete.tmpl", size: 3647, mode: os.FileMode(420), modTime: time.Unix(1792028650, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5b\x6d\x6f\xdb\x38\xf2\x7f\x6d\x7f\x8a\x59\x23\x1b\xd8\x85\x2b\xa7\x7d\xf7\xf7\x1f\x39\x20\xdb\xb4\x07\x03\x8b\xee\x5d\xbb\xc0\x2d\x50\x14\xbb\x8c\x34\xb2\xb9\xa5\x49\x2d\x49\x39\x09\x7c\xfe\xee\x07\x3e\x48\xa2\x1e\x1c\xcb\xae\xb7\x2d\xfa\x2a\x96\x44\x0e\x87\xc3\xdf\xfc\x66\x48\x4e\xb6\xdb\xd9\xb3\xe1\x2b\x91\x3d\x4a\xba\x5c\x69\x78\x79\xf5\xe2\xff\x9e\x67\x12\x15\x72\x0d\x6f\x48\x8c\x77\x42\x7c\x82\x05\x8f\x23\xb8\x61\x0c\x6c\x23\x05\xe6\xbb\xdc\x60\x12\x0d\x7f\x5d\x51\x05\x4a\xe4\x32\x46\x88\x45\x82\x40\x15\x30\x1a\x23\x57\x98\x40\xce\x13\x94\xa0\x57\x08\x37\x19\x89\x57\x08\x2f\xa3\xab\xe2\x2b\xa4\x22\xe7\xc9\x90\x72\xfb\xfd\xe7\xc5\xab\xd7\x6f\xdf\xbf\x86\x94\x32\x04\xff\x4e\x0a\xa1\x21\xa1\x12\x63\x2d\xe4\x23\x88\x14\x74\x30\x98\x96\x88\xd1\xf0\xd9\x6c\xb7\x1b\x0e\xb7\x5b\x48\x30\xa5\x1c\x61\xf4\x57\x8e\xf2\x71\x04\xbb\x9d\x79\x79\x91\x7d\x5a\xc2\xfc\x1a\xee\x88\x42\xb8\x88\x5e\x09\x9e\xd2\x65\xf4\x2f\x12\x7f\x22\x4b\x04\xdf\x53\xe3\x3a\x63\x44\x23\x8c\x56\x48\x12\x94\x23\xb8\x68\x7f\xa2\xeb\x4c\x48\x1d\x7c\xba\xb8\xcb\x29\x33\xb3\x9b\x5f\x43\x26\x29\xd7\x30\xce\x88\x8a\x09\x83\x8b\xe8\x2d\x59\xe3\x04\x46\xff\xae\xa9\x22\x31\x46\xba\x71\x1d\xca\xdf\xa5\x14\xdf\x68\x9d\x33\x4d\x95\x16\xd2\xe8\x37\xbf\x86\xa5\x86\x31\x43\x0e\x17\xd1\x7b\xf7\x72\x02\x2f\xac\x06\xb3\x19\x84\x4a\xec\x76\xc6\xee\xc6\x68\xc5\x9b\x54\x48\xb0\xb6\xa0\x7c\x69\x9a\xd6\x94\x33\xed\x91\x6b\xaa\x29\xaa\x68\xa8\x1f\x33\x6c\x4a\x53\x5a\xe6\xb1\x86\xed\x70\x10\x5b\xa3\x0d\x07\x8c\xae\xa9\x1e\x0c\x9e\x51\xae\x87\x03\x91\xa6\x0a\xab\x27\x99\xa0\x1c\x0c\x3e\x7c\xfc\xc5\xfc\x18\x0e\x72\x4e\xff\xca\xd1\xbc\x50\x5a\x52\xbe\x1c\x0e\x32\x89\x09\x8d\x89\x46\x05\x83\x0f\x1f\xcb\xa7\xc8\x8c\x5a\x68\x34\x1c\xcc\x66\x40\xb9\x46\xb9\xc6\x84\x1a\xab\x1b\xfd\xad\x86\x83\xed\xf6\x39\x48\xc2\x97\x08\x17\xbf\x4f\xe1\x22\xb0\x50\x69\x19\x2b\x61\x60\x04\xaa\xf2\x05\x04\x8f\xd1\x4f\x6e\x76\xa6\x99\x11\x87\x3c\x31\x5d\x9c\x2d\xff\xb3\x42\x89\x40\x92\x44\x01\x01\x8e\xf7\x50\xaa\x68\x0d\x19\x18\x36\x1a\xa6\x39\x8f\x61\x5c\x5b\xd2\xdd\x0e\x9e\xd5\x0d\x38\x71\x22\xc7\x99\x82\x28\x8a\xba\x27\x3c\x69\x76\x32\xe6\x0e\xe5\xee\x76\x51\x60\xb8\x6b\x20\x59\x86\x3c\x69\x0e\x1d\xb4\x99\x42\xa6\xa2\x28\x9a\x0c\x07\x12\x75\x2e\x39\x34\x9a\xfa\xd9\xfe\x6c\x96\xb2\x98\xad\x5d\x57\x50\x1a\x33\xd0\xc2\xce\xd4\xc2\xa6\xf7\x3c\xad\xb0\xb1\x93\x42\xb9\x3e\x38\x29\xa3\xb1\x6b\x7d\x0d\x97\xf6\xc7\x01\x6d\x7f\xb1\x58\xf3\xea\x72\x70\xd0\xfb\x0c\x85\x9d\xbc\xb1\x97\xd3\x57\x65\xdf\xfc\x1a\x2e\xdd\xaf\x43\x4a\x1b\x4f\xa8\x74\xb6\x4f\x9f\xa1\xb2\xe9\x3f\x16\x06\x4a\xf6\x67\x3f\x8d\xed\xa0\x7b\x51\x63\x3f\x4f\x41\x1c\xc2\x8b\x89\x0f\x8e\x78\x2d\xbd\xaf\x88\x02\x45\xd7\x94\x11\x49\xf5\x23\xdc\x53\xbd\x02\x4c\x96\xa5\xb3\x1a\xf2\x8e\x19\x45\xae\x23\xbd\xce\x18\x58\x82\xde\x6e\x43\xef\xf5\x7e\xfb\x3a\x59\xa2\x02\xe7\x8e\x70\x61\x64\xfc\xbe\x9f\x53\x31\xfa\xf5\x31\xc3\x36\xb3\x1a\xce\xb0\x4f\x01\xc5\x61\xc9\x71\xf1\x8a\x50\xee\x78\x31\xce\xa5\x34\x21\xcd\x1a\x1e\x84\x8b\x30\x76\xe0\x8a\x11\x93\x25\x46\xc3\x41\xcf\x35\xd9\x3b\xea\xd8\xaf\x4e\x6d\x46\x6e\x89\x06\x6e\xf4\xf9\x35\x5c\x76\xb4\xd8\x3a\xaa\x9d\x37\x57\x21\x72\xef\x1d\xbd\x3d\x07\x9a\x36\xe2\x84\x25\xbe\x81\xba\xa7\x3a\x5e\xb5\xfa\x26\xd2\xfc\x8a\x6e\x29\x61\x18\xeb\xf1\xc4\xaa\xd1\x8b\x4f\x9f\x3b\xb9\xb1\x89\x9d\xdb\x2d\xfc\x29\x28\xaf\xc8\xd4\xcb\x53\x30\x9a\x82\x59\x88\xb9\x69\x6a\xc5\x3a\x44\x3c\x68\xc3\xaf\x17\x30\x7a\xe7\x75\x19\x05\x6a\x8d\xcc\xd2\x8f\x0c\x10\xfc\x18\x8e\xb7\x2d\x5e\x8a\xa5\x4f\x61\x94\xb8\x31\x66\x3f\xaa\x99\xb5\xdb\x2c\x23\x7a\x35\x0a\xf9\xbd\xe8\xfb\x1c\x1e\xca\x50\xed\xc4\x44\xa5\xe8\xed\xd6\x52\xbd\x7f\xac\x3f\xf9\x88\x81\x4c\x15\xd2\x4e\x9e\xc1\x11\x13\x18\x53\x9e\xe0\x43\x60\xe9\xab\x09\x94\x52\xba\xa6\x52\xa9\x56\xe9\x5e\x7f\xf2\xee\x6b\x47\x19\x0e\xac\xc3\xf9\xf8\x66\xc8\xe8\x0d\x95\x4a\x83\x6b\xe3\xbc\x21\xb5\x6f\xc2\x60\xe4\xf2\x81\xc7\x22\xf7\x72\xfc\x04\xef\x7c\x9f\x67\xaf\xa5\x7c\x2b\xf4\x1b\x93\xb2\xc1\xfd\x0a\x39\x70\x61\xba\x33\x71\x6f\xb2\x98\x52\xc8\x3d\x51\x2e\xaf\xeb\x4d\x6d\x56\xb7\x71\xac\x1f\x20\x16\x5c\xe3\x83\x36\x59\x9a\xf9\x3b\x81\xf1\xb3\x50\xc1\x29\xa0\x94\x42\x4e\x3c\xd5\x65\x2c\x97\x36\x9f\x79\x57\x49\xb7\x4d\xcc\x02\x34\x9d\xc0\xc5\xa8\x17\x93\xe8\x86\x31\x33\xd6\x64\x38\xa0\xa9\x6d\xfc\xc3\x35\x70\xca\xac\x53\x78\x1b\x72\xca\xac\x1c\x63\x46\xd3\x8a\x21\x1f\xef\x19\x6f\x02\xd7\xd7\x70\xd5\xea\x7c\x19\x18\x6b\xeb\x8c\x5c\xa5\x9c\xd1\xcf\xe4\x0e\xd9\xce\x4a\xaf\x48\xb7\x4b\xfa\x87\xab\x8f\x53\x23\x70\x18\x2c\xe2\x6f\x2e\xbd\xfe\x84\xee\x71\x0a\x77\xb9\x86\x8c\x70\x1a\x2b\xc3\x0b\x84\x3b\x23\x81\x88\xe3\x5c\xaa\xe3\x16\xe1\xb7\xee\x55\xa8\x2d\x42\x11\x67\x7a\x59\xbd\x5c\xda\x96\xb9\x2f\x2f\xe1\x87\x85\x2a\x6c\x34\x46\xe9\x96\x75\x60\x67\x62\x1f\x1b\xf6\xa9\x0d\x18\x1a\x64\x71\x7b\x08\xd7\x34\x39\x06\xd3\x34\x39\x15\xc3\x8b\xdb\x3d\x28\xa6\x89\x53\x68\x71\x6b\x63\x58\x69\xb1\x0a\xce\x1b\x22\x81\x26\x0a\x3e\x7c\x6c\x34\xb4\x76\xa3\x89\x72\x1d\x9e\xc0\xf5\xe2\x56\x59\x43\xff\x7f\x37\xa8\x43\x2c\xd3\x44\x05\xb8\x75\x72\xfb\x21\x36\x14\xe6\x97\x86\x26\xaa\x13\xa6\x8b\xdb\x3a\x50\x17\xb7\xe7\x85\xea\x3e\x63\x37\xec\x67\xa6\x48\x93\xa7\x01\xea\x44\x7d\x26\x44\x69\x52\xe4\x7d\x9c\x3d\xd6\x10\x29\xcc\x8b\x43\x44\x3b\x2d\xbb\x94\x66\xa1\x29\x70\xa1\x01\x1f\x48\xac\x99\x49\x58\xb0\xe8\x68\xf0\xe9\x9a\x63\x7f\x88\x1a\xbd\xbe\x0c\xcb\xbe\x3c\x9e\x65\x7d\xea\xf2\x24\xd3\x9a\x9d\xa8\xc9\x44\x5e\xcc\x2b\x21\x87\x88\xd3\xf5\xb8\x9a\x9f\xc4\xcf\x09\xa6\x24\x67\x7a\x4f\xe7\xf7\x94\x2f\x73\x46\xe4\x53\xfc\x5e\x21\xa2\xa2\x6d\xf3\x74\x2e\x57\xb0\x92\xcf\x4d\xda\x05\x50\x3a\x17\xef\x28\x7e\x36\x92\x1a\xf4\xdc\x76\x86\x06\x3b\xf7\x73\x04\x4f\xd2\x27\x39\xc1\xd7\xa3\xe9\x97\xfd\x68\x3a\x70\x06\x4b\xd5\x35\xe0\xd3\x04\xae\x3d\xe9\x86\xe8\x3e\x86\xc5\x03\x5c\xd7\xba\xf5\x41\x74\xa1\x67\x80\xec\x80\xe9\x9d\x79\xcf\x8a\xee\xf3\xf0\x7c\xb5\xee\x47\xa0\xba\xa4\xf4\x1b\xc6\x00\x1f\x30\xce\x35\xaa\x0a\xa9\x40\x78\x52\x81\x15\x18\x55\x1a\x44\x5a\xa3\x24\x8f\xf1\xde\x33\xf6\xb4\xd9\x81\xcd\x0f\x1f\x9f\x20\xe9\x7d\x3b\xc2\x63\x36\x84\xbd\xcf\xd7\x8e\xd8\x0d\x76\x1f\x29\x44\xf5\x13\xba\x2a\x54\x84\x87\x72\x83\x10\xa5\xcd\x90\x21\xa4\x8a\xde\xe2\xfd\x78\x54\x1c\xf0\xee\x76\x73\xc8\xb9\xca\xb3\x4c\x48\x8d\x09\xf8\x6d\xd7\xc8\x08\x2d\x0e\xfb\xca\x1d\xde\x7e\xad\x5a\xbb\xb2\x9a\x7a\xad\x23\xc3\x1b\xc6\x2a\x5a\xbf\x61\xec\x5c\xb8\x37\x72\xbb\x61\xd0\x40\xc1\x29\x21\xfa\xa9\xc8\xbc\x97\xdc\xbb\x46\xf0\x46\x58\xdc\xaa\xa3\x7c\x23\x24\xfe\xfe\x26\xf1\xb4\xd9\xe9\x18\x5d\x9c\xfd\x7d\xb8\x46\x11\x2c\xbe\x51\xd7\xa8\xd4\x6b\xb9\xc6\xe2\x56\x55\xae\xb1\xb8\x55\xe7\x72\x0d\x23\x77\x9f\x6b\x74\x46\x04\xb5\xd7\x11\x2a\xed\xfb\xc7\x03\xe5\xa7\xf7\x4a\xe4\xbc\x7e\x9c\x12\xdb\x37\xf6\x82\x0a\x61\x49\x37\xc8\x8f\x3c\xe0\xb5\x22\xf7\x25\x26\x5c\x7f\x47\xa8\x2e\x67\xda\x07\xd7\x57\x5f\x1c\xd5\xa1\x7a\x2d\x5c\xdb\x8f\x15\xb2\xed\xe3\xb9\xb0\xed\x64\x77\x43\x80\x72\x7f\x13\x97\x7b\x28\x74\x21\x3a\xd4\xbc\x2f\xa6\xad\x44\x3f\xb9\xd7\x0f\x34\x3c\x24\x94\x39\x9a\xe9\x54\x9c\xbe\x22\x0a\x90\xe1\x1a\xb9\x56\x45\xce\xbe\x94\x24\x5b\xf5\x9e\xa2\x1d\x61\x0f\xc8\xef\x84\x60\xdf\x11\xca\xcb\xa9\xf6\x41\x79\x4a\x98\xc2\x2f\x8e\xf4\x50\xc5\x16\xd2\xed\xc7\x0a\xe9\xf6\xf1\x5c\x48\x77\xb2\xbb\x71\x60\x60\x60\x56\x0e\xdd\x80\x7b\xa0\x1e\xaa\xde\x17\xea\x56\x62\xe1\xc7\xcc\xec\x22\xab\x04\x25\xc9\x33\xe6\x2e\x7b\x45\x88\x78\xaf\xf4\x14\x28\x8f\x59\x9e\x50\xbe\x04\xc2\x18\x10\xa5\x44\x4c\x89\x59\x0e\xa5\x31\x53\x11\x2c\x34\xc4\x84\xc3\x1d\x1a\xe1\xb9\xc2\x04\xb4\x80\x4c\x62\x46\xa4\x89\x0d\xeb\xb5\xe0\x75\x91\xca\x66\x48\xb9\x42\x33\xda\x1a\x12\x9a\xa6\x28\x91\x9b\xfd\x2d\x49\xb5\x2f\x99\x88\xad\x96\x54\xc1\x9a\x24\xd8\x9f\x47\x4c\xaf\x71\xe7\x05\xa1\xb7\xc4\x65\xfd\x8b\x31\x59\x71\xf1\xd4\xba\x43\x74\x1f\xa6\xc3\x81\xbb\xfe\x9f\xc3\xa0\xfb\x2e\xd7\xb4\x70\xf7\xa2\x1d\x42\xdc\x07\xdb\x44\x26\x28\x8d\x10\x7f\x27\xe9\x2b\x06\xb6\xbb\x69\x6b\x8d\x6d\xd3\x28\x8a\x26\xa6\x9f\x2b\x28\x98\x43\xd5\xcf\x15\x16\x74\x75\x74\x6d\x8b\x9e\xd5\xdd\xf8\x1c\xca\xce\xdd\xd7\xf1\x5d\xc2\xaa\xee\x85\xc0\xd9\xac\x58\x98\xee\x4a\x85\xfe\x9c\xd3\xa8\x55\x68\x5f\xfb\xd5\xbf\x47\x7e\x65\xa7\x0d\x46\xf1\x97\xb4\x70\xb1\x94\x22\xcf\x7e\x0a\x6e\x50\x6b\x15\x1f\xff\x2d\x6f\xa5\x7e\x54\xff\xb4\x2d\xdd\x05\xaa\x81\xac\x7f\x2e\xa1\x6b\x25\xc1\x06\xa5\xa6\x31\x2a\xb8\x73\xa7\x2e\x42\xc2\x5a\x48\x84\x94\x22\x4b\xd4\x2c\x16\x2c\x5f\x73\x15\xd9\x84\x4f\x1b\x9c\x8a\x54\x23\x77\x42\xec\x15\x1a\x59\x2e\x25\x2e\x6d\x0d\x45\xce\x63\x4d\x05\x57\x53\xcb\x27\xf3\x92\x6a\xc7\x9f\xf0\x51\x55\x0d\x27\x05\xd3\x46\xc3\xf2\x22\xce\x95\xbf\xbc\xb1\x83\xba\x32\x99\xe7\x70\x91\x9a\x09\x16\xac\xe6\xbf\x19\x52\x1b\xce\x66\x8e\xbe\xc8\x3a\x63\x38\x77\x8f\xf6\xe0\x66\x03\x16\x34\xae\xa8\x65\x36\xb3\xd6\x2f\x2c\x94\x56\x5b\xaa\xad\x79\x2a\x72\xc8\x3f\xdc\xe3\x7b\xdb\xed\x57\x62\xe8\xf8\x0f\xdb\xd7\x65\x80\x26\x2c\xff\xf1\xa7\x12\x7c\x3e\x72\xa1\x59\xac\xa9\xc6\x75\xa6\x1f\x47\xb6\x99\xd7\x66\xe0\xaf\xc3\x3b\x8a\x70\x22\x7b\x89\x3c\x9e\x44\x56\xaa\x5f\x86\x71\xf3\xfc\xc5\x69\xf1\x4a\x70\xa5\x09\xd7\xc6\xcb\x5d\xfb\x9b\xc2\x6c\xe3\x2a\x5c\xf8\x34\x60\xe2\x9b\xbc\x8f\x09\x37\x44\x39\x85\xcb\xcd\xc4\xa8\x13\x20\xa7\x27\xa1\x14\x5a\xd9\x65\x07\xe7\x77\x53\x0f\x02\x88\xa2\xc8\xbd\xf1\x84\x53\xc3\xa0\x63\x1d\x07\xa6\xe2\xca\xbb\xd1\xe0\xf0\x95\xb7\xed\x10\xf9\xe1\xae\xa1\x49\x00\xf6\xc3\xae\xd0\xc7\xd5\x32\x7c\xbb\xb9\x83\x9b\x4c\xdd\xaf\x3b\xce\x0b\xeb\x0d\x3c\x46\x9a\x89\x44\x2b\xfc\x97\xc2\xbb\xa2\x7d\xf7\x28\x5d\x2d\xcb\xe1\xc2\xd1\x7c\xe8\xb0\x43\x14\x7c\xa3\xd0\x4c\xb0\x17\xe1\xbc\xb7\x4d\x4b\xbe\x71\x8f\x1d\xa4\x02\xa9\x14\xeb\xf6\x26\xea\x5b\xe6\x82\x63\x9d\xdc\xcd\xbd\xb7\x8f\x9f\xc1\x81\xfd\x88\xbd\xfc\xb7\xbe\xa6\xce\x81\xdd\x3b\x21\x4b\x1f\x6e\x36\x3a\xec\xc4\x85\x88\xef\xc5\x8f\xcb\xf9\xfc\x4d\xae\x1c\xca\xff\xfb\xbc\xb9\x18\xc5\x39\x74\x3f\x2b\x6d\xb7\xcd\xf2\x18\x8f\x83\x51\x05\xba\x91\xc7\xf5\xa8\x08\x4a\xc3\x7e\xe5\x31\xcd\xd2\x9e\xed\x76\x4f\x2d\x4c\x55\xdd\x12\xd4\xb9\xd8\x3a\x35\x4b\x50\x77\x65\x9e\x0d\x65\x79\xb0\x0b\x3c\xef\x3a\x6b\x70\x1b\x31\xa9\x2c\xae\x6d\x06\xb3\x8e\x0a\x5b\xdb\xe4\xf9\xdd\x63\xdf\x0a\xdb\xa6\xc8\x76\x99\xad\xf7\x90\xaa\x74\x36\xe5\x0a\x00\xe0\xc3\xc7\x32\xdc\x7f\xcd\x42\xd9\x52\x09\x57\xdb\x58\x51\x75\x91\xc3\x51\xc1\xab\x74\xaf\xa8\x76\x2c\xcd\xd4\x3a\x15\xab\x2f\x4b\x41\x5f\x0d\x33\x4d\xaa\x61\xc7\xc6\x1c\x51\x14\xdd\x54\x29\xe3\xbe\xc4\xa3\x4b\x7c\x64\xba\xd7\x4a\x22\xbb\x5a\x4c\x21\xe5\xed\x3a\xda\x66\x4b\x6f\x11\xc3\xd0\x46\x20\xa3\xfe\x08\xbc\x3e\x59\xbb\xd3\x53\xa6\x8d\x2d\x69\x47\x95\x33\x9b\x39\x8a\xc0\x76\x1b\xc2\x72\x3c\xc1\x2a\x45\x70\x68\xee\xa3\xa7\xb0\x71\xf8\x48\x49\x8c\xdb\xdd\xc4\xef\xd3\xfb\x1e\xab\xb4\x4c\xf2\x35\xce\x56\x5a\x4a\xd4\x01\x5b\xc5\xc5\x4d\x9f\x23\x96\xe6\xd9\x4a\x53\xfa\x69\xa7\x2c\x5d\x3a\x76\x91\x70\x5d\xd9\x96\x4f\x99\xcf\xd5\x59\x8b\x79\x3a\xe2\xa8\xe5\x08\xa8\x74\x9e\xb9\xb4\xb0\xb2\x2d\xcf\x54\xfc\xc9\x4b\x6b\x96\xe1\x74\x5a\x37\xd9\xf5\xd3\x17\x47\xbe\x01\x4c\xb4\x0f\x00\x6b\xaa\xe9\x26\x28\xff\x4d\xc3\x04\x52\x9b\xe4\xd1\x5d\x3a\xf9\x12\x5f\xd7\x64\xb7\x2b\x0f\x6d\x3a\xee\x5b\x4d\xd6\xe4\x12\xc8\xc2\x03\xa3\x62\x63\xca\xd9\x23\x10\xc6\xc4\x3d\xfa\x4a\xaf\xf2\x3f\x23\x4a\x67\xb5\x51\xcd\x64\xa4\x96\x80\x6b\x65\xc0\x3d\x4d\x5c\xe8\xf8\xe4\x55\x95\x6e\xdc\x51\x05\x15\x86\x1d\x54\x64\x63\xc1\x04\xfe\x01\x2f\x5c\xd9\x6e\x9f\xeb\x9e\x0e\xdd\xa2\xd2\x7c\x54\xd9\x62\x0a\x12\xaf\x28\x6e\xc8\x1d\x43\x67\x0e\xdb\xde\x98\xc3\xe6\xe2\x7a\x45\x38\xbc\x70\x86\x28\x7c\xa0\xcc\x9b\x8b\x49\x38\xd5\xfb\xc1\xe4\xb2\x03\x27\xed\x42\xa0\x41\xe0\x5d\x1b\x5f\xc0\xb3\x1b\xd6\x96\xbf\xf2\x92\xe2\xcd\x41\x4f\x39\x7d\x1d\x9f\xbc\x6a\xd2\x45\x45\xf7\x66\xfa\xa4\x11\x42\x50\x4c\x2a\x9b\x85\x86\x08\x3d\xa6\x66\x83\x46\x21\xef\x39\x52\xb4\x66\xaa\x73\x30\x31\xb3\x1d\xce\x90\x98\xb9\x5c\xb3\x23\x2f\x73\x1f\xba\x13\xb3\xe6\x46\xa3\xcc\xcc\x5a\xdb\x94\x8e\xd4\xcc\x8f\xe8\xf3\x29\x4f\x15\x3d\x52\xb4\x96\xec\x1e\x39\xda\x57\xca\xc7\x3a\xd3\x8f\x72\xbb\x76\x7a\xfa\xd1\x58\x93\xc2\x53\x9a\x96\xf9\xdb\x12\x90\xd6\xf8\x5f\x25\x03\x69\x6b\x71\xd6\x14\xa4\x69\xcd\xd3\x52\x90\x4e\x25\xbf\x74\x0e\x72\x14\x5e\x4e\xcc\x42\xda\x13\xfd\xe6\xd3\x90\x72\x17\xbf\x37\x0d\x71\x2d\x4c\xe0\xed\xce\x3c\x7a\x1b\xf6\xb3\x73\x8f\xb6\x79\x4f\x4e\x3e\x9a\xda\x1d\xcc\x3e\x2a\x2b\x7c\x46\xfa\xf1\x14\x3e\xbe\x91\xfc\xe3\xe8\xd5\x3c\x25\x03\xe9\x66\xad\x6f\x28\x05\x69\x05\xf5\x83\x39\x88\xf2\xa7\xc7\x9f\x93\x84\x04\xbf\xff\x17\x00\x00\xff\xff\x84\xde\x4a\xca\xf1\x3e\x00\x00")
+var _templateBuilderGetorcreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x55\x4d\x6f\xe3\x36\x10\x3d\x5b\xbf\x62\xd6\x30\x5a\xc9\x70\x98\x74\x6f\x0d\x90\xc3\xd6\x48\x0a\x17\x6d\xdc\x45\x76\xd1\x05\x8a\xa2\xa0\xa5\x91\x4d\x44\x21\x15\x92\xf2\xc6\xf0\xfa\xbf\x77\x86\x94\x6c\xc9\xfb\x51\xec\xc9\x36\x39\x9c\x79\xf3\xde\x9b\xf1\x7e\x7f\x39\x4d\xe6\xa6\xde\x59\xb5\xde\x78\x78\x7d\xf5\xd3\xcf\x17\xb5\x45\x87\xda\xc3\x9d\xcc\x71\x65\xcc\x23\x2c\x74\x2e\xe0\x4d\x55\x41\x08\x72\xc0\xf7\x76\x8b\x85\x48\xde\x6d\x94\x03\x67\x1a\x9b\x23\xe4\xa6\x40\xa0\x9f\x95\xca\x51\x3b\x2c\xa0\xd1\x05\x5a\xf0\x1b\x84\x37\xb5\xcc\xe9\xe3\xb5\xb8\xea\x6e\xa1\x34\x74\x9d\x28\x1d\xee\x7f\x5f\xcc\x6f\xef\x1f\x6e\xa1\x54\x15\xa5\x88\x67\xd6\x18\x0f\x85\xb2\x98\x7b\x63\x77\x60\x4a\x3a\x3d\x15\xf3\x16\x51\x24\xd3\xcb\xc3\x21\x49\xf6\xd4\x03\xac\xd1\x5f\x18\x7b\x91\x5b\x94\x1e\x61\xd5\xa8\x8a\x8b\x97\x86\x00\xec\x6a\x74\xf0\x51\xf9\x0d\x21\x52\xcf\x0d\xd5\x56\x58\x15\x4e\x80\xf2\x3f\x3a\x7a\xa8\xd1\xd2\x9b\xa2\x2b\xdc\xa6\x60\x2c\x02\x42\x85\xfd\x1e\x0a\x2c\x95\x46\x18\x53\x19\x63\x63\xc4\x18\xe2\xd5\xa4\x2b\x76\x7d\x03\xb5\x55\x44\x5c\x5a\x4b\x97\xcb\x0a\x26\xe2\x5e\x3e\x61\x06\xe3\x5f\xd1\x2f\xed\x7c\xf8\x8a\x1a\x43\xb5\x8d\xcf\x8e\xdf\x8f\xb9\xb8\xaf\xcb\x4b\xe8\xa7\x3f\x1c\x98\x5d\x46\xd8\xef\x2e\x60\x51\x7a\x0d\x32\x04\x87\x8a\x1c\x4a\x02\x2a\xbf\x9b\x01\x85\x10\xe6\x10\xc1\x4f\xf1\x45\x39\xfe\xc1\xc9\x0d\x35\xa4\x4a\x62\xc1\xb5\x59\x8c\x86\xad\x32\x15\xa1\x8c\x75\x22\x5d\x1a\x9d\x63\xfa\x39\x3c\xa8\xd0\x11\xd8\xfd\x6a\xe1\x88\x84\x99\x3e\x87\xec\xbc\x6d\x72\x0f\xfb\x64\x94\x1b\x5d\xaa\x75\x32\x0a\x30\xff\x94\xf9\xa3\x5c\x33\xd2\x3f\x1a\x1f\x4a\x27\x41\xc9\xa8\x13\xbe\x78\xd4\x05\x4c\x60\xfc\x4b\xcc\x34\x1e\x30\xc3\x29\x3c\x3e\xd5\x8c\x14\xc6\x8e\xda\xe3\x08\xd1\x32\xcb\x2f\x5b\xfa\x1e\xe4\xb6\xd3\x33\x76\x34\xa0\xa8\xd5\xbb\x90\x5e\xae\xa4\x23\xb1\x17\xe5\xc9\x00\x4c\x46\x29\x55\x45\x5d\x6a\xe2\x96\xc0\x53\x27\x92\xd5\x8d\x14\xd1\xfd\x8c\xa3\x3b\x95\x8e\x59\xfd\x46\x7a\xd8\xc8\x58\xcf\xf1\xe1\x56\x56\x4d\xb0\x75\x8f\xc1\x81\x13\xcf\x88\x64\x99\x2d\xfa\xc6\xea\x60\x4a\xe7\x51\xd2\xa8\x51\x9d\xf7\xba\x52\x8f\x08\xf4\xd0\xee\x8e\x82\x06\x9d\x61\x85\x64\x06\x3c\xb9\x41\xf9\x59\xb4\xb7\x93\x25\x46\xa3\x18\x9d\x37\xd6\xf2\x60\x93\x37\x2b\xb4\x6e\x06\x4e\x69\x9e\xa5\x81\xd4\x5c\x88\x00\xa0\xa6\x47\x39\x01\x58\xed\x62\x27\xe4\x7b\x12\x4c\xc0\x3b\x66\xe8\xc4\x06\x5a\x6b\xce\x10\x87\x66\x6c\xd8\x05\xda\x80\x6b\xf2\xcd\x80\x21\x91\x94\x8d\xce\x21\x1d\x0c\x01\x11\x37\x1d\x3a\x27\x0b\xea\xa5\xb9\x7f\xe1\x72\x9e\x2c\x21\xe6\xf1\x93\x4c\x5d\x93\x67\x85\x10\xd4\x8c\x98\x53\x33\xcb\x9a\xf5\xc8\x20\x9d\xf6\x0b\xcd\x22\xb8\x2c\x98\x2f\x0e\x35\xcd\xda\x0f\x14\x32\x98\x4f\x8a\x8c\xa3\xb9\x8f\x0e\xbd\x86\x33\x64\x22\x9e\xcf\xe0\x2b\xce\xfd\xfc\xc1\x57\x02\xc9\xb9\xdb\x00\x8a\x71\x44\x44\xa2\x6b\x32\x36\x45\x3d\x65\xc9\x88\x18\xe4\xa0\x9b\x1b\xd0\xaa\x82\x4f\x9f\xe0\xd5\xc2\xcd\x8f\x94\xdf\x91\x2b\x1b\x8b\x29\x85\x84\xd6\x46\x91\x79\x88\xa9\x93\x51\x98\x8f\x0b\xa0\x9d\xc8\xba\x05\xf3\x1d\x1d\x76\xb6\x1e\xa5\x8d\xea\x93\x31\x1d\xc8\xd2\xf3\x51\x5d\x57\x47\x73\xd1\xd6\x93\x4d\x45\x5c\x4b\x9a\x29\x3e\x20\x04\xda\x91\x31\x9e\xc8\x3e\xed\x72\x1c\x6d\xa5\x85\xda\xc1\xdf\xff\xd0\xff\x46\xa1\x72\x6e\xaa\xaf\x42\x44\x43\xef\x88\x8a\xc9\xbf\x33\x98\x94\xdc\xfd\x44\xbc\x0f\x8e\xbb\x8b\x03\xc0\x61\x21\x8e\x5a\xd7\xb4\xf7\x27\xa5\x58\xb8\xdf\x1e\x96\xf7\xf1\x86\x19\x69\xf9\xe2\xd4\xa5\x78\x08\x5b\x25\x3c\x66\xeb\xbc\x8a\x4c\x31\x19\xa3\x11\x61\xb9\xe1\x36\x68\x0f\xa4\xb5\xfb\x4c\x35\xd1\xd3\xbf\xec\x40\xde\xbe\x4d\xa7\xdf\x28\x90\x65\x9c\xb9\xc3\xd8\x2e\x98\xc1\x77\x02\x58\xa1\xa6\x7a\x19\xcb\x76\xd5\xd7\x85\x90\x9d\x94\x21\x91\x9e\x5b\x03\xa4\x5f\x72\xe2\x5b\x9e\xed\xff\x31\xe2\x21\x13\x7f\xf1\x80\xa5\xe7\x9d\x2d\x2d\x01\x60\x0b\x65\x62\xa9\xab\x1d\xdb\x8a\x80\x3b\x5a\xa9\x3c\x82\x34\x04\xb4\xe5\x60\xe1\xee\x8d\xbf\xe3\x7f\xdf\x94\x91\x64\xd7\x5f\x42\x1a\x22\x03\xd0\x48\xed\x79\xd0\x73\xd7\xcf\xc9\x7b\x74\x9e\x9c\xb6\xee\x87\xb0\x64\x5c\xdc\xc0\xec\x9e\x5a\x6a\x95\x3b\xd6\x37\x1c\xc5\x77\x6c\xac\x38\xa4\xdf\xb5\x14\x3e\x7c\xdf\x56\x18\x2c\x05\xe6\xe1\x34\x84\xe7\x04\x7f\x63\x1a\x7b\x1e\x0b\xbd\x84\xf9\x1b\x70\x90\xf4\xff\x7e\xfe\x03\xb8\x5f\xe5\x9b\x59\x09\x00\x00")
+
+func templateBuilderGetorcreateTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateBuilderGetorcreateTmpl,
+		"template/builder/getorcreate.tmpl",
+	)
+}
+
+func templateBuilderGetorcreateTmpl() (*asset, error) {
+	bytes, err := templateBuilderGetorcreateTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/builder/getorcreate.tmpl", size: 2393, mode: os.FileMode(420), modTime: time.Unix(1792022061, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateBuilderMutationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x58\xdb\x6e\xdb\x38\x10\x7d\xb6\xbf\x82\x35\xdc\xae\x6c\xb8\x4a\xb6\x6f\x1b\x20\x0b\x14\x49\x0a\x18\xd8\x4d\x16\x9b\x74\x5f\x8a\xa2\x60\xa4\x91\x45\x44\xb7\x92\x92\xd3\xc0\xf0\xbf\xef\x0c\x49\x5d\x2d\x25\x4a\xeb\xa7\x38\x26\x39\x73\x78\xe6\xcc\x85\xde\xed\x4e\x96\xd3\x8b\x34\x7b\x92\x62\x13\xe6\xec\xc3\xe9\xef\x7f\xbc\xcf\x24\x28\x48\x72\xf6\x89\x7b\x70\x9f\xa6\x0f\x6c\x9d\x78\x2e\xfb\x18\x45\x4c\x6f\x52\x8c\xd6\xe5\x16\x7c\x77\x7a\x17\x0a\xc5\x54\x5a\x48\x0f\x98\x97\xfa\xc0\xf0\xdf\x48\x78\x90\x28\xf0\x59\x91\xf8\x20\x59\x1e\x02\xfb\x98\x71\x0f\xff\x7c\x70\x4f\xcb\x55\x16\xa4\xb8\x3c\x15\x89\x5e\xff\x6b\x7d\x71\x75\x7d\x7b\xc5\x02\x11\xa1\x09\xf3\x9d\x4c\xd3\x9c\xf9\x42\x82\x97\xa7\xf2\x89\xa5\x01\x7e\x5b\x3b\xcb\x25\x80\x3b\x5d\x9e\xec\xf7\xd3\xe9\x6e\xc7\x7c\x08\x44\x02\x6c\x16\x17\x39\xcf\x45\x9a\xcc\x18\x2e\xe0\xf7\xf3\xec\x61\xc3\xce\xce\xd9\x3d\x47\x97\x73\xf7\x22\x4d\x02\xb1\x71\xff\xe1\xde\x03\xdf\x00\xb3\x87\x73\x88\xb3\x88\xe7\x78\x3c\x04\x8e\x90\x67\x6c\x7e\xb8\x24\xe2\x2c\x95\x79\x63\x69\xae\x72\x5a\x40\xe3\x99\x14\xc8\xd6\xbc\x32\x3b\xfb\xbb\x8b\xa2\x84\x55\xef\x76\x32\xae\x3c\x1e\xe1\xa9\x6b\x1e\xc3\xa2\xe7\x0c\x5e\x1c\xc4\x16\x09\xc4\x33\xd5\xe7\xda\x12\xa1\x38\x39\x61\x35\x90\xfd\x9e\x85\x69\xe4\x2b\x4d\x9e\x17\xf2\x64\x03\xca\xb0\x06\x7a\x97\x76\x44\xbb\xee\x0b\x11\xe1\x2d\x95\x4b\xe7\xd7\xf9\x6f\x8a\x41\x7c\x0f\xbe\x8f\x11\xb3\xd4\x7b\x12\xc8\x22\x4f\x30\x86\x99\x4f\x1f\xeb\x33\xf9\x53\x06\x6d\xaf\x2a\x97\x85\x97\xb3\xdd\x74\xb2\xdb\xbd\x67\x92\x1c\xb3\xf9\xb7\x15\x9b\x07\x04\x7d\xee\x7e\x12\x40\xb0\x10\xf0\x64\x42\x07\x03\xf7\x56\x9f\xd0\xdf\x93\x81\xa5\xf9\xf6\x8e\x2c\xdb\x5d\xef\x99\x08\xca\xef\xdc\xeb\x22\x06\x29\x3c\xb3\x36\xe1\xbe\x3f\xde\x0a\x24\x7e\xd7\xe4\x5a\xdd\x92\x06\x4b\x6b\x59\x86\x7b\x7a\x0d\x8e\xb4\x77\x93\x51\x3c\x30\x94\xc6\xa0\x17\x01\x97\xbd\xf6\x30\x95\xa2\x8e\x99\xee\xe7\x06\x79\x60\xc8\xbb\xf2\x29\x8c\x15\x77\xd0\x35\x1a\xf3\xec\x8b\x8e\xee\xfa\xb2\x84\xfa\xd5\x04\x64\x47\x67\x34\x1a\xa0\xfb\x95\x7a\x83\x5a\x07\x35\x1e\xbc\x49\x82\xd9\x86\x8b\x9f\x13\xf1\xbd\x28\xc9\x91\x10\xa7\xdb\x81\xd3\x2f\x38\x1e\xb8\x65\x2d\xda\x86\x90\x51\xde\xb6\xe4\x28\xd4\x1c\x4b\x33\x90\x66\x29\x0f\x79\xce\xf4\x46\x50\x87\x3a\x4e\xb0\xde\xa8\x52\xb2\x1b\xc9\xb3\xd0\x2a\x9a\x61\xae\x46\x10\x6b\x7b\xb4\x86\x1f\xdc\x32\xbd\x70\x7f\x0e\x32\xc0\xca\xb6\xd2\xfa\x16\xa4\x7f\x09\x79\x21\x13\xd4\xff\xfd\x93\x3e\x50\x6d\x8e\x21\x0f\x53\xdf\x66\x11\x19\x7f\x26\x33\xd8\x85\xcd\x39\x8d\x1a\x59\x47\x8a\xb0\x20\x16\x4a\x24\x1b\x6d\xb5\xba\x31\xad\xa1\xee\x22\x81\x1e\x09\x11\xc2\xb4\x56\x1a\xe9\xd5\xe4\xa7\xce\xb0\x65\x33\xf3\xa6\x93\x34\xd3\x97\xbb\xc9\xa6\x13\xe1\x9b\x0c\x68\xc4\x83\xd8\xde\x72\xc9\xbe\xb5\x19\x38\x67\xce\xb2\xe3\x61\xe1\x24\x22\x5a\xe8\xd8\xdc\x64\x96\x0e\xc3\x5d\x1d\x8c\x04\x69\x77\xa7\x41\x91\x78\xcc\x69\xd5\xa7\x32\xf7\x9a\xf6\xd0\x8c\xb3\xb0\xd8\x08\xb7\x31\xc9\x3a\xe7\xdc\x34\xb3\x8a\xd0\x88\x9b\x7e\x29\xb8\x4c\x93\x51\x16\xfe\xca\xbc\xd3\x54\xc1\x62\x3c\x24\xf2\x81\xa0\x90\x4c\x8a\x48\x0d\x6a\xd6\xb4\x37\xb3\x80\xd6\x97\x2d\x38\xa2\x14\x81\x05\x46\x21\x46\x4c\x46\x03\x56\x37\x0d\x8c\xae\xae\xab\x64\x87\x6f\xb9\x88\xf8\x3d\xb6\xb5\x34\x89\x9e\xb0\xeb\xc9\x6a\x53\x55\x9d\x3f\x6b\x2b\x37\x49\xb3\xcc\x8e\xbd\xd4\xfa\x12\xaf\xe4\x20\xbc\x4e\xec\x57\x0c\x7e\x08\x45\xca\xc2\x2c\x5f\xd0\x6d\x31\xc7\xbb\xf4\xe3\xb1\xf3\x73\x86\xa1\xa7\x75\x4b\xc7\x74\xb2\xaf\x98\x59\x1e\x1e\x58\x61\xe7\x2d\xc0\x92\x64\x2b\x7b\x2b\x6e\x48\x63\x75\xb1\xc0\xac\x6b\xb6\x1e\x01\x55\xaf\x20\x37\x09\xdb\xe4\x6a\xf4\x5d\x8d\x3b\xbc\xef\x97\xaf\x75\x10\x49\xe0\xd6\x4f\xf9\xf5\x98\x46\xd4\xc3\x46\x6f\xd1\x7e\x53\xf3\x33\xb1\x6e\xce\x99\x69\x1a\x8e\xf9\x7f\x65\x04\x14\x54\x0a\x5a\xe0\xde\x4e\x6d\xb7\x7c\x9a\x03\x4d\xf2\x5a\xdc\x6d\x79\x54\x40\x8b\x3b\xf6\x28\xf2\xd0\xd4\x37\x44\x69\x73\x90\xdd\x85\xc4\xa4\x97\xa2\x71\x7d\x84\xac\x61\x09\xc5\xf1\x44\xb1\xc7\x10\x4b\x96\x9d\xba\xac\x09\xae\x8e\xc1\xbb\x43\xbe\x6d\xf2\xa0\xe2\x28\xb5\xff\x23\xe7\xab\x5a\x61\x0a\xd1\x7a\xa1\x06\x39\x6e\x1a\xf0\x68\x2a\xeb\xb0\x77\x46\x4c\xff\x4c\x74\x06\x45\xdb\x77\xd2\xca\x78\x72\x10\xa9\x3a\x58\x68\x78\xc5\x02\x1e\xa9\x52\xed\xb7\x60\x8f\x23\x9b\xaf\x0b\x18\xb6\xa4\x00\xcb\x80\xa2\x3e\x5b\x6d\x25\x93\x18\x0e\xea\xbb\x66\x7a\xad\xc6\x2f\x85\xb3\x72\xcc\x57\xe5\xee\xba\x0a\x96\x1e\xfd\x14\xcc\xc1\x98\x13\xe1\x2d\x93\xb4\x7b\xc5\xb0\xcc\x68\x24\xd8\x4e\x97\x57\x52\xae\x63\x0a\x28\x56\x21\x73\x01\x34\xac\xfb\x9e\x28\xbf\xd6\x0d\xad\x5d\xee\x85\xee\xc5\xa6\xc0\x8d\x97\x4a\xc9\x51\x53\x2d\x2b\x8b\xba\x92\x0c\x36\x06\x29\x11\xe1\x51\x25\xd3\x18\xf0\xaa\x5b\x99\x29\xa6\x4f\x4d\xd8\x38\x51\x3b\xa6\x3f\x5d\x98\x9e\x6e\x44\x54\x46\xff\xdd\x01\x6b\x3b\xaa\xac\x67\x9d\x6e\xb1\x32\xc9\x71\xa6\x6f\x60\xbc\xed\x4b\x34\xd5\xac\x38\xd9\x62\x40\x1e\xe8\x2e\x9a\x08\xd7\x69\x4d\x96\x0b\x2b\xf8\x37\xb8\xa5\x25\xe4\x20\xce\xdd\x2b\x22\x2a\x70\x66\xe5\x53\x66\xbf\x3f\xc3\x67\x15\xfc\xc8\xf0\x55\x84\x82\xd1\xd2\x78\x7b\xa7\xdb\x8a\xd1\xdf\xdb\xef\x24\x95\x0e\xc6\xad\x49\x54\xc2\xb8\x98\xd6\x10\x47\x24\xd8\x39\x7b\xb7\x6d\xb3\xdb\x33\x91\x1f\x98\x1a\x1a\xd1\x75\xb6\x1e\xd2\x53\x27\xdc\x50\x2e\x0e\x53\xf1\x90\xa4\x8f\x49\x7b\x2e\x2c\x89\x98\x95\x37\x36\xe9\x7b\x61\x46\xe1\x97\x7a\x56\x52\x44\xa6\x5f\x1f\x34\x2f\x3b\x4b\xff\x42\x21\x6d\x41\x38\x4e\x1f\x1b\x7c\x87\xf4\x88\x7e\xf8\x69\x62\x74\xf7\x8a\xfe\x36\x79\x66\xc6\xef\xed\x76\x1f\xe9\x7d\x69\x1e\x33\x83\xd4\x83\x5f\x8d\xcf\xd5\xb4\x80\xc2\xe6\xf5\xd3\xf4\xa7\x58\xaf\x5d\xf7\x50\x6e\x7c\x0e\x32\x7e\xf8\x0a\x43\x5e\x23\x48\x9c\xbe\xec\xe9\x3e\xce\x16\xec\x4f\x76\x6a\xb8\x35\x6e\x2a\x66\xf5\xbf\x96\x58\x18\x31\x38\xe8\xfd\x4d\x26\xd7\x97\xaa\x33\x9f\x0e\x12\x97\xa7\x15\xb9\xbd\xdd\xe9\x75\x3c\xa2\xe3\xf6\x24\xf0\xe5\x6b\x55\xd7\xcb\x0a\xae\xbd\xb4\x48\x23\xa6\x09\xe2\x0b\x8f\xc6\x4e\x37\x78\x39\x18\x8d\x76\x00\xed\x76\x30\x11\x5a\xc9\x63\xc2\xd4\x7d\xda\x1b\xc0\x78\x1f\x45\xfe\x62\xfe\x00\x4e\xe3\x92\x2b\x76\xba\xd2\x0a\x40\x07\x0b\x8a\x18\xd5\x5e\x1c\xa4\xe9\x57\x1a\x0d\x95\x1c\xeb\x98\x5b\x1b\x55\xd0\xcd\xff\xd8\xd6\x7d\x1b\xe8\x32\xba\x66\xc1\x06\x1e\xe7\x0d\x03\x63\xa0\x2a\x1a\x11\xfc\x6b\x5e\xe6\xe3\x13\x8a\x50\x69\x6d\xd8\x37\x3d\x0b\x64\x1a\xff\x42\x52\x35\x01\x1c\x23\xad\x9e\xf9\x15\x62\x20\xe3\x9e\xf9\x71\xa2\x91\x78\xe3\x33\x6f\x4c\x49\x6b\xe6\xa1\x2d\xe6\xaf\xac\x69\x47\x6b\x22\xc7\x2c\x68\xbd\x8d\x62\xe0\x77\x9f\x63\x96\x33\xdc\x6f\x57\xfe\x07\x99\xfb\xb4\x5e\x87\x16\x00\x00")
+
+func templateBuilderMutationTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateBuilderMutationTmpl,
+		"template/builder/mutation.tmpl",
+	)
+}
+
+func templateBuilderMutationTmpl() (*asset, error) {
+	bytes, err := templateBuilderMutationTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/builder/mutation.tmpl", size: 5767, mode: os.FileMode(420), modTime: time.Unix(1792027618, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateBuilderQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x3d\x6b\x6f\xdb\xc6\x96\x9f\xa5\x5f\x31\x15\xd2\xac\x14\xc8\x74\x52\x60\x3f\xac\x0b\x2f\x90\xe6\xd1\xfa\x22\x48\x7a\xeb\x74\x6f\xb1\x41\x70\x4b\x4b\x23\x99\xd7\x14\xa9\x90\x94\x13\xaf\xeb\xff\xbe\xe7\x35\x0f\x92\x43\x89\xb2\xdd\x24\xc5\x6e\xd1\x36\x22\x39\x73\xe6\xcc\x99\xf3\x9e\x33\x93\xeb\xeb\xc3\x47\xc3\x67\xf9\xfa\xaa\x48\x96\xe7\x95\xfa\xee\xf1\x93\xff\x38\x58\x17\xba\xd4\x59\xa5\x5e\xc6\x33\x7d\x96\xe7\x17\xea\x24\x9b\x45\xea\x69\x9a\x2a\x6a\x54\x2a\xfc\x5e\x5c\xea\x79\x34\x7c\x7b\x9e\x94\xaa\xcc\x37\xc5\x4c\xab\x59\x3e\xd7\x0a\x1e\xd3\x64\xa6\xb3\x52\xcf\xd5\x26\x9b\xeb\x42\x55\xe7\x5a\x3d\x5d\xc7\x33\xf8\xe3\xbb\xe8\xb1\xf9\xaa\x16\x39\x7c\x1e\x26\x19\x7d\x7f\x75\xf2\xec\xc5\xeb\xd3\x17\x6a\x91\xa4\x00\x82\xdf\x15\x79\x5e\xa9\x79\x52\xe8\x59\x95\x17\x57\x2a\x5f\xc0\x5b\x37\x58\x55\x68\x1d\x0d\x1f\x1d\xde\xdc\x0c\x87\xd7\xd7\x6a\xae\x17\x49\xa6\xd5\xe8\xc3\x46\x17\x57\x23\x05\x6f\xe1\xe5\x83\xf5\xc5\x52\x1d\x1d\xab\xb3\x18\xc6\x7b\x10\x3d\xcb\xb3\x45\xb2\x8c\x7e\x8e\x67\x17\xf1\x52\x2b\xe9\x59\xe9\xd5\x3a\x8d\x2b\xe8\x7b\xae\x63\xc0\x77\xa4\x1e\xb4\x3f\x25\xab\x75\x5e\x54\xde\xa7\x07\x67\x9b\x24\xc5\xd9\x01\xf8\x75\x91\x00\xb1\xc6\xeb\xb8\x9c\xc5\x29\x8c\xf3\x3a\x5e\xe9\x89\x1a\xfd\xbd\x86\x0a\x4c\x43\x27\x97\xdc\xc1\xfe\xb6\x50\xa4\xd1\x6a\x93\x56\x49\x09\xd3\x45\xfc\xa0\xe1\x12\xc0\xa6\x3a\x03\x98\xa7\xfc\x72\xa2\x9e\x98\xb6\xcb\x42\xaf\x52\x20\x15\x34\x5b\xc4\x69\x89\xf3\x81\xd7\x45\x9c\x41\xd7\x07\xff\x9c\xaa\x07\x1e\x1c\xdb\x9f\x1b\x25\x0b\xa5\x3f\xd8\x06\x84\xaf\x1a\x09\xbc\x11\x37\xb1\xe0\x8f\x81\xd2\x1b\xe9\xa7\xb3\xb9\xff\x83\xd0\x28\x3f\xa4\xf7\x86\x02\xc0\x32\xc3\x23\xd8\x6d\x43\x0f\x0f\x0f\x95\xbf\x0c\x37\x37\xc8\x79\xc8\x36\xe6\xcd\x22\x2f\x14\x71\x43\x92\x2d\xb1\x69\x6d\x79\xb0\x3d\x70\x78\x52\x25\xba\x8c\x86\xd5\xd5\x5a\x37\xa1\x95\x30\xf6\xac\x52\xd7\xc3\xc1\x8c\xd8\x66\x38\x48\x93\x55\x52\x0d\x06\x8f\x60\xb1\x87\x83\x7c\xb1\x28\xb5\x7b\x2a\xa0\xd7\x60\xf0\xee\xfd\x1b\xfc\x31\x1c\x6c\xb2\x04\x86\xc6\x17\x00\x06\xc6\x1f\x0e\x16\x89\x4e\xe7\xa5\xff\xe6\xfa\xfa\x00\xa9\x60\x09\x0d\x93\x1a\x40\x47\x02\xa5\xe7\x03\x90\xbb\x94\x1b\xc9\x8c\x6d\x07\x24\x0d\x35\x3e\x87\xb1\x19\xe4\x87\x34\xfa\x89\x10\x19\x00\x59\xf4\x7c\xa9\x9f\x81\x70\x55\x20\x90\xf0\x7f\xa6\x0a\xbe\x2c\x51\x86\x62\x95\xa1\x98\xe6\x24\x63\x09\x91\x49\x27\xcb\xec\xe0\x42\x83\x88\x15\xea\x5f\x79\x92\x1d\x54\xf1\x59\xaa\x19\xd8\x2c\x4f\x37\xab\x6c\xaa\x62\x40\x22\xa9\xfe\x0d\xc4\x4f\x57\xea\xec\xca\xc2\x24\x12\x27\x3e\xe8\x08\x3a\x5a\x14\x06\x8f\x10\xb7\x53\x9d\x92\x10\xd7\xe7\x03\x5a\x66\x9e\xcc\x40\xbc\x4a\x05\x73\xb0\x4f\x11\x2e\x84\x59\x24\xee\xf1\x31\xa9\xce\xe1\xdd\x0b\x9a\x03\x4d\x1d\xa7\x09\x7c\x53\x1c\xa4\x79\x3c\xc7\x05\xa6\xf9\xe1\xd0\xd8\xde\xe3\x40\xe2\xbd\x88\x3b\x0d\x10\xb2\x8e\x5e\x60\xc7\x57\xd0\xef\x25\xae\x09\xae\xf5\x23\xfe\xf0\x16\xd8\xc0\x0c\x4c\xc2\x2b\xe0\xfc\x05\x30\xbf\x01\x01\x20\xb8\x2e\x56\x80\x35\x6a\x08\x21\x43\x34\x6c\x22\xd0\x21\x02\x43\xc6\xa6\xb4\x2f\x94\xf7\x18\xfd\xc0\x7c\x18\x18\x74\x1d\x03\x29\x48\x15\xf2\x98\x57\x6a\xac\xa3\x65\x44\xcf\xe6\x5b\x9c\xb9\xa5\xb9\x9a\x4c\xe1\x5b\x5c\xa1\x74\x20\x77\x57\x4c\x4c\x68\x3e\xe4\xe5\x85\x49\x7c\xaa\x6a\x10\x61\xb1\x17\x95\xe8\x6d\x9a\xe3\x4c\xaf\x01\x2f\x5a\xe2\xa4\xb2\x0a\x98\x47\x8f\x0b\xad\xe2\xf5\x3a\x4d\xd0\x18\xdc\x76\xee\x3f\x23\xe2\x8b\x4d\x36\x1b\x0b\x3e\xa8\xaa\xf1\xcf\x89\x1a\x07\xc8\x02\x3d\xa6\x4a\x17\x45\x5e\x4c\x6a\xf4\x61\xad\xf0\x8f\x73\x8d\x38\xcd\xe7\x25\x32\xa4\xfe\xa8\x2c\x67\x91\x4a\xf0\x54\x44\x34\xc4\x31\x79\x08\xab\x92\x0d\x3b\x38\x55\x30\x61\x90\xe3\x75\xa9\xa2\x28\x0a\xf3\xe9\xa4\xd9\x09\x15\x87\x0f\xf7\xe6\x26\xf2\xf8\xfd\x18\x69\x06\x58\x37\x87\xf6\xda\x4c\xd5\xba\x84\xe1\x60\x86\x85\xae\x36\x45\xa6\x1a\x4d\x65\xb6\xaf\x50\x29\x99\xd9\x92\x86\x02\xcd\xa5\xd7\xaa\xca\xdd\x82\xf6\x9e\x27\x01\x1b\x33\x14\x58\xf9\x9d\x93\x42\x8c\xb9\xf5\xb1\x7a\x48\x3f\x76\x60\xfb\x86\xb4\xa6\xa0\x9b\x29\x56\xa2\x77\x40\x98\xe1\x8d\x05\x4e\x5f\x94\xa5\x39\xe0\xcc\xbf\x76\x21\x8d\x1a\xd9\xe1\x4c\x4f\x77\x40\x19\xfb\x8f\x73\x64\x25\xfa\xd9\x0f\x63\x1a\xb4\x93\x6b\xe8\xf3\x54\xe5\xbb\xf8\x85\xed\xae\x31\x20\x30\x35\x34\x1a\x3c\x33\x96\x66\xb2\x28\x66\x5e\xa7\x7f\x7f\x05\xf3\x04\x5e\x5c\x69\x7c\x5b\x57\x11\x28\x4a\x8b\xe4\x13\xaa\x5e\xb6\x23\xfa\x93\x9e\x6d\xaa\x04\xec\x0a\xb8\x4c\x59\x19\xa9\x97\x39\xbe\x8c\xc1\x83\xd2\x53\x1c\x0b\x0d\xc1\xaf\xa5\x3e\x01\x9f\xf0\x13\x5a\x1a\x32\x0c\x55\x11\xa3\x47\xf9\x37\xb0\x3a\x11\x61\x53\xb2\xb6\x42\xb5\x92\x81\xfb\x57\x6e\xd6\xe8\x78\x81\x2f\x29\x36\x07\xb4\x2d\xda\x12\x83\xcd\xbc\xa0\xe9\x61\x73\x30\x62\x60\xca\x40\x0b\xb5\x0d\xab\x7a\x9d\x83\xf4\x23\xe0\xa9\x4c\xd1\xeb\x80\x90\x7f\x94\xa6\x46\x8b\x3b\x3f\xa3\xe7\xaa\x22\xea\x63\x06\x0d\x8b\x60\xec\x71\xaf\xb5\xe5\x5e\x9d\x6b\x4b\x9f\x05\xed\x1d\xeb\xeb\x79\x47\xf0\x53\x8c\x26\x73\x2f\x2f\x37\x38\xc9\x31\x78\x98\xfc\x8a\xc1\xb0\x77\x20\x1f\x84\xb9\x85\xb6\xbe\x7e\x33\x94\x01\xdb\x50\x2a\x76\x88\x36\x48\x3b\xa0\x19\x99\x04\x70\xee\x57\x71\x84\x63\x9c\xa0\x93\x20\x16\x01\xbd\x0c\xe3\x1f\xd0\xb2\x7e\xd4\xb2\xae\xe2\xe5\x00\x83\x40\xc3\x59\x52\xa5\x57\x6a\x53\x0a\x33\x89\xc0\xad\x74\x75\x9e\xcf\x7b\xcb\x95\x3f\xb7\xf1\x44\x89\x2b\x86\x04\x17\x7a\xc9\x9b\xeb\xb6\x8f\x90\x37\x7c\x04\x64\x9e\x3c\x7a\xae\xcb\x19\xbc\xc3\x3f\x90\xb0\xec\xde\x3e\xe5\x07\xa2\x32\xe1\xe4\x45\x13\x64\x10\xf2\x88\xbc\x0a\x34\x60\x20\x3b\x20\x5d\x80\xdb\xb4\xe1\x47\x34\xd6\x0a\x88\x46\x7d\xc8\xb7\xe2\xf5\x60\x6f\xd1\x89\x02\x7a\x39\x4e\x06\x58\xe1\xa8\x37\x59\xca\xcf\xec\xa3\x95\x6a\x0c\x52\xb5\x2e\xf2\xb5\x2e\xd0\xad\x9d\x98\x75\x5c\x02\xcd\x32\x1c\x45\xa0\xa2\x2b\x47\xb6\x7d\x4e\xb0\x4b\x72\xce\x00\xfa\xa2\xc8\x57\xcc\x0d\x31\x38\x80\x10\x32\x4d\x6d\x53\x88\xf7\xac\xc8\x09\x14\x79\x62\xe2\xe2\x4a\x8a\x37\x8d\x03\x11\xca\x7a\xe1\x7c\x0d\xd0\x0f\xff\xa3\x8b\x5c\x5d\xc6\xe9\x06\xc4\x8b\x99\x64\x53\xea\xc5\x26\x25\x4d\x02\xac\xb0\x99\x99\xe5\x3f\x39\x7c\x83\xd0\xad\x63\x99\x01\x18\xf0\x58\xd1\x49\x2f\x91\xc5\xe0\x5f\x5c\xa5\x75\xba\x29\xc8\x9f\xff\xc5\xb1\x05\xb9\x05\xb8\x9a\x33\x60\xbf\xac\xaa\x99\xe8\x88\x5c\xba\xf1\x04\x41\x0c\x06\x4c\xf1\xb1\x0b\x57\x12\x60\x84\x05\x7b\x2a\xb2\x1a\x26\x4e\x01\xb9\x78\x90\xa8\xef\xec\x33\x3c\xe0\x48\x7e\x50\xd2\x62\x83\x85\xcf\x00\xed\xf0\x45\x90\x80\x88\x7a\x3c\xab\x3e\x4d\x70\x52\x3d\xd9\x5c\xf0\x96\x45\x40\x5d\x43\xe1\x44\x2f\x4d\x23\x9d\x3a\x55\x0d\x7f\x9f\xca\x0a\xf7\x34\x26\x5e\xf8\x02\x2b\xff\xab\x89\x5f\x40\x51\x97\x18\x45\x30\x3f\xd3\x4b\x5c\x5f\xe0\xe0\x64\x6e\x1d\x52\xb0\x3c\xd0\x52\xcc\x4d\x43\x07\x3b\xe6\x5f\xc7\xcb\x24\x03\x33\x34\xc7\x01\x58\x4b\xb0\xd7\x03\x8c\xc3\x0e\x80\xa0\x62\x75\x9d\x1a\x1b\xc6\x0d\x2a\x36\xd6\x56\x13\xbb\x1a\x91\x7a\x6b\x70\x34\x4d\x2e\x51\x86\x66\xcc\xcd\x06\x58\xa1\x31\x56\x9a\x8a\xc8\x81\x7f\x9a\x59\x99\x00\x14\x51\xe0\x60\x4a\xa8\xdc\x96\x9b\x18\x98\xaa\xd2\x30\x37\x14\x80\x7c\x03\x93\x05\xcb\x73\x46\x7f\xaa\x19\x38\x11\x67\x28\x38\xab\xfc\x12\x5b\x9c\xb3\x70\x5a\x32\x21\x94\xa4\x28\x40\x2a\x2f\x91\x7d\xd8\xbd\x2f\xd1\x8e\xe2\x3a\xf7\xd6\x87\x76\x25\xc6\xbd\x78\xc3\x06\x9e\x12\x75\xf7\x33\x33\x80\xf7\xe9\x0c\x14\x8e\x28\xfc\xd2\xe9\x1b\x95\x81\xd4\xcd\x81\xd4\xf0\xb5\x94\x68\xd3\xf8\x0e\x89\x89\x3d\x69\x48\xa6\xbe\xe7\x1c\xdb\x55\xa2\xae\xc0\x01\xa4\x52\x7c\x56\x11\x38\x1e\x10\xb6\xe8\xdc\xc8\x45\x2f\xf2\xa9\x11\xdc\xf0\x6a\x42\xcf\x2c\xcf\x0e\x48\x2d\x91\xeb\x4a\x6b\x5d\x68\xf0\x5e\x30\xbb\x04\xed\xd9\xb3\xf5\x3b\xf7\x26\x3e\x11\x65\x2c\x33\x00\x41\x6a\xaa\x08\xfa\x1e\x5c\x17\xd4\x86\x60\x93\x4a\x4a\x13\x91\x66\x12\x28\xd7\x12\x35\xf9\x8b\xc6\x71\x49\x19\xfd\x6c\x89\xc7\x42\x6b\xa0\xe4\x1e\x94\xc8\xda\xc3\xc1\x00\x84\x45\xec\x1b\x3d\xb6\xe0\xb2\x11\xc5\x06\x63\xb1\x67\x13\x84\x3a\xb8\x61\x33\xb8\xad\xd3\xd3\x56\x9f\x21\xff\x07\x63\x96\x11\xcb\xed\x37\xc7\xea\x31\x03\x69\xc2\xe0\x00\x44\xda\x4d\xb8\xe7\xcd\x0e\xf7\xdc\xd7\xf1\x27\x2e\x5c\x25\xfd\x22\x6e\xad\x17\xc5\x86\x9c\x9b\x67\x6c\x2c\xd8\x2a\xcd\xe2\x34\x15\xc1\x75\x92\x6e\x23\x64\x1c\x90\x61\x9e\x91\x36\xe0\x9c\x08\xfb\xbe\x7a\x6e\xd2\x24\x24\xe3\xc0\x8c\x3e\x57\xa3\x02\xbc\xf4\xad\xac\x01\xec\xe2\x77\xd0\x28\x71\xe6\x8f\x54\x68\x18\xab\x04\x37\xb2\xce\xf7\xe8\x7a\xc7\x49\x0a\x03\x01\xce\x7e\x80\x8e\x32\x50\xe8\x65\x02\xd1\x09\x4a\xb2\xf3\xa8\x02\xb3\xb5\x1d\xad\xa3\x65\xb3\x60\x21\x6a\x86\xa2\xf3\x69\x9b\xf1\x29\x2a\xa7\x65\x81\xa9\xaf\x19\x1f\xe7\x66\x32\xe5\x60\x32\x42\x30\x93\x69\x20\x08\x88\x2b\xfa\x92\x94\xc5\x40\x3a\xa2\x4e\xb9\x32\x33\x68\x26\x22\x84\x42\x6c\xe7\x51\xbf\x20\x31\xde\x36\x1b\x7a\xd9\x09\x6c\x13\x43\xfb\x3c\xd3\x61\x85\xe0\xab\x77\x61\x9b\x0a\x7c\xa3\x05\x30\x12\x8e\x84\x8a\x7d\x95\xcf\x93\x05\xc2\xc2\x2e\x12\xf0\x10\xd6\x8a\x12\xb7\x10\xe9\xa8\x2a\x59\xe1\x62\xe7\x9a\x4d\x01\xb8\x00\xb3\x0b\x36\x11\x8e\x15\x9c\x2b\xcc\x0a\x8b\xdd\xba\x55\x6f\xed\x22\xa4\x45\xd7\x41\xb5\x13\x26\x8d\xe6\x26\x53\x82\x02\x77\x19\x17\x75\xfa\xbc\x7b\xdf\xb1\xde\x43\x94\xd7\xa6\x74\x52\xd7\x12\xc5\x37\x03\xee\x0b\x29\x24\x6e\x11\xad\x36\xd1\x2f\xaf\xf2\xd9\xc5\x18\x45\xb8\x36\xe0\x71\x07\xd0\x46\xd2\x6f\x1b\xe0\x5f\xb3\x54\x40\xdf\x10\x96\xa9\xce\xc6\xfe\x18\x13\x75\x4c\xfa\xa5\x4f\x22\xfa\xe1\xc3\x16\x3e\x81\xcc\xd4\xb1\x4c\xd8\xba\x71\x80\x60\x58\x25\x4d\xb1\x21\x21\xc6\x1c\x74\xd4\x9e\xef\x33\xe4\xc0\xf1\x64\x8f\x5c\x19\xcc\x91\x64\x02\xbe\xb0\x15\x6a\xa3\xf8\x3d\xb7\xf0\x56\x06\xb3\xab\x97\xd6\x1d\xc6\xaf\xec\x69\xb2\xf6\xc7\xd7\x7e\x63\x33\x1d\x78\x41\x7d\x8c\xe2\x1e\x84\x06\x9c\x76\xa2\xa1\x70\x2f\x04\x87\x25\x2a\x0c\x1a\xd9\x4b\x31\x4a\xb4\x56\xce\x30\xd5\xd8\xe3\x7a\x68\xd1\x83\x06\xf4\x09\xd1\x96\x11\x27\xdf\xb7\x10\x6f\xe3\x5d\xb3\x18\x22\xe1\x88\x8e\xf5\x58\x51\x2c\x31\x68\x88\xe7\x14\x40\xb1\xdf\x6a\xb1\xa8\x05\xbd\xbe\xee\x6a\xca\x6f\x87\x52\x32\x1a\x09\xb5\x3f\x28\xba\xb8\x1d\x45\x5f\xe1\x78\xd6\xbc\xb0\xb3\x64\xa4\x18\x35\x58\x2d\xa7\x6a\x42\xbe\xcd\x7a\x8e\x09\x4c\xd4\x3b\x73\xf0\x0c\x2b\x9b\xc3\xb4\xbe\x15\xa6\xde\x09\xd3\x2b\x35\x3b\x47\xca\x92\x62\x13\xcf\xd2\xe8\x36\xd3\x48\x3c\xeb\x45\x92\xb2\xa5\x40\x17\xd5\x53\x8a\xe8\xc9\xc9\xc0\x2d\x75\x8a\xe4\xe3\x01\x40\x05\x26\x08\x5b\xf4\x56\x90\x84\x21\x1d\x35\x55\xb3\xc5\x52\x92\x08\xa0\xaf\xba\x52\xff\xbe\xe2\x92\x75\x14\xbe\x18\x3f\xac\xab\xb8\x6b\x86\x75\x84\x70\x21\xb4\xf2\xd4\xe3\x64\x18\xe0\xf6\x16\xcf\x34\xf8\xa5\x96\x84\x65\xd6\xa9\x65\x56\x0e\x1f\xf1\x46\x24\x6d\x77\x9e\xc7\x10\xb6\x83\xb7\x92\xc6\x45\x52\x5d\xf1\xba\xd6\xf6\x45\xc0\xd9\x95\x60\xb4\x02\x17\x5e\xd1\x86\x65\x5d\x37\x89\xcc\xbb\xdd\x0d\xda\x8d\x80\xa7\x7f\x76\xef\x31\x7a\x9b\x15\xb5\x9d\x46\xcc\xe9\xd3\x93\xb7\xe1\x65\xf7\x34\x70\xdd\x12\x61\xe7\xd9\x06\x42\x0c\x80\xc8\x7a\x4a\x78\x88\xb7\x41\xec\xfe\x18\xa0\x10\x81\xd8\xf6\x33\x4a\x9d\xa3\x9a\xf8\xa3\x36\x23\x76\x76\x9d\x96\x7c\x18\x68\x61\x97\xb5\xa9\x42\xf9\xfd\x8d\x64\x55\x30\xf2\xab\xed\x9b\x72\x1e\xa7\x84\xa5\x98\x9d\xb7\xfa\x72\xc2\x30\x7a\xce\x69\xc4\xf1\xc4\xf8\xa1\xbb\x75\xf1\x01\xc3\x9d\xe1\x5e\x32\x40\xc5\x8d\x32\xb7\x33\x21\xf0\x4a\x35\x9a\x2a\x5c\x88\x23\x71\x91\x65\xef\x0a\xb8\x1e\xf9\xe7\x81\x1a\x99\x64\xc5\xc8\x43\x6b\x84\x4b\x3f\x42\x46\x90\x31\xd8\xfe\x11\xbf\x98\xa5\x5f\xa8\x91\xa4\x3e\x0f\xbf\x2d\x0f\x89\x6e\x87\xa8\xd5\x47\xbe\x0e\x36\x7d\x0f\xd4\x27\xbb\x75\xcd\x60\x22\x0b\x5a\x58\xf9\xc0\x66\xbb\xbc\x27\xb1\xbc\x92\xeb\x1a\xde\x65\x06\x7b\x4c\x00\xac\x37\xa6\x84\x1d\xa5\x1f\x4f\x94\x85\x12\x9a\x8a\x43\xcd\xe1\x5e\x7f\xf2\xc5\x19\xe5\xbb\x9e\x19\xfd\xb3\x64\x8f\x12\x3f\x28\x2d\xb6\xcf\xe8\x1f\x09\xcd\xb0\x2e\x14\x13\x23\xa9\xb6\x03\x88\x43\xa5\xd3\xd4\xf3\x92\x0f\xcc\xf8\xe8\x2d\xdb\x9d\xce\x9a\xa6\x17\x25\x0e\xd2\x90\x71\x02\x4f\xa2\x84\x51\x4d\x8c\x47\x46\x8e\x61\x3c\x4a\x73\xac\x31\x49\x0f\xc8\xc4\xc5\x72\xc3\x99\x7d\x84\xb2\x29\x19\x80\xcd\xed\x7a\x7e\xb0\x41\x45\x2c\x04\xc1\x83\x60\xa9\x94\x08\x19\x8d\x92\x6c\xa9\x60\xb0\x8e\x03\x79\x3e\x77\x6d\xf3\x59\xc7\x20\x92\x88\x3f\x7b\xdd\x94\x09\x06\xb7\x3c\x4d\x39\xe0\xe6\x2c\x27\xcd\xaf\xb6\x7f\x70\x84\x40\xf1\xbf\xc1\xd6\xbc\x1e\x36\x18\x78\x34\x1d\x53\xd0\xf2\x81\xd5\x0f\x96\x82\x88\x7b\xd7\xd0\x33\xa4\x03\xb0\xeb\xe0\x83\x04\xb1\x5e\x7b\x0a\x82\xbd\xed\xe1\x46\x9e\x4f\xde\x9e\x3c\xaf\x25\x7c\x27\x12\xc8\xfe\xfb\x84\x01\xdf\x18\xe4\x6c\xc2\x8f\xe6\xd3\x53\xb3\xfa\x33\x82\xd5\xa3\x84\x02\xcd\x2c\xa4\x56\x27\xc1\xac\xc2\x9d\x15\xad\x49\x28\x40\xb8\x68\x3d\x37\xc2\x85\xd4\x27\xfc\x1a\xb3\x87\x36\xb4\x4a\xa4\xe5\x54\x87\x36\xde\x8f\x8d\x88\x76\x85\xf8\x83\x76\xa6\xbc\x28\xab\x9a\x63\xb6\xa0\x37\x35\x37\x8b\x72\xd1\x57\xa6\x8e\x48\xd2\xe5\xbf\x48\x9f\x47\x2f\x8a\xe2\x75\x5e\xbd\xc4\xf2\x23\xca\xbe\x01\xc3\x61\xf7\x34\xff\x88\x15\x39\x16\xc8\x47\xb0\xec\x54\xa3\x14\xf5\xcf\xcd\x02\x26\xdb\xa2\xb2\x90\x6b\xb3\x2b\x93\x1d\x4e\x91\x3c\x99\x44\x8e\x97\xfa\xb9\x38\x12\x2a\x75\x8c\x67\xa2\xa6\x46\xe7\x87\x1e\xb1\xae\x55\x33\x8d\xf5\x2a\x3e\xd3\x69\x33\x45\x13\x82\xfe\xee\xf1\x7b\xeb\x85\x9b\x45\xfc\x8d\x4b\xc5\x2e\x34\x3f\x72\x7e\x74\x1d\x67\xc9\xac\x44\x9b\x8e\x45\x0c\x48\x24\x95\xcf\xc0\x57\x29\xf7\x5b\x84\xdf\xc2\xab\x50\x5b\x04\x93\x05\xed\x45\x75\xbb\xb4\x2d\x72\x43\x08\xf9\xcd\x49\x69\x68\x34\x86\x2f\xec\x53\xd0\x4c\xe8\xb1\x99\xc2\xf2\x07\xf4\x09\x72\xf2\x7c\x17\x5f\x27\xf3\x7d\x78\x1a\x5a\xdf\x92\x87\x4f\x9e\x77\x70\x31\x80\x24\x84\x40\xdf\xa1\xde\xb3\x14\x6b\xa4\x18\xe6\x36\xb3\xe0\x1a\x12\xdd\x12\xdc\x5e\xc0\x0e\x5b\xf8\xfa\xe4\x79\x49\x84\xfe\x3e\xcc\xd4\xb5\xb0\x7f\x5e\x7a\x7c\xcb\x70\xfb\x71\xac\x0f\x4c\x96\x06\x80\x05\xd9\x14\x96\xa5\xc6\xa8\x27\xcf\xef\x97\x55\xbb\x88\xdd\xa0\x1f\x4e\x31\x99\x6f\x67\x50\x06\x75\x47\x16\x4d\xe6\xa6\xee\x01\xc3\x63\x9f\x23\x73\x7c\xb1\x4b\xd1\x4e\x6d\x17\x4b\x16\x09\xba\xc1\x98\xcf\x70\xa3\x17\x33\x70\xd2\x11\xf9\xd3\xec\x20\xf6\xaf\xa0\x00\x34\x3e\x8f\x96\xfd\x6e\xd2\xda\xe4\x8a\xbc\x7d\x15\xb7\x81\xb4\xaf\x2e\x96\xe0\x64\xab\x3e\xc6\xca\x43\x8c\x35\x9e\x1c\xd5\xcc\xe3\x56\xf5\xca\x3d\x1e\x1f\xdd\x4a\x8b\xcb\x5e\x59\x47\xe7\xd3\x24\x5b\x6e\x20\xca\xdd\x66\x05\x1c\xdf\x38\xe5\x8e\x4f\xf7\x25\x30\x04\xf9\xbe\x55\xbb\x61\xa7\xe0\xe2\xed\xa5\xc5\x11\x52\x43\x89\xb7\x45\xa6\xa1\xc3\xfb\x89\x8b\xa8\xf2\x5b\x89\xca\x97\x53\xe6\xbd\xc5\xa7\x8f\xca\xf7\x44\x86\xd4\x7e\x4d\x3c\x12\xdc\xb6\x64\x05\xee\xcb\xc0\x3e\x16\xc1\xe3\xfe\x5a\xb7\x3e\x7c\x6f\xf0\xf4\xf8\xdf\xb3\x1a\xbc\x08\xf7\x2a\x03\xf7\x63\x33\x1c\x77\xec\xc1\xfb\xd6\x3c\xe0\x71\x00\xd9\xc7\xf1\x77\x77\x30\xae\xb3\x2c\x0d\x04\xe0\xc2\x11\x5f\x71\x99\xb8\xad\xef\x8c\x45\xb9\xb6\xd3\x88\x26\x18\xc2\x88\xf0\x19\x04\x91\x6f\x28\xb4\xa5\x94\xe2\xa3\x7e\x99\xc4\x40\xf9\xe5\xde\xa9\x43\x93\x83\x8a\x5e\xea\x18\xbe\xea\x17\x19\xd6\x3d\xcc\xd5\x68\xbe\x89\xd3\x8f\x45\x52\xe9\x91\xcd\xe3\xb3\xdb\x56\x9e\xc7\xf3\xfc\xa3\x6f\x9d\x71\x06\xaf\xf5\x47\x37\x89\x92\x22\x3d\xdc\xcd\x8d\x4e\x2f\x92\xf5\x4f\x79\x7e\x51\xd6\x32\xde\x0c\x09\x87\x70\xb6\x87\xb1\x71\x69\x90\xee\xec\x98\x08\x93\x00\x09\xa5\xc4\x7a\xef\x4e\xec\x91\x0f\xab\xa1\x5e\xdf\x3d\xf0\x26\xe1\x6f\x19\xf8\x52\xd9\x24\x7e\x0e\x42\x03\x34\x1b\x8f\x5c\xb4\x7e\xa4\x36\x99\xab\x18\x94\x74\xd3\x68\xe2\x6d\x45\xd8\xcc\x56\x13\x97\x56\x0e\xaa\x86\x54\xab\xc8\x18\x3e\x39\x13\x07\x0f\xf7\x25\xdd\x08\x77\x3f\x66\x6f\xf0\xfa\x6d\x9c\x1d\x99\x27\x8f\xc1\x15\x04\x7b\x58\xc2\xd0\x50\x42\x25\xca\xcc\x9c\x52\x81\x52\x60\x1f\x78\xcc\x9b\xe5\xa5\xcb\x44\x4d\x6c\x65\x90\xd9\x51\xc7\x6d\x08\x22\xaf\x29\xa4\xf1\x77\x5d\xa9\xde\x10\xd3\x44\x58\xa9\x2a\xab\x5d\xf2\x06\x6e\x3d\xb9\x15\xab\x12\x4f\x1d\xa1\x22\xe2\x0a\x34\x4e\x3f\x61\x52\x43\xaa\x8e\xa6\xbc\x73\x42\x65\xa1\xd0\xe9\x0c\xd8\x01\xc6\x28\xa5\xa2\x11\x31\xf2\xaa\xd5\xd2\x7c\xb9\x44\x0c\x6c\x4d\xe4\x5c\x9f\x6d\xf8\x15\xee\xe3\xd2\x06\x7d\x5c\x96\x58\x42\x24\xaf\xc8\xe4\xeb\xb2\xea\xcf\x08\x1e\xe9\x3a\xcc\x37\x17\x7e\xc9\x7e\xda\x22\x9e\xe9\xeb\xfb\x57\x74\xa3\xd1\x34\xac\xec\xfe\x02\x2a\xa5\x41\xc1\x3e\xaa\xc5\x9f\xee\x9f\xaf\x5e\xda\x08\xb6\xd4\x0c\x38\x46\x7b\xd9\x58\xdf\xcd\xec\xcf\x6b\xe2\x7e\x05\x78\xac\xe5\xf8\x7d\x1e\x53\xfa\x17\xe0\x2e\xe3\xb2\x7e\x55\x06\xcb\x21\x15\xe2\x24\x67\xb0\xe0\xe1\xbe\x0c\x16\xc2\x0d\x33\x4f\x8b\x77\xd8\x1b\x2d\x3b\x39\xc6\x61\xdf\xdf\x17\x2d\x65\x7a\x3f\xc4\xc0\x1e\x28\x2d\xbe\x99\x49\xb8\x4c\x79\x43\x27\x35\x78\xaf\x22\xe0\x87\xb2\xcd\x59\x21\x80\x86\x88\xcd\x72\x68\x60\xab\x09\x71\x18\xde\xad\x87\x68\x03\x4b\xad\x6d\x25\x57\x59\xc5\x05\x58\x68\x0c\x89\xc8\x54\x00\xd2\x93\xa9\x2d\x71\xe7\x8d\xf4\x84\x22\x29\x2e\x51\x6a\x54\x17\xd5\x4a\x8b\xe8\xa8\x44\xa3\x22\xc9\x15\x8d\xd2\x2e\x0d\x9a\x20\xf0\x2a\x8b\x98\xcc\x4b\x7e\x29\xc5\x8e\x0c\xb5\xd0\x25\xb0\x1f\x6d\x3b\x9f\xe1\x94\x74\xff\xa5\x34\x34\x0c\xfb\x1f\x4c\x87\x9a\xb1\xf1\x0e\x0a\x81\xa6\xe8\xb0\x43\x5c\x47\xe4\x15\x59\x6f\x3d\x13\x4a\xb5\xd5\xea\x8f\x3f\xea\xd5\xd5\xdd\xf5\x39\xc2\x23\xb6\x75\x48\xb5\x04\xe5\xce\x32\x8c\xd0\xdf\x49\x61\x9e\xd5\x36\xf0\x47\x93\x7a\x3d\x4f\xb3\xf6\x00\x35\x8a\x6c\x9f\xe0\x3f\xe1\x2d\x14\x3c\x01\xe0\xaa\x0a\x8e\x4c\x01\x76\x57\xe9\xc3\x35\x97\x97\x77\x9c\x0c\x43\x0f\x6d\x6a\xd2\xa0\xbc\x2c\x9e\xa4\x60\xbc\x97\x5f\x20\xa6\xf4\x29\x1a\x37\xa4\x70\xc2\x91\xc8\x37\xd0\xa6\x55\x41\xb3\x58\x55\xd1\x0b\x24\xd8\xa2\xa9\xa4\xf4\xa7\x35\xef\x31\x62\xf5\x36\x02\xfa\xf6\x2d\xf1\xa1\x8f\xf5\x48\x98\xc4\x6c\x02\xb1\xaa\xe2\x1a\xd5\x66\xec\x7c\xf2\xfc\xc7\xb7\x10\xc7\x4f\xa4\x8a\xcb\xd3\x0a\xdc\xcb\x15\x93\x86\xaa\xeb\xbb\xf6\xdb\x88\x21\x27\x5b\x15\x49\xc8\xec\x90\xa0\xe0\xd8\xab\xf8\x42\x37\x39\xd9\x24\x1c\x26\x5c\xc0\x94\x78\x95\x4b\x73\x0e\xc9\xa8\xfb\xbb\xe4\xbd\xa4\x20\x92\xf7\xbe\x8a\xa2\x8f\x7e\x52\x99\x4f\xe6\xfa\x6a\x8a\x4e\xe9\xd6\x0e\x6b\xec\x59\x6d\x4c\x20\xbb\x92\x3c\x59\x75\xef\x36\xfb\xf1\x5f\xd2\x62\x5b\x2a\xf5\xb1\xd9\x8f\x3f\x93\xc5\xf6\x91\x6a\xd9\x6c\xfa\xe8\xac\x36\x3d\xde\x97\xdd\x66\xd8\x61\xa6\xc1\xca\x05\x3a\xf7\xbe\x11\xe6\x09\xd6\x31\x7a\x98\xf7\xb5\xd7\x04\x51\x26\xf7\xe2\x53\xe2\x6f\xe4\xe2\x41\xff\xc4\x3f\xd6\x8c\x85\x55\x3a\x95\xb3\x87\x92\x31\x5d\x16\xf1\xfa\xbc\xf7\x14\x69\x84\x0e\xb1\xc0\xd3\xf5\xf7\x2e\x17\x74\x07\xc2\x5f\x52\x36\x2c\xa9\xfa\xc8\x86\x9b\xe6\x9f\x2f\x1f\x3e\x62\x2d\xf9\xa0\x8f\x4e\x3e\xe8\xf1\xbe\xe4\x83\x61\x87\xb9\x07\x99\x07\x57\x49\xf3\x80\x1d\x4c\xe3\xa3\xde\x57\x40\x08\xa2\x91\x7e\x2a\x55\x77\x61\xde\x7c\x83\x47\x25\xb1\x0e\xaa\x76\xfc\x5f\x90\xc6\x3c\xc0\x2c\xdd\x50\x15\x3d\xd6\xd3\xc4\x65\x99\xcf\xf0\x66\x82\x39\x9d\x55\xa6\x33\x77\xe2\x45\xf2\x31\x2a\x2e\xf7\x31\x55\xfa\xe0\xea\xae\xe4\xb0\xe6\x95\x2b\x2d\x45\x3f\x14\x5a\x72\x0a\x03\xfc\xd3\x85\xc6\x9a\xc1\xf4\xca\x3b\x62\xc3\x05\xf5\xb0\x04\xab\x18\xaf\x7e\xe8\xab\x7d\xb8\x08\x3a\x54\xaa\x22\x94\xd8\xe2\x66\x0d\xba\x7d\x2c\x72\x00\xa0\x45\xf8\x94\x3a\xb6\xe0\x42\xa5\x00\x10\xfe\x40\x4d\xd0\xf7\x40\x20\xd6\x4b\xe3\x83\xa3\x01\xa7\x8c\x0f\x30\xb1\x3f\x26\x97\x7e\x40\x47\xdb\x8f\x53\x34\xa1\x8e\xdc\xd6\xf4\xe4\x03\x77\xfd\x7a\xba\xc3\x79\x53\xaf\x00\xb2\x76\x89\x88\xbb\x45\xe4\x48\x75\x9e\xf3\x6a\x1e\x4a\x6d\x5f\x2f\xc2\xf7\x8b\xd4\x08\x61\x0e\x36\x87\x10\xb3\x27\x94\x11\xb2\xbb\x01\xe4\xa8\x45\x69\xfb\xa9\x85\xc2\x7d\xbb\xc8\xc1\xcb\x40\x6a\xf7\x88\x74\x5d\x09\x72\xa4\x7a\x96\x30\xb5\xe6\x40\x87\x52\x48\x26\xc2\xd7\x83\xf4\x57\xed\x8d\x4b\x32\x8e\x76\x1c\x55\x30\x27\x0b\xa6\xed\xae\x58\x9b\x7f\xd4\xe3\xa4\x43\xe8\xa0\xb2\xdc\x35\x94\x6f\xd6\x3f\x78\x15\x91\xb5\xbb\x74\xfe\xb0\x15\x9e\xdf\x96\x3f\x52\x4b\x2e\x88\x44\x45\x23\xcf\x56\xe1\x10\x24\x7b\xbc\x12\x83\x63\x3a\x90\x53\x40\x00\x5c\x98\xb3\xc5\x87\x72\xa0\xd9\xcb\xbe\xe6\xa0\x70\x32\x06\x42\xe5\xa8\xf1\x12\x38\x7e\x49\x77\x7a\x80\xc6\xa1\x1d\x93\x29\x59\x81\x23\x6b\x0c\xc7\x17\xfa\xaa\x74\x0d\x27\xc6\x16\xf2\x05\x01\x04\x85\xaf\x56\xb2\xc7\x7d\xe9\x03\x1f\x02\x36\xb6\x48\xbe\x3d\xe6\x63\x02\x6c\x74\xa4\x24\x91\x0f\x22\xe3\x16\xe9\xa5\x22\x81\xe5\xeb\x82\xa4\x06\xd1\x50\x68\xe1\x12\xf3\x74\x2c\xd8\xe4\x42\x7e\xe7\xc7\x53\xea\xf6\x36\x46\xd3\xf9\x3b\xf5\xe5\xf8\x00\x5d\xb0\xdf\xff\x55\xe6\xd9\xd1\x88\xdd\xb0\x1c\xf4\x97\x5e\xad\xab\xab\x11\x35\x13\x6c\xbc\x7a\xc8\xe6\xf5\x46\xf5\xe3\xce\xb2\x0c\xe3\x9d\x67\x95\xcd\xc9\x64\x43\x36\xbf\x16\x92\x5d\xbe\x89\x34\x39\x05\x6b\xc2\xdb\x06\x0f\x2f\xe9\x04\xb3\xc7\x39\x3d\xcd\x80\xc1\x8a\x96\x5d\x99\x84\x76\xc7\xd9\xe6\x1a\x0f\xb2\xad\x60\x66\x32\x41\x79\xa3\xc1\xee\xaa\x46\xea\xd0\x3a\x15\x6d\x95\x2f\x7d\xb8\xa9\x1f\x87\xe6\x2e\x6b\x3e\xeb\xc2\x67\xe2\x42\x1e\x02\xbb\x1c\xb6\xf0\xb2\xa7\x63\x19\x3a\x99\x63\x1c\x03\x73\xb6\xa5\x47\xc9\xfb\x16\xb7\x72\x0f\xe5\xb3\x4f\x9d\x3b\x53\xa5\x91\x99\x39\xee\xce\xca\x8f\x77\x5c\x00\x06\xe4\x70\x47\xe5\xfd\x5a\x6f\xbf\x4c\xdd\x77\x25\x1d\x06\x21\x27\xd2\x47\xa5\x33\x11\x3f\xae\x5d\x8e\xd2\xc4\xa0\x86\x80\xcb\x21\xf8\xbe\x1b\xa1\x60\x14\x26\x5f\xaa\xd0\x4b\x63\xf2\xe5\x58\x56\x61\xf2\x63\x40\x2b\xba\x7c\x63\x2d\x47\xf0\x35\x2b\xb3\x7d\xb5\x14\xcf\xbd\xb7\x92\xba\x07\x0d\x24\x23\xf6\x52\x40\xf5\x35\x65\x0d\x54\xca\xcd\x66\x56\x09\x35\x1b\xed\xd6\x42\x06\xc4\x7e\x8a\xc8\xf6\xfa\x7f\x5d\x54\xd7\x45\x96\x30\x5f\x52\x1d\xf9\x48\x7c\x39\x8d\x64\xb0\x60\xa5\xd4\x8f\xd8\xe6\xe2\x20\x77\xde\x47\x78\x79\xe4\x04\x67\x24\xb2\x39\x32\x9e\xc1\xb0\xdf\x79\x9f\xe6\x59\x25\xe8\x13\x3e\xdc\x53\xbb\x61\xb2\x76\xf0\x8e\x94\xec\x99\x3b\x92\x62\xef\xff\x64\xeb\xff\x4b\xf0\x92\xcd\x86\x63\x60\x6f\x0d\x68\x7a\x14\x81\x0b\x24\xa9\xc9\xc1\xd9\x55\xdf\x0b\x24\x9b\x20\xdb\xb7\x48\x8a\x94\x7b\x37\x43\x42\x74\x0f\xff\xbc\x7b\x6f\x7d\xae\x2f\x7c\xbb\x20\xcd\xbf\x74\xa7\x3f\x1b\x48\x74\x5e\x0f\xc8\x27\xda\xed\x5d\x80\xeb\xee\x4b\xfd\xf8\x96\x80\x01\xdf\x12\x60\xaf\xfd\xe0\x1c\x9f\x75\xd8\xe7\xe6\xb6\xa0\x5b\x4f\xfa\xa7\xf8\x52\xee\xfe\x74\x6c\x36\x0e\x30\x27\x2d\xda\xe1\x39\xb5\x3e\xc4\xa5\x74\x8c\x3a\xe1\x1b\x5f\x03\x05\x40\x36\x00\xa1\xeb\xd3\x9c\x59\x36\xf8\xe3\x4d\x68\x36\x36\x31\x47\xb3\x2c\x3b\xb5\x12\xfc\x75\xf6\x35\xa6\xaa\xc1\x4e\x13\x37\xec\x18\xd9\x06\x4c\xc1\x53\x17\xdf\x74\x79\xc9\x21\xf0\x11\x76\xaf\x5d\x04\x14\x6a\x01\x26\x27\x6b\xdf\x03\xd4\x6c\x69\x7c\x1e\xb2\x02\xdb\x6e\xad\xc5\x0b\xe7\x78\x49\x88\x66\xa2\xde\xb8\xdb\xcd\xcd\x5a\x17\x07\xe6\x76\x37\xc7\x16\xee\x38\x62\xec\xde\xba\xed\xbe\x2e\xa6\xb1\xdb\x29\x88\x6b\x19\xed\xa1\xfe\xc4\x9b\xda\x83\x63\x30\x77\x06\xaa\xa0\xc5\x34\xac\x65\xa2\x26\xff\x78\x3f\xa5\x7c\x49\xd7\xbd\xb0\x7d\x63\x3a\xd2\x28\xfd\xe3\x36\x5e\x82\xf1\xbe\x9b\xb2\xf5\x0b\xaf\xf6\x20\x8f\xcc\xae\x49\x9e\xe6\x5d\x58\x2d\x67\x6e\x6f\xd9\xb8\xfb\xc4\xd6\x0d\x96\xc4\x61\x12\x1f\xd7\x7b\xd0\x25\x6e\xde\x5b\x04\xf6\xa0\xc7\x14\x44\xdd\xb5\xe4\x36\xa8\x03\xc1\x28\xef\x9a\x5b\xdd\x18\xec\x90\x77\xba\xf8\x09\x2f\xb9\xf1\xef\x7d\xaa\x29\x37\x4a\x1e\x97\xd8\x46\x2e\x90\xc3\x6b\xb8\x80\x5c\xb9\xa7\x2b\x49\x56\x6f\xa1\x05\x0d\xaf\xb4\xab\x14\x2e\xfd\x0a\x05\xcf\xf7\x75\x97\x4a\x04\x69\x66\xef\xc7\xe8\xa8\x40\xef\xbf\xa7\x13\x04\xff\xb9\xb7\x78\x7a\xf0\x85\x13\xb7\xcb\x3e\x7b\x3e\xcd\xcd\x9e\x26\xf4\xfd\xb7\x7d\xba\x70\x0c\xb9\xc3\x75\x64\x5b\xb6\x18\x3f\xbb\x6d\x20\x7c\xda\x63\x17\x68\x0f\x96\xfb\xad\x17\xcf\xed\xe6\x36\x7f\x3a\xdf\x6f\xdf\x18\x6a\x1d\x58\xaf\xc4\xc1\x5e\x81\xe7\x79\xe9\x9d\x59\x5f\xf8\x49\x86\x0a\x13\x0c\x5c\xe6\xe4\x9f\x34\xf7\xaf\x34\x09\x14\xe6\x63\x64\xcd\x49\x06\x23\xc9\x91\xc9\xbe\xe2\x29\x96\x38\xc5\x13\xb2\x72\xbc\xd0\x5e\xac\x6e\x85\x9e\xac\x26\x66\x2d\xc8\x1c\xd5\xee\x8d\xe8\x49\x62\x83\xe3\xd6\x5a\xc4\xaa\x51\x84\xe8\x1d\x6b\x0d\xb8\x30\xe4\x6b\x4f\xd4\x7f\x82\xff\x71\xdd\xb7\x22\x2f\x80\x5b\x64\xc9\x27\xd5\x42\xf1\xec\x3c\xd1\x97\x58\xe7\xcf\xe4\xa0\xf6\x48\x0e\xca\xd7\x54\xe7\xc0\x6f\x4f\x98\x10\x46\x06\x6c\x6e\xc5\x4c\xa2\x76\xd3\xcd\x0e\x36\x79\x78\xd9\xf7\xea\x1b\xf3\xf6\xd2\xde\x44\x54\x5b\x7e\x27\x25\xe6\xcd\x4e\x49\xb9\xfd\x3a\x6e\xad\x0b\xac\x4c\xb1\xd6\xe5\x74\x2b\x11\x7c\xa6\xe8\x48\x4b\xf8\x12\x53\xa3\x41\xeb\xe6\x87\xbb\x87\xc0\xcd\x50\x72\x67\xe0\x4b\x1d\xee\x21\xf0\xe5\x58\x3e\x10\xf7\xf2\x87\x70\xe0\xdb\x4c\x46\xd9\xc8\xb7\x95\xca\x0a\x84\xbe\x32\xa2\xbb\xee\xb5\x67\x08\xdc\x82\xdd\x23\x06\xfe\x3f\x11\xef\x02\xf9\x83\x7e\x93\xcd\x21\xde\xde\x6f\x6a\x30\x81\x11\xcd\xe6\x52\xdc\xdd\x73\x6a\x0d\x74\xcf\xae\x53\x1b\xfe\x97\xf0\x9d\xda\x58\xdc\xab\xf3\xd4\x5c\x96\xdb\x39\x4f\x41\x24\x3f\xb7\xf7\xb4\x17\xe3\xdd\xd2\x7f\x6a\x4f\xf4\xab\x77\xa0\x6c\xfe\xb7\xd3\x81\xe2\x16\x54\xc0\x1d\xf4\x99\x7a\x13\xf6\xce\x5e\x53\x9b\xbc\xb7\x76\x9b\x9a\xd8\xed\xf4\x9b\x1c\x15\xee\xe0\x38\x6d\xe3\x8f\xaf\xc4\x73\xda\x7b\x35\x6f\xe3\x3b\x85\xb5\xd6\x57\xe4\x3c\xb5\xdc\x91\x9d\xde\x53\x29\x7b\xa3\x77\x71\x9f\x5a\xae\x14\xdd\x2b\xdb\xbc\xd3\x51\xf2\x85\xf5\x1b\x1d\xe9\xc6\xd8\x7c\x7d\x65\xbe\x76\xdf\xc2\x38\x35\xf7\x01\xdb\x63\x84\xe6\x04\x63\x64\xaf\xfc\x33\x7f\x45\x99\xf4\x39\xb4\x63\x8d\xec\x76\xad\xff\x97\x84\x3d\xe0\x5b\xa2\xdd\xb1\xca\x83\xfa\x5f\x9a\x34\xad\x5d\x93\x19\xba\x78\x71\xda\xb1\xe9\xb8\xad\x86\x95\x60\xf0\xc0\xff\xad\x8b\xfc\xbf\xd0\xd3\x30\xca\xc3\xbf\x7a\xc5\xe1\x81\x7a\x82\x2f\x60\xb1\x88\x80\x92\x03\xbc\x1e\xb5\x2e\x73\xf2\x5b\x04\xff\x3a\x1c\x7f\x76\xdb\xab\xbb\xda\x97\x4c\xe1\x91\x7d\x0f\x7e\x9d\x7f\xff\x17\x66\x78\x31\x61\xe6\x6e\x00\x00")
 
 func templateBuilderQueryTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -194,12 +267,12 @@ func templateBuilderQueryTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/query.tmpl", size: 16113, mode: os.FileMode(420), modTime: time.Unix(1568645715, 0)}
+	info := bindataFileInfo{name: "template/builder/query.tmpl", size: 28390, mode: os.FileMode(420), modTime: time.Unix(1792028771, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderSetterTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4b\x8f\xdb\x36\x10\x3e\x4b\xbf\x62\x2a\xb8\x85\xe4\x66\xb9\x49\x6e\x2d\xe0\xc3\x36\x4e\x00\x03\xed\xe6\xe0\xe4\xb4\x58\x14\x5a\x71\xe4\x65\xa3\x25\x15\x92\x72\xbb\x70\xf5\xdf\x0b\xbe\x24\x59\x96\x1f\x9b\xb6\x37\x9b\x9c\xf7\x7c\xf3\x71\xb4\xdb\x5d\xcf\xe3\x77\xa2\x7e\x96\x6c\xf3\xa8\xe1\xed\xeb\x37\x3f\x5d\xd5\x12\x15\x72\x0d\x1f\xf2\x02\x1f\x84\xf8\x02\x2b\x5e\x10\xb8\xa9\x2a\xb0\x42\x0a\xcc\xbd\xdc\x22\x25\xf1\xa7\x47\xa6\x40\x89\x46\x16\x08\x85\xa0\x08\x4c\x41\xc5\x0a\xe4\x0a\x29\x34\x9c\xa2\x04\xfd\x88\x70\x53\xe7\xc5\x23\xc2\x5b\xf2\x3a\xdc\x42\x29\x1a\x4e\x63\xc6\xed\xfd\xaf\xab\x77\xef\x6f\xd7\xef\xa1\x64\x15\x82\x3f\x93\x42\x68\xa0\x4c\x62\xa1\x85\x7c\x06\x51\x82\x1e\x38\xd3\x12\x91\xc4\xf3\xeb\xb6\x8d\xe3\xdd\x0e\x28\x96\x8c\x23\x24\x0a\xb5\x46\x99\x40\xdb\x9a\xd3\xd9\x43\xc3\x2a\x13\xc3\xcf\x0b\xa8\x73\x55\xe4\x15\xcc\xc8\xba\x10\x35\x92\x5f\xfc\x8d\x17\x94\x58\x20\xdb\x3a\xc9\xee\x77\xa7\xee\x85\x4a\x86\x15\x55\x46\x64\x46\x3e\xb8\xdf\xfe\xa6\xa9\x69\xae\x9d\x76\x99\x57\x0a\xdd\xf9\x15\xb0\x12\x84\x84\xf4\x31\x57\xeb\xa6\x2c\xd9\x5f\xbd\xc9\xe4\xb3\x55\x49\xb2\x53\xb7\x1f\xb9\x11\x68\xdb\x38\x1a\x3a\x59\x80\x96\x0d\x76\xc7\x3e\x2a\x13\xd4\x6f\x8d\xce\x1f\x2a\x1c\xc6\x76\x05\xc8\x29\xf8\x2a\xc9\x9c\x6f\x10\x66\xbf\xbf\x82\x59\x69\xd3\x28\x3b\x49\x6b\xaa\xde\x4f\xbf\x24\x9f\x9e\x6b\x24\x6b\x2d\x19\xdf\xf4\xfe\x1a\x5e\xd8\x82\x4a\xc6\x35\x24\x6b\xd4\x09\xa4\xa1\xba\x25\xb9\xcd\x9f\xd0\xc5\x7c\x7d\x0d\x9d\x7c\xdb\x82\x42\xad\x6c\x63\xed\xa1\x95\x33\xc7\x36\x04\x12\x47\x56\x2c\xdd\xeb\x45\xdb\xc2\x7c\xd8\xc5\xb6\xcd\x86\x16\x53\x17\x72\xdb\x7a\x8b\x26\x58\x2b\x33\x52\x82\x5d\x1c\x45\x23\xc3\xc4\xa9\xac\xb5\x6c\x0a\x6d\xeb\x65\x04\x17\xf0\x43\xb0\x69\x55\xae\xe0\x7a\x6e\xe2\xd6\x26\x7f\xde\x3c\xa1\x64\x05\x68\xe3\x46\x6c\x51\x4a\x46\x11\x6a\x89\x5b\x26\x1a\x05\x45\x5e\x55\x0a\xb4\x80\x1b\x4a\x09\x58\x60\x3a\x13\xac\x84\x9c\xd3\xae\x9a\xb7\xde\x4c\xd7\x4e\x2b\x78\x10\x5f\x4e\xe9\x91\x10\x39\xab\xbc\x65\xdf\xd9\x28\x92\xa8\x1b\xc9\x61\x64\x23\x8e\xda\xd8\xb4\xec\x7a\x0e\xf9\x56\x30\x0a\x1b\xe4\x28\x5d\x2e\xac\xaa\x0c\x52\xc0\xcd\x8b\x82\x52\xc8\xfe\xd0\x64\xa8\x42\x0e\xbb\x5d\xc8\x20\xe5\x42\xf7\x69\x78\xe1\x0c\x52\x61\xa1\xf2\xb1\xd6\x4c\x70\x87\x81\x25\x96\x79\x53\xe9\xcc\xa9\xa4\x36\xfd\x90\xee\xac\x24\x0e\xdc\x41\x28\x0b\xd5\x86\x59\x88\xe0\xc3\x01\xc4\x82\xbb\x23\x50\x0b\x58\xdb\x33\x70\x06\x73\x26\x2d\x73\xb5\x61\x5b\xe4\xb0\xcd\xab\xc6\xb2\x97\x89\x98\xb3\x8a\xc4\xd1\x4b\x20\x39\x72\xdc\x43\x73\x7e\x01\x36\x23\x56\x42\xa7\xf0\x9d\xed\xb0\x3b\x9f\x46\xad\x77\x31\x0f\x2a\x99\x11\xb5\x20\x3a\x86\x83\xc8\xf5\x31\x30\xc1\xa0\xa7\xa7\x51\x39\x31\xee\x37\x94\x9e\xe9\x41\x98\xf7\x9c\x52\xd5\xa7\xa5\xc5\x7e\x0f\x5e\x58\xdf\x6f\x19\xf9\x50\xd6\x8b\xc6\xea\x54\xd5\x8f\xcf\xe2\x90\x2e\xa2\x16\xd0\x50\xbf\xb3\x31\xbf\xd4\xc8\x8f\x0b\xd8\x33\xf2\xcd\x7d\xec\x07\xf0\x5c\x0f\xdf\x55\x98\xcb\x0b\xbb\x58\x18\x59\x37\x43\x6e\x44\x44\xf9\x9f\x34\xf2\x48\xcb\x2e\xa4\x69\xc7\x81\x07\xd2\x36\xd8\x23\x2a\xe6\xcd\xbc\xbc\xb6\x83\x2a\xef\xbf\x9b\xe8\x9e\xff\xf7\x74\x83\xfd\xbb\x29\xec\xc3\x99\xe4\x66\x36\xda\xd6\x75\x65\x86\xe4\x33\x67\x5f\xed\x43\xed\x65\x16\x76\x3f\xf1\x22\x81\xbc\xcd\x1d\xa3\x6a\x9f\xf2\xba\xd6\x88\x3a\x83\x54\x31\xbe\x69\xaa\x5c\x1a\x9b\xb6\xea\x7f\xfb\x6d\x26\x83\x64\xb5\x54\xc7\x7d\x06\xbb\xd3\x66\xc3\x1f\xf4\xed\x4f\x56\xcb\x51\x6c\x1e\x0b\xc1\x8c\x1f\x3b\x61\xb0\xda\xd3\x2a\x76\xb4\x8a\x74\x83\x61\xd0\xd1\xf3\x8a\xbf\x7a\x78\x06\x46\x5d\x90\xf6\x15\x19\x04\xaa\x3a\x87\x2f\xdb\x02\xfa\xa8\xd2\xc3\xec\xad\x33\x74\x9b\x18\xa3\x0a\x08\x21\x9d\x1b\xab\x4d\x56\xcb\xd3\xec\x31\x41\x1e\x2e\xaf\x13\xcc\x71\x99\x02\x3c\xe5\x5f\x30\x7d\xca\xeb\xbb\x51\x20\xf7\xca\x4a\xee\x2c\xa3\xfb\xd9\xdd\xcb\xeb\x6a\x7a\x57\x98\x72\x73\xc7\xe8\x3d\x2c\x20\x58\xdc\x85\x6d\xc4\xd6\xc4\xdb\x31\x8f\x3e\xb3\x2b\x9f\xc5\xb7\xa9\xd3\xf1\x67\x67\xc2\x81\xba\x63\xf7\x07\x4e\xa2\xf6\xf2\xed\x64\x48\x60\x5d\x92\x33\xec\xa9\xec\x60\x33\x58\x2d\xd5\x45\xcb\xc1\x08\xd3\x87\x1b\x42\x30\x34\x5e\x12\x2e\x47\xf3\xff\xb2\x3f\xf4\x61\xa5\x8c\x3a\xd1\xb3\x48\x35\x50\x65\xf4\xf8\xe2\x60\x31\x37\xd5\xd2\xc1\x04\xcd\x19\x7d\xe9\x1a\xd1\x7f\x31\x54\xe2\x4f\x94\x90\xda\x86\x94\x90\x7c\x4f\xde\xa8\x64\xaf\x66\xdd\x37\x0c\x2b\x01\xbf\x1a\xad\xbd\x8a\x38\x43\x0b\x48\xb6\x89\xff\x3b\x74\x51\x9e\x60\xc6\x71\xbb\x27\x3e\x3b\xce\x32\xd6\x6e\x37\x26\xa5\x21\x27\x4d\x23\xe0\xdf\x7f\xaf\x4c\x10\xe1\x90\xa3\xe6\x23\x9f\x27\x3e\x6b\xa6\x18\x62\xba\x83\xe3\xa6\x87\x78\xc8\x6a\x99\x4d\xd0\x03\x73\xdf\xba\x96\xad\xee\xee\x47\x50\x7c\x05\x15\xf2\xce\x42\x96\x05\x5e\xb2\x7c\x92\xb0\xfe\x4d\x32\xfd\x66\x4e\xca\xdd\x2f\x20\xf9\x63\xf0\xce\x0c\xb8\xc8\xdd\xb7\x6d\x4f\x49\x7d\xc5\x2c\xaa\x0d\xe5\x04\xa1\x7b\xe8\x17\xa7\xfe\x90\xac\x96\x67\x60\x3c\x2e\x02\xa3\x8a\x10\x92\x8d\x48\x6b\xb8\x01\xf4\xbf\xfe\x09\x00\x00\xff\xff\x9f\x3d\x4f\x4f\x2d\x11\x00\x00")
+var _templateBuilderSetterTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x58\x4b\x73\xdb\x36\x10\x3e\x4b\xbf\x62\xcb\x71\x5b\x49\xb5\xe9\x24\xb7\x76\xc6\x07\xd7\x71\x66\x3c\x93\xda\x07\x25\x27\x8f\xa7\x03\x93\x4b\x19\x0d\x4d\x32\x04\xa9\xd6\xa3\xea\xbf\x77\xb1\x00\xf8\x12\x29\x51\x6e\xd3\x93\x65\x60\xb1\xcf\x0f\xdf\x62\xb9\xd9\x9c\x2f\xa6\x57\x69\xf6\x92\xcb\xd5\x53\x01\xef\xde\xbc\xfd\xf9\x2c\xcb\x51\x61\x52\xc0\x07\x11\xe0\x63\x9a\x7e\x81\x9b\x24\xf0\xe1\x32\x8e\x81\x85\x14\xe8\xfd\x7c\x8d\xa1\x3f\xfd\xf4\x24\x15\xa8\xb4\xcc\x03\x84\x20\x0d\x11\xe8\xdf\x58\x06\x98\x28\x0c\xa1\x4c\x42\xcc\xa1\x78\x42\xb8\xcc\x44\x40\x7f\xde\xf9\x6f\xdc\x2e\x44\x29\x6d\x4f\x65\xc2\xfb\x1f\x6f\xae\xae\x6f\x97\xd7\x10\xc9\x98\x54\x98\xb5\x3c\x4d\x0b\x08\x65\x8e\x41\x91\xe6\x2f\x90\x46\xb4\x5a\x1b\x2b\x72\x44\x7f\xba\x38\xdf\x6e\xa7\xd3\xcd\x06\x42\x8c\x64\x82\xe0\x29\x2c\x0a\xcc\x3d\xa0\x65\x5a\x3d\x79\x2c\x65\xac\x7d\xf8\xe5\x02\x32\xa1\x02\x11\xc3\x89\xbf\x0c\xd2\x0c\xfd\x5f\xed\x8e\x15\x24\x2b\x28\xd7\x46\xb2\xfa\x5d\x1d\xb7\x42\x91\xc4\x38\x54\x5a\xe4\xc4\xff\x60\x7e\xdb\x9d\x32\x0b\x45\x61\x4e\x47\x22\xa6\xe8\x78\xfd\x0c\x64\x04\x69\x0e\xb3\x27\xa1\x96\x65\x14\xc9\xbf\x6a\x95\xde\x67\x3e\xe2\xcd\xf7\xed\xde\x25\x5a\x80\x74\x4d\x9a\x46\x2e\x28\xf8\x12\xab\x65\xeb\x95\x76\xea\xb7\xb2\x10\x8f\x31\x36\x7d\x3b\x03\x4c\x42\xb0\x59\xca\x45\xb2\x42\x38\xf9\xfd\x94\x4e\x71\x18\x51\x25\xc9\xaa\xb2\x76\xf8\x91\xff\xe9\x85\x52\xb5\x2c\x72\x99\xac\x6a\x7b\x65\x12\x70\x42\x69\xb5\x00\x6f\x89\x85\x07\x33\x97\xdd\xc8\xbf\x15\xcf\x68\x7c\x3e\x3f\x87\x4a\x7e\xbb\x05\x2a\x8d\xe2\xc2\xf2\x22\xcb\xe9\x65\x76\xc1\x9f\x4e\x58\x6c\xd6\xaa\x05\xed\x2e\x9a\x55\xdc\x6e\xe7\x4d\x8d\x33\xe3\x32\x49\x19\x8d\xda\x59\x96\xe9\x1c\x82\xcd\x74\x32\xe9\x28\xf6\xcd\x11\x0a\xad\x0c\x0a\xce\x97\x16\xbc\x80\x1f\x9c\x4e\x3e\x72\x06\xe7\x0b\xed\x77\xa1\xe3\x4f\xca\x67\xcc\x65\x00\x85\x36\x93\x92\x9a\x5c\x12\xde\xe9\xae\xac\x65\x5a\x2a\xa0\xf0\x63\x8a\x2f\x85\xcb\x30\xf4\x81\x81\x69\x54\x10\x06\x04\x95\xc0\x65\xf3\xd6\xaa\xa9\xca\xc9\x82\x3b\xfe\x89\x30\x1c\x70\x31\x91\xb1\xd5\x6c\x2b\xbb\xe3\xa9\xd2\x77\xec\xb0\x9f\x59\x46\x0a\x06\x5c\xbd\x51\x4b\x56\x72\xc8\x4b\xd6\x31\xde\xd1\x1c\x8b\x32\x4f\xa0\xa3\x66\x3a\x21\x78\x92\x20\x85\x20\xd6\xa9\x0c\x61\x85\x09\xe6\x26\xe9\x32\x8e\x35\xa4\xc1\x5c\x6c\x45\xb4\x91\xd7\x8b\x3a\x44\xe5\x22\x20\xa5\xd6\xff\x59\x42\xcc\x51\xe5\xdb\x0a\xd3\x45\x4b\x19\xd3\x77\x59\x21\xd3\xc4\x80\xf5\x3d\x46\xa2\x8c\x8b\xb9\x39\x32\xe3\xe0\x5d\xc4\xb4\x6d\x6e\xa1\x13\x9a\xbb\x64\xc3\x89\xf3\xe0\xc3\xce\x5d\x70\xe6\x06\xee\x84\xbb\x14\x2d\x05\x07\x2e\x87\x0e\x4b\x6f\xad\x28\x5d\x09\xac\x45\x5c\x32\xcd\x6a\x8f\x49\x0d\x5d\x9d\x63\xee\x4e\xc7\x70\x7d\x87\x16\x23\x2e\xd1\x84\x3c\xa9\x0e\x7c\xc7\x15\x36\xeb\xfd\xd7\xcb\x9a\x58\xb8\x23\x73\x2d\xca\x38\x1a\xc2\xc1\xc4\xd4\xd1\x51\x96\xad\xa9\x4e\x88\x75\x7b\x49\xbd\x40\xac\xb0\x51\x89\x32\x8e\xdb\x55\x30\x76\xbd\xbb\xfc\x96\xb6\xbc\x76\xd6\x9d\xf0\xa1\x8c\xd3\xf5\xe8\x64\xfc\x54\x13\x39\x2d\xdf\x7e\xfe\xf8\x51\xfb\x24\x8b\x1f\xd5\x6b\xd2\x5f\x7b\xf0\xad\x52\x1f\xc4\x28\xf2\x81\x2b\xc9\xcd\x89\x4f\xf5\x57\xe0\x50\xdd\x46\xb2\xa8\xb9\xf9\x47\xb0\xdf\xd1\xf4\xd7\xa6\x95\xa3\x72\xa0\x9b\xe7\xf1\x18\x3c\x1c\x44\x4f\x6f\xa4\x56\x70\x80\x07\x5c\x73\xa4\x78\x55\x5d\x5f\xc2\x59\x0b\x95\x47\x82\xec\x35\xfd\xd1\xe1\x6b\x54\x11\xf6\xc1\x6f\xb8\x72\xcd\xde\x3a\xd9\x02\xea\x77\x92\xd1\xb1\x18\xab\xe4\xa7\x0b\x68\x29\x79\x75\x1d\xfb\xfb\x5b\x5f\x09\xb9\xc3\x8d\xad\x22\x0b\xab\x2e\x77\x28\xc7\x27\x7d\x2f\x1f\xf8\x44\x1b\x56\x4c\xe4\x68\x75\xa0\xe3\x20\x63\xc4\x90\x7e\xf1\x24\x0a\xcd\xfc\x8a\x48\x90\x04\xec\x3b\x99\xfc\x17\x8f\x42\x11\x43\xd9\x85\x82\x9e\x79\x4a\x04\xba\xcb\x99\x57\x33\x82\x89\xf2\x94\xa3\xd7\x7d\x83\x74\x0b\x52\x23\x62\xfd\x6a\xcf\xf8\x71\x2d\x8b\x57\xa2\x6c\x0d\xbe\xef\x88\x40\xe7\xf4\x3a\xc6\xe7\xa3\x60\xd6\x5b\xe9\x16\xc5\xd9\xf4\x50\x55\x4c\x76\x66\xf6\x4f\x0b\xd7\x9b\xed\x29\x2c\xc6\xe8\x26\x77\xe7\xa7\xb0\xd6\x7f\x86\x7b\x57\x0f\x7c\x8d\x17\x3b\xe0\x3d\xea\x4d\xd4\x70\x7c\xdc\x99\x86\xa3\xaf\x47\x7b\xfd\xe4\x39\x04\xf7\x2b\x4d\x9c\x23\xd1\xce\x24\x6b\xc0\x6e\xf0\x99\x46\xff\x09\x6d\x0d\x20\xe7\x55\xbd\xa7\x71\xd5\x87\x3a\xcd\xe1\x27\xec\xff\xd6\x6c\x1a\x05\x6c\x4f\x6b\x68\x86\xce\xeb\x70\x85\xf5\xb4\x96\xf2\xb8\xe6\x09\xdd\x64\x08\xfe\xe6\xa9\x84\xfe\xe7\x44\x7e\xe5\xf1\xd0\xca\x5c\xf0\x54\x6c\x45\x5c\x14\x7a\x4f\x86\xaa\xfd\x72\xaa\xaa\x9e\x66\xf4\x22\x56\xf4\xfe\x2e\x63\x91\x6b\x9d\x5c\xd0\xbf\xed\x0c\x3d\x07\xef\xe6\xbd\x1a\xb6\xe9\xf4\xf6\xab\x75\xff\xa0\x45\x16\xe9\xea\xf8\x66\x61\xe6\xd4\xd8\xfe\x95\x6a\xd2\xaf\x5f\x6c\x58\xd1\x28\x52\x52\x5c\xc7\x44\xdb\xa0\xed\xd6\xe3\x0b\xc8\xd0\x38\xc9\x23\x41\xc3\x51\x55\x19\x3c\x6e\xf6\xac\xbd\x9a\xed\x46\xcf\xc6\xd0\xcc\xff\x24\x68\x89\xd1\x98\xe1\xd3\xfe\xcd\xfb\xfd\x6d\x78\x80\x1e\x71\x5f\x0b\x1e\x77\x00\x9e\xc5\x17\x9c\x3d\x8b\xec\xbe\xe3\xc8\x83\x62\xc9\x0d\x3f\xf3\x2c\x2d\xb4\xe2\x3a\xdb\x6e\xc7\x9a\xb9\x97\xe1\x03\x99\x72\x1a\x37\x6e\xb0\xe4\x9c\x58\x3d\x7a\x82\x93\xfc\xa1\x81\xf1\xad\xf3\x34\x3c\x43\xf4\x18\x50\xf7\xf2\x61\xc7\xc8\x64\x3b\x7e\xd4\x6c\x72\x63\x15\x24\xfd\xaa\x58\x72\x67\xcc\x23\xb8\x8f\x9a\xf4\x3a\x98\xde\x1d\xf7\x9c\xa2\xee\xfc\x31\x1e\xcd\xdf\x64\x18\xac\xdd\x9a\x91\x85\xc5\x28\xa4\x6a\xa8\x92\xf0\xe0\x28\xc2\x98\xeb\x2b\x69\xe3\x06\x2d\x64\x78\x6c\x67\xab\xbf\x53\xc5\xe9\x9f\x24\x31\xe3\x82\x44\xe0\x7d\xef\xbf\x25\x4e\x6a\xe6\xac\xfa\x72\x46\x9e\xe2\x57\x7d\xaa\x95\x11\xa3\x88\xd8\x71\xed\xd9\x7f\x9b\x26\xa2\x3d\xcc\xd8\x2d\x77\xcf\xc7\xae\x83\x8c\xb5\xd9\x74\x49\xa9\xc9\x49\xfd\x08\xf8\xf7\x5f\xc9\x7a\x88\xb0\xc9\x51\x8b\x8e\xcd\x3d\x1f\xd3\xfa\x18\x62\xcf\x4c\xd9\xa6\x4d\xf6\x87\x10\x36\xef\xa1\x07\x69\xbe\xb0\x32\x5b\xdd\x3f\x74\xa0\x78\x0a\x31\x26\x95\x86\xf9\xdc\xf1\x12\xf3\x89\x27\xeb\x9e\xa4\xeb\x2d\x8d\x94\xd9\xa7\xed\x3f\x1a\x7d\xa6\xc1\x45\x66\x9f\x22\xab\x28\xa9\xce\x18\xa3\x5a\x53\x8e\x13\x7a\x80\x7a\x02\xa9\x17\xc9\xc3\x03\x30\xee\x26\x81\x7e\xda\x67\x5d\x93\xb4\x9a\x2f\x80\xfa\xd7\x3f\x76\x56\x24\xb0\xa3\x17\x00\x00")
 
 func templateBuilderSetterTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -214,12 +287,12 @@ func templateBuilderSetterTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/setter.tmpl", size: 4397, mode: os.FileMode(420), modTime: time.Unix(1568906061, 0)}
+	info := bindataFileInfo{name: "template/builder/setter.tmpl", size: 6051, mode: os.FileMode(420), modTime: time.Unix(1792027618, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x6d\x6f\xe3\x36\xf2\x7f\x2d\x7d\x8a\xa9\xe0\x0d\xec\x20\x96\xb3\xfb\xee\x9f\x3f\x7c\x40\xbb\xc9\x02\x01\x0e\xdb\xc3\x66\xdb\x2b\x2e\x0d\x0a\x5a\x1c\xd9\x6c\x64\x52\x4b\x52\x4e\x72\x3e\x7d\xf7\x03\x9f\x64\x49\x96\x9d\xa4\x08\x70\x68\x5f\xc5\xe2\xc3\x70\xe6\x37\xcf\x93\xed\x76\x76\x1a\x7f\x14\xe5\x93\x64\xcb\x95\x86\x0f\xe7\xef\xff\x6f\x5a\x4a\x54\xc8\x35\x7c\x22\x19\x2e\x84\xb8\x87\x6b\x9e\xa5\xf0\x7d\x51\x80\x3d\xa4\xc0\xec\xcb\x0d\xd2\x34\xfe\xba\x62\x0a\x94\xa8\x64\x86\x90\x09\x8a\xc0\x14\x14\x2c\x43\xae\x90\x42\xc5\x29\x4a\xd0\x2b\x84\xef\x4b\x92\xad\x10\x3e\xa4\xe7\x61\x17\x72\x51\x71\x1a\x33\x6e\xf7\xff\x7e\xfd\xf1\xea\xf3\xcd\x15\xe4\xac\x40\xf0\x6b\x52\x08\x0d\x94\x49\xcc\xb4\x90\x4f\x20\x72\xd0\xad\xc7\xb4\x44\x4c\xe3\xd3\x59\x5d\xc7\xf1\x76\x0b\x14\x73\xc6\x11\x92\xaa\xa4\x44\x63\x02\x75\x6d\x56\x47\xe5\xfd\x12\x2e\xe6\xb0\x20\x0a\x61\x94\x7e\x14\x3c\x67\xcb\xf4\x1f\x24\xbb\x27\x4b\x04\x7f\x55\xe3\xba\x2c\x88\x46\x48\x56\x48\x28\xca\x04\x46\xfb\x5b\x6c\x5d\x0a\xa9\x5b\x5b\xa3\x45\xc5\x0a\x23\xde\xc5\x1c\x4a\xc9\xb8\x86\x71\x49\x54\x46\x0a\x18\xa5\x9f\xc9\x1a\x27\x90\xfc\xd4\xe5\x45\x62\x86\x6c\xe3\x6e\x34\xbf\x1b\x32\xfe\xd0\xba\x2a\x34\x53\x5a\x48\xc3\xe0\xc5\x1c\x96\x1a\xc6\x05\x72\x18\xa5\x37\x6e\x71\x02\xef\x2d\x0b\xb3\x19\xb4\xb9\xa8\x6b\x83\xbc\x81\x2d\xac\xe4\x42\x82\x45\x83\xf1\xa5\x3d\x6a\xd9\x32\x07\x91\x6b\xa6\x19\xaa\x34\xd6\x4f\x25\xf6\xc9\x28\x2d\xab\x4c\xc3\x36\x8e\x32\x0b\x57\x1c\x6d\xb7\xd3\x16\x12\x0e\xe1\x59\xce\xb0\xa0\xca\x00\x32\xad\xeb\x38\x2a\x25\x52\x96\x11\x8d\x0a\x6e\xef\x9a\x8f\xb4\xfd\x6e\xec\xb8\xfe\xe7\x0a\x25\x02\xa1\x54\x01\x01\x8e\x0f\xd0\x9c\xb6\x2c\xb7\x44\x48\xe3\xbc\xe2\x19\x8c\xdb\xe0\xd5\x35\x9c\x76\x19\x9e\x38\x8a\xe3\x52\x41\x9a\xa6\xc3\x4f\x4f\xfa\x97\x8c\x78\x5d\xb2\x69\x4b\x82\x39\x90\xb2\x44\x4e\xc7\x07\x8f\x9c\x41\xa9\xd2\x34\x9d\xc4\x91\x44\x5d\x49\x0e\x1d\x05\x3b\x59\xb7\x5b\x78\x60\x7a\x05\xf8\xa8\x91\x53\x18\x41\xf2\x83\x7b\x3f\xe9\x68\x3d\xea\xd8\x99\x42\xad\xcd\x89\xd4\x1b\x84\xb9\x59\xff\x51\x62\x5e\x55\x48\x97\xa8\xf6\x49\xce\x66\x70\x43\x36\x08\xf8\x88\x59\x65\xc4\x36\xd0\x7f\xab\x50\x3e\x01\xe1\x14\x9c\x60\x6e\x95\x57\xeb\x05\x4a\xe3\x82\x52\x3c\xa8\xd9\x06\xa5\x66\x19\x2a\x58\x13\x9d\xad\x90\xc2\xe2\xc9\xf9\xa6\x28\x51\x12\xcd\x04\x1f\x52\x1d\x0c\xe9\xce\x70\x30\xce\xf4\x23\x64\x82\x6b\x7c\xd4\xc6\x47\xcd\xdf\x09\x8c\x19\xd7\x67\x80\x52\x0a\x39\xf1\xea\xea\x21\xf0\xc5\x13\x4e\x5a\x6f\x24\xde\xb9\x13\xe7\xfb\xc9\xbf\x50\x8a\x9f\x49\x51\x61\x02\xe7\xce\x52\x07\x21\x52\x64\x83\x1e\x21\x6b\xee\xe6\x85\x69\xf8\x60\x79\xcf\x2f\x1d\x1d\xf5\xc0\x74\xb6\xea\x6b\x3e\xa5\xd2\xfc\x4a\x2f\x19\x29\x30\xd3\x63\xcb\xbb\x25\x23\x09\x5f\x22\x8c\x7e\x3b\x83\x51\xcb\xc1\x1b\xc7\xb6\x6f\x47\x99\x89\x54\xdb\x2d\xfc\x2e\x18\x6f\xce\x05\x62\x0a\x92\x33\x30\xf1\xe4\x22\x8e\xa2\x03\x96\x67\xed\x5e\x35\x24\x03\xbe\x13\xcf\x84\x57\x7e\x14\x51\xcc\x49\x55\xe8\x36\xa5\x73\x0f\xb7\x4a\x3f\xe3\xc3\x38\x09\xf1\xb3\xae\x2f\xa0\xe2\xaa\x2a\x4d\x04\x44\x0a\xd4\x31\x93\x18\x92\x01\xae\x42\x05\x54\x0e\x73\xc5\x38\xc5\xc7\x96\xbc\xe7\x5d\xf6\x5a\xdc\xed\x8c\xf3\x17\x97\x4f\xee\xd1\x7e\x9d\xc1\xa2\xd2\x50\x12\xce\x32\x65\xb4\x42\xb8\x63\x18\x44\x96\x55\x52\xbd\xca\xe8\x7e\x19\xb6\x3a\x13\xc8\xb7\x71\x44\xf2\x1c\x33\x8d\xd4\x22\x62\xd4\xd4\x97\xa7\xc5\x38\xcb\xed\xa1\xef\xe6\xc0\x59\x61\xb5\x6d\x39\x1c\xa3\x94\x93\xd8\x20\xe4\x21\x09\x34\xbd\x78\x57\x8f\x98\x0d\xf8\xde\x8b\x85\x30\xf7\x87\x65\x70\x98\x6c\xe3\xe8\xb7\x97\xb0\xef\xb9\x43\x29\x5b\x8c\xed\x70\x37\x5f\x6f\x85\xbb\xa5\x3c\xcc\xf3\xb6\xc1\x71\x80\xdb\x20\xea\xe4\xff\x8f\x23\x6d\xe3\xe4\xcb\x1c\xed\x25\xf1\xb4\x17\x4b\x42\xf0\x18\xe9\x75\x59\x34\x69\x3f\x87\xc4\x3b\xc4\xec\x9d\x9a\x85\xf2\xa3\xe5\x81\xee\xd2\x63\x13\x72\xdc\xf5\x10\x6a\x82\xc9\x77\x43\xfd\x48\x70\xec\xd7\x17\x39\x24\xef\xd4\x8f\x1c\x93\xbd\x9a\xa1\x81\xaa\x5d\x57\xb4\x28\xb4\xca\x85\xce\xea\xd1\x8a\x81\x80\x62\x7c\x59\xe0\x40\xe9\xf0\xd4\x2a\x1c\xba\x04\xf7\x6b\x07\x46\x1d\x81\xeb\xcb\xf4\xab\xb9\x13\x62\xea\x91\x7a\xe2\xf9\xec\xd9\x95\xed\x65\x09\xf4\x0f\x13\x7c\xb3\x24\xea\x08\xd1\x06\xc3\x23\x4e\xd3\x45\xf5\x68\x96\x3c\x6d\xeb\xe7\x4d\xf3\x65\xc2\x59\x91\xbc\x6d\xce\xfc\xcb\xa5\x4c\xce\x8a\xbf\x72\xd2\xec\xd8\xe1\xd1\xbc\xd9\x31\xc3\x50\x5d\xa7\x5f\x76\x04\xdf\x32\x93\xf6\x69\x1f\xcf\xa8\x20\x5c\x3f\xf9\x5a\xbf\xfb\xd3\xa4\xd8\x01\xae\xff\x04\x59\xb6\xc5\xf5\xff\x2e\xd1\xee\x7e\xce\x4e\x41\xad\x88\x44\x1a\x92\x98\x4b\x48\xb0\x40\xfd\x80\xe8\x2c\x48\x3f\x08\x1f\xc5\xa5\x02\x3b\x7a\xd8\x9b\x3c\x84\x3c\xe6\xf6\x5a\x18\xe5\x0e\x9d\x4f\x8e\x6a\x2b\x4c\x0a\x09\x63\x2e\x34\x8c\xf2\xf4\x7a\xbd\xae\x34\x59\x14\x38\x31\x5f\x6e\x7c\x70\xe9\x82\x4e\x90\x6f\x6a\x76\x6e\x2c\x87\x96\x54\x63\x04\xf9\x2e\xbf\x36\x01\xd8\xad\xa5\x9f\xab\x35\x4a\x96\xb9\xbd\x88\x50\xea\xce\xbf\x84\x4a\x88\x7d\xfd\xdf\x8e\xfa\x8f\xa5\xe9\xf5\x48\xe1\xe3\x70\x81\x44\x0e\x92\x5e\x08\x51\x74\x68\xb4\xd5\xd1\xb1\x24\x6f\x43\x57\x26\xd9\x36\x8f\x8d\xb0\x4f\x70\x4d\xca\xdb\x5e\x59\x71\xe7\xd4\xb6\x7d\x35\xf1\xd2\xac\x26\x12\xd7\x62\x83\xd4\x64\x0a\x13\x75\x73\xf3\xe8\x4f\x9c\x7d\xab\xd0\xad\x8c\x4a\x98\x43\x62\x45\x6c\x4e\xb5\x01\x71\x23\xa0\x51\xb9\x9b\x02\xa1\x1f\x03\x99\x70\xb8\x47\xd1\x20\x62\x28\x98\x14\x50\xd7\xc7\xc4\xd9\xb3\xdc\x69\xdf\x88\x77\x96\x6b\x6b\x14\xb0\xa2\x90\xe2\xd5\x96\xeb\x2b\x1c\x5f\x54\xb6\xcb\x4f\x27\x51\x7a\x93\x89\x12\xd3\x1f\x0e\x14\x9f\x87\xa6\x5a\x3d\x3f\x18\x50\x42\x1f\x1c\xef\xfd\x36\xe2\x35\xd3\xb5\xe4\xa3\xc1\x3e\x19\xc2\x37\x8e\x22\x5f\xde\xda\x2b\x75\x0d\x56\x4f\x2e\x05\x98\x65\xdc\xd5\xaf\x74\x89\xa0\x85\x5f\x75\xde\xe1\xb6\xd2\x38\x8a\x5e\xd8\xc6\xb4\x5e\x1a\x0f\x4e\x96\xa2\xa8\x1f\x5b\xbd\xe1\x6c\xb7\xd0\x65\xdf\x5c\x98\x83\x96\x15\x1e\x2e\x4f\x42\xc5\x10\xac\xc5\xc3\x63\xed\xb6\x10\x0f\x28\x61\xdc\x34\x08\xe9\x7b\x53\x42\xb7\x24\x9b\x84\x0b\xb3\x53\x03\xb3\x1d\xe6\x98\x77\x85\xfb\x5d\x12\x49\xd6\xa8\x51\x9a\x5c\x91\x17\xcc\x14\x4c\x36\x62\xdb\x01\x6c\xe0\xc1\xde\xb0\x56\x13\x79\x75\xe1\x37\xc3\x40\x07\xa6\xc6\x49\x36\x89\xff\x6c\x0a\x27\xb3\xc5\xa8\xfa\xd4\x55\xe8\x17\xeb\x72\x09\x8c\x4d\xa3\x51\x15\x44\x36\xa0\xfc\xc7\xa3\x34\x81\xe4\xfa\xd2\x99\x64\xa3\xe2\x40\xa7\xae\x9d\xa1\xe3\xeb\xd4\x0c\x8b\x27\x60\x54\xbd\x52\xdb\xbb\x47\xc7\x8c\xda\x39\x63\xcf\x5d\x0f\x98\x01\xcb\xf7\xb2\xac\x0f\x34\xc3\x96\xb0\x4b\xb9\xfb\x26\x74\xf4\x22\xac\xc9\x3d\x8e\x8f\xc5\x11\x53\x78\x46\x36\x01\x98\x36\x8f\x59\x77\xb5\x5e\x69\x04\x7a\xf5\x8b\xb7\x8c\xaa\x5b\x76\x77\x07\x73\x68\x02\x55\xdd\xbc\x70\xcc\x8e\x87\x5c\xbb\xb1\x84\x97\xf8\x76\xd0\xfa\xbe\xc6\xd5\x9b\x7a\xb6\xb3\xe7\xba\x36\xda\x3e\xdd\xa7\x7a\x48\xe3\x54\x19\xc1\xac\x3a\x6e\xef\x7a\xca\x38\x83\x02\x79\x43\x78\x32\x09\x91\xc2\x6a\x23\x61\xbb\xe4\x63\xdc\x8b\xb9\x53\x6e\x7f\x0e\xc9\xef\xad\xac\xe3\xba\x06\xab\x49\xb7\x5f\xd7\x3b\x85\x36\x8c\x3b\xad\x1a\x4d\x85\x43\x46\x5f\x61\x7b\xb7\x98\x5e\x5f\x3e\xa3\xba\x74\xdf\x09\xdc\xf4\x3b\x3a\x50\x5b\x1d\x48\x50\x4d\x6d\x16\x26\xfd\xa6\x87\x84\x35\xea\x95\xa0\x21\x24\x7d\x08\xf3\x88\x83\x89\xca\x35\x9e\x3e\xbf\x87\x7f\xf1\xf8\xec\x14\xfe\xb7\x33\x0d\xdb\xff\x46\x29\x5a\xfb\x4d\x7f\xdb\xdc\x6f\x27\x30\x7f\xa8\x69\x2d\xa6\xfb\x45\x44\xb7\x8e\x9b\x76\x0b\xa2\x6e\xc9\xe6\xb4\x34\x10\x03\x06\x8b\xa4\xe0\xfc\xdb\xfd\xe2\x0a\x4e\x4e\xe0\xbb\xc1\x94\x32\x48\xa9\x01\xdf\x59\xc0\x26\x14\xfb\xad\x7f\x7c\x79\x16\x3a\xfc\x7a\xc3\x6e\x18\xb8\x56\x5f\x99\x5d\x19\x4f\xda\xc1\x7c\x2f\x4c\x0c\x4b\x03\x27\x9b\x8e\x79\x4c\xdb\xfd\x80\x90\xe6\xca\xcf\xa4\x60\x94\x68\x21\x95\x7b\xee\x8a\x57\xeb\x57\x82\xd6\x6a\x52\x7a\x9d\xcd\xbe\xb0\xcd\x73\x46\xa4\xd3\x97\x90\xdf\xef\x84\x3a\x0e\x62\x4d\xcb\x78\x75\xbe\xd6\xe9\x95\xe9\xd6\xf2\xee\x00\x60\xd3\xbc\x98\x13\x56\x20\xb5\x66\x6f\x1b\x04\xf8\x35\x71\x0f\x7a\xd0\x7f\x4d\x2e\xe0\xdd\x26\xb1\xcd\x64\x13\xa9\xbb\xe0\x75\x7e\x3e\x5f\xd7\x76\x6a\xaa\x06\xd4\x10\x7f\xfa\x92\xf7\x2b\xec\x09\xfc\x0d\xde\x3b\x89\x87\x04\x3e\x34\xf1\xb0\x13\x9f\xb2\x40\x20\x4a\xb1\x25\x5f\x23\xd7\xca\xb4\xdf\x04\x2a\xc7\x88\x4d\xcc\x4e\x76\xdc\xc9\x1e\xa6\x22\xbe\xb8\xb0\xdd\x10\xee\x1c\xc0\x47\xbb\x01\x9b\x38\x56\x56\x9d\x9c\x0c\x9a\xd0\x5e\x2f\x31\x7f\x4e\xbb\x87\x84\xb5\x8f\xbb\x51\xe9\xf3\xd2\x05\xf1\xda\xae\x30\xa8\xd9\xe0\x68\xff\x0d\x00\x00\xff\xff\x02\x11\x7c\x43\x98\x1f\x00\x00")
+var _templateBuilderUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\x5b\x73\xdb\x36\x16\x7e\x96\x7e\x05\xc2\x71\xb2\x92\x47\xa6\x93\xbc\xad\x77\xdc\x99\xd6\x76\xa6\x9e\x49\x93\xdd\x38\xdd\xed\xac\xeb\xc9\x40\x24\x68\xa1\xa6\x48\x96\x80\xec\x78\x15\xfd\xf7\x3d\x07\x37\x02\x24\x25\x4b\x89\xb3\xdb\x76\xfa\x62\x8b\x04\x70\x70\xee\x97\x4f\x5a\x2e\x0f\xf7\x87\x27\x65\x75\x5f\xf3\xeb\x99\x24\x2f\x9f\xbf\xf8\xeb\x41\x55\x33\xc1\x0a\x49\x5e\xd1\x84\x4d\xcb\xf2\x86\x9c\x17\x49\x4c\xbe\xcd\x73\xa2\x36\x09\x82\xeb\xf5\x2d\x4b\xe3\xe1\xfb\x19\x17\x44\x94\x8b\x3a\x61\x24\x29\x53\x46\xe0\x31\xe7\x09\x2b\x04\x4b\xc9\xa2\x48\x59\x4d\xe4\x8c\x91\x6f\x2b\x9a\xc0\xbf\x97\xf1\x73\xbb\x4a\xb2\x12\x96\x87\xbc\x50\xeb\xaf\xcf\x4f\xce\xde\x5c\x9c\x91\x8c\xe7\x40\x42\xbf\xab\xcb\x52\x92\x94\xd7\x2c\x91\x65\x7d\x4f\xca\x0c\xde\x36\x97\xc9\x9a\xb1\x78\xb8\x7f\xb8\x5a\x0d\x87\xcb\x25\x49\x59\xc6\x0b\x46\xa2\x45\x95\x52\xc9\x22\x02\xaf\xe1\xed\x5e\x75\x73\x4d\x8e\x8e\xc9\x94\xc2\x85\x7b\xf1\x49\x59\x64\xfc\x3a\xfe\x3b\x4d\x6e\xe8\x35\x23\xe6\xa8\x64\xf3\x2a\x87\x43\x24\x9a\x31\x0a\x0c\x47\x64\xaf\xbb\xc4\xe7\x55\x59\x4b\x6f\x69\x6f\xba\xe0\x39\x8a\x07\xe4\xab\x9a\x83\xb6\x46\x15\x15\x09\xcd\xe1\x9e\x37\x74\xce\xc6\x24\xfa\x31\xe4\x05\x04\x61\xfc\x56\x9f\x70\x9f\x1d\x19\xb3\x69\xbe\xc8\x25\x17\x20\x30\x32\x08\x1b\xaf\x81\x6e\xce\x0a\x20\x7a\xa1\x5f\x8e\xc9\x0b\xc5\xc2\xe1\x21\xf1\xb9\x58\xad\x50\xf3\xa8\x36\xfb\x26\x2b\x6b\xa2\xb4\xc1\x8b\x6b\xb5\x55\xb1\x85\x1b\xc1\xb4\x5c\x72\x26\xe2\xa1\xbc\xaf\x58\x9b\x8c\x90\xf5\x22\x91\x64\x39\x1c\x24\x4a\x5d\xc3\xc1\x72\x79\xe0\x69\x42\x6b\xf8\x30\xe3\x2c\x4f\x05\x2a\xe4\x00\xd8\x19\x80\xcb\xa4\x3c\x81\x05\x41\x2e\xaf\xdc\x43\xec\xdf\x3b\x1c\x00\xcf\x35\x93\x8b\xba\x40\x96\xd0\x94\x4c\x92\xe9\x3d\xb9\xa0\xb7\xec\x9d\x7d\x3f\x21\xb4\x48\xc9\xac\x04\xe2\x4a\x1c\xcb\xac\x3a\x2d\x67\x54\x92\x3b\x56\x33\x92\x97\x60\xaa\x14\x4f\xe3\x26\xab\x30\xcd\x1b\x99\x32\x10\x1e\xfc\x08\xf6\x52\x01\x6e\x39\x9f\x73\x29\xd1\x5b\x07\xcd\xf5\xfb\x97\x57\xfb\x01\x77\x5a\xa7\xff\x9a\x21\x75\x9a\xc2\xed\x94\x14\xec\x8e\x38\x59\x94\x42\x3d\x05\xc7\xc3\x6c\x51\x24\x64\xe4\x9b\x16\xd4\xb7\x1f\xaa\x73\xac\x29\x8e\x2a\x41\xe2\x38\xee\x57\xcc\xb8\x7d\x08\x95\x1f\x92\x8d\x3d\xfd\x1e\x13\x5a\x55\xac\x48\x47\x6b\xb7\x4c\x48\x25\xe0\xb6\xb1\x95\x97\x04\xee\xe7\x64\xfd\x61\x21\xc1\x3b\xca\xc2\x18\x45\xeb\x7b\x6e\x5f\xaa\x78\xdb\x28\x2d\xe9\x13\xd7\x12\x1d\x69\xa9\x82\x98\x80\x0d\xee\xce\xa5\x63\xee\xd9\x86\x6d\x4b\x82\xce\xb7\xe7\x85\xac\x5d\x39\x52\xe7\x7c\x5e\xb4\x4e\xbb\x3b\x27\xa4\xac\x8e\xd0\x8f\xe2\xb7\x95\x0e\x49\xa5\x00\xd8\x7d\xc7\xe5\x8c\xb0\x8f\x12\x94\x09\x7e\x1c\x7d\xa7\xc5\x88\x82\xa0\x1c\x04\x69\x00\x3c\x56\xe2\x8e\xd8\xc4\x2b\x9e\x5c\x7d\x2e\x31\x13\x49\x2c\xbd\x66\xa2\x4b\x12\x0c\x84\x81\x01\x24\x59\xb2\x40\xbb\xa3\x35\x7e\x5d\x30\x48\x85\x18\x21\xbe\xcd\x8a\xc5\x7c\x0a\x17\x80\xc5\xea\xf2\x4e\x1c\x82\x3a\x24\x24\x59\x41\xe6\x54\x42\xd6\x35\x51\x02\xf1\x56\x56\xac\x56\x2a\xd9\xda\x9a\xc8\xc1\x28\x91\x1f\x21\x88\x0a\x09\xc2\x61\x0a\xc5\xff\xa8\x53\xa9\x5c\x1a\xf5\x7a\x42\xf3\xfc\x6d\x85\x84\xc7\x64\x04\xa9\x70\x42\x58\x5d\x97\xf5\x18\x8d\xcc\x53\xa1\x1e\x31\x99\xb5\x0d\x86\xd4\xcf\x4f\x05\x5e\xa0\x09\x06\x4e\x0b\x69\x6f\x04\xa7\xc7\xea\xf8\xb0\xd1\x08\x9c\xd8\x46\x29\x70\xd4\xfa\xf0\xd7\xd0\x8a\xe1\x7b\x07\xc5\x5c\x5e\x29\x07\x3d\x3f\x8d\xdf\x63\xda\x5d\xad\x7c\x35\x95\x6a\x97\x40\x2d\xe1\xc1\x37\xec\xae\x39\x2b\x46\x8d\x6e\xba\x8e\xf6\xce\x70\x1a\x79\x4c\x47\x26\x0a\x22\x5d\x01\xa3\x7f\xb3\xba\xfc\x27\xcd\x17\xf0\x22\x2a\x78\x1e\xe9\x9c\xdd\xeb\x8d\x02\x64\x33\xce\xa8\x12\xbf\x71\xc7\x01\xcf\x88\xe1\x31\x06\x4a\x3c\x55\x1a\x7b\x5b\xe4\xf7\xc8\xbd\x35\x19\xd0\x9e\xe0\x9f\xe1\x40\xfb\x3a\x1c\xda\x8b\x5f\x31\x0a\x8b\xec\xac\xa0\xd3\x1c\xd4\x1e\xa5\x0b\x9a\xdf\xd5\x1c\x2b\xa3\x66\x03\x76\xb5\x3d\x43\xcc\x68\x5a\xde\x91\x27\xc7\x48\x4d\xdd\xb0\x26\x95\xc5\x48\xcd\x7a\xa9\xef\x44\x86\x03\x64\xff\xc0\xca\xd2\xcb\xce\x1d\xba\x83\x63\x65\x83\xb7\x0a\x73\xcb\x58\xb3\x8c\xbb\x7a\xf8\x53\x2a\x40\x87\x55\x1c\xe0\x46\xeb\xc7\xe4\x1b\xf2\x9c\x3c\x7b\x46\x9e\x58\x3d\x5e\xdc\xf0\xea\x7b\x68\xaf\x84\x26\xd0\xbe\x6f\xba\x10\x71\xb5\x98\xe6\x5c\xcc\x46\xcf\xce\x6e\xc1\x2d\x96\x6f\x5b\x89\x6c\x42\xd0\x95\x8e\x48\x2b\xf3\xc5\xaf\xe9\x94\x01\x1b\x6f\x8e\xdc\xe5\x2b\xa3\x12\xcb\xa6\x12\x14\x2d\xa5\xe3\x0a\x65\x33\xd5\x53\x47\x4f\xd0\x30\x14\xd0\xd8\x09\xdb\x96\xd9\x5a\x6b\x62\x2b\xc9\x39\xb6\x8a\x29\xa7\x39\x34\x6a\x5b\x87\x90\x58\x93\x58\x1e\x8a\x93\xb6\x49\xb7\x09\x86\x8e\xf7\x1b\xe7\xf7\xbb\x1a\xc3\xdb\x21\x64\x2f\x06\xed\x65\x25\xfb\x62\xc0\xfa\x50\xd0\xa4\x69\xc7\x11\xc0\x48\x32\xeb\x7a\x67\x8d\x9f\xe2\x53\xad\x9d\x91\x12\x41\x91\xa9\x69\x01\x67\xf7\x3e\x4c\xc8\x9e\xd7\xed\xb9\x2e\x4f\xb3\x98\x60\xdb\x0a\x24\x7f\x29\x41\xf7\x76\x9f\x25\x26\x48\x34\x21\x28\xcb\xd1\x86\xe8\xc0\x67\xe1\x48\x5e\x78\x1e\xec\xcb\x35\x80\xee\x99\x82\x4c\x47\x3d\x7e\x5c\xd6\x02\xb3\xd1\x28\xb2\xed\x34\x5c\x08\x8d\xbd\x58\x54\xd8\x10\x43\x00\x19\xcb\x47\x2e\xe6\x80\x6e\x2e\xac\x5e\xd6\xf3\xc5\x61\x38\xf8\xe8\x49\xfc\x3c\x64\xd0\xe3\xaf\x49\xfd\xae\x4b\xdc\xa6\x00\xd8\x3c\x6f\x3b\x48\x42\x33\xa9\xc7\x91\x7b\xdd\x43\x6a\x7f\x87\xb6\x10\xa8\xbf\xf7\x5a\x4d\x42\xbb\x0d\x26\x46\x82\x2b\x14\xba\x0f\xf5\xc2\x65\x8e\x65\x4f\x70\x1c\x7f\xc0\x2e\x12\x8c\x2b\x68\x82\x3b\x91\x34\xd8\xee\xe2\x1f\xaf\xc7\xba\xb1\x95\xd8\x0d\x62\x77\x3a\xd1\x8c\xa4\x25\x44\x97\x04\xbe\x33\x54\x22\x49\x66\xe8\x17\xc2\x6b\x74\x5d\xf7\x6a\xd8\xe7\x72\xa7\x32\xe5\x34\xb6\x73\xb1\x0a\xba\x63\x3f\x04\x6f\x69\x8d\xf6\xac\xf2\x45\xad\x5a\xb6\x77\x1e\x0f\xed\xa6\xba\x93\xd4\x9a\x06\xfc\x58\x37\x7e\x3d\x54\x86\xe8\x8f\x38\xc1\x80\x98\x18\x32\x64\x13\x11\xcc\xbf\xab\xd1\x58\x15\xa8\x0f\xbb\x75\x1a\x7f\x6b\xe7\xf0\x4e\x0a\x5f\xf9\x1d\x74\x1f\xab\x7e\x0e\xc5\x3b\x7e\xd2\xf3\xef\x0d\x53\x4f\x13\xe8\x9e\x25\xf4\xb6\x05\x4f\x04\x26\x0e\x5a\x68\x35\x92\x32\x49\x16\x10\x57\xbb\x18\xf2\xa7\xdd\x0c\x88\x03\x29\x88\x44\xb3\x0c\xdc\x8a\xa5\x1b\x15\xd3\x2e\x9d\xdd\xe2\xa6\x44\x18\xc1\xcb\xb1\xaf\x13\x4b\xdc\xc8\x7f\x06\x21\xd9\x13\x97\x5b\x4b\x89\xe7\x77\x13\x52\x2b\x13\x18\xfc\xb0\x93\x7c\x86\xfd\xa6\xab\xc4\x9b\x1b\xcb\xe1\xd3\x63\x59\x4e\x51\xde\x4d\xa8\xa5\x33\x40\x8f\x38\x56\x47\x1b\x9c\x38\xb4\x95\x9a\x4d\xb6\x2b\x37\xdb\xcc\x30\xad\xc6\xd2\x76\x91\x7b\x12\xea\xa8\x43\x42\x32\xe8\xf1\x74\x51\x38\x7c\x2a\x0e\x2d\x22\xe3\xd5\x21\x7d\xe8\xa3\xab\xbe\xfa\xb8\xad\xb7\x36\xed\x87\xe3\xd5\x5e\x59\xb0\x36\xe4\x02\x17\x3d\x15\x6f\x0b\x16\x75\x60\x14\xa7\x33\x1f\x6a\xf1\x28\x78\x08\x4a\xf0\x76\x23\x88\x42\x89\x80\x7f\x39\xeb\x41\x53\xee\x3d\x2c\x25\x24\xd8\x85\x53\x78\x4a\x5a\x0d\xce\x70\xa0\x89\x90\xfd\x0e\x5e\xe2\xcd\xfd\xa6\xe1\x72\x1d\x0a\x54\xe6\xb0\x09\x9b\xe8\xb2\x81\xd5\x8b\x56\x15\xbc\x4a\x49\xa9\x7b\x36\x53\xe9\x54\x23\x17\x6f\x09\xd6\x3c\x80\xfa\x3c\x3e\x8a\x10\xe8\xed\xf7\x01\x24\x80\xef\x4d\xa0\x95\xee\x21\xc1\xd3\x07\x41\x86\xd0\x1d\xb7\xc3\x19\x3e\x9b\xe0\xc3\x58\x03\x93\x27\xaa\xf9\x48\x5f\xd5\xe5\x1c\xdb\x8e\x0a\x1c\xc9\xc3\xe0\xee\xb5\x77\xf9\xd1\xa1\xd0\xb5\x9a\x29\xd7\xca\xf0\xd4\x68\x21\x14\xb4\x07\x99\x4d\x2b\x08\x29\xcf\x99\x9c\x95\xe9\x58\xf3\x8d\xc7\xaf\x41\x49\x05\x11\x05\xad\xc4\x0c\x7a\x1f\x70\x11\x2e\x75\x77\x04\x62\x83\x4f\xe3\x50\x89\xfb\xb4\xb3\xf9\xbd\x90\x66\x30\x26\xe7\xf2\x2f\x02\x49\x53\xf0\xe8\x4a\x05\xa9\x61\xc9\xdf\x8d\x7d\x55\xc0\x1d\x26\x71\x2d\xc9\xc8\x99\xef\xfc\x74\xbc\x8b\x53\x86\x5a\x1a\x95\x35\xbf\xe6\x05\xf8\xdb\x7e\x0f\x9e\x17\xe6\x01\x03\xe9\x05\xdd\x52\x4f\x82\xd7\x0c\x0e\xed\x4c\x1c\x6c\x3f\xd6\x49\xfe\xd3\x27\xe2\xee\x3d\xee\x34\x2f\x6d\xa8\x6f\xe0\xcf\xbe\xe8\xc9\x30\xf5\xbe\xd2\x8a\x55\x89\x18\x95\x07\x65\x41\xab\x56\x41\xd3\xea\xc3\x29\xcf\x32\x5f\x26\x27\xea\xa4\xc3\x97\x1e\x6a\xec\x04\xa4\x09\xc5\xea\x0a\x3b\xd7\x06\xf5\x27\xd3\x95\xa7\x87\x15\xbd\x75\x6f\x1a\xe6\xf7\xf8\x29\xe6\x9b\xe6\x42\x07\xb3\x67\x06\x67\xf7\xcf\x2a\x4b\x3a\x44\x3e\x02\x73\x45\x6b\x0f\xd8\xe9\x2a\x98\x9c\xf1\x39\xc3\x22\x2d\x24\x05\x0a\x66\xba\xb2\x1a\x44\x1f\x45\x32\x3c\xcf\x91\x79\xfc\xac\xcb\x36\x90\x37\x54\xad\xe5\xa6\x9e\xc5\x96\x7a\xa5\xd3\x0c\x9f\xe4\x8c\xd6\x5e\xd2\xca\x9c\xb6\xc7\xfa\xc4\x4a\x8f\x52\xeb\xce\x2b\x66\x51\x62\x38\xb1\x6f\x2f\xb5\x47\x1d\xdf\x8a\x04\x9a\xdf\x63\xbc\x8f\xd9\x27\x9b\x99\xdd\xe1\x32\x4b\x7d\x13\x89\x90\x42\x30\x8d\xb6\x9e\x56\xde\x60\x69\x5e\x6e\x84\xb5\xb7\x45\x4d\x6d\x51\xb4\x05\x7c\xdb\x2c\x40\x3e\x0b\x16\x5d\x3b\x4e\xfd\x89\xfc\xfd\x26\x90\xbf\x76\x56\x7b\x74\x18\xf0\x11\x61\x3f\xd5\x76\x6c\x44\xfe\xce\x4f\x8f\x3a\x79\x1a\x1a\xce\x09\x79\x03\x1d\x60\x77\x49\x41\x85\x2f\xda\x18\x61\x77\xd7\xb6\x80\xe1\x17\x43\x85\xad\xb2\xbb\x01\x2d\x5c\x1b\x57\xbf\x51\xa4\xf0\x4f\xa0\xf0\x7f\x01\x14\x3e\x1e\x0e\xd3\x6e\x00\x77\x87\x62\x02\x0f\xed\xeb\x03\xbf\x0a\x38\xd3\xbe\x64\x33\x48\x63\x07\xc5\x5d\x6b\xe1\x1f\x07\xb5\xe9\x11\xeb\x8f\x00\xdc\x78\x62\xfd\xff\xb0\x9b\xe6\xe3\xe1\x3e\x81\x02\x5f\x43\x36\x30\xb8\x88\x99\xf1\xa6\x4c\xde\x31\xa6\x7d\x50\xde\x95\xa6\xb2\xc0\x38\xa7\x7e\xe0\xd3\xf9\x7d\x8f\xc5\x21\x2c\xc2\xdc\x33\xac\xaf\xbb\x57\x4d\xc0\xd0\x07\xce\xcb\x5b\x68\xb9\x77\xbd\xd7\xcc\xcf\x06\x65\xf2\xf1\x28\xdd\xc2\xc7\x17\x49\x59\xb1\xf8\xbb\x35\x68\xd4\xba\x5f\xfe\xe0\x2e\xcf\xd2\xc6\xc6\x67\x8a\xd5\x55\xd3\x50\xb1\xf8\xc7\x82\x43\xbc\x36\xb6\x6b\xcd\x3b\x6a\xa0\xf0\x26\x1e\xe6\x4f\x3c\x06\xef\x32\x0d\x38\x54\x63\xd8\xdb\xd4\x6e\xd6\x00\x5a\x70\x2d\x91\xa5\x79\x8b\x6d\x86\x5d\x8a\x71\x5e\xdc\x0e\xf8\xf4\x5b\xfd\xde\xdf\xb7\x74\x1b\x1f\xc5\x10\x4b\xbd\x79\xa8\xe1\xe9\x98\x80\xbb\xb0\xf5\xf5\xab\xe9\xfa\xdc\xfc\xf1\x38\xea\x81\xf8\xee\x51\x8f\xf8\x7d\xea\x47\x91\xac\x50\x21\x79\x79\xa7\x46\x69\x3b\x65\xc7\x2f\x70\xc8\xf6\xa4\x19\x5b\x25\x42\xe4\x70\xdd\xbe\x15\xea\x3b\x30\xfd\xb9\xa2\x35\x3c\xe1\xf7\x51\x88\x6a\xe6\x1c\xbb\x0c\x87\xef\xb8\x7b\xd5\x09\x15\x49\x03\xe3\xc2\xec\x57\x64\x20\x50\x8d\xe6\xe9\x98\x44\xb7\x91\x79\x74\xdd\x06\x2e\xf1\x54\xbc\x0a\xad\xf8\x0e\x63\x17\x92\xd2\x08\xb1\xa6\x45\x4e\x6b\xa7\x88\x4f\x46\x33\x63\x12\x9d\x9f\x8a\x28\xb0\xab\xa5\x03\x57\xa8\xe0\x67\xbb\xb9\x3e\x7e\x11\x08\x24\x76\xb4\x70\x73\x29\x7e\x09\x8f\x45\xa3\x85\xfc\xae\x31\x7d\xcf\x5c\xa4\x99\x5e\x63\x7d\x7f\x72\xdf\xe9\x20\x99\xd3\x1b\x36\x9a\xd3\xea\xb2\xc5\xd8\x95\xce\xcf\x4b\x3d\x9f\xab\x39\x1c\x91\x22\xde\x20\x44\x28\xd0\xce\x37\x5e\xc2\xa9\x4b\x7e\x75\x05\x37\xdb\x0b\x96\x6e\xc8\x7f\xd8\x77\xb3\x35\x9e\xb0\x4d\x40\x5b\xab\x7f\xed\x68\xd6\xfe\x0c\xbb\xc0\xda\xfb\x5d\xaa\xeb\x2c\x9e\x2a\x04\x40\x99\xa3\xe7\x07\x10\xf8\x33\x0e\x4b\x78\x3c\xb6\xd9\x41\x59\x23\xe2\xe8\xe8\x4d\x78\x71\xbd\x4b\xaf\xc3\xf2\x2f\x66\xd9\x0d\x43\xda\x92\x7a\x5d\x83\x90\xda\xa0\x8e\x71\x6d\x55\xb4\x94\xdd\x84\xf6\xb2\xcb\xcd\x4b\xe0\xf0\x01\xd3\xc5\xdd\x20\xe8\xcc\xe8\x41\xb7\xb0\xa6\x68\xbb\x6e\xc3\xfe\x06\x53\x8d\x9f\x1a\x58\xb6\x29\xe9\x65\x83\x01\xaf\x29\xde\x1a\xe0\x50\x4b\x07\xee\xa7\xc1\xa6\x62\xdb\x06\xe2\xc0\x2e\xff\x07\x46\x41\x6f\xdd\x4d\x86\xee\xbc\x5f\xd4\xcd\x26\xd7\x6e\x5b\x2a\x5d\xd8\xd3\xe0\x9d\x01\x30\x91\xc5\x7a\xac\x3f\xd5\xc3\xd5\x7a\x6c\x44\x83\x93\x17\x2a\x70\x34\xc0\xea\x05\xff\xd2\xd0\xf2\x00\x49\xf5\x6b\xa3\xde\x32\xd2\x4b\xc9\x29\x5f\x7b\xc0\xad\xed\x63\xbb\xf8\x68\xc0\xaf\x71\x6c\xc7\x80\x79\x6d\x6d\x3e\xf6\x33\xfa\x60\x3b\x91\xc8\xb3\xdb\x3e\x1c\x47\x97\x18\xd8\xff\x1e\x7f\x61\x01\xbe\x30\x47\x6b\xef\xa6\x2e\x1f\xab\xd9\x20\xa1\x77\x83\x03\x3c\x1f\xa2\x3d\x7e\x24\x01\xc1\xc7\xe1\x88\xc1\xda\xf0\x5b\x3e\x78\x3a\x17\x67\xc5\x62\xfe\x05\xb2\x86\xa3\x49\x57\x60\x77\xdd\xf6\xe2\x76\x26\x98\x20\x0d\xa8\x00\xc2\xdc\x95\xcd\x65\x7c\x86\x63\x58\x16\x62\x03\xb7\xee\xc6\x8c\x72\x04\xe4\x30\xb8\x55\x63\x4f\x7e\x8e\xf4\x85\xc6\xb5\x7e\x8e\x8e\xc8\xd3\xdb\x48\x8d\x8b\xe3\x00\x12\x76\xca\x0b\x3e\x1e\x3c\xd0\x4c\x1f\x84\xdd\xb4\x53\xaa\xcd\xb2\x6d\xc9\x59\x5b\x72\xf2\x0d\x79\xd1\xc1\x26\x9d\xc0\xeb\xc0\x10\x05\x06\x55\x39\x23\x54\x08\x7e\x5d\xcc\x61\x80\xc4\x6f\xbb\x08\x25\x0b\xcd\x88\x6a\x3f\xb4\xec\xac\x91\xdd\x02\x26\xa6\x85\xc2\xaf\xb5\x60\xd9\x85\xb9\xc9\xe9\x3d\x3e\xb1\xa9\x61\x84\xcc\xb0\x8d\xa4\x61\x6b\xb1\x8b\xb0\xea\x72\xfd\xad\xf9\xc3\xd2\x59\xf1\xfc\x50\x58\x63\x59\x85\xe4\x7e\x4f\xc5\x45\x32\x63\x73\xea\x05\x89\x3a\x67\xbe\xcd\xca\x8a\xb0\xac\xf9\xee\xee\x1d\x59\x0e\xfd\xb8\xc8\xba\xc6\x6f\xbe\x83\xee\x71\xf6\x2f\xf6\x75\xd8\xa0\xfb\x70\x87\x0e\x85\x6e\xae\xbe\xbc\xeb\x6a\x02\x3e\xfd\x17\x15\x98\x48\x41\xb5\x33\x00\x00")
 
 func templateBuilderUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -234,12 +307,32 @@ func templateBuilderUpdateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/update.tmpl", size: 8088, mode: os.FileMode(420), modTime: time.Unix(1570030781, 0)}
+	info := bindataFileInfo{name: "template/builder/update.tmpl", size: 13237, mode: os.FileMode(420), modTime: time.Unix(1792028650, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateClientTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xdf\x6f\xe3\xb8\x11\x7e\xb6\xfe\x8a\xa9\xe0\xbd\x4a\x81\x23\xed\xdd\x5b\x5d\xec\xc3\x21\xd9\x1e\x0c\x14\xc9\xb5\x9b\xa2\xf7\x76\xa0\xa9\x91\xcc\x46\x26\xb5\x24\x95\x38\x70\xfd\xbf\x17\x43\x52\xbf\x6c\xc7\xc9\x2e\x7a\x2f\x89\x45\x52\x33\xdf\xcc\x7c\x1c\x7e\xe2\x7e\x9f\x5f\x45\x37\xaa\x79\xd1\xa2\xda\x58\xf8\xe9\xe3\x8f\x7f\xb9\x6e\x34\x1a\x94\x16\xfe\xc6\x38\xae\x95\x7a\x84\x95\xe4\x19\xfc\x5c\xd7\xe0\x16\x19\xa0\x79\xfd\x84\x45\x16\x3d\x6c\x84\x01\xa3\x5a\xcd\x11\xb8\x2a\x10\x84\x81\x5a\x70\x94\x06\x0b\x68\x65\x81\x1a\xec\x06\xe1\xe7\x86\xf1\x0d\xc2\x4f\xd9\xc7\x6e\x16\x4a\xd5\xca\x22\x12\xd2\xcd\xff\x7d\x75\xf3\xf9\xee\xcb\x67\x28\x45\x8d\x10\xc6\xb4\x52\x16\x0a\xa1\x91\x5b\xa5\x5f\x40\x95\x60\x47\xce\xac\x46\xcc\xa2\xab\xfc\x70\x88\xa2\xfd\x1e\x0a\x2c\x85\x44\x88\x79\x2d\x50\xda\x18\xc2\xf0\xbc\x79\xac\x60\xf9\x09\xd6\xcc\x20\xcc\xb3\x1b\x25\x4b\x51\x65\xbf\x32\xfe\xc8\x2a\xa4\x45\xfb\x3d\x58\xdc\x36\x35\xb3\x08\xf1\x06\x59\x81\x3a\x86\xb9\x7b\x5d\x6c\x1b\xa5\x2d\x24\xd1\x2c\xae\x55\x15\x47\xd1\x2c\x26\x8b\xa7\x46\xf2\xad\xa8\x34\xb3\x18\x47\xb3\xfd\x1e\x34\x93\x15\xc2\xfc\xf7\x05\xcc\x25\xb9\x9e\x67\x77\xaa\x40\x43\x26\x67\xde\x82\x3c\x63\xc2\x8f\x0f\x03\xce\xd6\x35\xa0\x2c\x1c\x96\x59\x5c\x09\xbb\x69\xd7\x19\x57\xdb\xbc\x0c\x65\x11\x92\xb7\x6b\x66\x95\xce\x51\xda\xbc\x10\xac\x46\x6e\x4f\x40\x18\xab\x34\xd9\x74\x50\xbe\x84\x87\x6b\x87\x66\xba\x30\xc4\x4b\xeb\xc2\x3b\xd9\xca\x0d\x99\xb0\xdc\xa3\x0f\xcb\x1c\x44\xb2\x40\x10\xdd\xfc\xe8\x77\x1a\x45\x79\x0e\x37\xae\x16\xc4\x08\x2a\xa7\xaf\x0c\xd8\x0d\xb3\xb0\x51\x75\x61\x80\xd5\x35\xd0\xd0\xba\x15\x75\x81\xda\x64\x91\x7d\x69\xb0\x7b\xcd\x58\xdd\x72\x0b\xfb\x68\xc6\x5d\xb6\xa2\x59\x9e\xc3\x17\xbe\xc1\x2d\x3b\x32\x59\x2a\x0d\x5c\x23\xb3\x42\x56\x0b\xf0\xc5\x10\xb2\x02\x26\x0b\x28\xb4\x6a\x1a\x7a\x30\xee\xcd\x2c\x9a\x05\x13\x57\xa1\x68\x99\x7f\xbe\x58\x3a\x1f\x7e\x9e\x83\xaf\xd2\x1d\xdb\x52\x89\xce\xa0\x10\xd2\xa2\x66\xdc\x79\x7f\x16\x76\xe3\xe6\xa7\x2f\x0d\xc1\xba\xec\x8d\x66\xae\x26\x8f\x3e\x0b\x7d\x56\x0f\x87\xe8\xe0\x92\x7a\x87\xcf\x21\x41\x2e\x64\x34\xc0\x40\xe2\x73\x87\xc2\xe7\xaa\xd5\x58\x0c\x00\x2a\xf1\x84\x12\x54\x63\x85\x92\x26\x8b\xca\x56\xf2\xc1\x4c\xa2\x1a\x6b\x20\xcb\xb2\x7b\x37\x9f\xc2\x55\x30\x4f\x89\xa7\x24\x78\x8b\xfb\x5a\x55\x4b\xa8\x55\x95\xfd\xaa\x85\xb4\xb5\x3c\x44\x33\x9e\x05\x9b\xce\x46\x96\x65\x69\x34\xd3\x68\x5b\x2d\xe1\x07\x6f\x64\x1f\xcd\x42\xf5\x96\xc0\x17\xd1\x2c\x24\x7f\x09\x5d\xf2\xef\xf0\xd9\x0f\x25\x3c\x2b\xb4\x78\x42\x9d\x2e\x4e\x88\x79\xa6\x16\xd3\xd4\x2d\x29\x9c\x33\xd9\x4b\x78\x67\xad\x27\x69\x97\xc6\xfb\xc6\xa5\x04\x25\xe5\x8f\x2b\x29\x91\x53\x28\x60\x95\x4b\x59\xc1\x2c\x73\x3d\xc3\x34\xc8\x45\x29\xb0\x80\xf5\x8b\x9f\x71\x28\x41\x92\x1f\x22\x18\x23\x6b\x7e\xf0\x3a\x2c\xe6\xee\xf5\xae\x51\xd1\xca\x85\x5b\xea\x73\x73\x54\x30\x66\x2d\xb5\xc6\x82\x3c\x0b\x9b\x79\x6c\x04\x85\xd5\xd0\x30\xcd\xb6\x68\x51\x1b\xe0\x4c\xc2\x1a\x81\x15\x05\x16\x9e\xf0\xa1\xd0\x44\xb5\x81\x85\xa1\xba\x14\x5d\xe2\x41\xdd\x39\xf7\x04\xe8\x8b\xc3\xe3\x12\x64\xac\x76\x7b\x25\xd4\x6f\x5c\xfe\x24\xd4\x7f\x01\xa8\xb5\xd2\x29\xf1\xc0\x3c\x0b\xcb\x37\x30\x18\xa4\x41\x6a\x4f\x6f\xb5\x19\x57\x2b\x4e\x79\xdc\xef\xe1\x3f\x4a\xc8\xa1\xb5\xdc\xfa\x76\x65\x20\x5e\x00\xb5\xeb\xa5\xaf\xea\x35\xcc\xed\xb6\xa9\xc9\x4c\x43\x44\x2b\x21\x0e\x8d\x2d\xff\x60\x72\x1f\x64\x4e\x75\x8b\x07\x97\x3d\x25\xae\x61\xd7\x37\x73\x6f\x26\xeb\x5a\x53\xdf\x4a\x67\x05\x96\xac\xad\x2d\xf9\x0b\x64\x95\xa2\x5e\x40\xb9\xb5\xd9\x67\x8a\xb8\x4c\xe2\x56\x9a\xb6\xa1\x2e\x87\x45\x08\x7a\x09\x1f\xbe\xc6\x8b\x51\x06\xd2\x81\x4a\x0f\xbb\xa3\xca\x5a\xcd\xa4\x61\x3c\x14\x71\x52\x98\x84\x77\xfb\x2b\x85\x87\x5d\xc2\xed\x8e\x0a\x69\x71\x67\xe9\x4c\xa0\xff\x54\x81\x87\xdd\x38\xfb\xa2\x84\xdf\x17\xa0\x1e\xdd\x66\x0c\xbb\x24\x4b\xae\xec\xee\xd6\x6f\x98\xbf\xd2\xdc\xfe\x42\x38\xdd\x39\x48\x1b\x85\x33\x29\x15\x35\x57\xa6\x2d\xb0\x31\x54\xd7\x2f\x84\x9c\x0e\xc6\x2e\xce\x99\xf5\x80\x08\x81\xc4\x67\x0f\x7c\xd1\x83\x49\x1d\x46\x9a\xff\xd3\x27\xf2\xfe\x6e\x30\x0e\x85\xeb\xd4\x63\x9f\x4b\xf8\xf0\x14\x3b\x7f\xde\x39\x2f\xab\x51\x1f\xea\xea\x41\x00\x5c\x4f\xe2\x59\xad\xaa\x05\x14\xb8\x6e\xdd\x93\xfb\x71\x18\x3a\xd1\xc3\x6e\xd2\x85\xca\xea\xff\xda\x60\xca\xea\xb4\xc5\x2c\x28\xea\x40\x8e\x5b\x42\x73\xc4\x0f\x87\xf0\x3a\xf0\x02\x56\xf6\xcf\x06\x5a\xe3\x3b\x40\x85\x16\x9e\x50\xaf\x95\x41\x8a\xae\xa2\xe4\x28\x09\x7d\x63\x51\x0d\xd2\xe1\xe6\xfa\x78\x9e\x47\x79\x3e\x0b\x66\x9c\x9f\x24\xa5\x51\x87\x26\x11\xb2\xc0\x5d\x1f\xd4\xc7\xb4\x03\xee\x57\xfc\xa3\x45\xfd\xd2\x2d\xbf\x51\x2d\x85\x62\x77\x29\xd9\x3c\xe1\x69\x30\x3d\x3e\x18\x44\xd9\x25\x7a\x5c\x6b\x7e\xa1\x5c\x61\x13\x07\x9c\x1d\x73\x16\xbe\x7a\xe9\xd9\x52\x5a\xdd\xe2\xe1\xe2\x89\xe2\x6b\x79\xe1\x4c\x29\xab\x3f\xe4\x54\x39\x57\xf4\x50\xef\x9b\x9a\x4a\xc7\xe9\xaf\x99\x9e\x24\xa3\x43\x86\x0e\x83\x46\xe3\x13\x4a\x6b\x1c\x23\xbe\xb6\xa8\x05\x1a\x28\xb5\xda\xf6\xbb\xe2\x4c\xcb\x70\xd6\x93\xd4\x37\x07\x4a\x7d\x97\xf9\xae\x2d\x84\x05\x91\x57\xbf\x97\xa2\xa5\xc0\xc2\x11\xd4\xf5\xda\x3e\xd2\xf8\x66\x50\xd1\x41\xf5\x84\xa5\x5e\xf5\xb0\xb1\xe6\x39\x95\x38\x9d\xd4\x72\x6a\x6e\xfa\xf2\x89\xa8\x0b\x32\x5d\xa3\xd3\x1a\x73\x99\xfd\x13\x39\xba\x13\xf6\x70\xd8\xef\x81\xfa\xca\x57\x3f\x1d\xf3\xd8\x8f\xb9\xa7\xe1\x78\xf8\x90\xfd\x64\xe2\xde\xfd\x7f\xa1\x56\xcf\xdd\xdb\x9d\x7a\xf6\xb2\x69\x8a\x64\xd8\x92\x17\x63\x71\x15\x19\x44\x94\x47\x3d\x68\xa8\x89\xcd\x84\x87\xf9\xd4\x6b\xb9\xc1\xd9\x50\xa9\x1f\x26\x13\xfb\x9e\xca\x3d\x7f\x9c\xa8\x1b\xa3\xf3\x03\x41\x36\x3a\x94\x13\x84\x23\x96\x4c\x4c\xa7\xc1\x54\x92\x1e\x0b\x4b\x6f\xf0\x08\xd2\xd1\xf4\x00\x2c\xf3\xbf\x3a\x7c\xff\x6a\x8a\x09\x3e\x09\xad\x1f\xf9\x0e\x80\xde\xd6\x09\xc0\xe0\xe2\x35\x80\x7e\xfa\x0d\x80\xf7\xf2\x2d\x8c\x43\x4d\x51\x5a\x61\x5f\xde\x82\x79\x2f\x31\xe9\xc8\x77\x22\xd6\xcf\x87\x40\x20\xc6\x7b\xb4\x1f\x5d\xdd\x8e\x4c\x65\xab\xdb\xf4\x18\xfb\xea\xf6\xdd\xe8\x45\xf1\x0e\xe4\xab\xdb\x44\x14\xa1\x2c\xab\xdb\xec\x81\x36\xe6\xfb\x50\x9f\xcb\xfd\xbd\x3c\x4d\xff\x02\x44\xb1\x04\x51\x1c\xfa\x73\xaf\xc6\x09\x8f\x0b\x3f\xf0\x1d\x34\xf1\xa6\x4e\x68\x12\x3c\xbc\x06\xd5\x4f\xbf\x4a\x13\x3f\x3d\xa1\xc9\x39\x88\xef\x67\x49\x6f\xf0\xfd\x2c\x19\x30\x8c\x59\xd2\x8f\xbe\xc6\x92\xd1\x82\xf7\x82\xbf\x44\x92\xb1\xbf\x77\x90\xe4\x1c\xe8\x73\x99\x77\x24\xc9\xba\xda\x65\xff\xde\xa0\xf6\xa9\x19\xdf\x72\x64\xce\x67\x9a\xbe\xda\xfd\xe8\x60\x7c\xf9\x1e\xd2\x04\x81\x73\x04\xde\x8d\xbe\x0a\xdc\xcd\xbe\xca\x98\x5f\xd0\x8e\x80\x4d\x4f\x09\x4f\x0e\xfa\x44\x14\xd6\x5c\xcc\xf6\x2f\x68\xcf\xa9\x7e\xda\x3e\x67\x52\x9f\x4c\xe1\x8f\xbf\x0a\x7a\xbe\x74\x52\xee\x72\x86\xb3\x7b\x59\xbf\x78\x8d\xd7\x87\xf3\x9b\xbf\xac\x7b\x44\x7a\x58\xc0\xba\xb5\xd0\x30\x29\xb8\xa1\x63\x97\xc9\xa0\x32\x14\xe7\xad\x36\x17\x23\xfa\xed\x1b\x42\x9a\x46\xe4\x3f\x24\x3b\x92\xf7\x1f\x19\x3c\x0b\x79\x22\x23\x67\x3f\x2f\x1c\xd0\xa4\xff\x46\x08\xd9\x18\x4c\x9d\x2a\x20\x0c\x02\xe3\x73\x51\xf9\xdb\x38\x5a\xdc\x31\xab\x97\x40\x49\xc3\x0c\x67\x35\xcc\xd1\x41\x76\x38\x53\x88\x5d\x92\x3b\x3d\xe4\x79\xb2\x87\x61\x69\x17\x4d\xa7\xe3\x3a\x1d\x31\xcc\x60\x51\x21\xa8\xf2\x98\x39\x6f\x10\xf8\x9c\x93\x37\xfb\x4b\x17\x93\xcf\xae\xdf\x41\xcb\x4f\x9e\xeb\xa3\xa8\x2e\x10\x7e\xe6\x95\x57\x65\x21\xa9\x51\x0e\xdf\xf3\x29\xfc\x18\x94\x72\xb8\x11\xe8\x75\x67\xf8\x9a\x4f\x1c\x35\xff\xb0\xab\x01\x77\xa3\x85\x3b\x4b\xba\x6e\x2e\x21\xee\xb4\x62\x1c\x14\x22\x95\x36\xa6\x4a\x07\x39\xef\xa8\x75\xe1\x3a\xc1\xe5\x26\x27\x89\x37\xba\x4d\xe8\x5f\x7d\xf5\x36\x61\xaa\xfc\x27\x97\x0b\xb3\xee\xb2\xa1\x36\x38\x9a\xfe\x56\xe0\xdf\x80\xbb\xff\xd0\xeb\x12\xfb\x31\x85\x37\xef\x43\x8e\xee\x6d\x07\xfc\x61\x1f\x39\x07\x91\xdb\x22\x9d\x86\x1e\x7e\x8e\x06\xff\x17\x00\x00\xff\xff\x94\x36\x8e\x5d\x3d\x18\x00\x00")
+var _templateBuilderUpsertTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\x5d\x6f\xdb\x3a\x12\x7d\x96\x7e\xc5\x5c\xc3\x37\x6b\x07\x0a\xdd\xde\xb7\xf5\x22\x0f\x77\xf3\x81\x06\xe8\x26\x0b\xa4\x5d\xf4\xa1\x40\xc1\x48\x23\x9b\x1b\x9a\xd4\x92\x94\x93\x40\xd0\x7f\x5f\x0c\x45\xca\x92\x63\x37\xe9\xee\x4b\xeb\x88\xc3\x33\x33\xe7\xcc\x0c\xc9\xa6\x59\x9c\xa6\x17\xba\x7a\x31\x62\xb5\x76\xf0\xc7\x87\x8f\x7f\x3d\xab\x0c\x5a\x54\x0e\xae\x79\x8e\x0f\x5a\x3f\xc2\x8d\xca\x19\xfc\x29\x25\x78\x23\x0b\xb4\x6e\xb6\x58\xb0\xf4\xcb\x5a\x58\xb0\xba\x36\x39\x42\xae\x0b\x04\x61\x41\x8a\x1c\x95\xc5\x02\x6a\x55\xa0\x01\xb7\x46\xf8\xb3\xe2\xf9\x1a\xe1\x0f\xf6\x21\xae\x42\xa9\x6b\x55\xa4\x42\xf9\xf5\xcf\x37\x17\x57\xb7\xf7\x57\x50\x0a\x89\x10\xbe\x19\xad\x1d\x14\xc2\x60\xee\xb4\x79\x01\x5d\x82\x1b\x38\x73\x06\x91\xa5\xa7\x8b\xb6\x4d\x53\xca\x01\xea\xca\xa2\x71\xf0\x50\x0b\x49\x5e\x4b\x6d\xc0\xbd\x54\x68\xe1\x49\xb8\x35\xd4\x4a\xfc\xa7\x46\x28\x05\xca\xc2\x32\x10\xee\x2f\x16\x56\xa8\xd0\x70\x87\x45\xf4\x98\x1b\xe4\x8e\x8c\x24\x32\xf0\xd0\x4d\x03\x05\x96\x42\x21\x4c\x3a\xfc\x09\x74\x5f\xa7\xd5\xe3\x0a\x96\xe7\xf0\xc0\x2d\xc2\x94\x5d\x68\x55\x8a\x15\xfb\x27\xcf\x1f\xf9\x0a\xa3\x4d\x8c\x65\x79\x0e\x95\x11\xca\xc1\xac\xe2\x36\xe7\x12\xa6\xec\x96\x6f\x70\x0e\x93\xaf\x63\x50\x83\x39\x8a\x6d\xb7\xa3\xff\xdd\xc3\x50\xaa\x8b\x05\x0c\x91\xdb\x96\x08\xa7\xd8\xe3\x17\xca\xdb\xe7\x21\xd4\x0a\x38\x19\x8f\x7c\x42\xdb\x02\x2a\x27\xdc\x4b\x06\xda\x40\x5d\x15\x9d\xa5\x5b\x63\xba\x58\x04\x7e\x88\x6b\xae\x00\x9f\x85\xf5\x8b\x5a\x21\x88\x12\x84\x83\x35\xef\xbc\x59\x82\xda\x72\x59\xf7\x6a\x05\x82\x1f\xf1\xa5\x03\x21\x8c\x41\x5c\x2c\x25\x31\xf6\x63\xb7\xce\xd4\xb9\x83\x26\x4d\x72\x4f\x60\x9a\xd0\x7e\xeb\x8c\x50\xab\x34\x69\x1a\x30\x5c\xad\x10\xa6\x3f\x32\x98\x96\x44\xca\x94\x5d\x13\xb8\x25\xc2\x92\xa4\x69\xce\x60\x5a\xb2\x7b\x8f\xe2\x17\x08\xf4\x94\x9c\x94\xec\x0b\xf9\x23\xb3\xa6\x01\x54\x05\x9c\xb5\x6d\xea\x6b\xe5\xe7\xa0\xb4\xb9\x1a\xf3\xdf\x61\x91\x1b\x22\x23\x1a\x95\xb5\xca\x77\xca\x4e\xee\xd1\x4d\x76\xfa\x96\x41\x60\x32\x0e\x92\x79\xfb\xb6\x05\x8b\xae\xe3\xd0\x83\xf4\xa2\x78\xd2\x58\x9a\x78\xb3\xd9\xa8\x18\x62\x4e\x3b\xe2\xe6\x43\x44\x6f\x5c\x51\xe6\xa3\xc4\xe7\xfb\x9b\x88\xe6\x64\x0f\x98\x35\xcd\x01\x06\xcf\xe1\x24\x62\xa6\x49\x62\xd0\xd5\x46\xc1\xde\xce\x34\x69\x53\x4f\x84\xa0\x5a\x29\x60\xa6\xb4\xeb\xa9\xba\x15\x52\xf2\x07\x89\x73\x98\x69\x43\x5f\xef\x2a\x27\xb4\xea\x98\xb9\xc4\x92\xd7\xd2\xcd\xa3\x86\x30\x55\xc1\xfc\xfa\x15\xa5\x11\xe8\x08\xb5\x91\xdb\x11\xc0\x1b\x1c\x53\x25\xd3\xd2\x4a\x6c\x51\xc5\x1a\xb6\x40\xe1\x2b\x21\x59\x9a\xfc\x8a\x04\x7b\x8e\x77\x52\x9c\xbe\x43\x8b\x44\x94\xd0\x6f\xf8\xed\x1c\x94\x90\x5e\xa3\x23\x2a\x05\x17\xa7\x71\xcb\x9c\x4c\x89\x84\xa3\x0a\x25\xbb\xea\xef\x26\x52\xf8\x45\x9d\x7e\xcf\xb7\x71\xe0\xed\xa8\xea\x99\x0a\x4d\x5d\x70\xc7\x69\xc2\xed\x66\x45\x30\xee\x46\x08\xb8\x35\xf7\x33\x81\x00\x0f\x8f\x85\x7e\x1e\x30\xb8\xd9\x6c\x6a\x47\x64\xc5\x29\xc3\x0d\x92\x52\xa0\x95\x7c\x01\xad\xc2\xd8\xd2\x8a\xa5\xef\x54\x80\x72\x98\xe5\xee\x19\x72\xad\x1c\x3e\x3b\x1a\xc3\xf4\x7f\x06\xba\x72\x16\x18\x63\xa8\x1c\xbb\xe0\x52\x76\xf5\x37\x87\xd9\xe9\x30\xcd\x0c\xd0\x18\x6d\xe6\xc4\xba\xf6\x16\x96\x3a\x9a\x36\xdd\xe2\xd3\x6e\x9f\x9d\x11\x1e\x63\x6c\x4e\x7c\x9e\xbd\x63\x2a\xf9\x33\x87\xf4\x37\x5c\xd9\x52\x9b\x0d\x9a\xb0\x1a\x45\x7f\xb3\x09\x47\x05\xb1\xa5\xb8\xc8\x6c\x70\xc4\x84\x6d\x03\x17\x7d\x79\xbc\x05\x3e\x3f\x5e\x65\xfb\x61\x9c\xc3\xc9\x36\x16\x1a\xe5\x1e\x4a\x68\x90\x66\xd7\xe1\xff\xe2\x52\x14\xdc\x69\x63\xe9\xaf\x1b\x7b\xa5\xea\xcd\xff\x93\xb1\x28\x49\x9c\xe3\x69\xf7\xfe\xde\x9f\xf4\xdf\x3c\xe2\xc8\x4b\x6c\x1d\x25\x64\x06\xe5\xc6\xb1\x2b\x2a\x88\x72\x36\x89\x47\x7c\xdb\x2e\x61\xdb\xbb\x2a\xb9\x90\x58\xf8\x33\xd6\xd7\x30\x7c\x9f\x8c\x26\xcc\xf7\xc9\x12\x7e\xdf\x4e\x7c\x5d\x75\x1c\xb7\x87\xb8\xdb\xff\x2d\x4a\x98\xb2\x4f\xdc\xde\xe7\x6b\xdc\xf0\x01\x93\x3d\xd1\x8b\x53\xdf\x76\xbb\xe3\x79\x78\xe4\x53\x1f\x85\x18\xb1\x00\x6e\x81\xf7\xad\x94\x81\x15\x2a\x47\xe0\x52\x86\x5d\x9b\xbe\xed\x44\x39\x6c\xe5\x42\x63\x37\x04\xfd\xa9\x1f\xae\x3f\x49\x42\x2d\x4b\x2d\x40\x42\x9c\x1c\xb8\x4c\xfc\x23\xac\x37\xba\x5a\x12\x14\xbb\xab\x2e\xc8\x37\x66\xfb\xba\x45\xcb\x25\x9c\x1c\x59\xf1\xc2\xbf\xdd\x5f\x49\x72\x48\xdd\xe5\xbb\x6a\x2c\x4b\x93\xb1\x14\x89\xff\x87\x04\xfd\x91\x41\xa9\xc8\x5b\xe7\x7d\x2f\xc6\x61\x7d\x37\xe9\xa8\x3e\x4b\x35\x8b\x2c\x1d\x2a\xb1\xff\xb9\xc2\x9a\x06\xa4\x7e\x42\xb3\x23\x7b\xaf\xb8\xfa\x09\xbf\x4b\x47\x94\x10\x06\x59\x0c\x58\x68\x75\x47\xf3\xb5\xd9\x9d\xe4\xbe\xd8\x95\x90\x69\xdc\xed\xeb\xef\x1a\xb9\xab\x0d\x5e\x29\x1a\xd1\x05\x4c\x9e\xb8\xcb\xd7\xfe\xba\x9b\x24\xdb\x6c\xd8\x8c\x43\x92\x6d\x18\xc1\xf3\xb4\xa7\x64\x98\xfd\xd0\x23\x1a\xd3\x05\x4c\xdc\xfd\x16\xc3\xbc\x7f\x14\xd5\x27\xad\x1f\x03\xad\xfb\xf8\x0f\xb5\x65\x55\xfd\x20\x85\x5d\xcf\x4e\xae\xb6\xa8\x5c\x73\xd7\x57\xda\x57\x7f\x22\xdd\x29\xcc\x80\xae\x1d\xcb\x57\xa2\x7d\xe6\x0f\x28\x33\xb8\xb9\x5c\xc2\x96\xdd\x5c\x66\x70\xab\x0b\x5c\xc2\x36\x83\xdb\x25\x7c\x6c\xe7\x21\x9e\x10\xe5\x36\xa3\x40\xe9\x92\xb8\x58\x00\x25\x16\xde\x14\xc7\xcf\x47\xeb\xb4\xa1\xea\x0e\x0d\x99\x4b\x41\xcf\xa6\x42\x70\x89\xb9\x7b\xf7\x31\x66\x8f\x1c\x63\x3f\x3b\xae\x0e\x8c\x90\x95\x83\x99\x44\x05\x53\x76\xdf\x85\x35\x87\x8f\x9d\x7c\xf6\x49\xb8\x7c\xfd\x4a\xbb\xc2\x50\x48\xec\xb2\x0b\x77\x36\x0f\x17\xc4\x51\xff\xc5\x0c\x97\xe7\x3b\xdc\x0e\x34\xa7\x97\x4f\xd3\xc0\xbf\xb5\x50\xbd\x5d\x04\xb3\x30\xc9\x80\x8a\x67\x79\xfc\x7e\xe2\x1b\x34\xe2\xb7\x6d\x3c\xcc\xe7\x69\x32\xca\x2e\x29\xba\xfb\xe2\xf2\x40\x3d\x69\x63\xd9\x2d\x3e\x8d\x9b\xa9\x56\xb6\xae\x2a\x6d\xe8\x61\x17\xa4\x98\x04\xa5\x3d\xae\xb4\x21\x83\xe3\x61\x09\x55\xe0\xf3\x20\xe1\x0f\xe3\xf8\x06\xe1\xed\x2e\x53\xdf\x20\xe7\x52\x5a\xff\xdb\xdf\x88\x2b\xae\x44\x6e\xe9\xba\xe9\x3f\x75\xde\xac\x7f\x58\x51\xe4\xbf\x74\xcb\xf9\xf6\x6b\xd7\x9c\x51\xd9\x90\xac\xc7\x1b\x38\xa6\xd5\x81\x75\x77\x9c\xd7\x8d\xec\x73\x99\x75\xa7\x5a\x9b\x46\x19\xb6\xd4\x2b\xef\x2d\x98\xa6\xe9\x5e\xe2\xf8\xec\x88\xbb\x29\x4c\xfe\xde\x25\x39\x19\xa6\x1b\x5e\x04\x6e\x53\xc9\xfe\x25\x50\xc2\x24\xe8\xb8\xf8\xdd\x2e\xe2\x3b\xbc\xf7\x14\x37\x3d\x3b\xdc\x54\x92\x1e\xf0\xdd\x76\x16\xdd\xbe\xba\xff\x36\x0d\xa0\x2a\xa0\x6d\xd3\xff\x0e\x00\x27\xd2\x3e\x79\xfe\x10\x00\x00")
+
+func templateBuilderUpsertTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateBuilderUpsertTmpl,
+		"template/builder/upsert.tmpl",
+	)
+}
+
+func templateBuilderUpsertTmpl() (*asset, error) {
+	bytes, err := templateBuilderUpsertTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/builder/upsert.tmpl", size: 4350, mode: os.FileMode(420), modTime: time.Unix(1792004119, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateClientTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x1c\x59\x6f\xe3\xc6\xf9\xd9\xfa\x15\x13\xc1\x71\x49\x43\xa6\x37\x79\xab\x17\x7e\x48\xf6\x68\xdd\xa6\xeb\x4d\xd6\x39\x80\x20\x08\x68\x72\x24\xb1\xa6\x48\x2d\x87\xb2\x2d\xb8\xfe\xef\xfd\xae\x21\x67\x48\x8a\x3e\x76\x83\x20\x40\xbb\x16\xe7\xf8\xe6\xbb\xaf\x99\xf6\xee\xee\xf8\x70\xf2\xaa\x5c\x6f\xab\x6c\xb1\xac\xd5\xd7\x2f\xbe\xfa\xfb\xd1\xba\xd2\x46\x17\xb5\x7a\x1b\x27\xfa\xb2\x2c\xaf\xd4\x59\x91\x44\xea\x9b\x3c\x57\xb4\xc8\x28\x9c\xaf\xae\x75\x1a\x4d\x2e\x96\x99\x51\xa6\xdc\x54\x89\x56\x49\x99\x6a\x05\x9f\x79\x96\xe8\xc2\xe8\x54\x6d\x8a\x54\x57\xaa\x5e\x6a\xf5\xcd\x3a\x4e\xe0\xcf\xd7\xd1\x0b\x3b\xab\xe6\x25\x4c\x4f\xb2\x82\xe6\xbf\x3b\x7b\xf5\xe6\xdd\x87\x37\x6a\x9e\xe5\x00\x82\xc7\xaa\xb2\xac\x55\x9a\x55\x3a\xa9\xcb\x6a\xab\xca\x39\x8c\xb6\x87\xd5\x95\xd6\xd1\xe4\xf0\xf8\xfe\x7e\x32\xb9\xbb\x53\xa9\x9e\x67\x85\x56\xd3\x24\xcf\x00\xf3\xa9\x92\xe1\xfd\xf5\xd5\x42\x9d\x9c\xaa\xcb\x18\x4e\xdc\x8f\x5e\x95\xc5\x3c\x5b\x44\xef\xe3\xe4\x2a\x5e\x68\x5c\x04\x6b\x6a\xbd\x5a\xe7\x71\x0d\x9b\x97\x3a\x06\x84\xa7\x6a\x9f\xb6\x67\xab\x75\x59\xd5\x2a\x98\xec\x4d\xf3\x72\x31\x9d\xc0\x5f\x84\xd8\x07\x72\xbc\xca\x16\x15\x00\x98\x4e\xf6\x60\x41\x15\x17\x30\xba\xff\xfb\x4c\xed\x17\x78\xf4\x7e\xf4\x0e\xf8\x62\x10\xe4\x1e\x43\x28\x06\x40\xf0\x78\x3b\x40\xb0\x8e\x94\x2e\x52\xc2\x65\x6f\xba\xc8\xea\xe5\xe6\x32\x4a\xca\xd5\xf1\x5c\xc4\x92\x15\xc9\xe6\x32\x06\xe6\x1c\x03\xc9\xc7\x69\x16\xe7\xc0\xaa\x1e\x12\x06\x16\x20\x4c\x42\xe5\x83\x7c\x1c\x11\x36\xfe\x42\xa1\x17\xd7\xc9\x9e\xe8\x8c\x86\x8c\x2c\x67\xec\x65\x19\xa1\x88\x10\x10\x45\x9a\x77\x7e\x87\x93\xc9\xf1\xb1\x7a\x45\xb2\x40\x8d\x40\x71\xb2\x64\xe0\x67\x5c\xab\x65\x99\xa7\x46\xc5\xa0\x50\x38\x74\xb9\xc9\x72\xe0\xbb\x89\x26\xf5\x76\xad\xed\x36\x53\x57\x9b\xa4\x56\x77\x93\xbd\x84\xb8\x35\xd9\x03\x90\x1f\x40\x8b\x56\x71\x07\xe4\xbc\xac\x54\x52\xe9\xb8\xce\x8a\xc5\x4c\xb1\x30\xe0\xa7\x8a\x01\x9b\xb4\x2a\xd7\x6b\xfc\x30\xb4\x33\x9a\xec\x09\x88\x43\x11\x5a\xc4\xdf\xa3\xa2\x63\xf2\xe1\x78\x96\xd2\xbb\x78\x85\x22\x1a\xc0\x22\x2b\x6a\x5d\xc5\x09\x9d\x7e\x03\x02\xa3\x79\x7f\x53\x4b\x2c\x71\xcf\x99\x39\xf4\x3e\x99\x0b\x0d\x57\x01\x83\x7b\x62\xea\x3b\x7d\x23\x0c\x22\x92\x01\xbb\x58\x15\xfa\xc6\x62\xc1\xbc\xda\x54\x60\x7d\x0d\x02\x8b\xec\x5a\x17\xaa\x5c\xd7\x59\x59\xc0\xb9\xf3\x4d\x91\xb4\x60\x02\x18\x37\x2a\x8a\xa2\x73\x9a\x0f\xd5\xa1\x80\x47\xc6\x23\x13\x18\xe2\x1d\x98\xc0\x89\x82\x7f\xa2\xf7\x15\x50\x99\x17\x33\x26\xd6\x9c\xa8\x03\xfe\x71\x77\x0f\xa8\x66\x73\x60\xda\x5b\xc0\x0b\x30\x78\x53\xc4\x97\x39\xe0\x31\xbd\x89\xeb\x64\x89\x26\x39\x03\xea\x71\x03\xfc\x4b\xab\x99\x30\xe0\x6d\x12\x09\x76\x84\x0d\x20\x13\x4e\xf6\x2a\x0d\x40\x0a\x75\xc0\xe8\x00\x36\xa2\x07\x27\x2a\x99\xc1\x07\x8b\xed\x44\x59\x31\x02\x41\x3c\x14\x24\x51\x5a\x01\xc5\x55\x38\xeb\xa9\xf8\x80\x54\x7d\x21\x9c\x20\x63\x06\xe4\x10\x24\x16\x5a\xa3\xee\x56\x20\xe7\x6b\x62\x2e\xf8\x34\x90\x04\xa0\x58\x80\x11\x02\x29\xaa\x2e\x89\xf9\x69\x5c\xc7\xe4\x7d\xcc\x5a\x27\xd9\x3c\x03\x86\x5c\x6e\x79\x86\xb0\x54\x05\x9e\x83\xaa\x1a\x23\x34\x1e\x3c\x92\xc5\x09\x6d\xb7\x2e\x0f\x57\xce\x68\x29\xf3\xa6\x23\xfa\xb8\xae\xd1\xc9\xa6\x78\x72\x56\x47\x8c\x1b\xa2\x12\xe7\x6a\x1d\x57\xb0\x19\xc5\xa4\x92\xb8\x50\x97\x70\x62\x9a\xc2\x52\x32\x1d\x51\x19\x54\xda\x56\x9f\x45\x4f\x90\xba\x80\x91\x7a\x47\xc7\x23\x42\x1f\x08\x1f\x62\x10\x58\x29\x59\x9d\xc8\xcf\x55\xa4\x40\x34\x69\xa6\x74\x55\x95\x55\x88\x1a\x65\x40\x29\x93\xa5\x6a\x01\xe2\x20\x3a\xba\x87\x1c\x16\xc9\x2a\x41\x3e\x82\x0c\xfe\x5b\x42\x88\x68\x9c\xd4\x6b\x76\x7c\x46\x4d\x67\x0a\xb5\xec\x84\xa5\x7a\xa4\xf6\x6b\x70\xec\x08\x66\x8d\x2a\x3b\x57\x53\x71\x91\xc7\x5f\x9a\x63\x26\xf2\x18\xe5\x36\x6d\x8f\x6c\x54\xe2\x48\xdd\x36\x61\x81\xc1\x44\xd6\xc9\x35\x4e\x79\x0f\x62\x4e\xbc\xc9\x6b\x3c\x4f\x94\xb5\xc8\xf2\x99\x9a\xaf\xea\xe8\x0d\x52\x3c\x0f\xa6\x9b\xc2\x6c\xd6\xe8\x2f\x75\x2a\x44\x9f\xa8\x2f\x3f\x02\xa2\x2d\x07\xc2\x56\x95\xde\xa3\x08\x60\x18\xd5\xc4\xb0\xa7\x24\x81\xec\x56\x2a\x8c\x87\x75\x06\x7e\x34\xce\x01\x9e\xc8\x2c\x48\xac\x11\x87\x04\x32\x48\xea\x5b\x04\x52\xeb\xdb\x1a\x43\x0f\xfe\x0d\x59\x28\x8e\x4c\xac\xd9\x58\x7e\x06\xe1\x9f\x2c\x1b\x74\xdb\x9f\x51\x36\xae\x58\x6c\x66\x80\x06\xef\x89\x88\x91\x10\x19\xf5\x39\xe2\xc8\xea\x07\xc8\x15\xb6\x60\x88\x1c\x20\x6f\x96\x1a\xe4\x52\xb9\xf1\x20\xc3\x34\x09\xd7\xa0\x8d\x61\xba\x84\xc2\xad\xf4\xc7\x8d\x36\xe0\xe2\xd4\x19\xf8\xea\xa5\x4e\xae\x5a\x39\x93\xf9\x3b\x82\x85\xdd\xc9\x12\x5d\x28\xdb\x3c\x2d\xcb\xe0\x2c\x8e\x64\xea\x26\x36\xd6\xf9\x35\x2e\xc5\xa0\x45\x01\xc6\x06\x75\x85\x12\x26\x82\xba\xd0\x85\xe6\x75\x98\xa2\x0d\x68\x09\x11\xf3\x80\x9a\x80\x6b\x87\xdf\x14\x11\x22\xab\x55\xe1\x4b\x1a\xfb\xe2\x14\x35\x1f\x17\x3d\xc4\x6c\x0a\xc5\x96\x48\x60\xf3\xf5\x94\xbc\x03\xf1\xd5\xee\x4d\xa2\x57\xc8\x18\xeb\xcd\xe1\x14\x61\xb9\x33\xdc\xe5\x9d\xe3\x66\x3f\x91\x3b\x2a\x30\x5a\x37\x51\xe5\x9f\xb1\x59\x86\x1d\x9f\x5b\xa8\x43\x20\x8d\xf1\xf8\x49\xa0\x01\x73\xb2\x9a\x0e\x2d\x4a\x76\xbd\x3f\x2f\x31\x95\xa5\x63\x9b\x9c\x05\x2c\x03\x05\xa8\x6f\xd7\x00\x10\xce\x33\x35\xea\xb0\x77\xe0\x7f\x00\x85\x37\x34\x0f\xc7\x5e\x96\x12\xc1\xed\x0e\x04\x6c\x09\x60\x9d\xd0\x60\x40\xfa\x3a\x2b\x37\x46\x95\x90\xff\xc6\x15\xfc\x37\x49\xf4\x1a\xc8\x89\x54\x63\x7d\x98\x0a\x94\x9b\xda\xe6\x46\xb0\x5b\x74\x9e\x36\x00\xca\x08\x98\x58\x8a\x39\x7d\x4f\x3d\x3a\xf2\xf8\x0b\xfa\x12\xa2\xed\x71\xce\x64\xff\x4f\x70\x26\x18\x86\x9e\x99\xcf\x89\x72\xc2\xc1\x56\x9f\x45\xdf\x38\x8e\x24\x2c\x6e\xe0\x23\x31\x55\x94\xd4\x81\xba\x31\x36\xee\xff\x84\x1b\xb6\x62\x5f\x0c\x5d\x74\x01\xd1\xeb\xe5\x89\x43\xe1\x9d\x12\x46\x3f\xb5\xe4\x64\x0e\xec\x23\x89\x08\xa3\xad\x3a\xed\x79\x8b\x64\x86\x23\xe4\x03\x44\x81\x1a\x4f\xe3\xa9\x9e\xa8\xdd\xb7\x50\x25\x2d\x2a\x2c\x1f\x81\x89\x2f\xe9\x5c\xa4\x0d\xf7\x30\xec\x13\x19\x39\x33\x9e\x95\x06\xe8\x69\xd4\xc1\x81\xfa\xe2\xd0\x22\x83\x22\x4d\x22\x48\x6b\x03\xf6\x42\x8e\xa4\xe1\xec\xbc\x34\x3a\x08\x3b\xe1\x1d\x16\x7a\xde\x8a\x71\x67\x39\x5e\xdc\x76\x52\xb3\x1a\xf4\xdd\xc4\x89\x64\x61\x5e\x66\xe5\x1a\xd8\xc5\xed\xb0\x5d\x05\x87\x17\xb7\x2e\x7f\x81\x8d\x60\x39\x50\x90\x13\x6f\x44\xa1\x82\xc3\xfa\xf6\x35\x67\xbc\x2f\x71\xee\x6e\x24\x1f\x71\x75\x15\x12\x41\xb0\x7c\x74\x42\xe8\x07\x5c\x54\x49\xd5\x40\x65\xbc\xc1\x29\x3b\xe9\x9a\x11\x42\x0c\x80\x40\x46\xbc\xd5\xee\xb0\x89\x13\xfd\x98\x30\x8a\x0c\x61\x41\x45\x9b\x7b\x66\x37\x42\x24\xf3\x85\x53\x92\xd8\x84\x0a\x11\xa0\xf2\x84\x24\x09\xb9\x95\xbe\xdc\xd0\x17\xfd\x98\x29\x93\x97\x37\xf8\x89\x7f\xdb\xb2\x25\x89\xf8\x57\x94\xe4\xe0\x39\x83\xf0\xb1\xd5\x4b\x12\xc1\x9f\xa8\xbe\xa5\x1d\x4d\x05\x83\xee\x62\x70\x7b\xba\x89\xf3\x9b\x2a\xab\xf5\x94\x7d\x1b\x59\x82\x59\xc6\x69\x79\xe3\xf2\x08\x49\x93\x61\xe0\x26\xfc\xe6\xcc\x82\x7f\xaf\x32\xf2\xaf\xa7\xcd\x4e\x64\xb8\x5d\x70\xc0\xb3\x77\x08\xbc\xe3\xb7\x6c\x09\x75\x71\xeb\x95\x4f\xf3\xc5\x67\xad\x8c\xe6\x8b\x7e\x6d\xe4\x1a\xc5\x6b\x94\x42\xc7\x2e\x48\x32\x47\x62\x0f\x90\x0b\xfd\xcd\x80\x23\xe2\xd2\x65\xa1\x6b\xf4\x5d\x97\x60\x7b\x28\xd5\x05\x2a\x05\x06\x2c\x5b\x11\x81\x33\xe2\x18\x66\x30\xce\xc2\x7f\xf6\x04\x0c\x9d\x13\x84\x38\x4a\xd8\x04\x19\x84\xcc\xdb\x86\xa8\x17\xa1\x45\x9c\x57\x7c\xbf\xd1\xd5\xd6\x2e\x7f\x05\xde\xa4\xe6\x5c\x03\x60\xf6\xec\x53\x40\xbb\xb5\x31\xc9\x91\xc8\xf0\x3c\x99\xa7\xa6\x91\xed\x5c\xa0\x10\xa5\xdc\x3b\xb5\x71\x41\xf0\xb5\x96\x33\x63\xed\x0d\x65\x31\x01\x3e\x05\x5b\xd8\xe8\xd1\x52\x98\x65\x39\x52\x0c\x37\x27\x87\x7f\xb8\xd0\x45\xde\x3f\x63\x9c\x6a\xc5\x0d\x1a\x9b\x83\xe1\x81\xd1\xae\xa5\x89\xa7\x9f\x10\xdc\xd0\x0b\xa5\x69\x86\x5f\x08\x5b\xea\x1f\x5b\x6d\x7a\xe0\x22\x75\x81\x53\x55\x06\x2a\xd3\x38\x5b\x4c\xbb\xd0\xcb\xad\xca\x94\x8a\x6f\x9b\x4b\xeb\x4a\x43\x5e\x0e\xa9\x75\x86\xba\x67\xe2\xb9\x16\xf0\x09\x76\xa5\x88\x04\xc0\x2e\xd9\x54\x15\x00\xc9\xb7\xa8\x81\x44\x0a\xe2\x2a\x90\x03\x1d\x2d\x22\xca\xee\x63\xd6\x67\x3b\x01\x58\x61\x2e\x26\xb9\x7e\x48\x78\xb5\xb5\x3d\x4e\xc7\x83\x81\x81\x33\xc1\x8b\xdb\xc8\xaa\x9d\xe0\x7e\x53\xc5\xeb\x35\x9c\x1b\x2f\x62\x60\x87\x24\xb3\x8d\x69\xac\x87\x6c\x01\x09\x08\x1c\xa3\x98\x61\xa3\x2d\xfa\x0e\xe2\x1c\xee\x83\xa0\x21\x5d\x9c\xf0\x0f\x31\x17\x3a\x7d\xac\xaf\x34\x64\x1f\xfd\x0e\x10\x8e\xb2\x8f\x26\xd7\xe7\xbb\xeb\xbf\x9a\x55\x48\xda\xd7\x18\x06\x15\x2d\x32\xe6\x5b\x85\x84\x75\xaa\x10\xb9\x57\x63\x5d\x23\x65\xb1\xa4\xa7\xb0\x7a\xce\x81\x46\x6a\x20\xcc\xe3\xdb\xd4\x53\x74\x84\xfa\xef\xf9\xd6\xad\xb9\x14\xac\x85\x64\xb1\xce\x56\xda\xaa\x0c\x7a\x32\xf1\xa0\x36\x35\x8d\x3e\x30\x28\x13\x58\x67\xf5\xe3\x1a\x4a\xd8\x1a\x93\x10\x94\x3f\xa0\x00\x22\xc2\x9f\xf7\xc3\xfe\xb2\x49\xfb\xed\x7e\xdb\xeb\x11\xa1\xb9\xc3\xc1\x50\x6a\x2c\x15\x1f\x66\x60\x80\x1d\xfc\x6b\xfc\x32\xcf\xe9\x89\xa0\x41\x63\x11\x04\x27\x1b\x0a\x2e\x60\x76\x15\x36\x50\xe6\x55\xb9\x6a\x12\x8b\xa1\xb2\x86\xf3\xbb\xb6\x7a\x69\x0a\x50\xc1\xc7\x26\x80\x7c\x97\x30\xa6\x22\xa8\x0d\x22\x3e\x5b\x87\x34\xea\x31\x7d\xd5\xde\x49\x48\x0f\x59\x96\x72\x0f\x39\x76\x3b\xc8\xfd\x86\xb1\x6d\x5c\x53\x6f\xdc\xdf\xdc\x6b\x91\xcb\xa5\x47\xa5\x29\x11\x07\x20\x3f\xe8\x44\x93\xd3\xb9\x97\xee\xac\xfe\xc8\xd3\xd3\x64\xca\x63\xf4\xd5\x96\x4e\x5f\x46\x5f\x9b\x69\x73\xfc\xff\xc0\xcd\xdc\xd8\xdd\xf6\x2e\xa2\xdb\x07\xff\x9e\xd8\x5d\xd9\x76\x38\x36\x3d\xd4\x37\xef\xcf\xac\x56\x7b\x28\x4b\xac\xcf\xa0\xd0\xd2\x2b\x18\x6a\x75\xd5\x5b\xc6\x5e\x3a\xe3\xb2\xd4\xb1\x01\x58\x4b\x9d\x94\xc4\xaa\x7d\xaa\xd7\x88\x56\x59\xb0\x8b\xc6\xb3\x51\xdb\x01\xd8\x3a\xdf\x54\xe0\x59\x5b\x34\x29\x96\x94\x15\xdd\x48\x95\x10\x0f\x92\x2b\xac\x86\x60\x6c\x53\xc0\xdf\x9a\xba\x32\x78\xde\x99\xbd\x9e\x20\xa0\xe0\x72\x56\x14\x4e\xda\xb4\x03\xf2\x40\x9d\xc4\x80\x0f\xe1\x8d\xca\xb6\x6d\xba\xfa\x64\x87\x18\x38\x40\x95\x60\x1e\x44\x26\x88\x4a\xb2\x0d\x84\xe0\xc9\x58\x65\x37\xf2\xec\x33\x12\x1d\x1d\x5e\xf2\xa0\x64\xff\xa1\xeb\xa1\xda\x00\xa8\x49\x65\xf7\xd9\xeb\xe8\x02\x61\xdd\xdf\x63\xc1\xe0\x41\xb4\xb5\x03\x81\xf9\xe5\x09\x70\x7c\x30\xb4\xfd\x5d\x59\xbf\xc5\x9a\xeb\xfc\xdf\x9f\x03\x9f\x37\xb7\x99\x79\x12\x61\x97\x65\x99\x77\xb6\x3f\x85\x20\xdc\x3e\xd9\xfb\x41\xe7\x65\x9c\x0e\x6f\x2b\xc8\x98\xc1\xad\xf9\x28\x8b\x7b\xb0\x7b\x7f\x79\xda\xe6\x5e\xd3\x63\x2e\x86\xf9\x36\xd3\xa8\x63\x72\x0d\x47\xb5\x03\xaa\xfc\xfe\x3c\xfa\xb1\xc8\x40\xa7\x54\x80\xea\x02\x9f\x67\xe6\x5f\x1f\xce\xdf\x85\xbc\x12\xe5\xf0\xed\x16\xb5\x3b\x36\x09\x6a\xf7\xdc\x9e\x34\x8c\xd6\x35\x71\x62\xfe\x08\x79\x8c\x80\xfe\xe5\x91\xb0\xbb\x3a\xb3\xc7\x52\x32\x9f\x86\xb0\x2f\x77\xaf\xbc\x71\x7e\x83\x5b\xba\x8e\x2b\xf5\xfb\xb0\x41\x9d\x0a\xdd\x8d\x7f\x09\x03\x28\x4f\x42\x7b\x9f\xe6\x3b\xd5\x36\x73\x1d\x75\xcb\x14\x5c\xda\x84\x95\x1d\x70\x7b\xb9\xe6\xc1\x84\xa0\xc3\xf3\xc2\xa4\xf6\xb0\x36\xe8\x1c\x78\x13\x77\x4d\x2a\x63\xf3\x87\x33\x74\x0a\xd8\xd8\xc3\x7c\x18\xb1\xcb\x81\xb7\xe8\x63\xd9\x01\x65\x76\xba\x04\x27\x24\x59\xb2\x8f\xb0\x8d\x8a\xfd\x9c\x79\xcb\x6d\xc3\xf5\x1a\x86\xa8\xc5\x88\x41\x15\xb2\xe0\xce\x0e\x72\x58\x90\x25\x26\xf9\x86\x9c\xac\x4e\x17\x78\x0d\x1f\x63\x43\x32\xce\x0d\xe9\x2f\xb6\x65\xd6\x47\xe0\xa6\x65\x6f\xd8\xe4\xdb\x7c\x88\x64\xd0\x36\xdb\xa7\x5c\xa6\xcd\x84\x87\x91\x33\xcb\x72\x93\xa7\xe8\x3a\x2b\xbd\x00\xa2\x35\x42\xb8\xa4\xf4\xbd\xd3\x6f\xc7\x18\x11\xa9\xb7\x20\x2f\x7d\x1b\x63\x88\x99\x01\x97\x56\x19\x86\x7f\x9b\x35\x59\x9a\x84\x45\xb5\x2e\xe2\xa2\x49\xc0\x24\x63\x3f\xf1\x73\x69\x8f\x8d\x51\x23\x87\x00\x45\x3d\xac\xc5\x1f\x3b\xb6\x40\xb9\x73\x9b\x67\x60\x06\xc5\x07\xdb\x5e\xce\x05\x7d\xbd\x05\x95\x12\x18\x36\xb7\xa6\x7e\xc1\x17\xd4\xd4\xc1\x0f\xab\x2c\x04\xc9\x60\x42\x1b\x4c\x57\x99\xe1\x16\x1e\xc1\x98\xf2\xae\x7b\xfa\xf7\x63\xf4\x33\x16\x3a\x41\xf7\x71\x41\xc4\xe7\x9d\xbd\x0e\x78\x53\xc8\x9b\xda\x3e\x0d\x65\x76\x21\xb3\x81\xaa\x2a\xc8\xaf\x52\xec\x25\xba\xe9\x26\xdf\x20\x4a\xf8\xf6\xf4\x8f\x66\x73\x53\x5a\x9d\x42\x85\xa2\x7c\x74\x9d\x72\x87\x13\x2f\xe6\x75\xae\x6b\x52\x2e\x2b\x1a\xd4\x2f\x0c\xd2\x56\xbf\x7e\xa4\xd5\xe7\x85\x3e\x7b\x4d\x3b\x5e\xd3\x0e\xfa\x8e\xd4\x2b\xba\xef\x47\x95\x91\x0e\x36\x3e\x1d\x11\xd5\x20\x8c\x56\x4e\xc2\xe7\xdb\x7e\x6b\x4a\x81\x87\x35\x78\x6f\x4f\x6a\x67\xed\x24\x37\x37\x6d\x05\xb2\xda\x40\x25\x95\x5c\x05\xdc\x2f\x04\xff\xe2\xce\xfc\x58\xe4\x32\xd7\x8c\xfa\x76\x78\x8a\x5c\x01\xb7\x15\x0c\xcf\xcf\x3c\x56\x52\x21\xc4\x59\x1d\x28\x02\x85\x04\x4c\xea\xe2\xf4\x1c\x93\x13\x4e\x28\x89\x15\xda\xf5\x5b\x3c\x20\x39\x09\xf9\x2f\x5f\x87\x77\x72\x86\x41\x05\x5d\x5f\x2e\x27\x74\x9c\x55\x67\xba\x75\x59\x52\xd6\xdd\x33\xe2\x4d\x6c\x0b\xfa\xcd\xb1\x0d\x55\x15\xd3\x10\x81\x71\xdc\x6b\xe3\x22\x92\x06\x31\xe9\xbc\xea\xd1\xe7\x12\x66\xdf\x7d\xc0\xb0\xcf\x66\x20\x29\xab\xb7\x60\x60\x15\x76\x94\x6a\xdb\xe4\xd6\x18\x92\xc4\x1f\x60\x91\xce\xcf\x51\x62\xe3\x5c\x10\xc5\xf9\x86\xde\x20\xe1\xb4\x38\x86\x0d\xc7\xe4\x39\x23\x27\x83\x82\xc6\x6e\x6e\x3a\xd8\xf7\x58\xea\x52\xb6\x8b\xaf\xce\x9a\x41\xe6\xba\x0f\x84\x80\x1c\xb6\x17\xf7\x9e\x8a\xed\xed\x39\x8a\xc0\xb0\x7a\x58\xcb\x11\xbb\x10\xe6\xe9\x61\x45\x68\x10\x3c\x2f\x1e\xc2\xb1\x8d\xaa\x2c\xc4\x87\xd0\x04\x88\x81\xad\x64\x7a\xef\x68\x86\x49\x40\x24\xc6\xa9\x80\x15\x3d\x42\x30\xb7\x3c\x51\xed\x51\x90\x61\xce\x04\x47\x77\xb8\x47\x2f\x38\xb0\xc7\x52\x9c\xa5\x8f\xa0\x16\x1c\xf7\x23\xb2\xf6\x4f\xa7\x34\x4b\x9b\xee\x05\xf9\x5e\xc7\x06\xd9\x7d\x3f\x47\xb5\x18\x54\x4f\xb5\xe4\x84\x5d\xa8\xf2\xf4\x4e\xd5\x6a\x62\xc3\x38\x8a\x8f\xd7\xac\x06\xe0\xe3\x35\xab\xc5\xc1\x6d\x25\x38\x51\x2b\xf0\x34\x27\xec\xa2\xee\x6a\xc9\x38\xf2\x63\x4a\xe2\x9e\xf7\x08\x25\xf1\x90\xde\x79\x89\xd1\xdc\x81\x40\x06\x6e\x51\xa2\x06\x9e\x15\xe6\xae\x44\x83\x90\xc0\x9b\x4e\xbb\x2d\x02\x9c\x4e\xd5\x41\x96\xb6\x3d\xf3\x83\x61\x84\xee\x64\x87\x4d\xf4\x73\x23\x65\xc5\x03\xdb\x1e\x8d\x54\xaf\x82\x80\x85\x94\x98\xc2\xea\x8b\x72\x93\x2c\x29\x16\x49\x90\xa5\x01\x65\x74\xcd\x91\x02\xef\xae\xec\xd1\x53\x8e\x0b\x6e\xbf\xa3\x0d\x43\x9d\xbe\x76\x96\xda\xcc\x53\x5a\xcb\x94\xfc\x64\x2b\x0a\x38\xb1\xc2\x44\x2e\xd7\xfc\x2e\x60\xe5\x5d\x8d\xcc\x37\xb9\xbc\x30\x84\xf8\x94\xa5\x1c\xf3\x12\x7c\xef\x65\x30\xc4\x55\xfa\xc8\x94\x7c\x81\x86\x75\x81\x81\x24\x08\x21\x83\xb2\xe9\x22\xd9\x46\x0a\x8a\x77\x8e\x75\x10\x10\xe9\xa1\x8a\x38\x21\xb9\xef\xe4\x94\x6c\x59\x96\x57\x6d\x3e\xa5\x6f\x75\xb2\xa9\xf5\x88\xaa\x11\x4f\x9e\x50\x83\x37\x7d\x36\xbe\xb4\x27\x8e\x82\x16\xc1\x06\x94\xc1\x7e\xa1\xa6\xc4\xf1\xa9\x8a\x94\x73\xa5\xb6\xa8\x55\x90\x03\xeb\x9a\x37\x02\xa1\xfa\x8a\x15\x61\xf4\xb1\xc1\x9f\xf2\xda\x80\x68\x72\x9e\x19\x8c\xbc\x32\x60\xf2\x9b\xfa\xdf\xd6\xb4\xee\x0d\xf4\x8e\xc7\x06\xbb\xde\x32\x0f\xbe\x3e\x18\x79\x7c\xb0\xd7\xb3\xac\x47\x92\xd7\xdc\x14\x58\x36\xbe\x08\xdb\xfd\x23\x84\x7a\xd6\xd6\x36\x14\xfd\xd6\x62\x2f\xdb\xf3\x1a\x6c\x4f\x09\x34\x72\x63\x31\x54\x8b\xed\x0c\x33\x34\xbb\x33\xca\x40\x42\xe6\x20\x36\x94\x6f\x62\xf1\x81\x4f\x7c\xc6\x3c\xf4\x67\xe9\xe8\x79\x31\xc6\xde\xcd\x8c\xfb\xbb\x08\x6b\x06\xf7\x3d\x15\xf6\x04\xf9\xbd\xfe\x95\xc6\x0f\xbc\xe0\xae\xd5\x3a\x2e\xb2\xc4\x70\xd2\x2e\x26\x5b\x26\xe0\xad\xcc\x28\x45\xcf\x6f\x2e\xb2\x43\xb0\x81\x71\xd6\x3e\xfd\x10\x3e\x21\x90\xc1\x67\x05\x84\x68\xd0\x7d\x3d\xd6\x82\x6a\xa9\x6c\x5b\x97\x7d\x72\x9d\x7b\x69\x84\xdb\x73\xde\xf8\x6c\xca\x32\x62\x86\x5c\xa1\xbb\x43\xbe\x9a\xb3\x42\xef\x39\xf9\x51\x56\x7d\xce\x46\xea\xe3\x99\x77\x66\xec\xb9\xfc\x0c\xa6\xfb\x30\xc3\x3e\xc0\xe9\xb1\x91\x5f\xbc\x30\x2f\xa9\xa1\xd7\x3c\x7a\x44\x15\x19\xb6\x82\x7e\xd4\xa3\xba\xcb\x44\x0a\xca\xe3\x86\xfd\xd8\x60\xb7\xc5\x7f\xd6\xc4\x4f\x01\x81\x2f\x5b\x35\x3a\x1e\x7c\xa5\x6c\x7b\x6d\xe2\x57\xed\xf5\x80\xb1\x15\x99\x8d\x59\xa9\xc6\x27\x7c\x23\xec\xff\xa4\xb6\xf3\x73\x6c\xae\x39\x30\x74\x59\xd8\x9a\x1d\x7d\x3e\xdb\xf0\x9e\xd5\x06\x47\x32\x34\x1f\xdb\xa8\x4b\x83\xe6\x13\xad\x8d\xe0\x34\x2f\x62\xb1\x41\x8e\xa9\xc8\x5c\xd7\x94\x98\xb4\x1a\x30\x74\x21\xe3\xa5\x3c\xe4\xe5\x59\xae\xb6\x4b\x04\xbb\xb3\xca\x4a\x18\xd6\x42\x54\x49\xb4\x5c\xd1\xb4\x49\x51\x3c\xaf\xf9\xdd\x2d\xac\xad\xca\x1b\xa3\x6e\xd0\x3c\x93\x25\x46\x7e\xea\x05\x71\xbe\xd3\x5e\xdf\xc8\xbd\xfd\xe5\x26\xbf\x6a\x8e\xa2\x8e\x21\xc0\xc1\x8b\x1e\x7a\xac\x63\xe8\xa2\x9b\x5a\x5f\xd8\xe6\x34\xcd\x6b\x4b\xfb\x18\x01\x16\xca\xed\x9b\xdf\x14\x20\xed\xc5\x06\xa3\x55\x49\xc1\x44\x54\x76\xee\x74\x15\x56\x30\x8f\x87\xe4\x25\x2c\xa8\xc4\x42\x70\x9d\x82\x60\x6f\x8d\x95\x1f\x02\x23\xaf\xa5\x6d\x8a\xfe\x88\xd0\xe6\xff\x85\xcb\xb6\x7b\xf0\x6e\x65\x79\xfe\xe5\x87\xbc\x77\x81\x2c\x2c\xa0\x85\x21\xbe\xb3\x7b\xd1\x71\x21\xa4\x16\x19\x70\x02\x14\x6a\x15\x5f\xe9\xe0\xd7\xdf\xba\xfa\x37\x73\x40\x80\x1a\x51\x3e\x8b\xcb\x39\x4d\x63\x1c\x10\x28\x40\xf9\x35\xfb\x0d\xea\x04\x1a\x82\x9f\x00\x83\xc0\xcf\x2b\x6d\x96\x8e\xda\x3e\x68\x84\x67\x05\x98\x21\x75\xd1\xc2\xe8\x9b\x3c\x67\x43\xdc\xfd\x44\xcd\xbe\xed\xbb\xdc\x42\x31\x66\xe9\x58\xc5\xeb\x5f\xbb\x94\xfc\xd6\x75\xc7\x48\x18\x61\x67\x09\xfb\x1d\x2f\x33\x1a\xda\x68\x8a\x4e\x42\xd0\xbf\x5e\x03\x28\xa4\xef\x9a\xa9\xe2\xe5\x4e\xa1\x39\xc4\x13\xe7\xe9\x1f\xc1\xf0\x8a\xc9\xdf\x5e\x4a\x97\xb8\xcd\x1a\x0f\x1c\x2d\xba\x53\x94\xda\x79\xcc\xf9\x2e\xbe\xd4\xb9\x3c\x1a\x93\xe4\xcc\x56\x3f\xee\xe5\xd4\xa3\x90\xdb\xbb\xde\x81\x96\x4d\x7f\x1f\xb8\x01\xdb\x73\x82\x58\x34\x74\x5b\x84\xbc\x1a\x9c\x90\xcd\x5c\xb7\xc2\x68\x96\xe7\x58\xb3\x7a\x09\xbe\x0f\xbe\xd8\xe4\xf9\xce\x23\x76\x4d\x36\xc7\x34\x79\xba\xff\x35\xfc\xf0\xae\x7d\x03\x27\x97\x87\x8d\xdb\xe7\xef\x67\xfb\xfd\x67\xdd\x45\x76\x1f\xf1\xb7\xfe\x40\xf6\xa0\x99\xbc\x1c\xf7\xfb\xbd\x97\x10\x7d\x69\x3e\xf6\x36\x93\x2e\xfa\x90\xbe\xe6\xc1\xc4\x94\xae\x20\xa1\xbe\xf0\xd9\x1f\x3a\x0f\x27\x68\x83\x77\x49\x37\x96\x85\x4b\xdb\x96\xaf\x14\x9b\x8b\x3b\xc4\x14\xfb\xc2\x08\x53\x1e\xf3\xb7\xde\xd6\xcf\xef\x0c\x96\x2e\x0f\xb5\x8a\x1c\xbc\x3e\xed\x16\xf6\x29\xf9\xc5\x90\x92\xbe\xf9\x3e\xb8\x1e\x48\xf3\x1d\xfc\x5a\x05\x74\x06\x9f\xad\x85\x2e\xe0\x67\xdd\x11\xef\xcc\x5e\x3b\x2c\x05\x40\x9f\x52\x01\x8c\x5d\x43\x3f\x3d\x99\xf5\xb5\x49\xf2\xda\xf1\x0c\xed\x33\x5e\x81\x7f\x26\x1d\xf1\xd3\xd2\x47\x5e\xd2\x04\x9d\xfb\xa7\xd0\xaf\xd9\xcf\x2b\xee\x27\xef\x64\xb4\xd3\x01\x47\x88\x23\x35\x3d\x27\x5e\x4d\x21\xc0\x17\x5a\x74\x35\xdd\x31\xd0\xd1\x66\x9b\x27\x29\x37\xb7\x4c\x39\x29\xbc\xc9\x8c\x7e\xe8\x2e\x6c\x9c\xa8\xe0\x01\x05\xe7\x57\x73\x8e\xcc\x82\x83\x81\xf9\x81\xee\xfb\x95\x96\x1b\x85\xae\x40\xe1\x2c\xd0\x14\x53\xc7\x84\xe5\x7d\x18\x7d\xd0\xf5\x30\x66\xa1\x7f\x43\xe4\xf6\x5b\xda\x6b\x23\xdf\x99\x6b\x71\xe6\x6f\x28\xe5\x15\x1f\xed\xf4\x7b\xd9\x4d\x37\xee\x59\x13\xd9\xe2\xa3\xa7\xa4\x8c\xf6\x91\x1b\xf7\x51\x5a\xbc\x74\xef\x19\x82\x6d\x95\xb6\x33\xf4\x9e\xa0\xec\x99\xe1\x03\x0d\x9e\xa1\x43\x1e\xec\xd9\x5b\x9a\xd8\x05\x71\x87\x09\xe8\x3b\x60\x84\x1a\xaa\x46\x1a\x42\x7b\x7c\x23\x3b\xd8\x9c\x3c\xfa\x13\xbb\x93\x64\x02\x4e\x47\xd5\x3e\x00\x9c\xca\xb3\x3f\x14\xed\x14\x25\x7d\xe4\x24\x5e\x23\x2d\x3f\xe2\xcd\x31\x16\xe0\xbd\xb6\xe6\xd8\xff\x14\xd3\x7f\x03\x3b\x9c\x2b\x61\xdb\xb1\x9d\x7e\x2a\xe2\x4f\xc0\x7b\x67\xbf\x72\x94\x02\xff\xff\xda\xa0\x97\xdb\xd1\x01\x5e\x1f\x73\xb8\xa5\xf9\x7f\xa2\xd5\xb8\x8d\x60\x43\x00\x00")
 
 func templateClientTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -254,12 +347,12 @@ func templateClientTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/client.tmpl", size: 6205, mode: os.FileMode(420), modTime: time.Unix(1570008718, 0)}
+	info := bindataFileInfo{name: "template/client.tmpl", size: 17248, mode: os.FileMode(420), modTime: time.Unix(1792028650, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateConfigTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4f\x6b\xdc\x3e\x10\x3d\xaf\x3e\xc5\x63\xc9\x61\x37\xe4\xa7\xcd\x2f\xb7\x16\xf6\x10\x92\x14\x02\xa1\x2d\xb4\xf7\x22\x4b\x63\xaf\x1a\x47\xe3\x4a\x72\x68\x30\xfb\xdd\x8b\xfe\x38\xeb\x40\x0e\xb9\x79\xf4\xde\xbc\x99\x79\x33\x9e\xa6\xdd\xb9\xb8\xe1\xe1\xc5\xdb\xee\x10\x71\x75\xf9\xff\xa7\xff\x06\x4f\x81\x5c\xc4\x17\xa5\xa9\x61\x7e\xc4\xbd\xd3\x12\xd7\x7d\x8f\x4c\x0a\x48\xb8\x7f\x26\x23\xc5\xcf\x83\x0d\x08\x3c\x7a\x4d\xd0\x6c\x08\x36\xa0\xb7\x9a\x5c\x20\x83\xd1\x19\xf2\x88\x07\xc2\xf5\xa0\xf4\x81\x70\x25\x2f\x67\x14\x2d\x8f\xce\x08\xeb\x32\xfe\x70\x7f\x73\xf7\xf5\xc7\x1d\x5a\xdb\x13\xea\x9b\x67\x8e\x30\xd6\x93\x8e\xec\x5f\xc0\x2d\xe2\xa2\x58\xf4\x44\x52\x9c\xef\x8e\x47\x21\xa6\x09\x86\x5a\xeb\x08\x6b\xcd\xae\xb5\xdd\x1a\xf5\xf9\x6c\x78\xec\xf0\x79\x8f\x46\x05\xc2\x99\xbc\xc9\xa8\xfc\xae\xf4\xa3\xea\x28\x91\xa6\x09\x91\x9e\x86\x5e\x45\xc2\xfa\x40\xca\x90\x5f\xe3\x6c\x4e\x3f\x41\xf6\x69\x60\x1f\x67\x68\xb7\xc3\xb7\x21\x5a\x76\x68\x47\xa7\xf3\x47\x64\x94\xda\xa3\xa7\xdc\xbe\xee\x2d\xb9\x28\x45\x7c\x19\x68\xc9\xde\x9c\x17\xde\x36\xcb\x94\x8e\x92\x6b\x39\xa7\x2a\xa8\xc2\x66\xbf\x50\x82\x72\x06\x36\x06\x34\xa3\xed\x0d\xf9\xaa\x5c\x52\x10\xa2\x1f\x75\xc4\x24\x56\xbb\x1d\x8c\xb7\xcf\xe4\x31\xa6\x1d\x24\x11\xfa\x4b\x7a\x8c\xd6\x75\x30\x2a\xaa\xec\x85\xa7\x3f\x23\x85\x18\xa4\x58\x55\xb6\xb1\xaa\x27\x1d\xe5\x6d\x0e\x8b\x0e\x35\x63\x07\x72\xaa\xe9\x09\xaa\x86\x3d\x77\x9d\x75\x5d\x4a\xcc\x71\xc3\xdc\x67\x76\xcf\xdd\xa9\x64\x65\x81\x5d\x4d\x7b\x62\x43\x52\xac\x12\x29\xbb\x20\xa5\xb4\x2e\x92\x6f\x95\xa6\xe9\xb8\x15\x4b\x57\x03\xd4\x30\xf4\x96\x8a\x29\x5c\xdf\xd8\x2d\x3c\x02\x37\xbf\x53\xb7\x22\x89\x61\xa3\x31\xbb\x3a\xd3\x37\x3c\xc4\x00\x29\x65\x91\xdc\x26\x6b\x52\x63\xbf\x2e\x12\x23\xdd\x84\x57\xae\xcb\xea\x21\x61\x2b\x1e\xe2\x46\x6f\xc5\xea\x28\x56\xb6\x85\x96\xa5\xed\x84\x68\x59\x2d\xda\x9f\x4c\x4a\xe0\x66\x06\x2e\xa0\x65\xcf\x5d\x4e\x2e\x73\xdc\x2e\x9c\x0b\x6f\x8d\x9b\xe7\x48\xc7\x51\xbc\xae\x43\x14\xcd\xed\x7c\x2b\x93\x58\x79\x8a\xa3\xaf\x57\xb3\x98\xb0\xf6\x94\x45\xf7\x88\x7e\xa4\x53\xe1\x07\xee\x10\x28\x16\xe7\xe6\x8a\xaf\x47\x9a\x0c\x58\xae\x23\xd7\x7d\xe0\x6e\xd3\xba\x77\xb7\xf2\xe1\x66\xd2\x5a\xf7\x68\xdd\xc2\x81\x62\xd9\xeb\x3f\x11\x96\xa7\x6c\xde\xcc\x9d\x83\xcd\xbb\x67\xf8\x71\x37\x5e\x37\x54\xcf\x37\xf7\x31\x4d\x20\x67\x70\x3c\xfe\x0b\x00\x00\xff\xff\x05\x81\xda\x42\xe6\x04\x00\x00")
+var _templateConfigTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x57\x6d\x6f\xdb\x36\x10\xfe\x1c\xff\x8a\x43\x10\x74\x96\xe1\x28\x69\xbf\xad\x80\x07\x14\x49\x0b\x04\x48\xd3\x97\x74\xeb\x87\x61\x18\x68\x89\xb6\xb9\x48\xa4\x46\x52\x71\x3d\x23\xff\x7d\xf7\x42\xc9\x52\x9b\xa0\x01\x8a\x3a\x12\x8f\xcf\xdd\x3d\xf7\xaa\xfd\xfe\x6c\x36\xb9\x70\xcd\xce\x9b\xf5\x26\xc2\xab\xf3\x97\xbf\x9e\x36\x5e\x07\x6d\x23\xbc\x53\x85\x5e\x3a\x77\x07\x57\xb6\xc8\xe1\x4d\x55\x01\x0b\x05\xa0\x73\x7f\xaf\xcb\x7c\xf2\x65\x63\x02\x04\xd7\xfa\x42\x43\xe1\x4a\x0d\xf8\x58\x99\x42\xdb\xa0\x4b\x68\x6d\xa9\x3d\xc4\x8d\x86\x37\x8d\x2a\xf0\xe7\x55\x7e\xde\x9d\xc2\xca\xe1\xf1\xc4\x58\x3e\xbf\xbe\xba\x78\x7b\x73\xfb\x16\x56\xa6\x42\x08\x79\xe7\x9d\x8b\x50\x1a\xaf\x8b\xe8\xfc\x0e\xdc\x0a\xdf\x1e\x94\x45\xaf\x75\x3e\x99\x9d\x3d\x3c\x4c\x26\xfb\x3d\x94\x7a\x65\xac\x86\xe3\xc2\xd9\x95\x59\x1f\x43\x7a\x7d\xd2\xdc\xad\xe1\xf5\x02\x96\x0a\x35\x9e\xe4\x17\x7c\x9a\x7f\x54\xc5\x9d\x5a\x6b\x12\x42\x99\xa8\xeb\xa6\x52\x11\x2f\x6f\xb4\x42\x83\x8f\xe1\xa4\xbb\x7e\x38\x32\x75\xe3\x7c\xec\x8e\xce\xce\xe0\x43\x13\x8d\xb3\xb0\x6a\x6d\xc1\x7f\x44\x07\xa2\xbb\xf5\x9a\xcd\x2f\x2a\x83\x1c\xe6\x93\xb8\x6b\xf4\x50\x7a\x3a\x13\xb9\x8c\x61\xc4\x22\x62\x8d\xef\x24\x04\x25\xd2\xce\x0f\x90\x40\xd9\x12\x0c\x92\xbf\x6c\x4d\x85\x66\x26\x64\xb9\x02\x21\xfa\xb6\x88\xb0\x9f\x1c\x21\x68\xe9\xcd\x3d\x12\xdf\x52\x0c\x08\x44\x7f\xd3\x45\x1b\x8d\x5d\x43\xa9\xa2\x62\x2e\xbc\xfe\xb7\xd5\x21\x86\x7c\x72\x94\xa4\x4b\xa3\x2a\xa4\x3a\xbf\xe4\x47\xc6\x61\xc9\x64\x5a\x92\x8a\x1b\x15\x61\xab\x30\x0c\x3a\xc2\x72\xc7\x47\x72\x03\x1c\xfb\x38\x87\xa5\x46\x9d\x78\x4f\xe4\xb6\x5e\x35\x8d\x2e\x05\x4f\xe4\x4b\xbd\x6c\xd7\xec\x0e\x3d\x85\xca\x6d\x4f\xd1\x18\x0c\xb1\xe8\x08\x39\x5c\xc5\x5f\x02\x58\x53\x71\x2a\x78\x65\x83\x62\x92\x55\x95\xb8\x20\xb3\xd9\xb8\x47\x8c\x16\x74\x6d\xd5\x12\x53\x49\xa5\xc7\xca\xad\xd7\xe8\x3f\x79\xcb\xcf\x98\xd5\x15\x4b\xe3\xc1\x81\xa7\x24\x05\xc8\xbd\x88\xd5\x94\xd1\x64\x28\x9d\x92\xa1\x40\x86\x1a\x4d\xfa\xe9\x26\xc7\x33\xcf\x73\x63\xa3\xf6\x2b\xac\x96\xfd\x43\xc6\xb0\x2c\xdb\x31\xd7\xc5\x33\x6e\xb0\x6e\x36\xae\x1a\x2b\xfb\x0e\x16\x2f\xbf\x81\xff\xb4\x77\x70\xaf\xaa\x56\x43\xad\xd1\x7d\xa1\x9d\xc0\xba\x5b\x88\x5d\x9a\x40\x3e\x62\x11\x1e\x31\x46\x34\xb5\xce\x2f\x93\x32\x06\x42\x4a\xcc\x6a\x07\xa4\x52\x4c\x09\x58\x84\xb5\x92\xf7\xa6\x10\xab\xd8\x47\x2c\xae\x0f\x8d\xb6\x9d\x7e\xa2\xfe\x51\xf5\xa3\x9b\x63\x1b\x92\xb2\x99\x50\xbb\xdf\x9f\x82\x59\x61\xc9\xbd\xd3\x2a\x62\x49\xbc\xe5\x78\x94\x70\x5c\xb6\xaa\xda\x7a\x13\x35\xd7\xe8\x11\x93\xb5\x51\x25\xda\x7f\x30\x33\xe5\x34\x57\xbc\xee\x8e\x03\x76\x01\x2a\x5a\x4c\x09\xc2\x38\x65\x10\xb6\x1e\x95\x1f\x25\xa1\x54\x5b\x82\xeb\x35\xd5\x2c\x99\x59\xa8\x8a\x94\x6f\x4d\xdc\x30\xe4\x4a\x99\x0a\x8d\x0a\x7d\x12\xd6\x26\xd4\x2a\x22\x3b\xe1\x71\xad\xa4\x22\xc1\x71\xcc\xb5\xf7\xce\x67\xa2\xa6\x36\xf4\x30\x30\x5f\x5e\xa0\x3e\xd7\x68\x89\x06\xa3\xaa\x61\x2a\x63\x6f\x8c\x94\xdf\x94\xe8\x85\xab\x6b\x13\x23\xb3\xf8\x13\xfe\x07\x88\xca\x0f\x34\x6d\x37\x9a\x9b\xe6\x8e\x5f\x63\xc5\x61\x99\x30\x5c\x32\x6e\x26\xbf\x12\x17\x8d\x4e\x13\xf7\x4f\xc6\x68\x4b\x54\x1c\xe2\xb3\x6c\x03\x34\xed\xb2\x32\x81\xf8\x61\x0f\xdb\x78\xf0\x6b\xd0\xa2\xb0\x09\x52\x87\xe2\xfb\x54\xc8\x78\x9f\x2e\xcf\xf0\xbf\xb1\x6a\x44\xe5\x9a\x09\x03\xda\xa4\x07\xf0\xeb\x42\x37\xc8\xfb\x77\xe8\x88\x96\xee\xcc\xe4\x77\x22\x9d\xf8\x99\x40\x1a\x27\x10\x50\xcb\x4c\x8d\x33\x5d\x1b\x35\xce\xba\x85\x75\xab\x7c\xc2\xf1\x7a\x6d\xf0\x58\x92\x1d\x11\xc6\x88\x16\x03\xda\xb9\x4d\xe1\xc1\x34\x53\x95\xc7\x09\x42\xaa\xa9\xa7\xa0\xbd\x88\x17\x76\x38\x3b\x3f\x7f\x7d\xdf\x46\xfd\x4d\x38\xc0\x24\xc0\x34\x3e\xf9\x7b\x0e\x27\x96\x66\xd3\x49\x7e\x83\x49\x1c\x84\x6e\x9a\x59\x36\xbf\x51\x35\x4d\x27\xf8\xf3\xaf\xd1\xf3\xd5\xc1\x80\x11\x9d\xc2\x43\x51\x39\x4b\x46\x63\x28\x29\x3d\x30\xad\x9a\x5d\xc7\xe0\xd0\xf4\xb9\xd8\x5b\x28\x8b\xad\x1a\x67\x43\x44\x94\x54\x1c\xae\xc5\xf7\x1b\xb4\x8f\x5a\x0c\xe7\x1b\x0e\x7c\x83\x7d\x37\x9f\x50\xda\xc3\xd4\x74\xdc\x67\xa2\x6e\x9a\x75\x2f\x88\x41\x4c\x26\x03\x8b\x05\xa7\xef\x9e\x2b\x86\x6c\xa1\xc7\xc9\x11\x3a\x67\xf2\xba\xcd\x3f\x5f\xbb\xe2\x6e\x9a\x51\x23\x5e\xe1\xd0\x90\x77\xbf\xdb\x2a\xbd\x4d\x57\x5e\x08\xe8\x9e\x19\xf9\x09\x65\x63\xce\x5e\x53\xf6\xa3\x47\xd3\xa7\xb9\xdb\x3f\xcc\x51\xef\xe8\x14\x7b\x78\x36\x4f\xca\xba\x1c\xed\x78\x95\xd9\x1d\x52\x55\x85\x54\x87\x29\xfd\xed\xa8\x61\x2d\xff\xa1\x61\x94\xb8\x2a\xba\x6e\x94\x75\xe2\x53\xfc\x0d\x80\xba\x04\x32\x23\x92\x68\x14\xa0\x5f\x78\x42\x8e\x89\xa3\x2c\x46\xbe\xe3\x1f\xd3\x22\x63\xf2\xd0\x10\xd2\x24\xe3\xd4\x4b\xf9\x7b\x4d\xbb\x00\xb6\x24\xef\x6a\x3e\x95\x99\xc8\xb3\x70\x4e\x59\xe8\x3c\x2f\x60\x0e\x2c\xae\x52\x21\xe2\xda\x43\x62\x35\x4c\x9d\xcc\xca\x3b\xad\x1b\xbe\x88\x1b\xdf\xbd\x71\x58\xaa\x34\x5f\xb4\xcf\xfa\x86\xd2\x7b\x4a\xfa\xfa\x5c\x69\x03\x25\xc8\x85\x94\xe5\x57\x4c\x9c\x9c\x83\x5f\xe4\x6c\xc0\x0f\x19\xc0\x0e\x14\x79\x5a\x21\x16\x49\x2e\x5d\xe1\xd9\xf5\x1b\x9c\xb3\xfc\x40\xa8\x1b\xed\xb7\x78\x3e\xed\xde\xcf\xd3\x05\xfa\x45\x53\x85\x1a\x86\x91\x81\xfd\x04\xc6\x25\x1d\x8e\x40\xba\xcb\x12\xe2\xcb\xc1\xce\x10\xc6\x2b\x43\x17\x62\xf2\x54\xb6\x8c\x14\x5f\xc1\xcc\xba\xd5\x6e\xdf\xe7\x2e\x0f\x88\x41\xf0\x93\x4d\x0c\xba\xc0\x19\xd0\xea\x83\xe2\x6b\x5c\x22\x70\x93\x0a\xa3\xd9\xde\xef\x94\x94\x1b\x3f\x5d\x44\xd8\x18\xc4\x99\xae\xec\xa3\xfb\xc8\xb3\x2d\xa4\x85\x66\x01\x2b\x7b\xb0\x8e\xa8\xff\x44\xcd\xf4\x4b\xbf\xb9\x74\x1c\x0d\xed\x4d\x3d\x26\x59\x94\x86\x15\x7d\x36\x60\x87\x58\xcb\xce\x28\x1c\xae\x91\x3e\x4b\xc0\xdd\x4a\x34\xef\x47\xb2\xf1\x98\x61\xeb\xb6\xa6\xe5\xae\x9f\xca\x69\xd3\x15\x44\x59\x62\x75\x99\x32\x38\x68\x3d\x4a\x91\x2c\x47\x60\xfc\x77\x24\x3d\x79\x0e\x38\xa2\xa9\xa2\x28\x6e\xb4\xda\x4c\x3b\xe1\xf7\xbb\xdb\x4f\xd7\x73\x28\x03\x6a\xa7\x43\xa2\x4e\xb2\x3e\xff\xe8\x91\xb8\xca\x66\x72\xf0\xa3\xf7\xd3\x57\xe7\xe7\x33\x5e\xb3\xde\x9b\x0a\xc7\xa1\x46\x02\xcb\x2c\x23\xc5\x1c\x85\x47\x6e\x94\xe3\xb5\xec\xd9\xc1\xe0\x18\x63\x02\x1f\x82\xf1\x07\xef\x57\xb7\xb2\xc1\xf5\x9f\x1a\x81\xf7\x36\x2a\xf1\xb4\x7f\xf5\xab\x42\xbf\xea\xa7\xa5\x8f\x96\xf1\xda\xac\xd1\x0e\xe4\x50\x36\x71\x82\x0d\xd4\x00\x69\xeb\x4e\x43\x8e\xe3\xa4\xad\x16\x39\xfa\xa4\xc3\x75\x7c\xd5\x2d\x7f\x81\x7b\x41\x29\x0d\x85\x37\xa6\xb9\x18\x40\x1b\x55\x90\xae\x81\x23\xd3\x14\x91\x27\x73\xe0\x74\xc7\xee\xc6\xeb\x2e\x0d\xa5\xad\xf2\x96\x73\x06\x01\xfd\x16\x29\xcc\xe1\xc6\x45\xfd\xc4\x86\xe9\x5b\xd4\x96\xbe\x27\x94\xdd\x25\xfb\x69\xbd\xe5\x76\x28\x53\xb7\x4f\x8c\xf4\x79\xd1\x6d\x0b\x1c\x92\x21\x6b\x53\xb1\x8c\x97\xff\x67\x07\x22\xd1\xba\x80\x17\xc9\xaf\x43\xd3\x90\x2e\x33\x08\xc5\x60\x13\x2a\x47\xad\x82\x1f\xa6\x8f\x7e\x68\x3d\xbf\x81\xf4\xbd\x4b\x9a\x6c\xdf\xe7\xcb\xf4\xed\xc3\x76\xe1\x54\x93\xf9\xf5\x3f\x7d\x79\x9a\x8b\xd8\x0f\x00\x00")
 
 func templateConfigTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -274,7 +367,7 @@ func templateConfigTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/config.tmpl", size: 1254, mode: os.FileMode(420), modTime: time.Unix(1567330565, 0)}
+	info := bindataFileInfo{name: "template/config.tmpl", size: 4056, mode: os.FileMode(420), modTime: time.Unix(1792027969, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -299,7 +392,7 @@ func templateContextTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x51\x6f\xe3\x36\x0c\x7e\x8e\x7f\x05\x11\xf4\x21\x29\x12\xfb\xd6\xb7\x0d\xe8\x43\xd7\x5d\x87\x02\xc5\x6d\xc0\x75\xdb\x63\xca\x48\xb4\x23\x54\x91\x3c\x8a\xce\x2d\x30\xfc\xdf\x07\x49\x89\xeb\xeb\xdd\xd6\x3e\x05\x26\x45\xf2\x23\xbf\x8f\x4c\xdf\x57\x97\xc5\xad\x6f\x8f\x6c\x9a\x9d\xc0\xd5\x87\x1f\x7e\x5c\xb7\x4c\x81\x9c\xc0\x1d\x2a\xda\x7a\xff\x0c\xf7\x4e\x95\x70\x63\x2d\xa4\x47\x01\xa2\x9f\x0f\xa4\xcb\xe2\x71\x67\x02\x04\xdf\xb1\x22\x50\x5e\x13\x98\x00\xd6\x28\x72\x81\x34\x74\x4e\x13\x83\xec\x08\x6e\x5a\x54\x3b\x82\xab\xf2\xc3\xd9\x0b\xb5\xef\x9c\x2e\x8c\x4b\xfe\x87\xfb\xdb\x8f\x9f\x3e\x7f\x84\xda\x58\x82\x93\x8d\xbd\x17\xd0\x86\x49\x89\xe7\x23\xf8\x1a\x64\x52\x4c\x98\xa8\x2c\x2e\xab\x61\x28\x8a\xbe\x07\x4d\xb5\x71\x04\x73\x6d\xd0\x92\x92\xaa\x61\xda\x5b\xe3\x2a\xcf\x9a\x78\x0e\xeb\x61\x28\x66\x7d\xbf\x86\x8b\x64\x80\x9f\xae\xe1\xa2\xfc\xac\x7c\x4b\xe5\x6f\xc9\x90\x1e\xd4\x9d\x53\x0b\x61\xb8\xd4\xc1\x96\x8f\x8c\x07\xe2\x80\x76\x09\x7d\x31\x9b\xd5\x9e\x61\xb3\x82\x3a\x86\x32\xba\x86\xa0\x36\x64\x75\x48\xce\x99\x70\xf9\xf3\x71\x51\xaf\x20\x46\xf6\x3d\xb4\x18\x14\xda\x73\xb5\x61\x58\x16\xb3\xd9\x50\xcc\x86\x22\x62\x20\xa7\x21\xc3\xae\x2e\x41\x75\x41\xfc\x1e\x82\x69\x1c\x4a\xc7\x71\x2e\x0c\x0d\xfb\xae\x5d\x6f\x8f\x10\x11\x89\xf1\x0e\x52\xa3\xff\xd3\x67\x8a\xa8\xc6\x2c\xa7\x8e\xab\x0a\x7e\xcd\x0f\xa0\x21\x09\x20\x5f\x3c\x58\xdc\x92\x0d\x80\x01\x5a\x64\xdc\x93\x10\x87\x12\x1e\x77\xb1\x21\x0e\x02\x5d\x64\xee\x44\xc1\xd3\x4d\x78\x82\x20\xd4\x26\x54\xd1\xd2\x32\x69\xa3\x50\x68\x95\xb2\xa3\xd3\xc9\x1c\x48\x79\xa7\x23\xf9\xe8\xc0\xb7\x11\x32\x5a\x70\xb8\xa7\x31\xd2\xd1\x3f\xf2\x12\x1e\x60\xe1\x39\xf9\x2c\x0a\x31\x74\x01\x1b\x5a\x96\xc5\xec\x8c\x37\x71\x11\x84\x8d\x6b\x56\x90\x7f\x97\x30\x1a\x5e\x31\xf4\x6a\xac\x6f\x4c\x09\xc3\x7c\xc2\x77\x10\x64\x59\xc1\xe6\xcd\x22\x89\x69\x26\xe9\xd8\x41\xed\xca\x13\xd0\x73\x3c\x39\xbd\xfc\x96\xe0\x37\x90\x44\x00\x53\x71\xd6\x6e\xaa\xcc\xbb\xce\x29\x18\x7d\x5f\x8c\xec\xee\xa2\xe4\xa6\x4f\xfe\x1a\x8d\xaf\xfb\x89\x18\xde\xd5\x91\xa9\xd3\xdb\xeb\x6b\x98\xcf\xb3\x98\xd3\x27\xfc\x42\x35\x76\x56\xfa\x3e\xc1\x1a\x86\x87\xa8\x9b\x2c\xe3\xf3\x14\xc8\xe9\x15\x6c\x36\xe5\x4d\xc8\x55\x97\x51\xfa\xa6\x9e\x62\x1d\x86\x3f\x5c\xed\xad\x5e\x2c\xcb\x3f\xd1\x76\x14\x16\x69\x6d\xd2\xcb\x9c\x77\xb1\xec\x7b\x20\x1b\x08\x86\xe1\xc5\x18\x81\x3e\x78\x85\x36\x79\xd3\x3c\x63\x99\xef\x4f\xb9\xba\x7c\xd1\x9c\xf2\x2e\x08\x3a\x09\x5f\x2f\x92\xce\xdd\xc0\x21\x81\x28\xdf\xb9\x4f\x29\xd9\x7b\x09\x4a\x6a\x9f\x78\x3f\xc5\xef\xd1\xdb\x3e\x37\xd1\xb9\xc5\x40\x70\x51\xde\x7a\x57\x9b\xa6\xfc\x1d\xd5\x33\x36\xf9\x55\x55\x7d\x7f\xe4\x71\xa9\xe2\xfe\x9c\x3b\x48\xfb\xfb\xf5\x6a\x8d\x01\x80\x4d\xc3\xd4\x60\x3a\x19\xe7\xdb\x51\xa6\xdc\xf7\x02\x61\xe7\x3b\xab\x61\x4b\x79\xc7\x31\xe7\x0d\xc2\x9d\x92\xb5\x60\x93\xf2\x69\x52\x5e\x27\xb1\x78\x06\x84\x3d\xb6\xf0\x4c\xc7\xe4\x32\x4e\x88\x31\xdf\xa3\xc8\x70\xbe\xd2\x49\x0a\xa4\xe3\x7f\x42\xeb\x5d\xa0\x53\x39\x07\xf9\xf6\x89\x8f\xf0\xfe\xee\xbc\xd0\x69\x44\xc3\x00\x57\x31\xf9\xde\xf3\x78\x44\xe3\x1d\xc1\x83\x37\x3a\xf2\x57\x5b\xa3\x24\x41\xe8\x02\xe5\x3b\x14\x3b\x8c\x13\xcc\x2a\x98\x7c\xbd\x28\x26\xeb\x6a\x05\xf3\x7c\x51\x37\xb1\xd6\x7c\xf9\x94\xd0\x8c\x67\x34\xc1\x3e\x9d\xdc\x04\xc6\x4c\x70\xfa\x03\x31\x9b\xf8\x1f\x26\x65\x31\x4b\xdc\xff\x07\x25\xd7\xdf\xf6\x34\x91\xe4\xbf\x01\x00\x00\xff\xff\x65\xdd\x08\xce\x53\x07\x00\x00")
+var _templateDialectGremlinByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4d\x6f\xe3\x36\x10\x3d\x4b\xbf\x62\x60\xe4\x60\x05\x36\xb5\xdd\x5b\x0b\xf8\x90\xcd\x26\x85\x81\x60\xb7\x68\xd2\xf6\xe8\x30\xe4\x50\x26\x42\x93\x2a\x49\x65\x1b\x08\xfc\xef\xc5\x50\x8a\x56\x71\xd2\xae\x4f\x81\xf8\xe6\xe3\xcd\xc7\x1b\xa7\xef\xeb\xf3\xf2\xd2\xb5\xcf\x5e\x37\xfb\x08\x1f\x3f\xfc\xf4\xf3\xba\xf5\x18\xd0\x46\xb8\xe6\x02\x1f\x9c\x7b\x84\xad\x15\x0c\x2e\x8c\x81\x6c\x14\x80\x70\xff\x84\x92\x95\x77\x7b\x1d\x20\xb8\xce\x0b\x04\xe1\x24\x82\x0e\x60\xb4\x40\x1b\x50\x42\x67\x25\x7a\x88\x7b\x84\x8b\x96\x8b\x3d\xc2\x47\xf6\xe1\x05\x05\xe5\x3a\x2b\x4b\x6d\x33\x7e\xb3\xbd\xbc\xfa\x72\x7b\x05\x4a\x1b\x84\xf1\xcd\x3b\x17\x41\x6a\x8f\x22\x3a\xff\x0c\x4e\x41\x9c\x25\x8b\x1e\x91\x95\xe7\x75\x4a\x65\xd9\xf7\x20\x51\x69\x8b\xb0\x90\x9a\x1b\x14\xb1\x6e\x3c\x1e\x8c\xb6\xb5\xf3\x12\xfd\x02\xd6\x29\x95\x45\xdf\xaf\xe1\x2c\x3f\xc0\x2f\x1b\x38\x63\xb7\xc2\xb5\xc8\xbe\xe6\x87\x6c\xa0\x3a\x2b\x96\xd1\xc3\xb9\x0c\x86\xdd\x79\xfe\x84\x3e\x70\x53\x41\x5f\x16\x85\x72\x1e\x76\x2b\x50\xe4\xea\xb9\x6d\x10\x94\x46\x23\x43\x06\x8b\xe8\xd9\xa7\xe7\xa5\x5a\x01\x79\xf6\x3d\xb4\x3c\x08\x6e\x5e\xb2\xa5\x54\x95\x45\x91\xca\x22\x95\xc4\x01\xad\x84\x53\x68\xd7\x28\x1b\xac\x85\xeb\x6c\x9c\x57\x80\x73\xf6\x57\xb2\xc1\x19\x66\xf8\x03\x9a\x8c\x23\xbb\x74\x36\x44\x6e\xe3\x0c\x1e\xba\xa9\x9d\x25\x93\xc5\xd7\x6e\x1e\x57\x2b\x38\x43\xb6\x0d\x5b\x4b\x65\x8f\x41\x8f\xdd\x36\xb0\xd8\xda\xd1\x69\x9e\x31\x27\x1c\x3d\xdf\xe6\xa5\x82\x8f\xf2\xdc\xa2\x51\xbf\xa3\x82\x94\xfa\xfe\x28\xc1\x27\x17\xf7\x8b\x01\x98\x1c\x87\xc9\xbc\x3b\x98\x69\xa0\x84\x6d\xad\xf0\x65\x51\x68\x05\x12\x83\xc8\xf8\x68\xb0\xc9\xa3\xf9\x8c\x19\x27\xf6\x91\x26\xb6\xdb\xb1\xd7\xf9\x53\xba\x5a\xd2\xcb\x50\x56\x4a\x15\xbb\xa4\xfe\x2f\xab\x15\xe4\x38\xd5\xdb\x21\xd6\xe7\x20\xba\x10\xdd\x01\x82\x6e\x2c\x8f\x9d\x47\xa0\x6d\x69\xbc\xeb\xda\xf5\xc3\x33\x10\xf9\x1c\x3c\x6f\xeb\xff\x4c\x3d\x7b\xd4\x53\x94\xb1\xcf\x75\x0d\xbf\x0e\x06\xd0\x60\x0c\x10\xbf\x39\xc8\xf4\x02\xf0\x00\x2d\xf7\xfc\x80\x11\x7d\x60\x70\xb7\xa7\xad\xf4\x21\x42\x47\xf2\x1b\x75\x74\x7f\x11\xee\x21\x44\x6c\x33\x2b\x52\x5b\xeb\x51\x6a\xc1\x23\xae\xca\xa2\xae\x81\x5b\x99\x0d\x03\x0a\x67\x25\x29\x98\x5b\x70\x2d\x51\xe6\x06\x2c\x3f\xe0\xe4\x69\xf1\x9f\xf8\xdd\x3d\xc0\xd2\xf9\x8c\x19\x1e\xd1\x43\x17\x78\x83\x15\x2b\x8b\x17\xbe\x54\xf9\x32\x44\xaf\x6d\xb3\x82\xe1\x6f\x05\xd3\xc3\xd1\x34\x4f\xd5\xc6\xd0\x25\x1e\xc6\xf6\x8c\x39\xb8\x8f\x2b\xd8\xfd\x30\x49\x5e\x09\x8f\xb1\xf3\x16\x94\x65\x23\xd1\x17\x7f\xb4\xf2\x9d\x01\xff\x80\x09\x11\x18\xb9\x50\x05\x67\xca\xce\x05\x7a\xdd\x59\x01\x13\xf6\x4d\xc7\xfd\x35\xdd\x8d\xb9\xc9\x5f\xd3\xe3\x71\x3d\xb4\x64\x27\x55\xa4\x55\xe6\xbb\xd9\xc0\x62\x91\x1f\x8a\xfc\x09\x9f\x51\xf1\xce\x44\xda\x68\x45\xcb\x7d\x43\x7b\x33\x0a\x60\xec\x02\x5a\xb9\x82\xdd\x8e\x5d\x84\x21\x6b\x45\x8a\xd0\x6a\xce\x35\xa5\x3f\xac\x72\x46\x2e\x2b\xf6\x27\x37\x1d\x86\x65\xbe\x7d\xd9\x92\xca\x4d\x69\x59\xf5\x3d\xa0\x09\x38\x0a\x7a\x78\x24\xa2\x37\x4e\x70\x53\x8d\x5a\x4e\x89\xd2\xbc\xdf\xe5\xfa\xfc\xfb\xce\x89\xf1\x84\x84\xd7\x42\x92\x43\x35\xf0\x94\x49\xb0\x13\xf5\x94\x83\x9d\x3a\xa0\xbc\xed\x33\xf4\x0b\x7d\x4f\x68\xfb\xd8\x90\xeb\x03\x0f\x08\x67\x74\x60\x95\x6e\xd8\x6f\x5c\x3c\xf2\x66\xb0\xaa\xeb\xf7\x5b\x4e\xa2\x22\xfd\xbc\x54\x90\xf5\xfb\x5a\x5a\x93\x03\xf0\xa6\xf1\xd8\x70\xd2\xdf\x74\x3b\x58\x8e\xbd\x8d\x10\xf6\xae\x33\x12\x1e\x70\xd0\x38\x1f\xe2\x86\xe8\x3b\x11\xd7\x91\x37\xb9\x63\x12\x85\x93\x79\x59\x9c\x07\x0e\x07\xde\xc2\x23\x3e\x67\x48\xdb\x88\x9e\xe7\x98\x40\x13\xce\xee\xc3\x2a\xa0\xa4\x1f\xf6\xd6\xd9\x80\x63\x3a\x3b\x1c\x3d\x88\x0e\xfa\x1e\xfe\xee\x5c\xc4\xb1\x45\x29\xc1\x47\x70\x1e\x0e\xce\x4f\xbf\x84\x74\x47\xf8\x93\xd3\x12\x84\xb3\xca\x68\x11\x33\x85\x2e\x60\x4e\x72\x4f\x15\x52\x07\x87\x2d\x98\x7d\x4d\xa5\x8f\x7b\xb5\x82\xc5\x70\x51\x77\x94\x6b\x51\xdd\x67\x36\xd3\x19\xcd\xb4\xc7\x93\x4b\x06\xa0\x67\x3c\xdd\x13\x7a\xaf\xe9\x1f\x91\xc8\xca\x22\xcf\xfe\x3f\x46\xb2\x79\x5b\x53\xd9\xf7\x6b\x40\x2b\x21\xa5\x7f\x07\x00\xb3\xe2\xd3\x93\x18\x09\x00\x00")
 
 func templateDialectGremlinByTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -314,12 +407,12 @@ func templateDialectGremlinByTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/by.tmpl", size: 1875, mode: os.FileMode(420), modTime: time.Unix(1567330626, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/by.tmpl", size: 2328, mode: os.FileMode(420), modTime: time.Unix(1791980636, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x5d\x8f\xda\x38\x17\xbe\x4e\x7e\xc5\x79\x11\xad\x12\x94\x1a\xda\xbb\x77\x56\x5c\xcc\xb2\x54\x45\xea\x4e\x3f\x66\x76\x6e\xaa\x6a\xe4\xb1\x4f\xc0\x9a\x60\xa7\xb6\x43\x8b\x50\xfe\xfb\xea\x38\x01\x42\x60\x56\xfb\x79\x45\xec\xf3\xf5\x9c\xc7\xcf\xb1\xd9\xed\xc6\xa3\x78\x66\xca\xad\x55\xcb\x95\x87\x37\x93\xd7\xff\x7f\x55\x5a\x74\xa8\x3d\xbc\xe5\x02\x1f\x8d\x79\x82\x85\x16\x0c\xae\x8b\x02\x82\x93\x03\xb2\xdb\x0d\x4a\x16\xdf\xad\x94\x03\x67\x2a\x2b\x10\x84\x91\x08\xca\x41\xa1\x04\x6a\x87\x12\x2a\x2d\xd1\x82\x5f\x21\x5c\x97\x5c\xac\x10\xde\xb0\xc9\xde\x0a\xb9\xa9\xb4\x8c\x95\x0e\xf6\xf7\x8b\xd9\xfc\xe6\x76\x0e\xb9\x2a\x10\xda\x3d\x6b\x8c\x07\xa9\x2c\x0a\x6f\xec\x16\x4c\x0e\xbe\x53\xcc\x5b\x44\x16\x8f\xc6\x75\x1d\xc7\xbb\x1d\x48\xcc\x95\x46\x18\x48\xc5\x0b\x14\x7e\xbc\xb4\xb8\x2e\x94\x1e\x0b\x8b\xdc\xe3\x00\xea\x9a\xbc\x86\x8f\x95\x2a\x08\xd3\xd5\x14\x4a\xee\x04\x2f\x60\xc8\x6e\x85\x29\x91\xfd\xdc\x5a\x5a\x47\x8b\x02\xd5\xa6\xf1\x3c\x7c\x1f\xc2\xa9\x68\x5e\x69\x01\xc9\x89\x6f\x5d\xc3\xa8\x5b\xa5\xae\x53\x68\x81\xdc\xf2\x0d\x26\xc2\xff\x00\x61\xb4\xc7\x1f\x9e\xcd\x9a\xdf\x14\x92\x10\xc2\x6e\xf8\x1a\xa1\xae\x33\x40\x6b\x8d\x4d\x61\x17\x03\x00\x11\x4d\x08\x5e\xb6\x59\xd8\x67\x74\xa5\xd1\x0e\x77\x75\x30\x7f\xab\xd0\x6e\x33\x78\x54\x5a\x2a\xbd\x0c\xae\x3d\x40\xac\x8d\x4c\x52\xf6\x89\x9c\x93\x34\x8e\x54\x4e\x45\x2e\x39\x4b\x4b\x5f\x6c\xfe\x03\x05\x81\xcd\xfa\x05\x32\x02\x94\xfe\x14\xc2\xff\x37\x05\xad\x0a\xd8\xc5\x51\x64\xd1\x57\x56\xd3\x32\xc0\x8f\xa3\x7a\x5f\x24\x03\xf3\x44\x85\x94\x9b\x19\xed\x3c\xd7\x7e\x4e\xed\x25\x4d\x1a\xf3\xf4\x6c\x78\xe0\xe4\x73\x87\x58\x62\xa1\x4b\xd4\x4e\x18\x9d\xab\xe5\xd5\x59\x0f\xcd\x7e\xdd\x6f\xb3\x9b\x8c\xbd\xb5\x66\xbd\xa7\x32\xf9\xd3\x2d\xb5\x7b\xfd\x6c\x19\x79\xc5\x7f\x59\x11\x49\x0a\x23\xe9\x0a\x76\x67\xf9\x06\xad\xe3\xa1\xee\x6e\xf7\x0a\xbe\x2b\xbf\x02\x76\x53\xad\x03\x65\x96\x2b\xed\x49\x70\x51\xe4\xb7\x25\x0d\xd9\x61\xd3\x79\x5b\x09\x1f\xe0\x46\xa5\x45\xd9\xcf\x37\x1e\x77\xbd\xc9\x43\x09\xee\x91\x91\xbf\x47\xe7\x2f\xf8\x87\xed\x35\xf7\x62\x85\x0e\xb8\x96\xa0\xbc\x6b\x92\x70\xed\x29\x90\x70\x1c\x93\x06\xc5\xad\xf9\x13\x26\x5f\xbe\x8e\x8e\xdb\x19\x4c\x32\xa2\x89\x51\xbf\x4d\x53\xa8\x65\x68\x62\x43\x11\x4b\x76\x2d\xe5\x7d\x60\x8a\x7d\xe4\xe2\x89\x2f\xe9\x44\xd9\x7b\xfe\x88\x45\xeb\x6f\xb9\x5e\x22\x0c\x1f\x32\x18\xe6\x14\x32\x64\x6f\x15\x16\xd2\x35\x4c\xa8\xfc\xec\xd8\x29\x68\x98\xb3\xdb\xc0\x49\xf0\x25\xf2\x3b\x27\x1a\xd2\xaa\x9c\x9c\x7e\xd3\xea\x5b\x85\x4d\xaa\xd3\x76\xa6\xc0\xcb\x12\xb5\x4c\x3a\x9b\x19\xbc\x3c\xae\x42\xa6\x86\xee\x2b\x58\xb2\xfb\x24\x65\xef\xb8\xbb\xdc\x4a\x06\xfd\x6d\x5a\xe7\x6c\x3f\x0a\x41\x3b\xa3\xf3\x46\xce\xfb\x48\xd9\xcc\x54\xda\x27\x69\xd6\x94\xa7\x63\xba\x82\x87\x07\xb6\x70\x49\xc9\x6e\xe6\x9f\x92\x49\x9a\x1e\xf2\x26\x37\xf8\x7d\x6e\x6d\xd3\x65\x48\xf1\x9f\xe3\x6b\x81\xd1\x69\x47\x27\xe7\x1d\x45\x1b\xf6\xd1\x9a\x12\xad\xdf\x26\x24\xb7\x5b\xa5\x97\x05\xfe\x8b\xa5\x1b\x51\x76\x6b\xf6\xf4\x83\x8d\x7e\xe6\x72\x89\xad\x7c\x82\x56\x9a\x97\x45\x19\x4d\xe6\xc1\x42\x0f\x3a\x36\x4d\x77\x0c\xbd\x11\x56\x69\x9f\xc3\xe0\x85\x63\x2f\xdc\xa0\x03\x78\x88\x5d\xa8\x71\x14\xe5\xc6\x82\x92\xe1\xb5\x08\x95\x2f\x41\xc7\xbe\x3a\x4f\x64\x89\x6c\xe1\x16\x9a\x46\xf1\xa0\xcc\x1e\xce\x29\x0c\x3e\x54\x7e\x70\x62\x0d\x48\xcf\x81\x22\xbb\xdb\x96\xf8\x3c\x5c\x3a\x96\x6b\x29\xe7\x41\x18\xba\xb9\x51\xd3\x70\x2d\x26\x24\x6a\x25\xd3\x94\x2d\xf4\x7d\x72\x3c\xcf\xe2\x08\xeb\x52\xe8\x9d\x39\x06\x7e\xa8\x7c\x37\xf2\xa0\x84\x63\xa7\xef\xb8\xeb\x5f\x6e\xff\x6c\x0e\xe7\xcd\x1c\x06\x59\x9f\x02\xa3\xc5\x91\xc2\xba\x6e\x27\x76\xf1\x0b\x61\xfd\xfb\x63\x45\x6a\xfa\xa3\xa9\xd2\xfb\xe7\x9c\x18\x79\x76\x38\x2e\x08\xf7\xd9\xdb\x5f\xe5\x50\xa0\xee\x12\x92\xc2\x74\x0a\x93\x46\x45\xed\xdb\xb4\x61\xf7\xbc\xa8\xf0\x57\x5e\x26\xde\x56\xd8\x0e\x47\xe4\xc3\x33\xd8\x09\xfd\x32\xf9\xca\x88\x3b\x36\x33\xbc\x40\x27\x30\xe9\x19\x89\x8a\xec\x2c\x5d\xda\x2a\xfd\x21\x03\x61\x8f\x62\xef\xc6\xbe\xbe\xfa\xda\x20\xf2\x16\xa6\x20\x6c\xbf\x8c\x6d\x53\x7b\xbb\x07\xd7\x42\xf7\x36\xee\x29\xed\xd9\x9e\x3a\x9c\x85\x7f\x6b\xed\xf7\xef\x01\x00\x00\xff\xff\x6e\x64\xde\x2d\xcb\x0a\x00\x00")
+var _templateDialectGremlinCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x56\x4d\x6f\xdb\x46\x10\x3d\x53\xbf\x62\x42\x28\x01\x29\x30\x2b\x25\xb7\xb8\xf0\xc1\x75\xe5\x56\x40\xa2\x26\x91\xeb\x4b\x60\x18\x6b\x72\x28\x2f\x4c\x2d\xd9\xe5\x4a\xb1\x20\xf0\xbf\x77\x66\x49\x89\x14\x2d\x17\x6d\x03\x54\x17\x8a\xbb\xf3\xf1\xe6\xcd\x9b\x5d\xee\x76\xe3\xd1\xe0\x32\x2f\xb6\x46\x2d\x1f\x2c\xbc\x9f\xbc\xfb\xf0\xb6\x30\x58\xa2\xb6\x70\x25\x63\xbc\xcf\xf3\x47\x98\xe9\x58\xc0\x45\x96\x81\x33\x2a\x81\xf7\xcd\x06\x13\x31\xb8\x7e\x50\x25\x94\xf9\xda\xc4\x08\x71\x9e\x20\xd0\x6b\xa6\x62\xd4\x25\x26\xb0\xd6\x09\x1a\xb0\x0f\x08\x17\x85\x8c\xe9\xf1\x5e\x4c\xf6\xbb\x90\xe6\xb4\x3d\x50\xda\xed\x7f\x9c\x5d\x4e\xe7\x8b\x29\xa4\x2a\xa3\x10\xf5\x9a\xc9\x73\x0b\x89\x32\x18\xdb\xdc\x6c\x21\x4f\x69\xb5\x4d\x66\x0d\xa2\x18\x8c\xc6\x55\x35\x18\xec\x76\x90\x60\xaa\x34\x82\x9f\x28\x99\x91\xc3\x78\x69\x70\x95\x29\x3d\x8e\x0d\x4a\x8b\x3e\x90\x19\x59\x0d\xef\xd7\x2a\x63\x4c\x67\xe7\x50\xc8\x32\x96\x19\x0c\xc5\x22\xce\x0b\x14\x3f\x37\x3b\x8d\x21\x65\x45\xb5\xa9\x2d\x0f\xff\x0f\xee\x9c\x34\x5d\xeb\x18\x82\x23\xdb\xaa\x82\x51\x37\x4b\x55\x85\xd0\x00\x59\xc8\x0d\x06\xb1\x7d\x22\x92\xb4\xc5\x27\x2b\x2e\xeb\x67\x08\x81\x73\x11\x73\xb9\x42\x72\x88\x00\x8d\xc9\x4d\x08\xbb\x01\xd0\x8f\x88\x66\x04\x6f\x9a\x28\xe2\x2b\x96\x45\x4e\xec\xed\x2a\xb7\x6d\x0d\x85\x35\x25\x95\x41\x46\x3d\x28\xa2\xf1\x09\x42\x67\xfa\xe7\x1a\xcd\x36\x82\x3b\xb6\x3c\xb8\x89\x2f\xbc\x4a\x16\x9e\x4a\x39\xf1\xa9\x30\x89\xe1\x7f\x62\xfa\x84\x31\x17\x10\xed\x23\x1d\x82\x44\x8c\x32\xfc\xc9\xf9\xbf\x3a\x07\xad\x32\x02\xef\x79\x06\xed\xda\x68\x7e\x75\x35\x0d\xbc\x6a\x9f\x25\x02\x92\x14\x65\x52\x25\x91\x50\x5a\xa9\xed\x94\x6b\x0e\xea\x30\xb4\xf7\x92\xbb\x23\xea\x6b\x87\x6d\xa6\xa6\xcb\xde\x8e\xd8\x4d\xd5\xf2\xec\x59\x11\xf5\x7a\xd5\xaf\xb3\x1b\x4c\x5c\x99\x7c\xb5\xe7\x37\xf8\xc7\x25\x35\x6b\xfd\x68\x11\x5b\x0d\xfe\xb5\x4c\x82\x10\x46\x49\x99\x89\xeb\x43\x63\x77\x5c\xf6\x5b\xf8\xae\xec\x03\x88\xf9\x7a\xe5\x28\x33\x52\xd1\x78\x92\x0a\x3d\xcf\x6e\x0b\x9e\xbc\xc3\x22\x3d\xd7\xb1\x75\x70\x3d\x9a\xe3\xa4\x1f\x6f\x3c\xee\x5a\xb3\x85\x8a\x69\x42\x04\xdb\x5b\x2c\xed\x09\x7b\xb7\xbc\x92\x96\x26\xb8\x04\xa9\x13\x50\x74\x04\xc4\x4d\xeb\xd8\x91\x71\xb4\x41\x9d\x62\x57\xf2\x11\x83\x6f\xb7\xa3\x76\x39\x82\x49\xc4\x34\x09\xae\xb7\x2e\x0a\x29\x16\x17\xb1\x61\x8f\xa5\xb8\x48\x92\x1b\xc7\x94\xf8\x2c\xe3\x47\xb9\xe4\x8e\x8a\x8f\xf2\x1e\xb3\xc6\xde\x48\x4d\x8b\xc3\xbb\x08\x86\x29\xbb\x0c\xc5\x95\xc2\x2c\x29\x6b\x26\xa8\xb5\xfd\xb6\xb3\xd3\x30\x15\x0b\xc7\x89\xb3\x65\xf2\x3b\x1d\x75\x61\xc9\x91\x8c\xfe\xd0\x8a\x94\x5d\x87\x3a\x2e\xe7\x1c\x64\x51\x10\xd6\xa0\xb3\x18\xc1\x9b\xf6\xcd\x45\xaa\xe9\x3e\xa3\x3a\x6e\x82\x50\xfc\x26\xcb\xd3\xa5\x44\xd0\x5f\xe6\xf7\x54\xec\x47\xc1\x69\xa7\x5e\xfa\xb5\xd6\xc4\x8d\xcc\x08\x57\x50\x18\x6e\x98\x3f\xf2\x3b\x25\xfa\xc2\xef\xd5\x17\x32\xbb\x14\x6c\xad\x6d\x10\x46\x35\x2e\xee\xdf\x19\xdc\xdd\x89\x59\x19\x14\x62\x3e\xfd\x12\x4c\xc2\xf0\x90\x30\x98\xe3\x77\x9a\xbf\xba\x7c\x17\xe3\x07\x80\x8f\x9e\x77\xe0\x79\x03\xc2\x06\x18\xcb\xc0\x3b\x12\x82\xe7\x6d\xc4\x67\x43\xc7\xb1\xb1\xdb\x80\x75\xb8\x50\x7a\x99\xe1\xff\xc1\x59\x2d\xe3\x2e\x98\x9e\xe2\xb0\x56\xdc\x34\x59\x62\x23\x38\xa7\xae\xfa\x82\x52\xb9\xe6\x6d\x7f\xa6\xfd\xce\x9e\xe6\x53\x89\xaf\x1a\x86\x91\x82\xff\xba\x14\xaf\x4b\xbf\x53\xc9\x10\xbb\x35\x90\x5b\x9a\x1b\x50\x89\xbb\x74\x5c\xe6\x53\x74\x62\x5f\xcf\x47\x42\x46\xea\xf2\x4c\xf3\xf0\x1e\xb4\xdc\xc3\x49\x30\x7f\x5f\x5b\xff\x68\xd7\x21\x7d\x0e\x14\xc5\x35\x1d\x2f\x2f\xc3\xe5\x7e\xd1\xd4\x4e\x9d\x62\x74\x7d\x06\x87\xee\x20\x0d\x78\x0c\x54\x42\x3a\x9b\xe9\x9b\xa0\x6d\x74\xd6\xc2\x3a\xe5\x7a\x9d\xb7\x8e\x04\xb2\xeb\x79\x90\x48\x5b\x29\x0d\x59\xff\x38\xfc\xb1\xc9\x9d\xd6\x93\xeb\xf4\x7e\x0c\x8c\x5f\x5a\x0a\xab\xaa\x99\xf1\xd9\x2f\x8c\xf5\xbf\xcf\x1b\xab\xe9\xef\xc6\x4d\xef\xbf\x0a\x98\x91\x17\xa7\xe6\x84\x70\x5f\xbc\x2f\x88\xb9\x0c\x75\x97\x90\x10\xce\xcf\x61\x52\xab\xa8\xb9\xcd\x36\xc2\x0d\xd0\x27\x59\x04\x24\x35\x6c\x86\xc3\xb3\xee\xe2\xec\xb8\x7e\x9b\xdc\x0a\xe6\x8e\x8a\xa3\xcf\xae\x32\xc6\xa0\xb7\xc9\x54\x44\xcf\xc2\x85\x8d\xd2\x69\xac\x62\xd3\x8a\xbd\xeb\xfb\xee\xec\xb6\x46\x44\x39\x29\xa5\xe9\xa7\x31\x4d\x68\x6b\xf6\xe0\x1a\xe8\xd6\x0c\x7a\x4a\x7b\xb1\xa6\x0e\x67\xee\xa3\xaf\xf9\xff\x17\x4d\x9e\xe7\xd7\x12\x0b\x00\x00")
 
 func templateDialectGremlinCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -334,12 +427,12 @@ func templateDialectGremlinCreateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/create.tmpl", size: 2763, mode: os.FileMode(420), modTime: time.Unix(1567330629, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/create.tmpl", size: 2834, mode: os.FileMode(420), modTime: time.Unix(1792022167, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\x51\x6b\xdb\x30\x10\xc7\x9f\xad\x4f\x71\x0b\xa1\x24\x21\x51\xba\x32\x06\xcb\xc8\x43\x69\x5a\x30\x6c\x7d\x68\xbb\xbd\x8c\xd1\xaa\xd2\xb9\xd1\x26\x4b\x46\x52\xcc\x82\xf1\x77\x1f\x92\xed\xe0\x74\x61\x29\xdb\xd3\x60\x6f\xf6\xdd\xe9\xee\x7f\x3f\xfb\xaf\xaa\x9a\x4f\xc8\x85\x29\xb6\x56\x3e\xad\x3d\x9c\x9d\xbe\x7e\x37\x2b\x2c\x3a\xd4\x1e\xae\x18\xc7\x47\x63\xbe\x43\xaa\x39\x85\x73\xa5\x20\x16\x39\x08\x79\x5b\xa2\xa0\xe4\x6e\x2d\x1d\x38\xb3\xb1\x1c\x81\x1b\x81\x20\x1d\x28\xc9\x51\x3b\x14\xb0\xd1\x02\x2d\xf8\x35\xc2\x79\xc1\xf8\x1a\xe1\x8c\x9e\x76\x59\xc8\xcc\x46\x0b\x22\x75\xcc\x7f\x48\x2f\x2e\xaf\x6f\x2f\x21\x93\x0a\xa1\x8d\x59\x63\x3c\x08\x69\x91\x7b\x63\xb7\x60\x32\xf0\xbd\x61\xde\x22\x52\x32\x99\xd7\x35\x21\x55\x05\x02\x33\xa9\x11\x06\x42\x32\x85\xdc\xcf\x9f\x2c\xe6\x4a\xea\xb9\xc0\xa0\x6a\x6e\x34\x0e\xa0\xae\x43\xe5\xd0\x22\x47\x59\xa2\x85\xc5\x12\x86\xf4\xa6\x7b\x0b\x8d\xe6\x73\xb8\xb2\x26\xbf\x41\x57\x98\xa0\xd1\x71\xa6\x5d\x14\xd3\xf6\x0b\x9b\x37\x29\xc1\x3c\x03\xa9\xbd\x81\xd0\x93\x5e\xb3\x1c\xa1\xae\x29\xc9\x36\x9a\xc3\x68\x6f\x4e\x5d\xc3\xa4\x5f\x34\xde\x1b\x32\xb2\xe8\x60\xd2\xf6\xa7\x5d\x74\x0c\x68\xad\xb1\x50\x91\xa4\xcc\x59\x31\x0d\xaf\x41\xb0\x45\x47\x6f\x90\x89\xcf\x4c\x6d\xf0\x23\x2b\x46\x63\x92\xc8\x2c\x66\x5f\x2d\x41\x4b\x15\x4e\x24\x16\xfd\xc6\xea\x10\x25\x49\x4d\x92\xaa\x9a\xc1\x30\xec\x12\x3a\x14\x56\x6a\x0f\x83\x72\xb0\xa7\x90\x24\x25\xb3\x71\x95\x58\x57\xd7\xe0\xbc\xdd\x70\x1f\xdb\xa5\x2b\x80\x66\xcd\x74\x45\xef\xb6\x45\x58\x02\xe0\xe1\x9b\x33\x7a\x31\x90\x62\x6a\x72\xe9\x31\x2f\xfc\x76\xf0\x40\x92\xa4\xaa\xc0\x32\xfd\x84\x30\xbc\x9f\xc2\x30\x6b\x30\x5f\x49\x54\xc2\xc5\x41\x49\xd4\x53\x30\xc7\x99\x82\x61\xd6\x51\x09\x03\x64\x16\x02\xa9\xbb\x93\x31\x24\xb5\x7f\xfb\xa6\xaa\x00\x95\x0b\xaf\xbb\x82\x6b\xa9\x14\x7b\x54\x21\x16\xc0\xa2\x16\x4d\x76\x98\x75\xea\x76\xd1\x4e\x65\x93\x6d\x47\xfd\x2a\xb8\x29\x8e\xb0\x5a\x9a\x8b\x25\x04\xf0\x74\x15\x7f\xa0\xd1\x49\x0f\xcd\xf8\xfd\x51\xde\x7b\x6c\x69\xba\x82\x65\x9f\x2d\x4d\x57\xe4\x38\xa6\xf8\xd5\xfa\x6d\xaa\xea\x00\xb5\xd0\x78\xf6\x1c\x9c\x97\x39\xd2\x4f\x5a\xfe\x18\x9d\x4e\xf7\x06\x1f\x24\x3f\x86\x3e\xe3\xd9\x91\xea\x1e\xad\xf6\x71\x16\x9e\x5b\x04\x5a\x2a\x12\x6d\xd6\xd6\xbc\xc0\x9b\x39\xd3\xdb\x17\x98\x33\xae\x11\x2e\x8f\x26\x75\xcb\x4d\x81\xf4\x36\x06\xfe\xca\xba\xae\x6d\xf1\x5b\xeb\x76\x45\xff\x86\x75\xbf\x7c\xfd\x6f\xde\x3f\x36\x6f\x66\x2c\xdc\x4f\xa1\x8c\x9f\x2c\xa2\xe8\xa3\x0d\x07\x26\xcf\x7f\x91\x25\xb0\xa2\x40\x2d\x46\xcf\x33\x53\x38\xe9\x5f\xfc\xe1\x70\x92\xae\x16\x50\xd2\x74\x35\x6d\x60\x1e\xc3\x7d\x98\xf7\xe2\xa8\xeb\xcb\x83\xd7\x45\xdf\xea\x87\x2b\x76\x3c\x3b\x7d\x3b\x87\x27\xf5\x38\x02\x3a\xec\xf4\x9f\x01\x00\x00\xff\xff\x0d\xe1\xdf\xde\x48\x08\x00\x00")
+var _templateDialectGremlinDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4b\x6f\xdb\x46\x10\x3e\x93\xbf\x62\x4a\x30\x81\x24\xc8\xab\x34\xb7\x2a\xd0\xc1\xb0\x62\x94\x68\x1d\x18\x76\xd2\x4b\x51\x24\x1b\x72\x68\x6f\x4b\x2d\xd9\xdd\x25\x5b\x81\xd8\xff\x5e\xcc\xf2\x21\x52\x0f\xcb\x49\xd1\x02\x05\x7a\x13\x77\xe7\xf1\xcd\x37\xc3\x6f\xc4\xba\x5e\xcc\xfc\xab\xbc\xd8\x2a\xf1\xf0\x68\xe0\xf5\xab\x6f\xbf\xbb\x28\x14\x6a\x94\x06\xae\x79\x8c\x9f\xf3\xfc\x37\x88\x64\xcc\xe0\x32\xcb\xc0\x19\x69\xa0\x7b\x55\x61\xc2\xfc\xf7\x8f\x42\x83\xce\x4b\x15\x23\xc4\x79\x82\x20\x34\x64\x22\x46\xa9\x31\x81\x52\x26\xa8\xc0\x3c\x22\x5c\x16\x3c\x7e\x44\x78\xcd\x5e\x75\xb7\x90\xe6\xa5\x4c\x7c\x21\xdd\xfd\x8f\xd1\xd5\xdb\x77\xf7\x6f\x21\x15\x19\x42\x7b\xa6\xf2\xdc\x40\x22\x14\xc6\x26\x57\x5b\xc8\x53\x30\x83\x64\x46\x21\x32\x7f\xb6\xb0\xd6\xf7\xeb\x1a\x12\x4c\x85\x44\x08\x12\xc1\x33\x8c\xcd\xe2\x41\xe1\x26\x13\x72\x91\x20\xa1\x5a\xe4\x12\x03\xb0\x96\x2c\x43\x85\x31\x8a\x0a\x15\x2c\x57\x10\xb2\xbb\xee\x89\x02\x2d\x16\x70\xad\xf2\xcd\x1d\xea\x22\x97\x1a\x41\xc7\x5c\x6a\x07\xa6\x8d\x47\x95\x37\x57\x09\x37\x1c\x84\x34\x39\x50\x4c\xf6\x8e\x6f\x10\xac\x65\x7e\x5a\xca\x18\x26\xa3\x3c\xd6\xc2\x6c\x68\x34\x1d\x25\x99\x28\xd4\x30\x6b\xe3\xb3\xee\x74\x0a\xa8\x54\xae\xa0\xf6\xbd\x6a\xc3\x8b\x39\x3d\x12\x60\x85\x9a\xdd\x21\x4f\x7e\xe2\x59\x89\x37\xbc\x98\x4c\x7d\x4f\xa4\xee\xf6\x9b\x15\x48\x91\x91\x87\xa7\xd0\x94\x4a\xd2\xa9\xef\x59\xdf\xab\xeb\x0b\x08\xa9\x16\x8a\x50\x28\x21\x0d\x04\x55\x30\x42\xe8\x7b\x15\x57\xae\x14\x67\x67\x2d\x68\xa3\xca\xd8\xb8\x70\xd1\x1a\xc0\xdd\xb1\x68\xcd\xde\x6f\x0b\x2a\x02\xe0\xd3\xaf\x3a\x97\xcb\x40\x24\xf3\x7c\x23\x0c\x6e\x0a\xb3\x0d\x3e\xf9\x9e\x57\xd7\xa0\xb8\x7c\x40\x08\x3f\xce\x21\x4c\x29\x67\xc8\xae\x05\x66\x89\x76\x89\xc8\xe2\x02\x0a\xae\x63\x9e\x41\x98\x76\xac\x50\x02\x91\x42\xae\xdc\x99\xc8\x32\xfe\x39\xc3\xe1\xef\x7b\x93\x2b\xfe\x40\xa6\xc4\x26\xca\x04\xac\x6d\x7c\xc2\x94\x7d\xcf\x75\x7b\xef\x98\x01\x6b\xb5\x51\x42\x3e\x90\x65\xa6\xc9\x89\xf0\xa7\x1d\xfa\x3e\x40\x57\x45\x73\xdb\x86\xf8\x01\xb7\x60\xed\x61\x59\x4d\x4e\x47\x69\xcb\xf9\x72\x05\xd4\x1e\xb6\x76\x63\x36\x79\x39\x20\x70\xfa\xe6\x6c\x57\x46\x1d\x60\xd1\x1a\x56\xc3\x0e\xb0\x68\xed\x9f\x27\x93\xb8\x3c\x45\x41\xc7\xf5\x1f\xc2\x3c\x02\xfe\x69\x08\x7f\x08\x81\xeb\x45\x40\xd1\x82\x7b\x15\x07\x30\x69\x46\xa2\xc9\x1b\x30\x3a\x18\x35\x67\x3a\x85\x60\xad\xcd\xce\xb0\x47\x7d\xdc\xb8\x49\xec\xba\x4c\xfc\x65\xdc\x9c\x7e\x31\x2b\xea\x56\x00\x6c\x80\xb6\x63\xd9\xeb\x7a\x27\xd2\xe3\x63\x40\x59\x44\x0a\x15\x2c\xc7\xbc\xd5\xf5\xe1\x74\xbd\x81\x6a\xd8\x0a\xef\x80\xfd\x63\x4e\xb0\x82\x59\x45\x59\x6c\x33\x44\xc7\x3d\x65\x99\x65\x27\xbc\x8d\x2a\x91\x7c\x86\xd5\xec\x0a\x7d\x4e\xfe\xbd\xc2\x8e\xbc\x37\xe3\xd1\xec\x08\xbc\xa0\x87\x76\xe0\xa4\xc8\x7c\xeb\xef\x8c\x9e\xa1\x97\x1b\x2e\xb7\xcf\x10\x4c\x07\x8e\x04\x9d\x5a\x10\xb2\xfb\x38\x2f\x90\xdd\xbb\x83\xbf\x25\xa7\xba\x0d\xf1\xa4\x9c\x76\x46\xff\x0d\x39\xfd\xf9\x97\xff\x05\xf5\x1f\x16\xd4\x34\x57\xf0\x71\xde\xe8\x41\x43\xd8\xb0\x01\xf5\x4e\x2c\x69\xc7\xd0\xc6\xd8\x27\x22\x3c\xa1\xa1\x28\x8d\x30\x5b\x0a\xeb\xf0\x74\x04\xd7\xd1\x7a\x09\x15\x8b\xd6\x7d\x0f\xce\xb4\xe9\xac\x58\x7f\x89\x5c\x07\xd5\x79\xa5\x0e\x1a\xe4\x4f\xa9\xf4\xd7\xe9\xf4\x9e\x52\xb7\x8f\xe7\xc4\xba\x91\xeb\xe3\x52\x37\x68\xab\xd7\x53\x7e\x4a\x15\x67\xc7\x83\x34\xbe\x23\xb1\xee\x23\x3d\x43\xa5\x3d\x6f\xaf\x96\x2e\xe2\xd3\x60\x9e\xc0\x32\x26\x69\xfc\x34\xdb\x17\xb5\x15\xf0\xa2\x40\x99\x4c\xf6\x6f\xe6\xd0\x00\x98\xfa\x07\xd8\xbe\x24\xc8\x78\x76\xc9\xdb\xeb\x06\x78\xde\xa2\x3d\xa7\x33\x27\x94\x66\x09\x27\x48\xe8\xe3\xf6\x2b\xc9\xf3\xec\xd4\x1f\x53\x71\x7a\x51\xd1\xb7\x09\x0c\x07\x10\x0a\xae\x34\x36\x2b\xa4\x91\x23\x70\x7f\x20\xe8\xe3\x80\xc3\x87\x0f\xd1\x7a\x0e\xd1\x2d\x49\xde\xcd\xe5\x15\xa4\x04\x9d\x81\xfb\x46\x38\xbf\xf2\xda\x09\xef\x96\x68\x57\x7f\xb3\xd3\xdc\x6b\xdc\x2f\xd8\x50\xab\x78\xb4\xf1\x54\xec\xee\x44\x4a\x9a\xeb\x6e\x9b\x89\xae\xeb\xbd\xf7\x01\xac\x95\x22\xdb\x69\x68\x10\xf4\xd5\x0e\x05\x6a\xec\xd1\xc5\xec\x97\xcd\x2c\xe8\x92\xec\xb8\x1a\xfa\x46\x9a\xa8\x68\x7b\xe6\xea\xea\x17\x5e\x59\x8a\x84\xdd\x12\x8b\x93\x1d\xd4\x69\xfb\x4f\x6a\x4f\x5e\x7b\x81\x4d\x37\x86\xbd\xa5\xcf\x91\x74\x12\xb8\x0e\x34\xd4\xba\x62\x07\x43\xf0\xa2\x0a\x5c\x9e\xe9\xee\x2f\x4f\x3b\xac\x1d\xac\xe8\x76\x08\x8a\x28\x94\x68\x1a\x38\xd1\xed\x11\x40\x0e\x3b\xac\xbe\x1e\x92\x90\x15\xcf\x44\x42\x43\xc1\x93\x44\xa1\xd6\xf0\xe2\xf7\x60\x3e\x68\xd3\x21\xd8\x63\xb4\xf5\x30\x6f\x2e\xaf\xfe\x2d\xe2\x76\x7d\xed\x07\x6d\xad\x0d\x4d\xca\xf1\xc1\x7a\xd9\x0f\x83\xc3\x4e\x2f\x56\x5d\x03\xca\x04\xac\xf5\xff\x1a\x00\xc0\xea\x76\x68\xe7\x0f\x00\x00")
 
 func templateDialectGremlinDecodeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -354,12 +447,12 @@ func templateDialectGremlinDecodeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/decode.tmpl", size: 2120, mode: os.FileMode(420), modTime: time.Unix(1567330632, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/decode.tmpl", size: 4071, mode: os.FileMode(420), modTime: time.Unix(1791990556, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x41\x6f\x13\x31\x10\x85\xcf\xeb\x5f\x31\x54\x15\xb2\xa3\xc5\x29\xbd\x01\xea\xa1\x84\x20\x22\x55\x08\x9a\x8a\x6b\xe4\xd8\xb3\x1b\xab\xc6\x5e\xc6\xde\x28\xd1\xca\xff\x1d\x79\xbb\xa9\x02\x54\x9c\x6c\xcd\x7c\xe3\xf7\x9e\x67\x18\xe6\x33\xb6\x08\xdd\x91\x6c\xbb\x4b\x70\x7d\xf5\xf6\xdd\x9b\x8e\x30\xa2\x4f\xf0\x59\x69\xdc\x86\xf0\x08\x2b\xaf\x25\xdc\x3a\x07\x23\x14\xa1\xf4\x69\x8f\x46\xb2\x87\x9d\x8d\x10\x43\x4f\x1a\x41\x07\x83\x60\x23\x38\xab\xd1\x47\x34\xd0\x7b\x83\x04\x69\x87\x70\xdb\x29\xbd\x43\xb8\x96\x57\xa7\x2e\x34\xa1\xf7\x86\x59\x3f\xf6\xef\x56\x8b\xe5\xd7\xf5\x12\x1a\xeb\x10\xa6\x1a\x85\x90\xc0\x58\x42\x9d\x02\x1d\x21\x34\x90\xce\xc4\x12\x21\x4a\x36\x9b\xe7\xcc\xd8\x30\x80\xc1\xc6\x7a\x84\x0b\x63\x95\x43\x9d\xe6\x2d\xe1\x4f\x67\xfd\xdc\xa0\xc3\x84\x17\x90\x73\xa1\x2e\xb7\xbd\x75\xc5\xd3\xfb\x1b\xe8\x54\xd4\xca\xc1\xa5\x5c\xeb\xd0\xa1\xfc\x38\x75\x26\x90\x50\xa3\xdd\x3f\x91\xcf\xf7\xe7\xf1\x22\xda\xf4\x5e\x03\x3f\x67\x73\x86\xd9\xb9\x48\xce\x02\x26\x1f\xcb\x03\x6a\xae\xd3\x01\x74\xf0\x09\x0f\x49\x2e\x9e\x4e\x01\xdc\xfa\x54\x03\x12\x05\x12\x30\xb0\x8a\x30\x16\xcd\xd7\xd3\xa0\xbc\xc7\xd8\x05\x1f\x71\xc8\xac\xfa\xd5\x23\x1d\x6b\xd8\x5a\x6f\xac\x6f\x47\xee\x0f\xaf\x39\xcb\x69\x8c\x0b\xf9\xbd\xc0\x5c\xb0\xca\x36\xe5\xf9\x97\x60\x43\xe5\x26\x4f\xe6\x6a\xf8\x4b\xa0\x2e\x8b\x16\x1f\xc6\xf1\x57\x37\xe0\xad\x2b\x0e\x2b\xc2\xd4\x93\x87\xab\xd1\x36\xab\x32\x3b\x55\x08\xa3\xbc\x47\x65\x56\x3e\x71\xc1\x32\x7b\xe9\x93\xe0\x3f\xbf\xc4\x05\xcc\x4c\x74\xf2\x81\xd4\x1e\x29\xaa\x51\x2e\x15\xe7\xad\xfc\xc1\x85\xfc\xa2\xe2\x9d\xda\xa2\x1b\x1f\x94\xdf\x94\x7e\x54\x2d\x96\x20\x63\x55\xb0\xaa\x09\x04\x9b\x1a\xba\x71\x6b\xca\xb7\xf8\x4f\xe4\x8e\xd0\x58\xad\x12\xc6\x31\x4a\xc7\x93\x38\x4f\x90\xe4\xda\x1a\x5c\x36\x0d\xea\xc4\x37\x1b\xf9\x89\x42\xc7\x85\x90\x8b\xd0\x4f\x99\x86\x01\xd0\x1b\xc8\xf9\x77\x00\x00\x00\xff\xff\xfa\x87\x8a\x9c\x39\x03\x00\x00")
+var _templateDialectGremlinDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x52\xdf\x6f\xd3\x30\x10\x7e\xae\xff\x8a\x63\x9a\x50\x52\x05\xb7\xec\x0d\xd0\x1e\x46\x29\xa2\xd2\x84\x80\x4e\xbc\x56\xae\x7d\x69\xad\x19\x3b\xd8\xce\xd4\x2a\xf2\xff\xbe\x73\x9a\x86\x82\x26\x9e\xe2\xdc\x7d\xf7\xfd\x38\xbb\xeb\x66\x53\xb6\x70\xcd\xd1\xeb\xdd\x3e\xc2\xcd\xfc\xed\xbb\x37\x8d\xc7\x80\x36\xc2\x67\x21\x71\xeb\xdc\x23\xac\xac\xe4\x70\x67\x0c\xf4\xa0\x00\xb9\xef\x9f\x50\x71\xf6\xb0\xd7\x01\x82\x6b\xbd\x44\x90\x4e\x21\xd0\xaf\xd1\x12\x6d\x40\x05\xad\x55\xe8\x21\xee\x11\xee\x1a\x21\xe9\x73\xc3\xe7\xe7\x2e\xd4\x8e\xda\x4c\xdb\xbe\x7f\xbf\x5a\x2c\xbf\xae\x97\x50\x6b\x43\x14\xa7\x9a\x77\x2e\x82\xd2\x1e\x65\x74\xfe\x08\xae\xa6\xea\x1f\xb1\xe8\x11\x39\x9b\xce\x52\x62\xac\xeb\x40\x61\xad\x2d\xc2\x95\xd2\xc2\xd0\xc0\x6c\xe7\xf1\x97\xd1\x76\xa6\xd0\x60\xc4\x2b\x20\x18\xa1\xae\xb7\xad\x36\xd9\xd3\xfb\x5b\x68\x44\x90\xc2\xc0\x35\x5f\x4b\xd7\x20\xff\x38\x74\x06\x20\xa9\xa2\x7e\x3a\x21\xc7\xf3\x38\x9e\x45\xeb\xd6\x4a\x28\x2e\xb1\x29\xc1\xf4\x52\x24\xa5\x12\x06\x1f\xcb\x03\xca\x42\xc6\x03\xed\xc8\x46\x3c\x44\xbe\x38\x7d\x4b\x28\xb4\x8d\x15\xa0\xf7\xce\x97\xd0\xb1\x09\xad\x36\x6b\xbe\x1e\x06\xf9\x0f\x0c\x8d\xa3\x7d\x75\x89\x4d\xa2\x17\x24\x13\xc8\x35\x21\xfe\x72\x99\x12\x1f\x06\x8a\x92\x4d\x7e\xb7\xe8\x8f\x15\x6c\x32\x6c\x9c\xe1\xdf\x73\x35\xb7\x75\x9d\xf5\x5e\xe2\x50\x3e\x9f\xf8\xd9\x6d\x05\x03\xd3\x48\x52\xe5\xab\x2f\x3f\xf4\xf3\xaf\x6e\xc1\x6a\x93\x3d\x93\xe9\xd8\x7a\x0b\xf3\x3e\x08\x9b\x24\x76\xae\x10\x9a\x12\x08\xb5\xb2\x91\x94\x69\x6b\x2f\xac\x0d\xfe\xb3\xb7\xa2\x84\xa9\x0a\x86\x3f\x8c\xc9\x49\x2e\x66\xeb\x3b\xfe\xb3\x28\xf9\x17\x11\xee\xc5\x16\x4d\x4f\xc8\xbf\x09\xf9\x28\x76\x98\x93\xf4\x55\xca\x5a\x3b\x0f\x9b\x0a\x9a\xfe\x1e\x85\xa5\xe6\xbf\x99\xe9\xb1\x2b\x2d\x45\xa4\xbd\xe7\x28\x4d\x11\xcb\xcb\x04\x91\xaf\xb5\xc2\x65\x5d\xd3\xab\x2a\x36\x1b\xfe\xc9\xbb\xa6\x28\x4b\xba\xc0\x76\xc8\x44\x84\x68\x15\x51\x3d\x03\x2d\x96\xca\x83\x4b\x03\x00\x00")
 
 func templateDialectGremlinDeleteTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -374,12 +467,12 @@ func templateDialectGremlinDeleteTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/delete.tmpl", size: 825, mode: os.FileMode(420), modTime: time.Unix(1568645716, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/delete.tmpl", size: 843, mode: os.FileMode(420), modTime: time.Unix(1792022167, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xc1\x6e\xdc\x36\x10\x3d\x5b\x5f\xf1\xb2\x8d\x03\x69\xab\x6a\x9d\xdc\xea\xc2\x87\x60\xbb\x6e\x03\x14\x46\xda\x24\x27\xc3\x07\xae\x38\xd2\x12\xa6\xc8\xcd\x90\x52\x6a\x2c\xf4\xef\x05\x49\x69\xeb\xda\x1b\x34\x87\xdc\x04\xce\xf0\xbd\x79\x8f\x4f\x73\x38\xac\x96\xd9\xda\xee\x1f\x58\xb5\x3b\x8f\x37\x17\xaf\x7f\xfe\x69\xcf\xe4\xc8\x78\x5c\x8b\x9a\xb6\xd6\xde\xe3\x9d\xa9\x2b\xbc\xd5\x1a\xb1\xc9\x21\xd4\x79\x20\x59\x65\x1f\x77\xca\xc1\xd9\x9e\x6b\x42\x6d\x25\x41\x39\x68\x55\x93\x71\x24\xd1\x1b\x49\x0c\xbf\x23\xbc\xdd\x8b\x7a\x47\x78\x53\x5d\xcc\x55\x34\xb6\x37\x32\x53\x26\xd6\xff\x78\xb7\xde\xdc\x7c\xd8\xa0\x51\x9a\x30\x9d\xb1\xb5\x1e\x52\x31\xd5\xde\xf2\x03\x6c\x03\xff\x88\xcc\x33\x51\x95\x2d\x57\xe3\x98\x65\x41\x03\xea\xde\x79\xdb\x81\x98\x2d\x3b\x08\x23\xe7\xcf\x9d\x30\x52\x13\x3b\x34\x6c\x3b\xb8\xcf\x1a\x52\x09\x4d\xb5\x77\x88\xd7\x0f\x07\x48\x6a\x94\x21\x2c\xa6\xc2\xaa\x65\xea\xb4\x32\xab\x84\xb0\x40\xea\x7a\xb9\xbf\x6f\x71\x79\x85\xad\x70\x84\x97\xd5\xda\x9a\x46\xb5\xd5\x7b\x51\xdf\x8b\x96\x42\x4f\xb6\x5a\x61\x1d\x4d\xe8\xf6\x9a\x3a\x32\xde\x45\x25\xd2\xe9\xea\x26\x9e\x1b\x4f\xdc\x88\x9a\xaa\xac\xe9\x4d\x8d\x9c\xb0\x61\x5e\x5b\xe3\x3c\x0b\x65\xfc\xb5\x50\x9a\x64\x11\x41\xf2\x02\xb9\xf3\xac\x4c\x5b\xe2\xf6\xee\x78\xf3\x30\x16\x38\x64\x67\x4c\xbe\x67\x03\xe7\xb9\xb6\x66\xa8\xfe\xec\xad\xa7\x9c\xaa\x3d\x53\xa3\xfe\xce\x0b\xfc\x08\xaa\x3a\xd7\x16\x25\x8c\xd2\xd9\x98\x1d\xf9\x96\x27\x09\x3f\x99\x4e\xb0\xdb\x09\xfd\x1b\x8b\xfd\xce\x59\x93\x6f\x71\x7b\xb7\x7d\xf0\x54\x24\x1b\x03\xe7\x20\x18\x03\x6e\x5f\xdf\x2d\xd3\x5c\xd9\x99\x6a\x42\x35\x78\xd2\x4e\xf7\xaa\x23\x52\xbe\x2d\xf1\x6a\x28\x7e\x89\x1d\x2f\xae\xc2\x1c\x01\x64\x9e\x9c\x98\xb3\xb3\x31\x42\x0c\xb7\x17\x77\xb8\x7a\xd6\xd1\x74\xbe\xda\x04\xee\x26\x5f\xcc\xee\x8f\xe3\x25\x3a\xe5\x9c\x32\x2d\xd2\x10\x18\x84\xee\x69\x51\xcc\x60\x2f\xd2\xb1\xab\x7e\x17\xee\x7d\x72\x63\x19\x08\x4a\xfc\xeb\x4e\xf1\x2d\x34\xca\x0c\x42\x2b\x39\xd3\x34\x96\x93\x13\x97\x38\x77\x8b\x12\x11\x34\xb1\x46\xa7\x71\x85\x99\xf9\x23\xab\xee\xab\xd4\xc7\xa7\x9b\xde\x65\xb5\x42\xaa\x21\x9d\xa7\xc4\x4c\x47\x7d\xf8\x8b\x02\xf3\x14\x48\xd4\xe1\xe5\x84\xf1\x6e\x0e\xd0\xc9\xd7\x3c\xa6\x60\x9a\xfd\x30\x61\x63\xb1\x49\x0a\x16\x48\xcc\x37\xf4\x65\xc3\xfc\xc9\xa8\xcf\x3d\x5d\x2b\xd2\x12\x35\x93\xf0\xe4\x20\x12\x55\x84\x9d\x12\x10\xe6\xe8\x63\x2b\x9a\xd0\x3b\xcf\xf0\x0c\x24\xd7\x62\x4b\xba\x4c\x5d\x98\x33\x3c\xe0\x3f\x19\x3e\x95\xc3\x47\xc1\x7e\x75\xa2\x7c\xe8\x5c\x7b\x19\xdf\xeb\xc3\x9e\x95\xf1\x4d\xbe\x48\x14\xe7\xae\x3a\x77\xf8\xa2\xfc\x2e\xa5\xe1\x12\xe7\x3f\x0c\x8b\x12\x8f\xe7\x28\x31\x14\x63\xf6\x5c\xf6\x46\xb6\xf4\x8d\xaa\x49\xb6\x74\x4a\x74\x80\x98\x35\x87\x9e\x12\xc7\xd8\x7c\x2f\x9d\x01\xf6\xb1\x4c\x25\x9f\x68\x9c\x79\x8f\x1a\x95\x5b\x4f\x59\x89\x6f\x0e\x65\xa4\xaa\xa3\x48\xd5\xc4\x88\xb5\x6a\x20\x13\x36\xf9\xde\x86\x75\xbc\xb3\x5a\x06\x03\x9e\x26\x2d\x7c\x78\xa1\x4c\xc8\x91\x30\xc9\x94\xc9\x83\x27\x14\x39\x63\x39\xdd\xae\xfe\x9a\x60\x0b\xe4\xa7\x0c\x28\xb1\xb5\x56\xc7\xdf\x90\xc2\xf6\x38\xe9\xc2\xf8\x3f\xfb\x85\x03\x4b\xaf\x7d\xf5\xab\xf0\xa2\x04\x7d\x75\xd5\x18\x15\x42\x20\xb4\xa3\xf8\xbb\xce\x0b\xa8\x84\xe7\x9e\xb2\xb8\xda\xc9\x48\x8c\xe3\x3f\x01\x00\x00\xff\xff\xe3\x20\x22\xd9\x0c\x07\x00\x00")
+var _templateDialectGremlinErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\xc1\x8e\xdb\x36\x10\x3d\x47\x5f\xf1\xa2\x66\x03\xc9\xab\xca\x9b\xdc\xea\x62\x0f\x81\xbb\x69\x03\x14\x8b\xb4\x49\x4e\x8b\x3d\xd0\xe2\x48\x26\x96\x22\x9d\x21\xa5\x74\x21\xe8\xdf\x0b\x52\xd2\xc6\xb0\x9b\x36\x40\x4f\x36\x38\x4f\xef\xcd\xbc\x79\xe4\x30\xac\x57\xc9\xd6\x1e\x1e\x59\x35\x7b\x8f\xd7\x57\xaf\x7e\xfa\xf1\xc0\xe4\xc8\x78\xbc\x15\x15\xed\xac\x7d\xc0\x3b\x53\x95\x78\xa3\x35\x22\xc8\x21\xd4\xb9\x27\x59\x26\x1f\xf7\xca\xc1\xd9\x8e\x2b\x42\x65\x25\x41\x39\x68\x55\x91\x71\x24\xd1\x19\x49\x0c\xbf\x27\xbc\x39\x88\x6a\x4f\x78\x5d\x5e\x2d\x55\xd4\xb6\x33\x32\x51\x26\xd6\x7f\x7f\xb7\xbd\xb9\xfd\x70\x83\x5a\x69\xc2\x7c\xc6\xd6\x7a\x48\xc5\x54\x79\xcb\x8f\xb0\x35\xfc\x91\x98\x67\xa2\x32\x59\xad\xc7\x31\x49\xc2\x0c\xa8\x3a\xe7\x6d\x0b\x62\xb6\xec\x20\x8c\x5c\xfe\xee\x85\x91\x9a\xd8\xa1\x66\xdb\xc2\x7d\xd6\x90\x4a\x68\xaa\xbc\x43\xfc\x7c\x18\x20\xa9\x56\x86\x90\xce\x85\x75\xc3\xd4\x6a\x65\xd6\x13\x43\x8a\x09\xf5\xe2\xf0\xd0\x60\x73\x8d\x9d\x70\x84\x17\xe5\xd6\x9a\x5a\x35\xe5\x7b\x51\x3d\x88\x86\x02\x26\x59\xaf\xb1\x8d\x26\xb4\x07\x4d\x2d\x19\xef\xe2\x24\xd2\xe9\xf2\x36\x9e\x1b\x4f\x5c\x8b\x8a\xca\xa4\xee\x4c\x85\x8c\xb0\xb5\xc6\x79\x16\xca\xf8\x9b\x20\x96\x47\x82\x2c\x47\xe6\x3c\x2b\xd3\x14\xb8\xbb\x7f\xfa\x6a\x18\x73\x0c\xc9\x33\x26\xdf\xb1\x81\xf3\x5c\x59\xd3\x97\x7f\x74\xd6\x53\x46\xe5\x81\xa9\x56\x7f\x65\x39\x2e\x41\x65\xeb\x9a\xbc\x80\x51\x3a\x19\x93\x27\xad\xd5\x99\xd8\x27\xd3\x0a\x76\x7b\xa1\x7f\x65\x71\xd8\x3b\x6b\xb2\x1d\xee\xee\x77\x8f\x9e\xf2\xc9\xbe\xa0\xd7\x0b\x46\x8f\xbb\x57\xf7\xab\xa9\xa7\xe4\x99\xaa\x43\x35\x78\xd1\xcc\xdf\x95\x4f\x4c\xd9\xae\xc0\xcb\x3e\xff\x39\x22\x9e\x5f\x87\x1e\x02\xc9\xd2\x35\x31\x27\xcf\xc6\x48\xd1\xdf\x5d\xdd\xe3\xfa\x0c\x51\xb7\xbe\x8c\xed\xd5\x59\xba\xb8\x3e\x8e\x1b\xb4\xca\x39\x65\x9a\x30\x77\xf8\xe9\x85\xee\x28\xcd\x17\xb2\xe7\xd3\xb1\x2b\x7f\x13\xee\xfd\xe4\xc4\x2a\x08\x14\xf8\xea\x4c\xfe\x3d\x32\xca\xf4\x42\x2b\xb9\xc8\xd4\x96\xc3\x24\x96\x37\xb8\x70\x69\x81\x48\x3a\xa9\x46\x97\x71\x3d\x23\x5d\xf9\x91\x55\xfb\x4d\xe9\xa7\xb5\xcd\x3b\x59\xaf\x31\xd5\x30\x9d\x4f\x49\x99\x8f\xba\x70\x7b\x82\xf2\x1c\x44\x54\x61\x71\xc2\x78\xb7\x04\xe7\x6c\x93\x8b\xd0\xd2\xf7\x30\xf3\x22\x8d\x80\x0d\x52\x4c\xaa\xb7\xf4\xe5\x86\xf9\x93\x51\x9f\x3b\x7a\xab\x48\x4b\x54\x4c\xc2\x93\x83\x98\x64\x62\x18\xe7\xed\x87\x1e\xba\x08\x45\x1d\xb0\x8b\xfe\x19\x49\xa6\xc5\x8e\x74\x31\xa1\xe6\x1e\x0a\xf4\x5f\x13\x1f\xb2\x7b\x9a\xbf\xa3\x30\xbf\x3c\x29\x0d\xad\x6b\x36\x71\x47\x1f\x0e\xac\x8c\xaf\xb3\x74\xa2\xbe\x70\xe5\x85\xc3\x17\xe5\xf7\x53\x02\x36\xb8\xf8\xa1\x4f\x0b\x1c\xeb\x17\xe8\xf3\xe2\xe8\x66\x6d\xa6\x2a\x2e\x91\x96\x29\x2e\x27\xd0\x98\x9c\xfb\x71\x23\x1b\xfa\x4e\x3b\x48\x36\xf4\x4f\x6e\x04\x8a\xc5\x8c\x80\x29\xf0\x94\xa5\xff\x6b\x40\xa0\x3b\x9e\x5f\xc9\x93\xe1\x17\xbd\x7f\x1d\x3e\x80\x96\xd9\x95\xdb\xce\xc1\x8a\x9a\x50\x46\xaa\x2a\x0e\xaf\xc2\x6b\x4b\x68\x54\x4f\x26\x3c\xf7\x07\x6b\x1c\x61\x6f\xb5\x0c\x39\x39\x8d\x65\x70\xca\x0b\x65\x42\xf0\x44\xbc\xe3\x96\x67\x6f\x4e\x24\x32\xc6\x6a\xfe\xba\xfc\x73\xa6\xcd\x91\x9d\x1a\x53\x60\x67\xad\x8e\xf7\x95\xc2\x33\x73\xe6\xce\xf8\x1f\x8f\x10\x07\xf6\x4e\xfb\xf2\x17\xe1\x45\x01\xfa\xe6\x7b\x64\x54\x48\x8d\xd0\x8e\xe2\x9d\x9e\x4f\xa9\x80\xe7\x8e\x92\x31\x19\x06\x90\x91\x18\xc7\xbf\x07\x00\x6e\x3d\x8c\x30\x29\x07\x00\x00")
 
 func templateDialectGremlinErrorsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -394,12 +487,12 @@ func templateDialectGremlinErrorsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/errors.tmpl", size: 1804, mode: os.FileMode(420), modTime: time.Unix(1567330638, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/errors.tmpl", size: 1833, mode: os.FileMode(420), modTime: time.Unix(1791985788, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xc1\x6e\xdb\x38\x10\x3d\x8b\x5f\x31\x6b\x04\x0b\xd1\xab\xa5\x93\xdc\x76\x0b\x1f\x92\x34\x09\x02\x34\x01\x1a\xa7\xbd\x14\x85\xc1\x90\x43\x9b\xa8\x4c\xaa\x24\x25\xd8\x10\xf8\xef\x05\x69\xd9\x70\x12\xa7\x40\x4f\x24\x67\xde\xcc\x7b\xc3\x47\xa9\xef\x27\x63\x72\x65\x9b\x8d\xd3\x8b\x65\x80\xf3\xd3\xb3\xff\xfe\x6d\x1c\x7a\x34\x01\x6e\xb8\xc0\x67\x6b\x7f\xc0\x9d\x11\x0c\x2e\xea\x1a\x32\xc8\x43\xca\xbb\x0e\x25\x23\x4f\x4b\xed\xc1\xdb\xd6\x09\x04\x61\x25\x82\xf6\x50\x6b\x81\xc6\xa3\x84\xd6\x48\x74\x10\x96\x08\x17\x0d\x17\x4b\x84\x73\x76\xba\xcb\x82\xb2\xad\x91\x44\x9b\x9c\xff\x74\x77\x75\xfd\x30\xbb\x06\xa5\x6b\x84\x21\xe6\xac\x0d\x20\xb5\x43\x11\xac\xdb\x80\x55\x10\x0e\xc8\x82\x43\x64\x64\x3c\x89\x91\x90\xbe\x07\x89\x4a\x1b\x84\x91\xd4\xbc\x46\x11\x26\x0b\x87\xab\x5a\x9b\xc9\xc2\xd9\xb6\x19\x41\x8c\x09\x74\xf2\xdc\xea\x3a\x49\xfa\x7f\x0a\x0d\xf7\x82\xd7\x70\xc2\x66\xc2\x36\xc8\x2e\x87\xcc\x00\x74\x28\x50\x77\x5b\xe4\x7e\xbf\x2f\x4f\x9c\xaa\x35\x02\xca\x17\xd8\x18\x61\x7c\xc8\x12\x23\x85\x41\xc7\x4c\x70\x53\x8a\xb0\x06\x61\x4d\xc0\x75\x60\x57\xdb\xb5\x82\x0e\xb4\x09\xe8\x14\x17\xd8\x47\x0a\xe8\x9c\x75\xd0\x93\xc2\xa1\x4f\xe4\x7f\x0f\x0d\xd8\x23\xfa\xc6\x1a\x8f\x7d\x24\xc5\xcf\x16\xdd\xa6\x82\x67\x6d\xa4\x36\x8b\x8c\x7b\x25\x84\x0d\x65\x9f\x13\xb2\xa4\x6c\x58\x49\xa1\x55\xa2\x38\x56\x21\x5d\xda\xb1\xeb\x35\x8a\xa4\xb4\x82\x57\x2c\x55\x72\x9d\x7e\xc8\xe5\x7f\x4d\xc1\xe8\x3a\xc9\x2c\x1c\x86\xd6\x99\x14\x25\x45\xcc\xfd\x6b\x34\xaf\xef\x85\x29\x8d\xb5\xf4\xf4\x9f\xa3\x39\xe3\x29\x4c\xa7\x70\x76\xd8\xcf\xa1\x67\x8f\xc8\xe5\x57\x5e\x97\x1d\xcd\xad\xbb\x55\xb5\xd3\x7e\x90\x6d\xf1\x9e\x37\x07\x93\xbd\x2f\x6d\x38\x76\x2b\xf6\x11\xd3\x53\x4d\x7d\x23\xf9\x53\x27\x87\x9b\x84\xb1\xf4\x35\x7b\x72\xbc\x43\xe7\x79\xe6\xeb\xb8\x83\x92\x14\x45\x70\x1e\xbe\x7d\x3f\x70\x95\x14\x85\xe1\x2b\x7c\x13\xa5\xa4\x50\xd6\xc1\xbc\x02\x65\xf2\x54\xdc\x2c\xf0\x8d\x2f\xca\xf8\x3c\x4d\x6a\x51\x41\xc8\xf3\x2b\xc3\x6e\xb7\x72\xca\x51\x33\xaa\x60\x34\xa2\x03\xf1\x14\x78\xd3\xa0\x91\x65\x70\x3e\xa1\xe9\x9e\x7c\x9f\xc9\xc7\x0a\xd2\xb2\xbd\xd8\x9d\x88\xdf\x68\xc8\xf6\xed\x65\xbc\x6d\xa6\x8e\xf3\xcf\xe7\xec\xc2\x27\x89\x94\x7d\x31\xca\xd6\xb2\xa4\x2c\x7b\xe6\x4b\x45\x53\x4a\x51\x7a\xe8\xcd\x3b\xaf\x98\xdd\xa6\xaf\xb8\xa4\x8c\x14\x45\x51\x5c\x6e\xca\xf9\x7c\xd7\xe6\xb8\x52\xc6\x18\x65\x37\x99\xef\x45\xd1\x36\xc4\xee\x79\x10\xcb\xa4\x30\xe3\x66\x98\xfe\x18\xdb\x49\x52\x60\xa8\x18\xc2\xc9\xe6\x2d\xd7\x10\x7f\xc0\x75\x28\xd3\xcb\xe9\x7b\x40\x23\x21\xc6\x5f\x01\x00\x00\xff\xff\xbd\x12\x2b\x4f\x43\x05\x00\x00")
+var _templateDialectGremlinGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x55\xdd\x6f\xdb\x36\x10\x7f\xb6\xfe\x8a\x9b\x11\x0c\x52\xa6\xd1\x6d\x81\x3d\x6c\x83\x1f\xd2\x34\xed\x02\xb4\xc5\xb6\x64\xdb\xc3\x30\xa4\x0c\x75\xb4\x89\xd2\xa4\x46\x52\x5a\x0c\xc3\xff\xfb\xee\x28\x45\x75\x5a\x37\xd8\xfa\x44\xf1\xbe\x7e\xbf\xfb\x10\x6f\xb7\x5b\x9c\x16\xe7\xbe\xdd\x06\xb3\x5a\x27\x78\xf6\xe4\xe9\xf7\xdf\xb6\x01\x23\xba\x04\x2f\xa5\xc2\x5b\xef\xdf\xc3\xa5\x53\x02\xce\xac\x85\x6c\x14\x81\xf5\xa1\xc7\x46\x14\xd7\x6b\x13\x21\xfa\x2e\x28\x04\xe5\x1b\x04\xba\x5a\xa3\xd0\x45\x6c\xa0\x73\x0d\x06\x48\x6b\x84\xb3\x56\x2a\x3a\x9e\x89\x27\xf7\x5a\xd0\x9e\xd4\x85\x71\x59\xff\xfa\xf2\xfc\xe2\xed\xd5\x05\x68\x63\x29\xc4\x20\x0b\xde\x27\x68\x4c\x40\x95\x7c\xd8\x82\xd7\x24\xfd\x00\x96\x02\xa2\x28\x4e\x17\xfb\x7d\x51\xec\x76\xd0\xa0\x36\x0e\x61\xde\x18\x69\xc9\x61\xb1\x0a\xb8\xb1\xc6\xd1\xe9\xbb\x76\x0e\x64\x45\x46\x27\xb7\x9d\xb1\x4c\xe9\x87\x25\xb4\x32\x2a\x69\xe1\x44\x5c\x29\xdf\xa2\x78\x3e\x6a\x46\x43\x02\x45\xd3\x0f\x96\xd3\xf7\xe4\xce\x98\xba\x73\x0a\xca\x07\xb6\xfb\x3d\x9c\x1e\xa2\xec\xf7\x15\x8c\x3c\xae\x94\x74\xa5\x4a\x77\x54\x23\x97\xf0\x2e\x89\xf3\xe1\xac\xa1\xa7\x74\x13\x06\x4d\xa5\xde\x91\x3d\x86\xe0\x03\xec\x8a\x19\x95\x98\xc1\xbf\x1e\x03\x88\x5f\x31\xb6\x9e\xea\xb6\xdb\x17\xb3\x14\x24\xe1\x45\xa2\x4f\x16\x1f\x51\x10\xa3\xc3\x2f\x1d\x86\x6d\x59\x15\xb3\xbf\xf9\xa3\x86\x1b\xb6\x9d\x1c\xc5\xa4\x36\x9a\x31\x8f\x05\x6a\x02\x7f\x89\x8b\x3b\x54\x4c\xbd\x86\x31\xd2\x14\xa4\xe6\x39\xa8\x7e\xcc\xfe\x5f\x2d\xc1\x19\xcb\xc4\x89\x79\xea\x82\x63\x69\x31\xdb\x67\x00\x8b\xee\xe3\x4a\x09\x6d\xd0\x36\xb1\xfa\xe6\xa8\xce\xc5\x0a\x96\x4b\x78\x7a\x18\x8f\xb0\xa8\x08\xb2\xf9\x5d\xda\xb2\xaf\x72\xe8\x7e\x53\xdf\x93\x3f\xd0\x76\xf8\x46\xb6\x07\xa9\x7d\x9e\xda\x78\xed\x37\xe2\x05\xf2\xf0\x72\x5c\x6a\xed\xff\xec\xed\x58\x4a\x38\x6d\xa2\x15\xd7\x53\x6b\x08\xaf\x97\x01\x4a\x82\x4d\x21\xc2\x9f\x7f\x1d\xf4\x99\x64\x4e\x6e\xf0\x13\x29\x91\xd6\xd4\xfe\x9b\x1a\xb4\xcb\x59\x49\xb7\x42\x38\x52\x9e\x9c\x0d\x87\xe0\x76\xb0\xa5\x76\xe2\xd5\x40\xa7\x9c\xb7\xf3\x1a\xe6\xf3\x6a\x04\x5e\x82\x6c\x5b\x74\x4d\x49\x17\xb6\xae\x26\xf0\x49\x93\xaf\x35\xf0\x31\x14\xf6\x9e\xc4\x23\x1c\x72\xfb\x26\x1a\x9f\x06\xd3\xc7\xf1\x6f\x6e\xc4\x59\x64\x8a\x95\xf8\xcd\x69\x6f\x9b\xb2\x12\xb9\x67\xb1\xd4\x15\xab\x74\x35\xf6\xf6\x91\xd9\xa6\x54\xe9\xaf\x26\x4f\x82\x98\xcd\x9e\x6f\x4b\x8a\x3a\x06\x39\xce\x53\x08\x51\x89\x97\x19\xed\x81\xd3\x20\x12\x6f\x64\x52\x6b\xe6\x97\xed\xae\x90\x5f\x90\x21\x0f\x16\x8c\x1e\xa3\x98\x9b\x3c\x60\x0d\x13\xf6\x19\x8a\x3f\xc9\xde\xb8\xd5\xe1\xec\xf5\x54\x89\xfe\x43\xd2\x7f\xac\x31\x60\xf9\xa8\xf7\x3d\xe5\x07\xb3\x2a\xde\xd2\xb3\x51\xf2\x9c\x92\x2f\x95\x15\xfe\xcb\x03\xb8\x58\xe7\x80\x8b\xb4\x6d\x91\x1f\xc3\x87\xa3\xfa\x05\x81\xf0\x4e\x6e\x5a\x9b\x63\x51\x19\xc7\xd2\x90\xf3\xad\xa4\x97\xfd\x84\x5f\x37\x6d\x56\xe2\x67\xa9\xde\x4b\x1a\x1e\x4a\xec\x05\x6a\xd9\xd9\x74\x4e\x8f\x7e\x7a\x2d\x6f\xd1\x56\xe2\x32\x96\xad\x78\x75\x5d\x7e\x57\x55\x5f\xc0\x40\xf9\xcd\x86\x76\x14\x33\xb8\x74\x30\xce\x7e\x9d\x17\x07\xad\xaf\xc6\x28\x99\xf2\x32\xa2\xe1\xb3\x86\x77\x51\xe4\x7e\x48\x78\xf7\x0f\x17\xfe\x1d\xc4\x84\x2d\xf8\x61\xd3\xf4\xb9\x9f\xbc\x61\x90\xd6\x14\x64\x1c\x31\x71\xfa\x17\xc0\x62\x47\xd9\x22\x07\x00\x00")
 
 func templateDialectGremlinGroupTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -414,7 +507,7 @@ func templateDialectGremlinGroupTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/group.tmpl", size: 1347, mode: os.FileMode(420), modTime: time.Unix(1567526275, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/group.tmpl", size: 1826, mode: os.FileMode(420), modTime: time.Unix(1792022167, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -439,7 +532,7 @@ func templateDialectGremlinMetaTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x90\x41\x6b\xdc\x30\x10\x85\xcf\xd2\xaf\x98\xe6\xb4\x0e\xae\x9c\xe6\xd6\x85\x1c\xc2\x76\x0b\x81\xb0\x94\xa6\xfd\x01\xaa\x34\x5e\x0f\x55\x46\x62\x24\xbb\x04\xa3\xff\x5e\x6c\xd6\xdb\x92\xa3\xde\x7b\x7a\xdf\x63\xe6\xb9\xbb\xd5\x87\x98\xde\x84\xce\x43\x81\xfb\xbb\x4f\x9f\x3f\x26\xc1\x8c\x5c\xe0\xab\x75\xf8\x2b\xc6\xdf\xf0\xc4\xce\xc0\x63\x08\xb0\x86\x32\x2c\xbe\x4c\xe8\x8d\xfe\x31\x50\x86\x1c\x47\x71\x08\x2e\x7a\x04\xca\x10\xc8\x21\x67\xf4\x30\xb2\x47\x81\x32\x20\x3c\x26\xeb\x06\x84\x7b\x73\xb7\xb9\xd0\xc7\x91\xbd\x26\x5e\xfd\xe7\xa7\xc3\xf1\xf4\x72\x84\x9e\x02\xc2\x45\x93\x18\x0b\x78\x12\x74\x25\xca\x1b\xc4\x1e\xca\x7f\xb0\x22\x88\x46\xdf\x76\xb5\x6a\x3d\xcf\xe0\xb1\x27\x46\xb8\xf1\x64\x03\xba\xd2\x9d\x05\x5f\x03\x71\xe7\x02\x21\x97\x2e\x26\xe4\x1b\xa8\x55\xab\xb1\x05\x14\x81\xfd\x03\x8c\x12\xcc\x37\x2b\x19\x77\xde\x16\xfb\xb2\xd6\x9e\xec\x2b\x36\x5a\x51\xbf\x86\x3e\x3c\x00\x53\x80\x59\x2b\x25\x58\x46\xe1\xe5\xb9\xfe\xd7\xaa\x6a\xe5\xae\x55\x17\x9a\x39\xe1\x9f\xc3\x0a\xdc\x6d\xca\x21\x72\x4f\xe7\xa5\xe1\xc8\x3e\x45\xe2\xb2\xbf\xa6\x37\x65\x71\xd5\xcf\xef\xcf\x7b\x18\x5b\xad\x54\x6d\xb5\xaa\x8d\x56\x5e\xa6\x77\xdd\x5f\x84\x26\x94\x9d\x6b\xf4\xb6\xe7\x1f\xd0\xa6\x84\xec\x77\x31\x15\x8a\x9c\x5b\xb8\x64\xbd\x4c\x4d\x63\x8c\x69\xda\x65\xfb\x72\x29\x64\x0f\xb5\xfe\x0d\x00\x00\xff\xff\x72\x49\x92\xec\xf7\x01\x00\x00")
+var _templateDialectGremlinOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x52\xc1\x6e\xdb\x30\x0c\x3d\xdb\x5f\xc1\xf5\x30\xd8\x85\xa7\xb4\xbd\x2d\x43\x0f\x45\xe6\x01\x01\x8a\x60\x6b\xbb\xf3\xa0\x4a\x74\xa2\x55\x95\x34\x4a\xce\x1a\x18\xf9\xf7\xd1\xae\xed\x16\x03\x86\xee\x22\x99\x7c\x8f\x8f\x8f\x94\xbb\x6e\x71\x9a\xaf\x7c\x38\x90\xd9\xee\x12\x5c\x9c\x9d\x7f\xfc\x10\x08\x23\xba\x04\x5f\xa4\xc2\x7b\xef\x1f\x60\xed\x94\x80\x2b\x6b\x61\x20\x45\xe8\x71\xda\xa3\x16\xf9\xdd\xce\x44\x88\xbe\x25\x85\xa0\xbc\x46\xe0\xd0\x1a\x85\x2e\xa2\x86\xd6\x69\x24\x48\x3b\x84\xab\x20\x15\x5f\x17\xe2\x6c\x42\xa1\xf1\x0c\xe7\xc6\x0d\xf8\xf5\x7a\x55\x6f\x6e\x6b\x68\x8c\x65\x89\xe7\x1c\x79\x9f\x40\x1b\x42\x95\x3c\x1d\xc0\x37\x9c\x7d\x69\x96\x08\x51\xe4\xa7\x8b\xe3\x31\xcf\xbb\x0e\x34\x36\xc6\x21\x9c\x68\x23\x2d\x17\x2c\xb6\x84\x8f\xd6\xb8\x85\xb2\x86\x27\x59\xf8\x80\xee\x04\x98\x9b\xb5\x15\x20\x11\x2c\x2f\xa1\x25\x2b\xbe\x4a\x8a\x58\x68\x99\xe4\xed\x20\xbb\x91\x8f\x58\xe6\x99\x69\x06\xd2\xbb\x4b\x70\xc6\x42\x97\x67\x19\x61\x6a\xc9\xf5\xe1\x50\x9f\x67\x2c\xa5\x66\xa9\xb1\x9b\xd8\xe0\xef\xd5\xd0\xb0\x98\x32\x2b\xef\x1a\xb3\xed\x15\x6a\xa7\x83\x37\x2e\x2d\x67\xf6\x94\xe9\xd1\xec\xfb\xcd\xf5\x12\xda\x8a\x3f\x8f\x7c\x1c\xd9\x84\xa6\xfd\x5f\xda\x9f\xc9\xec\x91\x0a\xc5\xe0\xe8\xa7\x9f\xab\x90\x81\x4f\x5d\xf8\x90\x8c\x77\xb1\x82\x91\xc6\xf5\x65\x29\x84\x28\xfb\xfd\x30\xa1\x1f\xff\xed\x4d\x05\xe3\xb6\xcf\x9b\xa2\x18\xfa\xfe\xef\x27\x03\x37\x18\x03\xeb\x63\xc7\x58\x22\xc9\x2d\xa2\xb4\x83\x43\xb1\x76\x3f\x59\xaa\x38\x67\x63\xbf\x5a\xa4\x43\x05\x3f\x7a\x60\x66\x89\x6f\x7d\xb6\x78\xd9\x2c\x83\x4a\xe8\xc1\xa7\xa8\x9f\x50\x15\x2a\x3d\x55\x30\xd6\xce\x65\x15\xb0\x87\xf2\xd3\x3f\xde\x62\x7a\x86\x31\x64\xaa\xa8\x89\x8a\xd7\xe3\xfe\xc7\xbc\xfc\x5b\xaa\x87\x71\xe0\xf9\x8d\x5f\x49\xfc\x01\x33\x48\x95\xe8\x20\x03\x00\x00")
 
 func templateDialectGremlinOpenTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -454,12 +547,12 @@ func templateDialectGremlinOpenTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/open.tmpl", size: 503, mode: os.FileMode(420), modTime: time.Unix(1570008718, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/open.tmpl", size: 800, mode: os.FileMode(420), modTime: time.Unix(1792022167, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\x5f\x6b\xeb\xb8\x13\x7d\xb6\x3f\xc5\x10\x0a\x3f\x3b\xa4\x4a\x7f\xf7\x6d\x17\xfa\xd0\xed\xe6\xb2\x81\x4b\xc3\x6e\x2f\xdd\x87\x52\x82\x6a\x8d\x13\x51\x55\x32\x23\xd9\xe5\x62\xfc\xdd\x17\x49\x4e\xe2\xa4\xb9\xa4\xdb\xee\x1f\xf6\x29\x41\x33\x9a\x39\x73\xce\xb1\xa4\xb6\x9d\x8e\xd3\x6b\x53\x7d\x23\xb9\x5a\x3b\xf8\x74\xf1\xff\x1f\xce\x2b\x42\x8b\xda\xc1\x67\x5e\xe0\xa3\x31\x4f\x30\xd7\x05\x83\x2b\xa5\x20\x24\x59\xf0\x71\x6a\x50\xb0\xf4\xeb\x5a\x5a\xb0\xa6\xa6\x02\xa1\x30\x02\x41\x5a\x50\xb2\x40\x6d\x51\x40\xad\x05\x12\xb8\x35\xc2\x55\xc5\x8b\x35\xc2\x27\x76\xb1\x89\x42\x69\x6a\x2d\x52\xa9\x43\xfc\xcb\xfc\x7a\x76\x73\x3b\x83\x52\x2a\x84\x7e\x8d\x8c\x71\x20\x24\x61\xe1\x0c\x7d\x03\x53\x82\x1b\x34\x73\x84\xc8\xd2\xf1\xb4\xeb\xd2\xb4\x6d\x41\x60\x29\x35\xc2\x48\x48\xae\xb0\x70\xd3\x15\xe1\xb3\x92\x7a\x5a\x11\x0a\x59\x70\x87\x53\x29\x46\x70\xde\x75\x69\x52\xd6\xba\xc8\x1c\x8c\x85\x55\xec\x2b\xf1\x06\xc9\x72\x95\x43\x9b\x26\x89\x63\xbf\x70\x3b\xff\x39\x93\x22\x4f\x93\x2e\x6d\xdb\x73\x40\x2d\xe0\x4f\xf4\x98\x9a\xca\xf6\x7d\xfc\xee\x33\x53\xc1\x8f\x97\x70\xc6\x6e\x0b\x53\x21\x5b\x54\x83\x10\xa7\xd5\x30\x76\x45\xab\x41\xd0\x3a\x43\x7c\x85\xc3\x84\xdb\x7e\xe9\xd4\x10\x7e\xbf\x2c\x7d\x6b\x76\xc7\x49\x72\x21\x0b\x3f\x41\x92\x24\x8d\x2f\xf7\xcc\x9f\x30\xbb\x7f\x90\xda\x21\x95\xbc\xc0\xb6\x9b\x80\x42\x9d\xb5\x6d\x84\xd4\x75\x79\xee\x93\x4b\x43\x20\xfd\x06\xe2\x7a\x85\xd0\x84\xda\x49\xd2\xdc\xcb\x07\xb8\x84\x5d\xf6\xbd\x7c\xf0\x81\xae\xef\xdc\xf3\xb5\xe3\xb2\x62\x6d\x0b\x05\x57\x6a\x3b\x14\x5b\x54\xd7\xde\x2a\x9e\x9c\xae\xf3\x8d\x5f\xc3\x6d\x18\xf3\xfb\x50\x59\x84\xae\xdb\x75\xf3\x6b\xa1\x43\xfe\x3e\x85\x4a\x89\x4a\x0c\x05\x2a\x87\x14\x7f\xf6\xd1\xb7\xb9\x24\xfb\xc2\x1f\x51\x4d\x02\x11\x25\xbb\x36\xda\x3a\xae\x1d\x74\xdd\x04\x2a\x36\xfb\x35\x6b\x3e\x02\xf0\xd0\x45\xdf\x03\x79\xca\x62\x1f\x77\x91\x36\x2e\x48\x73\x23\xd5\xce\x48\xa7\x09\x38\x21\x79\x73\x54\xf3\x5e\xf2\xad\xbc\xd1\x4f\xd1\x01\x9b\xae\xa1\x69\x6c\x9d\xbf\xc1\x58\xfb\xc8\xf2\x03\x8f\xbe\x43\x1e\x14\x2b\x9c\xae\xf9\x9e\x3a\x7b\xfc\xce\xc4\x86\xdc\x10\x53\x1e\x69\x88\xe3\x0e\xc9\x2e\x1c\x8f\x37\x69\xb4\x4f\x19\x2d\x6a\x37\xa8\xeb\x09\x42\x36\xb7\x73\xed\x75\xe9\x8b\x1e\x6e\xbb\x84\xd1\x5c\x8f\xb6\xb1\xe9\x18\x78\x63\xa4\x80\x42\x52\x51\x2b\x4e\x20\xb0\x42\x2d\xb0\x90\x68\x21\x9c\x96\xc9\x10\x58\xc0\xd5\x37\x78\x0d\xcf\x33\xf3\x16\x9f\x4c\xc7\x1e\xac\x74\xff\xb3\xc0\x35\x78\x8a\xe0\x45\xba\x35\x58\x54\xe5\x39\x61\x89\x84\xba\xc0\x09\x38\xfe\x84\xe1\x68\x77\x2f\x06\x1a\x24\x27\x8b\x7d\x54\x71\xe4\x5b\x54\xe5\x6f\x58\xf6\x87\x96\x63\x3f\x19\xb7\x0e\x62\x46\xcc\x03\x1d\xb7\xde\x48\x9c\x37\xc3\x80\x97\xae\x9b\xed\x6f\x79\x15\xbf\xcb\xfe\x42\x3b\xf8\x71\xff\x01\x4b\x9c\xc9\x28\xd5\x72\x3f\x69\xeb\x80\xf7\xda\xe6\x68\xe5\xbd\xee\xff\x9a\xb7\x0e\x2d\x11\x70\x78\x63\x11\x96\xf0\x8c\x5c\x5b\x90\x0e\xec\xda\xd4\x4a\xc0\xa3\x7f\x17\xd4\xe1\x05\x61\x34\xc6\x27\x03\xc2\x76\xa4\x2d\xca\x44\xea\x09\x98\xda\x79\xf6\x96\x4b\x36\xd7\x77\x59\x3e\xf1\xff\x16\xb5\x8b\xbe\x08\xd7\xdf\x72\x02\xd5\xee\x06\xf4\xaa\xdb\xfe\x16\xac\x32\xa9\xf3\xfe\x9f\xa9\x5d\xbe\xb9\x01\x13\xc7\x7e\x5f\x23\x61\x16\x62\xbe\x20\xc5\xbf\x49\x2c\x7e\x68\xca\x98\x2c\x75\x3e\xd9\x66\xcd\xf5\xf1\x24\xdf\x26\x66\xc5\x9f\x63\x1f\x01\xf5\x03\xf9\xfd\xaf\x05\xdd\x78\xfe\xe4\x6c\x8e\x86\x03\x9d\xfa\xb0\x22\x3c\x47\x7f\xd3\x27\xc6\xf5\xfe\xc3\x8d\x8e\xdf\xc9\x64\xbf\xf7\xb8\xb9\x88\xef\x9b\x6d\x45\x1b\xee\x96\xe3\x1c\xc4\x84\x48\xc4\xc6\x1d\x37\xf8\x12\x69\xab\xb2\x28\xb4\xef\x75\x09\xbc\xf2\xe6\xcf\x1c\xd9\x09\x84\xf5\x70\x47\x51\x4f\xc7\x72\xc9\xae\x62\x94\x31\xf6\xce\xa7\x80\xa1\xff\xe6\xe0\x0b\xfa\xd8\xdc\xda\xb8\xb7\x0c\x7e\x80\xb2\x07\x39\x04\x72\x63\x5c\xe6\x0e\x41\xfc\x11\x00\x00\xff\xff\xa5\xc2\xe7\x43\xf1\x0c\x00\x00")
+var _templateDialectGremlinPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe5\x58\xdd\x6f\xdb\x36\x10\x7f\xb6\xff\x0a\xc2\x08\x30\x29\x70\xe4\xac\x6f\x1b\xd0\x87\x2c\x73\x3b\x03\x41\xd2\x35\x41\xf6\x50\x14\x06\x2d\x9d\x6c\xa2\x32\x29\x90\x94\x93\xc0\xd5\xff\xbe\x3b\x52\xb2\xe4\x8f\xce\x8e\x93\xad\x1b\xf6\x64\x5b\x77\x3c\xfe\xee\xee\x77\x1f\xf2\x72\x39\x38\xed\x5e\xaa\xfc\x49\x8b\xe9\xcc\xb2\x37\xe7\x3f\xfe\x74\x96\x6b\x30\x20\x2d\x7b\xc7\x63\x98\x28\xf5\x85\x8d\x64\x1c\xb1\x8b\x2c\x63\x4e\xc9\x30\x92\xeb\x05\x24\x51\xf7\x6e\x26\x0c\x33\xaa\xd0\x31\xb0\x58\x25\xc0\xf0\x67\x26\x62\x90\x06\x12\x56\xc8\x04\x34\xb3\x33\x60\x17\x39\x8f\xf1\xe3\x4d\x74\x5e\x4b\x59\xaa\x50\xdc\x15\xd2\xc9\xaf\x46\x97\xc3\xeb\xdb\x21\x4b\x45\x86\x26\xfc\x33\xad\x94\x65\x89\xd0\x10\x5b\xa5\x9f\x98\x4a\xf1\x69\x73\x99\xd5\x00\x51\xf7\x74\x50\x96\xdd\xee\x72\xc9\x12\x48\x85\x04\xd6\x4b\x04\xcf\xf0\xc0\x60\xaa\x61\x9e\x09\x39\x40\x57\x12\x11\x73\x0b\x03\x91\xf4\xd8\x19\x6a\x77\xd2\x42\xc6\x81\x65\xa7\x89\xc9\xa2\x3b\xcd\x17\xa0\x0d\xcf\x42\xb6\xec\x76\x3a\x36\xfa\x8d\x9b\xd1\xaf\x81\x48\xc2\x6e\xa7\x44\xbb\x67\x0c\x64\xc2\x9e\x71\xc7\x40\xe5\xa6\xba\x87\x4e\x9f\xa8\x9c\xfd\xfc\x96\x9d\x44\xb7\xb1\xca\x21\xba\xc9\x5b\x22\xae\xa7\x6d\xd9\x05\xfe\x6c\x84\x06\x7d\xe6\x53\x68\x2b\xdc\x56\x8f\xf6\x39\x41\xe7\x45\x4a\x57\x47\xf7\x5c\x0b\x8e\xd8\xc8\x83\x4e\xa7\xb3\x20\x73\x73\xfe\x05\x82\x4f\x9f\x85\xb4\xa0\x53\x4c\xf0\xb2\xec\xb3\x0c\x64\x80\x0e\x3a\x48\x65\x19\x86\xa4\x9c\x2a\xcd\x04\x1d\xd0\x5c\xe2\xad\x0b\x67\x1b\x6d\x7c\x12\x9f\xd9\x5b\xd6\x68\xe3\x6f\x12\x94\xd5\xcd\x55\xbc\x9a\x58\xe6\x11\xea\xc6\x1c\xc9\x53\x3b\x85\x61\xb8\x24\xaa\x50\x70\xca\x92\x2e\xde\x86\xbb\x88\x22\x3a\x07\x19\x32\xa5\x2c\x9b\xdb\xe8\x99\xbb\x21\x3c\x2e\x43\xa9\x80\x2c\x69\x27\x28\x6d\x87\xf8\x1d\x49\x0f\x63\x49\x70\xc5\x27\x90\xf5\x5d\x20\xd2\xe8\x52\x49\x63\x39\x96\x4c\x89\xd1\xcc\xa3\xe1\xef\x81\x7f\xfe\xde\x03\xb8\xe7\x59\x81\xb0\x16\xbd\x17\x02\xdf\x64\xd7\xb7\xc0\x7f\x67\xea\x71\x74\x6c\x2d\x9f\x18\x09\x0c\x59\x65\xc5\x07\xe3\x6f\x61\xe4\x46\xc0\x83\x5c\xa3\x51\x6f\xa4\x87\x5a\xbd\xb0\xba\x76\x8b\xac\x15\x70\x89\x1d\x87\x80\x5f\x8b\xac\x29\x9b\xfd\xe9\x7e\x1d\x82\xef\x64\xcb\x1a\xdf\x3d\x66\x7f\xa2\x06\xe6\x70\x79\x74\xe1\x01\x40\xd6\xc1\x87\x1b\x71\x38\x9a\x97\x13\xb0\x0f\x00\xf2\x85\x85\x45\xe7\x06\xa7\xec\x43\x54\x99\x63\xf0\x18\x67\x45\x02\xc6\xcd\x83\x22\xcf\x71\x9a\x4c\x68\x6c\xf4\x1d\xc5\xdc\x90\x70\x54\xc0\xb1\x20\x24\xaa\x1a\xb1\x70\xe3\x83\x62\x50\xd3\x38\xd1\x82\xee\x88\x98\x9b\x15\x87\xa4\xf3\xfd\xdd\x30\xe0\x61\xb8\x57\xef\x0a\xf5\x26\x47\x96\xb3\x2d\xf2\x0c\xe7\xc5\x5a\xc4\x44\xf2\xd8\x8e\xd9\x08\xa7\xe7\xe3\xfe\x66\xa4\xcd\x5f\xd5\xd0\xc2\x38\xe2\x6c\x96\x8e\xf1\xb5\x83\x87\x7d\xf1\x8c\xc7\x88\xda\xcb\x4e\xc6\xfd\x3a\x79\x08\xc8\x67\xce\xa0\x63\x07\x36\x3d\x5f\x72\xbd\x05\x19\x8e\x7a\x58\x82\xdc\x20\x25\x49\xfd\x9a\xcf\x21\x64\x5f\xb7\x88\x4e\xdc\x5e\xd1\xbc\xeb\x8b\xd3\x46\x7f\xcc\x40\x43\x30\x1e\x47\x37\x3a\x40\x98\x58\x2d\x47\x46\x1a\x92\x29\x0c\x66\x7c\xad\x6f\xae\x35\xb7\x61\x52\x77\x36\x27\xcb\xc8\x45\x27\x87\xc6\xc3\x46\xec\x17\x12\xa1\x24\xa9\xf4\x6e\x0a\xdb\xb2\x4b\x55\x8e\x79\x33\x23\x49\x19\xaa\x8c\x6e\x1e\xc3\x53\xa3\x3a\xed\x28\x43\xbe\xf3\x85\x12\x09\x8b\x85\x8e\x8b\x8c\x6b\x74\x29\x47\x07\x21\x16\xc8\xfb\x8a\xb3\x2d\x60\x0e\x57\x75\xc1\x36\x3c\x8a\xcc\x81\x55\x86\x60\x85\xfd\xc1\x60\x25\x31\x0a\x11\x7b\x10\x76\xc6\x0c\x64\xe9\x99\x86\x14\x63\x2f\x63\xe8\x33\x8b\xb4\x72\x75\x66\x1f\x14\x43\x23\x16\x97\xb7\x35\x54\xde\xe5\x5b\x3c\xf6\x11\xd2\xaa\xbb\xda\xe8\x17\x65\x67\xae\xdb\x78\xcc\xad\x46\xb3\x6a\x5e\xa8\x45\x0a\x4d\x5c\xca\x72\xb8\x7e\x64\x4b\x7e\x1f\xbc\x42\xbf\xaa\xe9\x20\xd5\xbf\x94\x10\xaf\x9c\xe9\xdd\x09\xba\x56\x96\x6a\x8b\xf2\xb4\x11\xf5\x9d\x99\xaa\xd4\xf7\x24\xec\x15\xb3\x43\x64\xfc\x07\xf2\x73\x22\x7c\x78\xc7\xeb\x4a\x23\xf9\xf2\x1c\x6e\x5b\x5e\xbb\xfd\xbb\x55\xfe\x26\x1f\x1c\x0e\x2a\x7b\xac\x7a\x36\x07\x2e\x71\x94\x5a\x66\x66\xaa\xc0\x81\x3d\xa1\xf7\xac\xc2\x8d\x54\x85\x89\x73\xaf\x60\xc0\x56\x2e\xad\x50\x76\x84\xec\x33\x55\x58\x8a\x1e\xf2\x64\x24\xb1\x54\xfb\xf4\x0d\x1d\xf6\x55\xeb\x26\x10\x4e\x96\xbc\x19\x42\x94\xf5\x6a\x0e\x75\xf2\x40\xc8\xb0\xfa\x86\x76\xc2\x7a\x49\x5b\x0d\x02\x27\xf3\xd3\xc0\x7d\xed\x78\xe3\x9b\x2d\xc3\x2b\xa3\xad\xfe\x4a\x6b\x24\x77\x2b\xd1\x35\x5e\xcb\x7f\xec\x22\xbe\xae\x1c\xa2\xf3\xdb\x09\xad\x3b\xd2\x5e\xdf\xac\x6e\x3b\xb4\xaf\xed\x79\x78\x78\xe6\x88\x06\x88\xb9\xac\xaa\x07\x4f\x15\xda\xef\x43\x86\x4d\x41\x82\xc6\xfa\x4a\x30\x8d\xd9\x13\x23\xbc\x54\x69\xb4\x57\x71\xcb\x56\xfd\x9e\xb2\x2b\x34\x53\x0f\xf8\x02\xfe\x94\xe3\xfe\x0c\xd1\x34\x72\x6f\xda\x6e\xdf\xca\x84\x41\x66\x20\x21\x62\x5b\x68\x30\x61\xb5\x52\x3d\xbb\xb0\x57\xd0\xfe\x1f\x1d\x98\xea\x4b\xcc\x71\xdd\xfb\xc0\x69\xc2\x5a\x7c\x87\xf3\x23\xb5\x56\xc5\xa4\xb0\xf8\x29\xce\xa0\xb5\xa4\xd6\xcc\xfb\x88\x2d\x81\xef\x6c\xbe\x1b\xac\xb9\x5d\x5d\x11\xe0\xea\x3a\x9c\x0b\x1b\xd4\x8b\xdf\x37\xa9\x59\x33\xb3\xbd\x71\xb9\x27\x47\x74\x6e\x24\xc8\xda\xff\x2b\xfa\xb9\xdb\xea\xb9\x5f\x58\x57\x16\x9b\xc5\x75\x1b\xbf\x57\xa8\x76\xd8\x2a\x52\xd7\xf0\xe0\xab\x11\xbd\x0a\xab\xe5\x16\x33\xc8\x73\xea\xa9\xb4\x42\xe2\x36\xb3\x72\x56\x37\xfb\xe5\x85\x97\x1e\xbf\x60\x2a\xfd\xdf\x74\xfc\xa5\x8b\x35\xbe\x2c\x1f\xe2\xf8\x06\xca\x0a\x64\x1b\x08\x6d\x17\x76\x13\xc4\x9f\x80\x77\x00\xc0\x98\x14\x00\x00")
 
 func templateDialectGremlinPredicateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -474,12 +567,12 @@ func templateDialectGremlinPredicateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/predicate.tmpl", size: 3313, mode: os.FileMode(420), modTime: time.Unix(1567330647, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/predicate.tmpl", size: 5272, mode: os.FileMode(420), modTime: time.Unix(1792022115, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x5d\x4f\x23\x37\x14\x7d\xce\xfc\x8a\xdb\x15\x42\x33\x69\xd6\xa1\xf4\xa9\x8b\x52\x89\x8f\xac\x1a\x89\x85\x16\x10\x2f\x55\x55\x99\xf1\x9d\x89\x85\x63\xcf\xda\x9e\x6c\x50\x34\xff\xbd\xf2\xc7\x84\x21\x24\x84\x5d\x15\x9e\x18\xec\x7b\xcf\x39\xbe\x3e\xbe\xb9\xcb\xe5\xb0\x9f\x9c\xaa\xea\x41\xf3\x72\x6a\xe1\xf0\xe0\x97\xdf\x3e\x56\x1a\x0d\x4a\x0b\x9f\x69\x8e\x77\x4a\xdd\xc3\x44\xe6\x04\x8e\x85\x00\x1f\x64\xc0\xed\xeb\x39\x32\x92\xdc\x4c\xb9\x01\xa3\x6a\x9d\x23\xe4\x8a\x21\x70\x03\x82\xe7\x28\x0d\x32\xa8\x25\x43\x0d\x76\x8a\x70\x5c\xd1\x7c\x8a\x70\x48\x0e\xda\x5d\x28\x54\x2d\x59\xc2\xa5\xdf\x3f\x9f\x9c\x8e\x2f\xae\xc7\x50\x70\x81\x10\xd7\xb4\x52\x16\x18\xd7\x98\x5b\xa5\x1f\x40\x15\x60\x3b\x64\x56\x23\x92\xa4\x3f\x6c\x9a\x24\x59\x2e\x81\x61\xc1\x25\xc2\x07\xc6\xa9\xc0\xdc\x0e\x4b\x8d\x33\xc1\xe5\xf0\x6b\x8d\xfa\xe1\x03\x34\x8d\x0b\xda\xbb\xab\xb9\x70\x92\x3e\x8d\xa0\xa2\x26\xa7\x02\xf6\xc8\x75\xae\x2a\x24\x27\x71\x27\x06\x6a\xcc\x91\xcf\x43\xe4\xea\x7b\x95\xee\x38\x8b\x5a\xe6\x90\x3e\x89\x6d\x1a\xe8\x77\x59\x9a\x26\x83\xa8\x63\x72\x66\xd2\xdc\x2e\x20\x57\xd2\xe2\xc2\x92\xd3\xf0\x37\x83\xf4\xef\x7f\x5c\x0a\x99\x9c\x91\x9b\x87\x0a\xa1\x69\x06\x80\x5a\x2b\x9d\xc1\x32\xe9\x69\x34\x4e\xc1\x7e\x44\x21\x57\x68\x2a\x25\x0d\x2e\x9b\xa4\xe7\x4f\x36\x80\x3b\x2e\x19\x97\xa5\x8f\x5b\x53\x43\x62\xda\x5f\x2e\x32\xcd\x48\xfc\x9b\xf4\x78\xe1\x38\x36\x65\x30\xed\xbe\xc8\x78\x81\xb9\xd3\x3b\x80\x35\x96\x81\xbb\xfa\xec\xc8\xa7\xff\x34\x02\xc9\x85\x93\xd9\xd3\x68\x6b\x2d\xdd\xbf\x5e\x7d\xd2\x6b\x92\xde\x1c\xb5\xe5\x39\x9a\x41\xcb\xa5\xd1\x90\x2b\xa4\xec\x36\x6e\x74\x94\xec\x80\xe2\xcc\x1f\x6f\x46\xef\x71\x53\xbd\x0e\x06\x20\x50\xa6\x2d\x61\x96\x25\xbd\x42\x69\xf8\x77\x00\x6e\x09\x17\x9e\x9c\xca\x12\xa1\x0d\xf1\x4c\x0e\x75\x04\xb4\xaa\x50\xb2\x94\x33\xd3\x86\x3b\xec\x74\x8d\xc4\x61\x36\x49\x2b\xce\x07\x4b\x2e\x92\xef\xf6\xc1\xb1\x10\x5b\x7d\xe0\x73\xc8\x05\x9d\xbd\xad\x0b\x6e\xa9\xa8\xf1\x0b\xad\x52\xab\x6b\x7c\x77\x53\x50\xed\xe0\x2b\x51\x6b\xff\xf8\xae\x3a\x35\xeb\xae\xfb\x2a\xb8\x77\xf6\x54\xd6\xa6\x3c\xf2\x59\xab\x59\x5b\x92\xf4\xd5\x4a\xb6\xa1\xe5\x4a\x16\xbc\x5c\xbf\xd0\xb8\x9c\xad\x2c\xb0\x25\xfd\x07\x6d\x71\xaa\x6a\x69\xb7\x18\x83\x4b\xfb\x76\x66\x08\xc4\xef\xe0\x82\x83\xc7\xca\xc7\x95\xb6\x1d\x4c\x9c\x80\xef\x2f\xd9\x78\xc1\xcd\xb6\x92\xdd\x29\x25\xde\xae\x66\x7f\x50\x73\x81\x8b\x77\xa9\x5a\x41\x85\xc1\xad\x95\x3b\x51\x4a\xfc\x48\xe9\xa2\x6c\xe8\x33\x23\xc8\x8d\xa6\x73\xd4\x86\x7a\xde\xb9\x3b\x42\x49\x6e\xc3\x29\xcf\xe9\x1d\x8a\xd0\x09\xff\xa4\xf9\x3d\x2d\x5d\x63\x22\x7e\x35\x9c\x79\x4b\xa1\xba\x07\x99\xc3\xd6\x7a\x92\x53\xa1\x24\xa6\xa1\xb3\xc6\x86\x5d\x3d\xf6\xea\xf5\xac\x4a\x23\xe3\x39\xb5\xb1\x7b\x57\xe9\x3c\x64\xf2\xc2\x77\xff\xf5\x70\xa5\x19\xea\x0c\x7e\x87\x83\xa0\x83\x5c\xba\x05\xc7\xf6\x0a\x2e\x9f\xec\xf3\x22\x8f\x23\x6a\x92\x9e\xf9\xc6\x6d\x3e\x05\xc1\x67\xdc\x0e\x40\x15\x85\x41\xbb\xe9\xd6\x63\xc0\x33\x58\x9f\x70\xe4\x80\x73\x6a\x30\xe0\xb4\xd5\xda\xdf\x6f\x01\xc3\xc2\x27\xaf\xfa\xca\xe9\x4b\xfb\x61\x67\x00\xf1\x03\x7e\x86\xbe\x4f\xce\x22\xd2\xee\xcc\x19\xb5\x53\xf2\x85\x2e\x26\xd2\xfe\x7a\x98\x6d\x10\x10\xb2\xce\xdd\x4a\xba\x02\x0f\xf5\xad\x25\xff\x5a\xe3\xa6\x83\x86\x9d\x23\x7f\x03\xe1\x3b\x83\xd1\x68\x55\xf3\x33\x64\x75\x95\x3e\xf9\xed\x9c\x27\x7e\xc2\x42\xc9\x20\x8c\x6e\xc3\x7e\x78\x13\xc3\x8a\xda\x69\x9c\xe3\x8c\x1f\x00\xfd\x32\x94\x28\x51\x53\xcb\x95\x04\x77\x71\x3e\x4a\x15\x40\xa1\xe4\x73\x94\x80\xac\x44\x02\x7e\x0e\xdc\x35\x06\x7a\x06\x3f\x0b\xf6\x96\xcb\x8f\xb0\xe7\x4f\xd4\x0e\x80\x63\xe6\xed\x0d\x5e\x90\x63\x77\xc0\xf0\x0d\x41\x22\x32\xb0\xca\xeb\x28\x35\xb5\xe8\x77\xbd\x0c\xab\x22\x73\xc0\xeb\x0e\x8d\x2d\x6c\xe7\xb7\x21\xe9\xb5\xef\x63\x67\x8f\x09\x80\xbc\x80\x3d\x24\xd7\x28\x8a\x2b\x2c\x3c\x40\xe8\x56\xab\x77\x36\x6a\x5f\x34\x39\x51\x76\xfa\xec\xa5\xba\xff\xd1\xb5\x45\x63\xa9\xb4\xae\x03\x04\x5c\x14\x06\x23\xf8\xc4\x4c\xa4\x7b\xfe\xf8\x32\xfc\x44\x8e\xd3\x80\xe6\xe6\xa1\x97\x39\xc8\x65\x6d\x6f\xd3\x2e\xd5\x8b\xd0\x97\xb5\x1d\xbf\x42\x39\x99\xc8\x47\xd0\xe0\x9d\x8e\x8b\xba\x36\x2a\xb4\x9a\xed\xb6\x11\x0d\xce\x89\x9b\x3e\xa7\x75\x94\x54\xec\xd5\x8e\x72\x89\x1d\x47\xf9\xab\xdd\x7b\x62\x23\x87\xe6\x6c\x64\x2c\xd5\xb6\xa3\xc7\x65\x3e\x71\xcf\x7b\xbb\xf1\xf5\x1e\x23\xb7\xcf\xba\xeb\xe4\x2c\x7b\xf4\x9c\xfc\x9f\x4d\xb7\x85\xef\x2d\x4c\xb8\x85\x6a\x65\xca\x1d\x47\x7b\xc9\x95\xff\x05\x00\x00\xff\xff\x11\x7d\xc0\x7f\x59\x0f\x00\x00")
+var _templateDialectGremlinQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x58\x59\x6f\xdb\x46\x10\x7e\x16\x7f\xc5\x24\x30\x5c\xd2\x95\x57\x6e\xfa\xd4\x04\x2e\x50\x1f\x41\x05\x38\x97\xed\xe6\x25\x08\x82\x35\x39\x92\x09\xd3\x24\xbd\xa4\x1c\x1b\x8a\xfe\x7b\x67\x66\x97\x97\x44\xd9\x72\x91\x36\x05\x0c\x58\xdc\x9d\x6b\x67\xbe\x39\x76\xe7\xf3\xd1\x8e\x77\x98\xe5\xf7\x26\x9e\x5e\x96\xf0\x62\xef\x97\xdf\x76\x73\x83\x05\xa6\x25\xbc\xd6\x21\x5e\x64\xd9\x15\x8c\xd3\x50\xc1\x1f\x49\x02\x42\x54\x00\xef\x9b\x5b\x8c\x94\x77\x7e\x19\x17\x50\x64\x33\x13\x22\x84\x59\x84\x40\x9f\x49\x1c\x62\x5a\x60\x04\xb3\x34\x42\x03\xe5\x25\xc2\x1f\xb9\x0e\xe9\xdf\x0b\xb5\x57\xed\xc2\x24\xa3\x6d\x2f\x4e\x65\xff\x64\x7c\x78\xfc\xf6\xec\x18\x26\x71\x42\x22\xec\x9a\xc9\xb2\x12\xa2\xd8\x60\x58\x66\xe6\x1e\xb2\x09\xad\x36\xca\x4a\x83\xa8\xbc\x9d\xd1\x62\xe1\x79\xf3\x39\x44\x38\x89\x53\x84\xe7\x51\xac\x13\x62\x18\x4d\x0d\x5e\x27\x71\x3a\xba\x99\xa1\xb9\x7f\x0e\x44\x45\x44\x5b\x17\xb3\x38\x61\x93\x5e\xee\x43\xae\x8b\x50\x27\xb0\xa5\xce\xc2\x2c\x47\x75\xe0\x76\x1c\x21\x29\xc5\xf8\xd6\x52\xd6\xbf\x6b\x76\xd6\x39\x99\xa5\x21\xf8\x1d\xda\xc5\x02\x76\xda\x5a\x16\x8b\x00\x9c\x1d\xe3\xa3\xc2\x0f\xcb\x3b\x72\x51\x5a\xe2\x5d\xa9\x0e\xed\xff\x00\xfc\x4f\x9f\x99\x45\x8d\x8f\xd4\xf9\x7d\x8e\xc4\x33\x04\x34\x26\x33\x01\xcc\xbd\x01\xf9\x99\x2d\xd8\x76\x52\xd4\x29\x16\x79\x46\xce\x9b\x2f\xbc\x41\x69\x34\x29\x2d\xe8\x0c\x44\xb1\x64\x87\x72\x0c\x1f\xf8\xf4\x7e\xe0\x0d\xc4\x0d\x43\xf8\xc2\xb4\x35\xa3\xaa\xb7\xe3\x09\x2b\xed\x13\x14\x19\xfe\xa5\x8e\xef\x30\xe4\x03\x0c\xc1\x49\xaa\x85\x0c\x19\x0c\xc1\x2b\xe1\x7f\xb6\x0f\x69\x9c\xb0\xe1\x64\x79\x39\x33\x29\x7f\xca\x79\xbc\x01\x59\x4c\x0c\x25\x05\xbf\x18\x56\xca\x88\x93\x8e\xa4\xa3\x8f\x6e\xa3\x65\xca\x23\xa2\xe2\x48\x1c\x73\xad\xaf\xb0\xcf\x83\x7b\x43\x48\x30\xf5\x2b\x85\x01\xc9\x9d\x64\x06\xbe\x0c\x81\x97\xf0\x4e\x94\xeb\x74\x8a\x50\x91\x88\x26\x96\xba\x0f\x3a\xcf\x31\x8d\x7c\xfa\xa8\xc8\x59\xb6\xbf\xa4\x84\x65\x2e\xbc\xca\x38\x21\x26\x0b\xbd\x27\x23\x83\xb2\x6a\x2d\x32\x84\x47\xbd\xd5\xd7\xdf\x1b\x17\xa4\xf4\x7f\x06\x0d\x6d\x58\x7e\x9e\xcc\x8c\x24\xe5\x69\xcb\x73\xed\x75\xf1\x05\xe7\x5f\xd7\xae\x3e\x3e\xf5\xda\x64\xd7\x95\x63\xfc\x8d\x2d\x59\x27\x8d\xe2\x33\x89\xa7\xcb\x61\x75\xcb\x01\xf3\xed\x3a\x48\x6d\x11\xcc\xb6\x90\x2d\xdb\x52\xc7\xd1\x94\x42\xc5\xf6\xb2\xc1\xe2\xa0\x3e\x57\xf2\x37\xaa\x63\x3d\x45\x73\x92\xe9\xe8\x75\x8c\x49\x44\xeb\xaf\x1c\x47\xcb\xe4\x07\xe2\xe1\x62\xcb\x02\xf8\x10\xae\xbe\x61\x85\x9f\x4e\x8c\xd6\x9c\x72\xd5\x45\x3d\x4e\x1a\xb0\x9b\xac\xab\x76\x81\x32\x45\x8e\xe7\xa8\xd6\xc8\xad\x73\x63\x34\x82\x25\x08\x82\xe5\x2c\xa4\xe4\x37\xd8\xa5\x6a\x4f\x14\x04\xa6\x4b\x5d\x76\x48\x6e\x75\x32\x43\x4a\xfc\xbc\xb0\x1d\xa1\x49\x61\xf5\xf4\xcc\x73\x28\x87\x9d\xa8\x48\xd4\x79\xad\x9c\x0e\xae\xcd\x54\x52\xec\xd3\xe7\x98\xf2\xd1\x4c\xa8\x13\xce\x17\xf3\xd2\xcc\x70\x51\xd7\x92\x49\x53\x46\x96\x63\x31\xe1\x08\xda\xa2\x22\x92\xea\xaa\xc2\x5f\xc4\xd9\x29\x1e\x0f\x17\x6f\xf5\x91\x4f\xfc\x46\xe7\xc2\xab\x94\x0a\x9e\x5e\x64\x44\xd4\x59\x69\xe2\x74\xea\xaf\x16\x9a\x42\x36\x86\xd0\x3a\x69\xbb\xd8\x38\xc8\x5c\xc4\x69\x44\x64\xc5\x46\x65\xa5\xa9\x1f\xee\x8c\x4b\x42\x6a\x40\x90\xa4\x07\xb2\xa6\x81\xcb\x3a\x54\x43\x42\x1b\x16\x19\x29\x8d\x1f\x35\x28\x6c\x46\x55\x44\x48\x02\xab\x9d\x29\x19\x9d\xae\x16\x15\xa2\x9a\x15\x64\x5a\x8b\x46\x6c\x56\x6c\xc4\x39\xad\xa1\x18\xa5\x0d\xca\x7a\x4c\xe3\x4d\x81\xb9\x36\xba\xc4\xe4\x1e\x18\x11\x48\x23\x8e\x18\x31\x04\x4d\x59\x41\x72\x0c\xd2\x3a\x0e\x45\x64\x12\x5f\xc7\x65\xb5\x41\xb6\x4c\x0a\x2c\x2b\x93\x44\x11\xeb\x61\xe9\x04\x94\x84\xa5\x67\x76\x0a\xb2\x6a\x89\xb0\x16\xff\x54\x9c\x3f\x54\x10\x96\xdb\x8e\x2b\x10\x56\x16\x4a\xaf\xab\xc8\x3f\xd8\x08\x5a\x2f\x2f\xf5\xa6\xc0\xc2\x85\xd1\xe2\x72\x83\xc9\x9a\xf4\xb0\x4c\x9c\x0d\x37\xbc\x68\x1d\x7b\x98\x64\x29\x32\x44\x06\x37\x15\x80\x28\x4f\xfc\xed\xb6\xe0\x43\x72\x45\x5a\xce\x6d\x95\x7d\x09\xfd\xd5\x77\xe1\xe0\xd6\x7b\x48\x56\x1d\x54\xf2\x59\x57\x3d\x7d\xdc\x28\x9a\x73\x29\x82\xc8\x8e\x08\xbc\x41\xcf\xe8\x51\xa1\xd7\xd6\x3d\x2e\x7b\x12\x8e\x96\x88\x6e\x17\xdf\x54\x0a\x57\x4e\xa2\x23\x33\xff\x4a\x63\xf2\x86\xed\x10\xcc\xca\x63\x8b\xe8\x08\xe0\x77\xd8\x73\x25\x58\xa2\x2e\x49\xa1\x7a\x73\x60\xdf\xa2\xe4\xd3\xde\xe7\xaa\x3a\x4b\x69\x4e\x8a\x4a\xf0\x86\x02\x2a\x46\x57\xd3\x9b\x0a\x65\x93\x95\x58\xdd\xd6\x13\x11\x78\x48\xa3\x7e\xb9\x66\xca\xa1\xa2\xf3\x3d\x27\x9b\xba\x88\xfb\x13\x4d\xe7\x0f\x94\xd5\xfd\x03\xe7\x9c\xbd\x66\xb6\x70\x2b\xd5\xd8\x3b\x16\xc3\x9e\xec\xcd\xe3\xbb\xb8\x58\xe7\x4d\xba\xad\x25\xff\xaa\x3b\xff\xd4\xc5\x5b\x52\xf5\x23\x1d\x2a\x96\xac\x75\xea\x01\x79\xc0\x7a\xb5\x69\x1e\x8f\x0c\x1a\x75\x09\x96\x32\x9e\x90\x77\xb9\x0f\xc4\x74\xcf\x6d\x6e\x2c\x5f\xe3\xf2\x52\x96\xe8\xa2\xa9\x67\x09\x95\x6e\x43\x61\x79\xf2\xcc\xb1\x7e\xe0\x78\x78\x16\x68\xe2\xc0\x23\xc8\xd2\xf1\x1a\x39\x8f\x1f\x51\x49\x2f\xeb\x1e\xc2\xb6\x9d\x28\x92\xa6\x43\xcd\xac\xcc\xba\x02\x0a\xd6\x45\x42\x2a\xcf\xd8\xfe\x57\x80\xcf\x0b\xc1\x10\xa8\x6d\xf2\x85\xfc\x12\xef\x21\xca\xd2\x9f\x4a\x08\x2f\xa5\xea\xcb\x25\x1e\x0b\x52\x54\xb7\xe5\x8c\xbb\x22\x71\xa2\x9a\x2a\x90\xd4\x94\x96\x28\x90\x0e\x9e\xea\xcc\xc6\x29\x6c\x08\x30\xf6\xfb\x3c\x7b\xcb\x18\x9c\xaa\x8f\xbe\xa0\xf7\x44\x5f\x60\x62\x2f\x73\xef\x75\x78\x45\x23\x37\xbb\x59\x56\x2d\x68\xd7\x44\xa0\x0d\xc4\x5b\x58\x9b\x30\x4d\x57\x6b\xe6\xc4\x7c\xfd\x9c\x48\x1d\x28\x8a\x43\x9a\x21\x6c\x77\xcc\xfd\x5b\xcb\x29\xd3\xc2\xb0\x1a\x13\x7a\x72\xc8\x11\x2c\x2f\x5b\x06\xe6\x27\x87\xec\x4b\xc4\x60\x7b\x1b\x9e\x2d\xd3\xcd\x52\x09\x3d\x46\xde\x80\x62\x9b\xeb\x69\x9c\x92\x11\x51\x1d\x5a\x46\x84\xa3\x80\x8b\x7b\xba\xde\x82\x4f\x38\xc8\x40\xd3\x16\x94\x31\xee\x5e\x18\xa4\xbb\xb7\x71\x71\x15\x29\x36\x85\x2c\xa4\x02\x9e\x26\xed\x6f\x86\xd3\x15\x62\x2e\xf1\x27\x4d\x24\xbd\x28\xf5\x45\x82\x70\x81\xe5\x57\xa4\x39\x8b\x9a\x51\x42\x99\x34\x70\xcb\x74\x5a\xdf\x4e\x4b\xce\xe5\xdf\xbe\x55\x8e\xb0\x0b\x01\x1f\x89\x8f\xe6\x0d\xac\x86\x1e\xff\xc8\x86\x57\x37\x5a\xf5\xce\xb8\x87\x9b\xba\xcb\x0a\x45\x00\xfb\xfb\xd4\x66\x9d\x3c\xdb\x6e\xad\xcc\x9e\xb2\x65\x93\x46\x24\xc9\xd8\xb2\x74\x05\xea\xca\xe5\xee\x4d\x86\xbb\x33\x09\x6a\x54\xc3\xba\x8a\x0c\xab\x55\x0c\xb0\x28\x18\x38\x5b\x5b\x12\x48\xc4\xc1\xbd\xcf\x00\x1f\x1f\x0d\x41\xfe\xa7\xa1\x71\xb4\xf4\x57\x50\x95\xa2\x21\x91\x48\x43\x5d\x54\x23\xa7\x73\x22\x1d\xb1\xe3\xc4\x97\x62\xd1\x29\xeb\xf6\x77\xec\xce\x10\xdc\x0f\xf8\x19\x76\x84\x39\x70\x92\x1e\xe7\xbc\xd6\xe5\xa5\x7a\xa3\xef\xa8\xa7\xfd\xfa\x22\xe8\x31\xc0\x72\x9d\xf0\x8a\x5f\x0b\xb7\x5e\x9b\xd9\x19\xa8\x27\x8a\x76\xe7\x95\xf8\xd5\xfe\x76\x01\xb3\xfe\x3c\xc2\x68\x96\xfb\x9d\xbb\xd4\x6d\x77\x4e\x99\xcf\x47\x3b\x16\x98\xa3\x9c\x2c\x74\xcf\x84\x45\xab\xe4\x4f\x31\x45\x1a\xe2\x63\x1a\xb7\x39\x28\x42\x45\xa0\xd6\xee\x0e\xc0\x73\x91\x02\x79\x66\x7c\xec\x95\x51\x34\xc8\x53\xa3\xc0\xa2\xba\xcc\xd8\xf7\x45\x1e\xbe\xec\x63\x06\x19\x54\xcd\xf5\xf0\x95\x46\x63\xa4\x14\xa3\x14\x61\x3b\xa6\x7c\x9b\x70\x79\x42\x66\x94\x99\xd3\x6c\xe5\xb5\xdf\x24\x2b\xb1\xad\x3b\xb6\x6b\xc8\x55\x21\x7a\xcf\x12\xf6\x81\x2b\xea\x9a\x59\xa1\x5b\x29\xdb\x53\xc3\x80\xca\xd2\xed\x70\x5d\xfb\xde\x78\x6a\x6e\x3d\x19\x30\x96\xa9\x44\x94\xab\xbd\xa7\x40\xf6\x64\xe7\x22\xcf\xfe\x5f\x29\x49\x54\x1f\x06\x55\x29\xe6\xe7\x5b\xb2\x70\xb5\x39\xca\xea\x72\xed\x90\xc5\x6e\x01\xe9\x8c\xe0\x67\x98\x4c\x4e\x71\xe2\x46\x65\x67\x7b\x55\xce\x0f\xa8\x63\xad\x74\x0b\x7b\x39\x22\x4f\x52\x6e\x52\x07\xa3\x96\x64\xef\xb3\xad\xb9\xdb\x8a\x1e\x17\xe3\x94\x4d\xc3\x7e\xe1\xe3\xf4\xd8\x6f\x5d\xb4\x1e\xd4\xa0\xde\xcd\x4a\x6a\x61\x2b\x8a\x7a\x05\x13\xed\xf1\x06\x56\x93\x01\xcb\x22\xeb\xd1\xbf\x95\x44\xed\x2c\x9a\x98\xec\xfa\xf1\x2c\xd2\x36\x71\xaa\xa9\x8a\x79\xaa\x84\x92\xab\xc8\x86\x09\xc5\x8c\xad\x84\x92\xc0\x6f\x75\xb2\x48\xae\x99\x94\x45\x74\x24\x53\xb6\x07\x39\xe2\xec\x24\xcf\x7f\x9d\x8c\xde\x3a\x80\x75\x92\x14\xec\x60\xb2\x9c\x61\xe3\xa3\xa0\x01\x5e\xfa\x70\x0c\xbd\x47\x30\xb7\xa9\xbe\x7f\x80\x45\x6f\x09\x85\x9b\xaa\xaa\xd1\x99\x6e\x02\xcf\x4e\x93\xad\x51\xf9\x37\x36\x88\x6c\x83\xb7\x1a\x00\x00")
 
 func templateDialectGremlinQueryTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -494,12 +587,12 @@ func templateDialectGremlinQueryTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/query.tmpl", size: 3929, mode: os.FileMode(420), modTime: time.Unix(1570094435, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/query.tmpl", size: 6839, mode: os.FileMode(420), modTime: time.Unix(1792026364, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x53\x4f\x6f\xd3\x30\x14\x3f\xc7\x9f\xe2\x31\x4d\x28\xa9\x82\xbb\xed\x06\x28\x87\x31\x86\x34\x09\x90\xd8\xa6\x5d\xa6\x1d\x5c\xfb\xa5\xb5\xe6\xda\xe1\xd9\x89\x5a\x45\xfe\xee\xc8\x49\x5a\xba\xa2\x21\x38\xc5\xf6\xfb\xbd\xdf\x1f\x3b\xaf\xef\xe7\x33\x76\xe5\x9a\x2d\xe9\xe5\x2a\xc0\xc5\xd9\xf9\xfb\x77\x0d\xa1\x47\x1b\xe0\x8b\x90\xb8\x70\xee\x19\x6e\xac\xe4\x70\x69\x0c\x0c\x20\x0f\xa9\x4e\x1d\x2a\xce\xee\x57\xda\x83\x77\x2d\x49\x04\xe9\x14\x82\xf6\x60\xb4\x44\xeb\x51\x41\x6b\x15\x12\x84\x15\xc2\x65\x23\xe4\x0a\xe1\x82\x9f\xed\xaa\x50\xbb\xd6\x2a\xa6\xed\x50\xff\x7a\x73\x75\xfd\xfd\xee\x1a\x6a\x6d\x10\xa6\x33\x72\x2e\x80\xd2\x84\x32\x38\xda\x82\xab\x21\x1c\x88\x05\x42\xe4\x6c\x36\x8f\x91\xb1\xbe\x07\x85\xb5\xb6\x08\x27\x4a\x0b\x83\x32\xcc\x97\x84\x6b\xa3\xed\xdc\x63\xda\x9e\x40\x8c\x09\x75\xba\x68\xb5\x49\x9e\x3e\x54\xd0\x08\x2f\x85\x81\x53\x7e\x27\x5d\x83\xfc\xd3\x54\x99\x80\x84\x12\x75\x37\x22\xf7\xeb\x7d\x7b\x12\xad\x5b\x2b\x21\x7f\x81\x8d\x11\x66\x87\x2a\x31\x16\x30\x19\xb9\x93\xc2\xe6\x32\x6c\x40\x3a\x1b\x70\x13\xf8\xd5\xf8\x2d\xa1\x03\x6d\x03\x52\x2d\x24\xf6\xb1\x00\x24\x72\x04\x3d\xcb\x3a\x41\x90\xb3\x2c\x0b\x24\x3a\x24\x2f\x0c\xcc\x94\x37\xfc\x7e\xb7\x65\x59\x46\xe8\xa1\x82\xb7\x93\x04\xbf\x45\xdf\x38\xeb\xb1\x8f\x2c\x2b\x58\xa6\x6b\x30\x68\x8f\x1d\xf2\x5a\xa3\x51\xbe\x80\xaa\x82\xf3\xa4\x73\x20\x50\xc1\x31\x78\x47\xfd\x20\x4c\x8b\xfe\x15\x2e\xce\x79\xc1\xb2\x08\x68\x3c\x0e\x8c\xe3\x71\xba\xba\xb5\x78\xc6\xfc\xf1\xe9\x20\x61\xf9\x37\x53\x45\x6a\x76\x04\xba\x84\x7a\xb8\x79\x61\x97\xf8\x87\xa9\x89\x3e\x29\x4d\x52\x8f\xfa\x09\x2a\xa8\x59\x96\xc5\xff\x09\xf4\x4d\x34\xf9\x8b\x08\x2c\xfb\xd9\x22\x6d\x4b\x58\x68\xab\xb4\x5d\x0e\x19\xf6\x74\xfc\x47\x2a\xe6\xe3\xd5\x22\x0d\xff\xc6\x31\xbf\xa2\xb4\xe2\xd7\x1b\x94\xe9\xb9\x4b\x38\x22\x2c\xd3\xec\x14\x1f\x87\xf6\x37\x15\x58\x6d\x86\x1c\x84\xa1\x25\x9b\x4e\x07\x17\xff\xfa\x74\x53\x1b\xa1\xe7\xb7\x28\xd4\x83\x30\x79\x37\xe6\xe8\xd6\xe5\xce\xe2\x41\x75\x8c\xfc\x3b\xc0\xeb\x0e\xa6\x6d\xb7\xe6\x9f\x31\xcd\x75\xe2\x1d\x26\x03\xad\x82\x18\x7f\x05\x00\x00\xff\xff\xc9\xdb\x41\xb3\x36\x04\x00\x00")
+var _templateDialectGremlinSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x53\xdd\x6b\xdb\x30\x10\x7f\xb6\xff\x8a\x5b\x29\xc3\x09\x9e\xd2\xf6\x6d\x1b\x7e\xe8\xb2\x0c\x0a\xdb\x60\x4b\xd9\x4b\x29\x43\x95\xce\x89\xa8\x22\x79\x92\x6c\x12\x8c\xff\xf7\x9d\x6c\x27\x4d\xc3\x3a\xb6\x27\x49\xf7\xf1\xfb\xb8\x43\x6d\x3b\x9b\xa6\x73\x5b\xed\x9c\x5a\xad\x03\x5c\x5d\x5c\xbe\x7d\x53\x39\xf4\x68\x02\x7c\xe2\x02\x1f\xac\x7d\x84\x1b\x23\x18\x5c\x6b\x0d\x7d\x91\x87\x98\x77\x0d\x4a\x96\xde\xae\x95\x07\x6f\x6b\x27\x10\x84\x95\x08\xf4\xd4\x4a\xa0\xf1\x28\xa1\x36\x12\x1d\x84\x35\xc2\x75\xc5\x05\x1d\x57\xec\x62\x9f\x85\xd2\x52\x3a\x55\xa6\xcf\x7f\xbe\x99\x2f\xbe\x2e\x17\x50\x2a\x4d\x10\x43\xcc\x59\x1b\x40\x2a\x87\x22\x58\xb7\x03\x5b\x52\xf4\x89\x2c\x38\x44\x96\x4e\x67\x5d\x97\xa6\x6d\x0b\x12\x4b\x65\x10\xce\xa4\xe2\x9a\x1a\x66\x2b\x87\x1b\xad\xcc\xcc\x63\x7c\x9e\x01\x95\x51\xd5\xf9\x43\xad\x74\xd4\xf4\xae\x80\x8a\x7b\xc1\x35\x9c\xb3\xa5\xb0\x15\xb2\x0f\x63\x66\x2c\x24\x56\x54\xcd\x50\x79\xb8\x1f\xda\x23\x69\x59\x1b\x01\xd9\xb3\xda\xae\x83\xe9\x31\x4b\xd7\x4d\x60\x14\xb2\x14\xdc\x64\x22\x6c\x69\x48\x26\xe0\x36\xb0\xf9\x70\xe6\xd0\x90\xdf\x80\xae\xa4\x59\xb7\x54\x8f\xce\x59\x07\x6d\x9a\x34\xdc\x41\x96\x26\x49\x70\x9c\xb0\x3d\x49\x9d\x4a\xaf\xd9\xed\xfe\x49\x29\x5a\x03\x14\xf0\x7a\xa4\x60\xdf\xd1\x57\x96\x46\xdb\x76\x69\x32\x49\x13\x55\x82\x46\x73\xaa\x90\x95\x0a\xb5\xf4\x13\x28\x0a\xb8\x8c\x3c\x47\x04\x05\x9c\x16\xef\xa1\x7f\x70\x5d\xa3\x7f\x01\x8b\x31\x46\x74\x1d\xa0\xa6\xb5\x46\xc4\x21\x1c\x47\xb7\xe1\x8f\x98\xdd\xdd\x1f\x39\xcc\xff\x26\x6a\x12\x9b\xc9\xbe\xca\xa1\xec\x27\xcf\xcd\x0a\xe1\xcf\xc5\x3d\xd3\x48\x75\xa7\xee\x49\x7c\x49\x81\xee\x7f\x0c\x7d\xe1\x55\xf6\xcc\x42\x9a\xfc\xaa\xd1\xed\x72\xf8\x19\xd9\x0f\x38\xec\x5b\x8c\x66\xc3\x4c\x69\x41\x31\x79\x0a\x2c\x5d\xbc\xb1\xc5\x16\x45\xdc\x73\x0e\x23\xd2\x01\x24\x8f\xbf\x66\xf2\xbe\xef\x7f\x55\x80\x51\xba\x77\xe0\x30\xd4\xce\xc4\x68\xcf\xff\xaf\x4b\x1b\xdb\x08\x92\xd6\xce\x25\xd9\xc9\x9a\xc1\x41\xb3\xc9\xf7\x1a\x8f\xb2\x83\xd9\x27\x07\x2f\x2b\x18\x9f\xcd\x86\x7d\xc4\xf8\xa3\x23\x6e\xff\x27\xd0\x48\x92\xf2\x1b\xbb\x45\xd8\x76\x30\x04\x00\x00")
 
 func templateDialectGremlinSelectTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -514,12 +607,32 @@ func templateDialectGremlinSelectTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/select.tmpl", size: 1078, mode: os.FileMode(420), modTime: time.Unix(1567600027, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/select.tmpl", size: 1072, mode: os.FileMode(420), modTime: time.Unix(1792022167, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateDialectGremlinTouchTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x52\x4d\x6f\x1a\x31\x10\x3d\xb3\xbf\x62\x8a\x68\xb4\x1b\x2d\x86\xe6\xd6\x54\x1c\x22\x0a\x2a\x52\x84\xd2\xd2\xf6\x5a\x39\xf6\x2c\x58\x31\xf6\x76\xd6\x4b\x41\x2b\xfe\x7b\xc6\x66\x93\x56\x95\x72\xb2\x3d\xef\xcd\x7b\xf3\xe1\xae\x9b\x5c\x67\x73\x5f\x9f\xc8\x6c\x77\x01\x6e\xa6\x1f\x3e\x8e\x6b\xc2\x06\x5d\x80\xa5\x54\xf8\xe8\xfd\x13\xac\x9c\x12\x70\x67\x2d\x24\x52\x03\x11\xa7\x03\x6a\x91\x7d\xdf\x99\x06\x1a\xdf\x92\x42\x50\x5e\x23\xf0\xd3\x1a\x85\xae\x41\x0d\xad\xd3\x48\x10\x76\x08\x77\xb5\x54\x7c\xdc\x88\xe9\x0b\x0a\x95\x67\x38\x33\x2e\xe1\xf7\xab\xf9\x62\xbd\x59\x40\x65\x2c\x4b\x5c\x62\xe4\x7d\x00\x6d\x08\x55\xf0\x74\x02\x5f\x71\xf4\xaf\x59\x20\x44\x91\x5d\x4f\xce\xe7\x2c\xeb\xb8\x07\x08\xbe\x55\x3b\x68\x90\xcb\x8b\xd9\x6d\xad\x65\xc0\x71\x30\x7b\x84\x9a\x7c\x8d\x14\x92\x86\x84\x03\x5f\xf1\xc8\x7c\x30\xcc\xbd\xf0\x40\x63\x25\x5b\x1b\x04\x24\xc5\xae\x8b\x01\xe3\x10\x86\xda\x48\xcb\x15\x4c\xb6\x84\x7b\x6b\xdc\x24\xd9\x0c\x81\x49\x83\xae\x1b\xc3\xa8\x82\xdb\x19\x8c\xc4\x46\xb1\x85\x58\x1a\xb4\x3a\x61\x3c\xa1\x08\x5c\xf5\x69\xe2\x1b\x36\xb5\xe7\xb6\x3b\xc6\x02\x49\xae\xa1\x91\x36\x32\xb6\xe2\x67\x6e\x74\x21\xb2\xc1\xe0\x8b\x6c\xee\xe5\x23\xda\x9c\xed\x47\xe2\x41\xaa\x27\xb9\x45\x56\x13\x29\x9a\x28\x0f\x7d\x27\xb9\x6e\xac\xd8\x18\xb7\xb5\x58\xc2\xff\xf4\xf8\xae\xc4\x9c\xed\x82\xe4\x2d\x9e\xcf\x6f\x51\x7e\xa4\xde\x3f\x5f\x5a\x5f\xcb\x7d\x84\xf2\x22\x19\xcd\x79\x3b\x21\x2f\xb2\xc1\xef\x16\xe9\x54\xc2\xaf\x58\xeb\x6b\xe1\xe2\x6b\x8c\x46\xd8\x54\x80\x44\x11\x54\x42\x93\x61\x58\x2c\x8e\xa8\x72\x15\x8e\x25\xf4\xb9\xaf\x69\x65\xfc\x38\xc5\xa7\x94\xf1\x6e\x06\xce\x58\xe8\xd8\x8b\x30\xb4\xe4\x62\x34\x1b\xf0\x78\x5c\xf9\x22\xc9\x6c\x1e\x9c\xd4\xab\x4b\x29\xbd\xd7\xdb\x99\x4c\x70\x30\x9b\xc1\xf4\x5f\xf0\x6a\x41\xb4\xf6\x61\x19\xbf\x5b\x57\xed\x83\xd8\xd4\x64\x5c\xa8\xf2\x61\x1a\x4a\xdf\x36\xfc\x31\x61\x07\x46\xdf\xc2\xfb\xc3\xb0\xe4\x4b\x71\x4e\x92\xbd\x08\x1b\x66\x71\xdd\xe8\xd2\x7a\x9f\x01\xaf\x55\xa9\x19\x35\x03\x00\x00")
+
+func templateDialectGremlinTouchTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateDialectGremlinTouchTmpl,
+		"template/dialect/gremlin/touch.tmpl",
+	)
+}
+
+func templateDialectGremlinTouchTmpl() (*asset, error) {
+	bytes, err := templateDialectGremlinTouchTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/dialect/gremlin/touch.tmpl", size: 821, mode: os.FileMode(420), modTime: time.Unix(1792022167, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\xdf\x73\xdb\x36\xf2\x7f\xa6\xfe\x8a\xfd\x6a\x94\x0c\xe9\xaf\x0a\x3b\x7d\x3b\x77\x7c\x33\xa9\xa3\x5c\x75\xd3\xb3\xd3\xc8\xed\x3d\x64\x32\x1a\x98\x5c\x4a\x18\x53\x00\x0b\x80\x8c\x7d\x1a\xfe\xef\x37\xf8\x41\x12\xa4\x64\xc7\x49\x7a\xed\x8b\x2d\x02\xcb\xdd\xfd\xec\x7e\x76\xb1\xc4\x7e\x7f\x7a\x32\xb9\x14\xe5\x83\x64\x9b\xad\x86\xef\xcf\x5e\xfd\xed\xbb\x52\xa2\x42\xae\xe1\x2d\x4d\xf1\x56\x88\x3b\x58\xf2\x94\xc0\xeb\xa2\x00\x2b\xa4\xc0\xec\xcb\x1a\x33\x32\xb9\xd9\x32\x05\x4a\x54\x32\x45\x48\x45\x86\xc0\x14\x14\x2c\x45\xae\x30\x83\x8a\x67\x28\x41\x6f\x11\x5e\x97\x34\xdd\x22\x7c\x4f\xce\xda\x5d\xc8\x45\xc5\xb3\x09\xe3\x76\xff\xe7\xe5\xe5\xe2\x6a\xb5\x80\x9c\x15\x08\x7e\x4d\x0a\xa1\x21\x63\x12\x53\x2d\xe4\x03\x88\x1c\x74\x60\x4c\x4b\x44\x32\x39\x39\x6d\x9a\xc9\x64\xbf\x87\x0c\x73\xc6\x11\xa6\x19\xa3\x05\xa6\xfa\x74\x23\x71\x57\x30\x7e\x5a\x95\x19\xd5\x38\x85\xa6\x31\x52\xb3\xdb\x8a\x15\xc6\xa7\xf3\x0b\x28\xa9\x4a\x69\x01\x33\xb2\x4a\x45\x89\xe4\x47\xbf\xe3\x05\x25\xa6\xc8\x6a\x27\xd9\xfd\xee\x5e\xf7\x42\x82\xa3\xd9\xdf\x52\xb5\xaa\xf2\x9c\xdd\xf7\x02\xd3\x6b\xde\x1b\xfd\x0f\x4a\x61\xe4\xce\xa0\x69\xf6\x7b\x60\xb9\x7b\xd3\x3e\xb8\xcd\x0b\x98\x72\x56\x4c\xdd\x12\xf2\xcc\xbc\x39\xc9\x2b\x9e\x42\x3c\x70\xa6\x69\xe0\x24\x84\xd1\x34\x09\x78\xa4\x2b\x5a\x63\x9c\xea\x7b\x48\x05\xd7\x78\xaf\xc9\xa5\xfb\x9f\x18\x15\xdf\x05\x46\xad\x02\x72\x45\x77\xde\x03\x2c\x94\xf9\xc5\xb8\xee\x6c\xcf\x01\xa5\x14\x32\x81\xfd\x24\x92\xa8\x8c\xef\x2f\xbd\x19\xf2\x1e\x55\x29\xb8\xc2\x7d\x33\x89\x7e\xaf\x50\x3e\xcc\xe1\x96\xf1\x8c\xf1\x8d\x95\x1b\xb9\x4b\xfc\x6b\x23\x1f\xc6\x52\x2c\xeb\x6c\x27\xe4\x17\xa3\x35\x4e\x26\x11\xcb\x8d\x1f\xc7\xb4\x66\xd2\xfc\x22\x8b\x7b\x4c\x0d\xe6\x39\x8c\x3c\x99\x1b\x86\x26\x3f\xd8\xd7\xff\xef\x02\x38\x2b\x0c\x94\x48\xa2\xae\x24\x87\x2e\xec\x1e\xe9\x24\x6a\x5a\x63\x73\x10\x77\xc6\x20\x53\x97\x82\x2b\x4d\xb9\x5e\x98\x48\xc4\x4e\x9d\xb8\xfb\xac\x9a\x21\xce\x49\x64\x17\x66\x16\xc4\x8c\xbc\xef\x21\xd8\x1d\xb3\xd1\x34\x36\xbc\x83\xa4\xa4\x82\xe7\x6c\x73\x7e\x00\xdb\xad\x9b\x77\x47\xa1\x31\x9b\x6f\xa5\xd8\xb5\xc9\x89\x8f\xc2\x6f\x1d\xe7\xac\xf0\x0e\x1b\x8f\x43\x38\xd2\x62\xe1\xac\x70\x40\x3c\x35\x7a\x19\x89\x8a\xbc\x47\x9a\x2d\xb9\x36\x09\xb2\x32\x8e\xad\x5f\xcc\xd7\x78\x50\x09\x2c\xb3\xf6\xc9\xf2\x0d\xb9\x79\x28\x31\x2c\x84\x04\x4e\x32\x55\x90\x1b\x49\x6b\x94\x8a\x5a\x28\xc6\xf0\x27\xa6\xb7\x40\xae\xaa\x9d\xcd\x94\xa4\x8c\x6b\xe7\xab\x36\x0a\xd2\x7e\x51\x69\x59\xa5\xda\x45\xa0\x94\x98\x8d\xf5\x9d\x9e\x86\xd2\x46\x82\xa5\x54\x23\x31\xf2\x1a\x95\x3e\x22\x6f\x97\x77\x54\xa7\x5b\x54\x40\x79\x06\x4c\x2b\xa7\x84\x72\x4d\x7c\x5c\x7b\xa5\xb6\x32\x76\xf4\x0e\xe3\x0f\x1f\x4f\xfa\xe5\x39\x9c\xcd\x0d\x6c\x62\x50\x0e\xa2\x69\x7f\x9f\x9e\x40\x4a\x15\x9a\xc6\xe7\xba\x18\xa8\x12\x53\x96\xb3\x14\x6a\x94\x1a\xef\xc1\x76\xbf\x43\xca\xd5\xc6\xdc\x86\xfc\x16\xb3\x2c\xe9\x54\x6d\x90\xa3\xa4\x45\xab\x2a\x17\x12\xae\xac\x1e\x96\xa2\x0a\x34\xf5\x39\xef\xd4\x24\xe4\x27\xaa\x7e\xa6\xb7\x58\xd8\xec\x92\x77\x34\xbd\xa3\x1b\x23\x45\xec\x6a\x32\x89\x22\xa3\x6f\x3d\x87\xd2\xf6\x4b\xca\x37\x78\x40\xde\x2e\xb0\xca\xa7\x22\xae\x13\x17\xa9\x10\x78\x4d\x25\xc4\xae\x38\x58\x0e\x42\x8e\x33\x1c\x17\xc8\x61\x46\x16\xd9\x06\x55\xe2\xfc\x8c\x64\x0d\x17\x50\x93\xcb\x42\x70\x34\xb4\x8c\xa2\x35\x5c\x80\xac\x9d\x9a\x56\x73\xa4\xa5\x82\x0f\x1f\x87\xc9\x9c\x44\x3e\x42\xce\xe7\xd9\x7a\x0e\xb3\xdc\x15\xeb\x5b\x86\x45\xa6\xfa\x22\x76\xee\xc4\x5c\x68\x98\xe5\x64\xb9\xdb\x55\x9a\xde\x16\x98\x98\xa7\x5f\x6d\x50\xdf\x60\x4e\xab\xc2\xb3\xd0\x94\x68\x4d\x8b\x0a\x8f\xf5\x2f\xf3\x9c\x93\x95\x25\xa6\xb5\x03\x4d\xf3\x83\x17\x0f\x0b\xb6\xcb\x6d\x4e\x7e\xe5\xec\xf7\xca\x67\x26\x1a\x92\xeb\x02\x68\x59\x22\xcf\xe2\x60\x71\x0e\x2f\xfb\x27\xa7\xcb\xb1\xff\xbc\x4f\xe9\xf1\x6c\xce\x61\xbc\xec\xbc\x6d\x1b\xa2\x6d\x11\x27\xd6\xd7\x84\x5c\x8a\xca\xb4\x82\xb9\x37\x60\xea\xe2\x1c\xd6\x6b\xb2\x54\x71\x49\xae\x16\xbf\xc4\x67\x49\xd2\xbd\x19\x5f\xe1\xa7\x85\x94\x0e\x89\x85\xfd\xed\x1e\xb4\xa6\x9b\xa4\x8b\x57\x97\xf0\x28\xaa\xc9\x3b\x29\x4a\x94\xfa\x21\x36\x69\x5f\x31\xbe\x29\xf0\x4b\xd4\x1b\x2d\x56\x55\x9f\x08\xd3\x9f\x0c\x29\x51\xb2\xb4\xb5\xf3\x54\xae\x69\x96\x3d\x3b\xdd\x8f\xe7\x3b\xa2\x59\xf6\x5b\x6b\x42\x76\x64\x37\x62\x82\xc7\xeb\x35\xb1\x9b\x87\x29\x3d\x80\x96\xcc\x4d\x7e\xba\x94\xb4\x61\x24\xab\x6a\x17\x27\xe4\x0a\xef\xb5\x2b\xa1\xaf\xe5\xd8\x1f\x48\xb2\x16\xf2\x01\xcd\xfe\x4c\x9e\xe5\x3b\x4d\x56\xa5\x64\x5c\xe7\xf1\xf4\xff\x2f\xe0\x45\x3d\xed\xc9\xd7\x79\xe4\xe9\x37\xe6\xdf\x37\x10\x70\xbd\xfe\x83\x73\xeb\x3c\xec\xc8\xdc\x79\x39\x3e\x76\xc6\x47\x50\x81\x54\x82\x28\x35\x13\x9c\x16\x90\xdb\xae\x48\x82\x03\xc3\x9e\xc3\x33\x93\xea\xeb\x56\xc8\x1d\x1f\x54\x42\xe9\xc0\x33\x34\x9d\x97\x71\x8d\x32\xa7\xa9\x1d\x1d\x9f\xd1\x74\x83\x62\x18\x6a\xb6\xf5\x76\x30\x1b\x19\x3f\x8f\x15\x5a\x5b\x5a\x81\x2f\x1d\x99\xfb\xb5\x67\xe4\xe4\x39\x01\x34\x9e\x15\xc8\x03\xc5\x09\xfc\x1d\xce\x9c\x0f\x35\x59\xb1\x0c\x17\x79\x8e\xa9\x36\x69\x7d\xd7\x09\x05\xf2\x84\x90\x84\xbc\x91\xa2\x74\x19\x3b\x92\x94\x20\x6a\xe8\xa2\x66\x4f\xc3\x60\xdc\x74\x1f\x4d\x4c\x70\xb3\x3d\x5d\xf2\x69\xb0\xc7\xcd\x8c\x69\x3e\x7f\x2c\xa5\x61\xfa\x42\x91\x17\x6a\x1a\x40\x9f\x61\x08\xba\x3f\xfc\x66\x48\x96\x6a\xc9\xcd\xb9\x89\x41\x82\x02\x63\x17\x30\xbd\xae\xf4\x34\xdc\xb4\xd6\x0e\x8d\xa1\xeb\xa2\x4f\x9a\x1c\xc4\xf7\xf4\x04\x24\xee\x44\x8d\x80\x16\xab\xa3\x5f\xe0\x5a\xd8\x2e\x1f\x63\x07\x9a\x46\xdc\x7e\xf6\x61\x3b\x6d\xdb\xdc\x0c\x27\x1f\x33\xcc\xb0\xec\xf1\x51\xc6\xb9\xf2\x19\x6d\xa1\xfb\xce\xc7\x15\x16\xf9\x7b\xcc\x7d\x7c\xb4\x1c\xb5\xf2\x1f\x85\xde\x2e\x6c\x91\x73\xa7\x2b\x71\x43\x90\x9d\x38\x02\x84\xe4\xdf\x5b\x94\x68\x08\x74\x2d\xcd\xdf\x25\xf7\xad\x76\xf9\xc6\x4c\x7c\xb6\x07\x5c\x57\x7a\xb0\x98\x24\xdd\x24\xe4\xc9\x45\x96\x1a\x25\xd5\x6e\x60\xea\xe0\x1f\xcf\xf3\x81\xab\x4b\xfe\x85\x8e\xea\x2d\xca\xa1\x43\xcf\xf3\xe7\x11\xfb\xd7\x95\xfe\x13\x1c\xe8\xfa\xb8\x99\x1c\xbb\x9e\xa1\xa5\x9a\x83\x96\xbe\x38\x5b\x76\xfa\xb1\x7a\xc0\xce\xcf\xd1\xc8\x3c\xe3\xb1\x6e\xf5\x78\xc5\xd5\xe4\x75\x96\x0d\xa1\xdb\x0f\xbf\xd8\x8f\xfb\x89\x63\xc3\x61\x08\x8f\xbd\x78\x23\xfa\xd7\x1c\x61\x1e\xe7\xee\x4f\x54\x8d\xbf\xb3\x1e\x65\xf6\x57\x0d\x0e\x6e\x6c\x18\x95\xc3\xd0\xdf\xe1\x14\xf0\x05\x33\x80\xe9\x8f\x4f\x8d\x00\xde\xc2\x1c\x4c\x28\x9c\x7a\xdf\xed\xbf\x1e\xc9\x86\x2c\xc6\x1f\x4e\x1d\x90\xaf\x2a\xe0\xbf\x00\xfe\x88\x43\xff\xa3\x68\x98\x87\xfe\x10\x69\x9a\x01\xee\xbf\x0a\xf5\xd1\xc3\xfd\xe0\x30\x0e\xbe\xbb\x6b\x37\xa5\xfd\x8b\x96\xb1\x96\x15\x26\xfd\xcd\x5a\xdd\x62\x08\x3e\x46\x9f\xbc\xc0\xf0\x33\x44\x10\xd8\x60\x88\xf0\xfd\x66\x47\xef\x10\x54\x25\xd1\x5e\x95\xea\xee\x72\x22\x13\xa8\x6c\x1f\x4c\x05\xd7\x94\x71\xd8\x09\x2b\x43\x39\x18\x3f\xfd\xc5\x01\xcb\xe1\x13\xc2\x96\xd6\x83\x8b\x12\xdf\xb6\xda\xba\xb6\xdd\xb4\xbb\x54\xf8\xd6\xaa\x7e\x22\x8d\xff\xb8\x89\x5f\x85\x59\x7c\xb9\x90\xb2\x8f\xc9\x5b\xca\x0a\xcc\xf6\x3b\xb5\x39\x87\xa9\x6f\xb3\x3d\x5e\x0f\x53\x1d\xc5\x39\x6d\x1e\x4f\x6c\x54\xc3\x45\x00\x5e\x7d\x38\xfb\x68\xaf\x28\xc8\xa5\xa0\x05\xaa\x14\xe3\xd1\xa6\xf1\x79\x0e\xf6\xce\xa2\xbd\xed\x48\x65\xdf\xdc\x43\xe9\x57\xe7\x1f\xfd\xd4\x69\x8d\xc8\xb1\x62\x39\x50\x76\x84\x59\x87\x07\x8e\x11\xf5\x97\x70\xe6\x43\xe2\x9f\x82\x71\xb3\x61\xa6\xc5\x89\xbd\x65\xf6\xaf\xfe\x37\x00\x00\xff\xff\xe5\x7a\x4f\x5c\xcf\x17\x00\x00")
+var _templateDialectGremlinUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x59\xdd\x73\xd3\x38\x10\x7f\x4e\xfe\x0a\x9d\x27\x30\x76\x2e\xa8\x85\xb7\x2b\xc3\xcd\xf0\x11\xee\x72\xc3\xb5\x40\x7a\xbd\x07\x60\x3a\xae\xbd\x4e\x3d\x38\xb6\x4f\x96\x43\x7b\x9d\xfc\xef\xb7\x2b\xc9\xb6\xec\xd8\x34\x14\x38\x78\xa0\x96\xb4\xda\xcf\x9f\x76\x57\xca\xcd\xcd\xc1\x74\xfc\x3c\xcb\xaf\x45\xbc\xba\x94\xec\xd1\xe1\xc3\x5f\x1e\xe4\x02\x0a\x48\x25\x7b\xe9\x07\x70\x91\x65\x1f\xd9\x22\x0d\x38\x7b\x9a\x24\x4c\x11\x15\x8c\xd6\xc5\x06\x42\x3e\x3e\xbd\x8c\x0b\x56\x64\xa5\x08\x80\x05\x59\x08\x0c\x87\x49\x1c\x40\x5a\x40\xc8\xca\x34\x04\xc1\xe4\x25\xb0\xa7\xb9\x1f\xe0\x9f\x47\xfc\xb0\x5a\x65\x51\x86\xcb\xe3\x38\x55\xeb\xaf\x16\xcf\xe7\xc7\xcb\x39\x8b\xe2\x04\x59\xe8\x39\x91\x65\x92\x85\xb1\x80\x40\x66\xe2\x9a\x65\x11\xce\x36\xc2\xa4\x00\xe0\xe3\xe9\xc1\x76\x3b\x1e\xdf\xdc\xb0\x10\xa2\x38\x05\xe6\x84\xb1\x9f\xe0\x86\x83\x95\x80\x75\x12\xa7\x07\x65\x1e\xfa\x12\x1c\x86\x64\x48\x35\xb9\x28\xe3\x84\x74\x3a\x7a\xc2\x72\xbf\x08\xfc\x84\x4d\xf8\x32\xc8\x72\xe0\xcf\xcc\x8a\x21\x44\xa9\x10\x6f\x34\x65\xfd\x5d\x6f\x37\x44\x19\x4a\xc4\xf5\x4b\xbf\x58\x96\x51\x14\x5f\x35\x04\xce\x49\xda\x08\xfd\x17\x44\x46\x74\x4e\x1a\x27\x6a\x72\x1c\x95\x69\xc0\xdc\x96\x9c\xed\x96\x4d\x6d\x0d\xb7\x5b\x8f\x19\x23\x96\xfe\x06\xdc\x40\x5e\xa1\x83\x53\x09\x57\x92\x3f\xd7\x7f\x3d\x62\xf1\x80\xc5\x91\xd6\x64\xbb\x55\x0c\xf8\xb1\xbf\xa6\x01\x7e\x43\x52\xd0\xd7\xbb\x0f\x6a\x7e\xf1\x82\x9f\x5e\xe7\xd5\x52\x1a\xe2\xc7\x8c\x81\x10\x99\xf0\xd8\xcd\x78\x44\xac\x84\x9f\xae\x80\x4d\xce\x67\x6c\x12\x91\xc6\x13\xfe\x32\x86\x24\x2c\x48\xe9\xd1\xc8\x08\xf3\x71\xeb\x24\xe2\x8b\x62\x49\xa1\x64\x6e\x8a\x61\xa2\xf1\x7a\x5d\x4a\xff\x22\x01\x4f\x53\x8f\x90\x34\x81\xb4\x6b\x25\xf7\xf3\x1c\x85\xd3\x6c\xc4\x97\x52\x94\x81\x54\x32\x94\xc1\xbf\xb2\x43\x52\x05\xff\x09\x90\xa5\x48\x59\xed\xbe\x5a\xd7\x82\x1f\xc3\x27\xd7\xc1\x85\x0b\x1f\xad\x9b\x90\x33\xa2\x78\xc5\x5f\xfb\xc1\x47\x7f\x45\xd6\x1d\x31\x2d\x22\x4e\x57\x4c\x66\x08\x29\xe2\xfe\xde\xd1\x12\x8d\x73\xde\x3b\x04\x54\xd2\xbc\x28\xf3\x3c\x13\x12\xd1\x7a\x71\x5d\x39\xdc\xf1\x48\x87\xca\x64\xed\xaa\x71\xeb\x1b\x0f\x00\xf9\xe7\xbe\xd9\xc0\xdf\x42\x91\x67\x88\xea\x1b\x5c\x93\x02\xe3\x25\x0a\xc4\x16\x52\x74\xad\x37\x1b\x3a\x81\xeb\x52\xc5\x61\x1d\x22\x54\xe5\x9f\x12\xc4\xf5\x8c\x9d\x13\xbf\x9a\x39\x7f\x43\xb3\x2e\x2e\x23\x1b\xf4\x4c\x9f\xb0\x50\xd0\x17\x9f\x5f\x41\x40\xf8\x99\x31\xc3\xa9\x66\x32\xa3\x93\xec\x3d\x56\xfb\x7f\x7a\xc2\x10\x9f\xca\xfd\x03\xce\x1f\x93\x4b\xb4\xb4\x19\xc3\xac\x80\x12\xe3\x02\xdd\x5f\x48\x3f\x95\x73\x0a\x8e\xab\xd9\xe1\xda\x6d\x6c\xda\xf6\x1b\x4f\x4f\x84\x06\xdd\xdb\xc6\x06\xb5\x42\x0b\x78\x3e\xc8\xe1\x2d\x84\x07\x2a\xf4\x47\x3b\x76\xeb\x79\xda\xdb\xf1\x0d\x2d\xbe\x14\xd9\xba\x0a\x97\xdb\x6b\x7e\xa5\x38\x8e\x8d\xc2\x0a\x0b\x96\x39\x42\xd9\x82\xeb\x06\x15\xfa\x9c\x69\x79\x5d\x65\xf4\x36\x02\xa3\x2d\x62\xe3\x0b\xa2\xcc\x93\x52\xa8\x24\x64\x99\xdc\x9a\x37\xa6\x9a\xf3\xd4\xd8\xd2\xb7\x71\x1f\xd3\x7a\x6c\x53\xc6\x8d\x86\x78\x6a\x5f\xba\xfd\x2e\x56\xe7\x64\x3a\x6c\xf1\xa0\xaa\xca\x9e\x50\x9d\xa1\xb5\xff\x11\xdc\x9d\x14\x35\x63\x87\xb3\x2a\x7d\xf4\x31\xf0\x94\xe8\x28\x13\x0c\x93\x95\xda\x6b\x7b\x90\x92\xb6\x4a\x65\x83\x2e\x56\xbe\x20\x0d\x9e\x98\x6c\xe1\xe2\x60\x97\x13\x6a\x54\xe7\x82\xca\x75\x8a\x50\xc5\x5e\x4d\x23\xa1\xc4\x3c\x58\xcc\xaa\xe8\xa0\xe3\x91\x85\x1f\x9e\x99\x05\x3a\xa3\x55\xf0\x6e\x47\xd9\x7e\x5e\xa9\x84\x2a\x37\x18\x2f\xd0\x1c\x5c\x35\xb6\x57\x34\x5a\xda\xae\xad\x9a\x9e\xb8\xbb\x1d\x31\x8a\xab\x05\xf9\xc6\x62\x2b\x07\x7e\x71\x19\x23\xc2\xe6\xc8\xc7\x21\x1b\xaa\x4a\x1e\x9b\x86\x45\xc2\x4f\xeb\x3c\x6a\xaa\xd3\xa7\x58\x5e\x32\x7e\x5c\xae\x55\xce\x11\x7e\x8c\x2d\x8a\x82\x92\x24\x06\x41\x33\x59\xa8\xb2\xa2\xed\xc6\x5e\x26\xec\xf2\x3b\x38\xb0\xa9\x89\x22\x0e\xb0\x4b\xe0\x44\x2f\xa1\x90\x3d\xf4\x6a\x7a\xed\x4b\xec\x62\x0a\x55\x01\x63\x6c\x83\x02\x93\xfb\xb8\x71\x57\xc3\xd4\x8e\xe1\xb4\x99\x56\xf1\x43\x3b\xb9\x4e\xec\xdd\xea\x72\x30\x65\x01\x15\x35\x6c\x75\x74\xdf\xc2\x8a\x1c\x82\x38\x8a\x83\x2a\xb8\xaa\xdf\xd9\x4d\x9e\x1b\x12\xb7\xe2\x67\x18\x58\xaf\x66\xb5\x82\x14\x08\xf9\x86\x15\xa1\xe4\xb8\x01\x45\xc3\xa9\xc9\x5e\x35\x1b\x8f\xff\xee\x17\xaf\xfc\x0b\x48\x34\x34\x9a\xe2\xca\xd5\x6c\x47\x77\x03\xc0\xbc\x75\xee\x5a\x29\xa1\xf6\xb1\x46\x63\xee\x6e\x3c\x55\x06\x28\x0d\xba\x3a\xc7\xa3\x41\xc8\xa6\x13\x5e\x17\xc1\x8e\xf2\xe7\xe1\x0a\xb1\x6e\xf2\x86\xd8\x20\x90\x37\xfc\x79\x82\xe6\xab\xb3\x35\x3a\xc7\x09\xb1\xd1\x6c\x2a\x9d\xb0\x0c\x17\x0c\x9d\xdf\x8a\xe4\x78\xe4\x7d\x41\xa3\x83\xea\xf4\x34\x37\x38\xfa\x4b\x79\xf4\x05\x44\x7e\x99\xc8\x26\x3b\x6f\xfc\xa4\x84\xbe\x3a\xdc\xd7\xec\x3c\x36\xe4\xad\xe4\x5c\x05\x16\x45\xa4\x31\x16\x6b\xc3\xbb\x8d\xac\xfa\x14\x5b\x93\x33\x76\xbf\x19\x69\x5e\x1a\xfa\x47\x4d\x3c\xfb\x43\x69\x72\x9e\x35\xad\xb5\xad\xea\xba\xca\x3a\x7a\xea\x37\x7d\x8e\xcf\x94\xde\xce\x54\xe9\x4f\xfd\xac\x87\xc4\x65\x2a\x5d\x6f\x66\x04\xd3\x61\x39\x62\xe7\xe7\xd8\x23\xba\x39\x3f\x9e\xbf\x71\x0f\x3d\xaf\xe6\xe8\x62\x03\x87\xdd\x82\xb6\x50\xb9\xe3\x2b\x34\xd3\x5a\x78\x95\xe8\xad\x57\xfb\xb1\x06\x02\xe2\x9a\xbf\x16\xd8\xe3\x0b\x79\xed\x12\x1c\x96\x58\x9a\x12\xf8\x16\x86\x5b\xd5\xb3\x0a\x1c\x25\x33\x02\x31\x08\x3c\xb5\x46\xfe\xe7\xb0\xe1\x87\xe1\xde\xf0\x18\xc6\xc7\x08\xd9\x9c\x55\x22\x44\x7d\x38\x88\x2c\x4b\x5d\x8c\x84\x5a\xdc\x85\xc0\x8e\xc9\xde\x8c\xe2\x56\x87\xaa\x72\x2f\x5f\x96\x6b\x64\x77\x8c\xb7\x0e\x7d\xe4\xee\x8a\xc9\x6f\x08\xca\xca\xe4\x1d\xf8\xfd\x9f\xf8\x8b\xd6\x92\x2f\x73\x81\x16\x46\xae\xf3\xf3\x13\x76\x6f\xe3\x34\xa0\xac\x35\x32\xb0\xec\xe2\xf2\x2b\x80\x89\xc6\x7d\xdb\xd8\x6a\x0d\x6b\x30\xd7\x5a\x0e\xdd\x80\xaa\x7a\x95\x00\xa6\xf0\x2c\x97\xa8\x0b\xd6\x1a\x75\xd9\x2a\xb8\x55\x5d\x54\xd1\x9e\x50\xa8\x4f\x2a\x22\x5d\x6b\x70\x5b\xae\x8d\x8f\x81\x32\x35\xba\x10\x44\xe4\x07\xea\x0e\xb5\x47\x92\xb6\x0e\x43\x9b\x73\x6f\x17\xae\xf4\xec\x3b\x68\xd5\xd1\xb2\x74\xa9\xc1\xdc\xcc\xed\x11\x93\x7d\x1c\x58\xdd\x89\x1b\xc6\xd6\x9d\x77\x83\xf1\x0f\x61\x1e\x45\x10\x48\x0a\xeb\xeb\x9a\xc8\xa2\xe7\x9c\x7b\xfc\x05\x0e\xdd\xaa\x49\xeb\x06\xc5\xf2\x1a\x68\xaf\xa9\xea\x69\xdd\xb2\xf4\x9b\x0a\x7a\x4c\x3d\x4a\x2c\x52\xc7\x5a\x4b\xe9\xbe\x41\xaf\x23\x0a\xd2\xcc\xb9\x57\xf0\x7b\x85\x63\x99\x3e\x01\xdb\xe8\xa6\x58\xe2\xfc\xa2\x58\xa4\x54\x67\xc1\x0a\x90\x25\x0c\x65\x9d\x94\xd2\xb1\x17\x95\xb4\x5d\x61\xa0\xb3\xe8\x67\x45\xb6\xfc\x8b\x40\xc4\x14\x9d\x6d\x80\x81\xb2\x55\xc3\xaf\x52\x4d\xd5\x70\x68\xa7\xcc\x21\x88\x00\x65\xe3\xea\x69\x08\xaa\xeb\x97\x0e\x50\x63\xe9\x12\x92\xe8\x2d\x44\x15\xde\xa4\xe8\xa4\xdd\x67\x99\xbc\x9c\xab\x03\x99\x6a\x06\x55\xcc\xf8\x02\x41\x8e\xbd\x83\x4e\xa2\x75\xf7\xd5\xef\xbf\x5d\xbe\x8b\xf4\x4b\xb8\x0e\x71\xc1\x28\xec\xcb\xa6\xc9\x55\xd4\x4e\xd5\x07\x03\x07\xf4\x8a\xd0\xfb\x4c\x62\xb9\xc9\xf6\xf8\x1d\x1c\xde\x36\x84\x9a\x4c\xbc\x34\x0c\xb6\x98\x1a\x00\xb7\x70\xdb\xd5\xb1\x1d\xca\x3d\x22\xa9\x5b\xd5\x2e\xa6\xf8\xdf\x97\x20\x80\x8e\xed\x89\xa0\xff\x17\xa9\x29\x70\x8b\x17\xd4\x94\xab\xcc\x8b\x7e\x6f\x4d\x7a\x5e\xdd\xaf\xf6\x45\xe0\x16\x74\xdc\x0a\x8e\x5b\x15\x95\xf8\xd5\x56\x68\x3f\x7d\x06\xe4\xef\xc0\xea\xbb\x28\x50\x23\x72\x08\x90\x56\x4e\x30\x37\x9f\x56\x4e\xb8\x0d\x46\x34\x86\xbe\x1a\x31\x9c\xe7\x36\xfc\x69\x18\x76\x4e\x14\x3d\xc5\xb8\xe6\x46\xe6\x69\x34\xec\xba\xb0\x6f\xe3\x69\xd6\x6c\xd3\x80\x19\xc6\x2e\xfa\xad\x7b\x15\x1e\x4e\x52\x77\x69\xd7\x74\xb3\xd6\x39\x0e\x6d\x7d\xdb\xbd\xd7\x17\x74\x5e\x54\x95\x3e\xd7\x78\x19\x09\x33\x46\xae\xd0\xec\x4d\x8d\xbd\xbb\x25\x2b\x3e\xef\xde\x6d\x6b\x43\xee\x74\x80\x7f\x80\xf9\xdd\xf4\xfe\x7d\xbc\x41\x83\xa6\x74\x6f\xb7\x2d\xbb\x7f\x94\xd5\xbd\x2d\x55\x4f\x0b\xd4\x7e\x1b\xd1\xfd\xf1\x9f\x7e\x8e\x59\x02\xfb\xdd\xbb\x3f\xd9\xee\x30\xea\x93\x3d\xf8\x48\x65\x5a\x3f\x2b\x32\x56\xef\x67\x12\x16\xbd\x18\xb1\xa2\x14\xa0\x7e\x00\x6b\x7e\x48\x08\x33\xd0\xbf\x56\xd0\x2f\x40\xb8\x97\xad\x33\x45\xe3\xa7\x8c\xec\x34\x8f\x43\x28\xe1\x13\xb0\x4b\xdc\x64\x3f\x6f\x99\xbc\xd7\x6a\x86\x6a\xe7\x7c\x6d\x5a\xf8\x0c\x0e\x7e\x3b\x75\x1f\xda\x30\xb8\xdf\x38\x44\xfd\x56\x70\xb3\x2e\x56\x47\xcc\x31\x39\xba\xb1\xd5\x98\x58\xf4\xda\xe8\x6c\x87\x51\x31\xa2\x77\x21\x4b\xf3\x77\x87\x1f\xd4\xbb\x13\xaa\xe0\x27\x50\x04\xe0\x76\x16\x49\xdf\x19\xdb\xd8\x2f\xc9\x81\x68\x2a\x83\x4d\xfd\xf0\xe8\x83\xb9\x28\x28\x21\xa2\xcb\x58\xb4\x98\xf5\xc0\x72\xb7\x5a\x11\xa9\x79\x5f\xa5\xbb\xdf\x1f\x59\x9c\xd2\x02\x35\xf8\x63\xf5\xbb\xa1\xd9\xfa\x1f\xca\x58\x85\x32\xa1\x1d\x00\x00")
 
 func templateDialectGremlinUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -534,12 +647,32 @@ func templateDialectGremlinUpdateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/update.tmpl", size: 6095, mode: os.FileMode(420), modTime: time.Unix(1568542264, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/update.tmpl", size: 7585, mode: os.FileMode(420), modTime: time.Unix(1792028650, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x51\x6b\xdb\x3e\x14\xc5\x9f\xad\x4f\x71\x30\x7d\xb0\x43\x63\xf7\xdf\xb7\xff\x60\x0f\x59\x69\xa1\x90\x6d\x94\x0c\xf6\x38\x5c\xe9\xda\x11\x53\x25\x47\x92\x5b\x82\xd0\x77\x1f\x92\xdb\x2c\x4b\x59\x98\x9f\x8c\xee\x3d\xfa\x9d\x7b\xae\x42\x68\x17\xec\xc6\x8c\x7b\x2b\x87\xad\xc7\xf5\xd5\x7f\xff\x2f\x47\x4b\x8e\xb4\xc7\x5d\xc7\xe9\xd1\x98\x9f\xb8\xd7\xbc\xc1\x4a\x29\xe4\x26\x87\x54\xb7\xcf\x24\x1a\xf6\x6d\x2b\x1d\x9c\x99\x2c\x27\x70\x23\x08\xd2\x41\x49\x4e\xda\x91\xc0\xa4\x05\x59\xf8\x2d\x61\x35\x76\x7c\x4b\xb8\x6e\xae\xde\xaa\xe8\xcd\xa4\x05\x93\x3a\xd7\xd7\xf7\x37\xb7\x5f\x36\xb7\xe8\xa5\x22\xbc\x9e\x59\x63\x3c\x84\xb4\xc4\xbd\xb1\x7b\x98\x1e\xfe\x08\xe6\x2d\x51\xc3\x16\x6d\x8c\x8c\x85\x00\x41\xbd\xd4\x84\x52\xc8\x4e\x11\xf7\xad\xdb\xa9\xd6\x58\x41\xb6\xc4\x32\x46\x56\x84\xb0\xc4\x45\x8f\x0f\x1f\x71\xd1\x6c\xb8\x19\xa9\xb9\x9b\x34\x9f\x6b\xfd\xa4\x79\xe5\xb0\x70\x3b\xd5\x6c\x48\x65\x5e\x8d\xc0\x8a\xa2\x37\x16\x3f\x2e\x91\x75\xb6\xd3\x03\xa1\x97\xa4\x84\xcb\xc5\xc2\x35\x5f\x13\xe1\xd3\xbe\x4a\xca\x10\x12\x20\xc6\xaa\xaf\x6b\x56\x14\x91\x15\x91\x25\x2a\x69\x81\xd9\x64\xbb\x00\x9f\x9c\x37\x4f\x70\x72\xd0\x9d\x9f\x6c\x4a\xc1\x62\xb0\x66\x1a\x97\x8f\x7b\x24\x23\x5e\x1a\x8d\x3c\xd6\x5f\xa6\xca\xdd\xed\xe1\x86\xd7\xf9\xda\x16\x9b\x87\x75\xce\x8d\x1b\x35\x3d\x69\xbc\xd8\x6e\x1c\x49\xe0\x45\xfa\x6d\x3e\xef\x86\xc1\xd2\xd0\x65\xc0\x1b\xa9\x61\x45\x92\xa5\x2f\xa7\x70\x92\x81\xf3\x56\xea\xe1\x64\x8c\x33\xae\x3a\x57\x9e\x8b\x74\xbe\x6e\x0e\xcf\x92\x9f\xac\x46\x6a\x58\xb9\xaa\xd7\xcd\xe6\x61\x5d\xb9\xfa\x32\x81\xea\xf7\xd9\x9d\x81\x26\xd6\x1f\x5b\xd6\xef\xd6\x7c\xa8\xa5\x30\xee\xd2\x0a\x8f\x5b\xbe\x1f\x0e\xff\xc9\xfa\x91\xf3\x10\x20\x7b\xd0\x2e\x43\xcb\xcf\xd4\xe9\x12\x31\xae\x9e\x87\x10\x40\xca\x11\x62\xcc\xcf\x42\xcf\x3f\xf3\x38\xd5\xac\x3a\xf2\x12\xa3\x6b\x6e\xaa\xfc\xb4\xea\xdf\xca\x72\x51\x1e\x34\x27\x89\xfc\x0a\x00\x00\xff\xff\x57\x92\xcd\x97\xb5\x03\x00\x00")
+var _templateDialectGremlinUpsertTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x56\x4d\x6f\xd3\x40\x10\x3d\xc7\xbf\x62\x88\xa2\xca\x8e\xcc\x06\xb8\x01\xea\xa1\x94\x06\x2a\x01\x02\x42\x7b\xad\xb6\xeb\x71\xba\xea\x66\x6d\xd6\x6b\xd3\xc8\xf2\x7f\x67\xc6\x76\x83\x93\x86\x8f\x8a\x4a\x70\x8a\x77\xf7\xcd\xcc\x7b\x6f\x67\xec\xd4\xf5\x6c\x1a\x1c\x67\xf9\xda\xe9\xe5\x95\x87\x67\x4f\x9e\x3e\x7f\x9c\x3b\x2c\xd0\x7a\x98\x4b\x85\x97\x59\x76\x0d\xa7\x56\x09\x38\x32\x06\x5a\x50\x01\x7c\xee\x2a\x4c\x44\xf0\xe5\x4a\x17\x50\x64\xa5\x53\x08\x2a\x4b\x10\x68\x69\xb4\x42\x5b\x60\x02\xa5\x4d\xd0\x81\xbf\x42\x38\xca\xa5\xa2\x9f\x67\xe2\xc9\xed\x29\xa4\x19\x1d\x07\xda\xb6\xe7\xef\x4e\x8f\x4f\x3e\x2c\x4e\x20\xd5\x86\x52\x74\x7b\x2e\xcb\x3c\x24\xda\xa1\xf2\x99\x5b\x43\x96\xd2\xee\x8f\x62\xde\x21\x8a\x60\x3a\x6b\x9a\x20\xa8\x6b\x48\x30\xd5\x16\x61\x9c\x68\x69\x28\x60\xb6\x74\xb8\x32\xda\xce\xca\x9c\x98\xfa\x31\x10\x8c\x50\x93\xcb\x52\x1b\xe6\xf4\xe2\x10\x72\x59\x28\x69\x60\x22\x16\x2a\xcb\x51\xbc\xea\x4f\x7a\x20\x55\x45\x5d\x75\xc8\xcd\xf3\x26\x9c\x8b\xa6\xa5\x55\x10\x6e\x61\x9b\x06\xa6\xc3\x2a\x4d\x13\x41\x4f\x64\x21\x2b\x0c\x95\xbf\x21\x93\xac\xc7\x1b\x2f\x8e\xbb\xdf\x08\xc2\x36\x44\x7c\x90\x2b\xa4\x80\x18\xd0\xb9\xcc\x45\x50\x07\x23\x72\x99\xcb\x1f\xf4\x29\xc4\x67\x2c\xf2\x8c\xac\xab\x9b\x60\xe4\x1d\x25\x74\x05\x09\x20\xc4\x0e\x09\xd1\x07\x84\x51\x30\xfa\x5a\xa2\x5b\xc7\x70\xc1\xb0\x4d\x8c\xf8\xc4\xbb\x7c\xac\x53\xae\xb7\x2f\x47\xe2\xf8\x49\x9c\xdc\xa0\x62\xde\x31\xf4\x99\x36\x49\x62\xee\x82\xe8\x65\x1b\xff\xe8\x10\xac\x36\xcc\x99\x48\xfb\xd2\x59\x5e\xb6\x52\x82\x11\x91\x6d\x05\x7e\x1e\xb8\xc4\xaa\x86\xaa\x6b\x72\x25\xd5\xcb\x17\x77\x58\x74\xfb\xcd\x2e\xd1\x61\x32\x31\x77\xd9\xea\xd6\x9a\xf0\x8f\x39\xf5\x7b\xbb\xd9\x62\x46\x05\xf7\xbe\xde\x30\x82\x69\x52\x18\xf1\x65\x73\x2d\x54\xb7\x92\x0e\xae\x71\x4d\xfd\xec\xd1\xa5\x34\x4b\x75\xeb\xc5\x63\x70\xd2\x2e\x11\x26\x17\x31\x4c\x52\x96\x34\x11\x67\x56\x93\xbf\x73\x8d\x26\x29\xb8\xbb\x46\x2c\x78\xd7\x0c\xce\x75\xd8\x1b\xf0\x51\xaa\x6b\xb9\x64\xef\x04\xaf\x53\xee\xa7\xc2\x4b\x1a\x5a\x62\x79\x70\x70\x27\xb6\x03\x2d\xbc\x2b\x95\x6f\xcb\x30\x6e\x60\xd1\xa8\x4d\x0e\x1d\xec\x4d\x27\xea\x5c\x9a\x12\x21\xcc\x1d\x09\x80\xf1\x74\x3c\xc8\x38\x16\xe3\x9d\x7c\x51\x47\xbb\x57\x88\x36\x69\xd7\xb3\x19\xe4\x8e\xc6\xcb\x79\x4d\xcd\x4c\x23\x6c\xf1\x1b\x54\xbc\x54\xb4\x0e\xf5\x6a\x55\x7a\x79\x49\x33\x9f\x76\xd2\x25\xc5\xd1\x28\xcb\xd2\x78\xa8\xb8\x7c\x11\x89\x60\xa4\x1c\x4a\x8f\xec\xd4\xc5\x85\x38\x4a\x92\xf3\x70\xd7\x83\x77\xf2\x12\x4d\xf4\x33\x77\x87\xbe\x32\x82\xbc\x25\xf2\xa7\x9b\xe2\xed\xc1\x3e\xc7\x7f\xeb\x5a\x4f\x4d\x7c\xec\x44\xae\x43\x6e\x82\x85\xb6\x4b\x83\xf1\xef\x2f\x2a\xfe\x4b\xbf\x23\xa6\xd0\x72\xff\xa1\xea\x75\xef\x1e\x11\x45\x43\xaf\xd9\x07\xa3\x79\x17\xd2\x97\xba\x9d\xe1\x7a\x9b\xc1\x9c\x07\xa8\x69\xc2\x88\x0e\xba\x76\xd8\x20\xde\xca\x62\x41\xef\x74\x4a\xd6\x49\xa6\x8c\xa4\x8c\xe8\x0c\xc0\xdb\xda\x6e\xfb\xa9\x5b\xb0\x2e\xca\xc4\xcd\x32\x50\x1c\x5a\xfa\x5e\xd0\xfa\x2c\x4f\x48\x6b\xbf\x1b\xdd\xf3\x72\x0f\x1f\xf8\x72\xff\x95\x6b\x43\xd3\x86\xcf\x15\x4f\xc4\x52\x9c\x87\x11\xe7\xdb\x3f\x47\xf1\xbe\x37\x4f\xcc\xaf\xb2\x48\xcc\x33\x93\x50\xec\x71\x46\x9f\xd9\x42\x61\x48\x03\x79\x66\xd3\x76\x33\x86\xce\xaf\xfb\x8c\x61\x7f\x65\x0f\x32\x8a\xd5\x7f\x34\x85\x5b\x3d\xb8\x33\x8b\xd5\x03\x37\xd4\x56\xad\x3f\x6e\xab\x5f\xcd\xd7\x9e\xbe\xe9\x3f\x97\x95\x68\xed\x79\x2f\xf3\x90\x4c\xa0\xab\x6e\xff\x2d\xf5\xa0\xef\xc7\x48\x36\xa9\x4b\x0a\x00\x00")
+
+func templateDialectGremlinUpsertTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateDialectGremlinUpsertTmpl,
+		"template/dialect/gremlin/upsert.tmpl",
+	)
+}
+
+func templateDialectGremlinUpsertTmpl() (*asset, error) {
+	bytes, err := templateDialectGremlinUpsertTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/dialect/gremlin/upsert.tmpl", size: 2635, mode: os.FileMode(420), modTime: time.Unix(1792022167, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateDialectSqlByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\xcf\x8f\xe2\x36\x14\xc7\xcf\xce\x5f\xf1\x14\x51\xc9\x41\xe0\x0c\xdc\x5a\xa9\x07\xca\x82\x84\x3a\xec\x16\xb1\xd2\x1e\xaa\x6a\x94\x71\x9e\x83\xd5\x60\x07\xdb\x99\x15\x8a\xfc\xbf\x57\x76\x02\x13\x4d\xdb\xdd\xe9\xa1\xb7\xf0\x7e\xf8\xfb\xf1\xe3\x7d\xdd\x75\xf9\x34\x59\xeb\xe6\x6a\x64\x75\x72\xb0\x7c\x58\xfc\x38\x6f\x0c\x5a\x54\x0e\xb6\x05\xc7\x67\xad\xff\x84\x9d\xe2\x0c\x56\x75\x0d\xb1\xc8\x42\xc8\x9b\x17\x2c\x59\xf2\xf9\x24\x2d\x58\xdd\x1a\x8e\xc0\x75\x89\x20\x2d\xd4\x92\xa3\xb2\x58\x42\xab\x4a\x34\xe0\x4e\x08\xab\xa6\xe0\x27\x84\x25\x7b\xb8\x65\x41\xe8\x56\x95\x89\x54\x31\xff\xb8\x5b\x6f\x3e\x1e\x37\x20\x64\x8d\x30\xc4\x8c\xd6\x0e\x4a\x69\x90\x3b\x6d\xae\xa0\x05\xb8\x91\x98\x33\x88\x2c\x99\xe6\xde\x27\x49\xd7\x41\x89\x42\x2a\x84\xb4\x94\x45\x8d\xdc\xe5\xf6\x52\xe7\xda\x94\x68\x52\x98\x7b\x9f\x90\xae\x9b\xc3\x44\xc0\x4f\x3f\xc3\x84\x1d\xb9\x6e\x90\x6d\x5b\xc5\xfb\x9c\x68\x15\xa7\x16\xa6\xf6\x52\xb3\x23\x86\x76\x6d\x32\xe8\x12\x42\x84\x36\xf0\x34\x83\xd8\x67\x0a\x55\x21\x08\x89\x75\x69\x63\x92\x58\xf6\x29\x28\xfc\x72\xa5\xa1\xb3\xeb\x82\x80\xf7\x54\x64\x59\x42\x88\x4f\x88\x4f\x82\x2a\xaa\x12\xbe\x07\x99\x63\x59\x61\xce\x75\xab\xdc\x98\x17\xc7\xbc\x9b\xb2\xc2\xef\xf1\xba\x45\xe8\xb0\xec\x73\xf1\x5c\x23\x0d\x18\x81\x40\x0a\x98\x20\xdb\x2f\xf7\x81\x83\x10\xe2\x96\xb1\xea\x52\x0f\x75\x81\x1c\xfb\xef\xb5\x56\xd6\x15\xca\x81\xf7\xa1\x9b\x44\xa4\x5b\x75\xaf\x15\x2f\xbb\x0e\x71\x9a\x4e\xd3\x2c\x63\xa1\x8e\x6c\x8d\x3e\x53\xb7\x1c\x7e\x7d\x39\xa1\xc1\xa1\xb0\x6e\xcf\xca\x6e\x0e\xd4\x2d\xd9\x7a\x90\xfa\xed\xd7\x91\xce\xef\x5d\x37\x10\xee\xec\x4e\xbd\xa0\xb1\x08\xde\x2f\xba\x0e\xb0\x8e\x9f\x0f\xe1\x33\x0e\xf1\x8f\x6c\x06\x6e\x31\x1c\xc3\x76\x1f\xd8\x18\x37\xbb\x5d\x77\x68\x0b\x8b\x0d\x9f\x96\x7b\x98\xe6\xff\xe9\xda\x6c\x65\x69\xda\xe7\x3e\x16\xe7\x00\xf0\x14\xa7\x90\xfe\x8f\x03\xe9\x83\x63\x8a\x77\x5d\x34\x0e\x25\x21\xe4\xd2\xa2\xb9\xce\xe0\x29\x70\x45\x56\x76\x08\x91\xb8\x00\xd1\x04\x37\xe0\x95\xe5\x09\x21\x52\x40\x89\x96\xf7\x4b\xdc\xe7\xfb\xf4\x07\x8c\x79\x9f\x8c\x77\x3b\x16\x50\x71\x76\xec\xd8\x18\xa9\x9c\xa0\x29\xfd\xc1\x66\xe9\x0c\xa2\x6c\xc4\x79\xbb\xe9\xf9\x14\x78\x6b\x9d\x3e\x83\x95\x95\x2a\x5c\x6b\x10\x82\x97\x2a\xa3\xdb\x66\xfe\x7c\x85\xb0\xc2\x4e\x6a\xd5\xff\x39\xff\x62\x8d\x58\x9d\xdf\x4f\x18\x9c\x91\xe7\x70\x3c\x3c\xc6\x17\x82\xc7\xb1\xc1\x57\x53\x34\x0d\x96\xf0\x55\xba\x53\x8c\x17\x55\x65\xb0\x2a\xa2\xc0\x4d\x89\x25\x24\xb4\x01\x40\x14\xa7\x6f\xdc\x63\x9d\x91\xaa\x7a\x8f\x61\x7b\xaa\xc2\xa6\xdf\x32\x63\x7f\x5c\x3f\x61\x83\xae\x35\x6a\x98\x3f\x15\x8a\x1d\x0f\x8f\xd4\x66\xb3\x20\xf4\x0f\xb3\xfb\x86\x68\x00\x1f\x64\x03\xe8\x44\xa8\xbf\x3d\x68\xf7\x5c\x18\xc6\x36\x3c\x56\xe3\x92\x2f\xf7\xe0\xbb\xd0\x47\xe4\xbd\x49\xf1\x12\x45\xd3\x3d\x16\x2a\x05\xef\x57\x2f\xd5\xab\x4f\x83\x67\x84\x8a\xce\x1b\xae\x43\x07\x6b\xbf\xb2\x78\x6f\xd9\x9a\xc6\x47\x34\x7b\xed\x4c\xa7\xe9\xbd\xe7\xcd\x44\xfe\x1a\x00\x1e\x12\x0e\x37\x9f\x06\x00\x00")
 
 func templateDialectSqlByTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -554,12 +687,12 @@ func templateDialectSqlByTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/by.tmpl", size: 949, mode: os.FileMode(420), modTime: time.Unix(1567330589, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/by.tmpl", size: 1695, mode: os.FileMode(420), modTime: time.Unix(1791980636, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xdf\x53\xdb\xb8\x16\x7e\xb6\xff\x8a\x73\x19\xda\xb1\x99\x20\x28\x6f\x97\x5e\xee\x0c\xcb\x8f\x99\xec\x16\x68\x09\xdd\x7d\x68\x3b\x3b\x8a\x7d\x94\x68\x51\xa4\x20\xc9\x29\x4c\xc6\xff\xfb\x8e\x64\x3b\x76\x8c\x81\x90\x76\xca\xee\xce\x3e\x25\xb6\xcf\x2f\x7d\xfa\x74\x3e\x49\xf3\xf9\xce\x56\x78\xa4\xa6\x77\x9a\x8f\xc6\x16\xf6\x76\xdf\xfc\x77\x7b\xaa\xd1\xa0\xb4\x70\x4a\x13\x1c\x2a\x75\x0d\x7d\x99\x10\x38\x14\x02\xbc\x91\x01\xf7\x5d\xcf\x30\x25\xe1\xd5\x98\x1b\x30\x2a\xd3\x09\x42\xa2\x52\x04\x6e\x40\xf0\x04\xa5\xc1\x14\x32\x99\xa2\x06\x3b\x46\x38\x9c\xd2\x64\x8c\xb0\x47\x76\xab\xaf\xc0\x54\x26\xd3\x90\x4b\xff\xfd\x5d\xff\xe8\xe4\x7c\x70\x02\x8c\x0b\x84\xf2\x9d\x56\xca\x42\xca\x35\x26\x56\xe9\x3b\x50\x0c\x6c\x23\x99\xd5\x88\x24\xdc\xda\xc9\xf3\x30\x9c\xcf\x21\x45\xc6\x25\xc2\x46\xca\xa9\xc0\xc4\xee\x98\x1b\xb1\x93\x68\xa4\x16\x37\x20\xcf\x9d\xc5\xe6\x30\xe3\xc2\xd5\xb3\x7f\x00\x53\x6a\x12\x2a\x60\x93\x0c\x12\x35\x45\xf2\x53\xf9\xa5\x34\xd4\x98\x20\x9f\x15\x96\x8b\xff\x0b\x77\x97\x90\x65\x32\x81\x68\xc9\x36\xcf\x61\xab\x99\x25\xcf\x63\x30\x37\x62\x40\x67\x18\x25\xf6\x16\x12\x25\x2d\xde\x5a\x72\x54\xfc\xc6\x10\x79\x73\x72\x4e\x27\x08\x79\xde\x03\xd4\x5a\xe9\x18\xe6\x61\x30\xa3\x1a\xa2\x30\x08\x34\x1a\x17\x82\x5c\xa2\xc9\x84\x0d\x83\xc0\x3b\x5c\x36\x32\x1e\xc0\xeb\x66\x90\x79\xa2\x24\xe3\xa3\x7d\x68\x55\x46\x8a\xf7\x79\x18\xc4\x61\x60\x6f\x7d\x2e\x37\xb8\xb6\x59\xaa\xdd\x3f\x72\x75\xeb\x2a\x8e\xc3\x80\x33\x6f\xf9\x9f\x03\x90\x5c\xb8\xca\x02\x8d\x36\xd3\xd2\x3d\xfa\x20\x61\x90\x87\x41\x03\x57\x57\x6d\x5f\x1a\xd4\xd6\x83\x43\xde\xd3\xe4\x9a\x8e\x5c\x69\xe4\x8a\x0e\x05\xc6\xe4\x18\x19\xcd\x84\x6d\x63\x57\xa5\x3e\x2e\xa6\x2f\x8a\xe3\x30\x98\xcf\xb7\x41\x53\x39\x42\xd8\xfc\xbd\x07\x9b\xcc\x25\xd8\x24\xa7\x1c\x45\x6a\xdc\x2c\x04\xae\xbe\x19\x15\x19\x76\x8d\xc5\x79\x6f\x32\x32\xb0\x3a\x4b\xac\x77\x82\x3c\x7f\x5b\xda\x37\x46\xe4\xd3\x70\xe6\x6c\xfb\xe6\xe7\xc1\xc5\x79\x11\x3a\x08\x86\x19\x5b\x00\xf5\x87\x51\x92\x9c\x51\x6d\xc6\x54\x44\x5b\x3e\x46\xec\x8d\xee\x23\x14\x74\x80\x14\x04\x41\x15\xd3\x43\x45\x06\x78\x1f\x20\xf7\xcc\x1c\x3d\x8c\xa5\xd2\x7a\x4a\x0c\x33\x16\x57\x25\xa2\x30\x58\x97\xf6\xac\x30\x8d\x82\x7d\x24\x99\x96\x81\xda\x7c\x72\xce\xd5\xd2\x60\x15\xa9\xc0\x63\xcb\x19\x48\x65\xfd\x6b\x2e\x84\x9b\x4b\xc8\x73\xc7\xe0\x22\x9a\xcf\x10\xfa\x61\x36\x53\xdc\x64\xa8\xef\x7a\x40\xf5\xc8\x38\x18\xab\xba\x3f\xb8\xd7\x51\x4d\xb0\xfd\x03\xb0\xb7\xe4\xe4\x16\x13\x47\xbc\x1e\x34\xdc\x7a\xf0\x5a\xa3\x89\xdf\x3e\x46\x44\xad\x84\x18\xd2\xe4\x3a\x2a\xa9\x1d\x7b\x5a\xf2\x74\x31\x7d\x1a\x0d\x79\x47\x8d\x2d\xa8\xd9\x4f\xa3\x27\xb9\xdd\x1d\xf2\x1e\x5e\xfd\xe3\x05\x3a\x9b\xa4\x7f\x4c\xfa\x66\x60\x35\x97\x23\xc8\x73\x63\x75\xa2\xe4\x8c\x9c\x2a\x3d\xa1\xb6\x2f\x6d\xe4\x0a\x7a\xb3\x1b\x3b\xcc\x8a\xb9\xf4\xe1\xfa\xc7\xe4\xea\x6e\xea\x1e\x23\x9e\xc6\x0b\x40\xef\xd1\x1f\x0b\xfa\x9f\xa4\x23\xac\xd9\x2f\x50\xde\x5b\x49\xee\x19\x5b\xbc\x8f\xe1\xff\xb0\xbb\x44\x77\x2a\x53\x67\xf6\x51\xf2\x9b\x0c\xbd\x03\x0a\x76\x89\xcc\x97\xb5\xb3\x05\x17\x7b\x17\xf0\x95\xdb\x31\x18\x14\x0c\x34\x32\xd4\x28\x13\x04\xdf\x70\x1d\x07\x99\xd2\x80\x3c\xf5\xf0\xfa\x2a\x57\xa9\xa3\x5a\x21\xae\x08\x8b\x93\xa9\xa0\xb6\xb3\x67\xef\x38\xe8\x50\x5b\x9e\x6e\xb8\x91\x6f\x97\x39\xdb\x7c\x72\xed\xe6\xe3\x34\xa5\x16\x3b\x97\x01\x16\x4d\xa7\xb1\x16\x62\x52\xc4\x09\x82\x87\x96\x0e\x92\x23\x25\xb2\x89\x5c\x5a\x40\xc8\xd3\xda\xf3\xb7\x31\x6a\x8c\x5c\xea\x93\x0f\x9d\x21\xdc\x94\x2e\xb9\xf3\x34\x8e\x6b\xce\x37\xda\xc6\x1a\xbc\x6f\xf7\x97\x0e\xa2\xd6\xed\x66\x09\xaf\x1f\x07\xd7\xa3\x68\xa1\xa7\x7b\x47\x6e\xf7\xb6\x8d\x9c\x07\xfe\x50\xa6\x51\x4c\xfa\xe6\x3c\x13\x62\xd5\x22\x5e\x0a\x70\xca\x18\x26\x16\x97\x5b\xcf\xa5\xfa\x6a\x0e\xcb\x0f\xad\x82\xd6\x4e\xc4\x19\x70\x69\xa3\x2a\x5f\x0c\xff\x7b\x46\x33\x78\x32\xdd\xeb\x13\xad\x3d\x9a\x9a\x72\x69\x4f\x29\x17\x98\xce\x27\x66\xb4\x0f\x6c\x62\xc9\x60\xaa\xb9\xb4\x2c\xda\xf8\xbc\x51\xc4\x2f\x95\xe2\xf3\x06\x44\xaf\x66\x31\x50\xa1\x91\xa6\x77\x6e\xcb\x23\x7d\x75\x60\x15\x50\x48\x39\xf3\x5d\xc4\x42\xe1\x57\xbb\x6d\x14\x33\x9d\x2f\x0d\x31\x5f\x52\x3e\xd7\x61\x91\x9c\xed\x9d\x01\xbc\x64\x03\x72\x31\xa9\x4b\xb9\x5b\xf6\xef\xa1\x7b\x78\xe3\x1f\xb6\xcb\x22\xfb\xa6\xef\x7c\x17\x2d\x9e\x42\x65\xe1\xcc\x17\xae\xb5\x0c\x77\xf6\xb5\x07\xb6\x51\x4f\x2d\xd4\x62\x25\x98\x07\xfc\xde\xff\xd2\x70\xfa\x54\x14\x97\xe7\x5f\x7a\xb0\xaa\xf9\xd0\x99\xd7\xd9\x7e\x75\xb2\x6f\xbc\xb2\x2d\xf5\xc8\x1a\x8c\x96\xac\x38\x35\xd9\xd6\xc8\xa0\x80\xd9\xf8\x8d\x3e\x7a\x4d\xe3\x12\x86\xca\x8e\xe1\x2b\xbd\x33\xa4\xd6\x99\x46\x1a\x74\x79\xda\x69\x9a\x30\x06\xc1\x4b\x2c\xfa\x6e\xa2\x5e\xbc\x28\x4f\xbf\x9b\x50\xae\xad\x93\x6b\xca\x64\xf8\xd7\x9a\xc7\x8b\xbd\xb3\x6a\x1e\xa7\x15\x90\xef\xcb\xba\x5e\x64\x62\xa7\xe4\x42\x47\xf1\xda\x62\xda\x38\x8b\x7c\x2f\x8a\xac\xb9\x35\xa8\xf9\xe1\xf4\x7d\xda\x2b\x9a\xde\x33\x45\xbe\x0a\xd6\xa4\xcb\x37\xb1\xe5\x69\xb2\x14\xe0\xad\xaa\xf3\x4f\x9e\x0f\x1f\x4b\xf2\x3d\x34\xfe\x5b\x25\x5e\x49\x04\xc5\xe0\xbe\xd2\xbf\x9a\xad\xa5\xf3\xd7\x78\x67\x56\x1b\x41\xb5\x1d\xc8\x5b\x27\xe0\xc5\xf1\x64\x21\x11\x95\xda\x2c\x68\xdf\x38\x86\x15\x28\x60\xe3\x30\x58\x1d\xcc\x0e\xad\xe2\xd1\xea\xe5\x7c\xda\xfd\xb2\xf2\xd6\xad\x3a\xf4\x07\x75\x7d\xcd\xe3\x7b\x50\x76\x8d\x67\x25\x0f\x3b\x04\xaf\x7b\xcf\xf1\xb7\x56\x80\x75\x77\xfb\x1d\xba\x71\x0f\xf6\x97\x81\xe4\x31\x44\x7e\xd8\x61\xe8\x21\x78\x6a\x2e\xfd\xdb\x36\xff\xa9\x6d\xb3\x9a\xe3\xd6\xdd\xdc\xd2\x84\x1f\xa9\xc9\x84\xdb\xe8\xf1\x7b\xb6\xea\xc2\xb7\x7c\xd7\xbe\x0d\xeb\x39\xab\xb0\xb8\x8e\x2f\x93\x3c\x7e\x33\xdf\xdc\xe3\x54\x37\x5e\x8f\xf4\xf1\x07\x9b\x78\xb9\xb1\xe9\x20\xcd\x53\x94\x59\x86\xa4\xae\xfb\xcf\x00\x00\x00\xff\xff\x6f\x02\x81\xbd\x15\x19\x00\x00")
+var _templateDialectSqlCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\x5b\x53\xdb\x46\x14\x7e\x96\x7f\xc5\xd6\x43\x33\x12\x63\x04\xe1\xad\x49\xe9\x0c\xe5\x32\xe3\x86\x40\x82\x49\xfb\x40\x98\xcc\x5a\x3a\x82\x2d\xb2\xe4\x48\x6b\x83\xc7\xe3\xff\xde\x73\x76\x57\x57\xcb\xf8\x42\x1a\x3a\x6d\x1f\xc0\xd6\x5e\xce\xe5\xdb\x73\xdb\x23\x4f\xa7\xbb\xdb\xad\xa3\x78\x38\x49\xc4\xed\x9d\x64\xfb\x7b\xaf\x7f\xda\x19\x26\x90\x42\x24\xd9\x29\xf7\xa0\x1f\xc7\xf7\xac\x1b\x79\x2e\x3b\x0c\x43\xa6\x16\xa5\x8c\xe6\x93\x31\xf8\x6e\xeb\xea\x4e\xa4\x2c\x8d\x47\x89\x07\xcc\x8b\x7d\x60\xf8\x18\x0a\x0f\xa2\x14\x7c\x36\x8a\x7c\x48\x98\xbc\x03\x76\x38\xe4\x1e\x7e\xec\xbb\x7b\xd9\x2c\x0b\x62\x9c\x6e\x89\x48\xcd\x9f\x75\x8f\x4e\xce\x7b\x27\x2c\x10\x21\x92\xd0\x63\x49\x1c\x4b\xe6\x8b\x04\x3c\x19\x27\x13\x16\x07\x38\x5a\x30\x93\x09\x80\xdb\xda\xde\x9d\xcd\x5a\xad\xe9\x94\xf9\x10\x88\x08\x58\xdb\x17\x3c\xc4\x0d\xbb\xe9\xd7\x70\xd7\x4b\x80\x4b\x68\x33\x5c\x82\x2b\xb6\xfa\x23\x11\x92\x3c\x6f\x0e\xd8\x90\xa7\x1e\x0f\xd9\x96\xdb\xf3\xe2\x21\xb8\xbf\x9a\x19\xb3\x10\x39\x82\x18\xeb\x95\xf9\xf7\x7c\xbb\x59\x94\xc6\x81\x0c\xee\x69\xc9\x96\x7b\x8a\x7c\x46\x09\x9c\x44\xbc\x1f\xa2\xda\x6d\x3d\xa7\x18\xb7\x82\x51\xe4\x31\xbb\x42\x76\x36\x63\xdb\x65\x81\x66\x33\x87\xa1\xbc\x3d\x3e\x06\xdb\x93\x8f\x88\x63\x24\xe1\x51\xba\x47\xfa\xd3\x61\xb6\x5a\xee\x9e\xf3\x01\xe0\xe2\x0e\x83\x24\x89\x13\x87\x4d\x5b\xd6\x74\xba\xc3\x24\x0c\x86\x21\x2a\x5a\x55\x5e\x44\x63\x1e\x0a\x1f\xc7\xd3\x36\xdb\x22\x51\xac\x31\x4f\x98\xdd\xb2\x2c\x3c\x3d\xe2\xe7\x5e\x42\x3a\x0a\x25\x0e\x28\xea\x97\x25\xf1\x0e\xd8\xab\x32\xc7\x29\x4a\x14\x88\xdb\x37\xac\xa6\x86\xab\xc7\x67\x8a\xc4\x0e\x13\x41\x03\x16\x7c\xe4\x0b\xa9\xa0\xb0\x2c\xcb\xbb\xe3\xd1\x2d\x72\xbf\xbe\x39\x15\x10\xfa\x47\xea\xd1\xec\x86\xc8\x57\xab\x9c\x96\x25\x1f\x95\x8e\x04\x6e\x9d\xa3\x9f\xd0\x37\xf7\xea\x91\x90\xc2\xa5\xc8\x93\x56\xfe\x70\xc0\x22\x11\x12\x22\xa8\x1d\xf2\x8f\xe8\x51\x11\x69\x59\x48\xb3\x74\xf4\xa4\x78\x17\xed\x2f\x91\xea\x50\xdc\x0f\xdc\xbb\xe7\xb7\xa4\xa5\x7b\x45\x22\x3b\xee\x31\x04\x1c\x71\xb1\x17\xb0\x3e\xd6\x20\xdb\x8e\xd3\x5a\xac\xb5\x3f\xe2\xe1\x43\x22\x8c\xf5\x59\x24\xa6\xf0\x9b\xf4\x11\xfe\x5b\x9a\x29\xc9\x5f\x10\xed\x1e\xbb\xdd\xb4\x27\x13\x11\xdd\x1a\xfc\x2c\xe1\xe7\xc8\xa4\x32\x41\xf8\xc7\xee\xa1\x8c\x85\xbd\x2d\x7c\x47\x2f\x98\xc3\xc3\xaa\x42\x92\xc4\x61\xd8\x47\x9d\x6d\x03\xb2\xde\xa6\xa9\x1b\x98\xdc\x1e\xcc\x83\xa3\x9e\x51\x22\x34\xca\x54\x72\x0c\x0e\x64\x88\x86\xab\x3a\xbe\x30\x85\x4c\xca\xb5\xe9\x6c\x97\x09\x19\x3b\x20\x99\xca\xcf\xf4\x3d\x21\x7b\x61\x5b\x5f\x3a\x6c\x2b\x30\xae\x47\x76\x94\xe6\x20\xa3\xcd\x8f\xa0\x09\x67\xda\xbd\x15\xb8\x88\xe6\xc8\x93\x6a\x13\x8e\xbe\x35\xeb\x9b\xd0\x0f\x10\xfb\xdf\x7a\x17\xe7\x85\x4e\x41\x0e\xfd\x9f\x69\x1c\xb9\xef\x79\x92\xde\xf1\xd0\xde\x56\x34\x56\x44\x5f\x19\xe4\xca\x78\x07\x55\x94\x50\x84\x8d\xe0\xae\x91\xd1\x43\xbd\x8f\x67\xbf\x2b\xe5\xdb\x5a\x01\x32\xd4\xf9\x33\x98\x0b\x0d\x44\x30\x8b\x9e\x41\x16\x1f\x98\xc2\x1b\x95\x8f\x30\x62\xd3\xb0\x08\x43\x72\x04\x9c\xa2\xc8\xa5\xa9\x29\x2e\x15\xfb\x7e\x32\x54\xe4\xb1\xe2\x80\xf1\xe1\x10\x49\xd8\x66\xa0\xc3\x4a\xb1\x63\xaa\xbe\xeb\xc0\xb4\x44\xed\x73\x78\x78\x63\xc4\x24\xf5\x31\x07\x09\x89\x4a\xe1\xdc\x25\xf8\xdc\x93\xe0\x93\xa8\x1a\x56\x0d\x49\x2e\xfa\xac\xd1\x38\x4b\xa6\x92\x29\xdc\xc3\x4c\xa5\x25\xd0\x94\x94\x05\xe0\x92\xb9\xb8\x19\x02\x4f\xcc\x39\x54\x2c\xd2\xd8\xcc\x9a\x27\x8a\xa6\xa5\x24\x6c\x3e\xb1\x68\x14\x86\x0b\x4e\x0d\x79\x43\x49\x99\xb2\xab\x2d\x70\x3b\xd0\x6e\x77\xe2\xd3\xd1\x60\x66\xd0\x78\x82\x7b\xf1\x10\x9d\xbe\x53\x03\xbb\xdb\x98\xda\x13\x10\xb7\xd1\xce\x3d\x4c\x52\x9d\xb7\x31\x5f\x2b\x83\xe0\x09\xb0\x14\x24\x8b\x23\xcc\xf2\x14\x84\x99\xca\xe0\x05\x96\x26\xad\x6a\x1b\xc0\x81\x10\x22\x7b\xde\x97\x89\x63\x15\x38\x87\xfd\xc2\xf6\x0c\x7a\xc6\x0b\x51\x50\xac\x39\xbc\xfb\x4b\x08\x52\xca\x16\x1d\x46\x7f\x7a\xf3\xd5\x04\xd3\x7e\x3d\xf4\x2f\x9a\x2c\x8d\xd6\x03\x57\x5b\xcf\x19\x44\xdb\x1d\xb6\x92\xac\x6f\x37\x8f\xd1\xf5\xa3\xb2\x10\x6b\x06\x3a\xb7\xe8\x63\x5a\x45\x82\x22\xd4\x35\x17\x0f\xba\x72\xda\xa5\xfc\x82\x67\x24\xfc\x36\x1d\xfc\x8e\x3e\x94\x65\xb6\x09\x88\x50\x38\x1a\x44\x15\x9c\x40\x07\xf8\x8a\x6d\xe5\xee\xd5\xb2\xbe\x8e\x20\x99\x74\xd0\x3a\x6e\x53\x52\x24\x63\xf1\x91\x86\xed\x22\xcb\xe3\x94\x7c\x74\x4f\x1e\xc1\xd3\xe7\x59\xda\xd6\x61\xaf\xb0\xac\x99\x47\x76\x09\xac\xc8\xbb\x94\x52\x91\x82\x7b\xc6\x53\xa9\xeb\x83\xae\x6f\x2f\x2d\x30\x9a\x49\xce\xb9\x60\xf7\x38\x0f\x91\xf5\xb4\x9e\xa5\xf1\xd3\x38\x19\x70\xe4\x2c\x6d\x12\xe8\xf5\x9e\x53\x44\xa3\x2c\x65\x92\x05\xe2\x23\x2e\x70\x4a\xd8\xad\xe2\xa0\x2a\x2e\x17\x4e\xda\xda\xd4\xb5\x8c\x97\x72\x64\x9d\x79\x2a\x2e\x7f\xbf\xff\x3e\xaf\x4f\xfe\xc5\xae\x57\x4f\x8c\x05\x14\xe0\x7e\x8a\x04\x1a\xa3\xe2\x07\x61\x80\x6a\x67\xa1\xf0\x62\xff\x82\x3d\x08\x79\x87\x51\x2f\x0c\xd0\xc0\x02\x48\x20\xc2\xeb\x8b\x09\x7b\xcf\xf2\xdf\x4d\x3d\xd8\xaa\xfb\x1b\xd5\xc4\x9f\x86\x74\x57\x58\xe0\xd1\xea\x8c\x4a\xe8\x3b\xae\xa6\x63\x59\x6b\x47\x81\x7c\xe7\x1f\x77\x08\x85\x4d\xac\x4f\x3e\xae\x5c\x6d\x3a\x45\x4c\xa8\x9a\xdb\xba\x71\x61\xb5\x73\xb7\x1a\xf0\xfa\x7e\x70\x3d\x89\xd6\x5a\xbe\xa2\x80\x3f\xc4\x1a\xca\xc1\xd0\x73\x8e\xf5\xc0\xaa\x42\xbc\x14\xe0\x3c\x08\x80\x4a\xb2\x4a\x68\xbe\x8c\x1f\xd2\x43\x33\x51\x13\x68\x63\x46\x74\x31\xc3\x98\x9b\xf1\x73\xd8\xcf\x6b\xc4\xc5\xa5\xec\x5e\x29\x28\x13\x8e\x2c\x4e\xe8\xce\x3e\x1d\xa4\x78\x8b\x0e\x06\xd2\xed\x0d\x31\xfe\xcb\xc0\x6e\x7f\xae\xc6\xb1\xcf\x6d\x66\xff\x38\x76\x18\x0f\xd1\x81\xfd\x09\xf5\x02\x22\x25\x19\x93\x31\xe3\xcc\x17\x81\x8a\x20\x92\xe9\x7d\xc5\xb6\xb6\x3e\xe5\x59\x45\xbd\x59\xe5\xba\xa0\x2b\x35\x8a\xd6\x59\xb8\x26\x12\x9c\xe0\xdd\x33\x69\xa6\x4f\x0f\xaf\xd5\xc3\x8e\x59\xdf\x4d\xbb\x14\x43\xf2\x4c\xc4\x59\xb6\x82\x96\xe7\x5b\x8b\xc8\xb8\xe2\x6d\xfb\x69\x57\xd1\x96\x98\x2e\xd8\xf5\xe1\x5d\x69\xcb\xb5\x96\x6a\x36\xbb\xe9\xb0\x55\x97\xf7\x69\xb9\xf3\x72\x01\x38\xab\x70\xd4\x15\x2c\x55\x29\x1f\xb2\x2b\xbc\x55\x60\x5f\x4b\x26\x94\x43\x76\x30\x87\x30\x4d\x3c\x55\x85\x35\xa8\x4c\x2f\x22\xd6\x8f\x31\xcd\x3c\xf0\x49\xea\x16\xd9\x65\x8e\x13\x10\xab\x0a\xa7\xd2\xc1\xe9\xff\xcb\x8a\xb1\xe7\x86\x81\x75\xd2\x6d\x61\xb6\x17\x45\x91\x31\xcc\x2c\xeb\x83\xfd\x82\x47\x38\x74\x2f\x12\x8c\xa7\x9b\x86\xe3\xc5\x80\x6f\x9c\x5e\x36\x4c\x2e\x45\x6a\xa1\x0c\x31\xec\x68\xa7\x5d\x33\x4d\x64\xc4\xbe\xb7\x95\xac\x9c\x29\x9e\xd5\x18\xfb\x16\x59\xe2\x39\x49\x22\x8e\x80\xee\xd1\xf3\xb9\xe2\xc7\xf1\x46\x99\x82\x2e\xe6\xab\x49\x9f\x25\x94\x59\xad\xf1\x94\x17\xb7\x79\xa8\xc9\xdb\x3a\x99\xc9\xd7\x7b\x97\x16\x2c\xea\x5e\xae\x2e\xce\xf5\xde\xcd\xca\x89\x3f\xeb\xb5\x59\x85\x7c\xe5\xae\x99\x65\x22\xc6\x5a\xcc\x5b\x0d\x51\xf3\x1f\xe3\xbe\xdf\xbb\x32\xfc\xdf\xe3\xff\x1b\x1e\xdf\xd8\x90\x5f\xa7\x03\xa1\x2e\xc0\xca\x88\x46\x91\xd4\xaf\xd0\x34\x69\x94\x26\xd4\x96\xa5\xde\xca\x91\xee\x25\xe3\x6b\xbb\xed\x46\xd3\xcb\x9b\x8e\xba\xf1\xa0\xb5\xca\xe8\x2c\x24\x53\x2a\x02\xdb\xe8\xc9\xed\x5a\xd9\xfa\xac\x86\x48\xad\x4c\xd6\xfd\x6b\xa5\xac\x0a\x31\x03\x7e\x0f\xf6\xf5\x0d\x8a\xd5\x61\x7b\x9d\xd5\xf9\xbc\x60\x75\x93\x49\x5f\x74\xdf\xf5\x40\xc7\xb4\xb1\xa8\xef\x61\x9b\xb6\x52\x3d\xe0\x3b\x0d\x33\xdd\x48\x3a\x28\x16\xf9\x14\x98\xfe\x95\x8e\xc5\xf8\x94\x1f\x43\xcd\x01\xbf\x14\xd9\x62\x12\x79\xca\x78\xfe\xae\x66\xd2\xc2\x0d\xf3\xcb\x96\x07\xf4\xac\x15\x95\x99\x24\x8d\x18\xf8\xbe\x49\x41\x3c\x6f\x60\xca\xb6\xa6\x42\x35\x0f\x9d\x6a\x3a\x5e\x7c\x89\xd0\x17\x07\xfd\x56\x45\xdd\x24\x34\xc1\x44\xb5\xec\xd5\x65\x22\x15\x3e\x54\x6e\x13\xcf\x32\xc5\x8d\x6d\xf1\x25\x8d\xb1\x5c\x3c\x14\x29\x5f\xf1\x4f\x97\x99\xe7\x02\xc3\x5c\xda\xe7\x6a\x58\xf4\xad\xcd\xf0\xf9\x09\xf2\x89\x17\x83\xd5\xd7\x82\x79\xdb\x5a\x83\x76\x6d\xac\xf4\xc6\x59\x2b\xbf\x2c\x7d\x61\x58\x94\x1e\x6a\x74\xf1\x49\x9c\xf1\x3e\x84\x66\xb8\xda\xa3\xef\xb0\x43\xda\x7a\xa4\x8c\xb1\x63\x3c\xa3\xa9\x56\x59\x86\x51\xed\x15\x5a\xa5\x2a\x3a\x8a\x07\x03\x14\xef\xe9\x37\x15\xd9\xef\x16\xcc\x58\x5d\x56\xf5\xc6\xaf\xa5\x7f\xf8\x62\x98\x3c\xfd\x1b\x98\xb2\x67\x55\x10\x6d\xae\xd5\x17\x16\xea\xe6\xe2\xda\x60\x38\xeb\x41\x52\xc8\xfd\x17\x9f\x29\x84\x3d\x7f\x24\x00\x00")
 
 func templateDialectSqlCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -574,12 +707,12 @@ func templateDialectSqlCreateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/create.tmpl", size: 6421, mode: os.FileMode(420), modTime: time.Unix(1569251090, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/create.tmpl", size: 9343, mode: os.FileMode(420), modTime: time.Unix(1792022221, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\x5d\x6f\xdb\x36\x14\x7d\x96\x7e\xc5\x9d\xe0\x14\x92\xe1\x52\x59\xdf\x96\xc2\x03\x8a\x38\x01\x34\x0c\xde\x50\x77\x7b\x5d\x19\xea\xd2\xe6\x4a\x93\x0a\x49\xb9\x33\x34\xfd\xf7\x81\x94\x94\x48\x6e\x32\x1b\x7b\xd8\x9b\x45\x5e\x9e\x7b\xce\xb9\x1f\x6e\x9a\x7c\x1e\xdf\xea\xea\x68\xc4\x76\xe7\xe0\xdd\xf5\xf7\x3f\xbc\xad\x0c\x5a\x54\x0e\xee\x29\xc3\x07\xad\xbf\x40\xa1\x18\x81\x0f\x52\x42\x08\xb2\xe0\xef\xcd\x01\x4b\x12\x7f\xda\x09\x0b\x56\xd7\x86\x21\x30\x5d\x22\x08\x0b\x52\x30\x54\x16\x4b\xa8\x55\x89\x06\xdc\x0e\xe1\x43\x45\xd9\x0e\xe1\x1d\xb9\x1e\x6e\x81\xeb\x5a\x95\xb1\x50\xe1\xfe\xe7\xe2\xf6\x6e\xbd\xb9\x03\x2e\x24\x42\x7f\x66\xb4\x76\x50\x0a\x83\xcc\x69\x73\x04\xcd\xc1\x8d\x92\x39\x83\x48\xe2\x79\xde\xb6\x71\xdc\x34\x50\x22\x17\x0a\x21\x29\x05\x95\xc8\x5c\x6e\x1f\x65\x5e\xa2\x67\x94\x6b\x85\x09\xb4\xad\x8f\x9a\x19\x64\x28\x0e\x68\xe0\x66\x09\x33\xf2\x71\xf8\xf2\x20\x79\x0e\xf7\x46\xef\x3f\xea\xaf\x16\x2c\xa3\xca\x06\x12\xf6\x51\x7a\xb5\x95\xf6\x94\x4b\xea\x28\x08\xe5\x34\x78\x2c\xb2\xa6\x7b\x84\xb6\x25\x31\xaf\x15\x83\x74\x82\xdf\xb6\x30\x1f\x07\x65\x4f\xe0\xa9\xf1\x19\xe6\xf6\x51\x12\xff\x99\x01\x1a\xa3\x0d\x34\x71\xd4\x34\x6f\x61\xe6\x53\x7b\x76\x95\x11\xca\x41\x72\x48\x26\xa0\x71\x74\xa0\x26\x64\x0f\x71\x6d\x0b\xd6\x99\x9a\x39\xff\x3c\x2a\x56\x00\xfe\x4e\x70\x98\x91\x62\x45\x0a\xbb\x71\x46\xa8\x2d\xb4\xad\x50\xae\x69\x00\xa5\xf5\x5c\x02\xaf\x62\x45\x3e\x1d\xab\xfe\x13\x55\x19\xc0\xa3\xa6\x01\x43\xd5\x16\x61\xf6\xc7\x02\x66\xbc\xb3\xe9\x5e\xa0\x2c\x6d\x17\x10\x48\x56\xd4\x32\x2a\x61\xc6\x07\x75\x81\x11\x27\xeb\x5a\xca\x1e\xb4\xc3\x1a\x70\xdb\x38\xca\xf3\xe0\xa7\x36\xbe\x25\x76\x68\x10\xec\x4e\xd7\xb2\x84\x07\xec\x8c\xf6\x48\xd4\x0e\xc5\xff\x1c\x48\xfe\x4a\xd9\x17\xba\x0d\x26\xdf\x6a\x59\xef\x95\xfd\x4c\xe2\x48\x70\xef\x99\xe7\xe6\xad\x24\x1b\x46\x55\xea\xa9\xbd\x19\xf9\x42\x8a\xd5\x62\xa0\x7b\x46\xd1\xf4\xdd\x8b\xfa\x9e\xa0\x06\x41\xd9\xfb\x40\xe1\xbb\x25\x28\x21\x83\xf9\x06\x5d\x6d\x94\x3f\x0d\x72\x4f\x9a\x81\x14\x2b\x58\xbe\x52\x1b\xeb\x0c\xd3\xea\x40\x0a\xa7\x69\x3a\x95\x90\x4d\x8b\xf6\x7c\x31\xf2\xf6\xbc\x42\x1f\xe1\xf3\x72\x52\xd8\x9f\x36\xbf\xac\x7b\xdd\x82\xc3\x81\xca\x1a\xfd\x83\xa9\x03\xdf\x1a\xf0\x1e\x24\xaa\x34\x84\x67\xf0\x23\x5c\x07\xc9\xd1\xa8\x12\x7f\x5a\xad\xc8\x6f\x6a\x4f\x8d\xdd\x51\xd9\x45\x2e\xe0\xcd\xa9\x0d\x2f\x61\x7f\xeb\x65\xf4\x64\x27\xdf\x3b\x72\xe7\xe7\x83\xa7\x49\x3d\xa0\x03\xf7\xe2\x86\x9e\xeb\x40\x6e\xe0\xea\x90\x2c\x3c\x50\x16\x00\x82\xc2\x41\x7c\xb0\xb0\x73\x60\x2d\xa4\xa4\x0f\x12\x9f\x3d\x38\x5b\x7c\xf2\x3b\x95\xa2\xec\x89\x5d\x22\x08\x96\xa0\xf0\x6b\xda\xf1\xeb\xe7\xa1\x63\x35\xbf\xf0\xb9\x3f\xf6\xf3\xcf\x21\xb9\xb2\xe4\xca\x26\x3d\xc5\x74\x1a\x9c\xc1\xdf\xe3\xb1\x0b\x35\xef\x85\x4d\xa4\x0f\x93\xfb\xff\xe4\x1e\xcf\xc9\xf8\x77\x5f\x52\x25\x64\x1c\x96\x71\x7f\x7e\x66\x7b\xef\xa9\x3a\x5e\xb0\xbe\x43\x15\xfd\x5f\x4b\x77\xb5\x61\xba\x42\xb2\x09\x07\xff\x69\xb9\xdb\xfe\xe9\xbf\x2e\xf7\x21\xe8\x92\xe5\xce\xb5\xe9\xd6\xd5\x1a\xff\x72\x69\x16\xda\xe9\xa2\x85\x1f\x8d\xb7\xfd\xcd\xb2\x9b\xa9\xa1\x5e\x8d\x0f\x78\x9e\xc2\x71\x2f\x4f\x28\xbd\x30\x63\xe3\x85\x15\xda\xe5\xb4\x39\x61\x09\xb4\xaa\x50\x95\xe9\xe9\xcd\x62\x9c\x28\x0b\xfb\xee\x95\xe2\xfe\x13\x00\x00\xff\xff\x46\x9c\x88\x2c\x5e\x08\x00\x00")
+var _templateDialectSqlDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\xd1\x6f\xdb\xb6\x13\x7e\x96\xfe\x8a\xab\xe1\x04\x56\xe0\xd2\xfd\xf5\xed\x97\xc2\x03\x02\x27\xdd\x34\xac\x99\x51\x37\x7b\x09\x82\x81\x95\x4e\x31\x17\x9a\x54\x48\xca\x6e\xa6\xe9\x7f\x1f\x8e\x92\x1c\xd9\xb1\x67\xa7\x7b\xda\x8b\x61\x91\xc7\xbb\xef\xbe\xfb\x8e\xbc\xb2\x1c\x9d\x85\x13\x9d\x3f\x19\x71\x3f\x77\xf0\xfe\xdd\xff\xfe\xff\x36\x37\x68\x51\x39\xf8\xc8\x13\xfc\xaa\xf5\x03\xc4\x2a\x61\x70\x21\x25\x78\x23\x0b\xb4\x6f\x96\x98\xb2\xf0\xcb\x5c\x58\xb0\xba\x30\x09\x42\xa2\x53\x04\x61\x41\x8a\x04\x95\xc5\x14\x0a\x95\xa2\x01\x37\x47\xb8\xc8\x79\x32\x47\x78\xcf\xde\xb5\xbb\x90\xe9\x42\xa5\xa1\x50\x7e\xff\x97\x78\x72\x75\x3d\xbb\x82\x4c\x48\x84\x66\xcd\x68\xed\x20\x15\x06\x13\xa7\xcd\x13\xe8\x0c\x5c\x27\x98\x33\x88\x2c\x3c\x1b\x55\x55\x18\x96\x25\xa4\x98\x09\x85\xd0\x4b\x05\x97\x98\xb8\x91\x7d\x94\xa3\x14\x09\xd1\x48\x2b\xec\x41\x55\x91\x55\xdf\x60\x82\x62\x89\x06\xce\xc7\xd0\x67\x9f\xdb\x2f\x72\x32\x1a\xc1\x47\xa3\x17\x9f\xf5\xca\x82\x4d\xb8\xb2\x1e\x84\x7d\x94\x94\x6d\xae\x95\x45\x48\xb9\xe3\x20\x94\xd3\x40\xbe\xd8\x35\x5f\x20\x54\x15\x0b\xb3\x42\x25\x30\xd8\xf0\x5f\x55\x70\xd6\x35\x8a\xd6\xce\x07\x86\x22\x9c\xd9\x47\xc9\x28\x56\x04\x68\x8c\x36\x50\x86\x81\x41\x57\x18\x05\x5b\x7e\x18\x81\xf1\x87\x86\x7e\x8b\x4d\x79\xf2\xc0\xef\xc9\x29\x9b\x68\x59\x2c\x94\x8d\xc2\x1a\x3f\x59\x76\xb0\xdf\x8b\x25\x2a\x48\x6a\x9b\x9a\xbf\x23\x13\x22\x2e\x04\xca\x94\x38\xe0\x8e\x9c\x09\xb3\x76\xb4\x42\x83\xa0\xb4\x03\x8b\x44\x35\xa6\xc0\x0d\x82\xc4\xcc\xc1\x4a\xb8\x79\x63\xfd\x27\x1a\x0d\x4b\x2e\x0b\xb4\x47\x12\xb4\xce\xf3\x99\x9c\xe1\x3a\xe8\xed\x9d\x75\x46\xa8\xfb\x0e\x5d\x65\xf9\x16\xfa\x74\x88\x8a\x99\x1b\xa1\x1c\xf4\x96\xbd\x8d\x10\x61\xb0\xe4\xc6\xe7\xe6\xed\xaa\x0a\xac\x33\x45\xe2\x88\xed\x20\xbe\x04\xa0\x3d\x91\x41\x9f\xc5\x97\x2c\xb6\x33\x1f\x02\xaa\x4a\x28\x57\x96\x80\xd2\x12\xcb\x74\x9c\xf6\xbf\x3c\xe5\xcd\x27\xaa\xd4\x3b\x0f\xca\x12\x0c\x57\xf7\x08\xfd\xdf\x87\xd0\xcf\x08\x48\x9f\x35\xcc\x79\x03\x0f\x32\xe7\x36\xe1\x12\xfa\x59\x9b\x2b\x45\xa5\xaf\x42\xca\xc6\x69\x18\x04\x1d\xbf\x1e\x37\x31\x47\x0e\x17\xfc\x01\x07\xb7\x77\x42\x39\x34\x19\x4f\xb0\xac\x86\x20\x51\x0d\x1a\x66\xa2\x28\x0c\x32\x6d\x40\x90\x6d\x0d\xa6\xe5\x8c\x92\xb4\x2b\xe1\x92\x79\xbb\x74\x2b\xee\x7c\xea\x09\xb7\xf8\x42\x4c\x6d\x9e\x13\xad\xac\xe3\xca\x41\x55\x9d\x53\x0a\x35\x14\x3a\x3a\x86\xd3\x0e\x97\x2c\xbe\xf4\xb0\xdf\x1e\xe0\x60\x6f\xb0\xec\xf8\x50\x65\xf9\x92\xc5\x26\xf8\xba\x18\x29\x66\xbc\x90\xce\x3b\x6a\x5a\x29\x5b\x38\x76\x45\xed\x95\x0d\x7a\x85\xc2\x6f\x79\xad\xd7\x9a\x0d\x38\x79\x04\x62\xce\x51\x09\xba\x52\xec\xad\x65\x77\x2b\xee\xa2\x30\x08\xea\x92\x88\x8c\xb4\xe7\x69\xd6\x2b\xcb\x66\x24\xd7\x46\xe0\x8c\x45\x1f\xfc\xe6\x9b\x31\x28\x21\x3d\xc7\x0d\x04\x34\xc6\x9f\xde\x12\x3f\x8b\x2f\x61\xbc\x47\x7d\xd6\x99\x44\xab\x25\x8b\x9d\xe6\x83\x4d\xc2\xa3\x4d\x59\x3e\x6f\x74\xd4\x73\xb8\x22\x64\x41\x71\x33\x16\xdb\x9f\x67\xbf\x5e\xd7\xab\x81\xc8\xea\x86\xa5\x03\x87\xc8\xff\xe0\x35\xe8\xcd\x23\xf8\x01\xde\xf9\x94\x83\x0e\x47\x7f\x58\xad\xd8\x8d\x5a\x70\x63\xe7\x5c\xd6\x96\x43\x38\xdd\xa6\x61\x97\xef\x97\x5c\xee\xab\x68\xe3\x1d\x32\x92\x5b\xdb\x55\xb5\x93\x73\x38\x59\xf6\x86\xe4\x88\x2a\xe8\x6b\x58\xff\x50\xf2\xbe\xb3\x45\x06\xda\x10\x09\x3f\x71\xfb\xa3\xf6\x8d\xe8\x19\xb9\xb9\x89\x2f\x1b\x46\x8e\x41\x0b\x87\xc9\xda\x19\x35\xb6\xf1\x94\x6c\x62\xfb\xe9\x62\xf2\x5d\x15\xf0\xa6\xec\x37\x2e\x45\xda\xb0\xd4\x2d\x6c\x3c\x6d\x9c\x06\xc1\x92\x2a\xaa\xd0\xb1\x29\x37\x16\xe3\x69\x5d\x0d\x56\x0b\xae\xa6\xc7\x87\x86\xf1\x06\xe5\xbb\x38\xcf\xc9\xc3\x6e\xbe\x85\x5a\x7a\x28\xf1\x14\x78\x9a\x1a\xb4\x16\x4e\x1e\x7b\x43\xd8\x11\xac\x5a\xa3\x6d\xc4\xdc\xe0\x1c\xb6\xea\x59\x83\xfd\x74\x31\xd9\x83\xf6\xa5\x44\x5e\x89\x77\x53\x1f\x5d\x4c\xed\x8d\xf2\x1a\x01\xd4\xb4\x5f\x0b\x29\xf9\x57\x49\xcb\xa7\xeb\x96\x5c\xee\x92\xde\xa6\x71\x53\xfc\xcd\x9a\xef\x78\x39\xb6\xaa\x7d\x14\x38\x85\x2b\x7f\x8b\x64\xed\x0b\x56\x27\x7c\x76\x7c\x6e\xfe\x71\xcd\xa0\x77\x62\xd9\x89\xed\x35\x10\x07\x9b\xc6\x11\xfc\xd5\x7d\xd3\xfc\x75\x03\xd5\xcb\xae\x7b\x6d\x63\xfd\xcb\xd8\xcf\x1d\xd1\xb2\x3d\x73\xda\xd4\x8f\xd0\x4e\x12\x55\x21\xe5\x1e\x34\x6f\x0e\xb5\x64\x5d\x9d\x70\x5b\x46\xdd\x8f\xee\xff\x46\xae\x4a\xc8\xd0\x4f\xa7\xcd\xfa\x81\x71\x76\xc1\xd5\xd3\x11\xf3\xac\xc7\x4a\xb3\x36\xf5\x7e\x9f\xcd\x12\x9d\x23\x9b\xf9\x85\xef\x9a\x76\x6d\x73\xf4\x1f\x87\xb9\xd6\xe8\xbf\x30\xed\xbe\x32\xa1\xd7\x4e\xa7\x34\x59\x50\x1a\xec\x1a\xbf\xb9\x41\x44\x4b\xc7\x4d\xac\x41\x47\x65\x64\x77\xda\x1d\x4d\xca\x2a\x5c\x5f\x7e\x5b\x6f\x44\x87\xb8\x06\xd6\x8e\x77\xb4\x21\xdd\x0f\x25\xbe\x2f\xb7\x6f\x01\x18\x03\xcf\x73\x54\xe9\x60\x7b\x67\xd8\x8d\x16\xf9\x99\x66\x8f\x80\xff\x1e\x00\x9d\xae\xee\x8f\x53\x0e\x00\x00")
 
 func templateDialectSqlDecodeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -594,12 +727,12 @@ func templateDialectSqlDecodeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/decode.tmpl", size: 2142, mode: os.FileMode(420), modTime: time.Unix(1570172976, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/decode.tmpl", size: 3667, mode: os.FileMode(420), modTime: time.Unix(1791987505, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\x41\x6f\x13\x31\x10\x85\xcf\xeb\x5f\x31\x54\x15\x5a\x47\x8b\x13\x7a\x03\xd4\x43\x08\xa9\x54\x09\x21\x68\x7a\x47\x8e\x3d\x9b\x58\x35\xf6\x66\xec\x0d\x89\x22\xff\x77\x64\x67\x37\x0a\x48\xf4\x14\x67\xe6\xcd\x7b\xdf\x8c\xf6\x74\x9a\x4e\xd8\xc2\x77\x47\x32\x9b\x6d\x84\xbb\xd9\xfb\x0f\xef\x3a\xc2\x80\x2e\xc2\x83\x54\xb8\xf6\xfe\x05\x1e\x9d\x12\x30\xb7\x16\x8a\x28\x40\xee\xd3\x1e\xb5\x60\xcf\x5b\x13\x20\xf8\x9e\x14\x82\xf2\x1a\xc1\x04\xb0\x46\xa1\x0b\xa8\xa1\x77\x1a\x09\xe2\x16\x61\xde\x49\xb5\x45\xb8\x13\xb3\xb1\x0b\xad\xef\x9d\x66\xc6\x95\xfe\xd7\xc7\xc5\xf2\xdb\x6a\x09\xad\xb1\x08\x43\x8d\xbc\x8f\xa0\x0d\xa1\x8a\x9e\x8e\xe0\x5b\x88\x57\x61\x91\x10\x05\x9b\x4c\x53\x62\xec\x74\x02\x8d\xad\x71\x08\x37\xda\x48\x8b\x2a\x4e\xc3\xce\x4e\x35\x5a\x8c\x78\x03\x29\x65\xc5\xed\xba\x37\x36\xf3\x7c\xbc\x87\x4e\x06\x25\x2d\xdc\x8a\x95\xf2\x1d\x8a\xcf\x43\x67\x10\x12\x2a\x34\xfb\xb3\xf2\xf2\xbe\x8c\xe7\xc0\xb6\x77\x0a\xea\x6b\x6d\x4a\x30\xb9\x0e\x49\x89\x43\xd8\xd9\xe5\x01\x55\xad\xe2\x01\x94\x77\x11\x0f\x51\x2c\xce\xbf\x1c\x6a\xe3\x62\x03\x48\xe4\x89\xc3\x89\x55\x7b\x49\xf9\xac\x79\x48\x3c\x61\xe8\x6d\x64\x55\x40\x5b\x96\xcf\x20\xb9\xbe\x2a\xff\x6b\x2e\x1e\xc8\xff\xaa\x73\xe5\x59\xae\x2d\x16\x10\xf1\x5d\xaa\x17\xb9\x41\x48\xe9\x5c\xe5\x9c\x55\xad\x27\xf8\xd9\x40\x57\x36\x91\x6e\x83\xf0\xd7\x7e\x29\x89\x8e\x50\x1b\x25\x23\x86\x0c\x51\x75\xf5\x98\xc9\x59\x95\x58\xb5\xeb\x91\x8e\x0d\x48\xda\x84\x11\xe2\x4b\xb9\xea\x7f\x32\x0b\xd9\x80\x79\x71\x12\x3f\xb2\x4b\xcd\x59\x65\xda\xbc\x71\x76\xfa\x97\x43\x53\x7e\x89\xf1\x5e\x0d\x5c\x25\x37\xf0\x96\x30\xf0\x4f\x65\xf6\xcd\x3d\x38\x63\x0b\x2c\x61\xec\xc9\xc1\xac\x9c\xb1\xe0\xca\xb6\x45\x15\x51\x37\x63\x0c\x61\x10\x4f\xfe\x77\x98\x0f\x8d\x2b\x88\x57\x8d\x86\x8a\x71\xb1\x1e\x3d\x79\x93\xf5\xec\xfc\xb9\xa1\xd3\x90\xd2\x9f\x00\x00\x00\xff\xff\x3b\x33\xcd\x35\x3c\x03\x00\x00")
+var _templateDialectSqlDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x59\xdf\x4f\xdb\x48\x10\x7e\x26\x7f\xc5\x5e\xc4\x21\x1b\x19\x43\xfb\x76\x54\x3c\xd0\x00\x52\x74\x2d\xed\x41\xa5\x3e\x20\x54\x39\xf6\x38\xb1\x70\xd6\x61\xbd\x09\x44\x51\xfe\xf7\x9b\xd9\x1f\xb6\x13\x3b\xc1\x50\xee\x47\xef\xfa\x80\x88\xd7\xb3\xb3\xbb\x33\xdf\x37\xf3\xd9\x5e\x2c\x0e\xf7\x3b\xbd\x6c\x32\x17\xc9\x70\x24\xd9\xdb\xa3\x37\xbf\x1d\x4c\x04\xe4\xc0\x25\xbb\x08\x42\x18\x64\xd9\x1d\xeb\xf3\xd0\x67\xa7\x69\xca\x94\x51\xce\xe8\xbe\x98\x41\xe4\x77\xbe\x8c\x92\x9c\xe5\xd9\x54\x84\xc0\xc2\x2c\x02\x86\x97\x69\x12\x02\xcf\x21\x62\x53\x1e\x81\x60\x72\x04\xec\x74\x12\x84\xf8\xef\xad\x7f\x64\xef\xb2\x38\xc3\xdb\x9d\x84\xab\xfb\x1f\xfa\xbd\xf3\xcb\xeb\x73\x16\x27\x29\xba\xd0\x63\x22\xcb\x24\x8b\x12\x01\xa1\xcc\xc4\x9c\x65\x31\x8e\x96\x8b\x49\x01\xe0\x77\xf6\x0f\x97\xcb\x4e\x67\xb1\x60\x11\xc4\x09\x07\xd6\x8d\x92\x20\xc5\x09\x87\xf9\x7d\x7a\x18\x41\x0a\x12\xba\x0c\x4d\xd0\x62\x77\x30\x4d\x52\xda\xcf\xf1\x09\x9b\x04\x79\x18\xa4\x6c\xd7\xbf\x0e\xb3\x09\xf8\xef\xcd\x1d\x63\x88\x2b\x42\x32\xd3\x96\xc5\xef\x62\x3a\x2d\x18\x4f\x79\xc8\x9c\xaa\xed\x72\xc9\xf6\xab\x8b\x2c\x97\x2e\xc3\x3d\x9c\x3f\x42\xe8\x84\xf2\x11\x63\xc3\x25\x3c\x4a\xbf\xa7\xff\xbb\xcc\x49\xb8\xf4\x18\x08\x91\x09\x97\x2d\x3a\x3b\x8b\xc5\x01\x93\x30\x9e\xa4\x81\x5c\x3b\x46\xc2\x67\x41\x9a\x44\x38\x9e\x77\xd9\x2e\xad\xaf\x8c\x93\x18\xf7\x7f\x01\x81\x9c\x0a\x38\xe7\xc1\x20\xc5\x80\x77\x83\x69\x94\x48\x75\xe2\x9d\x2d\x1e\x75\x60\x0e\x8d\x71\xe9\x12\x52\xcc\x0b\xfa\xcd\xf0\xb8\x7e\x8f\x62\x14\xc1\x79\x34\x84\x9c\x2e\x31\x5d\x12\xa2\xf7\x73\x8a\x5a\x16\xcb\x2b\x88\xf3\x96\xeb\x84\xda\x53\x6d\xa5\x76\xb3\x01\x43\xb8\x32\x95\x47\xf4\x5b\xa7\xdd\x5c\x6c\x47\x80\x71\xa1\xb2\x7b\xb0\x21\xbd\x4e\x33\x24\x5c\xb5\xec\x2c\x10\x04\x79\x4a\xa8\x7f\x05\xf9\x34\x95\x9d\x9d\x1c\x52\x05\x4c\xf2\x42\xe3\xd7\xea\xda\x71\xfd\x0b\x91\x8d\x1d\x1a\xf9\x42\x39\x51\x20\xf1\x3f\x07\xe1\x5d\x30\xa4\x13\xeb\x51\xd7\x45\x7b\x79\xa6\x77\xba\x82\x23\x32\x89\x04\xfd\xf2\xed\x6d\x34\xfe\x9a\xc8\x91\x41\x0e\xa1\xc9\xed\xec\xc4\xb8\xf2\x37\x8f\x4d\xd4\x21\x02\x8e\xbe\xd7\xbd\x20\x89\xa3\x24\x24\xd4\x10\xbc\x76\x26\x8e\xdd\x31\xce\xc6\x33\x61\x9a\x11\x7c\x6a\xf7\x66\xdc\x3f\x17\xc2\x71\xdf\xa9\xe1\x5f\x4e\x18\x4f\x52\x35\x51\x00\x22\x8c\xb3\x23\x05\x56\x35\xf5\x7e\x0a\x62\xee\xb1\x40\x0c\x73\x7b\xfa\x33\x15\xe8\x0d\x87\x55\x21\x31\xf1\x29\x36\xe1\xff\x41\x5e\x1c\xb7\xba\x93\x0d\x81\xb0\x24\xf2\x58\x65\x65\x8f\xed\x61\x4a\xda\x6c\x37\x88\x63\x5c\x12\x22\xcf\x2e\x83\xf3\xfc\xab\xec\x21\x3f\x35\x37\x2a\x9b\xd8\xea\xc8\x8c\x20\x71\x1d\xeb\xd3\xf5\xc8\xbe\x53\xc1\x25\xfe\x3e\xdc\x67\x1a\x78\xaa\x8c\x71\xac\x8a\x39\xd5\xb4\x00\x6b\x56\xc0\xf3\x20\x94\x49\xc6\xf1\x10\x68\x8f\x87\xcd\x44\x44\x66\x89\x60\xc8\xf2\xa9\xb6\xa4\x69\x8a\x9c\x2c\xcd\x86\x3e\x53\x45\x6e\x3b\xc2\x4b\xde\x2b\x88\x4f\xee\x86\x74\xd0\x41\x80\x2c\x23\xea\xf2\x38\x19\x56\xf2\xf2\x52\x1e\x50\x0a\xb6\xa7\xea\xcb\xa3\xc1\x67\x9b\x78\x6e\xa0\xd0\x3a\x88\x7a\x59\x3a\x1d\xf3\xdc\xf7\xfd\xff\x32\xb9\x44\x96\xa6\x03\x3c\x89\x63\x82\xac\x1d\x09\x84\x29\xb9\xd9\x53\xa5\x07\x2f\x16\x0d\xfc\xb3\x4b\x34\x30\x4a\x3e\x9a\xd1\x3a\x7d\xc8\xf5\xf3\x37\x44\xa5\xd0\x41\xbb\x24\xca\x19\x63\x37\xb7\x48\x06\xbc\xd2\x18\xbf\xb9\x55\x2d\xd0\xbf\x0c\xc6\xba\xb8\x9b\x50\xd2\x4a\xfe\x25\x85\x57\x75\x3a\x65\xad\x0e\x55\xb5\x5e\x84\x0a\xa5\xc7\xb5\x50\xeb\x71\x6a\x15\xe5\xb1\xc8\x83\xc2\x02\x85\xc4\x69\x3e\x89\x8a\x9d\xdf\x4b\xb3\x1c\x28\x26\x1b\x8f\x16\x8f\x25\x25\x27\x13\xb1\xd3\xa5\xb5\x89\x3b\xcb\xe5\x31\x8b\x83\x84\x5a\x2a\xb2\x81\xf3\x84\x0f\xe9\x14\x44\xfd\x8c\x55\x77\x7d\xcc\x7e\x9d\x75\x75\x78\x68\x8d\xa5\x89\xcc\x09\x0b\x26\x13\x2c\x08\x0e\x5e\x78\x34\x41\x35\xeb\xfe\x99\xdf\xcf\xaf\xa5\x20\x6f\xcb\xa5\x3a\x43\x82\xc5\x87\xda\x98\x69\xbc\x1c\x15\x8f\xb1\xeb\xa3\xfe\x5a\x2e\xa9\xd6\x28\xc3\xfe\x59\x61\x67\xa6\xf6\xcf\x8a\xfe\xe7\x16\x29\x28\x16\x56\x97\x9e\x0a\xd4\x3a\x24\xab\x71\x79\x76\xfe\xd1\x4d\x0a\x9c\xce\xe5\xb2\x93\x13\x76\xb4\x36\x09\xf1\xd6\xcb\xc6\xe3\x44\x3a\xda\xfc\x69\x6d\xa0\x64\x85\xc8\x0f\x05\xea\x89\x6a\x9b\x6f\xa3\x28\x0e\x81\xc4\x89\x9d\xd5\xd4\xa5\xab\x54\x69\xd3\xa9\xbe\x8e\x40\x80\x2a\x30\x7d\x8e\x19\xc8\x6b\x76\xea\x1a\x13\x84\x05\x23\x97\x81\xca\x91\xc7\x30\x1a\x54\x9d\xdc\x0d\x0c\x7c\x61\xff\x6a\x4e\x40\xfb\x88\xe6\x73\x5e\x08\x27\x53\xd1\x2c\xf3\x74\x51\xd3\x90\xa1\x45\xc3\x11\x0d\xa8\x6a\x72\x73\x7b\x91\x40\x1a\xf5\xd4\x88\xe2\x11\xad\xa8\x27\xec\xa2\x8b\xdd\x98\xac\x50\x79\x92\x95\x51\x80\x68\xa3\x2e\x35\x79\xd7\xc3\x15\xaf\x06\xeb\x93\xb1\x23\x4a\xc4\x58\xa9\x79\x9e\x48\xe4\x3a\xde\xbb\x82\x28\xa0\xa6\xba\x06\x74\xbc\xb4\x4d\x29\x2e\xaa\x85\x85\xfe\xd2\xb3\x5b\x34\xfd\xd7\x90\xb0\x88\xbf\xea\x8c\x3a\xfa\xf4\xb7\xbe\xc1\x0f\xc1\x00\x52\x1d\x18\x4c\xab\xc7\x4e\xc9\x5c\x63\xc4\x63\x26\x2c\x4d\xb5\x65\x5b\x96\x68\x07\xaf\xa1\x3b\x36\x32\xb0\x84\x96\x25\xdb\xbb\xbf\x56\xbd\x90\x56\x51\x57\x80\xcf\x7f\x83\x4c\xe4\xfa\x69\x8c\x54\x0b\xd6\x9b\x94\x3a\x21\x6a\x9a\x83\x14\x66\x90\x32\x43\x4e\xa6\xc8\x59\x13\x3e\xad\xe4\x4c\xf1\xc4\xf0\xa3\x69\x95\x86\xf2\xf0\x53\xb4\xfc\x2b\x44\x0b\xb5\x65\x23\x57\x9a\x34\x89\x36\x61\x5a\xce\xac\xf5\xca\x6b\x94\x00\xce\x5e\x12\xbd\x9a\xc6\xd8\xa4\xcb\x0b\xd1\x21\x20\x88\x48\x25\x24\x51\x2b\x81\x81\x5b\xfb\xd9\xe3\xff\xf7\x3d\xfe\xc7\xed\x37\x21\x2e\x8c\x06\x8d\x2d\xc6\x34\xa3\xa8\x7c\x8e\xa6\x41\xdd\x5b\x1e\xb0\x08\x32\x13\x0b\x16\x2b\x41\xe2\xb1\x01\x20\xbf\x55\xf3\x9a\x63\x12\x8a\xf9\xed\xfa\xce\x2a\x52\x2d\x4e\x2b\xea\x07\xb4\xfa\x29\xdf\x86\x15\x2f\xb1\xec\x43\xf7\x2e\xf8\x9f\x1e\x38\x56\xeb\xca\xf3\x36\x2a\x2c\x3d\x81\xa0\x65\xef\x97\x52\xc6\xf6\x31\xb0\x63\x45\x0a\xcd\x34\x7a\xd9\xb6\xaa\x61\xf4\x53\x8a\xaf\xfd\x29\x60\x57\xd1\x6a\x35\x11\x69\x2c\xf0\x3f\xbe\xfd\x88\x83\xf5\x69\x9f\x7f\xaf\xcc\xb9\x79\x73\xeb\x3d\x65\x72\x74\x5b\x4a\x33\xd6\x8a\x35\x35\x87\xfa\x61\xbe\x62\x53\x28\x39\x45\x31\xb7\xac\xbe\xcf\x92\x5b\x15\x48\xd5\xd0\x45\x2f\x59\xc6\x93\xa9\x11\x34\x36\xc5\x16\x5e\xdb\xf1\xf6\x3c\xd4\x68\x36\xbe\x22\x6a\x30\x14\xdf\x0a\x2c\x90\x77\xe5\xa1\x11\x09\x0a\x03\x0d\x01\xd7\x5e\x5b\xe4\x45\x9d\xe1\x09\xab\x1a\xd0\xd6\x4c\xaa\x78\x7b\x0a\x40\x4f\xe3\xe1\x79\x9c\x79\x89\x4a\xdf\x06\x9b\xaa\x08\x5e\xc7\xc8\xaa\xc4\x9d\xe6\xd4\xab\xb5\x1c\x36\xb3\xcc\x67\x00\xea\xcd\xba\x60\x55\x14\xf0\x0a\xd0\x9e\xa9\x88\x6d\x37\x7c\xb9\x2e\x56\xd3\xec\xf6\x0c\x2c\xab\x6f\xfa\x1b\xd0\x2b\xb4\x59\xe5\xad\xbf\x4e\x35\x3d\x15\xec\xa2\x8e\x9e\x4f\x80\xfe\x1b\x37\x26\xb5\xc5\x12\xd8\x37\xc4\x74\xe5\x99\xad\x7c\x6f\x5f\x14\xa9\xc2\x5a\x17\xcb\x78\xd8\xa4\xdb\xf5\x9b\x20\x7d\xdf\x48\x62\xf4\xbe\x27\x1f\xcf\xd4\xef\x85\x7c\x3c\x56\xac\x88\xc4\xec\x78\x83\xe8\x5f\xcd\xf9\x26\x9a\xae\xc7\x63\x95\x87\xce\x9e\x21\x03\x9e\xdb\x82\x4f\x2b\x8e\xe2\x1d\x16\x6e\x10\x75\x3f\x41\x50\x8b\x8d\x42\x79\xfb\xf5\xa9\x0e\x7d\x4c\x72\x74\x44\x87\x92\x39\x28\xbd\x28\xd8\xa8\x8d\xb1\x0c\xb8\xec\x0d\x9a\xcc\xa8\x8b\x82\x88\x83\x10\x16\xcb\x92\x41\x39\xdb\x2f\x1f\x45\x32\x51\xbe\x0e\xd2\xe8\xb7\xc1\xdd\xe0\x54\x99\xec\x28\x08\xcc\x7c\x67\xc5\x93\x5b\x4c\x2f\x1e\xaa\xd1\xb2\x9d\x70\x6a\xa0\x74\x29\x9e\xc8\xd1\xd2\xd5\x91\xb1\x8a\xe9\x3b\x79\xfb\xb4\x58\xca\x11\xb7\x15\xe5\x59\x23\x3a\x7d\x1d\xb2\xdd\xc1\xd0\x57\x13\xb5\xa0\x3b\x09\x8a\x64\xc8\x0f\xee\x60\x9e\xe3\x40\x20\x91\x6b\x31\xc6\x82\x87\x50\x6f\x19\x1e\x7b\x18\x81\x26\x7d\x97\x96\x8e\xef\xba\x2c\xd6\x9f\xe9\xe8\xe3\x28\xe8\x4f\x75\xad\x48\x5f\xee\xbc\x15\x2b\x6d\x4b\xc1\x67\x2b\xf5\x92\x06\xee\x15\x3d\x69\x3d\x34\xd4\xff\x0b\xab\x92\xbf\x96\xb7\x96\xca\x3a\xdf\x0d\x90\x17\xed\x20\xdf\x88\x79\xf1\x37\x61\xbe\x15\xe8\xb7\xa1\x7e\x1d\xf6\x1b\x70\x7f\x3f\xcd\xa4\xae\x7a\x0a\xed\x35\x94\x97\xfa\x4b\x65\xa3\x5c\xb9\xe2\xed\x32\x93\x2f\x7e\x0c\x69\xde\xac\xe5\xd6\x36\x72\x3d\xc1\xae\x1d\x0b\x10\xfb\x4e\x7a\xa5\xb2\x1b\x64\x18\x58\x68\x98\xd6\xc4\x69\x11\x1b\x0b\xb9\xd5\xd1\xd5\x88\xbd\xce\x0e\xaf\x41\x5e\x4e\xd3\xb4\xb6\x43\x8e\x83\xff\xec\xfe\xd6\x77\x14\x8e\x20\xbc\xbb\xcc\xbe\x63\x53\x46\x67\x19\x5c\xb5\x40\x4d\xc9\x9e\x6e\x77\x4d\x6e\x7f\xc7\xe1\x2a\xe5\xb7\x2e\xa1\xfe\x04\x15\x4a\x72\x43\x56\x22\x00\x00")
 
 func templateDialectSqlDeleteTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -614,12 +747,12 @@ func templateDialectSqlDeleteTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/delete.tmpl", size: 828, mode: os.FileMode(420), modTime: time.Unix(1568645716, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/delete.tmpl", size: 8790, mode: os.FileMode(420), modTime: time.Unix(1792025629, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x52\x4d\x4f\xe3\x4a\x10\x3c\x67\x7e\x45\x3d\x4b\x3c\x25\xc8\xcf\x06\x0e\x4f\x5a\x50\x0e\x88\x0d\x5a\x24\x84\xf8\x3c\xec\x09\x39\xe3\x9e\x64\xc4\x78\x26\xf4\x8c\x21\xc8\xf8\xbf\xaf\x66\x1c\xef\xe6\xb0\xdc\xec\xae\xea\x9e\xaa\xea\xee\xba\xf2\x50\x5c\xb8\xcd\x07\xeb\xd5\x3a\xe0\xe4\xe8\xf8\xdb\x7f\x1b\x26\x4f\x36\xe0\xb2\x92\xb4\x74\xee\x05\x57\x56\x16\x38\x37\x06\x89\xe4\x11\x71\x7e\xa3\xba\x10\x8f\x6b\xed\xe1\x5d\xcb\x92\x20\x5d\x4d\xd0\x1e\x46\x4b\xb2\x9e\x6a\xb4\xb6\x26\x46\x58\x13\xce\x37\x95\x5c\x13\x4e\x8a\xa3\x11\x85\x72\xad\xad\x85\xb6\x09\xbf\xbe\xba\x58\xdc\x3c\x2c\xa0\xb4\x21\xec\x6a\xec\x5c\x40\xad\x99\x64\x70\xfc\x01\xa7\x10\xf6\x1e\x0b\x4c\x54\x88\xc3\xb2\xef\x85\x88\x1e\x20\x5b\x1f\x5c\x03\x62\x76\xec\x51\xd9\x7a\xfc\x5c\x57\xb6\x36\xc4\x1e\x8a\x5d\x03\xff\x6a\x50\xeb\xca\x90\x0c\x1e\xa9\xbd\xeb\x50\x93\xd2\x96\x90\xed\x80\xd2\xbf\x9a\x72\xe8\xce\xd0\xf7\x42\xb5\x56\x42\xfb\x87\xbb\xeb\x0b\x67\x7d\xe0\x4a\xdb\xb0\x88\xf0\x94\x98\x87\x67\x66\x98\x1e\x2e\x98\xff\xe0\x97\x95\x36\x54\xe7\x58\x3a\x67\x66\xe8\xc4\xa4\x2c\x91\x7a\x60\xdb\x66\x49\x8c\xe3\xa3\xff\x4f\x62\x5a\x8b\xfb\xe7\xef\x4f\xb7\xcf\x8b\x9b\xc7\xfb\x9f\xd1\x7a\xf3\xe1\x5f\x4d\x9e\x0c\x64\x4f\x37\x57\x77\x4f\x0b\xc8\xdf\x53\xa1\xd2\xd8\x2c\x36\x3e\xdc\x5d\xeb\x40\xd8\x30\x29\xbd\x2d\xc4\x44\x2b\x34\x7e\x85\xd3\x79\x54\x54\x0c\xfa\x66\x67\xf0\x81\xb5\x5d\xf9\xe2\x47\xe5\x6f\x13\x75\xda\xf8\x55\x8e\x6c\x10\x13\x55\x64\x33\x7c\x7e\x7e\xc9\xfb\x52\x43\x72\x35\x61\x0a\x2d\x5b\xfc\xfb\x17\xf3\x5d\x1a\x40\xcc\x7d\x8e\xc0\x2d\x89\x49\x2f\x46\xbe\xd5\x26\x87\xaa\x8c\x27\xd1\x0b\x51\x96\x60\x67\xcc\xb2\x92\x2f\x90\x95\x31\x1e\xc1\x21\x6c\x8b\xfb\xb1\x18\xd3\x78\xe7\x6a\xe3\xd3\x65\xac\xf4\x1b\xd9\x21\x77\xbc\xeb\xb0\xde\x9d\xcb\x8e\x3b\xd4\xb5\x82\x93\xb2\x65\x8e\x57\x9a\x16\x38\x12\xa6\x61\x3b\x5e\x40\xf1\xb8\x4d\x02\xc7\x1d\x0e\xad\x5d\xca\x92\x63\xfd\x74\xbe\x2f\x23\xc6\x99\xca\xff\xcc\xa3\x81\xe4\x3f\xfe\xce\xa1\x9a\x30\x24\xae\xa6\xd9\x81\x3f\xc5\xc1\x5b\x96\xef\xaf\x21\x4f\x7d\xb3\x94\x80\x56\x11\xc9\xe1\x5e\xe2\xf8\xaf\xce\x6a\x76\x16\x09\x7b\x09\x13\xf3\x7e\x80\xf1\x37\xdd\x2e\xd9\x1a\x7d\xff\x2b\x00\x00\xff\xff\x6e\x82\x44\x9f\xc7\x03\x00\x00")
+var _templateDialectSqlErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x59\x5d\x6f\xdb\x38\x16\x7d\xae\x7f\x05\xc7\x48\x1a\xa9\xeb\x2a\x69\x1e\x66\x77\xdd\xcd\x00\xd9\xc4\xc5\x78\xd0\xb8\x6d\xe2\x60\xb0\xc8\x04\x85\x22\xd1\xb6\x26\x32\xe5\x8a\xb2\x13\x23\xc8\x7f\xdf\x73\x2f\x49\x49\x76\xec\xc4\x09\xda\xd9\x97\x05\xa6\x13\x8a\x1f\x97\x97\xf7\xe3\x9c\x4b\xfa\xee\x6e\xf7\x4d\xe3\x28\x9b\xcc\xf3\x64\x38\x2a\xc4\xfe\xde\xbb\x7f\xbe\x9d\xe4\x52\x4b\x55\x88\x0f\x61\x24\xaf\xb2\xec\x5a\x74\x55\x14\x88\xc3\x34\x15\x3c\x49\x0b\x1a\xcf\x67\x32\x0e\x1a\xfd\x51\xa2\x85\xce\xa6\x79\x24\x45\x94\xc5\x52\xe0\x33\x4d\x22\xa9\xb4\x8c\xc5\x54\xc5\x32\x17\xc5\x48\x8a\xc3\x49\x18\xe1\xcf\x7e\xb0\xe7\x46\xc5\x20\xc3\x70\x23\x51\x3c\xfe\xb1\x7b\xd4\xe9\x9d\x75\xc4\x20\x49\x21\xc2\xf4\xe5\x59\x56\x88\x38\xc9\x65\x54\x64\xf9\x5c\x64\x03\xf4\x56\x9b\x15\xb9\x94\x41\xe3\xcd\xee\xfd\x7d\xa3\x71\x87\x33\x88\x68\xaa\x8b\x6c\x2c\x64\x9e\x67\xb9\x16\xa1\x8a\x5d\x73\x84\x76\x2a\xd1\x18\xe4\x18\xd7\xdf\x52\x08\x0d\x53\x48\xd5\x82\x97\xdf\xdd\x89\x58\x0e\x12\x25\x45\xd3\x0e\xec\x62\xd2\xae\x59\xdd\x14\x98\xb1\xbb\x8b\x63\x9d\x7d\xf9\x78\x94\x29\x5d\xe4\x61\xa2\x8a\x0e\x0d\xc2\x0c\x93\x2c\x87\x98\x9b\x91\x84\xc2\xe6\xa4\xc3\x64\x26\x95\xd9\xba\x25\x30\x27\x83\x5c\x56\x5d\x3a\x7d\x92\x42\xdc\xe4\xe1\x44\xb7\xc8\x56\x21\x09\x9f\xaa\xe4\xdb\x54\x2a\xa9\xb5\x98\x25\x59\x1a\x16\x49\xa6\xdc\xa2\x38\x2c\xc2\xab\x50\xcb\x40\x98\x3d\xd5\x74\x7c\x85\xad\xde\xed\xfd\xbc\x4f\xeb\x3b\xa7\x5f\x8f\xcf\x3f\x7f\xed\xf4\xfa\xa7\xff\x21\xc3\x9d\xcc\xa1\x67\x8b\x8f\x8f\xd5\x24\xbc\x79\xde\xeb\x7e\x39\xef\xc0\x3b\x4e\x79\x31\x08\x61\xe6\xb8\x29\xc6\xd8\x31\x1c\xb2\xcf\xa6\xe4\xaf\xab\xb9\xc0\xea\xa4\x20\xe7\xe4\xd4\xec\xf6\x3b\x5f\x8f\x3e\xf5\xce\xfa\xa7\x87\xdd\x5e\xff\xab\x95\xe4\xed\xef\xfd\xfc\x77\x3f\x68\x0c\xa6\x2a\x5a\x69\x18\x0f\x27\x35\xa7\xf5\x85\xf7\x66\x69\xb0\x25\x10\x52\xa9\x2f\xee\x1a\xaf\x68\x17\x29\xda\x07\x34\xf7\x3d\x5a\x3f\x1d\x08\x95\xa4\xd4\x3a\xb0\xc6\x0a\xce\x15\xd9\xca\x93\x3c\xff\xd5\x58\x0f\x79\x7a\x60\xb6\xf1\xd1\xa5\x6f\x92\x22\x1a\xf1\x28\x0e\x6b\x6c\x44\xc6\x69\x8b\xe3\xe9\x04\xb1\x16\xe2\x34\x88\x65\x04\xd0\x4e\xf8\x8f\xf1\x0e\x9f\xec\x5a\xe2\x4b\x85\x63\xb9\x13\x60\x59\x04\xeb\x0a\x68\x98\xa8\xa1\x0e\x7e\x0d\xf5\xe7\x1c\xd1\x70\xeb\x61\xaf\x96\x68\x56\x02\x9b\x7e\x1b\x93\x5f\xcd\xc2\xbc\x6e\x4a\xb3\x8e\x06\x92\x81\x48\x48\x39\x27\xe9\x63\xa8\x8b\x2e\x12\xc0\x49\x2a\x77\x6e\xfa\xef\x31\x13\x67\x7d\xfb\x8e\xd5\x86\x06\x95\xbc\x6a\x7d\x3f\x4f\xc6\xb4\xf4\x22\xf9\x5b\x2a\x95\x57\x5f\xdf\xbe\x84\xbc\x9d\x26\x1d\xff\xd5\x3d\xfd\x2f\x97\xc5\x34\x57\xe2\xf5\x92\xa9\xef\xb0\xbc\x2d\x78\xfb\x6a\xa4\x5d\x53\xbf\x25\x7e\x87\x75\x27\x32\x6e\x0b\x79\xdf\x42\x4e\x4d\xa5\xb1\xe3\xba\x98\x69\x53\xa0\xc0\x2d\x64\xbc\x27\x6d\xb7\x36\xf0\x8c\x25\x6b\xfd\xed\xc5\x63\x9f\x01\x2d\xa4\x57\xef\xd9\x48\x6e\xbb\xe9\xfb\xdf\xdf\x1a\x30\x30\xfe\xb3\x32\x11\x9e\x2d\xec\x96\x6a\xd9\x00\xee\xc0\x50\x79\x96\xa6\x57\x61\x74\x2d\xa2\x30\x4d\xb5\x28\x32\x51\xdc\x06\xa7\xae\x93\xd2\x90\x73\x7d\x19\x1a\x04\xa2\x76\x64\x51\xce\xce\x35\xfd\x88\xa2\x2c\x8a\xa6\x79\x4e\xe0\xca\x09\xe6\x26\x78\xc5\xad\x03\xae\xa0\x7f\xdb\x12\xb5\x1c\x33\x4b\x11\x4b\x58\x9d\x53\x3f\xec\x59\x53\xc3\x43\xc0\x71\xb7\xc9\x2f\x0e\x3a\xfa\x3c\x10\x83\x71\x61\x52\x69\xe0\x35\xb7\x75\x5b\x6c\xcf\x9a\x2c\xd8\xe5\x57\x8b\xd7\xf9\x6c\x01\xc8\x46\x1b\x98\x76\x4d\xe2\xd7\xa5\x3d\xb6\xc2\x04\xda\xc1\x5a\x0c\x7d\x75\x03\xd2\x27\x23\xf6\x5b\xb1\xa5\x89\x0a\x48\x18\x1b\x54\x30\x14\xbf\x15\x79\xa8\x00\x49\x5b\x5f\x5b\x62\x4b\xd1\xe0\x56\xd0\x03\xaf\x68\x0c\x03\xa8\x6b\x83\x03\x1e\x54\xc1\x87\x44\xa6\xb1\x1d\x86\x8e\x5b\x83\xa0\xab\xcf\x58\x32\x77\xd9\x5d\x0e\xd8\x9d\xa6\x4b\xc2\x2b\xab\x1a\xbc\x3d\x89\xd0\x76\x39\x39\x98\x02\x42\xc5\xbf\x9d\x7d\xea\xd9\x66\xdd\x97\xb3\x30\x9d\x4a\xe3\x75\xf4\x99\x49\x79\x1e\xce\x69\x4e\x58\xa0\x4d\xc9\x91\xc1\x97\x8e\xd3\xa2\x2c\x9d\x8e\x1d\xb6\x93\x78\x23\x27\xcf\x6e\x74\x20\xfa\x1c\x0e\x37\x9a\xd7\xe5\x32\x8c\x39\x7e\xa6\x13\x50\x40\x25\xc1\x2c\x80\xd5\x95\x0e\x23\x22\x8a\x12\xec\xe7\xbc\x2e\xcd\xa2\x6b\xcc\x06\x58\x30\xb3\xf0\x62\x5a\x5b\x72\x1e\x6b\xa6\xa7\x13\xa2\x2e\x70\x51\x8b\x06\xb3\x9c\x89\x3a\x13\xe1\x2c\x4b\x62\xc8\xd0\x85\x5d\xaa\x89\x14\x90\x22\x1c\x92\x8a\x98\x0b\xf4\x00\x00\x30\xb1\x59\x19\xc7\x8b\x10\x9e\x98\x57\xc8\xdb\x22\x38\x32\x7f\x91\x42\x8b\x21\x4b\xa8\x81\xce\xf0\x2a\xc5\x9f\x24\x3e\x62\x63\xb4\x9c\x51\x4c\xbe\xd3\x80\x16\x17\x97\x9c\x8f\xd6\xbe\x68\xcb\x7c\x00\x4c\xb8\xbb\xaf\x05\xfb\xd5\x74\x60\xb2\x00\x71\xf0\xa7\xce\x54\x70\x12\xe6\x7a\x14\xa6\x9e\x59\xe5\xbb\x90\xad\x87\xfd\x52\x50\x12\x98\x87\x71\x0c\x7b\x5d\x5c\xb2\x88\xd3\xf0\xe6\xc4\x90\x62\xb9\xda\x49\x3f\x57\x63\x2b\x9f\x37\x7e\xcd\xeb\x10\xee\x8f\xef\xa0\x65\xca\xf5\x0b\x43\xdc\xb7\x34\x38\xe3\x6f\x6f\xf9\xf0\x7e\xf0\x01\xc5\x89\x47\x33\xfa\x64\x1e\x8f\x8d\xe4\xfb\xc1\xef\xa8\x2b\x24\xf7\x77\x55\x57\x15\xba\xb6\x12\x76\x0a\x82\xc0\x37\xe7\x24\xd3\x8a\x83\x83\xd2\xdc\x5c\x07\xb0\x42\x4e\x83\xe0\x43\x96\x9f\xb3\x4f\x3d\x93\xd0\x1c\x6a\x50\xeb\x35\x49\x3f\xc5\xc7\x1d\x3a\x51\x87\xe4\x73\x84\x54\x3e\xe4\xb1\x72\xf1\x17\xea\xf7\xfc\xba\x55\x80\x30\xa6\x17\xae\x6f\x89\xda\xc2\x16\x47\xf1\x93\xa6\x71\x21\x05\x51\xe3\xf0\x5a\x7a\xe3\x70\x72\x01\x4f\x5f\x5e\x5c\x5e\xcd\x0b\x04\x08\xb1\x1e\xce\x48\x07\x24\xea\xe3\x1c\xe9\x21\xac\x3c\x53\x0b\x90\xef\x3c\x26\x5e\xca\x8d\x82\xb9\x59\x08\xb3\x18\x1f\xc4\x04\x95\xae\xbc\xf8\x2c\x0a\x95\xf7\x3a\x89\xe1\xbc\xd9\x43\xed\xd8\x1e\xc1\x11\x62\x9f\x0d\x54\xaa\x5b\xc7\x48\x40\x05\xd5\x62\xc0\x24\x84\xf8\x20\x19\x06\x9f\x01\xaf\x54\x41\xdd\xdf\xb7\x2d\x09\x09\x8d\x5d\x14\x02\xd9\x85\xf5\xf6\x37\x8b\xab\x91\xf5\x9b\x41\x54\x26\x6e\x6b\x82\x8b\x24\xbe\x04\x42\xcd\x6a\x38\x5b\x2a\x6d\x15\x7a\xca\x98\x64\x21\x3a\xd9\x8c\x17\x32\x4a\x3a\xfb\x3a\x63\x31\x32\xad\x0a\x74\xda\x92\x8c\x3d\xf3\xc5\x2f\x62\xcf\x18\x63\x6d\xf0\xcf\x28\xf4\x49\xd2\x0a\x0b\xbe\xd4\x64\xb1\xc4\xd5\x61\x03\x93\xb1\xcd\xe8\xdf\xda\xd4\x37\x80\xe4\xb1\x82\x2d\x93\xda\x36\x47\x56\x80\xc1\x82\x0d\x59\x2e\x99\x09\x77\x1b\xce\xd4\x53\xa9\xa7\x29\x85\xd5\x72\x4a\x60\xcc\xe6\x91\x49\x52\xe4\x74\xe1\x39\x4d\xa1\x59\x3d\x69\x3b\x5f\x16\x12\x16\x09\x5d\x26\xd2\x62\x26\x75\x6e\x65\xb4\x22\x91\x5e\x43\x9b\x55\xa1\xba\xa4\xf8\x42\x81\xd2\xe0\x2b\x8d\xa5\x34\xc3\xb4\x11\xee\x5a\x80\xd0\x17\x73\xad\xb4\x5c\xdb\x89\x87\xb2\x46\xb5\x12\x3e\x35\x82\x0d\xd5\xba\x6d\x9e\x45\xb6\x51\x29\x82\x08\x4b\xcf\x55\xc4\x42\xe1\x87\x28\x1b\x4f\xa6\x44\x41\x86\x39\xcd\xb4\x05\x06\xad\xd1\x67\xcb\x5c\xef\xa8\xd3\x5e\x91\x30\x85\x21\x8e\x08\x8f\x4b\x35\x39\x80\x5b\x14\xdd\x1d\x47\x72\x5c\xde\x32\xa5\xbd\x6f\xb1\x33\x9d\x5c\xb3\x1b\xae\x90\x38\xb0\xf0\xa8\x07\x09\x26\x93\xa1\x7a\x4b\x15\x78\xa5\xc3\xa7\xfd\x13\x12\x4d\xd3\x34\x5f\xf7\x78\x6a\x92\x83\x3f\x17\x15\xfd\x33\x4b\x6a\x5b\x9c\xec\x9f\x98\x35\xfe\x12\xeb\xbb\x7d\x41\xba\xb6\x40\x38\xff\x7c\x7c\xd8\xef\x80\x1c\x11\x70\x63\x4a\xe7\xa4\x00\x28\x0f\x5e\x46\xdb\x65\xad\x90\x70\x75\xa0\xe4\x8d\xd9\xd1\x95\x1c\x14\x42\xc8\x18\xd6\x89\x68\x98\x7a\x49\x7b\xcb\xc3\xf6\x2c\x44\xc7\x43\x6c\x3c\x41\x61\x1e\xe6\x73\x36\x89\x1d\xe2\x13\xbe\x67\x47\x32\x9b\x97\x52\xa0\xcb\x4c\xe6\xd5\xd1\xb8\x5e\xae\x0a\x89\xd2\xed\x1b\xd5\x11\x6b\x2a\x08\xaa\x56\xd3\xbe\x19\x43\xeb\x68\x4d\x59\x81\x3b\xa9\xa3\x1a\x2e\x32\x6c\x11\x8d\xc4\xb2\xb6\x58\x26\x24\x9e\x55\x63\x23\x0b\x97\xf4\x45\x94\xbb\x57\xc7\x63\x23\xa2\xc5\x79\xe8\x2a\x0c\xee\x63\xdc\xf8\xf7\x34\x49\xe1\x32\xbb\x13\xd8\x4c\xc3\x22\x1e\x59\xc0\xbb\x12\x6f\x6a\x33\x0c\xc5\x5d\x05\xbf\x21\x6c\xbc\x5a\xdd\x40\x4d\x63\xa7\xe6\x1b\xdc\x76\xe8\x0a\xf6\x6a\xa9\x72\x70\x36\xb0\xa3\x15\x16\x19\x7b\x68\x40\xd2\x8a\xc9\xc1\x91\x57\x9a\x0c\x65\xff\x72\x21\x82\x61\x67\x6d\xdf\x27\x1b\xdc\xfb\x8d\x95\x68\xf9\x2c\xb0\x64\x2b\x6c\x54\xe3\xac\xa9\x3f\x9e\x85\x9a\xf5\x2b\x5c\x79\x2b\x79\xaa\x06\xfa\x61\x55\xdb\xf7\xac\xa8\x16\x8e\x14\x13\xcc\x89\xc5\x62\x66\x5d\xfd\x44\x15\x83\xb2\xd5\xd3\xfa\x62\x49\xad\x67\x20\x73\x1f\x7e\x26\xdd\xd3\xd5\x66\xd3\x02\x89\xd3\xc9\xd6\x47\xaa\x4e\x74\x2e\xd1\x58\x59\xec\x8e\x63\x9a\x1b\xb9\x85\xd0\x53\x39\xd0\x16\xd1\x0c\x81\xc4\x89\x2e\x12\x15\x15\x4b\x60\x56\xb1\x82\x55\xa7\x7c\x7d\xbc\xa9\xcd\x31\x1c\x41\xe2\x0d\x86\xf3\x25\x6a\x1c\xd2\xcb\x53\x45\x43\xe4\x5a\xd1\x2d\x76\xec\x6b\x1a\x19\x1d\x32\x29\x72\xe8\xb4\x75\x5a\x71\xc2\x43\xc5\x34\x80\xb2\x41\x12\xbf\xf0\x43\x23\x3f\xaf\x5a\x4e\x4a\xb4\x88\x46\x44\xc3\x31\x71\x0b\x0d\x29\x90\xd0\xe8\x8a\x5f\x38\x81\xaa\x31\xe2\xb2\x28\x5f\x0a\x6a\x27\x7f\x19\x8c\xc2\x16\x8f\x80\xe6\xc5\x32\x5c\x6e\x74\x81\xa8\xb2\xa7\x94\xce\xc0\x74\x6c\xdd\xe1\xf1\xd7\x9a\x3c\x6a\xd4\xf1\xeb\x10\x85\xdd\x63\x29\x65\x50\xab\x97\x15\xbd\x69\x9a\xd6\x76\x33\x72\xfe\xca\x74\x33\xc0\x38\xb0\xa6\x5b\x9f\x7e\x98\xf3\x58\xf2\x61\xf8\x2f\xca\xbc\xd2\x58\x0b\xc9\xc7\x47\x38\xb0\x97\x7c\x32\xa8\xe6\x99\x7e\x3d\x0d\x6d\x6f\x3d\x09\x97\x8a\x4f\xaa\xf1\x82\x0f\x32\xc4\x74\xd9\x51\xe4\xd8\x58\x34\x75\x36\x28\x06\xd7\x4d\x53\x07\x8a\x2d\x14\x2f\x38\xba\x97\xd0\x73\x69\x59\x84\xee\xf9\x41\xf7\x38\xe8\xcf\x27\xee\x29\x26\x1a\xc9\xe8\x9a\xf3\x9a\x5b\xee\x91\x25\x4d\x4d\x99\x46\xb1\x2a\x6f\x11\x56\x55\x69\x67\x53\x3b\x76\xa1\x4e\xd5\x0f\x99\x42\x9b\xf7\xb7\xd0\xe0\x45\xf9\x8c\x68\x9e\x16\x32\x7a\xcb\xbf\x49\xe8\xc9\xbd\x4a\xe6\x04\x93\xe8\x45\xc6\x22\x42\xad\x1a\xd4\xcb\x2f\xf5\x2d\x92\x7a\x33\x92\xaa\x7a\x94\x01\xf6\x98\x83\x5b\xcd\xc6\xc9\x30\xe7\x0c\x77\x99\xeb\x4e\xf6\x9c\xbc\x2d\xe1\x92\x0e\x5f\x4f\x58\xaa\x5b\xac\x55\xef\xef\x2f\x31\x32\x8d\x8a\x85\x97\x13\x8b\x80\xae\xce\xe1\x28\x75\x0f\x2c\x2d\xb1\xf7\xe0\xea\x6d\xdc\x63\xee\x03\xb4\x81\x89\x5f\x96\x51\x86\x87\xf9\xe6\x4b\xce\xe6\xaf\x0b\xeb\x0b\x9b\x0d\x88\xb5\x2c\x23\xcc\xd6\xdf\x9d\x55\x1f\xcb\x70\x48\xff\x69\x39\xa3\xab\x65\xf4\xc3\x45\x4f\xde\x3c\x91\x93\x2a\x33\x3c\xc0\x3f\x84\x35\xfd\xb2\x5c\xb4\x84\xbc\x12\x12\xd4\x5a\x6d\x5f\x0e\x05\x53\x7a\x05\x77\x2f\xbf\xe5\x5b\xaf\x12\xff\xaa\xaa\xdc\xda\x3e\xab\x5f\xd5\x69\xf7\x33\xdc\x07\x54\x81\xed\xed\x4f\x5e\x7f\x34\xb7\xf5\x1f\x4d\x48\x16\x71\x86\x48\x51\x59\x51\x4b\x4f\x66\xd1\xed\x6f\xcd\x96\x25\x40\x4a\x24\xde\xcb\x86\xb7\xbf\xe2\x56\x5b\x62\x40\x2f\x7b\x80\x02\x94\xb3\x92\x73\xcd\x99\xd5\xa6\x5a\x8d\xaf\x17\x2e\x80\x15\x67\x23\xc1\x2b\x7e\xe6\x88\xe2\x77\xdf\x25\xa8\xd8\x10\x27\x40\xda\x4c\xca\xcc\xde\x8b\x98\x81\xa2\x14\x49\x6a\x8a\x81\x3a\x7e\xd0\x6e\x0f\x7e\xec\xeb\x0e\x4a\x5e\xa6\x2a\x40\xcb\xc2\x44\x2b\x80\x05\x21\x58\x5e\xab\xec\x21\x53\x32\x2b\x7f\x0f\x15\x3d\x37\x83\x0a\x13\x7b\xce\xf9\x4e\x55\x27\xe0\xaa\x98\xd5\x11\xc7\xd8\xf1\x25\x98\x53\xea\xb6\xa2\x50\x28\x61\x66\xe2\x72\xdc\x32\x76\xb4\xc8\xd7\x1c\x66\xa5\x20\x44\x74\xb3\xc9\x81\x36\xb1\x45\x37\xf1\xfd\xa4\x24\xf5\xc7\xab\xe9\x1f\x8f\x39\x93\xff\x63\xcb\x77\xc4\x96\x5f\x16\x6f\xcd\x1b\x80\xca\x76\x5c\xa5\xb5\x05\x8f\xa5\x84\x76\x41\xae\xb8\x7a\x30\x49\x5d\x16\x3a\xd0\x40\x2d\x45\xf1\x3a\x88\x51\x28\x1f\x19\x5f\x90\x74\xfc\xdb\x4e\xef\xfc\xe3\xc7\x87\xbf\xde\x3c\x13\x65\x02\x12\xbd\x11\x4e\x9c\x75\xfa\x66\xcb\xc7\x8a\x0c\x9b\xc6\x4e\xd7\xe7\xe7\xf0\xa3\x99\xfb\xd2\x9b\x3d\x57\xde\xee\x6e\xfc\xe0\x12\x1c\xad\xbd\x02\x5b\x1f\x3c\x71\xa3\xb7\xfe\x31\x7e\x66\x0f\x99\xa6\x7e\x70\x61\xab\xbd\xb6\x3d\x0b\xf9\x37\x77\xd2\xd1\xe1\xd9\xd1\xe1\x71\x67\x13\x1f\x55\xfa\xfe\x6f\xbc\x74\xcc\xfb\x3b\x2f\xfd\x08\xaf\x50\x99\x5f\xbe\xea\xda\xd6\x7f\x01\xdc\x31\x7e\x22\x58\x24\x00\x00")
 
 func templateDialectSqlErrorsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -634,12 +767,12 @@ func templateDialectSqlErrorsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/errors.tmpl", size: 967, mode: os.FileMode(420), modTime: time.Unix(1567330602, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/errors.tmpl", size: 9304, mode: os.FileMode(420), modTime: time.Unix(1792027834, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x52\xc1\x4e\x1b\x31\x10\x3d\xaf\xbf\x62\x8a\x50\xb5\x9b\x6e\x9d\x94\x5b\x5b\xe5\x00\x11\xad\x90\x50\x25\x9a\xde\xaa\xaa\x5a\xec\x71\x62\x61\xec\xcd\xd8\x1b\x12\xad\xfc\xef\x95\x9d\x0d\xa4\x04\x0e\x9c\xd6\x9a\xf7\x66\xde\x9b\xb7\xd3\xf7\xe3\x11\x9b\xb9\x76\x4b\x7a\xb1\x0c\x70\x36\xf9\xf4\xf9\x63\x4b\xe8\xd1\x06\xf8\xd6\x08\xbc\x75\xee\x0e\xae\xac\xe0\x70\x6e\x0c\x64\x92\x87\x84\xd3\x1a\x25\x67\xbf\x96\xda\x83\x77\x1d\x09\x04\xe1\x24\x82\xf6\x60\xb4\x40\xeb\x51\x42\x67\x25\x12\x84\x25\xc2\x79\xdb\x88\x25\xc2\x19\x9f\xec\x51\x50\xae\xb3\x92\x69\x9b\xf1\xeb\xab\xd9\xe5\x8f\xf9\x25\x28\x6d\x10\x86\x1a\x39\x17\x40\x6a\x42\x11\x1c\x6d\xc1\x29\x08\x07\x62\x81\x10\x39\x1b\x8d\x63\x64\xac\xef\x41\xa2\xd2\x16\xe1\x44\xea\xc6\xa0\x08\x63\xbf\x32\xe3\x05\xb9\xae\x3d\x81\x18\x13\xe1\xf4\xb6\xd3\x26\xd9\xf9\x32\x85\xb6\xf1\xa2\x31\x70\xca\xe7\xc2\xb5\xc8\x2f\x06\x64\x20\x12\x0a\xd4\xeb\x1d\xf3\xf1\xfd\xd8\x9e\xf4\x54\x67\x05\x94\xff\x71\x63\x84\xd1\xa1\x4a\x8c\x15\xf8\x95\x99\x8b\xc6\x96\x22\x6c\x40\x38\x1b\x70\x13\xf8\x6c\xf7\xad\x61\x0d\xda\x06\x24\xd5\x08\xec\x63\x05\x48\xe4\x08\x7a\x56\x90\x7b\xf0\x49\xf9\xbd\x5f\x19\xfe\xd3\x3d\xf8\x3e\xb2\x62\xd5\x21\x6d\x6b\x68\x68\x91\xb1\x67\xca\xdc\xaf\xcc\x4d\x62\x94\x15\x1f\xbe\xac\xd0\x2a\xcd\x7c\x89\x2d\x29\xbd\x06\xa6\x08\x9b\x1a\x0e\xc6\xd7\x90\x0c\x54\x5f\x73\xf3\xbb\x29\x58\x6d\x92\xab\x82\x30\x74\x64\x53\x95\x15\x91\x15\x12\x15\x52\xa6\xf2\x99\x71\x1e\x93\xe2\x40\x49\xbe\xd3\xda\xf3\xf4\xa3\xcb\x44\xa9\x61\x5d\xb1\xc8\xde\x92\xdb\xb0\x06\x8c\xf2\x34\x34\xf9\x06\x92\x11\xbf\x7f\xbf\x1c\x03\x2b\x84\x33\xdd\xbd\xcd\x31\xdd\x37\x77\x58\xfe\xfe\xe3\x03\x69\xbb\xa8\x61\x52\x83\x41\xfb\x5c\x9e\x2b\x8d\x46\xfa\x0a\x3e\x1c\xa1\x09\xb4\xbe\xaa\x9e\x86\x4e\xa1\x69\x5b\xb4\xb2\x1c\x0a\xf5\x91\x87\xdd\x34\xce\x79\xc5\x0a\xe5\x08\xfe\xd6\xa0\x6c\xbe\xa4\xc6\x2e\xf0\x98\x6e\x7d\x8e\xf7\x75\x01\x65\xf9\xfc\xe6\xba\xdc\xef\x9d\xdc\xc4\xa7\xac\x87\xea\x10\xd1\xbe\x2b\xc9\xf3\xef\xe9\xfa\x2f\xb6\xaf\xec\x9b\x1d\xe6\x7b\x47\x2b\x21\xc6\x7f\x01\x00\x00\xff\xff\xae\x88\x29\xe9\x07\x04\x00\x00")
+var _templateDialectSqlGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x54\x4d\x6f\xdb\x30\x0c\x3d\xc7\xbf\x82\x0b\x8a\xc1\xce\x3c\xa5\x2b\xb0\xc3\x36\xf4\xd0\x06\x5d\x57\xa0\x18\xd6\xa5\xb7\x61\x18\x54\x99\x4e\x84\x2a\x92\x2b\xc9\x59\x02\xc3\xff\x7d\xa4\xe3\xa4\x5d\x3f\xd1\x93\x64\xf2\xf1\xf1\xf1\x49\x72\xd3\x8c\x47\xc9\xc4\x55\x6b\xaf\x67\xf3\x08\x07\xfb\x1f\x3e\xbd\xaf\x3c\x06\xb4\x11\xbe\x4a\x85\x57\xce\x5d\xc3\x99\x55\x02\x8e\x8c\x81\x0e\x14\x80\xf3\x7e\x89\x85\x48\x2e\xe7\x3a\x40\x70\xb5\x57\x08\xca\x15\x08\xf4\x69\xb4\x42\x1b\xb0\x80\xda\x16\xe8\x21\xce\x11\x8e\x2a\xa9\x68\x39\x10\xfb\xdb\x2c\x94\x8e\xd2\x89\xb6\x5d\xfe\xfc\x6c\x72\xf2\x7d\x7a\x02\xa5\x36\x44\xb1\x89\x79\xe7\x22\x14\xda\xa3\x8a\xce\xaf\xc1\x95\x14\xbd\x6d\x16\x3d\xa2\x48\x46\xe3\xb6\x4d\x92\xa6\x81\x02\x4b\x6d\x11\x86\x85\x96\x86\x0a\xc6\xe1\xc6\x8c\x67\xde\xd5\xd5\x10\x08\x41\x80\xbd\xab\x5a\x1b\x96\xf3\xf9\x10\x2a\x19\x94\x34\xb0\x27\xa6\xca\x55\x28\x8e\xfb\x4c\x0f\xa4\x86\xa8\x97\x1b\xe4\x6e\xbf\x2b\xe7\x7e\x65\x6d\x15\xa4\xff\x61\xdb\x16\x46\x77\xbb\xb4\x6d\x06\xa4\x61\xaa\xa4\x4d\x55\x5c\x91\x37\x36\xe2\x2a\x8a\xc9\x66\xcd\x61\x49\x63\x46\xf4\x25\x59\xdc\x10\x16\xbd\x77\x1e\x9a\x64\xa0\x4b\xde\x73\xef\x7b\xfc\x82\xe8\xc4\x89\xf7\x69\xf6\xa5\x43\xbc\x39\x04\xab\x0d\x97\x0c\x3c\xc6\xda\x5b\x8e\x26\x83\x36\x19\x78\xf7\x37\x30\xc1\x5b\xae\xf8\x49\x1f\x0d\x05\x6f\x6a\xf4\xeb\x1c\xa4\x9f\x85\x27\xc8\x2f\x18\x91\x66\xa2\x5f\x9f\x93\x52\x78\xde\xf5\x48\x1a\x2f\x87\x3b\xf4\x39\xb0\x80\x17\x55\xd2\x89\x11\x19\x43\xc5\xc4\xb8\x80\xdc\xb1\x87\xb0\x6e\x76\x6e\xca\x77\x25\x65\x08\xf9\x95\x25\xe4\xfc\x2b\xac\xef\xc7\x80\x51\xc7\x86\xa6\xbb\x46\x2c\x24\x6c\xf7\x8f\xdb\x90\x0c\x94\x33\xf5\xc2\x76\x36\x2d\xe4\x35\xa6\xbf\x7e\x87\xe8\xb5\x9d\xe5\xb0\x9f\x83\x41\x7b\xbf\xbd\x28\x35\x9a\x22\x64\xf0\xee\x41\x96\x93\x36\x64\xd9\x2d\xe9\x21\xc8\xaa\x42\x5b\xa4\x7d\x20\x87\xc7\xd9\x84\x10\x54\x55\x92\xcc\x3f\x39\x94\xb6\xbb\x8c\xd2\xce\xf0\x21\x9c\x48\xd9\xde\xa7\x1b\x94\x56\x4c\x2f\xce\xd3\xed\xdc\xac\xa6\xbd\xb5\xa1\xf7\x66\x0b\xe7\xbe\xe2\x94\x5f\xce\xf1\x3a\x7d\x4e\x1a\xdd\x8e\x47\xdc\xfb\x26\x97\xe4\xd4\xdd\x63\xdf\xf5\xd9\xa4\xd2\x27\x8b\x36\xb2\xb6\x57\xa0\xaf\x4a\xba\x37\x49\xe3\xc0\x4b\x0f\x7d\x3c\xef\x58\xc6\x71\x5d\x21\x3f\xfa\xee\xdc\x7f\x78\x2c\xb4\x92\x11\x5f\x49\x82\x2b\xb9\xa8\x4c\xc7\xc3\x34\xa7\x97\x29\x2f\x13\xfa\x65\xc5\x74\x38\x1a\x66\x39\x7c\xcc\x5e\x49\xa9\xdc\x62\x41\x3f\x55\xa6\xdc\x55\xfe\x03\x13\xe9\xb6\x9f\x7d\x05\x00\x00")
 
 func templateDialectSqlGroupTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -654,12 +787,12 @@ func templateDialectSqlGroupTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/group.tmpl", size: 1031, mode: os.FileMode(420), modTime: time.Unix(1567330605, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/group.tmpl", size: 1405, mode: os.FileMode(420), modTime: time.Unix(1792022315, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x41\x6f\x32\x37\x10\x3d\xb3\xbf\xe2\x09\x71\x48\xa2\xc4\x9b\x70\x6b\x25\x0e\x11\x4d\x24\xd4\x10\xa5\x4d\x6e\x55\x55\x99\xf5\x2c\x58\x31\x36\xb1\x0d\x09\xda\xee\x7f\xaf\x6c\x2f\xcb\x42\x21\xad\xf4\xdd\xd6\x1e\xcf\x9b\x37\x6f\xde\x6c\x55\xe5\x57\xd9\xd8\xac\xb6\x56\xce\x17\x1e\xc3\xdb\xbb\x9f\x6e\x56\x96\x1c\x69\x8f\x47\x5e\xd0\xcc\x98\x77\x4c\x74\xc1\x70\xaf\x14\xe2\x23\x87\x10\xb7\x1b\x12\x2c\x7b\x5b\x48\x07\x67\xd6\xb6\x20\x14\x46\x10\xa4\x83\x92\x05\x69\x47\x02\x6b\x2d\xc8\xc2\x2f\x08\xf7\x2b\x5e\x2c\x08\x43\x76\xbb\x8b\xa2\x34\x6b\x2d\x32\xa9\x63\xfc\x69\x32\x7e\x78\x7e\x7d\x40\x29\x15\xa1\xb9\xb3\xc6\x78\x08\x69\xa9\xf0\xc6\x6e\x61\x4a\xf8\x4e\x31\x6f\x89\x58\x76\x95\xd7\x75\x96\x85\x1e\x50\x18\xed\x3c\xd7\xde\x41\x13\x09\x12\x28\x8d\x85\xfb\x50\x10\x92\x2b\x2a\xbc\x63\x88\xaf\xab\x0a\x82\x4a\xa9\x09\xfd\x26\x92\xbb\x0f\x95\x2f\xc9\xf3\xbc\xc5\xe8\xa3\xae\xb3\x5e\x9e\xe3\x8d\xcf\x14\x61\x61\x94\x70\x91\x94\x8f\x67\xcd\x97\x94\x08\x11\xaa\x0a\xca\x7c\x92\xc5\x80\x3d\x87\xeb\xba\xde\x35\x20\xb8\xe7\x33\xee\x88\x65\xbd\x04\x33\x42\xbf\xaa\x30\x60\xe9\x54\xd7\xfd\xac\x57\x55\x37\xb0\x5c\xcf\x09\x83\xbf\xae\x31\x20\xfc\x3c\xc2\x80\x3d\x88\x39\xb9\x48\x21\x70\x08\x39\x94\x92\xc6\x0d\xc1\x58\xa5\xcb\x28\x7c\xed\x59\xa6\x8c\x1d\x1d\x4b\x8a\x7b\x69\x74\x4e\x62\x1e\xc8\xc4\xa2\xb2\x0c\x4f\xa6\xc3\x69\x78\xf1\xb6\x20\xac\xac\x5c\x72\xbb\xc5\x3b\x6d\x21\xa8\x50\xdc\x92\xc0\x8c\x94\xf9\x64\x55\x05\xd2\x22\xf1\x39\x43\xa6\x69\x8d\xd8\xef\xa4\xba\xfd\xed\x6a\xd1\x47\xdb\x77\x48\xdf\xae\x1a\x0c\xfc\x0d\x6d\x7c\x82\xde\xf7\x3a\xd1\x1b\xb2\x8e\xbe\x6f\x39\x0e\x21\x0c\x79\xdf\x71\xc4\xdd\xb5\x4d\xda\x4b\xbf\x65\x0d\xf0\xc4\x83\xbe\xa4\xf3\x2e\x4d\x47\x3a\xac\x78\xf1\xce\xe7\xd1\x6e\xc6\x46\xa3\x1a\xf0\x8d\x91\x02\x85\xb4\xc5\x5a\x71\x0b\x41\x2b\xd2\x82\x74\xb1\xc5\xa7\xf4\x8b\x58\xa9\xdf\x29\xf5\xd2\x40\xd4\x75\x7f\x07\x17\xeb\x7d\xdf\xc5\xe8\x00\xe3\x58\xac\x8e\xd2\x51\xb9\x20\x4f\x3b\xa9\x03\x95\xc6\x46\xad\x97\xfa\xac\x3e\x45\x0c\x43\x90\x36\x5e\xea\xf9\xff\x31\x46\xef\x1c\xf0\xc1\x78\x53\xf8\x04\xe5\xce\xf7\xde\x32\x69\x3b\x37\xdc\xca\xc0\xea\x47\xb6\xb3\xc5\x68\xb7\x33\x31\x71\x8d\xf3\xb9\x52\x78\xfd\xed\xa9\x69\xdc\x81\xdb\x93\xdb\x59\x4a\x52\xc2\xb1\xac\xb7\xe1\xb6\x45\x18\xe1\x8f\x3f\x9d\xb7\x52\xcf\xab\xc6\xe4\x6c\xf2\x0b\xeb\x48\x70\xdd\xf4\xda\x59\xd6\x32\x2d\xeb\x63\xc4\x6b\x86\x13\x32\xcb\x53\x79\x3b\x8d\xea\x2c\xe8\x94\xec\x34\x60\xcf\xeb\x65\x3b\xd8\x40\xe7\x22\x61\xfc\xc7\x3f\xe1\xdf\x1b\x1c\x2f\xf7\xce\x78\xf9\xb5\x3b\x3c\xae\xc5\x39\xc7\x0c\xa3\x48\xc7\x9e\x71\x07\xa6\x69\xb1\xbb\x7f\x88\xc3\xbd\x3b\x36\x14\x2e\xa6\xc3\xe9\x25\x4b\x99\xa7\x28\x75\xe4\x0e\xc6\x92\x5a\xd0\xd7\xa1\xbd\x1c\x6e\x83\xc3\xae\x71\x36\x7e\x17\xe2\x7b\x39\xda\xbd\x39\x3c\x5d\x46\xb9\x8f\x5d\xf9\x4f\x00\x00\x00\xff\xff\x75\xd7\xa4\x71\xf6\x06\x00\x00")
+var _templateDialectSqlMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\xc1\x6e\xe3\x36\x14\x3c\x4b\x5f\x31\x30\x7c\x48\x16\x09\x9d\xcd\xad\x05\x7c\x58\x04\x09\x10\x74\x13\x6c\xbb\x41\x2f\x8b\x45\x41\x93\x4f\x36\x11\x9a\x74\x48\xca\x89\xa1\xea\xdf\x0b\x92\x92\x2c\x27\x71\xb0\x40\x6f\x96\x48\x3e\xce\xcc\x9b\x37\x72\xd3\xcc\x3e\x95\x57\x76\xb3\x73\x6a\xb9\x0a\xb8\xbc\xf8\xfc\xdb\xf9\xc6\x91\x27\x13\x70\xc3\x05\x2d\xac\x7d\xc4\xad\x11\x0c\x5f\xb4\x46\xda\xe4\x11\xd7\xdd\x96\x24\x2b\x1f\x56\xca\xc3\xdb\xda\x09\x82\xb0\x92\xa0\x3c\xb4\x12\x64\x3c\x49\xd4\x46\x92\x43\x58\x11\xbe\x6c\xb8\x58\x11\x2e\xd9\x45\xbf\x8a\xca\xd6\x46\x96\xca\xa4\xf5\xaf\xb7\x57\xd7\xf7\xdf\xaf\x51\x29\x4d\xe8\xde\x39\x6b\x03\xa4\x72\x24\x82\x75\x3b\xd8\x0a\x61\x74\x59\x70\x44\xac\xfc\x34\x6b\xdb\xb2\x8c\x1c\x20\xac\xf1\x81\x9b\xe0\x61\x88\x24\x49\x54\xd6\xc1\x3f\x69\x48\xc5\x35\x89\xe0\x19\xd2\xee\xa6\x81\xa4\x4a\x19\xc2\xa4\x5b\x99\xf9\x27\x3d\x5b\x53\xe0\xb3\xa1\xc6\x04\x6d\x5b\x16\xb3\x19\x1e\xf8\x42\x13\x56\x56\x4b\x9f\x40\x85\xf4\x6c\xf8\x9a\x32\x20\x42\xd3\x40\xdb\x67\x72\x98\xb2\xfb\xf8\xba\x6d\x7b\x02\x92\x07\xbe\xe0\x9e\x58\x59\xe4\x32\x73\x4c\x9a\x06\x53\x96\x9f\xda\x76\x52\x16\x4d\x73\x0e\xc7\xcd\x92\x30\xfd\xe7\x0c\x53\xc2\xef\x73\x4c\xd9\xb5\x5c\x92\x4f\x10\x22\x86\x78\x86\xf2\xa1\xab\x0e\x60\xba\x65\x8c\x28\xac\xc6\x28\xf3\x89\x1e\x8e\x23\xcd\x83\xb2\x66\x46\x72\x19\xc1\xa4\x4b\x55\x15\xb7\xdc\x5d\xde\xc5\x1d\x0f\x2b\xc2\xc6\xa9\x35\x77\x3b\x3c\xd2\x0e\x92\x84\xe6\x8e\x24\x16\xa4\xed\x33\x6b\x1a\x90\x91\x19\xcf\x11\x30\x1d\x35\x62\x7f\x91\x1e\xf3\xeb\xef\xa2\xa7\x81\xf7\x94\xd8\xc3\x6e\xd3\xd5\xc0\xbf\x30\x36\x56\x28\x8b\x11\xd7\x5b\xb3\x25\xe7\xe9\x63\xca\xa9\x09\xb1\xc9\x7b\xc6\xa9\x6e\x4f\x9b\x4c\x50\x61\xc7\xba\xc2\xb7\x01\xf4\xa2\x7c\xf0\xb9\x3b\xca\x63\xc3\xc5\x23\x5f\x26\xbb\x59\x97\x8c\x6a\xc1\xb7\x56\x49\x08\xe5\x44\xad\xb9\x83\xa4\x0d\x19\x49\x46\xec\xf0\xac\xc2\x2a\xe9\xdd\xf1\x4c\x57\x7d\xeb\x4a\xb4\xed\xa4\x2f\x97\xee\xfb\x98\xc5\xa0\xd5\x48\x86\xbd\x58\x23\xa5\x93\x72\x51\x9e\xa1\x53\x07\x2a\x5d\x59\x5d\xaf\xcd\x51\x7d\x44\x5a\x86\x24\x63\x83\x32\xcb\x5f\x31\x46\x71\xac\xf0\x41\x7b\xf3\xbd\xef\x40\x1e\xfd\xde\x5b\x26\x4f\xe7\x96\x3b\x15\x51\xfd\x9f\xe9\x1c\x6a\x0c\xd3\x99\x91\xf8\xce\xf9\x5c\x6b\x7c\xff\xf3\x2b\x44\xf7\x96\xbb\x77\xa7\xb3\x52\xa4\xa5\x67\x65\xb1\xe5\x6e\xa8\x30\xc7\x8f\x9f\x3e\x38\x65\x96\x4d\x59\xbc\x9e\xca\x2a\x4f\x65\xde\x7b\x93\x8e\x77\xbd\x88\x7a\x55\x6c\xa4\xd4\xd9\x2b\x49\xda\x32\x01\xfd\x9b\x6b\x25\x3b\xdd\x1c\x6d\xac\x8b\x3e\xcc\xf9\xd1\xb5\x29\xb9\x59\x79\x6c\xe3\x4e\x9c\x6c\xb8\x0b\x7d\xc2\x8c\xdb\xe9\x4f\x59\x59\x54\xb5\x11\xe3\x92\x27\x5d\x8d\x4c\xe0\x14\x0b\x6b\x35\x22\x8f\x38\x1d\x2a\x82\xcf\x11\xd3\x93\x8d\x4b\x85\xaa\xfa\xab\xe7\xf3\x7e\xe5\x87\xfa\x99\xce\x15\x85\xa3\x50\x3b\x83\xe0\x6a\x8a\xcf\x91\x6c\x5b\x0e\xaf\x2b\xae\x3d\x65\x72\x9d\x4b\xa7\xec\x86\x78\xa8\x1d\x5d\x9b\x88\x56\x62\x42\x26\x88\x18\xfa\x93\x21\xc7\xd2\x38\xf7\xcd\x1a\x88\xc5\x9f\x3c\x60\xcd\x77\x58\x10\xc4\x2a\x22\x95\x58\xec\x92\x38\xeb\x3a\x24\x87\xfa\xe3\x69\xcb\xf0\x30\xde\x98\xaf\x52\x26\xe9\xc8\x03\xc1\x1a\x9d\x6b\x3d\xd5\xe4\x76\xf1\xd3\x55\xeb\xd0\xd7\xf3\x87\x20\x9e\xc9\x11\x12\xea\x01\x41\x4f\x03\xd2\xa9\x2d\xb9\x38\x25\xd1\x37\x1d\x97\x43\xdb\xbc\xf6\x4d\xc8\xbe\xb9\x8b\x24\x48\x76\x47\x92\x1a\x45\x91\xe6\x29\xce\xd6\xe4\xac\x2c\x0e\x3d\x53\x1c\x8e\x52\x7c\xc8\xf1\x33\x65\xf7\xf5\x7a\x08\x82\x08\xe3\xe4\x9d\x5b\xdf\x7e\x43\xde\x26\x7e\x3c\x36\x4a\x92\x6f\x7f\x8c\x87\x9d\x1b\x89\x23\x41\x70\x99\x86\xea\x8d\x29\x0f\x42\x66\xa8\x3d\xfe\xa2\x1c\xe6\xf4\xeb\x00\xc2\xc9\xdd\xe5\x5d\x74\x76\x31\x64\xd0\x21\xa4\x91\xce\x51\x38\x65\x24\xbd\x1c\xc6\x91\xc7\x45\x12\x13\x47\xd7\x3f\xc7\xf5\xbd\x1c\x83\xda\x87\x4f\xa7\x49\xee\x23\x29\xa6\xd5\x5a\x85\x64\x8b\x64\x92\xbd\xcd\x94\x35\xbd\x43\x7b\x27\xc2\xc7\xae\xaf\x29\xfe\x23\x09\x76\xec\xf7\x6e\x63\x88\xe9\xff\x61\xea\xed\x5d\xec\x27\x43\xc0\xfe\xca\xb0\x89\xf0\x82\x39\xfa\x97\xec\x76\x5f\xe7\x44\x84\x97\xb3\xd4\xdd\xd1\xc7\x2b\x7f\x83\x3c\x63\xec\xf4\x55\x88\x9f\x83\x8c\x44\xdb\x96\xff\x0d\x00\x89\x5b\x3c\xef\x27\x0a\x00\x00")
 
 func templateDialectSqlMetaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -674,12 +807,12 @@ func templateDialectSqlMetaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/meta.tmpl", size: 1782, mode: os.FileMode(420), modTime: time.Unix(1567330610, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/meta.tmpl", size: 2599, mode: os.FileMode(420), modTime: time.Unix(1792003388, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\x41\x8b\xdb\x30\x14\x84\xcf\xd6\xaf\x98\xe6\x64\x07\x57\x4e\x73\x6b\x21\x87\x90\xa6\x10\x28\xe9\x21\xfd\x03\xae\xf4\x5c\x8b\xd5\x3e\x29\x4f\xb2\x97\x60\xfc\xdf\x17\x9b\x0d\xec\xf1\xcd\xcc\x9b\x6f\xa6\xa9\xd9\xaa\x53\x88\x0f\x71\xff\xfb\x8c\xfd\xee\xdb\xf7\xaf\x51\x28\x11\x67\xfc\x6a\x0d\xfd\x0b\xe1\x05\x17\x36\x1a\x47\xef\xb1\x86\x12\x16\x5f\x46\xb2\x5a\xfd\xed\x5d\x42\x0a\x83\x18\x82\x09\x96\xe0\x12\xbc\x33\xc4\x89\x2c\x06\xb6\x24\xc8\x3d\xe1\x18\x5b\xd3\x13\xf6\x7a\xf7\x74\xd1\x85\x81\xad\x72\xbc\xfa\xbf\x2f\xa7\xf3\xf5\x76\x46\xe7\x3c\xe1\x43\x93\x10\x32\xac\x13\x32\x39\xc8\x03\xa1\x43\xfe\x04\xcb\x42\xa4\xd5\xb6\x99\x67\xa5\xa6\x09\x96\x3a\xc7\x84\x8d\x75\xad\x27\x93\x9b\x74\xf7\x8d\xf1\x8e\x38\x37\x21\x12\x6f\x30\xcf\xaa\xb0\x32\xd6\x20\x11\xfc\x38\x20\xdd\xbd\xfe\x13\x89\x4b\x2b\x6e\x24\xb9\xb6\xaf\x54\xc3\xb6\xb9\xbd\xad\xfd\xcb\x5d\xa9\xc2\x75\x6b\xfe\xcb\x01\xec\x3c\x26\x55\x14\x42\x79\x10\x5e\xce\xb5\x4a\x15\xb3\x7a\x6a\x57\x7a\x3b\xad\xc8\xb2\x8d\x91\xd8\x96\x21\x66\x17\x38\xd5\xf8\xb9\x32\x4a\x2b\x63\x55\x69\xad\xab\x7a\xf9\x5f\x66\x13\xdb\x65\xd9\x7b\x00\x00\x00\xff\xff\x01\xec\x54\x15\x85\x01\x00\x00")
+var _templateDialectSqlOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x52\x4b\x6f\xdb\x30\x0c\x3e\xc7\xbf\x82\x33\xba\xc2\x2e\x5c\x25\xed\x6d\x19\x72\x28\xdc\x0c\x2b\x50\x74\x8f\x14\xbb\x14\xc5\xa0\xda\xb4\x23\xc4\x91\x3c\x5a\xf1\x1a\x18\xfe\xef\xa3\xe4\x24\x0d\xf6\xc4\x2e\x36\xc8\x8f\xfa\x1e\xa2\xba\x6e\x7c\x16\xa4\xa6\xde\x92\x2a\x97\x16\x2e\x27\x17\x6f\xce\x6b\xc2\x06\xb5\x85\x77\x32\xc3\x27\x63\x56\x70\xa3\x33\x01\x57\x55\x05\x7e\xa8\x01\x87\x53\x8b\xb9\x08\xee\x97\xaa\x81\xc6\x6c\x28\x43\xc8\x4c\x8e\xc0\x65\xa5\x32\xd4\x0d\xe6\xb0\xd1\x39\x12\xd8\x25\xc2\x55\x2d\x33\xfe\x5d\x8a\xc9\x1e\x85\xc2\x30\x1c\x28\xed\xf1\xdb\x9b\x74\x7e\xb7\x98\x43\xa1\x2a\xa6\x18\x7a\x64\x8c\x85\x5c\x11\x66\xd6\xd0\x16\x4c\xc1\xdd\x17\x31\x4b\x88\x22\x38\x1b\xf7\x7d\x10\x74\x1d\xe4\x58\x28\x8d\x10\xe6\x4a\x56\x7c\x60\xdc\x7c\xab\xc6\x59\xa5\x38\xc5\xd8\xd4\xa8\x43\xe0\xb9\x51\x4e\x6d\x02\x48\x04\xd3\x19\xf0\x80\xf8\xc0\x48\x94\x93\x6a\x91\xee\xe4\x1a\x13\xc8\xa5\x95\x0b\xcf\xef\xea\x38\x18\xa9\xc2\xcf\xbf\x9a\x81\x56\x15\x74\xc1\x68\x44\x68\x37\xa4\x5d\xe9\xa9\x82\x11\xf3\xee\x7a\x4e\x28\x92\x35\x7f\xf3\xc8\xd4\x56\x19\xdd\x24\x70\xed\xe9\x59\xa5\x8d\x63\x21\x44\xec\xcc\xf2\x00\xfc\xdb\x77\xad\x74\x39\xf8\x26\xf3\xbd\x71\x9e\x4f\x9d\xe9\xcf\x5c\x74\xfd\xc1\x1a\xb7\x33\x31\x64\x10\x9f\x36\x48\xdb\x28\xb3\xcf\x09\x84\x8b\xf9\xed\x3c\xbd\x87\x8b\x30\x81\x87\x47\xa5\x2d\x52\xc1\xdb\xec\xfa\xae\x4f\xc0\xf1\xc5\x6f\xff\x90\xec\xa7\x50\x6e\x56\xa4\x95\x69\x30\xfa\x0f\xef\xbc\xec\x6c\x35\x98\xef\xba\x73\x38\xa9\x57\xa5\x73\xfa\x24\x79\xf1\x27\x22\x35\xba\x50\xa5\xf8\x28\xb3\x95\x2c\xd1\x4f\xb1\xfd\x66\xb8\xb0\x43\xa8\x05\x93\xac\xa5\xf8\xb2\x43\x5c\xae\xbf\x6d\xa4\x58\x5b\x31\x27\x32\x54\x44\x21\x9b\xf3\x92\x7d\x3f\xe5\xb7\x2a\x73\xbe\x49\x68\x3c\x1d\xec\x85\xa6\xf0\xba\x0d\xbd\x5a\xec\xe3\x32\x6f\xc5\xeb\xdb\xc3\x31\xcc\x66\x30\x39\xe6\x3f\x65\xf2\xc1\xd2\xce\x51\x97\xfa\xac\x53\x58\xab\x92\xa4\x45\xf1\x5e\x36\xcb\xde\x93\x15\x86\xe0\x6b\x02\xad\x0b\x42\x52\x73\xc6\x3d\xaf\x67\x64\xad\xd6\xf1\x1f\x1f\xf4\xc0\xd1\xeb\xe2\xaa\x3f\xde\xc3\xaf\xf2\xd7\xfc\x58\xdd\x85\x4e\x0f\xe4\x0f\x93\xc7\x04\x7e\xef\xea\x65\x73\x3f\x00\x4a\xbd\x01\x9d\xf1\x03\x00\x00")
 
 func templateDialectSqlOpenTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -694,12 +827,12 @@ func templateDialectSqlOpenTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/open.tmpl", size: 389, mode: os.FileMode(420), modTime: time.Unix(1570008718, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/open.tmpl", size: 1009, mode: os.FileMode(420), modTime: time.Unix(1792027372, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xd1\x4f\xe3\xb8\x13\x7e\x4e\xff\x8a\x11\xaa\xf4\x4b\x50\x71\xa1\xbb\x3c\xfc\x4e\xe2\x24\xd4\x03\xa9\x77\x0b\xbd\x53\x57\x77\x0f\x08\xad\xbc\xf1\xa4\xf5\xae\xb1\x83\xed\x16\xa1\x6c\xfe\xf7\x93\x1d\x37\x4d\x4a\x0a\x3d\x96\xd5\xbd\xdc\x13\x49\x66\x3c\xf3\xcd\x37\xdf\x4c\x4d\x51\x0c\x0f\x7b\x63\x95\x3f\x6a\x3e\x5f\x58\x18\x1d\x9f\xfc\xff\x28\xd7\x68\x50\x5a\xb8\xa4\x29\x7e\x56\xea\x2b\x4c\x64\x4a\xe0\x5c\x08\xf0\x4e\x06\x9c\x5d\xaf\x90\x91\xde\xc7\x05\x37\x60\xd4\x52\xa7\x08\xa9\x62\x08\xdc\x80\xe0\x29\x4a\x83\x0c\x96\x92\xa1\x06\xbb\x40\x38\xcf\x69\xba\x40\x18\x91\xe3\xb5\x15\x32\xb5\x94\xac\xc7\xa5\xb7\x7f\x98\x8c\x2f\xae\x67\x17\x90\x71\x81\x10\xbe\x69\xa5\x2c\x30\xae\x31\xb5\x4a\x3f\x82\xca\xc0\x36\x92\x59\x8d\x48\x7a\x87\xc3\xb2\xec\xf5\x8a\x02\x18\x66\x5c\x22\x1c\x30\x4e\x05\xa6\x76\x68\xee\xc5\x30\xd7\xc8\x78\x4a\x2d\x0e\x39\x3b\x80\xa3\xb2\xec\x45\xd9\x52\xa6\xb1\x81\x43\x73\x2f\xc8\x0c\x85\x0f\x9d\x40\xd1\x8b\xa2\xa2\x38\x02\x9e\x41\x9f\x4c\x7e\x21\x13\x33\xb3\x9a\xcb\x39\x94\x25\x67\x03\xf8\x04\x3f\x9d\x81\xb1\x3a\x55\x72\x45\xce\xad\xe2\x31\x67\x89\xf3\x47\xc9\xc0\x45\x8d\x0c\xf9\x6b\x81\x1a\x63\x17\xf6\xe2\x8f\xd8\x90\x71\x5c\x14\x55\xac\xb1\x92\xc6\x52\x69\xa1\x2c\x93\x01\x70\x96\x24\xbd\xa8\xec\x35\x4e\xef\x83\x7e\xa8\x72\x13\x2a\x70\x27\xfb\x2a\x77\x90\xfa\x64\x96\xaa\x1c\xc9\x34\x6f\x98\xa8\x9e\x37\x6d\xe7\x7a\xde\x30\x1a\xab\x34\x9d\x63\xd3\x61\x16\x3e\xed\x49\x8f\xca\xc9\x9f\x54\x73\xca\x78\x5a\x95\x1e\x0d\x87\xce\x20\x95\x05\xaa\xe7\xcb\x3b\x94\xd6\xc0\x03\x6a\x84\x5c\xab\x15\x67\xc8\x06\x40\xf3\xdc\x15\xeb\x9a\x7a\x79\xfe\x61\x76\x01\x69\x20\xc5\x0c\x42\x04\xc3\x65\x8a\xf0\x80\x90\x52\xf9\x3f\xeb\x0e\x88\x47\x38\x98\x5c\x43\x9c\x1c\x10\xf0\x22\x7b\xe0\x42\xc0\x1d\xfd\x8a\x95\x0c\x6a\x7a\x20\xa3\xc2\x3c\x12\x17\x88\x67\x20\x50\x7a\xea\x1d\x0d\x65\x99\xc0\xd9\x19\x1c\xfb\x02\xda\x4d\xba\xa4\xc2\x60\xec\x7a\x11\x45\x91\x46\xbb\xd4\xd2\x3d\xfa\x82\x56\x8e\x1e\x97\x28\xbe\xb9\xe5\xd2\xa2\xce\x68\x8a\x45\x39\xd8\x8e\xed\x0f\x67\x4a\x03\x77\x07\x34\x95\x73\x84\x55\xc8\x55\x14\x5d\x62\x5a\xdd\xf0\x5b\x27\xa7\x2d\x35\x6d\x62\xde\xf0\xdb\xa4\x28\x00\x85\xc1\xe0\x0e\x67\xd0\x32\x3b\xeb\x5a\x75\x1e\xae\x57\x92\xf3\xef\xc8\xe7\x39\xe9\x14\x70\xa3\x8c\x75\x8c\x2e\x2d\x17\x05\xa4\x54\x88\x5a\x38\x64\x9a\x8f\xdd\x90\x3b\x01\x96\xe5\x33\x3a\x0f\xf5\xb7\xd5\xb2\x22\x84\x6c\xaa\xe3\xac\xae\xe5\x15\x33\x91\x71\x14\xac\x39\x12\x59\x53\xd4\x97\xce\xfa\x92\xa4\x77\x0c\x6d\xb6\x55\xca\xea\xb5\xe8\xb6\x87\x76\x17\xc2\xff\x26\xfa\x07\x4f\xf4\xf7\xca\xbb\xad\x88\x4a\xda\x8e\x1d\x47\xdd\x35\x17\x81\xb9\x01\xac\x3a\x55\x1f\x44\xef\xf3\x7f\x8f\xe2\x91\xcd\x71\xb8\xa0\x2d\x49\xb5\xfa\x7e\xc1\xf6\x6f\x3a\x92\xab\xd1\x15\x84\x7e\xdb\x13\xbf\x1f\xc8\x47\xfa\x59\x60\xec\x19\x5c\xb3\x54\xd1\x7c\x2f\xc8\x44\x56\xcf\x91\x3d\xd9\x35\xf4\x95\x7d\x93\xd3\x7b\x21\xf9\xfd\xb7\x86\xd7\x4d\xa0\x08\xc9\xc4\x4c\xe4\x0a\xb5\x5f\x05\x27\x9b\xad\x70\x5c\x53\x74\x9b\x90\x4b\xad\xee\x7c\xa3\x2a\x64\x55\x3c\xff\xdc\x4c\x1c\x32\x57\x7f\x92\xad\x95\xa8\x74\x55\xec\x14\x62\x2a\x99\x7b\x9e\x8e\xa6\xad\xfc\x89\xef\xcb\xf0\x10\x9c\xd3\xb7\x6f\x10\x3b\x87\x07\x6e\x17\xc0\x03\x40\xc7\x7c\x02\xfe\xaa\xf1\x3c\x5b\x0e\xea\xb5\xb2\xd7\x4b\x21\xe2\x9a\x27\x24\x63\x25\x96\x77\xb2\x05\xb9\x05\x33\xe4\x9f\x8e\xae\xda\xf9\xa9\x31\x2a\xdd\x3f\xfb\x1b\xf4\xea\x29\x52\x52\x79\x46\x7b\xb6\x62\xed\xfe\x94\x8f\x9d\x54\x74\x76\x2f\x4c\xeb\x2b\x47\xc4\xb1\xf7\xf6\x63\xe2\x2a\xf0\x3f\xf5\x27\xd5\x24\xf7\xbf\xb8\x97\x63\xff\x72\xd4\xa1\xea\xca\x7f\xed\xe1\xdc\xeb\xa3\xae\xa2\xa3\x9d\x0d\xb5\x23\xff\xa9\x26\x3b\xdc\x28\x7c\x0e\x89\xd0\xaf\x3e\xfb\x06\x3c\xe6\xa1\x0b\xeb\x70\x15\x4c\x24\x01\xc6\x76\x87\xea\x50\x5e\x78\xf5\x19\xef\xb6\xb1\x6d\xd0\x55\x78\xde\xb5\xf1\xec\x68\xbe\x77\x7d\xbf\x76\x0d\xba\xb2\xef\xea\x31\x78\xb2\x08\xfa\x5f\xfc\x98\x07\xd1\x78\x85\xd9\x77\xe1\xed\x57\xc5\x65\x6c\x47\xe1\x6d\x2a\x9f\x0f\xc4\x7d\xa0\x01\xd8\x51\xed\xe4\xa9\xd9\x92\x7d\x05\xf1\x74\x0b\x62\xd8\x33\x76\x54\x5f\xe8\x3e\x0d\x20\xdf\xdc\xe9\x9c\xbe\x4c\xf8\xc5\xc9\x63\x7b\x9a\xd4\x37\x45\xfb\xde\x1f\x5d\x97\x7a\xfa\x64\x19\x4c\x64\xbc\x7b\x06\xc1\xbe\x4f\xfe\x95\x75\xb5\xd1\x57\x6b\xf8\xbb\x18\xdb\x5e\xc1\x3f\x5e\x8a\xdd\xe2\xea\xd4\xe6\x7e\xfd\x1a\x6d\xfa\xb5\xab\x35\x5d\x7b\xc9\x89\xe9\x4d\xd7\xf4\x0e\xd6\x3b\x56\xee\x9e\xbb\xf6\xad\xaa\xef\x10\x66\xa3\xf2\xd7\x6d\x62\x2a\x5f\xfe\x8f\xbb\x1b\xbb\x3f\x1f\x0a\xc8\x63\xe3\x70\xfc\xf3\xf4\x4a\xef\x95\x9d\x3f\x9b\x9d\x67\xc0\xe1\xe7\xc6\x65\x73\xaa\xe3\x0d\x9b\xaf\xc6\x26\x95\x7d\x11\x5c\x1e\x1b\xf7\xc3\x19\x3f\xb9\x26\xfe\x1d\x00\x00\xff\xff\xa5\xf9\x0b\x05\xae\x11\x00\x00")
+var _templateDialectSqlPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x59\xdb\x6e\x1b\x37\x10\x7d\x96\xbe\x82\x15\x0c\x74\x37\x90\x29\x5f\x92\x87\x16\x70\x01\xd5\xb1\x51\xb5\x89\x95\xc4\x69\xf2\x10\x04\xc1\x7a\x97\x92\xd9\xae\xc9\x0d\x49\x49\x31\x14\xfd\x7b\x67\x48\xee\x4d\xda\xb5\xe5\x4b\x10\xa0\x48\x1e\x62\x69\x39\x1c\xce\x9c\x39\x67\x48\xae\x96\xcb\xc1\x93\xee\xb1\xcc\xae\x15\x9f\x5e\x1a\x72\xb0\xb7\xff\xcb\x6e\xa6\x98\x66\xc2\x90\xd3\x28\x66\x17\x52\xfe\x4b\x46\x22\xa6\x64\x98\xa6\xc4\x1a\x69\x82\xe3\x6a\xce\x12\xda\x7d\x7b\xc9\x35\xd1\x72\xa6\x62\x46\x62\x99\x30\x02\x5f\x53\x1e\x33\xa1\x59\x42\x66\x22\x61\x8a\x98\x4b\x46\x86\x59\x14\xc3\x9f\x03\xba\x97\x8f\x92\x89\x84\xe1\x2e\x17\x76\xfc\xc5\xe8\xf8\xe4\xec\xfc\x84\x4c\x78\x0a\x2e\xdc\x33\x25\xa5\x21\x09\x57\x2c\x36\x52\x5d\x13\x39\x81\xa7\xe5\x62\x46\x31\x46\xbb\x4f\x06\xab\x55\xb7\xbb\x5c\x92\x84\x4d\xb8\x60\xa4\x97\xf0\x28\x85\x09\x03\xfd\x39\x1d\x40\x1a\x09\x8f\x23\xc3\x06\x3c\xe9\x91\x5d\xb0\xec\x4c\x66\x22\x0e\x34\x79\x02\xc3\xf4\x9c\xa5\xd6\x75\x48\x96\xdd\x4e\x67\xb9\xdc\x25\x7c\x42\x76\xe8\xe8\x39\x1d\xe9\x73\xa3\xb8\x98\x92\xd5\x8a\x27\x7d\xf2\x89\xfc\x7a\x44\xb4\x51\xb1\x14\x73\x3a\x34\x92\x07\x3c\x09\xd1\x9e\x89\x84\xa0\xd7\x8e\xa6\xef\x2f\x99\x62\x01\xba\x3d\x79\x1d\x68\x7a\x1c\x40\x4c\xd6\xd7\xb1\x14\xda\x44\x00\xe6\x6a\x15\xf6\x09\x4c\x0c\xbb\x9d\x55\xb7\x32\x7b\x9b\xe8\x07\x32\xd3\x3e\x03\x9c\xb9\x23\x33\x0c\x69\x87\x9e\xc7\x32\x63\x74\x9c\x55\x86\x22\x35\xad\x8e\x0d\xe1\x6b\x39\xa8\x21\xdd\x68\xca\xaa\x06\xe7\xfe\xd1\x96\xf0\xc8\x8c\xbe\x8b\x14\x8f\x20\x34\x97\x7a\x67\x30\xc0\x01\x01\xb5\x82\xa5\x67\x57\xc0\x1b\x4d\x16\x00\x06\xc9\x94\x9c\xf3\x84\x01\x80\x51\x96\x61\xb2\x58\xd4\xd3\xe1\x0b\x28\x73\xec\x41\xd1\x7d\xef\x41\x73\x01\x35\x5d\x00\x87\x22\xf1\xb3\xc1\x09\xe9\x35\xe9\x8d\xce\x48\x10\xf6\x28\xb1\x24\x5b\x70\xe0\xdf\x55\xf4\x2f\x73\x34\x28\xe0\x21\x93\x28\xd5\xd7\x14\x1d\x41\x1c\x29\x13\x16\x7a\x84\x01\x10\x27\x47\x47\x64\xcf\x26\x50\x2f\xd2\x29\xcc\x61\x01\xd6\x02\xfe\x29\x66\x66\x4a\xe0\x47\x9b\xd0\x1c\xe1\xc1\x85\x82\x0f\x1f\xb9\x30\x4c\x4d\x40\x06\xcb\x55\x7f\xdd\xb7\x9d\x3c\x91\x8a\x70\x9c\xa0\x22\x01\x28\xce\xfd\x5a\x60\xd6\x40\xa6\xf9\x07\xfe\x11\xe9\xb4\xc6\xa6\xd2\x27\x8c\x03\xb1\x08\x83\xe0\xbc\x39\xd8\xd6\x86\x71\x34\x67\x9d\x0d\xd7\x32\x09\xed\x1b\xd6\xb3\x98\x34\x12\xb8\x92\x46\xee\xa3\x89\xcb\x60\x16\x47\x00\x7b\x4e\x1c\xa0\xda\x31\x8a\x1c\x09\xb8\x5a\xdd\xc0\x73\x9f\x7f\x9d\x2d\x73\x4a\x69\x99\x1d\x4f\x8a\x5c\xee\xa1\x89\x09\x67\x69\x52\x95\xc4\xa4\x4a\xea\x53\x1c\xbd\x8d\xd2\x2d\xa2\x9d\x6c\xa6\x02\xcf\xce\x5f\xbf\x78\x17\xa5\x33\x88\x67\xde\x7b\x40\xc4\xeb\x42\x6e\x8b\xfa\x87\xca\xbf\xb5\xca\xf3\x54\x27\xf4\x8f\x48\x7b\x78\x5c\x85\x5d\xc2\x77\x6b\x03\x6d\x7d\xa0\x53\xd1\x70\x85\x44\x41\x06\x0a\x35\xce\x45\x0f\x2c\x7a\x61\xbe\x6a\x11\x5b\xa1\xc7\x87\x8a\xb3\xce\x67\x27\x4c\xac\x23\x16\xf9\x8c\xa7\xbe\xc6\x5b\x49\xb6\x51\x0a\x85\x8a\x1f\x2c\xe7\xc1\x05\x33\x0b\xc6\xc4\xe3\xc9\xfa\x77\xe7\xb0\x55\xdb\x51\x9f\x5c\xdc\x23\x5a\x33\xcb\x52\xd8\x96\x6b\x81\xf2\xe4\x4b\x35\xd4\x11\x1c\x7d\xbe\xdc\x16\xea\x83\x45\xf5\x58\x9a\xf2\x92\x9a\xeb\xaa\x94\xda\x94\x54\x08\x69\xe5\x22\x50\x72\xb1\x3b\xb7\x84\x48\xb9\x86\x0c\x22\x48\x40\xcf\xb2\x4c\x2a\x03\x67\x40\x29\x20\x9e\x8b\x6b\xf2\xf2\x1a\x78\x03\xf9\xf8\x64\x2c\x84\xda\x39\xc0\x09\x53\x25\x67\x19\x98\x2f\xb8\xb9\xb4\x06\xe3\x37\x04\x70\x54\x11\x80\x45\x50\x5c\xf6\x44\xc8\xb4\x71\xe7\x40\x46\x7c\x65\xb4\x0f\x5f\xd3\xe7\xee\x41\x10\x92\x9f\x8e\xf2\x51\x6a\x57\x75\xe9\x60\xda\xba\xa2\x69\x5b\x8f\x57\x39\x16\xfd\x1c\x80\xc6\x5d\x5d\x7b\x39\x5b\x1f\x4e\xd1\x38\x7b\x28\x92\xc0\xc9\x1c\x29\xe0\x6c\x77\x3e\xf5\x73\xd6\x02\x25\x1c\x65\x75\xae\x6e\x00\xf5\xd6\xcd\xc6\xb5\x86\xde\x1c\xd7\xa1\x3d\x68\x15\x91\x06\x99\xa3\xe9\x59\x74\xc5\x42\xf2\xb5\xa6\x41\x9c\x55\x86\x50\x1e\x0d\x3a\x61\xd1\xe9\xaa\x75\x1c\xab\xc0\xe6\x00\xb2\x6e\x28\xa6\x2b\x4a\x05\xa3\x86\xce\xe7\x21\x6a\x41\xc8\x79\x70\x08\xd5\x26\x2f\x49\x05\x22\xde\x0c\x91\xef\x40\x3c\x6f\x47\x45\x5b\xb9\x2b\x24\x7e\x2e\xa8\x8f\xac\x7c\x6a\x55\x10\x46\xe2\x2d\x86\x09\xf9\x69\x7b\x52\xba\x7f\x6c\xad\x3d\xb6\x58\xbe\xef\x99\xee\x01\xbf\x63\xa3\x61\xc9\x94\x0d\x2e\xa3\xda\x91\xa1\xb6\xaf\x9f\x24\xdb\x6f\xea\x8c\xbe\x3c\x78\x49\x3c\x3f\xcc\xbe\x3d\x13\xd2\xb7\xd1\x05\x20\x11\x56\x79\xd2\xcd\x79\x3a\x12\x9e\xdd\x66\xbf\xed\xa0\xd7\x2d\x48\xed\xd6\xb4\x56\x8c\xbe\xfa\xab\x62\xf5\xc1\x63\x07\x4d\x51\x8f\xc4\x9c\x29\xbb\x97\xec\x97\xdb\xca\x5e\x81\xe7\xc7\x90\x9e\x2a\x79\x65\xab\xe4\x22\x73\xfe\xec\xe7\xea\xc2\x7e\x65\xf7\x27\x5c\x3b\x06\x03\x35\x6d\xb2\x63\x12\x60\xbb\x81\xcf\x63\xf8\x5c\x5d\x3f\xb4\x15\x1d\x3c\x21\x68\xf4\xf5\x2b\x09\xd0\xc0\xb6\x1e\xee\x03\x44\xe4\x43\x62\xaf\x97\x37\xa3\x85\xa1\x9e\x49\x73\x36\x4b\xd3\xa0\xc0\x89\x01\x4a\xe9\xec\x4a\xd4\x42\xae\x85\xe9\xd7\x1f\x43\x45\x6a\xeb\x47\x5a\xcb\x78\xfb\xd5\x1f\xa1\x56\x9b\x91\x52\xdf\xab\xb6\x2c\x45\x6e\xbe\x89\x47\x2b\x14\x8d\xd5\xf3\xbd\xeb\x9e\x12\x11\xf2\x41\x22\xd9\xc4\xb8\x51\x36\x1b\xb8\x43\xaa\x3f\x64\x92\x13\xb5\xd6\x64\xf5\xb7\x94\xc4\xe3\xd6\xe1\x7f\x22\x01\x44\xeb\xf1\x77\x0a\xcc\xc0\xee\xf4\xfb\xfe\x06\xf0\x0f\x7e\xd9\xb3\x5f\x76\x1b\x18\xeb\xec\x73\x0b\x34\x2f\xa6\xfa\x5d\xb1\xa5\xa7\x99\x03\xfb\xa8\x00\xbb\xdb\x29\xaf\x69\x90\xfc\x8e\x7b\x6c\x0b\x70\x9d\xf9\x2a\xe4\xee\x5c\x98\x78\xec\xb6\x61\xac\x57\xa8\x70\x65\x89\x56\xcc\xb1\x66\xdd\xea\xe1\xc9\x0f\xb9\x78\x0e\xeb\xf1\xb4\x14\xdf\x9a\x3e\xcd\x4d\x3d\xaf\xcc\x61\x41\xfb\x0d\x91\x03\x22\x28\x61\x4f\x1a\xcb\x30\x73\xe8\xbf\xfd\x29\xb9\x08\xcc\x81\xff\x36\x16\x37\x3b\xe2\xd6\x11\x1c\x31\x0e\x0a\x23\x0b\xcd\x1a\xed\x5d\x88\xcf\xd6\x42\xf4\x3d\x04\x17\x7b\x0f\xc4\x81\x09\x86\x7d\x31\x78\x32\xf5\x9f\xca\xa3\x30\x1c\x69\xb3\xf2\xac\xe7\x0e\xd2\xfe\x40\x1c\x98\x67\xd5\x93\xe6\x30\x49\x4e\x94\x92\x0a\x1e\x53\xf8\xe0\x7d\x98\xa7\x76\xad\x1c\x9b\x67\x1b\x1b\x28\x48\xb7\x5d\xb4\xc4\x3c\x0d\xbf\xcb\x16\x5f\x12\xb2\xd6\x2d\x9a\x20\x5e\xef\xc7\xdf\x9e\xbb\xcd\x6c\x6c\x24\xf3\x03\x0b\x7c\xd0\x5c\xe0\x83\x4a\x81\xdb\x6a\xd9\xd4\xf9\x90\xae\x8f\x7a\x16\x6a\x29\x53\x43\x53\xdf\xb6\x9b\x7f\x2f\xb8\x1a\xa8\x5f\x81\xaa\x65\x73\x00\xe4\x7c\xef\x57\x2c\x9e\x29\xcd\xe7\xf6\x47\x98\x29\x13\x78\x7b\xce\x6f\xe0\x18\x36\x22\xa9\xe1\xf2\x1c\x19\xb8\x49\x4f\x20\x00\x7c\x73\x00\x77\x69\xae\x88\x5c\x08\x62\x80\x99\x24\x60\x74\x4a\xed\x8f\x2b\xf6\xaa\x8e\x37\x7a\x7c\x73\x3c\x8b\xe1\x9a\xc8\x74\x48\x5d\x21\xee\xb4\x25\x15\x61\x6d\xb1\x37\xb9\xf7\x97\x2a\xb6\xa3\x0d\x35\x44\x94\x12\x08\xc9\x4e\xae\xa2\x55\xcc\xf6\x2f\x54\x82\x2d\x3a\x44\xe8\x1d\xe2\x7a\x47\x1b\xe8\xe7\x4b\xb5\x06\x52\x48\xec\xa6\x53\x25\x96\xb0\xc6\xd8\x7e\x65\x47\xb1\xff\x63\x7d\xcb\xad\xa6\x42\xe1\xdb\x7a\x8c\x9b\x7d\x23\x5b\x6f\x24\x6b\x86\xdb\x4e\x71\x3d\x2e\x99\x7a\x58\x32\x75\x91\x87\x85\x6b\xbc\xc9\xeb\x18\xf4\x9c\x72\xf0\xf2\x0d\x81\x7d\x2a\x0b\xec\xde\x96\xaf\x07\x8e\x8e\xe8\x50\xdb\xa6\x58\xdd\x23\xf3\x5d\x0b\x51\xb6\xba\x2b\x37\xa4\xf2\x70\xe7\x6b\xca\x3e\x3b\xbb\x75\xe7\xb9\x84\x72\x5f\x58\x4b\xa7\x9c\xc3\xca\x2f\x29\xf9\xcb\x9a\x9b\xac\x5d\x61\x8a\xab\xdc\x7a\x70\x61\xfd\xfc\xe2\x76\xe8\xbf\x05\x97\x22\xd8\x2e\x27\xbb\xb5\x2f\x42\x3a\x6e\x5a\x7f\xd1\xd2\x01\xc2\xf5\x8d\xa4\x09\x83\xa6\x9b\xe8\x6d\xe1\xe3\xb9\x33\xec\xde\xa5\x15\x55\xb2\x6c\x8d\xd6\x65\xbb\x70\x9c\x84\xbf\xf7\x78\xe5\x01\x62\xbd\xf5\xb7\xda\x66\x5a\xdb\xf9\x05\xb7\xb5\xa7\xf6\x1d\x97\x97\x6a\xab\xd5\xf9\x8d\xab\x43\xad\x38\xf9\xad\xf2\x93\xc4\x58\x05\xe5\xae\x70\xef\xd8\xa0\xaf\xdd\x1a\x1c\x38\x47\x12\x04\x1b\xc8\xff\x07\x37\x83\x27\x71\xe8\x1f\x00\x00")
 
 func templateDialectSqlPredicateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -714,12 +847,12 @@ func templateDialectSqlPredicateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/predicate.tmpl", size: 4526, mode: os.FileMode(420), modTime: time.Unix(1567330614, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/predicate.tmpl", size: 8168, mode: os.FileMode(420), modTime: time.Unix(1792022315, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\xdf\x6f\xd3\xc8\x13\x7f\x8e\xff\x8a\xa1\xea\x17\xd9\x51\xea\xb4\x29\x2f\xdf\x56\x3d\x89\x6b\x8b\x94\x3b\x4a\x81\x22\xf1\x80\xd0\x69\x6b\x8f\x93\xa5\x9b\xdd\x74\x77\x93\xb6\x0a\xfe\xdf\x4f\x3b\x6b\x3b\x4e\xe2\xb4\x09\x54\x07\x0f\x88\xac\x77\x7e\x7e\xe6\xb3\x33\x03\xb3\x59\xb7\x1d\x9c\xaa\xf1\x83\xe6\x83\xa1\x85\xde\xfe\xc1\xff\xf7\xc6\x1a\x0d\x4a\x0b\x6f\x58\x82\xd7\x4a\xdd\x40\x5f\x26\x31\xbc\x16\x02\x48\xc8\x80\xbb\xd7\x53\x4c\xe3\xe0\xd3\x90\x1b\x30\x6a\xa2\x13\x84\x44\xa5\x08\xdc\x80\xe0\x09\x4a\x83\x29\x4c\x64\x8a\x1a\xec\x10\xe1\xf5\x98\x25\x43\x84\x5e\xbc\x5f\xde\x42\xa6\x26\x32\x0d\xb8\xa4\xfb\xb7\xfd\xd3\xf3\x77\x57\xe7\x90\x71\x81\x50\x7c\xd3\x4a\x59\x48\xb9\xc6\xc4\x2a\xfd\x00\x2a\x03\x5b\x73\x66\x35\x62\x1c\xb4\xbb\x79\x1e\x04\xb3\x19\xa4\x98\x71\x89\xb0\x93\x72\x26\x30\xb1\x5d\x73\x2b\xba\xb7\x13\xd4\x0f\x3b\x90\xe7\x4e\x60\x77\x7c\x33\x80\xa3\x13\xd8\x8d\xaf\x12\x35\xc6\xf8\x3d\x4b\x6e\xd8\x00\xcb\xdb\xeb\x09\x17\x2e\xd8\xa3\x13\x18\x33\x93\x30\x51\x09\xfe\x59\xdc\x14\x82\x1a\x13\xe4\x53\x2f\x59\xfd\xae\xd4\x5d\x34\xd9\x44\x26\x10\x2e\xc8\xe6\x39\xb4\xeb\x5e\xf2\x3c\x02\x73\x2b\x5e\x0b\x11\x26\xf6\x1e\x12\x25\x2d\xde\xdb\xf8\xd4\xff\x1d\x41\xf8\xe5\x2b\xc9\xc7\xef\xd8\xc8\x85\xd8\x01\xd4\x5a\xe9\x08\x66\x41\x4b\xab\x3b\xe3\x9c\xbf\x34\xb7\x22\xfe\xa8\xee\xcc\x2c\x0f\x5a\x06\x05\xc1\xe4\x2e\x96\x3c\xc7\xe6\x56\x7c\x70\x48\x84\x51\xd0\xe2\x19\x4c\x24\xbf\x9d\x60\x93\xa0\xbf\x39\x06\x81\x32\xf4\xbf\x23\x38\x39\x81\x7d\xe7\xb5\xf2\x10\x9f\x71\x63\xb9\x4c\xac\x33\x97\x07\x2d\x02\xb9\x03\x4c\x0f\x28\xaa\x4a\xac\xee\x12\x75\x63\x60\xa9\x76\xbf\x0a\xc9\xc4\xde\x77\xa0\x66\xac\x03\x2e\xd1\xe8\x98\x94\x5f\x9c\x80\xe4\x82\xe2\xd0\x68\x27\x5a\xba\x23\x81\x42\x31\xa4\x98\xa1\x26\xf9\xf8\x54\x28\x83\xce\xed\x6c\xb6\xe7\xbc\x59\xaa\xa8\x98\x68\xaa\xe8\xc7\xb9\xf7\xa0\x35\x65\xba\x08\xc9\xba\x02\xcd\x66\x73\x39\x82\x9d\x84\x96\xa3\x77\xa2\xf1\x1b\xad\x46\x0e\xf9\x70\xf3\x10\x6b\xda\x89\x92\x19\x1f\x2c\x13\xa4\xf8\x1c\x05\xa5\xfa\x5c\xa3\xe3\x4c\x05\x5b\x31\xeb\x54\x4d\xa4\x5d\xc3\x2d\x2e\xed\xb3\xf1\x69\x4e\xa6\x2f\x5f\x8d\xd5\x5c\x0e\x66\x24\x5f\x7b\x5e\x31\x9d\xfb\x67\x2e\x02\x63\x99\x24\xb0\x3d\xb2\x8e\x68\xcd\x24\x8c\xe0\x8f\x82\x77\x85\x87\x75\x6c\x25\x70\x2b\xd2\xf9\xb4\x5d\x22\x15\x4b\x6b\x77\x62\x32\x92\xa6\x60\x76\x1c\xc7\x91\xfb\x13\xfd\x32\x06\xef\x3f\xce\x5f\x9e\xc1\x0b\xfa\xf2\x0e\xef\x6d\x18\xad\x6a\x2a\xed\xee\xee\xc2\x9d\xb2\xb9\xe5\xf9\x11\x48\x45\x66\x7c\x73\xdd\xf1\x2f\xd4\xf1\x5c\x02\x97\xb6\x9e\x09\x99\xbe\x4a\x98\x0c\x5f\xca\xc7\x42\xcc\x46\x36\x3e\x77\xce\xb2\x45\x47\x19\xe3\x02\x53\xd0\xc8\x52\x2e\x07\x90\x38\xe0\x8f\xe0\x7f\xd3\x1d\x8a\xcd\x3b\x2e\xdf\xc1\x0f\xf0\xf7\xfc\x9e\x9b\x75\xfc\xbd\x56\x4a\xd4\x09\x2c\x3b\xeb\xca\x53\x7f\x08\xf3\x3a\xae\xe6\x99\x31\x61\x70\x7d\xae\xc9\x10\x93\x1b\x40\x17\x12\xca\x04\xd7\xa5\xe9\x28\xfb\x03\xa9\xf6\xcf\xcc\xda\x21\x50\x3e\x9d\x4f\x0f\xe3\xe5\x31\x30\x35\x8f\xa5\x5d\x4c\x96\xc7\x92\x5e\x68\x4f\x8e\x23\x3c\x35\xb0\xe2\x32\x68\x65\x4a\xc3\x3f\x1d\x98\x12\x6b\x98\x1c\x20\x4c\x0d\xd9\x71\xf2\x27\xc0\xc6\x63\x94\x69\xc8\x53\xd3\x81\x69\xdc\x3f\x5b\xc0\x84\xbe\x6e\x8d\x48\xf1\xf0\xa0\xed\x1e\xf2\x55\xd9\x89\x66\x41\xcb\x1e\xd0\x03\xbd\x15\xf1\x27\x76\x2d\x30\x5c\xee\x34\xf4\x35\x5a\xec\x5e\x73\x1b\xa1\x3d\xa8\x9a\xc0\xb2\x66\xf1\xbd\xec\x0a\xd4\xe1\x43\x7b\xe0\xf1\x6b\xc0\xb7\x8e\x67\xe5\xad\xb1\x12\xf5\xd1\x59\xc4\xb1\xd2\x92\x9e\x88\x86\x10\x2d\xca\x30\x9e\x97\x61\xd9\xd9\x58\x63\xca\x13\x66\xd1\x97\x67\x5c\xf9\xd9\xd4\x80\xd2\xae\x08\x4d\xba\x3c\x03\x95\x65\xc6\x4f\xd3\x15\x35\xba\x39\x2e\x25\x6a\xc8\x74\xbb\x20\xf8\x88\x5b\xb7\x14\x8e\x98\x4c\x19\x2d\x72\x2e\x90\x42\x36\x11\x6c\x62\x30\x86\xcf\x08\xc6\x32\x6d\xbd\xce\x1d\xb7\x43\xb7\xd0\xb1\x89\xb0\x30\x65\x62\x82\x1d\x60\x32\x05\x35\x45\xad\xb9\xdb\x31\x2d\x5c\xa3\x50\x77\xc0\x33\x90\x88\xa9\x5b\x44\x6b\x30\x5f\x92\xf1\xb0\xed\x9d\x44\xf1\x5b\x17\x43\x38\x62\x76\x18\x5f\xb0\xfb\xbe\xb4\x87\xbd\x2a\x2d\x1f\x5f\x43\x56\x74\x71\x5c\xdc\x37\x54\xbb\xb0\xda\x26\x81\x05\xd2\x97\x12\x01\x2d\x8c\x28\x53\xf0\x3b\x6a\xb7\xed\xa7\x43\x77\xcc\x7c\x7e\x5c\xa2\xa1\x4d\x97\x3e\xc3\x00\x25\x6a\x66\xb9\x92\x04\x11\x49\xa9\x0c\x18\x0c\xf8\x14\x25\x60\x3a\xc0\x18\x68\xe1\x7d\x6c\xdf\x25\xeb\xb4\xf4\xfa\x15\x08\xeb\x4b\xef\x79\x4a\x04\x03\x0a\xc6\x79\x76\x46\xe1\x0e\x09\x45\xb0\x8a\x62\x18\x68\x66\x91\x6e\x29\x04\xab\x0a\xaf\xe5\x4a\x35\xdf\x7f\x4b\xb3\xf5\xb5\xca\x8b\xf1\x0c\x76\x31\xbe\xe8\x5d\x50\x20\xb4\xfd\x70\xa7\x71\x00\x79\xee\x0e\xdf\xdc\x61\x9f\x0e\xa5\x70\xdf\xf4\xe5\x14\xb5\xc1\x42\x84\x43\x29\xe1\xc4\x2b\x55\x87\xe7\x1e\x19\x6d\xea\x06\x48\x7d\xab\xa9\x27\xb4\x6c\xef\xa9\x65\xa6\x65\x7b\x55\xab\xe8\xc5\xa7\x2b\xcf\xb2\x61\x91\x89\x48\xed\xf0\xf1\xb6\x54\x04\xe6\xee\xea\xaa\x4e\xf3\xd5\x72\x8b\x3a\x5c\xe3\x17\xe3\xf7\x7f\xd7\x94\xbf\x78\x84\xf2\xfc\x6b\x14\x39\xee\xb7\x5a\xbe\x63\x1d\x16\xa7\xbf\x14\x97\xa1\xed\x15\xa7\x4b\xb9\x9d\xe1\x6f\x64\xb8\x03\x5b\xa1\x40\xec\xa3\xde\xb8\x90\x91\x0f\xa1\xec\xa7\x74\xf0\xc1\xbd\xf2\x07\x17\xdb\x41\xe1\x66\xb5\x7a\xb5\xaf\x4b\x2e\x3b\x60\x5f\x6d\x8f\x95\x67\x27\x0a\x83\x8e\x75\x4a\x7b\x96\x5e\x42\xe8\xfa\xcb\x2e\xc6\x97\xbd\xcb\x05\x2e\x46\x44\xba\x6e\x1b\x9c\xd0\xf7\xef\x10\x3a\x01\xea\x4f\xbc\x20\xab\x7b\x41\x51\xf1\x40\x7e\x0d\x25\xb1\x98\x17\x1b\x16\x64\x69\x0a\xae\x86\xb7\x3c\x0b\xd7\xd4\xaf\xf7\xd3\xf5\xdb\x32\xa1\xaa\x72\x45\x49\x2e\x7b\x17\x8b\x25\x61\xc6\xa8\xe4\x37\x28\xc8\x73\xbc\x8e\x06\x74\x37\x81\x69\xbb\x37\x4b\x88\xfa\xf9\xd4\x3c\xa9\x32\xad\x46\x4f\x4f\x2a\xe6\x87\x53\x71\x49\x3a\xe5\xd0\x92\x2a\xdd\x68\x68\x39\xa5\xda\xd0\x92\x34\x5d\x16\x26\x95\xb3\xe4\x26\x15\xed\x09\xb5\x58\x9c\xe6\xc2\x80\xfa\x4f\x07\xde\x1e\x4d\x3c\x9e\x36\xd1\xa6\x1c\x6d\xd2\x21\xdf\x37\x57\xf4\xcf\x63\xc8\x73\x9e\x86\x91\x83\xdb\x53\xb9\x7f\x36\x87\x7e\x69\x74\xfe\x6e\xb3\x73\x51\x5c\x36\x4b\x36\xcc\x42\xf9\xb3\xc3\xb0\xc1\xc0\x33\x4d\xc3\x8d\x2c\xaf\x8c\xc3\x15\x2d\xb9\xf2\xb8\xbc\xab\xcf\x43\xd4\x48\xff\x1f\x71\xfe\x21\xdc\x42\xbd\x03\x3c\xfd\xc5\x43\x75\x1b\xcc\x7f\xc7\xa9\x5a\x43\x6b\x4d\x3a\xab\xdd\x73\x8e\xea\xf6\x04\xf6\xca\x0b\x05\xdf\xa8\xd6\x4f\x97\xba\x2a\x73\x45\xe3\x67\x98\xb9\x1b\xe1\xf1\x83\x43\xf7\xf1\x4c\x36\xab\xe3\xa6\x70\x36\x84\x5d\x22\xda\x34\xdd\xfe\x0d\x00\x00\xff\xff\xb6\xf5\xfd\x4e\xee\x18\x00\x00")
+var _templateDialectSqlQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x5a\xdd\x73\xdb\x36\x12\x7f\x96\xfe\x0a\xd4\x93\x7a\x48\x8f\x42\x3b\x76\x5e\x2e\x1e\x75\x26\x67\x3b\x53\xdd\x25\x71\x52\xa7\xcd\x83\xc7\xd3\xa1\x29\x48\x42\x4c\x83\x0a\x49\xc9\xf1\xa8\xfa\xdf\x6f\x3f\x40\x10\xa4\x28\x8b\x72\xd2\xa4\xf7\x62\x8b\xc4\x62\xb1\x58\xec\xc7\x6f\x17\x5c\x2c\xf6\xf7\xba\x27\xc9\xf4\x3e\x55\xe3\x49\x2e\x0e\x0f\x9e\xfd\xeb\xe9\x34\x95\x99\xd4\xb9\x78\x15\x46\xf2\x3a\x49\x6e\xc4\x40\x47\x81\x78\x19\xc7\x82\x88\x32\x81\xe3\xe9\x5c\x0e\x83\xee\x87\x89\xca\x44\x96\xcc\xd2\x48\x8a\x28\x19\x4a\x01\x8f\xb1\x8a\xa4\xce\xe4\x50\xcc\xf4\x50\xa6\x22\x9f\x48\xf1\x72\x1a\x46\xf0\xef\x30\x38\x28\x46\xc5\x28\x81\xe1\xae\xd2\x34\xfe\x7a\x70\x72\xf6\xf6\xe2\x4c\x8c\x54\x0c\x2c\xf8\x5d\x9a\x24\xb9\x18\xaa\x54\x46\x79\x92\xde\x8b\x64\x04\x6f\xcb\xc5\xf2\x54\xca\xa0\xbb\xb7\xbf\x5c\x76\xbb\x8b\x85\x18\xca\x91\xd2\x52\xec\x0c\x55\x18\xc3\x84\xfd\xec\x73\xbc\xff\x79\x26\xd3\xfb\x1d\x01\x14\x40\xf0\x64\x7a\x33\x16\x2f\xfa\xe2\x49\x70\x11\x25\x53\x19\xbc\x0b\xa3\x9b\x70\x2c\x8b\xd1\xeb\x99\x8a\x51\x58\xa0\x98\x86\x59\x14\xc6\x96\xf0\xdf\x66\xc4\x10\x82\x38\x52\xcd\x99\xd2\xfe\xb6\xd3\x51\x9a\xd1\x4c\x47\xc2\xab\xd0\x2e\x97\x62\xcf\x5d\x65\xb9\xf4\x05\x48\x08\x1a\xf5\xa2\xfc\x0b\x28\x4e\xe7\xf2\x4b\x1e\x9c\xf0\x7f\x5f\x78\x97\x57\x44\x1f\xbc\x0d\x6f\x51\xc4\x9e\x90\x69\x9a\xa4\xbe\x58\x74\x3b\x69\x72\x97\xe1\xe2\xbb\xc0\x20\xf8\x0d\x1e\x16\xcb\x6e\x27\x93\x31\xa9\xa9\x07\xbc\xe2\xd9\xad\xce\x68\x06\x92\xd5\xe4\x08\x78\xd9\xf7\xa8\x1a\x5c\xdb\xef\x76\xd4\x88\x68\x7f\xea\x0b\xad\x62\x5c\xa1\x93\xca\x7c\x96\x6a\x7c\x24\x36\xdd\x0e\xac\x40\xca\xec\x89\x30\x1d\xd3\xea\xc5\x82\x01\x73\x2a\xd9\x34\x2c\x39\x4c\xf1\x57\x60\xd7\xec\x09\x87\x59\x4f\xe0\x86\xfc\xe3\x36\x32\xc0\x21\x03\x47\xa4\x0f\x4e\xe2\x24\x93\xb8\xec\x62\xf1\x14\x57\xcb\xe9\xe4\xe2\x59\x4a\x27\xf7\x5b\xb9\x7a\xb7\x33\x0f\x53\x23\x52\x8e\x07\x01\x3f\x2d\x1d\xa9\x97\x88\xea\xd2\xe7\xa4\xab\x28\xd4\x1e\x2e\x67\xd5\xda\x4e\x4e\x87\x05\x1c\xed\x48\x8d\xeb\xd6\x60\x5e\x1b\xf1\xd3\x50\x83\x21\x3e\xf9\xb3\x27\x9e\x48\x36\xd1\xb3\xe1\x58\x66\x24\x17\x0a\x46\xda\x6a\x52\x2c\x3e\xcb\xe0\x0c\xcc\x38\x7d\x9d\x84\xc3\x57\x4a\xc6\x43\x78\x7f\x6c\x66\x38\x52\x3e\x70\x3a\x60\x10\x38\x19\xd5\x62\x0c\x5f\x16\x66\x57\x39\xac\x72\x53\xab\x4a\x68\x50\x43\x07\x15\xc1\xca\x78\x2a\xa4\x1e\xd2\x6e\x0c\x55\xc9\xaa\x87\x33\xba\xe0\x34\xfb\xfb\xc2\xb1\x4c\xc1\x84\x19\xc5\x81\xc2\xd4\x30\x02\x00\x01\x98\x0d\x70\xc3\x01\x73\x26\x42\xe5\x86\x26\x0b\xb6\x73\x3e\x6b\x91\x0d\x1e\xb8\x87\xee\x75\x61\xdd\xea\xf2\x2a\xcb\x53\xa5\xc7\xae\x27\x5a\xc1\x9a\xb5\x5a\xf3\xb1\x99\x56\xa0\xc9\x26\x5a\x1e\x39\x16\xb1\xd4\x1e\xff\xf6\x45\xbf\x2f\x0e\x48\xb5\xd6\xd1\x4e\x55\x96\x2b\x1d\xe5\x68\xf4\x46\xad\xc0\xf5\x49\x70\x9e\x9a\xc0\x43\x87\x8c\x3c\xea\xfc\x13\xa4\x70\x58\x76\x46\x20\x33\x58\xdb\x94\x62\x18\x19\xdf\x8a\xcb\xca\x51\x38\x8b\x73\xe2\xed\xf9\xe6\x8c\xa7\x5e\x21\x8c\xbf\xe6\x7c\x8b\x13\x31\x9b\x74\x82\x2c\x68\x96\x86\x48\x15\x23\x34\xd4\xac\x49\x15\x3c\xc2\xaa\xe0\xdf\xbe\xf8\xc5\x88\x5d\x30\xef\x8b\x70\x3a\x85\x15\xbd\xe2\x4c\x16\x82\x82\x80\xbb\x1a\xad\x3e\x38\xc5\xf3\xcc\xf2\x50\xa3\xa9\x81\xad\x31\xc7\x20\x08\x50\x7e\xa3\x84\xa8\x54\x82\x11\xab\xf0\x97\x9f\xea\x5b\xf8\x23\x8c\xd5\x90\xf7\xe1\x45\x7e\x83\xe1\xd3\x9f\xd1\x6d\x1e\x9c\xa1\x8d\x8c\xbc\x9d\x22\xef\x2c\x97\x2f\x20\xab\xcd\x71\x3e\xaf\x22\x7e\xfe\x2c\x50\x00\x4e\x51\x20\x85\xab\xd1\xf2\xcc\xd9\xfe\xac\xda\x0b\x25\x7a\x46\x15\xb8\x13\xde\x4d\x25\x82\x59\x72\x10\xc3\x7b\x38\x62\x55\xc2\x96\x19\x68\xc8\x25\xc6\x49\xdb\x3b\x17\xd9\xfe\x05\x9d\xce\x1a\xff\x2a\xdc\x49\xc1\x8b\x74\x04\x40\x63\xb1\x6c\xf2\xad\x1e\x9e\xd1\xd7\xa7\xb2\x9d\x9d\xde\x96\xe9\xcc\x4c\xac\x64\x2a\xa3\x06\x90\xe3\x81\x90\x8d\x52\x6a\xcc\x1c\xfc\x1e\x8c\xf0\xc3\xfd\x14\xed\x07\x06\xc8\x61\xe1\xcd\x20\x63\xdd\xf0\x5b\x43\xde\x17\x3b\xa0\x8c\x1d\x7e\x67\x3c\x8a\x22\xbc\x65\x26\x89\xd5\x2a\xcb\xf2\x7d\x8d\xb1\x5c\xcb\x98\xe3\xed\xba\xc0\x2f\x62\x18\xe0\xe8\xab\x01\xd4\x65\x0c\xbe\x38\x4c\x94\x44\x12\xb6\x5c\x8c\x8c\xe1\x50\xf4\x6a\x6e\x05\xaa\x59\x86\xf2\x94\x34\xa4\xd1\x00\x05\xf8\x00\xef\xd4\xd0\x32\x97\xa4\xc1\x30\x95\x44\xa2\x24\xba\x4a\x9a\xe5\x3d\x71\xa7\xf2\x09\x51\xc4\xea\x16\x62\x7d\x11\xfe\x93\xd1\x28\x83\x24\x62\x66\x73\xca\x83\xc8\x10\xe3\xcc\x44\x0b\x09\x88\x93\xa4\xef\xe1\x5a\x66\x92\xee\xad\x6e\xaa\x5c\x17\x77\x0d\x93\xaf\xef\xf1\xbd\x4a\x51\xb8\x6d\x32\xca\x43\x69\xb4\xee\x01\x26\xad\x32\x1f\x73\x7e\x86\xfc\x3d\x9b\x1c\xcb\x58\x03\x82\x3e\xbb\x08\x9a\xb6\x09\xf6\x44\xe6\x04\xf7\xd2\xbb\x5d\x3b\x37\x1e\xc4\xba\x07\x78\x3f\x85\xed\x6e\xf2\x99\xc2\x55\x14\x87\xea\xdb\xf0\x46\x42\xc8\xad\xf8\x6b\x29\x00\x30\xba\xbe\x1f\x9c\x5a\xc2\xdb\x70\x7a\x59\x04\x61\x63\xac\x75\x48\x5b\x99\x8c\xc1\x50\xf1\xa6\xcb\x80\xcc\x2a\x40\x91\x6c\xb6\xab\xda\x38\x45\xea\x61\x76\xa9\xae\x20\x4e\x80\x9d\x43\x50\x01\x3d\xcf\x83\x97\x79\xa2\x88\x37\xd0\xfb\x66\xba\x8c\x33\x59\x99\x02\xf4\x86\xa4\xa0\x30\x59\x8c\x76\x72\x69\xc6\x0a\x32\x52\x85\x9b\xf1\x59\x97\xab\x79\x7e\xab\x20\x5c\x22\xc6\xa7\xc6\x93\xdf\x1c\xbe\x61\x21\x50\x59\x0a\x39\x3d\x33\xce\xfc\x09\x1f\x0e\xe8\xa1\x20\x1e\x64\x03\x0d\x06\x99\x99\x58\x00\xf4\x05\x05\x92\xdb\xa9\xb8\xb1\xa7\xc4\x34\x7f\x46\xb2\x01\xb4\xf9\x10\x5e\xc7\xd2\xab\xa7\x38\x63\x8d\x38\xe6\xa4\x4e\xdf\xcd\x49\xff\x49\x94\xf6\xf2\x67\x7e\x70\x8e\xff\x82\x93\x35\x3c\xde\xfd\xd7\x61\x70\xc9\xc2\x81\x0d\xf8\xbd\x52\x33\x3c\xd5\x18\xff\xaa\x10\x26\xa4\xb9\x72\xa0\x20\x37\x92\xb0\xf0\x56\x4b\x7f\xa2\xa5\xbb\xa5\x1d\x80\xfa\xe0\x14\x49\xdd\xe7\xc2\xc3\xe0\x00\xbf\xcf\xe1\xb7\xab\x54\x9f\xb4\xb7\xbf\x27\x90\xe8\xaf\xbf\x84\x87\x04\x14\x8c\x94\xd1\x3a\x86\x0e\x5f\x50\x11\xfa\x3d\x75\xcb\x30\xc0\x65\xf2\xb7\x6b\xb5\x3e\xa7\xeb\xfa\x14\x29\xe9\x1c\x0c\xb7\xa2\xa4\x30\xcb\x92\xa8\xaa\x22\xb3\x4a\x4d\xd6\x36\x1b\xac\xe2\x4c\xcb\xe0\xe3\x44\x42\x20\x43\x9d\x0f\xb4\x07\xdc\x7b\x14\xb3\x01\x12\xf9\xdd\x55\x14\x05\x54\x2f\x33\xa6\xda\x41\x97\xfe\x53\x0d\x77\x50\x73\xfc\xfe\x2b\x14\x08\xfc\x70\x9b\xc4\x8f\x83\x00\x07\x07\xce\x57\xc6\xeb\x41\x37\xfc\xd6\xe4\x2d\x27\x18\xd8\xb0\xc2\xe6\xf3\x2e\x4c\x73\x95\xab\x44\xbf\xc6\xf9\x5e\xb6\x52\x84\x2c\x60\x0f\x4b\x67\x13\xee\xfa\x8c\xe4\x9a\x7a\x04\x9f\xbf\x59\xf5\xde\xaa\x72\x7f\xa8\x68\x87\x84\x0c\x5b\xc8\xc4\x24\x89\x0d\xcc\x70\x70\x00\x45\x7d\xae\xf2\x24\xbe\x6e\x22\x82\xe4\x4c\x69\xbb\x57\x34\x8b\x32\xcc\x2a\x54\xe8\x04\x5c\xf1\x7b\x6c\x6e\x98\x41\x6b\x79\x08\x06\x88\x2f\x0f\xac\x60\xaa\x6e\xc7\xa4\x23\x12\xf9\x2d\xe4\x6a\x53\x00\x15\x5c\x3b\x1a\x50\x7c\x09\xdb\x38\xa5\x48\xf3\x4e\x96\xef\xfc\xae\x5b\x76\x13\xb7\x0b\x6c\x28\xec\xc2\xfc\x9e\xd8\x85\x19\x0d\x85\xb4\xab\xbc\xce\xb2\xd8\x83\x2d\x79\xf0\x89\x4a\xf1\x86\x64\x58\x24\xbf\x41\x9e\x84\x1e\xac\xe1\x63\xe4\x67\x07\x85\x27\x0b\xfd\xfc\x62\xff\x96\x29\x3e\x59\xa6\x8d\x58\xb2\xc2\x5a\x56\x58\xcb\x2a\xeb\x4a\x21\x42\x7b\x6e\x95\xff\x2c\x30\x19\xaa\x28\xcc\x25\x0a\x77\x79\x65\x1f\x83\x55\x90\x64\x6a\xbe\x55\x2f\x1d\x9c\x42\x24\x90\x26\x0a\x58\xce\x64\x19\x3d\xd7\x2d\x7b\x55\x6f\xec\x3b\xf5\x10\x61\x27\x63\x5e\x15\xe0\x54\xb6\xec\xda\xe0\xa6\xeb\x7b\xac\x09\x1a\x30\xd1\x8a\xc9\x5d\x35\xa0\x40\xc6\x47\x24\x45\x81\x8f\xb0\x12\x42\xc8\x6d\xf1\x11\x23\xd7\x05\x21\x16\x5e\xeb\x12\x5f\x19\xd4\x82\x3f\x49\x10\x83\xad\xd0\x44\xcb\xa9\xca\x54\xba\x84\x90\x2d\x5f\x42\x3e\x68\x64\x80\x8f\x00\x53\x95\x6c\xd5\xf0\x8a\xcd\x99\x10\x5a\xdf\x86\x34\x9a\xd8\x77\x0c\x18\x71\xae\xd2\x33\x69\xcc\xb7\xc4\x2a\xbf\x73\xff\x83\xdd\x85\xc0\x15\x95\x4c\x41\x63\xfd\x51\x48\x5f\x47\x6e\x9b\xe7\x19\x9b\xde\x40\xc8\x3b\xf6\x6b\xc0\xaf\xac\x81\xb9\xd2\x2b\xcb\xa5\x2d\xaa\x80\x93\x64\xa6\xf3\x35\x45\x2f\x80\xe7\xd6\xad\xdc\x76\x5d\xa5\xad\xd0\xe6\x41\x59\xff\x96\xdd\x28\xb7\x8b\xb2\x31\xef\x8b\x65\x77\x5d\x97\xa9\xe8\x5c\x15\xed\x1a\xb3\xc2\xba\x76\x57\x05\x4f\x07\xac\x34\x54\x83\x6d\x73\xad\x34\x3d\x78\x5e\xd1\xf3\xe0\x2c\x52\xd6\x6f\x90\x17\x42\x36\x4e\xac\xe4\x22\xe4\x07\xa5\x1c\x02\x91\x64\x96\x8b\x4f\x00\xac\x4c\x19\x0a\xa9\x23\x47\x0c\xd6\x13\x64\xcd\xc4\x26\xcb\xe5\x34\x13\x9e\x0c\xc6\x81\x70\x82\x10\x1c\x02\xc5\x0a\x5f\xdc\x01\xcc\x10\xe1\x10\xab\xc3\x3c\xb1\xab\x16\xb5\x2c\xaa\x24\x6a\x3a\x2f\x24\xa2\xad\x1d\xc3\xb8\x39\x8f\xdd\xdd\x46\xf5\x95\xcb\x9a\x62\x6e\x0d\x5d\xa5\x41\xb8\xbb\x0b\x8a\x6e\xa2\x9a\x80\xa5\x39\x8c\xea\xc3\x0c\x4c\xfa\x56\xa0\x95\x8e\xa1\x09\x8a\x8d\x10\x25\xc2\x3c\xae\x21\x8f\x03\xb0\xca\x4f\xf9\xbe\xc5\x5b\x83\x1a\x8a\x61\xdf\x0f\x3e\xc2\x51\x18\x4f\x30\xe6\xfb\xe3\x6e\x15\x0e\x1e\xbe\x53\xc0\xe6\x5f\x3d\xfb\x57\x66\x26\x29\x8e\xdd\x55\x1b\x7c\x3a\x21\x36\x7c\xb1\x65\x80\x18\x62\x06\x8d\x3d\xae\xee\x1a\x28\xf0\x90\x88\xeb\x3a\x89\xa3\x50\xc5\x60\x88\xa9\x0c\x87\x68\xd3\x64\xeb\x2f\xc4\xcf\xf3\x1d\x92\xcd\xaf\xc4\xb1\x47\xf4\xee\xce\xbe\x80\x0b\xae\x09\x60\xd7\x49\x12\xff\xc8\x08\x36\x0a\x21\x17\x94\x87\xb7\xbe\x51\xda\xaa\x88\xb1\x31\x24\xa5\x7b\x4b\x38\x40\x2d\xd9\xc3\x49\xa7\x22\xc4\x1b\x4f\xba\xfe\xb9\x9b\x48\x2d\x12\x1d\xdf\xc3\x1f\xa2\x95\x3a\x99\x8d\x27\xec\xfa\xec\x4d\x0d\x9b\xa5\x81\x63\x51\xf1\x36\xc8\x99\x7b\xfc\xe2\x17\x28\xcf\x2b\x1d\x7e\x86\xfb\xcf\x7e\xa8\x67\x18\x05\xaf\x33\xbd\x68\x22\xa3\x1b\x21\xd1\x42\xa4\x8e\x64\xdd\xea\x9a\x9c\xc9\x30\x76\xfc\xa9\xe7\x00\xc3\xed\x4c\x73\x70\x9a\xad\xbd\x30\xad\xa1\x7c\xd7\x4a\xe7\x9b\xae\x43\xb7\xba\x09\x45\x9f\x2e\xeb\x87\x4a\xe5\x60\x50\xda\xbc\xc4\x59\x73\x46\x59\x15\xbc\x4d\x70\x7b\xce\xad\xa9\xd2\x55\xe9\x6d\x79\x1b\x06\x5b\xbd\x98\x5d\x7f\x7e\xf0\x36\xac\x56\x38\x99\x53\xce\x27\x61\x8e\x26\x3a\xc3\x2b\xf8\x30\x83\xb4\x98\x15\x8c\xb8\xb3\x29\x10\x23\x93\x75\xe0\x42\x65\xe2\x09\xb8\x23\x8b\x0d\xbe\x48\x4e\x61\x0d\xcb\x39\x8a\x15\x7e\x0f\x80\x99\xd5\xe9\xb0\x96\x6d\x57\xdb\xa1\xe5\x16\x6f\x54\xf4\x38\x4d\xd7\x55\xa5\xb8\x10\xc7\x4d\x62\xc2\x1b\xc2\xef\x07\x5a\x9e\xbd\xa3\x8d\x76\xf7\x75\xe5\xe1\x57\x3b\xa0\x0d\x69\xb7\x4d\x2f\xb4\x62\x00\xdf\xbc\x0b\xd8\xc8\xfd\xf1\x31\x6d\xe5\x1e\xa7\x72\xc7\xfa\x7e\xa3\x49\xf1\x9e\xc8\x18\x1c\x30\x54\x3b\x7c\x08\x60\x6b\x4e\xdd\x69\xb7\x63\x99\xbe\x7a\xea\x85\x19\x82\x4e\xc4\xad\x04\x80\x36\x2c\x56\xb6\x7b\xdd\xf6\xaa\xa9\xd9\x28\x2a\x36\x81\x0a\xdf\xd4\xa2\xa3\xb7\x7e\xf5\x84\x4b\x1e\xd4\x92\x33\x60\x74\xcd\xf5\x66\x81\x4e\x83\x57\x69\x72\x8b\x9d\x3c\x32\x86\x86\xa0\xb3\xa6\xfd\xd3\x40\xd9\xe6\x3e\x70\x83\x34\x75\xbb\xfa\x2a\xd4\x66\xf9\xfc\x0a\x91\xa2\x19\x78\xf2\x9a\x9b\x6f\x99\x1d\xeb\x42\x3d\x54\xee\x97\x97\x6d\x18\x50\x59\xdf\x38\x17\x1b\xbc\x0c\x62\x1b\x9c\x9e\x47\x8e\xc5\x6a\x27\x0e\x4c\x97\x93\x33\xc4\xcf\x5b\x30\xe2\x90\x3e\x3d\x1a\x91\x6f\x10\x6d\x14\x87\x10\x57\x03\xf1\x11\x8c\x35\x0f\xd3\x9c\xe7\x90\x27\x98\x3b\x73\x31\x0f\xe3\x99\xe4\xd8\x97\xc0\x82\xa9\xc2\xaf\xa2\x72\x71\x2d\xe3\xe4\x8e\x6a\x0e\xc0\x18\x18\xfa\x9c\x73\x3d\x27\xe6\xde\x1e\x2f\xe2\x1b\x24\x70\x1b\xe6\x93\xe0\x4d\xf8\x65\xa0\xf3\xa3\x43\xbb\xad\x76\x68\xa3\xc1\xbc\x0c\x57\x46\x1f\x7e\xd3\x8d\x6f\xb5\xe4\xa5\xae\x2e\xc5\x82\xfd\x69\xc8\xfb\x53\x5a\x66\x4e\xd8\x1f\x4b\x2d\xd3\x10\x3b\x95\xa4\x22\xa2\xa2\x3a\x8c\x03\x02\xf5\x22\xb8\xf5\xfb\xd0\x17\x5a\xc4\x9d\x3e\xd3\xe2\x8f\x79\xa4\xfb\x99\x16\x96\xf0\xfc\xd5\x0e\x08\x63\xeb\xae\x3b\x69\x91\x1a\xca\x30\x06\x21\x24\x8d\x92\x08\x79\x62\x56\x2d\x3e\x0e\x2a\xbf\xd8\x2a\xd8\x56\x3e\x10\xb2\x31\xfc\x1d\xce\xee\x0b\x8c\x3d\xdb\x66\x99\x0e\xd8\xf2\xbc\x65\x96\x69\x48\x33\x0d\xdf\xcf\xfc\xe0\x5b\xa1\xd5\xce\x5a\x11\x1c\x3b\xf9\x21\x7d\x6c\x05\x1b\xae\x27\x3e\x18\xb2\xb1\xf2\xb0\x7d\xc2\xea\xe4\x47\x8f\xbd\x3a\xc9\x9f\xd7\x63\xf4\xd1\xd6\x57\x52\x7e\x40\x5f\x72\x70\xc8\x3e\x32\x4f\x7c\x15\x73\x68\x9e\xf0\x3e\xe6\x68\xeb\x0b\x27\xc0\x62\xdb\x68\xa1\xf0\xc6\x72\x33\xbc\x7a\x91\x4b\xe8\x81\xe5\x7a\xce\x0f\xee\x35\xd1\x76\x17\x16\xf9\xf3\xed\xd5\x64\x3a\xa3\xdf\xfd\x12\xed\x6f\x33\xc5\xa6\x8b\xa6\xe6\x83\xa8\x65\xfe\x55\x89\xea\xf9\x7f\xcd\xb9\x1d\x7e\xf5\xb9\x6d\xb5\x97\xfa\x89\x6d\x71\x55\xf7\xc3\x63\xc2\x16\xde\xd0\xa0\xd5\x76\x97\xa6\xed\x05\x72\x35\x69\xbb\xc5\xcd\xc9\x72\x04\x02\x6e\x4e\x96\xa1\x76\x5a\x88\x82\xe6\x14\x79\x93\x7a\xd7\x2d\xf2\x26\x4e\x72\xf2\x26\x7f\x23\x54\x49\x96\xd4\x0f\xbd\x33\x50\xc5\x91\x05\x67\x56\x72\xe4\x77\xcd\xb9\x98\x74\xf0\xb3\x92\xe6\x4f\x5a\x39\x8b\xe9\xda\x0d\x94\x1a\x7a\xce\xa5\xd3\xe0\xb4\x54\x7d\x2d\x4b\xfe\xd3\xd2\x64\x95\x5c\xb7\xcc\x6a\x47\xf5\xac\x56\x18\xaa\x7e\x64\x5a\x2b\x12\x59\x79\x61\x7e\xf6\x7e\x4b\xae\x45\x4e\x53\x43\xf2\x4f\x8b\x9a\x44\xbf\x95\x93\x1e\x7d\x75\xe8\x3b\x7a\x84\x0e\xac\xa0\xb6\x15\x5f\x17\x97\xaf\x1e\x5c\xb1\x8d\xae\xbe\x99\xaa\xfe\x79\x09\xd3\x51\xc0\x9a\x3d\xad\x46\xcb\x52\x41\xdb\x9b\x74\x83\x42\x1b\xa7\xea\xda\xa9\x6f\x36\x36\x6b\x68\x36\x13\x7c\x83\xdc\xaa\xdb\x02\x85\x56\xa6\xf5\x7f\xa1\xe1\xc7\x40\x84\x87\x0f\xa5\x9d\x49\xb6\x95\xbb\x41\x5f\x35\xe3\xd8\xe8\xe0\xdf\x57\x2a\x07\x29\xd8\x5c\xf5\x3f\x58\xc4\xbe\x2f\x6c\x35\x00\x00")
 
 func templateDialectSqlQueryTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -734,12 +867,12 @@ func templateDialectSqlQueryTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/query.tmpl", size: 6382, mode: os.FileMode(420), modTime: time.Unix(1570094421, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/query.tmpl", size: 13676, mode: os.FileMode(420), modTime: time.Unix(1792023599, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xcf\x6f\x13\x3f\x10\xc5\xcf\xeb\xbf\xe2\x7d\xa3\xea\xab\x6c\x15\x9c\xd2\x1b\xa0\x1e\x42\xd4\x4a\x95\x10\x02\xc2\x1d\x6d\xed\xd9\xc4\xaa\xb1\x93\xb1\x37\x3f\xb4\xf2\xff\x8e\x66\xbb\x54\x69\xe1\xc2\xc9\x23\xbf\xcf\xcc\x7b\x63\xf7\xfd\xfc\x52\x2d\xe3\xf6\xc4\x6e\xbd\xc9\xb8\xbe\x7a\xfb\xee\xcd\x96\x29\x51\xc8\xb8\x6b\x0c\x3d\xc4\xf8\x88\xfb\x60\x34\x16\xde\x63\x80\x12\x44\xe7\x3d\x59\xad\xbe\x6f\x5c\x42\x8a\x1d\x1b\x82\x89\x96\xe0\x12\xbc\x33\x14\x12\x59\x74\xc1\x12\x23\x6f\x08\x8b\x6d\x63\x36\x84\x6b\x7d\xf5\x5b\x45\x1b\xbb\x60\x95\x0b\x83\xfe\xe9\x7e\x79\xfb\x79\x75\x8b\xd6\x79\xc2\x78\xc7\x31\x66\x58\xc7\x64\x72\xe4\x13\x62\x8b\x7c\x66\x96\x99\x48\xab\xcb\x79\x29\x4a\xf5\x3d\x2c\xb5\x2e\x10\x26\xd6\x35\x9e\x4c\x9e\xa7\x9d\x9f\x27\x92\x72\x82\x52\x84\xb8\x78\xe8\x9c\x97\x3c\xef\x6f\xb0\x6d\x92\x69\x3c\x2e\xf4\xca\xc4\x2d\xe9\x8f\xa3\x32\x82\x4c\x86\xdc\xfe\x89\x7c\xae\x9f\xdb\xc5\xb0\xed\x82\xc1\xf4\x05\x5b\x0a\x2e\xcf\x5d\x4a\xa9\x91\x76\x7e\x65\x9a\x30\x35\xf9\x08\x13\x43\xa6\x63\xd6\xcb\xa7\x73\x86\x3d\x5c\xc8\xc4\x6d\x63\xa8\x2f\x35\x88\x39\x32\x7a\x55\x71\x3c\x24\x71\xfe\x3f\xed\xbc\xfe\x16\x0f\xa9\x2f\xaa\xda\x75\xc4\xa7\x19\x1a\x5e\x0f\xda\x2b\x67\x9d\x76\xfe\xab\x10\xd3\x5a\x8f\xa7\xaa\x5c\x2b\x33\xff\x46\x5b\x96\x6a\x24\x4d\x3e\xce\x70\x36\x7e\x06\x09\x50\x7f\x18\x9a\xff\xbb\x41\x70\x5e\x52\x55\x4c\xb9\xe3\x20\xb7\xaa\x2a\xaa\xb2\xd4\x12\x0f\xa8\x5e\xfa\x98\x48\x1c\x47\x44\x72\xcb\xda\x2b\xf9\xe9\xa9\x20\x33\xec\x6b\x55\xd4\xbf\xbc\xdb\xb8\xc6\x30\x4c\x6a\x47\xc3\xe3\xec\x1d\x1d\x64\xa5\x89\xb4\xe8\x2f\x8d\x79\x6c\xd6\x84\x52\x7e\x88\x30\x79\x19\x61\xf8\xfd\xd7\x66\xba\x75\xe4\x6d\xd2\x5a\xd7\xfa\x8e\xe3\xcf\x3f\x74\x69\x5d\xa4\xa9\xcc\xab\x25\x74\xdf\x83\x82\x45\x29\xbf\x02\x00\x00\xff\xff\x34\xc6\xb2\xd5\x29\x03\x00\x00")
+var _templateDialectSqlSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x52\x4d\x6f\xdb\x30\x0c\x3d\x47\xbf\x82\x0b\x8a\xc1\x0e\x32\xa5\xeb\x6d\x1b\x7a\xc8\x82\x14\x28\x30\x0c\xdb\xb2\x7b\xe1\x4a\x74\x22\x54\x93\x5c\x4a\x4e\x1b\x18\xfe\xef\x23\x1d\xaf\x5f\xd8\x07\x76\x12\xc5\xf7\xf8\xf8\x48\xa9\xeb\x16\x33\xb5\x8a\xcd\x81\xdc\x76\x97\xe1\xec\xf4\xed\xbb\x37\x0d\x61\xc2\x90\xe1\xa2\x32\x78\x1d\xe3\x0d\x5c\x06\xa3\x61\xe9\x3d\x0c\xa4\x04\x82\xd3\x1e\xad\x56\xdf\x77\x2e\x41\x8a\x2d\x19\x04\x13\x2d\x02\x5f\xbd\x33\x18\x12\x5a\x68\x83\x45\x82\xbc\x43\x58\x36\x95\xe1\xe3\x4c\x9f\xfe\x42\xa1\x8e\x0c\x2b\x17\x06\xfc\xd3\xe5\x6a\xfd\x79\xb3\x86\xda\x79\x96\x38\xe6\x28\xc6\x0c\xd6\x11\x9a\x1c\xe9\x00\xb1\xe6\xec\x63\xb3\x4c\x88\x5a\xcd\x16\x7d\xaf\x54\xd7\x81\xc5\xda\x05\x84\xa9\x75\x95\xe7\x82\x45\xba\xf5\x8b\x84\x12\x4e\x81\x29\xcc\x38\xb9\x6e\x9d\x17\x3f\xef\xcf\xa1\xa9\x92\xa9\x3c\x9c\xe8\x8d\x89\x0d\xea\x8f\x23\x32\x12\xb9\x23\xba\xfd\x91\xf9\x10\x3f\x94\x4b\xc3\xba\x0d\x06\x8a\x67\xdc\xbe\x87\xd9\xd3\x2e\x7d\x5f\x02\x9b\xd8\x98\x2a\x14\x26\xdf\xf3\x72\x42\xc6\xfb\xac\x57\xc7\x73\x0e\x7b\x9e\x33\x23\xd5\xbc\xe3\x8e\xb9\x48\x14\x09\x3a\x35\x71\xb5\xc4\xd2\xfb\x85\xbe\x66\x39\xbd\x26\x2a\xca\x0f\x03\xe3\xd5\x39\x04\xe7\xa5\x64\x42\x98\x5b\x0a\x92\x55\x93\x5e\x4d\x28\xde\x25\x11\x78\x2d\x15\xdf\xf8\xd2\x71\xf2\xb6\x45\x3a\xcc\xa1\xa2\x6d\xfa\x83\xf8\x57\x61\x14\xa5\x1e\xcf\xbf\x59\xb1\x24\xd1\xc8\xe4\xf1\xe6\xf0\x44\x7e\x0e\x62\xe0\x9f\x2e\xf9\xc9\x58\x4c\xa8\x7a\xe5\x63\x42\xe9\x38\x52\xc4\xb7\x6c\x6e\x23\x9f\xa5\x10\x0a\xef\xab\x54\xbc\xf9\xff\x58\xfd\x38\xc6\x20\x26\xb1\xc3\x61\xbf\x7b\x87\x77\x32\xd2\x54\x4a\xf4\x97\xca\xdc\x54\x5b\xe4\x9a\x2b\x01\xa6\xcf\x2d\x0c\x1f\xe8\x65\x33\x5d\x3b\xf4\x36\x69\xad\x4b\x7d\x41\xf1\x47\xf1\xbb\x77\x5a\xa6\x42\xf4\x4a\x31\xcd\x38\x06\xcb\xc8\x4f\x6d\x4a\x87\xc9\x6c\x03\x00\x00")
 
 func templateDialectSqlSelectTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -754,12 +887,32 @@ func templateDialectSqlSelectTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/select.tmpl", size: 809, mode: os.FileMode(420), modTime: time.Unix(1567539807, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/select.tmpl", size: 876, mode: os.FileMode(420), modTime: time.Unix(1792022315, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x6d\x6f\xdb\x38\xf2\x7f\x6d\x7f\x8a\x59\x23\xcd\x4a\xf9\xbb\x4a\xda\x77\xff\xf4\x52\x20\x97\xa4\x80\xef\xae\x71\x37\xee\xde\xbd\xe8\x16\x05\x23\x8d\x62\x5e\x64\xca\x21\x69\x27\x39\x43\xdf\xfd\x30\xa4\x1e\x28\x59\x76\xed\x38\x68\xbb\xe8\x2d\xb0\xad\x25\x3e\xcd\xc3\x8f\x33\xbf\xa1\xd8\xc5\xe2\xf0\xa0\x7b\x96\x4e\x1f\x25\xbf\x19\x6b\x78\x7d\xf4\xea\xff\x5f\x4e\x25\x2a\x14\x1a\xde\xb1\x10\xaf\xd3\xf4\x16\x06\x22\x0c\xe0\x34\x49\xc0\x74\x52\x40\xed\x72\x8e\x51\xd0\xfd\x38\xe6\x0a\x54\x3a\x93\x21\x42\x98\x46\x08\x5c\x41\xc2\x43\x14\x0a\x23\x98\x89\x08\x25\xe8\x31\xc2\xe9\x94\x85\x63\x84\xd7\xc1\x51\xd1\x0a\x71\x3a\x13\x51\x97\x0b\xd3\xfe\x8f\xc1\xd9\xc5\xe5\xe8\x02\x62\x9e\x20\xe4\xef\x64\x9a\x6a\x88\xb8\xc4\x50\xa7\xf2\x11\xd2\x18\xb4\xb3\x98\x96\x88\x41\xf7\xe0\x30\xcb\xba\xdd\xc5\x02\x22\x8c\xb9\x40\xe8\x45\x9c\x25\x18\xea\x43\x75\x97\x1c\xce\xa6\x11\xd3\xd8\x83\x2c\xa3\x1e\x7b\xd3\xdb\x1b\x38\x3e\x81\xbd\x60\x14\xa6\x53\x0c\x3e\xb0\xf0\x96\xdd\x60\xd1\x7a\x3d\xe3\x09\x49\x7b\x7c\x02\x53\xa6\x42\x96\x94\x1d\xff\x9a\xb7\xe4\x1d\x25\x86\xc8\xe7\xb6\x67\xf9\xbb\x1c\x9e\x77\x4a\x05\x52\xfb\x98\xa9\xd1\x2c\x8e\xf9\x43\xd5\xa1\x37\x14\x85\x48\x2f\x61\xef\x3f\x28\x53\xea\x78\x04\x59\xb6\x58\x00\x8f\xed\x50\xf3\x60\x1b\x4f\xa0\x27\x78\xd2\xb3\xaf\x50\x44\xe5\x50\x89\x9a\x46\xf6\x44\xaf\x6d\x2c\xb5\x92\xae\x57\x85\x84\xee\xf8\x6e\x3c\x13\x21\x78\x35\x6d\xb2\x0c\x0e\x5c\x3b\x64\x99\x0f\xea\x2e\x19\xb1\x39\x7a\xa1\x7e\x80\x30\x15\x1a\x1f\x74\x70\x66\xff\xf6\x8b\xe1\x9a\x46\xd6\x96\x37\xd3\x04\x97\x6c\x92\xcb\x82\x89\xa2\x5f\x5c\xe8\x52\x82\x3e\xa0\x94\xf4\x7f\x2a\x7d\x58\x74\x3b\x0a\x13\xe3\x65\x52\x48\xdd\x25\xc1\xc8\x3c\x9b\x15\x1c\x3f\x05\xb5\x65\xce\xd2\x64\x36\x11\x2a\x08\x82\x6a\x0d\x33\x60\x70\x4e\x42\x2a\xcd\x84\x76\xb5\xf6\x83\x77\x32\x9d\x78\x34\xfd\x47\x76\x9d\xe0\xd2\xec\xe6\xad\xef\x77\x3b\x64\xde\x6a\x9d\x6e\xa7\xd3\xec\x39\x38\x6f\xda\x2e\xe0\x91\xef\x15\x5a\xe4\x53\xe4\x32\x75\x3b\x9d\x38\x95\xf0\xa5\x0f\x53\x03\x19\x26\x6e\x10\x9a\xc3\xa7\x12\x23\x1e\x32\x8d\x8a\xcc\xd1\xe9\x4c\xdd\xc9\x3a\x59\x3e\xa1\xf5\x5e\x47\xa6\xf7\x8a\xa6\xda\x27\x5d\xae\xd2\x7b\xb5\xc8\xba\x9d\xbb\x19\xca\xc7\x3e\x30\x79\x63\xda\x8a\xe1\xc1\x6f\xf4\xde\xf3\xbb\x1d\x1e\x1b\xa3\x9f\x2c\xad\x1d\x49\xfa\x95\x77\x0c\xf5\x43\x1f\x9c\xb9\xfa\x40\xab\xf9\x6f\xcc\xd8\x5f\x4e\x40\xf0\xc4\x48\x28\x51\xcf\xa4\x80\x12\xa6\xb9\x4f\xbb\x24\x6b\x84\x31\x4a\x33\x2e\x38\x4b\x52\x85\xb4\xfa\x9c\x49\xe0\x91\x82\x4f\x9f\xb9\xd0\x5d\x63\x11\xd3\xe1\x12\x1f\xb4\x67\x30\x90\x77\x01\xd3\xbe\xec\x03\xeb\x04\x07\xce\x70\x02\xfb\x35\xa4\x85\xa9\x88\xf9\xcd\xf1\x92\x7e\xf6\xbd\x99\x23\xb7\xc1\xb1\x35\x82\x3b\x9b\x01\x07\xd9\xd2\x6b\xd7\xb7\x5d\xe3\x78\xa2\x83\x0b\x42\x71\xec\xf5\x8a\x10\x93\x65\xc7\x10\x33\x9e\x60\x04\x2a\x64\x42\x70\x71\x43\xaa\x92\x5e\x29\xb8\x02\x1f\xc3\x8b\x79\xcf\x58\x8d\x7c\xdc\xb1\x02\x46\xd6\x41\xa4\x3a\x01\x79\xa0\x46\x5a\xd2\x0c\x39\xb6\xaf\x6a\x90\xf3\xfc\x02\xfa\x3c\x06\x91\xea\x62\xcc\xc0\x20\x9f\x8b\x7c\x07\xb9\x83\x06\xe7\x7e\x63\xbb\xd4\x5b\xab\x20\xd1\x69\x80\xd8\x31\x9e\xf1\xdc\x28\x64\xc2\xdb\xe7\xd1\x33\xd9\x4a\x22\x8b\x48\x51\x1e\xb5\xd8\xc5\x45\x7f\x87\x60\x74\x02\x6c\x3a\x45\x11\x79\x3c\x52\x7d\xe0\x91\xdf\x2d\x36\x49\x0d\x34\xea\x9e\xeb\x70\x0c\x82\x84\x4e\x50\x50\x6f\xff\x8d\x91\x31\x64\x0a\x41\xc0\xc9\x09\x1c\x1d\x77\x57\x48\xbc\x7f\x21\xe5\x65\xaa\xdf\x51\xa2\x5a\x90\xf8\xa3\xa9\xe4\x42\xe7\xf2\x17\x6e\x84\x7b\xae\xc7\x95\xd8\x2d\x81\x21\xab\xd6\x7b\x0b\xaf\x56\x2e\xb7\xca\x40\x93\x54\x22\xe8\x31\x13\x40\x7a\x2d\x2f\x4d\xb9\x52\xd1\x8b\x75\x32\x38\x51\xa4\xf4\x28\x8f\x4b\xa3\x18\x43\x58\xe7\xb5\x89\x26\x78\xb2\x1c\x86\x88\x39\x90\xb9\xf5\x18\x25\xfe\x4a\x89\x79\x82\x7a\x4c\x3e\xd4\x29\xd8\xdc\xdb\x07\xa5\x99\xd4\xc0\x40\x4b\x26\x14\x0b\x35\x4f\x45\x00\x26\x6b\x77\x28\xd2\x38\xbb\xb1\x25\x24\x7d\x7c\xa0\x78\x54\xc5\xae\x0d\xe3\x4f\x01\x83\xe0\x1d\xc7\x24\x52\x56\x59\x8a\x2e\x9e\xd5\x4f\x99\x1c\x73\x85\x6a\x96\x50\xa8\xe9\x14\x29\xcf\xe6\x9e\xdf\x8d\xe4\x2b\xb2\x43\xf0\x2f\x52\xd6\x24\x91\x81\x18\x08\xad\xda\x72\x54\x33\x05\x11\x40\x29\x4f\x51\x6e\xe9\xf8\x39\x9c\x6d\x1e\xd8\xfb\xd2\x87\xbd\xd8\xd2\x12\x57\xda\x42\x87\x54\x82\x67\x36\x76\x1c\x0c\x26\x93\x99\x36\x42\xd0\x93\x95\xf2\x1c\x63\x36\x4b\x74\x3e\x86\xcc\x34\x67\xc9\x0c\xdb\x4c\x4a\xcf\x71\x30\xd2\x72\x16\x6a\xb3\x12\x64\xd9\x9b\xbc\x7b\x6d\xef\x96\xe6\x8b\x83\x81\xfa\xdb\x68\x78\x59\xcc\x4e\x86\x8a\x4b\x97\xfd\x5b\xa5\x22\x78\xcf\xa4\x1a\xb3\xc4\x3b\x30\xf3\xf8\x79\xb7\x65\x6f\x75\x56\x05\x05\xe3\x32\xf3\x5f\xb5\x86\x71\x46\x30\xc2\xd6\xfc\xbf\x17\xd7\x2d\x7b\x3d\x8b\xfd\x4a\x6c\x27\x5a\x6d\x3f\x55\x4d\x89\x5a\xc4\xe9\xb4\xe5\xa3\x96\x94\x44\xb3\x16\xe4\x31\x2e\x37\x69\x11\xd0\x73\x3f\x5e\xf2\x24\x21\x37\xe6\x4c\xc9\x2e\x62\x96\x6e\x5d\xd9\xfe\x59\xf9\xe4\xe3\xe3\x14\x83\xcb\xd9\x04\x25\x0f\x4b\x49\xd6\x39\x9e\x45\xd1\xe6\xbe\x2f\x6d\x76\x1a\x45\x5b\xdb\xac\xdd\x48\x8e\xec\x8e\xea\x45\x23\x89\xbe\x99\x19\x9b\x70\xea\x74\x0e\x36\x1b\xf8\x7f\x27\xb9\x98\xe5\xc8\xcc\x02\xc5\x99\x6a\x53\x4f\x36\xe6\x71\x55\xac\x63\x6f\xd3\x29\x97\x84\x6b\x02\x6f\xe9\x45\x05\x88\xea\xed\xf2\x93\x35\xf8\x70\x4a\x01\x97\x25\x4e\x80\x58\x62\x47\x09\x32\xd9\x06\x90\x66\x38\x68\x20\x7f\x8d\x4f\x37\x35\xa6\xcd\x2a\x2b\xec\x47\xf1\xda\x22\xd4\x0a\x47\xb8\x7f\xc2\x1a\xae\x6d\xdb\xf6\xb4\xfb\xec\xc4\x8b\xcb\x59\x92\x7c\x1d\xff\x7e\xb5\x43\x6b\x73\xd5\xe9\x4a\x0c\xbf\x14\x33\x5f\x4c\xa6\xfa\x31\xa7\xbb\x4d\xc6\x5e\xf4\x29\x09\xbb\xcb\xb8\xf4\x43\x70\xf1\x80\x61\x0b\x3d\xdf\x97\xb8\x31\x5d\x95\x69\x92\x5c\xb3\xf0\xd6\xcb\x53\x6f\xc9\xaf\x5c\x6a\x60\x32\xe7\x45\x74\x83\xca\x30\xc4\xc3\x03\x40\xa1\x0d\xd3\x48\x67\x1a\x62\x93\xa4\x28\xee\xda\x77\x80\xa6\xa7\xcd\xe8\xc6\x69\xcd\xfc\x5a\xa7\x0c\xb5\xc4\x87\x36\xf1\x15\x8b\x39\xb4\x1f\x83\xf7\xaf\xdf\x57\xbc\x93\x68\x4a\x13\xb8\x12\x27\xe9\x1c\x23\xc7\xef\x58\xf8\xdd\x87\xb7\x05\x9b\x31\x33\xee\x31\xa7\xc6\xde\xbb\xa6\x87\x57\x55\xd1\x8c\x86\x31\xcf\x51\x96\x9c\x98\x41\xd9\x61\xef\x1a\xca\x91\x0e\x56\x90\x48\xe8\xf1\x09\x4c\xd8\x2d\x7a\xa6\xa6\xe9\x6f\x2d\xa4\x45\x0f\x55\x42\xc8\xa3\xd5\xa5\xe1\x9a\x29\xdc\x2d\xaa\x71\x32\x4d\x98\x6e\x3d\x02\x39\x0c\x53\xd2\x4f\xf3\xa8\x47\x46\x7f\x59\x00\x1e\x6b\x54\x1a\x0d\x97\x46\x4b\x16\x8b\x38\xd3\x2c\x2b\xef\x92\xe0\x1c\x13\x6c\x21\x48\xf4\x8c\x96\x26\xb9\x5b\x24\xb0\x4b\x6d\xc4\x9b\x30\xf8\xf0\x77\x67\xec\x27\xeb\x8b\x2c\xfb\x5c\x31\xa8\x5d\xa7\xbb\xb6\xd3\x61\x63\x3e\x67\xd3\xed\xb4\xeb\x36\xdf\x76\x8d\xc4\x8e\xc1\x08\x93\xf8\x0a\xe3\x62\xd3\x11\xfe\xcd\x06\x53\x98\xc4\x20\xa9\xa4\x46\x11\xa2\xd9\x69\xa6\xc3\xc7\xe1\xf9\xf0\x18\x66\x0a\x61\x78\x55\x1c\x99\x99\x2a\x83\x5d\xa7\x73\x2c\x48\x76\xd3\x87\x3b\xb8\x70\x67\xa3\x37\x6c\xbe\x33\x26\x9a\x4e\xac\x79\x71\x47\x3f\x6e\xe3\xc9\xf6\x9c\x5c\xab\xa0\xad\x8b\x87\xcf\x14\xd3\x7e\xe6\xe8\xb3\xa2\x3c\x5b\x0f\xdd\x75\x19\x1d\x03\x7b\x92\xf8\xf4\xa0\xb5\x34\x7e\xb3\x70\x85\x96\xcb\x2f\x2b\x41\x6f\x9b\x15\xe4\x8f\x12\xb0\x1a\xe7\x42\x26\x12\x0d\x5f\x0f\xa9\x50\x7d\xff\x7a\x58\x06\x9d\x55\x44\x73\x2d\x92\x7e\x44\x6f\x6f\xe5\xa4\x1f\xc4\x47\xab\x93\x0a\x79\x6a\x55\x52\x59\x95\x2b\x9e\xe4\x82\xa7\xfa\xe0\xf9\xb6\x5c\x3d\x19\xec\x96\x0b\xb6\x48\x05\x6b\x33\x81\x53\x12\xb4\x85\x6b\xab\x5a\xbd\x0a\x73\xc2\x7e\xee\x55\x26\x22\xea\xf6\xbb\xe0\x77\x33\x7c\x8a\x8f\x79\xdc\x3c\x10\x35\xe7\x92\x1b\x83\x6d\xd5\x39\x65\xc8\xc4\xaf\x1a\x12\x2e\x6e\x8d\x0c\x54\x18\xc0\x1f\x3d\xab\x54\xbe\xd1\xff\xe8\x81\x4e\xe1\x45\x04\x26\x1f\x84\xa8\xc0\x7b\x0b\xaf\xfc\x5e\x1f\x84\xef\x02\xf8\xab\x69\xa9\xcd\x54\xbb\xe6\xa3\xe7\x0a\x3f\x06\xfc\x9b\xe3\x96\x52\x5e\x39\xb2\x82\xff\xc5\x6f\x1b\x1f\x2d\x7e\x3a\xfa\xec\xfb\xc1\xf7\xc4\xfb\xb3\x85\x8d\xed\x4c\x97\xeb\xbe\xda\x7a\xdb\xa6\x58\x3f\x38\x15\x91\xe7\x07\x03\xb5\x55\xf0\xfa\xce\xc6\x67\x71\x8c\xa1\xc6\xa8\x3c\x9b\x95\xa8\xcc\x77\xc1\xd3\xbc\xa1\x21\xd8\xce\x0b\xf2\x18\xb8\xd0\x5e\xb1\xae\x0f\x7f\xd9\x22\x9e\x6d\xbc\xec\xfe\x85\x94\xc6\xca\x92\x71\xa1\xdf\x99\xcf\x44\x8b\x89\xba\x39\x86\xda\xb7\x98\xe5\x10\xe3\xbd\x98\xfb\xc0\x12\x89\x2c\x7a\x84\x30\x15\xc2\x48\x49\x91\x87\x41\xc4\x63\x13\x10\x75\x1e\x9a\xaa\x61\x3d\x8b\x80\xac\xa6\x6a\x75\xb6\x53\xb1\x78\xaa\xcc\x8a\x73\x00\x73\xbc\xe4\x72\xf1\x9c\x8d\x1f\xf5\xcb\xf0\x5a\x11\xed\x2f\x84\xd8\x2a\xa6\x11\xfd\xcd\x8d\xb1\x53\xbc\x7b\x72\xc0\x2b\xa4\x2f\x39\xb8\x7d\xee\xdb\x8f\xb4\x0b\x1e\x19\x8b\x64\x7d\x68\xe3\x13\xb6\x0f\x52\x27\x1e\x39\x5f\xd9\x4d\x0e\xa2\xd4\xf3\x52\x62\x0c\xa1\x44\xf3\x51\x9b\x8a\x53\x7b\x52\xc4\x05\x5c\xa7\x7a\x0c\xf7\xec\x51\xb9\x45\x6a\xf3\xb4\xfb\xf9\xcf\x6c\x9c\x1b\x1e\x96\x5f\x28\x94\xab\x82\xcd\xaa\x00\x95\x5f\x3a\xd8\xb6\x52\xdd\xaa\x50\xae\x01\x66\x5e\x61\x22\xf7\xd6\xa2\x7e\x58\xf9\x4f\xf3\xd6\x9b\x7f\x3a\xfa\xdc\x87\xf9\xa7\x57\x9f\xd7\xd4\x4f\x2d\x47\x8c\xdf\x94\x98\x2e\x6d\xa4\x61\xe1\x9b\x3f\x77\xc2\x7f\x72\xbe\xdf\xed\x6b\xe2\x8f\xc0\x74\xdb\xfc\x5a\x1d\x73\x7c\x25\xec\x4d\x0b\xb3\x7f\x28\xa4\xff\x4e\x81\x70\x1a\x0c\xa5\xe7\x3f\x99\x35\xac\xa4\x42\xdf\x0e\x55\xad\xa0\x22\x32\x33\xed\xdb\x68\xb7\x25\xa3\xf9\x21\xc0\xf5\x93\x33\x9b\x54\x20\xa4\x71\x4b\x0d\xf5\x62\xfe\x24\x7a\x73\x8b\x8f\x6a\x33\x55\xd6\xb2\x20\xa7\xce\x2c\xd3\xf7\xfa\x7d\x5e\x5e\xc8\x28\x76\x90\x73\xad\x29\xb7\x99\x21\x12\x2a\xf7\xb2\xd2\x92\x76\x6a\x70\xaa\x53\xee\x6d\x2e\x35\xd5\x02\xd5\xd9\xaf\x7a\x9e\x43\xdd\x96\x6f\x93\x79\x80\xda\x4a\x30\x67\xae\x96\x5b\x05\x75\x3a\x93\x2f\xf3\x67\xad\x47\x9f\x5c\x44\x15\x8b\xd6\x42\xce\xb2\xf9\xbf\x93\x5d\xd6\x9a\xe5\x9b\x15\x9a\xab\x6d\xe4\xc0\xea\x7f\x61\xfa\x27\x0f\xd3\x05\x16\x1a\x37\xf6\xca\x2b\xc0\xfa\x21\x38\x4b\x27\x13\xae\xbd\xed\x6e\xf6\x56\x6d\xf5\xdb\xe6\xcd\x6b\xe6\x76\xbf\x16\x15\xb0\x73\xed\x5b\xf0\xa4\x6b\x2f\xec\x17\x57\xd1\xd7\xde\xdd\x77\x09\x5b\xe6\xde\xec\x6b\x4f\x24\xab\x93\x48\xce\xd2\xda\xd2\x82\xb5\x48\xcd\xf1\xaa\x00\x5c\xa9\xef\xe1\x01\xe4\xbf\xb9\x32\x17\xb9\x6e\xc5\x7d\x2a\x80\x69\xfb\x6f\x12\xa6\x29\x17\xba\xac\x66\x1b\xb7\x29\xec\xc5\xdc\x4a\x62\x7b\x3b\xb7\x5b\xe6\x11\x42\xb2\x95\xcf\xf1\x55\x65\xa1\xff\x06\x00\x00\xff\xff\x71\x5e\x3c\xcf\xa1\x31\x00\x00")
+var _templateDialectSqlTouchTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x53\xd1\x4e\x03\x37\x10\x7c\x3e\x7f\xc5\x12\xa5\xe8\x82\x12\x87\xf2\xd6\x20\x2a\x21\x08\x52\x24\x8a\x0a\xa1\xea\xb3\xb1\xf7\x72\x16\x8e\x7d\xac\xf7\x12\xa2\xd3\xfd\x7b\x65\x27\xa9\x52\xa0\x8f\xb7\xb3\x3b\xb3\x33\xb7\xee\xba\xe9\x85\xb8\x0b\xcd\x8e\xec\xaa\x66\xb8\xba\xfc\xf5\xb7\x49\x43\x18\xd1\x33\x3c\x28\x8d\x6f\x21\xbc\xc3\xc2\x6b\x09\xb7\xce\x41\x6e\x8a\x90\x70\xda\xa0\x91\xe2\xb5\xb6\x11\x62\x68\x49\x23\xe8\x60\x10\x6c\x04\x67\x35\xfa\x88\x06\x5a\x6f\x90\x80\x6b\x84\xdb\x46\xe9\x1a\xe1\x4a\x5e\x1e\x51\xa8\x42\xeb\x8d\xb0\x3e\xe3\x8f\x8b\xbb\xf9\xd3\x72\x0e\x95\x75\x08\x87\x1a\x85\xc0\x60\x2c\xa1\xe6\x40\x3b\x08\x15\xf0\x89\x18\x13\xa2\x14\x17\xd3\xbe\x17\x22\x79\x00\x0e\xad\xae\x21\x22\xc7\x3c\xdd\x36\x46\x31\x4e\xd8\xae\x11\x2a\x8b\xce\x24\x02\x05\x3e\xed\xc8\x01\x2c\xc7\x43\x0b\x18\xac\x54\xeb\x58\x42\x26\xeb\xba\x54\xb0\x1e\x61\x60\xac\x72\xa8\x79\x1a\x3f\xdc\x34\xb3\x0f\xa0\xef\x45\xd1\x75\x13\x18\x56\x30\xbb\x81\xa1\x5c\xea\xd0\xa0\x7c\xc8\xfc\x47\x8c\x71\xdd\x38\xc5\x5f\x08\xac\xdf\x28\x67\x93\x60\x1c\xc0\x30\x13\x6d\x14\xa5\x24\x21\x7e\x38\xf9\x82\xb1\x75\x2c\x8a\x8f\x16\x69\x37\x06\x45\xab\x98\x14\x12\xf4\x57\x5e\xb3\xec\x3a\x18\xca\x3f\x95\x7e\x57\x2b\x84\xbe\x97\xaf\xea\xcd\xe1\x48\x8a\xa2\x58\x22\x7f\x43\xd3\x77\x25\xef\x82\x8f\xac\x3c\x43\xdf\x8f\xe1\xe7\x96\x3d\xfb\xfd\x3e\x83\x27\xb5\x4e\xd3\xe5\x28\xf3\xfe\x5d\x23\x61\x99\x56\x98\x3f\xff\x28\x20\x17\xf7\xff\x95\xb0\x66\x3f\xf9\x9c\x5c\x94\x23\x51\xd8\x0a\x90\x28\x39\xd1\xd2\x90\xdd\x20\xc9\xf9\x27\xea\x52\xf3\xe7\x18\x4e\xbc\x8e\xe1\x9c\x30\x8e\xae\x73\xf7\xd9\x0d\x78\xeb\xa0\x13\x45\x41\xc8\x2d\xf9\x54\x15\x45\x2f\x0a\x55\x55\xa8\x19\xcd\xf8\xc8\x4a\x18\xe5\x4b\xd8\xc6\xdb\x03\x70\xa2\xf9\xff\x2c\xd3\x29\xfc\xb1\x5b\x3e\x3f\x02\x61\x13\xe8\x70\x2f\xbe\x5d\xbf\x21\xa5\x23\xd1\xb5\xf2\x2b\x34\x40\x61\x1b\xa1\x54\xde\x80\x0f\x0c\x6b\xc5\xba\x46\x33\x1a\x43\xaa\x70\x8a\xa6\x0a\x84\xe3\x4c\x97\x0e\x0e\x3f\x6d\x64\xf4\x1a\x13\x47\x2a\x50\xd8\xa6\xd7\xa0\x6b\xd4\xef\x68\x20\x78\xb7\x03\x5b\x81\x0f\x7b\xe6\x2d\x12\x1e\xb5\x64\xde\xfa\xe8\x0e\x7e\x87\xcb\xd3\xbd\xbd\x75\xd9\x7d\xdc\x5a\xd6\xf5\x5e\xe8\xdf\x04\xb4\x3c\x84\x2d\xf7\xbf\xeb\xeb\x7f\x5a\xdc\x97\xf9\xaf\xcc\xd3\x54\xca\x7d\x74\x9d\xb8\xb5\x8a\x78\x12\xd3\xec\x4b\x4a\x19\x3e\xcb\x4a\x27\xd0\xf9\x9c\xe8\x29\xf0\x43\x7a\xb9\x5d\xb5\x66\xb9\x6c\xc8\x7a\xae\xca\x41\x56\x3d\x5c\x0f\x6c\x2d\xd7\x60\xcd\x0c\x7e\xd9\x0c\xf2\x4d\xf4\xa2\x38\x3c\xb2\xd9\x37\x57\x5d\x37\x01\xf4\x06\xfa\x5e\xfc\x33\x00\x90\x89\x37\x4c\x8b\x04\x00\x00")
+
+func templateDialectSqlTouchTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateDialectSqlTouchTmpl,
+		"template/dialect/sql/touch.tmpl",
+	)
+}
+
+func templateDialectSqlTouchTmpl() (*asset, error) {
+	bytes, err := templateDialectSqlTouchTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/dialect/sql/touch.tmpl", size: 1163, mode: os.FileMode(420), modTime: time.Unix(1792003388, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateDialectSqlUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5c\xeb\x73\xdb\x36\x12\xff\x2c\xfd\x15\xa8\x26\x71\x49\x9f\x42\x27\xf9\x76\xe9\xf9\x66\x72\x89\x33\xe3\x6b\x62\x37\x71\x7a\xfd\xe0\x78\x32\x34\x09\xda\x3c\x53\xa4\x42\x52\x8a\x7d\xae\xfe\xf7\xdb\x5d\x00\x24\xc0\x97\x48\x59\x89\xdd\x69\x3b\xd3\x89\x05\xe2\xb1\xd8\x5d\xfc\xf6\x81\xc7\xed\xed\xde\xee\xf8\x55\x32\xbf\x49\xc3\x8b\xcb\x9c\x3d\x7f\xfa\xec\xef\x4f\xe6\x29\xcf\x78\x9c\xb3\x37\xae\xc7\xcf\x93\xe4\x8a\x1d\xc6\x9e\xc3\x5e\x46\x11\xa3\x4a\x19\xc3\xef\xe9\x92\xfb\xce\xf8\xe3\x65\x98\xb1\x2c\x59\xa4\x1e\x67\x5e\xe2\x73\x06\x3f\xa3\xd0\xe3\x71\xc6\x7d\xb6\x88\x7d\x9e\xb2\xfc\x92\xb3\x97\x73\xd7\x83\x7f\x9e\x3b\x4f\xd5\x57\x16\x24\xf0\x79\x1c\xc6\xf4\xfd\xed\xe1\xab\x83\xa3\x93\x03\x16\x84\x11\x74\x21\xca\xd2\x24\xc9\x99\x1f\xa6\xdc\xcb\x93\xf4\x86\x25\x01\x94\x96\x83\xe5\x29\xe7\xce\x78\x77\x6f\xb5\x1a\x8f\x6f\x6f\x99\xcf\x83\x30\xe6\x6c\xe2\x87\x6e\x04\x0d\xf6\xb2\x2f\xd1\xde\x62\xee\xbb\x39\x9f\x30\xa8\x02\x35\x1e\xcd\xaf\x2e\xd8\x8b\x7d\xf6\xc8\x39\xf1\x92\x39\x77\x7e\x71\xbd\x2b\xf7\x82\xab\xaf\xe7\x8b\x30\x42\x6a\xa1\xc6\xdc\xcd\x3c\x37\x2a\x2a\xfe\x4b\x7e\x91\x15\x81\x1e\x1e\x2e\x45\xcd\xe2\xef\xa2\xb9\xac\x94\x00\x2d\xf0\xfd\xd2\xcd\x4e\x16\x41\x10\x5e\x97\x15\x26\xc7\xb1\x22\xe9\x09\x7b\xf4\x3f\x9e\x26\x58\x71\x12\x87\x51\x59\x9a\x25\x41\x1e\x5c\x09\x62\xdf\x70\x37\x5f\xa4\xfc\x20\x76\xcf\x23\x60\xe9\x44\x7c\x2b\xeb\xba\x0b\x3f\xcc\x9b\xab\xd2\xa7\xb2\x66\xca\xa9\xde\xe4\x33\x16\x01\x91\x61\x20\xe8\xa4\x1f\xf4\x15\x3b\xf9\xa0\xa6\x44\xc5\x3c\xf6\xb1\xfd\x38\x58\xc4\x1e\xb3\x8c\xe9\xaf\x56\x6c\x57\x67\xdc\x6a\x65\x33\xe0\xfa\x89\xbb\xe4\x96\x97\x5f\x83\x36\xc4\x39\xbf\xce\x9d\x57\xe2\x5f\x5b\x35\xcf\xb1\xa5\x31\x3c\x75\xe3\x1c\xb9\x33\x49\x0b\x8f\x32\xfc\xeb\xf4\x8c\xca\x0f\x5f\x3b\x1f\x6f\xe6\x5c\xa7\x67\xca\x78\x9a\xe2\xff\x49\x6a\xb3\xdb\xf1\x08\xa7\x97\xf3\xd9\x3c\x02\x71\x9b\x2a\x10\xc6\x4b\x37\x0a\x51\x0d\xb2\x09\x7b\x84\x53\x19\x65\x3c\x22\x8d\x42\x5e\x40\x15\xe7\x84\x7e\x13\x71\x9a\x4e\x38\x82\x42\xa8\x46\x44\x5a\x6d\xdc\xb5\xa1\xee\xab\x24\x5a\xcc\xe2\xcc\x71\x9c\x92\x78\x45\x3a\xcc\x3e\xcb\xdd\x38\xd7\xc9\xb7\x9d\x37\x69\x32\xb3\x70\xf0\x8f\xd8\x59\x6d\x6c\x2a\xb5\x6d\x20\x2d\x7f\x2d\x26\x53\x65\xbd\xe3\xa7\xf8\x97\xa3\x3e\xdb\xb6\xe0\x42\xc9\xd4\xf1\x68\x54\xed\xf6\xf0\x75\xad\x9b\xd0\xb7\x2d\xc5\x10\xd9\x85\x14\x79\xc1\x27\xe7\xb7\x30\xbf\x94\x42\x44\xc1\x42\xb5\x00\xd8\xf2\x79\xca\xe6\xb4\x04\xdc\x18\x7a\xaf\xf6\x0b\x08\xe2\x87\x1e\xb2\x1d\xe5\x33\x9a\xeb\x83\x40\xdf\x40\x26\x4a\x10\x04\xa0\x06\x39\x48\x53\xcb\xfe\x89\x4a\x7f\xd8\x67\xb0\x1a\xa8\x1d\x68\xcb\x22\x8d\xa9\x77\x5a\x29\x52\xf4\xd4\x87\x9a\xae\x58\x00\x72\xc2\x4f\xd8\xde\x2e\x81\x87\x28\xcd\x62\x77\x9e\x5d\x02\x8c\x84\x88\x59\xae\xaf\xa0\x25\x07\xaa\x33\xd7\xcb\xc3\x24\x16\xb8\xc2\x99\x00\x8b\x29\x73\x61\xfa\x61\xfe\x23\xa0\x58\xe2\x5d\x71\x6a\xf1\xee\xe6\xe4\xfd\x5b\x87\x11\xd6\x8c\x46\xf9\xb5\x50\x3f\x98\x7b\x8b\x50\x3e\x5e\x4b\x3e\xa9\x79\x6a\x33\x6a\x9f\x12\xce\x09\x1b\xac\x93\x34\xdb\xdf\x67\x52\xc5\x1d\xa2\x4c\xf4\x5b\x70\xf2\x4d\x92\xfe\x4a\x73\xb1\x6c\xd1\xa9\x2e\xd4\x34\xf9\x9a\x21\xe5\x3b\xa8\x7c\x1f\xe0\xc7\x2d\x14\x7e\x59\xf0\xf4\x06\x66\x9e\x5e\xd0\xb7\xa2\xa7\xf7\x58\x8e\xbd\x14\xe2\x92\xcb\x56\xb1\x3c\xbf\x36\x15\xbe\x4e\x76\xa1\xf3\xb2\x33\x0f\xb9\xa7\x8d\x37\x65\x48\x51\x3f\xc1\x57\x06\x4f\x93\x28\x3a\x07\xe5\xb6\xa4\x40\xec\x92\x16\xf8\x55\x62\x17\xb2\x00\xac\x03\x50\x85\x43\x39\xaf\xa2\x24\x23\xd6\x2c\xdd\x94\x85\x7e\xc6\x4e\xcf\xc2\x38\x2f\xd4\x09\xc5\x2f\x87\xb0\x62\x50\x1c\x5c\x4d\xb6\xd0\x2e\x6c\x10\x83\x89\xc3\x26\x06\x64\x99\x2c\xc6\xc5\x41\x23\x1d\xe1\x82\x21\x7c\x92\x63\x31\x1a\xa8\xbe\x4e\xc5\x42\xd5\x80\x17\x38\xbd\x63\x60\x22\x60\x69\x10\x5e\xbc\xa8\xe9\x86\x28\xa7\x3e\xa4\x8c\xa4\x56\xea\xbd\x11\xda\xa0\xac\xad\x66\x5e\x0b\x8a\x1e\xc9\xd6\xf3\x14\xa8\x0c\xd8\x24\x98\xe5\xb8\x28\x93\x34\xb0\x3e\x4d\x1e\x67\x2f\x58\xe0\x86\x88\x7b\x60\x1a\xe3\x38\x8c\x2f\x70\x8e\x38\xa1\x84\xe1\xc7\xc7\x8f\x97\x9f\x26\x42\x0c\x13\x61\x69\x35\xee\x8c\x86\xcb\x13\x2b\x22\x41\x00\x96\xa6\x8a\x89\x42\x4d\xba\x62\xdd\x8c\x80\xbb\x85\x7a\x22\xee\x1e\x66\x27\x79\x8a\x64\x4a\x28\xfe\x60\x80\x9e\x55\xf4\x0a\xf5\x49\xcc\xa2\xcd\x21\x01\x35\xcc\xca\xaa\x35\x3a\x7c\x5d\xa1\xa4\xf2\x55\x27\x89\xd4\x41\xf6\xae\xe3\xd3\x08\xd5\x87\x96\xdf\xe6\xd2\xc5\x2e\xd6\x4b\xb4\x89\xdf\x06\x83\x35\xf1\x4e\x94\x73\xb4\x5a\x75\x48\x59\xa7\x18\xe4\xbd\x94\xd2\xb6\xfb\x48\x80\x68\xee\xc3\x75\xaa\x68\x70\x5a\x96\x18\x02\x17\x8b\x70\x9f\xb9\xf3\x39\x14\x52\x23\x00\x12\xfc\xc7\xd6\x99\xbf\xaa\x70\x8e\x16\xe5\x09\x4c\xcc\xda\x01\xb3\xd7\x93\x69\x6b\xf8\x84\x46\x05\xe7\x18\xfa\x1a\x4f\x14\x4b\x74\x54\x18\x21\xd4\x14\x24\xc3\x8f\x29\xb4\xb1\xdb\x0c\xd9\xde\x9e\xf4\x80\x01\xad\xdd\x14\x3c\x6b\x04\x2d\x9f\x9d\x73\x00\x17\x5e\xb3\x60\x60\xdc\x16\xf8\x19\x91\xa7\x34\x65\xce\xb8\x36\x79\x89\x7d\x3f\xf5\x32\x4a\x35\x7c\xad\x5b\x93\x1a\x92\x65\x5f\xc3\xdc\xbb\x64\x31\x8e\x18\xf1\x18\xe7\x09\xa3\xe1\x10\x9e\x0b\x12\x89\xd1\x76\x3d\x7d\x31\x6e\x81\x9c\x1d\x60\xf4\x51\x92\xbf\xc1\xc0\xe0\x16\x19\x7f\x22\xbe\x10\x00\x31\xe8\xfa\x52\xf0\x59\x60\xcd\xe3\x0c\xbd\x97\xd5\x44\xa9\xa5\xbe\x74\xc6\xdf\x16\x72\xe4\x64\xfe\xc9\x9e\x99\x73\xe9\x40\xcf\x99\x10\x9c\x0b\xfe\x06\x30\x4b\x4d\x07\xa5\x95\x21\xf1\xb5\x79\x99\x28\xfa\x1d\xe7\xa6\x44\x5c\xac\x20\xe8\x54\x89\x92\xc4\xd7\xae\x31\x35\xef\x00\xbc\xdf\xd9\x2c\xcc\x2d\x7d\x3d\x87\x51\xd3\x68\xa6\x42\x69\x46\xb8\xd4\x2d\xa9\xfc\x4d\x06\x0e\xf4\x3a\x26\x9b\xde\xd0\x17\xe1\x8c\xe1\x23\x82\x87\x18\x92\xcf\x97\xf2\x1f\x31\x9c\x9c\x71\x88\x2b\x61\x0d\x03\xc8\x29\x27\x10\x5c\xf6\x34\x67\xae\xbe\xca\xbe\x8b\xff\x57\xa5\x5f\x38\xd7\x8f\xc0\xd7\x7e\xc4\x45\x8c\x77\xe0\x5f\x00\xf6\xa9\xf8\x8d\x18\xc5\x81\xcd\x0b\x70\xcf\x53\xfc\xf3\xdd\xf3\x63\xfa\x0a\x93\xf4\x40\x03\xc0\x91\x23\x25\xf3\xa8\x86\x4f\x30\x30\xd5\x70\x24\x4c\x11\x35\x78\x78\x11\x3f\xb9\xe2\x37\x12\x6c\x2e\x71\x54\xbf\x98\x30\x3a\x2f\xb2\x3d\x8c\xaa\x82\x63\xae\x8c\x81\x72\xa0\x9a\x7c\x57\x2f\xe2\xd0\x63\x73\xb3\xdf\x7f\x27\xbd\xaa\x36\x21\xad\x74\xc0\x74\x2c\xbc\xfc\x4d\xc8\x23\x0a\x98\x60\xa9\x49\xbd\x83\x41\x3a\x68\x99\x4a\x3f\x55\x56\xf9\xc0\x83\x4c\xb8\x9c\x6a\x11\x54\x22\x3c\x68\x49\xb1\x96\x16\xa5\x35\xd7\xab\x84\x72\x6d\x9d\x89\x48\xd0\xa8\x28\x20\x70\xb8\x81\xd6\x0d\x89\xbe\x26\x5b\xa4\xfe\x8e\x59\x09\xfd\x89\xe6\x14\x58\x09\x35\x91\x8d\x3c\x0a\x80\x09\xf6\x40\x95\xe0\xa4\x64\xc2\xf0\x90\x00\x0b\x5d\x10\x29\x07\x54\xc5\xa7\x12\x41\xce\xf1\xc7\xb3\x32\xa3\xa0\x53\x20\x6a\xb8\xac\xa8\x00\xb5\x8b\x96\x05\x06\x7c\x23\xfd\xba\x4f\x75\xe1\xce\x2f\x3f\x6b\x95\x4e\x05\x1b\x56\xab\xb3\xfe\xd5\xcf\x45\xf5\x6d\xaa\x0f\x31\x5c\xe3\xbc\x32\xdf\x0e\xad\xb3\xac\x94\x86\x25\x80\x2a\xa3\xfc\xc8\x07\x9e\x2d\x22\xe4\xff\x48\x65\x7a\x44\xde\x44\x06\x99\xcd\xb9\x0b\xe7\x37\x84\x57\x4a\x71\x1c\xc6\xe0\xdf\x65\x56\xaf\x55\x05\xb3\x75\x1c\x87\x9c\x4a\xe5\xca\x69\x10\x18\xc8\x34\x97\x46\xad\x9a\x03\xe8\xbe\x08\xd7\x02\xe7\x70\x36\x5b\xe4\x44\x04\xfe\x12\x54\xbe\xe6\x81\x0b\x93\x50\xd1\x08\x34\x58\xba\xd1\x82\x37\x61\x38\xfe\x0e\x2a\xf8\xf3\x93\xac\x6e\x88\xa0\x60\x1f\x0c\x99\xfd\xfb\xe4\xf8\x48\xf5\x8e\x8c\x0a\x0a\x1b\xf1\xdf\x0c\x6c\xc7\x3b\x37\xcd\x2e\xdd\xc8\xda\xa5\x7e\x6c\x59\xad\xc1\x3a\x8c\x3a\x2d\xc4\x48\xf9\xda\xa5\x30\x30\x47\xd4\xc8\xdb\xc0\xe4\x2c\x90\x64\x97\x64\x6b\xfe\xf1\xf0\xae\x24\x87\xde\xbf\xfd\x0f\x31\x65\x22\x26\x85\x49\x45\x7d\x84\xc2\x5b\x6f\x0a\x78\x1b\x62\x5e\x47\x5b\xa0\x41\xb1\x88\x55\x44\x21\x65\x7b\x14\x46\x11\x8a\x56\x26\x0d\xc5\x20\x34\x7c\xd1\xab\x92\x89\xaa\x7a\x92\x27\xa9\x4c\xf3\x8e\x5a\x46\x8e\x17\x51\xd4\x32\x7a\xe0\x02\xa7\xb4\xbe\xab\xd3\xd2\x7e\xaf\xc6\x26\x01\x98\xb4\x74\x8e\x16\x33\x9e\x86\x5e\xd1\xa6\x4b\xf3\x5c\xdf\xef\xaf\x7c\x85\xd0\x5e\xfa\x7e\x1f\xa1\x99\x9a\xd7\x28\x91\x06\xe6\x69\x1f\x15\xfc\xae\x97\x59\x55\x9f\x47\xa3\xdd\x7e\x0d\xff\xb6\x2f\xc9\x2c\x5a\xae\x84\xa6\x6a\x5d\xf5\x55\x9b\x4a\x3f\xfa\x14\x4d\xe5\xef\xdb\x65\x8d\xb8\xaa\x3a\xd4\x0a\x4a\x85\x28\x4b\xeb\xbf\x04\xc3\x8f\xe7\xe8\x63\xc2\x88\x25\x42\x35\xda\xba\x26\x05\xa9\xe2\x51\x65\x99\x75\xc8\xb4\x2f\x33\x41\x9c\xe3\x56\xfe\xa1\xc1\x10\x1a\x2a\x88\x93\xc9\xfa\xf1\x5d\x04\xd6\x67\x19\x0f\x5a\xc7\xc0\xb0\xfe\x82\xab\xfe\xd6\xf0\xf1\x08\x86\x58\xbf\xdc\xec\x12\x10\x8c\xbe\xcc\x84\x40\xc0\x7e\x50\x3d\x1f\xcc\xe6\xf9\x8d\xcc\x15\x56\xd3\xb1\xaa\x4e\x91\x8d\xd5\xc3\x7a\x88\xb1\x0e\xae\xb9\xd7\x90\x57\xdd\x01\xfb\xbd\x0d\xcf\x61\x80\x11\x26\xbf\x14\xad\xe1\x09\xee\xf1\x35\x19\xe4\x8a\xfd\xcd\x1a\x61\x90\x52\x24\xcd\x48\x88\x01\x83\x68\xa9\x85\x04\x3a\x3f\x44\x63\xb4\xc6\xa6\x23\xd7\x95\x50\xaf\xfb\x64\xe4\xc3\xdc\x21\x10\x08\x6a\x5e\xcd\x54\x4e\xb8\x49\x22\x03\x64\x52\x20\xd9\x9d\x4c\xaa\x4c\x42\xf5\xaa\xae\x08\x47\xb7\xac\xdd\xec\xb5\x6a\xb9\x91\x09\xd5\x82\x57\x88\x45\x70\x83\x19\x93\x20\xc9\x22\x67\x01\x69\x13\x7a\x29\xa2\x4c\x46\x20\x5a\x00\x5a\xf5\x46\xfb\x47\xca\x5a\xc6\x5d\x04\x4a\xa5\xce\x6e\x3b\x92\xd9\x28\x44\xa9\x6d\xbf\xc0\x2c\x5f\xf3\x88\x37\xf8\xd6\xcd\x21\x88\xed\x98\x3a\x51\x84\x7d\x8a\xd3\x38\x69\xe2\x6a\x06\xe5\xc0\xc9\x00\x5c\xf3\x18\x16\xa8\x64\x2f\xfe\x57\xba\xeb\xc7\xe9\x3a\xaf\xbd\x23\xb8\x91\xfe\xfb\x94\x6d\xd0\xc5\xb9\xd1\x85\xad\xcf\xca\xb4\x38\xbd\x42\x8b\xf5\x44\x1a\x03\x68\x68\xaf\xe1\xec\x9d\x80\x76\xc0\xaa\x16\xdb\x18\xc5\x42\x69\x4a\x8b\xa4\x7c\x96\x2c\x9b\xd5\x48\x87\x42\x8e\x69\x66\x20\x77\xe6\x5e\x71\x8b\x02\xe7\x29\x7b\x3a\x1d\xdc\xa3\x20\x0b\xf3\xc9\xd0\x61\xfb\x2e\x6f\x47\x17\xba\x53\xd2\xbc\x35\x2f\x72\x6d\x7b\x5e\x82\x4b\x2c\x0f\xfd\x09\xae\xdc\x27\x4a\x0a\xdc\xc8\x97\x73\x82\x50\x4e\x19\xf3\x02\x04\xef\x6d\xdd\x88\x69\x67\x94\x45\x11\x40\x15\xc6\xec\x3c\x81\x8a\x5f\xdd\x9b\xcc\x69\x5d\x57\xca\x01\xc1\x9f\x2f\x61\x56\xf7\xba\xce\xb8\x5a\x06\xd3\x2d\x90\x75\x7e\x77\xb2\xdc\x16\xb2\xbe\x1f\x10\x6c\xde\x5f\x95\xa5\x0f\x0d\x59\xaa\x5b\x92\xdc\x39\x2e\xec\xe0\xb6\x4c\x56\x4b\x3a\xa8\x7b\xe9\x75\x79\xd4\x0d\xd9\x54\xd5\xac\xa7\xa0\x9a\xb3\xb1\xba\x84\xfe\xc2\xfa\x3f\x2e\xd6\xff\x21\x15\xae\xb1\x23\x2e\x72\x45\xf5\x49\x60\x69\x35\xde\xe0\x0f\x45\x85\xcd\xad\x76\x32\x98\xc7\xcf\x8f\x31\x13\x8b\x7b\x50\xca\x06\xca\x23\x59\xc6\x26\x93\x3c\x71\x45\x31\x21\xed\x32\x80\x62\xa5\x69\xe8\xfb\x1c\xcc\xe8\x8d\xdc\x83\x88\xf9\x57\x19\x7a\x88\x33\x59\x50\x7a\x43\x95\x31\xaa\xc4\x48\x9f\x5a\xd3\x61\x1b\xfe\x65\x11\x02\x5e\x99\x41\xc3\x40\x60\x2b\x7c\xfe\xe3\xaf\xf1\x9b\x9f\x51\xa9\x77\x76\x06\xec\x4f\xe1\xc6\x68\x11\x09\x3c\x60\x90\x1c\xa4\x6a\x0f\x44\xd3\xda\x1d\x34\xd4\xb7\xee\xc0\x46\x97\xc1\x1d\x44\xb0\xa9\x0c\xb6\x07\x1c\x06\xf7\xef\xc6\xfe\xe1\xe9\x06\xd3\x91\x69\xca\x64\x6d\xb0\x93\xab\x27\x8d\xe4\x81\x67\xb9\x85\x89\x7e\xb7\xdc\xca\xb6\xe4\x5e\x27\x4a\xda\x08\xc8\x45\x72\xa9\xdc\xe1\xb4\xf5\xe4\x92\xe4\x8d\x77\xc9\xbd\xab\xfa\xa6\x5e\x7d\x0d\x68\xf9\x9e\x41\x0b\x64\x22\xbe\x49\x08\x99\x4c\x59\x2f\x16\x6c\x61\x49\x34\xa6\x91\x25\xab\x7e\x8d\x43\xd0\x83\x4d\x56\x0b\xee\xb3\x98\x27\x77\xe8\x8c\x4b\x6f\x1a\xdb\xce\x4a\x79\x6e\xfc\x63\xce\xa2\x30\xbe\x22\x1a\x10\xa6\xd9\x27\x93\x77\x9f\x26\x78\xfc\xe2\xb1\xcf\xc8\x3f\xf0\x00\xc6\x2d\x18\xd9\x06\x96\xc6\xb6\x0e\x05\x6b\xdd\x94\x26\x8e\xdf\xd5\x3f\xd9\x16\x90\x13\x8c\xf4\x47\x00\x74\x81\x8a\x96\x25\x90\x1c\xbc\xef\xbd\x97\x7a\xfa\xf4\x0c\x00\xe4\x3e\x91\x63\x6b\x00\x3c\x8c\x75\x72\xee\xed\xdc\x1b\xea\x72\xd9\x14\x19\xdb\x00\x40\x83\xcc\xc0\x3d\x33\xdf\x0d\x02\xd0\x70\xee\x17\x9b\xd1\xd0\x37\x9d\xfa\x7e\x29\x3f\x54\x08\xbb\xf3\x80\xd0\x0f\x1e\xe3\x54\xe3\xda\xec\x1f\x03\x2c\x43\xef\x61\x77\x88\xc5\xa9\x0b\x43\x11\xdc\xdc\xce\xb2\x8b\x17\x4c\x3f\x30\x38\xa9\xc3\x8b\xf5\x78\x69\x33\x37\xc2\x03\x9b\x37\x78\x37\x25\x26\x0a\x11\x75\x5c\xe6\x87\x01\x81\x61\x2e\x61\xa9\x6c\x36\x11\xd2\x5f\x19\xd3\x2c\x21\xb8\x0c\xa8\xd1\x66\x29\x0b\x54\xee\x6d\xc8\xd0\xcc\x0c\xce\x10\x5a\xcb\xa0\xeb\x33\x6a\x6b\x89\x67\x18\x0a\x49\x46\xdc\x09\xeb\x36\x06\x3b\x45\x7d\x11\x8f\x29\x27\x9c\x26\x71\x1b\xfa\xc4\x11\xda\xf8\xa8\x7b\x65\xa2\x0e\xc7\x4a\x50\xa7\xbc\xf0\x42\xf6\x07\xcd\xce\x13\x30\x3b\xcc\x03\x21\xe4\xbd\xf2\x67\xd5\x9d\xf5\xad\x27\xec\x47\xda\xb5\x2f\xe1\xa5\x65\xc0\x93\x81\xe0\x24\xef\xff\x58\xdf\xf2\x24\x90\xa1\x30\xcb\x52\x27\xa4\xb4\x6e\xcd\x9d\x4a\x3a\x99\x91\x59\x4b\x00\x41\xa8\x7d\xfa\xec\xac\x23\x96\x6e\xd8\x5f\xfc\xae\xee\x7d\x6d\x21\x1d\x2b\xd9\xfc\xb1\x8d\xfd\xc6\xb6\xfe\x6e\x47\xa7\x1e\x42\xbc\xd0\x24\xd7\x32\xe3\xb8\x06\xf6\xe6\x8a\xed\xbf\x28\xea\xef\x09\x08\xe7\x98\xb3\xb7\x37\xf6\x18\x5a\xdd\xa0\xef\xa7\x55\x8d\x4a\x85\x8e\xcc\x5c\xa6\xe8\x07\x7a\x33\x0f\x42\xb9\xfe\xc4\x5e\x0d\xee\xf6\x27\x41\x43\xec\xf4\x78\xb9\x91\x6b\x83\xd9\xb8\x7e\xd3\xe8\xf4\x80\xb4\xf8\xb2\x30\xdd\xdd\x6b\xbc\x38\x79\xaa\x56\x8f\x76\x59\x48\xf2\x8b\x9c\x88\x4c\x4a\x18\xf8\x82\xab\xd4\x79\x99\x27\xa1\xd5\x9f\x6a\x8c\x01\xca\xb3\x96\x59\xdf\xc3\x96\x2d\xda\xa0\x9f\xbd\x30\x37\xa2\x24\x38\x0d\x22\xac\xfb\xa8\xa4\xe9\xca\x28\x40\x52\xd0\xd1\x3f\x04\xd4\xa6\xff\xc3\xf0\x6d\x1e\x1a\x73\x9f\xcd\x37\x0b\x7e\x2a\x07\x56\x1f\x4e\x14\x3d\x2f\x0b\x0c\x20\xab\x0b\xf6\x9e\x68\xee\x0c\xfc\xbf\x5b\xe8\xda\xce\x23\x4d\x61\xff\x02\xff\x3f\x31\xf8\x77\xdc\xe2\xea\xbc\xb9\x44\x59\x48\xed\x06\x4b\x79\xb5\x24\xe5\x91\x50\xc5\xe2\x5e\xa0\xa6\xad\x13\x67\xd2\xa8\xab\x45\xf8\x27\x4e\x77\x89\x39\xa9\x7e\x5a\xbb\xd1\x02\xad\x09\xe0\xf1\xa4\x12\x1a\xea\x67\xc6\x8e\x37\xdb\x2b\xdf\xec\x86\xd3\x48\x5d\xcc\x79\xb1\xdf\x75\x77\xa5\xf1\xa6\xeb\x16\xa8\x5b\xbb\xf9\xbc\xd9\xac\x3a\x0c\x9b\x36\x5f\x99\x72\xa0\x34\x82\x05\xeb\xd6\x9e\x76\xb1\x40\x9d\x49\xac\x44\x1a\x65\x3a\xa2\xa5\xfb\x86\x51\x8a\x93\x2b\x43\x86\xab\x0f\x00\xdd\xb4\xa6\xe7\xdb\xaf\x67\x29\x07\xaa\x48\x90\x88\xa4\x88\xb8\x87\xa7\xdd\xd5\x4a\x69\xeb\x94\x12\x25\x59\xe8\x73\x3d\x53\x72\x9f\xdb\xf7\x6a\xfe\x05\x7f\x65\x41\x75\x13\xbf\xfd\x28\x74\x2f\x16\xdd\x5f\x4a\x60\xa3\x09\x76\x69\x3c\x14\x7f\x2e\x0c\x58\x76\x13\x7b\x04\x84\xdf\x6a\x97\xaa\xb5\x41\x9f\x1b\x8d\xcd\xf7\xdd\x4a\x78\xc5\x12\xc9\x8e\xad\x27\x83\xb4\xc3\xcd\x34\x44\xb6\x8e\x67\x5b\x3c\xc3\xbd\x6d\xde\x8c\x5b\x7d\x92\xe1\xbb\xe1\xad\xc7\xb6\xcd\x6b\x53\xea\x26\x86\x50\xd7\xec\x54\x6c\x8a\x9c\x35\x62\x58\x1f\x8d\x7c\xc0\xdc\xdd\xf6\x96\xea\xfa\x1b\x93\xad\x4f\x6d\x35\x9d\xcd\xd7\xee\x27\x60\xbd\x76\xb6\xbe\x75\xcf\x79\x34\x65\x0d\xcf\x8b\x4c\xd9\x4b\x6c\xfa\xab\xbc\xa0\xfe\x1a\x3c\x3b\xdd\xa3\xb3\xc4\xfd\xdd\x7a\x53\xfb\xce\x77\x40\x2a\xaa\x22\x03\x7b\xf5\x92\x89\x40\x61\xf1\x22\xc7\x6d\x25\x77\xdc\x6f\xb2\xf2\x85\x8f\xca\x04\x6b\xb7\x42\xf0\xe3\x2b\xb2\x88\x19\xbd\xf8\x61\x6f\xf5\x10\x93\x7e\x5d\xa1\xe1\xfd\x80\x42\x94\x0d\x3e\x96\x18\x12\x73\x17\xc6\x35\xff\xa6\x87\x96\x1a\x03\xca\x96\xf7\xc7\xca\x47\xc5\x64\x24\xd6\xf3\xcd\xb0\xf1\xb6\x52\xc8\x43\x1e\x1f\x13\x2d\xda\x6e\x28\x0d\x7b\xfa\x69\xa0\x7a\xd2\xed\x10\x84\xc0\x68\x91\xd2\xa3\x7d\xfa\x3b\x4a\x7a\xb9\xf6\x1c\x51\xf9\x7c\x43\x53\xab\xca\xeb\x3a\x4a\x9a\xe5\xcb\x51\xcd\x40\xbe\xcd\x27\x77\xb2\x9e\x6f\xee\xb4\xcd\x40\xbc\x1c\x64\x35\x3f\x28\x44\xcd\x77\xdb\xd5\xb8\x95\x31\xf5\x48\xaf\x78\x18\x4c\x7b\xeb\x63\xa3\x47\xdc\x8a\x05\xa6\x55\xd6\x87\x9e\x8a\x3b\x88\xe6\x75\x22\xed\xf1\xa0\xb2\x25\x9d\x1e\x35\x2b\x8b\x4b\x44\xfa\x86\x6c\xe5\x61\x41\x73\x5f\x16\x11\x2e\x6c\x49\x5d\x2e\xc1\x8c\x9e\xb5\xbe\x73\xa4\xd2\x94\x87\x79\xe2\x42\x77\x76\xfd\x39\x40\x65\x92\xe5\x47\xcd\xde\x28\xfa\x97\x06\xf9\xa2\x02\x3d\x71\x59\x9c\xf1\xe9\x78\x8b\xd0\x7c\xb2\x6c\xbc\xb7\xc7\x74\xdc\x64\x62\x08\xb1\xf1\xea\xc9\x32\xe3\xf9\x3b\x96\x88\xf7\xf1\x2e\x80\xed\xb1\xa1\x7e\xc5\x0b\x11\x61\xce\xbe\xba\x99\xac\xef\x3b\x7d\x5f\x86\x34\xf0\xbb\xf6\xde\x99\xf1\x9c\x9a\xcd\x4e\xcf\xc8\x75\x17\xd5\x91\xf1\x72\xb4\xf5\x8f\xbf\x74\x5f\x54\xdc\xe4\xb1\x80\xad\xbc\x15\xa0\xb8\x75\xf7\x3b\xee\x95\x7b\xc3\x4d\x97\xcc\xb7\x76\xc7\xbc\xeb\xee\x30\x94\x77\x4e\xaa\x9a\x67\xdf\xed\xac\x5d\xbd\x5c\x5d\xbd\xf7\xbd\x86\x7f\x46\xd3\xb6\x5c\xee\x10\x02\x06\xdd\x71\x6c\xbf\xc6\xbd\xc1\x2d\xee\x0e\x9e\xaf\x61\x82\xba\xa4\x5d\x9b\x79\xe7\x05\xed\xde\x9c\xed\x3e\x03\x6a\xbc\x31\x29\x80\xac\xe6\xa2\xd6\x31\x5d\x0e\x6e\x8f\xc5\x0b\xb5\xea\xb1\x59\xed\xdd\xd9\xce\x97\x7d\xf5\x78\xdd\xf0\xce\x9b\x77\x95\xda\x77\x94\x64\x18\xdf\xb4\x47\x24\xcc\x9b\x61\xc7\x33\xe5\x7e\x14\xb6\x6a\x6f\x97\x29\xeb\x93\xd1\x32\xbe\x8a\xbf\x02\x92\xba\xb9\x78\xb1\x78\x9e\x80\x35\x2f\x92\x35\x95\x5b\xb1\xe2\x1d\xbc\x92\xe2\xc2\x9e\xc9\x34\x07\xe6\xa8\x04\x7d\x1a\x8b\x4a\x0e\xfd\x1f\xbc\x28\x93\x53\xbf\x59\x00\x00")
 
 func templateDialectSqlUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -774,12 +927,32 @@ func templateDialectSqlUpdateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/update.tmpl", size: 12705, mode: os.FileMode(420), modTime: time.Unix(1570107181, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/update.tmpl", size: 22975, mode: os.FileMode(420), modTime: time.Unix(1792028650, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateEntTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x5f\x6f\xdb\x36\x10\x7f\x96\x3e\xc5\x55\x70\x07\x3b\x70\xe4\xae\x6f\xcb\x90\x87\x2e\x69\x01\x03\x43\xf6\x27\x29\xf6\x50\x14\x09\x2d\x9e\x6c\x2e\x14\xa9\x92\x94\x13\x43\xd0\x77\x1f\x8e\x94\x64\xc9\x76\xdb\x74\x4f\x51\xee\x8e\x77\xbf\xfb\x7f\xae\xeb\xc5\x59\x7c\xa5\xcb\x9d\x11\xeb\x8d\x83\xb7\x6f\x7e\xfe\xe5\xbc\x34\x68\x51\x39\xf8\xc0\x32\x5c\x69\xfd\x08\x4b\x95\xa5\xf0\x4e\x4a\xf0\x42\x16\x88\x6f\xb6\xc8\xd3\xf8\x6e\x23\x2c\x58\x5d\x99\x0c\x21\xd3\x1c\x41\x58\x90\x22\x43\x65\x91\x43\xa5\x38\x1a\x70\x1b\x84\x77\x25\xcb\x36\x08\x6f\xd3\x37\x1d\x17\x72\x5d\x29\x1e\x0b\xe5\xf9\xbf\x2f\xaf\xde\xdf\xdc\xbe\x87\x5c\x48\x84\x96\x66\xb4\x76\xc0\x85\xc1\xcc\x69\xb3\x03\x9d\x83\x1b\x18\x73\x06\x31\x8d\xcf\x16\x4d\x13\xc7\x75\x0d\x1c\x73\xa1\x10\x92\x42\x73\x94\x09\xb4\xd4\x49\xf9\xb8\x86\x8b\x4b\x58\x31\x8b\x30\x49\xaf\xb4\xca\xc5\x3a\xfd\x93\x65\x8f\x6c\x8d\x24\x54\xd7\xe0\xb0\x28\x25\x73\x08\xc9\x06\x19\x47\x93\xc0\xa4\x7b\xbe\x67\x89\xa2\xd4\xc6\x75\xac\xc5\x02\x48\x79\x7a\xc3\x0a\xd2\x42\x3e\x13\x60\x6f\x1b\x50\x39\xe1\x76\x90\xeb\xe0\xf9\x48\xd0\x66\x1b\x2c\x58\x1a\xbb\x5d\x79\xc8\x71\xa6\xca\x1c\xd4\x71\x94\x79\x90\xc4\x7d\x12\x6e\x03\x93\xf4\x8e\xad\xef\x76\x25\x5a\x68\x9a\x87\xba\x06\xc3\xd4\x1a\x61\x22\xe6\x30\x71\xe4\x5b\x0a\x4d\x53\xd7\x20\x72\x50\x44\x86\x37\xa4\xae\xae\x01\x15\x0f\x9c\x89\x83\xa6\xb9\x48\xce\x93\x9e\xf8\xd0\x7f\xc5\xd1\x62\x01\xcb\xeb\x10\x5c\x24\xec\x69\x1c\x2d\xaf\x03\xb6\xe5\x75\x4a\x86\x49\xdf\xc3\xbf\x56\xab\x8b\x44\xf0\xb9\x2e\x04\x85\xc5\xed\x92\x87\x38\xda\xc3\xb9\x9f\xc3\x24\x27\x38\x93\xf4\x83\x40\xc9\x2d\x9c\x93\xf6\x28\x84\xaa\x64\x36\x63\x12\x26\x79\xef\xef\x46\x93\x0c\xd9\xdc\x32\x59\x61\x07\x80\x30\xee\xa5\x12\xc8\x49\x57\x1a\x03\x00\x44\x27\xf5\x04\xcf\x89\x20\xa4\x64\x2b\x49\xc4\xb3\x91\xf7\xf9\xde\x89\xf0\xef\xad\x0f\xf5\x1d\x5b\x53\x24\xbc\x0f\x24\xec\xe1\x8e\xfd\xc1\xe0\xcf\x7b\xbe\xc6\xce\x1d\xea\x16\x10\x6b\xa5\x0d\xc2\x1a\x15\x1a\xe6\x84\x5a\x03\xf2\x35\x06\xac\x16\x7c\x49\x92\xe4\x79\x9b\x40\x1c\x58\x0c\x5a\x0e\xa2\x82\xdf\x8b\x0a\xe1\xde\x0b\x91\xb1\x14\xee\x7a\x21\x8b\x0e\x9c\x06\x25\xe4\x1c\x98\xe2\x60\x37\xba\x92\x1c\x56\x08\x55\xc9\x99\x43\x0e\x05\x53\x15\x93\x72\x97\x92\xed\x93\x86\xdb\x02\xd2\x8e\x88\x1f\x95\xf8\x52\x11\xf9\xd3\xe7\x3e\x92\x67\x01\x03\x85\xb2\x7f\xf4\x10\x68\x07\xf1\x3c\x0c\xe8\xf0\xbb\xad\xe8\xf0\xe2\xb0\x4e\x18\xe7\xc2\x09\xad\x98\xec\xba\xa1\x8d\x68\xe8\x6d\xde\xcd\x85\xae\x89\xa2\xd3\xe5\x77\x42\x79\x34\xaa\x2a\x18\x57\x45\x0f\x2b\x4f\xdb\x0c\x91\x5f\xe9\xa8\x4d\x86\x42\x57\xba\x28\x68\x38\x9e\x37\x4d\x48\x63\xdb\x80\x5d\x43\x7d\xcd\xff\x30\x52\x06\x78\xad\xd3\x86\xc6\x50\x8b\x3a\xfc\xd3\x3e\x9a\xb8\xa2\x94\xc4\x29\x8d\x50\x2e\x87\x84\x0b\x26\x31\x73\x8b\xd7\x76\xc1\x91\x06\xed\x42\x2b\x4c\xf6\x4a\xda\x77\xcf\xfd\xc8\x0a\x1a\x26\xed\x90\x6b\xc1\xf9\x99\x68\x30\x43\xb1\x45\x13\x0c\xff\xdd\xfd\xd7\x1c\x01\x1c\xd5\x7f\x07\x2c\xaf\x54\xd6\x03\x83\xe4\xaf\x0a\xcd\x2e\x81\xe9\xb8\xa4\x66\xdd\x68\xe9\x5f\x34\x0d\x7c\xa9\xd0\x08\xb4\x5f\xa9\xe8\x61\xad\x77\x8c\x34\x8e\xfc\xe3\xe9\x08\x76\xd3\xc0\xd9\x50\x6a\x36\xb4\x32\x9d\xc1\x61\xa9\x36\x8d\x07\x49\xb3\x35\x32\xe8\x2a\xa3\x60\xfa\xd3\x50\xc1\x95\x14\xa8\x5c\x0d\x07\x56\xd2\x30\x89\x9b\x59\x3a\xd4\x7f\x20\x34\x8b\xa3\x51\x80\x17\x0b\xf8\xe8\xbb\x0e\x82\x29\x0b\x0c\x56\x95\x90\xb4\x08\x69\x25\xf8\x96\xa4\x91\xe1\x77\xd9\xd8\xd9\xc5\x02\x6e\xb4\x43\x70\x1b\xe6\xe6\xb0\xd3\x15\x28\x44\x4e\xbd\x9d\x31\x29\xc7\xc2\x1f\xd5\x93\x61\xe5\x74\x06\x2b\xcc\x69\x18\x91\x44\xaf\xb6\x40\xb7\xd1\x7c\x4e\x2d\x7d\x64\x86\xac\x3c\x31\xdb\xc2\x43\x0e\xb9\xd1\x05\x30\x70\x86\x29\xcb\x32\x6a\xc0\x30\x46\x28\x19\x03\xa2\x7f\x94\xe9\xa2\x10\x8e\x46\x8a\x36\x60\xb4\x94\xc8\x61\xc5\xb2\xc7\x34\x7e\x51\x9e\x42\x64\xba\x14\x75\xf4\x40\xfd\x43\x21\x65\xe8\xff\x25\xa8\x57\x71\x9c\x9e\x36\x27\x3e\x5c\x50\xf9\x3f\xb6\xdb\x75\xb4\xa7\x29\xd8\xdf\x0b\x08\xb0\xdc\xa1\x01\x11\x04\x33\xa9\x2d\xf2\x39\xa9\xb5\x3a\xbc\xa7\xf4\x28\x7c\x76\x7d\x8d\x3f\x09\x29\x69\x02\xe3\x33\x66\x15\xc5\xcb\x6d\x8c\xae\xd6\x1b\x6f\x99\x1b\x8f\xee\x69\x23\xb2\x0d\x64\x06\x59\x10\x18\x85\xfb\xa5\x11\xed\xca\x60\x44\xa7\x40\xba\xe7\x39\xe8\x47\xea\xd4\xd3\x51\x4b\x03\x8a\x74\x7a\xe6\x9e\xaf\xfd\xe7\x2c\x8e\x44\x0e\xaf\xf4\xa3\xef\x94\x92\x29\x91\x4d\x93\xee\x88\x6a\x9a\x8b\xa3\x9b\x87\xd6\xc5\x28\x4e\xac\xbb\x7e\x12\xdf\x16\xd1\x37\x2d\xc3\x25\xb8\xe7\x94\x9b\x6d\x9f\xf4\x03\xf1\x36\x75\xb7\xce\x50\x61\x8b\xa2\x94\x48\x83\x37\x64\x2f\x2f\x5c\x1a\x38\x68\x5e\x18\xab\x20\x3e\x9d\xd1\x76\x21\x8d\x75\x1c\xad\x2a\xbf\x34\x56\x3b\x87\x36\xbd\xc1\xa7\xdf\xaa\x3c\x47\x33\x55\x42\xce\x3c\x33\xfd\xc7\x08\x87\xed\xc3\x64\xa8\x6e\x9a\x9c\x90\xf0\xa0\xc2\xc4\x9e\x26\x82\x5f\xbe\xde\x26\xf3\xa3\xf0\x2f\xaf\x67\xb3\xd8\x9f\x07\x83\x4b\x6e\x7c\x3a\xf5\x07\xc4\xd1\x69\x43\xcb\x4c\xe4\xb0\x3d\x95\xd7\x53\xf7\xd1\xaf\xb0\x85\x57\x97\x74\x1b\xf8\xa4\x46\xdf\x86\x3c\x87\xd1\xa2\x0c\xf8\xcf\xb6\x84\x37\x8a\x3a\x4c\x28\x6d\x87\xe4\xc7\x95\xbd\x04\xb3\x37\xe7\x2d\xb5\x1b\x75\xf8\x7d\x94\x94\x19\x25\xa2\x2d\x20\x62\x76\x59\x0e\xfb\x36\x84\x90\x2e\xd9\xa5\x6d\x0b\x29\x8c\x40\xc1\xfb\x01\x4d\xe5\x44\xbb\xcc\x60\xfb\xbb\x87\xf9\x9e\x6f\xf7\xd1\xf2\xba\x3b\x42\x5f\x54\x64\x82\x4f\x67\x5e\x5b\x1d\x47\x82\xcf\xe1\x9e\x32\x65\x9d\xc9\xb4\xda\xa6\xef\x9c\x16\x87\x0a\xa8\x1e\x7a\x07\x04\x8f\x9b\x78\xe0\xae\xdf\xd7\x96\x7e\x31\xf9\x95\x2b\x2b\x43\x91\x1a\x8e\xf2\xbd\x40\xe8\x49\x06\x25\x33\xd6\x57\x4b\x20\xeb\xfc\x60\xcb\xf4\xbf\x3d\xfa\x67\x9f\x3e\x8f\x9c\xf8\x91\x3b\xc5\x1f\x45\xf8\xec\x08\xef\x04\x92\x5b\x52\x99\xec\x55\x87\x6b\xe8\x05\xc7\x4c\xc1\xd4\xee\xe0\x9a\x39\x75\xce\xa4\x30\x38\xaa\xc6\x97\xcd\xe9\xec\x0c\xfd\x9c\x41\x98\x3f\xd3\x2c\x5f\xb7\x9f\x33\x4a\x13\xad\xe5\x7b\x41\xf8\x82\xd3\x47\x3a\x5a\x2f\x06\xb4\x4f\xf7\xe2\x73\x3b\xcd\xe0\x12\xb2\x7c\x4d\xe3\x6e\x08\xe7\xbf\x00\x00\x00\xff\xff\x3f\x98\xd0\x4f\x56\x0f\x00\x00")
+var _templateDialectSqlUpsertTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x57\xdf\x6f\xdb\x36\x10\x7e\x96\xfe\x8a\x6b\x60\x04\x52\xe0\x32\x6d\xdf\x96\xc2\x0f\x99\x63\x03\x19\x32\x77\x89\xb3\xed\xa1\x28\x06\x46\xa2\x6c\x2d\xb2\xa8\x92\x54\xe2\x40\xd0\xff\xbe\x3b\x8a\x96\x6d\xd9\x89\xd3\x76\xd8\x02\x24\x36\x8f\x77\xbc\xef\xbe\xfb\x41\xa6\xaa\x4e\x4f\xfc\xa1\x2c\x9e\x54\x3a\x9b\x1b\xf8\xf0\xee\xfd\x4f\x6f\x0b\x25\xb4\xc8\x0d\x8c\x79\x24\xee\xa4\xbc\x87\xcb\x3c\x62\x70\x9e\x65\x60\x95\x34\xd0\xbe\x7a\x10\x31\xf3\x6f\xe7\xa9\x06\x2d\x4b\x15\x09\x88\x64\x2c\x00\x97\x59\x1a\x89\x5c\x8b\x18\xca\x3c\x16\x0a\xcc\x5c\xc0\x79\xc1\x23\xfc\xf8\xc0\xde\xad\x76\x21\x91\xb8\xed\xa7\xb9\xdd\xbf\xba\x1c\x8e\x26\xd3\x11\x24\x69\x86\x47\x34\x32\x25\xa5\x81\x38\x55\x22\x32\x52\x3d\x81\x4c\x50\xba\x76\x66\x94\x10\xcc\x3f\x39\xad\x6b\xdf\xaf\x2a\x88\x45\x92\xe6\x02\x8e\xe2\x94\x67\x68\x70\xaa\xbf\x66\xa7\x65\x81\x28\xcd\x11\xa0\x0a\x6a\xf4\xee\xca\x34\x23\x3c\x67\x03\x28\xb8\x8e\x78\x06\x3d\x36\x8d\x64\x21\xd8\xcf\x6e\xc7\x29\xa2\x47\x91\x3e\x34\x9a\xed\xf7\xd6\x9c\x1c\x26\x65\x1e\x41\xb0\xa5\x5b\xd7\x70\xb2\xe9\xa5\xae\x43\x40\x10\x53\xfe\x20\x82\xc8\x2c\x91\x9c\xdc\x88\xa5\x61\xc3\xe6\x33\x84\xc0\xaa\xb3\x09\x5f\x08\x54\xee\x83\x50\x4a\xaa\x10\x2a\xdf\xab\xaa\xb7\x60\xc4\xa2\xc8\xb8\xe9\x44\x94\xe6\x0f\x3c\x4b\x63\x94\xeb\x23\xe8\x11\x14\xef\x81\x2b\x08\x7c\xcf\xc3\x94\x00\xfe\xa0\x16\xbb\x11\xba\xcc\x0c\xca\xee\xc5\x13\xc9\x52\xf4\xa8\x12\xcc\x65\x85\xfa\x5e\x59\x90\x3d\x7c\xfe\xa2\x8d\x4a\xf3\x19\x4a\xc8\x5f\x9a\x20\x96\xb1\xe0\xa6\x54\x62\x94\xf3\xbb\x0c\xd3\x77\xc4\xcb\x38\x6d\xf8\xf3\x3c\x2f\x9a\xf3\x7c\x86\x4e\x3e\x7f\x19\xa7\x22\x8b\x87\x76\xe9\xac\x45\x1e\x5b\xad\xd0\xf7\xcc\xd2\x86\x42\xdc\x75\xf8\x61\xb1\xa2\x6f\xec\x76\x49\x84\xa0\x2a\xfa\x24\xcd\x37\x03\xc8\xd3\x8c\x02\xc7\x20\xd0\x7f\x4e\x4b\x7b\x88\xef\xe1\x99\x1b\x69\xa3\xe0\x2e\x73\x4a\xaa\xe5\x9e\xfd\xc6\xa3\x7b\x3e\x23\xfa\xd8\x2d\x41\x0e\x1b\xee\x14\x21\x83\xde\x5f\x7d\xe8\x25\x64\x86\x81\x11\x62\xdd\x04\x82\x5e\x91\xc4\x52\xec\x43\x48\xd6\xbd\x84\x4d\x8d\x2a\x23\x63\x8d\x50\xfa\xd1\xe9\x6f\xe0\x6c\x29\x4b\xd8\xa5\xfe\x65\xfa\x69\xe2\x38\x42\xb0\x49\x1b\xfe\xdf\x5a\xe6\xec\x57\xae\xf4\x9c\x67\xc1\x89\x3d\x23\xb4\x4a\xbb\x71\x7b\xdb\xa1\x2b\x99\x65\x77\x18\x5b\xe0\xc8\x6c\xcc\x56\x1e\x2c\x1d\x6c\x2a\x76\x49\xa0\x75\x42\x15\xa6\x0d\xc7\xf6\xa5\xaa\x42\x40\xe1\x0a\xb0\xc8\xb4\x58\x03\xfd\xa6\x63\x1a\xd1\xf4\xfa\xea\x0f\x4b\xc5\x51\x13\x0e\x95\xc6\xfa\x74\x57\x03\x2d\x39\x39\xb6\x2f\x11\xb4\x58\x94\x86\xb2\xb3\x72\xed\x0a\x70\x00\xbc\x28\xd0\x28\x68\xd6\x8d\x8f\x17\x61\x3c\xef\x0a\xf5\x7e\xcf\xd3\xaf\x65\xeb\x03\x65\xdd\xdc\x52\x33\x0c\x06\x87\xbd\xac\x12\x62\xf5\x5f\x08\x7c\x9d\x93\xfd\x90\x5e\xee\xa6\xb6\x9d\x5a\x1a\x9c\xa0\x0f\x1b\xed\x55\xd9\xef\x67\xf0\x8a\x0c\x4d\xc4\xa3\xd5\x6b\xd8\x98\xe2\x88\x4d\x0d\x86\x8e\x7b\x37\x22\xe6\x91\x11\x31\x6e\xba\x0a\x68\x82\xa0\xb5\x45\xbd\x87\xd8\x7a\x3d\x16\xa4\xa2\x03\x2f\x44\xc2\x71\xa8\x58\xa6\x6d\xbe\x56\x02\x24\xcc\x9e\xda\x69\x8b\xf5\xb6\x8d\x76\xab\xe3\x76\x23\x71\xda\x6e\x16\xb6\x51\x38\xf1\x98\xa6\x6d\x5d\x07\x61\x8b\x78\x5f\x45\x1f\x70\xb1\x85\xfa\xdb\x1d\xb5\xab\x1f\x6c\x9c\xdd\xbe\x71\xe5\xdb\x21\xf5\xdf\xee\x94\xba\x33\xa9\x37\xbf\x2b\xf9\xa8\x89\xb8\x63\x7b\x79\xe0\xa2\x72\x0a\x07\xcb\x58\x16\x64\x77\x4e\x92\xa1\x42\x3d\xba\x0f\xb0\x0b\xd5\x53\x1f\xb8\x9a\xe9\xd5\xcc\x9e\x0a\xba\xc1\x76\xe8\x1a\xca\xac\x5c\xe4\x9a\x31\x16\xb2\xb1\x92\x8b\x80\x74\xed\x20\x7f\x66\xbc\x87\xec\xcf\xb9\x50\xc2\xea\x8d\xae\x83\x3d\x1d\xde\x07\xfc\x83\x7a\xd7\x04\x22\x08\xfd\x76\xd8\x22\x12\xb3\x74\xe2\x88\xc6\xea\x06\x4c\x1a\xb8\x8f\x3a\xfc\xb8\x33\x95\x0f\xcd\x64\x77\x9d\x90\x35\x9b\xe0\xa5\x1e\x84\x8d\x1d\xd2\xe2\x58\x69\xd2\xba\x56\x75\x50\xac\xc5\x30\x93\x5a\x04\xdf\xe9\xd6\xe6\xac\x93\xb2\x2d\xea\x07\xb0\xaa\xd4\x4f\x39\x56\x46\x82\xcf\x2e\x13\x3c\x73\x23\x5f\x34\x4f\x8c\x20\xec\xb7\xcf\x82\x0a\xec\x5d\xd8\xa1\x17\x0b\xba\xa9\x41\x9b\xb3\x96\xe4\xed\x56\xec\x14\xc0\x7f\x0c\xc3\xd5\xf4\x56\xda\x47\x4b\x11\xed\xc9\xfa\x31\xbe\x99\x76\xe9\x3f\xc0\x3e\x9e\xbd\x4d\xf3\xff\x5c\xe0\x3f\x50\xdf\x87\x23\xb5\x28\x6f\x36\x9e\xb8\x34\x25\x36\x9f\xad\x15\x3e\x6b\x93\x74\x76\xb6\x73\xdb\x36\xf2\x26\x11\x6f\xba\x0d\xb2\x59\xfe\x2f\xe1\x38\x1e\x29\x35\x91\x66\x4c\xff\x29\xb8\x4a\xd8\xe4\xec\x8a\xdf\x89\xac\x6e\x90\xae\x69\xe8\x62\xb6\xcc\x53\x8b\x04\xcf\xb0\xf0\x4a\x30\x2d\x29\xaf\x6c\xe3\xd7\xb0\xfb\x8a\x11\xbb\xf6\x66\xa5\x4d\x72\xe9\xb7\x5b\x41\x96\x8d\xfe\x6e\xf8\x97\x17\x7d\x90\x45\x1f\xdc\xfb\xe2\xfb\xe6\xcd\xb3\xad\x35\x94\x8b\x05\xa2\x7a\x39\xfc\xd5\x53\xde\xc9\xba\x10\xfb\xa4\xe5\xdb\xff\xbd\x9c\x8f\x7f\x00\xdf\x01\x5a\xf5\x95\x0e\x00\x00")
+
+func templateDialectSqlUpsertTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateDialectSqlUpsertTmpl,
+		"template/dialect/sql/upsert.tmpl",
+	)
+}
+
+func templateDialectSqlUpsertTmpl() (*asset, error) {
+	bytes, err := templateDialectSqlUpsertTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/dialect/sql/upsert.tmpl", size: 3733, mode: os.FileMode(420), modTime: time.Unix(1792022221, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateEntTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x19\xdb\x72\xdb\xb8\xf5\x59\xfa\x0a\x2c\x47\x49\x25\x8d\x4c\xa5\xfb\xd6\xec\xe4\x21\x6b\x67\xb7\xee\xa4\xce\x76\x9d\x4c\x1f\x3c\x1e\x07\x22\x41\x09\x35\x45\x6a\x49\x50\xb6\xc6\xeb\x7f\xef\xb9\x00\x24\x78\x91\xe3\x24\xad\x1f\x2c\x12\x38\x38\x38\xf7\x1b\x1f\x1e\x96\xf3\xf1\x69\xbe\x3b\x14\x7a\xbd\x31\xe2\xc7\x57\x7f\xfd\xdb\xc9\xae\x50\xa5\xca\x8c\xf8\x45\x46\x6a\x95\xe7\xb7\xe2\x3c\x8b\x42\xf1\x36\x4d\x05\x01\x95\x02\xf7\x8b\xbd\x8a\xc3\xf1\xc7\x8d\x2e\x45\x99\x57\x45\xa4\x44\x94\xc7\x4a\xc0\x6b\xaa\x23\x95\x95\x2a\x16\x55\x16\xab\x42\x98\x8d\x12\x6f\x77\x32\x82\x9f\x1f\xc3\x57\x6e\x57\x24\x39\x6c\x8f\x75\x46\xfb\xef\xcf\x4f\xdf\x5d\x5c\xbe\x13\x89\x4e\x01\x05\xaf\x15\x79\x6e\x44\xac\x0b\x15\x99\xbc\x38\x88\x3c\x81\xd5\xe6\x32\x53\x28\x15\x8e\xe7\xcb\xc7\xc7\xf1\xf8\xe1\x41\xc4\x2a\xd1\x99\x12\xc1\x16\x68\x48\x03\x61\x57\x27\xbb\xdb\xb5\x78\xfd\x46\xac\x24\x5c\x38\x09\x4f\xf3\x2c\xd1\xeb\xf0\x37\x19\xdd\xca\xb5\x42\x20\x80\x31\x6a\xbb\x4b\xa5\x81\xb3\x1b\x25\x81\xde\x40\x4c\xdc\xf1\x66\x4b\x6f\x77\x79\x61\xdc\xd6\x72\x29\x10\x79\x78\x21\xb7\x88\x05\x79\x46\x82\xe9\x6e\x01\x82\xd3\xe6\x00\xec\x31\xe7\x2d\xc0\x12\x84\xb0\x95\xe1\xd8\x1c\x76\xdd\x1d\x53\x54\x91\x11\x0f\xe3\x51\x44\x44\xe2\xee\x9d\x36\x1b\x00\xf9\x28\xd7\x1f\x01\xbe\x04\xb0\xcf\xb0\x5a\xc8\x0c\x68\x9f\xe8\x85\x98\x18\xe4\x2d\x84\x75\x58\xd6\x89\xc8\x70\x59\xbc\x42\x74\xb0\xa0\xb2\x98\x77\x00\xec\xf1\xf1\x75\x70\x12\xd4\x8b\x9f\xeb\xa7\xf1\x08\x78\x39\x3f\x63\xe1\x2a\xa4\x3d\x1c\x8f\xe0\x9d\x68\x3b\x3f\x0b\xf1\x62\xc4\xf7\xf9\x3f\x65\x9e\xbd\x0e\x74\xbc\xc8\xb7\x1a\xc5\x62\x0e\xc1\xe7\xf1\xa8\x21\xe7\x06\xc8\x49\x90\x9c\x49\xf8\x8b\x56\x69\x5c\x8a\x13\xc4\x3e\x62\x51\xed\x64\x19\xc9\x14\x20\x6a\x7e\x37\x39\xc2\xe0\x9d\x7b\x99\x56\xca\x11\x80\x34\x36\x50\x01\xd8\x03\xe0\x0a\xc7\x02\xfe\x46\x83\x78\x98\x73\x5c\xd0\x69\x2a\x57\x29\x2e\xce\x5b\xdc\x27\x0d\x13\xfc\x7a\x49\xa2\x06\xa9\xa2\x24\x88\x07\x04\x26\x72\xbf\xcc\x4f\xef\xbe\x4b\x30\x4e\x34\x26\xde\x46\x7e\xb3\x2a\x4d\x07\x69\x2d\x14\x1a\x51\x89\x08\x8e\xf2\x8a\xb6\x74\xf1\xe9\xfd\x7b\xe7\x04\xb1\x34\x12\xad\x37\x44\xe4\x47\x31\x83\x93\xa6\x4c\x9c\xcf\xca\x11\xb6\x14\xb3\xf5\x2e\x5e\xab\x86\xab\xe5\x5c\xe8\x75\x96\x17\x4a\xac\x55\xa6\x0a\x69\x74\xb6\x16\x0a\x40\x98\xac\x52\x90\xa7\x21\xe4\x89\xb5\x4b\xe5\x09\xb2\x61\xde\x23\x4f\x7d\x49\xd9\xc8\x7f\x03\x84\x97\x85\xe2\x63\x0d\x54\x2a\x23\x4c\x2e\x32\x9d\x2e\x84\x04\x4e\xca\x4d\x5e\x81\x7c\x56\x4a\x54\x3b\x90\x0a\x84\x97\xad\xcc\x2a\x99\xa6\x07\x92\xcd\xe0\xc5\xd6\x2f\x20\x8e\xc0\xe2\xa7\x4c\xff\x51\xe1\xf2\xd5\x75\x6d\x20\x73\xa6\x01\x2d\xa4\x3e\xf4\x99\xd7\x3a\x66\xf2\x94\x70\xd1\x22\x5a\xf2\x04\x41\xf0\x6b\xc3\x79\xa1\x20\x92\xe8\x3c\x2b\x97\x8a\x76\x40\x06\x39\xac\x17\x40\x5d\x0c\xaf\x56\xdd\xeb\x42\xee\x36\x21\x63\xa8\x45\x51\x0a\x09\x7a\x59\x29\x54\xc9\x2e\xdf\x55\x29\x71\xbf\x3a\xf4\xe2\xcb\xbf\x2a\x05\x81\xf2\x6e\xa3\x32\xa1\xc0\x26\x8b\x93\x34\x97\x31\x9e\xc2\xb0\xa9\xd0\xb5\x47\x4c\x96\x7f\x88\x57\xac\x83\x13\x6d\x41\xdf\x2b\x6c\x28\x62\x99\x74\x1d\x5c\xc6\xb1\x46\xd6\x40\xf6\x36\x8c\x59\x9b\xe1\xa0\x1c\x3b\xe6\x5c\xf4\x1b\x0d\xfb\xd9\x00\xf2\x51\xcb\x45\x44\xdb\x9d\x6b\xb2\x92\xd0\xda\x20\x6a\x2e\x6c\xc5\x37\x1f\xe8\x34\xdf\x6e\x31\xab\x01\x20\x1b\xaa\x8d\x9c\x2e\x12\x1e\xd3\x30\xe7\x02\x2b\x01\x96\x16\xac\x76\x72\xc0\x77\xa9\xbb\x97\x0e\x18\x5b\x93\x13\xd0\xeb\xba\x1e\x1c\x8a\x81\x00\xfb\x2d\x3e\x37\xfe\x9f\x3a\x0f\xdb\x51\xeb\x9a\x6e\xce\x38\x71\x22\xa7\xfc\x6b\x9f\xc7\x6d\x9b\x28\x6d\x58\xb5\x96\xc1\x2f\x56\x31\x13\x03\x69\x19\x77\x76\x85\xce\x4c\x22\x82\x58\xcb\x14\xaa\x84\xe5\x8b\x72\x19\x2b\xac\x42\x96\x79\xa6\x82\x06\x89\x3d\x77\x5f\xe7\x73\xc6\x30\xb1\x15\x80\x47\xc1\x04\xaa\x0d\xa5\xf7\xa0\x27\xba\xf8\x77\xf7\xd6\x27\xb0\x9d\x1c\xf8\x86\x93\x23\xb9\xc1\x59\xd7\x24\xa9\xb2\xa8\x26\x5c\x4c\xdb\x81\x7c\x26\x82\x0f\xc5\x05\x84\xf8\xc0\xd7\x2c\x9f\xa1\xec\x61\xaa\x22\x7b\x6e\xce\x5c\x08\xa8\x3f\x20\x76\x22\x45\xda\xfc\xe5\x78\x52\x21\xf4\xd3\x16\xeb\x70\xd9\xdc\xb7\xc6\x99\x4f\xc7\x74\xc6\x9b\x9e\x1f\xa2\xa3\xc2\x35\x1d\x1c\xe1\xd1\x6c\x45\x07\x46\xcc\x0f\xd2\x88\xaf\xe4\xed\x7b\x94\x4d\x17\xcd\x10\x8a\x71\x73\xfe\xe5\x7e\x4c\xa7\x3d\xb3\x3a\x6a\x54\xad\xcc\xe7\x8c\xa9\xad\x93\x80\x62\x68\xd0\xe8\x46\x59\xdd\xd8\x5a\xc9\xd7\x08\x38\x44\xa1\x55\x79\xc4\xaf\x7c\x8f\x73\x1b\x20\xf0\x6f\x95\xb7\xe7\x67\x2e\xd0\xa3\x1c\xad\x14\xa6\x2f\x7d\x04\xa7\xa9\x86\x40\xf7\xd0\x13\x25\x97\x96\x8f\xb3\xd0\xc7\xdf\x01\x9a\x8d\x47\x5d\x09\xba\x28\x00\xee\x20\xe3\x0f\x59\x7a\xb0\xf1\xef\x13\xe5\xe1\xda\x30\xa5\x58\x55\x3a\xc5\x8a\x1f\x6b\x5f\x4a\xd2\x98\x7b\xa8\x68\x6f\x0b\x01\xce\x5e\xe4\x70\xd2\x6c\xa4\x59\x88\x43\x5e\x41\xe9\x0a\x69\x02\xb2\x3d\x88\x3c\x6d\x03\x7f\xca\xee\x20\x48\x82\x14\x56\x2a\xc1\xf2\x04\x21\x6a\xb4\x5b\x65\x36\x39\xd8\xba\x4e\xfa\xd7\xe0\x2d\x77\xb2\xb4\xe4\x01\xfa\xa4\xc8\xb7\x40\xa4\x01\x83\x28\x65\x84\xc1\x99\x0b\x0b\x54\x92\xb7\x48\x87\x22\xc8\x15\xda\x60\x9a\x05\x56\x8a\x3c\x4d\x31\xe1\x42\xdb\x10\x8e\x9f\xa5\x3f\x96\x8c\x53\x9d\x5b\xe7\xd5\x0f\x50\xa6\x83\xe6\xbe\x4d\x71\x35\x8a\xbe\xda\x5a\x5a\x43\xed\x90\xe0\xa0\x07\xc3\x9f\xd2\x95\xf7\xd8\x9a\xa0\xd8\xbf\x24\x1a\x21\x13\x03\x88\x35\x03\x46\x69\x0e\xfd\xdc\x02\xd1\x96\x39\x9f\x47\x45\x65\xea\xde\xd4\x5e\x70\x07\x41\x0f\xab\x33\x75\xaf\xa2\x0a\x25\x67\x36\x45\x5e\xad\x37\x1c\x71\x0a\xa2\xf3\x6e\xa3\xa3\x8d\x88\x0a\x25\x19\xa0\x25\xf8\xe7\xca\xd6\x19\x44\x6b\x1d\x45\x6a\xee\x21\xea\xdd\x0e\xc5\x10\x96\x5f\xc8\x54\x84\xd3\xb9\xb9\x3f\xa3\x47\x30\x76\x30\x9d\x1f\xe0\x10\xfa\xd2\x4e\x66\x3a\x9a\x06\xae\x6f\x84\xa6\xa9\xd7\xe6\xa1\x1f\xb4\xe4\x24\x5d\xc3\x17\x90\xe3\x8c\x9e\xbc\x59\xbc\x11\xe6\x1e\x9e\xf7\xb5\xfa\x3b\xe0\x63\x56\xdd\x69\x0a\xf9\xcb\xf3\xab\x58\xa9\x1d\x98\xe4\xee\x30\x14\x53\xc0\xfa\xa1\xa7\xb0\x15\x17\xda\x33\xbe\x62\xe1\x07\x12\xa6\xda\x83\x8b\x6a\x3a\xae\x4b\x44\x1f\x2b\x83\xdd\xb8\x55\x3b\xe2\x8b\xc8\xee\xc4\x14\xd4\xbd\x91\xc8\xa5\x60\xba\x67\x0b\x8b\x11\x12\x48\x55\xaa\xa4\x4a\xb9\xaf\x95\xb7\xe8\x82\x52\x94\x19\x98\xd6\x06\x64\xc2\x74\x21\x72\x6b\x63\x53\x15\xae\x43\xe7\xb3\x74\x7e\x5b\x19\xd4\xfa\x2c\xf4\x7d\xdf\xd6\xb7\x70\xfc\x1f\x97\x1f\x2e\x1c\x17\x6c\x60\x70\x10\xc5\x5d\xe2\xc0\xa0\x24\x42\x10\x7f\x54\x41\x56\xdf\x8a\x5f\xc1\x0c\xa9\x29\x46\xb0\x72\x03\xff\x63\x2e\xd1\x90\x9d\xbc\xd0\x6b\xdd\xa8\xe6\x99\x86\x45\x52\x1f\xb2\xab\x61\x5d\x85\x11\xc1\x6f\xe5\x2d\xfe\xdb\x5d\x41\xf6\x50\x45\x22\x23\xf5\xf0\x78\xed\x3d\xcf\x66\x56\xa9\x04\x4e\xaa\x3c\x01\x5d\xf8\x79\xa3\xd1\x24\x0a\x1b\xe4\xcc\x5b\x6b\xb8\x28\x83\x26\x67\x87\xe2\xa1\xd3\x31\x33\x84\x67\x51\x0d\xa8\x52\xd0\x03\x22\x27\x25\x1e\xa2\x94\x65\x89\x6f\x2d\x0b\x78\x9e\x00\x98\xa1\x52\xf1\xad\x47\x19\xea\x0b\xa8\x9f\xfc\xc5\x9b\x37\x54\x7d\x78\x29\x8a\x12\xfd\x23\x01\xdf\x44\xce\x51\xf1\xb2\xab\xce\xd9\xeb\x9f\x84\x75\x48\x7b\xf2\x26\x02\x97\x6d\x91\x4a\x88\x6e\x28\x6d\xcf\xbb\x2e\x04\xeb\xd6\xeb\xc0\xdd\xf8\xe1\x01\x56\x07\x6f\x02\x88\x97\x37\x51\xaf\xbe\xee\xd7\x76\x04\x31\xc9\xf0\x7a\x2c\x15\x06\xea\x11\x02\x20\x47\x05\x00\xd4\xe3\x94\x72\x66\x12\xfe\x5d\x96\xbf\xe6\x98\xbb\x67\x62\x0a\x4a\x83\x95\xf3\xf2\xe7\x83\x01\x4d\xd1\xe3\xf9\x6f\xfc\xfb\xcf\xb7\xa7\xfc\x70\x89\xf6\x3e\x6b\x90\x82\xbc\x10\x9d\x3f\xe2\xe0\x7b\xb8\x0c\x82\xed\x63\x65\x13\xd3\xfb\xf8\xf8\x13\x40\xfc\xd0\xe8\x63\x34\xba\xa1\x13\x72\xb7\x83\x74\x31\x6d\x15\x73\x53\x00\x02\xa7\x9f\xef\xc3\x30\x9c\x31\x6c\xe4\xa3\x22\x81\xed\x5d\xc1\x46\x35\x57\x5a\xaa\xfe\x08\xe6\xfb\x28\x9b\xef\xbf\xee\xea\xef\x15\x47\xf7\x9e\x27\x24\x53\x0b\xa6\x26\xc2\x76\x8f\xdd\xe7\xa7\xca\xce\x66\x8a\x02\x09\x14\x0f\x4d\x44\x80\xbb\x01\xc2\x06\x64\x76\x58\x81\x72\x41\x4a\xc7\xc2\x7e\x45\x3a\xe3\xee\xb5\x99\x51\xd2\xf8\x71\x49\x4e\x4c\x4d\x67\x30\xd0\xe0\x0e\x0d\x6f\x5c\xa7\xfd\x1c\x92\x7a\x45\xf1\x37\x50\x30\x24\x31\x57\xcc\x83\x33\x72\xb4\x04\xf2\x68\x70\x01\xa8\x15\x76\xed\x1c\x12\x93\xad\x09\x79\x07\xd2\xf8\xf3\x82\x1a\x83\x43\x58\x2f\x19\x23\xa8\x7c\x55\x91\x83\xaf\xd0\x09\xc3\x0b\x75\xf7\x73\x95\x24\xaa\x20\x05\xd3\x66\xf8\xef\x02\xfa\x55\x7b\x30\xf0\xd1\x4d\x83\x01\x08\x22\x8a\x5b\xd1\x69\xa0\xe3\x37\x2f\xf6\xc1\xa2\x67\x7f\xe7\x67\x90\x08\x7c\xc3\xd0\xc7\xe3\x0c\x7b\xd3\xa5\xca\x4a\x88\xf5\x7b\x45\x22\x5c\xce\x21\x56\xba\x05\x97\xec\x21\xf5\xe5\xb6\x4c\xad\x53\x79\x5e\x99\x5d\x65\x42\x7f\x5e\xf7\x4d\x2e\xda\x8b\x71\x3d\xb7\x79\x52\x0e\x0b\xd1\xea\x4a\x59\x28\xf3\xfd\x6c\xd6\x73\x60\xa6\xe4\xeb\x91\x3d\x87\x66\xba\xee\x98\xcd\xf5\x34\x3d\x43\xed\x5a\x4b\xc4\x4d\x67\x3a\xd6\x24\xcf\x74\x92\xb4\x32\x9f\xdf\x91\xfb\x95\xcb\x46\x82\x8e\x62\x8d\x46\x85\x55\x95\xad\x71\xdc\xc8\x87\xb2\x3a\x12\x9b\x56\x05\x12\x5b\x67\x7f\x6a\x58\x5c\x09\xd3\x14\x46\x52\x48\xbe\x22\x4f\xe3\x7a\x1e\x68\xdb\x96\x06\x6a\xe5\xa0\x32\x75\x67\x97\xfd\x42\xcb\xd5\x08\x3a\x2e\xbd\x59\x3f\x97\x12\xae\xd0\x82\x9e\x67\x87\x75\x94\x75\xac\x2e\xbb\x53\xb9\x80\x5b\x3a\xde\x75\x75\x4d\xc6\x7b\xba\x21\xa3\x06\xbb\xd8\x4b\xa8\x4b\xe8\xad\x6c\x6f\x3e\x3b\xc9\x4a\x7f\xb2\x23\xc3\x17\x65\xd0\x1f\x94\xf0\x64\x7f\xe5\x43\xae\x8e\x41\xb6\x9c\xaa\x6f\xff\x14\x3f\x64\x53\xb2\xcc\xd0\xc8\xa7\x8c\xde\x5b\xfc\xf3\x4f\x51\x03\x5a\x2f\x78\xf9\xd2\x99\x65\x6e\xde\xfd\x51\xc1\xad\x53\x47\xcc\x74\xfe\xa2\x9c\x41\xe8\x94\xb3\xfe\xda\x6a\xe6\x66\x20\x1d\x17\xb0\x55\x94\x87\x0f\xae\x63\x2a\x1e\x3a\x66\xfc\xcc\x38\xe1\x4d\x8f\x41\xaf\xd0\xb0\x40\x0f\x87\x76\x62\x4d\x91\x43\x05\xda\x03\x5b\x7a\x1d\x34\x46\x23\xa7\xc2\x3a\x17\xda\x85\x85\xf0\x54\xfa\x40\xcf\xb6\x49\x6a\x3e\xaa\xf1\x74\x01\x3f\xb6\x95\x46\x66\x86\x0a\xdb\x0f\x08\xf7\x7b\x4d\x03\x04\xdd\xe6\xf5\x71\xe6\x18\xf2\x84\xf1\xff\xa1\xc0\xa9\xd0\x52\xe0\xb4\xec\x51\xe0\x04\x3c\x9c\x9f\x2c\x0d\x3c\x12\x66\x05\xe0\x57\x32\x28\xda\x38\xb9\xf0\xd4\x41\xc7\xad\xd0\x80\x59\xbc\x50\xf6\x9b\x2a\x0d\x85\x9d\x0b\x9e\x9f\xb9\x0f\x5c\xcf\x4a\x65\x3a\x86\x34\x86\xd8\xb0\xe4\x06\x29\xde\x50\x05\x6d\x0a\x28\x72\xf7\xe1\x5b\x93\xeb\xe9\x40\xd6\xa9\x69\xd7\x31\x8d\x08\x4e\x5a\xe3\x4e\x6a\xae\xc8\x8d\x3a\xd1\xc8\xcd\xb7\x19\x80\x9b\x5f\x09\x01\xb6\x28\xc9\x7d\x78\x39\x4f\x3a\x83\x9d\x7a\x90\x5d\x1f\xbb\xba\x6e\x31\xf1\x35\x63\xde\x4e\x35\x42\x75\x71\xd0\xa0\xb6\x23\xd5\x2f\xcf\x82\xb7\x32\x3b\x74\x86\xc1\x43\xd3\xe0\x50\x78\x73\xff\xf6\x14\x71\x58\x3b\x3e\x9f\x33\xdb\x69\x4c\xa3\x64\xed\x7a\x67\x54\x13\xb6\x69\x37\x1a\xe9\x63\xa6\x7b\x38\x2c\x17\xde\xda\xd5\x8d\xbe\xf6\x1a\x98\x64\x8d\xcd\x4e\x67\x24\x07\x5e\x4e\x55\x56\xe9\xb7\x7a\xfc\x65\x01\x33\x46\xe6\x7d\xb9\xb3\x4e\xdd\xfd\x44\xde\xaa\xd2\x5c\x66\x3c\x3e\xfd\xa6\x09\xa4\xd5\x12\xb4\x9d\x8a\x8a\x59\xbb\xee\xba\x22\xb7\x45\x3e\xd9\x9a\x88\x7b\x9f\x0f\xc6\x03\xe5\xf6\x13\xb5\x7a\xd8\xf4\xa4\xb6\x7c\x6a\x62\x04\x8d\x25\x91\xe5\x2f\x95\xfb\x0c\xe5\xd7\x2e\x3d\x12\xa8\x8b\xb7\xb6\xda\xfe\xa2\xb1\x10\xa9\xca\xa6\x84\x82\x8b\x17\x56\x29\x78\x5f\xd6\xa8\x95\x6f\x18\xea\x26\x50\x9b\x80\xff\x26\x6b\x73\xc2\x35\x50\x77\x6c\x5d\x3f\xfe\x17\x55\xe4\x4b\x90\x95\x21\x00\x00")
 
 func templateEntTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -794,12 +967,32 @@ func templateEntTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/ent.tmpl", size: 3926, mode: os.FileMode(420), modTime: time.Unix(1570170532, 0)}
+	info := bindataFileInfo{name: "template/ent.tmpl", size: 8597, mode: os.FileMode(420), modTime: time.Unix(1792024874, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateEnttestTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x6f\x8b\xdc\xb6\x13\x7e\x6d\x7d\x8a\xf9\x99\x10\xec\x63\x23\xe7\xf2\xeb\x9b\x26\x2c\x25\x59\x92\x12\x68\xef\x5a\xee\x4a\x0b\x21\x04\xad\x3c\xb6\xc5\x7a\x25\x47\x1a\xdf\xdd\x62\xfc\xdd\xcb\xc8\x5e\xdf\x6e\x72\x85\xec\xab\x95\xe6\xcf\x33\xf3\xe8\x99\xf1\x30\x14\x17\x62\xe3\xba\x83\x37\x75\x43\xf0\xea\xe5\xe5\xcf\x2f\x3a\x8f\x01\x2d\xc1\x07\xa5\x71\xeb\xdc\x0e\x3e\x5a\x2d\xe1\x6d\xdb\x42\x74\x0a\xc0\x76\x7f\x87\xa5\x14\xb7\x8d\x09\x10\x5c\xef\x35\x82\x76\x25\x82\x09\xd0\x1a\x8d\x36\x60\x09\xbd\x2d\xd1\x03\x35\x08\x6f\x3b\xa5\x1b\x84\x57\xf2\xe5\xd1\x0a\x95\xeb\x6d\x29\x8c\x8d\xf6\xdf\x3e\x6e\xde\x5f\xdd\xbc\x87\xca\xb4\x08\xf3\x9d\x77\x8e\xa0\x34\x1e\x35\x39\x7f\x00\x57\x01\x9d\x80\x91\x47\x94\xe2\xa2\x18\x47\x21\x86\x01\x4a\xac\x8c\x45\x48\xd1\x12\x61\xa0\x14\xe6\xfb\x67\xdd\xae\x86\xd7\x6b\xd8\xaa\x80\xf0\x4c\x6e\x9c\xad\x4c\x2d\xff\x50\x7a\xa7\x6a\x64\xa7\x61\x78\x01\xf7\x86\x1a\xc0\x07\x42\x5b\xc2\x33\x48\x67\x6b\x7a\x92\xed\xc5\x38\x8a\x64\x18\x80\x70\xdf\xb5\x8a\x10\xd2\x06\x55\x89\x3e\x05\x39\x25\x01\x8e\x65\x4c\xb3\xef\x9c\x27\xc8\x44\x92\x6a\x67\x09\x1f\x28\x15\x22\x49\x87\xe1\x29\xf4\x68\xaa\x0d\x35\xfd\x56\x6a\xb7\x2f\xaa\x99\x70\x63\x75\xbf\x55\xe4\x7c\x81\x96\x8a\xd2\xa8\x16\x35\x15\xe1\x6b\x5b\x04\xdd\xe0\x5e\xa5\x22\x17\x82\x0e\x1d\x32\x4e\x51\xc0\x2d\x06\x32\xb6\xbe\x65\xf6\x99\x4e\x63\x09\x3d\x27\x03\x6a\x14\xf1\x6d\x68\x94\xc7\x12\xb6\x48\xf7\x88\x36\x06\xd1\x14\x24\x6f\x41\xd9\x72\x39\xbd\x8b\xa7\x9e\x9f\x6f\x7b\x80\x99\x00\x29\x92\x47\x88\x25\xf7\x20\x92\xe4\x83\x32\xed\x95\xbb\xcf\x72\x91\x24\xef\xbd\x77\x3e\x93\x52\x2e\x2e\xc3\x98\x8b\x64\x14\x11\xee\xba\x23\xe3\x2c\xe8\xc8\x41\xef\x31\x80\x6e\x0d\xab\x4c\x7b\x54\x6c\x92\x22\x99\x7d\xaa\xde\xea\xec\xc2\xc5\x43\xc8\x85\x48\xe6\xbf\x10\xc8\xf7\x9a\x22\xb0\xeb\x28\xc0\xfc\xfb\xf4\xf9\xf8\xd2\xe3\x28\xa7\x1c\x22\x49\xf6\xa6\xf6\x8a\xf0\x9a\x1d\x3f\x7d\x9e\x88\x93\xbf\x2f\x97\xd1\x69\x64\x22\x8b\x02\xfe\x36\xd4\x5c\xcf\x20\x95\xf3\xf7\xca\x97\x01\x8e\xa8\xe4\xbe\x2f\x95\x4b\x3c\x8d\xca\x62\x3d\x52\xca\xef\x2a\xc9\x61\xee\x6a\x10\x89\x47\xea\xfd\xdc\x9f\x83\xa5\xc3\xa9\x21\x19\x53\xac\x41\x75\x1d\xda\x32\x9b\xce\x2b\xae\x22\x48\x29\x23\x91\xe3\x52\xec\x59\x1f\x4f\xd7\xac\x7a\x72\x30\x91\x70\x5e\xf2\x79\xec\x52\xf9\x53\x0c\xfd\x78\xf1\xa7\x6c\x9f\xf4\x70\x72\xfd\x54\x2b\xd7\x1d\x5a\xd0\xaa\x6d\x03\x9c\x11\x87\x36\xca\xd0\xf7\xdc\x4a\x83\x30\xd5\xf6\xd8\x0d\x64\x37\xf1\x46\x6e\x58\x3c\x98\x83\x9b\x77\x46\x24\x18\xcb\xf9\xc1\x24\x63\xdc\x36\x18\xd5\x0d\x95\x32\x6d\x80\xac\x0f\xc6\xd6\x30\x0b\x37\x07\x53\x81\xb3\x38\xad\x17\xdc\x47\x27\x2c\x25\x7c\xac\x80\x20\xf4\x1d\x4f\x33\x4b\x15\x95\xed\xbb\xd8\x3c\xe3\x07\xc8\x50\xd6\x92\x31\xc5\xd9\x28\xb9\x0a\x7e\x75\x70\x29\x2f\x7f\x8a\x0d\xa8\xad\xbb\xc3\x7c\xc5\x7e\x47\x0d\x19\xce\xe6\x78\xbe\xee\x1b\x9c\xaa\xe6\xf0\xe8\x6e\x28\x40\xe8\xb7\x7c\x0e\xa0\xdd\xbe\x6b\x91\x90\x9b\x10\x45\x91\xcc\xf1\xaf\xd7\xcb\x54\x32\x7b\x19\xad\x20\x0d\x5f\x5b\x43\xf8\xff\x74\x05\x29\x6f\xd0\xd7\x68\xe9\x97\xbd\x2b\x71\xbd\xc7\xbd\xf3\x87\xe7\x5a\xe9\x06\xd7\xd3\x0e\x78\xfe\xa5\xda\xad\x2f\xd3\x9c\x53\xf6\x01\x7d\xe0\xf5\x38\xd3\xf5\x57\x40\x2f\xff\xec\xd1\x1f\xb2\x5c\xbe\x6d\xdb\x7f\x32\x4d\x0f\xec\x39\x89\x67\xc2\x5b\xb6\xcd\x0a\x4a\x6f\xee\xd0\x5f\xa9\x3d\xae\xa0\x54\xa4\x6e\xe2\x87\x80\xcf\x3c\xaa\xc6\xd6\xd3\x9b\x83\x94\xcb\x30\x5c\x9c\xbc\xf3\x26\xa2\xb2\xb6\x1c\x17\xf1\x7c\x96\xd4\x30\x8a\xa4\x72\x1e\xbe\xc4\x68\xb6\x78\x65\x6b\xe4\x43\x38\xce\x7e\xe6\xe2\x40\x24\x7a\x05\xe8\x3d\xfb\x7c\xa3\x9f\xec\xbf\x6b\x5b\xc1\x34\x5b\x93\x14\x4d\x15\x33\xfc\x6f\x0d\xd6\xb4\x31\x3d\xc9\x69\x93\xa1\xf7\xbc\xd6\x48\x9e\x2c\xb9\x71\x09\x60\xd6\xe4\x99\x0a\xb3\x79\xe1\xcb\x77\x4a\xef\x6a\xcf\xdf\xb7\x2c\x5f\xc1\xd9\x10\x30\xe6\x9b\x6f\x01\xb5\xdc\xb4\x2e\x60\x96\xff\x10\xb8\xa6\x15\xb8\x1d\xb7\x4c\x32\x7b\x5c\xb5\xb0\x99\x34\x9a\xf1\x53\x65\x79\x0e\x63\xfe\x86\xfd\x22\x00\xc9\x73\x2b\x0c\xb0\x80\xc2\xb4\xa4\x8f\xf3\xad\xc5\xe9\xf7\xec\xdf\x01\x00\x2b\xcf\x03\x3f\x1f\x08\x00\x00")
+
+func templateEnttestTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateEnttestTmpl,
+		"template/enttest.tmpl",
+	)
+}
+
+func templateEnttestTmpl() (*asset, error) {
+	bytes, err := templateEnttestTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/enttest.tmpl", size: 2079, mode: os.FileMode(420), modTime: time.Unix(1791992024, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateExampleTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xdf\x6b\xeb\x36\x14\x7e\xb6\xff\x8a\x33\xe1\x32\xfb\x92\xda\xdd\xbd\x30\x58\x20\x6c\x5d\x6e\x3a\x02\x23\xed\x6e\x33\xd8\x5b\x51\xa5\x63\x47\x54\x91\x5c\x49\x76\x1b\x3c\xff\xef\x43\xb2\x9b\x1f\x6b\x3b\x06\xf7\xe5\xbe\x84\x48\x3a\xfa\x74\xce\xf7\x7d\xe7\xe0\xae\x2b\x3e\xc4\x73\x5d\xef\x8c\xa8\x36\x0e\x3e\x5e\xfc\xf0\xd3\x79\x6d\xd0\xa2\x72\x70\x45\x19\xde\x6b\xfd\x00\x4b\xc5\x72\xb8\x94\x12\x42\x90\x05\x7f\x6e\x5a\xe4\x79\xbc\xde\x08\x0b\x56\x37\x86\x21\x30\xcd\x11\x84\x05\x29\x18\x2a\x8b\x1c\x1a\xc5\xd1\x80\xdb\x20\x5c\xd6\x94\x6d\x10\x3e\xe6\x17\x2f\xa7\x50\xea\x46\xf1\x58\xa8\x70\xfe\xfb\x72\xbe\x58\xdd\x2e\xa0\x14\x12\x61\xdc\x33\x5a\x3b\xe0\xc2\x20\x73\xda\xec\x40\x97\xe0\x8e\x1e\x73\x06\x31\x8f\x3f\x14\x7d\x1f\xc7\x5d\x07\x1c\x4b\xa1\x10\x08\x3e\xd3\x6d\x2d\x91\xc0\xb8\x9f\xd4\x0f\x15\x4c\x67\x70\x4f\x2d\x42\x92\xcf\xb5\x2a\x45\x95\xdf\x50\xf6\x40\x2b\xf4\x41\x5d\x07\x0e\xb7\xb5\xa4\x0e\x81\x6c\x90\x72\x34\x04\x92\x70\x5d\x6c\x6b\x6d\x1c\xa4\x71\x44\xa4\xae\x48\x1c\x11\x87\xd6\x09\x15\xfe\x6a\xeb\x7f\x15\xba\xa2\x31\x92\xc4\x71\x44\x2a\xe1\x36\xcd\x7d\xce\xf4\xb6\x28\x47\xe2\x84\x62\xcd\x3d\x75\xda\x14\xa8\x5c\xc1\x05\x95\xc8\x5c\x61\x1f\x25\x89\xa3\xae\x03\x43\x55\x85\x90\xdc\x4d\x20\x51\x3e\xc9\x24\x5f\x69\x8e\xd6\x3f\x1e\x45\xc4\x67\xaf\x5e\x67\x5c\x0c\xfb\x87\x8d\x80\x75\x0e\xa8\xb8\xbf\x98\x0d\x65\xa3\x6a\x3d\x62\x53\xd7\x68\x06\x12\xfe\x86\xda\x08\xe5\x4a\x20\x67\xf6\x6e\xb9\x5a\x2f\x7e\xfb\x72\xb9\x5e\x5e\xaf\xee\x16\xab\xcf\x37\xd7\xcb\xd5\x7a\xe0\xac\x28\x80\x5b\x05\xa5\x1e\x84\xe3\xd4\x51\xcf\x5d\x0e\x4b\x05\xda\x04\x3d\x35\x98\x66\x90\xc8\xf3\x61\x41\x6a\x46\xa5\xdc\x4d\xf6\xdb\xa5\x96\x52\x3f\x09\x55\x01\xd3\xdb\x2d\x55\x7c\x1a\x17\x45\x5c\x14\x11\xbc\xa4\xd6\xf7\x33\xe2\xf5\x9d\xd6\xd4\xda\x5f\x1c\xab\xd3\x00\xb2\xd1\xd6\x4d\x3f\x7d\xba\xf8\x31\x2b\x3c\xf4\xcf\x35\x35\x16\xd7\x62\x8b\xb3\xb5\x69\x90\x40\xa5\xc3\x93\x70\xde\x7a\xc0\x96\x9a\x90\xab\x75\x46\xa8\x2a\xd4\xfd\x1e\xa3\xe7\x7d\x1f\x97\x8d\x62\xb0\x18\xfc\xd1\x75\x50\x53\xcb\xa8\xf4\x54\xae\xe8\xd6\xf3\x98\x66\xd0\xc5\x91\x28\x03\xe6\x6c\x06\x84\xf8\x75\x64\xd0\x35\x46\xc5\x51\x1f\x47\xcc\x3d\x7b\x50\xa6\x95\xc3\x67\x97\xff\x4a\xd9\x43\x65\xbc\x8d\xd3\x2c\x8e\xb8\x69\x27\x80\xc6\xf8\x08\xfb\x28\xf3\xeb\x1a\x55\x4a\xb6\x3b\xaf\xf6\xc4\x63\x66\x01\xdc\x47\x7c\x37\x03\x25\x64\x40\x97\xba\xca\xaf\xa8\xa3\xb2\x4c\x49\x49\x85\x44\x0e\xcc\x20\xf5\x2e\xdb\x73\x0f\x4c\x0a\x54\x6e\x0a\x67\x2d\x09\x4f\x64\x21\x1b\x8e\x25\x1a\xe0\xa6\xcd\xe7\x52\x5b\xf4\x39\x0c\x81\x3e\x83\x15\x3e\xcd\xc3\x22\xfd\x6c\x44\x8b\x26\xe5\xa6\xcd\xb2\x38\x2a\x8a\x03\x7e\x8b\xc6\x09\x86\x76\x2f\x76\xd7\x81\xd4\x4f\xde\x30\x7b\x52\xbe\xb7\x80\xbc\x42\x9b\x1f\x1b\x56\x4c\x20\xc1\x40\xaf\xca\x17\xfe\x74\x70\xac\x77\xa1\x28\x41\x69\x07\x09\xe6\x4b\xbb\x54\x2d\x1a\x8b\xc3\x61\x38\x4d\x82\x29\x0f\x3e\x3c\xe3\xc4\x87\xae\x77\x35\xe6\x5f\x90\xa1\xcf\x14\x12\x71\x7a\xa3\xef\x03\xe9\xa1\x9a\x3c\x18\x68\xb8\x30\x66\x98\xfb\xd0\x28\x9a\xfb\xaa\x30\xcd\x86\xe5\xa9\x15\xca\x90\xeb\x78\xed\x4a\xa0\xe4\x63\xc6\xe3\x23\x2f\x56\x28\x07\xcc\x43\xa7\xdc\xa2\x3b\xb3\xbe\x2f\x52\xff\x6e\x99\x8f\xee\x99\xfb\x41\xd7\xf7\x87\xb7\xc6\xde\x0b\x80\xb7\xb4\xc5\xbf\x52\xe6\x9e\x33\xbf\xf6\xfa\xde\x78\x34\xa9\x52\x72\xe0\xf7\xb4\x84\x41\x12\xe4\x53\x32\x09\x1d\xe2\x6b\xce\xe2\x13\xe4\xa3\x0e\xdf\x6b\xf8\xa6\x60\x41\x55\x7c\x86\x27\xe1\x36\x20\xdc\x89\x7c\x89\x3a\xb0\xfc\x8a\x55\x75\x4c\xe8\x11\x9b\x6f\x51\xa9\x4e\x58\xfc\x4a\x0a\x4f\xaa\xfc\x7a\x8b\xe9\xda\x5f\x23\x94\x73\xff\x6a\xd7\xf9\xf8\x04\xf3\x3f\x95\x78\x6c\x70\xd8\xf1\x31\x33\x20\x16\xdd\x18\x72\xd0\x2f\x40\x84\x49\xf1\x62\x54\x48\x5f\x6a\xd3\x75\x76\x58\x0c\xe2\x65\xaf\xae\x0d\x85\xfe\x1f\x8b\x07\xfb\x9c\x16\xbf\xcf\xe3\xd8\x44\xef\x58\x48\xbd\xe7\x9e\x13\x91\xb3\x38\xf8\xe5\xb1\x41\xb3\xfb\x06\x3a\x79\x98\x90\xb3\xd7\x79\xe6\x47\x13\x19\xff\xed\xa1\x3f\x7c\xf6\xa3\x8b\xb2\xfc\x4a\x18\xeb\xf6\x0d\xf6\x7a\xa4\xbe\x39\x54\x03\x01\x7e\xe8\x0d\x03\x64\xa4\xee\x64\xa4\x46\x51\xff\x56\xc7\x1e\xa2\x87\x4f\x96\xff\x6c\xd2\xe3\x1e\xbd\x6e\x5c\xdd\xb8\x69\x1c\xbe\x31\xc6\xfd\xa3\xbf\xff\x04\x00\x00\xff\xff\xaa\xb1\x2c\xe8\x79\x09\x00\x00")
+var _templateExampleTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\x5d\x6f\xdb\xb8\x12\x7d\x16\x7f\xc5\x5c\xc1\xc1\x95\x8b\x84\xea\x6d\x81\x0b\x5c\x03\xc6\xdd\xae\x9b\x2c\x0c\x2c\x9c\x6c\xeb\x05\xf6\xad\x60\xc4\x91\x4c\x84\x26\x15\x92\x72\x62\x68\xf5\xdf\x17\x43\xc9\x5f\x89\xbb\x2d\xd0\x87\x7d\x31\x4c\xce\x70\x3e\xce\x9c\x39\x6a\xdb\xfc\x0d\x9b\xd9\x7a\xeb\x54\xb5\x0a\xf0\xee\xed\x7f\xfe\x77\x55\x3b\xf4\x68\x02\xdc\x88\x02\xef\xad\x7d\x80\xb9\x29\x38\x7c\xd0\x1a\xa2\x93\x07\xb2\xbb\x0d\x4a\xce\x96\x2b\xe5\xc1\xdb\xc6\x15\x08\x85\x95\x08\xca\x83\x56\x05\x1a\x8f\x12\x1a\x23\xd1\x41\x58\x21\x7c\xa8\x45\xb1\x42\x78\xc7\xdf\xee\xac\x50\xda\xc6\x48\xa6\x4c\xb4\xff\x3a\x9f\x5d\x2f\x3e\x5f\x43\xa9\x34\xc2\x70\xe7\xac\x0d\x20\x95\xc3\x22\x58\xb7\x05\x5b\x42\x38\x4a\x16\x1c\x22\x67\x6f\xf2\xae\x63\xac\x6d\x41\x62\xa9\x0c\x42\x8a\xcf\x62\x5d\x6b\x4c\x61\xb8\x1f\xd5\x0f\x15\x4c\xa6\x70\x2f\x3c\xc2\x88\xcf\xac\x29\x55\xc5\xef\x44\xf1\x20\x2a\x24\xa7\xb6\x85\x80\xeb\x5a\x8b\x80\x90\xae\x50\x48\x74\x29\x8c\xc8\xc2\xd4\xba\xb6\x2e\x40\xc6\x92\x54\xdb\x2a\x65\x49\x1a\xd0\x07\x65\xe2\x5f\xeb\xe9\xd7\x60\xc8\x1b\xa7\x53\xc6\x92\xb4\x52\x61\xd5\xdc\xf3\xc2\xae\xf3\x72\x00\x4e\x99\xa2\xb9\x17\xc1\xba\x1c\x4d\xc8\xa5\x12\x1a\x8b\x90\xfb\x47\x9d\xb2\xa4\x6d\xc1\x09\x53\x21\x8c\xbe\x5c\xc2\xc8\x50\x91\x23\xbe\xb0\x12\x3d\x25\x4f\x92\x94\xaa\x37\xaf\x2b\xce\xfb\xfb\xc3\x45\xca\x92\xa4\x6d\xaf\x8e\xa3\x95\x31\x9a\xe1\x37\x0a\xb5\x1c\xe2\x45\x9f\x27\x15\x56\x30\x2a\xf9\x72\x5b\x23\xbf\x7b\xa8\xee\x44\x58\x0d\xe6\x98\x90\x0f\xf1\xa2\x33\x1a\xd9\xdb\x8e\x0f\x47\xff\xc7\x3d\xc2\x68\x36\x94\xae\xa9\x6b\x74\x3d\xde\x7f\x42\xed\x94\x09\x25\xa4\x17\xfe\xcb\x7c\xb1\xbc\xfe\xe5\xd3\x87\xe5\xfc\x76\xf1\xe5\x7a\xf1\xf1\xee\x76\xbe\x58\xf6\xe3\xc9\x73\x90\xde\x40\x69\x7b\x8e\x48\x11\x04\x8d\x89\xc3\xdc\x80\x75\x91\x3a\x16\x5c\xd3\xb3\x81\xa0\xf7\xa0\x6d\x21\xb4\xde\x5e\xee\xaf\x4b\xab\xb5\x7d\x52\xa6\x82\xc2\xae\xd7\xc2\xc8\x09\xcb\x73\x96\xe7\x09\xec\x4a\xeb\xba\x69\x4a\x54\x9a\xd4\xc2\xfb\x9f\x42\x51\x67\x31\xc8\xca\xfa\x30\x79\xff\xfe\xed\x7f\xc7\x39\x85\xfe\x7f\x2d\x9c\xc7\xa5\x5a\xe3\x74\xe9\x1a\x4c\xa1\xb2\x40\xf7\x70\xb5\xa1\x80\x1b\xe1\x62\xad\x3e\x38\x65\x2a\xc6\xfe\x66\x78\x57\x5d\xc7\xca\xc6\x14\x70\xdd\x53\xb1\x6d\xa1\x16\xbe\x10\x9a\xa6\xb6\x10\x6b\x1a\x59\x36\x86\x96\x25\xaa\x8c\x31\xa7\x53\x48\x53\x3a\x27\x0e\x43\xe3\x0c\x4b\x3a\x96\x14\xe1\x99\x40\x2d\xac\x09\xf8\x1c\xf8\xcf\xa2\x78\xa8\x1c\x6d\x4c\x36\x66\x89\x74\x9b\x4b\x40\xe7\xc8\xc3\x3f\x6a\x7e\x5b\xa3\xc9\xd2\xf5\x96\x88\x75\x49\x31\xc7\x31\x38\x79\xfc\x6b\x0a\x46\xe9\x18\x5d\xdb\x8a\xdf\x88\x20\x74\x99\xa5\xa5\x50\x1a\x25\x14\x0e\x05\x11\x7a\x8f\x3d\x14\x5a\xa1\x09\x13\xb8\xd8\xa4\x31\xc5\x38\x56\x23\xb1\x44\x07\xd2\x6d\xf8\x4c\x5b\x8f\x54\x43\xef\x48\x15\x2c\xf0\x69\x16\x0f\xd9\x47\xa7\x36\xe8\x32\xe9\x36\xe3\x71\xcf\x14\x55\x52\xd7\x9f\x50\xc8\x5b\xa3\xb7\x34\xf5\x24\xcf\xe1\xb1\x41\xb7\x8d\xe3\x73\x28\xe4\x95\x25\x53\xdb\x82\xb6\x4f\xe8\x8e\x50\x82\x0d\xba\xa0\x0a\xf4\x9c\x82\x91\xe1\x13\x16\x48\x29\xa0\xeb\xf6\x00\xf4\x85\xf0\xb6\x3d\x7a\xc9\x7f\xa3\x0c\xd9\x98\xdf\x28\xe7\x43\x56\x84\xe7\xef\x45\x24\x96\x46\x88\x9c\xa9\xe7\x25\x2a\x04\xe8\x1d\x11\x5d\x9b\x2c\x3d\x57\x7f\x94\xb8\x49\x7a\x09\xaf\xaa\x1f\xe0\x41\xed\x71\x07\xca\x7e\x16\xbb\xae\xf7\x8b\x71\x26\xf4\xbf\x3d\xa0\xac\x76\xc8\x0c\x54\x54\x97\x30\xc2\x61\xf3\xaf\xc9\x7a\xd8\x5e\x55\x82\xb1\x01\x46\xc8\xe7\x7e\x6e\x36\xe8\x86\xbc\xbd\x75\x14\x17\xf8\xb0\xb3\x17\x32\x25\xd7\x28\x12\xfb\xa2\x47\xea\xf4\x45\xd7\xbd\x40\x7f\x78\x30\x54\xc8\x49\x45\x92\x64\x46\x5d\x61\x36\xee\x8f\xa7\x6b\xd3\xab\xd4\xf0\xec\x44\xaa\xc8\xf3\x6a\xbf\x36\x65\x0f\xe8\x41\x55\x3e\x63\xb8\xf0\xa4\x21\x19\x01\x5b\xf2\x61\xd3\x66\xf4\xfd\xe9\xba\x43\xae\x9d\x66\x51\xc4\xcf\x62\x83\x7f\x0c\x4c\x48\xbe\x36\xba\xd3\x16\xfa\xf5\xc0\xfd\x04\xa9\xe7\x31\x3b\x89\x7c\xac\x8c\xbb\x19\x9e\x1d\x58\xe4\x32\x3e\xf7\x02\xac\xc2\xc9\xf8\x4e\xa9\xf1\x55\x4e\xb3\x13\x34\xdb\xf6\x5b\x82\xff\x83\x10\x9e\x74\xf9\xe3\x14\xb3\x35\x35\x96\x0a\x29\x29\x6b\xdb\x92\xff\x08\xf9\xef\x46\x3d\x36\xd4\x1e\xe1\x60\x6b\x98\x42\xea\x31\x0c\x2e\xbb\xfc\x43\x88\xa8\xaa\x3b\xa2\x42\xb6\xeb\xcd\xd6\xe3\xc3\x01\x23\x5c\xe3\x57\xcf\xfa\x46\xbf\x87\xe2\x91\x3e\xa7\xcd\xef\xeb\x38\x26\xd1\x37\xb7\xff\x05\x7b\x5e\xec\xff\x91\x10\xfe\xf3\x9b\xdc\x8b\xe9\xf4\x75\x9d\xfc\xe8\xeb\x85\x2f\x39\x14\x45\x76\x60\xd1\xa9\xd4\x26\x67\xc4\xf6\x9b\x72\x3b\xc2\xb3\x42\x9b\x24\xdd\xb9\x8d\x3d\x78\x9f\xca\xec\xd9\x25\xfd\xda\xbe\xde\x36\xa1\x6e\xc2\x84\x75\xec\xe0\xc3\xda\x16\xd0\x48\xe8\x3a\xf6\xd7\x00\x21\x57\x40\x67\x1c\x0b\x00\x00")
 
 func templateExampleTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -814,7 +1007,27 @@ func templateExampleTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/example.tmpl", size: 2425, mode: os.FileMode(420), modTime: time.Unix(1567330554, 0)}
+	info := bindataFileInfo{name: "template/example.tmpl", size: 2844, mode: os.FileMode(420), modTime: time.Unix(1791987083, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateFixtureTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x58\x5b\x6f\xdb\x38\x16\x7e\x96\x7e\xc5\x19\x23\x19\x58\x85\x86\xee\x74\x5f\xba\x1e\xe4\xa1\xe8\x05\x9b\x45\xa7\x2d\x36\xed\x02\x8b\x20\x28\x18\xf1\xc8\xe6\x58\x26\x55\x92\x72\x13\x78\xfd\xdf\x17\x87\xa4\xae\x71\xdc\x66\xdf\x24\x5e\xce\xfd\x3b\x17\xee\xf7\x8b\x67\xe9\x6b\x5d\xdf\x1b\xb9\x5a\x3b\x78\xf1\xfc\xf7\xbf\xff\x56\x1b\xb4\xa8\x1c\xbc\xe3\x05\xde\x6a\xbd\x81\x4b\x55\x30\x78\x55\x55\xe0\x0f\x59\xa0\x7d\xb3\x43\xc1\xd2\xcf\x6b\x69\xc1\xea\xc6\x14\x08\x85\x16\x08\xd2\x42\x25\x0b\x54\x16\x05\x34\x4a\xa0\x01\xb7\x46\x78\x55\xf3\x62\x8d\xf0\x82\x3d\x6f\x77\xa1\xd4\x8d\x12\xa9\x54\x7e\xff\xfd\xe5\xeb\xb7\x1f\xae\xde\x42\x29\x2b\x84\xb8\x66\xb4\x76\x20\xa4\xc1\xc2\x69\x73\x0f\xba\x04\x37\x60\xe6\x0c\x22\x4b\x9f\x2d\x0e\x87\x34\xdd\xef\x41\x60\x29\x15\xc2\xac\x94\x77\xae\x31\x38\x83\xb8\x7e\x56\x6f\x56\xb0\xbc\x80\x5b\x6e\x11\xce\xd8\x6b\xad\x4a\xb9\x62\x9f\x78\xb1\xe1\x2b\xa4\x43\xfb\x3d\x38\xdc\xd6\x15\x77\x08\xb3\x35\x72\x81\x66\x06\x67\xb4\x93\xca\x6d\xad\x8d\x83\x79\x9a\xcc\x0a\xad\x1c\xde\xb9\x59\x9a\xcc\x50\x15\x5a\x48\xb5\x5a\xfc\x65\xb5\xa2\x85\x72\xeb\xd7\xa5\x5e\x48\xdd\x38\x59\xd1\x8f\xd5\xc6\x2f\x3a\xb9\xc5\x59\x9a\x26\xfb\xfd\x6f\x60\xb8\x5a\x21\x9c\x7d\xcd\xe1\x4c\x91\x48\x67\xec\x83\x16\x68\x89\x55\x92\xcc\x48\x56\xf5\x50\xbe\x45\x58\xef\x17\x66\x69\x32\xa5\x56\x7a\x6a\x8a\xbd\x93\x58\x89\x48\xcf\x9f\xf9\x2e\xdd\x1a\xce\x4a\xf6\xf9\xbe\x46\xf6\x69\xb3\xfa\xc4\xdd\x3a\x6e\x7b\x86\x2c\xd2\xf3\x87\x51\x89\xb0\x37\xfc\x99\x7e\x07\x8a\xad\x98\x57\xc5\x1a\xb7\xbc\x57\x20\xd2\x1b\x5c\x4a\x93\xd9\x4a\xd7\x9b\x15\x93\x6a\x71\xcf\xb7\x15\xdb\xbd\x98\xa5\x59\x9a\x2e\x16\x10\x3d\x65\x61\xad\x49\x6a\x8a\x02\x83\x85\x36\xc2\x06\x57\x63\x7f\x82\xa2\xc2\xc2\xed\x3d\x85\x85\x34\xa0\x28\xce\xdc\x7d\x8d\xc0\x95\x88\x6b\x06\x4b\x34\xa8\x0a\x04\xc5\xb7\xc8\x52\xbf\xdd\x11\xd8\xf2\xfa\xda\x3a\x23\xd5\xea\xe6\xf8\xa7\x54\x0e\x4d\xc9\x0b\xdc\x1f\xbc\x70\xef\x35\x17\xef\xda\xdb\x95\xe6\xc7\x05\x5c\xc9\x1d\xaa\xa9\x98\xf3\xff\xbc\xfa\xf3\x3d\x68\x03\xff\xbc\xfa\xf8\x21\x03\xa9\x9c\xf6\x77\x57\x86\xd7\x6b\x46\xc4\x3f\x0f\x28\x71\x83\xb0\x32\xba\xa9\x51\x3c\xa6\x20\x29\xe4\x37\x79\xaf\x65\x0e\x6e\xcd\x1d\x81\xad\x21\xa0\x95\xda\x10\xe1\x42\x2b\x85\x85\x93\x6a\x45\x1c\xb7\xe0\x34\x68\xb7\x46\xd3\x71\x6b\x6c\xdc\x93\x06\x50\xac\xd0\x32\x78\xa7\x0d\xe0\x1d\xdf\xd6\x15\x2e\xd3\xc5\x22\x5d\x2c\x92\x2f\x16\x0d\x7d\x27\x09\x7f\xb9\x0d\x1f\x09\x09\xb1\x04\xfe\x72\x1b\x7e\xf9\x0a\x97\xf0\xb7\xe7\xe1\xa7\x34\x12\x95\xb0\x4b\xb8\x56\xdc\xc9\x1b\xbf\x48\x5f\xa3\xab\xb4\x30\xb8\xfb\xe2\x65\x60\xf6\xc0\x18\x85\x41\xee\x50\xb4\x49\x40\x1b\x4a\x21\xd1\xde\xe4\x54\x7b\xcc\xe9\x36\x6f\x57\x83\x5a\x64\x0c\xb2\x2c\x17\x02\x05\xf0\xd2\xa1\x01\x5e\x55\x1d\xa3\xef\xd8\x73\x62\xf0\x8f\xd6\xa2\x78\x0f\x05\x57\x3d\xd9\xee\x7c\x69\xf4\x96\x08\x10\x5d\xef\xe5\x1c\x6e\x1b\x07\x06\xbf\x35\xd2\xa0\x08\x4c\xbd\xf8\x4a\x3b\xb0\x4d\x4d\x89\x83\x48\x7f\xd0\x0e\x83\xab\xc2\x11\xbb\xd6\x4d\x25\xe0\x16\x63\xc2\x12\xa0\x15\x68\x85\x44\xd8\x4a\x81\xad\xa6\x06\x2b\xee\xa4\xdf\xab\xee\x59\xf4\x4b\x51\x49\x54\x8e\x0d\x63\x73\x5e\xb8\xbb\x1c\x66\x0e\xad\x13\xdc\xf1\x45\x63\xd1\x58\x46\x48\x9b\x0d\x97\x7d\x84\xc5\xf5\x8c\xa8\x95\x8d\x2a\x60\x5e\xc0\xb3\xd7\x9e\x66\x06\x53\xa2\x10\x33\x1e\x41\x9d\x32\x5f\x0e\x35\x77\x6b\x0b\x8c\xb1\x80\x9e\x0c\xd0\x18\x6d\x60\x9f\x26\xe5\x1d\x25\xa0\x2d\xdf\xe0\xbc\xc5\x42\x96\x26\xa5\x36\xf0\x35\x5c\xa3\xed\x90\xfd\xe8\xcf\xd2\x9d\x84\xc4\xcd\x89\x06\x6d\x86\xc4\xc9\xfe\x85\x24\x44\x85\x73\x3a\x96\xa5\x49\x22\x4b\x7f\xe2\x97\x0b\x50\xb2\xf2\xd7\x12\x83\xae\x31\x0a\xca\xad\x63\x6f\x49\x80\x72\x3e\x6b\xd3\xfc\xe1\xb0\x04\x83\x5c\x8c\x11\xb9\x84\xf3\xdd\xcc\x73\x22\x8a\x94\xaa\x68\xf5\x98\xc4\x2d\xbb\xe5\x05\x90\xa5\xd8\x17\xb5\xe5\xc6\xae\x79\x35\x0f\xc2\xfe\x4a\x17\xb3\x3f\x9e\x2a\x92\x40\x5f\x18\x47\x42\xc1\xf9\xb7\x28\x17\xa9\x3a\x96\x4e\x1b\xc2\x7e\xde\x05\x5f\x67\x3c\x62\x1f\x8c\x20\x4b\x28\xef\xae\xdd\x7d\x7d\x03\x17\x03\x41\x92\x6e\x31\xe8\xf6\xc3\x5c\x47\x16\xf1\x4c\x3d\x57\x83\x65\xcb\xb5\xf7\x58\x2b\x45\x60\x20\x4b\xf2\xa9\xde\xd0\x7e\x64\x76\x6d\xb0\xbc\xf9\x03\xf4\x26\x1e\xf9\x91\x39\xa2\x1d\xe0\xdc\xb2\x73\xea\x24\x04\x16\x15\x27\x10\x49\x05\xe7\xdf\x66\x79\xab\x7c\x19\x62\xc7\x8b\x98\x1c\x86\xea\x79\x8e\x70\x11\x45\x6b\x55\x38\xa4\x74\x4a\x06\x83\x9d\x50\x9f\x64\x61\x97\x6f\xa8\x26\xc2\xe1\x90\x43\x85\x6a\x5e\xde\x65\x31\x62\xdd\x7d\xdd\xeb\x5e\xde\x79\x9d\xec\x77\xe9\x8a\x35\xc9\x05\xfb\x87\xd5\x77\x52\xcb\xf7\x7b\x90\xa5\x4f\x03\x67\xca\x07\xf4\x47\x55\xdd\x87\x12\x59\x50\x0f\x12\x0b\xfd\x07\xbe\xf5\xd5\x7c\x39\x2a\xb8\xfb\x7d\x5b\x38\x93\x44\x60\xc9\x9b\xca\x2d\x7f\x22\xc2\x1a\xb5\x51\xfa\xfb\xa0\x12\x51\xa2\xec\x8c\xd9\x06\x96\x14\xf6\xb1\xe8\x38\x6e\x13\x7f\x9a\x0c\x73\x48\xff\x7f\xa5\x63\x1a\x30\x58\x0e\xed\xca\x0c\x96\x76\x3e\xb1\x45\x16\x83\x5b\x74\x79\xa1\x60\xa3\x13\x2c\x14\x87\x98\xa7\x28\x4d\xe5\x84\x83\x09\x99\x10\x1f\x59\x9a\x1c\xcd\x1f\x3f\xb2\x65\x60\xd1\x45\xe9\x98\xff\xb9\x8d\xa8\xf5\xe1\x19\x41\x4b\x61\x47\x52\xdb\xe3\x82\xc0\x05\x48\x11\x5c\x70\xd4\xd1\xa7\x2d\x1b\xc3\x43\x96\x44\xf8\xad\xaf\x21\x87\x0e\xb0\x4f\xb3\xeb\x20\xbd\x4d\xed\x5a\x49\xb5\x19\x59\xf5\x51\x6d\x1e\x37\x78\x0e\x52\xd8\x23\xd9\xf1\x87\x16\x27\xe6\x4f\xb2\x37\x99\xd2\xdb\x73\x60\xd0\x51\xd7\xd9\x72\x54\xb2\x4a\x43\x3f\x47\xe1\x06\x61\x35\x74\x72\xd4\xa0\xa3\xe8\x4b\x7d\xd7\xd3\xb5\xf9\x2e\xfe\x86\x16\x8f\xf0\xc4\x62\xd1\x2c\xef\x5a\x61\x6d\x46\xf7\xed\x9c\x12\x43\x5b\x14\xaf\x6f\xc2\x17\x99\x9c\x36\xbb\x64\xd4\x6e\xe4\xf0\xfc\x01\xbe\x62\xf2\x1d\x3a\xd2\xef\x11\x91\x40\xe5\x02\x78\x5d\xa3\x12\x73\xfa\xf3\xe6\x08\xb0\x24\x35\xd8\x95\xe7\x68\xfd\x5e\xd6\x29\x4f\x7f\x51\xfb\x28\xef\xbf\x79\xd5\x60\x2c\x47\xc1\x0a\x3b\xbf\xa2\x4b\xe0\xad\x4e\x50\xd2\x10\x11\xba\xd6\x5d\x1e\x1b\x46\xe9\xac\xef\x66\xc1\x60\x1c\x0d\x7d\x73\x12\x2d\x32\xa4\x3e\xf7\x14\x73\xd8\xc1\xb0\xca\xf4\xad\xc2\x6d\x53\x76\xe0\xa6\xf9\x89\xfd\x19\x2b\x6c\x24\x42\x5c\x02\x0d\xca\x3b\x0f\xe1\x1b\x75\x43\x63\xbc\xfa\xf1\xd7\x53\xea\xab\xb5\x67\xb2\xcb\xc6\xca\x13\x65\x6a\x6a\x76\x68\x5c\x50\xde\xf7\xe9\x5b\x5e\x4f\x7c\xed\xb9\x53\xeb\xec\x6f\xe8\xdb\xbf\xb0\x70\x76\xac\x6a\x2f\xe5\x58\xcd\xc1\x0f\x29\x1b\xeb\x46\x20\xb8\xbc\x08\x1f\x8c\xc2\x05\x7d\xaa\xf3\xf5\x80\x2a\xd3\xe0\xde\xb0\x3a\x53\xea\xdf\x1e\xab\x66\x83\x33\x21\x96\x3a\x8b\xf9\x1a\xb6\x21\xfb\x77\xb1\xe4\xf7\x88\x5d\x92\x6c\xaf\x29\xeb\x5d\xd5\x46\x2a\x37\xdf\x64\x54\x09\x46\x1a\xb5\x95\x22\x9a\x75\x1b\x45\xbc\x9e\x0a\x45\x4c\xe4\x51\x0e\xfe\xf3\x5a\x3e\xa0\x1c\x97\x23\x83\xde\x73\xfe\xfc\xd8\x51\x97\x6f\xc6\x48\x95\x3d\x18\x3b\xb4\x8a\xae\x2d\x79\x0c\xa6\x3d\xb1\x87\x8e\xca\xa1\x47\xac\xcf\x5a\x30\xb0\xed\xa3\x55\x31\x83\xf9\xf5\x83\x42\xe9\x03\xdb\x3b\x73\xc7\x3d\x86\x6d\x97\x01\x7e\xd2\xff\x81\xd7\xf2\x11\xa0\xfb\x2b\xd9\x29\x3f\x7c\x7d\xcc\xd9\x3e\x65\x86\x4e\x6d\xc7\xe6\x31\x3f\xc5\xa2\xf8\x4b\xd7\xad\x45\x3f\x28\x59\xe5\xa3\x04\xdd\x28\xbc\xab\xb1\x18\xe5\x48\x32\x1b\xc2\xf9\xe7\x59\x0e\xbb\xbe\xf0\x3d\x9e\x9f\x68\x7f\xd0\xc5\x3c\x85\x55\xdb\xc2\x7c\x9e\xf5\x36\x38\xa4\xc9\x6e\x98\x4f\xa7\xbe\x20\x20\x10\xfb\x36\xa3\xca\x49\x75\xa4\x3d\xaf\xb5\x14\xad\x61\xda\x96\xc8\x97\xb6\x74\x6c\x9a\xc7\xc4\x1d\xf7\xaf\xdf\xb9\xf5\x7d\x8f\x7f\xcd\x1a\x34\xaf\x51\xfd\x64\x67\x03\x1a\xa4\x18\x85\xbd\xcd\xdb\xe2\xb4\xdf\x47\xf1\x9e\xd4\x57\x91\xee\x61\x1c\xa4\x0b\x1e\xd0\x5d\xdd\x9c\x85\x99\xce\x3f\x81\xd1\xa3\xc0\xb0\x67\x8a\x7f\x16\xf8\xb8\xd2\x86\x09\x97\xe0\xe6\xf3\xff\x04\x57\xad\xca\x01\x74\x11\x61\x34\x3d\x0e\xc4\x38\x1c\xb2\x31\xab\xe3\x53\x64\x1c\x2f\x06\x28\x1b\xc4\x74\x06\x73\x29\x60\xea\x57\xea\x59\x7a\x9c\xc5\xfe\xcc\x37\x31\xaf\xfd\xf7\x3c\xfa\x9b\x9e\x28\x62\xb4\x0c\x9d\xee\xf9\x91\x47\x17\x0b\x50\x4d\x55\x85\x13\x61\x5a\xb7\x1b\x59\xd7\x28\xfa\xd7\x83\x18\xae\x9d\xfe\xd1\x1a\x74\x96\x1e\x5a\x58\x88\x11\x4f\x61\x34\x79\xd1\xb4\x2c\x55\x83\xd1\xeb\x11\xf9\x24\x11\xec\x7f\xf2\xc1\xae\x9f\x0e\xca\xd6\x2b\x33\x02\x79\xe2\x53\xcb\xce\x9b\x25\x3c\xe5\xb5\x4f\x78\x7d\x3f\x77\xac\x04\xff\xba\x3b\xd5\x8c\x49\x31\x8e\xea\x6e\x48\xa5\xea\xdf\x0d\xa7\xa4\xc0\xb4\xef\x8a\x1e\x60\x57\xe8\xf6\x7b\xa8\xb9\x2d\x78\x35\x90\x79\xbe\xcb\x26\x8d\xd9\xe0\xe1\x70\xdc\xc2\x7a\x8d\xfb\xf8\x97\x39\x9c\xf9\x3a\xc9\xba\xc8\x3f\x93\x3e\x02\xba\x6e\xd9\xf7\x8d\xd8\x99\xa7\x5b\x1f\x4f\x51\xc7\x87\xa7\xa9\xc6\xfd\xd0\x44\x2a\xd3\x2b\x98\x58\xc5\xa1\x89\xd4\x8e\x00\xa6\xa4\xd3\x8f\x23\x51\x73\xbe\xf3\x6d\xf2\xa9\x0e\x85\xb8\x4d\xba\x94\x1d\xbb\x7c\x33\x84\xfd\xa4\xa7\x5f\x2c\x60\xd0\x86\x03\x17\xf1\xcd\x31\x3c\x1c\x9d\xc0\x23\xc4\x07\xc6\x31\xa0\xfd\x4b\x6d\x7f\x45\x9e\x80\xed\xa4\xfb\x7f\x08\xda\x23\xa0\x3c\x89\xe3\xa7\x14\xd5\xae\x35\x6c\x6a\xc1\x1d\xe6\xde\x08\x28\x28\x0e\x0a\xf6\xc5\xaf\x7d\x54\x78\xf9\x66\x2e\x45\x96\x43\xc9\x2b\x8b\x3f\x07\xf7\x1f\x60\x10\x23\x06\x1f\x04\xe4\x24\xc6\x22\x04\x6d\x3e\xc1\x5a\xd7\x59\xe4\xed\x0d\xb2\x4d\x77\xcd\x9b\x20\x1b\xc2\xf4\x28\x0c\x87\x01\x19\xe3\xef\x14\xf4\xda\x51\x10\xd9\x17\x25\xbf\x35\x5d\x26\xa0\x38\xa4\xf2\xb7\xb3\x19\xc1\xfd\xf7\x96\xcb\x49\x36\xa1\xea\xd2\xeb\xe3\xa0\xc4\x93\x65\x9b\x40\x9b\x0e\xe6\xb0\xd2\x6e\x09\xe7\xa2\x93\x28\xb2\x09\x52\x45\xb1\xa2\xef\x26\x29\xa1\x33\xc6\xe5\x9b\xf9\xce\x5e\x3f\xbf\x89\x77\xa2\x83\x2f\xc0\x19\x9f\x30\x23\x74\x2b\xdb\xab\x13\xe9\xbd\x12\x62\xbf\x07\x1a\x41\x9a\x8a\x9b\x8e\xe2\x7f\x5b\x16\x87\x83\xf7\x82\x65\x8c\x9d\xa6\x3d\xcc\x44\xdd\x4f\x7c\x30\x2a\xe1\x97\x78\x6b\x00\x5f\x42\x29\xed\x7e\xed\xdc\xde\xaa\xd8\x63\x3f\x1e\x25\x94\x1f\xd2\x2e\x15\xf5\x5f\x47\x96\xd2\xff\x0d\x00\xa4\x68\x56\x1d\x6e\x1b\x00\x00")
+
+func templateFixtureTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateFixtureTmpl,
+		"template/fixture.tmpl",
+	)
+}
+
+func templateFixtureTmpl() (*asset, error) {
+	bytes, err := templateFixtureTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/fixture.tmpl", size: 7022, mode: os.FileMode(420), modTime: time.Unix(1791985479, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -839,7 +1052,7 @@ func templateHeaderTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateImportTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\x51\x6b\xdb\x30\x10\x7e\x8e\x7f\xc5\x61\xf2\xd0\x86\x55\xee\xfa\xb6\xc1\x1e\x4a\x68\x21\x30\x46\x20\x7d\x1f\x8a\x74\xb2\x8f\xd8\x92\x77\x3a\x77\x0b\xc6\xff\x7d\xc8\x71\x88\x47\x56\x0a\x7d\xf2\x77\xf7\x7d\xba\xef\xe4\x3b\xf5\x7d\xb1\xca\xd6\xa1\x3d\x32\x95\x95\xc0\xc3\xfd\xe7\x2f\x77\x2d\x63\x44\x2f\xf0\xac\x0d\xee\x43\x38\xc0\xc6\x1b\x05\x8f\x75\x0d\xa3\x28\x42\xe2\xf9\x15\xad\xca\x5e\x2a\x8a\x10\x43\xc7\x06\xc1\x04\x8b\x40\x11\x6a\x32\xe8\x23\x5a\xe8\xbc\x45\x06\xa9\x10\x1e\x5b\x6d\x2a\x84\x07\x75\x7f\x66\xc1\x85\xce\xdb\x8c\xfc\xc8\x7f\xdf\xac\x9f\x7e\xec\x9e\xc0\x51\x8d\x30\xe5\x38\x04\x01\x4b\x8c\x46\x02\x1f\x21\x38\x90\x99\x99\x30\xa2\xca\x56\xc5\x30\x64\x59\xdf\x83\x45\x47\x1e\x21\xa7\xa6\x0d\x2c\x39\x0c\x43\x76\x82\x70\x93\x2d\x72\xd7\x48\x9e\x2d\x72\x13\xbc\xe0\x9f\x11\x22\x73\xe0\x98\x50\xa3\xa5\x4a\xdf\x28\x6c\x82\x7f\x9d\x20\xf9\x72\x64\x85\x1a\xcc\xb3\x45\xdf\xdf\x41\xb1\x02\x2a\x7d\x60\x84\x12\x3d\xb2\x90\x2f\x21\x78\x28\x59\xb7\x15\xc4\x16\x0d\x39\x72\x06\x04\x9b\xb6\xd6\x82\x11\xc6\xe6\xc6\xa3\xe4\xc0\x07\x81\x1b\xfc\x05\x4b\xb5\x0e\xde\x51\xa9\xb6\xda\x1c\x74\x89\xb0\x3c\xa3\xdb\xd4\xf4\x62\x91\xf7\xfd\xb5\x68\x18\x8a\x96\xd1\x92\xd1\x92\xda\x79\x53\x34\xa6\x2f\x71\x92\x26\xff\xdf\x24\xd5\x45\xbf\x33\x15\x36\x1a\x4e\x76\x63\x29\x35\xd3\xa2\xb7\x27\x26\x05\xac\x7d\x6a\xf1\xe7\x27\x58\x3a\xf8\xfa\x0d\x96\x6a\x27\xdc\x19\x79\x26\xac\x6d\x9c\x2a\x5c\x1c\x9c\xda\x1e\xca\xad\x96\x6a\x62\xfe\x29\x7e\x5d\xfd\x1d\xab\x37\x4d\x5e\x8e\x2d\x7e\xcc\x69\x8e\xf3\x92\xa4\xea\xf6\xca\x84\xa6\x70\xd3\xa6\x93\x37\xdd\x5e\x4b\xe0\x02\xfd\xb8\x27\xef\x69\x0a\x4b\xba\x46\x23\xd3\x8e\xcc\x2e\x11\x25\x70\x9a\xc2\xf4\xd7\x4e\xc1\xff\x6e\x3b\xed\x69\xd2\x4d\x67\xd4\x66\x4c\xc5\xd9\x84\xce\xaa\xeb\x39\xcd\xf0\x6d\x7a\x09\x13\xfe\x1b\x00\x00\xff\xff\xcb\x8c\xa7\x03\xd8\x03\x00\x00")
+var _templateImportTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x53\x4d\x6f\xdb\x30\x0c\x3d\xc7\xbf\x82\x30\x7a\x68\x83\x55\xee\x7a\xdb\x80\x1d\x8a\x20\x05\x02\x0c\x43\x80\xf4\x3e\x28\x12\x65\x6b\xb1\x25\x4f\x92\xbb\x05\x46\xfe\xfb\x28\x59\x6d\xd2\x65\x5b\x8b\x9d\x48\x91\x8f\x8f\xe2\xd7\x38\x56\xf3\x62\x61\xfb\xbd\xd3\x75\x13\xe0\xf6\xe6\xfd\x87\xeb\xde\xa1\x47\x13\xe0\x9e\x0b\xdc\x5a\xbb\x83\x95\x11\x0c\xee\xda\x16\x12\xc8\x43\xf4\xbb\x47\x94\xac\x78\x68\xb4\x07\x6f\x07\x27\x10\x84\x95\x08\xf4\x6c\xb5\x40\xe3\x51\xc2\x60\x24\x3a\x08\x0d\xc2\x5d\xcf\x05\x89\x5b\x76\xf3\xe4\x05\x65\xc9\x5d\x68\x93\xfc\x9f\x57\x8b\xe5\x97\xcd\x12\x94\x6e\x89\x62\xb2\x39\x6b\x03\x48\xed\x50\x04\xeb\xf6\x60\x15\x59\x8f\xc9\x82\x43\x64\xc5\xbc\x3a\x1c\x8a\x62\x1c\x41\xa2\xd2\x06\xa1\xd4\x5d\x6f\x5d\x28\x81\xcc\x93\x0a\x97\xc5\xac\x54\x5d\x28\x49\x08\x6b\x02\xfe\x4c\x2a\x1a\xfa\xad\x36\x75\xf5\xcd\x5b\x93\x0c\xce\x59\xe7\xa3\xd6\xf1\xd0\x44\xe9\x83\xa3\x80\xc7\xac\x12\x36\x79\xfd\xde\x88\x28\x83\xee\x90\xe4\x38\x5e\x43\x35\x07\x5d\x1b\xeb\x10\x6a\x34\xe8\x02\x41\xc1\x1a\xa8\x1d\xef\x1b\xf0\x3d\x0a\xad\xb4\x12\x10\xb0\xeb\x5b\x1e\xd0\x43\xfa\x75\x0a\xd5\x0a\x0c\x55\x79\x89\xdf\xe1\x82\x2d\xac\x51\xba\x66\x6b\x2e\x76\xbc\x46\x32\x64\xed\x2a\x56\x33\x9b\x95\x54\xe6\x19\xe8\x70\xa8\x68\x5a\x52\x0b\x22\x2e\xff\x01\x4a\xe6\xe3\x3b\x42\x63\xfe\x1f\x3a\x34\x47\xfc\x86\x66\xd4\x71\x98\xd2\x25\x2a\x76\x82\x45\x23\x27\x4f\x7c\x38\x6e\xe2\x17\xbf\xbe\x83\x0b\x05\x1f\x3f\x11\xc7\x26\xb8\x41\x84\x7b\x8d\xad\xf4\x99\xe1\x98\x41\xb1\xf5\xae\x5e\x53\x63\xb3\xe7\x05\xf9\x39\xfb\x2b\xa9\xfe\x9a\xe4\x61\xdf\xe3\xff\x65\x3a\xd5\xcb\x9a\xe8\x86\x2d\x13\xb6\xab\x54\x3e\x01\x6d\xc4\xb0\xe5\xb4\x88\x15\x1d\x46\xf9\x06\x4c\x25\x35\x6f\x69\x75\xdf\x84\xf5\xa9\xf1\x95\x8a\x75\xe5\xa5\x3a\xa9\xda\x13\x2c\x8e\x2d\xb7\x79\x7a\xfc\xa9\x3d\x79\xe3\x23\x2e\xc7\xb0\x55\x32\xf9\x93\x91\x3e\xa1\xce\x07\xfb\xbb\x4e\xcb\x49\xbd\x46\x1e\x06\x87\x4b\xc3\xb7\x2d\x9d\x34\x1d\x4e\x10\xf1\x96\xcb\xbc\x94\xaf\xd6\xf6\x1c\xf0\x22\xc1\x55\x3c\xda\xac\xff\x02\x98\x5f\x80\xf6\x83\x04\x00\x00")
 
 func templateImportTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -854,12 +1067,12 @@ func templateImportTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/import.tmpl", size: 984, mode: os.FileMode(420), modTime: time.Unix(1568822311, 0)}
+	info := bindataFileInfo{name: "template/import.tmpl", size: 1155, mode: os.FileMode(420), modTime: time.Unix(1792024429, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5b\x6f\xdb\x36\x14\x7e\x96\x7e\xc5\x99\xa0\x02\x76\xe1\xca\x69\xdf\xe6\xc1\x0f\x45\x93\x62\xc6\xba\xa2\x40\xb3\xed\x21\x08\x0a\x5a\x3a\x8c\xd9\x4a\xa4\x4a\xd2\xae\x03\x41\xff\x7d\xe0\x4d\xa2\x3c\x67\xc9\xd6\x2d\x2f\xb1\x78\x6e\xdf\xb9\xf2\xb0\xeb\x96\xcf\xd3\x37\xa2\xbd\x97\xec\x6e\xa7\xe1\xd5\xc5\xcb\x1f\x5f\xb4\x12\x15\x72\x0d\x6f\x49\x89\x5b\x21\xbe\xc0\x86\x97\x05\xbc\xae\x6b\xb0\x4c\x0a\x0c\x5d\x1e\xb0\x2a\xd2\xeb\x1d\x53\xa0\xc4\x5e\x96\x08\xa5\xa8\x10\x98\x82\x9a\x95\xc8\x15\x56\xb0\xe7\x15\x4a\xd0\x3b\x84\xd7\x2d\x29\x77\x08\xaf\x8a\x8b\x40\x05\x2a\xf6\xbc\x4a\x19\xb7\xf4\x77\x9b\x37\x57\xef\x3f\x5e\x01\x65\x35\x82\x3f\x93\x42\x68\xa8\x98\xc4\x52\x0b\x79\x0f\x82\x82\x8e\x8c\x69\x89\x58\xa4\xcf\x97\x7d\x9f\xa6\x5d\x07\x15\x52\xc6\x11\xb2\x06\x35\xc9\xc0\x1d\xbe\x80\x6f\x4c\xef\x00\x8f\x1a\x79\x05\x39\x64\x1f\x48\xf9\x85\xdc\x61\x06\x79\xe1\x7f\xc2\x8b\xbe\x4f\x93\xae\x03\x8d\x4d\x5b\x13\x8d\x90\xed\x90\x54\x28\x33\x28\x8c\x96\xae\x03\x23\xeb\x8d\x8c\x4c\xac\x69\x85\xd4\x19\xe4\x96\x54\x0a\xae\x34\xcc\xd2\x64\xb9\x84\x77\x64\x8b\x35\xec\x44\x5d\x29\xeb\x85\xd2\x92\xf1\x3b\xa8\xed\x71\x85\x5c\x68\xf3\x69\x28\x5d\x07\xb5\xf8\x86\x12\xf2\xe2\x3d\x69\x10\xfa\x1e\xf4\x7d\x3b\xb8\x5f\x11\x4d\xb6\x44\x61\x91\x26\x4e\xe7\x1a\xb2\xae\x83\xbc\x70\x5f\x7d\x9f\x59\x7b\xf6\x68\x73\x59\xbc\x31\x18\x08\xd7\x46\xcd\x5f\xac\x4f\xec\xb2\x0a\x28\xc3\xba\x3a\x63\xe8\x9c\xb2\x60\x76\x73\x59\x7c\xd4\x42\x92\x3b\xfc\x05\xef\x9d\xf9\xae\x03\x49\xf8\x1d\x42\xfe\x69\x01\x39\x85\xd5\x1a\xf2\xe2\xad\xd1\xad\x4c\x60\x8d\x98\xb3\x64\x08\x74\xd4\x6a\x83\x1e\xc0\x3b\x8e\x47\x51\x8f\xd1\xa2\x43\xb8\x0e\x28\x35\x1e\xa1\x95\xa2\x45\xa9\xef\xcf\x38\x94\x4c\x2c\x78\x57\xe8\x39\x47\x4c\x9a\x43\x31\x44\x4e\x29\xc7\xe9\x5c\xf3\x62\x60\xe1\x1b\x4d\xba\x69\x6b\x43\x6a\x25\xe3\x9a\x42\x56\x31\x52\x63\xa9\x97\xcf\xd4\xd2\x14\xe2\xb2\xf4\x1e\xab\x6c\xd4\x14\x84\x8f\x43\x35\x39\x35\xb6\x94\x92\xb1\xe0\xe6\xb6\xe4\x9e\x02\xe5\x29\x48\x0e\x44\x32\xb2\xad\xf1\x14\x49\xd7\x01\xa3\xb0\x23\xea\x7a\x8a\xe6\xa9\x28\xa7\x0d\xc2\x28\x08\x53\xcf\x3f\x13\x75\x89\x94\xec\x6b\xed\x3e\x7e\x27\x35\xab\x88\x16\x52\x19\xce\x03\x91\xa6\x59\x86\x06\xcd\x8b\x5f\xd9\x11\xab\x0d\xff\x83\xe9\x5d\x90\xb3\x00\x1a\x76\x64\x1c\xd6\x26\xf9\x26\xa1\xc6\xef\x72\x87\x0d\x81\xbe\x2f\x6c\x51\xfa\x4a\xe8\x7a\xa3\x82\xf1\xd9\x3c\x08\xf9\x2a\x5c\xc3\x4d\x51\x14\xb7\x37\xb7\xc8\xb5\xab\xcc\x2e\x4d\x12\x6b\xda\x47\x96\x2d\x20\xff\x64\x22\x77\xf4\x07\xc5\xfb\x7d\x63\x95\x39\x08\x5e\xdf\x8d\x31\xc7\xa0\xef\x6f\x7d\x81\xcf\xe6\x8b\xa0\xc9\x07\x20\x49\xfa\x74\xf2\x4d\x03\x86\x27\xc0\x0f\x4a\xe3\xfa\x63\xe7\x9a\x2a\x75\x36\xf3\x0a\x55\x39\x24\x1c\x32\xf3\x99\xc1\xac\x25\xaa\x24\x75\xe8\x91\xf9\x20\x10\x32\x43\x8b\x21\x2f\xb4\xf8\xad\xad\x88\xc6\xe8\x20\x4a\x93\x13\xb4\xa6\x18\x35\xb4\x0f\x42\x31\xcd\x04\x0f\xb9\x0a\xd1\xf1\x5d\x6c\xe1\xf4\xbd\x19\xff\xb6\x83\x9d\x9f\xe6\x54\xb2\x56\x0b\x09\x54\x48\xd7\xee\x43\xf7\xda\xf0\x14\x56\x49\xac\x61\x0d\x51\x02\x6f\x9c\x48\x6c\x9c\xf1\x0d\xaf\xf0\x68\x52\x71\x4a\x1d\x08\xc5\xe5\x60\xd8\x96\x84\x4b\x4b\xad\xf0\x7f\x44\x4d\xcf\x02\x7e\x04\x52\xa8\x9c\x78\x00\xf9\x74\x45\xb9\x1a\x73\x91\x57\xfe\xc8\x4d\x54\xcf\x60\xb1\xf9\x8c\x0d\x9e\x05\xd1\x68\xae\x86\xc3\x03\xa9\xf7\x08\x82\x43\x29\x91\x18\x98\xd6\x4f\x3f\x65\xcf\xfa\x7a\xa2\x72\x1d\x47\x2f\xa0\x28\x66\x03\xf0\x8d\xba\x66\x56\x05\xdd\xf3\x72\x36\x87\x61\x4a\x38\xfd\xd7\xe6\x9a\xeb\xfb\xf9\x83\x8e\x4f\x2b\xf3\x41\xf7\x27\x6c\xff\x3a\x08\x7b\xab\xe5\xfb\x42\x30\x41\xf2\x9f\x04\xc2\x4d\xc6\xf3\x3d\x09\x39\x37\xf0\x5c\x10\x06\x86\x98\x6e\x17\x89\xd5\x1a\x86\xfb\xc0\xd8\x87\xd9\x33\x35\x07\x94\x52\xc8\x6c\xb0\x3e\x8d\x18\xf7\x6e\x33\x05\xc4\x44\xc8\x6b\x0e\xb1\xc9\x26\xc1\xc9\x7c\x74\x60\xa3\x8d\x40\x49\xea\x1a\x2b\xd8\xde\x5b\xd6\xed\x9e\xd5\x15\x4a\x05\x5b\xa4\x42\x22\x28\x72\xc0\x10\x47\x46\x01\xbf\x9e\x38\xf7\x32\x20\x49\x62\x1c\xd3\x28\x8f\xec\x37\x17\xb7\x36\xca\xce\x51\x17\x41\x1b\x42\xd3\xe3\xe7\x15\x8d\x19\x08\x42\x60\xef\x82\x24\x39\x8c\x28\x56\x0f\x19\x74\x9c\x94\x5b\x16\x7b\xa7\x58\x7d\xd3\x34\xba\xd8\x06\xb5\xf1\x2d\xf3\x79\x01\x39\x8f\x6f\x99\x89\xef\x1e\xef\x04\x8a\x1d\x24\x9f\xed\xe4\x98\x3d\x68\xca\xdd\x41\xa7\xc3\x24\xb1\x37\x91\xf9\x93\xa8\xf7\x92\x43\x24\x1f\xea\xfa\x6f\x81\x9b\x74\x7f\x5a\x00\xb5\x88\x1d\x60\xe3\x79\x20\x27\x26\x7f\x52\x1a\x22\xe5\x53\xbd\xf3\x9f\x2c\xe5\x87\x35\x70\x56\x8f\x02\x01\x08\x4a\x19\x8e\x82\xcb\xe1\xbf\xe7\xe0\xac\x8e\x3d\xe8\x67\x43\x66\xe3\xe6\x88\x97\xb5\xf0\x7b\x1e\xef\x22\xa9\x79\xd6\x84\x47\x41\xb9\x57\x5a\x34\x6e\xb9\x36\xae\x21\xdf\x37\x7e\x5c\x83\x7d\x40\x3c\xb2\xc7\xa6\x49\xd4\xcf\x57\x46\xd8\xe3\x58\x3e\x07\xd1\x30\x6d\xeb\xbd\xf5\x0f\x0a\x5b\x70\x54\x1a\x7b\x3b\xb4\x36\x0b\x67\xc4\xd5\xa3\xb5\xbd\x5a\x83\x96\xac\x09\x6f\x10\x9f\x88\xe2\xa3\x5b\x7b\xc7\xc7\x49\xbc\x26\xa3\xb3\xeb\x7d\x52\x83\xf6\x07\xe6\xd6\xe8\xa3\x69\x3a\xcb\x18\x6b\x71\x1b\x76\x9a\x26\xc9\xf0\x76\x99\xd4\xab\x89\x43\x18\x30\xc6\xe3\xa1\x46\xbb\x0e\xa6\x3b\x86\x9b\x65\xe1\x6c\xa8\xad\x60\xc8\xaf\xdc\xe8\xf6\x6c\x67\x63\xac\xd4\xb9\x41\xe0\x46\x93\x8a\xc5\xe6\xe0\x62\x31\x9b\x87\xb7\x80\x2d\x25\x5f\x23\xee\x68\xa6\xe6\x76\xe5\x4a\x1f\x9d\x88\xdf\x31\xdb\x5c\x1c\xed\x55\xa1\xfe\xd9\x9c\xb3\x5e\x45\x66\xcf\xf4\xdf\xe0\x6c\xd4\x7d\xea\x1b\xd3\xe5\xee\x24\x99\xae\x8f\x4a\xb3\x42\x4e\xb7\xc3\xd3\x14\xb9\x3a\xe5\x86\x0a\x17\xd0\xf7\x8b\xf8\xbe\x79\x2c\x6f\x03\xef\x2a\x3d\xd7\x91\xfe\xee\x9b\x12\x69\xa3\x8b\x2b\x83\x9e\xce\xdc\x2b\x71\x2c\xdd\x15\x30\x6e\xa3\x1c\xc5\xf0\xa1\x7d\x6a\x05\xcf\xbe\x66\x8b\x29\xc5\x36\xfe\xb0\x55\x9f\x7f\x6f\xb8\x9f\x7f\x06\x00\x00\xff\xff\x7f\x6d\xbc\x22\xc3\x10\x00\x00")
+var _templateMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x1a\xdb\x72\xdb\x36\xf6\x59\xfc\x0a\x54\x63\xef\x4a\x1e\x85\x4e\xfb\xb6\xda\xd1\x43\x27\x71\x76\x3d\x6d\xd3\x4c\xed\xb6\x0f\x1e\x4f\x87\x22\x41\x0b\x0d\x6f\x21\x48\xd5\x5e\x8d\xfe\xbd\xe7\x02\x90\x20\x45\x59\x4c\xe3\x7d\xa8\x1f\x22\x12\x38\xf7\x1b\x0e\x0e\xb3\xdb\x5d\x5e\x78\x6f\xf2\xe2\xa9\x54\x0f\x9b\x4a\x7c\xf3\xfa\xeb\x7f\xbd\x2a\x4a\xa9\x65\x56\x89\x77\x41\x28\xd7\x79\xfe\x51\x5c\x67\xa1\x2f\xbe\x4d\x12\x41\x40\x5a\xe0\x7e\xb9\x95\x91\xef\xdd\x6e\x94\x16\x3a\xaf\xcb\x50\x8a\x30\x8f\xa4\x80\xd7\x44\x85\x32\xd3\x32\x12\x75\x16\xc9\x52\x54\x1b\x29\xbe\x2d\x82\x10\x7e\xbe\xf1\x5f\xdb\x5d\x11\xe7\xb0\xed\xa9\x8c\xf6\xbf\xbf\x7e\x73\xf5\xfe\xe6\x4a\xc4\x2a\x01\x12\xbc\x56\xe6\x79\x25\x22\x55\xca\xb0\xca\xcb\x27\x91\xc7\xb0\xda\x32\xab\x4a\x29\x7d\xef\xe2\x72\xbf\xf7\xbc\xdd\x4e\x44\x32\x56\x99\x14\xd3\x54\x56\xc1\x54\xf0\xe2\x2b\xf1\x87\xaa\x36\x42\x3e\x56\x32\x8b\xc4\x99\x98\x7e\x08\xc2\x8f\xc1\x83\x9c\x8a\x33\xdf\x3c\x8a\x57\x00\x3a\x01\xfc\x4a\xa6\x45\x12\x54\x40\x61\x23\x03\x90\x7a\x2a\x7c\xa4\x02\x3b\x88\x6b\x98\xb4\x40\x2a\x2d\xf2\xb2\x02\x42\xb4\x15\xe6\x99\xae\xc4\xcc\x9b\x5c\x5e\x8a\xef\x83\xb5\x4c\xc4\x26\x4f\x22\x4d\x5a\xe8\xaa\x54\xd9\x83\x48\x68\x39\x92\x59\x5e\xe1\x2b\xee\x00\xc1\x24\xff\x03\x0c\x74\xe6\xbf\x0f\x52\x09\x94\x44\xf5\x54\x34\xea\x47\x41\x15\xac\x03\x0d\x4a\x4e\x98\xe6\x4a\x4c\x01\xe5\xcc\xe7\xb7\xfd\x7e\x4a\xfc\x68\xe9\xfa\xad\xff\x06\x65\x08\xc0\x69\x40\xe6\x80\x7b\x87\xaf\x8a\xc0\xcc\x32\x89\x06\x18\x0d\x11\xb3\x6c\x61\xf9\x06\x1c\x01\x46\xfb\x4e\x3e\x31\x7b\x58\x2f\x83\x0c\xac\x78\xf6\xdb\x42\x9c\xc5\x62\xb9\x02\xb8\x77\x48\x5b\xa3\x61\x11\x8d\x39\xe1\x46\xdc\x52\x25\xa3\x5b\xe1\x19\xe2\xa4\xd4\xad\xb5\xe2\xc6\x5c\x5b\x59\x56\xf2\x51\x14\x65\x5e\xc0\xd3\xd3\x80\x42\x93\x0e\x07\xa3\x4a\x3c\xa4\x08\xba\xd9\x06\x83\xa3\x94\x66\x48\x56\xcd\xa0\x09\x12\x1f\x29\x55\x10\x0f\xb8\x55\x80\xbc\x55\x2c\xa6\x91\x0a\x12\x08\xd7\xcb\x73\x7d\x89\x81\x78\x19\x1a\x8d\xf5\xb4\xa5\x64\x91\x1f\x9b\x68\x62\x32\x14\x4a\x93\x36\xe0\xe6\x14\x72\x63\x44\x19\x23\xc9\x36\x28\x55\xb0\x4e\x64\x5f\x12\xc0\x55\xb1\xd8\x04\xfa\xb6\x2b\xcd\x58\x29\xbb\x09\x02\xa4\x72\x8c\xe7\xff\x06\xfa\xad\x8c\x83\x3a\xa9\xf8\xe5\x97\x20\x51\xe0\x95\xbc\xd4\xfc\x7e\x0b\x6a\xe9\x38\x2f\x53\x09\x2b\x80\x0b\xd2\x61\xfa\x34\x29\x7b\xe6\xff\xa0\x1e\x65\x74\x9d\xfd\x0a\x6f\x96\x12\x89\x94\xaa\x47\xf0\xf2\x0a\xc3\x01\x5d\x8c\x96\x80\xc2\x92\x06\xb0\xeb\x53\x98\x9a\xd8\xd8\xed\x91\x84\xca\x66\x73\x8b\x64\xe2\x72\x25\xee\x7c\xdf\xbf\xbf\xbb\x87\x02\xc7\xb1\xba\x03\x08\x62\x6d\x6c\xad\xc0\xd6\xbf\xa1\x2d\x1f\xcd\x82\xff\xbe\x4e\x89\x18\x8b\x60\xe8\xdd\x21\x3b\x05\x4b\xf7\x26\xe4\x67\xf3\x85\xa5\x64\x4c\x32\x99\xec\xbd\xce\x7b\x6c\x65\x18\x21\xbe\x25\xea\x46\xa4\x1a\x4a\x33\x8f\x79\x9e\x45\x52\x87\x4d\x08\x40\x04\xc0\xeb\x54\xcc\x8a\x40\x87\x41\x62\xb3\x66\xde\x20\x58\x5f\xc5\x7e\xe3\xa9\xd8\xff\xb9\x00\x2f\x49\x67\xc1\x75\x5c\xec\x77\xdc\xc6\x84\x88\x35\x90\x82\xdd\x0f\xb9\x56\x95\xca\x33\xeb\x3b\x6b\x2d\x93\xe7\x24\x1e\x24\xa1\x32\x39\xce\x7a\xe3\x6a\xa9\x0a\xe0\x00\x07\x42\xc9\x05\xa1\xc9\x6f\x32\x97\x4f\x44\x5c\x0a\x2b\xe1\x38\xf4\x8e\x51\x5c\xe6\x2a\xbb\x86\x83\xe7\x11\x5d\xd3\xdf\x6d\x36\x40\x6b\xcb\x98\x42\x84\xdd\x94\x68\xf9\x7f\x94\x3a\x1e\x14\xf8\x84\x48\x36\x92\xdc\x12\x65\xdc\xe7\xf8\xae\xf5\x05\xf0\xe3\x25\xae\xb9\x06\x80\x64\x33\x1e\x6b\x34\xb3\xa8\x4e\xe5\xb5\x8b\xdb\x20\xa9\xa5\xc8\x33\x11\x96\x32\x40\x31\x49\x4f\x53\x87\x07\x75\xed\x91\x5c\xb9\xd6\xb3\x52\xf8\xb3\xbe\xe0\xef\xea\x0c\x01\x62\xf8\x99\xcd\x45\x53\x4c\x98\xc9\x2d\x9e\x86\xfb\xfd\xfc\xa8\xf6\xdd\x70\x3d\x6a\x83\x0e\xd8\x5f\xb6\x44\x4d\x54\xbe\xcc\x0e\x1d\x49\x5e\xce\x1a\x5c\x33\x8f\xe5\xa7\x38\xcb\x50\x4a\xb6\x85\x03\xe2\x42\x50\xe3\x01\x10\xcd\xf9\x81\x32\x88\xd9\xb9\x9e\x8b\x73\x3c\x32\x0c\x7b\xfb\xdb\xb5\x5f\x66\x8c\x00\x39\x12\x40\x5f\xd6\x32\xb0\xb6\x9a\x76\x8c\x35\x35\xd6\x12\xd7\x15\xa2\x40\x75\x4a\xa0\x57\x5c\x3f\x11\xe8\xba\x56\x49\x84\xe2\xaf\x25\x60\x4b\x34\x3f\x16\x20\x4c\x14\x23\x2b\x58\x4c\x7e\x3a\xd0\xf6\x6b\x2b\xd3\xc4\x95\xa8\x6b\x7d\x17\xe1\xee\xf5\x3d\xd9\x9f\x35\x67\xb3\x92\x5d\xb1\x04\x0c\x93\x6a\xdd\x62\x91\x04\x1d\x1d\x93\x49\xe5\x4a\xb2\x3c\xce\x94\xa1\xe3\x8c\x80\xe8\x18\x22\x9a\x5d\xff\x8a\xce\xab\x65\xe1\x1e\x50\xbf\xc3\x29\x90\xb9\x07\x54\xcf\x16\x46\xfa\x9e\x60\x54\x77\x7e\xa7\x42\x33\x3b\xc9\x96\x8f\xb2\x7e\x0d\x9a\xd0\x81\x86\x7f\xa5\xac\xea\x32\x13\x0e\x9d\x9b\xaa\xac\xc3\xea\x9d\x6d\xb5\x46\xe9\x84\xf1\x01\x9d\x4d\x4c\xca\xb0\x2e\x68\x1c\xbb\x3d\x19\xa4\x0c\x8e\xc8\x06\x79\xce\x0d\x9a\xd5\xde\xc8\x38\x04\xea\xea\xb2\x9f\x35\xbe\x77\x73\x6a\x30\xc1\x9c\xe3\x70\x38\xbd\x1a\x80\x91\xc9\x25\xcb\x32\x2f\xa7\x63\xf2\x6a\xdb\x50\x7e\x81\xac\xd2\xc1\x56\x1e\xe4\x93\xa3\xdc\x98\x6c\x6a\xc1\x5f\x34\x97\xb6\xad\x14\xcb\x63\x0c\xc7\xe5\x11\xd9\x76\x64\xfe\x38\xba\xb7\xd9\xd3\x8a\x72\x2a\x77\x88\xd5\x4b\xe7\x4c\x57\xfe\x53\xb9\x82\x6e\x2c\x4b\xdc\x3c\x96\x1e\xff\x26\x80\xaf\x56\x22\x53\x49\x8b\x67\xc5\x82\x3d\xbb\xb4\x1f\x4e\x24\x40\xfb\x9c\xbc\x71\x9e\xe7\x87\xd7\x04\xba\x06\x70\xef\xdb\xb5\x3d\xc4\xbe\xb3\xe0\xdc\x09\x09\xf6\x55\x22\xb7\x70\xf9\x75\x82\x84\xc6\x02\x83\x97\xe9\xb4\xae\xe8\xe0\xd0\xbe\xb8\xdd\xc0\x4d\x2f\x80\xc0\x1f\x93\x16\x78\x19\x71\x44\x18\xd5\xac\xb7\xf0\xb3\x8e\xb2\xa0\xcd\x4d\x98\x17\x92\x73\xb8\x94\xb5\xc6\x4b\x98\xc0\x44\x88\x40\x25\xdc\x69\x15\x68\x45\xff\x54\xcb\x52\x49\x4d\xc9\xbc\xae\xb3\x08\xee\x6d\x50\x3c\x64\xa4\x42\x68\x1f\xf4\x02\x1a\x77\x12\x3b\x00\x2e\x81\x48\x54\xaa\x2a\xe4\x04\x17\x94\x3c\x93\x02\xae\x6b\xa1\x5c\xd0\xa6\xaa\xfe\x09\x50\x45\x91\x28\x60\x97\xf3\xed\xd8\xd0\x16\xb5\x36\x57\x6b\x55\x1a\x19\xe1\xa2\xb8\xc9\x23\xb2\x16\xcb\x86\x96\x0f\x2a\x32\x5c\x24\xc3\x04\x7e\x23\xc3\xc7\xe9\x85\x71\xf7\x41\x66\xb2\x04\xd1\x40\x1c\x4d\x01\x4e\x76\x6f\x46\x36\x05\x0f\x5b\x7c\x8f\x72\x9d\x99\x69\x0a\x4e\x8c\x43\xa0\xf8\xa1\xd1\x8d\xc8\x05\x51\x04\xa4\xaa\xbc\x23\x2f\x89\x52\x59\xd1\xc8\xa0\x8d\x62\x50\xcb\x1c\x12\x77\xf7\x8d\xad\x3a\x7e\x22\x56\x3f\xa2\xe9\x9c\xb8\x32\xa6\x34\x5e\x20\xda\x8b\x56\x6d\x96\x24\x88\x2b\x33\xc7\xea\x42\x5b\x37\x11\xe1\x5b\xb2\xa4\xb9\xe1\x21\x2e\x42\xf0\xe8\xa1\x19\x03\xf4\x2d\x22\x66\xd2\x7f\xf0\x87\x66\x38\x73\x20\xca\xa2\xf2\x4d\x95\x9e\x29\x95\x79\xca\x84\x3e\x87\x78\x22\x5f\xb3\x22\x14\x06\x3d\xc9\x16\x98\x6a\x14\x04\x59\x5e\x89\xff\xc9\x32\xc7\x69\x12\x01\xc2\x49\xe4\x71\x36\x9a\x1b\xf7\x0d\x7b\x9c\x53\xd0\xb8\xbf\x35\x53\x1b\x7d\x1d\x53\x0d\x44\x48\x37\x3c\x38\x9d\x0c\x6c\x53\xf7\x8f\x79\x08\xc3\xc1\xc0\x2e\x47\xe5\x1d\x58\x2c\x56\x0f\xb3\xb9\x91\xde\x9b\x38\x72\x02\x85\x34\xf8\x28\x67\xc7\x98\x2d\x44\x22\xb3\x19\xb3\x9b\x43\x2d\xc3\x0a\x0b\x17\x6c\xdd\x16\x58\x23\x0a\xd6\x4a\xb0\x23\x41\xfb\xbf\x6e\x64\x09\xd7\xe8\xd5\x4a\xbc\xe6\x22\x0a\xce\xad\x54\x56\x4b\xbe\xed\x4f\x8a\x85\xad\xc3\xef\x54\x02\x61\xf3\x43\x50\x34\x58\x5e\x53\xa6\xdd\x2a\x5c\x04\x99\x0a\x67\x71\x5a\xf9\x37\xdc\x20\xcc\x78\xe0\x66\x47\x94\xfb\xfd\x12\x6c\x4a\x25\xaf\xe7\x06\xce\x84\xf3\x4f\x4b\x71\xbe\x9d\x82\xe4\xa4\x19\xf1\x47\x7d\x58\x9c\x06\xe1\x4e\xdd\x83\x03\x0a\x0f\x97\x4d\x45\x6f\x37\xbd\xfd\xac\x5f\xa4\x9d\x91\x83\x36\x33\x27\x37\x42\x00\xc2\x8e\x16\x74\xe3\x3e\xa6\xab\xdb\xf6\x44\xb7\xed\xc9\xc9\x6a\xb7\xc0\xe2\x71\x2c\x8a\xa8\x65\x1a\xe2\x09\xd1\xc4\x05\x65\xd7\xa8\x45\xef\x3b\x33\x16\xe1\xe8\x36\x1e\x30\x47\x72\x5b\x2b\x96\x47\x43\x71\xc7\xce\x6f\xa7\x3c\xfb\x85\x77\x70\x25\x6f\xc9\x73\xae\x32\x79\x7a\x5e\x1e\x24\xee\xce\xeb\xf5\x21\x38\xd4\xcb\xd1\xb4\x7e\xd3\x2a\xec\x08\x72\x49\xf6\xc9\x79\xc6\xe3\x56\x05\x73\x76\xe6\x34\x2a\xa0\x10\xc6\x87\x25\x5c\xba\x6a\xd9\x78\x6f\xbf\xf0\x0e\xbb\x90\xe7\xa5\xe7\x92\xc0\x90\xf4\x4c\x12\xa0\x58\x7d\xb4\xbd\xd7\x99\xf9\xc1\xf3\xe5\x85\x1d\xbe\x87\xb5\xae\xf2\x94\x87\xd8\x98\x4c\x32\xab\x53\x5b\x10\x69\x50\x7f\x62\x5e\x6c\xa7\x91\x78\x72\x41\xfb\x72\xad\xaf\x90\x00\x3c\x41\xab\xf0\x9f\xdc\xf4\x45\xde\xc9\x9e\xfb\x0b\xba\x67\x16\x99\xae\xfc\xfa\xf3\x3a\x69\x1b\x9f\x96\xed\xe7\x76\x78\x1a\x5c\x11\x6e\x06\x2f\x2b\xf8\x0a\x47\x35\xb6\xcb\xe4\xd8\x10\x6b\x62\x37\x41\xad\x29\xd0\x60\xba\x89\x93\x0c\x77\xa1\x4c\x81\x17\x1b\x97\x91\xda\xd2\x3c\xf0\xd2\xd2\x1b\xea\xf2\xcc\x0c\xa3\xbb\x89\x45\xea\x0a\x85\x7e\xae\x46\xb5\x36\x3c\x36\x1c\x83\x7a\xf5\x09\xea\xd5\xd1\x4b\x5c\x33\x39\xe5\x2b\x04\xcf\x48\x4c\x38\x98\x08\x80\xa8\xcb\x31\x66\xe9\x6c\x32\x42\x90\xe9\xe3\x12\x43\x10\x56\x31\x0c\x7d\x8e\x3b\x0e\x19\x92\x0b\xec\x04\xd6\x4c\xad\xdc\xc6\x1b\xc6\xc4\x1d\x85\xda\x48\x92\xcc\xd7\x84\xb9\x6e\xa8\x1f\x99\x05\xb5\x61\x8f\x81\x41\x80\x2e\x15\xfe\xb8\xe1\x79\x74\x72\xf0\x67\xa3\x83\xaa\xd0\xf7\xa7\xbd\x41\x75\x87\xb9\xec\x68\xbb\x26\x6d\x80\x59\x46\xe6\x6b\x87\xe4\x4f\x1c\xcc\xa3\x4d\xff\xb9\x67\xa3\x76\xa6\x5d\x34\x28\xa7\x36\xdc\xcc\x67\x18\x8a\x3a\x13\x00\xbc\x34\xd3\x7c\xbc\xfc\xed\x73\xb1\xd1\x79\x5c\x26\xbe\x44\x02\x8e\xf0\xe2\xdf\x23\x33\x0f\xbe\xff\x80\xaf\x9d\xb9\x78\xdb\x3a\x96\x35\x34\x48\xa9\x74\x37\x87\xba\x00\xca\x57\xea\x16\x1d\xc0\x95\xf8\x07\x1e\xa2\x98\xa3\xed\x74\x1c\xbc\x80\x48\x4b\x61\xbe\x4c\xda\xb8\x59\x98\x2f\xa5\x4b\xfe\x08\x0b\xaf\xd7\x6f\x97\x4c\x80\x94\xe8\x50\xb0\x24\xe8\xaf\xf9\xc2\xe9\x90\x9a\xb4\x1f\x09\x97\x43\x1d\x3a\x82\xa0\x5c\x96\x06\x67\xbc\x05\xa4\xaa\x42\xff\x10\x8a\x6d\x39\x01\x87\x4f\xb2\x65\x97\xaf\x39\x12\x90\x2f\x02\xf1\x99\x88\x2d\xc4\xc5\x11\xe9\x7b\x05\xa3\x7b\x96\x3a\x93\x70\x3b\x7b\xea\x15\x3a\x1b\xa1\xdd\xa3\x76\x86\xb7\x04\xf7\xbc\x9d\x9b\xe9\x33\x51\xe9\x55\x4e\x5a\x73\xe3\xac\x0d\x05\x64\x4e\xd1\x79\x60\xe3\xb8\x63\xe1\x43\x1b\xc7\x7d\x0b\x1f\xb3\x71\xfc\x8c\x85\x07\x6c\x1c\xbb\x16\x9e\xb8\xcd\x4f\xaf\xc8\x4e\xe8\x0d\x2d\xcf\xa5\x6e\x27\x7a\x5f\x06\xa5\x6d\xd8\x4c\x2f\xa6\xa8\x0d\x3b\x7e\xbe\x42\x1d\x14\x83\x1d\x99\xf3\xe5\xec\xe7\x4c\x41\x0f\xdc\x88\xc0\xaf\xdc\xd5\x9d\xc0\xfc\xb1\xc0\x9b\x76\x90\x34\xb8\x76\x61\x14\xf6\x7b\x95\x24\x34\x8f\xb0\xd8\x76\x61\x14\xf6\x75\x8a\x13\x16\x17\xbd\x59\x19\x85\x7f\x23\x33\xfc\x04\xb6\x6d\xf1\x9b\x95\x51\xf8\xdd\xef\x3e\x93\x89\x79\x3f\x8d\xcb\x9f\x3d\xbb\x9f\x36\xdd\xee\x02\xfe\x9a\xcd\x25\x5f\x59\xb7\x02\xf3\xf0\x17\x2c\x9d\xfd\xc1\xdc\x76\xbb\x10\xf9\x47\x8c\x8a\xed\xe1\x14\x94\x07\x73\x5f\xc1\x7e\x33\x70\x1b\x53\xb0\xeb\x4c\x3e\x16\x32\xc4\x81\x0a\x51\x3b\xbf\xa5\x82\xcd\xe7\x62\x2f\x8f\xc4\xf6\xd9\xc1\x77\x7b\x22\xc3\x41\xb8\xb5\xb0\xe3\xee\x08\xb8\x70\x15\x3d\xc8\xb6\x12\xe1\xdb\xb3\x85\xc8\xfc\xf7\x04\xc2\x3a\x52\x0a\x38\x45\x7a\x85\xa0\x97\xaf\x26\xb3\xbb\x30\x3f\x61\x69\x77\x61\x60\x61\x20\xaf\x31\x3a\xe4\x5f\xce\x29\xf9\x45\x39\x25\x21\x90\xae\xb3\x2d\x34\x25\x4e\x56\xf0\xfb\x33\xd8\xb7\x9c\x33\x3d\xc5\x4c\x6a\x19\xcd\xde\xe4\x49\x9d\x66\xe0\x89\xe3\xa5\x89\xbe\xfe\x1b\x6c\x03\xfe\x7c\x9d\x0a\x07\xeb\xd4\x50\x18\xf0\xf1\xde\x7a\xbe\x73\xd3\xff\x8c\x03\xde\x8c\xd6\x18\x72\x0d\x21\xa2\x2a\x6d\xae\x88\xc8\x00\x8f\x23\x89\xa1\xc3\x43\x48\x66\x02\x49\xe0\x50\xc6\xff\x01\xb6\xa1\x09\x01\x74\x81\xd0\x0e\x72\x4f\x58\x6a\x3b\xf0\x14\x29\xdc\x42\x69\xdc\xb5\x96\x22\xcd\x23\x05\xd4\x23\x33\x3f\x70\x3f\xb0\x8b\x8b\xc3\xc6\x42\xec\x0c\x4b\x97\x21\x4f\x43\xd8\x16\x7f\x02\x46\x15\x5e\xaf\x2d\x27\x00\x00")
 
 func templateMetaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -874,12 +1087,12 @@ func templateMetaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/meta.tmpl", size: 4291, mode: os.FileMode(420), modTime: time.Unix(1570033406, 0)}
+	info := bindataFileInfo{name: "template/meta.tmpl", size: 10029, mode: os.FileMode(420), modTime: time.Unix(1792025296, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateMigrateMigrateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\xc1\x8e\xdb\x36\x10\x3d\x8b\x5f\x31\x15\xda\xd4\x0e\x1c\x2a\x49\x4f\x75\xb3\x87\x74\x77\x53\x18\x68\xdd\x14\x9b\x20\x3d\x86\x26\x47\x12\x11\x8a\x54\xc8\x91\xed\x85\xe1\x7f\x2f\x48\x4a\x5e\x3b\x49\xd1\x1c\x5a\x74\x2f\xc2\x92\xc3\xf7\xf8\xde\x1b\xce\xee\xe1\x50\x3d\x66\xd7\xae\xbf\xf7\xba\x69\x09\x9e\x3f\x7d\xf6\xe3\x93\xde\x63\x40\x4b\xf0\x4a\x48\xdc\x38\xf7\x01\x56\x56\x72\x78\x69\x0c\xa4\xa2\x00\x71\xdf\x6f\x51\x71\xf6\xa6\xd5\x01\x82\x1b\xbc\x44\x90\x4e\x21\xe8\x00\x46\x4b\xb4\x01\x15\x0c\x56\xa1\x07\x6a\x11\x5e\xf6\x42\xb6\x08\xcf\xf9\xd3\x69\x17\x6a\x37\x58\xc5\xb4\x4d\xfb\xbf\xae\xae\x6f\xd7\x77\xb7\x50\x6b\x83\x30\xae\x79\xe7\x08\x94\xf6\x28\xc9\xf9\x7b\x70\x35\xd0\x19\x19\x79\x44\xce\x1e\x57\xc7\x23\x63\x87\x03\x28\xac\xb5\x45\x28\x3b\xdd\x78\x41\x58\x42\x5e\x7f\x02\x3b\x4d\x2d\xe0\x9e\xd0\x2a\xf8\x16\xca\xd7\x42\x7e\x10\x0d\x96\x67\x95\x4f\x8e\x47\x56\x1c\x0e\x40\xd8\xf5\x46\x10\x42\xd9\xa2\x50\xe8\x4b\xe0\x11\xe5\x70\x80\x78\x36\xe2\xe9\xae\x77\x9e\x60\xc6\x8a\x52\x3a\x4b\xb8\xa7\x92\x15\x65\xdd\x51\xc9\x58\x51\x36\x9a\xda\x61\xc3\xa5\xeb\xaa\x7a\x34\x4e\x5b\x39\x6c\x04\x39\x5f\xa1\xa5\x4a\x69\x61\x50\xa6\x33\x5f\x5b\x5b\x85\x8f\xa6\x0a\xb2\xc5\x4e\x94\x6c\xce\xd8\x56\xf8\x48\x5f\x55\xf0\x4e\x53\xfb\x8b\x71\x1b\x61\xde\x5a\xfd\x71\xc0\xd5\x0d\x04\xa4\x90\x9c\x1b\xac\xde\xa2\x0f\xc2\x80\x56\x01\x5c\x4f\xda\xd9\x00\xe4\xd2\x66\xd6\xad\x9d\xe5\x09\x67\x35\xda\x9a\xab\x62\x7c\x68\xc5\xc6\xa0\x5a\x40\x6c\x81\x53\x35\xec\xb4\x31\x20\x8c\x71\x32\x7a\x24\xe0\xd9\x8b\x17\x3f\x3c\x07\x2f\x6c\x83\x09\xa8\x76\x39\xea\x44\x59\x03\x0a\xd9\x46\x04\x4d\xf7\x30\xa3\x88\x38\xcf\x84\x6b\x47\x08\xd4\x0a\xba\xe0\x95\xc2\x5a\x47\xb0\x41\x10\x7d\x6f\x34\x2a\x70\x16\xd2\xb1\x90\x8b\x85\xf1\x28\xd4\x3d\xe0\x5e\x07\xe2\xac\xf8\x82\xfe\x2b\xc8\x4e\xf1\xcf\xf7\x4e\x96\xdd\x78\xd7\x5f\x3b\x33\x74\xf6\xc1\x2e\xe5\x5d\x0f\x32\x2f\x8e\xd7\xf9\x37\xbc\x4a\xb0\xce\xa8\x11\x3a\x24\x88\xa4\x65\x87\x1e\x61\x88\x2f\x24\x9a\xb6\x71\xd4\x42\xad\xd1\xa8\x00\xc2\x2a\x40\xd5\x60\xe0\x90\x5e\x96\xc2\x5a\x0c\x86\x52\x78\xb5\x30\x01\x47\xe5\x67\x32\x2e\x54\x3f\xac\x5f\x28\x5e\x59\x85\xfb\x4f\x04\xeb\xb4\xf6\x5f\xe8\x4d\xc8\xf8\xa9\xde\xfc\x42\xd5\xf4\xba\xc7\x4b\xff\xbd\xcc\x8b\x56\x19\x52\x8e\x20\x9d\x0d\xe4\x85\xb6\x14\x40\x9c\x61\x0e\x41\xdb\x06\xde\xbf\x5d\xaf\xfe\x78\x7b\x0b\xab\xf5\xcd\xed\x9f\xef\x17\x09\x22\x1a\x4a\x2d\x7a\xac\x9d\xc7\x05\x68\xfa\x3e\x4e\x2f\xe9\xba\x0e\xad\x42\x15\x09\xb3\xa6\x0b\xa5\xe4\xa0\x41\x82\xce\xf9\xb1\xb7\x0d\xee\xf5\x46\x9b\xd8\xcc\x17\xf7\x07\xd9\xc6\x07\x10\xce\x62\xc9\x5e\x7f\x96\x4a\x5a\x8e\x4f\xb8\xaa\xe0\x2e\x9f\xd5\x39\x8d\x97\xaf\x57\xa9\x0f\xa4\x47\x41\xda\x36\x8b\xc9\x58\xdb\xa4\xeb\x47\x5f\xfb\xf4\xcb\x84\xc9\xe8\xbe\xc7\x09\x25\x90\x1f\x24\xc1\x81\x15\xca\x6f\x61\xfa\x19\xe7\x07\xbf\xf1\x71\x14\xb0\xe2\x34\x12\x56\x37\xb0\x71\xce\xb0\x63\xba\xc9\x1a\x77\x23\x4c\x62\xc7\x00\x02\x2c\xee\x4e\xea\x8c\x46\x4b\x9c\xd5\x83\x95\x0f\xb5\xb3\x48\x74\x49\x30\x87\xc7\x23\xce\x01\x3c\xd2\xe0\x2d\x3c\xca\x0b\x07\xe5\xb7\x4b\x50\x7e\x7b\x84\x4c\x79\x9d\x88\x1e\xf8\x8c\x99\xd8\x3c\xe6\xd9\x1e\x46\xc2\x59\x98\x50\xe7\xe3\xa9\x99\xa4\x3d\x8c\xa3\x97\x5f\xe7\xef\x22\x86\x16\x80\x73\x3e\xba\xf3\x5b\x1e\xec\xbf\xa7\x28\xe7\x80\xde\x3b\x1f\xed\x19\x07\xfe\x22\xae\xc0\xf2\x14\xd0\x1a\x77\xe3\x89\x59\xe0\xca\x6f\x33\x1e\xe7\x7c\xce\x0a\x5d\xa7\xe2\x6f\xae\xc0\x6a\x13\x31\x8a\x51\x5c\xdd\x11\xbf\x8d\xc0\xf5\xac\x8c\xe3\x7a\xc4\x5e\xc2\x77\xdb\x32\x11\xcc\x59\x71\x64\x53\xf5\xb8\xcb\x1f\x44\x2c\xe0\x4d\x9a\x6d\x89\x26\xfb\xf2\xce\x6b\xc2\x37\x0e\x76\xf1\x1b\xbe\xd0\x63\xb1\x2b\x77\xa0\x6d\x20\x14\x2a\x8e\x58\x3f\x58\x1b\xfb\x82\x5a\xec\x40\x34\x22\x6e\xe5\xf7\x2d\x48\x6c\x44\x7c\x45\x55\x15\xa1\x27\x1d\xcb\xab\x29\xd1\xbb\xb1\x39\x33\xe7\x6c\xb2\xf4\x67\x21\x3f\x34\x3e\xfe\x61\x9e\xcd\x17\xe0\x02\xbf\x23\xe5\x06\x9a\xff\x74\x69\x43\x55\x15\x85\x71\x0d\x7f\x25\x48\x98\x59\x52\x1b\x59\x8e\x91\xee\xb3\xe4\x4e\x1c\x5f\x8a\x6e\x07\xda\xe5\x5b\xf8\xaf\xce\x31\x76\xdf\xf2\x0a\x1e\x85\x33\x0d\xb9\x0b\x63\x40\x19\x6c\x09\xbb\x05\x2b\x8a\xbc\xbc\x84\x1c\x6c\x8a\xe4\x9f\xbb\xe0\x7f\xea\x81\xd3\xff\x19\x7f\x05\x00\x00\xff\xff\xe0\x03\x68\x5f\x92\x09\x00\x00")
+var _templateMigrateMigrateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x58\x5d\x73\xe3\xb6\x15\x7d\xb6\x7e\xc5\xad\x26\x4d\xa5\x1d\x2d\x95\x6c\x9e\xea\x64\x1f\x5c\xdb\x9b\x68\xda\xb8\xc9\xec\x6e\xd2\x99\x4e\x67\x02\x91\xa0\x84\x31\x09\x30\x00\x28\xad\xe2\xf1\x7f\xef\xb9\x00\x48\x91\xb2\xbd\x9b\x4d\x93\x87\x7a\xc6\x23\x09\x00\xef\xc7\xb9\xe7\x7e\x80\x77\x77\xcb\x67\x93\x4b\xd3\x1c\xac\xda\x6c\x3d\xbd\xf8\xec\xf3\xbf\x3e\x6f\xac\x74\x52\x7b\x7a\x25\x72\xb9\x36\xe6\x96\x56\x3a\xcf\xe8\xa2\xaa\x28\x1c\x72\xc4\xfb\x76\x27\x8b\x6c\xf2\x66\xab\x1c\x39\xd3\xda\x5c\x52\x6e\x0a\x49\xf8\x59\xa9\x5c\x6a\x27\x0b\x6a\x75\x21\x2d\xf9\xad\xa4\x8b\x46\xe4\xf8\x78\x91\x7d\xd6\xed\x52\x69\xb0\x3d\x51\x3a\xec\xff\x63\x75\x79\x7d\xf3\xfa\x9a\x4a\x55\x41\x44\x5c\xb3\xc6\x78\x2a\x94\x95\xb9\x37\xf6\x40\xa6\xc4\xea\x51\x99\xb7\x52\x66\x93\x67\xcb\xfb\xfb\xc9\xe4\xee\x8e\x0a\x59\x2a\x2d\x69\x5a\xab\x8d\x15\x5e\x4e\x29\xae\x3f\xa7\xbd\xf2\x5b\x92\xef\xbc\xd4\x05\x7d\x42\xd3\xef\x44\x7e\x2b\x36\xd8\x3f\x9e\x7c\x8e\xa3\x67\x10\xe1\x65\xdd\x54\x58\xa1\xe9\x56\x0a\x18\x3e\xa5\x8c\xa5\x60\x87\x9f\x65\x79\xaa\x6e\x8c\xf5\x34\x9b\x9c\x4d\x73\xa3\x3d\xa4\x4e\xf1\xb5\xac\xf1\x81\xcf\x0d\x34\xb5\xeb\x2c\x37\xf5\xb2\x4c\xc0\x29\x9d\xb7\x6b\x01\xf3\x97\x80\x73\x59\x28\x51\xc1\x99\xe9\x47\x9c\x5d\xba\x9f\xab\xa5\x03\x74\xb5\x98\x4e\xe6\x93\xc9\x4e\x58\x56\xbf\x5c\xd2\x8f\x90\xf0\x75\x65\xd6\xa2\x7a\xab\xd5\xcf\xad\x5c\x5d\x91\x93\x08\x0d\x23\xd7\x6a\xb5\x93\xd6\x89\x8a\x54\xe1\xc8\x34\x5e\x19\x8d\x1d\x13\x36\xa3\xdf\x58\xc9\x82\x9c\x55\x82\x35\x9e\xe2\xf0\x49\x2d\xd6\x95\x2c\x16\xc4\x14\xe8\x4f\x03\x48\xc4\x5f\x54\x95\xc9\x19\x23\x41\x9f\x7f\xf5\xd5\x17\x2f\xc8\x0a\xbd\x91\x41\x50\x69\x62\xa8\x83\xca\x92\x24\x02\xce\x12\x94\x3f\xd0\xcc\xb3\xc4\x79\x54\x78\x63\xf0\xb8\xdf\x0a\x3f\xd2\x9b\x0b\xad\x11\xee\x35\x24\x37\x4d\xa5\x40\x1e\x2c\x86\xc7\x5c\x3c\x2c\x2a\x8b\xa8\x1c\x10\x4a\xe5\x3c\x24\x3d\xe2\xff\x4b\x8a\x48\x65\x0f\xf7\x7a\xc8\xae\xac\x69\x2e\x4d\xd5\xd6\xfa\x08\x57\x81\x35\x90\x37\x2c\x26\x73\x7e\x0f\xac\x82\x58\x53\x15\x49\xb4\x0b\x22\x82\x2f\x7b\x69\x11\x24\xce\x10\x06\x6d\x6d\xc0\xd0\x52\xc9\x0a\xc0\x09\x30\x4d\x16\x1b\xe9\x32\x0a\x99\x05\x5a\x8b\xb6\xf2\x21\x78\xa5\xa8\x9c\x4c\x9e\x0f\xdc\x18\x79\x7d\x5c\x1f\x79\xbc\x42\x22\xbe\x3b\x71\x58\x85\xb5\x3f\xc2\xdf\x20\x59\x9e\xfa\x1b\x33\xb4\xe8\xb2\x3b\x19\xfd\xb4\x9b\x23\xaa\xb4\x21\x8e\x40\x52\x3b\x6f\x85\xd2\x38\x2a\x06\x32\x5b\xa7\xf4\x86\x7e\x7a\x7b\xb3\xfa\xfe\xed\x35\xad\x6e\xae\xae\xff\xf5\xd3\x22\x88\x60\x40\xa1\xce\x4a\x20\x2d\x17\xa4\xfc\x5f\xb8\x7a\x21\xef\x6a\x24\x35\x9e\x84\xc2\xe8\xd3\xc8\x53\xac\x6e\x24\x1c\xc4\x33\x91\xdb\x15\x68\xb7\x56\x15\x93\x79\x64\x3f\xe5\x5b\x4e\x00\x37\x08\x4b\xc4\xfa\x41\x54\xc2\x72\x1f\x94\x6f\xb9\x54\xf6\xf1\x38\x02\x59\xf3\xfa\xac\xd9\x0a\x87\x74\xa1\xb7\x28\x93\x7c\xf2\xfa\x5d\xc3\x7e\x30\x59\x6c\xab\x35\xfb\x6a\x74\x75\xe0\x67\xa3\x93\x45\xa1\x3c\x52\xbe\xb3\x06\x69\xc4\xee\x02\x9e\xa6\x32\x07\x3e\x2e\x48\xcb\x3d\x71\x51\x60\x2d\xa1\x94\xc6\x7a\xbd\x08\x08\xb1\x92\x4b\x54\x34\x2b\x72\xdf\x27\x73\xa7\x2a\x30\x46\x02\xf7\x36\x1f\x29\x11\xa5\x4f\xd5\x9d\x83\xde\xc9\x06\x88\xda\x50\x65\x70\xc4\x26\x03\xb8\x4f\xb0\xd0\x07\xa1\x66\xb5\xaf\xda\xaa\x5a\xd0\x7e\xab\x50\x2e\xa0\xd1\x71\x89\x39\xaa\xf0\x70\x34\xef\x48\x1f\x40\x1b\x01\xcb\x2b\x3d\xa6\xaf\xe0\xb2\xda\xe8\xbf\xcb\x83\x3b\x42\x5b\xc6\xc5\xe7\xb7\xbc\x9a\xa3\x84\x04\x98\xdf\xcb\xfa\x2b\xe5\x02\x21\x94\x0f\x30\x14\x02\x65\x08\xf1\x48\x75\xa8\x30\xc4\x85\xca\xb5\x4d\xe8\x05\x03\xf9\x43\x76\x06\x41\x33\x99\x6d\x32\xfa\x41\x79\xe9\xdc\x3c\x02\xdd\x73\x0d\x5d\xc6\x99\xd2\x97\xb7\xd3\x10\x86\x8d\xd4\x54\xc2\xb8\xd6\x06\x93\x01\x80\xcc\x6f\x13\xf8\x41\x16\x08\x0c\x12\x03\x0b\xd7\x11\x30\xd4\xc9\x3c\xda\xfd\x10\x5a\x44\xab\x83\x6d\x88\xcb\x08\xbd\xc1\x46\x0f\xe2\x37\xe8\x44\x8e\x09\xe5\x68\x1b\xbe\x3e\xc0\x88\xe2\x91\xbd\x15\x4d\x64\x51\x0f\x6a\x39\x48\x8c\x64\x74\x6c\xd6\x2e\x3a\x8f\x1a\x8f\xf2\x2d\xf3\x16\x59\x9d\xb7\xce\x9b\x9a\x9c\x47\x33\x41\x2a\xc2\xe8\x88\x96\xc7\x84\x01\xe6\x20\x13\x23\xf2\xb4\x46\xc3\xc6\x54\x50\xb9\x79\x10\x99\x98\x8d\xdd\x48\x3f\xe5\x17\x1d\x20\x80\x5e\x3b\x10\x78\x60\xcb\x30\xb2\x47\xef\x46\x20\x84\xa5\x21\x87\xf2\x41\x62\x26\x9e\x70\x44\x18\xee\x90\x4a\x95\x71\xee\xd0\xb5\x8b\x44\xd4\x05\xa6\x9a\x5b\x3c\xb7\xb5\x4a\x73\xd8\x82\xbc\x74\xc4\xa9\x5f\x64\x70\xc7\xca\xda\xec\x58\x82\xd4\x6d\x4d\x3b\x51\xb5\x5c\xe6\x1f\xd6\xd8\x22\xf2\xef\xb4\xc8\xc6\xbc\x14\xaa\x72\xdc\x1a\x5d\x8b\x7c\xe9\xb5\x33\xba\xb1\x6f\xba\xbe\x32\x90\x13\x25\x3c\xd0\xb2\x83\x16\xbc\xe4\x68\xae\xd5\x06\xdf\x52\x33\xbe\x64\x09\xb1\x40\x24\x73\x71\x42\x90\x3f\x34\x92\x31\x14\xa0\x3a\x8a\x27\xd4\xd6\x0a\x52\xa3\x1c\xcc\x20\x50\x6c\xf9\x24\x0b\x42\xc2\xee\x45\x62\x50\x34\xef\x84\x2f\xef\x6d\x64\x11\xf0\x53\x5a\xe6\x92\x87\x9d\x90\x4f\x69\xdc\xe9\x4a\xc5\xc3\x1a\x71\x1a\xea\x41\xd1\xe8\x1f\xea\xe5\x77\x2b\xbd\xcc\x54\x5a\x83\xd4\x1e\xb9\xbe\x9e\xce\x64\xd8\x9e\x3f\xa9\x2c\x29\x49\x52\x46\x6a\xe2\x5a\xaf\xa8\x2b\xaf\x27\xaa\x86\x85\x75\x96\xa7\x33\x1f\xd4\xd7\x0b\x1b\x69\xec\x2b\x38\xb0\x83\xd6\x6f\x84\xdb\x32\x9f\x42\x19\x54\x5c\x8f\x1b\xcb\x1c\x48\x32\x51\x72\x24\x0f\xc0\x45\xdf\x8a\x57\x5d\x73\xb4\x45\xdf\xa7\x59\x50\x57\x01\x69\x7d\x18\x5b\x13\x89\x17\x06\x99\xb4\x95\x83\x82\xda\x9f\x96\xb0\x30\xeb\x8d\x45\xed\x85\x4b\x72\x8e\x0f\x3b\x51\xcb\xd3\x1e\x75\xb4\x92\xcb\x64\x96\x38\x11\x5c\x7b\x49\x53\xcc\xe6\x9f\x64\xe1\xc7\xfd\xfd\x34\x38\xfd\x3a\xb6\xe5\xe4\xf6\xc5\x77\xab\x68\x4b\xa8\x51\x7a\xb3\xe8\x6c\x67\xc6\xc3\x74\x1e\x59\x9a\x48\xff\x04\xc2\x24\x50\x3f\x49\x89\xa1\xa1\xbb\xc9\x59\x61\x77\xd4\xfd\xa5\xd1\x3c\xbb\xb2\x3c\x65\x4f\xce\xfa\x69\x1b\x23\x28\x06\xf9\x6a\x72\x1f\x2c\xb9\x91\xfb\x24\x26\x68\xe7\x6e\x16\x9a\x70\x37\x38\x04\xa4\xb2\x49\xd9\xea\xfc\x78\x76\xc6\x8a\xc6\x0a\xe6\xf4\x2c\xc9\xb9\x43\x70\xd0\x21\x34\x7d\x1a\x17\xee\x70\xf8\x1c\x3e\xec\xee\x29\xaa\xbc\x0c\x8a\x8e\xfa\xc0\xfc\xa4\xad\xaf\xc4\x49\xe1\xcc\x75\x52\xe7\xe9\xa9\x59\xee\xdf\x51\xba\xd5\x64\x97\xf1\x73\xc1\x55\xc9\x51\x96\x65\x1d\xcb\x62\xc4\xfe\x19\x6a\xd5\x9c\xa4\xb5\x00\x17\xf0\xa4\x48\x2e\x78\x85\xce\x7b\x52\xc2\xad\xf4\xc4\xcc\x65\xb0\x73\xc1\x45\x0a\x73\xd7\xec\xdf\xff\x79\x4c\xe0\xdd\xa0\x08\xfc\x10\x69\x30\xe3\xe0\xce\xef\xa3\x21\xb0\x63\xce\xff\x93\x33\x55\x06\x4d\x7f\x7a\x49\x5a\x55\x6c\xc0\x59\x42\x06\x77\xb1\xec\x9a\xad\x2a\x67\x53\xbe\x46\x25\xc3\xce\xe9\xcf\xbb\x69\xb0\x0e\x0f\xe3\xb6\x97\x4e\xa7\xdd\xec\x88\xc0\x82\xde\x84\x3b\x47\x50\x13\x41\xfd\xd1\xa2\x81\xbf\x31\x68\x78\xdc\xc8\x1f\x99\xfd\xb8\xa4\xed\x91\x2e\xce\xe3\x82\xc2\xbc\x1d\x0c\x4e\x35\x89\x8d\xe0\xad\x98\xec\x89\xfd\x19\xe4\xb2\xe8\xce\x0f\x20\x96\xe8\xf0\x3a\x21\x10\x75\xce\xba\x78\xfc\x0d\x4d\x70\x63\xf9\xc2\x3c\xc3\x20\x61\x5c\xf6\xda\x17\xa6\xf5\xf3\x2f\xc7\x30\x2c\x97\x67\x67\x95\xd9\x64\xaf\xa0\xa7\x9a\x05\x6f\x59\xcb\x3d\xab\x7b\x10\xf6\x5e\xc7\x63\x71\x87\x3f\x26\x5a\x61\x7f\x35\x09\x98\xba\xf0\xe4\x53\x37\xf0\x21\x52\x98\x03\x14\x85\x9d\xd3\x1e\x73\xf9\x59\x5c\x3e\xa7\xc8\x8a\x10\x92\x0f\x53\xe8\xff\x95\x40\xc9\x92\x94\xbc\x23\x06\x75\xc5\x2e\x5a\x99\x2e\x4b\x62\x50\x84\x53\x65\xac\x84\xf3\xc3\x26\x00\xa9\x17\x98\xa5\xea\x06\xf7\x11\x14\x29\x26\x9b\x72\x49\x01\x97\xee\x72\x44\xb7\x20\x92\x87\xd6\x61\xc1\x3d\x16\x9f\x21\x29\x3a\xd4\x1e\x21\xc5\x1c\x07\x83\xaa\x45\x0c\xf9\xfc\x23\x12\xff\x7d\x88\x4f\x81\xeb\x6f\x43\x7d\x60\xec\x09\xd6\xee\x3d\x60\x77\xef\x13\x2c\x5f\x80\xea\x06\x88\xf2\x44\x1e\x5e\x13\x0d\x41\x5b\xf0\x6c\x6a\x43\xa7\xe8\xf7\x38\x0c\x93\x30\xd7\xa6\xf0\x60\xba\xca\xe8\x22\x0c\xa2\x62\x30\x81\xa0\x75\x1e\x27\x80\x45\xbc\xe0\xf3\xf3\x71\x9a\x90\xc7\xcb\x52\xba\x9d\x9e\x98\xd6\x40\xbe\xc4\x31\x56\xa5\x7e\x8d\xa5\xad\xf6\xc0\x74\x3c\x05\x25\x1b\xfa\x49\x81\x2f\xef\x71\xf6\x2e\x9e\x8e\xbb\x7b\x22\xf0\x48\xb7\x3f\x22\xf4\xf8\xf9\x3f\xc6\xde\x9d\x04\x5f\x95\x87\x15\x8c\xde\x58\xbe\xa9\xef\xf8\xb7\xea\x2e\x6e\xa3\x8c\x48\x94\xa8\x85\xc7\x97\x11\x4b\x1e\x9d\x3b\x16\x01\x75\x54\x5c\x56\x73\x1c\x21\x94\xe7\xa1\xa9\xe7\x9a\xa0\x67\x09\x04\xd4\xb7\xd2\x07\xa7\xd2\xa5\x51\xba\xdc\xaa\xb5\xec\x5e\xbf\x60\x37\x0d\xd3\xb5\x72\xe1\xdd\x45\x7a\xd5\xc5\xb3\x4a\x7c\x5b\xb4\x60\x55\xc7\x9f\x31\xec\x38\x1d\x4d\x2e\xc2\x88\xce\xb7\x4a\x1c\x01\xbe\xdd\xac\xc7\x59\x0e\x52\x8e\xef\x62\x1f\x66\xfe\x24\xbc\xa9\x19\xe0\x33\x73\x52\xf6\xa4\x98\x3f\x4e\x98\x21\xd6\x8f\xf3\xe6\xe3\xe7\x83\xa7\x0a\xf8\xef\x5f\xb2\xa3\x03\x8f\x94\xec\xfe\x7d\xef\x7f\x01\x01\x21\xb6\xf5\x1a\x17\x00\x00")
 
 func templateMigrateMigrateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -894,12 +1107,12 @@ func templateMigrateMigrateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/migrate/migrate.tmpl", size: 2450, mode: os.FileMode(420), modTime: time.Unix(1567952288, 0)}
+	info := bindataFileInfo{name: "template/migrate/migrate.tmpl", size: 5914, mode: os.FileMode(420), modTime: time.Unix(1792027440, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateMigrateSchemaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x4b\x6f\xe3\x36\x10\x3e\x4b\xbf\x62\x20\xb8\xc5\x6e\x60\x4b\x49\x6e\x35\xe0\x43\x90\xcd\x02\xc1\x16\xe9\xa2\xc9\x9e\x82\xa0\x60\xa8\x91\x45\x58\x22\x15\x8a\x4a\xe3\xaa\xfa\xef\x05\x1f\x92\xe8\x57\xec\xed\x6e\x2e\x16\xc9\x79\x70\xbe\xf9\x66\x86\x69\xdb\xe4\x2c\xbc\x16\xd5\x5a\xb2\x65\xae\xe0\xf2\xfc\xe2\xb7\x59\x25\xb1\x46\xae\xe0\x33\xa1\xf8\x2c\xc4\x0a\x6e\x39\x8d\xe1\xaa\x28\xc0\x08\xd5\xa0\xcf\xe5\x2b\xa6\x71\xf8\x90\xb3\x1a\x6a\xd1\x48\x8a\x40\x45\x8a\xc0\x6a\x28\x18\x45\x5e\x63\x0a\x0d\x4f\x51\x82\xca\x11\xae\x2a\x42\x73\x84\xcb\xf8\xbc\x3f\x85\x4c\x34\x3c\x0d\x19\x37\xe7\xbf\xdf\x5e\xdf\xdc\xdd\xdf\x40\xc6\x0a\x04\xb7\x27\x85\x50\x90\x32\x89\x54\x09\xb9\x06\x91\x81\xf2\x9c\x29\x89\x18\x87\x67\x49\xd7\x85\x61\xdb\x42\x8a\x19\xe3\x08\x51\x4d\x73\x2c\x49\x04\x76\x7b\x06\x7f\x33\x95\x03\xbe\x29\xe4\x29\x4c\x20\xfa\x4a\xe8\x8a\x2c\x31\x82\xa8\x64\x4b\x49\x14\x46\x30\xeb\xba\x30\x68\x5b\x50\x58\x56\x05\x51\x08\x51\x8e\x24\x45\x19\x41\xac\xad\xb4\x2d\x68\x5d\x6d\x8f\x95\x95\x90\x0a\x3e\x18\x71\x49\xf8\x12\x61\xf2\xd7\x14\x26\x1c\xe6\x0b\x98\xc4\x77\x22\xc5\x5a\x0b\x06\x41\xd4\xb6\x30\x89\xaf\x05\xcf\xd8\x32\x76\x3e\xa1\xeb\x12\xbd\xcd\xbd\x8d\x48\x9b\x9a\x0d\x0e\x82\x68\xc9\x54\xde\x3c\xc7\x54\x94\x49\xe6\xc0\x67\x9c\x36\xcf\x44\x09\x99\x20\x57\x89\x8d\x2f\xc9\x18\x16\x69\x74\x8a\x42\xca\x48\x81\x54\x25\xf5\x4b\xe1\x94\xa3\xf0\x63\x18\xbe\x12\x69\x03\x99\xf9\x91\x28\x1b\xc9\x03\x79\x2e\xfa\x50\xb4\x44\x72\x06\x19\xe3\x29\xa8\x75\x85\xc0\x4d\x96\x6d\x8a\x96\x92\x54\xf9\x90\x19\xa5\xd5\xa6\xc0\x32\xc0\x37\x56\xab\x1a\x4c\x76\xac\x89\x89\x51\x9b\x2f\x80\xf1\x14\xdf\x06\xb4\xce\x47\x27\x87\x01\x6d\x5b\x63\xf3\x05\x26\x2a\xbe\x23\x25\x6a\x0c\xcd\x15\xed\x99\x35\xbd\xd0\x6a\x66\x6d\xd1\x1c\xf3\xe6\x2e\x40\x45\xd1\x94\xbc\xd6\xa6\x2b\x52\x53\x52\x0c\xe6\xfe\x85\x4a\x32\xae\x32\x88\x7e\xa9\xaf\xad\x54\x64\x15\x93\x04\xb4\x83\x5e\xb5\xeb\x20\x17\x45\x5a\x9b\xd8\xfb\xcd\x4c\x58\x8a\x9b\x9c\x3b\x8b\x5d\x17\x59\x34\x62\xe3\x7d\xc3\xc2\x02\x1e\x9f\xce\x6c\x26\x62\xeb\xad\x0d\x83\x1d\x08\xa8\x81\x40\x39\x09\x97\x8b\x20\x68\x41\xdb\x9f\x5b\x67\x74\x70\x36\x85\x87\x75\x85\x73\x30\xb4\x88\xed\x99\xde\xd1\x14\xac\x95\x93\x9a\x5a\x0b\xed\x4c\xa3\x39\xa1\xf1\x37\xce\x5e\x1a\x7d\x00\xf6\x6b\x0e\x4a\x36\x38\xf5\x81\xf3\xc5\x6f\x39\x95\x58\xea\xb6\xd0\x75\x30\x2c\x8e\x28\xdd\x35\x45\xe1\x32\x05\xfd\xf7\x1c\xdc\xe5\xc7\xb3\x3d\xfa\xa6\x70\x27\x34\xbe\x67\xff\x18\x6d\xfd\x6b\x34\xe3\xf7\xe5\xaf\x94\x92\x5a\x5e\xff\x5a\x9c\x62\x83\xd0\x61\x8d\x1b\xde\x94\x26\x33\xe6\x63\x0e\x8f\x4f\xb5\x92\x8c\x2f\x5b\x18\xcb\x9c\x4d\x61\x62\xe8\x6b\x8c\xe9\xfb\xe3\xa6\x55\x78\xef\x4e\x9f\x30\x23\x4d\x61\x80\x73\x9f\x16\x03\x4d\x5c\xaf\x1b\xc4\x3b\xd1\xd9\x9c\x79\x2d\x22\x08\x06\x3e\x1b\x7e\x1d\x61\xb3\xa9\x92\x4d\x2e\xab\x3e\x1d\x23\x93\x2d\x19\x81\xf1\x4c\xc8\x92\x28\x26\xf8\x69\xa4\x1e\x4c\x2d\xe0\x57\x47\x68\xe3\xd0\xf0\xd9\xe3\xe9\xa8\x6f\xc2\x71\x94\x9e\x6f\x95\x96\x39\xfb\x2a\x59\x49\xe4\xfa\x0b\xae\xe7\xfb\xcb\x64\xbb\x4e\xaa\x95\x2b\x94\x51\xb3\xcf\x80\x2f\xca\x0e\x97\xd4\x40\x57\xdd\x60\xaa\x95\xeb\x30\x43\x6d\x6d\x5e\xf2\x51\x2f\x19\x74\xdd\xd3\x56\xba\x37\x93\xb4\xbd\xb4\xc1\x7d\x16\x12\xd9\x92\x7f\xc1\x75\xed\x47\x37\x6e\xef\x8d\x30\xeb\x23\xf4\xd4\x47\xaf\x2e\x84\xfb\x75\xf9\x2c\x0a\x87\x77\xb6\x8a\xed\x7a\x80\xdc\x47\x7d\x3f\xac\x01\xc0\x6e\x0f\xba\x30\x9e\xb3\xd5\x2e\x64\xbb\xe0\x5e\x1e\x42\x77\x13\x60\x7a\xd1\x03\x7c\xf9\xbd\x08\xef\x82\xbc\x6f\xa7\x9b\x0e\x59\x4d\xce\xa0\x12\xb5\xaa\x04\x47\x90\x98\x49\xe4\x94\xf1\x25\x28\x01\xe4\x55\x30\x3b\xce\x68\x8e\x74\xa5\x77\x0b\x21\xaa\x61\x62\xe9\xbf\x3f\x31\xfb\x21\xcc\x46\xfd\xe3\xb0\x59\x71\x53\x3c\xff\x0f\xc0\xbe\x07\xf8\x86\xde\x9b\x6d\x3f\x11\xe5\xbe\xcd\x65\xab\xf8\x0f\xfe\xad\x4a\x89\xda\x1c\x3b\xbd\x8d\xfe\x70\xee\xfa\xcd\xd0\xed\xc2\x03\x3e\xb6\x4c\x7f\xc2\x02\x0f\x9a\xb6\x87\xa7\x9a\xf6\x46\xe1\x76\x8d\xf6\xa3\x4b\xc5\xb7\xfa\xa1\x82\x43\x1e\xdc\xd2\xe7\x82\xd9\x6a\x77\x7a\x8d\xa6\x01\x4b\xdf\x5c\x3d\x6c\x99\x19\x4b\xd6\xef\x90\x2c\x7d\xdb\xec\x91\xfa\xaf\x9f\xca\xbd\xc0\x30\xaf\x07\x89\x63\xfc\xdc\xf3\xac\xb0\xf4\xd4\xe6\x0e\xf1\xec\xd4\xa2\xfe\x79\x55\xbd\x87\x70\x7b\xb6\x86\xb0\xfb\x8f\x2d\x91\xfd\xb3\xd2\x5f\x27\x09\xb8\x97\xad\x9d\x7d\xa4\x28\xcc\x90\x53\x76\xd3\xbd\x69\x1d\x90\x61\xe0\x64\xfd\xf7\xda\x30\xde\x8e\xbf\x9b\x03\xaf\x2a\xdf\x9b\xcc\xd3\x70\xf3\xd2\x9d\x7e\x9d\x67\x0d\xa7\xc0\x38\x53\x1f\x3e\x42\x7b\xea\x2b\xfd\xbb\x5f\x04\x5b\xd9\x7e\x67\xd0\xf8\xd3\xde\x3f\x1e\xd3\x3a\xb4\x1d\x58\xc0\xa9\xfd\x68\xfb\x2e\x3d\x04\xde\xb7\xfd\xe7\xce\x2d\xfe\x0b\x00\x00\xff\xff\x7a\x4a\xf3\x99\xab\x0e\x00\x00")
+var _templateMigrateSchemaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\xdd\x6f\xdb\x36\x10\x7f\x96\xfe\x8a\x83\xe0\x0d\x6d\xe0\x48\x6d\xde\x66\xc0\x0f\x45\xda\x02\x41\xb7\x34\x68\xda\xa7\x20\x18\x18\xea\x64\x13\x96\x48\x85\xa2\xbb\x78\x9a\xfe\xf7\x81\x5f\x12\x65\xcb\x89\xbb\xf5\xc9\x22\xef\x8b\xf7\xbb\x0f\x1e\xdd\xb6\xd9\x59\x7c\x29\xea\x9d\x64\xab\xb5\x82\x8b\x37\x6f\x7f\x3b\xaf\x25\x36\xc8\x15\x7c\x24\x14\x1f\x84\xd8\xc0\x15\xa7\x29\xbc\x2b\x4b\x30\x4c\x0d\x68\xba\xfc\x8e\x79\x1a\x7f\x5d\xb3\x06\x1a\xb1\x95\x14\x81\x8a\x1c\x81\x35\x50\x32\x8a\xbc\xc1\x1c\xb6\x3c\x47\x09\x6a\x8d\xf0\xae\x26\x74\x8d\x70\x91\xbe\xf1\x54\x28\xc4\x96\xe7\x31\xe3\x86\xfe\xfb\xd5\xe5\x87\xeb\xdb\x0f\x50\xb0\x12\xc1\xed\x49\x21\x14\xe4\x4c\x22\x55\x42\xee\x40\x14\xa0\x02\x63\x4a\x22\xa6\xf1\x59\xd6\x75\x71\xdc\xb6\x90\x63\xc1\x38\x42\xd2\xd0\x35\x56\x24\x01\xbb\x7d\x0e\x7f\x31\xb5\x06\x7c\x52\xc8\x73\x98\x41\x72\x43\xe8\x86\xac\x30\x81\xa4\x62\x2b\x49\x14\x26\x70\xde\x75\x71\xd4\xb6\xa0\xb0\xaa\x4b\xa2\x10\x92\x35\x92\x1c\x65\x02\xa9\xd6\xd2\xb6\xa0\x65\xb5\x3e\x56\xd5\x42\x2a\x78\x65\xd8\x25\xe1\x2b\x84\xd9\x9f\x73\x98\x71\x58\x2c\x61\x96\x5e\x8b\x1c\x1b\x2d\x12\x45\x49\xdb\xc2\x2c\xbd\x14\xbc\x60\xab\xd4\xd9\x84\xae\xcb\xf4\x36\x0f\x36\x12\xad\xea\xbc\x37\x10\x25\x2b\xa6\xd6\xdb\x87\x94\x8a\x2a\x2b\x1c\xf8\x8c\xd3\xed\x03\x51\x42\x66\xc8\x55\x66\xfd\xcb\x0a\x86\x65\x9e\x9c\x22\x90\x33\x52\x22\x55\x59\xf3\x58\x3a\xe1\x24\x7e\x1d\xc7\xdf\x89\xb4\x8e\x9c\x87\x9e\x28\xeb\xc9\x57\xf2\x50\x7a\x57\x34\x47\x76\x06\x05\xe3\x39\xa8\x5d\x8d\xc0\x4d\x94\x6d\x88\x56\x92\xd4\xeb\x3e\x32\x4a\x8b\xcd\x81\x15\x80\x4f\xac\x51\x0d\x98\xe8\x58\x15\x33\x23\xb6\x58\x02\xe3\x39\x3e\xf5\x68\xbd\x19\x8c\x1c\x07\xb4\x6d\x8d\xce\x47\x98\xa9\xf4\x9a\x54\xa8\x31\x34\x47\xb4\x34\xab\x7a\xa9\xe3\x60\xd6\x16\xcd\x21\x6e\xee\x00\x54\x94\xdb\x8a\x37\x5a\x75\x4d\x1a\x4a\xca\x5e\xdd\x3f\x50\x4b\xc6\x55\x01\xc9\x2f\xcd\xa5\xe5\x32\x09\x14\x45\x59\x06\x6d\x3b\x88\x76\x1d\xac\x45\x99\x37\xc6\x77\xbf\x59\x08\x9b\xe2\x26\xe6\x4e\x63\xd7\x25\x16\x8d\x34\x8e\xa2\x3d\x0d\x4b\xb8\xbb\x3f\xb3\x91\x48\xad\xb5\x36\x8e\x0e\x20\xa0\xfa\x9c\x33\xe5\x38\x5c\x2c\xa2\xa8\x05\xad\x7f\x61\x8d\xd1\xde\xd8\x1c\xbe\xee\x6a\x5c\x80\x49\x8b\xd4\xd2\xf4\x8e\x4e\xc1\x46\x39\xae\xb9\xd5\xd0\x9e\x6b\x34\x67\x34\xfd\xc6\xd9\xe3\x56\x8b\x83\xfd\x5a\x80\x92\x5b\x9c\x87\xc0\x85\xec\x57\x9c\x4a\xac\x74\x5b\xe8\x3a\xe8\x17\x2f\x08\x5d\x6f\xcb\xd2\x45\x0a\xfc\xf7\x02\xda\x76\x8f\x36\x21\x6f\x0a\x77\x46\xd3\x5b\xf6\xb7\xe6\x00\xfd\x6b\x24\xd3\xe7\xf9\xdf\x29\x25\x35\xbf\xfe\xb5\x38\x69\x81\xe4\x19\x89\x1b\x89\x94\x35\x4c\xe8\xf4\x81\x7e\xf1\x9c\x2d\x0b\xc8\x7b\xa2\xf0\x33\x2f\x77\x5a\xcc\x7f\x1f\x85\xc3\x1b\xfb\xc0\xb7\x95\x8e\x26\x98\x8f\x05\xdc\xdd\x37\x4a\x32\xbe\x6a\x61\xe8\x29\x6c\x0e\x33\xd4\xf1\x37\x27\xd7\x60\xe1\xd8\x05\x78\x0e\x80\x2f\xc8\x49\x85\xda\x38\xb8\xcf\xe3\x56\xe4\xc8\x8a\xfc\x01\x2b\xef\xb1\x20\xdb\xd2\xe4\x82\xfb\x34\x80\x99\x5a\x0c\x1a\x5c\x7a\x00\x62\x37\xf7\xd9\xde\x6b\xee\x4b\xd4\x94\xcc\x0b\x05\x6a\x0a\x7f\x5c\x9e\xca\x67\xd8\x50\x9c\xb6\xbe\x80\xf1\x42\xc8\x8a\x28\x1d\xdc\x93\xea\xb4\x57\xb5\x84\x5f\x5d\x8d\x1a\x83\xa6\x44\x83\xd2\x1b\xe4\x8d\x3b\xae\x4a\x17\x30\xae\x75\x43\xbb\x91\xac\x22\x72\xf7\x09\x77\x8b\xe9\xca\xdf\x2f\xfd\x7a\xe3\x6a\x7f\x90\xf4\x11\x08\x59\xd9\xf1\x2e\xd1\x67\x29\x3e\x6a\x75\xae\x69\xf6\xed\x62\x7c\xc8\x3b\xbd\x64\xd0\x75\xf7\x43\x90\x06\x63\xc1\x7a\xbc\xb4\x71\xfc\x28\x24\xb2\x15\xff\x84\xbb\x26\xf4\x6e\xd8\x9e\xf4\xb0\xf0\x1e\x06\xe2\xde\x4a\xd4\x3a\x17\x6e\x77\xd5\x83\x28\x1d\xde\xc5\x26\xb5\xeb\x1e\xf2\x10\xf5\x69\x58\x23\x80\x03\xcb\xf4\xad\xb1\x5c\x6c\x0e\x21\x1b\xf1\x1a\x70\x2f\x8e\xa1\x3b\x06\x98\xbe\xf5\x00\x5f\xfc\x28\xc2\x07\xa8\x4e\xee\x74\xde\x61\x3d\xab\x41\x2d\x1a\x55\x0b\x8e\x20\xb1\x90\xc8\x29\xe3\x2b\x50\x02\xc8\x77\xc1\xec\x0d\x4d\xd7\x48\x37\x7a\xb7\x14\xa2\xee\x2f\x61\xad\xe0\x0b\x16\xff\x0b\xb3\x41\xfe\x65\xd8\x2c\xbb\x29\x9e\xff\x06\xa0\xef\x01\xa1\xa2\xe7\xae\xeb\x9f\x88\xb2\x6f\x73\xc5\x26\xfd\xcc\xbf\xd5\x39\x51\xe3\x9b\xd4\x31\x46\x9e\xb8\x70\xfd\xa6\xef\x76\xf1\x11\x1b\x7b\xaa\xdf\x63\x89\x47\x55\x5b\xe2\xa9\xaa\x1d\x61\xbc\x3d\xf4\x5a\x7d\x63\xa9\xf4\x4a\xcf\x5e\x7e\xb0\x8b\x22\xb7\x0c\x73\xc1\x6c\xb5\xf1\x7e\x5c\x75\x5b\x62\xf9\x93\xab\x87\x3d\x35\x43\xc9\x86\x1d\x92\xe5\x4f\x3e\x98\x7d\xc1\x46\x7e\xd0\xf0\x0c\xfd\x08\xd2\x73\x0c\x08\x69\xba\x9e\x61\x06\x33\x51\xa4\xd7\xe1\xa5\x1e\x47\xd3\x68\xbc\xdc\x1b\x0e\xfd\x73\x69\xae\xcd\x3a\xe1\xd0\xf2\x91\x2c\x9f\x6e\x0e\x3f\xaf\x3b\x4c\x78\x36\xb1\xd5\x67\x45\x90\x60\xda\x8f\x1b\x89\x05\x1b\x45\x2a\x8a\xfc\xde\x02\x2a\x52\xdf\xd9\xb1\xe0\x9e\x71\xd5\x4e\xba\x4a\xfd\x34\x9e\x86\x3a\xdc\x03\x87\xea\x66\xec\xae\xfd\x30\x86\x93\xa7\x1e\x9f\x71\x44\xf4\xa4\x3d\xc2\xf4\xa4\x10\xae\xb3\x0c\xdc\x53\xc5\xde\xfc\xa4\x2c\xcd\x28\x6e\x6e\xf1\xc6\x3f\x52\x5c\xf8\xe3\xc8\xf1\x86\x03\x78\x7f\xb9\xbf\xfc\x10\x32\x4e\xcd\x9a\x0d\xab\x35\xad\x20\x65\xe3\x53\xf3\x94\xd7\x0b\xe1\x39\xbc\x9a\x78\xc2\xbc\xd6\x5f\xb7\x1b\x56\xff\x61\xde\xa3\x7a\x50\x31\x22\xd6\xd0\xd2\x8c\x93\xc7\x9e\x35\x3e\xcd\xb8\x50\x8e\xdf\x21\x17\x74\x4f\x75\xd8\x33\xfb\x09\xea\x10\xdf\x70\xd1\xe9\x67\x62\xb1\xe5\x14\x18\x67\xea\xd5\x6b\x68\x4f\x7d\x2e\xfe\xf0\x1c\x17\xa8\x65\xcf\x8f\x07\xe1\x8c\x16\x92\x87\x22\xea\x2f\x0b\x58\xc2\xa9\xb7\xc8\xfe\x59\x3c\x04\xc1\xb7\xf9\x3b\x01\x90\xe7\xd0\x75\xf1\xbf\x03\x00\x1a\xd9\xc6\x2f\x34\x11\x00\x00")
 
 func templateMigrateSchemaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -914,12 +1127,12 @@ func templateMigrateSchemaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/migrate/schema.tmpl", size: 3755, mode: os.FileMode(420), modTime: time.Unix(1570019529, 0)}
+	info := bindataFileInfo{name: "template/migrate/schema.tmpl", size: 4404, mode: os.FileMode(420), modTime: time.Unix(1792005316, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatePredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x51\x6b\xe4\x36\x10\xc7\x9f\xad\x4f\xf1\xc7\xb8\x60\x1f\x1b\x3b\x77\x6f\x0d\xf4\xe1\x7a\xbd\xc2\x41\x09\x81\xe4\xbd\x68\xa5\xb1\x2d\xe2\x95\x5c\x69\xbc\x49\x10\xfe\xee\x45\xb6\xb3\xd9\x24\x0d\xe5\xde\x16\xcf\xcc\x5f\xfa\xfd\x66\x15\x63\xf3\x49\x7c\x73\xe3\x93\x37\x5d\xcf\xf8\x72\xf9\xf9\xd7\x8b\xd1\x53\x20\xcb\xf8\x53\x2a\xda\x3b\x77\x8f\x1f\x56\xd5\xf8\x3a\x0c\x58\x9a\x02\x52\xdd\x1f\x49\xd7\xe2\xae\x37\x01\xc1\x4d\x5e\x11\x94\xd3\x04\x13\x30\x18\x45\x36\x90\xc6\x64\x35\x79\x70\x4f\xf8\x3a\x4a\xd5\x13\xbe\xd4\x97\xcf\x55\xb4\x6e\xb2\x5a\x18\xbb\xd4\xff\xfa\xf1\xed\xfb\xf5\xed\x77\xb4\x66\x20\x6c\xdf\xbc\x73\x0c\x6d\x3c\x29\x76\xfe\x09\xae\x05\x9f\x1d\xc6\x9e\xa8\x16\x9f\x9a\x79\x16\x22\x46\x68\x6a\x8d\x25\xe4\xa3\x27\x6d\x94\x64\xca\xb1\x56\x2e\xf0\x60\xb8\x07\x3d\x32\x59\x8d\x02\xf9\x8d\x54\xf7\xb2\xa3\xfc\x55\xef\xc5\x3c\x8b\x2c\x46\x30\x1d\xc6\x41\x32\x21\xef\x49\x6a\xf2\x39\xea\x94\x13\x23\xd2\x74\x4a\x34\x87\xd1\x79\x46\x29\xb2\xbc\x3d\x70\x2e\x44\x96\x77\x86\xfb\x69\x5f\x2b\x77\x68\xda\xcd\x98\xb1\x6a\xda\x4b\x76\xbe\x21\xcb\x8d\x36\x72\x20\xc5\x4d\xe7\xe9\x30\x18\xdb\x74\x5e\x8e\x7d\xa3\xc3\x90\xff\xcc\x74\xf8\x67\xc8\x45\xb5\xd0\x7a\x69\x3b\x42\xf1\xf7\x0e\x85\xc5\xd5\x6f\x28\xea\x6b\xa7\x29\x2c\x18\x4d\x83\x18\x51\xd8\xfa\x5a\x1e\x08\xf3\x9c\x36\x92\x74\x9e\x68\xd1\x4e\x56\xb1\x71\x16\xad\xf3\x5b\xef\x26\x25\xb5\xef\x27\x33\x68\xf2\xa1\x16\xfc\x34\xd2\x9b\xac\x34\x5a\xc6\x08\xd3\xa2\x63\x94\x03\x59\x14\xf5\x2d\x3b\x2f\x3b\xaa\xf0\x19\xf3\x6c\x2c\x93\x4f\x20\x71\x4e\xd6\x86\x90\xe6\x62\x44\x69\xac\xa6\xc7\x97\x6e\x5c\x56\xf5\xef\xeb\x51\x6b\xc3\x2a\x78\xe5\xfb\x38\xfe\x1d\xde\x0d\xf9\x3f\x56\x41\x50\xce\x06\xf6\x93\x62\xc8\x73\x5a\xe7\xb1\x08\x07\x7b\x79\x24\x1f\xe4\x10\xb0\x97\xe9\xff\xe9\x2c\x36\xb9\x48\xac\xb5\x48\x78\x1f\xc5\x97\x2f\xde\xcd\x0e\x45\xd8\x30\x16\xfb\xcf\x4c\x0b\x88\x69\x51\x18\xcc\xf3\xee\x04\xd5\xa6\x48\x73\xee\xef\x79\xfc\x4c\x41\xf5\xe2\xe0\x8d\xf4\x28\x32\x4f\x3c\x79\xfb\xfa\x7b\xb9\xdc\xb6\x3c\xe2\x4c\x79\x95\x9a\xb3\xf0\x60\x58\xf5\x38\xa6\xcb\x1d\xeb\x32\xb1\xad\x85\xf4\x1c\xfe\x9f\x41\x64\x99\x92\x61\x5d\xfd\xfb\x7b\x5e\x89\x2c\xcb\x4e\x44\xe5\xb1\xda\x72\xb7\x07\x92\x65\x9a\x5a\x39\x0d\xbc\xf4\x8d\xd2\x1a\x55\xb6\x07\xae\x6f\x47\x6f\x2c\xb7\x65\x3e\xd9\x7b\xeb\x1e\xec\x62\x7c\x59\xce\x69\x55\x57\xf8\xe5\x2e\xdf\xe1\x58\xa5\xc8\x59\x64\x73\x25\xce\x9f\xde\x7f\xfc\xfa\x37\x00\x00\xff\xff\x59\x6c\xb9\xae\xbd\x04\x00\x00")
+var _templatePredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x54\x5d\x8f\xd3\x30\x10\x7c\x6e\x7e\xc5\x2a\x2a\x22\x39\xf5\x92\x83\x37\x4e\xe2\x01\xee\x40\xaa\x84\x4e\xa0\xf2\x8e\x5c\xc7\x69\xad\x73\xed\x9c\xed\x14\xaa\xaa\xff\x9d\xb1\x9d\xa6\x39\x3e\x84\x78\x72\xe2\x5d\x8f\x77\x66\x67\x7d\x3c\xd6\x57\xd9\x9d\xe9\x0e\x56\x6e\xb6\x9e\x5e\xdf\xbc\x7a\x73\xdd\x59\xe1\x84\xf6\xf4\x91\x71\xb1\x36\xe6\x91\x96\x9a\x57\xf4\x4e\x29\x8a\x49\x8e\x42\xdc\xee\x45\x53\x65\x5f\xb7\xd2\x91\x33\xbd\xe5\x82\xb8\x69\x04\xe1\x57\x49\x2e\xb4\x13\x0d\xf5\xba\x11\x96\xfc\x56\xd0\xbb\x8e\x71\x2c\xaf\xab\x9b\x73\x94\x5a\x83\x70\x26\x75\x8c\x7f\x5a\xde\x7d\x78\x58\x7d\xa0\x56\x2a\x40\xa4\x3d\x6b\x8c\xa7\x46\x5a\xc1\xbd\xb1\x07\x32\x2d\x76\x2f\x97\x79\x2b\x44\x95\x5d\xd5\xa7\x53\x96\x1d\x8f\xd4\x88\x56\x6a\x41\x39\x4a\x6f\x24\x67\x5e\xe4\x94\x22\xd7\xf4\x5d\xfa\x2d\x89\x1f\x5e\xe8\x86\xe6\x94\x7f\x66\xfc\x91\x6d\x10\x9f\xe6\x5e\x23\x79\x06\x18\x2f\x76\x9d\xc2\x0e\xe5\x5b\xc1\x50\x7c\x4e\x55\xc0\x41\x24\x9c\x0e\x88\x72\xd7\x19\xeb\xa9\xc8\x66\x39\x37\xda\x03\x37\xc7\x67\xbb\xc3\x82\x75\x83\xbb\xfa\x75\xc5\xcd\xae\x6e\x07\xf1\xa4\xe6\xfd\x9a\x81\x42\x0d\x49\xeb\x46\x32\x05\x42\xf5\xc6\x8a\x9d\x92\x1a\x2b\xeb\xb6\x75\xe3\x54\xfe\x3f\xa7\xdd\x13\xf2\xcb\x48\xdc\x32\xbd\x11\x34\xff\xb6\xa0\xb9\x43\x1a\x98\xd1\xed\x5b\x9a\x57\xab\xe1\xe7\x74\x42\x92\x6c\x49\x3c\x8d\x09\xd5\x03\xdb\x81\x61\x00\x09\x94\xea\x9a\x56\xfd\xfa\xa9\x17\x10\x19\xfa\x82\xa0\x12\x3b\xdc\x86\x06\xae\x0f\xb1\x13\xab\x2f\x9f\x28\xc5\xd7\xbd\x54\x90\xc5\xa5\x6e\x08\x8a\xe5\x2f\x88\x41\x1c\xe9\x5f\x3a\xea\xdd\xe5\xd4\xf2\x7e\xa9\xbf\x84\x53\xe1\x86\x51\x6b\xb7\x40\xe7\x6d\x68\xb4\x17\x56\xea\xcd\x39\x5b\x36\x0e\x2b\xf3\xc4\x2c\x5a\x2f\x7c\x6f\x75\x82\x62\xda\x20\x6e\x87\x02\x06\x6f\xb8\xc0\xc0\x79\xe0\x85\x4a\xab\xcc\x1f\x3a\x31\x61\x81\xe2\x6d\x50\x90\x8e\xd9\x0c\x97\x2f\xef\xdd\x18\x4b\xd0\x2e\xa1\x08\x15\xcd\x75\x66\x13\x6a\x18\x3e\x63\x32\x88\xb5\x40\x22\xd6\x75\xea\x80\x5a\x23\x58\x4c\x0c\x17\x70\xd1\xe1\xec\x78\x82\x2b\x19\x66\xc6\x68\x28\x91\x3c\x17\x05\x92\x7b\xa1\x69\x70\x4a\x95\xcd\x26\xa5\x14\xe7\xdd\xbb\xb4\x96\x54\x5c\xa1\x27\xd5\x6a\xa8\x6a\x41\xc2\x5a\x63\xcb\x6c\xe2\xbf\x89\x11\x9f\xf7\x5e\xa7\xae\x3f\x60\x04\x5d\x74\x33\x2a\x45\xc6\x5c\xa7\x66\x9f\x4e\xa1\xb5\xa1\xa0\xb1\x11\xd4\xf6\x9a\x7b\x89\x7a\x43\x3f\x52\xee\x30\x1b\x21\xfd\xdc\xe9\x41\xdb\xe7\x58\xe1\x68\x91\x7c\xb5\xc1\x2c\x28\x50\x1c\x1d\x57\xd2\x2b\xa4\x8c\x2d\x38\xc6\x9a\x95\x1b\x9c\x58\x48\xbc\x09\x3f\x26\xfe\xbc\x29\xab\xf7\xe9\xaa\x29\xbd\xe4\xed\xbf\xc3\xff\x46\xef\xb3\xb0\xf7\x69\x38\x82\xd8\xce\xdb\x1e\x5f\x6c\xca\x16\x24\xa3\x5b\xf1\x72\xb0\x3d\x88\x31\xe5\x68\xcd\x82\x5f\x21\xc1\x30\x58\x14\xb8\x56\x59\xa0\xf7\x37\xf8\xe2\xa2\xbb\xfc\xd7\xcc\xcd\x25\x3e\x17\x23\xa9\x36\x40\xca\xa9\x7e\xe3\x44\x5e\x24\x28\x2f\x1a\xfc\x22\x3a\xbc\x9c\xcc\xfb\x7c\xbf\x88\xd5\x16\x7b\x9a\x48\x5e\x86\xe4\x99\x83\x0b\xf9\x96\xf6\xa1\xb8\x7d\x55\x04\x6e\x29\x10\x5e\xc5\x7f\x73\x40\x22\x87\x3e\xf4\xe7\x3a\x6f\x11\x9e\x8d\x8c\x8a\x7d\x39\xe0\x0e\xf6\x9c\xcd\xf0\x22\xb3\x5e\xf9\x98\xd7\x31\x2d\x79\x81\x47\xb2\x5a\x75\x18\x7a\xdf\x16\x79\xaf\x1f\xb5\xf9\xae\xa3\xe2\xb1\x39\x63\xab\x6e\xe9\xc5\xd7\x7c\x41\xfb\x32\x40\x02\xe9\xf4\x6c\x02\xfe\xf4\xf5\x13\x7c\x72\xd7\xf5\xc4\x06\x00\x00")
 
 func templatePredicateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -934,12 +1147,52 @@ func templatePredicateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/predicate.tmpl", size: 1213, mode: os.FileMode(420), modTime: time.Unix(1567330539, 0)}
+	info := bindataFileInfo{name: "template/predicate.tmpl", size: 1732, mode: os.FileMode(420), modTime: time.Unix(1792022315, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateRestTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3a\x5d\x73\xdc\x36\x92\xcf\xe4\xaf\xe8\x63\xc9\x0e\xc7\x47\x73\xec\xbc\xdd\xe4\x26\x57\x8a\x2d\x27\xba\x75\x64\xad\xad\x64\x1f\x5c\xaa\x2c\x44\x36\x35\x58\x71\x40\x1a\xc0\xcc\x48\x35\x3b\xff\xfd\xaa\x1b\xe0\xd7\x0c\xf5\x91\xc4\x75\x75\x55\xb7\x0f\xb6\x38\x00\xba\x1b\xfd\xdd\x0d\x60\xbb\x9d\xbe\x08\xdf\x54\xf5\x9d\x96\xd7\x0b\x0b\xdf\xbe\x7a\xfd\x1f\x2f\x6b\x8d\x06\x95\x85\x77\x22\xc3\xab\xaa\xba\x81\x53\x95\xa5\x70\x5c\x96\xc0\x8b\x0c\xd0\xbc\x5e\x63\x9e\x86\x17\x0b\x69\xc0\x54\x2b\x9d\x21\x64\x55\x8e\x20\x0d\x94\x32\x43\x65\x30\x87\x95\xca\x51\x83\x5d\x20\x1c\xd7\x22\x5b\x20\x7c\x9b\xbe\x6a\x66\xa1\xa8\x56\x2a\x0f\xa5\xe2\xf9\xf7\xa7\x6f\x4e\xce\x3e\x9d\x40\x21\x4b\x04\x3f\xa6\xab\xca\x42\x2e\x35\x66\xb6\xd2\x77\x50\x15\x60\x7b\xc4\xac\x46\x4c\xc3\x17\xd3\xdd\x2e\x0c\xb7\x5b\xc8\xb1\x90\x0a\x21\xd2\x68\x6c\x04\x7e\xf0\xa8\xbe\xb9\x86\xd9\x1c\xae\x84\x41\x38\x4a\xdf\x54\xaa\x90\xd7\xe9\xb9\xc8\x6e\xc4\x35\xd2\xa2\xed\xf6\x25\x6c\xa4\x5d\x00\xde\x5a\x54\x39\x1c\x41\xe4\x67\xa3\x06\xd5\xcb\xdd\x2e\x0c\xb6\x5b\xb0\xb8\xac\x4b\x61\x11\xa2\x05\x8a\x1c\x75\x04\xa9\xc3\x00\x04\x48\x04\xe5\xb2\xae\xb4\x85\x38\x0c\x22\x54\x59\x95\x4b\x75\x3d\xfd\x87\xa9\x54\x14\x06\x51\xb1\xb4\xf4\x47\xa1\x9d\x2e\xac\xad\x9b\xef\x95\x2e\xe9\x53\x63\x51\x62\xc6\x2b\x8c\xd5\x59\xa5\xd6\xfe\x53\xaa\x6b\x13\x11\xf9\x97\xa0\x85\xba\x46\x38\xfa\x2d\x81\x23\x45\x3c\x1d\xa5\x67\x55\x8e\x86\xf6\x10\xec\x2f\x28\x78\x81\x4a\xdf\x49\x2c\x73\xbf\x24\x68\x79\x3d\x2a\xd2\x8b\xbb\x1a\xd3\xf3\x9b\xeb\x73\x61\x17\x7e\x3a\x88\xb6\x5b\x66\x29\x6a\x16\x7b\xbe\x86\x3f\x7a\xdf\x21\x83\x8c\x88\xf5\x29\x3b\x66\x50\x75\x08\x3b\x75\xe3\x07\xc8\x3c\xcd\x49\x18\x4e\xa7\x70\x86\x9b\x9f\x84\xca\x4b\xd4\xa0\xd1\xae\xb4\x32\x20\x14\x90\x60\xd3\x66\xdc\x2e\x84\x05\x36\x52\x03\x02\x3e\x9e\x7c\xba\x80\xe3\xf3\x53\x28\x2a\x9a\x42\x40\x65\xa5\x95\x68\x9c\x55\x21\x5c\x6b\x51\x2f\x52\xc2\x7d\xb1\x40\xb8\xaa\x72\x6f\x6f\x08\x99\x46\xd2\xba\x50\x39\xd4\xc2\x66\x0b\xd0\xf8\x65\x85\xc6\x1a\xb2\x74\x01\xff\xfd\xe9\xc3\x19\x54\x57\xff\xc0\xcc\x26\x8e\xa8\xb4\x06\x6e\xf0\xce\x80\xd0\xc8\x18\x94\x58\xa2\x21\xd4\x1e\x63\xc1\x5a\x49\xe1\x18\xd4\xaa\x2c\x61\x2d\xca\x15\x42\x56\xa2\xd0\xcc\x46\x55\x5b\x59\x29\x51\xba\x75\xe4\x0c\x43\xba\x69\xf8\x98\x70\x69\xfe\xa8\x26\xd5\xce\xe6\x60\x94\xb8\x41\x88\xeb\x72\xa5\x45\x49\xa2\x3d\x13\x4b\x9c\x90\x12\xa6\xd3\x70\x3a\x0d\x7e\x3c\xb9\x00\x00\x60\xb9\x33\xcc\x6e\x47\xbf\x01\xa0\x94\xc4\x25\x71\x40\x73\x0e\x10\x76\xbb\x56\x76\x24\xae\xe0\xfc\xc3\xa7\x8b\x71\x70\x27\x38\x92\xd1\x00\x3c\xbd\x87\xe8\x74\x2b\xf3\x1d\x74\xfa\x1c\x81\x3a\x3f\xbe\x78\xf3\xd3\x28\xd4\xaa\xce\xef\xa5\xf5\xf6\xe4\xfd\xc9\xc5\xc9\x18\x54\x8e\x25\x8e\x42\xf5\x0c\x8e\x85\xc4\x46\x41\xe2\xe8\x94\x2f\xb2\x0c\x6b\xcb\xfa\x2c\xe5\x52\xda\x04\xaa\xa2\x30\x68\xd9\x50\x2a\x4d\x71\x2f\xc6\xf4\x3a\x85\x88\xd4\x9f\xbc\xa4\x90\x32\x81\x2f\x2b\xd4\x77\x50\x0b\x2d\x96\x68\x51\x9b\x84\x97\xdb\x05\x12\x11\x8a\x37\x8d\x8d\x74\x4b\xd8\x8c\x56\x14\x4d\x85\xa1\xf0\xc8\x63\xb1\x41\x67\x5b\xef\x78\xe0\x67\x51\x43\xb1\x52\x19\x19\x0e\x61\x40\x91\x2d\x9c\x9a\xee\x26\x29\xfc\x2a\xb4\x14\xb9\xcc\x88\x46\x8b\xc0\xed\x4d\x5c\x63\x2a\x55\x34\x01\xbc\xad\x31\xb3\x20\x20\xab\x96\x4b\xf1\xd2\x20\x6d\xc0\x62\xce\x46\x40\x28\xd9\x4a\x4d\xea\x25\xb1\x94\x96\x22\xae\x58\x95\x64\x21\x15\xbc\x75\xdf\xef\x59\x12\x44\x87\xd8\x92\x16\x32\xa1\xbe\xb1\x80\xb7\x19\x62\x0e\x3f\x8b\x5b\x5e\x90\xc2\x27\x54\x46\x5a\xb9\x6e\x7c\xc1\xaf\xbb\xf2\x8c\x92\x93\xba\x8d\x4a\x75\x0d\x95\x76\xf2\x94\xea\x3a\x85\x77\x95\x06\xbc\x15\xcb\xba\xc4\x59\x17\xbd\x3b\xe3\x67\x45\x92\x3f\x48\x95\xe3\x2d\xa4\xf0\x6a\x68\xe9\x64\x05\xf7\x7a\xc4\x7f\xc9\x3c\xbd\xb6\xf3\xd7\xaf\x9e\xb3\x4a\xe9\x83\x29\xcf\x5f\xca\x7c\xcf\x26\x48\xda\xbd\x30\x14\x67\xa5\xa4\x84\xf9\xa2\x49\x3a\xbb\x5d\xfa\x86\x87\x26\xc3\xb0\xb4\x0d\x03\x67\xe1\xf0\x7c\xe1\x22\xd5\xd6\x81\xce\xc0\xfd\xdd\x85\xbb\x30\xcc\x2a\x65\x38\x91\x4c\xa7\x03\xd1\x52\xc8\x69\x2d\x8e\xb4\x32\xb4\x49\x0e\x3f\x79\x05\xaa\xa2\xd0\x67\xa1\x52\x98\x86\xc1\x00\xc1\x1c\x5e\xbf\x7a\x15\x06\xd3\x69\xab\x8d\x06\xe7\x52\xdc\xca\xe5\x6a\x79\x0f\xee\x34\x0c\x5a\x00\xc6\xf1\xca\x87\x62\xcf\x05\x48\x52\xc9\x12\x95\x8f\x18\x03\xa6\xbd\x4d\x37\x41\x38\x0d\xed\x5d\x8d\x2d\xa4\xb1\x7a\x95\x59\xd8\x86\xc1\xbd\x42\x24\x99\x4c\xa7\xf0\x89\xc2\xf9\x4f\x17\x17\xe7\x0f\x52\x93\xca\xa2\x2e\x44\x86\xa9\xd3\x52\xbc\x80\x17\x9e\xd6\xa4\x43\x11\x6f\x9c\x62\x3e\xa2\xa9\x2b\x65\xf0\x6f\x5a\x5a\xd4\x09\x68\x78\xe1\xc7\x39\xda\x4e\x68\x5f\xb5\xd0\xd6\x90\x51\xf9\x8c\x9c\x7e\xaa\x4b\x69\xe3\xe6\xd7\x85\x96\xcb\x58\xa7\xbf\x7c\x7c\x9f\x9e\x0b\xbb\x48\x20\x9a\x46\x13\xf7\x7f\x18\xc8\x02\x4a\x54\x31\xa3\x98\xc0\xf7\xf0\x2d\x21\x0c\x36\x44\xed\x44\xeb\x4a\xc7\x9b\xc4\x6d\xe4\x93\x15\x76\x65\xce\x2a\xfb\x8e\xea\xa2\x04\x8a\xa5\x4d\x79\x45\x11\x47\x2b\x75\xa3\xaa\x0d\x27\x82\x05\x3c\xfb\x12\x25\xd0\x91\x9b\x4c\xc2\xc0\xdb\x54\x18\xec\xc2\x60\x2d\x34\xc8\x1c\x5e\xb8\xdd\xed\x6f\x60\x3e\xf7\x3b\x90\x39\xcc\xe1\x39\x6f\xeb\xf3\xeb\x4b\x86\x34\x1b\x49\x79\xc6\x8d\xbd\xba\x84\xed\xe3\x69\x3c\xa3\xc2\x2a\x7a\xc8\xa7\xa2\x59\x18\x04\x8b\x74\x3f\xab\x7b\x65\x11\xf7\x3a\x01\x99\x4f\x06\x59\x3e\xf0\xb1\x65\xf6\xf5\x45\xc5\xfe\xb5\xdd\x3e\xc4\x15\xef\x95\x12\xc8\x6c\x3e\xdc\x34\xcf\x78\x23\x9d\xcd\xa1\xd6\x52\x59\x88\x16\xa9\x1b\x4a\xa3\x86\x6f\x42\x32\x9d\x42\x8b\xa7\x65\xb7\x29\x48\xc8\x66\x5b\xaf\xf5\xde\x71\x5f\x8e\x3d\xb4\xe1\x43\xbc\x4f\x34\xe6\x04\xb4\xd8\x9c\xbe\x6d\x6c\x83\x6d\x3b\xb3\xb7\xa4\x54\x4d\x95\x98\xc5\x5b\x1b\x4f\xd8\x64\xdc\xc2\xf9\x1c\x94\x2c\x69\x59\x63\x1c\x3a\xfd\x19\xed\xa2\xca\x79\x8c\xb5\xcf\x1a\x71\x83\x3f\x22\x6b\x2c\x70\x59\x6e\x36\x87\x9e\xbc\x76\xbb\xf4\xaf\x34\x4c\xf8\x03\xa2\x80\x5a\x37\x4b\x3c\x33\xef\xa5\xb1\x31\xc3\x36\x4a\xf3\x10\x93\xef\x78\xf5\xbf\x75\xbb\x79\xc0\x2c\x7e\x10\x79\xcb\x2e\x6a\xcd\xe4\x5a\xff\x08\xc8\xce\x83\x60\x6d\x92\x86\x3e\xd3\x4b\x8f\xcb\x32\xce\xec\x6d\x7f\x6f\x0f\x50\x33\x4c\x28\x26\xec\xf7\xd3\xe0\x0d\x52\x75\xb8\xb7\xbf\x0f\x7f\x49\x60\x6d\x26\xbe\x68\x97\x05\x47\xeb\x23\x95\x7e\x44\x91\x7f\x50\xe5\x1d\x19\xcf\xa1\x68\xcf\x2b\xe3\x64\xeb\x12\x66\xbb\x7d\x8d\x22\x77\x55\x7e\xac\x9f\xb8\xfb\xdf\x29\x2b\x5f\xff\x1e\x68\xf3\x0d\x8f\x3f\xa0\x4e\xbf\xc0\xc1\x27\x3e\xd3\x7f\x7d\x55\xb6\xa2\x70\x84\xd2\x4f\x62\x8d\xff\x5b\xba\x74\x2c\xe6\x09\xac\x1b\x7d\x36\x01\xac\x1f\xc1\xee\x67\xd0\xe9\xf6\xac\xb2\xc7\x65\x59\x6d\x70\x2f\x94\x2d\x79\x16\x9e\x71\xa7\x41\x56\x22\xdc\x2a\x8e\x69\x0e\x94\x83\xff\x6e\x34\x01\xb0\x26\xd2\xd3\xb7\xdc\xeb\xb1\x51\x79\x61\xcc\xe6\x90\x63\x56\xe5\x18\xbf\x60\x2f\x4f\xe0\xb9\xcc\x0f\xf5\xf2\x24\xad\xf4\xb7\x2b\xd5\x5a\x94\x32\x27\xda\x1c\x77\x1d\xf6\xfd\xf4\x34\x12\x47\xee\x09\x23\x9d\x66\x87\x86\xf7\x23\x5a\xd2\xaf\xcf\x1a\x63\x2a\x7e\x82\x86\x9b\x1d\xd1\x96\x1e\x76\xd5\x49\xf8\x90\xa3\x1e\xf8\x29\xb5\x6a\xb3\xf0\x51\x3f\x7d\x74\xd7\xf7\x08\x7c\x74\xff\xae\xfb\x39\x94\xd4\x2f\x3c\xfe\x41\xe1\xe9\xdb\x78\x20\xac\xa1\xa3\xba\x65\xb1\xc3\xf2\x80\xa3\xfe\xf1\x0d\x76\xaa\x74\x44\x06\x4e\x2a\x8b\x47\x28\xfd\x79\x05\xee\x6b\xe9\x2d\x37\x7e\xb3\x03\x81\x74\xa2\x73\x2b\x5a\xd1\xa5\x27\xb7\x98\x91\xd5\x4d\xbe\xfb\xf3\x9b\x4d\x39\x3d\xff\xc4\xa7\x48\x71\x6f\xb7\x67\x15\xe7\x60\x65\xff\x40\x31\xf4\x55\x22\xc9\xce\xd7\xd9\x3d\xdb\xa0\x9c\x0c\xa2\xae\x4b\xe9\xcb\x96\xfd\x0e\x96\x3a\x05\x31\xe8\x15\xa0\x52\x23\x05\x0d\xc3\xf9\x6a\x66\x0f\xbf\xcb\xf9\x83\xb2\x7f\x00\xcb\x55\x40\xe2\xba\x66\x03\x2b\x5d\xa6\xbf\x72\x33\x3a\x21\x55\x54\xdc\x53\x35\x6d\xed\x6c\x0e\x4b\x71\x83\xf1\x52\xd4\x9f\x5d\x91\x73\xd9\xb6\x03\xdb\xdd\x24\x0c\x5c\x77\x33\x9b\x0f\xda\xaa\x30\xa0\x8e\xf3\x06\xb9\x66\x71\x85\xa1\x27\x46\x0a\xd6\x62\x43\xe3\x6e\x84\xa3\xcf\x0d\xde\x4d\xba\x92\x88\xe0\xda\x6a\x28\x62\x02\x51\x02\x91\x3b\x0e\xe0\xf2\x37\x50\xad\xfd\xfb\xe3\xbd\xf4\xd8\x56\x32\xd6\x62\x33\x92\xa5\xfe\xf9\x4f\x50\xf0\x9f\xf0\xca\xa7\x2b\xdf\x2e\x8e\x45\xda\x67\xc6\x35\x03\x37\x78\xc7\xa5\xdd\xa4\x4d\x58\xb2\xa0\x23\x28\x98\xcf\x9b\x0d\x79\x64\x54\x6e\xc0\xf7\x5d\xef\xe7\x46\xbd\x7d\x0e\x4c\x86\xc1\xe0\x59\xee\x9b\xf7\x61\x8f\x58\x15\xf0\x2c\x8f\x12\x50\x49\x8b\x8a\x69\x3b\xe2\x5e\xc8\x73\x20\xf7\x0c\x76\x80\xa5\x41\x4f\x89\x35\x9d\x7e\x60\xd1\xc4\xaa\xdb\x6f\x56\x29\x2b\xd5\x0a\x5b\x31\x72\xdf\xed\x84\x47\xba\xf9\x2d\x81\xa2\x53\xce\xb0\x0d\xd3\x62\x93\x40\x94\x44\x13\x4f\x83\x41\x1b\x8f\xf6\xf6\x74\x6c\xb2\x86\xff\x06\xf8\x27\x61\xce\x35\x16\xf2\x36\x2e\x12\x88\x5e\xb6\xe0\x41\x91\xf8\xf3\x9b\x39\x14\x9f\x5f\xcf\x2e\x93\x3e\xa2\xb7\xe8\x31\x39\x4e\xbd\x09\x14\x1e\x96\x4d\x60\xbf\xd5\xf1\xbd\xcf\xe9\x5b\x2a\xb1\x8d\x15\x1c\x60\xb6\xdb\x07\xcf\x6b\xb7\xdb\x26\xe1\xc4\x95\x86\xa3\x22\x3d\x35\x94\x9f\xe8\xab\x3d\x3b\x99\xc0\x6e\x97\x8c\x53\x2b\xf6\x48\xb9\xaa\xa4\xfd\x60\xb1\xb6\xca\x20\x5e\x63\xe6\x38\x2e\x38\x5b\x0f\x8b\x97\x07\x2d\x90\xc1\xfc\x41\x25\x9b\x62\xd1\xb3\x83\x7d\xcd\xee\x3a\x9f\x21\xef\x4f\xa0\xaa\x49\x4d\xce\x77\xff\x82\x77\xec\x57\xdf\xf1\x5c\xe7\x50\x4f\x92\x26\x33\x44\x8d\xef\x7a\xa4\xec\xf1\x33\x06\x3e\x5f\x8e\x4f\x96\x2b\x6c\xfd\xd3\x6d\x86\xe3\x4b\x5c\xd5\xec\x55\x09\x3c\x5f\xd3\x3f\x73\x6f\x45\xf9\x80\x80\x1c\x3e\x78\xf6\x65\x06\xcf\xd6\x8d\xab\x36\x69\x81\x37\xe7\x43\xd7\xe7\x1b\xbc\xbb\x84\xb9\x3b\x0d\xf6\xc5\xe4\x53\x4e\xf4\x65\xc1\x67\x6d\x71\x55\x1b\x38\x2a\x26\x10\x93\xd1\xec\x9b\xc9\x23\xc6\x39\x30\x17\xaf\xf4\x4e\x9a\x45\x5f\x5a\x7b\xc2\x3c\x98\xfb\x5d\xb2\x1c\x97\xe6\x1f\x97\xa7\x97\xe8\xbd\x32\x1d\x56\xe8\x83\x1f\x7d\x8b\x1f\x21\xdf\x9c\x2a\xec\x65\xbf\x36\xfc\xfa\x32\xdc\x15\xd9\x74\xde\xe3\x77\x40\x27\x3e\x2e\x90\xd7\xad\x58\x0e\x54\xd0\x9e\xe1\xb6\x50\xe3\x55\x91\xdf\x17\x6a\xed\x9d\x89\x77\x93\xfe\x6d\x81\x1a\xe3\x9a\xf3\xb7\xf7\x69\x8e\xc8\x71\xe9\xe3\xb2\x87\x53\xb2\xf4\x67\x1f\xe3\xb5\xec\x30\xf1\xbb\xd6\x86\x8e\x11\x4d\xef\xd2\xc2\x65\x7b\xdf\x12\x3e\x98\xef\xaf\x56\xb2\xcc\xe9\x0c\x82\xac\x93\xd3\x15\x1f\xd0\xdb\x05\x2e\x0f\xab\x80\x41\xab\x78\x7f\x21\xf0\x66\xd0\x4a\x42\x2f\xc9\xd3\x35\x5b\xfa\x51\x6c\x7e\x46\x63\xc4\x35\xf6\x2a\x83\x27\xf8\x11\x2d\x39\x5a\xd3\x44\xb4\xe6\xab\xc3\xc0\x9f\x80\x24\x50\xdd\xd0\xb0\xa3\xf7\xf9\x71\xdf\xb9\xfc\x8e\x20\x48\xdd\x81\xbb\x5b\x88\x9b\x36\xe0\x71\xd8\x49\x13\xa9\x68\xe9\x9a\x4a\xa6\x03\x07\xf3\x36\x31\x9b\x03\xf3\xfb\x8b\x5a\x0a\x6d\x16\xa2\xa4\x42\x22\x81\xe7\x0d\xe0\x48\x8d\x3a\x66\xd3\x8d\x4b\x71\xc4\xe1\x8b\xb1\x26\x8e\x7b\xdf\x7a\x7c\xcf\x7b\xa1\xac\x3d\x8f\x77\x39\xeb\x57\xa7\xf5\x4a\x53\x64\x4a\x4f\xcd\x89\x5a\x2d\x9b\x50\xd1\xb1\x72\x0f\x95\x16\x18\x76\xbb\xd8\xd9\x2c\xdd\x5b\x6a\xa1\x4c\x51\xe9\x25\xea\xf6\xb4\xff\x10\xb6\xb7\xca\x43\x3b\xb9\x50\x02\xa4\x5a\xc4\x01\xae\xfb\x29\x71\x4c\x66\x63\x42\xf3\x96\x4c\xc2\x12\xb2\xc4\xfc\xcf\xca\x2d\xd8\x1d\xc4\xa5\xa0\x39\xc8\x40\xbb\xdd\x42\x2d\x4c\x46\xf7\x76\x45\xe3\x04\x1d\x3b\x3e\x0a\xf4\xba\x53\x5f\x2c\x7c\x68\x6e\x10\x8f\x8a\xd4\xd7\xb9\x94\x05\x7a\x85\xd8\x08\x67\x4b\x69\x0c\x5d\xb7\x90\x57\x4b\x8d\x79\xcb\xd5\x93\x38\xea\x6f\xa6\xe1\xa4\xff\xed\x09\xfa\x38\xea\x3b\x61\xdf\x69\x1e\x76\x1d\xae\x23\x1d\x0f\x3e\x83\xcb\xd0\xaf\x10\x7b\x06\xdd\xef\xfd\xb1\xa7\xed\xa5\xbf\x4a\xf8\xf9\x79\x65\xc5\x55\x89\xff\x47\xa3\x90\xb7\xa8\xbe\x25\x75\x6e\xeb\x22\x2e\x85\x1c\xbe\x4d\x88\xd4\xaa\x2c\xa3\xc6\x63\x7c\x7b\xff\x86\x2e\xb3\x47\x8d\xd7\x1b\x7d\xcf\x14\xfb\x56\xf2\xaf\x00\xf8\xff\x3c\x00\xba\x00\xd8\x1c\x12\x3d\x21\x00\x3e\x60\xab\x87\x88\x7f\x5f\x4c\x6a\x45\xd3\x7f\xe3\x33\x9d\xf6\x8e\xf2\xf8\xd3\x45\xa8\xde\x33\x90\xe6\x0a\xbf\x09\x51\xf4\x98\xc4\x87\x9d\xfe\x29\xe0\xfe\x3d\x63\x7c\x7f\x3c\x49\x5c\x3c\xe1\x0e\x95\x1c\xe4\xd1\x00\xd4\x3f\xeb\xe5\xa9\x33\xdc\xbc\xe5\x33\x5f\x1d\xeb\xf4\x87\x2a\xbf\x9b\xa4\xee\x77\xfc\xdc\x33\x7c\xa0\x7e\x2f\x1d\x25\xcb\xf1\x63\xde\x3e\x7b\x5e\xef\x2e\xa9\x75\x92\x6d\xc2\x8e\xaf\x3c\xa7\xd3\xa1\xac\xbb\xb7\x1e\xca\x07\x4c\xc9\x92\xd3\xc8\x6f\x1e\x3c\x97\x7c\xa9\xbd\x41\x8d\x9c\xe1\xe8\x66\x7c\xb5\xc4\x1c\xae\xee\xfa\x42\xf6\xf2\x1d\x53\xe5\xd3\xe2\x34\x59\x71\x73\xe7\xe7\xe2\xb5\x87\xde\x86\xa3\x6e\xe0\x29\xb5\x96\x4f\x87\x21\x74\xe3\xd9\x67\xbf\x63\xbb\x6d\x71\xc1\xd0\xcd\x71\x93\xd4\x68\x90\x3a\x18\x90\xca\x56\x40\xe3\x0e\x1d\x6f\x84\xea\xe6\xaa\x46\x4d\xbe\xe6\xd9\x1b\x74\xca\x3e\x10\x4f\xc0\xdf\x43\x27\xed\xc0\x96\xd5\x2f\xfb\x17\xd6\xa7\xf4\x1a\xe2\x87\x3b\x8b\xd4\x63\x27\xf0\x4d\xfa\xcd\xe4\x3b\x90\x6d\x73\xe2\x37\x7c\x83\x77\x9f\x67\xf2\xb2\xc1\x64\xd2\x8b\xea\x7d\xb5\x41\x4d\x40\x9f\xe5\xbf\xbf\x9e\x5d\x0e\xf8\x63\x54\x11\x7e\x89\x06\x5c\x72\xbf\xe7\x6f\x18\xfc\x4d\xa7\xd8\xf8\x18\xcb\xdd\x83\xe7\x9b\x79\xa6\x69\x37\xc5\x6a\x96\xc6\x3f\x44\x71\x0a\x26\x89\xb4\x22\x80\x33\x59\x36\xaf\x58\x9a\xc1\x66\x39\x08\xb8\xaa\xaa\x12\x85\x4a\x60\xed\x5f\xbb\xf4\x16\x8d\xbf\x6a\x69\xdf\xde\xd0\x6b\x46\x7a\xfa\x42\xe5\x4f\xe9\xf7\x33\x90\xf8\xa0\x85\xf5\xc2\x49\x60\x4d\xf7\x78\xd0\x3f\x5e\x84\xb8\xf7\xab\xef\xb4\xfe\xd4\xa3\xaa\xdb\xeb\x8e\x48\x1a\x25\x4b\x3a\x27\x54\x95\xa5\xaf\x59\xa7\x86\xe6\x8c\xf0\x5c\x68\x83\x3f\x54\x55\xe9\x0f\x0a\x3d\xa0\xf2\x50\x52\xf1\xf9\x98\xa1\xf7\x94\xa4\x6b\xff\x88\x90\xb2\xce\x0a\x3f\x14\xf1\xda\x4c\xd2\x93\x12\x97\x7c\x45\xe7\x0f\xd1\xcc\x13\x0f\xd1\x06\x08\xcf\x70\x13\x33\x15\x4e\xc5\x71\x83\xb5\x7f\x1c\xd2\xdd\x29\xd1\xcd\x6a\x7a\xda\x88\x61\xfc\xee\xd6\xf3\xc9\xa1\xc5\x75\xb3\x64\x55\x9e\x15\x0a\xfb\x71\x43\xfa\xb8\xae\x51\xe5\x8e\x3a\x21\xf6\x94\x9b\x6a\xb3\x11\x18\x4d\xf7\x89\xba\x90\xd3\x3f\xc6\x3a\xd8\x27\x17\x56\xeb\x91\xdd\x1d\x6e\xae\x47\xe9\x40\xc6\x8d\x30\x46\xa8\x37\xa7\xe9\x8e\xa0\xff\x43\x8f\xcd\xf6\xfc\x61\xff\x4c\xa1\xd2\xae\xca\x5d\x80\xc1\x6b\x7a\xe3\x42\x36\x56\xc1\xda\xbd\xbd\x72\x80\xd2\x74\x98\xf9\x61\x98\x7f\x88\xe8\x66\xdd\xc3\x33\xb5\x5a\x5e\x51\x9b\x54\xe9\xc6\x39\xcc\x84\x8e\x36\x7b\xcb\x9d\x29\x43\x45\x41\x77\x23\x4d\xf3\x62\xa6\x13\x51\x67\xec\x43\x43\x6f\xe3\xe6\xbd\x15\xd9\xe7\xcb\x2b\x0a\x37\x64\xb9\x9d\x9c\x7b\xef\x09\x3a\x31\xf7\x43\xca\x38\x92\xc6\x21\xfe\xba\xaa\x3c\x4a\xc6\xe9\xe5\xeb\xae\x87\xda\x64\x42\x0e\x4d\x0f\x7c\xfc\x4d\x8c\x7b\x94\x5c\x15\x5d\x92\xe1\x48\xb3\x11\x0d\x44\x97\x4b\xfc\x33\x0e\x27\x83\xee\x1e\xa7\xf1\x63\x7a\xec\xd1\xf9\x72\xe3\xc8\xbd\x7e\xe1\xb4\x7d\x92\x42\x60\x93\x9e\x47\x0f\x2e\x79\xdc\x03\x9f\x31\x70\x2e\x90\xb4\x90\xca\xbe\x13\xb2\x5c\x69\x7c\x00\x0f\x3d\x9c\x2d\x65\x66\x07\x56\x7e\xb8\x8c\xcd\x52\x89\x92\xdf\x3d\x69\xce\xe1\x9d\x65\xb6\xd7\x66\xee\xcb\x45\xeb\x6b\xb9\x46\xe5\xcd\xac\xb3\x14\xed\x5f\x96\x78\x0b\xe9\x5d\xb8\x8d\xbf\x3c\x61\xa9\x4b\x65\x0f\x2c\x67\x1b\x06\x9b\xd4\xdf\x7d\x4d\xd8\xd7\x23\x7f\xeb\xf5\x92\xa2\x0b\xc5\x36\xbe\x6e\xca\x04\xf5\xb0\xee\xfd\xf4\x24\xdc\xbb\x34\x23\xec\x93\x30\xf8\x0d\xba\xf2\xe6\x44\xb9\xf2\x66\x33\x49\xdd\x67\xdc\x1a\x48\x77\x69\x76\xc8\x27\xeb\x76\x8c\x4f\x76\xb6\xa5\x3b\x4e\x32\x54\xd2\xf1\xd3\x1d\x6f\x0c\xee\x6d\xaf\xaa\x6c\x83\xde\xa2\x4a\xc0\x48\x45\xef\xd2\x17\x78\x07\x4b\x71\x07\x74\xa7\x21\xa4\x72\x8e\x43\x75\x69\x8e\x56\xc8\xb2\x79\x7b\xd9\x5c\xa6\xf5\x1e\x21\xe7\xc2\x0a\x7a\xaa\x3e\xe9\x4b\xd9\xdf\xf6\x3d\x26\xe6\x9e\xa1\x6e\xc3\x60\x69\xf8\xdd\x3b\x6a\xed\x2a\x16\xff\xaa\x87\x97\x7f\x3f\x7f\xc4\x40\xd8\x3f\x09\xc3\x60\xe1\x05\x3d\x0e\xf2\x82\xdf\x85\xc3\x2b\x57\x1a\x4e\x7a\x0f\xf9\x02\x87\xc7\x87\x97\xbf\x93\x8e\x66\x11\xef\x2e\xfa\x7b\x18\xec\xb6\x3c\x3d\x83\xa5\xb9\xde\x0d\x2b\xed\xff\x19\x00\xe4\x09\x2b\xf2\x8d\x30\x00\x00")
+
+func templateRestTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateRestTmpl,
+		"template/rest.tmpl",
+	)
+}
+
+func templateRestTmpl() (*asset, error) {
+	bytes, err := templateRestTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/rest.tmpl", size: 12429, mode: os.FileMode(420), modTime: time.Unix(1792005654, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateShadowTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\x6d\x6f\xdb\xc8\x11\xfe\x2c\xfd\x8a\x39\xc2\x71\x49\x83\xa1\x73\xf9\x74\xd5\xc1\x05\xae\xb6\x53\x04\x48\xed\xa6\xc9\x01\x05\x8c\xe0\xb0\x26\x57\xd2\xc2\x7c\xeb\x92\x92\x6d\xe8\xf4\xdf\x3b\x33\xbb\xcb\x37\xd1\xb2\x9d\x38\xf9\xd4\x20\xb1\x2c\x72\xe7\x7d\x9e\x99\xd9\xdd\x6c\x36\xc7\x47\xd3\xd3\xa2\xbc\xd7\x6a\xb1\xac\xe1\xed\x9b\x9f\xff\xfa\xba\xd4\xb2\x92\x79\x0d\xef\x44\x2c\xaf\x8b\xe2\x06\xde\xe7\x71\x04\xbf\xa5\x29\xf0\xa2\x0a\xe8\xbd\x5e\xcb\x24\x9a\x7e\x5e\xaa\x0a\xaa\x62\xa5\x63\x09\x71\x91\x48\xc0\xaf\xa9\x8a\x65\x5e\xc9\x04\x56\x79\x22\x35\xd4\x4b\x09\xbf\x95\x22\xc6\x8f\xb7\xd1\x1b\xf7\x16\xe6\x05\xbe\x9e\xaa\x9c\xdf\x7f\x78\x7f\x7a\x7e\xf1\xe9\x1c\xe6\x2a\x45\x16\xe6\x99\x2e\x8a\x1a\x12\xa5\x65\x5c\x17\xfa\x1e\x8a\x39\x3e\x6d\x85\xd5\x5a\xca\x68\x7a\x74\xbc\xdd\x4e\xa7\x9b\x0d\x24\x72\xae\x72\x09\x5e\xb5\x14\x49\x71\xeb\x81\x7d\x7c\x50\xde\x2c\x60\x76\x02\xd7\x02\x25\x1e\x44\xa7\x45\x3e\x57\x8b\xe8\x5f\x22\xbe\x11\x0b\x49\x8b\x70\x4d\x2d\xb3\x32\x15\x35\x12\x2f\xa5\x40\x85\x3d\x38\x60\x72\x95\x95\x85\xae\xc1\x9f\x4e\xbc\xb8\xc8\x6b\x79\x57\x7b\xf8\xeb\x3c\xe3\x8f\xea\x3e\x8f\xbd\xe9\x74\x82\xf4\x5a\xe4\xc8\xeb\xe0\x8f\x10\x0e\x72\x92\x75\x10\x5d\xa0\x23\x2a\xe2\x31\x99\x78\xa4\x44\xbe\x2b\xf8\xd8\x3c\x6f\x1f\x78\xc4\xeb\x35\xc8\x3c\x61\x42\x6f\xa1\xea\xe5\xea\x3a\x8a\x8b\xec\x78\x6e\xc3\xa0\xf2\x78\x75\x2d\xd0\x19\xc7\x18\x9c\xe3\x44\x89\x14\x5d\xe3\x4d\x83\xe9\xf4\xf8\x18\x3e\xb1\xe1\x48\x2f\xae\x53\x14\x4e\x0e\x4c\x56\x22\x7d\x7d\xab\x15\x9a\x96\x51\x68\xd8\x83\x18\xa6\x54\x21\x7d\x84\x41\x35\x0e\xa5\x77\x21\xbf\xc9\x56\xb5\xa8\x55\x91\x57\xfd\xa5\x20\xb4\x24\x11\x99\xd2\xba\xd0\x18\xd7\xba\xe0\xb7\xc6\xd7\x90\x68\xb5\xc6\x30\x8b\x79\x6d\x82\x7d\x0f\xb7\x52\x4b\x10\x65\x89\xd4\x09\x14\x26\x9a\xa5\x56\x99\xd0\xf7\x21\x08\xb4\x90\xc3\x2b\xab\x55\x5a\x37\xa2\x30\xbb\x48\xc6\x7f\x57\x52\x2b\xd4\x1f\x45\x62\x3e\x65\xa5\x20\x79\xb7\xe8\x8b\x31\x1a\xab\x40\x85\x2e\x41\x27\x46\x98\xaf\x2a\x5d\x69\xa2\x46\x19\x99\xaa\x32\x51\x63\xd6\x0d\x96\x93\x14\x4b\xc1\x52\xb4\xa4\x30\xb7\x56\x2d\xd0\x9a\x1c\xe6\xab\x3c\x26\x57\x80\xa8\xe0\xc8\xf8\xf6\x9c\xac\x37\xfa\x27\x05\xe4\x98\x9c\x73\x94\xc7\x34\x45\x29\x75\xcf\x73\x24\xa4\xf1\x73\xfd\x97\x0a\x56\x84\x87\x79\xa1\x51\xad\x05\x2d\xcd\x17\x50\x89\xb9\x4c\xef\xe1\x5a\xd6\xb7\x12\x25\x5a\x9d\x2a\xf0\x65\xb4\x88\x60\xae\x8b\x0c\xfe\xa1\x65\x96\x12\x1c\x0a\xf8\xf4\xf1\x43\x10\x21\x5b\xe2\x7c\x51\x60\x48\xeb\xa5\xa8\x4d\xd8\x54\xd2\x06\x4c\x4b\x41\xb6\xe4\x9c\x81\x64\xde\x03\x41\xb3\xd2\x9a\x70\x68\x89\xca\x99\x34\x60\x07\xf5\x42\x9b\xad\xaa\x1a\xf5\x04\x41\x5a\xd8\x87\x11\xfc\xb3\xc9\x16\x52\x85\x85\xc9\x3b\x19\xaf\x48\x3e\xe9\x8c\xb8\xa8\x44\x6c\x56\xf4\x34\xb9\x5d\xca\x9c\x84\x90\x3a\x9d\x55\x54\x3a\x30\xe4\x99\xaa\x91\x43\xa3\xd8\x3d\xd3\x26\xaa\x8a\x85\x4e\x2c\x31\x28\x72\xa9\x2e\xd2\x14\x1f\x5c\x23\x84\x22\xf8\x8c\xbc\x5c\xee\x14\x73\xe6\x3e\x94\x4f\x11\x73\x29\x15\x42\x85\x68\x22\x27\x4a\xa5\xb1\x4e\x35\x72\x21\x5e\x12\x9c\x5b\x8a\xb5\xaa\xd4\x75\x5b\x94\x06\x29\x67\x02\x32\x31\xa1\x0e\x41\x6a\x4d\x15\x80\xc2\x7e\x59\xca\xdc\xf7\x16\x26\x80\x5e\x88\xc5\xa5\xae\xcb\xd9\xf1\x71\x5a\xc4\x22\x5d\x16\x55\x3d\xfb\xe5\xe7\x5f\xde\xe2\x0b\x5a\x6c\x12\xcc\x4f\xf4\x3a\xe4\xcc\xf3\x89\x91\x24\x77\x05\xb0\x21\x01\x93\xb4\xc0\xea\xa1\x55\x5e\xa7\xc8\xb5\x05\xf6\xcc\x63\xa1\x01\xad\xd9\x06\xf4\x31\x25\x7a\x68\x18\x72\xfc\x6c\xa9\x88\xce\xf8\x6b\x68\x53\xbe\x91\x84\x52\x02\xb8\x2c\x39\x06\x9b\xe9\x44\xcb\x7a\xa5\x0d\x02\xfc\x18\x8e\x62\x2e\x5d\xa4\xc7\x64\x12\x47\xd6\xfe\x13\x38\x34\xcf\x37\x46\xc4\xcc\x66\x45\x08\xa8\xe8\x0c\xe2\x08\x3f\xb6\x4c\x60\x65\x9d\x58\xa1\xd3\xc9\x76\xba\xed\x14\x2c\x06\x15\x45\xbe\x81\x21\x3a\x7a\x50\xb8\x42\x13\x74\x91\xb7\x38\x63\xe8\x55\xae\xb6\xec\x00\x3b\x04\x64\x6a\x33\xa5\x6a\xaa\x86\x45\x2d\x57\x85\xb1\x72\x62\x4b\x54\x1b\xdc\xfa\xbe\x94\x3d\x3d\xab\x5a\xaf\xe2\x9a\x5c\x81\xb2\x2e\x4b\xd2\x9b\xeb\xa6\xcb\xec\x56\x3f\xdf\x20\x31\x84\x55\x99\xf0\x67\x22\x53\x89\xf6\x20\x13\x4a\xd3\x7b\xc4\xf2\x04\x19\x20\x43\x2c\x05\xcc\xee\x33\x09\xb3\x0c\x09\xbe\xc0\xd2\xad\x6a\x0d\x63\x24\xe3\x85\x1d\xc2\xf7\x67\x6e\x55\xce\xce\x52\xe6\x8b\x6c\x3d\x9b\x0a\x5b\xdf\x04\x54\xa5\x8c\xd5\x5c\xc5\xbc\x16\x99\x21\x31\x26\x95\xd4\xd4\x63\x36\x5b\xe6\x87\xa6\xa2\xb6\x55\xac\xd5\xb5\x6d\x24\x73\x53\x57\x49\x79\x63\xae\xa9\xac\x48\x7e\xee\xf2\x94\x29\x4f\x2d\x76\x96\x45\x9a\x58\x4a\x25\xcd\xaf\xb6\x40\x24\x6a\x3e\xc7\x5a\x93\xd7\x4d\xd9\xeb\x38\x9e\x79\xf8\x97\x69\x12\x34\x4d\xc2\xa6\x9b\x7f\x21\x6f\x03\xc0\xfc\xaa\xba\xdd\xc9\x9a\xe0\xa4\x5e\x7d\x79\x47\xd2\xcc\x57\x9b\x64\x36\xbd\xb0\xc1\xcb\x0c\xa5\x56\x5d\xcf\x38\xb3\x23\x03\x19\x5f\xf6\x0a\x7d\x60\x68\xfd\xc0\xba\x9a\x82\x8e\x9e\x95\x11\x7a\xec\xa7\x13\xc8\xb1\xf2\x13\x22\x1c\x58\x32\x04\x71\x49\xf8\x9c\xfb\x9e\x9b\x3b\xb6\xdb\x99\x33\xe0\x15\x2b\x8d\x3f\x7d\x95\x9c\xbc\x5a\x07\x33\x78\xb5\x26\xe8\x62\x99\xa0\x9f\x14\xd2\x90\x79\xd3\xcf\x73\x02\x34\xe2\xe4\xb9\xcc\x1f\x60\xca\xec\x8c\x3b\x4c\xa2\x76\xe2\xb3\x9b\xb9\xcc\x4b\xf4\xea\xf2\x2a\xaf\xd1\x5a\xae\xb9\x4d\x9d\x34\x15\xb7\xa5\x22\xee\x4c\x98\xcb\x8a\x93\xad\x43\xcf\xc5\xbf\x58\xb7\x3d\xc8\xaa\x81\xeb\x09\x9e\x32\x8f\xd3\xa2\x22\x17\x77\x89\xf0\xaf\x11\x66\x61\x68\x69\x7a\x08\xa4\x52\x8e\xa9\xd4\x43\xa1\x4b\x8e\x51\xae\x0c\x0e\x36\x64\x4c\x51\xcc\x25\xcb\x11\x8e\x0c\xb3\xe9\x24\x5b\x01\xff\xa1\x79\x2f\xc2\x8e\x27\xef\x70\x11\x0e\x69\xc4\xf8\xea\x8b\xa9\x91\x66\x38\xa4\x19\x8f\x3e\x9d\xaf\x2d\x7f\x13\x43\x23\xf0\xd6\xe9\x48\x83\xc0\xa8\xab\xfc\x4a\xac\xb1\x10\x62\xa8\x03\x67\x48\x4f\x41\x93\xa9\x99\xd3\x2f\xb0\x3c\x30\x4b\xed\x13\x9b\xa6\x19\x9c\xec\xe4\x28\x7e\xed\x66\xd5\xa1\x21\xd8\x18\x93\x67\x90\xb9\xc2\xac\x57\x39\xfd\x7b\x28\x41\xb8\xb6\x9a\x0c\x52\xb5\xcd\x8d\x7d\x9d\x7c\x44\x69\xe4\xee\xc7\xf5\x1d\x0c\x3c\x87\x9c\x4b\x18\x75\x69\x30\x66\x56\x51\x12\x93\xa0\x31\x90\x8d\xcb\xa2\x6c\x15\x7d\x28\xe2\x1b\x1f\x5f\xe0\xa6\x80\xa6\x17\x7a\xf4\x7b\x9e\xda\x87\x59\xe4\xe2\x77\x42\x33\x2a\xfe\xee\x37\x8f\x48\x03\x17\x3f\x63\x40\xeb\x09\x47\xb5\x33\xeb\x0d\xd3\x76\x64\xca\x62\x74\x68\x06\x01\x33\xcb\x1c\x12\xc6\x73\x7f\x7f\x92\xee\xb8\xd3\x68\xea\x07\x0f\xc7\x7e\xd7\x35\x45\x59\xd1\xa4\xd2\x58\xde\xf7\x0b\xa7\xca\xc0\x6f\x94\xb3\x7f\x70\x88\x90\xce\xec\x79\x88\x09\x09\x21\xa1\x91\x85\x4e\xa7\x36\x4e\xdc\xc3\x88\x03\x6e\x63\xfa\x77\x9c\xd7\x16\x9a\x36\x7f\x7e\x60\xfc\x3d\x99\x6c\x41\xa6\xb8\x41\x63\x22\x0a\xeb\xc8\x52\x5e\xd6\x4e\x0f\x34\xfc\xd1\xe4\xe7\x26\xc3\x67\xc4\x68\x2c\x21\x2d\xb7\xe7\xf9\xf0\xd1\xf4\x22\x37\xba\xc2\x6b\x5a\xa6\x1d\x73\x8c\xb6\x6e\xd4\x1c\x69\x85\xc3\x06\xd8\x6d\x7d\xc2\x36\x3e\x63\x45\x0c\x6e\x40\x73\x32\xfc\x02\xcb\x3f\xd6\x4c\xdb\xb8\xb0\xe6\x25\xdd\x1e\x1f\x36\x72\x7b\x0d\xd3\x59\x9e\xe2\xd8\x6a\x17\x04\xf0\x37\x78\x63\xe7\x3e\xa3\xb7\x7f\xd8\xe9\x90\x9b\xcb\x72\x06\x24\x8b\x1a\xcd\x8c\x24\x86\x38\x8b\xcc\x50\x5c\x48\xbd\x73\xc6\x7d\x8b\x57\x62\xdb\x7a\x95\xb8\x59\xc0\xa9\x89\x9d\xaa\x2b\x0b\x73\xc1\x36\xf2\x99\x53\x70\x1b\xd8\x78\xef\xdf\x64\x53\x47\x34\xd3\x16\xbd\xe0\x3e\x09\x7e\x29\x30\x2d\x52\xda\x62\x5f\x88\x0c\x8d\xf3\x4e\x79\x05\x1d\x0c\xd0\x7a\x33\x95\xed\x5b\xff\x3b\xaf\xf0\x9c\x00\x3b\xbe\xed\x21\x38\xe3\x15\x4e\x00\x0f\x79\xfb\x96\x7f\xa4\x05\x0d\xfb\xaa\xee\xa9\xd3\x39\x19\xf0\xdc\x36\xab\x59\xab\x65\x2c\x79\xac\x27\x1c\xba\xdf\x9d\x07\xec\xb9\x07\x06\x92\x46\x5d\xe4\xf3\x6f\x29\x92\xcb\x1c\xf7\x99\xf8\x06\x13\x91\x46\xeb\x4f\xd8\x63\x80\x1a\x4d\x67\xd8\x54\xf9\xd8\x08\x1c\xda\x9d\x34\x05\x91\xcb\xfd\xe8\x26\xd2\xa5\x62\x4f\xb9\xed\x16\x8e\x3a\x91\xd9\x6e\x83\x46\xf6\x43\xb5\x1f\x61\x11\x45\x11\xd5\x8b\x53\x91\xa6\x66\x43\x12\x80\x7f\x64\x8e\x4a\xc8\x6f\xc8\x26\x6c\xf7\x45\x13\xa7\x2d\x3a\xe2\x68\x20\xbc\x79\xd9\xee\x58\xb8\xa8\xad\x9b\x4d\x9a\x7b\xef\x34\x32\x1a\xa0\x02\x81\x19\xf4\x70\xd5\x4f\x63\x3d\x94\x19\x70\x31\x58\x47\x06\x7a\x56\x44\x08\xcd\x83\x66\xd3\x33\xd0\xaa\x59\x39\x7c\xee\x36\x47\xc3\xe7\xc6\xf5\x91\x6d\x98\x76\x73\x38\xe2\x3e\xb3\x3d\xb3\xa6\xa2\x71\x87\x3d\xd7\x6f\x8c\x5a\xb3\x1d\x2f\xf5\xf4\x31\x39\x48\x73\xe5\x70\x55\xf7\x2d\x95\x93\x19\x1c\xae\x71\x56\xa5\x0d\x5e\x75\xab\xa8\xac\x19\x3d\x1b\xdf\x1a\xb6\x8d\x6b\x83\x5f\x4d\x15\xa1\x43\xb8\xd6\xaf\x33\xaa\xf6\xe3\x8e\xd8\xad\x33\x9e\x31\xc6\x73\xd5\xc6\xeb\x25\x85\x67\x2a\xcf\x9a\x07\x68\xae\x3d\x28\x86\xca\x07\xd5\x68\x81\x7b\xbc\x51\x59\x4d\xc1\x6c\x99\xef\xb0\x35\x2c\xcf\x70\xdb\xd2\x7b\xe3\x63\x1e\xd9\xe6\xe1\x1a\x53\xd0\x4c\x56\xf8\xce\x56\xfe\x9e\xc4\x2e\x5a\x6d\xfd\x19\x60\xd2\x3c\x6d\x51\x59\x3d\x09\x96\xb4\xc0\xf2\x7b\x3e\x3c\x1b\x45\xbe\x1a\x9e\x57\x5f\x8c\x67\xde\x9f\xf1\x8e\xe3\xdb\x21\xaa\x92\x6a\x14\xa4\xef\xcf\xaa\xaf\xc3\xe9\x8b\x82\xaa\x71\xd8\xcb\x82\xaa\xc4\x19\x5b\xc5\x14\xfd\xdd\x95\xed\xbb\x2e\xe2\xbe\x27\xd8\x8c\x91\x0f\x83\xad\x07\x31\x96\x94\x91\x1c\xea\xe7\x18\xbd\xe0\x3b\x08\x1b\xcc\x12\x06\x1d\x7c\xce\x6c\xc8\x71\xe6\x90\x77\xa5\x8c\x69\x5a\x7e\x95\x20\x55\x16\x36\xea\x04\xdb\x5d\x8c\x72\x92\x3d\x82\x52\xdf\x36\x63\x1b\x72\xef\x32\x97\x5e\xf0\x18\x66\x7f\x20\x64\x51\x9f\xff\x37\xd5\x1f\xdd\x54\xbb\xde\x7f\xf9\xbe\x3a\x5c\xa1\x92\x1f\xda\x64\x1f\x83\xe2\x37\x35\xd9\x86\xf9\x8f\x6a\xb2\x76\x66\x6f\x01\x7b\x7e\x27\x63\x7b\x10\xdb\x6d\xb2\x7c\xd5\xf2\x24\xcc\x32\x2d\xed\xd0\x9f\x8f\xda\x46\x99\xa0\x51\xe5\x99\x90\x55\xf6\x86\xe1\xeb\x71\x9a\xef\xe0\xd4\xa9\xf1\x24\x9c\xbe\xf9\x4e\x2d\xb5\x71\xcd\xe3\x78\xfa\xf6\x36\xe9\x2c\x7e\x09\xb8\x18\xc5\x9f\xdd\x26\xf3\xef\x20\xe5\xe1\xfe\x68\xc8\xc7\xfa\x63\x3e\xd6\x18\x73\x87\x2b\x14\x63\x2f\xa3\x1f\x46\x98\xd9\xe5\xb6\x00\xa3\xff\x22\x60\xef\x00\x0d\x60\xcc\x82\x62\x4f\x4b\xb4\x37\x72\x55\xef\xa6\x66\xe4\xc2\xd7\x5d\x18\x0e\xef\x7c\x3f\xda\x4b\x3f\xbe\x62\x60\x83\xe3\xa5\x50\x39\xdd\xb6\x12\xac\x0b\xba\xdb\x6c\x6e\x06\xcd\xd5\x2a\x6f\xbd\xff\x13\x18\xf9\xcf\xb8\x34\xdc\x8b\x6f\xe7\x8a\xc0\x39\x62\x3c\xfd\x71\x5c\x7e\xd9\xd6\xbb\x3b\x2b\x5b\xd9\x4f\xee\xb8\xf6\x60\x6f\xdd\x9e\xeb\xad\xcd\xb1\xde\x77\x68\xc5\x5b\x56\xaa\x3d\xcf\x51\x21\x75\x40\x73\x19\xcf\xa7\x3a\x9f\xec\x17\x73\x8a\xb2\xdb\x2c\xdd\x5b\x67\xd4\x9f\x7f\x42\x93\xa8\xe3\x45\x69\xc4\xfc\xb5\x9d\xfd\x48\x9d\xb6\x16\x35\xb7\x50\xb6\x52\x18\x5b\xe1\xe4\xa1\x6a\x34\x9d\x8c\x37\xe4\x7d\xfe\x7f\x32\xe8\x39\x9d\x9e\x58\x59\x46\xac\xb2\x07\x79\xee\x7c\xd3\xce\xe6\xeb\x2a\x78\x79\x2d\x1e\xae\x3c\x46\xaf\xdd\xd2\xd3\xd1\x2c\x74\x7a\x05\xe6\xb0\x6f\xe2\xba\x2d\x9d\x4d\x8b\x1b\xe9\x67\xa2\xbc\x1a\xee\x2f\x77\x10\xd4\x61\xd8\x1e\x54\x67\x6d\x3e\xb7\x57\x23\x8e\xff\x55\x86\xfc\xbe\x60\x6c\xb3\xfd\x10\xa0\xa3\x60\xec\x8d\x37\xac\x8f\x25\xa5\x69\xe5\xcb\xaf\xf4\x70\xb3\x7f\xe0\x71\xde\x7b\xd6\xbc\x13\x0c\xcf\xc3\x5f\x2a\x58\x83\xd9\xed\x10\x7f\x5e\x14\xf5\x3b\x3a\x5d\xdf\xc0\xf0\x7f\x23\x45\x1f\xc4\xb5\x4c\xb7\xd0\x34\x88\xe9\x30\xcb\xba\x0d\xa2\xfd\xed\x7f\x10\x29\x85\x46\x4e\x26\x00\x00")
+
+func templateShadowTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateShadowTmpl,
+		"template/shadow.tmpl",
+	)
+}
+
+func templateShadowTmpl() (*asset, error) {
+	bytes, err := templateShadowTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/shadow.tmpl", size: 9806, mode: os.FileMode(420), modTime: time.Unix(1792027969, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateTxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x4d\x8f\xdb\x36\x13\x3e\x4b\xbf\x62\x5e\x63\xf1\xc2\x5e\x38\x54\x9a\x5b\x0d\xec\x21\xd8\xa4\x40\x80\x62\x81\x36\x2e\x9a\x5b\x42\x93\x63\x8b\x88\x44\xba\xd4\xc8\x96\x21\xf8\xbf\x17\x43\x52\x92\xbd\xeb\xa4\x1f\xe8\x25\x6b\x91\xf3\xf1\xcc\xf0\x99\x87\x4c\xdf\x17\xf7\xf9\xa3\xdb\x9f\xbc\xd9\x95\x04\x6f\x5e\xff\xf0\xe3\xab\xbd\xc7\x06\x2d\xc1\x4f\x52\xe1\xc6\xb9\xaf\xf0\xc1\x2a\x01\x6f\xab\x0a\x82\x51\x03\xbc\xef\x0f\xa8\x45\xbe\x2e\x4d\x03\x8d\x6b\xbd\x42\x50\x4e\x23\x98\x06\x2a\xa3\xd0\x36\xa8\xa1\xb5\x1a\x3d\x50\x89\xf0\x76\x2f\x55\x89\xf0\x46\xbc\x1e\x76\x61\xeb\x5a\xab\x73\x63\xc3\xfe\xcf\x1f\x1e\xdf\x3f\x7d\x7c\x0f\x5b\x53\x21\xa4\x35\xef\x1c\x81\x36\x1e\x15\x39\x7f\x02\xb7\x05\xba\x48\x46\x1e\x51\xe4\xf7\xc5\xf9\x9c\xe7\x7d\x0f\x1a\xb7\xc6\x22\xcc\xa8\x9b\x41\x5a\x22\xac\xf7\x95\x24\x84\x59\x89\x52\xa3\x9f\xc1\x5d\xd8\x32\xf5\xde\x79\x82\x79\x9e\xcd\x94\xb3\x84\x1d\xcd\xf2\x6c\xd6\x9c\xac\x9a\xe5\x79\x36\xdb\x19\x2a\xdb\x8d\x50\xae\x2e\xb6\xa9\x7e\x63\x55\xbb\x91\xe4\x7c\x81\x96\x0a\x6d\x64\x85\x8a\x66\xf9\x22\xcf\x8b\x02\xd6\x1d\xd7\x2c\x81\xbc\xb4\x8d\x54\x64\x9c\x95\x15\xa8\xca\x70\x07\xa9\x94\xc4\xdb\xca\xa3\x24\xd4\xb0\x39\x81\x92\x55\x65\xec\x0e\x1e\x83\x85\x58\x77\xf3\x85\xc8\xe9\xb4\x47\x8e\xd4\x90\x6f\x15\x41\x9f\x67\xca\xd9\xad\xd9\xe5\x59\xdf\x83\x97\x76\x87\x70\xf7\x79\x09\x77\x16\x56\x0f\x70\x27\x9e\x9c\xc6\x06\x5e\x9d\xcf\x79\x96\x15\x05\xf4\x3d\xdc\x59\xf1\x24\x6b\x84\xf3\x99\xd3\x71\xfb\x12\x82\xad\xf3\x60\x2c\xa1\x67\x68\x76\x07\x47\x43\x65\xd8\xbf\x76\xda\xb4\xa6\xd2\xe8\x1b\x91\x67\xd9\xf5\xce\xfd\xd5\x67\x44\x1d\x60\xa1\xd5\xdc\xcf\x73\xe8\xc2\xa3\xab\x6b\x43\xa0\xc2\x9f\x08\xe0\xa2\x21\x22\xdf\xb6\x56\xc1\x9c\x3a\xb8\x5f\x77\x8b\x64\x3d\x5f\x00\x7a\xef\x3c\x97\xeb\x91\x5a\x6f\x81\x3a\x11\x0b\x17\xda\x9b\x03\x7a\x31\xbf\xa7\xee\x5d\xf8\xb9\x10\xd4\x89\xc1\x31\x65\xfd\xd5\x55\xd5\x46\xaa\xaf\xe0\xd3\x8f\xbf\xcc\x3c\x78\xfc\x8b\xdc\x93\xeb\x50\x73\xec\x70\x74\x67\x0a\x3c\x5e\x1c\xfa\xc6\x58\xdd\x00\x39\x50\xad\xf7\x61\xf5\x3b\xed\x08\x7e\xf3\x05\xdc\xa7\x08\x13\xa8\xff\xc7\x95\x3e\xcf\x12\x23\x56\x13\xce\x65\x9e\x65\x1f\x55\x89\xb5\x5c\x41\x6d\x76\x5e\x12\x8a\x27\x3c\xc6\xa5\x39\x75\xa9\x8e\xc5\x32\x9e\xe9\xf7\x69\x74\x7d\xea\x2b\x78\xc2\xe3\x8d\x83\x9f\x8f\xc9\x87\xa8\xcc\x82\x10\x21\x30\x81\xd5\x04\xb6\xc6\x37\x04\x96\xd5\x80\xd9\xa7\x9d\x02\xec\x64\xbd\xaf\x10\xc2\xbc\xf6\xfd\x2b\xb8\x8b\x46\xab\x07\x30\x56\x63\x37\x82\x79\x1d\x46\xb4\x28\x60\x68\x3d\x1c\xbd\xdc\xc7\x63\xdd\x99\x03\x5a\x48\xe3\x27\xd6\x5d\xe4\xb2\x04\xeb\xf6\xe3\x6a\x72\x32\x9c\xad\x46\x4b\x32\xf6\x9b\xe7\xb4\x44\x30\x1a\x65\x98\x0f\x07\x4d\xbb\x0f\x32\x70\x71\x2c\x4d\x08\xe8\x5a\x02\xa9\x35\xcf\x8a\xb4\x27\xc0\x8e\xbc\x8c\xd2\x46\x2e\xc0\x98\x46\xa5\x28\xe0\xf7\x12\x2d\xc8\x61\x2d\x0c\x77\x08\x9f\x28\xc4\xd3\xbd\x04\x43\xb0\xc3\x34\x15\x0d\xb7\xf3\xa2\x06\x63\x1b\x92\x56\xa1\xb8\x98\x22\x69\xf5\x44\x6d\xe9\x31\x54\xc8\xad\xe4\x00\x61\x98\x59\x62\x06\x1c\xc1\x9c\x77\xda\x06\x3d\xd4\x6d\x43\x01\x06\x38\x8b\x1c\x33\xe8\x26\xd6\xac\xaa\xce\x07\x3d\x76\x69\x4c\xc1\xf9\x71\x70\x5e\xce\x4d\x51\xb0\xf7\x87\x2d\x48\x50\x95\x63\x39\xbf\xd8\xe6\x26\x62\xbd\x41\xad\x51\x87\xc8\x16\x53\x22\xd8\xa1\x45\x1f\xc4\x0e\x2d\x19\x32\xd8\x2c\x47\x84\x61\xe5\xc4\x71\xe5\x7e\x5f\x19\xe4\xa1\xf9\xa3\x45\x7f\x5a\x86\xf2\x12\x4b\x56\x41\x9b\x02\x41\x06\xf6\x89\x5f\xd8\xea\xd3\xa7\x4f\xdc\x4e\x8e\x14\xbc\xe0\x68\xaa\x0a\x36\x08\xd8\xa1\x6a\x09\x75\x20\x4e\xe9\x5d\xbb\x8b\x1a\xa7\x13\x85\x4a\xa3\xca\x51\x83\xc3\x2d\x72\xa3\xd4\x27\x47\x18\x67\x77\xe4\x9e\x69\xc0\x3a\x82\x9d\xf3\xae\x25\xbe\x5f\x1a\xb9\xc5\xa4\xd6\xa3\xd1\xa4\xd9\x21\xfb\x94\x15\xa1\x21\xe9\x63\xca\xab\xe6\xc2\xd6\xbb\x5a\xe4\x99\xf6\x87\x67\xc4\x8d\x31\xba\x41\xc3\xc3\x05\x5a\x9d\x98\x8b\x57\x80\x33\xea\x2e\x38\x94\xe4\xc8\xe2\x71\xdd\xa5\x2a\xb9\xb1\x16\x8f\xcf\x2e\xa5\x44\xca\xa8\x3e\xc1\x7c\xae\xa8\x83\x74\x0b\x8a\xc7\xf8\x77\x09\x2f\x71\x2d\x60\x12\xc3\x65\x54\xce\x05\x57\x4c\x5d\xf8\xe2\x39\xd6\xfe\x20\x62\xc0\x45\x9e\x99\x6d\x58\xfe\xdf\x03\x58\x53\xb1\xe1\xa0\x67\xd6\x54\xc1\x83\xf5\x62\xd4\xb8\x21\x72\x4f\x1d\xcb\x5b\x00\xb0\xe2\x7f\xce\x4b\x76\x48\xf5\xad\xbb\x51\x6a\x9f\xf7\x93\x35\x62\x8f\x9e\x15\x75\xc0\x4b\x0e\xe4\xc1\x19\x3d\xcc\x94\xf3\xd3\x48\x85\x29\xe5\x90\x7c\x0e\xb7\x87\x4a\xc0\xc7\xd2\xb5\x95\x66\x76\xb1\x39\x6a\x70\xb6\x3a\xf1\x05\x7e\xdb\xfe\x42\xd2\x27\x10\xdc\x8f\xeb\xe6\x2e\x60\x3e\x1d\xdc\xd4\x49\x18\xef\xa0\x50\x31\xc4\x8a\xdf\x45\xcb\xab\xb2\x93\xf7\x30\x6d\x7f\x97\x6b\xb7\xd0\xa5\xf0\xf3\x05\x53\x98\x39\x76\x01\x43\xf0\x71\x4e\x06\xc3\x85\xe7\x1a\x8c\xaf\x1d\x56\xa4\x20\x0b\x43\xe8\x8b\xb8\xc1\x6c\xba\x60\x61\x3a\x7a\xb8\x7a\x2c\x4c\x81\xe2\xf7\x37\x15\x2e\x68\xe3\x6f\xd7\xea\xf6\x65\x3d\xbc\x06\xbe\xdc\x92\xb6\x6f\x3c\x03\x2e\x51\x3e\x7b\x83\xbc\x84\x39\xf2\x65\x04\x3a\xaa\xe5\x3f\x86\x3a\xc4\xba\x06\xfb\x6d\xf5\x7d\x01\xf7\xe5\xc3\xe5\x25\xe0\xf7\x1d\xaa\xe1\x0a\xea\x04\x7f\xdd\x3e\x78\xde\xb9\x3d\xf9\x51\x56\x23\x1d\x96\x20\xfd\xae\x59\xc2\x21\x56\xc9\x8f\xe1\xfe\x7c\xf3\xd9\x94\x92\x71\xc8\xe5\xa0\xe7\xc9\x77\x78\x2b\x05\xfd\x9e\xb0\x85\xcf\xdb\xe0\xc2\xd6\x7f\x8c\x6e\x8c\x79\x13\xde\x41\x7a\xf8\xfc\xfc\x05\xf1\x70\xd9\xfd\xb9\x35\xd5\x22\xfc\xa7\x22\x3d\x7a\xff\x0c\x00\x00\xff\xff\x7d\x11\x3e\xd1\x36\x0d\x00\x00")
+var _templateTxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x59\x5b\x6f\xdb\x46\x16\x7e\x36\x7f\xc5\xac\xe0\x74\x25\x47\xa1\xd2\xbe\xad\x0b\x3f\x38\xae\xbb\x08\xd6\x70\xda\xd8\xbb\x1b\xa0\x28\xd2\x11\x39\x92\x88\x50\x24\x3d\x1c\x5a\x12\x04\xfd\xf7\xfd\xce\x99\x21\x39\x94\x68\x47\x6e\xb3\x81\x10\x89\x73\x39\xf7\xcb\x77\xe8\xed\x76\x72\x16\x5c\xe5\xc5\x46\x27\xf3\x85\x11\x3f\xbc\xfd\xfe\x1f\x6f\x0a\xad\x4a\x95\x19\xf1\xb3\x8c\xd4\x34\xcf\xbf\x88\xf7\x59\x14\x8a\xcb\x34\x15\x7c\xa8\x14\xb4\xaf\x1f\x55\x1c\x06\xf7\x8b\xa4\x14\x65\x5e\xe9\x48\x89\x28\x8f\x95\xc0\x63\x9a\x44\x2a\x2b\x55\x2c\xaa\x2c\x56\x5a\x98\x85\x12\x97\x85\x8c\xf0\xf5\x43\xf8\xb6\xde\x15\xb3\x1c\xdb\x41\x92\xf1\xfe\xcd\xfb\xab\xeb\xdb\xbb\x6b\x31\x4b\x52\x90\xb0\x6b\x3a\xcf\x8d\x88\x13\xad\x22\x93\xeb\x8d\xc8\x67\x58\x6d\x99\x19\xad\x54\x18\x9c\x4d\x76\xbb\x20\xd8\x6e\x45\xac\x66\x49\xa6\xc4\xc0\xac\x07\xc2\x2d\x19\xb5\x2c\x52\x69\xb0\xb8\x50\x12\x82\x0c\xc4\x69\xbd\x75\x5a\x3e\xa4\xe2\xfc\x42\xcc\x64\x0a\x49\x76\x3b\x2c\x69\x99\xcd\x95\x38\xfd\x3c\xc6\x26\xf8\x49\x3c\xe0\xc0\x69\x78\xe7\x1e\xf8\x50\x32\x13\xea\xa1\x39\x10\xde\xca\x25\xa8\x83\xd6\xc0\x6e\x33\xd9\x0b\x88\x56\xb9\xf3\x2a\x8b\xfd\x1f\x41\x90\x2c\x8b\x5c\x1b\x31\x0c\x4e\x06\x51\x9e\x19\xb5\x36\x03\xfc\x9c\x2d\xf9\xab\xdc\x64\xd1\x20\xc0\x8f\x79\x62\x16\xd5\x34\x8c\xf2\xe5\x64\xe6\x5c\x90\x64\x51\x35\x95\xe0\x3b\x81\x63\x26\x71\x22\x53\x98\x05\x97\xb6\xdb\x37\x24\x15\x73\x06\x83\x93\xe3\x2f\x4f\x48\x6e\x4b\xc0\x49\x37\x0a\x82\xc9\x44\xdc\xaf\xc9\x87\x12\x5a\xc8\xac\x94\x91\x49\xf2\x4c\xa6\x22\x4a\x13\x8a\x08\xb3\x90\x86\xb6\x23\xad\x60\xd9\x58\x4c\x37\x22\x92\x69\x9a\x64\x73\x71\xc5\x27\xc2\xfb\xf5\x70\x14\x06\x66\x53\x28\xa2\x54\xc2\x16\x91\x11\xdb\xe0\x04\xea\xce\x92\x39\xf1\xf3\x4d\x9d\x59\x23\xdf\x22\x72\x4a\xf1\x86\x15\x80\x08\x64\xca\xcc\x5a\x77\xb7\x23\x76\x14\x0e\x4e\x82\x59\xae\x11\x20\x46\x69\x12\x0d\x6c\x57\x50\x97\xf7\xbb\x97\xa6\x55\x92\xc2\xe7\x65\x08\x8a\xdd\x9d\xb3\xce\xa3\x95\x9a\xc5\x72\x56\xd8\xb1\x15\xae\xf2\xe5\x32\x31\x08\x69\xfa\xb2\x02\x78\x06\xb1\xa1\xe0\x8c\x3e\x16\x10\x49\xab\x54\xc9\x52\xd9\x93\xa5\x7c\x54\x45\x0e\x29\x29\x68\xa5\xc8\x54\x49\xb6\xea\xde\xb7\xdc\xc2\x60\x56\x65\x91\x18\x9a\xb5\x38\xbb\x5f\x8f\x1c\xdb\xe1\x48\x28\xad\x41\x75\xdb\x38\x18\x0f\xc3\xd3\xf0\x67\x58\xbd\xd2\xea\x3a\x93\xd3\x14\x14\x07\x2b\x69\xa2\xc5\x60\xd4\xb7\x15\x57\x32\x5d\xe9\xc4\x28\x6c\xb3\x5d\x29\x76\xb5\x26\x7b\x9b\x75\x68\xbd\x11\xc6\x3a\x79\x54\x3a\x1c\x9e\x99\xf5\x4f\xfc\x73\x14\x46\x4e\x82\x1f\xf9\xf4\xdf\x2e\x44\x96\xa4\x24\xc7\xc9\x89\x56\x60\x90\xd1\x32\x9e\x76\x6c\x57\x1b\x7b\x4f\x88\x65\xd9\x9e\x80\xdb\xb4\x2a\x1b\xba\xee\x9e\x33\xf6\x33\x44\x5a\x05\x5a\x42\xcb\x84\xcc\xf2\x24\x2d\x27\x21\x24\x76\x71\x6d\xb3\xbb\xdd\x39\x4a\xf3\x4e\x4e\xd8\x68\xf8\x98\xa7\xe9\x54\x46\x5f\x50\x93\xec\x8f\x23\x22\x82\x63\x76\x41\xa1\x5e\xbe\x38\x0c\x6a\x7e\x3d\x81\xf0\xbc\xb5\x9d\xb1\x75\x73\xbf\xa3\xcc\xf1\xc6\x6e\x6d\xfd\x14\xa9\x63\x2c\xea\xdd\xdd\x05\x7b\x95\x8a\xcc\xfa\x4e\xcd\x51\xeb\x4b\x23\xb5\x29\x7b\x0d\xc4\xd9\xed\xda\x81\xb7\x3c\x16\x55\x49\xb9\x2f\xc5\xdd\xaf\x37\x6d\xb6\x85\x2e\x7d\xb8\x2e\xe0\x0a\xb1\xe8\xa1\xd9\xa4\x2a\x25\x76\x73\x79\x2c\x24\x34\x23\x89\xe9\x36\x52\x9f\xfd\x1d\x27\x65\x24\x75\x0c\x0f\x66\xe9\xa6\xe3\x52\xaa\x84\xc4\x60\xa5\xb4\x12\xb2\x28\x50\x47\x62\x21\x67\x28\x4c\x74\x79\x25\x4b\xab\x97\x8a\x2d\xe1\x2f\x4a\x15\x36\x68\x54\x16\xa5\x39\x8b\xef\x0b\x95\x17\x2a\x0b\xc5\x7d\x37\x66\x9e\xd6\x41\x82\x69\x81\xf2\x96\xf0\x0e\x0b\xb7\x5a\xa8\xec\x19\x06\x54\xb4\xad\x75\xa8\x6d\x4f\x26\xf8\x9c\x58\xc2\x63\xaf\x2e\xb0\x47\x86\x91\x59\x8f\x68\xdf\x95\x8c\xa6\x08\x60\xc9\xaf\x02\x78\xdc\x79\xc7\x40\x61\xa1\x52\x48\x45\xf7\xc7\x4e\xea\xd0\x16\xd8\xe1\x68\xbf\xa0\x78\xb4\xdc\xc9\x36\xe8\x1b\xca\xdd\x03\x75\x71\x24\xe9\xbb\xf9\xd2\x88\x2d\x5c\x57\xc5\x59\xfe\x46\x69\xc4\x81\xb1\xcd\xa2\x11\xa5\x51\xac\x1f\xbf\x56\x03\x9b\x3c\x99\xa3\x53\xa7\xb0\x6a\x83\x02\x46\xe2\xfb\xa6\x98\x82\x50\xf8\x93\x6d\xa5\x48\xd3\x8b\x0b\xe1\xfa\x6a\xf8\x4f\xad\x96\x08\xa2\x4e\xd1\x84\xce\x63\x81\x36\x1f\x5e\x93\x20\xb3\xe1\x00\xa9\x3f\x45\x10\x82\xf4\x95\x15\xe3\x17\x68\x6e\x71\xc6\x79\x8f\xc3\x4b\xf6\x78\x06\x40\x54\x56\x05\x41\x08\xdb\x7b\xc9\xdd\xaf\xca\x9a\xf3\x60\xdc\x15\x6a\x64\xeb\xb4\x9f\xb5\x65\xd1\x78\x9b\x8e\x12\x23\xeb\xed\x03\x5f\xff\x49\xd1\x39\xe8\x6d\x6e\x1e\x6a\x71\x2e\x5e\x3d\x0e\x58\x00\x30\x84\x34\xd1\x6c\xde\xf1\x05\xaf\x38\x87\x00\x47\x95\xc5\x91\x55\x8f\x6e\xa1\xec\x09\x26\x45\xf5\xcf\xb2\xfe\xd3\xd5\x8f\xe8\xd9\xf2\x67\x49\xba\x52\xd8\x4f\xd5\x99\xe9\xbb\xfb\x35\x19\xcd\x2a\x72\x2e\x40\x62\x6c\xa1\xc7\xf3\x68\xa7\x0b\x4e\xce\xc5\xad\x5a\xf5\xe0\x93\x21\xc8\x8d\x1c\x3d\xe2\xcb\x77\xd1\x66\xa8\xd3\xd9\xca\x5a\x03\x4c\x82\x2e\x16\x28\x59\xb9\xa8\xae\x5e\x79\xd8\x6d\x9a\x64\xa8\x66\x26\x17\x51\xa5\x35\xaf\xb6\xee\x39\x00\x23\x2e\x75\xc5\x99\xa3\xb0\x6d\xb5\xb5\x2b\xbe\xc6\x8d\x17\x49\xce\x3b\xa0\xfd\xa5\x3c\x17\xcb\x64\xae\x01\x14\x43\xa8\x65\x97\x40\xdc\x39\x78\xf4\x2d\xed\xd3\x30\x3f\xb4\x52\xc0\x90\x7f\x72\x86\xd9\x42\x97\x06\x39\x14\x2b\x06\x91\x71\x1e\x09\xb5\x96\x98\x11\x94\xe0\x31\x82\xcc\x78\x6a\x0f\x41\x0c\xd8\x49\xad\x1b\x61\xde\xd6\xc6\xad\x8b\x84\x58\x69\xe9\x0a\xfa\x1c\xcf\x59\x93\xfd\x00\xbd\x0c\x49\x11\xff\x79\xd1\xac\xba\x4b\x09\x71\x5b\x42\x60\x69\xed\x4d\x70\x1b\x14\x92\x58\x49\x86\xb9\x79\x9d\xde\xdd\xdc\x27\x82\x79\x65\x84\x8c\x63\x4e\xad\x6c\x03\xc9\x71\xc2\x4e\x5c\xb8\x45\x62\xb4\x88\x17\x54\xff\x4b\xcd\x40\xd6\x6b\x8c\xd1\x99\xbc\x2b\x76\x04\xd2\xc7\xd4\xa7\xe6\xca\xd4\x90\x15\xe6\xf4\x74\x48\x32\x64\x72\x16\xa9\xd0\x03\xc3\xd4\xc7\x1a\x24\x64\xeb\x51\xc1\xa6\x24\x02\x8c\xc9\x69\x52\xa8\xe5\xe0\xe3\xb4\x53\x61\x56\x14\xcb\x0a\x66\x25\x31\xd0\xac\xb8\xa9\xd9\xf6\xb6\xa4\x61\x2f\xd7\x3c\x26\xe6\xae\x41\x31\x9c\xae\xd9\xec\x75\x7e\xd7\xb9\xc4\x7b\x02\x54\xd4\xe6\xf6\xfa\x22\x8c\xa8\x96\x53\x15\xc7\x58\x27\xca\x18\x09\x2d\x23\x68\x9a\x29\xcd\x33\x0b\xcc\x9f\x98\x44\x95\xe3\x46\x42\x5e\xd9\x10\x5d\xdb\xc8\x29\x69\x1e\x2a\xa5\x37\x63\x56\xcf\x45\xc9\x39\x8f\x18\x1c\x20\x75\xf4\x85\xbf\xd2\xa9\x4f\x9f\x3e\x91\x39\x89\x12\xdf\x82\xbf\xa0\xe7\x14\x74\xd7\x2a\xaa\xc0\x92\x03\x67\xa1\xf3\x6a\x6e\x47\x15\x57\xe1\x56\x8b\x24\x5a\x34\xa3\x14\x0f\xb7\x3d\xaa\xde\xe6\x18\x61\x39\x77\x9b\xd8\xc3\x41\x6a\x05\xf3\x1c\x24\x0d\x8d\xbd\xa5\x9c\x29\x37\x74\x35\x87\xda\xd1\x8b\xb9\xb7\x5c\x55\x8d\x4c\xf6\x8d\x2b\x66\x3a\x5f\x86\xb6\x45\x76\x03\xd7\xd2\x58\xd7\xa3\x18\xcf\xf5\xe9\x66\x0f\x62\xe0\x26\x8e\xb4\x31\xd4\x33\x9c\x82\x4a\x3b\x1b\x39\x62\x19\x99\xd2\x39\xa9\x33\x38\xf5\x74\xc1\xb1\xa5\x41\x7e\x8b\xc9\xb7\x5a\x51\xb2\x94\xc4\xa4\x7b\x9d\xf0\x97\xc3\x79\x71\x1d\x4f\xd4\x32\x11\x52\x34\x11\xb6\x07\x61\x25\xa8\x81\x25\xa6\xc7\xff\x30\x31\xa7\x4e\x56\x0c\xfb\xb5\x94\x15\xc2\x4a\x1f\xc8\x69\x71\xa0\x05\x81\xb5\x23\x2d\x5e\xb5\x14\x3a\xa0\x95\xc4\x4e\xcc\xdf\x81\x0c\x17\xc8\x9e\xd8\xd6\x09\x02\xa1\x3d\x9a\xb2\x94\x60\x7f\x96\xd8\xe9\x74\x6f\x20\xc9\xd4\x0a\x49\x6a\x39\x5a\xe8\xbc\xda\x1b\xd8\x5d\xa6\xdb\x92\xce\xc7\xfb\xf0\x11\x23\x86\x3d\x67\x13\x66\xaa\xa3\xc8\x47\x4e\x66\xdd\xc1\x0e\x96\xe0\x57\x91\x03\x8f\x8b\x5e\x9b\xac\x29\x6f\xcd\x9a\x7a\x06\x0b\x70\x4e\xff\xed\x4d\x50\x50\x9e\x80\xd0\x6a\x08\x03\x8c\x9a\x41\xa9\xe9\x79\x64\x84\xe8\x70\x46\xef\x8f\xcc\xff\xcb\xb8\xde\xc0\x45\x11\x3d\x35\xb4\xb7\x81\x4f\x01\xba\x0e\x5b\x46\x30\xd6\x60\xd0\x41\x88\xd8\x06\x8f\xbb\xfa\xc4\x70\xf0\xf1\xfa\xe6\xfa\xf2\xee\x5a\xdc\x5d\xfe\xe7\xfa\x97\x0f\xef\x6f\xef\xc5\xa0\x07\xd0\xb5\xb7\xf1\x69\x00\xb2\xb5\x8f\xf6\xa7\xd6\x52\x34\xf5\xf4\x68\x23\x79\xd7\xf2\x6f\x62\x29\xfd\xcc\x5c\xfb\x97\x6c\xf5\xe1\xe6\xe6\xdd\xe5\xd5\xbf\xc4\xfd\x87\x97\xd8\xeb\xe3\x57\xe6\x53\xd2\xcf\x4b\x32\xaf\x74\xf5\x8c\xa4\x3c\x3e\x3a\xcc\xe5\x95\x5b\x18\xaa\x3f\xc5\xfb\x2d\x54\x23\xf2\xbe\x41\xa6\x2f\x29\xcf\xc8\x54\xea\xe1\xf5\xeb\xc0\x2b\x6a\xf4\x66\x13\x80\xfd\xae\x40\x6d\x33\x40\xec\xe8\x6f\x9f\x9b\xdd\xcf\xaf\x62\x60\x70\x77\x0f\x16\x7a\x94\x94\x0e\x28\x4a\x0f\x69\xf8\x51\x95\x55\x6a\x82\xee\xbb\x22\x7c\xae\xd1\xc8\xec\x54\x37\xf0\xcc\xfb\xda\x1b\x9e\x7f\xfb\x9d\x01\x00\xbd\x71\xdc\xee\xb6\x88\x9f\xef\x40\xf3\xf0\x25\xd2\x0b\x2a\x43\xd8\x14\x07\x86\x8c\x8f\xe3\xd6\xfe\xe7\xfe\xd8\xce\x85\xc2\x6a\xd3\xa9\x0e\x7e\x84\xd4\x9d\xd8\xe5\x3b\xe0\x17\xa3\x30\x5b\xbc\x15\x23\xe2\x63\xa2\xbb\xdf\x65\x9d\x50\x2c\xcd\xb2\x6e\x2a\x5e\x8c\xdb\x90\xe6\x16\xe3\x59\xe1\xf8\xa1\xaa\x11\x0c\xc3\x1e\xb5\x36\x99\x22\x28\xe3\xcd\x53\x2d\x0e\xfe\xf5\x33\xc8\x0e\x5b\xc7\xfb\xd9\x45\xde\x3b\x50\x9a\x6b\x7a\x4b\x4f\xe8\x86\x14\x7b\xed\x53\x7d\xa9\xcf\x6b\x77\xd7\x86\xb0\xef\xc9\x03\xff\xa5\xdd\xc1\x28\x83\x1e\xe7\xa7\x54\xe7\xcd\x10\xf0\x77\x81\xfc\x1a\xb6\x8e\x40\x91\x92\x8f\x79\x12\xd7\x78\x15\x46\x69\xe0\x2a\x23\x60\x22\x49\x18\xa7\x1f\xb0\x86\xe2\x0e\x28\x3b\x8d\x09\xb9\xd1\xf1\xfa\xc5\x8a\x9b\xb3\x0f\xcf\xf7\x46\x03\xb5\xc5\x83\xd4\x6d\x41\x51\x9b\xbb\xa2\x29\x45\x1c\xb5\xc2\x6a\xec\x86\xf7\x6e\x25\x71\x6b\x0e\x7c\x1c\x8b\xe3\xfa\xa4\x6b\x5f\x58\xd8\x18\xf5\xc5\x08\xbb\x6f\x34\xea\x61\x12\x18\xdb\xfe\x41\x80\xd0\x3e\x43\xee\x9a\xb4\x47\x97\x8f\xb5\x55\x5d\xb4\x4e\x15\x9d\xf7\xe9\x2d\x21\xfb\xfc\xe4\xf4\xc0\x73\xc7\xbf\xbb\x93\xc3\x1f\xf7\x75\x8b\xfb\xa3\x6f\x6c\x38\x18\x15\x0e\xa5\xdc\x6b\xd4\x87\x62\x36\xf1\xd2\x08\xda\x34\xd1\x17\x8b\x5a\xd3\xea\x0a\xfb\xf4\x64\x73\x20\xee\xe1\x5b\xe0\x43\x81\x29\x63\xeb\xf1\xce\xe6\x6f\xbf\xe3\xeb\x0a\x7e\x08\x00\xed\xc8\x62\xc3\x01\x5d\x4c\xcf\x31\x18\x3d\x0a\x2f\xb3\xbd\x3a\xd6\xe9\x9e\x6d\x53\x70\xb3\x92\xbb\x5b\xc3\x0f\x9e\x8d\x5a\xd9\xf8\xb1\x5f\x38\xde\xfa\xc6\xd2\x35\x34\x7b\xc5\xa3\x62\xf8\x79\x7f\x3a\xbf\xf0\xad\x3f\x84\x8d\x47\xfc\xc7\x42\x57\x8f\xfe\x07\x0f\x77\x9d\x59\x29\x1d\x00\x00")
 
 func templateTxTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -954,12 +1207,32 @@ func templateTxTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/tx.tmpl", size: 3382, mode: os.FileMode(420), modTime: time.Unix(1567330536, 0)}
+	info := bindataFileInfo{name: "template/tx.tmpl", size: 7465, mode: os.FileMode(420), modTime: time.Unix(1792027969, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateWatchTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\x5b\x6f\xdb\x38\x16\x7e\x96\x7e\xc5\x19\x23\xc8\x48\x81\x4b\x77\xe6\x6d\xdd\x7a\x81\x41\xd3\x05\x02\x74\x93\xbd\xb4\x98\x87\xa2\x18\x50\xd4\xb1\x45\x44\x26\xb5\x24\x15\xc7\x50\xfd\xdf\x17\x87\xa4\x6e\x8e\xb3\xe8\x62\xfa\xd0\xd6\xbc\x9c\xf3\x9d\xef\x5c\xa9\xae\x5b\xdd\xa4\x1f\x74\x73\x34\x72\x57\x39\xf8\xf5\xed\x2f\x7f\x79\xd3\x18\xb4\xa8\x1c\xfc\x8d\x0b\x2c\xb4\x7e\x84\x3b\x25\x18\xfc\x56\xd7\xe0\x0f\x59\xa0\x7d\xf3\x84\x25\x4b\x3f\x57\xd2\x82\xd5\xad\x11\x08\x42\x97\x08\xd2\x42\x2d\x05\x2a\x8b\x25\xb4\xaa\x44\x03\xae\x42\xf8\xad\xe1\xa2\x42\xf8\x95\xbd\xed\x77\x61\xab\x5b\x55\xa6\x52\xf9\xfd\x4f\x77\x1f\x3e\xde\xff\xfb\x23\x6c\x65\x8d\x10\xd7\x8c\xd6\x0e\x4a\x69\x50\x38\x6d\x8e\xa0\xb7\xe0\x26\xca\x9c\x41\x64\xe9\xcd\xea\x74\x4a\xd3\xae\x83\x12\xb7\x52\x21\x2c\x0e\xdc\x89\x6a\x01\x71\xd5\xe1\xbe\xa9\xb9\x43\x58\x54\xc8\x4b\x34\x0b\xb8\xf2\x5b\x72\xdf\x68\xe3\x20\x4b\x93\x85\xd0\xca\xe1\xb3\x5b\xa4\xc9\xc2\x1e\x95\x58\xa4\x69\xb2\xe8\x3a\xb8\x62\x1f\xb4\xda\xca\x1d\xfb\x07\x17\x8f\x7c\x87\x70\x3a\xad\x1a\x83\xa5\x14\xdc\xe1\x22\x4d\xba\x0e\x0c\x57\x3b\x84\xab\x3f\x96\x70\xa5\x60\xbd\x81\x2b\x76\xaf\x4b\xb4\xa4\x20\x09\x32\xd4\x05\x21\x61\x7d\x5c\xf0\xb2\xde\x00\xaa\xd2\x5f\x5c\xec\xa4\xab\xda\x82\x09\xbd\x5f\x6d\x23\xfd\x52\x89\xb6\xe0\x4e\x9b\x15\x2a\xb7\x48\xf3\x34\x5d\xad\xe0\xe3\x13\x79\x48\x5a\xe0\x20\x2a\x8f\x44\x6f\x41\x79\x00\x91\xbf\x9d\xe1\x4d\xb5\x04\x57\x71\x7f\xae\xc4\x5a\x3e\xa1\xc1\x12\x9c\xf6\xfb\x9e\x2a\x34\x36\x30\x8b\xfe\x32\xb8\x63\x83\x6c\x90\x6f\x81\x1b\x84\xa6\x2d\x6a\x69\x2b\x2c\x81\x6f\x5d\x70\xa8\x34\xb0\x6f\x1d\x77\x52\x2b\x38\x70\x0b\xbc\x69\x6a\x89\xe5\x12\xb4\x81\x43\x85\x0a\xa4\xb3\xe0\x0c\x57\x96\x0b\x7f\x48\x5a\x10\x7a\xbf\x97\xce\x51\xd4\x90\x96\x68\x81\x75\xa6\x15\x0e\xba\x34\x59\xad\xe0\xa1\x21\xa4\x04\x46\x37\x68\x82\xf8\x88\x2e\x1a\x99\x21\xdb\x31\x40\xe5\xd8\x43\xf3\xc1\x20\xf9\x56\x9b\xf8\xfb\x16\x6b\x74\xf8\xa0\x30\x67\x5e\xda\x97\xc6\xa2\x79\x69\x83\x8d\xc7\xbf\x34\x25\xf7\xc7\x97\xc0\x6b\xab\x41\x4e\x68\x20\x9b\x84\x17\x5f\xb2\x34\x79\x68\xe2\x15\x2f\xf6\x33\x81\x97\x76\xce\xd9\x1c\x26\x4b\x13\x7f\xca\x3a\x23\xd5\xce\xdf\xba\xbb\x9d\x1f\x29\xfd\x5d\x06\x77\xee\x67\x0b\x4a\xd6\xb0\xd5\x06\x5a\x0f\xc9\x02\x57\x25\x94\x64\x8d\xd4\xca\x42\x71\x84\x21\xf4\x2c\x4b\x93\xbb\x5b\x90\xca\xa1\xa1\xf8\xe8\x4e\x5e\x3a\x45\x1e\x54\xba\x2e\x03\xac\x08\x9d\xbc\x41\x3f\x83\xd8\xa0\x31\x32\x78\xd3\x75\x90\x49\x55\xe2\xf3\x10\xb7\x6f\x73\x76\xcf\xf7\x14\x93\xf9\xd2\x23\x90\x11\x9a\xd7\xb0\x8d\xb2\x0c\x5a\xd7\x5b\x32\x38\xc9\x46\x3b\x6c\xc5\x29\xc0\x0a\x74\x07\x44\x35\x8f\x32\x92\xb8\x6f\xad\x03\xa5\x1d\x14\x08\x7b\x5d\xca\xad\xa4\x68\x48\x3c\xfa\x17\x26\x0d\x1c\xb7\xfb\x02\xcd\x25\xf6\x88\x8c\x7b\xe2\x22\x3d\xf9\x9c\x28\xda\x21\xcc\xc3\x55\xa4\x10\x1b\x02\x5c\xd4\x92\x72\xa6\x0f\x5c\x1b\x13\x41\x9a\x01\x24\x83\xcf\x15\x7a\x31\x7a\x0b\x7c\x1a\xc0\x24\x3e\xf0\x4b\x91\x1d\xe5\xb6\xca\xc9\xda\x8b\x7e\x2d\xd4\x03\x91\xa5\xb4\x82\x9b\xe0\x9b\x3d\x68\x05\x46\xd7\x75\xc1\xc5\x63\xcc\x04\x52\x38\xcb\x83\x86\x9b\x98\xdd\x6e\xc4\x33\x9a\xb0\xa4\x58\xf5\xde\x71\xaf\xc0\x65\x69\x12\x65\x00\xdc\x14\xad\x4d\x93\x7d\x0b\xe1\x0f\x55\x39\xf6\xf7\xd6\xe1\x73\x9a\x34\xa8\x4a\xa9\x76\x00\x5f\xbf\xdd\xf8\x74\x4c\x93\xc1\x5f\x7b\xde\x7c\xbd\x89\xbf\xbe\x05\x74\xdd\x29\x12\x1d\x97\x7b\x80\xb6\x2d\xac\x30\xb2\xe9\xf3\x95\xc3\xef\x74\x00\x04\xaf\x6b\x8a\x8c\x81\x30\xca\xc4\xff\xb4\xd8\x62\x09\x07\xe9\x2a\xdd\x3a\x28\x6a\x2d\x1e\x29\x49\x56\x2b\xa2\x67\xf4\x4e\xa0\xce\x55\x78\xf4\x09\x3c\x96\xaf\xe2\xe8\x0f\xee\xb4\xd1\xad\xa3\xa2\x1f\xb9\x99\xe8\x0c\xb4\xf6\x28\x47\x6a\xdd\xb1\xf1\x14\xc4\xb4\xdc\xb7\x2f\x18\xf1\xe8\xa6\x7c\x58\xb9\x53\xbc\xf6\x35\x16\xce\x58\x88\x35\x65\xa8\x2d\x93\x98\xbb\x54\x63\x29\x70\x08\x17\x4b\xb7\xad\x12\x90\x15\xde\x33\x79\x7f\x3d\x43\x08\x3a\x73\x42\x2a\xb7\x50\xc0\x66\xe3\x2b\x43\x97\x26\x89\x41\xd7\x1a\x95\x26\xa7\x34\x29\xd8\xbe\x65\x9f\xb4\x78\xcc\xf2\x34\x29\x71\x8b\x06\xfc\xd2\x17\x55\xc7\x45\xba\xcc\xa2\xff\x7f\x1a\x65\x14\xac\xf7\xf7\x86\x0a\x36\xaa\x32\x1b\x96\x96\x80\xf9\x5c\x0d\x25\xfc\x81\xfa\x5a\xe8\x73\x05\x1b\x6c\x21\x59\x72\x0b\x07\x46\x74\x6e\x36\x80\xcc\x17\x3b\x5a\x4e\x0e\xac\x69\xc9\x16\x12\x76\x22\xb8\x81\x2a\xf7\x0c\xc1\x02\xea\x57\x0a\x0f\x3e\xc7\x48\xc3\x2c\x6a\xe7\x51\x7e\xce\x93\x7b\xce\x72\x4f\xd9\xeb\xfc\x90\xa9\xa4\xb4\xff\x79\x5d\xb4\xb6\x0b\x44\xac\xa1\x60\x34\x51\x64\x79\x8f\x49\xa1\xa5\xa2\xf8\x1a\xae\xb8\x3d\x85\x97\x59\xfe\x84\x8d\x96\xe4\xa3\x08\x75\xb2\xed\xdb\xe6\x59\xb0\xef\xf5\xd3\xd8\x70\x27\x79\x8c\x4a\xd4\xda\x92\x2b\xa6\xf2\x63\xe7\xfc\x79\xd6\x2b\xe7\x24\x04\x54\x7f\x92\x88\x9e\x01\xe2\x63\xb0\xff\x0c\xe1\x65\x1f\xd0\x8d\x89\x72\x62\xaa\xe8\x43\xec\xfa\xfa\x72\xd4\xc1\x66\x58\x9f\x42\x2a\x22\x88\x60\xea\x59\x12\xf5\x81\x1a\xa9\xbc\x44\xf6\x1c\x58\x90\x92\xbd\xc8\x9d\xef\xdf\x47\x50\x3f\x90\x4e\x51\xdf\xda\x43\x0e\x18\xd2\x59\xde\x78\x5e\xcf\xd2\x8d\x58\xf8\x63\x09\x38\x26\x4b\x14\x13\x93\xce\x6b\x67\x43\x9a\xe7\x63\x5a\xf4\x7d\x60\xd6\x21\xfe\x7f\xe3\x7b\x31\x59\xfe\x7a\x44\xfc\x60\xe9\x38\xb7\x35\xe0\x8c\x05\xbe\x40\xe0\x65\x49\xa9\x12\x6b\xc1\x30\x15\xec\xe4\x13\xaa\xc9\x34\xe4\xf4\x8f\xc5\xd3\x20\x38\xa3\x5a\x12\xc6\xa5\x1c\xfa\xb6\x43\xd6\xf8\x12\x74\x1d\x17\x3a\x77\x6c\xd6\x34\xa3\x2e\x21\xd4\xe5\x35\xec\xf9\x23\x66\xb3\xea\xbc\x84\x5f\xf2\x53\x4f\xc3\x90\xf5\xef\xc6\x40\x25\x52\x66\x4c\x5c\xa6\xc2\x4b\x18\xab\xde\x84\xd1\x64\x52\x0c\x37\x01\xc1\xc5\x6e\x19\x8b\xe0\xe4\xf8\xd7\xc3\x37\xd8\x0c\x48\xbb\xd3\x34\x21\x0e\x91\xec\x56\x8d\x74\x1b\xa4\x0a\x62\xa7\xbd\x04\xb6\x46\xef\x7f\x8c\xdd\x89\xa4\xec\x30\xb0\x3a\x09\x92\x3f\xc3\x8e\x9f\x4f\x31\x1b\x6d\x5b\xc2\x61\x12\xda\xd4\x06\xc0\x77\xd3\x49\x5b\x0c\x3d\x3d\xb8\xce\x5e\xee\xe2\x51\x5a\x6f\xca\x14\x76\xd3\x9e\x77\xc9\xc3\x14\xe7\x81\x79\x75\x63\x73\x8b\x0b\xa1\xb5\x1d\xe6\xf0\x2d\xd6\x18\x66\x02\xc1\x2d\xc2\x81\xc5\x3e\xff\xfe\xcd\xc4\x3b\x6b\x9f\x24\xbc\xad\xdd\x7a\x34\x6c\x5b\x93\x65\xd3\xaa\x19\x27\x9a\x79\xba\x46\xd4\xde\x60\x51\x23\x37\x61\x72\xf4\x67\x2f\x19\xe7\xc5\x66\xf9\x30\x79\xbc\x30\x2f\x78\xe1\xcc\x8c\xa8\x73\xbd\x81\x68\xec\x94\x06\xca\xdf\x3e\xb8\xc2\x41\xf2\xcd\xff\x7c\xb9\x76\x1d\x8d\x96\x34\xa1\x5f\x29\xf6\x2f\xe4\xe5\x83\xaa\x8f\xf4\x30\xa5\x67\x6b\x9c\x9f\xd7\x1b\x68\x8c\x54\xfe\x88\x7f\x30\x2c\x3e\xf8\x0d\xff\xea\x5e\xad\xe2\xec\xd7\x13\x14\x9e\xa7\x0a\xeb\x9e\x98\xae\x1b\x2e\x9e\x4e\x71\x9c\x27\x1e\xb9\x83\xbd\xbf\x39\xd6\x93\xc9\xab\xc7\x0f\xe6\x51\x12\x79\x81\x86\xec\x5a\xd3\x47\x06\xdf\x34\xe9\x4e\x7c\xc9\xd3\x50\x5a\x6a\x85\x0c\xee\xb5\xc3\x20\x98\xb6\x47\x61\xbe\x33\xe3\x13\xaf\x5b\x7a\xdf\xd1\x3c\x4e\xfb\xd6\x69\xd3\xbf\x91\x48\xa6\xd7\xd2\x77\xf3\xd9\x03\x7a\x98\x4c\x0d\x6e\xb5\xc1\xe5\xe4\xa9\x46\x1b\xfd\x23\x6e\xf6\x6c\xf3\x3a\x79\x7d\xe0\xc7\x89\x20\x9a\x18\xd2\xd5\x2a\xa1\x2a\x3a\xe9\x1e\x31\x99\x67\x44\x31\xcf\x6a\x26\xdc\x33\xa5\xef\x6a\x95\x24\x82\xbe\xa8\xb0\x3b\xf5\xc4\x6b\x49\x0a\xb3\x30\x86\x2d\x01\xd9\xdd\x6d\x4e\x62\x4f\x24\x3e\x44\x9a\x80\x9b\x89\x07\x4f\xa7\x1c\x06\x79\x3d\x6f\xf4\xad\x82\xfe\x5d\x42\x63\x81\x31\x36\x60\x9f\x03\xc9\xe1\xfd\x1b\xf2\x43\x4c\x43\x0a\x53\x51\x11\xf6\xb1\x10\xc7\x04\x8d\xb5\x5b\xb0\xa2\xb5\x6c\x2c\x44\xe7\x1f\x40\xd8\x27\x5e\x60\x9d\xa7\xc9\x4e\x03\x81\x0d\x4d\x2c\x16\x1d\x9a\x92\xa8\xbc\x8f\x65\x28\x88\x9b\x55\x36\xda\x24\x0a\xa9\x72\x4d\x12\x9b\x28\xb2\x48\x70\xdd\x33\xbb\xd5\x0a\xb3\x7c\x4d\xab\x31\x25\x26\x07\xfa\xec\xf7\xdb\x54\xb0\x2f\xb4\xf4\x03\xeb\x33\xd4\xab\xa1\x02\x2a\xe9\x7b\xc7\x23\xd9\x4e\x94\xb3\x68\xd9\xdd\xad\xf7\x03\x51\xf5\x8e\xb6\xaf\xaf\x01\x7d\x7e\xf5\x05\xf6\xfa\x1a\x6a\x54\x59\x63\x73\xf8\x2b\xbc\x8d\xe2\x48\x9e\x0f\xff\x25\xa0\x31\x24\x53\xb0\x7f\xb6\x68\x8e\x59\xce\x7e\xaf\xd0\x5c\xe0\xed\xee\x36\x93\x65\xde\x6f\x37\x96\x31\x96\xb3\x8f\xcf\xd2\x3a\x72\x6b\xfe\xce\x0b\x8a\x3a\xbf\x7f\x87\x9f\xbc\xf8\x5e\x5d\x42\x5e\x97\xaa\xc5\xf0\xf3\x94\x8e\x7f\x4f\x19\x0c\x14\x8a\x0a\xde\xbf\x01\x5c\xa7\xc9\xeb\xa4\x4e\x58\xa5\x49\x23\x08\xa3\xfe\x96\xe5\x43\x11\x12\x55\xea\x2b\x49\xf8\xd8\x35\xfc\x27\xed\x3a\x40\x55\xc2\xe9\x94\xfe\x77\x00\x45\x39\xdd\x20\x8e\x14\x00\x00")
+
+func templateWatchTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateWatchTmpl,
+		"template/watch.tmpl",
+	)
+}
+
+func templateWatchTmpl() (*asset, error) {
+	bytes, err := templateWatchTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/watch.tmpl", size: 5262, mode: os.FileMode(420), modTime: time.Unix(1791995583, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateWhereTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\x4d\x6f\xdc\x36\x13\x3e\x4b\xbf\x62\x20\xc8\x78\xb5\x41\x4c\x25\xb9\xbd\x05\x7c\x30\x62\x07\xd9\xa2\xb0\xd3\x3a\x68\x0f\x41\x50\xd0\xe2\x48\x22\xac\x25\x15\x92\xbb\x8e\xa1\xea\xbf\x17\xfc\xd0\x87\xd7\x6b\x67\x8d\xc4\x40\x8d\xdc\x76\xc9\xe1\x70\xe6\x79\x9e\x99\xa1\xba\x2e\x7f\x11\xbf\x95\xed\x8d\xe2\x55\x6d\xe0\xcd\xab\xd7\xff\x3f\x6c\x15\x6a\x14\x06\xde\xd1\x02\x2f\xa5\xbc\x82\xa5\x28\x08\x1c\x37\x0d\x38\x23\x0d\x76\x5f\x6d\x90\x91\xf8\x63\xcd\x35\x68\xb9\x56\x05\x42\x21\x19\x02\xd7\xd0\xf0\x02\x85\x46\x06\x6b\xc1\x50\x81\xa9\x11\x8e\x5b\x5a\xd4\x08\x6f\xc8\xab\x61\x17\x4a\xb9\x16\x2c\xe6\xc2\xed\xff\xb6\x7c\x7b\x7a\x76\x71\x0a\x25\x6f\x10\xc2\x9a\x92\xd2\x00\xe3\x0a\x0b\x23\xd5\x0d\xc8\x12\xcc\xec\x32\xa3\x10\x49\xfc\x22\xef\xfb\x38\xee\x3a\x60\x58\x72\x81\x90\x5c\xd7\xa8\x30\x01\xbf\x7a\x08\xd7\xdc\xd4\x80\x5f\x0d\x0a\x06\x29\x24\x1f\x68\x71\x45\x2b\x4c\x20\x25\xe1\x27\x1c\xf6\x7d\x1c\x75\x1d\x18\x5c\xb5\x0d\x35\x08\x49\x8d\x94\xa1\x4a\x80\x58\x2f\x5d\x07\xf6\x6c\xb8\x65\x32\xe2\xab\x56\x2a\x93\x40\xea\xb6\xf2\x1c\x96\x27\x36\x78\x83\x4a\xc3\x06\x95\xe1\x05\x6a\xb8\xa4\x16\x05\xe9\xd2\xe1\x0a\x38\x43\x61\x78\xc9\x51\x91\xb8\x5c\x8b\x02\x96\x27\x19\x67\xd0\x75\x90\x92\xe5\x09\xf9\x78\xd3\x22\xf4\xfd\x02\x5a\x85\x8c\x17\xd4\x20\x71\x5b\x67\x74\x65\xd7\xa1\x8b\x23\x85\x66\xad\xc4\x3d\x06\x5d\x07\xbc\x84\xca\x40\xd6\xa0\x80\x94\x5c\x18\xa9\x68\x85\x0b\x78\x0d\x7d\xff\x01\xd5\x09\xa7\x0d\x16\x66\xcc\x28\x8b\x23\x9b\xb8\xa2\xa2\x42\x48\xff\x7e\x09\xa9\xf6\x27\xe0\x97\xa3\xe9\xb8\x07\xc8\x59\xa6\x66\xd5\x36\x76\xb3\x55\x5c\x98\x12\x12\xe6\x3d\xe6\x07\x3a\x1f\x43\xca\x39\x4b\x26\x4f\xc3\xd9\x43\xf8\x3a\x62\xe7\xdd\x58\xe0\x5e\xfa\x08\x6c\x38\xee\x96\x45\xec\x61\x9e\x85\x24\x5b\x7b\xa1\x6c\xb5\xc3\x08\x02\x59\x29\x55\x95\x5d\x4f\xec\x65\x43\xe6\xa9\x6c\xc9\x9f\x54\x71\xca\x78\xe1\x17\x9d\x99\xb3\xd2\xc1\x2c\x70\xe9\x7c\x38\x0a\x66\xd9\x2c\x4f\x0e\x74\xe2\xbc\x04\x40\xe3\x28\xcf\x61\xb4\xec\x7b\xa0\x6d\xdb\x70\xd4\x4e\x9d\x76\x7d\x32\x9d\x28\x09\x74\x7b\x3d\x60\xc3\x48\x1c\xb9\xe3\x33\x3f\xd9\x10\x9a\x25\x75\x57\xe8\x84\x90\x31\xd6\x47\xa8\xe3\xc7\xcb\xe3\x11\xfa\x88\x76\x94\xdb\xb1\xaa\x12\x9f\x69\x72\xde\x3a\x68\x21\x09\xc7\x66\x1a\x19\x1c\x3c\x46\x62\xb9\x6c\xf5\x1d\x99\xed\x16\x1a\x09\x42\xbb\x2d\xb5\xad\x7f\x8b\x38\xda\xae\xf5\x59\xde\xa5\xcf\xf8\x9d\xe5\x53\x07\xfd\xe4\x2f\xe0\xd7\x8b\xf3\x33\x28\xa8\x10\xd2\xc0\xa5\x6d\x7f\xab\x96\x2a\xdb\xf6\x34\x17\x15\x24\x47\x09\x50\xc1\xe0\x54\xac\x57\x50\x53\x0d\x14\x8c\xe5\xd0\x77\x2a\xe6\xb1\xb2\x4a\x71\x32\x01\x61\x59\x72\xed\xcc\x65\xc1\x4b\xb0\x6e\x33\xa9\x20\x2d\xc9\x52\xbb\xbb\xdc\x2f\xeb\x6f\xe1\x33\xbe\xa5\x62\xaa\x0b\xda\x58\x93\x51\xbd\xf7\xc9\x17\xbf\xac\x69\xc3\xcd\x0d\x14\x35\x16\x57\x77\xa5\xdb\x75\xf0\x65\x2d\x2d\x80\xa3\xb3\xa0\x65\x58\x9a\xff\xe9\xd0\xc7\xec\x6d\x46\xce\x2f\x38\xfd\x9d\xc4\xd1\x5d\xb5\x6f\xfc\xbf\xbd\x14\xfc\x04\x12\x7e\x8c\x86\x77\x89\xd8\xb1\x9e\x58\x11\x8c\x56\xfb\x2b\xb5\x0c\x87\xb7\x85\xfa\x0d\xa5\x6e\x49\x75\xeb\xef\x22\x8e\xa2\x20\x93\xa0\xd7\x47\x29\xd7\xd6\xa1\x1e\xbb\x6a\x39\xe9\x79\x08\x52\xb7\x58\xf0\x92\x17\x13\x0b\x1a\x18\xd7\xf4\xb2\x41\x06\xa5\x54\xb0\x5a\x37\x86\x1f\x0e\xe6\x76\xec\x57\x28\x46\xf1\x5a\x8e\xf0\xcb\x4e\x8e\x82\x66\x67\x1c\x70\xc1\xf0\xeb\x8c\x89\x57\x93\x95\x0d\xef\xc8\x8a\xd6\x11\x61\xff\x65\x05\x6d\x9a\xf1\x38\x39\x77\xf1\x2f\x86\xb4\x66\xbd\xfd\xce\x00\x71\xc7\xb7\x87\xc7\x66\x9f\xd9\xb1\xf9\xe6\xe8\x80\xec\x76\xed\x2d\x20\x1b\x26\xc3\x18\x5b\xea\x4a\xdf\x06\xe2\xeb\x80\x5c\x18\x65\x9b\xc4\x78\xff\x50\xd9\xe1\x72\x67\x7e\x04\x46\xf1\xd5\xf0\x50\xf1\x6b\xd3\xc3\xe5\x56\x50\xdf\x31\xa8\xee\xaf\xf6\xdd\x93\x2b\x74\x26\xe7\x93\x37\x5b\x80\xed\x3b\xd1\x8c\xef\x05\xe3\xda\x83\x4d\x21\xf4\xc3\x2d\x97\xb6\x12\x36\x16\xd2\x15\xbd\xc2\xec\xd3\x67\x2e\x0c\xaa\x92\x16\xd8\xf5\x2f\xa1\x41\x31\x9b\xb2\x0b\x5b\x31\x91\x55\x2e\xb7\x07\xbc\x3a\x36\xbe\xe1\x44\x9b\x4f\xfc\x33\x1c\xc1\x64\xfd\x89\x7f\xb6\x1b\x7d\xb8\x79\x80\xf8\xbf\x3c\x5d\xa7\x1e\xf5\x63\x07\xad\xd3\xc1\x93\xcc\xda\x59\xc1\xde\xdb\xbc\x02\x14\xa7\xac\x42\x7d\xb7\xf4\x42\xcd\xa1\x67\xe0\x9f\x31\x91\xf7\x54\x1f\xb8\x9a\x7d\xb0\x2a\xde\x53\x6d\xfd\x3e\x54\x0e\x38\x8a\x10\x59\x85\xbb\xaa\xe1\x59\x3d\xc6\x6c\xba\x89\x05\xf5\xf1\x32\xb0\xf9\xe7\x35\x7d\x1a\x15\x78\x34\xa7\x08\x0e\xf4\x5f\xdc\xd4\xc9\x88\xf2\x8f\xa5\xd1\xa3\x42\xa1\xe2\x1b\x14\x50\x48\xc1\xb8\xe1\x52\x68\xc8\xa4\xa9\x51\xcd\x46\xde\x62\x17\xe3\x76\x5b\x03\x21\xe4\x36\xad\xe8\x9b\x7a\xb8\xe8\x67\x93\xc5\xb5\xe7\xeb\xc9\x1e\xe3\x79\x0e\xc7\x82\x41\xa5\xe4\xba\xd5\xd0\x70\x6d\x40\x96\xf3\xc7\xc9\xf8\x94\x3e\x3e\x3b\x01\xd9\xa2\xa2\x46\x2a\xb8\x44\x73\x8d\xe8\xe4\xb0\x0a\x1f\xdc\xc7\x82\x65\xb3\x73\x77\x78\xdc\x87\xc1\xe7\xf0\x0d\x4e\xc5\x7e\x1f\xe1\xe4\xbe\x8f\xf0\x3c\x87\x73\xb5\x0f\xe2\xe7\x7f\x3c\x08\xf8\xb9\xfa\x29\xf0\x96\xea\xbb\xe1\x3e\x93\xe6\x56\x67\xb3\x6f\xac\x11\xd9\xd0\xd4\x7c\xd3\x9a\x90\xf0\x18\x9f\x49\x93\xb5\xf7\xe0\xf3\xdc\x81\x15\xd2\x7c\x1f\xb2\x53\x1b\xf9\x37\x00\x00\xff\xff\xc3\xe8\x67\x3f\xe9\x14\x00\x00")
+var _templateWhereTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x1b\x6b\x4f\xe4\x46\xf2\xf3\xcc\xaf\xe8\xb3\xd8\xc4\xde\x1b\x3c\x9b\xe4\x74\x52\x88\xf6\x24\x76\x81\x64\x4e\x2c\x6c\x16\x92\x7c\x40\x28\x32\x76\x1b\xfa\xf0\xd8\xc6\xdd\x1e\x40\x64\xff\xfb\x55\x55\x3f\xfc\x18\x0f\xcc\x24\x8b\x92\x5c\xee\xc3\x6a\x71\x3f\xaa\xaa\xeb\x5d\xd5\x3d\x0f\x0f\xd3\x97\xe3\xb7\x45\x79\x5f\x89\xcb\x2b\xc5\xbe\x7c\xf5\xc5\xd7\xdb\x65\xc5\x25\xcf\x15\x3b\x88\x62\x7e\x51\x14\xd7\x6c\x96\xc7\x21\xdb\xcd\x32\x46\x8b\x24\xc3\xf9\x6a\xc1\x93\x70\x7c\x7a\x25\x24\x93\x45\x5d\xc5\x9c\xc5\x45\xc2\x19\x7c\x66\x22\xe6\xb9\xe4\x09\xab\xf3\x84\x57\x4c\x5d\x71\xb6\x5b\x46\x31\xfc\xf7\x65\xf8\xca\xce\xb2\xb4\x80\xe9\xb1\xc8\x69\xfe\x70\xf6\x76\xff\xe8\x64\x9f\xa5\x22\x03\x10\x7a\xac\x2a\x0a\xc5\x12\x51\xf1\x58\x15\xd5\x3d\x2b\x52\x18\x6d\x90\xa9\x8a\xf3\x70\xfc\x72\xfa\xf1\xe3\x78\xfc\xf0\xc0\x12\x9e\x8a\x9c\x33\xef\xf6\x8a\x57\xdc\x63\x7a\x74\x9b\xdd\x0a\x75\xc5\xf8\x9d\xe2\x79\xc2\xb6\x98\xf7\x3e\x8a\xaf\xa3\x4b\x98\xdf\x0a\xcd\x9f\x6c\x1b\x96\x8e\x00\x80\xe2\xf3\x32\x8b\x14\x80\xb8\xe2\x11\x90\xed\xb1\x10\xa1\xc0\x0c\xee\x35\x58\x9a\x45\x62\x5e\x16\x95\x02\x40\x34\x35\x9d\xb2\xd9\x1e\x12\xaf\x78\x25\xd9\x82\x57\x0a\x0e\x29\xd9\x45\x84\x5c\x28\xe8\x38\xa2\x62\x22\x01\xa6\x8a\x54\xf0\x2a\x1c\xa7\x75\x1e\xc3\x1e\x5f\x24\x0c\xe0\x6e\x85\xb3\xbd\xf0\xf4\xbe\xe4\x00\x2d\x60\xc0\xfe\x44\xc4\x80\x26\xa4\xa9\xa3\x68\x8e\xe3\xec\x61\x3c\xaa\xb8\xaa\xab\x7c\xc5\x02\xf8\x5b\xa4\xec\x52\x31\x3f\xe3\x39\x0c\x9f\x00\xdb\xe0\x84\x01\xfb\x02\x26\xdf\xf3\x6a\x4f\x44\x19\xf0\xd2\x9d\xc8\x1f\x8f\xf0\xe0\x55\x94\x03\x1b\xb6\x7e\x9e\xb0\x2d\xa9\x77\xb0\x9d\xd7\xcd\x76\xcd\x20\x5a\xb9\xa5\xe0\xf4\x38\x59\x56\x22\x57\x29\xf3\x12\x0d\x71\xfa\x42\x4e\x1d\x49\x53\x91\x78\x0d\x24\xbb\x77\x9b\xdd\x39\xde\x69\x30\xc8\xb8\x89\xa6\x00\xc9\x21\x2c\xc1\x58\xb3\xb9\x45\x52\x51\x22\xc2\xa2\x94\xc4\x23\x66\x84\xb5\x15\x55\x97\x38\xee\x21\x32\x7b\x72\x58\x1b\xfe\x18\x55\x22\x02\x42\xf4\x20\x2d\xa3\x55\xd2\x2c\x33\xb2\x24\x18\x24\x82\xd6\x69\x66\x7b\x2f\x60\x19\x42\x31\x0c\x1d\x8f\x40\xae\x6e\x25\x48\x20\x2a\xcb\x4c\x80\x5c\x51\x3b\x71\xbc\x59\xda\x88\xc4\x88\x5b\xeb\x03\xcf\xc0\x44\x46\xb4\xbd\x05\xc7\xb7\xa4\xa1\x50\x87\x48\x0f\xc3\xd0\xd1\xba\x81\x76\x7c\x7a\xf5\xd8\x40\x3f\x46\x03\xe6\xb6\x5b\x5d\x7a\xfa\xa4\xde\x71\x49\xac\x65\x9e\xd9\xd6\xd2\x11\x0b\x60\x13\x15\x9b\x82\x46\x2c\xa9\xd9\xb0\xa2\x85\x46\xd1\xba\xaa\xd6\xfb\x0a\xc6\xa3\xbe\xad\x03\xb3\x22\xf8\xf2\xf9\xcd\x32\xc7\x02\x3d\x2c\xc0\xbf\xdd\xb5\x58\xf1\x2a\xd0\xcc\xf6\xe4\x4d\xe6\x05\x56\x83\x66\x7b\xb3\xfc\xfb\x9a\x83\x0b\x6b\xeb\xcf\x2c\x5f\xad\x33\x13\xcd\x48\x1c\x02\xd5\xd5\x9e\x8f\xb3\x4b\xb1\x00\x32\x6e\x34\x24\xc9\x22\x26\xeb\x0b\xfa\x0a\x35\x1a\xf5\xb9\x64\x35\x3a\x9c\xb4\xa8\x8c\x2f\x12\xf9\x25\xbb\xb8\xd7\xde\x94\xcb\x3a\x53\x04\x2c\xca\x0b\x18\xa9\x34\x28\x8d\xab\xa8\x15\x4b\xb9\x8a\xaf\x70\x87\x80\x65\x88\x37\x15\x95\x54\x21\x3b\x00\x70\xfc\x2e\x02\x5e\xf2\x1d\xc4\x84\xff\x46\x31\x1c\x24\x57\x1d\x05\x0b\xe9\x90\x7e\x10\xfe\x84\x3e\x98\x94\xdc\x79\x59\x98\x75\x6c\xf0\x9f\xde\x0b\x06\x10\x04\x81\x45\xc6\x4e\x91\x11\x39\x9c\x27\xe6\x25\xb0\xda\x71\x44\x03\x62\x51\xc5\x0d\x6b\xad\xb3\x75\xac\x69\x18\x19\x17\x00\xe0\x4e\xd9\xad\xe6\xec\x20\x61\xc2\xa0\x1d\x34\xaf\x2a\x84\x9e\x46\x22\x6b\x16\x59\x03\x6e\xe8\xbf\x69\x59\xd8\x89\x41\xf4\xeb\xed\xd2\x47\xe8\xbe\x64\x2f\x41\x69\xc2\x13\x9e\x51\xb8\x0b\x68\xdf\x48\x4d\x90\x26\xb4\x87\x1b\xe0\x9f\xb4\xc8\x7c\x19\xbe\xd5\xc7\xf1\x91\x4b\xa3\x11\xe8\x2a\xae\xfb\xdb\x6b\x96\x03\xe9\xb4\x75\x24\xc3\xdd\x24\xd9\xc7\x13\xf9\x30\x47\xcb\x0c\x21\xf8\x27\x99\x80\x34\xec\x46\xcc\xb3\x1c\xa1\xfa\xd6\xdd\x00\x7c\xa9\x22\x60\x2e\xb8\x9c\x09\x53\x84\xe6\x23\x59\x89\xd1\xe9\xa3\x42\x0d\xa9\x35\x0d\x7f\x4a\xcd\x36\xbc\x6f\xd0\xfd\x6f\xb2\x9f\xce\xb7\xa6\x04\xba\x7e\xaa\xe5\x9f\x53\xed\x99\x0f\x90\xd3\xd2\xc4\xb9\xe9\x4b\xf6\xef\x93\xe3\x23\x16\x47\x39\x98\x3d\xbb\x40\x4b\x98\x97\x60\x32\x90\x9e\x49\xb4\x76\xef\xb5\x47\x8e\x6e\x3f\xaf\xe7\xec\x8a\xd8\xaf\x30\xd6\xe8\x8c\x2a\x69\x04\x46\x02\x64\x39\xb2\x8d\xd2\x2e\xf2\xb6\x70\x74\x04\xeb\x83\x8f\xd8\x4a\xc3\x99\x24\x5c\x3e\xc2\xa3\x4f\x02\xea\xe3\x0a\xf8\xfc\x2e\x92\xdf\x16\x18\xc7\xc0\xb6\xb5\xcb\xee\x84\xe1\x48\xc6\x51\x86\xeb\x5c\xf8\x5d\x15\x7f\xf9\x4d\x1d\x65\x42\xdd\x33\xc8\x29\xe3\xeb\x65\x6d\x83\x3d\x37\x75\x81\x11\xc0\x01\x33\xc1\x58\x7b\x49\x9d\x88\x21\x36\x55\xb4\x11\xec\x7f\x0f\x0a\xb7\x1c\xae\x17\xfa\x6b\xad\x10\xfc\x0c\x31\x78\x93\x20\x3c\x14\x85\x49\x1d\x3c\xd4\x0e\xb7\x6a\xfd\x50\x9b\x9a\xcd\xfd\x48\xfb\x44\xa8\xed\xc5\xda\xde\x27\xa9\xb2\xd6\x1f\xa3\xc8\x1b\xa9\x34\x26\x12\xd2\xa5\x85\x69\xa3\xe8\x96\x48\x59\xf2\x18\xf2\xec\xb8\x91\x82\xa4\x20\x71\xc9\x73\x5e\xc1\x17\x86\x89\xec\x1e\x45\x01\xda\x72\x4f\x53\xb2\x2e\x31\xa1\x87\x29\x88\x97\x11\x54\x3a\x16\x56\x52\x81\x73\x82\xa0\x60\x55\x5e\x23\x7f\x8d\xba\x48\xfc\xc5\x2f\xdf\x2c\x3e\xa6\x34\xd5\xca\x64\x2b\x0d\x2c\xc1\x4b\x09\x2d\x6d\xeb\x27\xb3\x8b\x75\x72\xd9\xc5\x93\xa9\x2c\xf3\xbb\xa6\x04\xc9\x8a\xcd\x54\x1d\x41\x5b\x64\xe2\x48\x88\x56\x6b\x20\x9a\x92\x05\x87\xdf\x19\xaf\x46\x4e\xcb\x5f\x43\xd1\x25\xe6\x36\xa4\xeb\xb1\x76\x88\x6f\x11\xf5\x1b\x12\xe7\xd5\xc6\x3b\x9c\x49\x1b\x0f\x44\x30\x45\xd6\x63\xd8\xba\x19\xb6\xd2\xa6\xed\xc6\x1e\xb5\x71\xd4\x5b\xd0\xb6\x45\x94\xd5\x9c\x62\x58\xaa\xb5\x93\xec\xce\xea\x8d\x99\x45\xdd\x82\xd4\x03\x4b\x42\xad\x5b\x94\x9d\x98\x35\x8d\x7a\x86\x46\xbf\xac\x4f\x8d\xb4\x6a\x35\x24\xb7\x7c\xa8\xd1\xaf\x1f\x11\x81\xf1\xa3\xa3\x05\xca\x72\x1e\x5d\x73\xff\xec\x9c\x32\xa5\x14\x4a\xf7\x87\x8f\x13\x06\x7e\xa6\x55\x6e\xe8\x70\x85\xf9\xa1\xc0\x0d\x5a\x2d\x17\x26\x62\x2d\xce\xc4\x39\xc8\xb8\x59\x0d\xdf\x36\x56\xb5\x4c\xf5\x0f\x5d\x66\x34\xbe\xee\xd3\x56\x1c\x24\xe1\x67\x29\x3a\x46\x8d\xd5\x6c\xe4\x04\xb7\x9d\x99\x9e\x0a\x1b\x2e\xd7\x70\x05\xde\x1b\xae\x6e\x39\xcf\xbd\x47\x03\x2c\x2a\xa9\x59\xb8\x99\x81\xda\x6c\x5d\x13\x2f\x20\xd4\xe6\x71\x06\x79\xc6\x82\x53\xae\x0d\xa5\xc5\x40\xf8\x5d\x8a\xfc\xdf\x9e\xee\xfb\x51\x40\x1b\x86\xa6\x0f\x61\xfa\x22\x18\x0c\xd5\xd1\x84\x5d\xfc\xd5\xa3\xf5\xf4\xc2\x8a\xf8\x39\xa2\x76\x5b\xcd\x56\x6b\xd9\x4f\x70\x28\x91\x1f\x46\x52\x3d\xae\x68\xd1\x06\xea\x05\x49\xf0\x55\xa4\x74\xce\x27\x51\x32\x58\xa7\x92\x9b\xd5\xf0\x85\xf6\xc0\xa6\x7b\x98\x01\x6e\x96\xb0\xa4\x86\x80\x2f\x8a\x7c\x45\xe6\x37\xa8\x7a\x0a\x0c\x0a\x12\xf2\x5b\x28\x47\x21\x87\xf7\xb7\x93\x60\x58\xd9\x12\x46\x2b\xf7\x0c\x8a\xb5\x34\x6d\x03\x9c\xeb\x27\x49\x22\xb9\xd3\x0a\x76\x5a\x43\x91\x3e\xc3\xae\x04\x6f\xd2\x0b\x85\x83\x34\x0f\xeb\xf4\x92\x7e\x83\x4b\x2f\x01\x52\x85\x34\xc5\xf2\xbc\x2c\xa4\x00\x21\x5c\xf3\x7b\x5b\xa5\xd5\xb9\x80\xf2\x87\xe9\xa6\x87\x16\x56\x43\x86\x70\x6e\x0a\x91\x38\x4f\x65\x82\xaf\x40\x15\x73\xe4\x0f\x89\xb8\x99\xd4\xc1\x14\x18\x4e\x31\xb9\x43\x9d\x54\x55\x1d\x2b\x17\x83\x97\x3c\x64\x07\xb5\x71\xb7\x4b\xdc\x66\xcf\x9e\xfe\xb4\x12\x8a\x5e\xe8\x44\xf3\x59\x76\xd2\xe6\x80\xde\xcc\x78\xe5\x47\x9c\xf2\x50\x6d\x3d\x24\xac\x67\x15\xcc\x52\xb3\xe9\x22\x52\xf1\x15\xcb\x8a\xe2\xba\x2e\x29\x21\x42\x23\x53\x48\xb3\x4e\x78\x44\xd5\x23\x12\x6c\x14\x8a\x7c\xe0\x78\xd6\x6b\xb2\x74\xca\x2e\xc9\x74\x9a\xe6\x14\xe0\x4f\xd5\xfc\x24\x33\xf4\xb4\x71\x6e\x9e\x70\xd0\x91\xa7\x22\x7f\xe6\x26\x67\xeb\x7c\xe6\x64\xfb\xc9\x65\xcb\x77\xf4\x8b\x73\xae\x19\xfa\x8b\x23\x1e\xf2\xd1\x17\xf2\x49\xb5\x85\x55\x08\xf7\x31\x67\xcf\x9d\x30\x39\xac\x1c\xd2\x87\x3f\x95\xf4\xf1\xb8\x1e\x32\x75\x73\xd1\xe3\xf9\xa7\x57\xd1\xf3\xa4\x9a\x2e\x70\x3f\x22\xd0\xa3\xe2\x09\x91\xb6\xa3\x76\x3b\x26\xd3\xdf\x8f\x89\x15\xe3\x0b\x50\xaf\xee\xfb\xe1\x58\x37\x61\x0b\x6c\x1f\xfa\x40\x41\xcb\x6f\x3b\x00\x7e\x10\x40\x72\x57\x2b\x9d\x43\x12\x46\xd7\xc3\x9a\x9d\xb0\xa3\x1f\x0e\x0f\xc9\x19\x51\xab\xaa\xa8\xb8\xb8\xcc\xb7\xc1\xd7\x18\xaa\xa8\xc6\x07\x6e\xc2\x16\x91\xdb\x96\x2f\x53\xd1\x05\x38\x16\x13\xdf\x3a\x3a\x95\x17\x09\x97\xff\xd7\xc2\x48\xe6\xc5\xef\xa6\x87\x2f\x24\xa6\x91\x9f\xde\xb9\x68\x2e\x45\xa6\xed\x0c\xd5\x79\x22\x30\x7f\x93\xcc\xd7\xf7\x22\x4d\x59\x1e\x0c\x69\x00\x4e\x53\x68\xea\x8a\x99\xeb\x4c\xc2\x20\xfa\xab\xa9\xc9\x2d\x49\xea\xd3\x2b\xca\x76\xfb\x1e\xae\xc3\xe3\x2d\xdb\xdc\xa2\xce\x08\x0f\xdf\x7d\xf9\xae\xf9\x38\xe1\x59\xfa\x81\xa7\xfd\x36\xf3\x3a\xfa\xf6\x81\xc7\x75\x85\x15\xeb\xd3\x25\xf2\xe6\x9a\x57\x59\xe0\x19\xb9\x40\x36\xc7\xcc\xc9\x40\x23\x97\xa3\x11\x92\xcb\xaa\x78\x14\xa3\x9a\xe2\x78\xd3\x85\x5f\x52\x5a\x48\xb1\xd2\x22\xcb\x8a\x5b\x74\x84\x8f\x22\x2f\x72\xf8\x57\xb1\x39\x78\x41\xaa\x5c\xa4\x29\xd8\x21\xa9\x3c\xf9\xfe\x90\x19\xf9\xca\x89\xf6\xb0\x0a\x14\x4a\x66\xd4\x2d\x05\xc7\x1c\x35\xa4\x63\x2e\x37\xc7\x83\x92\xf3\xe4\x77\xf8\x5a\x44\x02\x29\xcc\xff\x69\x76\xfa\x1d\xfb\xb0\xff\xf6\x87\x0f\x27\xb3\x1f\xf7\x87\x8b\xa6\x15\xd6\xb3\x96\xdd\xfc\x01\xcb\xf5\x65\xd3\xf9\x75\xb6\x53\x35\x5a\xf7\xbb\xf5\xda\x07\x32\x40\x77\xc1\x03\xba\xf4\x83\xae\xff\x56\x5e\xdc\x2c\x99\xd4\x9b\xfb\x17\xf2\x6d\x51\xe7\x2b\x7a\x00\x45\x95\x60\x63\xbd\x7d\x39\x6d\xfa\xa3\x50\x78\x5d\x80\x1f\x86\xb8\xbc\x4a\x97\xe5\xc4\xd4\xfc\x39\x03\xa3\x89\xe1\x3c\xa8\xfd\x04\x11\x29\xc6\x31\xaa\x65\xab\x9a\x87\xad\x62\x05\x6f\xb7\x72\xb3\xac\x28\xd1\x7e\x4c\xf0\x6f\xa8\x73\x68\xb0\x2e\x11\xd8\x99\xed\xde\x7f\xd3\x9d\xf4\x53\x37\xe0\xc7\x88\x61\xe9\x06\xbc\x6d\x06\x48\x19\x95\xf8\x04\x6e\xb9\xb5\x80\x07\xb8\x28\x8a\x2c\x60\x74\x3f\xf9\xa8\x6e\xb7\x1a\xbe\x28\xdb\x4c\x1a\x6b\x18\x7a\x9f\xf0\xa6\x16\x19\x9e\xbe\xd3\xeb\x7e\xb0\x8f\x74\x56\xe3\x70\xa1\xa3\x55\x56\x0e\x1a\xcb\x3a\xb6\xe2\x14\x3b\xc5\x33\x63\x0d\x8a\x30\xe0\xef\x46\xc9\xfd\x01\xcb\x21\xb9\x69\xab\x89\xb5\x5a\x59\x12\x02\xfd\x58\xcb\x21\x76\xad\xea\xa5\x4f\xe3\x41\x88\xa9\x0b\xd6\xe2\x9c\xe1\xc2\x68\x84\xe6\x08\x7e\x97\x3a\xea\x8b\xd0\xc7\x42\xde\xcd\x6d\xc2\x80\x38\x92\x3a\xa7\x34\xab\x5a\xac\xdf\xe9\x1f\xdf\x5f\x04\x83\xc4\x8f\x12\x9e\x46\x60\x19\x76\x43\x19\xe5\x22\xf6\xd3\xb9\x0a\x4f\x34\x7f\x7c\xaf\xce\xaf\xf3\xe2\x36\xd7\x77\xb6\x98\xff\x12\x97\x76\xd8\x8b\x53\x6f\xc2\x16\x81\x81\xab\xc1\xb9\xc7\x58\x46\x47\xc6\xeb\x0a\xaa\x69\x5e\x6d\x2a\xa1\x01\x1d\x6c\x09\xab\x7b\xdc\xce\xd7\x63\x3e\x6b\x6b\x0e\x3c\xa1\x1b\x8c\x55\xda\x0a\xc6\xfc\xde\xfa\xd9\x03\xb4\x29\x7d\x82\x6e\xe9\x92\x56\xc5\xbc\x15\x53\x4d\x4b\x44\xc3\xfe\xf8\xb1\xe4\xd5\xb6\x39\x1a\x73\xe8\x51\x6f\xd0\x6d\xf4\xd6\x4a\xb7\x20\xa4\xb7\x80\x0a\xaf\x10\x8b\x5b\x40\x97\x90\x63\x8a\x6b\x50\x82\x39\xeb\x76\x65\x56\x68\x4f\xab\x33\x33\xed\xb4\x94\xac\x1e\xcd\xb0\x68\x5a\xee\xce\xc0\xdc\x1c\x26\x24\x46\xeb\xa5\x72\xe6\x42\xeb\x9e\x34\x0f\x0f\x3b\xbc\xf1\x37\x25\x6b\xd2\xe2\x47\x8f\x11\x56\xa1\x5b\x74\x19\x0c\x03\x66\x10\xac\x75\xdd\x66\xaf\x3a\x2c\x8a\xa7\xf2\xe7\x26\xd2\xf7\x0e\xf6\xf3\xfa\x47\xea\x9e\x21\x18\xf7\x8c\xe6\x89\x07\x25\x41\x47\x75\xf5\xf3\xd0\x03\x7a\x8f\xf5\x2e\x2a\x07\x35\x91\xf2\x3d\x88\x57\x28\x26\xd5\xd7\x4b\xfd\x94\x0b\x52\xc5\x12\x92\xe0\xf0\x32\xc4\x10\xb6\xfb\x7e\x66\xc6\x03\xd2\x38\xbc\x73\xa1\x62\xd7\xd4\xb2\xb8\x18\x4b\xde\xe6\xcd\x86\xb9\x9e\xc4\xf0\x47\x81\x0f\x22\x76\x51\xe2\x6d\x38\xb8\x0c\x59\xa7\xa9\xb8\x33\xd0\x3d\xba\x2b\x83\x51\xfc\x23\xbc\x54\x5e\x30\x41\x0c\x98\x83\x23\xe4\xd6\xbd\x26\x7e\x12\x8c\x3c\x81\x30\xe9\xae\x2a\x2d\x58\x89\xc6\x3f\xc1\xec\x41\xe4\x01\xe6\x89\x68\x49\x11\x93\xf8\x00\xd8\xd2\xa9\xe9\x43\xdf\xe5\x90\xe4\xe6\x12\xb7\x0d\x46\xc2\x20\x41\x82\xff\x5b\xa0\x30\x40\x72\x38\x11\xd1\x84\x14\xd8\x0b\x59\x5d\xc7\x21\x58\x69\x4e\x45\xb4\xd6\xaa\xac\x15\xe2\x81\x25\xff\x91\x45\x0e\x29\xcd\x3c\xaa\xe4\x55\x04\x40\xbb\x17\xb5\xc6\x86\x1a\xf2\x4c\xa3\x41\x37\x33\xe9\x91\x0b\xcf\xe3\x02\x8d\x3b\x24\xe6\xb7\x5e\x1a\x98\x8c\x02\xd0\x90\x44\xe8\xe1\x41\x55\xd4\x65\xfb\x2d\xcd\xee\xd1\x9e\x3b\xa0\xb1\x49\xa7\x21\xfe\x1c\xc5\x77\x26\xa9\x25\x7d\xde\x09\x51\xfe\xb0\xca\x4d\xf4\xcb\x35\x0a\x53\x84\xb3\xb9\x0c\xd6\x50\x26\xec\x95\xbe\x0a\x9e\x63\x40\xc0\x28\x71\xdd\xdc\xff\xce\x29\xba\xd1\x3e\xfb\xac\xc1\xc7\xaf\x09\xbb\xd6\x55\x98\x2c\x2a\x65\x3a\xe4\x92\x66\x60\xb8\x75\xde\x06\xd9\x2a\xea\x0c\x72\xda\x6a\xf0\x43\xbe\xd9\x22\x81\x90\x23\x15\xa5\x7b\x71\xa5\xb5\xdb\xbf\x9e\xb0\xf9\xd9\xf5\x39\x86\xb1\x81\x57\x56\xc6\x10\x49\x3b\x60\x4e\x87\x8d\x36\x6d\xee\x40\xcd\xd8\x84\x95\xfa\x58\x66\xf3\x6e\x67\x16\x5f\x1e\x4e\x10\xa0\xb1\x5b\x63\x7c\xd6\x68\xd5\x55\xa7\xda\x4b\x97\xcd\x14\xdb\xdb\xa8\xc6\x5a\x27\xb5\x68\xed\x59\x60\xca\xca\x83\xa6\xd9\xa6\xc2\x45\x43\x9e\x30\xfd\x84\x04\xa0\x4d\x98\xc7\x6f\xbc\x31\x72\x86\xa2\xa1\x06\x2e\x43\x6a\x3d\xbf\xb9\x57\xdc\xa7\x45\x9f\x87\x9f\x07\xdf\xc0\x8a\x7f\xb1\x57\xc4\x36\x07\x85\x80\x9c\xed\x88\xf3\x89\xdb\x7a\x5a\x1c\x16\xb7\x9a\xd6\x33\xf1\xf7\x2f\x76\xce\x8d\x0a\xe8\xa4\x88\x1e\x7f\x01\x08\x97\xdb\xf4\x1e\xaa\x61\x9e\x62\x96\x02\xf8\x81\x7b\x9a\x81\xe7\xdc\xc3\xb7\xa0\xc0\x36\xaf\x49\x31\xba\x4f\xb3\x83\xd6\xe5\x3e\xf6\x03\xbc\xe6\x85\x74\x73\x91\xe3\xf2\x8b\xe6\xcd\x3e\xfd\x36\x60\xaa\x85\xe1\xb9\x24\xa4\x93\x75\x0c\xa5\x20\x8f\x5e\xc4\xd3\x8a\x81\xf7\x48\x2e\x6c\xd9\x87\x3e\xad\x95\x9b\x3c\x1e\x32\xdb\x9e\xf9\xb9\x4e\x2b\x5b\x4d\xfb\xf2\xec\x4b\x74\x85\x4c\x5b\x07\x7d\x52\xa2\xcb\x17\xc6\x8f\x88\xb6\xb9\x49\x5b\x57\xa2\x4f\xd5\x00\x7d\x41\x77\x6f\xe8\x3a\x4e\x05\x53\x6d\x7a\xc4\x09\x99\x76\xaf\x9c\xdb\x01\xeb\x05\x2b\x16\x89\xb5\xfc\x17\x37\xfa\xcc\xda\xb4\xd1\x37\x20\xe5\x94\x87\x93\x19\xd2\x78\x60\xfc\x8a\x09\x35\xf6\x7f\x4c\x07\x0c\x18\xbd\xdd\x66\x04\x58\x7b\x2f\xc5\x4a\x9b\x07\xb4\xd7\xde\x42\x6d\x9b\x70\xfc\x09\x0e\x3e\x33\x42\x14\xdd\x08\x07\x2e\x58\x5c\x03\x90\xac\x88\xd4\x3f\xff\x61\x4a\x6c\x70\x86\x1f\x0e\xde\xb2\xaf\xbe\xfa\xea\x6b\xea\x08\x59\x37\x80\xe1\xbf\xf3\x90\x48\x47\x6d\x19\xd8\xc0\x88\x54\x98\x40\xba\x98\x60\xd9\x6e\xa3\x21\x13\x0a\xd7\x50\x84\x44\x37\x48\x24\x99\x71\x80\x57\x40\x49\x85\xe9\x71\xc5\xf1\xb5\x9d\x34\x4f\xf0\x0c\x17\xa8\x89\x24\xeb\x38\xe6\x3c\xc1\xdf\x16\x91\xef\x34\x0c\xf2\xe9\xa8\xc0\xc4\xae\xcf\xc4\x0c\x00\xb5\xf2\xa2\x4e\x5d\xe4\xa0\x73\xbf\xd3\xa7\xf6\x0d\xd3\xad\x50\x71\xc9\x6b\x1d\x40\x3e\xfb\xac\xc7\x21\x9f\x80\x2c\x02\xb3\xc0\x08\x0a\xc2\x83\x0e\xdf\xf8\xbb\x26\x49\xc2\x68\xc5\x97\xc1\x80\xce\xcc\x03\x0c\x9c\x98\x9b\x63\x74\xa3\xcc\xaf\xeb\x7f\xfd\x19\x7e\xfd\x03\x32\x5f\xeb\xe7\x3f\xe1\xaa\x9f\xff\x00\xcb\x8f\xab\x75\x38\x7e\xfc\xe1\x51\x86\x1f\x57\x7f\x09\x7e\x17\xd5\x6f\x66\xf7\x11\xb8\x98\x76\x57\x9b\x5c\x8e\xe5\xac\x69\x68\xeb\x14\xa7\xe1\x84\xe6\x31\xde\xd6\x95\xec\x7f\x93\xb1\xc0\x85\xdf\xc6\xd9\x4e\xcb\x62\xfa\x12\xbc\x35\xc5\x58\xfa\xfd\x4d\xc3\x5f\xf3\x5a\xc9\xf8\x7e\xdb\x5e\xd0\xaf\x41\xfb\x3f\x6b\x74\x81\x8e\xe6\xb6\x5d\xe4\x0d\x4f\x62\x80\x17\x1e\x97\x26\xfa\xdb\xc6\xac\x9d\x38\xd0\x4d\x45\x93\xb6\x61\x1c\xcb\x30\xc7\x6b\x3f\xc3\xf5\x76\x3a\x29\x4b\xeb\x25\xad\xf9\x7d\x03\x78\xf9\x82\x12\x76\x9d\xd7\xfa\xd4\x9b\xfc\x06\xc7\x74\x4e\x80\x4b\x6c\x97\xac\xe9\x16\x35\xb7\xa6\x3a\xa7\x6e\x5a\x51\x66\x0d\x6a\x50\x67\x5d\xb3\xd0\x45\x69\x2c\xbb\x97\x5f\xf0\x5a\xba\x64\x8f\xb0\xb3\x73\x52\x26\x7d\x72\xfb\x06\x90\x08\xfd\xe5\x97\x7e\x30\xf9\x6c\x21\x6d\x6b\x6f\x80\xe6\x45\xab\x18\xe8\xd3\xb3\x92\x2f\x9b\x20\x7f\x0c\xf7\x00\x5e\xdb\x09\x73\x7f\xfe\x17\x00\xdd\xf6\xa4\xbb\x3b\x00\x00")
 
 func templateWhereTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -974,7 +1247,7 @@ func templateWhereTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/where.tmpl", size: 5353, mode: os.FileMode(420), modTime: time.Unix(1570012859, 0)}
+	info := bindataFileInfo{name: "template/where.tmpl", size: 15291, mode: os.FileMode(420), modTime: time.Unix(1792024429, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1031,12 +1304,16 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"template/audit.tmpl":                     templateAuditTmpl,
 	"template/base.tmpl":                      templateBaseTmpl,
 	"template/builder/create.tmpl":            templateBuilderCreateTmpl,
 	"template/builder/delete.tmpl":            templateBuilderDeleteTmpl,
+	"template/builder/getorcreate.tmpl":       templateBuilderGetorcreateTmpl,
+	"template/builder/mutation.tmpl":          templateBuilderMutationTmpl,
 	"template/builder/query.tmpl":             templateBuilderQueryTmpl,
 	"template/builder/setter.tmpl":            templateBuilderSetterTmpl,
 	"template/builder/update.tmpl":            templateBuilderUpdateTmpl,
+	"template/builder/upsert.tmpl":            templateBuilderUpsertTmpl,
 	"template/client.tmpl":                    templateClientTmpl,
 	"template/config.tmpl":                    templateConfigTmpl,
 	"template/context.tmpl":                   templateContextTmpl,
@@ -1051,7 +1328,9 @@ var _bindata = map[string]func() (*asset, error){
 	"template/dialect/gremlin/predicate.tmpl": templateDialectGremlinPredicateTmpl,
 	"template/dialect/gremlin/query.tmpl":     templateDialectGremlinQueryTmpl,
 	"template/dialect/gremlin/select.tmpl":    templateDialectGremlinSelectTmpl,
+	"template/dialect/gremlin/touch.tmpl":     templateDialectGremlinTouchTmpl,
 	"template/dialect/gremlin/update.tmpl":    templateDialectGremlinUpdateTmpl,
+	"template/dialect/gremlin/upsert.tmpl":    templateDialectGremlinUpsertTmpl,
 	"template/dialect/sql/by.tmpl":            templateDialectSqlByTmpl,
 	"template/dialect/sql/create.tmpl":        templateDialectSqlCreateTmpl,
 	"template/dialect/sql/decode.tmpl":        templateDialectSqlDecodeTmpl,
@@ -1063,16 +1342,23 @@ var _bindata = map[string]func() (*asset, error){
 	"template/dialect/sql/predicate.tmpl":     templateDialectSqlPredicateTmpl,
 	"template/dialect/sql/query.tmpl":         templateDialectSqlQueryTmpl,
 	"template/dialect/sql/select.tmpl":        templateDialectSqlSelectTmpl,
+	"template/dialect/sql/touch.tmpl":         templateDialectSqlTouchTmpl,
 	"template/dialect/sql/update.tmpl":        templateDialectSqlUpdateTmpl,
+	"template/dialect/sql/upsert.tmpl":        templateDialectSqlUpsertTmpl,
 	"template/ent.tmpl":                       templateEntTmpl,
+	"template/enttest.tmpl":                   templateEnttestTmpl,
 	"template/example.tmpl":                   templateExampleTmpl,
+	"template/fixture.tmpl":                   templateFixtureTmpl,
 	"template/header.tmpl":                    templateHeaderTmpl,
 	"template/import.tmpl":                    templateImportTmpl,
 	"template/meta.tmpl":                      templateMetaTmpl,
 	"template/migrate/migrate.tmpl":           templateMigrateMigrateTmpl,
 	"template/migrate/schema.tmpl":            templateMigrateSchemaTmpl,
 	"template/predicate.tmpl":                 templatePredicateTmpl,
+	"template/rest.tmpl":                      templateRestTmpl,
+	"template/shadow.tmpl":                    templateShadowTmpl,
 	"template/tx.tmpl":                        templateTxTmpl,
+	"template/watch.tmpl":                     templateWatchTmpl,
 	"template/where.tmpl":                     templateWhereTmpl,
 }
 
@@ -1118,13 +1404,17 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"template": &bintree{nil, map[string]*bintree{
-		"base.tmpl": &bintree{templateBaseTmpl, map[string]*bintree{}},
+		"audit.tmpl": &bintree{templateAuditTmpl, map[string]*bintree{}},
+		"base.tmpl":  &bintree{templateBaseTmpl, map[string]*bintree{}},
 		"builder": &bintree{nil, map[string]*bintree{
-			"create.tmpl": &bintree{templateBuilderCreateTmpl, map[string]*bintree{}},
-			"delete.tmpl": &bintree{templateBuilderDeleteTmpl, map[string]*bintree{}},
-			"query.tmpl":  &bintree{templateBuilderQueryTmpl, map[string]*bintree{}},
-			"setter.tmpl": &bintree{templateBuilderSetterTmpl, map[string]*bintree{}},
-			"update.tmpl": &bintree{templateBuilderUpdateTmpl, map[string]*bintree{}},
+			"create.tmpl":      &bintree{templateBuilderCreateTmpl, map[string]*bintree{}},
+			"delete.tmpl":      &bintree{templateBuilderDeleteTmpl, map[string]*bintree{}},
+			"getorcreate.tmpl": &bintree{templateBuilderGetorcreateTmpl, map[string]*bintree{}},
+			"mutation.tmpl":    &bintree{templateBuilderMutationTmpl, map[string]*bintree{}},
+			"query.tmpl":       &bintree{templateBuilderQueryTmpl, map[string]*bintree{}},
+			"setter.tmpl":      &bintree{templateBuilderSetterTmpl, map[string]*bintree{}},
+			"update.tmpl":      &bintree{templateBuilderUpdateTmpl, map[string]*bintree{}},
+			"upsert.tmpl":      &bintree{templateBuilderUpsertTmpl, map[string]*bintree{}},
 		}},
 		"client.tmpl":  &bintree{templateClientTmpl, map[string]*bintree{}},
 		"config.tmpl":  &bintree{templateConfigTmpl, map[string]*bintree{}},
@@ -1142,7 +1432,9 @@ var _bintree = &bintree{nil, map[string]*bintree{
 				"predicate.tmpl": &bintree{templateDialectGremlinPredicateTmpl, map[string]*bintree{}},
 				"query.tmpl":     &bintree{templateDialectGremlinQueryTmpl, map[string]*bintree{}},
 				"select.tmpl":    &bintree{templateDialectGremlinSelectTmpl, map[string]*bintree{}},
+				"touch.tmpl":     &bintree{templateDialectGremlinTouchTmpl, map[string]*bintree{}},
 				"update.tmpl":    &bintree{templateDialectGremlinUpdateTmpl, map[string]*bintree{}},
+				"upsert.tmpl":    &bintree{templateDialectGremlinUpsertTmpl, map[string]*bintree{}},
 			}},
 			"sql": &bintree{nil, map[string]*bintree{
 				"by.tmpl":        &bintree{templateDialectSqlByTmpl, map[string]*bintree{}},
@@ -1156,11 +1448,15 @@ var _bintree = &bintree{nil, map[string]*bintree{
 				"predicate.tmpl": &bintree{templateDialectSqlPredicateTmpl, map[string]*bintree{}},
 				"query.tmpl":     &bintree{templateDialectSqlQueryTmpl, map[string]*bintree{}},
 				"select.tmpl":    &bintree{templateDialectSqlSelectTmpl, map[string]*bintree{}},
+				"touch.tmpl":     &bintree{templateDialectSqlTouchTmpl, map[string]*bintree{}},
 				"update.tmpl":    &bintree{templateDialectSqlUpdateTmpl, map[string]*bintree{}},
+				"upsert.tmpl":    &bintree{templateDialectSqlUpsertTmpl, map[string]*bintree{}},
 			}},
 		}},
 		"ent.tmpl":     &bintree{templateEntTmpl, map[string]*bintree{}},
+		"enttest.tmpl": &bintree{templateEnttestTmpl, map[string]*bintree{}},
 		"example.tmpl": &bintree{templateExampleTmpl, map[string]*bintree{}},
+		"fixture.tmpl": &bintree{templateFixtureTmpl, map[string]*bintree{}},
 		"header.tmpl":  &bintree{templateHeaderTmpl, map[string]*bintree{}},
 		"import.tmpl":  &bintree{templateImportTmpl, map[string]*bintree{}},
 		"meta.tmpl":    &bintree{templateMetaTmpl, map[string]*bintree{}},
@@ -1169,7 +1465,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"schema.tmpl":  &bintree{templateMigrateSchemaTmpl, map[string]*bintree{}},
 		}},
 		"predicate.tmpl": &bintree{templatePredicateTmpl, map[string]*bintree{}},
+		"rest.tmpl":      &bintree{templateRestTmpl, map[string]*bintree{}},
+		"shadow.tmpl":    &bintree{templateShadowTmpl, map[string]*bintree{}},
 		"tx.tmpl":        &bintree{templateTxTmpl, map[string]*bintree{}},
+		"watch.tmpl":     &bintree{templateWatchTmpl, map[string]*bintree{}},
 		"where.tmpl":     &bintree{templateWhereTmpl, map[string]*bintree{}},
 	}},
 }}
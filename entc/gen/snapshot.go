@@ -0,0 +1,201 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"encoding/json"
+)
+
+type (
+	// Snapshot is a structured description of a resolved graph. Unlike Describe, that writes
+	// a textual description for humans, it gives programmatic access to the types of the graph,
+	// their relations and their SQL tables, and it can be encoded as JSON for external tools
+	// (e.g. ERD and data dictionary generators).
+	Snapshot struct {
+		// Package is the package path of the generated code.
+		Package string `json:"package,omitempty"`
+		// Nodes holds the types of the graph.
+		Nodes []*NodeSnapshot `json:"nodes"`
+		// Tables holds the SQL tables of the graph, including the join tables
+		// of M2M relations. It's empty if the graph does not support SQL.
+		Tables []*TableSnapshot `json:"tables,omitempty"`
+	}
+
+	// NodeSnapshot describes a type of the graph.
+	NodeSnapshot struct {
+		Name          string           `json:"name"`
+		Table         string           `json:"table,omitempty"`
+		Label         string           `json:"label,omitempty"`
+		ReadOnly      bool             `json:"read_only,omitempty"`
+		SkipMigration bool             `json:"skip_migration,omitempty"`
+		ID            *FieldSnapshot   `json:"id"`
+		Fields        []*FieldSnapshot `json:"fields,omitempty"`
+		Edges         []*EdgeSnapshot  `json:"edges,omitempty"`
+		Indexes       []*IndexSnapshot `json:"indexes,omitempty"`
+	}
+
+	// FieldSnapshot describes a field of a type.
+	FieldSnapshot struct {
+		Name      string   `json:"name"`
+		Type      string   `json:"type"`
+		Column    string   `json:"column,omitempty"`
+		Enums     []string `json:"enums,omitempty"`
+		Unique    bool     `json:"unique,omitempty"`
+		Optional  bool     `json:"optional,omitempty"`
+		Nillable  bool     `json:"nillable,omitempty"`
+		Default   bool     `json:"default,omitempty"`
+		Immutable bool     `json:"immutable,omitempty"`
+		Sensitive bool     `json:"sensitive,omitempty"`
+	}
+
+	// EdgeSnapshot describes an edge of a type, and its relation.
+	EdgeSnapshot struct {
+		Name     string `json:"name"`
+		Type     string `json:"type"`
+		Inverse  bool   `json:"inverse,omitempty"`
+		Ref      string `json:"ref,omitempty"`
+		Relation string `json:"relation"`
+		Unique   bool   `json:"unique,omitempty"`
+		Optional bool   `json:"optional,omitempty"`
+		// Table and Columns hold the SQL table that stores the relation and its columns.
+		// For O2O, O2M and M2O relations, it's the table that holds the foreign-key, and
+		// for M2M relations, it's the join table.
+		Table   string   `json:"table,omitempty"`
+		Columns []string `json:"columns,omitempty"`
+	}
+
+	// IndexSnapshot describes an index of a table.
+	IndexSnapshot struct {
+		Name    string   `json:"name"`
+		Unique  bool     `json:"unique,omitempty"`
+		Columns []string `json:"columns"`
+	}
+
+	// TableSnapshot describes a SQL table of the graph.
+	TableSnapshot struct {
+		Name        string                `json:"name"`
+		Columns     []*ColumnSnapshot     `json:"columns"`
+		PrimaryKey  []string              `json:"primary_key"`
+		ForeignKeys []*ForeignKeySnapshot `json:"foreign_keys,omitempty"`
+		Indexes     []*IndexSnapshot      `json:"indexes,omitempty"`
+	}
+
+	// ColumnSnapshot describes a column of a SQL table.
+	ColumnSnapshot struct {
+		Name     string `json:"name"`
+		Type     string `json:"type"`
+		Size     int64  `json:"size,omitempty"`
+		Unique   bool   `json:"unique,omitempty"`
+		Nullable bool   `json:"nullable,omitempty"`
+	}
+
+	// ForeignKeySnapshot describes a foreign-key of a SQL table.
+	ForeignKeySnapshot struct {
+		Symbol     string   `json:"symbol"`
+		Columns    []string `json:"columns"`
+		RefTable   string   `json:"ref_table"`
+		RefColumns []string `json:"ref_columns"`
+		OnDelete   string   `json:"on_delete,omitempty"`
+	}
+)
+
+// Snapshot returns a structured description of the graph, its types and their tables.
+//
+//	graph, err := gen.NewGraph(cfg, schemas...)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	json.NewEncoder(os.Stdout).Encode(graph.Snapshot())
+//
+func (g *Graph) Snapshot() *Snapshot {
+	s := &Snapshot{Package: g.Config.Package, Nodes: make([]*NodeSnapshot, 0, len(g.Nodes))}
+	for _, n := range g.Nodes {
+		s.Nodes = append(s.Nodes, n.Snapshot())
+	}
+	if !g.migrateSupport() {
+		return s
+	}
+	for _, t := range g.Tables() {
+		ts := &TableSnapshot{Name: t.Name, PrimaryKey: make([]string, 0, len(t.PrimaryKey))}
+		for _, c := range t.Columns {
+			ts.Columns = append(ts.Columns, &ColumnSnapshot{Name: c.Name, Type: c.Type.String(), Size: c.Size, Unique: c.Unique, Nullable: c.Nullable})
+		}
+		for _, c := range t.PrimaryKey {
+			ts.PrimaryKey = append(ts.PrimaryKey, c.Name)
+		}
+		for _, fk := range t.ForeignKeys {
+			fs := &ForeignKeySnapshot{Symbol: fk.Symbol, RefTable: fk.RefTable.Name, OnDelete: string(fk.OnDelete)}
+			for _, c := range fk.Columns {
+				fs.Columns = append(fs.Columns, c.Name)
+			}
+			for _, c := range fk.RefColumns {
+				fs.RefColumns = append(fs.RefColumns, c.Name)
+			}
+			ts.ForeignKeys = append(ts.ForeignKeys, fs)
+		}
+		for _, idx := range t.Indexes {
+			is := &IndexSnapshot{Name: idx.Name, Unique: idx.Unique}
+			for _, c := range idx.Columns {
+				is.Columns = append(is.Columns, c.Name)
+			}
+			ts.Indexes = append(ts.Indexes, is)
+		}
+		s.Tables = append(s.Tables, ts)
+	}
+	return s
+}
+
+// Snapshot returns a structured description of the type, its fields, edges and indexes.
+func (t Type) Snapshot() *NodeSnapshot {
+	n := &NodeSnapshot{
+		Name:          t.Name,
+		Table:         t.Table(),
+		Label:         t.Label(),
+		ReadOnly:      t.ReadOnly(),
+		SkipMigration: t.SkipMigration(),
+		ID:            t.ID.snapshot(),
+	}
+	for _, f := range t.Fields {
+		n.Fields = append(n.Fields, f.snapshot())
+	}
+	for _, e := range t.Edges {
+		n.Edges = append(n.Edges, &EdgeSnapshot{
+			Name:     e.Name,
+			Type:     e.Type.Name,
+			Inverse:  e.IsInverse(),
+			Ref:      e.Inverse,
+			Relation: e.Rel.Type.String(),
+			Unique:   e.Unique,
+			Optional: e.Optional,
+			Table:    e.Rel.Table,
+			Columns:  e.Rel.Columns,
+		})
+	}
+	for _, idx := range t.Indexes {
+		n.Indexes = append(n.Indexes, &IndexSnapshot{Name: idx.Name, Unique: idx.Unique, Columns: idx.Columns})
+	}
+	return n
+}
+
+// MarshalJSON implements the json.Marshaler interface. The type is encoded as its Snapshot.
+func (t Type) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Snapshot())
+}
+
+// snapshot returns the snapshot of the field.
+func (f Field) snapshot() *FieldSnapshot {
+	return &FieldSnapshot{
+		Name:      f.Name,
+		Type:      f.Type.String(),
+		Column:    f.StorageKey(),
+		Enums:     f.Enums(),
+		Unique:    f.Unique,
+		Optional:  f.Optional,
+		Nillable:  f.Nillable,
+		Default:   f.Default,
+		Immutable: f.Immutable,
+		Sensitive: f.Sensitive(),
+	}
+}
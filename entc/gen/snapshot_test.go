@@ -0,0 +1,85 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/facebookincubator/ent/entc/load"
+	"github.com/facebookincubator/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraph_Snapshot(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+			{Name: "password", Info: &field.TypeInfo{Type: field.TypeString}, Sensitive: true},
+		},
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet"},
+			{Name: "groups", Type: "Group"},
+		},
+	}
+	pet := &load.Schema{
+		Name: "Pet",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", RefName: "pets", Unique: true, Inverse: true},
+		},
+	}
+	group := &load.Schema{
+		Name: "Group",
+		Edges: []*load.Edge{
+			{Name: "users", Type: "User", RefName: "groups", Inverse: true},
+		},
+	}
+	graph, err := NewGraph(Config{Package: "entc/gen", Storage: drivers, IDType: &field.TypeInfo{Type: field.TypeInt}}, user, pet, group)
+	require.NoError(err)
+	s := graph.Snapshot()
+	require.Equal("entc/gen", s.Package)
+	require.Len(s.Nodes, 3)
+
+	u := s.Nodes[0]
+	require.Equal("User", u.Name)
+	require.Equal("users", u.Table)
+	require.Equal("int", u.ID.Type)
+	require.Equal(&FieldSnapshot{Name: "name", Type: "string", Column: "name", Unique: true}, u.Fields[0])
+	require.True(u.Fields[1].Sensitive)
+	require.Equal(&EdgeSnapshot{Name: "pets", Type: "Pet", Relation: "O2M", Optional: true, Table: "pets", Columns: []string{"owner_id"}}, u.Edges[0])
+	require.Equal("M2M", u.Edges[1].Relation)
+	require.Equal("user_groups", u.Edges[1].Table)
+	owner := s.Nodes[1].Edges[0]
+	require.True(owner.Inverse)
+	require.Equal("pets", owner.Ref)
+	require.Equal("M2O", owner.Relation)
+
+	tables := make(map[string]*TableSnapshot)
+	for _, t := range s.Tables {
+		tables[t.Name] = t
+	}
+	require.Len(tables, 4)
+	require.Equal([]string{"id"}, tables["pets"].PrimaryKey)
+	require.Len(tables["pets"].ForeignKeys, 1)
+	fk := tables["pets"].ForeignKeys[0]
+	require.Equal([]string{"owner_id"}, fk.Columns)
+	require.Equal("users", fk.RefTable)
+	require.Equal([]string{"id"}, fk.RefColumns)
+	require.Equal([]string{"user_id", "group_id"}, tables["user_groups"].PrimaryKey)
+	require.Len(tables["user_groups"].ForeignKeys, 2)
+
+	buf, err := json.Marshal(graph.Nodes[1])
+	require.NoError(err)
+	require.JSONEq(`{
+		"name": "Pet",
+		"table": "pets",
+		"label": "pet",
+		"id": {"name": "id", "type": "int", "column": "id"},
+		"edges": [{"name": "owner", "type": "User", "inverse": true, "ref": "pets", "relation": "M2O", "unique": true, "optional": true, "table": "pets", "columns": ["owner_id"]}]
+	}`, string(buf))
+}
@@ -53,7 +53,7 @@ var drivers = []*Storage{
 		},
 		SchemaMode: Unique | Cascade | Migrate,
 		Ops: func(f *Field) []Op {
-			if !f.IsString() {
+			if !f.IsString() || f.HasGoType() {
 				return nil
 			}
 			return []Op{EqualFold, ContainsFold}
@@ -74,7 +74,13 @@ var drivers = []*Storage{
 			"github.com/facebookincubator/ent/dialect/gremlin/encoding/graphson",
 		},
 		SchemaMode: Unique,
-		OpCode:     opCodes(gremlinCode[:]),
+		Ops: func(f *Field) []Op {
+			if !f.IsString() || f.HasGoType() {
+				return nil
+			}
+			return []Op{EqualFold, ContainsFold}
+		},
+		OpCode: opCodes(gremlinCode[:]),
 	},
 }
 
@@ -93,6 +99,33 @@ func NewStorage(s string) (*Storage, error) {
 // String implements the fmt.Stringer interface for template usage.
 func (s *Storage) String() string { return s.Name }
 
+// storageOps returns the storage specific operations of the field
+// that are supported by all the given storage drivers.
+func storageOps(storage []*Storage, f *Field) []Op {
+	var ops []Op
+	for i, s := range storage {
+		if s.Ops == nil {
+			return nil
+		}
+		if i == 0 {
+			ops = s.Ops(f)
+			continue
+		}
+		supported := make(map[Op]bool)
+		for _, op := range s.Ops(f) {
+			supported[op] = true
+		}
+		common := ops[:0:0]
+		for _, op := range ops {
+			if supported[op] {
+				common = append(common, op)
+			}
+		}
+		ops = common
+	}
+	return ops
+}
+
 var (
 	// exceptional operation names in sql.
 	sqlCode = [...]string{
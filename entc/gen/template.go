@@ -24,6 +24,7 @@ type (
 	TypeTemplate struct {
 		Name   string             // template name.
 		Format func(*Type) string // file name format.
+		Skip   func(*Type) bool   // skip condition.
 	}
 	// GraphTemplate specifies a template that is executed with
 	// the Graph object.
@@ -40,14 +41,22 @@ var (
 		{
 			Name:   "create",
 			Format: pkgf("%s_create.go"),
+			Skip:   (*Type).ReadOnly,
 		},
 		{
 			Name:   "update",
 			Format: pkgf("%s_update.go"),
+			Skip:   (*Type).ReadOnly,
+		},
+		{
+			Name:   "mutation",
+			Format: pkgf("%s_mutation.go"),
+			Skip:   (*Type).ReadOnly,
 		},
 		{
 			Name:   "delete",
 			Format: pkgf("%s_delete.go"),
+			Skip:   (*Type).ReadOnly,
 		},
 		{
 			Name:   "query",
@@ -100,10 +109,55 @@ var (
 			Format: "migrate/schema.go",
 			Skip:   func(g *Graph) bool { return !g.migrateSupport() },
 		},
+		{
+			Name:   "enttest",
+			Format: "enttest/enttest.go",
+			Skip:   func(g *Graph) bool { return !g.migrateSupport() },
+		},
 		{
 			Name:   "predicate",
 			Format: "predicate/predicate.go",
 		},
+		{
+			Name:   "audit",
+			Format: "audit.go",
+			Skip: func(g *Graph) bool {
+				enabled, _ := g.FeatureEnabled(FeatureAudit.Name)
+				return !enabled || !g.migrateSupport()
+			},
+		},
+		{
+			Name:   "shadow",
+			Format: "shadow.go",
+			Skip: func(g *Graph) bool {
+				enabled, _ := g.FeatureEnabled(FeatureDualWrite.Name)
+				return !enabled
+			},
+		},
+		{
+			Name:   "rest",
+			Format: "rest/rest.go",
+			Skip: func(g *Graph) bool {
+				enabled, _ := g.FeatureEnabled(FeatureREST.Name)
+				return !enabled
+			},
+		},
+		{
+			Name:   "watch",
+			Format: "watch.go",
+			Skip: func(g *Graph) bool {
+				enabled, _ := g.FeatureEnabled(FeatureWatch.Name)
+				return !enabled
+			},
+		},
+		{
+			Name:   "fixture",
+			Format: "fixture.go",
+			Skip: func(g *Graph) bool {
+				enabled, _ := g.FeatureEnabled(FeatureFixture.Name)
+				return !enabled
+			},
+		},
 		{
 			Name:   "example",
 			Format: "example_test.go",
@@ -14,8 +14,10 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql/schema"
 	"github.com/facebookincubator/ent/entc/load"
+	"github.com/facebookincubator/ent/schema/edge"
 	"github.com/facebookincubator/ent/schema/field"
 
 	"github.com/olekukonko/tablewriter"
@@ -43,6 +45,51 @@ type (
 		// StructFields are additional struct fields to be added to
 		// the generated entity.
 		StructFields []*load.StructField
+		// Order holds the default order of the type queries, as
+		// configured in its schema. It's empty if it wasn't set.
+		Order []*OrderField
+		// Scopes holds the named scopes of the type queries, as
+		// configured in its schema.
+		Scopes []*Scope
+		// countedBy holds the edges with counter fields that point to this type.
+		countedBy []*Edge
+		// referencedBy holds the edges of all types that point to this type.
+		referencedBy []*Edge
+		// softRefs holds the foreign-keys that reference the table of this type.
+		softRefs []*SoftRef
+	}
+
+	// SoftRef is a foreign-key that references the table of a type. When the "softfk" feature
+	// is enabled, its delete action is executed by the generated code on deletion of the type
+	// nodes, because the foreign-keys may be disabled in the database.
+	SoftRef struct {
+		// Table and Column are the table and the column of the foreign-key.
+		Table, Column string
+		// Type is the type of the table, or nil if it's a join table of M2M edges.
+		Type *Type
+		// Action is the delete action of the foreign-key. Actions other than CASCADE and
+		// SET NULL (of nullable columns) restrict the deletion of referenced nodes.
+		Action schema.ReferenceOption
+	}
+
+	// OrderField is a field in the default order of a type.
+	OrderField struct {
+		// Field is the ordered field, or the ID field of the type.
+		Field *Field
+		// Desc indicates if the field is ordered in descending order.
+		Desc bool
+	}
+
+	// Scope is a named scope of the type queries.
+	Scope struct {
+		// Name is the name of the scope, as defined in the schema.
+		Name string
+		// Where holds the sorted filter keys of the scope predicates.
+		Where []string
+		// Order holds the orders of the scope.
+		Order []*OrderField
+		// Limit is the limit of the scope, or 0 if it wasn't set.
+		Limit int
 	}
 
 	// Field holds the information of a type field used for the templates.
@@ -70,8 +117,13 @@ type (
 		StructTag string
 		// Validators holds the number of validators this field have.
 		Validators int
+		// Transformers holds the number of transformers this field have.
+		Transformers int
 		// Position info of the field.
 		Position *load.Position
+		// NillableStorage indicates that the NULL values of this field are distinguished
+		// from its zero value, using the generated OrNull accessors and setters.
+		NillableStorage bool
 	}
 
 	// Edge of a graph between two types.
@@ -93,6 +145,11 @@ type (
 		StructTag string
 		// Relation holds the relation info of an edge.
 		Rel Relation
+		// OnDelete holds the delete action of the edge. For inverse edges,
+		// it's the action of their assoc edge.
+		OnDelete string
+		// StorageKey holds the storage key of the relation of assoc edges.
+		StorageKey *edge.StorageKey
 		// SelfRef indicates if this edge is a self-reference to the same
 		// type with the same name (symmetric relation). For example, a User
 		// type have one of following edges:
@@ -101,6 +158,12 @@ type (
 		//	edge.To("spouse", User.Type).Unique()	// one 2 one.
 		//
 		SelfRef bool
+		// Counter holds the field of the edge owner that counts the neighbors of the
+		// edge (edge.CountField). It's set on inverse edges as well, and then it's a
+		// field of the edge type.
+		Counter *Field
+		// countField holds the name of the counter field, until it's resolved.
+		countField string
 	}
 
 	// Relation holds the relational database information for edges.
@@ -124,8 +187,14 @@ type (
 		Name string
 		// Unique index or not.
 		Unique bool
+		// Type of the index (e.g. BTREE or FULLTEXT), if it was set.
+		Type string
 		// Columns are the table columns.
 		Columns []string
+		// Prefixes holds the prefix lengths of the columns (key parts).
+		Prefixes map[string]int
+		// Fields are the type fields of the index. The edge columns are not included.
+		Fields []*Field
 	}
 )
 
@@ -154,41 +223,241 @@ func NewType(c Config, schema *load.Schema) (*Type, error) {
 			return nil, fmt.Errorf("unique field %q cannot have default value", f.Name)
 		case typ.fields[f.Name] != nil:
 			return nil, fmt.Errorf("field %q redeclared for type %q", f.Name, typ.Name)
-		case f.Info.Type == field.TypeEnum:
+		case f.Info.Type == field.TypeEnum || len(f.Enums) > 0:
 			if err := validEnums(f); err != nil {
 				return nil, err
 			}
-			// enum types should be named as follows: typepkg.Field.
-			f.Info.Ident = fmt.Sprintf("%s.%s", typ.Package(), pascal(f.Name))
+			// enum types should be named as follows: typepkg.Field,
+			// unless they're defined by a custom Go type.
+			if !f.Info.ValueScanner {
+				f.Info.Ident = fmt.Sprintf("%s.%s", typ.Package(), pascal(f.Name))
+			}
+		}
+		if err := validStructTag(f.Tag); err != nil {
+			return nil, fmt.Errorf("invalid struct tag for field %q: %v", f.Name, err)
 		}
 		typ.Fields[i] = &Field{
-			def:           f,
-			Name:          f.Name,
-			Type:          f.Info,
-			Unique:        f.Unique,
-			Position:      f.Position,
-			Nillable:      f.Nillable,
-			Optional:      f.Optional,
-			Default:       f.Default,
-			UpdateDefault: f.UpdateDefault,
-			Immutable:     f.Immutable,
-			StructTag:     structTag(f.Name, f.Tag),
-			Validators:    f.Validators,
+			def:             f,
+			Name:            f.Name,
+			Type:            f.Info,
+			Unique:          f.Unique,
+			Position:        f.Position,
+			Nillable:        f.Nillable,
+			Optional:        f.Optional,
+			Default:         f.Default,
+			UpdateDefault:   f.UpdateDefault,
+			Immutable:       f.Immutable,
+			NillableStorage: f.NillableStorage,
+			StructTag:       structTag(f.Name, f.Tag, f.Sensitive),
+			Validators:      f.Validators,
+			Transformers:    f.Transformers,
 		}
 		typ.fields[f.Name] = typ.Fields[i]
 	}
+	for _, o := range schema.Config.Order {
+		f, err := typ.orderField(o, "the default order")
+		if err != nil {
+			return nil, err
+		}
+		typ.Order = append(typ.Order, f)
+	}
 	return typ, nil
 }
 
+// orderField returns the order of the given schema order field. The where argument
+// describes the option of the order in the schema, and it's used in the errors.
+func (t Type) orderField(o ent.OrderField, where string) (*OrderField, error) {
+	f, ok := t.fields[o.Field]
+	switch {
+	case o.Field == t.ID.Name:
+		f = t.ID
+	case !ok:
+		return nil, fmt.Errorf("unknown field %q in %s of type %q", o.Field, where, t.Name)
+	case f.IsJSON():
+		return nil, fmt.Errorf("json field %q cannot be used in %s of type %q", o.Field, where, t.Name)
+	}
+	return &OrderField{Field: f, Desc: o.Desc}, nil
+}
+
+// reservedNames holds the generated identifiers that cannot be used as the Go names of the fields
+// (i.e. their PascalCase form), because they are the names of the entity methods and fields, or the
+// names of package-level identifiers in the type package.
+var reservedNames = map[string]bool{
+	"Update":        true,
+	"Unwrap":        true,
+	"String":        true,
+	"Diff":          true,
+	"Clone":         true,
+	"FromRows":      true,
+	"FromResponse":  true,
+	"Label":         true,
+	"Table":         true,
+	"Columns":       true,
+	"Descriptor":    true,
+	"And":           true,
+	"Or":            true,
+	"Not":           true,
+	"FilterMap":     true,
+	"PredicateFunc": true,
+	"Scope":         true,
+	"Edges":         true,
+}
+
+// renameReserved renames the fields that their generated identifiers collide with the generated
+// code of the type. For example, a field named "update" collides with the Update method of the
+// entity, and it's renamed to "update_field". Renamed fields keep their original name as their
+// storage key and in their struct tag, and therefore, the database schema is not changed.
+func (t *Type) renameReserved() error {
+	reserved := make(map[string]bool, len(reservedNames))
+	for name := range reservedNames {
+		reserved[name] = true
+	}
+	for _, e := range t.Edges {
+		name := pascal(e.Name)
+		for _, s := range []string{"", "ID", "Label", "Table", "Column", "InverseLabel", "InverseTable", "PrimaryKey"} {
+			reserved[name+s] = true
+		}
+		reserved["Query"+name], reserved["Has"+name], reserved["Has"+name+"With"], reserved["By"+name+"Count"] = true, true, true, true
+	}
+	names := make(map[string]bool, len(t.Fields))
+	for _, f := range t.Fields {
+		names[pascal(f.Name)] = true
+	}
+	for _, f := range t.Fields {
+		if !reserved[pascal(f.Name)] {
+			continue
+		}
+		name := f.Name + "_field"
+		if names[pascal(name)] || reserved[pascal(name)] {
+			return fmt.Errorf("field %q collides with the generated code of %q, and cannot be renamed to %q", f.Name, t.Name, name)
+		}
+		if f.def.StorageKey == "" {
+			f.def.StorageKey = f.StorageKey()
+		}
+		f.Name = name
+		if f.IsEnum() && !f.HasGoType() {
+			f.Type.Ident = fmt.Sprintf("%s.%s", t.Package(), pascal(name))
+		}
+		names[pascal(name)] = true
+	}
+	return nil
+}
+
+// resolveScopes resolves the named scopes of the type, as configured in its schema. It fails
+// if the name of a scope collides with the generated identifiers of the type package (e.g. the
+// predicates of its fields), or if one of its filter keys is not a storage key of the type fields.
+func (t *Type) resolveScopes() error {
+	if t.schema == nil || len(t.schema.Config.Scopes) == 0 {
+		return nil
+	}
+	reserved := make(map[string]bool, len(reservedNames))
+	for name := range reservedNames {
+		reserved[name] = true
+	}
+	columns := map[string]bool{t.ID.StorageKey(): true}
+	for _, f := range append([]*Field{t.ID}, t.Fields...) {
+		name := pascal(f.Name)
+		reserved[name] = true
+		for _, op := range append(ops(f), storageOps(t.Storage, f)...) {
+			reserved[name+op.Name()] = true
+		}
+		for _, e := range f.Enums() {
+			reserved[name+pascal(e)] = true
+		}
+		columns[f.StorageKey()] = true
+	}
+	for _, e := range t.Edges {
+		reserved["Has"+pascal(e.Name)], reserved["Has"+pascal(e.Name)+"With"] = true, true
+	}
+	for _, s := range t.schema.Config.Scopes {
+		name := pascal(s.Name)
+		switch {
+		case s.Name == "":
+			return fmt.Errorf("missing scope name")
+		case reserved[name]:
+			return fmt.Errorf("scope %q collides with the generated code of the %s package", s.Name, t.Package())
+		}
+		reserved[name] = true
+		scope := &Scope{Name: s.Name, Limit: s.Limit}
+		for k := range s.Where {
+			column := k
+			if i := strings.IndexByte(k, '.'); i > 0 {
+				column = k[:i]
+			}
+			if !columns[column] {
+				return fmt.Errorf("unknown field %q in the predicates of scope %q", column, s.Name)
+			}
+			scope.Where = append(scope.Where, k)
+		}
+		sort.Strings(scope.Where)
+		for _, o := range s.Order {
+			f, err := t.orderField(o, fmt.Sprintf("the order of scope %q", s.Name))
+			if err != nil {
+				return err
+			}
+			scope.Order = append(scope.Order, f)
+		}
+		t.Scopes = append(t.Scopes, scope)
+	}
+	return nil
+}
+
+// ReadOnly reports if the type is read-only (e.g. backed by a SQL view). Only the query
+// builders are generated for read-only types, and their tables are not migrated.
+func (t Type) ReadOnly() bool {
+	return t.schema != nil && t.schema.Config.ReadOnly
+}
+
+// SkipMigration reports if the table of the type is managed outside of the migration. Unlike
+// read-only types, the table of the type is included in the graph tables (for the foreign-keys
+// that reference it), but it's not created nor changed by the generated migration.
+func (t Type) SkipMigration() bool {
+	return t.schema != nil && t.schema.Config.SkipMigration
+}
+
 // Label returns Gremlin label name of the node/type.
 func (t Type) Label() string { return snake(t.Name) }
 
 // Table returns SQL table name of the node/type.
 func (t Type) Table() string {
 	if t.schema != nil && t.schema.Config.Table != "" {
-		return t.schema.Config.Table
+		return t.qualify(t.schema.Config.Table)
+	}
+	return t.qualify(snake(t.ruleset().Pluralize(t.Name)))
+}
+
+// ColumnFields returns the id and the fields of the type, in the order of their
+// columns in the table, as configured by the ColumnOrder option of the schema.
+func (t Type) ColumnFields() []*Field {
+	fields := append([]*Field{t.ID}, t.Fields...)
+	sort.SliceStable(fields, func(i, j int) bool {
+		return t.columnRank(fields[i].StorageKey()) < t.columnRank(fields[j].StorageKey())
+	})
+	return fields
+}
+
+// columnRank returns the position of the column in the ColumnOrder option of the
+// schema, or the length of the option for columns that are not listed in it.
+func (t Type) columnRank(column string) int {
+	var order []string
+	if t.schema != nil {
+		order = t.schema.Config.ColumnOrder
+	}
+	for i, c := range order {
+		if c == column {
+			return i
+		}
+	}
+	return len(order)
+}
+
+// qualify qualifies the given table name with the schema (database) name
+// of the type, if it was configured (e.g. "billing.users").
+func (t Type) qualify(table string) string {
+	if t.schema != nil && t.schema.Config.Schema != "" {
+		return t.schema.Config.Schema + "." + table
 	}
-	return snake(rules.Pluralize(t.Name))
+	return table
 }
 
 // Package returns the package name of this node.
@@ -211,6 +480,76 @@ func (t Type) HasAssoc(name string) (*Edge, bool) {
 	return nil, false
 }
 
+// HasInverse returns the inverse edge of the given assoc edge, if this type has one.
+func (t Type) HasInverse(assoc string) (*Edge, bool) {
+	for _, e := range t.Edges {
+		if e.Inverse == assoc {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// CascadeEdges returns the edges that their neighbors are deleted by the generated code when
+// the nodes of this type are deleted (edge.AppCascade). Neighbors of these edges hold a foreign
+// key to the nodes of this type.
+func (t Type) CascadeEdges() (edges []*Edge) {
+	for _, e := range t.Edges {
+		if e.OnDelete == string(edge.AppCascade) && (e.O2M() || e.O2O() && !e.IsInverse() && !e.SelfRef) {
+			edges = append(edges, e)
+		}
+	}
+	return
+}
+
+// Cascade reports if the referencing rows are deleted with the referenced nodes.
+func (r SoftRef) Cascade() bool { return r.Action == schema.Cascade }
+
+// SetNull reports if the column of the referencing rows is set to NULL on deletion of the referenced nodes.
+func (r SoftRef) SetNull() bool { return r.Action == schema.SetNull }
+
+// SoftRefs returns the foreign-keys that reference the table of this type, if the "softfk"
+// feature is enabled. Their delete actions are executed by the generated delete builders.
+func (t Type) SoftRefs() []*SoftRef {
+	return t.softRefs
+}
+
+// CountedBy returns the edges with counter fields that point to this type. The counters of
+// their owners are changed when the nodes of this type are deleted.
+func (t Type) CountedBy() []*Edge {
+	return t.countedBy
+}
+
+// MutatedTables returns the tables that may be changed by the mutations of this type: its table, the
+// tables of its edges, the tables of the edges that point to it (e.g. join tables and foreign-keys of
+// other types), the tables with counter fields of its nodes, and the audit log table if it's enabled.
+func (t Type) MutatedTables() []string {
+	var (
+		tables []string
+		seen   = make(map[string]bool)
+	)
+	add := func(table string) {
+		if !seen[table] {
+			seen[table] = true
+			tables = append(tables, table)
+		}
+	}
+	add(t.Table())
+	for _, e := range t.Edges {
+		add(e.Rel.Table)
+	}
+	for _, e := range t.referencedBy {
+		add(e.Rel.Table)
+	}
+	for _, e := range t.countedBy {
+		add(e.Owner.Table())
+	}
+	if enabled, _ := t.FeatureEnabled(FeatureAudit.Name); enabled {
+		add(auditTable().Name)
+	}
+	return tables
+}
+
 // HasValidators reports if any of the type's field has validators.
 func (t Type) HasValidators() bool {
 	for _, f := range t.Fields {
@@ -221,6 +560,21 @@ func (t Type) HasValidators() bool {
 	return false
 }
 
+// HasSchemaValidators reports if the type has schema-level validators for its mutations.
+func (t Type) HasSchemaValidators() bool {
+	return t.schema != nil && t.schema.Validators > 0
+}
+
+// HasTransformers reports if any of the type's field has transformers.
+func (t Type) HasTransformers() bool {
+	for _, f := range t.Fields {
+		if f.Transformers > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // HasDefault reports if any of this type's fields has default value on creation.
 func (t Type) HasDefault() bool {
 	for _, f := range t.Fields {
@@ -251,6 +605,27 @@ func (t Type) HasOptional() bool {
 	return false
 }
 
+// HasNillableStorage reports if this type has a field with NillableStorage.
+func (t Type) HasNillableStorage() bool {
+	for _, f := range t.Fields {
+		if f.NillableStorage {
+			return true
+		}
+	}
+	return false
+}
+
+// HasStorageValue reports if the type has fields with values that are converted
+// before they are stored in the database (e.g. UUID fields). See Field.HasStorageValue.
+func (t Type) HasStorageValue() bool {
+	for _, f := range t.Fields {
+		if f.HasStorageValue() {
+			return true
+		}
+	}
+	return false
+}
+
 // MixedInWithDefault returns all mixed-in fields with default values for creation or update.
 func (t Type) MixedInWithDefault() (fields []*Field) {
 	for _, f := range t.Fields {
@@ -299,6 +674,17 @@ func (t Type) MutableFields() []*Field {
 	return fields
 }
 
+// UniqueFields returns the types's unique fields.
+func (t Type) UniqueFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.Unique {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 // NumM2M returns the type's many-to-many edge count
 func (t Type) NumM2M() int {
 	var n int
@@ -310,6 +696,17 @@ func (t Type) NumM2M() int {
 	return n
 }
 
+// TouchField returns the update-time field of the type (e.g. "updated_at"), which is the
+// first time field with an update default. It returns nil if there is no such field.
+func (t Type) TouchField() *Field {
+	for _, f := range t.Fields {
+		if f.IsTime() && f.UpdateDefault {
+			return f
+		}
+	}
+	return nil
+}
+
 // TagTypes returns all struct-tag types of the type fields.
 func (t Type) TagTypes() []string {
 	tags := make(map[string]bool)
@@ -345,10 +742,15 @@ func (t Type) Describe(w io.Writer) {
 	b.WriteString(t.Name + ":\n")
 	table := tablewriter.NewWriter(b)
 	table.SetAutoFormatHeaders(false)
-	table.SetHeader([]string{"Field", "Type", "Unique", "Optional", "Nillable", "Default", "UpdateDefault", "Immutable", "StructTag", "Validators"})
+	header := []string{"Field", "Type", "Unique", "Optional", "Nillable", "Default", "UpdateDefault", "Immutable", "StructTag", "Validators"}
+	table.SetHeader(header)
 	for _, f := range append([]*Field{t.ID}, t.Fields...) {
+		// sensitive fields are omitted from the description.
+		if f.Sensitive() {
+			continue
+		}
 		v := reflect.ValueOf(*f)
-		row := make([]string, v.NumField()-2)
+		row := make([]string, len(header))
 		for i := range row {
 			row[i] = fmt.Sprint(v.Field(i + 1).Interface())
 		}
@@ -378,19 +780,38 @@ func (t Type) Describe(w io.Writer) {
 // NewIndex adds a new index for the given type table.
 // It fails if the schema index is invalid.
 func (t *Type) AddIndex(idx *load.Index) error {
-	index := &Index{Unique: idx.Unique}
+	index := &Index{Unique: idx.Unique, Type: strings.ToUpper(idx.Type)}
 	if len(idx.Fields) == 0 {
 		return fmt.Errorf("missing fields")
 	}
+	for name := range idx.Prefixes {
+		found := false
+		for i := 0; i < len(idx.Fields) && !found; i++ {
+			found = idx.Fields[i] == name
+		}
+		if !found {
+			return fmt.Errorf("prefix length of field %q that is not in the index", name)
+		}
+	}
 	for _, name := range idx.Fields {
 		f, ok := t.fields[name]
 		if !ok {
 			return fmt.Errorf("unknown index field %q", name)
 		}
-		if f.def.Size != nil && *f.def.Size > schema.DefaultStringLen {
+		prefix, ok := idx.Prefixes[name]
+		switch {
+		case ok && prefix <= 0:
+			return fmt.Errorf("invalid prefix length %d for index field %q", prefix, name)
+		case ok:
+			if index.Prefixes == nil {
+				index.Prefixes = make(map[string]int)
+			}
+			index.Prefixes[snake(name)] = prefix
+		case index.Type != "FULLTEXT" && f.def.Size != nil && *f.def.Size > schema.DefaultStringLen:
 			return fmt.Errorf("field %q exceeds the index size limit (%d)", name, schema.DefaultStringLen)
 		}
 		index.Columns = append(index.Columns, snake(name))
+		index.Fields = append(index.Fields, f)
 	}
 	for _, name := range idx.Edges {
 		var edge *Edge
@@ -416,6 +837,27 @@ func (t *Type) AddIndex(idx *load.Index) error {
 	return nil
 }
 
+// TupleIndexes returns the composite keys of the type. i.e. the unique indexes of 2
+// fields or more, without edges. They are used for generating the tuple predicates.
+func (t Type) TupleIndexes() []*Index {
+	var idx []*Index
+	for _, i := range t.Indexes {
+		if i.Unique && len(i.Fields) > 1 && len(i.Fields) == len(i.Columns) {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
+// TupleName returns the Go name of the composite key of the index (e.g. NamePhone).
+func (i Index) TupleName() string {
+	var b strings.Builder
+	for _, f := range i.Fields {
+		b.WriteString(pascal(f.Name))
+	}
+	return b.String()
+}
+
 // Constant returns the constant name of the field.
 func (f Field) Constant() string { return "Field" + pascal(f.Name) }
 
@@ -444,27 +886,115 @@ func (f Field) Enums() []string {
 // Validator returns the validator name.
 func (f Field) Validator() string { return pascal(f.Name) + "Validator" }
 
+// Transformer returns the transformer name.
+func (f Field) Transformer() string { return pascal(f.Name) + "Transformer" }
+
 // IsTime returns true if the field is a timestamp field.
 func (f Field) IsTime() bool { return f.Type != nil && f.Type.Type == field.TypeTime }
 
 // IsJSON returns true if the field is a JSON field.
 func (f Field) IsJSON() bool { return f.Type != nil && f.Type.Type == field.TypeJSON }
 
+// IsSlice returns true if the field is a JSON field with a slice type (e.g. []string).
+func (f Field) IsSlice() bool { return f.IsJSON() && strings.HasPrefix(f.Type.Ident, "[]") }
+
+// SliceElem returns the element type of a slice field (e.g. string for []string).
+func (f Field) SliceElem() string { return strings.TrimPrefix(f.Type.Ident, "[]") }
+
 // IsString returns true if the field is a string field.
 func (f Field) IsString() bool { return f.Type != nil && f.Type.Type == field.TypeString }
 
 // IsInt returns true if the field is an int field.
 func (f Field) IsInt() bool { return f.Type != nil && f.Type.Type == field.TypeInt }
 
-// IsEnum returns true if the field is an enum field.
-func (f Field) IsEnum() bool { return f.Type != nil && f.Type.Type == field.TypeEnum }
+// IsEnum returns true if the field is an enum field. Enums with a custom Go type (see
+// field.EnumValues) can be stored as integers, and therefore, they are detected by their values.
+func (f Field) IsEnum() bool {
+	return f.Type != nil && (f.Type.Type == field.TypeEnum || f.HasGoType() && f.def != nil && len(f.def.Enums) > 0)
+}
+
+// IsBytes returns true if the field is a bytes field.
+func (f Field) IsBytes() bool { return f.Type != nil && f.Type.Type == field.TypeBytes }
+
+// HasGoType reports if the field has a custom Go type (see field.GoType).
+func (f Field) HasGoType() bool { return f.Type != nil && f.Type.ValueScanner }
+
+// IsUUID returns true if the field is a UUID field.
+func (f Field) IsUUID() bool { return f.Type != nil && f.Type.Type == field.TypeUUID }
+
+// IsIP returns true if the field is an IP address field.
+func (f Field) IsIP() bool { return f.Type != nil && f.Type.Type == field.TypeIP }
+
+// IsMAC returns true if the field is a MAC address field.
+func (f Field) IsMAC() bool { return f.Type != nil && f.Type.Type == field.TypeMAC }
+
+// HasStorageValue reports if the values of the field are converted to another
+// type before they are stored in the database (UUID, IP and MAC fields).
+func (f Field) HasStorageValue() bool { return f.IsUUID() || f.IsIP() || f.IsMAC() }
+
+// DefaultFunc reports if the default values of the field are functions (e.g. time.Now).
+func (f Field) DefaultFunc() bool { return f.IsTime() || f.IsUUID() }
+
+// SQLValue returns the Go expression of the SQL value of the given field value.
+// UUIDs are stored in their binary form, and IP and MAC addresses as strings,
+// or as NULL if they are nil.
+func (f Field) SQLValue(v string) string {
+	if f.HasStorageValue() && strings.HasPrefix(v, "*") {
+		v = "(" + v + ")"
+	}
+	switch {
+	case f.IsUUID():
+		return v + "[:]"
+	case f.IsIP(), f.IsMAC():
+		return fmt.Sprintf("sql.NullString{String: %s.String(), Valid: %s != nil}", v, v)
+	default:
+		return v
+	}
+}
+
+// GremlinValue returns the Go expression of the Gremlin value of the given field value.
+// UUIDs, IP and MAC addresses are stored as strings.
+func (f Field) GremlinValue(v string) string {
+	if !f.HasStorageValue() {
+		return v
+	}
+	if strings.HasPrefix(v, "*") {
+		v = "(" + v + ")"
+	}
+	return v + ".String()"
+}
+
+// NotEqual returns the Go expression for checking if the 2 given
+// values of the field type are not equal.
+func (f Field) NotEqual(a, b string) string {
+	switch {
+	case f.HasGoType():
+		return fmt.Sprintf("!reflect.DeepEqual(%s, %s)", a, b)
+	case f.IsTime():
+		return fmt.Sprintf("!%s.Equal(%s)", a, b)
+	case f.IsBytes(), f.IsMAC():
+		return fmt.Sprintf("!bytes.Equal(%s, %s)", a, b)
+	case f.IsIP():
+		return fmt.Sprintf("!%s.Equal(%s)", a, b)
+	case f.IsJSON():
+		return fmt.Sprintf("!reflect.DeepEqual(%s, %s)", a, b)
+	default:
+		return fmt.Sprintf("%s != %s", a, b)
+	}
+}
 
 // NullType returns the sql null-type for optional and nullable fields.
 func (f Field) NullType() string {
+	if f.HasGoType() || f.IsUUID() {
+		if f.Nillable {
+			return "*" + f.Type.String()
+		}
+		return f.Type.String()
+	}
 	switch f.Type.Type {
 	case field.TypeJSON:
 		return "[]byte"
-	case field.TypeString, field.TypeEnum:
+	case field.TypeString, field.TypeEnum, field.TypeIP, field.TypeMAC:
 		return "sql.NullString"
 	case field.TypeBool:
 		return "sql.NullBool"
@@ -482,6 +1012,9 @@ func (f Field) NullType() string {
 // NullTypeField extracts the nullable type field (if exists) from the given receiver.
 // It also does the type conversion if needed.
 func (f Field) NullTypeField(rec string) string {
+	if f.HasGoType() {
+		return rec
+	}
 	switch f.Type.Type {
 	case field.TypeEnum:
 		return fmt.Sprintf("%s(%s.String)", f.Type, rec)
@@ -513,12 +1046,19 @@ func (f Field) Column() *schema.Column {
 		c.Type = field.TypeInt
 		c.Increment = true
 	}
+	if c.Type != field.TypeEnum {
+		// enums with an integer Go type.
+		c.Enums = nil
+	}
 	if f.def != nil {
 		if f.def.Size != nil {
 			c.Size = *f.def.Size
 		}
+		c.Precision = int64(f.def.Precision)
+		c.DateOnly = f.def.DateOnly
+		c.Renamed = f.def.Renamed
 	}
-	if f.Default && !f.IsTime() {
+	if f.Default && !f.DefaultFunc() {
 		c.Default = f.DefaultName()
 	}
 	return c
@@ -527,6 +1067,8 @@ func (f Field) Column() *schema.Column {
 // ExampleCode returns an example code of the field value for the example_test file.
 func (f Field) ExampleCode() string {
 	switch t := f.Type.Type; {
+	case f.HasGoType():
+		return fmt.Sprintf("*new(%s)", f.Type)
 	case t.Numeric():
 		return "1"
 	case t == field.TypeBool:
@@ -535,6 +1077,12 @@ func (f Field) ExampleCode() string {
 		return "time.Now()"
 	case t == field.TypeString:
 		return `"string"`
+	case t == field.TypeUUID:
+		return "uuid.New()"
+	case t == field.TypeIP:
+		return `net.ParseIP("127.0.0.1")`
+	case t == field.TypeMAC:
+		return "net.HardwareAddr{0x00, 0x00, 0x5e, 0x00, 0x53, 0x01}"
 	case t == field.TypeEnum:
 		enums := f.Enums()
 		if len(enums) == 0 {
@@ -547,6 +1095,10 @@ func (f Field) ExampleCode() string {
 	}
 }
 
+// Sensitive reports if the field is sensitive, and should be omitted
+// from the String method and the JSON encoding of the entity.
+func (f Field) Sensitive() bool { return f.def != nil && f.def.Sensitive }
+
 // StorageKey returns the storage name of the field.
 // SQL columns or Gremlin property.
 func (f Field) StorageKey() string {
@@ -580,6 +1132,10 @@ func (e Edge) O2O() bool { return e.Rel.Type == O2O }
 // IsInverse returns if this edge is an inverse edge.
 func (e Edge) IsInverse() bool { return e.Inverse != "" }
 
+// OwnFK indicates if the foreign-key of this edge is held by the table of its owner type,
+// and therefore, it's set on the insert of the type. i.e. M2O edges and O2O inverse edges.
+func (e Edge) OwnFK() bool { return e.M2O() || e.O2O() && e.IsInverse() && !e.SelfRef }
+
 // Constant returns the constant name of the edge.
 // If the edge is inverse, it returns the constant name of the owner-edge (assoc-edge).
 func (e Edge) Constant() string {
@@ -619,6 +1175,11 @@ func (e Edge) StructField() string {
 	return e.Name
 }
 
+// EagerLoadField returns the struct field (of the query builder) for storing the eager-loading info.
+func (e Edge) EagerLoadField() string {
+	return "with" + pascal(e.Name)
+}
+
 // Column returns the first element from the columns slice.
 func (r Relation) Column() string {
 	if len(r.Columns) == 0 {
@@ -655,8 +1216,12 @@ func (r Rel) String() string {
 	return s
 }
 
-func structTag(name, tag string) string {
+func structTag(name, tag string, sensitive bool) string {
 	t := fmt.Sprintf(`json:"%s,omitempty"`, name)
+	// sensitive fields are omitted from the JSON encoding.
+	if sensitive {
+		t = `json:"-"`
+	}
 	if tag == "" {
 		return t
 	}
@@ -666,6 +1231,48 @@ func structTag(name, tag string) string {
 	return tag
 }
 
+// validStructTag checks that the given struct tag is a list of key:"value" pairs (separated by
+// spaces), as expected by reflect.StructTag.Get and reported by go vet.
+func validStructTag(tag string) error {
+	for tag != "" {
+		i := 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		switch {
+		case i == 0:
+			return fmt.Errorf("missing key in struct tag %q", tag)
+		case i+1 >= len(tag) || tag[i] != ':':
+			return fmt.Errorf("missing key:value separator in struct tag %q", tag)
+		case tag[i+1] != '"':
+			return fmt.Errorf("unquoted value in struct tag %q", tag)
+		}
+		key, value := tag[:i], tag[i+1:]
+		i = 1
+		for i < len(value) && value[i] != '"' {
+			if value[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(value) {
+			return fmt.Errorf("unterminated value for key %q in struct tag", key)
+		}
+		if _, err := strconv.Unquote(value[:i+1]); err != nil {
+			return fmt.Errorf("invalid value for key %q in struct tag: %v", key, err)
+		}
+		tag = value[i+1:]
+		switch {
+		case tag == "":
+		case tag[0] != ' ':
+			return fmt.Errorf("missing space separator after key %q in struct tag", key)
+		default:
+			tag = strings.TrimLeft(tag, " ")
+		}
+	}
+	return nil
+}
+
 func validEnums(f *load.Field) error {
 	if len(f.Enums) == 0 {
 		return fmt.Errorf("missing values for enum field %q", f.Name)
@@ -5,9 +5,11 @@
 package gen
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/entc/load"
 	"github.com/facebookincubator/ent/schema/field"
 
@@ -62,6 +64,42 @@ func TestType(t *testing.T) {
 	require.Nil(typ)
 }
 
+func TestType_Order(t *testing.T) {
+	require := require.New(t)
+	typ, err := NewType(Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+		Config: ent.Config{
+			Order: []ent.OrderField{{Field: "name", Desc: true}, {Field: "id"}},
+		},
+	})
+	require.NoError(err)
+	require.Len(typ.Order, 2)
+	require.Equal(typ.Fields[0], typ.Order[0].Field)
+	require.True(typ.Order[0].Desc)
+	require.Equal(typ.ID, typ.Order[1].Field)
+	require.False(typ.Order[1].Desc)
+
+	typ, err = NewType(Config{Package: "entc/gen"}, &load.Schema{
+		Name:   "T",
+		Config: ent.Config{Order: []ent.OrderField{{Field: "unknown"}}},
+	})
+	require.Error(err, "unknown field in order")
+	require.Nil(typ)
+
+	typ, err = NewType(Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "dirs", Info: &field.TypeInfo{Type: field.TypeJSON}},
+		},
+		Config: ent.Config{Order: []ent.OrderField{{Field: "dirs"}}},
+	})
+	require.Error(err, "json field in order")
+	require.Nil(typ)
+}
+
 func TestType_Label(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -94,6 +132,10 @@ func TestType_Table(t *testing.T) {
 		typ := &Type{Name: tt.name}
 		require.Equal(t, tt.label, typ.Table())
 	}
+	typ := &Type{Name: "Invoice", schema: &load.Schema{Config: ent.Config{Schema: "billing"}}}
+	require.Equal(t, "billing.invoices", typ.Table())
+	typ = &Type{Name: "Invoice", schema: &load.Schema{Config: ent.Config{Table: "bills", Schema: "billing"}}}
+	require.Equal(t, "billing.bills", typ.Table())
 }
 
 func TestType_Receiver(t *testing.T) {
@@ -175,6 +217,21 @@ func TestType_AddIndex(t *testing.T) {
 	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"text"}})
 	require.Error(t, err, "index size exceeded")
 
+	err = typ.AddIndex(&load.Index{Type: "FULLTEXT", Fields: []string{"text"}})
+	require.NoError(t, err, "full-text index on long fields")
+
+	err = typ.AddIndex(&load.Index{Fields: []string{"text"}, Prefixes: map[string]int{"text": 0}})
+	require.Error(t, err, "invalid prefix length")
+
+	err = typ.AddIndex(&load.Index{Fields: []string{"text"}, Prefixes: map[string]int{"name": 100}})
+	require.Error(t, err, "prefix length of a field that is not in the index")
+
+	err = typ.AddIndex(&load.Index{Type: "fulltext", Fields: []string{"text"}, Prefixes: map[string]int{"text": 100}})
+	require.NoError(t, err, "prefix length for long fields")
+	idx := typ.Indexes[len(typ.Indexes)-1]
+	require.Equal(t, "FULLTEXT", idx.Type)
+	require.Equal(t, map[string]int{"text": 100}, idx.Prefixes)
+
 	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"name"}, Edges: []string{"parent"}})
 	require.Error(t, err, "missing edge")
 
@@ -186,6 +243,13 @@ func TestType_AddIndex(t *testing.T) {
 
 	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"name"}, Edges: []string{"owner"}})
 	require.NoError(t, err, "valid index on M2O relation and field")
+	require.Empty(t, typ.TupleIndexes(), "indexes with edges are not composite keys")
+
+	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"name", "text"}, Prefixes: map[string]int{"text": 100}})
+	require.NoError(t, err)
+	tuples := typ.TupleIndexes()
+	require.Len(t, tuples, 1)
+	require.Equal(t, "NameText", tuples[0].TupleName())
 }
 
 func TestField(t *testing.T) {
@@ -199,6 +263,92 @@ func TestField(t *testing.T) {
 	require.Equal(t, "\"string\"", Field{Type: &field.TypeInfo{Type: field.TypeString}}.ExampleCode())
 }
 
+func TestField_Sensitive(t *testing.T) {
+	typ, err := NewType(Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "password", Sensitive: true, Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "token", Sensitive: true, Tag: `yaml:"token"`, Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, typ.Fields[0].Sensitive())
+	require.Equal(t, `json:"-"`, typ.Fields[0].StructTag)
+	require.Equal(t, `json:"-" yaml:"token"`, typ.Fields[1].StructTag)
+	require.False(t, typ.Fields[2].Sensitive())
+	require.Equal(t, `json:"name,omitempty"`, typ.Fields[2].StructTag)
+	require.False(t, typ.ID.Sensitive())
+
+	b := &strings.Builder{}
+	typ.Describe(b)
+	require.NotContains(t, b.String(), "password")
+	require.Contains(t, b.String(), "name")
+}
+
+func TestField_StructTag(t *testing.T) {
+	typ, err := NewType(Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Tag: `json:"fullName" xml:"name"`, Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "nick", Tag: `xml:"nick,attr"  yaml:"nick"`, Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `json:"fullName" xml:"name"`, typ.Fields[0].StructTag)
+	require.Equal(t, `json:"nick,omitempty" xml:"nick,attr"  yaml:"nick"`, typ.Fields[1].StructTag)
+
+	for _, tag := range []string{`json:fullName`, `json:"fullName"xml:"name"`, `json:"fullName`, `json`, `:"name"`, `json:"\x"`} {
+		_, err := NewType(Config{Package: "entc/gen"}, &load.Schema{
+			Name:   "T",
+			Fields: []*load.Field{{Name: "name", Tag: tag, Info: &field.TypeInfo{Type: field.TypeString}}},
+		})
+		require.Error(t, err, tag)
+	}
+}
+
+func TestType_TouchField(t *testing.T) {
+	typ := &Type{
+		Fields: []*Field{
+			{Name: "created_at", Type: &field.TypeInfo{Type: field.TypeTime}, Default: true},
+			{Name: "version", Type: &field.TypeInfo{Type: field.TypeInt}, UpdateDefault: true},
+			{Name: "updated_at", Type: &field.TypeInfo{Type: field.TypeTime}, Default: true, UpdateDefault: true},
+		},
+	}
+	require.Equal(t, typ.Fields[2], typ.TouchField())
+	require.Nil(t, (&Type{Fields: typ.Fields[:2]}).TouchField())
+}
+
+func TestField_IsSlice(t *testing.T) {
+	f := Field{Name: "strings", Type: field.Strings("strings").Descriptor().Info}
+	require.True(t, f.IsSlice())
+	require.Equal(t, "string", f.SliceElem())
+	f = Field{Name: "raw", Type: field.JSON("raw", json.RawMessage{}).Descriptor().Info}
+	require.False(t, f.IsSlice())
+	f = Field{Name: "name", Type: &field.TypeInfo{Type: field.TypeString}}
+	require.False(t, f.IsSlice())
+}
+
+func TestField_StorageValue(t *testing.T) {
+	f := Field{Name: "uuid", Type: field.UUID("uuid").Descriptor().Info}
+	require.True(t, f.HasStorageValue())
+	require.True(t, f.DefaultFunc())
+	require.Equal(t, "(*value)[:]", f.SQLValue("*value"))
+	require.Equal(t, "(*value).String()", f.GremlinValue("*value"))
+	f = Field{Name: "ip", Type: field.IP("ip").Descriptor().Info}
+	require.True(t, f.HasStorageValue())
+	require.Equal(t, "sql.NullString{String: v.String(), Valid: v != nil}", f.SQLValue("v"))
+	require.Equal(t, "sql.NullString{String: (*value).String(), Valid: (*value) != nil}", f.SQLValue("*value"))
+	require.Equal(t, "!a.Equal(b)", f.NotEqual("a", "b"))
+	f = Field{Name: "mac", Type: field.MAC("mac").Descriptor().Info}
+	require.Equal(t, "vs[i].String()", f.GremlinValue("vs[i]"))
+	require.Equal(t, "!bytes.Equal(a, b)", f.NotEqual("a", "b"))
+	f = Field{Name: "name", Type: &field.TypeInfo{Type: field.TypeString}}
+	require.False(t, f.HasStorageValue())
+	require.Equal(t, "*value", f.SQLValue("*value"))
+	require.Equal(t, "*value", f.GremlinValue("*value"))
+}
+
 func TestField_Constant(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -243,6 +393,7 @@ func TestEdge(t *testing.T) {
 	require.Equal(t, "UsersInverseLabel", users.InverseConstant())
 	require.Equal(t, "user_groups", users.Label())
 	require.Equal(t, "user_groups", groups.Label())
+	require.Equal(t, "withGroups", groups.EagerLoadField())
 }
 
 func TestType_Describe(t *testing.T) {
@@ -0,0 +1,108 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect/record"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/audit/ent"
+	"github.com/facebookincubator/ent/entc/integration/audit/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog(t *testing.T) {
+	drv, err := sql.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+	client := ent.NewClient(ent.Driver(drv))
+	require.NoError(t, client.Schema.Create(ctx))
+
+	actx := ent.WithActor(ctx, "a8m")
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetNickname("ariel").SetPassword("pass").SaveX(actx)
+	logs, err := client.AuditLogs(ctx, user.Label, a8m.ID)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, ent.AuditCreate, logs[0].Op)
+	require.Equal(t, "a8m", logs[0].Actor)
+	require.Len(t, logs[0].Changes, 4)
+	require.Equal(t, user.FieldName, logs[0].Changes[0].Field)
+	require.Equal(t, "a8m", logs[0].Changes[0].New)
+	require.Equal(t, ent.FieldChange{Field: user.FieldPassword, New: ent.Redacted}, logs[0].Changes[3], "sensitive values are redacted")
+
+	a8m = a8m.Update().AddAge(1).ClearNickname().SaveX(ctx)
+	logs, err = client.AuditLogs(ctx, user.Label, a8m.ID)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	require.Equal(t, ent.AuditUpdate, logs[1].Op)
+	require.Empty(t, logs[1].Actor)
+	require.Equal(t, []ent.FieldChange{
+		{Field: user.FieldAge, Old: float64(30), New: float64(31)},
+		{Field: user.FieldNickname, Old: "ariel"},
+	}, logs[1].Changes)
+
+	client.User.Update().Where(user.ID(a8m.ID)).SetName("Ariel").ExecX(actx)
+	logs, err = client.AuditLogs(ctx, user.Label, a8m.ID)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	require.Equal(t, []ent.FieldChange{{Field: user.FieldName, Old: "a8m", New: "Ariel"}}, logs[2].Changes)
+
+	client.User.UpdateOneID(a8m.ID).SetPassword("secret").ExecX(actx)
+	logs, err = client.AuditLogs(ctx, user.Label, a8m.ID)
+	require.NoError(t, err)
+	require.Len(t, logs, 4)
+	require.Equal(t, []ent.FieldChange{{Field: user.FieldPassword, Old: ent.Redacted, New: ent.Redacted}}, logs[3].Changes)
+
+	client.User.DeleteOne(a8m).ExecX(actx)
+	logs, err = client.AuditLogs(ctx, user.Label, a8m.ID)
+	require.NoError(t, err)
+	require.Len(t, logs, 5)
+	require.Equal(t, ent.AuditDelete, logs[4].Op)
+	require.Equal(t, "Ariel", logs[4].Changes[0].Old)
+	require.Equal(t, ent.FieldChange{Field: user.FieldPassword, Old: ent.Redacted}, logs[4].Changes[3])
+
+	// mutations that were rolled back are not recorded.
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	nati := tx.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	require.NoError(t, tx.Rollback())
+	logs, err = client.AuditLogs(ctx, user.Label, nati.ID)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+}
+
+func TestAuditSnapshot(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file:snapshot?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	drv := record.New(db)
+	ctx := context.Background()
+	client := ent.NewClient(ent.Driver(drv))
+	require.NoError(t, client.Schema.Create(ctx))
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	for _, update := range []func(){
+		func() { a8m.Update().AddAge(1).ExecX(ctx) },
+		func() { client.User.Update().Where(user.ID(a8m.ID)).AddAge(1).ExecX(ctx) },
+		func() { client.User.Update().Where(user.Name("nati")).AddAge(1).ExecX(ctx) },
+	} {
+		drv.Reset()
+		update()
+		records := drv.Records()
+		require.True(t, len(records) > 2)
+		require.Equal(t, "begin", records[0].Op)
+		require.Equal(t, "query", records[1].Op)
+		require.Equal(t, records[0].Tx, records[1].Tx, "the snapshot is read in the transaction of the update")
+		require.Equal(t, "commit", records[len(records)-1].Op)
+	}
+	logs, err := client.AuditLogs(ctx, user.Label, a8m.ID)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	require.Equal(t, []ent.FieldChange{{Field: user.FieldAge, Old: float64(31), New: float64(32)}}, logs[2].Changes)
+}
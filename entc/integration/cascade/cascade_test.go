@@ -0,0 +1,104 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package cascade
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/facebookincubator/ent/entc/integration/cascade/ent"
+	"github.com/facebookincubator/ent/entc/integration/cascade/ent/enttest"
+	"github.com/facebookincubator/ent/entc/integration/cascade/ent/pet"
+	"github.com/facebookincubator/ent/entc/integration/cascade/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCascade(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	ctx := context.Background()
+
+	t.Log("application-level cascade of o2m edges")
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("luna").SetOwner(nati).SaveX(ctx)
+	client.User.DeleteOne(a8m).ExecX(ctx)
+	require.Equal(t, []string{"luna"}, client.Pet.Query().Select("name").StringsX(ctx))
+
+	t.Log("application-level cascade of nested nodes")
+	child := client.User.Create().SetName("child").SetParent(nati).SaveX(ctx)
+	client.User.Create().SetName("grandchild").SetParent(child).SaveX(ctx)
+	client.Pet.Create().SetName("milo").SetOwner(child).SaveX(ctx)
+	client.User.Create().SetName("alex").SaveX(ctx)
+	affected, err := client.User.Delete().Where(user.Name("nati")).Exec(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, affected)
+	require.Equal(t, []string{"alex"}, client.User.Query().Select("name").StringsX(ctx))
+	require.Zero(t, client.Pet.Query().CountX(ctx))
+
+	t.Log("database cascade of m2o edges")
+	alex := client.User.Query().OnlyX(ctx)
+	client.Card.Create().SetNumber("1").SetOwner(alex).SaveX(ctx)
+	client.Card.Create().SetNumber("2").SaveX(ctx)
+	client.User.DeleteOne(alex).ExecX(ctx)
+	require.Equal(t, []string{"2"}, client.Card.Query().Select("number").StringsX(ctx))
+
+	t.Log("database restrict of o2m edges")
+	pet := client.Pet.Create().SetName("pedro").AddCards(client.Card.Query().OnlyX(ctx)).SaveX(ctx)
+	require.Error(t, client.Pet.DeleteOne(pet).Exec(ctx))
+	require.Equal(t, 1, client.Pet.Query().CountX(ctx))
+	client.Card.Delete().ExecX(ctx)
+	client.Pet.DeleteOne(pet).ExecX(ctx)
+	require.Zero(t, client.Pet.Query().CountX(ctx))
+}
+
+func TestIDInQuery(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:subquery?mode=memory&cache=shared&_fk=1")
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("luna").SetOwner(nati).SaveX(ctx)
+
+	owners := client.Pet.Query().Where(pet.Name("pedro")).QueryOwner()
+	require.Equal(t, []string{"a8m"}, client.User.Query().Where(user.IDInQuery(owners)).Select("name").StringsX(ctx))
+	require.Equal(t, []string{"nati"}, client.User.Query().Where(user.IDNotInQuery(owners)).Select("name").StringsX(ctx))
+	pets := client.Pet.Query().Where(
+		pet.IDInQuery(a8m.QueryPets()),
+		pet.IDNotInQuery(client.Pet.Query().Where(pet.Name("xabi"))),
+	)
+	require.Equal(t, []string{"pedro"}, pets.Select("name").StringsX(ctx))
+
+	t.Log("interceptors are applied on subqueries")
+	scoped := client.With()
+	scoped.Pet.Intercept(func(ctx context.Context, q *ent.PetQuery) error {
+		name, ok := ctx.Value(nameKey{}).(string)
+		if !ok {
+			return errors.New("missing name in context")
+		}
+		q.Where(pet.Name(name))
+		return nil
+	})
+	named := scoped.Pet.Query()
+	_, err := client.Pet.Query().Where(pet.IDInQuery(named)).All(ctx)
+	require.EqualError(t, err, "missing name in context")
+	_, err = client.User.Query().Where(user.HasPetsWith(pet.IDInQuery(named))).Count(ctx)
+	require.EqualError(t, err, "missing name in context", "subqueries of edge predicates")
+	_, err = client.Pet.Delete().Where(pet.IDNotInQuery(named)).Exec(ctx)
+	require.EqualError(t, err, "missing name in context", "interceptor errors fail mutations")
+	require.Equal(t, 3, client.Pet.Query().CountX(ctx))
+	ctx = context.WithValue(ctx, nameKey{}, "luna")
+	require.Equal(t, []string{"luna"}, client.Pet.Query().Where(pet.IDInQuery(named)).Select("name").StringsX(ctx))
+	require.Equal(t, 2, client.Pet.Query().Where(pet.IDNotInQuery(named)).CountX(ctx))
+	require.Equal(t, []string{"nati"}, client.User.Query().Where(user.HasPetsWith(pet.IDInQuery(named))).Select("name").StringsX(ctx))
+}
+
+// nameKey is the context key of the name that is used by the interceptors of the TestIDInQuery test.
+type nameKey struct{}
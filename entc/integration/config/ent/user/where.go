@@ -7,6 +7,11 @@
 package user
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/config/ent/predicate"
 )
@@ -112,6 +117,119 @@ func IDLTE(id int) predicate.User {
 	)
 }
 
+// IDInQuery applies the In predicate on the ID field, with the ids of the given query as a subquery.
+// It's used for filtering by the results of another query, without fetching its ids first. For example:
+//
+//	client.User.Query().Where(user.IDInQuery(client.User.Query().Where(...)))
+//
+// The interceptors of the client are applied on the subquery with the context of the query, and
+// their errors fail the query.
+func IDInQuery(q predicate.Subquery) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		t, err := q.IDsSubquery(s.Context())
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(FieldID), t))
+	})
+}
+
+// IDNotInQuery applies the NotIn predicate on the ID field, with the ids of the given query as a subquery.
+func IDNotInQuery(q predicate.Subquery) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		t, err := q.IDsSubquery(s.Context())
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldID), t))
+	})
+}
+
+// PredicateFunc returns a predicate from the given function.
+// It allows adding custom SQL fragments to the User builders.
+func PredicateFunc(f func(*sql.Selector)) predicate.User {
+	return predicate.User(f)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.User, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.User, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.User, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("user: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(
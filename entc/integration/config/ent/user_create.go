@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/config/ent/user"
 )
@@ -16,16 +17,26 @@ import (
 // UserCreate is the builder for creating a User entity.
 type UserCreate struct {
 	config
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uc *UserCreate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uc.userMutation, op: ent.OpCreate}
 }
 
 // Save creates the User in the database.
-func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+func (uc *UserCreate) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return uc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (uc *UserCreate) SaveX(ctx context.Context) *User {
-	v, err := uc.Save(ctx)
+func (uc *UserCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	v, err := uc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
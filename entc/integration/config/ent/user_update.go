@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/config/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/config/ent/user"
@@ -18,7 +19,11 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
+	userMutation
 	predicates []predicate.User
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*User
 }
 
 // Where adds a new predicate for the builder.
@@ -27,14 +32,52 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Mutation returns the mutation of the builder.
+func (uu *UserUpdate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uu.userMutation, op: ent.OpUpdate}
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+func (uu *UserUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := uu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (uu *UserUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uu
+		intercepted.predicates = append(predicates, uu.predicates...)
+		uu = &intercepted
+	}
 	return uu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (uu *UserUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*User, error) {
+	var us []*User
+	uu.returning = &us
+	defer func() { uu.returning = nil }()
+	if _, err := uu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return us, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
+func (uu *UserUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := uu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -42,65 +85,122 @@ func (uu *UserUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
+func (uu *UserUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
+func (uu *UserUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(user.FieldID).From(sql.Table(user.Table))
+func (uu *UserUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(user.FieldID).From(sql.Table(user.Table)).SetDialect(uu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range uu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := uu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+	if uu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(user.Columns...).
+			From(sql.Table(user.Table)).
+			Where(sql.InInts(user.FieldID, ids...)).
+			SetDialect(uu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var us Users
+		err := us.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into User: %v", err))
+		}
+		us.config(uu.config)
+		*uu.returning = us
 	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	id int
+	id     int
+	entity *User
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.User
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uuo.userMutation, op: ent.OpUpdateOne, id: &uuo.id}
+}
+
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (uuo *UserUpdateOne) SetChangedFrom(original *User) *UserUpdateOne {
+	u := uuo.entity
+	if u == nil || original == nil {
+		return uuo
+	}
+	return uuo
 }
 
 // Save executes the query and returns the updated entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+func (uuo *UserUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uuo
+		intercepted.predicates = append(predicates, uuo.predicates...)
+		uuo = &intercepted
+	}
 	return uuo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	u, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	u, err := uuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -108,21 +208,28 @@ func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
 }
 
 // Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
+func (uuo *UserUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
-	selector := sql.Select(user.Columns...).From(sql.Table(user.Table))
+	selector := sql.Select(user.Columns...).From(sql.Table(user.Table)).SetDialect(uuo.driver.Dialect())
 	user.ID(uuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range uuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -0,0 +1,152 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package counter
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/facebookincubator/ent/entc/integration/counter/ent"
+	"github.com/facebookincubator/ent/entc/integration/counter/ent/enttest"
+	"github.com/facebookincubator/ent/entc/integration/counter/ent/pet"
+	"github.com/facebookincubator/ent/entc/integration/counter/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounter(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	ctx := context.Background()
+	// counts returns the counters of the user, as they are stored in the database.
+	counts := func(u *ent.User) (int, int) {
+		u = client.User.GetX(ctx, u.ID)
+		return u.PetsCount, u.FriendsCount
+	}
+
+	t.Log("o2m edges from both sides")
+	pedro := client.Pet.Create().SetName("pedro").SaveX(ctx)
+	a8m := client.User.Create().SetName("a8m").AddPets(pedro).SaveX(ctx)
+	require.Equal(t, 1, a8m.PetsCount)
+	xabi := client.Pet.Create().SetName("xabi").SetOwner(a8m).SaveX(ctx)
+	pets, _ := counts(a8m)
+	require.Equal(t, 2, pets)
+	a8m = a8m.Update().RemovePets(pedro).SaveX(ctx)
+	require.Equal(t, 1, a8m.PetsCount)
+	a8m = a8m.Update().ClearPets().AddPets(pedro).SaveX(ctx)
+	require.Equal(t, 1, a8m.PetsCount)
+	a8m = a8m.Update().RemovePets(pedro).AddPets(xabi).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SaveX(ctx)
+	xabi.Update().SetOwner(nati).ExecX(ctx)
+	pets, _ = counts(a8m)
+	require.Zero(t, pets)
+	pets, _ = counts(nati)
+	require.Equal(t, 1, pets)
+	client.Pet.UpdateOne(pedro).SetOwner(nati).ExecX(ctx)
+	pets, _ = counts(nati)
+	require.Equal(t, 2, pets)
+	xabi.Update().ClearOwner().ExecX(ctx)
+	pets, _ = counts(nati)
+	require.Equal(t, 1, pets)
+	client.Pet.DeleteOne(pedro).ExecX(ctx)
+	pets, _ = counts(nati)
+	require.Zero(t, pets)
+
+	t.Log("m2m edges with self reference")
+	nati = nati.Update().AddFriends(a8m).SaveX(ctx)
+	require.Equal(t, 1, nati.FriendsCount)
+	_, friends := counts(a8m)
+	require.Equal(t, 1, friends)
+	alex := client.User.Create().SetName("alex").AddFriends(a8m, nati).SaveX(ctx)
+	require.Equal(t, 2, alex.FriendsCount)
+	_, friends = counts(a8m)
+	require.Equal(t, 2, friends)
+	client.User.Update().Where(user.ID(a8m.ID)).RemoveFriends(nati).ExecX(ctx)
+	_, friends = counts(a8m)
+	require.Equal(t, 1, friends)
+	_, friends = counts(nati)
+	require.Equal(t, 1, friends)
+	alex = alex.Update().ClearFriends().AddFriends(nati).SaveX(ctx)
+	require.Equal(t, 1, alex.FriendsCount)
+	_, friends = counts(a8m)
+	require.Zero(t, friends)
+	alex.Update().AddFriends(a8m).ExecX(ctx)
+	client.User.DeleteOne(alex).ExecX(ctx)
+	_, friends = counts(a8m)
+	require.Zero(t, friends)
+	_, friends = counts(nati)
+	require.Zero(t, friends)
+
+	t.Log("m2m edges from both sides")
+	hub := client.Group.Create().SetName("hub").AddUsers(a8m).SaveX(ctx)
+	require.Equal(t, int64(1), hub.UsersCount)
+	nati.Update().AddGroups(hub).ExecX(ctx)
+	require.Equal(t, int64(2), client.Group.GetX(ctx, hub.ID).UsersCount)
+	hub = hub.Update().RemoveUsers(a8m).SaveX(ctx)
+	require.Equal(t, int64(1), hub.UsersCount)
+	a8m.Update().AddGroups(hub).ExecX(ctx)
+	a8m.Update().ClearGroups().ExecX(ctx)
+	require.Equal(t, int64(1), client.Group.GetX(ctx, hub.ID).UsersCount)
+	client.User.DeleteOne(nati).ExecX(ctx)
+	require.Zero(t, client.Group.GetX(ctx, hub.ID).UsersCount)
+}
+
+func TestCounterConcurrency(t *testing.T) {
+	// a file database is used, since the shared cache of in-memory
+	// databases fails concurrent transactions instead of waiting.
+	dir, err := ioutil.TempDir("", "counter")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?_fk=1&_busy_timeout=10000&_txlock=immediate", filepath.Join(dir, "ent.db")))
+	defer client.Close()
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	// run concurrently fn n times, and fail on the first error.
+	concurrently := func(n int, fn func(i int) error) {
+		var wg sync.WaitGroup
+		errs := make(chan error, n)
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs <- fn(i)
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+	}
+
+	const n = 20
+	concurrently(n, func(i int) error {
+		if i%2 == 0 {
+			_, err := client.Pet.Create().SetName(fmt.Sprintf("pet-%d", i)).SetOwner(a8m).Save(ctx)
+			return err
+		}
+		p, err := client.Pet.Create().SetName(fmt.Sprintf("pet-%d", i)).Save(ctx)
+		if err != nil {
+			return err
+		}
+		return a8m.Update().AddPets(p).Exec(ctx)
+	})
+	require.Equal(t, n, client.User.GetX(ctx, a8m.ID).PetsCount)
+
+	pets := client.Pet.Query().Where(pet.HasOwner()).IDsX(ctx)
+	concurrently(n/2, func(i int) error {
+		if i%2 == 0 {
+			return client.Pet.DeleteOneID(pets[i]).Exec(ctx)
+		}
+		return client.Pet.UpdateOneID(pets[i]).ClearOwner().Exec(ctx)
+	})
+	require.Equal(t, n/2, client.User.GetX(ctx, a8m.ID).PetsCount)
+	require.Equal(t, n/2, client.Pet.Query().Where(pet.HasOwner()).CountX(ctx))
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/ent/card"
 )
 
 // Card is the model entity for the Card schema.
@@ -28,26 +29,50 @@ type Card struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Number holds the value of the "number" field.
 	Number string `json:"number,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the CardQuery when eager-loading is set.
+	Edges CardEdges `json:"edges"`
 	// additional struct fields defined in the schema.
 	RequestID string      // RequestID.
 	Logger    *log.Logger // Logger.
 }
 
+// CardEdges holds the relations/edges of other nodes in the graph.
+type CardEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+}
+
 // FromRows scans the sql response data into Card.
 func (c *Card) FromRows(rows *sql.Rows) error {
+	return c.scan(rows, card.Columns)
+}
+
+// scan scans the given columns of the sql response data into Card.
+// Fields that their columns were not selected are left with their zero values.
+func (c *Card) scan(rows *sql.Rows, columns []string) error {
 	var vc struct {
 		ID        int
 		CreatedAt sql.NullTime
 		UpdatedAt sql.NullTime
 		Number    sql.NullString
 	}
-	// the order here should be the same as in the `card.Columns`.
-	if err := rows.Scan(
-		&vc.ID,
-		&vc.CreatedAt,
-		&vc.UpdatedAt,
-		&vc.Number,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case card.FieldID:
+			values[i] = &vc.ID
+		case card.FieldCreatedAt:
+			values[i] = &vc.CreatedAt
+		case card.FieldUpdatedAt:
+			values[i] = &vc.UpdatedAt
+		case card.FieldNumber:
+			values[i] = &vc.Number
+		default:
+			return fmt.Errorf("unexpected column %q for type Card", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	c.ID = strconv.Itoa(vc.ID)
@@ -64,17 +89,17 @@ func (c *Card) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var vc struct {
-		ID        string `json:"id,omitempty"`
-		CreatedAt int64  `json:"created_at,omitempty"`
-		UpdatedAt int64  `json:"updated_at,omitempty"`
-		Number    string `json:"number,omitempty"`
+		ID        string    `json:"id,omitempty"`
+		CreatedAt time.Time `json:"created_at,omitempty"`
+		UpdatedAt time.Time `json:"updated_at,omitempty"`
+		Number    string    `json:"number,omitempty"`
 	}
 	if err := vmap.Decode(&vc); err != nil {
 		return err
 	}
 	c.ID = vc.ID
-	c.CreatedAt = time.Unix(0, vc.CreatedAt)
-	c.UpdatedAt = time.Unix(0, vc.UpdatedAt)
+	c.CreatedAt = vc.CreatedAt
+	c.UpdatedAt = vc.UpdatedAt
 	c.Number = vc.Number
 	return nil
 }
@@ -102,6 +127,30 @@ func (c *Card) Unwrap() *Card {
 	return c
 }
 
+// Clone returns a deep copy of the Card, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (c *Card) Clone() *Card {
+	return c.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Card, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (c *Card) clone(seen map[interface{}]interface{}) *Card {
+	if c == nil {
+		return nil
+	}
+	if _c, ok := seen[c]; ok {
+		return _c.(*Card)
+	}
+	_c := *c
+	_c.config = config{}
+	seen[c] = &_c
+	_c.Edges.Owner = c.Edges.Owner.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (c *Card) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -114,6 +163,23 @@ func (c *Card) String() string {
 	return buf.String()
 }
 
+// DiffCard returns the fields that have different values in the given Cards,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffCard(a, b *Card) []FieldChange {
+	var changes []FieldChange
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		changes = append(changes, FieldChange{Field: card.FieldCreatedAt, Old: a.CreatedAt, New: b.CreatedAt})
+	}
+	if !a.UpdatedAt.Equal(b.UpdatedAt) {
+		changes = append(changes, FieldChange{Field: card.FieldUpdatedAt, Old: a.UpdatedAt, New: b.UpdatedAt})
+	}
+	if a.Number != b.Number {
+		changes = append(changes, FieldChange{Field: card.FieldNumber, Old: a.Number, New: b.Number})
+	}
+	return changes
+}
+
 // id returns the int representation of the ID field.
 func (c *Card) id() int {
 	id, _ := strconv.Atoi(c.ID)
@@ -125,9 +191,14 @@ type Cards []*Card
 
 // FromRows scans the sql response data into Cards.
 func (c *Cards) FromRows(rows *sql.Rows) error {
+	return c.scan(rows, card.Columns)
+}
+
+// scan scans the given columns of the sql response data into Cards.
+func (c *Cards) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vc := &Card{}
-		if err := vc.FromRows(rows); err != nil {
+		if err := vc.scan(rows, columns); err != nil {
 			return err
 		}
 		*c = append(*c, vc)
@@ -142,10 +213,10 @@ func (c *Cards) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var vc []struct {
-		ID        string `json:"id,omitempty"`
-		CreatedAt int64  `json:"created_at,omitempty"`
-		UpdatedAt int64  `json:"updated_at,omitempty"`
-		Number    string `json:"number,omitempty"`
+		ID        string    `json:"id,omitempty"`
+		CreatedAt time.Time `json:"created_at,omitempty"`
+		UpdatedAt time.Time `json:"updated_at,omitempty"`
+		Number    string    `json:"number,omitempty"`
 	}
 	if err := vmap.Decode(&vc); err != nil {
 		return err
@@ -153,8 +224,8 @@ func (c *Cards) FromResponse(res *gremlin.Response) error {
 	for _, v := range vc {
 		*c = append(*c, &Card{
 			ID:        v.ID,
-			CreatedAt: time.Unix(0, v.CreatedAt),
-			UpdatedAt: time.Unix(0, v.UpdatedAt),
+			CreatedAt: v.CreatedAt,
+			UpdatedAt: v.UpdatedAt,
 			Number:    v.Number,
 		})
 	}
@@ -7,10 +7,13 @@
 package card
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
 )
 
 const (
@@ -42,9 +45,25 @@ const (
 // Columns holds all SQL columns are card fields.
 var Columns = []string{
 	FieldID,
+	FieldNumber,
 	FieldCreatedAt,
 	FieldUpdatedAt,
-	FieldNumber,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Tables holds the tables that may be changed by the mutations of the card. The mutations
+// invalidate only the query results of these tables that were cached by the entcache driver.
+var Tables = []string{
+	"cards",
 }
 
 var (
@@ -68,6 +87,92 @@ var (
 
 	// descNumber is the schema descriptor for number field.
 	descNumber = fields[0].Descriptor()
+	// NumberTransformer is a transformer for the "number" field. It is called by the builders before validation.
+	NumberTransformer = func() func(string) string {
+		transformers := descNumber.Transformers
+		fns := [...]func(string) string{
+			transformers[0].(func(string) string),
+			transformers[1].(func(string) string),
+		}
+		return func(number string) string {
+			for _, fn := range fns {
+				number = fn(number)
+			}
+			return number
+		}
+	}()
 	// NumberValidator is a validator for the "number" field. It is called by the builders before save.
 	NumberValidator = descNumber.Validators[0].(func(string) error)
 )
+
+// Scope is a reusable named scope of the Card queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.Card
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the Card type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "Card",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeString,
+		GoType:     "string",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "created_at",
+			StorageKey: FieldCreatedAt,
+			Type:       field.TypeTime,
+			GoType:     "time.Time",
+			Immutable:  true,
+			Default:    true,
+		},
+		{
+			Name:       "updated_at",
+			StorageKey: FieldUpdatedAt,
+			Type:       field.TypeTime,
+			GoType:     "time.Time",
+			Immutable:  true,
+			Default:    true,
+		},
+		{
+			Name:       "number",
+			StorageKey: FieldNumber,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(string)
+				if !ok {
+					return fmt.Errorf("card: unexpected type %T for field number", v)
+				}
+				return NumberValidator(vv)
+			},
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "owner",
+			Type:     "User",
+			Rel:      "O2O",
+			Unique:   true,
+			Optional: true,
+			Inverse:  true,
+			Table:    "cards",
+			Columns:  []string{"owner_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the Card type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
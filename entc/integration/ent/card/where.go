@@ -7,7 +7,11 @@
 package card
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -580,6 +584,68 @@ func NumberHasSuffix(v string) predicate.Card {
 	)
 }
 
+// NumberEqualFold applies the EqualFold predicate on the "number" field.
+func NumberEqualFold(v string) predicate.Card {
+	return predicate.CardPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldNumber), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNumber, p.EqualFold(v))
+		},
+	)
+}
+
+// NumberContainsFold applies the ContainsFold predicate on the "number" field.
+func NumberContainsFold(v string) predicate.Card {
+	return predicate.CardPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldNumber), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNumber, p.ContainsFold(v))
+		},
+	)
+}
+
+// CreatedAtBetween applies the Between predicate on the "created_at" field.
+// The range is inclusive, and it's identical to CreatedAtGTE(a) and CreatedAtLTE(b).
+func CreatedAtBetween(a, b time.Time) predicate.Card {
+	return predicate.CardPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.Between(s.C(FieldCreatedAt), a, b))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldCreatedAt, p.GTE(a)).Has(Label, FieldCreatedAt, p.LTE(b))
+		},
+	)
+}
+
+// CreatedAtWithinLast applies a predicate on the "created_at" field, that checks if its value
+// is within the last d duration. It's identical to CreatedAtGTE(time.Now().Add(-d)).
+func CreatedAtWithinLast(d time.Duration) predicate.Card {
+	return CreatedAtGTE(time.Now().Add(-d))
+}
+
+// UpdatedAtBetween applies the Between predicate on the "updated_at" field.
+// The range is inclusive, and it's identical to UpdatedAtGTE(a) and UpdatedAtLTE(b).
+func UpdatedAtBetween(a, b time.Time) predicate.Card {
+	return predicate.CardPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.Between(s.C(FieldUpdatedAt), a, b))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdatedAt, p.GTE(a)).Has(Label, FieldUpdatedAt, p.LTE(b))
+		},
+	)
+}
+
+// UpdatedAtWithinLast applies a predicate on the "updated_at" field, that checks if its value
+// is within the last d duration. It's identical to UpdatedAtGTE(time.Now().Add(-d)).
+func UpdatedAtWithinLast(d time.Duration) predicate.Card {
+	return UpdatedAtGTE(time.Now().Add(-d))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Card {
 	return predicate.CardPerDialect(
@@ -593,15 +659,31 @@ func HasOwner() predicate.Card {
 	)
 }
 
+// HasNoOwner applies a predicate that checks that the "owner" edge is empty. It's identical
+// to Not(HasOwner()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the Card nodes.
+func HasNoOwner() predicate.Card {
+	return predicate.CardPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(sql.IsNull(t1.C(OwnerColumn)))
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.InE(OwnerInverseLabel))
+		},
+	)
+}
+
 // HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
 func HasOwnerWith(preds ...predicate.User) predicate.Card {
 	return predicate.CardPerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(FieldID).From(sql.Table(OwnerInverseTable))
+			t2 := sql.Select(FieldID).From(sql.Table(OwnerInverseTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(OwnerColumn), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -614,6 +696,214 @@ func HasOwnerWith(preds ...predicate.User) predicate.Card {
 	)
 }
 
+// PredicateFunc returns a predicate from the given per-dialect functions.
+// It allows adding custom SQL/Gremlin fragments to the Card builders.
+func PredicateFunc(f0 func(*sql.Selector), f1 func(*dsl.Traversal)) predicate.Card {
+	return predicate.CardPerDialect(f0, f1)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.Card, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.Card, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.Card, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldCreatedAt:
+		switch op {
+		case "eq":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return CreatedAtEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return CreatedAtNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]time.Time); ok || convert(value, &vs) {
+				return CreatedAtIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]time.Time); ok || convert(value, &vs) {
+				return CreatedAtNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return CreatedAtGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return CreatedAtGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return CreatedAtLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return CreatedAtLTE(v), nil
+			}
+		}
+	case FieldUpdatedAt:
+		switch op {
+		case "eq":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return UpdatedAtEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return UpdatedAtNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]time.Time); ok || convert(value, &vs) {
+				return UpdatedAtIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]time.Time); ok || convert(value, &vs) {
+				return UpdatedAtNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return UpdatedAtGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return UpdatedAtGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return UpdatedAtLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return UpdatedAtLTE(v), nil
+			}
+		}
+	case FieldNumber:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NumberEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NumberNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NumberIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NumberNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NumberGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NumberGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NumberLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NumberLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NumberContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NumberHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NumberHasSuffix(v), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NumberEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NumberContainsFold(v), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("card: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Card) predicate.Card {
 	return predicate.CardPerDialect(
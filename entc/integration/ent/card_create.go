@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -22,15 +23,20 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // CardCreate is the builder for creating a Card entity.
 type CardCreate struct {
 	config
-	created_at *time.Time
-	updated_at *time.Time
-	number     *string
-	owner      map[string]struct{}
+	cardMutation
+	// id of the node, if it's mirrored from the primary storage.
+	id *string
+}
+
+// Mutation returns the mutation of the builder.
+func (cc *CardCreate) Mutation() *CardMutation {
+	return &CardMutation{cardMutation: &cc.cardMutation, op: ent.OpCreate}
 }
 
 // SetCreatedAt sets the created_at field.
@@ -90,7 +96,8 @@ func (cc *CardCreate) SetOwner(u *User) *CardCreate {
 }
 
 // Save creates the Card in the database.
-func (cc *CardCreate) Save(ctx context.Context) (*Card, error) {
+func (cc *CardCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Card, error) {
+	options := ent.NewCallOptions(opts...)
 	if cc.created_at == nil {
 		v := card.DefaultCreatedAt()
 		cc.created_at = &v
@@ -102,12 +109,34 @@ func (cc *CardCreate) Save(ctx context.Context) (*Card, error) {
 	if cc.number == nil {
 		return nil, errors.New("ent: missing required field \"number\"")
 	}
+	if cc.number != nil {
+		v := card.NumberTransformer(*cc.number)
+		cc.number = &v
+	}
 	if err := card.NumberValidator(*cc.number); err != nil {
 		return nil, fmt.Errorf("ent: validator failed for field \"number\": %v", err)
 	}
 	if len(cc.owner) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if cc.shadow != nil {
+		return cc.dualSave(ctx, opts...)
+	}
+	v, err := cc.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		cc.bus.publish(&Event{Op: ent.OpCreate, Type: card.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save creates the Card in the storage of the client dialect.
+func (cc *CardCreate) save(ctx context.Context) (*Card, error) {
 	switch cc.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return cc.sqlSave(ctx)
@@ -119,8 +148,8 @@ func (cc *CardCreate) Save(ctx context.Context) (*Card, error) {
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (cc *CardCreate) SaveX(ctx context.Context) *Card {
-	v, err := cc.Save(ctx)
+func (cc *CardCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Card {
+	v, err := cc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -128,6 +157,7 @@ func (cc *CardCreate) SaveX(ctx context.Context) *Card {
 }
 
 func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
+	ctx = entcache.Invalidates(ctx, card.Tables...)
 	var (
 		res sql.Result
 		c   = &Card{config: cc.config}
@@ -137,6 +167,13 @@ func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
 		return nil, err
 	}
 	builder := sql.Insert(card.Table).Default(cc.driver.Dialect())
+	if id := cc.id; id != nil {
+		id, err := strconv.Atoi(*id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(card.FieldID, id)
+	}
 	if value := cc.created_at; value != nil {
 		builder.Set(card.FieldCreatedAt, *value)
 		c.CreatedAt = *value
@@ -149,6 +186,18 @@ func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
 		builder.Set(card.FieldNumber, *value)
 		c.Number = *value
 	}
+	if len(cc.owner) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "owner", cc.owner); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	for eid := range cc.owner {
+		eid, err := strconv.Atoi(eid)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(card.OwnerColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -158,26 +207,6 @@ func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
 		return nil, rollback(tx, err)
 	}
 	c.ID = strconv.FormatInt(id, 10)
-	if len(cc.owner) > 0 {
-		eid, err := strconv.Atoi(keys(cc.owner)[0])
-		if err != nil {
-			return nil, err
-		}
-		query, args := sql.Update(card.OwnerTable).
-			Set(card.OwnerColumn, eid).
-			Where(sql.EQ(card.FieldID, id).And().IsNull(card.OwnerColumn)).
-			Query()
-		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return nil, rollback(tx, err)
-		}
-		affected, err := res.RowsAffected()
-		if err != nil {
-			return nil, rollback(tx, err)
-		}
-		if int(affected) < len(cc.owner) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"owner\" %v already connected to a different \"Card\"", keys(cc.owner))})
-		}
-	}
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
@@ -186,8 +215,9 @@ func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
 
 func (cc *CardCreate) gremlinSave(ctx context.Context) (*Card, error) {
 	res := &gremlin.Response{}
-	query, bindings := cc.gremlin().Query()
-	if err := cc.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cc.gremlin()
+	query, _ := traversal.Query()
+	if err := cc.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -18,12 +19,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // CardDelete is the builder for deleting a Card entity.
 type CardDelete struct {
 	config
 	predicates []predicate.Card
+	// id of the node, if it's deleted using a CardDeleteOne builder.
+	id *string
 }
 
 // Where adds a new predicate to the delete builder.
@@ -33,7 +37,37 @@ func (cd *CardDelete) Where(ps ...predicate.Card) *CardDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (cd *CardDelete) Exec(ctx context.Context) (int, error) {
+func (cd *CardDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	if cd.shadow != nil {
+		return cd.dualExec(ctx, opts...)
+	}
+	n, err := cd.exec(ctx)
+	if err != nil || n == 0 || options.SkipHooks {
+		return n, err
+	}
+	e := &Event{Op: ent.OpDelete, Type: card.Label, N: n}
+	if cd.id != nil {
+		e.Op, e.ID = ent.OpDeleteOne, *cd.id
+	}
+	cd.bus.publish(e)
+	return n, nil
+}
+
+// exec deletes the Card nodes from the storage of the client dialect.
+func (cd *CardDelete) exec(ctx context.Context) (int, error) {
+	predicates, err := interceptCard(ctx, cd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cd
+		intercepted.predicates = append(predicates, cd.predicates...)
+		cd = &intercepted
+	}
 	switch cd.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return cd.sqlExec(ctx)
@@ -45,8 +79,8 @@ func (cd *CardDelete) Exec(ctx context.Context) (int, error) {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cd *CardDelete) ExecX(ctx context.Context) int {
-	n, err := cd.Exec(ctx)
+func (cd *CardDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := cd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,11 +88,15 @@ func (cd *CardDelete) ExecX(ctx context.Context) int {
 }
 
 func (cd *CardDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = entcache.Invalidates(ctx, card.Tables...)
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(card.Table))
+	selector := sql.Select().From(sql.Table(card.Table)).SetDialect(cd.driver.Dialect()).WithContext(ctx)
 	for _, p := range cd.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(card.Table).FromSelect(selector).Query()
 	if err := cd.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -72,8 +110,9 @@ func (cd *CardDelete) sqlExec(ctx context.Context) (int, error) {
 
 func (cd *CardDelete) gremlinExec(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := cd.gremlin().Query()
-	if err := cd.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cd.gremlin()
+	query, _ := traversal.Query()
+	if err := cd.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -93,12 +132,12 @@ type CardDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (cdo *CardDeleteOne) Exec(ctx context.Context) error {
-	n, err := cdo.cd.Exec(ctx)
+func (cdo *CardDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := cdo.cd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{card.Label}
 	default:
 		return nil
@@ -106,6 +145,8 @@ func (cdo *CardDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cdo *CardDeleteOne) ExecX(ctx context.Context) {
-	cdo.cd.ExecX(ctx)
+func (cdo *CardDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cdo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -26,14 +28,26 @@ import (
 // CardQuery is the builder for querying Card entities.
 type CardQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit     *int
+	offset    *int
+	order     []Order
+	unique    []string
+	fields    []string
+	unordered bool
+	hints     []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.Card
+	// eager-loading edges.
+	withOwner *UserQuery
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath     func(context.Context) (*sql.Selector, error)
+	gremlinPath func(context.Context) (*dsl.Traversal, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -60,25 +74,166 @@ func (cq *CardQuery) Order(o ...Order) *CardQuery {
 	return cq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored. Note that, hints are ignored by Gremlin queries.
+func (cq *CardQuery) Hint(hints ...sql.Hint) *CardQuery {
+	cq.hints = append(cq.hints, hints...)
+	return cq
+}
+
+// defaultOrder returns the default order of the Card queries, as configured in its schema.
+// It's applied on queries that were not ordered explicitly using the Order method.
+func (cq *CardQuery) defaultOrder() []Order {
+	return []Order{
+		Desc(card.FieldNumber),
+	}
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	cs, err := client.Card.Query().
+//		Fields(card.FieldCreatedAt, card.FieldUpdatedAt).
+//		All(ctx)
+//
+func (cq *CardQuery) Fields(fields ...string) *CardQuery {
+	cq.fields = append(cq.fields, fields...)
+	return cq
+}
+
+// Unordered disables the ordering by id that is added to the Gremlin queries that are paginated
+// using Limit or Offset (and the default order of the schema). The order of the vertices
+// (and therefore, the content of the pages) is not guaranteed without it, but it can be removed when
+// the order is irrelevant (e.g. sampling).
+func (cq *CardQuery) Unordered() *CardQuery {
+	cq.unordered = true
+	return cq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (cq *CardQuery) Scope(scopes ...card.Scope) *CardQuery {
+	for _, s := range scopes {
+		cq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				cq.Order(Desc(o.Field))
+			} else {
+				cq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			cq.Limit(s.Limit)
+		}
+	}
+	return cq
+}
+
+// CardInterceptor is a query interceptor of the CardClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the CardClient.Intercept method.
+type CardInterceptor func(context.Context, *CardQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (cq *CardQuery) prepare(ctx context.Context) (*CardQuery, error) {
+	var interceptors []CardInterceptor
+	if cq.inters != nil {
+		cq.inters.mu.RLock()
+		interceptors = cq.inters.Card
+		cq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && cq.sqlPath == nil && cq.gremlinPath == nil {
+		return cq, nil
+	}
+	query := cq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	if path := query.gremlinPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.gremlin, query.gremlinPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptCard returns the predicates that the interceptors of the client add to a Card query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptCard(ctx context.Context, cfg config) ([]predicate.Card, error) {
+	query, err := (&CardQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryOwner chains the current query on the owner edge.
 func (cq *CardQuery) QueryOwner() *UserQuery {
 	query := &UserQuery{config: cq.config}
 	switch cq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := cq.sqlQuery()
-		t2.Select(t2.C(card.OwnerColumn))
-		query.sql = sql.Select(t1.Columns(user.Columns...)...).
-			From(t1).
-			Join(t2).
-			On(t1.C(user.FieldID), t2.C(card.OwnerColumn))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := cq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(card.OwnerColumn))
+			return sql.Select(t1.Columns(user.Columns...)...).
+				From(t1).
+				Join(t2).
+				On(t1.C(user.FieldID), t2.C(card.OwnerColumn)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := cq.gremlinQuery()
-		query.gremlin = gremlin.InE(user.CardLabel).OutV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := cq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.InE(user.CardLabel).OutV(), nil
+		}
 	}
 	return query
 }
 
+// WithOwner tells the query-builder to eager-load the nodes that are connected to the "owner" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Card.Query().
+//		WithOwner(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (cq *CardQuery) WithOwner(opts ...func(*UserQuery)) *CardQuery {
+	query := &UserQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withOwner = query
+	return cq
+}
+
 // First returns the first Card entity in the query. Returns *ErrNotFound when no card was found.
 func (cq *CardQuery) First(ctx context.Context) (*Card, error) {
 	cs, err := cq.Limit(1).All(ctx)
@@ -124,7 +279,7 @@ func (cq *CardQuery) FirstXID(ctx context.Context) string {
 
 // Only returns the only Card entity in the query, returns an error if not exactly one entity was returned.
 func (cq *CardQuery) Only(ctx context.Context) (*Card, error) {
-	cs, err := cq.Limit(2).All(ctx)
+	cs, err := cq.Limit(2).Unordered().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +305,7 @@ func (cq *CardQuery) OnlyX(ctx context.Context) *Card {
 // OnlyID returns the only Card id in the query, returns an error if not exactly one id was returned.
 func (cq *CardQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = cq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = cq.Limit(2).Unordered().IDs(ctx); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -174,33 +329,63 @@ func (cq *CardQuery) OnlyXID(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of Cards.
-func (cq *CardQuery) All(ctx context.Context) ([]*Card, error) {
-	switch cq.driver.Dialect() {
+func (cq *CardQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*Card, error) {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.shadow != nil && !ent.NewCallOptions(opts...).SkipHooks {
+		return query.dualAll(ctx)
+	}
+
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return cq.sqlAll(ctx)
+		return query.sqlAll(ctx)
 	case dialect.Gremlin:
-		return cq.gremlinAll(ctx)
+		return query.gremlinAll(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
 // AllX is like All, but panics if an error occurs.
-func (cq *CardQuery) AllX(ctx context.Context) []*Card {
-	cs, err := cq.All(ctx)
+func (cq *CardQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*Card {
+	cs, err := cq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return cs
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (cq *CardQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlQueryString(ctx)
+	case dialect.Gremlin:
+		return query.gremlinQueryString(ctx)
+	default:
+		return "", nil, errors.New("ent: unsupported dialect")
+	}
+}
+
 // IDs executes the query and returns a list of Card ids.
 func (cq *CardQuery) IDs(ctx context.Context) ([]string, error) {
-	switch cq.driver.Dialect() {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return cq.sqlIDs(ctx)
+		return query.sqlIDs(ctx)
 	case dialect.Gremlin:
-		return cq.gremlinIDs(ctx)
+		return query.gremlinIDs(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
@@ -215,13 +400,46 @@ func (cq *CardQuery) IDsX(ctx context.Context) []string {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Cards that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (cq *CardQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if cq.sql != nil || cq.gremlin != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &CardQuery{
+		config:     cq.config,
+		predicates: append([]predicate.Card{}, cq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(string)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for Card", after)
+		}
+		query.Where(card.IDGT(id))
+	}
+	ids, err := query.Order(Asc(card.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (cq *CardQuery) Count(ctx context.Context) (int, error) {
-	switch cq.driver.Dialect() {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return cq.sqlCount(ctx)
+		return query.sqlCount(ctx)
 	case dialect.Gremlin:
-		return cq.gremlinCount(ctx)
+		return query.gremlinCount(ctx)
 	default:
 		return 0, errors.New("ent: unsupported dialect")
 	}
@@ -238,11 +456,15 @@ func (cq *CardQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (cq *CardQuery) Exist(ctx context.Context) (bool, error) {
-	switch cq.driver.Dialect() {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return cq.sqlExist(ctx)
+		return query.sqlExist(ctx)
 	case dialect.Gremlin:
-		return cq.gremlinExist(ctx)
+		return query.gremlinExist(ctx)
 	default:
 		return false, errors.New("ent: unsupported dialect")
 	}
@@ -266,10 +488,17 @@ func (cq *CardQuery) Clone() *CardQuery {
 		offset:     cq.offset,
 		order:      append([]Order{}, cq.order...),
 		unique:     append([]string{}, cq.unique...),
+		fields:     append([]string{}, cq.fields...),
+		unordered:  cq.unordered,
+		hints:      append([]sql.Hint{}, cq.hints...),
+		edgeCount:  cq.edgeCount,
 		predicates: append([]predicate.Card{}, cq.predicates...),
+		withOwner:  cq.withOwner,
 		// clone intermediate queries.
-		sql:     cq.sql.Clone(),
-		gremlin: cq.gremlin.Clone(),
+		sql:         cq.sql.Clone(),
+		sqlPath:     cq.sqlPath,
+		gremlin:     cq.gremlin.Clone(),
+		gremlinPath: cq.gremlinPath,
 	}
 }
 
@@ -291,11 +520,18 @@ func (cq *CardQuery) Clone() *CardQuery {
 func (cq *CardQuery) GroupBy(field string, fields ...string) *CardGroupBy {
 	group := &CardGroupBy{config: cq.config}
 	group.fields = append([]string{field}, fields...)
-	switch cq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		group.sql = cq.sqlQuery()
-	case dialect.Gremlin:
-		group.gremlin = cq.gremlinQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := cq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			group.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			group.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return group
 }
@@ -315,20 +551,27 @@ func (cq *CardQuery) GroupBy(field string, fields ...string) *CardGroupBy {
 func (cq *CardQuery) Select(field string, fields ...string) *CardSelect {
 	selector := &CardSelect{config: cq.config}
 	selector.fields = append([]string{field}, fields...)
-	switch cq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		selector.sql = cq.sqlQuery()
-	case dialect.Gremlin:
-		selector.gremlin = cq.gremlinQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := cq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			selector.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			selector.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return selector
 }
 
 func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
 	rows := &sql.Rows{}
-	selector := cq.sqlQuery()
-	if unique := cq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := cq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := cq.driver.Query(ctx, query, args, rows); err != nil {
@@ -336,21 +579,145 @@ func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
 	}
 	defer rows.Close()
 	var cs Cards
-	if err := cs.FromRows(rows); err != nil {
+	if err := cs.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	cs.config(cq.config)
+	if query := cq.withOwner; query != nil {
+		if err := cq.sqlLoadOwner(ctx, query, cs); err != nil {
+			return nil, err
+		}
+	}
 	return cs, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (cq *CardQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := cq.sqlQuery(ctx)
+	if unique := cq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	if len(cq.order) == 0 {
+		for _, p := range cq.defaultOrder() {
+			p(selector)
+		}
+	}
+	columns := card.Columns
+	if fields := cq.fields; len(fields) > 0 {
+		columns = append([]string{card.FieldID}, fields...)
+		for _, c := range fields {
+			if !card.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (cq *CardQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := cq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadOwner loads the nodes of the owner edge of the given Cards using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (cq *CardQuery) sqlLoadOwner(ctx context.Context, query *UserQuery, nodes []*Card) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*Card, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(card.OwnerTable)
+	selector.Join(t1).On(t1.C(card.OwnerColumn), selector.C(user.FieldID))
+	key := t1.C(card.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := cq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Owner = edge
+	}
+	return nil
+}
+
 func (cq *CardQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := cq.sqlQuery()
+	selector := cq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{card.FieldID}
 	if len(cq.unique) > 0 {
 		unique = cq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := cq.edgeCount; c != nil && len(cq.predicates) == 0 && len(cq.unique) == 0 &&
+		len(cq.hints) == 0 && cq.limit == nil && cq.offset == nil {
+		selector = c.Clone().SetDialect(cq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := cq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -367,11 +734,22 @@ func (cq *CardQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (cq *CardQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := cq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := cq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(card.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := cq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := cq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (cq *CardQuery) sqlIDs(ctx context.Context) ([]string, error) {
@@ -386,13 +764,33 @@ func (cq *CardQuery) sqlIDs(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
-func (cq *CardQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (cq *CardQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(card.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (cq *CardQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(card.Table)
 	selector := sql.Select(t1.Columns(card.Columns...)...).From(t1)
 	if cq.sql != nil {
 		selector = cq.sql
 		selector.Select(selector.Columns(card.Columns...)...)
 	}
+	selector.SetDialect(cq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(cq.hints...)
 	for _, p := range cq.predicates {
 		p(selector)
 	}
@@ -412,8 +810,9 @@ func (cq *CardQuery) sqlQuery() *sql.Selector {
 
 func (cq *CardQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := cq.gremlinQuery().Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cq.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := cq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	vertices, err := res.ReadVertices()
@@ -429,8 +828,9 @@ func (cq *CardQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 
 func (cq *CardQuery) gremlinAll(ctx context.Context) ([]*Card, error) {
 	res := &gremlin.Response{}
-	query, bindings := cq.gremlinQuery().ValueMap(true).Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cq.gremlinAllQuery()
+	query, _ := traversal.Query()
+	if err := cq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	var cs Cards
@@ -438,13 +838,55 @@ func (cq *CardQuery) gremlinAll(ctx context.Context) ([]*Card, error) {
 		return nil, err
 	}
 	cs.config(cq.config)
+	if query := cq.withOwner; query != nil {
+		if err := cq.gremlinLoadOwner(ctx, query, cs); err != nil {
+			return nil, err
+		}
+	}
 	return cs, nil
 }
 
+// gremlinAllQuery returns the traversal of All, that returns the value maps of the vertices.
+func (cq *CardQuery) gremlinAllQuery() *dsl.Traversal {
+	args := []interface{}{true}
+	for _, f := range cq.fields {
+		args = append(args, f)
+	}
+	return cq.gremlinQuery().ValueMap(args...)
+}
+
+func (cq *CardQuery) gremlinQueryString(context.Context) (string, interface{}, error) {
+	query, bindings := cq.gremlinAllQuery().Query()
+	return query, bindings, nil
+}
+
+// gremlinLoadOwner loads the nodes of the owner edge of the given Cards using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (cq *CardQuery) gremlinLoadOwner(ctx context.Context, query *UserQuery, nodes []*Card) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&CardClient{config: cq.config}).QueryOwner(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Owner = edges[0]
+		}
+	}
+	return nil
+}
+
 func (cq *CardQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := cq.gremlinQuery().Count().Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cq.gremlinTraversal(false).Count()
+	query, _ := traversal.Query()
+	if err := cq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -452,14 +894,22 @@ func (cq *CardQuery) gremlinCount(ctx context.Context) (int, error) {
 
 func (cq *CardQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
-	query, bindings := cq.gremlinQuery().HasNext().Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cq.gremlinTraversal(false).HasNext()
+	query, _ := traversal.Query()
+	if err := cq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
 }
 
+// gremlinQuery returns the traversal of the query for listing its vertices, with its default orders.
 func (cq *CardQuery) gremlinQuery() *dsl.Traversal {
+	return cq.gremlinTraversal(true)
+}
+
+// gremlinTraversal returns the traversal of the query. The default orders are added only to the traversals
+// of listing queries (list), since they don't change the results of the others (e.g. Count and Exist).
+func (cq *CardQuery) gremlinTraversal(list bool) *dsl.Traversal {
 	v := g.V().HasLabel(card.Label)
 	if cq.gremlin != nil {
 		v = cq.gremlin.Clone()
@@ -467,13 +917,25 @@ func (cq *CardQuery) gremlinQuery() *dsl.Traversal {
 	for _, p := range cq.predicates {
 		p(v)
 	}
-	if len(cq.order) > 0 {
+	limit, offset := cq.limit, cq.offset
+	list = list && !cq.unordered
+	// paginated queries are ordered by id (also as a tie-breaker of the
+	// query orders), in order to keep the pages stable between calls.
+	stable := (limit != nil || offset != nil) && list
+	order := cq.order
+	if len(order) == 0 && list {
+		order = cq.defaultOrder()
+	}
+	if len(order) > 0 || stable {
 		v.Order()
-		for _, p := range cq.order {
+		for _, p := range order {
 			p(v)
 		}
+		if stable {
+			v.By(dsl.ID, dsl.Incr)
+		}
 	}
-	switch limit, offset := cq.limit, cq.offset; {
+	switch {
 	case limit != nil && offset != nil:
 		v.Range(*offset, *offset+*limit)
 	case offset != nil:
@@ -495,6 +957,11 @@ type CardGroupBy struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving     *sql.Predicate
+	gremlinHaving *dsl.Traversal
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -503,8 +970,27 @@ func (cgb *CardGroupBy) Aggregate(fns ...Aggregate) *CardGroupBy {
 	return cgb
 }
 
+// Having adds per-dialect predicates on the aggregated values of the groups.
+// In Gremlin, the predicate is applied using a `where` step on the values of each group.
+// For example:
+//
+//	client.Card.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5), __.Select(ent.DefaultCountLabel).Is(p.GT(5))).
+//		Scan(ctx, &v)
+//
+func (cgb *CardGroupBy) Having(p0 *sql.Predicate, p1 *dsl.Traversal) *CardGroupBy {
+	cgb.sqlHaving = p0
+	cgb.gremlinHaving = p1
+	return cgb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (cgb *CardGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := cgb.path(ctx); err != nil {
+		return err
+	}
 	switch cgb.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return cgb.sqlScan(ctx, v)
@@ -607,6 +1093,9 @@ func (cgb *CardGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (cgb *CardGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := cgb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := cgb.sqlQuery().Query()
 	if err := cgb.driver.Query(ctx, query, args, rows); err != nil {
@@ -623,13 +1112,18 @@ func (cgb *CardGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range cgb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(cgb.fields...)
+	selector.Select(columns...).GroupBy(cgb.fields...)
+	if cgb.sqlHaving != nil {
+		selector.Having(cgb.sqlHaving)
+	}
+	return selector
 }
 
 func (cgb *CardGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
-	query, bindings := cgb.gremlinQuery().Query()
-	if err := cgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cgb.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := cgb.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(cgb.fields)+len(cgb.fns) == 1 {
@@ -656,11 +1150,14 @@ func (cgb *CardGroupBy) gremlinQuery() *dsl.Traversal {
 		names = append(names, f)
 		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
 	}
-	return cgb.gremlin.Group().
+	v := cgb.gremlin.Group().
 		By(__.Values(cgb.fields...).Fold()).
 		By(__.Fold().Match(trs...).Select(names...)).
-		Select(dsl.Values).
-		Next()
+		Select(dsl.Values)
+	if cgb.gremlinHaving != nil {
+		v = v.Unfold().Where(cgb.gremlinHaving).Fold()
+	}
+	return v.Next()
 }
 
 // CardSelect is the builder for select fields of Card entities.
@@ -670,10 +1167,15 @@ type CardSelect struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (cs *CardSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cs.path(ctx); err != nil {
+		return err
+	}
 	switch cs.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return cs.sqlScan(ctx, v)
@@ -776,6 +1278,9 @@ func (cs *CardSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (cs *CardSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := cs.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := cs.sqlQuery().Query()
 	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
@@ -804,8 +1309,8 @@ func (cs *CardSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		}
 		traversal = cs.gremlin.ValueMap(fields...)
 	}
-	query, bindings := traversal.Query()
-	if err := cs.driver.Exec(ctx, query, bindings, res); err != nil {
+	query, _ := traversal.Query()
+	if err := cs.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(cs.fields) == 1 {
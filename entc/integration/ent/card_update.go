@@ -11,8 +11,8 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
-	"time"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -23,17 +23,17 @@ import (
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // CardUpdate is the builder for updating Card entities.
 type CardUpdate struct {
 	config
-
-	updated_at   *time.Time
-	number       *string
-	owner        map[string]struct{}
-	clearedOwner bool
-	predicates   []predicate.Card
+	cardMutation
+	predicates []predicate.Card
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Card
 }
 
 // Where adds a new predicate for the builder.
@@ -42,6 +42,11 @@ func (cu *CardUpdate) Where(ps ...predicate.Card) *CardUpdate {
 	return cu
 }
 
+// Mutation returns the mutation of the builder.
+func (cu *CardUpdate) Mutation() *CardMutation {
+	return &CardMutation{cardMutation: &cu.cardMutation, op: ent.OpUpdate}
+}
+
 // SetNumber sets the number field.
 func (cu *CardUpdate) SetNumber(s string) *CardUpdate {
 	cu.number = &s
@@ -77,18 +82,57 @@ func (cu *CardUpdate) ClearOwner() *CardUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (cu *CardUpdate) Save(ctx context.Context) (int, error) {
+func (cu *CardUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := cu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (cu *CardUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]string, error) {
+	options := ent.NewCallOptions(opts...)
 	if cu.updated_at == nil {
 		v := card.UpdateDefaultUpdatedAt()
 		cu.updated_at = &v
 	}
+	if cu.number != nil {
+		v := card.NumberTransformer(*cu.number)
+		cu.number = &v
+	}
 	if cu.number != nil {
 		if err := card.NumberValidator(*cu.number); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"number\": %v", err)
+			return nil, fmt.Errorf("ent: validator failed for field \"number\": %v", err)
 		}
 	}
 	if len(cu.owner) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"owner\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if cu.shadow != nil {
+		return cu.dualSave(ctx, opts...)
+	}
+	ids, err := cu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 && !options.SkipHooks {
+		cu.bus.publish(&Event{Op: ent.OpUpdate, Type: card.Label, N: len(ids)})
+	}
+	return ids, nil
+}
+
+// save updates the Card nodes in the storage of the client dialect.
+func (cu *CardUpdate) save(ctx context.Context) ([]string, error) {
+	predicates, err := interceptCard(ctx, cu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cu
+		intercepted.predicates = append(predicates, cu.predicates...)
+		cu = &intercepted
 	}
 	switch cu.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
@@ -96,13 +140,26 @@ func (cu *CardUpdate) Save(ctx context.Context) (int, error) {
 	case dialect.Gremlin:
 		return cu.gremlinSave(ctx)
 	default:
-		return 0, errors.New("ent: unsupported dialect")
+		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (cu *CardUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Card, error) {
+	var cs []*Card
+	cu.returning = &cs
+	defer func() { cu.returning = nil }()
+	if _, err := cu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (cu *CardUpdate) SaveX(ctx context.Context) int {
-	affected, err := cu.Save(ctx)
+func (cu *CardUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := cu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -110,44 +167,49 @@ func (cu *CardUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (cu *CardUpdate) Exec(ctx context.Context) error {
-	_, err := cu.Save(ctx)
+func (cu *CardUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := cu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cu *CardUpdate) ExecX(ctx context.Context) {
-	if err := cu.Exec(ctx); err != nil {
+func (cu *CardUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(card.FieldID).From(sql.Table(card.Table))
+func (cu *CardUpdate) sqlSave(ctx context.Context) (_ []string, err error) {
+	ctx = entcache.Invalidates(ctx, card.Tables...)
+	selector := sql.Select(card.FieldID).From(sql.Table(card.Table)).SetDialect(cu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range cu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = cu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := cu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -162,56 +224,108 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if cu.clearedOwner {
+	if cu.clearedOwner && len(cu.owner) == 0 {
 		query, args := sql.Update(card.OwnerTable).
 			SetNull(card.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(cu.owner) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "owner", cu.owner); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for _, id := range ids {
 			eid, serr := strconv.Atoi(keys(cu.owner)[0])
 			if serr != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
+			}
+			p := sql.EQ(card.FieldID, id)
+			if !cu.clearedOwner {
+				p = p.And().IsNull(card.OwnerColumn)
 			}
 			query, args := sql.Update(card.OwnerTable).
 				Set(card.OwnerColumn, eid).
-				Where(sql.EQ(card.FieldID, id).And().IsNull(card.OwnerColumn)).
+				Where(p).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(cu.owner) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"owner\" %v already connected to a different \"Card\"", keys(cu.owner))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"owner\" %v already connected to a different \"Card\"", keys(cu.owner))})
 			}
 		}
 	}
+	if cu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(card.Columns...).
+			From(sql.Table(card.Table)).
+			Where(sql.InInts(card.FieldID, ids...)).
+			SetDialect(cu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var cs Cards
+		err := cs.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Card: %v", err))
+		}
+		cs.config(cu.config)
+		*cu.returning = cs
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
+	}
+	vs := make([]string, len(ids))
+	for i, id := range ids {
+		vs[i] = strconv.Itoa(id)
 	}
-	return len(ids), nil
+	return vs, nil
 }
 
-func (cu *CardUpdate) gremlinSave(ctx context.Context) (int, error) {
+func (cu *CardUpdate) gremlinSave(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := cu.gremlin().Query()
-	if err := cu.driver.Exec(ctx, query, bindings, res); err != nil {
-		return 0, err
+	traversal := cu.gremlin()
+	query, _ := traversal.Query()
+	if err := cu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
-		return 0, err
+		return nil, err
+	}
+	if cu.returning != nil {
+		var cs Cards
+		if err := cs.FromResponse(res); err != nil {
+			return nil, err
+		}
+		cs.config(cu.config)
+		*cu.returning = cs
+		ids := make([]string, 0, len(cs))
+		for _, c := range cs {
+			ids = append(ids, c.ID)
+		}
+		return ids, nil
+	}
+	vertices, err := res.ReadVertices()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vertices))
+	for _, vertex := range vertices {
+		ids = append(ids, vertex.ID.(string))
 	}
-	return res.ReadInt()
+	return ids, nil
 }
 
 func (cu *CardUpdate) gremlin() *dsl.Traversal {
@@ -247,11 +361,13 @@ func (cu *CardUpdate) gremlin() *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(card.Label, user.CardLabel, id)),
 		})
 	}
-	v.Count()
+	if cu.returning != nil {
+		v.ValueMap(true)
+	}
 	if len(constraints) > 0 {
 		constraints = append(constraints, &constraint{
 			pred: rv.Count(),
-			test: __.Is(p.GT(1)).Constant(&ErrConstraintFailed{msg: "update traversal contains more than one vertex"}),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
 		})
 		v = constraints[0].pred.Coalesce(constraints[0].test, v)
 		for _, cr := range constraints[1:] {
@@ -265,12 +381,16 @@ func (cu *CardUpdate) gremlin() *dsl.Traversal {
 // CardUpdateOne is the builder for updating a single Card entity.
 type CardUpdateOne struct {
 	config
-	id string
+	id     string
+	entity *Card
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Card
+	cardMutation
+}
 
-	updated_at   *time.Time
-	number       *string
-	owner        map[string]struct{}
-	clearedOwner bool
+// Mutation returns the mutation of the builder.
+func (cuo *CardUpdateOne) Mutation() *CardMutation {
+	return &CardMutation{cardMutation: &cuo.cardMutation, op: ent.OpUpdateOne, id: &cuo.id}
 }
 
 // SetNumber sets the number field.
@@ -307,12 +427,34 @@ func (cuo *CardUpdateOne) ClearOwner() *CardUpdateOne {
 	return cuo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (cuo *CardUpdateOne) SetChangedFrom(original *Card) *CardUpdateOne {
+	c := cuo.entity
+	if c == nil || original == nil {
+		return cuo
+	}
+	for _, change := range DiffCard(original, c) {
+		switch change.Field {
+		case card.FieldNumber:
+			cuo.SetNumber(c.Number)
+		}
+	}
+	return cuo
+}
+
 // Save executes the query and returns the updated entity.
-func (cuo *CardUpdateOne) Save(ctx context.Context) (*Card, error) {
+func (cuo *CardUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Card, error) {
+	options := ent.NewCallOptions(opts...)
 	if cuo.updated_at == nil {
 		v := card.UpdateDefaultUpdatedAt()
 		cuo.updated_at = &v
 	}
+	if cuo.number != nil {
+		v := card.NumberTransformer(*cuo.number)
+		cuo.number = &v
+	}
 	if cuo.number != nil {
 		if err := card.NumberValidator(*cuo.number); err != nil {
 			return nil, fmt.Errorf("ent: validator failed for field \"number\": %v", err)
@@ -321,6 +463,34 @@ func (cuo *CardUpdateOne) Save(ctx context.Context) (*Card, error) {
 	if len(cuo.owner) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if cuo.shadow != nil {
+		return cuo.dualSave(ctx, opts...)
+	}
+	c, err := cuo.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		cuo.bus.publish(&Event{Op: ent.OpUpdateOne, Type: card.Label, ID: c.ID, Node: c, N: 1})
+	}
+	return c, nil
+}
+
+// save updates the Card in the storage of the client dialect.
+func (cuo *CardUpdateOne) save(ctx context.Context) (*Card, error) {
+	predicates, err := interceptCard(ctx, cuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cuo
+		intercepted.predicates = append(predicates, cuo.predicates...)
+		cuo = &intercepted
+	}
 	switch cuo.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return cuo.sqlSave(ctx)
@@ -332,8 +502,8 @@ func (cuo *CardUpdateOne) Save(ctx context.Context) (*Card, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (cuo *CardUpdateOne) SaveX(ctx context.Context) *Card {
-	c, err := cuo.Save(ctx)
+func (cuo *CardUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Card {
+	c, err := cuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -341,21 +511,29 @@ func (cuo *CardUpdateOne) SaveX(ctx context.Context) *Card {
 }
 
 // Exec executes the query on the entity.
-func (cuo *CardUpdateOne) Exec(ctx context.Context) error {
-	_, err := cuo.Save(ctx)
+func (cuo *CardUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := cuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cuo *CardUpdateOne) ExecX(ctx context.Context) {
-	if err := cuo.Exec(ctx); err != nil {
+func (cuo *CardUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
-	selector := sql.Select(card.Columns...).From(sql.Table(card.Table))
+	ctx = entcache.Invalidates(ctx, card.Tables...)
+	selector := sql.Select(card.Columns...).From(sql.Table(card.Table)).SetDialect(cuo.driver.Dialect())
 	card.ID(cuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range cuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = cuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -401,7 +579,7 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if cuo.clearedOwner {
+	if cuo.clearedOwner && len(cuo.owner) == 0 {
 		query, args := sql.Update(card.OwnerTable).
 			SetNull(card.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
@@ -411,14 +589,21 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 		}
 	}
 	if len(cuo.owner) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "owner", cuo.owner); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for _, id := range ids {
 			eid, serr := strconv.Atoi(keys(cuo.owner)[0])
 			if serr != nil {
 				return nil, rollback(tx, err)
 			}
+			p := sql.EQ(card.FieldID, id)
+			if !cuo.clearedOwner {
+				p = p.And().IsNull(card.OwnerColumn)
+			}
 			query, args := sql.Update(card.OwnerTable).
 				Set(card.OwnerColumn, eid).
-				Where(sql.EQ(card.FieldID, id).And().IsNull(card.OwnerColumn)).
+				Where(p).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
 				return nil, rollback(tx, err)
@@ -428,7 +613,7 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(cuo.owner) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"owner\" %v already connected to a different \"Card\"", keys(cuo.owner))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"owner\" %v already connected to a different \"Card\"", keys(cuo.owner))})
 			}
 		}
 	}
@@ -440,8 +625,9 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 
 func (cuo *CardUpdateOne) gremlinSave(ctx context.Context) (*Card, error) {
 	res := &gremlin.Response{}
-	query, bindings := cuo.gremlin(cuo.id).Query()
-	if err := cuo.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cuo.gremlin(cuo.id)
+	query, _ := traversal.Query()
+	if err := cuo.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -461,6 +647,9 @@ func (cuo *CardUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 1)
 	v := g.V(id)
+	for _, p := range cuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
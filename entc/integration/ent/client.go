@@ -13,6 +13,7 @@ import (
 	"net/url"
 
 	"github.com/facebookincubator/ent/entc/integration/ent/migrate"
+	"github.com/facebookincubator/ent/entcache"
 
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/ent/comment"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/g"
 	"github.com/facebookincubator/ent/dialect/sql"
 )
@@ -63,7 +65,7 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	c := config{log: log.Println}
+	c := config{log: log.Println, inters: &inters{}, bus: &bus{}}
 	c.options(opts...)
 	return &Client{
 		config:    c,
@@ -92,7 +94,7 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 		if err != nil {
 			return nil, err
 		}
-		return NewClient(append(options, Driver(drv))...), nil
+		return open(append(options, Driver(drv))...)
 
 	case dialect.Gremlin:
 		u, err := url.Parse(dataSourceName)
@@ -105,13 +107,95 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 			},
 		})
 		drv := gremlin.NewDriver(c)
-		return NewClient(append(options, Driver(drv))...), nil
+		return open(append(options, Driver(drv))...)
 
 	default:
 		return nil, fmt.Errorf("unsupported driver: %q", driverName)
 	}
 }
 
+// Ping verifies that the connection to the database is still alive.
+func (c *Client) Ping(ctx context.Context) error {
+	switch c.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		rows := &sql.Rows{}
+		if err := c.driver.Query(ctx, "SELECT 1", []interface{}{}, rows); err != nil {
+			return err
+		}
+		return rows.Close()
+
+	case dialect.Gremlin:
+		rsp := &gremlin.Response{}
+		traversal := g.Inject(1)
+		query, _ := traversal.Query()
+		if err := c.driver.Exec(ctx, query, traversal, rsp); err != nil {
+			return err
+		}
+		return rsp.Err()
+
+	default:
+		return fmt.Errorf("ent: unsupported dialect: %q", c.driver.Dialect())
+	}
+}
+
+// Ready reports whether the client is ready for serving requests. It checks that the
+// database is reachable, and that its schema was migrated by the same version of the
+// generated code.
+func (c *Client) Ready(ctx context.Context) error {
+	if err := c.Ping(ctx); err != nil {
+		return fmt.Errorf("ent: ping database: %v", err)
+	}
+	return c.CheckSchema(ctx)
+}
+
+// CheckSchema checks that the database schema was migrated by the same version of the
+// generated code (see migrate.Hash), and returns an *ErrSchemaVersion if it was not.
+// While the schema is in its expanded state (see migrate.ModeExpand), both the expanded
+// version and the previous one are accepted. Dialects without migration support are not
+// checked.
+func (c *Client) CheckSchema(ctx context.Context) error {
+	switch c.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		versions, err := c.Schema.Versions(ctx)
+		if err != nil {
+			return fmt.Errorf("ent: reading schema versions: %v", err)
+		}
+		if len(versions) == 0 {
+			return &ErrSchemaVersion{Client: migrate.Hash}
+		}
+		for _, v := range versions {
+			if v == migrate.Hash {
+				return nil
+			}
+		}
+		return &ErrSchemaVersion{Database: versions[0], Client: migrate.Hash}
+
+	case dialect.Gremlin:
+		return nil
+
+	default:
+		return fmt.Errorf("ent: unsupported dialect: %q", c.driver.Dialect())
+	}
+}
+
+// open creates a new client configured with the given options, and runs the
+// schema verification in case it was configured using the VerifySchema option.
+func open(opts ...Option) (*Client, error) {
+	c := NewClient(opts...)
+	if c.verify == nil {
+		return c, nil
+	}
+	switch err := c.CheckSchema(context.Background()); {
+	case err == nil:
+	case IsSchemaVersion(err) && !*c.verify:
+		c.log(err)
+	default:
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
 // Tx returns a new transactional client.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
@@ -121,7 +205,10 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: tx, log: c.log, debug: c.debug}
+	cfg := config{driver: tx, log: c.log, debug: c.debug, slow: c.slow, inters: c.inters.clone(), bus: c.bus.tx()}
+	if c.shadow != nil {
+		cfg.shadow, cfg.report, cfg.mirror = c.shadow, c.report, &mirror{}
+	}
 	return &Tx{
 		config:    cfg,
 		Card:      NewCardClient(cfg),
@@ -149,7 +236,9 @@ func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := config{driver: dialect.Debug(c.driver, c.log), log: c.log, debug: true}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	cfg.debug = true
 	return &Client{
 		config:    cfg,
 		Schema:    migrate.NewSchema(cfg.driver),
@@ -167,6 +256,48 @@ func (c *Client) Debug() *Client {
 	}
 }
 
+// With returns a shallow copy of the client configured with the given options, in addition
+// to the options of the client. The original client is not modified, and therefore, it's safe
+// to call With concurrently on a shared client (e.g. for a debug client of one request). The
+// driver of a transactional client (see Tx.Client) is not wrapped again by the logging options.
+//
+//	client.With(ent.Debug(), ent.Log(logger.Println)).
+//		Card.
+//		Query().
+//		Count(ctx)
+//
+func (c *Client) With(opts ...Option) *Client {
+	cfg := c.config
+	cfg.options(opts...)
+	cfg.inters = c.inters.clone()
+	return &Client{
+		config:    cfg,
+		Schema:    migrate.NewSchema(cfg.driver),
+		Card:      NewCardClient(cfg),
+		Comment:   NewCommentClient(cfg),
+		FieldType: NewFieldTypeClient(cfg),
+		File:      NewFileClient(cfg),
+		FileType:  NewFileTypeClient(cfg),
+		Group:     NewGroupClient(cfg),
+		GroupInfo: NewGroupInfoClient(cfg),
+		Item:      NewItemClient(cfg),
+		Node:      NewNodeClient(cfg),
+		Pet:       NewPetClient(cfg),
+		User:      NewUserClient(cfg),
+	}
+}
+
+// Dialect returns the dialect of the client driver. It can be used to check
+// the features that are supported by the underlying database at runtime.
+//
+//	if client.Dialect().Supports(dialect.Upsert) {
+//		// ...
+//	}
+//
+func (c *Client) Dialect() dialect.Name {
+	return dialect.Name(c.driver.Dialect())
+}
+
 // Close closes the database connection and prevents new queries from starting.
 func (c *Client) Close() error {
 	return c.driver.Close()
@@ -177,11 +308,48 @@ type CardClient struct {
 	config
 }
 
+// CardQuerier is the read API of the CardClient. It's implemented by the CardClient, and it
+// can be used by services that depend only on reading Cards, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type CardQuerier interface {
+	Get(ctx context.Context, id string) (*Card, error)
+	GetX(ctx context.Context, id string) *Card
+	GetNotFoundOK(ctx context.Context, id string) (*Card, error)
+	Exist(ctx context.Context, id string) (bool, error)
+	ExistX(ctx context.Context, id string) bool
+	Reload(ctx context.Context, nodes ...*Card) error
+	ReloadX(ctx context.Context, nodes ...*Card)
+}
+
+var _ CardQuerier = (*CardClient)(nil)
+
 // NewCardClient returns a client for the Card from the given config.
 func NewCardClient(c config) *CardClient {
 	return &CardClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the Card queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.Card.Intercept(func(ctx context.Context, q *CardQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(card.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *CardClient) Intercept(interceptors ...CardInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.Card = append(c.inters.Card, interceptors...)
+}
+
 // Create returns a create builder for Card.
 func (c *CardClient) Create() *CardCreate {
 	return &CardCreate{config: c.config}
@@ -194,7 +362,7 @@ func (c *CardClient) Update() *CardUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *CardClient) UpdateOne(ca *Card) *CardUpdateOne {
-	return c.UpdateOneID(ca.ID)
+	return &CardUpdateOne{config: c.config, id: ca.ID, entity: ca}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -214,7 +382,64 @@ func (c *CardClient) DeleteOne(ca *Card) *CardDeleteOne {
 
 // DeleteOneID returns a delete builder for the given id.
 func (c *CardClient) DeleteOneID(id string) *CardDeleteOne {
-	return &CardDeleteOne{c.Delete().Where(card.ID(id))}
+	builder := c.Delete().Where(card.ID(id))
+	builder.id = &id
+	return &CardDeleteOne{builder}
+}
+
+// Touch sets the "updated_at" field of the Card with the given id to the current
+// time in a single statement. It's useful for invalidating caches or re-sorting lists by
+// recency. Note that, other update defaults and hooks are not executed.
+func (c *CardClient) Touch(ctx context.Context, id string) error {
+	switch c.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		ctx = entcache.Invalidates(ctx, card.Tables...)
+		var res sql.Result
+		query, args := sql.Update(card.Table).
+			Set(card.FieldUpdatedAt, card.UpdateDefaultUpdatedAt()).
+			Where(sql.EQ(card.FieldID, id)).
+			Query()
+		if err := c.driver.Exec(ctx, query, args, &res); err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		// MySQL reports the number of changed rows (and not matched), and therefore,
+		// the existence of the row is checked only if no rows were changed.
+		if affected > 0 {
+			return nil
+		}
+		switch exist, err := c.Query().Where(card.ID(id)).Exist(ctx); {
+		case err != nil:
+			return err
+		case !exist:
+			return &ErrNotFound{fmt.Sprintf("Card with id: %v", id)}
+		default:
+			return nil
+		}
+	case dialect.Gremlin:
+		res := &gremlin.Response{}
+		traversal := g.V(id).
+			HasLabel(card.Label).
+			Property(dsl.Single, card.FieldUpdatedAt, card.UpdateDefaultUpdatedAt()).
+			Count()
+		query, _ := traversal.Query()
+		if err := c.driver.Exec(ctx, query, traversal, res); err != nil {
+			return err
+		}
+		n, err := res.ReadInt()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return &ErrNotFound{fmt.Sprintf("Card with id: %v", id)}
+		}
+		return nil
+	default:
+		return fmt.Errorf("ent: unsupported dialect %q", c.driver.Dialect())
+	}
 }
 
 // Create returns a query builder for Card.
@@ -236,6 +461,71 @@ func (c *CardClient) GetX(ctx context.Context, id string) *Card {
 	return ca
 }
 
+// GetNotFoundOK is like Get, but returns a nil Card without an error, if there is no entity with the given id.
+func (c *CardClient) GetNotFoundOK(ctx context.Context, id string) (*Card, error) {
+	ca, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return ca, err
+}
+
+// Exist reports if a Card entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *CardClient) Exist(ctx context.Context, id string) (bool, error) {
+	return c.Query().Where(card.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *CardClient) ExistX(ctx context.Context, id string) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Cards in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *CardClient) Reload(ctx context.Context, nodes ...*Card) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(card.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*Card, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, ca := range nodes {
+		if _, ok := byID[ca.ID]; !ok {
+			return &ErrNotFound{card.Label}
+		}
+	}
+	for _, ca := range nodes {
+		v := byID[ca.ID]
+		ca.CreatedAt = v.CreatedAt
+		ca.UpdatedAt = v.UpdatedAt
+		ca.Number = v.Number
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *CardClient) ReloadX(ctx context.Context, nodes ...*Card) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
 // QueryOwner queries the owner edge of a Card.
 func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -247,6 +537,9 @@ func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
 			From(sql.Table(card.OwnerTable)).
 			Where(sql.EQ(card.FieldID, id))
 		query.sql = sql.Select().From(t1).Join(t2).On(t1.C(user.FieldID), t2.C(card.OwnerColumn))
+		query.edgeCount = sql.Select().Count(card.OwnerColumn).
+			From(sql.Table(card.OwnerTable)).
+			Where(sql.EQ(card.FieldID, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(ca.ID).InE(user.CardLabel).OutV()
@@ -260,16 +553,65 @@ type CommentClient struct {
 	config
 }
 
+// CommentQuerier is the read API of the CommentClient. It's implemented by the CommentClient, and it
+// can be used by services that depend only on reading Comments, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type CommentQuerier interface {
+	Get(ctx context.Context, id string) (*Comment, error)
+	GetX(ctx context.Context, id string) *Comment
+	GetNotFoundOK(ctx context.Context, id string) (*Comment, error)
+	Exist(ctx context.Context, id string) (bool, error)
+	ExistX(ctx context.Context, id string) bool
+	Reload(ctx context.Context, nodes ...*Comment) error
+	ReloadX(ctx context.Context, nodes ...*Comment)
+	GetByUniqueInt(ctx context.Context, v int) (*Comment, error)
+	GetByUniqueIntX(ctx context.Context, v int) *Comment
+	ExistsByUniqueInt(ctx context.Context, v int) (bool, error)
+	GetByUniqueFloat(ctx context.Context, v float64) (*Comment, error)
+	GetByUniqueFloatX(ctx context.Context, v float64) *Comment
+	ExistsByUniqueFloat(ctx context.Context, v float64) (bool, error)
+}
+
+var _ CommentQuerier = (*CommentClient)(nil)
+
 // NewCommentClient returns a client for the Comment from the given config.
 func NewCommentClient(c config) *CommentClient {
 	return &CommentClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the Comment queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.Comment.Intercept(func(ctx context.Context, q *CommentQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(comment.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *CommentClient) Intercept(interceptors ...CommentInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.Comment = append(c.inters.Comment, interceptors...)
+}
+
 // Create returns a create builder for Comment.
 func (c *CommentClient) Create() *CommentCreate {
 	return &CommentCreate{config: c.config}
 }
 
+// GetOrCreate returns a builder for creating a Comment entity, or getting the existing
+// one that has the same value in one of the unique fields of the builder.
+func (c *CommentClient) GetOrCreate() *CommentGetOrCreate {
+	return &CommentGetOrCreate{config: c.config}
+}
+
 // Update returns an update builder for Comment.
 func (c *CommentClient) Update() *CommentUpdate {
 	return &CommentUpdate{config: c.config}
@@ -277,7 +619,7 @@ func (c *CommentClient) Update() *CommentUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *CommentClient) UpdateOne(co *Comment) *CommentUpdateOne {
-	return c.UpdateOneID(co.ID)
+	return &CommentUpdateOne{config: c.config, id: co.ID, entity: co}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -297,7 +639,9 @@ func (c *CommentClient) DeleteOne(co *Comment) *CommentDeleteOne {
 
 // DeleteOneID returns a delete builder for the given id.
 func (c *CommentClient) DeleteOneID(id string) *CommentDeleteOne {
-	return &CommentDeleteOne{c.Delete().Where(comment.ID(id))}
+	builder := c.Delete().Where(comment.ID(id))
+	builder.id = &id
+	return &CommentDeleteOne{builder}
 }
 
 // Create returns a query builder for Comment.
@@ -319,16 +663,170 @@ func (c *CommentClient) GetX(ctx context.Context, id string) *Comment {
 	return co
 }
 
+// GetNotFoundOK is like Get, but returns a nil Comment without an error, if there is no entity with the given id.
+func (c *CommentClient) GetNotFoundOK(ctx context.Context, id string) (*Comment, error) {
+	co, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return co, err
+}
+
+// Exist reports if a Comment entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *CommentClient) Exist(ctx context.Context, id string) (bool, error) {
+	return c.Query().Where(comment.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *CommentClient) ExistX(ctx context.Context, id string) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Comments in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *CommentClient) Reload(ctx context.Context, nodes ...*Comment) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(comment.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*Comment, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, co := range nodes {
+		if _, ok := byID[co.ID]; !ok {
+			return &ErrNotFound{comment.Label}
+		}
+	}
+	for _, co := range nodes {
+		v := byID[co.ID]
+		co.UniqueInt = v.UniqueInt
+		co.UniqueFloat = v.UniqueFloat
+		co.NillableInt = v.NillableInt
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *CommentClient) ReloadX(ctx context.Context, nodes ...*Comment) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
+// GetByUniqueInt returns a Comment entity by its unique unique_int field, or
+// an *ErrNotFound if there is no such entity.
+func (c *CommentClient) GetByUniqueInt(ctx context.Context, v int) (*Comment, error) {
+	return c.Query().Where(comment.UniqueIntEQ(v)).Only(ctx)
+}
+
+// GetByUniqueIntX is like GetByUniqueInt, but panics if an error occurs.
+func (c *CommentClient) GetByUniqueIntX(ctx context.Context, v int) *Comment {
+	co, err := c.GetByUniqueInt(ctx, v)
+	if err != nil {
+		panic(err)
+	}
+	return co
+}
+
+// ExistsByUniqueInt reports if a Comment entity with the given unique_int exists.
+func (c *CommentClient) ExistsByUniqueInt(ctx context.Context, v int) (bool, error) {
+	return c.Query().Where(comment.UniqueIntEQ(v)).Exist(ctx)
+}
+
+// CreateOrUpdateByUniqueInt returns an upsert builder for Comment. The entity is created
+// if there is no Comment with the given unique_int, and updated otherwise.
+func (c *CommentClient) CreateOrUpdateByUniqueInt(v int) *CommentUpsert {
+	return (&CommentUpsert{config: c.config, key: comment.FieldUniqueInt}).SetUniqueInt(v)
+}
+
+// GetByUniqueFloat returns a Comment entity by its unique unique_float field, or
+// an *ErrNotFound if there is no such entity.
+func (c *CommentClient) GetByUniqueFloat(ctx context.Context, v float64) (*Comment, error) {
+	return c.Query().Where(comment.UniqueFloatEQ(v)).Only(ctx)
+}
+
+// GetByUniqueFloatX is like GetByUniqueFloat, but panics if an error occurs.
+func (c *CommentClient) GetByUniqueFloatX(ctx context.Context, v float64) *Comment {
+	co, err := c.GetByUniqueFloat(ctx, v)
+	if err != nil {
+		panic(err)
+	}
+	return co
+}
+
+// ExistsByUniqueFloat reports if a Comment entity with the given unique_float exists.
+func (c *CommentClient) ExistsByUniqueFloat(ctx context.Context, v float64) (bool, error) {
+	return c.Query().Where(comment.UniqueFloatEQ(v)).Exist(ctx)
+}
+
+// CreateOrUpdateByUniqueFloat returns an upsert builder for Comment. The entity is created
+// if there is no Comment with the given unique_float, and updated otherwise.
+func (c *CommentClient) CreateOrUpdateByUniqueFloat(v float64) *CommentUpsert {
+	return (&CommentUpsert{config: c.config, key: comment.FieldUniqueFloat}).SetUniqueFloat(v)
+}
+
 // FieldTypeClient is a client for the FieldType schema.
 type FieldTypeClient struct {
 	config
 }
 
+// FieldTypeQuerier is the read API of the FieldTypeClient. It's implemented by the FieldTypeClient, and it
+// can be used by services that depend only on reading FieldTypes, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type FieldTypeQuerier interface {
+	Get(ctx context.Context, id string) (*FieldType, error)
+	GetX(ctx context.Context, id string) *FieldType
+	GetNotFoundOK(ctx context.Context, id string) (*FieldType, error)
+	Exist(ctx context.Context, id string) (bool, error)
+	ExistX(ctx context.Context, id string) bool
+	Reload(ctx context.Context, nodes ...*FieldType) error
+	ReloadX(ctx context.Context, nodes ...*FieldType)
+}
+
+var _ FieldTypeQuerier = (*FieldTypeClient)(nil)
+
 // NewFieldTypeClient returns a client for the FieldType from the given config.
 func NewFieldTypeClient(c config) *FieldTypeClient {
 	return &FieldTypeClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the FieldType queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.FieldType.Intercept(func(ctx context.Context, q *FieldTypeQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(fieldtype.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *FieldTypeClient) Intercept(interceptors ...FieldTypeInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.FieldType = append(c.inters.FieldType, interceptors...)
+}
+
 // Create returns a create builder for FieldType.
 func (c *FieldTypeClient) Create() *FieldTypeCreate {
 	return &FieldTypeCreate{config: c.config}
@@ -341,7 +839,7 @@ func (c *FieldTypeClient) Update() *FieldTypeUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *FieldTypeClient) UpdateOne(ft *FieldType) *FieldTypeUpdateOne {
-	return c.UpdateOneID(ft.ID)
+	return &FieldTypeUpdateOne{config: c.config, id: ft.ID, entity: ft}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -361,7 +859,9 @@ func (c *FieldTypeClient) DeleteOne(ft *FieldType) *FieldTypeDeleteOne {
 
 // DeleteOneID returns a delete builder for the given id.
 func (c *FieldTypeClient) DeleteOneID(id string) *FieldTypeDeleteOne {
-	return &FieldTypeDeleteOne{c.Delete().Where(fieldtype.ID(id))}
+	builder := c.Delete().Where(fieldtype.ID(id))
+	builder.id = &id
+	return &FieldTypeDeleteOne{builder}
 }
 
 // Create returns a query builder for FieldType.
@@ -383,16 +883,143 @@ func (c *FieldTypeClient) GetX(ctx context.Context, id string) *FieldType {
 	return ft
 }
 
+// GetNotFoundOK is like Get, but returns a nil FieldType without an error, if there is no entity with the given id.
+func (c *FieldTypeClient) GetNotFoundOK(ctx context.Context, id string) (*FieldType, error) {
+	ft, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return ft, err
+}
+
+// Exist reports if a FieldType entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *FieldTypeClient) Exist(ctx context.Context, id string) (bool, error) {
+	return c.Query().Where(fieldtype.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *FieldTypeClient) ExistX(ctx context.Context, id string) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given FieldTypes in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *FieldTypeClient) Reload(ctx context.Context, nodes ...*FieldType) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(fieldtype.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*FieldType, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, ft := range nodes {
+		if _, ok := byID[ft.ID]; !ok {
+			return &ErrNotFound{fieldtype.Label}
+		}
+	}
+	for _, ft := range nodes {
+		v := byID[ft.ID]
+		ft.Int = v.Int
+		ft.Int8 = v.Int8
+		ft.Int16 = v.Int16
+		ft.Int32 = v.Int32
+		ft.Int64 = v.Int64
+		ft.OptionalInt = v.OptionalInt
+		ft.OptionalInt8 = v.OptionalInt8
+		ft.OptionalInt16 = v.OptionalInt16
+		ft.OptionalInt32 = v.OptionalInt32
+		ft.OptionalInt64 = v.OptionalInt64
+		ft.NillableInt = v.NillableInt
+		ft.NillableInt8 = v.NillableInt8
+		ft.NillableInt16 = v.NillableInt16
+		ft.NillableInt32 = v.NillableInt32
+		ft.NillableInt64 = v.NillableInt64
+		ft.ValidateOptionalInt32 = v.ValidateOptionalInt32
+		ft.State = v.State
+		ft.Link = v.Link
+		ft.NullLink = v.NullLink
+		ft.Priority = v.Priority
+		ft.Role = v.Role
+		ft.NullableInt = v.NullableInt
+		ft.nullNullableInt = v.nullNullableInt
+		ft.NullableString = v.NullableString
+		ft.nullNullableString = v.nullNullableString
+		ft.UUID = v.UUID
+		ft.IP = v.IP
+		ft.Mac = v.Mac
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *FieldTypeClient) ReloadX(ctx context.Context, nodes ...*FieldType) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
 // FileClient is a client for the File schema.
 type FileClient struct {
 	config
 }
 
+// FileQuerier is the read API of the FileClient. It's implemented by the FileClient, and it
+// can be used by services that depend only on reading Files, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type FileQuerier interface {
+	Get(ctx context.Context, id string) (*File, error)
+	GetX(ctx context.Context, id string) *File
+	GetNotFoundOK(ctx context.Context, id string) (*File, error)
+	Exist(ctx context.Context, id string) (bool, error)
+	ExistX(ctx context.Context, id string) bool
+	Reload(ctx context.Context, nodes ...*File) error
+	ReloadX(ctx context.Context, nodes ...*File)
+}
+
+var _ FileQuerier = (*FileClient)(nil)
+
 // NewFileClient returns a client for the File from the given config.
 func NewFileClient(c config) *FileClient {
 	return &FileClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the File queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.File.Intercept(func(ctx context.Context, q *FileQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(file.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *FileClient) Intercept(interceptors ...FileInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.File = append(c.inters.File, interceptors...)
+}
+
 // Create returns a create builder for File.
 func (c *FileClient) Create() *FileCreate {
 	return &FileCreate{config: c.config}
@@ -405,7 +1032,7 @@ func (c *FileClient) Update() *FileUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *FileClient) UpdateOne(f *File) *FileUpdateOne {
-	return c.UpdateOneID(f.ID)
+	return &FileUpdateOne{config: c.config, id: f.ID, entity: f}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -425,7 +1052,9 @@ func (c *FileClient) DeleteOne(f *File) *FileDeleteOne {
 
 // DeleteOneID returns a delete builder for the given id.
 func (c *FileClient) DeleteOneID(id string) *FileDeleteOne {
-	return &FileDeleteOne{c.Delete().Where(file.ID(id))}
+	builder := c.Delete().Where(file.ID(id))
+	builder.id = &id
+	return &FileDeleteOne{builder}
 }
 
 // Create returns a query builder for File.
@@ -447,6 +1076,72 @@ func (c *FileClient) GetX(ctx context.Context, id string) *File {
 	return f
 }
 
+// GetNotFoundOK is like Get, but returns a nil File without an error, if there is no entity with the given id.
+func (c *FileClient) GetNotFoundOK(ctx context.Context, id string) (*File, error) {
+	f, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return f, err
+}
+
+// Exist reports if a File entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *FileClient) Exist(ctx context.Context, id string) (bool, error) {
+	return c.Query().Where(file.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *FileClient) ExistX(ctx context.Context, id string) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Files in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *FileClient) Reload(ctx context.Context, nodes ...*File) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(file.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*File, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, f := range nodes {
+		if _, ok := byID[f.ID]; !ok {
+			return &ErrNotFound{file.Label}
+		}
+	}
+	for _, f := range nodes {
+		v := byID[f.ID]
+		f.Size = v.Size
+		f.Name = v.Name
+		f.User = v.User
+		f.Group = v.Group
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *FileClient) ReloadX(ctx context.Context, nodes ...*File) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
 // QueryOwner queries the owner edge of a File.
 func (c *FileClient) QueryOwner(f *File) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -458,6 +1153,9 @@ func (c *FileClient) QueryOwner(f *File) *UserQuery {
 			From(sql.Table(file.OwnerTable)).
 			Where(sql.EQ(file.FieldID, id))
 		query.sql = sql.Select().From(t1).Join(t2).On(t1.C(user.FieldID), t2.C(file.OwnerColumn))
+		query.edgeCount = sql.Select().Count(file.OwnerColumn).
+			From(sql.Table(file.OwnerTable)).
+			Where(sql.EQ(file.FieldID, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(f.ID).InE(user.FilesLabel).OutV()
@@ -477,6 +1175,9 @@ func (c *FileClient) QueryType(f *File) *FileTypeQuery {
 			From(sql.Table(file.TypeTable)).
 			Where(sql.EQ(file.FieldID, id))
 		query.sql = sql.Select().From(t1).Join(t2).On(t1.C(filetype.FieldID), t2.C(file.TypeColumn))
+		query.edgeCount = sql.Select().Count(file.TypeColumn).
+			From(sql.Table(file.TypeTable)).
+			Where(sql.EQ(file.FieldID, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(f.ID).InE(filetype.FilesLabel).OutV()
@@ -490,16 +1191,62 @@ type FileTypeClient struct {
 	config
 }
 
+// FileTypeQuerier is the read API of the FileTypeClient. It's implemented by the FileTypeClient, and it
+// can be used by services that depend only on reading FileTypes, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type FileTypeQuerier interface {
+	Get(ctx context.Context, id string) (*FileType, error)
+	GetX(ctx context.Context, id string) *FileType
+	GetNotFoundOK(ctx context.Context, id string) (*FileType, error)
+	Exist(ctx context.Context, id string) (bool, error)
+	ExistX(ctx context.Context, id string) bool
+	Reload(ctx context.Context, nodes ...*FileType) error
+	ReloadX(ctx context.Context, nodes ...*FileType)
+	GetByName(ctx context.Context, v string) (*FileType, error)
+	GetByNameX(ctx context.Context, v string) *FileType
+	ExistsByName(ctx context.Context, v string) (bool, error)
+}
+
+var _ FileTypeQuerier = (*FileTypeClient)(nil)
+
 // NewFileTypeClient returns a client for the FileType from the given config.
 func NewFileTypeClient(c config) *FileTypeClient {
 	return &FileTypeClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the FileType queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.FileType.Intercept(func(ctx context.Context, q *FileTypeQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(filetype.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *FileTypeClient) Intercept(interceptors ...FileTypeInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.FileType = append(c.inters.FileType, interceptors...)
+}
+
 // Create returns a create builder for FileType.
 func (c *FileTypeClient) Create() *FileTypeCreate {
 	return &FileTypeCreate{config: c.config}
 }
 
+// GetOrCreate returns a builder for creating a FileType entity, or getting the existing
+// one that has the same value in one of the unique fields of the builder.
+func (c *FileTypeClient) GetOrCreate() *FileTypeGetOrCreate {
+	return &FileTypeGetOrCreate{config: c.config}
+}
+
 // Update returns an update builder for FileType.
 func (c *FileTypeClient) Update() *FileTypeUpdate {
 	return &FileTypeUpdate{config: c.config}
@@ -507,7 +1254,7 @@ func (c *FileTypeClient) Update() *FileTypeUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *FileTypeClient) UpdateOne(ft *FileType) *FileTypeUpdateOne {
-	return c.UpdateOneID(ft.ID)
+	return &FileTypeUpdateOne{config: c.config, id: ft.ID, entity: ft}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -527,28 +1274,119 @@ func (c *FileTypeClient) DeleteOne(ft *FileType) *FileTypeDeleteOne {
 
 // DeleteOneID returns a delete builder for the given id.
 func (c *FileTypeClient) DeleteOneID(id string) *FileTypeDeleteOne {
-	return &FileTypeDeleteOne{c.Delete().Where(filetype.ID(id))}
+	builder := c.Delete().Where(filetype.ID(id))
+	builder.id = &id
+	return &FileTypeDeleteOne{builder}
+}
+
+// Create returns a query builder for FileType.
+func (c *FileTypeClient) Query() *FileTypeQuery {
+	return &FileTypeQuery{config: c.config}
+}
+
+// Get returns a FileType entity by its id.
+func (c *FileTypeClient) Get(ctx context.Context, id string) (*FileType, error) {
+	return c.Query().Where(filetype.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileTypeClient) GetX(ctx context.Context, id string) *FileType {
+	ft, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return ft
+}
+
+// GetNotFoundOK is like Get, but returns a nil FileType without an error, if there is no entity with the given id.
+func (c *FileTypeClient) GetNotFoundOK(ctx context.Context, id string) (*FileType, error) {
+	ft, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return ft, err
+}
+
+// Exist reports if a FileType entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *FileTypeClient) Exist(ctx context.Context, id string) (bool, error) {
+	return c.Query().Where(filetype.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *FileTypeClient) ExistX(ctx context.Context, id string) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given FileTypes in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *FileTypeClient) Reload(ctx context.Context, nodes ...*FileType) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(filetype.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*FileType, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, ft := range nodes {
+		if _, ok := byID[ft.ID]; !ok {
+			return &ErrNotFound{filetype.Label}
+		}
+	}
+	for _, ft := range nodes {
+		v := byID[ft.ID]
+		ft.Name = v.Name
+	}
+	return nil
 }
 
-// Create returns a query builder for FileType.
-func (c *FileTypeClient) Query() *FileTypeQuery {
-	return &FileTypeQuery{config: c.config}
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *FileTypeClient) ReloadX(ctx context.Context, nodes ...*FileType) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
 }
 
-// Get returns a FileType entity by its id.
-func (c *FileTypeClient) Get(ctx context.Context, id string) (*FileType, error) {
-	return c.Query().Where(filetype.ID(id)).Only(ctx)
+// GetByName returns a FileType entity by its unique name field, or
+// an *ErrNotFound if there is no such entity.
+func (c *FileTypeClient) GetByName(ctx context.Context, v string) (*FileType, error) {
+	return c.Query().Where(filetype.NameEQ(v)).Only(ctx)
 }
 
-// GetX is like Get, but panics if an error occurs.
-func (c *FileTypeClient) GetX(ctx context.Context, id string) *FileType {
-	ft, err := c.Get(ctx, id)
+// GetByNameX is like GetByName, but panics if an error occurs.
+func (c *FileTypeClient) GetByNameX(ctx context.Context, v string) *FileType {
+	ft, err := c.GetByName(ctx, v)
 	if err != nil {
 		panic(err)
 	}
 	return ft
 }
 
+// ExistsByName reports if a FileType entity with the given name exists.
+func (c *FileTypeClient) ExistsByName(ctx context.Context, v string) (bool, error) {
+	return c.Query().Where(filetype.NameEQ(v)).Exist(ctx)
+}
+
+// CreateOrUpdateByName returns an upsert builder for FileType. The entity is created
+// if there is no FileType with the given name, and updated otherwise.
+func (c *FileTypeClient) CreateOrUpdateByName(v string) *FileTypeUpsert {
+	return (&FileTypeUpsert{config: c.config, key: filetype.FieldName}).SetName(v)
+}
+
 // QueryFiles queries the files edge of a FileType.
 func (c *FileTypeClient) QueryFiles(ft *FileType) *FileQuery {
 	query := &FileQuery{config: c.config}
@@ -557,6 +1395,8 @@ func (c *FileTypeClient) QueryFiles(ft *FileType) *FileQuery {
 		id := ft.id()
 		query.sql = sql.Select().From(sql.Table(file.Table)).
 			Where(sql.EQ(filetype.FilesColumn, id))
+		query.edgeCount = sql.Select().Count().From(sql.Table(file.Table)).
+			Where(sql.EQ(filetype.FilesColumn, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(ft.ID).OutE(filetype.FilesLabel).InV()
@@ -570,11 +1410,48 @@ type GroupClient struct {
 	config
 }
 
+// GroupQuerier is the read API of the GroupClient. It's implemented by the GroupClient, and it
+// can be used by services that depend only on reading Groups, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type GroupQuerier interface {
+	Get(ctx context.Context, id string) (*Group, error)
+	GetX(ctx context.Context, id string) *Group
+	GetNotFoundOK(ctx context.Context, id string) (*Group, error)
+	Exist(ctx context.Context, id string) (bool, error)
+	ExistX(ctx context.Context, id string) bool
+	Reload(ctx context.Context, nodes ...*Group) error
+	ReloadX(ctx context.Context, nodes ...*Group)
+}
+
+var _ GroupQuerier = (*GroupClient)(nil)
+
 // NewGroupClient returns a client for the Group from the given config.
 func NewGroupClient(c config) *GroupClient {
 	return &GroupClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the Group queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.Group.Intercept(func(ctx context.Context, q *GroupQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(group.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *GroupClient) Intercept(interceptors ...GroupInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
 // Create returns a create builder for Group.
 func (c *GroupClient) Create() *GroupCreate {
 	return &GroupCreate{config: c.config}
@@ -587,7 +1464,7 @@ func (c *GroupClient) Update() *GroupUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *GroupClient) UpdateOne(gr *Group) *GroupUpdateOne {
-	return c.UpdateOneID(gr.ID)
+	return &GroupUpdateOne{config: c.config, id: gr.ID, entity: gr}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -607,7 +1484,9 @@ func (c *GroupClient) DeleteOne(gr *Group) *GroupDeleteOne {
 
 // DeleteOneID returns a delete builder for the given id.
 func (c *GroupClient) DeleteOneID(id string) *GroupDeleteOne {
-	return &GroupDeleteOne{c.Delete().Where(group.ID(id))}
+	builder := c.Delete().Where(group.ID(id))
+	builder.id = &id
+	return &GroupDeleteOne{builder}
 }
 
 // Create returns a query builder for Group.
@@ -629,6 +1508,73 @@ func (c *GroupClient) GetX(ctx context.Context, id string) *Group {
 	return gr
 }
 
+// GetNotFoundOK is like Get, but returns a nil Group without an error, if there is no entity with the given id.
+func (c *GroupClient) GetNotFoundOK(ctx context.Context, id string) (*Group, error) {
+	gr, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return gr, err
+}
+
+// Exist reports if a Group entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *GroupClient) Exist(ctx context.Context, id string) (bool, error) {
+	return c.Query().Where(group.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *GroupClient) ExistX(ctx context.Context, id string) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Groups in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *GroupClient) Reload(ctx context.Context, nodes ...*Group) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(group.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*Group, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, gr := range nodes {
+		if _, ok := byID[gr.ID]; !ok {
+			return &ErrNotFound{group.Label}
+		}
+	}
+	for _, gr := range nodes {
+		v := byID[gr.ID]
+		gr.Active = v.Active
+		gr.Expire = v.Expire
+		gr.Type = v.Type
+		gr.MaxUsers = v.MaxUsers
+		gr.Name = v.Name
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *GroupClient) ReloadX(ctx context.Context, nodes ...*Group) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
 // QueryFiles queries the files edge of a Group.
 func (c *GroupClient) QueryFiles(gr *Group) *FileQuery {
 	query := &FileQuery{config: c.config}
@@ -637,6 +1583,8 @@ func (c *GroupClient) QueryFiles(gr *Group) *FileQuery {
 		id := gr.id()
 		query.sql = sql.Select().From(sql.Table(file.Table)).
 			Where(sql.EQ(group.FilesColumn, id))
+		query.edgeCount = sql.Select().Count().From(sql.Table(file.Table)).
+			Where(sql.EQ(group.FilesColumn, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(gr.ID).OutE(group.FilesLabel).InV()
@@ -653,6 +1601,8 @@ func (c *GroupClient) QueryBlocked(gr *Group) *UserQuery {
 		id := gr.id()
 		query.sql = sql.Select().From(sql.Table(user.Table)).
 			Where(sql.EQ(group.BlockedColumn, id))
+		query.edgeCount = sql.Select().Count().From(sql.Table(user.Table)).
+			Where(sql.EQ(group.BlockedColumn, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(gr.ID).OutE(group.BlockedLabel).InV()
@@ -668,17 +1618,17 @@ func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
 	case dialect.MySQL, dialect.SQLite:
 		id := gr.id()
 		t1 := sql.Table(user.Table)
-		t2 := sql.Table(group.Table)
-		t3 := sql.Table(group.UsersTable)
-		t4 := sql.Select(t3.C(group.UsersPrimaryKey[0])).
-			From(t3).
-			Join(t2).
-			On(t3.C(group.UsersPrimaryKey[1]), t2.C(group.FieldID)).
-			Where(sql.EQ(t2.C(group.FieldID), id))
+		t2 := sql.Table(group.UsersTable)
+		t3 := sql.Select(t2.C(group.UsersPrimaryKey[0])).
+			From(t2).
+			Where(sql.EQ(t2.C(group.UsersPrimaryKey[1]), id))
 		query.sql = sql.Select().
 			From(t1).
-			Join(t4).
-			On(t1.C(user.FieldID), t4.C(group.UsersPrimaryKey[0]))
+			Join(t3).
+			On(t1.C(user.FieldID), t3.C(group.UsersPrimaryKey[0]))
+		query.edgeCount = sql.Select().Count().
+			From(t2).
+			Where(sql.EQ(t2.C(group.UsersPrimaryKey[1]), id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(gr.ID).InE(user.GroupsLabel).OutV()
@@ -698,6 +1648,9 @@ func (c *GroupClient) QueryInfo(gr *Group) *GroupInfoQuery {
 			From(sql.Table(group.InfoTable)).
 			Where(sql.EQ(group.FieldID, id))
 		query.sql = sql.Select().From(t1).Join(t2).On(t1.C(groupinfo.FieldID), t2.C(group.InfoColumn))
+		query.edgeCount = sql.Select().Count(group.InfoColumn).
+			From(sql.Table(group.InfoTable)).
+			Where(sql.EQ(group.FieldID, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(gr.ID).OutE(group.InfoLabel).InV()
@@ -711,11 +1664,48 @@ type GroupInfoClient struct {
 	config
 }
 
+// GroupInfoQuerier is the read API of the GroupInfoClient. It's implemented by the GroupInfoClient, and it
+// can be used by services that depend only on reading GroupInfos, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type GroupInfoQuerier interface {
+	Get(ctx context.Context, id string) (*GroupInfo, error)
+	GetX(ctx context.Context, id string) *GroupInfo
+	GetNotFoundOK(ctx context.Context, id string) (*GroupInfo, error)
+	Exist(ctx context.Context, id string) (bool, error)
+	ExistX(ctx context.Context, id string) bool
+	Reload(ctx context.Context, nodes ...*GroupInfo) error
+	ReloadX(ctx context.Context, nodes ...*GroupInfo)
+}
+
+var _ GroupInfoQuerier = (*GroupInfoClient)(nil)
+
 // NewGroupInfoClient returns a client for the GroupInfo from the given config.
 func NewGroupInfoClient(c config) *GroupInfoClient {
 	return &GroupInfoClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the GroupInfo queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.GroupInfo.Intercept(func(ctx context.Context, q *GroupInfoQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(groupinfo.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *GroupInfoClient) Intercept(interceptors ...GroupInfoInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.GroupInfo = append(c.inters.GroupInfo, interceptors...)
+}
+
 // Create returns a create builder for GroupInfo.
 func (c *GroupInfoClient) Create() *GroupInfoCreate {
 	return &GroupInfoCreate{config: c.config}
@@ -728,7 +1718,7 @@ func (c *GroupInfoClient) Update() *GroupInfoUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *GroupInfoClient) UpdateOne(gi *GroupInfo) *GroupInfoUpdateOne {
-	return c.UpdateOneID(gi.ID)
+	return &GroupInfoUpdateOne{config: c.config, id: gi.ID, entity: gi}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -748,7 +1738,9 @@ func (c *GroupInfoClient) DeleteOne(gi *GroupInfo) *GroupInfoDeleteOne {
 
 // DeleteOneID returns a delete builder for the given id.
 func (c *GroupInfoClient) DeleteOneID(id string) *GroupInfoDeleteOne {
-	return &GroupInfoDeleteOne{c.Delete().Where(groupinfo.ID(id))}
+	builder := c.Delete().Where(groupinfo.ID(id))
+	builder.id = &id
+	return &GroupInfoDeleteOne{builder}
 }
 
 // Create returns a query builder for GroupInfo.
@@ -770,6 +1762,70 @@ func (c *GroupInfoClient) GetX(ctx context.Context, id string) *GroupInfo {
 	return gi
 }
 
+// GetNotFoundOK is like Get, but returns a nil GroupInfo without an error, if there is no entity with the given id.
+func (c *GroupInfoClient) GetNotFoundOK(ctx context.Context, id string) (*GroupInfo, error) {
+	gi, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return gi, err
+}
+
+// Exist reports if a GroupInfo entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *GroupInfoClient) Exist(ctx context.Context, id string) (bool, error) {
+	return c.Query().Where(groupinfo.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *GroupInfoClient) ExistX(ctx context.Context, id string) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given GroupInfos in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *GroupInfoClient) Reload(ctx context.Context, nodes ...*GroupInfo) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(groupinfo.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*GroupInfo, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, gi := range nodes {
+		if _, ok := byID[gi.ID]; !ok {
+			return &ErrNotFound{groupinfo.Label}
+		}
+	}
+	for _, gi := range nodes {
+		v := byID[gi.ID]
+		gi.Desc = v.Desc
+		gi.MaxUsers = v.MaxUsers
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *GroupInfoClient) ReloadX(ctx context.Context, nodes ...*GroupInfo) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
 // QueryGroups queries the groups edge of a GroupInfo.
 func (c *GroupInfoClient) QueryGroups(gi *GroupInfo) *GroupQuery {
 	query := &GroupQuery{config: c.config}
@@ -778,6 +1834,8 @@ func (c *GroupInfoClient) QueryGroups(gi *GroupInfo) *GroupQuery {
 		id := gi.id()
 		query.sql = sql.Select().From(sql.Table(group.Table)).
 			Where(sql.EQ(groupinfo.GroupsColumn, id))
+		query.edgeCount = sql.Select().Count().From(sql.Table(group.Table)).
+			Where(sql.EQ(groupinfo.GroupsColumn, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(gi.ID).InE(group.InfoLabel).OutV()
@@ -791,11 +1849,48 @@ type ItemClient struct {
 	config
 }
 
+// ItemQuerier is the read API of the ItemClient. It's implemented by the ItemClient, and it
+// can be used by services that depend only on reading Items, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type ItemQuerier interface {
+	Get(ctx context.Context, id string) (*Item, error)
+	GetX(ctx context.Context, id string) *Item
+	GetNotFoundOK(ctx context.Context, id string) (*Item, error)
+	Exist(ctx context.Context, id string) (bool, error)
+	ExistX(ctx context.Context, id string) bool
+	Reload(ctx context.Context, nodes ...*Item) error
+	ReloadX(ctx context.Context, nodes ...*Item)
+}
+
+var _ ItemQuerier = (*ItemClient)(nil)
+
 // NewItemClient returns a client for the Item from the given config.
 func NewItemClient(c config) *ItemClient {
 	return &ItemClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the Item queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.Item.Intercept(func(ctx context.Context, q *ItemQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(item.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *ItemClient) Intercept(interceptors ...ItemInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.Item = append(c.inters.Item, interceptors...)
+}
+
 // Create returns a create builder for Item.
 func (c *ItemClient) Create() *ItemCreate {
 	return &ItemCreate{config: c.config}
@@ -808,7 +1903,7 @@ func (c *ItemClient) Update() *ItemUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *ItemClient) UpdateOne(i *Item) *ItemUpdateOne {
-	return c.UpdateOneID(i.ID)
+	return &ItemUpdateOne{config: c.config, id: i.ID, entity: i}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -828,7 +1923,9 @@ func (c *ItemClient) DeleteOne(i *Item) *ItemDeleteOne {
 
 // DeleteOneID returns a delete builder for the given id.
 func (c *ItemClient) DeleteOneID(id string) *ItemDeleteOne {
-	return &ItemDeleteOne{c.Delete().Where(item.ID(id))}
+	builder := c.Delete().Where(item.ID(id))
+	builder.id = &id
+	return &ItemDeleteOne{builder}
 }
 
 // Create returns a query builder for Item.
@@ -850,16 +1947,119 @@ func (c *ItemClient) GetX(ctx context.Context, id string) *Item {
 	return i
 }
 
+// GetNotFoundOK is like Get, but returns a nil Item without an error, if there is no entity with the given id.
+func (c *ItemClient) GetNotFoundOK(ctx context.Context, id string) (*Item, error) {
+	i, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return i, err
+}
+
+// Exist reports if a Item entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *ItemClient) Exist(ctx context.Context, id string) (bool, error) {
+	return c.Query().Where(item.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *ItemClient) ExistX(ctx context.Context, id string) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Items in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *ItemClient) Reload(ctx context.Context, nodes ...*Item) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(item.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*Item, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, i := range nodes {
+		if _, ok := byID[i.ID]; !ok {
+			return &ErrNotFound{item.Label}
+		}
+	}
+	for _, i := range nodes {
+		v := byID[i.ID]
+		i.UpdateField = v.UpdateField
+		i.LabelField = v.LabelField
+		i.Type = v.Type
+		i.Func = v.Func
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *ItemClient) ReloadX(ctx context.Context, nodes ...*Item) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
 // NodeClient is a client for the Node schema.
 type NodeClient struct {
 	config
 }
 
+// NodeQuerier is the read API of the NodeClient. It's implemented by the NodeClient, and it
+// can be used by services that depend only on reading Nodes, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type NodeQuerier interface {
+	Get(ctx context.Context, id string) (*Node, error)
+	GetX(ctx context.Context, id string) *Node
+	GetNotFoundOK(ctx context.Context, id string) (*Node, error)
+	Exist(ctx context.Context, id string) (bool, error)
+	ExistX(ctx context.Context, id string) bool
+	Reload(ctx context.Context, nodes ...*Node) error
+	ReloadX(ctx context.Context, nodes ...*Node)
+}
+
+var _ NodeQuerier = (*NodeClient)(nil)
+
 // NewNodeClient returns a client for the Node from the given config.
 func NewNodeClient(c config) *NodeClient {
 	return &NodeClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the Node queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.Node.Intercept(func(ctx context.Context, q *NodeQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(node.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *NodeClient) Intercept(interceptors ...NodeInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.Node = append(c.inters.Node, interceptors...)
+}
+
 // Create returns a create builder for Node.
 func (c *NodeClient) Create() *NodeCreate {
 	return &NodeCreate{config: c.config}
@@ -872,7 +2072,7 @@ func (c *NodeClient) Update() *NodeUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *NodeClient) UpdateOne(n *Node) *NodeUpdateOne {
-	return c.UpdateOneID(n.ID)
+	return &NodeUpdateOne{config: c.config, id: n.ID, entity: n}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -892,7 +2092,9 @@ func (c *NodeClient) DeleteOne(n *Node) *NodeDeleteOne {
 
 // DeleteOneID returns a delete builder for the given id.
 func (c *NodeClient) DeleteOneID(id string) *NodeDeleteOne {
-	return &NodeDeleteOne{c.Delete().Where(node.ID(id))}
+	builder := c.Delete().Where(node.ID(id))
+	builder.id = &id
+	return &NodeDeleteOne{builder}
 }
 
 // Create returns a query builder for Node.
@@ -914,6 +2116,69 @@ func (c *NodeClient) GetX(ctx context.Context, id string) *Node {
 	return n
 }
 
+// GetNotFoundOK is like Get, but returns a nil Node without an error, if there is no entity with the given id.
+func (c *NodeClient) GetNotFoundOK(ctx context.Context, id string) (*Node, error) {
+	n, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return n, err
+}
+
+// Exist reports if a Node entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *NodeClient) Exist(ctx context.Context, id string) (bool, error) {
+	return c.Query().Where(node.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *NodeClient) ExistX(ctx context.Context, id string) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Nodes in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *NodeClient) Reload(ctx context.Context, nodes ...*Node) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(node.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*Node, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, n := range nodes {
+		if _, ok := byID[n.ID]; !ok {
+			return &ErrNotFound{node.Label}
+		}
+	}
+	for _, n := range nodes {
+		v := byID[n.ID]
+		n.Value = v.Value
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *NodeClient) ReloadX(ctx context.Context, nodes ...*Node) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
 // QueryPrev queries the prev edge of a Node.
 func (c *NodeClient) QueryPrev(n *Node) *NodeQuery {
 	query := &NodeQuery{config: c.config}
@@ -925,6 +2190,9 @@ func (c *NodeClient) QueryPrev(n *Node) *NodeQuery {
 			From(sql.Table(node.PrevTable)).
 			Where(sql.EQ(node.FieldID, id))
 		query.sql = sql.Select().From(t1).Join(t2).On(t1.C(node.FieldID), t2.C(node.PrevColumn))
+		query.edgeCount = sql.Select().Count(node.PrevColumn).
+			From(sql.Table(node.PrevTable)).
+			Where(sql.EQ(node.FieldID, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(n.ID).InE(node.NextLabel).OutV()
@@ -941,6 +2209,8 @@ func (c *NodeClient) QueryNext(n *Node) *NodeQuery {
 		id := n.id()
 		query.sql = sql.Select().From(sql.Table(node.Table)).
 			Where(sql.EQ(node.NextColumn, id))
+		query.edgeCount = sql.Select().Count().From(sql.Table(node.Table)).
+			Where(sql.EQ(node.NextColumn, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(n.ID).OutE(node.NextLabel).InV()
@@ -954,11 +2224,48 @@ type PetClient struct {
 	config
 }
 
+// PetQuerier is the read API of the PetClient. It's implemented by the PetClient, and it
+// can be used by services that depend only on reading Pets, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type PetQuerier interface {
+	Get(ctx context.Context, id string) (*Pet, error)
+	GetX(ctx context.Context, id string) *Pet
+	GetNotFoundOK(ctx context.Context, id string) (*Pet, error)
+	Exist(ctx context.Context, id string) (bool, error)
+	ExistX(ctx context.Context, id string) bool
+	Reload(ctx context.Context, nodes ...*Pet) error
+	ReloadX(ctx context.Context, nodes ...*Pet)
+}
+
+var _ PetQuerier = (*PetClient)(nil)
+
 // NewPetClient returns a client for the Pet from the given config.
 func NewPetClient(c config) *PetClient {
 	return &PetClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the Pet queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.Pet.Intercept(func(ctx context.Context, q *PetQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(pet.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *PetClient) Intercept(interceptors ...PetInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.Pet = append(c.inters.Pet, interceptors...)
+}
+
 // Create returns a create builder for Pet.
 func (c *PetClient) Create() *PetCreate {
 	return &PetCreate{config: c.config}
@@ -971,7 +2278,7 @@ func (c *PetClient) Update() *PetUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *PetClient) UpdateOne(pe *Pet) *PetUpdateOne {
-	return c.UpdateOneID(pe.ID)
+	return &PetUpdateOne{config: c.config, id: pe.ID, entity: pe}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -991,7 +2298,9 @@ func (c *PetClient) DeleteOne(pe *Pet) *PetDeleteOne {
 
 // DeleteOneID returns a delete builder for the given id.
 func (c *PetClient) DeleteOneID(id string) *PetDeleteOne {
-	return &PetDeleteOne{c.Delete().Where(pet.ID(id))}
+	builder := c.Delete().Where(pet.ID(id))
+	builder.id = &id
+	return &PetDeleteOne{builder}
 }
 
 // Create returns a query builder for Pet.
@@ -1013,6 +2322,69 @@ func (c *PetClient) GetX(ctx context.Context, id string) *Pet {
 	return pe
 }
 
+// GetNotFoundOK is like Get, but returns a nil Pet without an error, if there is no entity with the given id.
+func (c *PetClient) GetNotFoundOK(ctx context.Context, id string) (*Pet, error) {
+	pe, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return pe, err
+}
+
+// Exist reports if a Pet entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *PetClient) Exist(ctx context.Context, id string) (bool, error) {
+	return c.Query().Where(pet.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *PetClient) ExistX(ctx context.Context, id string) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Pets in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *PetClient) Reload(ctx context.Context, nodes ...*Pet) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(pet.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*Pet, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, pe := range nodes {
+		if _, ok := byID[pe.ID]; !ok {
+			return &ErrNotFound{pet.Label}
+		}
+	}
+	for _, pe := range nodes {
+		v := byID[pe.ID]
+		pe.Name = v.Name
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *PetClient) ReloadX(ctx context.Context, nodes ...*Pet) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
 // QueryTeam queries the team edge of a Pet.
 func (c *PetClient) QueryTeam(pe *Pet) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -1024,6 +2396,9 @@ func (c *PetClient) QueryTeam(pe *Pet) *UserQuery {
 			From(sql.Table(pet.TeamTable)).
 			Where(sql.EQ(pet.FieldID, id))
 		query.sql = sql.Select().From(t1).Join(t2).On(t1.C(user.FieldID), t2.C(pet.TeamColumn))
+		query.edgeCount = sql.Select().Count(pet.TeamColumn).
+			From(sql.Table(pet.TeamTable)).
+			Where(sql.EQ(pet.FieldID, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(pe.ID).InE(user.TeamLabel).OutV()
@@ -1043,6 +2418,9 @@ func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
 			From(sql.Table(pet.OwnerTable)).
 			Where(sql.EQ(pet.FieldID, id))
 		query.sql = sql.Select().From(t1).Join(t2).On(t1.C(user.FieldID), t2.C(pet.OwnerColumn))
+		query.edgeCount = sql.Select().Count(pet.OwnerColumn).
+			From(sql.Table(pet.OwnerTable)).
+			Where(sql.EQ(pet.FieldID, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(pe.ID).InE(user.PetsLabel).OutV()
@@ -1056,16 +2434,65 @@ type UserClient struct {
 	config
 }
 
+// UserQuerier is the read API of the UserClient. It's implemented by the UserClient, and it
+// can be used by services that depend only on reading Users, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type UserQuerier interface {
+	Get(ctx context.Context, id string) (*User, error)
+	GetX(ctx context.Context, id string) *User
+	GetNotFoundOK(ctx context.Context, id string) (*User, error)
+	Exist(ctx context.Context, id string) (bool, error)
+	ExistX(ctx context.Context, id string) bool
+	Reload(ctx context.Context, nodes ...*User) error
+	ReloadX(ctx context.Context, nodes ...*User)
+	GetByNickname(ctx context.Context, v string) (*User, error)
+	GetByNicknameX(ctx context.Context, v string) *User
+	ExistsByNickname(ctx context.Context, v string) (bool, error)
+	GetByPhone(ctx context.Context, v string) (*User, error)
+	GetByPhoneX(ctx context.Context, v string) *User
+	ExistsByPhone(ctx context.Context, v string) (bool, error)
+}
+
+var _ UserQuerier = (*UserClient)(nil)
+
 // NewUserClient returns a client for the User from the given config.
 func NewUserClient(c config) *UserClient {
 	return &UserClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the User queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.User.Intercept(func(ctx context.Context, q *UserQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(user.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *UserClient) Intercept(interceptors ...UserInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a create builder for User.
 func (c *UserClient) Create() *UserCreate {
 	return &UserCreate{config: c.config}
 }
 
+// GetOrCreate returns a builder for creating a User entity, or getting the existing
+// one that has the same value in one of the unique fields of the builder.
+func (c *UserClient) GetOrCreate() *UserGetOrCreate {
+	return &UserGetOrCreate{config: c.config}
+}
+
 // Update returns an update builder for User.
 func (c *UserClient) Update() *UserUpdate {
 	return &UserUpdate{config: c.config}
@@ -1073,7 +2500,7 @@ func (c *UserClient) Update() *UserUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
-	return c.UpdateOneID(u.ID)
+	return &UserUpdateOne{config: c.config, id: u.ID, entity: u}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -1093,7 +2520,9 @@ func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
 
 // DeleteOneID returns a delete builder for the given id.
 func (c *UserClient) DeleteOneID(id string) *UserDeleteOne {
-	return &UserDeleteOne{c.Delete().Where(user.ID(id))}
+	builder := c.Delete().Where(user.ID(id))
+	builder.id = &id
+	return &UserDeleteOne{builder}
 }
 
 // Create returns a query builder for User.
@@ -1115,6 +2544,126 @@ func (c *UserClient) GetX(ctx context.Context, id string) *User {
 	return u
 }
 
+// GetNotFoundOK is like Get, but returns a nil User without an error, if there is no entity with the given id.
+func (c *UserClient) GetNotFoundOK(ctx context.Context, id string) (*User, error) {
+	u, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return u, err
+}
+
+// Exist reports if a User entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *UserClient) Exist(ctx context.Context, id string) (bool, error) {
+	return c.Query().Where(user.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *UserClient) ExistX(ctx context.Context, id string) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Users in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *UserClient) Reload(ctx context.Context, nodes ...*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*User, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, u := range nodes {
+		if _, ok := byID[u.ID]; !ok {
+			return &ErrNotFound{user.Label}
+		}
+	}
+	for _, u := range nodes {
+		v := byID[u.ID]
+		u.Age = v.Age
+		u.Name = v.Name
+		u.Last = v.Last
+		u.Nickname = v.Nickname
+		u.Phone = v.Phone
+		u.Password = v.Password
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *UserClient) ReloadX(ctx context.Context, nodes ...*User) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
+// GetByNickname returns a User entity by its unique nickname field, or
+// an *ErrNotFound if there is no such entity.
+func (c *UserClient) GetByNickname(ctx context.Context, v string) (*User, error) {
+	return c.Query().Where(user.NicknameEQ(v)).Only(ctx)
+}
+
+// GetByNicknameX is like GetByNickname, but panics if an error occurs.
+func (c *UserClient) GetByNicknameX(ctx context.Context, v string) *User {
+	u, err := c.GetByNickname(ctx, v)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// ExistsByNickname reports if a User entity with the given nickname exists.
+func (c *UserClient) ExistsByNickname(ctx context.Context, v string) (bool, error) {
+	return c.Query().Where(user.NicknameEQ(v)).Exist(ctx)
+}
+
+// CreateOrUpdateByNickname returns an upsert builder for User. The entity is created
+// if there is no User with the given nickname, and updated otherwise.
+func (c *UserClient) CreateOrUpdateByNickname(v string) *UserUpsert {
+	return (&UserUpsert{config: c.config, key: user.FieldNickname}).SetNickname(v)
+}
+
+// GetByPhone returns a User entity by its unique phone field, or
+// an *ErrNotFound if there is no such entity.
+func (c *UserClient) GetByPhone(ctx context.Context, v string) (*User, error) {
+	return c.Query().Where(user.PhoneEQ(v)).Only(ctx)
+}
+
+// GetByPhoneX is like GetByPhone, but panics if an error occurs.
+func (c *UserClient) GetByPhoneX(ctx context.Context, v string) *User {
+	u, err := c.GetByPhone(ctx, v)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// ExistsByPhone reports if a User entity with the given phone exists.
+func (c *UserClient) ExistsByPhone(ctx context.Context, v string) (bool, error) {
+	return c.Query().Where(user.PhoneEQ(v)).Exist(ctx)
+}
+
+// CreateOrUpdateByPhone returns an upsert builder for User. The entity is created
+// if there is no User with the given phone, and updated otherwise.
+func (c *UserClient) CreateOrUpdateByPhone(v string) *UserUpsert {
+	return (&UserUpsert{config: c.config, key: user.FieldPhone}).SetPhone(v)
+}
+
 // QueryCard queries the card edge of a User.
 func (c *UserClient) QueryCard(u *User) *CardQuery {
 	query := &CardQuery{config: c.config}
@@ -1123,6 +2672,8 @@ func (c *UserClient) QueryCard(u *User) *CardQuery {
 		id := u.id()
 		query.sql = sql.Select().From(sql.Table(card.Table)).
 			Where(sql.EQ(user.CardColumn, id))
+		query.edgeCount = sql.Select().Count().From(sql.Table(card.Table)).
+			Where(sql.EQ(user.CardColumn, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(u.ID).OutE(user.CardLabel).InV()
@@ -1139,6 +2690,8 @@ func (c *UserClient) QueryPets(u *User) *PetQuery {
 		id := u.id()
 		query.sql = sql.Select().From(sql.Table(pet.Table)).
 			Where(sql.EQ(user.PetsColumn, id))
+		query.edgeCount = sql.Select().Count().From(sql.Table(pet.Table)).
+			Where(sql.EQ(user.PetsColumn, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(u.ID).OutE(user.PetsLabel).InV()
@@ -1155,6 +2708,8 @@ func (c *UserClient) QueryFiles(u *User) *FileQuery {
 		id := u.id()
 		query.sql = sql.Select().From(sql.Table(file.Table)).
 			Where(sql.EQ(user.FilesColumn, id))
+		query.edgeCount = sql.Select().Count().From(sql.Table(file.Table)).
+			Where(sql.EQ(user.FilesColumn, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(u.ID).OutE(user.FilesLabel).InV()
@@ -1170,17 +2725,17 @@ func (c *UserClient) QueryGroups(u *User) *GroupQuery {
 	case dialect.MySQL, dialect.SQLite:
 		id := u.id()
 		t1 := sql.Table(group.Table)
-		t2 := sql.Table(user.Table)
-		t3 := sql.Table(user.GroupsTable)
-		t4 := sql.Select(t3.C(user.GroupsPrimaryKey[1])).
-			From(t3).
-			Join(t2).
-			On(t3.C(user.GroupsPrimaryKey[0]), t2.C(user.FieldID)).
-			Where(sql.EQ(t2.C(user.FieldID), id))
+		t2 := sql.Table(user.GroupsTable)
+		t3 := sql.Select(t2.C(user.GroupsPrimaryKey[1])).
+			From(t2).
+			Where(sql.EQ(t2.C(user.GroupsPrimaryKey[0]), id))
 		query.sql = sql.Select().
 			From(t1).
-			Join(t4).
-			On(t1.C(group.FieldID), t4.C(user.GroupsPrimaryKey[1]))
+			Join(t3).
+			On(t1.C(group.FieldID), t3.C(user.GroupsPrimaryKey[1]))
+		query.edgeCount = sql.Select().Count().
+			From(t2).
+			Where(sql.EQ(t2.C(user.GroupsPrimaryKey[0]), id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(u.ID).OutE(user.GroupsLabel).InV()
@@ -1196,17 +2751,17 @@ func (c *UserClient) QueryFriends(u *User) *UserQuery {
 	case dialect.MySQL, dialect.SQLite:
 		id := u.id()
 		t1 := sql.Table(user.Table)
-		t2 := sql.Table(user.Table)
-		t3 := sql.Table(user.FriendsTable)
-		t4 := sql.Select(t3.C(user.FriendsPrimaryKey[1])).
-			From(t3).
-			Join(t2).
-			On(t3.C(user.FriendsPrimaryKey[0]), t2.C(user.FieldID)).
-			Where(sql.EQ(t2.C(user.FieldID), id))
+		t2 := sql.Table(user.FriendsTable)
+		t3 := sql.Select(t2.C(user.FriendsPrimaryKey[1])).
+			From(t2).
+			Where(sql.EQ(t2.C(user.FriendsPrimaryKey[0]), id))
 		query.sql = sql.Select().
 			From(t1).
-			Join(t4).
-			On(t1.C(user.FieldID), t4.C(user.FriendsPrimaryKey[1]))
+			Join(t3).
+			On(t1.C(user.FieldID), t3.C(user.FriendsPrimaryKey[1]))
+		query.edgeCount = sql.Select().Count().
+			From(t2).
+			Where(sql.EQ(t2.C(user.FriendsPrimaryKey[0]), id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(u.ID).Both(user.FriendsLabel)
@@ -1222,17 +2777,17 @@ func (c *UserClient) QueryFollowers(u *User) *UserQuery {
 	case dialect.MySQL, dialect.SQLite:
 		id := u.id()
 		t1 := sql.Table(user.Table)
-		t2 := sql.Table(user.Table)
-		t3 := sql.Table(user.FollowersTable)
-		t4 := sql.Select(t3.C(user.FollowersPrimaryKey[0])).
-			From(t3).
-			Join(t2).
-			On(t3.C(user.FollowersPrimaryKey[1]), t2.C(user.FieldID)).
-			Where(sql.EQ(t2.C(user.FieldID), id))
+		t2 := sql.Table(user.FollowersTable)
+		t3 := sql.Select(t2.C(user.FollowersPrimaryKey[0])).
+			From(t2).
+			Where(sql.EQ(t2.C(user.FollowersPrimaryKey[1]), id))
 		query.sql = sql.Select().
 			From(t1).
-			Join(t4).
-			On(t1.C(user.FieldID), t4.C(user.FollowersPrimaryKey[0]))
+			Join(t3).
+			On(t1.C(user.FieldID), t3.C(user.FollowersPrimaryKey[0]))
+		query.edgeCount = sql.Select().Count().
+			From(t2).
+			Where(sql.EQ(t2.C(user.FollowersPrimaryKey[1]), id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(u.ID).InE(user.FollowingLabel).OutV()
@@ -1248,17 +2803,17 @@ func (c *UserClient) QueryFollowing(u *User) *UserQuery {
 	case dialect.MySQL, dialect.SQLite:
 		id := u.id()
 		t1 := sql.Table(user.Table)
-		t2 := sql.Table(user.Table)
-		t3 := sql.Table(user.FollowingTable)
-		t4 := sql.Select(t3.C(user.FollowingPrimaryKey[1])).
-			From(t3).
-			Join(t2).
-			On(t3.C(user.FollowingPrimaryKey[0]), t2.C(user.FieldID)).
-			Where(sql.EQ(t2.C(user.FieldID), id))
+		t2 := sql.Table(user.FollowingTable)
+		t3 := sql.Select(t2.C(user.FollowingPrimaryKey[1])).
+			From(t2).
+			Where(sql.EQ(t2.C(user.FollowingPrimaryKey[0]), id))
 		query.sql = sql.Select().
 			From(t1).
-			Join(t4).
-			On(t1.C(user.FieldID), t4.C(user.FollowingPrimaryKey[1]))
+			Join(t3).
+			On(t1.C(user.FieldID), t3.C(user.FollowingPrimaryKey[1]))
+		query.edgeCount = sql.Select().Count().
+			From(t2).
+			Where(sql.EQ(t2.C(user.FollowingPrimaryKey[0]), id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(u.ID).OutE(user.FollowingLabel).InV()
@@ -1275,6 +2830,8 @@ func (c *UserClient) QueryTeam(u *User) *PetQuery {
 		id := u.id()
 		query.sql = sql.Select().From(sql.Table(pet.Table)).
 			Where(sql.EQ(user.TeamColumn, id))
+		query.edgeCount = sql.Select().Count().From(sql.Table(pet.Table)).
+			Where(sql.EQ(user.TeamColumn, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(u.ID).OutE(user.TeamLabel).InV()
@@ -1291,6 +2848,8 @@ func (c *UserClient) QuerySpouse(u *User) *UserQuery {
 		id := u.id()
 		query.sql = sql.Select().From(sql.Table(user.Table)).
 			Where(sql.EQ(user.SpouseColumn, id))
+		query.edgeCount = sql.Select().Count().From(sql.Table(user.Table)).
+			Where(sql.EQ(user.SpouseColumn, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(u.ID).Both(user.SpouseLabel)
@@ -1307,6 +2866,8 @@ func (c *UserClient) QueryChildren(u *User) *UserQuery {
 		id := u.id()
 		query.sql = sql.Select().From(sql.Table(user.Table)).
 			Where(sql.EQ(user.ChildrenColumn, id))
+		query.edgeCount = sql.Select().Count().From(sql.Table(user.Table)).
+			Where(sql.EQ(user.ChildrenColumn, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(u.ID).InE(user.ParentLabel).OutV()
@@ -1326,6 +2887,9 @@ func (c *UserClient) QueryParent(u *User) *UserQuery {
 			From(sql.Table(user.ParentTable)).
 			Where(sql.EQ(user.FieldID, id))
 		query.sql = sql.Select().From(t1).Join(t2).On(t1.C(user.FieldID), t2.C(user.ParentColumn))
+		query.edgeCount = sql.Select().Count(user.ParentColumn).
+			From(sql.Table(user.ParentTable)).
+			Where(sql.EQ(user.FieldID, id))
 
 	case dialect.Gremlin:
 		query.gremlin = g.V(u.ID).OutE(user.ParentLabel).InV()
@@ -13,6 +13,7 @@ import (
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/ent/comment"
 )
 
 // Comment is the model entity for the Comment schema.
@@ -30,19 +31,34 @@ type Comment struct {
 
 // FromRows scans the sql response data into Comment.
 func (c *Comment) FromRows(rows *sql.Rows) error {
+	return c.scan(rows, comment.Columns)
+}
+
+// scan scans the given columns of the sql response data into Comment.
+// Fields that their columns were not selected are left with their zero values.
+func (c *Comment) scan(rows *sql.Rows, columns []string) error {
 	var vc struct {
 		ID          int
 		UniqueInt   sql.NullInt64
 		UniqueFloat sql.NullFloat64
 		NillableInt sql.NullInt64
 	}
-	// the order here should be the same as in the `comment.Columns`.
-	if err := rows.Scan(
-		&vc.ID,
-		&vc.UniqueInt,
-		&vc.UniqueFloat,
-		&vc.NillableInt,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case comment.FieldID:
+			values[i] = &vc.ID
+		case comment.FieldUniqueInt:
+			values[i] = &vc.UniqueInt
+		case comment.FieldUniqueFloat:
+			values[i] = &vc.UniqueFloat
+		case comment.FieldNillableInt:
+			values[i] = &vc.NillableInt
+		default:
+			return fmt.Errorf("unexpected column %q for type Comment", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	c.ID = strconv.Itoa(vc.ID)
@@ -95,6 +111,33 @@ func (c *Comment) Unwrap() *Comment {
 	return c
 }
 
+// Clone returns a deep copy of the Comment, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (c *Comment) Clone() *Comment {
+	return c.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Comment, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (c *Comment) clone(seen map[interface{}]interface{}) *Comment {
+	if c == nil {
+		return nil
+	}
+	if _c, ok := seen[c]; ok {
+		return _c.(*Comment)
+	}
+	_c := *c
+	_c.config = config{}
+	seen[c] = &_c
+	if v := c.NillableInt; v != nil {
+		_v := *v
+		_c.NillableInt = &_v
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (c *Comment) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -109,6 +152,23 @@ func (c *Comment) String() string {
 	return buf.String()
 }
 
+// DiffComment returns the fields that have different values in the given Comments,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffComment(a, b *Comment) []FieldChange {
+	var changes []FieldChange
+	if a.UniqueInt != b.UniqueInt {
+		changes = append(changes, FieldChange{Field: comment.FieldUniqueInt, Old: a.UniqueInt, New: b.UniqueInt})
+	}
+	if a.UniqueFloat != b.UniqueFloat {
+		changes = append(changes, FieldChange{Field: comment.FieldUniqueFloat, Old: a.UniqueFloat, New: b.UniqueFloat})
+	}
+	if (a.NillableInt == nil) != (b.NillableInt == nil) || a.NillableInt != nil && (*a.NillableInt) != (*b.NillableInt) {
+		changes = append(changes, FieldChange{Field: comment.FieldNillableInt, Old: a.NillableInt, New: b.NillableInt})
+	}
+	return changes
+}
+
 // id returns the int representation of the ID field.
 func (c *Comment) id() int {
 	id, _ := strconv.Atoi(c.ID)
@@ -120,9 +180,14 @@ type Comments []*Comment
 
 // FromRows scans the sql response data into Comments.
 func (c *Comments) FromRows(rows *sql.Rows) error {
+	return c.scan(rows, comment.Columns)
+}
+
+// scan scans the given columns of the sql response data into Comments.
+func (c *Comments) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vc := &Comment{}
-		if err := vc.FromRows(rows); err != nil {
+		if err := vc.scan(rows, columns); err != nil {
 			return err
 		}
 		*c = append(*c, vc)
@@ -6,6 +6,13 @@
 
 package comment
 
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the comment type in the database.
 	Label = "comment"
@@ -29,3 +36,76 @@ var Columns = []string{
 	FieldUniqueFloat,
 	FieldNillableInt,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Tables holds the tables that may be changed by the mutations of the comment. The mutations
+// invalidate only the query results of these tables that were cached by the entcache driver.
+var Tables = []string{
+	"comments",
+}
+
+// Validators holds the schema-level validators of the comment mutations. They are called by the builders before save.
+var Validators = schema.Comment{}.Validators()
+
+// Scope is a reusable named scope of the Comment queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.Comment
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the Comment type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "Comment",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeString,
+		GoType:     "string",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "unique_int",
+			StorageKey: FieldUniqueInt,
+			Type:       field.TypeInt,
+			GoType:     "int",
+			Unique:     true,
+		},
+		{
+			Name:       "unique_float",
+			StorageKey: FieldUniqueFloat,
+			Type:       field.TypeFloat64,
+			GoType:     "float64",
+			Unique:     true,
+		},
+		{
+			Name:       "nillable_int",
+			StorageKey: FieldNillableInt,
+			Type:       field.TypeInt,
+			GoType:     "int",
+			Optional:   true,
+			Nillable:   true,
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{},
+}
+
+// Descriptor returns the runtime descriptor of the Comment type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
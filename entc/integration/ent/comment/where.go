@@ -7,7 +7,11 @@
 package comment
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
@@ -567,6 +571,208 @@ func NillableIntNotNil() predicate.Comment {
 	)
 }
 
+// PredicateFunc returns a predicate from the given per-dialect functions.
+// It allows adding custom SQL/Gremlin fragments to the Comment builders.
+func PredicateFunc(f0 func(*sql.Selector), f1 func(*dsl.Traversal)) predicate.Comment {
+	return predicate.CommentPerDialect(f0, f1)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.Comment, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.Comment, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.Comment, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldUniqueInt:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return UniqueIntEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return UniqueIntNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return UniqueIntIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return UniqueIntNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return UniqueIntGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return UniqueIntGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return UniqueIntLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return UniqueIntLTE(v), nil
+			}
+		}
+	case FieldUniqueFloat:
+		switch op {
+		case "eq":
+			if v, ok := value.(float64); ok || convert(value, &v) {
+				return UniqueFloatEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(float64); ok || convert(value, &v) {
+				return UniqueFloatNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]float64); ok || convert(value, &vs) {
+				return UniqueFloatIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]float64); ok || convert(value, &vs) {
+				return UniqueFloatNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(float64); ok || convert(value, &v) {
+				return UniqueFloatGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(float64); ok || convert(value, &v) {
+				return UniqueFloatGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(float64); ok || convert(value, &v) {
+				return UniqueFloatLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(float64); ok || convert(value, &v) {
+				return UniqueFloatLTE(v), nil
+			}
+		}
+	case FieldNillableInt:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return NillableIntIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return NillableIntNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableIntIsNil(), nil
+				}
+				return Not(NillableIntIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableIntNotNil(), nil
+				}
+				return Not(NillableIntNotNil()), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("comment: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Comment) predicate.Comment {
 	return predicate.CommentPerDialect(
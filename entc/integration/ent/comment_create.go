@@ -9,8 +9,10 @@ package ent
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -19,14 +21,21 @@ import (
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/comment"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // CommentCreate is the builder for creating a Comment entity.
 type CommentCreate struct {
 	config
-	unique_int   *int
-	unique_float *float64
-	nillable_int *int
+	commentMutation
+	// id of the node, if it's mirrored from the primary storage.
+	id *string
+}
+
+// Mutation returns the mutation of the builder.
+func (cc *CommentCreate) Mutation() *CommentMutation {
+	return &CommentMutation{commentMutation: &cc.commentMutation, op: ent.OpCreate}
 }
 
 // SetUniqueInt sets the unique_int field.
@@ -56,13 +65,37 @@ func (cc *CommentCreate) SetNillableNillableInt(i *int) *CommentCreate {
 }
 
 // Save creates the Comment in the database.
-func (cc *CommentCreate) Save(ctx context.Context) (*Comment, error) {
+func (cc *CommentCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Comment, error) {
+	options := ent.NewCallOptions(opts...)
 	if cc.unique_int == nil {
 		return nil, errors.New("ent: missing required field \"unique_int\"")
 	}
 	if cc.unique_float == nil {
 		return nil, errors.New("ent: missing required field \"unique_float\"")
 	}
+	for _, fn := range comment.Validators {
+		if err := fn(cc.Mutation()); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for comment: %v", err)
+		}
+	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if cc.shadow != nil {
+		return cc.dualSave(ctx, opts...)
+	}
+	v, err := cc.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		cc.bus.publish(&Event{Op: ent.OpCreate, Type: comment.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save creates the Comment in the storage of the client dialect.
+func (cc *CommentCreate) save(ctx context.Context) (*Comment, error) {
 	switch cc.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return cc.sqlSave(ctx)
@@ -74,8 +107,8 @@ func (cc *CommentCreate) Save(ctx context.Context) (*Comment, error) {
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (cc *CommentCreate) SaveX(ctx context.Context) *Comment {
-	v, err := cc.Save(ctx)
+func (cc *CommentCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Comment {
+	v, err := cc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -83,6 +116,7 @@ func (cc *CommentCreate) SaveX(ctx context.Context) *Comment {
 }
 
 func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
+	ctx = entcache.Invalidates(ctx, comment.Tables...)
 	var (
 		res sql.Result
 		c   = &Comment{config: cc.config}
@@ -92,6 +126,13 @@ func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
 		return nil, err
 	}
 	builder := sql.Insert(comment.Table).Default(cc.driver.Dialect())
+	if id := cc.id; id != nil {
+		id, err := strconv.Atoi(*id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(comment.FieldID, id)
+	}
 	if value := cc.unique_int; value != nil {
 		builder.Set(comment.FieldUniqueInt, *value)
 		c.UniqueInt = *value
@@ -121,8 +162,9 @@ func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
 
 func (cc *CommentCreate) gremlinSave(ctx context.Context) (*Comment, error) {
 	res := &gremlin.Response{}
-	query, bindings := cc.gremlin().Query()
-	if err := cc.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cc.gremlin()
+	query, _ := traversal.Query()
+	if err := cc.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -168,3 +210,253 @@ func (cc *CommentCreate) gremlin() *dsl.Traversal {
 	}
 	return tr
 }
+
+// CommentUpsert is the builder for creating a Comment entity, or updating the
+// fields of an existing one if it has the same value in the unique key field of the builder.
+type CommentUpsert struct {
+	config
+	key          string
+	unique_int   *int
+	unique_float *float64
+	nillable_int *int
+}
+
+// SetUniqueInt sets the unique_int field.
+func (cu *CommentUpsert) SetUniqueInt(i int) *CommentUpsert {
+	cu.unique_int = &i
+	return cu
+}
+
+// SetUniqueFloat sets the unique_float field.
+func (cu *CommentUpsert) SetUniqueFloat(f float64) *CommentUpsert {
+	cu.unique_float = &f
+	return cu
+}
+
+// SetNillableInt sets the nillable_int field.
+func (cu *CommentUpsert) SetNillableInt(i int) *CommentUpsert {
+	cu.nillable_int = &i
+	return cu
+}
+
+// SetNillableNillableInt sets the nillable_int field if the given value is not nil.
+func (cu *CommentUpsert) SetNillableNillableInt(i *int) *CommentUpsert {
+	if i != nil {
+		cu.SetNillableInt(*i)
+	}
+	return cu
+}
+
+// Save creates the Comment in the database, or updates the entity that has
+// the same value in the key field. Immutable fields are set only on creation.
+func (cu *CommentUpsert) Save(ctx context.Context, opts ...ent.CallOption) (*Comment, error) {
+	options := ent.NewCallOptions(opts...)
+	mutation := &CommentMutation{op: ent.OpCreate, commentMutation: &commentMutation{
+		unique_int:   cu.unique_int,
+		unique_float: cu.unique_float,
+		nillable_int: cu.nillable_int,
+	}}
+	for _, fn := range comment.Validators {
+		if err := fn(mutation); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for comment: %v", err)
+		}
+	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	v, err := cu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		cu.bus.publish(&Event{Op: ent.OpUpdateOne, Type: comment.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save upserts the Comment in the storage of the client dialect.
+func (cu *CommentUpsert) save(ctx context.Context) (*Comment, error) {
+	switch cu.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return cu.sqlSave(ctx)
+	case dialect.Gremlin:
+		return cu.gremlinSave(ctx)
+	default:
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cu *CommentUpsert) SaveX(ctx context.Context, opts ...ent.CallOption) *Comment {
+	v, err := cu.Save(ctx, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cu *CommentUpsert) sqlSave(ctx context.Context) (*Comment, error) {
+	ctx = entcache.Invalidates(ctx, comment.Tables...)
+	var (
+		res    sql.Result
+		key    interface{}
+		update []string
+	)
+	tx, err := cu.driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	builder := sql.Insert(comment.Table)
+	if value := cu.unique_int; value != nil {
+		builder.Set(comment.FieldUniqueInt, *value)
+		update = append(update, comment.FieldUniqueInt)
+		if cu.key == comment.FieldUniqueInt {
+			key = *value
+		}
+	}
+	if value := cu.unique_float; value != nil {
+		builder.Set(comment.FieldUniqueFloat, *value)
+		update = append(update, comment.FieldUniqueFloat)
+		if cu.key == comment.FieldUniqueFloat {
+			key = *value
+		}
+	}
+	if value := cu.nillable_int; value != nil {
+		builder.Set(comment.FieldNillableInt, *value)
+		update = append(update, comment.FieldNillableInt)
+	}
+	rows := &sql.Rows{}
+	query, args := builder.OnConflict(cu.driver.Dialect(), []string{cu.key}, update...).Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return nil, rollback(tx, err)
+	}
+	query, args = sql.Select(comment.Columns...).From(sql.Table(comment.Table)).Where(sql.EQ(cu.key, key)).Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return nil, rollback(tx, err)
+	}
+	c := &Comment{config: cu.config}
+	if !rows.Next() {
+		rows.Close()
+		return nil, rollback(tx, &ErrNotFound{comment.Label})
+	}
+	if err := c.FromRows(rows); err != nil {
+		rows.Close()
+		return nil, rollback(tx, err)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (cu *CommentUpsert) gremlinSave(ctx context.Context) (*Comment, error) {
+	res := &gremlin.Response{}
+	traversal := cu.gremlin()
+	query, _ := traversal.Query()
+	if err := cu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
+	}
+	c := &Comment{config: cu.config}
+	if err := c.FromResponse(res); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (cu *CommentUpsert) gremlin() *dsl.Traversal {
+	var key interface{}
+	if cu.key == comment.FieldUniqueInt && cu.unique_int != nil {
+		key = *cu.unique_int
+	}
+	if cu.key == comment.FieldUniqueFloat && cu.unique_float != nil {
+		key = *cu.unique_float
+	}
+	// properties of new vertices (immutable fields and default values).
+	create := __.AddV(comment.Label)
+	v := g.V().Has(comment.Label, cu.key, key).Fold().Coalesce(__.Unfold(), create)
+	if cu.unique_int != nil {
+		v.Property(dsl.Single, comment.FieldUniqueInt, *cu.unique_int)
+	}
+	if cu.unique_float != nil {
+		v.Property(dsl.Single, comment.FieldUniqueFloat, *cu.unique_float)
+	}
+	if cu.nillable_int != nil {
+		v.Property(dsl.Single, comment.FieldNillableInt, *cu.nillable_int)
+	}
+	return v.ValueMap(true)
+}
+
+// CommentGetOrCreate is the builder for creating a Comment entity, or getting the existing
+// one if its creation violates the uniqueness of one of the fields of the builder.
+type CommentGetOrCreate struct {
+	config
+	commentMutation
+}
+
+// SetUniqueInt sets the unique_int field.
+func (cgoc *CommentGetOrCreate) SetUniqueInt(i int) *CommentGetOrCreate {
+	cgoc.unique_int = &i
+	return cgoc
+}
+
+// SetUniqueFloat sets the unique_float field.
+func (cgoc *CommentGetOrCreate) SetUniqueFloat(f float64) *CommentGetOrCreate {
+	cgoc.unique_float = &f
+	return cgoc
+}
+
+// SetNillableInt sets the nillable_int field.
+func (cgoc *CommentGetOrCreate) SetNillableInt(i int) *CommentGetOrCreate {
+	cgoc.nillable_int = &i
+	return cgoc
+}
+
+// SetNillableNillableInt sets the nillable_int field if the given value is not nil.
+func (cgoc *CommentGetOrCreate) SetNillableNillableInt(i *int) *CommentGetOrCreate {
+	if i != nil {
+		cgoc.SetNillableInt(*i)
+	}
+	return cgoc
+}
+
+// Save creates the Comment in the database. If the creation fails on a constraint violation, the
+// Comment that has the same value in one of the unique fields of the builder is returned instead.
+// Unlike querying the entity before creating it, it's safe for concurrent callers, since the uniqueness
+// is enforced by the storage. The constraint error is returned if there is no such Comment.
+func (cgoc *CommentGetOrCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Comment, error) {
+	create := &CommentCreate{config: cgoc.config, commentMutation: cgoc.commentMutation}
+	v, err := create.Save(ctx, opts...)
+	if err == nil || !IsConstraintFailure(err) {
+		return v, err
+	}
+	var ps []predicate.Comment
+	if create.unique_int != nil {
+		ps = append(ps, comment.UniqueIntEQ(*create.unique_int))
+	}
+	if create.unique_float != nil {
+		ps = append(ps, comment.UniqueFloatEQ(*create.unique_float))
+	}
+	if len(ps) == 0 {
+		return nil, err
+	}
+	v, qerr := (&CommentQuery{config: cgoc.config}).Where(comment.Or(ps...)).Only(ctx)
+	switch {
+	case IsNotFound(qerr):
+		return nil, err
+	case qerr != nil:
+		return nil, qerr
+	}
+	return v, nil
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cgoc *CommentGetOrCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Comment {
+	v, err := cgoc.Save(ctx, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -18,12 +19,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/comment"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // CommentDelete is the builder for deleting a Comment entity.
 type CommentDelete struct {
 	config
 	predicates []predicate.Comment
+	// id of the node, if it's deleted using a CommentDeleteOne builder.
+	id *string
 }
 
 // Where adds a new predicate to the delete builder.
@@ -33,7 +37,37 @@ func (cd *CommentDelete) Where(ps ...predicate.Comment) *CommentDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (cd *CommentDelete) Exec(ctx context.Context) (int, error) {
+func (cd *CommentDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	if cd.shadow != nil {
+		return cd.dualExec(ctx, opts...)
+	}
+	n, err := cd.exec(ctx)
+	if err != nil || n == 0 || options.SkipHooks {
+		return n, err
+	}
+	e := &Event{Op: ent.OpDelete, Type: comment.Label, N: n}
+	if cd.id != nil {
+		e.Op, e.ID = ent.OpDeleteOne, *cd.id
+	}
+	cd.bus.publish(e)
+	return n, nil
+}
+
+// exec deletes the Comment nodes from the storage of the client dialect.
+func (cd *CommentDelete) exec(ctx context.Context) (int, error) {
+	predicates, err := interceptComment(ctx, cd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cd
+		intercepted.predicates = append(predicates, cd.predicates...)
+		cd = &intercepted
+	}
 	switch cd.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return cd.sqlExec(ctx)
@@ -45,8 +79,8 @@ func (cd *CommentDelete) Exec(ctx context.Context) (int, error) {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cd *CommentDelete) ExecX(ctx context.Context) int {
-	n, err := cd.Exec(ctx)
+func (cd *CommentDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := cd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,11 +88,15 @@ func (cd *CommentDelete) ExecX(ctx context.Context) int {
 }
 
 func (cd *CommentDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = entcache.Invalidates(ctx, comment.Tables...)
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(comment.Table))
+	selector := sql.Select().From(sql.Table(comment.Table)).SetDialect(cd.driver.Dialect()).WithContext(ctx)
 	for _, p := range cd.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(comment.Table).FromSelect(selector).Query()
 	if err := cd.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -72,8 +110,9 @@ func (cd *CommentDelete) sqlExec(ctx context.Context) (int, error) {
 
 func (cd *CommentDelete) gremlinExec(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := cd.gremlin().Query()
-	if err := cd.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cd.gremlin()
+	query, _ := traversal.Query()
+	if err := cd.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -93,12 +132,12 @@ type CommentDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (cdo *CommentDeleteOne) Exec(ctx context.Context) error {
-	n, err := cdo.cd.Exec(ctx)
+func (cdo *CommentDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := cdo.cd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{comment.Label}
 	default:
 		return nil
@@ -106,6 +145,8 @@ func (cdo *CommentDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cdo *CommentDeleteOne) ExecX(ctx context.Context) {
-	cdo.cd.ExecX(ctx)
+func (cdo *CommentDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cdo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -25,14 +26,24 @@ import (
 // CommentQuery is the builder for querying Comment entities.
 type CommentQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit     *int
+	offset    *int
+	order     []Order
+	unique    []string
+	fields    []string
+	unordered bool
+	hints     []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.Comment
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath     func(context.Context) (*sql.Selector, error)
+	gremlinPath func(context.Context) (*dsl.Traversal, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -59,6 +70,107 @@ func (cq *CommentQuery) Order(o ...Order) *CommentQuery {
 	return cq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored. Note that, hints are ignored by Gremlin queries.
+func (cq *CommentQuery) Hint(hints ...sql.Hint) *CommentQuery {
+	cq.hints = append(cq.hints, hints...)
+	return cq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	cs, err := client.Comment.Query().
+//		Fields(comment.FieldUniqueInt, comment.FieldUniqueFloat).
+//		All(ctx)
+//
+func (cq *CommentQuery) Fields(fields ...string) *CommentQuery {
+	cq.fields = append(cq.fields, fields...)
+	return cq
+}
+
+// Unordered disables the ordering by id that is added to the Gremlin queries that are paginated
+// using Limit or Offset. The order of the vertices
+// (and therefore, the content of the pages) is not guaranteed without it, but it can be removed when
+// the order is irrelevant (e.g. sampling).
+func (cq *CommentQuery) Unordered() *CommentQuery {
+	cq.unordered = true
+	return cq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (cq *CommentQuery) Scope(scopes ...comment.Scope) *CommentQuery {
+	for _, s := range scopes {
+		cq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				cq.Order(Desc(o.Field))
+			} else {
+				cq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			cq.Limit(s.Limit)
+		}
+	}
+	return cq
+}
+
+// CommentInterceptor is a query interceptor of the CommentClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the CommentClient.Intercept method.
+type CommentInterceptor func(context.Context, *CommentQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (cq *CommentQuery) prepare(ctx context.Context) (*CommentQuery, error) {
+	var interceptors []CommentInterceptor
+	if cq.inters != nil {
+		cq.inters.mu.RLock()
+		interceptors = cq.inters.Comment
+		cq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && cq.sqlPath == nil && cq.gremlinPath == nil {
+		return cq, nil
+	}
+	query := cq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	if path := query.gremlinPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.gremlin, query.gremlinPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptComment returns the predicates that the interceptors of the client add to a Comment query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptComment(ctx context.Context, cfg config) ([]predicate.Comment, error) {
+	query, err := (&CommentQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // First returns the first Comment entity in the query. Returns *ErrNotFound when no comment was found.
 func (cq *CommentQuery) First(ctx context.Context) (*Comment, error) {
 	cs, err := cq.Limit(1).All(ctx)
@@ -104,7 +216,7 @@ func (cq *CommentQuery) FirstXID(ctx context.Context) string {
 
 // Only returns the only Comment entity in the query, returns an error if not exactly one entity was returned.
 func (cq *CommentQuery) Only(ctx context.Context) (*Comment, error) {
-	cs, err := cq.Limit(2).All(ctx)
+	cs, err := cq.Limit(2).Unordered().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +242,7 @@ func (cq *CommentQuery) OnlyX(ctx context.Context) *Comment {
 // OnlyID returns the only Comment id in the query, returns an error if not exactly one id was returned.
 func (cq *CommentQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = cq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = cq.Limit(2).Unordered().IDs(ctx); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -154,33 +266,63 @@ func (cq *CommentQuery) OnlyXID(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of Comments.
-func (cq *CommentQuery) All(ctx context.Context) ([]*Comment, error) {
-	switch cq.driver.Dialect() {
+func (cq *CommentQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*Comment, error) {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.shadow != nil && !ent.NewCallOptions(opts...).SkipHooks {
+		return query.dualAll(ctx)
+	}
+
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return cq.sqlAll(ctx)
+		return query.sqlAll(ctx)
 	case dialect.Gremlin:
-		return cq.gremlinAll(ctx)
+		return query.gremlinAll(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
 // AllX is like All, but panics if an error occurs.
-func (cq *CommentQuery) AllX(ctx context.Context) []*Comment {
-	cs, err := cq.All(ctx)
+func (cq *CommentQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*Comment {
+	cs, err := cq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return cs
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (cq *CommentQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlQueryString(ctx)
+	case dialect.Gremlin:
+		return query.gremlinQueryString(ctx)
+	default:
+		return "", nil, errors.New("ent: unsupported dialect")
+	}
+}
+
 // IDs executes the query and returns a list of Comment ids.
 func (cq *CommentQuery) IDs(ctx context.Context) ([]string, error) {
-	switch cq.driver.Dialect() {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return cq.sqlIDs(ctx)
+		return query.sqlIDs(ctx)
 	case dialect.Gremlin:
-		return cq.gremlinIDs(ctx)
+		return query.gremlinIDs(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
@@ -195,13 +337,46 @@ func (cq *CommentQuery) IDsX(ctx context.Context) []string {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Comments that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (cq *CommentQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if cq.sql != nil || cq.gremlin != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &CommentQuery{
+		config:     cq.config,
+		predicates: append([]predicate.Comment{}, cq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(string)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for Comment", after)
+		}
+		query.Where(comment.IDGT(id))
+	}
+	ids, err := query.Order(Asc(comment.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (cq *CommentQuery) Count(ctx context.Context) (int, error) {
-	switch cq.driver.Dialect() {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return cq.sqlCount(ctx)
+		return query.sqlCount(ctx)
 	case dialect.Gremlin:
-		return cq.gremlinCount(ctx)
+		return query.gremlinCount(ctx)
 	default:
 		return 0, errors.New("ent: unsupported dialect")
 	}
@@ -218,11 +393,15 @@ func (cq *CommentQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (cq *CommentQuery) Exist(ctx context.Context) (bool, error) {
-	switch cq.driver.Dialect() {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return cq.sqlExist(ctx)
+		return query.sqlExist(ctx)
 	case dialect.Gremlin:
-		return cq.gremlinExist(ctx)
+		return query.gremlinExist(ctx)
 	default:
 		return false, errors.New("ent: unsupported dialect")
 	}
@@ -246,10 +425,16 @@ func (cq *CommentQuery) Clone() *CommentQuery {
 		offset:     cq.offset,
 		order:      append([]Order{}, cq.order...),
 		unique:     append([]string{}, cq.unique...),
+		fields:     append([]string{}, cq.fields...),
+		unordered:  cq.unordered,
+		hints:      append([]sql.Hint{}, cq.hints...),
+		edgeCount:  cq.edgeCount,
 		predicates: append([]predicate.Comment{}, cq.predicates...),
 		// clone intermediate queries.
-		sql:     cq.sql.Clone(),
-		gremlin: cq.gremlin.Clone(),
+		sql:         cq.sql.Clone(),
+		sqlPath:     cq.sqlPath,
+		gremlin:     cq.gremlin.Clone(),
+		gremlinPath: cq.gremlinPath,
 	}
 }
 
@@ -271,11 +456,18 @@ func (cq *CommentQuery) Clone() *CommentQuery {
 func (cq *CommentQuery) GroupBy(field string, fields ...string) *CommentGroupBy {
 	group := &CommentGroupBy{config: cq.config}
 	group.fields = append([]string{field}, fields...)
-	switch cq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		group.sql = cq.sqlQuery()
-	case dialect.Gremlin:
-		group.gremlin = cq.gremlinQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := cq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			group.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			group.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return group
 }
@@ -295,20 +487,27 @@ func (cq *CommentQuery) GroupBy(field string, fields ...string) *CommentGroupBy
 func (cq *CommentQuery) Select(field string, fields ...string) *CommentSelect {
 	selector := &CommentSelect{config: cq.config}
 	selector.fields = append([]string{field}, fields...)
-	switch cq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		selector.sql = cq.sqlQuery()
-	case dialect.Gremlin:
-		selector.gremlin = cq.gremlinQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := cq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			selector.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			selector.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return selector
 }
 
 func (cq *CommentQuery) sqlAll(ctx context.Context) ([]*Comment, error) {
 	rows := &sql.Rows{}
-	selector := cq.sqlQuery()
-	if unique := cq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := cq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := cq.driver.Query(ctx, query, args, rows); err != nil {
@@ -316,21 +515,61 @@ func (cq *CommentQuery) sqlAll(ctx context.Context) ([]*Comment, error) {
 	}
 	defer rows.Close()
 	var cs Comments
-	if err := cs.FromRows(rows); err != nil {
+	if err := cs.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	cs.config(cq.config)
 	return cs, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (cq *CommentQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := cq.sqlQuery(ctx)
+	if unique := cq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := comment.Columns
+	if fields := cq.fields; len(fields) > 0 {
+		columns = append([]string{comment.FieldID}, fields...)
+		for _, c := range fields {
+			if !comment.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (cq *CommentQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := cq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
 func (cq *CommentQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := cq.sqlQuery()
+	selector := cq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{comment.FieldID}
 	if len(cq.unique) > 0 {
 		unique = cq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := cq.edgeCount; c != nil && len(cq.predicates) == 0 && len(cq.unique) == 0 &&
+		len(cq.hints) == 0 && cq.limit == nil && cq.offset == nil {
+		selector = c.Clone().SetDialect(cq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := cq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -347,11 +586,22 @@ func (cq *CommentQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (cq *CommentQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := cq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := cq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(comment.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := cq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := cq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (cq *CommentQuery) sqlIDs(ctx context.Context) ([]string, error) {
@@ -366,13 +616,33 @@ func (cq *CommentQuery) sqlIDs(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
-func (cq *CommentQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (cq *CommentQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(comment.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (cq *CommentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(comment.Table)
 	selector := sql.Select(t1.Columns(comment.Columns...)...).From(t1)
 	if cq.sql != nil {
 		selector = cq.sql
 		selector.Select(selector.Columns(comment.Columns...)...)
 	}
+	selector.SetDialect(cq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(cq.hints...)
 	for _, p := range cq.predicates {
 		p(selector)
 	}
@@ -392,8 +662,9 @@ func (cq *CommentQuery) sqlQuery() *sql.Selector {
 
 func (cq *CommentQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := cq.gremlinQuery().Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cq.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := cq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	vertices, err := res.ReadVertices()
@@ -409,8 +680,9 @@ func (cq *CommentQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 
 func (cq *CommentQuery) gremlinAll(ctx context.Context) ([]*Comment, error) {
 	res := &gremlin.Response{}
-	query, bindings := cq.gremlinQuery().ValueMap(true).Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cq.gremlinAllQuery()
+	query, _ := traversal.Query()
+	if err := cq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	var cs Comments
@@ -421,10 +693,25 @@ func (cq *CommentQuery) gremlinAll(ctx context.Context) ([]*Comment, error) {
 	return cs, nil
 }
 
+// gremlinAllQuery returns the traversal of All, that returns the value maps of the vertices.
+func (cq *CommentQuery) gremlinAllQuery() *dsl.Traversal {
+	args := []interface{}{true}
+	for _, f := range cq.fields {
+		args = append(args, f)
+	}
+	return cq.gremlinQuery().ValueMap(args...)
+}
+
+func (cq *CommentQuery) gremlinQueryString(context.Context) (string, interface{}, error) {
+	query, bindings := cq.gremlinAllQuery().Query()
+	return query, bindings, nil
+}
+
 func (cq *CommentQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := cq.gremlinQuery().Count().Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cq.gremlinTraversal(false).Count()
+	query, _ := traversal.Query()
+	if err := cq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -432,14 +719,22 @@ func (cq *CommentQuery) gremlinCount(ctx context.Context) (int, error) {
 
 func (cq *CommentQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
-	query, bindings := cq.gremlinQuery().HasNext().Query()
-	if err := cq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cq.gremlinTraversal(false).HasNext()
+	query, _ := traversal.Query()
+	if err := cq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
 }
 
+// gremlinQuery returns the traversal of the query for listing its vertices, with its default orders.
 func (cq *CommentQuery) gremlinQuery() *dsl.Traversal {
+	return cq.gremlinTraversal(true)
+}
+
+// gremlinTraversal returns the traversal of the query. The default orders are added only to the traversals
+// of listing queries (list), since they don't change the results of the others (e.g. Count and Exist).
+func (cq *CommentQuery) gremlinTraversal(list bool) *dsl.Traversal {
 	v := g.V().HasLabel(comment.Label)
 	if cq.gremlin != nil {
 		v = cq.gremlin.Clone()
@@ -447,13 +742,22 @@ func (cq *CommentQuery) gremlinQuery() *dsl.Traversal {
 	for _, p := range cq.predicates {
 		p(v)
 	}
-	if len(cq.order) > 0 {
+	limit, offset := cq.limit, cq.offset
+	list = list && !cq.unordered
+	// paginated queries are ordered by id (also as a tie-breaker of the
+	// query orders), in order to keep the pages stable between calls.
+	stable := (limit != nil || offset != nil) && list
+	order := cq.order
+	if len(order) > 0 || stable {
 		v.Order()
-		for _, p := range cq.order {
+		for _, p := range order {
 			p(v)
 		}
+		if stable {
+			v.By(dsl.ID, dsl.Incr)
+		}
 	}
-	switch limit, offset := cq.limit, cq.offset; {
+	switch {
 	case limit != nil && offset != nil:
 		v.Range(*offset, *offset+*limit)
 	case offset != nil:
@@ -475,6 +779,11 @@ type CommentGroupBy struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving     *sql.Predicate
+	gremlinHaving *dsl.Traversal
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -483,8 +792,27 @@ func (cgb *CommentGroupBy) Aggregate(fns ...Aggregate) *CommentGroupBy {
 	return cgb
 }
 
+// Having adds per-dialect predicates on the aggregated values of the groups.
+// In Gremlin, the predicate is applied using a `where` step on the values of each group.
+// For example:
+//
+//	client.Comment.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5), __.Select(ent.DefaultCountLabel).Is(p.GT(5))).
+//		Scan(ctx, &v)
+//
+func (cgb *CommentGroupBy) Having(p0 *sql.Predicate, p1 *dsl.Traversal) *CommentGroupBy {
+	cgb.sqlHaving = p0
+	cgb.gremlinHaving = p1
+	return cgb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (cgb *CommentGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := cgb.path(ctx); err != nil {
+		return err
+	}
 	switch cgb.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return cgb.sqlScan(ctx, v)
@@ -587,6 +915,9 @@ func (cgb *CommentGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (cgb *CommentGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := cgb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := cgb.sqlQuery().Query()
 	if err := cgb.driver.Query(ctx, query, args, rows); err != nil {
@@ -603,13 +934,18 @@ func (cgb *CommentGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range cgb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(cgb.fields...)
+	selector.Select(columns...).GroupBy(cgb.fields...)
+	if cgb.sqlHaving != nil {
+		selector.Having(cgb.sqlHaving)
+	}
+	return selector
 }
 
 func (cgb *CommentGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
-	query, bindings := cgb.gremlinQuery().Query()
-	if err := cgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cgb.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := cgb.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(cgb.fields)+len(cgb.fns) == 1 {
@@ -636,11 +972,14 @@ func (cgb *CommentGroupBy) gremlinQuery() *dsl.Traversal {
 		names = append(names, f)
 		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
 	}
-	return cgb.gremlin.Group().
+	v := cgb.gremlin.Group().
 		By(__.Values(cgb.fields...).Fold()).
 		By(__.Fold().Match(trs...).Select(names...)).
-		Select(dsl.Values).
-		Next()
+		Select(dsl.Values)
+	if cgb.gremlinHaving != nil {
+		v = v.Unfold().Where(cgb.gremlinHaving).Fold()
+	}
+	return v.Next()
 }
 
 // CommentSelect is the builder for select fields of Comment entities.
@@ -650,10 +989,15 @@ type CommentSelect struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (cs *CommentSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cs.path(ctx); err != nil {
+		return err
+	}
 	switch cs.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return cs.sqlScan(ctx, v)
@@ -756,6 +1100,9 @@ func (cs *CommentSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (cs *CommentSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := cs.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := cs.sqlQuery().Query()
 	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
@@ -784,8 +1131,8 @@ func (cs *CommentSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		}
 		traversal = cs.gremlin.ValueMap(fields...)
 	}
-	query, bindings := traversal.Query()
-	if err := cs.driver.Exec(ctx, query, bindings, res); err != nil {
+	query, _ := traversal.Query()
+	if err := cs.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(cs.fields) == 1 {
@@ -10,7 +10,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -20,19 +22,17 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/comment"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // CommentUpdate is the builder for updating Comment entities.
 type CommentUpdate struct {
 	config
-	unique_int        *int
-	addunique_int     *int
-	unique_float      *float64
-	addunique_float   *float64
-	nillable_int      *int
-	addnillable_int   *int
-	clearnillable_int bool
-	predicates        []predicate.Comment
+	commentMutation
+	predicates []predicate.Comment
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Comment
 }
 
 // Where adds a new predicate for the builder.
@@ -41,6 +41,11 @@ func (cu *CommentUpdate) Where(ps ...predicate.Comment) *CommentUpdate {
 	return cu
 }
 
+// Mutation returns the mutation of the builder.
+func (cu *CommentUpdate) Mutation() *CommentMutation {
+	return &CommentMutation{commentMutation: &cu.commentMutation, op: ent.OpUpdate}
+}
+
 // SetUniqueInt sets the unique_int field.
 func (cu *CommentUpdate) SetUniqueInt(i int) *CommentUpdate {
 	cu.unique_int = &i
@@ -108,20 +113,73 @@ func (cu *CommentUpdate) ClearNillableInt() *CommentUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (cu *CommentUpdate) Save(ctx context.Context) (int, error) {
+func (cu *CommentUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := cu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (cu *CommentUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]string, error) {
+	options := ent.NewCallOptions(opts...)
+	for _, fn := range comment.Validators {
+		if err := fn(cu.Mutation()); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for comment: %v", err)
+		}
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if cu.shadow != nil {
+		return cu.dualSave(ctx, opts...)
+	}
+	ids, err := cu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 && !options.SkipHooks {
+		cu.bus.publish(&Event{Op: ent.OpUpdate, Type: comment.Label, N: len(ids)})
+	}
+	return ids, nil
+}
+
+// save updates the Comment nodes in the storage of the client dialect.
+func (cu *CommentUpdate) save(ctx context.Context) ([]string, error) {
+	predicates, err := interceptComment(ctx, cu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cu
+		intercepted.predicates = append(predicates, cu.predicates...)
+		cu = &intercepted
+	}
 	switch cu.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return cu.sqlSave(ctx)
 	case dialect.Gremlin:
 		return cu.gremlinSave(ctx)
 	default:
-		return 0, errors.New("ent: unsupported dialect")
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (cu *CommentUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Comment, error) {
+	var cs []*Comment
+	cu.returning = &cs
+	defer func() { cu.returning = nil }()
+	if _, err := cu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
 	}
+	return cs, nil
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (cu *CommentUpdate) SaveX(ctx context.Context) int {
-	affected, err := cu.Save(ctx)
+func (cu *CommentUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := cu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -129,44 +187,49 @@ func (cu *CommentUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (cu *CommentUpdate) Exec(ctx context.Context) error {
-	_, err := cu.Save(ctx)
+func (cu *CommentUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := cu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cu *CommentUpdate) ExecX(ctx context.Context) {
-	if err := cu.Exec(ctx); err != nil {
+func (cu *CommentUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(comment.FieldID).From(sql.Table(comment.Table))
+func (cu *CommentUpdate) sqlSave(ctx context.Context) (_ []string, err error) {
+	ctx = entcache.Invalidates(ctx, comment.Tables...)
+	selector := sql.Select(comment.FieldID).From(sql.Table(comment.Table)).SetDialect(cu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range cu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = cu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := cu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -196,25 +259,70 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
+		}
+	}
+	if cu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(comment.Columns...).
+			From(sql.Table(comment.Table)).
+			Where(sql.InInts(comment.FieldID, ids...)).
+			SetDialect(cu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var cs Comments
+		err := cs.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Comment: %v", err))
 		}
+		cs.config(cu.config)
+		*cu.returning = cs
 	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
+	}
+	vs := make([]string, len(ids))
+	for i, id := range ids {
+		vs[i] = strconv.Itoa(id)
 	}
-	return len(ids), nil
+	return vs, nil
 }
 
-func (cu *CommentUpdate) gremlinSave(ctx context.Context) (int, error) {
+func (cu *CommentUpdate) gremlinSave(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := cu.gremlin().Query()
-	if err := cu.driver.Exec(ctx, query, bindings, res); err != nil {
-		return 0, err
+	traversal := cu.gremlin()
+	query, _ := traversal.Query()
+	if err := cu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
-		return 0, err
+		return nil, err
 	}
-	return res.ReadInt()
+	if cu.returning != nil {
+		var cs Comments
+		if err := cs.FromResponse(res); err != nil {
+			return nil, err
+		}
+		cs.config(cu.config)
+		*cu.returning = cs
+		ids := make([]string, 0, len(cs))
+		for _, c := range cs {
+			ids = append(ids, c.ID)
+		}
+		return ids, nil
+	}
+	vertices, err := res.ReadVertices()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vertices))
+	for _, vertex := range vertices {
+		ids = append(ids, vertex.ID.(string))
+	}
+	return ids, nil
 }
 
 func (cu *CommentUpdate) gremlin() *dsl.Traversal {
@@ -276,11 +384,13 @@ func (cu *CommentUpdate) gremlin() *dsl.Traversal {
 	if len(properties) > 0 {
 		v.SideEffect(__.Properties(properties...).Drop())
 	}
-	v.Count()
+	if cu.returning != nil {
+		v.ValueMap(true)
+	}
 	if len(constraints) > 0 {
 		constraints = append(constraints, &constraint{
 			pred: rv.Count(),
-			test: __.Is(p.GT(1)).Constant(&ErrConstraintFailed{msg: "update traversal contains more than one vertex"}),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
 		})
 		v = constraints[0].pred.Coalesce(constraints[0].test, v)
 		for _, cr := range constraints[1:] {
@@ -294,14 +404,16 @@ func (cu *CommentUpdate) gremlin() *dsl.Traversal {
 // CommentUpdateOne is the builder for updating a single Comment entity.
 type CommentUpdateOne struct {
 	config
-	id                string
-	unique_int        *int
-	addunique_int     *int
-	unique_float      *float64
-	addunique_float   *float64
-	nillable_int      *int
-	addnillable_int   *int
-	clearnillable_int bool
+	id     string
+	entity *Comment
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Comment
+	commentMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (cuo *CommentUpdateOne) Mutation() *CommentMutation {
+	return &CommentMutation{commentMutation: &cuo.commentMutation, op: ent.OpUpdateOne, id: &cuo.id}
 }
 
 // SetUniqueInt sets the unique_int field.
@@ -370,8 +482,67 @@ func (cuo *CommentUpdateOne) ClearNillableInt() *CommentUpdateOne {
 	return cuo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (cuo *CommentUpdateOne) SetChangedFrom(original *Comment) *CommentUpdateOne {
+	c := cuo.entity
+	if c == nil || original == nil {
+		return cuo
+	}
+	for _, change := range DiffComment(original, c) {
+		switch change.Field {
+		case comment.FieldUniqueInt:
+			cuo.SetUniqueInt(c.UniqueInt)
+		case comment.FieldUniqueFloat:
+			cuo.SetUniqueFloat(c.UniqueFloat)
+		case comment.FieldNillableInt:
+			if c.NillableInt == nil {
+				cuo.ClearNillableInt()
+			} else {
+				cuo.SetNillableInt(*c.NillableInt)
+			}
+		}
+	}
+	return cuo
+}
+
 // Save executes the query and returns the updated entity.
-func (cuo *CommentUpdateOne) Save(ctx context.Context) (*Comment, error) {
+func (cuo *CommentUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Comment, error) {
+	options := ent.NewCallOptions(opts...)
+	for _, fn := range comment.Validators {
+		if err := fn(cuo.Mutation()); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for comment: %v", err)
+		}
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if cuo.shadow != nil {
+		return cuo.dualSave(ctx, opts...)
+	}
+	c, err := cuo.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		cuo.bus.publish(&Event{Op: ent.OpUpdateOne, Type: comment.Label, ID: c.ID, Node: c, N: 1})
+	}
+	return c, nil
+}
+
+// save updates the Comment in the storage of the client dialect.
+func (cuo *CommentUpdateOne) save(ctx context.Context) (*Comment, error) {
+	predicates, err := interceptComment(ctx, cuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cuo
+		intercepted.predicates = append(predicates, cuo.predicates...)
+		cuo = &intercepted
+	}
 	switch cuo.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return cuo.sqlSave(ctx)
@@ -383,8 +554,8 @@ func (cuo *CommentUpdateOne) Save(ctx context.Context) (*Comment, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (cuo *CommentUpdateOne) SaveX(ctx context.Context) *Comment {
-	c, err := cuo.Save(ctx)
+func (cuo *CommentUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Comment {
+	c, err := cuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -392,21 +563,29 @@ func (cuo *CommentUpdateOne) SaveX(ctx context.Context) *Comment {
 }
 
 // Exec executes the query on the entity.
-func (cuo *CommentUpdateOne) Exec(ctx context.Context) error {
-	_, err := cuo.Save(ctx)
+func (cuo *CommentUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := cuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
-	if err := cuo.Exec(ctx); err != nil {
+func (cuo *CommentUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (c *Comment, err error) {
-	selector := sql.Select(comment.Columns...).From(sql.Table(comment.Table))
+	ctx = entcache.Invalidates(ctx, comment.Tables...)
+	selector := sql.Select(comment.Columns...).From(sql.Table(comment.Table)).SetDialect(cuo.driver.Dialect())
 	comment.ID(cuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range cuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = cuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -484,8 +663,9 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (c *Comment, err error
 
 func (cuo *CommentUpdateOne) gremlinSave(ctx context.Context) (*Comment, error) {
 	res := &gremlin.Response{}
-	query, bindings := cuo.gremlin(cuo.id).Query()
-	if err := cuo.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := cuo.gremlin(cuo.id)
+	query, _ := traversal.Query()
+	if err := cuo.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -505,6 +685,9 @@ func (cuo *CommentUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 2)
 	v := g.V(id)
+	for _, p := range cuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
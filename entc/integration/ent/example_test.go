@@ -9,11 +9,16 @@ package ent
 import (
 	"context"
 	"log"
-	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 
+	"net"
+	"time"
+
 	"github.com/facebookincubator/ent/entc/integration/ent/fieldtype"
+	"github.com/facebookincubator/ent/entc/integration/ent/item"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/google/uuid"
 )
 
 // dsn for the database. In order to run the tests locally, run the following command:
@@ -107,6 +112,15 @@ func ExampleFieldType() {
 		SetNillableInt64(1).
 		SetValidateOptionalInt32(1).
 		SetState(fieldtype.StateOn).
+		SetLink(*new(schema.Link)).
+		SetNullLink(*new(schema.Link)).
+		SetPriority(*new(schema.Priority)).
+		SetRole(*new(schema.Role)).
+		SetNullableInt(1).
+		SetNullableString("string").
+		SetUUID(uuid.New()).
+		SetIP(net.ParseIP("127.0.0.1")).
+		SetMac(net.HardwareAddr{0x00, 0x00, 0x5e, 0x00, 0x53, 0x01}).
 		SaveX(ctx)
 	log.Println("fieldtype created:", ft)
 
@@ -206,6 +220,7 @@ func ExampleGroup() {
 		SetLast("string").
 		SetNickname("string").
 		SetPhone("string").
+		SetPassword("string").
 		SaveX(ctx)
 	log.Println("user created:", u1)
 	gi3 := client.GroupInfo.
@@ -291,6 +306,10 @@ func ExampleItem() {
 	// create item vertex with its edges.
 	i := client.Item.
 		Create().
+		SetUpdateField("string").
+		SetLabelField("string").
+		SetType(item.TypeA).
+		SetFunc("string").
 		SaveX(ctx)
 	log.Println("item created:", i)
 
@@ -406,6 +425,7 @@ func ExampleUser() {
 		SetLast("string").
 		SetNickname("string").
 		SetPhone("string").
+		SetPassword("string").
 		SaveX(ctx)
 	log.Println("user created:", u4)
 	u6 := client.User.
@@ -415,6 +435,7 @@ func ExampleUser() {
 		SetLast("string").
 		SetNickname("string").
 		SetPhone("string").
+		SetPassword("string").
 		SaveX(ctx)
 	log.Println("user created:", u6)
 	pe7 := client.Pet.
@@ -429,6 +450,7 @@ func ExampleUser() {
 		SetLast("string").
 		SetNickname("string").
 		SetPhone("string").
+		SetPassword("string").
 		SaveX(ctx)
 	log.Println("user created:", u8)
 	u10 := client.User.
@@ -438,6 +460,7 @@ func ExampleUser() {
 		SetLast("string").
 		SetNickname("string").
 		SetPhone("string").
+		SetPassword("string").
 		SaveX(ctx)
 	log.Println("user created:", u10)
 
@@ -449,6 +472,7 @@ func ExampleUser() {
 		SetLast("string").
 		SetNickname("string").
 		SetPhone("string").
+		SetPassword("string").
 		SetCard(c0).
 		AddPets(pe1).
 		AddFiles(f2).
@@ -9,11 +9,15 @@ package ent
 import (
 	"bytes"
 	"fmt"
+	"net"
+	"reflect"
 	"strconv"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/fieldtype"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/google/uuid"
 )
 
 // FieldType is the model entity for the FieldType schema.
@@ -55,10 +59,38 @@ type FieldType struct {
 	ValidateOptionalInt32 int32 `json:"validate_optional_int32,omitempty"`
 	// State holds the value of the "state" field.
 	State fieldtype.State `json:"state,omitempty"`
+	// Link holds the value of the "link" field.
+	Link schema.Link `json:"link,omitempty"`
+	// NullLink holds the value of the "null_link" field.
+	NullLink *schema.Link `json:"null_link,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority schema.Priority `json:"priority,omitempty"`
+	// Role holds the value of the "role" field.
+	Role schema.Role `json:"role,omitempty"`
+	// NullableInt holds the value of the "nullable_int" field.
+	NullableInt int `json:"nullable_int,omitempty"`
+	// NullableString holds the value of the "nullable_string" field.
+	NullableString string `json:"nullable_string,omitempty"`
+	// UUID holds the value of the "uuid" field.
+	UUID uuid.UUID `json:"uuid,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP net.IP `json:"ip,omitempty"`
+	// Mac holds the value of the "mac" field.
+	Mac *net.HardwareAddr `json:"mac,omitempty"`
+	// nullNullableInt reports if the "nullable_int" field is NULL in the database.
+	nullNullableInt bool
+	// nullNullableString reports if the "nullable_string" field is NULL in the database.
+	nullNullableString bool
 }
 
 // FromRows scans the sql response data into FieldType.
 func (ft *FieldType) FromRows(rows *sql.Rows) error {
+	return ft.scan(rows, fieldtype.Columns)
+}
+
+// scan scans the given columns of the sql response data into FieldType.
+// Fields that their columns were not selected are left with their zero values.
+func (ft *FieldType) scan(rows *sql.Rows, columns []string) error {
 	var vft struct {
 		ID                    int
 		Int                   sql.NullInt64
@@ -78,28 +110,78 @@ func (ft *FieldType) FromRows(rows *sql.Rows) error {
 		NillableInt64         sql.NullInt64
 		ValidateOptionalInt32 sql.NullInt64
 		State                 sql.NullString
+		Link                  schema.Link
+		NullLink              *schema.Link
+		Priority              schema.Priority
+		Role                  schema.Role
+		NullableInt           sql.NullInt64
+		NullableString        sql.NullString
+		UUID                  uuid.UUID
+		IP                    sql.NullString
+		Mac                   sql.NullString
+	}
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fieldtype.FieldID:
+			values[i] = &vft.ID
+		case fieldtype.FieldInt:
+			values[i] = &vft.Int
+		case fieldtype.FieldInt8:
+			values[i] = &vft.Int8
+		case fieldtype.FieldInt16:
+			values[i] = &vft.Int16
+		case fieldtype.FieldInt32:
+			values[i] = &vft.Int32
+		case fieldtype.FieldInt64:
+			values[i] = &vft.Int64
+		case fieldtype.FieldOptionalInt:
+			values[i] = &vft.OptionalInt
+		case fieldtype.FieldOptionalInt8:
+			values[i] = &vft.OptionalInt8
+		case fieldtype.FieldOptionalInt16:
+			values[i] = &vft.OptionalInt16
+		case fieldtype.FieldOptionalInt32:
+			values[i] = &vft.OptionalInt32
+		case fieldtype.FieldOptionalInt64:
+			values[i] = &vft.OptionalInt64
+		case fieldtype.FieldNillableInt:
+			values[i] = &vft.NillableInt
+		case fieldtype.FieldNillableInt8:
+			values[i] = &vft.NillableInt8
+		case fieldtype.FieldNillableInt16:
+			values[i] = &vft.NillableInt16
+		case fieldtype.FieldNillableInt32:
+			values[i] = &vft.NillableInt32
+		case fieldtype.FieldNillableInt64:
+			values[i] = &vft.NillableInt64
+		case fieldtype.FieldValidateOptionalInt32:
+			values[i] = &vft.ValidateOptionalInt32
+		case fieldtype.FieldState:
+			values[i] = &vft.State
+		case fieldtype.FieldLink:
+			values[i] = &vft.Link
+		case fieldtype.FieldNullLink:
+			values[i] = &vft.NullLink
+		case fieldtype.FieldPriority:
+			values[i] = &vft.Priority
+		case fieldtype.FieldRole:
+			values[i] = &vft.Role
+		case fieldtype.FieldNullableInt:
+			values[i] = &vft.NullableInt
+		case fieldtype.FieldNullableString:
+			values[i] = &vft.NullableString
+		case fieldtype.FieldUUID:
+			values[i] = &vft.UUID
+		case fieldtype.FieldIP:
+			values[i] = &vft.IP
+		case fieldtype.FieldMac:
+			values[i] = &vft.Mac
+		default:
+			return fmt.Errorf("unexpected column %q for type FieldType", columns[i])
+		}
 	}
-	// the order here should be the same as in the `fieldtype.Columns`.
-	if err := rows.Scan(
-		&vft.ID,
-		&vft.Int,
-		&vft.Int8,
-		&vft.Int16,
-		&vft.Int32,
-		&vft.Int64,
-		&vft.OptionalInt,
-		&vft.OptionalInt8,
-		&vft.OptionalInt16,
-		&vft.OptionalInt32,
-		&vft.OptionalInt64,
-		&vft.NillableInt,
-		&vft.NillableInt8,
-		&vft.NillableInt16,
-		&vft.NillableInt32,
-		&vft.NillableInt64,
-		&vft.ValidateOptionalInt32,
-		&vft.State,
-	); err != nil {
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	ft.ID = strconv.Itoa(vft.ID)
@@ -135,6 +217,29 @@ func (ft *FieldType) FromRows(rows *sql.Rows) error {
 	}
 	ft.ValidateOptionalInt32 = int32(vft.ValidateOptionalInt32.Int64)
 	ft.State = fieldtype.State(vft.State.String)
+	ft.Link = vft.Link
+	ft.NullLink = vft.NullLink
+	ft.Priority = vft.Priority
+	ft.Role = vft.Role
+	ft.NullableInt = int(vft.NullableInt.Int64)
+	ft.nullNullableInt = !vft.NullableInt.Valid
+	ft.NullableString = vft.NullableString.String
+	ft.nullNullableString = !vft.NullableString.Valid
+	ft.UUID = vft.UUID
+	if value := vft.IP; value.Valid {
+		v := net.ParseIP(value.String)
+		if v == nil {
+			return fmt.Errorf("parse field ip: invalid IP address %q", value.String)
+		}
+		ft.IP = v
+	}
+	if value := vft.Mac; value.Valid {
+		v, err := net.ParseMAC(value.String)
+		if err != nil {
+			return fmt.Errorf("parse field mac: %v", err)
+		}
+		ft.Mac = &v
+	}
 	return nil
 }
 
@@ -163,6 +268,15 @@ func (ft *FieldType) FromResponse(res *gremlin.Response) error {
 		NillableInt64         *int64          `json:"nillable_int64,omitempty"`
 		ValidateOptionalInt32 int32           `json:"validate_optional_int32,omitempty"`
 		State                 fieldtype.State `json:"state,omitempty"`
+		Link                  schema.Link     `json:"link,omitempty"`
+		NullLink              *schema.Link    `json:"null_link,omitempty"`
+		Priority              schema.Priority `json:"priority,omitempty"`
+		Role                  schema.Role     `json:"role,omitempty"`
+		NullableInt           *int            `json:"nullable_int,omitempty"`
+		NullableString        *string         `json:"nullable_string,omitempty"`
+		UUID                  string          `json:"uuid,omitempty"`
+		IP                    string          `json:"ip,omitempty"`
+		Mac                   *string         `json:"mac,omitempty"`
 	}
 	if err := vmap.Decode(&vft); err != nil {
 		return err
@@ -185,9 +299,68 @@ func (ft *FieldType) FromResponse(res *gremlin.Response) error {
 	ft.NillableInt64 = vft.NillableInt64
 	ft.ValidateOptionalInt32 = vft.ValidateOptionalInt32
 	ft.State = vft.State
+	ft.Link = vft.Link
+	ft.NullLink = vft.NullLink
+	ft.Priority = vft.Priority
+	ft.Role = vft.Role
+
+	if v := vft.NullableInt; v != nil {
+		ft.NullableInt = *v
+	} else {
+		ft.nullNullableInt = true
+	}
+
+	if v := vft.NullableString; v != nil {
+		ft.NullableString = *v
+	} else {
+		ft.nullNullableString = true
+	}
+
+	if vft.UUID != "" {
+		value, err := uuid.Parse(vft.UUID)
+		if err != nil {
+			return fmt.Errorf("parse field uuid: %v", err)
+		}
+		ft.UUID = value
+	}
+
+	if vft.IP != "" {
+		value := net.ParseIP(vft.IP)
+		if value == nil {
+			return fmt.Errorf("parse field ip: invalid IP address %q", vft.IP)
+		}
+		ft.IP = value
+	}
+
+	if vft.Mac != nil {
+		value, err := net.ParseMAC(*vft.Mac)
+		if err != nil {
+			return fmt.Errorf("parse field mac: %v", err)
+		}
+		ft.Mac = &value
+	}
+
 	return nil
 }
 
+// NullableIntOrNull returns the value of the "nullable_int" field, or nil if it's NULL in the database.
+func (ft *FieldType) NullableIntOrNull() *int {
+	if ft.nullNullableInt {
+		return nil
+	}
+	v := ft.NullableInt
+	return &v
+}
+
+// NullableStringOrNull returns the value of the "nullable_string" field, or nil if it's NULL in the database.
+func (ft *FieldType) NullableStringOrNull() *string {
+	if ft.nullNullableString {
+		return nil
+	}
+	v := ft.NullableString
+	return &v
+}
+
 // Update returns a builder for updating this FieldType.
 // Note that, you need to call FieldType.Unwrap() before calling this method, if this FieldType
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -206,6 +379,60 @@ func (ft *FieldType) Unwrap() *FieldType {
 	return ft
 }
 
+// Clone returns a deep copy of the FieldType, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (ft *FieldType) Clone() *FieldType {
+	return ft.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the FieldType, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (ft *FieldType) clone(seen map[interface{}]interface{}) *FieldType {
+	if ft == nil {
+		return nil
+	}
+	if _c, ok := seen[ft]; ok {
+		return _c.(*FieldType)
+	}
+	_c := *ft
+	_c.config = config{}
+	seen[ft] = &_c
+	if v := ft.NillableInt; v != nil {
+		_v := *v
+		_c.NillableInt = &_v
+	}
+	if v := ft.NillableInt8; v != nil {
+		_v := *v
+		_c.NillableInt8 = &_v
+	}
+	if v := ft.NillableInt16; v != nil {
+		_v := *v
+		_c.NillableInt16 = &_v
+	}
+	if v := ft.NillableInt32; v != nil {
+		_v := *v
+		_c.NillableInt32 = &_v
+	}
+	if v := ft.NillableInt64; v != nil {
+		_v := *v
+		_c.NillableInt64 = &_v
+	}
+	if v := ft.NullLink; v != nil {
+		_v := *v
+		_c.NullLink = &_v
+	}
+	if v := ft.IP; v != nil {
+		_c.IP = append(net.IP(nil), v...)
+	}
+	if v := ft.Mac; v != nil {
+		_v := append(net.HardwareAddr(nil), *v...)
+		_c.Mac = &_v
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (ft *FieldType) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -238,10 +465,109 @@ func (ft *FieldType) String() string {
 	}
 	buf.WriteString(fmt.Sprintf(", validate_optional_int32=%v", ft.ValidateOptionalInt32))
 	buf.WriteString(fmt.Sprintf(", state=%v", ft.State))
+	buf.WriteString(fmt.Sprintf(", link=%v", ft.Link))
+	if v := ft.NullLink; v != nil {
+		buf.WriteString(fmt.Sprintf(", null_link=%v", *v))
+	}
+	buf.WriteString(fmt.Sprintf(", priority=%v", ft.Priority))
+	buf.WriteString(fmt.Sprintf(", role=%v", ft.Role))
+	buf.WriteString(fmt.Sprintf(", nullable_int=%v", ft.NullableInt))
+	buf.WriteString(fmt.Sprintf(", nullable_string=%v", ft.NullableString))
+	buf.WriteString(fmt.Sprintf(", uuid=%v", ft.UUID))
+	buf.WriteString(fmt.Sprintf(", ip=%v", ft.IP))
+	if v := ft.Mac; v != nil {
+		buf.WriteString(fmt.Sprintf(", mac=%v", *v))
+	}
 	buf.WriteString(")")
 	return buf.String()
 }
 
+// DiffFieldType returns the fields that have different values in the given FieldTypes,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffFieldType(a, b *FieldType) []FieldChange {
+	var changes []FieldChange
+	if a.Int != b.Int {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldInt, Old: a.Int, New: b.Int})
+	}
+	if a.Int8 != b.Int8 {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldInt8, Old: a.Int8, New: b.Int8})
+	}
+	if a.Int16 != b.Int16 {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldInt16, Old: a.Int16, New: b.Int16})
+	}
+	if a.Int32 != b.Int32 {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldInt32, Old: a.Int32, New: b.Int32})
+	}
+	if a.Int64 != b.Int64 {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldInt64, Old: a.Int64, New: b.Int64})
+	}
+	if a.OptionalInt != b.OptionalInt {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalInt, Old: a.OptionalInt, New: b.OptionalInt})
+	}
+	if a.OptionalInt8 != b.OptionalInt8 {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalInt8, Old: a.OptionalInt8, New: b.OptionalInt8})
+	}
+	if a.OptionalInt16 != b.OptionalInt16 {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalInt16, Old: a.OptionalInt16, New: b.OptionalInt16})
+	}
+	if a.OptionalInt32 != b.OptionalInt32 {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalInt32, Old: a.OptionalInt32, New: b.OptionalInt32})
+	}
+	if a.OptionalInt64 != b.OptionalInt64 {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldOptionalInt64, Old: a.OptionalInt64, New: b.OptionalInt64})
+	}
+	if (a.NillableInt == nil) != (b.NillableInt == nil) || a.NillableInt != nil && (*a.NillableInt) != (*b.NillableInt) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNillableInt, Old: a.NillableInt, New: b.NillableInt})
+	}
+	if (a.NillableInt8 == nil) != (b.NillableInt8 == nil) || a.NillableInt8 != nil && (*a.NillableInt8) != (*b.NillableInt8) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNillableInt8, Old: a.NillableInt8, New: b.NillableInt8})
+	}
+	if (a.NillableInt16 == nil) != (b.NillableInt16 == nil) || a.NillableInt16 != nil && (*a.NillableInt16) != (*b.NillableInt16) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNillableInt16, Old: a.NillableInt16, New: b.NillableInt16})
+	}
+	if (a.NillableInt32 == nil) != (b.NillableInt32 == nil) || a.NillableInt32 != nil && (*a.NillableInt32) != (*b.NillableInt32) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNillableInt32, Old: a.NillableInt32, New: b.NillableInt32})
+	}
+	if (a.NillableInt64 == nil) != (b.NillableInt64 == nil) || a.NillableInt64 != nil && (*a.NillableInt64) != (*b.NillableInt64) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNillableInt64, Old: a.NillableInt64, New: b.NillableInt64})
+	}
+	if a.ValidateOptionalInt32 != b.ValidateOptionalInt32 {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldValidateOptionalInt32, Old: a.ValidateOptionalInt32, New: b.ValidateOptionalInt32})
+	}
+	if a.State != b.State {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldState, Old: a.State, New: b.State})
+	}
+	if !reflect.DeepEqual(a.Link, b.Link) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldLink, Old: a.Link, New: b.Link})
+	}
+	if (a.NullLink == nil) != (b.NullLink == nil) || a.NullLink != nil && !reflect.DeepEqual((*a.NullLink), (*b.NullLink)) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNullLink, Old: a.NullLink, New: b.NullLink})
+	}
+	if !reflect.DeepEqual(a.Priority, b.Priority) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldPriority, Old: a.Priority, New: b.Priority})
+	}
+	if !reflect.DeepEqual(a.Role, b.Role) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldRole, Old: a.Role, New: b.Role})
+	}
+	if a.NullableInt != b.NullableInt {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNullableInt, Old: a.NullableInt, New: b.NullableInt})
+	}
+	if a.NullableString != b.NullableString {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldNullableString, Old: a.NullableString, New: b.NullableString})
+	}
+	if a.UUID != b.UUID {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldUUID, Old: a.UUID, New: b.UUID})
+	}
+	if !a.IP.Equal(b.IP) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldIP, Old: a.IP, New: b.IP})
+	}
+	if (a.Mac == nil) != (b.Mac == nil) || a.Mac != nil && !bytes.Equal((*a.Mac), (*b.Mac)) {
+		changes = append(changes, FieldChange{Field: fieldtype.FieldMac, Old: a.Mac, New: b.Mac})
+	}
+	return changes
+}
+
 // id returns the int representation of the ID field.
 func (ft *FieldType) id() int {
 	id, _ := strconv.Atoi(ft.ID)
@@ -253,9 +579,14 @@ type FieldTypes []*FieldType
 
 // FromRows scans the sql response data into FieldTypes.
 func (ft *FieldTypes) FromRows(rows *sql.Rows) error {
+	return ft.scan(rows, fieldtype.Columns)
+}
+
+// scan scans the given columns of the sql response data into FieldTypes.
+func (ft *FieldTypes) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vft := &FieldType{}
-		if err := vft.FromRows(rows); err != nil {
+		if err := vft.scan(rows, columns); err != nil {
 			return err
 		}
 		*ft = append(*ft, vft)
@@ -288,31 +619,77 @@ func (ft *FieldTypes) FromResponse(res *gremlin.Response) error {
 		NillableInt64         *int64          `json:"nillable_int64,omitempty"`
 		ValidateOptionalInt32 int32           `json:"validate_optional_int32,omitempty"`
 		State                 fieldtype.State `json:"state,omitempty"`
+		Link                  schema.Link     `json:"link,omitempty"`
+		NullLink              *schema.Link    `json:"null_link,omitempty"`
+		Priority              schema.Priority `json:"priority,omitempty"`
+		Role                  schema.Role     `json:"role,omitempty"`
+		NullableInt           *int            `json:"nullable_int,omitempty"`
+		NullableString        *string         `json:"nullable_string,omitempty"`
+		UUID                  string          `json:"uuid,omitempty"`
+		IP                    string          `json:"ip,omitempty"`
+		Mac                   *string         `json:"mac,omitempty"`
 	}
 	if err := vmap.Decode(&vft); err != nil {
 		return err
 	}
 	for _, v := range vft {
-		*ft = append(*ft, &FieldType{
-			ID:                    v.ID,
-			Int:                   v.Int,
-			Int8:                  v.Int8,
-			Int16:                 v.Int16,
-			Int32:                 v.Int32,
-			Int64:                 v.Int64,
-			OptionalInt:           v.OptionalInt,
-			OptionalInt8:          v.OptionalInt8,
-			OptionalInt16:         v.OptionalInt16,
-			OptionalInt32:         v.OptionalInt32,
-			OptionalInt64:         v.OptionalInt64,
-			NillableInt:           v.NillableInt,
-			NillableInt8:          v.NillableInt8,
-			NillableInt16:         v.NillableInt16,
-			NillableInt32:         v.NillableInt32,
-			NillableInt64:         v.NillableInt64,
-			ValidateOptionalInt32: v.ValidateOptionalInt32,
-			State:                 v.State,
-		})
+		entity := &FieldType{ID: v.ID}
+		entity.Int = v.Int
+		entity.Int8 = v.Int8
+		entity.Int16 = v.Int16
+		entity.Int32 = v.Int32
+		entity.Int64 = v.Int64
+		entity.OptionalInt = v.OptionalInt
+		entity.OptionalInt8 = v.OptionalInt8
+		entity.OptionalInt16 = v.OptionalInt16
+		entity.OptionalInt32 = v.OptionalInt32
+		entity.OptionalInt64 = v.OptionalInt64
+		entity.NillableInt = v.NillableInt
+		entity.NillableInt8 = v.NillableInt8
+		entity.NillableInt16 = v.NillableInt16
+		entity.NillableInt32 = v.NillableInt32
+		entity.NillableInt64 = v.NillableInt64
+		entity.ValidateOptionalInt32 = v.ValidateOptionalInt32
+		entity.State = v.State
+		entity.Link = v.Link
+		entity.NullLink = v.NullLink
+		entity.Priority = v.Priority
+		entity.Role = v.Role
+		if v.NullableInt != nil {
+			entity.NullableInt = *v.NullableInt
+		} else {
+			entity.nullNullableInt = true
+		}
+		if v.NullableString != nil {
+			entity.NullableString = *v.NullableString
+		} else {
+			entity.nullNullableString = true
+		}
+		if v.UUID != "" {
+			value, err := uuid.Parse(v.UUID)
+			if err != nil {
+				return fmt.Errorf("parse field uuid: %v", err)
+			}
+			entity.UUID = value
+		}
+
+		if v.IP != "" {
+			value := net.ParseIP(v.IP)
+			if value == nil {
+				return fmt.Errorf("parse field ip: invalid IP address %q", v.IP)
+			}
+			entity.IP = value
+		}
+
+		if v.Mac != nil {
+			value, err := net.ParseMAC(*v.Mac)
+			if err != nil {
+				return fmt.Errorf("parse field mac: %v", err)
+			}
+			entity.Mac = &value
+		}
+
+		*ft = append(*ft, entity)
 	}
 	return nil
 }
@@ -8,8 +8,13 @@ package fieldtype
 
 import (
 	"fmt"
+	"net"
 
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 const (
@@ -51,6 +56,24 @@ const (
 	FieldValidateOptionalInt32 = "validate_optional_int32"
 	// FieldState holds the string denoting the state vertex property in the database.
 	FieldState = "state"
+	// FieldLink holds the string denoting the link vertex property in the database.
+	FieldLink = "link"
+	// FieldNullLink holds the string denoting the null_link vertex property in the database.
+	FieldNullLink = "null_link"
+	// FieldPriority holds the string denoting the priority vertex property in the database.
+	FieldPriority = "priority"
+	// FieldRole holds the string denoting the role vertex property in the database.
+	FieldRole = "role"
+	// FieldNullableInt holds the string denoting the nullable_int vertex property in the database.
+	FieldNullableInt = "nullable_int"
+	// FieldNullableString holds the string denoting the nullable_string vertex property in the database.
+	FieldNullableString = "nullable_string"
+	// FieldUUID holds the string denoting the uuid vertex property in the database.
+	FieldUUID = "uuid"
+	// FieldIP holds the string denoting the ip vertex property in the database.
+	FieldIP = "ip"
+	// FieldMac holds the string denoting the mac vertex property in the database.
+	FieldMac = "mac"
 
 	// Table holds the table name of the fieldtype in the database.
 	Table = "field_types"
@@ -76,6 +99,31 @@ var Columns = []string{
 	FieldNillableInt64,
 	FieldValidateOptionalInt32,
 	FieldState,
+	FieldLink,
+	FieldNullLink,
+	FieldPriority,
+	FieldRole,
+	FieldNullableInt,
+	FieldNullableString,
+	FieldUUID,
+	FieldIP,
+	FieldMac,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Tables holds the tables that may be changed by the mutations of the fieldtype. The mutations
+// invalidate only the query results of these tables that were cached by the entcache driver.
+var Tables = []string{
+	"field_types",
 }
 
 var (
@@ -85,8 +133,41 @@ var (
 	descValidateOptionalInt32 = fields[15].Descriptor()
 	// ValidateOptionalInt32Validator is a validator for the "validate_optional_int32" field. It is called by the builders before save.
 	ValidateOptionalInt32Validator = descValidateOptionalInt32.Validators[0].(func(int32) error)
+
+	// descNullableString is the schema descriptor for nullable_string field.
+	descNullableString = fields[22].Descriptor()
+	// DefaultNullableString holds the default value on creation for the nullable_string field.
+	DefaultNullableString = descNullableString.Default.(string)
+
+	// descUUID is the schema descriptor for uuid field.
+	descUUID = fields[23].Descriptor()
+	// DefaultUUID holds the default value on creation for the uuid field.
+	DefaultUUID = descUUID.Default.(func() uuid.UUID)
+
+	// descIP is the schema descriptor for ip field.
+	descIP = fields[24].Descriptor()
+	// IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	IPValidator = descIP.Validators[0].(func(net.IP) error)
+
+	// descMac is the schema descriptor for mac field.
+	descMac = fields[25].Descriptor()
+	// MacValidator is a validator for the "mac" field. It is called by the builders before save.
+	MacValidator = descMac.Validators[0].(func(net.HardwareAddr) error)
 )
 
+// Scope is a reusable named scope of the FieldType queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.FieldType
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
 // State defines the type for the state enum field.
 type State string
 
@@ -108,3 +189,255 @@ func StateValidator(state State) error {
 		return fmt.Errorf("fieldtype: invalid enum value for state field: %q", state)
 	}
 }
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(role schema.Role) error {
+	switch role.String() {
+	case "user", "admin", "owner":
+		return nil
+	default:
+		return fmt.Errorf("fieldtype: invalid enum value for role field: %q", role)
+	}
+}
+
+// descriptor holds the runtime descriptor of the FieldType type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "FieldType",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeString,
+		GoType:     "string",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "int",
+			StorageKey: FieldInt,
+			Type:       field.TypeInt,
+			GoType:     "int",
+		},
+		{
+			Name:       "int8",
+			StorageKey: FieldInt8,
+			Type:       field.TypeInt8,
+			GoType:     "int8",
+		},
+		{
+			Name:       "int16",
+			StorageKey: FieldInt16,
+			Type:       field.TypeInt16,
+			GoType:     "int16",
+		},
+		{
+			Name:       "int32",
+			StorageKey: FieldInt32,
+			Type:       field.TypeInt32,
+			GoType:     "int32",
+		},
+		{
+			Name:       "int64",
+			StorageKey: FieldInt64,
+			Type:       field.TypeInt64,
+			GoType:     "int64",
+		},
+		{
+			Name:       "optional_int",
+			StorageKey: FieldOptionalInt,
+			Type:       field.TypeInt,
+			GoType:     "int",
+			Optional:   true,
+		},
+		{
+			Name:       "optional_int8",
+			StorageKey: FieldOptionalInt8,
+			Type:       field.TypeInt8,
+			GoType:     "int8",
+			Optional:   true,
+		},
+		{
+			Name:       "optional_int16",
+			StorageKey: FieldOptionalInt16,
+			Type:       field.TypeInt16,
+			GoType:     "int16",
+			Optional:   true,
+		},
+		{
+			Name:       "optional_int32",
+			StorageKey: FieldOptionalInt32,
+			Type:       field.TypeInt32,
+			GoType:     "int32",
+			Optional:   true,
+		},
+		{
+			Name:       "optional_int64",
+			StorageKey: FieldOptionalInt64,
+			Type:       field.TypeInt64,
+			GoType:     "int64",
+			Optional:   true,
+		},
+		{
+			Name:       "nillable_int",
+			StorageKey: FieldNillableInt,
+			Type:       field.TypeInt,
+			GoType:     "int",
+			Optional:   true,
+			Nillable:   true,
+		},
+		{
+			Name:       "nillable_int8",
+			StorageKey: FieldNillableInt8,
+			Type:       field.TypeInt8,
+			GoType:     "int8",
+			Optional:   true,
+			Nillable:   true,
+		},
+		{
+			Name:       "nillable_int16",
+			StorageKey: FieldNillableInt16,
+			Type:       field.TypeInt16,
+			GoType:     "int16",
+			Optional:   true,
+			Nillable:   true,
+		},
+		{
+			Name:       "nillable_int32",
+			StorageKey: FieldNillableInt32,
+			Type:       field.TypeInt32,
+			GoType:     "int32",
+			Optional:   true,
+			Nillable:   true,
+		},
+		{
+			Name:       "nillable_int64",
+			StorageKey: FieldNillableInt64,
+			Type:       field.TypeInt64,
+			GoType:     "int64",
+			Optional:   true,
+			Nillable:   true,
+		},
+		{
+			Name:       "validate_optional_int32",
+			StorageKey: FieldValidateOptionalInt32,
+			Type:       field.TypeInt32,
+			GoType:     "int32",
+			Optional:   true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(int32)
+				if !ok {
+					return fmt.Errorf("fieldtype: unexpected type %T for field validate_optional_int32", v)
+				}
+				return ValidateOptionalInt32Validator(vv)
+			},
+		},
+		{
+			Name:       "state",
+			StorageKey: FieldState,
+			Type:       field.TypeEnum,
+			GoType:     "fieldtype.State",
+			Enums:      []string{"on", "off"},
+			Optional:   true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(State)
+				if !ok {
+					return fmt.Errorf("fieldtype: unexpected type %T for field state", v)
+				}
+				return StateValidator(vv)
+			},
+		},
+		{
+			Name:       "link",
+			StorageKey: FieldLink,
+			Type:       field.TypeString,
+			GoType:     "schema.Link",
+			Optional:   true,
+		},
+		{
+			Name:       "null_link",
+			StorageKey: FieldNullLink,
+			Type:       field.TypeString,
+			GoType:     "schema.Link",
+			Optional:   true,
+			Nillable:   true,
+		},
+		{
+			Name:       "priority",
+			StorageKey: FieldPriority,
+			Type:       field.TypeInt,
+			GoType:     "schema.Priority",
+			Optional:   true,
+		},
+		{
+			Name:       "role",
+			StorageKey: FieldRole,
+			Type:       field.TypeInt,
+			GoType:     "schema.Role",
+			Enums:      []string{"user", "admin", "owner"},
+			Optional:   true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(schema.Role)
+				if !ok {
+					return fmt.Errorf("fieldtype: unexpected type %T for field role", v)
+				}
+				return RoleValidator(vv)
+			},
+		},
+		{
+			Name:       "nullable_int",
+			StorageKey: FieldNullableInt,
+			Type:       field.TypeInt,
+			GoType:     "int",
+			Optional:   true,
+		},
+		{
+			Name:       "nullable_string",
+			StorageKey: FieldNullableString,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+			Default:    true,
+		},
+		{
+			Name:       "uuid",
+			StorageKey: FieldUUID,
+			Type:       field.TypeUUID,
+			GoType:     "uuid.UUID",
+			Optional:   true,
+			Default:    true,
+		},
+		{
+			Name:       "ip",
+			StorageKey: FieldIP,
+			Type:       field.TypeIP,
+			GoType:     "net.IP",
+			Optional:   true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(net.IP)
+				if !ok {
+					return fmt.Errorf("fieldtype: unexpected type %T for field ip", v)
+				}
+				return IPValidator(vv)
+			},
+		},
+		{
+			Name:       "mac",
+			StorageKey: FieldMac,
+			Type:       field.TypeMAC,
+			GoType:     "net.HardwareAddr",
+			Optional:   true,
+			Nillable:   true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(net.HardwareAddr)
+				if !ok {
+					return fmt.Errorf("fieldtype: unexpected type %T for field mac", v)
+				}
+				return MacValidator(vv)
+			},
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{},
+}
+
+// Descriptor returns the runtime descriptor of the FieldType type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
@@ -7,13 +7,20 @@
 package fieldtype
 
 import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/google/uuid"
 )
 
 // ID filters vertices based on their identifier.
@@ -351,6 +358,114 @@ func ValidateOptionalInt32(v int32) predicate.FieldType {
 	)
 }
 
+// Link applies equality check predicate on the "link" field. It's identical to LinkEQ.
+func Link(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLink, p.EQ(v))
+		},
+	)
+}
+
+// NullLink applies equality check predicate on the "null_link" field. It's identical to NullLinkEQ.
+func NullLink(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldNullLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullLink, p.EQ(v))
+		},
+	)
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v schema.Priority) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldPriority), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPriority, p.EQ(v))
+		},
+	)
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v schema.Role) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldRole), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldRole, p.EQ(v))
+		},
+	)
+}
+
+// NullableInt applies equality check predicate on the "nullable_int" field. It's identical to NullableIntEQ.
+func NullableInt(v int) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldNullableInt), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableInt, p.EQ(v))
+		},
+	)
+}
+
+// NullableString applies equality check predicate on the "nullable_string" field. It's identical to NullableStringEQ.
+func NullableString(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.EQ(v))
+		},
+	)
+}
+
+// UUID applies equality check predicate on the "uuid" field. It's identical to UUIDEQ.
+func UUID(v uuid.UUID) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldUUID), v[:]))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUUID, p.EQ(v.String()))
+		},
+	)
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v net.IP) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldIP), sql.NullString{String: v.String(), Valid: v != nil}))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldIP, p.EQ(v.String()))
+		},
+	)
+}
+
+// Mac applies equality check predicate on the "mac" field. It's identical to MacEQ.
+func Mac(v net.HardwareAddr) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldMac), sql.NullString{String: v.String(), Valid: v != nil}))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldMac, p.EQ(v.String()))
+		},
+	)
+}
+
 // IntEQ applies the EQ predicate on the "int" field.
 func IntEQ(v int) predicate.FieldType {
 	return predicate.FieldTypePerDialect(
@@ -2563,6 +2678,2449 @@ func StateNotNil() predicate.FieldType {
 	)
 }
 
+// LinkEQ applies the EQ predicate on the "link" field.
+func LinkEQ(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLink, p.EQ(v))
+		},
+	)
+}
+
+// LinkNEQ applies the NEQ predicate on the "link" field.
+func LinkNEQ(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLink, p.NEQ(v))
+		},
+	)
+}
+
+// LinkIn applies the In predicate on the "link" field.
+func LinkIn(vs ...schema.Link) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldLink), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLink, p.Within(v...))
+		},
+	)
+}
+
+// LinkNotIn applies the NotIn predicate on the "link" field.
+func LinkNotIn(vs ...schema.Link) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.NotIn(s.C(FieldLink), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLink, p.Without(v...))
+		},
+	)
+}
+
+// LinkGT applies the GT predicate on the "link" field.
+func LinkGT(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GT(s.C(FieldLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLink, p.GT(v))
+		},
+	)
+}
+
+// LinkGTE applies the GTE predicate on the "link" field.
+func LinkGTE(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GTE(s.C(FieldLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLink, p.GTE(v))
+		},
+	)
+}
+
+// LinkLT applies the LT predicate on the "link" field.
+func LinkLT(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LT(s.C(FieldLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLink, p.LT(v))
+		},
+	)
+}
+
+// LinkLTE applies the LTE predicate on the "link" field.
+func LinkLTE(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LTE(s.C(FieldLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLink, p.LTE(v))
+		},
+	)
+}
+
+// LinkIsNil applies the IsNil predicate on the "link" field.
+func LinkIsNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldLink)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldLink)
+		},
+	)
+}
+
+// LinkNotNil applies the NotNil predicate on the "link" field.
+func LinkNotNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldLink)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldLink)
+		},
+	)
+}
+
+// NullLinkEQ applies the EQ predicate on the "null_link" field.
+func NullLinkEQ(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldNullLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullLink, p.EQ(v))
+		},
+	)
+}
+
+// NullLinkNEQ applies the NEQ predicate on the "null_link" field.
+func NullLinkNEQ(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldNullLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullLink, p.NEQ(v))
+		},
+	)
+}
+
+// NullLinkIn applies the In predicate on the "null_link" field.
+func NullLinkIn(vs ...schema.Link) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldNullLink), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullLink, p.Within(v...))
+		},
+	)
+}
+
+// NullLinkNotIn applies the NotIn predicate on the "null_link" field.
+func NullLinkNotIn(vs ...schema.Link) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.NotIn(s.C(FieldNullLink), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullLink, p.Without(v...))
+		},
+	)
+}
+
+// NullLinkGT applies the GT predicate on the "null_link" field.
+func NullLinkGT(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GT(s.C(FieldNullLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullLink, p.GT(v))
+		},
+	)
+}
+
+// NullLinkGTE applies the GTE predicate on the "null_link" field.
+func NullLinkGTE(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GTE(s.C(FieldNullLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullLink, p.GTE(v))
+		},
+	)
+}
+
+// NullLinkLT applies the LT predicate on the "null_link" field.
+func NullLinkLT(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LT(s.C(FieldNullLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullLink, p.LT(v))
+		},
+	)
+}
+
+// NullLinkLTE applies the LTE predicate on the "null_link" field.
+func NullLinkLTE(v schema.Link) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LTE(s.C(FieldNullLink), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullLink, p.LTE(v))
+		},
+	)
+}
+
+// NullLinkIsNil applies the IsNil predicate on the "null_link" field.
+func NullLinkIsNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldNullLink)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldNullLink)
+		},
+	)
+}
+
+// NullLinkNotNil applies the NotNil predicate on the "null_link" field.
+func NullLinkNotNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldNullLink)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldNullLink)
+		},
+	)
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v schema.Priority) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldPriority), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPriority, p.EQ(v))
+		},
+	)
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v schema.Priority) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldPriority), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPriority, p.NEQ(v))
+		},
+	)
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...schema.Priority) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldPriority), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPriority, p.Within(v...))
+		},
+	)
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...schema.Priority) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.NotIn(s.C(FieldPriority), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPriority, p.Without(v...))
+		},
+	)
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v schema.Priority) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GT(s.C(FieldPriority), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPriority, p.GT(v))
+		},
+	)
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v schema.Priority) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GTE(s.C(FieldPriority), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPriority, p.GTE(v))
+		},
+	)
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v schema.Priority) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LT(s.C(FieldPriority), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPriority, p.LT(v))
+		},
+	)
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v schema.Priority) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LTE(s.C(FieldPriority), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPriority, p.LTE(v))
+		},
+	)
+}
+
+// PriorityIsNil applies the IsNil predicate on the "priority" field.
+func PriorityIsNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldPriority)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldPriority)
+		},
+	)
+}
+
+// PriorityNotNil applies the NotNil predicate on the "priority" field.
+func PriorityNotNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldPriority)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldPriority)
+		},
+	)
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v schema.Role) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldRole), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldRole, p.EQ(v))
+		},
+	)
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v schema.Role) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldRole), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldRole, p.NEQ(v))
+		},
+	)
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...schema.Role) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldRole), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldRole, p.Within(v...))
+		},
+	)
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...schema.Role) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.NotIn(s.C(FieldRole), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldRole, p.Without(v...))
+		},
+	)
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v schema.Role) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GT(s.C(FieldRole), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldRole, p.GT(v))
+		},
+	)
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v schema.Role) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GTE(s.C(FieldRole), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldRole, p.GTE(v))
+		},
+	)
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v schema.Role) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LT(s.C(FieldRole), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldRole, p.LT(v))
+		},
+	)
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v schema.Role) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LTE(s.C(FieldRole), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldRole, p.LTE(v))
+		},
+	)
+}
+
+// RoleIsNil applies the IsNil predicate on the "role" field.
+func RoleIsNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldRole)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldRole)
+		},
+	)
+}
+
+// RoleNotNil applies the NotNil predicate on the "role" field.
+func RoleNotNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldRole)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldRole)
+		},
+	)
+}
+
+// NullableIntEQ applies the EQ predicate on the "nullable_int" field.
+func NullableIntEQ(v int) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldNullableInt), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableInt, p.EQ(v))
+		},
+	)
+}
+
+// NullableIntNEQ applies the NEQ predicate on the "nullable_int" field.
+func NullableIntNEQ(v int) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldNullableInt), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableInt, p.NEQ(v))
+		},
+	)
+}
+
+// NullableIntIn applies the In predicate on the "nullable_int" field.
+func NullableIntIn(vs ...int) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldNullableInt), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableInt, p.Within(v...))
+		},
+	)
+}
+
+// NullableIntNotIn applies the NotIn predicate on the "nullable_int" field.
+func NullableIntNotIn(vs ...int) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.NotIn(s.C(FieldNullableInt), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableInt, p.Without(v...))
+		},
+	)
+}
+
+// NullableIntGT applies the GT predicate on the "nullable_int" field.
+func NullableIntGT(v int) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GT(s.C(FieldNullableInt), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableInt, p.GT(v))
+		},
+	)
+}
+
+// NullableIntGTE applies the GTE predicate on the "nullable_int" field.
+func NullableIntGTE(v int) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GTE(s.C(FieldNullableInt), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableInt, p.GTE(v))
+		},
+	)
+}
+
+// NullableIntLT applies the LT predicate on the "nullable_int" field.
+func NullableIntLT(v int) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LT(s.C(FieldNullableInt), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableInt, p.LT(v))
+		},
+	)
+}
+
+// NullableIntLTE applies the LTE predicate on the "nullable_int" field.
+func NullableIntLTE(v int) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LTE(s.C(FieldNullableInt), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableInt, p.LTE(v))
+		},
+	)
+}
+
+// NullableIntIsNil applies the IsNil predicate on the "nullable_int" field.
+func NullableIntIsNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldNullableInt)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldNullableInt)
+		},
+	)
+}
+
+// NullableIntNotNil applies the NotNil predicate on the "nullable_int" field.
+func NullableIntNotNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldNullableInt)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldNullableInt)
+		},
+	)
+}
+
+// NullableStringEQ applies the EQ predicate on the "nullable_string" field.
+func NullableStringEQ(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.EQ(v))
+		},
+	)
+}
+
+// NullableStringNEQ applies the NEQ predicate on the "nullable_string" field.
+func NullableStringNEQ(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.NEQ(v))
+		},
+	)
+}
+
+// NullableStringIn applies the In predicate on the "nullable_string" field.
+func NullableStringIn(vs ...string) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldNullableString), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.Within(v...))
+		},
+	)
+}
+
+// NullableStringNotIn applies the NotIn predicate on the "nullable_string" field.
+func NullableStringNotIn(vs ...string) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.NotIn(s.C(FieldNullableString), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.Without(v...))
+		},
+	)
+}
+
+// NullableStringGT applies the GT predicate on the "nullable_string" field.
+func NullableStringGT(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GT(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.GT(v))
+		},
+	)
+}
+
+// NullableStringGTE applies the GTE predicate on the "nullable_string" field.
+func NullableStringGTE(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GTE(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.GTE(v))
+		},
+	)
+}
+
+// NullableStringLT applies the LT predicate on the "nullable_string" field.
+func NullableStringLT(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LT(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.LT(v))
+		},
+	)
+}
+
+// NullableStringLTE applies the LTE predicate on the "nullable_string" field.
+func NullableStringLTE(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LTE(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.LTE(v))
+		},
+	)
+}
+
+// NullableStringContains applies the Contains predicate on the "nullable_string" field.
+func NullableStringContains(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.Contains(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.Containing(v))
+		},
+	)
+}
+
+// NullableStringHasPrefix applies the HasPrefix predicate on the "nullable_string" field.
+func NullableStringHasPrefix(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.HasPrefix(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.StartingWith(v))
+		},
+	)
+}
+
+// NullableStringHasSuffix applies the HasSuffix predicate on the "nullable_string" field.
+func NullableStringHasSuffix(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.HasSuffix(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.EndingWith(v))
+		},
+	)
+}
+
+// NullableStringIsNil applies the IsNil predicate on the "nullable_string" field.
+func NullableStringIsNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldNullableString)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldNullableString)
+		},
+	)
+}
+
+// NullableStringNotNil applies the NotNil predicate on the "nullable_string" field.
+func NullableStringNotNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldNullableString)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldNullableString)
+		},
+	)
+}
+
+// NullableStringEqualFold applies the EqualFold predicate on the "nullable_string" field.
+func NullableStringEqualFold(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.EqualFold(v))
+		},
+	)
+}
+
+// NullableStringContainsFold applies the ContainsFold predicate on the "nullable_string" field.
+func NullableStringContainsFold(v string) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldNullableString), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNullableString, p.ContainsFold(v))
+		},
+	)
+}
+
+// UUIDEQ applies the EQ predicate on the "uuid" field.
+func UUIDEQ(v uuid.UUID) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldUUID), v[:]))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUUID, p.EQ(v.String()))
+		},
+	)
+}
+
+// UUIDNEQ applies the NEQ predicate on the "uuid" field.
+func UUIDNEQ(v uuid.UUID) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldUUID), v[:]))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUUID, p.NEQ(v.String()))
+		},
+	)
+}
+
+// UUIDIn applies the In predicate on the "uuid" field.
+func UUIDIn(vs ...uuid.UUID) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = vs[i][:]
+			}
+			s.Where(sql.In(s.C(FieldUUID), v...))
+		},
+		func(t *dsl.Traversal) {
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = vs[i].String()
+			}
+			t.Has(Label, FieldUUID, p.Within(v...))
+		},
+	)
+}
+
+// UUIDNotIn applies the NotIn predicate on the "uuid" field.
+func UUIDNotIn(vs ...uuid.UUID) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = vs[i][:]
+			}
+			s.Where(sql.NotIn(s.C(FieldUUID), v...))
+		},
+		func(t *dsl.Traversal) {
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = vs[i].String()
+			}
+			t.Has(Label, FieldUUID, p.Without(v...))
+		},
+	)
+}
+
+// UUIDIsNil applies the IsNil predicate on the "uuid" field.
+func UUIDIsNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldUUID)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldUUID)
+		},
+	)
+}
+
+// UUIDNotNil applies the NotNil predicate on the "uuid" field.
+func UUIDNotNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldUUID)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldUUID)
+		},
+	)
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v net.IP) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldIP), sql.NullString{String: v.String(), Valid: v != nil}))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldIP, p.EQ(v.String()))
+		},
+	)
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v net.IP) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldIP), sql.NullString{String: v.String(), Valid: v != nil}))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldIP, p.NEQ(v.String()))
+		},
+	)
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...net.IP) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = sql.NullString{String: vs[i].String(), Valid: vs[i] != nil}
+			}
+			s.Where(sql.In(s.C(FieldIP), v...))
+		},
+		func(t *dsl.Traversal) {
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = vs[i].String()
+			}
+			t.Has(Label, FieldIP, p.Within(v...))
+		},
+	)
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...net.IP) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = sql.NullString{String: vs[i].String(), Valid: vs[i] != nil}
+			}
+			s.Where(sql.NotIn(s.C(FieldIP), v...))
+		},
+		func(t *dsl.Traversal) {
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = vs[i].String()
+			}
+			t.Has(Label, FieldIP, p.Without(v...))
+		},
+	)
+}
+
+// IPIsNil applies the IsNil predicate on the "ip" field.
+func IPIsNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldIP)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldIP)
+		},
+	)
+}
+
+// IPNotNil applies the NotNil predicate on the "ip" field.
+func IPNotNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldIP)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldIP)
+		},
+	)
+}
+
+// MacEQ applies the EQ predicate on the "mac" field.
+func MacEQ(v net.HardwareAddr) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldMac), sql.NullString{String: v.String(), Valid: v != nil}))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldMac, p.EQ(v.String()))
+		},
+	)
+}
+
+// MacNEQ applies the NEQ predicate on the "mac" field.
+func MacNEQ(v net.HardwareAddr) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldMac), sql.NullString{String: v.String(), Valid: v != nil}))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldMac, p.NEQ(v.String()))
+		},
+	)
+}
+
+// MacIn applies the In predicate on the "mac" field.
+func MacIn(vs ...net.HardwareAddr) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = sql.NullString{String: vs[i].String(), Valid: vs[i] != nil}
+			}
+			s.Where(sql.In(s.C(FieldMac), v...))
+		},
+		func(t *dsl.Traversal) {
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = vs[i].String()
+			}
+			t.Has(Label, FieldMac, p.Within(v...))
+		},
+	)
+}
+
+// MacNotIn applies the NotIn predicate on the "mac" field.
+func MacNotIn(vs ...net.HardwareAddr) predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = sql.NullString{String: vs[i].String(), Valid: vs[i] != nil}
+			}
+			s.Where(sql.NotIn(s.C(FieldMac), v...))
+		},
+		func(t *dsl.Traversal) {
+			v := make([]interface{}, len(vs))
+			for i := range v {
+				v[i] = vs[i].String()
+			}
+			t.Has(Label, FieldMac, p.Without(v...))
+		},
+	)
+}
+
+// MacIsNil applies the IsNil predicate on the "mac" field.
+func MacIsNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldMac)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldMac)
+		},
+	)
+}
+
+// MacNotNil applies the NotNil predicate on the "mac" field.
+func MacNotNil() predicate.FieldType {
+	return predicate.FieldTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldMac)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldMac)
+		},
+	)
+}
+
+// PredicateFunc returns a predicate from the given per-dialect functions.
+// It allows adding custom SQL/Gremlin fragments to the FieldType builders.
+func PredicateFunc(f0 func(*sql.Selector), f1 func(*dsl.Traversal)) predicate.FieldType {
+	return predicate.FieldTypePerDialect(f0, f1)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.FieldType, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.FieldType, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.FieldType, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldInt:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IntEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IntNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return IntIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return IntNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IntGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IntGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IntLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IntLTE(v), nil
+			}
+		}
+	case FieldInt8:
+		switch op {
+		case "eq":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return Int8EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return Int8NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int8); ok || convert(value, &vs) {
+				return Int8In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int8); ok || convert(value, &vs) {
+				return Int8NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return Int8GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return Int8GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return Int8LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return Int8LTE(v), nil
+			}
+		}
+	case FieldInt16:
+		switch op {
+		case "eq":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return Int16EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return Int16NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int16); ok || convert(value, &vs) {
+				return Int16In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int16); ok || convert(value, &vs) {
+				return Int16NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return Int16GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return Int16GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return Int16LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return Int16LTE(v), nil
+			}
+		}
+	case FieldInt32:
+		switch op {
+		case "eq":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return Int32EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return Int32NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int32); ok || convert(value, &vs) {
+				return Int32In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int32); ok || convert(value, &vs) {
+				return Int32NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return Int32GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return Int32GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return Int32LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return Int32LTE(v), nil
+			}
+		}
+	case FieldInt64:
+		switch op {
+		case "eq":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return Int64EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return Int64NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int64); ok || convert(value, &vs) {
+				return Int64In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int64); ok || convert(value, &vs) {
+				return Int64NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return Int64GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return Int64GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return Int64LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return Int64LTE(v), nil
+			}
+		}
+	case FieldOptionalInt:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return OptionalIntEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return OptionalIntNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return OptionalIntIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return OptionalIntNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return OptionalIntGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return OptionalIntGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return OptionalIntLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return OptionalIntLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return OptionalIntIsNil(), nil
+				}
+				return Not(OptionalIntIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return OptionalIntNotNil(), nil
+				}
+				return Not(OptionalIntNotNil()), nil
+			}
+		}
+	case FieldOptionalInt8:
+		switch op {
+		case "eq":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return OptionalInt8EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return OptionalInt8NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int8); ok || convert(value, &vs) {
+				return OptionalInt8In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int8); ok || convert(value, &vs) {
+				return OptionalInt8NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return OptionalInt8GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return OptionalInt8GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return OptionalInt8LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return OptionalInt8LTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return OptionalInt8IsNil(), nil
+				}
+				return Not(OptionalInt8IsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return OptionalInt8NotNil(), nil
+				}
+				return Not(OptionalInt8NotNil()), nil
+			}
+		}
+	case FieldOptionalInt16:
+		switch op {
+		case "eq":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return OptionalInt16EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return OptionalInt16NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int16); ok || convert(value, &vs) {
+				return OptionalInt16In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int16); ok || convert(value, &vs) {
+				return OptionalInt16NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return OptionalInt16GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return OptionalInt16GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return OptionalInt16LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return OptionalInt16LTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return OptionalInt16IsNil(), nil
+				}
+				return Not(OptionalInt16IsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return OptionalInt16NotNil(), nil
+				}
+				return Not(OptionalInt16NotNil()), nil
+			}
+		}
+	case FieldOptionalInt32:
+		switch op {
+		case "eq":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return OptionalInt32EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return OptionalInt32NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int32); ok || convert(value, &vs) {
+				return OptionalInt32In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int32); ok || convert(value, &vs) {
+				return OptionalInt32NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return OptionalInt32GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return OptionalInt32GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return OptionalInt32LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return OptionalInt32LTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return OptionalInt32IsNil(), nil
+				}
+				return Not(OptionalInt32IsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return OptionalInt32NotNil(), nil
+				}
+				return Not(OptionalInt32NotNil()), nil
+			}
+		}
+	case FieldOptionalInt64:
+		switch op {
+		case "eq":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return OptionalInt64EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return OptionalInt64NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int64); ok || convert(value, &vs) {
+				return OptionalInt64In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int64); ok || convert(value, &vs) {
+				return OptionalInt64NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return OptionalInt64GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return OptionalInt64GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return OptionalInt64LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return OptionalInt64LTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return OptionalInt64IsNil(), nil
+				}
+				return Not(OptionalInt64IsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return OptionalInt64NotNil(), nil
+				}
+				return Not(OptionalInt64NotNil()), nil
+			}
+		}
+	case FieldNillableInt:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return NillableIntIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return NillableIntNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NillableIntLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableIntIsNil(), nil
+				}
+				return Not(NillableIntIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableIntNotNil(), nil
+				}
+				return Not(NillableIntNotNil()), nil
+			}
+		}
+	case FieldNillableInt8:
+		switch op {
+		case "eq":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return NillableInt8EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return NillableInt8NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int8); ok || convert(value, &vs) {
+				return NillableInt8In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int8); ok || convert(value, &vs) {
+				return NillableInt8NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return NillableInt8GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return NillableInt8GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return NillableInt8LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int8); ok || convert(value, &v) {
+				return NillableInt8LTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableInt8IsNil(), nil
+				}
+				return Not(NillableInt8IsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableInt8NotNil(), nil
+				}
+				return Not(NillableInt8NotNil()), nil
+			}
+		}
+	case FieldNillableInt16:
+		switch op {
+		case "eq":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return NillableInt16EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return NillableInt16NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int16); ok || convert(value, &vs) {
+				return NillableInt16In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int16); ok || convert(value, &vs) {
+				return NillableInt16NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return NillableInt16GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return NillableInt16GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return NillableInt16LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int16); ok || convert(value, &v) {
+				return NillableInt16LTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableInt16IsNil(), nil
+				}
+				return Not(NillableInt16IsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableInt16NotNil(), nil
+				}
+				return Not(NillableInt16NotNil()), nil
+			}
+		}
+	case FieldNillableInt32:
+		switch op {
+		case "eq":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return NillableInt32EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return NillableInt32NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int32); ok || convert(value, &vs) {
+				return NillableInt32In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int32); ok || convert(value, &vs) {
+				return NillableInt32NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return NillableInt32GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return NillableInt32GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return NillableInt32LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return NillableInt32LTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableInt32IsNil(), nil
+				}
+				return Not(NillableInt32IsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableInt32NotNil(), nil
+				}
+				return Not(NillableInt32NotNil()), nil
+			}
+		}
+	case FieldNillableInt64:
+		switch op {
+		case "eq":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return NillableInt64EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return NillableInt64NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int64); ok || convert(value, &vs) {
+				return NillableInt64In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int64); ok || convert(value, &vs) {
+				return NillableInt64NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return NillableInt64GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return NillableInt64GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return NillableInt64LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int64); ok || convert(value, &v) {
+				return NillableInt64LTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableInt64IsNil(), nil
+				}
+				return Not(NillableInt64IsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NillableInt64NotNil(), nil
+				}
+				return Not(NillableInt64NotNil()), nil
+			}
+		}
+	case FieldValidateOptionalInt32:
+		switch op {
+		case "eq":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return ValidateOptionalInt32EQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return ValidateOptionalInt32NEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int32); ok || convert(value, &vs) {
+				return ValidateOptionalInt32In(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int32); ok || convert(value, &vs) {
+				return ValidateOptionalInt32NotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return ValidateOptionalInt32GT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return ValidateOptionalInt32GTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return ValidateOptionalInt32LT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return ValidateOptionalInt32LTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return ValidateOptionalInt32IsNil(), nil
+				}
+				return Not(ValidateOptionalInt32IsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return ValidateOptionalInt32NotNil(), nil
+				}
+				return Not(ValidateOptionalInt32NotNil()), nil
+			}
+		}
+	case FieldState:
+		switch op {
+		case "eq":
+			if v, ok := value.(State); ok || convert(value, &v) {
+				return StateEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(State); ok || convert(value, &v) {
+				return StateNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]State); ok || convert(value, &vs) {
+				return StateIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]State); ok || convert(value, &vs) {
+				return StateNotIn(vs...), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return StateIsNil(), nil
+				}
+				return Not(StateIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return StateNotNil(), nil
+				}
+				return Not(StateNotNil()), nil
+			}
+		}
+	case FieldLink:
+		switch op {
+		case "eq":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return LinkEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return LinkNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]schema.Link); ok || convert(value, &vs) {
+				return LinkIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]schema.Link); ok || convert(value, &vs) {
+				return LinkNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return LinkGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return LinkGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return LinkLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return LinkLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return LinkIsNil(), nil
+				}
+				return Not(LinkIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return LinkNotNil(), nil
+				}
+				return Not(LinkNotNil()), nil
+			}
+		}
+	case FieldNullLink:
+		switch op {
+		case "eq":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return NullLinkEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return NullLinkNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]schema.Link); ok || convert(value, &vs) {
+				return NullLinkIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]schema.Link); ok || convert(value, &vs) {
+				return NullLinkNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return NullLinkGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return NullLinkGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return NullLinkLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(schema.Link); ok || convert(value, &v) {
+				return NullLinkLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NullLinkIsNil(), nil
+				}
+				return Not(NullLinkIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NullLinkNotNil(), nil
+				}
+				return Not(NullLinkNotNil()), nil
+			}
+		}
+	case FieldPriority:
+		switch op {
+		case "eq":
+			if v, ok := value.(schema.Priority); ok || convert(value, &v) {
+				return PriorityEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(schema.Priority); ok || convert(value, &v) {
+				return PriorityNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]schema.Priority); ok || convert(value, &vs) {
+				return PriorityIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]schema.Priority); ok || convert(value, &vs) {
+				return PriorityNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(schema.Priority); ok || convert(value, &v) {
+				return PriorityGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(schema.Priority); ok || convert(value, &v) {
+				return PriorityGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(schema.Priority); ok || convert(value, &v) {
+				return PriorityLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(schema.Priority); ok || convert(value, &v) {
+				return PriorityLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return PriorityIsNil(), nil
+				}
+				return Not(PriorityIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return PriorityNotNil(), nil
+				}
+				return Not(PriorityNotNil()), nil
+			}
+		}
+	case FieldRole:
+		switch op {
+		case "eq":
+			if v, ok := value.(schema.Role); ok || convert(value, &v) {
+				return RoleEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(schema.Role); ok || convert(value, &v) {
+				return RoleNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]schema.Role); ok || convert(value, &vs) {
+				return RoleIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]schema.Role); ok || convert(value, &vs) {
+				return RoleNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(schema.Role); ok || convert(value, &v) {
+				return RoleGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(schema.Role); ok || convert(value, &v) {
+				return RoleGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(schema.Role); ok || convert(value, &v) {
+				return RoleLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(schema.Role); ok || convert(value, &v) {
+				return RoleLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return RoleIsNil(), nil
+				}
+				return Not(RoleIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return RoleNotNil(), nil
+				}
+				return Not(RoleNotNil()), nil
+			}
+		}
+	case FieldNullableInt:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NullableIntEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NullableIntNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return NullableIntIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return NullableIntNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NullableIntGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NullableIntGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NullableIntLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return NullableIntLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NullableIntIsNil(), nil
+				}
+				return Not(NullableIntIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NullableIntNotNil(), nil
+				}
+				return Not(NullableIntNotNil()), nil
+			}
+		}
+	case FieldNullableString:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NullableStringEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NullableStringNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NullableStringIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NullableStringNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NullableStringGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NullableStringGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NullableStringLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NullableStringLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NullableStringContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NullableStringHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NullableStringHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NullableStringIsNil(), nil
+				}
+				return Not(NullableStringIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NullableStringNotNil(), nil
+				}
+				return Not(NullableStringNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NullableStringEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NullableStringContainsFold(v), nil
+			}
+		}
+	case FieldUUID:
+		switch op {
+		case "eq":
+			if v, ok := value.(uuid.UUID); ok || convert(value, &v) {
+				return UUIDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(uuid.UUID); ok || convert(value, &v) {
+				return UUIDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]uuid.UUID); ok || convert(value, &vs) {
+				return UUIDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]uuid.UUID); ok || convert(value, &vs) {
+				return UUIDNotIn(vs...), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return UUIDIsNil(), nil
+				}
+				return Not(UUIDIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return UUIDNotNil(), nil
+				}
+				return Not(UUIDNotNil()), nil
+			}
+		}
+	case FieldIP:
+		switch op {
+		case "eq":
+			if v, ok := value.(net.IP); ok || convert(value, &v) {
+				return IPEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(net.IP); ok || convert(value, &v) {
+				return IPNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]net.IP); ok || convert(value, &vs) {
+				return IPIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]net.IP); ok || convert(value, &vs) {
+				return IPNotIn(vs...), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return IPIsNil(), nil
+				}
+				return Not(IPIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return IPNotNil(), nil
+				}
+				return Not(IPNotNil()), nil
+			}
+		}
+	case FieldMac:
+		switch op {
+		case "eq":
+			if v, ok := value.(net.HardwareAddr); ok || convert(value, &v) {
+				return MacEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(net.HardwareAddr); ok || convert(value, &v) {
+				return MacNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]net.HardwareAddr); ok || convert(value, &vs) {
+				return MacIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]net.HardwareAddr); ok || convert(value, &vs) {
+				return MacNotIn(vs...), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return MacIsNil(), nil
+				}
+				return Not(MacIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return MacNotNil(), nil
+				}
+				return Not(MacNotNil()), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("fieldtype: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.FieldType) predicate.FieldType {
 	return predicate.FieldTypePerDialect(
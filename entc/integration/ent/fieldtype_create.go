@@ -10,36 +10,32 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/g"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/fieldtype"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/entcache"
+	"github.com/google/uuid"
 )
 
 // FieldTypeCreate is the builder for creating a FieldType entity.
 type FieldTypeCreate struct {
 	config
-	int                     *int
-	int8                    *int8
-	int16                   *int16
-	int32                   *int32
-	int64                   *int64
-	optional_int            *int
-	optional_int8           *int8
-	optional_int16          *int16
-	optional_int32          *int32
-	optional_int64          *int64
-	nillable_int            *int
-	nillable_int8           *int8
-	nillable_int16          *int16
-	nillable_int32          *int32
-	nillable_int64          *int64
-	validate_optional_int32 *int32
-	state                   *fieldtype.State
+	fieldtypeMutation
+	// id of the node, if it's mirrored from the primary storage.
+	id *string
+}
+
+// Mutation returns the mutation of the builder.
+func (ftc *FieldTypeCreate) Mutation() *FieldTypeMutation {
+	return &FieldTypeMutation{fieldtypeMutation: &ftc.fieldtypeMutation, op: ent.OpCreate}
 }
 
 // SetInt sets the int field.
@@ -240,8 +236,141 @@ func (ftc *FieldTypeCreate) SetNillableState(f *fieldtype.State) *FieldTypeCreat
 	return ftc
 }
 
+// SetLink sets the link field.
+func (ftc *FieldTypeCreate) SetLink(s schema.Link) *FieldTypeCreate {
+	ftc.link = &s
+	return ftc
+}
+
+// SetNillableLink sets the link field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillableLink(s *schema.Link) *FieldTypeCreate {
+	if s != nil {
+		ftc.SetLink(*s)
+	}
+	return ftc
+}
+
+// SetNullLink sets the null_link field.
+func (ftc *FieldTypeCreate) SetNullLink(s schema.Link) *FieldTypeCreate {
+	ftc.null_link = &s
+	return ftc
+}
+
+// SetNillableNullLink sets the null_link field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillableNullLink(s *schema.Link) *FieldTypeCreate {
+	if s != nil {
+		ftc.SetNullLink(*s)
+	}
+	return ftc
+}
+
+// SetPriority sets the priority field.
+func (ftc *FieldTypeCreate) SetPriority(s schema.Priority) *FieldTypeCreate {
+	ftc.priority = &s
+	return ftc
+}
+
+// SetNillablePriority sets the priority field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillablePriority(s *schema.Priority) *FieldTypeCreate {
+	if s != nil {
+		ftc.SetPriority(*s)
+	}
+	return ftc
+}
+
+// SetRole sets the role field.
+func (ftc *FieldTypeCreate) SetRole(s schema.Role) *FieldTypeCreate {
+	ftc.role = &s
+	return ftc
+}
+
+// SetNillableRole sets the role field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillableRole(s *schema.Role) *FieldTypeCreate {
+	if s != nil {
+		ftc.SetRole(*s)
+	}
+	return ftc
+}
+
+// SetNullableInt sets the nullable_int field.
+func (ftc *FieldTypeCreate) SetNullableInt(i int) *FieldTypeCreate {
+	ftc.nullable_int = &i
+	return ftc
+}
+
+// SetNillableNullableInt sets the nullable_int field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillableNullableInt(i *int) *FieldTypeCreate {
+	if i != nil {
+		ftc.SetNullableInt(*i)
+	}
+	return ftc
+}
+
+// SetNullableIntOrNull sets the nullable_int field to the given value, or to NULL if it's nil.
+func (ftc *FieldTypeCreate) SetNullableIntOrNull(i *int) *FieldTypeCreate {
+	if i != nil {
+		ftc.clearnullable_int = false
+		return ftc.SetNullableInt(*i)
+	}
+	ftc.nullable_int = nil
+	ftc.clearnullable_int = true
+	return ftc
+}
+
+// SetNullableString sets the nullable_string field.
+func (ftc *FieldTypeCreate) SetNullableString(s string) *FieldTypeCreate {
+	ftc.nullable_string = &s
+	return ftc
+}
+
+// SetNillableNullableString sets the nullable_string field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillableNullableString(s *string) *FieldTypeCreate {
+	if s != nil {
+		ftc.SetNullableString(*s)
+	}
+	return ftc
+}
+
+// SetNullableStringOrNull sets the nullable_string field to the given value, or to NULL if it's nil.
+func (ftc *FieldTypeCreate) SetNullableStringOrNull(s *string) *FieldTypeCreate {
+	if s != nil {
+		ftc.clearnullable_string = false
+		return ftc.SetNullableString(*s)
+	}
+	ftc.nullable_string = nil
+	ftc.clearnullable_string = true
+	return ftc
+}
+
+// SetUUID sets the uuid field.
+func (ftc *FieldTypeCreate) SetUUID(u uuid.UUID) *FieldTypeCreate {
+	ftc.uuid = &u
+	return ftc
+}
+
+// SetNillableUUID sets the uuid field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillableUUID(u *uuid.UUID) *FieldTypeCreate {
+	if u != nil {
+		ftc.SetUUID(*u)
+	}
+	return ftc
+}
+
+// SetIP sets the ip field.
+func (ftc *FieldTypeCreate) SetIP(n net.IP) *FieldTypeCreate {
+	ftc.ip = &n
+	return ftc
+}
+
+// SetMac sets the mac field.
+func (ftc *FieldTypeCreate) SetMac(na net.HardwareAddr) *FieldTypeCreate {
+	ftc.mac = &na
+	return ftc
+}
+
 // Save creates the FieldType in the database.
-func (ftc *FieldTypeCreate) Save(ctx context.Context) (*FieldType, error) {
+func (ftc *FieldTypeCreate) Save(ctx context.Context, opts ...ent.CallOption) (*FieldType, error) {
+	options := ent.NewCallOptions(opts...)
 	if ftc.int == nil {
 		return nil, errors.New("ent: missing required field \"int\"")
 	}
@@ -267,6 +396,47 @@ func (ftc *FieldTypeCreate) Save(ctx context.Context) (*FieldType, error) {
 			return nil, fmt.Errorf("ent: validator failed for field \"state\": %v", err)
 		}
 	}
+	if ftc.role != nil {
+		if err := fieldtype.RoleValidator(*ftc.role); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"role\": %v", err)
+		}
+	}
+	if ftc.nullable_string == nil && !ftc.clearnullable_string {
+		v := fieldtype.DefaultNullableString
+		ftc.nullable_string = &v
+	}
+	if ftc.uuid == nil {
+		v := fieldtype.DefaultUUID()
+		ftc.uuid = &v
+	}
+	if ftc.ip != nil {
+		if err := fieldtype.IPValidator(*ftc.ip); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"ip\": %v", err)
+		}
+	}
+	if ftc.mac != nil {
+		if err := fieldtype.MacValidator(*ftc.mac); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"mac\": %v", err)
+		}
+	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if ftc.shadow != nil {
+		return ftc.dualSave(ctx, opts...)
+	}
+	v, err := ftc.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		ftc.bus.publish(&Event{Op: ent.OpCreate, Type: fieldtype.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save creates the FieldType in the storage of the client dialect.
+func (ftc *FieldTypeCreate) save(ctx context.Context) (*FieldType, error) {
 	switch ftc.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ftc.sqlSave(ctx)
@@ -278,8 +448,8 @@ func (ftc *FieldTypeCreate) Save(ctx context.Context) (*FieldType, error) {
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (ftc *FieldTypeCreate) SaveX(ctx context.Context) *FieldType {
-	v, err := ftc.Save(ctx)
+func (ftc *FieldTypeCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *FieldType {
+	v, err := ftc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -287,6 +457,7 @@ func (ftc *FieldTypeCreate) SaveX(ctx context.Context) *FieldType {
 }
 
 func (ftc *FieldTypeCreate) sqlSave(ctx context.Context) (*FieldType, error) {
+	ctx = entcache.Invalidates(ctx, fieldtype.Tables...)
 	var (
 		res sql.Result
 		ft  = &FieldType{config: ftc.config}
@@ -296,6 +467,13 @@ func (ftc *FieldTypeCreate) sqlSave(ctx context.Context) (*FieldType, error) {
 		return nil, err
 	}
 	builder := sql.Insert(fieldtype.Table).Default(ftc.driver.Dialect())
+	if id := ftc.id; id != nil {
+		id, err := strconv.Atoi(*id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(fieldtype.FieldID, id)
+	}
 	if value := ftc.int; value != nil {
 		builder.Set(fieldtype.FieldInt, *value)
 		ft.Int = *value
@@ -364,6 +542,52 @@ func (ftc *FieldTypeCreate) sqlSave(ctx context.Context) (*FieldType, error) {
 		builder.Set(fieldtype.FieldState, *value)
 		ft.State = *value
 	}
+	if value := ftc.link; value != nil {
+		builder.Set(fieldtype.FieldLink, *value)
+		ft.Link = *value
+	}
+	if value := ftc.null_link; value != nil {
+		builder.Set(fieldtype.FieldNullLink, *value)
+		ft.NullLink = value
+	}
+	if value := ftc.priority; value != nil {
+		builder.Set(fieldtype.FieldPriority, *value)
+		ft.Priority = *value
+	}
+	if value := ftc.role; value != nil {
+		builder.Set(fieldtype.FieldRole, *value)
+		ft.Role = *value
+	}
+	if value := ftc.nullable_int; value != nil {
+		builder.Set(fieldtype.FieldNullableInt, *value)
+		ft.NullableInt = *value
+	} else {
+		if ftc.clearnullable_int {
+			builder.Set(fieldtype.FieldNullableInt, nil)
+		}
+		ft.nullNullableInt = true
+	}
+	if value := ftc.nullable_string; value != nil {
+		builder.Set(fieldtype.FieldNullableString, *value)
+		ft.NullableString = *value
+	} else {
+		if ftc.clearnullable_string {
+			builder.Set(fieldtype.FieldNullableString, nil)
+		}
+		ft.nullNullableString = true
+	}
+	if value := ftc.uuid; value != nil {
+		builder.Set(fieldtype.FieldUUID, (*value)[:])
+		ft.UUID = *value
+	}
+	if value := ftc.ip; value != nil {
+		builder.Set(fieldtype.FieldIP, sql.NullString{String: (*value).String(), Valid: (*value) != nil})
+		ft.IP = *value
+	}
+	if value := ftc.mac; value != nil {
+		builder.Set(fieldtype.FieldMac, sql.NullString{String: (*value).String(), Valid: (*value) != nil})
+		ft.Mac = value
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -381,8 +605,9 @@ func (ftc *FieldTypeCreate) sqlSave(ctx context.Context) (*FieldType, error) {
 
 func (ftc *FieldTypeCreate) gremlinSave(ctx context.Context) (*FieldType, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftc.gremlin().Query()
-	if err := ftc.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftc.gremlin()
+	query, _ := traversal.Query()
+	if err := ftc.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -448,5 +673,32 @@ func (ftc *FieldTypeCreate) gremlin() *dsl.Traversal {
 	if ftc.state != nil {
 		v.Property(dsl.Single, fieldtype.FieldState, *ftc.state)
 	}
+	if ftc.link != nil {
+		v.Property(dsl.Single, fieldtype.FieldLink, *ftc.link)
+	}
+	if ftc.null_link != nil {
+		v.Property(dsl.Single, fieldtype.FieldNullLink, *ftc.null_link)
+	}
+	if ftc.priority != nil {
+		v.Property(dsl.Single, fieldtype.FieldPriority, *ftc.priority)
+	}
+	if ftc.role != nil {
+		v.Property(dsl.Single, fieldtype.FieldRole, *ftc.role)
+	}
+	if ftc.nullable_int != nil {
+		v.Property(dsl.Single, fieldtype.FieldNullableInt, *ftc.nullable_int)
+	}
+	if ftc.nullable_string != nil {
+		v.Property(dsl.Single, fieldtype.FieldNullableString, *ftc.nullable_string)
+	}
+	if ftc.uuid != nil {
+		v.Property(dsl.Single, fieldtype.FieldUUID, (*ftc.uuid).String())
+	}
+	if ftc.ip != nil {
+		v.Property(dsl.Single, fieldtype.FieldIP, (*ftc.ip).String())
+	}
+	if ftc.mac != nil {
+		v.Property(dsl.Single, fieldtype.FieldMac, (*ftc.mac).String())
+	}
 	return v.ValueMap(true)
 }
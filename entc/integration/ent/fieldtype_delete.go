@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -18,12 +19,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/fieldtype"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // FieldTypeDelete is the builder for deleting a FieldType entity.
 type FieldTypeDelete struct {
 	config
 	predicates []predicate.FieldType
+	// id of the node, if it's deleted using a FieldTypeDeleteOne builder.
+	id *string
 }
 
 // Where adds a new predicate to the delete builder.
@@ -33,7 +37,37 @@ func (ftd *FieldTypeDelete) Where(ps ...predicate.FieldType) *FieldTypeDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (ftd *FieldTypeDelete) Exec(ctx context.Context) (int, error) {
+func (ftd *FieldTypeDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	if ftd.shadow != nil {
+		return ftd.dualExec(ctx, opts...)
+	}
+	n, err := ftd.exec(ctx)
+	if err != nil || n == 0 || options.SkipHooks {
+		return n, err
+	}
+	e := &Event{Op: ent.OpDelete, Type: fieldtype.Label, N: n}
+	if ftd.id != nil {
+		e.Op, e.ID = ent.OpDeleteOne, *ftd.id
+	}
+	ftd.bus.publish(e)
+	return n, nil
+}
+
+// exec deletes the FieldType nodes from the storage of the client dialect.
+func (ftd *FieldTypeDelete) exec(ctx context.Context) (int, error) {
+	predicates, err := interceptFieldType(ctx, ftd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *ftd
+		intercepted.predicates = append(predicates, ftd.predicates...)
+		ftd = &intercepted
+	}
 	switch ftd.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ftd.sqlExec(ctx)
@@ -45,8 +79,8 @@ func (ftd *FieldTypeDelete) Exec(ctx context.Context) (int, error) {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ftd *FieldTypeDelete) ExecX(ctx context.Context) int {
-	n, err := ftd.Exec(ctx)
+func (ftd *FieldTypeDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := ftd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,11 +88,15 @@ func (ftd *FieldTypeDelete) ExecX(ctx context.Context) int {
 }
 
 func (ftd *FieldTypeDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = entcache.Invalidates(ctx, fieldtype.Tables...)
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(fieldtype.Table))
+	selector := sql.Select().From(sql.Table(fieldtype.Table)).SetDialect(ftd.driver.Dialect()).WithContext(ctx)
 	for _, p := range ftd.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(fieldtype.Table).FromSelect(selector).Query()
 	if err := ftd.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -72,8 +110,9 @@ func (ftd *FieldTypeDelete) sqlExec(ctx context.Context) (int, error) {
 
 func (ftd *FieldTypeDelete) gremlinExec(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftd.gremlin().Query()
-	if err := ftd.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftd.gremlin()
+	query, _ := traversal.Query()
+	if err := ftd.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -93,12 +132,12 @@ type FieldTypeDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (ftdo *FieldTypeDeleteOne) Exec(ctx context.Context) error {
-	n, err := ftdo.ftd.Exec(ctx)
+func (ftdo *FieldTypeDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := ftdo.ftd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{fieldtype.Label}
 	default:
 		return nil
@@ -106,6 +145,8 @@ func (ftdo *FieldTypeDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ftdo *FieldTypeDeleteOne) ExecX(ctx context.Context) {
-	ftdo.ftd.ExecX(ctx)
+func (ftdo *FieldTypeDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := ftdo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
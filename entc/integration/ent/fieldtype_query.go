@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -25,14 +26,24 @@ import (
 // FieldTypeQuery is the builder for querying FieldType entities.
 type FieldTypeQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit     *int
+	offset    *int
+	order     []Order
+	unique    []string
+	fields    []string
+	unordered bool
+	hints     []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.FieldType
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath     func(context.Context) (*sql.Selector, error)
+	gremlinPath func(context.Context) (*dsl.Traversal, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -59,6 +70,107 @@ func (ftq *FieldTypeQuery) Order(o ...Order) *FieldTypeQuery {
 	return ftq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored. Note that, hints are ignored by Gremlin queries.
+func (ftq *FieldTypeQuery) Hint(hints ...sql.Hint) *FieldTypeQuery {
+	ftq.hints = append(ftq.hints, hints...)
+	return ftq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	fts, err := client.FieldType.Query().
+//		Fields(fieldtype.FieldInt, fieldtype.FieldInt8).
+//		All(ctx)
+//
+func (ftq *FieldTypeQuery) Fields(fields ...string) *FieldTypeQuery {
+	ftq.fields = append(ftq.fields, fields...)
+	return ftq
+}
+
+// Unordered disables the ordering by id that is added to the Gremlin queries that are paginated
+// using Limit or Offset. The order of the vertices
+// (and therefore, the content of the pages) is not guaranteed without it, but it can be removed when
+// the order is irrelevant (e.g. sampling).
+func (ftq *FieldTypeQuery) Unordered() *FieldTypeQuery {
+	ftq.unordered = true
+	return ftq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (ftq *FieldTypeQuery) Scope(scopes ...fieldtype.Scope) *FieldTypeQuery {
+	for _, s := range scopes {
+		ftq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				ftq.Order(Desc(o.Field))
+			} else {
+				ftq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			ftq.Limit(s.Limit)
+		}
+	}
+	return ftq
+}
+
+// FieldTypeInterceptor is a query interceptor of the FieldTypeClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the FieldTypeClient.Intercept method.
+type FieldTypeInterceptor func(context.Context, *FieldTypeQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (ftq *FieldTypeQuery) prepare(ctx context.Context) (*FieldTypeQuery, error) {
+	var interceptors []FieldTypeInterceptor
+	if ftq.inters != nil {
+		ftq.inters.mu.RLock()
+		interceptors = ftq.inters.FieldType
+		ftq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && ftq.sqlPath == nil && ftq.gremlinPath == nil {
+		return ftq, nil
+	}
+	query := ftq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	if path := query.gremlinPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.gremlin, query.gremlinPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptFieldType returns the predicates that the interceptors of the client add to a FieldType query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptFieldType(ctx context.Context, cfg config) ([]predicate.FieldType, error) {
+	query, err := (&FieldTypeQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // First returns the first FieldType entity in the query. Returns *ErrNotFound when no fieldtype was found.
 func (ftq *FieldTypeQuery) First(ctx context.Context) (*FieldType, error) {
 	fts, err := ftq.Limit(1).All(ctx)
@@ -104,7 +216,7 @@ func (ftq *FieldTypeQuery) FirstXID(ctx context.Context) string {
 
 // Only returns the only FieldType entity in the query, returns an error if not exactly one entity was returned.
 func (ftq *FieldTypeQuery) Only(ctx context.Context) (*FieldType, error) {
-	fts, err := ftq.Limit(2).All(ctx)
+	fts, err := ftq.Limit(2).Unordered().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +242,7 @@ func (ftq *FieldTypeQuery) OnlyX(ctx context.Context) *FieldType {
 // OnlyID returns the only FieldType id in the query, returns an error if not exactly one id was returned.
 func (ftq *FieldTypeQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = ftq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = ftq.Limit(2).Unordered().IDs(ctx); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -154,33 +266,63 @@ func (ftq *FieldTypeQuery) OnlyXID(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of FieldTypes.
-func (ftq *FieldTypeQuery) All(ctx context.Context) ([]*FieldType, error) {
-	switch ftq.driver.Dialect() {
+func (ftq *FieldTypeQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*FieldType, error) {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.shadow != nil && !ent.NewCallOptions(opts...).SkipHooks {
+		return query.dualAll(ctx)
+	}
+
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return ftq.sqlAll(ctx)
+		return query.sqlAll(ctx)
 	case dialect.Gremlin:
-		return ftq.gremlinAll(ctx)
+		return query.gremlinAll(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
 // AllX is like All, but panics if an error occurs.
-func (ftq *FieldTypeQuery) AllX(ctx context.Context) []*FieldType {
-	fts, err := ftq.All(ctx)
+func (ftq *FieldTypeQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*FieldType {
+	fts, err := ftq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return fts
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (ftq *FieldTypeQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlQueryString(ctx)
+	case dialect.Gremlin:
+		return query.gremlinQueryString(ctx)
+	default:
+		return "", nil, errors.New("ent: unsupported dialect")
+	}
+}
+
 // IDs executes the query and returns a list of FieldType ids.
 func (ftq *FieldTypeQuery) IDs(ctx context.Context) ([]string, error) {
-	switch ftq.driver.Dialect() {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return ftq.sqlIDs(ctx)
+		return query.sqlIDs(ctx)
 	case dialect.Gremlin:
-		return ftq.gremlinIDs(ctx)
+		return query.gremlinIDs(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
@@ -195,13 +337,46 @@ func (ftq *FieldTypeQuery) IDsX(ctx context.Context) []string {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit FieldTypes that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (ftq *FieldTypeQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if ftq.sql != nil || ftq.gremlin != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &FieldTypeQuery{
+		config:     ftq.config,
+		predicates: append([]predicate.FieldType{}, ftq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(string)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for FieldType", after)
+		}
+		query.Where(fieldtype.IDGT(id))
+	}
+	ids, err := query.Order(Asc(fieldtype.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (ftq *FieldTypeQuery) Count(ctx context.Context) (int, error) {
-	switch ftq.driver.Dialect() {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return ftq.sqlCount(ctx)
+		return query.sqlCount(ctx)
 	case dialect.Gremlin:
-		return ftq.gremlinCount(ctx)
+		return query.gremlinCount(ctx)
 	default:
 		return 0, errors.New("ent: unsupported dialect")
 	}
@@ -218,11 +393,15 @@ func (ftq *FieldTypeQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (ftq *FieldTypeQuery) Exist(ctx context.Context) (bool, error) {
-	switch ftq.driver.Dialect() {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return ftq.sqlExist(ctx)
+		return query.sqlExist(ctx)
 	case dialect.Gremlin:
-		return ftq.gremlinExist(ctx)
+		return query.gremlinExist(ctx)
 	default:
 		return false, errors.New("ent: unsupported dialect")
 	}
@@ -246,10 +425,16 @@ func (ftq *FieldTypeQuery) Clone() *FieldTypeQuery {
 		offset:     ftq.offset,
 		order:      append([]Order{}, ftq.order...),
 		unique:     append([]string{}, ftq.unique...),
+		fields:     append([]string{}, ftq.fields...),
+		unordered:  ftq.unordered,
+		hints:      append([]sql.Hint{}, ftq.hints...),
+		edgeCount:  ftq.edgeCount,
 		predicates: append([]predicate.FieldType{}, ftq.predicates...),
 		// clone intermediate queries.
-		sql:     ftq.sql.Clone(),
-		gremlin: ftq.gremlin.Clone(),
+		sql:         ftq.sql.Clone(),
+		sqlPath:     ftq.sqlPath,
+		gremlin:     ftq.gremlin.Clone(),
+		gremlinPath: ftq.gremlinPath,
 	}
 }
 
@@ -271,11 +456,18 @@ func (ftq *FieldTypeQuery) Clone() *FieldTypeQuery {
 func (ftq *FieldTypeQuery) GroupBy(field string, fields ...string) *FieldTypeGroupBy {
 	group := &FieldTypeGroupBy{config: ftq.config}
 	group.fields = append([]string{field}, fields...)
-	switch ftq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		group.sql = ftq.sqlQuery()
-	case dialect.Gremlin:
-		group.gremlin = ftq.gremlinQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := ftq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			group.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			group.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return group
 }
@@ -295,20 +487,27 @@ func (ftq *FieldTypeQuery) GroupBy(field string, fields ...string) *FieldTypeGro
 func (ftq *FieldTypeQuery) Select(field string, fields ...string) *FieldTypeSelect {
 	selector := &FieldTypeSelect{config: ftq.config}
 	selector.fields = append([]string{field}, fields...)
-	switch ftq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		selector.sql = ftq.sqlQuery()
-	case dialect.Gremlin:
-		selector.gremlin = ftq.gremlinQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := ftq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			selector.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			selector.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return selector
 }
 
 func (ftq *FieldTypeQuery) sqlAll(ctx context.Context) ([]*FieldType, error) {
 	rows := &sql.Rows{}
-	selector := ftq.sqlQuery()
-	if unique := ftq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := ftq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := ftq.driver.Query(ctx, query, args, rows); err != nil {
@@ -316,21 +515,61 @@ func (ftq *FieldTypeQuery) sqlAll(ctx context.Context) ([]*FieldType, error) {
 	}
 	defer rows.Close()
 	var fts FieldTypes
-	if err := fts.FromRows(rows); err != nil {
+	if err := fts.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	fts.config(ftq.config)
 	return fts, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (ftq *FieldTypeQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := ftq.sqlQuery(ctx)
+	if unique := ftq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := fieldtype.Columns
+	if fields := ftq.fields; len(fields) > 0 {
+		columns = append([]string{fieldtype.FieldID}, fields...)
+		for _, c := range fields {
+			if !fieldtype.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (ftq *FieldTypeQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := ftq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
 func (ftq *FieldTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := ftq.sqlQuery()
+	selector := ftq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{fieldtype.FieldID}
 	if len(ftq.unique) > 0 {
 		unique = ftq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := ftq.edgeCount; c != nil && len(ftq.predicates) == 0 && len(ftq.unique) == 0 &&
+		len(ftq.hints) == 0 && ftq.limit == nil && ftq.offset == nil {
+		selector = c.Clone().SetDialect(ftq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := ftq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -347,11 +586,22 @@ func (ftq *FieldTypeQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (ftq *FieldTypeQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := ftq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := ftq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(fieldtype.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := ftq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := ftq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (ftq *FieldTypeQuery) sqlIDs(ctx context.Context) ([]string, error) {
@@ -366,13 +616,33 @@ func (ftq *FieldTypeQuery) sqlIDs(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
-func (ftq *FieldTypeQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (ftq *FieldTypeQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(fieldtype.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (ftq *FieldTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(fieldtype.Table)
 	selector := sql.Select(t1.Columns(fieldtype.Columns...)...).From(t1)
 	if ftq.sql != nil {
 		selector = ftq.sql
 		selector.Select(selector.Columns(fieldtype.Columns...)...)
 	}
+	selector.SetDialect(ftq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(ftq.hints...)
 	for _, p := range ftq.predicates {
 		p(selector)
 	}
@@ -392,8 +662,9 @@ func (ftq *FieldTypeQuery) sqlQuery() *sql.Selector {
 
 func (ftq *FieldTypeQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftq.gremlinQuery().Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftq.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := ftq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	vertices, err := res.ReadVertices()
@@ -409,8 +680,9 @@ func (ftq *FieldTypeQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 
 func (ftq *FieldTypeQuery) gremlinAll(ctx context.Context) ([]*FieldType, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftq.gremlinQuery().ValueMap(true).Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftq.gremlinAllQuery()
+	query, _ := traversal.Query()
+	if err := ftq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	var fts FieldTypes
@@ -421,10 +693,25 @@ func (ftq *FieldTypeQuery) gremlinAll(ctx context.Context) ([]*FieldType, error)
 	return fts, nil
 }
 
+// gremlinAllQuery returns the traversal of All, that returns the value maps of the vertices.
+func (ftq *FieldTypeQuery) gremlinAllQuery() *dsl.Traversal {
+	args := []interface{}{true}
+	for _, f := range ftq.fields {
+		args = append(args, f)
+	}
+	return ftq.gremlinQuery().ValueMap(args...)
+}
+
+func (ftq *FieldTypeQuery) gremlinQueryString(context.Context) (string, interface{}, error) {
+	query, bindings := ftq.gremlinAllQuery().Query()
+	return query, bindings, nil
+}
+
 func (ftq *FieldTypeQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftq.gremlinQuery().Count().Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftq.gremlinTraversal(false).Count()
+	query, _ := traversal.Query()
+	if err := ftq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -432,14 +719,22 @@ func (ftq *FieldTypeQuery) gremlinCount(ctx context.Context) (int, error) {
 
 func (ftq *FieldTypeQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftq.gremlinQuery().HasNext().Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftq.gremlinTraversal(false).HasNext()
+	query, _ := traversal.Query()
+	if err := ftq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
 }
 
+// gremlinQuery returns the traversal of the query for listing its vertices, with its default orders.
 func (ftq *FieldTypeQuery) gremlinQuery() *dsl.Traversal {
+	return ftq.gremlinTraversal(true)
+}
+
+// gremlinTraversal returns the traversal of the query. The default orders are added only to the traversals
+// of listing queries (list), since they don't change the results of the others (e.g. Count and Exist).
+func (ftq *FieldTypeQuery) gremlinTraversal(list bool) *dsl.Traversal {
 	v := g.V().HasLabel(fieldtype.Label)
 	if ftq.gremlin != nil {
 		v = ftq.gremlin.Clone()
@@ -447,13 +742,22 @@ func (ftq *FieldTypeQuery) gremlinQuery() *dsl.Traversal {
 	for _, p := range ftq.predicates {
 		p(v)
 	}
-	if len(ftq.order) > 0 {
+	limit, offset := ftq.limit, ftq.offset
+	list = list && !ftq.unordered
+	// paginated queries are ordered by id (also as a tie-breaker of the
+	// query orders), in order to keep the pages stable between calls.
+	stable := (limit != nil || offset != nil) && list
+	order := ftq.order
+	if len(order) > 0 || stable {
 		v.Order()
-		for _, p := range ftq.order {
+		for _, p := range order {
 			p(v)
 		}
+		if stable {
+			v.By(dsl.ID, dsl.Incr)
+		}
 	}
-	switch limit, offset := ftq.limit, ftq.offset; {
+	switch {
 	case limit != nil && offset != nil:
 		v.Range(*offset, *offset+*limit)
 	case offset != nil:
@@ -475,6 +779,11 @@ type FieldTypeGroupBy struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving     *sql.Predicate
+	gremlinHaving *dsl.Traversal
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -483,8 +792,27 @@ func (ftgb *FieldTypeGroupBy) Aggregate(fns ...Aggregate) *FieldTypeGroupBy {
 	return ftgb
 }
 
+// Having adds per-dialect predicates on the aggregated values of the groups.
+// In Gremlin, the predicate is applied using a `where` step on the values of each group.
+// For example:
+//
+//	client.FieldType.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5), __.Select(ent.DefaultCountLabel).Is(p.GT(5))).
+//		Scan(ctx, &v)
+//
+func (ftgb *FieldTypeGroupBy) Having(p0 *sql.Predicate, p1 *dsl.Traversal) *FieldTypeGroupBy {
+	ftgb.sqlHaving = p0
+	ftgb.gremlinHaving = p1
+	return ftgb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (ftgb *FieldTypeGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := ftgb.path(ctx); err != nil {
+		return err
+	}
 	switch ftgb.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ftgb.sqlScan(ctx, v)
@@ -587,6 +915,9 @@ func (ftgb *FieldTypeGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (ftgb *FieldTypeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ftgb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ftgb.sqlQuery().Query()
 	if err := ftgb.driver.Query(ctx, query, args, rows); err != nil {
@@ -603,13 +934,18 @@ func (ftgb *FieldTypeGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range ftgb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(ftgb.fields...)
+	selector.Select(columns...).GroupBy(ftgb.fields...)
+	if ftgb.sqlHaving != nil {
+		selector.Having(ftgb.sqlHaving)
+	}
+	return selector
 }
 
 func (ftgb *FieldTypeGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
-	query, bindings := ftgb.gremlinQuery().Query()
-	if err := ftgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftgb.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := ftgb.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(ftgb.fields)+len(ftgb.fns) == 1 {
@@ -636,11 +972,14 @@ func (ftgb *FieldTypeGroupBy) gremlinQuery() *dsl.Traversal {
 		names = append(names, f)
 		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
 	}
-	return ftgb.gremlin.Group().
+	v := ftgb.gremlin.Group().
 		By(__.Values(ftgb.fields...).Fold()).
 		By(__.Fold().Match(trs...).Select(names...)).
-		Select(dsl.Values).
-		Next()
+		Select(dsl.Values)
+	if ftgb.gremlinHaving != nil {
+		v = v.Unfold().Where(ftgb.gremlinHaving).Fold()
+	}
+	return v.Next()
 }
 
 // FieldTypeSelect is the builder for select fields of FieldType entities.
@@ -650,10 +989,15 @@ type FieldTypeSelect struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (fts *FieldTypeSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := fts.path(ctx); err != nil {
+		return err
+	}
 	switch fts.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return fts.sqlScan(ctx, v)
@@ -756,6 +1100,9 @@ func (fts *FieldTypeSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (fts *FieldTypeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := fts.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := fts.sqlQuery().Query()
 	if err := fts.driver.Query(ctx, query, args, rows); err != nil {
@@ -784,8 +1131,8 @@ func (fts *FieldTypeSelect) gremlinScan(ctx context.Context, v interface{}) erro
 		}
 		traversal = fts.gremlin.ValueMap(fields...)
 	}
-	query, bindings := traversal.Query()
-	if err := fts.driver.Exec(ctx, query, bindings, res); err != nil {
+	query, _ := traversal.Query()
+	if err := fts.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(fts.fields) == 1 {
@@ -10,7 +10,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -19,57 +22,19 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/fieldtype"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/entcache"
+	"github.com/google/uuid"
 )
 
 // FieldTypeUpdate is the builder for updating FieldType entities.
 type FieldTypeUpdate struct {
 	config
-	int                          *int
-	addint                       *int
-	int8                         *int8
-	addint8                      *int8
-	int16                        *int16
-	addint16                     *int16
-	int32                        *int32
-	addint32                     *int32
-	int64                        *int64
-	addint64                     *int64
-	optional_int                 *int
-	addoptional_int              *int
-	clearoptional_int            bool
-	optional_int8                *int8
-	addoptional_int8             *int8
-	clearoptional_int8           bool
-	optional_int16               *int16
-	addoptional_int16            *int16
-	clearoptional_int16          bool
-	optional_int32               *int32
-	addoptional_int32            *int32
-	clearoptional_int32          bool
-	optional_int64               *int64
-	addoptional_int64            *int64
-	clearoptional_int64          bool
-	nillable_int                 *int
-	addnillable_int              *int
-	clearnillable_int            bool
-	nillable_int8                *int8
-	addnillable_int8             *int8
-	clearnillable_int8           bool
-	nillable_int16               *int16
-	addnillable_int16            *int16
-	clearnillable_int16          bool
-	nillable_int32               *int32
-	addnillable_int32            *int32
-	clearnillable_int32          bool
-	nillable_int64               *int64
-	addnillable_int64            *int64
-	clearnillable_int64          bool
-	validate_optional_int32      *int32
-	addvalidate_optional_int32   *int32
-	clearvalidate_optional_int32 bool
-	state                        *fieldtype.State
-	clearstate                   bool
-	predicates                   []predicate.FieldType
+	fieldtypeMutation
+	predicates []predicate.FieldType
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*FieldType
 }
 
 // Where adds a new predicate for the builder.
@@ -78,6 +43,11 @@ func (ftu *FieldTypeUpdate) Where(ps ...predicate.FieldType) *FieldTypeUpdate {
 	return ftu
 }
 
+// Mutation returns the mutation of the builder.
+func (ftu *FieldTypeUpdate) Mutation() *FieldTypeMutation {
+	return &FieldTypeMutation{fieldtypeMutation: &ftu.fieldtypeMutation, op: ent.OpUpdate}
+}
+
 // SetInt sets the int field.
 func (ftu *FieldTypeUpdate) SetInt(i int) *FieldTypeUpdate {
 	ftu.int = &i
@@ -536,31 +506,301 @@ func (ftu *FieldTypeUpdate) ClearState() *FieldTypeUpdate {
 	return ftu
 }
 
+// SetLink sets the link field.
+func (ftu *FieldTypeUpdate) SetLink(s schema.Link) *FieldTypeUpdate {
+	ftu.link = &s
+	return ftu
+}
+
+// SetNillableLink sets the link field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillableLink(s *schema.Link) *FieldTypeUpdate {
+	if s != nil {
+		ftu.SetLink(*s)
+	}
+	return ftu
+}
+
+// ClearLink clears the value of link.
+func (ftu *FieldTypeUpdate) ClearLink() *FieldTypeUpdate {
+	ftu.link = nil
+	ftu.clearlink = true
+	return ftu
+}
+
+// SetNullLink sets the null_link field.
+func (ftu *FieldTypeUpdate) SetNullLink(s schema.Link) *FieldTypeUpdate {
+	ftu.null_link = &s
+	return ftu
+}
+
+// SetNillableNullLink sets the null_link field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillableNullLink(s *schema.Link) *FieldTypeUpdate {
+	if s != nil {
+		ftu.SetNullLink(*s)
+	}
+	return ftu
+}
+
+// ClearNullLink clears the value of null_link.
+func (ftu *FieldTypeUpdate) ClearNullLink() *FieldTypeUpdate {
+	ftu.null_link = nil
+	ftu.clearnull_link = true
+	return ftu
+}
+
+// SetPriority sets the priority field.
+func (ftu *FieldTypeUpdate) SetPriority(s schema.Priority) *FieldTypeUpdate {
+	ftu.priority = &s
+	return ftu
+}
+
+// SetNillablePriority sets the priority field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillablePriority(s *schema.Priority) *FieldTypeUpdate {
+	if s != nil {
+		ftu.SetPriority(*s)
+	}
+	return ftu
+}
+
+// ClearPriority clears the value of priority.
+func (ftu *FieldTypeUpdate) ClearPriority() *FieldTypeUpdate {
+	ftu.priority = nil
+	ftu.clearpriority = true
+	return ftu
+}
+
+// SetRole sets the role field.
+func (ftu *FieldTypeUpdate) SetRole(s schema.Role) *FieldTypeUpdate {
+	ftu.role = &s
+	return ftu
+}
+
+// SetNillableRole sets the role field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillableRole(s *schema.Role) *FieldTypeUpdate {
+	if s != nil {
+		ftu.SetRole(*s)
+	}
+	return ftu
+}
+
+// ClearRole clears the value of role.
+func (ftu *FieldTypeUpdate) ClearRole() *FieldTypeUpdate {
+	ftu.role = nil
+	ftu.clearrole = true
+	return ftu
+}
+
+// SetNullableInt sets the nullable_int field.
+func (ftu *FieldTypeUpdate) SetNullableInt(i int) *FieldTypeUpdate {
+	ftu.nullable_int = &i
+	ftu.addnullable_int = nil
+	return ftu
+}
+
+// SetNillableNullableInt sets the nullable_int field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillableNullableInt(i *int) *FieldTypeUpdate {
+	if i != nil {
+		ftu.SetNullableInt(*i)
+	}
+	return ftu
+}
+
+// SetNullableIntOrNull sets the nullable_int field to the given value, or to NULL if it's nil.
+func (ftu *FieldTypeUpdate) SetNullableIntOrNull(i *int) *FieldTypeUpdate {
+	if i != nil {
+		ftu.clearnullable_int = false
+		return ftu.SetNullableInt(*i)
+	}
+	ftu.nullable_int = nil
+	ftu.addnullable_int = nil
+	ftu.clearnullable_int = true
+	return ftu
+}
+
+// AddNullableInt adds i to nullable_int.
+func (ftu *FieldTypeUpdate) AddNullableInt(i int) *FieldTypeUpdate {
+	if ftu.addnullable_int == nil {
+		ftu.addnullable_int = &i
+	} else {
+		*ftu.addnullable_int += i
+	}
+	return ftu
+}
+
+// ClearNullableInt clears the value of nullable_int.
+func (ftu *FieldTypeUpdate) ClearNullableInt() *FieldTypeUpdate {
+	ftu.nullable_int = nil
+	ftu.clearnullable_int = true
+	return ftu
+}
+
+// SetNullableString sets the nullable_string field.
+func (ftu *FieldTypeUpdate) SetNullableString(s string) *FieldTypeUpdate {
+	ftu.nullable_string = &s
+	return ftu
+}
+
+// SetNillableNullableString sets the nullable_string field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillableNullableString(s *string) *FieldTypeUpdate {
+	if s != nil {
+		ftu.SetNullableString(*s)
+	}
+	return ftu
+}
+
+// SetNullableStringOrNull sets the nullable_string field to the given value, or to NULL if it's nil.
+func (ftu *FieldTypeUpdate) SetNullableStringOrNull(s *string) *FieldTypeUpdate {
+	if s != nil {
+		ftu.clearnullable_string = false
+		return ftu.SetNullableString(*s)
+	}
+	ftu.nullable_string = nil
+	ftu.clearnullable_string = true
+	return ftu
+}
+
+// ClearNullableString clears the value of nullable_string.
+func (ftu *FieldTypeUpdate) ClearNullableString() *FieldTypeUpdate {
+	ftu.nullable_string = nil
+	ftu.clearnullable_string = true
+	return ftu
+}
+
+// SetUUID sets the uuid field.
+func (ftu *FieldTypeUpdate) SetUUID(u uuid.UUID) *FieldTypeUpdate {
+	ftu.uuid = &u
+	return ftu
+}
+
+// SetNillableUUID sets the uuid field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillableUUID(u *uuid.UUID) *FieldTypeUpdate {
+	if u != nil {
+		ftu.SetUUID(*u)
+	}
+	return ftu
+}
+
+// ClearUUID clears the value of uuid.
+func (ftu *FieldTypeUpdate) ClearUUID() *FieldTypeUpdate {
+	ftu.uuid = nil
+	ftu.clearuuid = true
+	return ftu
+}
+
+// SetIP sets the ip field.
+func (ftu *FieldTypeUpdate) SetIP(n net.IP) *FieldTypeUpdate {
+	ftu.ip = &n
+	return ftu
+}
+
+// ClearIP clears the value of ip.
+func (ftu *FieldTypeUpdate) ClearIP() *FieldTypeUpdate {
+	ftu.ip = nil
+	ftu.clearip = true
+	return ftu
+}
+
+// SetMac sets the mac field.
+func (ftu *FieldTypeUpdate) SetMac(na net.HardwareAddr) *FieldTypeUpdate {
+	ftu.mac = &na
+	return ftu
+}
+
+// ClearMac clears the value of mac.
+func (ftu *FieldTypeUpdate) ClearMac() *FieldTypeUpdate {
+	ftu.mac = nil
+	ftu.clearmac = true
+	return ftu
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (ftu *FieldTypeUpdate) Save(ctx context.Context) (int, error) {
+func (ftu *FieldTypeUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := ftu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (ftu *FieldTypeUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]string, error) {
+	options := ent.NewCallOptions(opts...)
 	if ftu.validate_optional_int32 != nil {
 		if err := fieldtype.ValidateOptionalInt32Validator(*ftu.validate_optional_int32); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)
+			return nil, fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)
 		}
 	}
 	if ftu.state != nil {
 		if err := fieldtype.StateValidator(*ftu.state); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"state\": %v", err)
+			return nil, fmt.Errorf("ent: validator failed for field \"state\": %v", err)
+		}
+	}
+	if ftu.role != nil {
+		if err := fieldtype.RoleValidator(*ftu.role); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"role\": %v", err)
+		}
+	}
+	if ftu.ip != nil {
+		if err := fieldtype.IPValidator(*ftu.ip); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"ip\": %v", err)
+		}
+	}
+	if ftu.mac != nil {
+		if err := fieldtype.MacValidator(*ftu.mac); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"mac\": %v", err)
 		}
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if ftu.shadow != nil {
+		return ftu.dualSave(ctx, opts...)
+	}
+	ids, err := ftu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 && !options.SkipHooks {
+		ftu.bus.publish(&Event{Op: ent.OpUpdate, Type: fieldtype.Label, N: len(ids)})
+	}
+	return ids, nil
+}
+
+// save updates the FieldType nodes in the storage of the client dialect.
+func (ftu *FieldTypeUpdate) save(ctx context.Context) ([]string, error) {
+	predicates, err := interceptFieldType(ctx, ftu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *ftu
+		intercepted.predicates = append(predicates, ftu.predicates...)
+		ftu = &intercepted
+	}
 	switch ftu.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ftu.sqlSave(ctx)
 	case dialect.Gremlin:
 		return ftu.gremlinSave(ctx)
 	default:
-		return 0, errors.New("ent: unsupported dialect")
+		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (ftu *FieldTypeUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*FieldType, error) {
+	var fts []*FieldType
+	ftu.returning = &fts
+	defer func() { ftu.returning = nil }()
+	if _, err := ftu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return fts, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (ftu *FieldTypeUpdate) SaveX(ctx context.Context) int {
-	affected, err := ftu.Save(ctx)
+func (ftu *FieldTypeUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := ftu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -568,44 +808,49 @@ func (ftu *FieldTypeUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (ftu *FieldTypeUpdate) Exec(ctx context.Context) error {
-	_, err := ftu.Save(ctx)
+func (ftu *FieldTypeUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := ftu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ftu *FieldTypeUpdate) ExecX(ctx context.Context) {
-	if err := ftu.Exec(ctx); err != nil {
+func (ftu *FieldTypeUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := ftu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(fieldtype.FieldID).From(sql.Table(fieldtype.Table))
+func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (_ []string, err error) {
+	ctx = entcache.Invalidates(ctx, fieldtype.Tables...)
+	selector := sql.Select(fieldtype.FieldID).From(sql.Table(fieldtype.Table)).SetDialect(ftu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range ftu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = ftu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := ftu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -746,28 +991,130 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ftu.clearstate {
 		builder.SetNull(fieldtype.FieldState)
 	}
+	if value := ftu.link; value != nil {
+		builder.Set(fieldtype.FieldLink, *value)
+	}
+	if ftu.clearlink {
+		builder.SetNull(fieldtype.FieldLink)
+	}
+	if value := ftu.null_link; value != nil {
+		builder.Set(fieldtype.FieldNullLink, *value)
+	}
+	if ftu.clearnull_link {
+		builder.SetNull(fieldtype.FieldNullLink)
+	}
+	if value := ftu.priority; value != nil {
+		builder.Set(fieldtype.FieldPriority, *value)
+	}
+	if ftu.clearpriority {
+		builder.SetNull(fieldtype.FieldPriority)
+	}
+	if value := ftu.role; value != nil {
+		builder.Set(fieldtype.FieldRole, *value)
+	}
+	if ftu.clearrole {
+		builder.SetNull(fieldtype.FieldRole)
+	}
+	if value := ftu.nullable_int; value != nil {
+		builder.Set(fieldtype.FieldNullableInt, *value)
+	}
+	if value := ftu.addnullable_int; value != nil {
+		builder.Add(fieldtype.FieldNullableInt, *value)
+	}
+	if ftu.clearnullable_int {
+		builder.SetNull(fieldtype.FieldNullableInt)
+	}
+	if value := ftu.nullable_string; value != nil {
+		builder.Set(fieldtype.FieldNullableString, *value)
+	}
+	if ftu.clearnullable_string {
+		builder.SetNull(fieldtype.FieldNullableString)
+	}
+	if value := ftu.uuid; value != nil {
+		builder.Set(fieldtype.FieldUUID, (*value)[:])
+	}
+	if ftu.clearuuid {
+		builder.SetNull(fieldtype.FieldUUID)
+	}
+	if value := ftu.ip; value != nil {
+		builder.Set(fieldtype.FieldIP, sql.NullString{String: (*value).String(), Valid: (*value) != nil})
+	}
+	if ftu.clearip {
+		builder.SetNull(fieldtype.FieldIP)
+	}
+	if value := ftu.mac; value != nil {
+		builder.Set(fieldtype.FieldMac, sql.NullString{String: (*value).String(), Valid: (*value) != nil})
+	}
+	if ftu.clearmac {
+		builder.SetNull(fieldtype.FieldMac)
+	}
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
+	if ftu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(fieldtype.Columns...).
+			From(sql.Table(fieldtype.Table)).
+			Where(sql.InInts(fieldtype.FieldID, ids...)).
+			SetDialect(ftu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var fts FieldTypes
+		err := fts.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into FieldType: %v", err))
+		}
+		fts.config(ftu.config)
+		*ftu.returning = fts
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	vs := make([]string, len(ids))
+	for i, id := range ids {
+		vs[i] = strconv.Itoa(id)
+	}
+	return vs, nil
 }
 
-func (ftu *FieldTypeUpdate) gremlinSave(ctx context.Context) (int, error) {
+func (ftu *FieldTypeUpdate) gremlinSave(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftu.gremlin().Query()
-	if err := ftu.driver.Exec(ctx, query, bindings, res); err != nil {
-		return 0, err
+	traversal := ftu.gremlin()
+	query, _ := traversal.Query()
+	if err := ftu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
-		return 0, err
+		return nil, err
 	}
-	return res.ReadInt()
+	if ftu.returning != nil {
+		var fts FieldTypes
+		if err := fts.FromResponse(res); err != nil {
+			return nil, err
+		}
+		fts.config(ftu.config)
+		*ftu.returning = fts
+		ids := make([]string, 0, len(fts))
+		for _, ft := range fts {
+			ids = append(ids, ft.ID)
+		}
+		return ids, nil
+	}
+	vertices, err := res.ReadVertices()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vertices))
+	for _, vertex := range vertices {
+		ids = append(ids, vertex.ID.(string))
+	}
+	return ids, nil
 }
 
 func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
@@ -877,6 +1224,36 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	if value := ftu.state; value != nil {
 		v.Property(dsl.Single, fieldtype.FieldState, *value)
 	}
+	if value := ftu.link; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldLink, *value)
+	}
+	if value := ftu.null_link; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldNullLink, *value)
+	}
+	if value := ftu.priority; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldPriority, *value)
+	}
+	if value := ftu.role; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldRole, *value)
+	}
+	if value := ftu.nullable_int; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldNullableInt, *value)
+	}
+	if value := ftu.addnullable_int; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldNullableInt, __.Union(__.Values(fieldtype.FieldNullableInt), __.Constant(*value)).Sum())
+	}
+	if value := ftu.nullable_string; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldNullableString, *value)
+	}
+	if value := ftu.uuid; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldUUID, (*value).String())
+	}
+	if value := ftu.ip; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldIP, (*value).String())
+	}
+	if value := ftu.mac; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldMac, (*value).String())
+	}
 	var properties []interface{}
 	if ftu.clearoptional_int {
 		properties = append(properties, fieldtype.FieldOptionalInt)
@@ -914,10 +1291,39 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	if ftu.clearstate {
 		properties = append(properties, fieldtype.FieldState)
 	}
+	if ftu.clearlink {
+		properties = append(properties, fieldtype.FieldLink)
+	}
+	if ftu.clearnull_link {
+		properties = append(properties, fieldtype.FieldNullLink)
+	}
+	if ftu.clearpriority {
+		properties = append(properties, fieldtype.FieldPriority)
+	}
+	if ftu.clearrole {
+		properties = append(properties, fieldtype.FieldRole)
+	}
+	if ftu.clearnullable_int {
+		properties = append(properties, fieldtype.FieldNullableInt)
+	}
+	if ftu.clearnullable_string {
+		properties = append(properties, fieldtype.FieldNullableString)
+	}
+	if ftu.clearuuid {
+		properties = append(properties, fieldtype.FieldUUID)
+	}
+	if ftu.clearip {
+		properties = append(properties, fieldtype.FieldIP)
+	}
+	if ftu.clearmac {
+		properties = append(properties, fieldtype.FieldMac)
+	}
 	if len(properties) > 0 {
 		v.SideEffect(__.Properties(properties...).Drop())
 	}
-	v.Count()
+	if ftu.returning != nil {
+		v.ValueMap(true)
+	}
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
@@ -925,52 +1331,16 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 // FieldTypeUpdateOne is the builder for updating a single FieldType entity.
 type FieldTypeUpdateOne struct {
 	config
-	id                           string
-	int                          *int
-	addint                       *int
-	int8                         *int8
-	addint8                      *int8
-	int16                        *int16
-	addint16                     *int16
-	int32                        *int32
-	addint32                     *int32
-	int64                        *int64
-	addint64                     *int64
-	optional_int                 *int
-	addoptional_int              *int
-	clearoptional_int            bool
-	optional_int8                *int8
-	addoptional_int8             *int8
-	clearoptional_int8           bool
-	optional_int16               *int16
-	addoptional_int16            *int16
-	clearoptional_int16          bool
-	optional_int32               *int32
-	addoptional_int32            *int32
-	clearoptional_int32          bool
-	optional_int64               *int64
-	addoptional_int64            *int64
-	clearoptional_int64          bool
-	nillable_int                 *int
-	addnillable_int              *int
-	clearnillable_int            bool
-	nillable_int8                *int8
-	addnillable_int8             *int8
-	clearnillable_int8           bool
-	nillable_int16               *int16
-	addnillable_int16            *int16
-	clearnillable_int16          bool
-	nillable_int32               *int32
-	addnillable_int32            *int32
-	clearnillable_int32          bool
-	nillable_int64               *int64
-	addnillable_int64            *int64
-	clearnillable_int64          bool
-	validate_optional_int32      *int32
-	addvalidate_optional_int32   *int32
-	clearvalidate_optional_int32 bool
-	state                        *fieldtype.State
-	clearstate                   bool
+	id     string
+	entity *FieldType
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.FieldType
+	fieldtypeMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (ftuo *FieldTypeUpdateOne) Mutation() *FieldTypeMutation {
+	return &FieldTypeMutation{fieldtypeMutation: &ftuo.fieldtypeMutation, op: ent.OpUpdateOne, id: &ftuo.id}
 }
 
 // SetInt sets the int field.
@@ -1431,8 +1801,311 @@ func (ftuo *FieldTypeUpdateOne) ClearState() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetLink sets the link field.
+func (ftuo *FieldTypeUpdateOne) SetLink(s schema.Link) *FieldTypeUpdateOne {
+	ftuo.link = &s
+	return ftuo
+}
+
+// SetNillableLink sets the link field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillableLink(s *schema.Link) *FieldTypeUpdateOne {
+	if s != nil {
+		ftuo.SetLink(*s)
+	}
+	return ftuo
+}
+
+// ClearLink clears the value of link.
+func (ftuo *FieldTypeUpdateOne) ClearLink() *FieldTypeUpdateOne {
+	ftuo.link = nil
+	ftuo.clearlink = true
+	return ftuo
+}
+
+// SetNullLink sets the null_link field.
+func (ftuo *FieldTypeUpdateOne) SetNullLink(s schema.Link) *FieldTypeUpdateOne {
+	ftuo.null_link = &s
+	return ftuo
+}
+
+// SetNillableNullLink sets the null_link field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillableNullLink(s *schema.Link) *FieldTypeUpdateOne {
+	if s != nil {
+		ftuo.SetNullLink(*s)
+	}
+	return ftuo
+}
+
+// ClearNullLink clears the value of null_link.
+func (ftuo *FieldTypeUpdateOne) ClearNullLink() *FieldTypeUpdateOne {
+	ftuo.null_link = nil
+	ftuo.clearnull_link = true
+	return ftuo
+}
+
+// SetPriority sets the priority field.
+func (ftuo *FieldTypeUpdateOne) SetPriority(s schema.Priority) *FieldTypeUpdateOne {
+	ftuo.priority = &s
+	return ftuo
+}
+
+// SetNillablePriority sets the priority field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillablePriority(s *schema.Priority) *FieldTypeUpdateOne {
+	if s != nil {
+		ftuo.SetPriority(*s)
+	}
+	return ftuo
+}
+
+// ClearPriority clears the value of priority.
+func (ftuo *FieldTypeUpdateOne) ClearPriority() *FieldTypeUpdateOne {
+	ftuo.priority = nil
+	ftuo.clearpriority = true
+	return ftuo
+}
+
+// SetRole sets the role field.
+func (ftuo *FieldTypeUpdateOne) SetRole(s schema.Role) *FieldTypeUpdateOne {
+	ftuo.role = &s
+	return ftuo
+}
+
+// SetNillableRole sets the role field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillableRole(s *schema.Role) *FieldTypeUpdateOne {
+	if s != nil {
+		ftuo.SetRole(*s)
+	}
+	return ftuo
+}
+
+// ClearRole clears the value of role.
+func (ftuo *FieldTypeUpdateOne) ClearRole() *FieldTypeUpdateOne {
+	ftuo.role = nil
+	ftuo.clearrole = true
+	return ftuo
+}
+
+// SetNullableInt sets the nullable_int field.
+func (ftuo *FieldTypeUpdateOne) SetNullableInt(i int) *FieldTypeUpdateOne {
+	ftuo.nullable_int = &i
+	ftuo.addnullable_int = nil
+	return ftuo
+}
+
+// SetNillableNullableInt sets the nullable_int field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillableNullableInt(i *int) *FieldTypeUpdateOne {
+	if i != nil {
+		ftuo.SetNullableInt(*i)
+	}
+	return ftuo
+}
+
+// SetNullableIntOrNull sets the nullable_int field to the given value, or to NULL if it's nil.
+func (ftuo *FieldTypeUpdateOne) SetNullableIntOrNull(i *int) *FieldTypeUpdateOne {
+	if i != nil {
+		ftuo.clearnullable_int = false
+		return ftuo.SetNullableInt(*i)
+	}
+	ftuo.nullable_int = nil
+	ftuo.addnullable_int = nil
+	ftuo.clearnullable_int = true
+	return ftuo
+}
+
+// AddNullableInt adds i to nullable_int.
+func (ftuo *FieldTypeUpdateOne) AddNullableInt(i int) *FieldTypeUpdateOne {
+	if ftuo.addnullable_int == nil {
+		ftuo.addnullable_int = &i
+	} else {
+		*ftuo.addnullable_int += i
+	}
+	return ftuo
+}
+
+// ClearNullableInt clears the value of nullable_int.
+func (ftuo *FieldTypeUpdateOne) ClearNullableInt() *FieldTypeUpdateOne {
+	ftuo.nullable_int = nil
+	ftuo.clearnullable_int = true
+	return ftuo
+}
+
+// SetNullableString sets the nullable_string field.
+func (ftuo *FieldTypeUpdateOne) SetNullableString(s string) *FieldTypeUpdateOne {
+	ftuo.nullable_string = &s
+	return ftuo
+}
+
+// SetNillableNullableString sets the nullable_string field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillableNullableString(s *string) *FieldTypeUpdateOne {
+	if s != nil {
+		ftuo.SetNullableString(*s)
+	}
+	return ftuo
+}
+
+// SetNullableStringOrNull sets the nullable_string field to the given value, or to NULL if it's nil.
+func (ftuo *FieldTypeUpdateOne) SetNullableStringOrNull(s *string) *FieldTypeUpdateOne {
+	if s != nil {
+		ftuo.clearnullable_string = false
+		return ftuo.SetNullableString(*s)
+	}
+	ftuo.nullable_string = nil
+	ftuo.clearnullable_string = true
+	return ftuo
+}
+
+// ClearNullableString clears the value of nullable_string.
+func (ftuo *FieldTypeUpdateOne) ClearNullableString() *FieldTypeUpdateOne {
+	ftuo.nullable_string = nil
+	ftuo.clearnullable_string = true
+	return ftuo
+}
+
+// SetUUID sets the uuid field.
+func (ftuo *FieldTypeUpdateOne) SetUUID(u uuid.UUID) *FieldTypeUpdateOne {
+	ftuo.uuid = &u
+	return ftuo
+}
+
+// SetNillableUUID sets the uuid field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillableUUID(u *uuid.UUID) *FieldTypeUpdateOne {
+	if u != nil {
+		ftuo.SetUUID(*u)
+	}
+	return ftuo
+}
+
+// ClearUUID clears the value of uuid.
+func (ftuo *FieldTypeUpdateOne) ClearUUID() *FieldTypeUpdateOne {
+	ftuo.uuid = nil
+	ftuo.clearuuid = true
+	return ftuo
+}
+
+// SetIP sets the ip field.
+func (ftuo *FieldTypeUpdateOne) SetIP(n net.IP) *FieldTypeUpdateOne {
+	ftuo.ip = &n
+	return ftuo
+}
+
+// ClearIP clears the value of ip.
+func (ftuo *FieldTypeUpdateOne) ClearIP() *FieldTypeUpdateOne {
+	ftuo.ip = nil
+	ftuo.clearip = true
+	return ftuo
+}
+
+// SetMac sets the mac field.
+func (ftuo *FieldTypeUpdateOne) SetMac(na net.HardwareAddr) *FieldTypeUpdateOne {
+	ftuo.mac = &na
+	return ftuo
+}
+
+// ClearMac clears the value of mac.
+func (ftuo *FieldTypeUpdateOne) ClearMac() *FieldTypeUpdateOne {
+	ftuo.mac = nil
+	ftuo.clearmac = true
+	return ftuo
+}
+
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (ftuo *FieldTypeUpdateOne) SetChangedFrom(original *FieldType) *FieldTypeUpdateOne {
+	ft := ftuo.entity
+	if ft == nil || original == nil {
+		return ftuo
+	}
+	for _, change := range DiffFieldType(original, ft) {
+		switch change.Field {
+		case fieldtype.FieldInt:
+			ftuo.SetInt(ft.Int)
+		case fieldtype.FieldInt8:
+			ftuo.SetInt8(ft.Int8)
+		case fieldtype.FieldInt16:
+			ftuo.SetInt16(ft.Int16)
+		case fieldtype.FieldInt32:
+			ftuo.SetInt32(ft.Int32)
+		case fieldtype.FieldInt64:
+			ftuo.SetInt64(ft.Int64)
+		case fieldtype.FieldOptionalInt:
+			ftuo.SetOptionalInt(ft.OptionalInt)
+		case fieldtype.FieldOptionalInt8:
+			ftuo.SetOptionalInt8(ft.OptionalInt8)
+		case fieldtype.FieldOptionalInt16:
+			ftuo.SetOptionalInt16(ft.OptionalInt16)
+		case fieldtype.FieldOptionalInt32:
+			ftuo.SetOptionalInt32(ft.OptionalInt32)
+		case fieldtype.FieldOptionalInt64:
+			ftuo.SetOptionalInt64(ft.OptionalInt64)
+		case fieldtype.FieldNillableInt:
+			if ft.NillableInt == nil {
+				ftuo.ClearNillableInt()
+			} else {
+				ftuo.SetNillableInt(*ft.NillableInt)
+			}
+		case fieldtype.FieldNillableInt8:
+			if ft.NillableInt8 == nil {
+				ftuo.ClearNillableInt8()
+			} else {
+				ftuo.SetNillableInt8(*ft.NillableInt8)
+			}
+		case fieldtype.FieldNillableInt16:
+			if ft.NillableInt16 == nil {
+				ftuo.ClearNillableInt16()
+			} else {
+				ftuo.SetNillableInt16(*ft.NillableInt16)
+			}
+		case fieldtype.FieldNillableInt32:
+			if ft.NillableInt32 == nil {
+				ftuo.ClearNillableInt32()
+			} else {
+				ftuo.SetNillableInt32(*ft.NillableInt32)
+			}
+		case fieldtype.FieldNillableInt64:
+			if ft.NillableInt64 == nil {
+				ftuo.ClearNillableInt64()
+			} else {
+				ftuo.SetNillableInt64(*ft.NillableInt64)
+			}
+		case fieldtype.FieldValidateOptionalInt32:
+			ftuo.SetValidateOptionalInt32(ft.ValidateOptionalInt32)
+		case fieldtype.FieldState:
+			ftuo.SetState(ft.State)
+		case fieldtype.FieldLink:
+			ftuo.SetLink(ft.Link)
+		case fieldtype.FieldNullLink:
+			if ft.NullLink == nil {
+				ftuo.ClearNullLink()
+			} else {
+				ftuo.SetNullLink(*ft.NullLink)
+			}
+		case fieldtype.FieldPriority:
+			ftuo.SetPriority(ft.Priority)
+		case fieldtype.FieldRole:
+			ftuo.SetRole(ft.Role)
+		case fieldtype.FieldNullableInt:
+			ftuo.SetNullableInt(ft.NullableInt)
+		case fieldtype.FieldNullableString:
+			ftuo.SetNullableString(ft.NullableString)
+		case fieldtype.FieldUUID:
+			ftuo.SetUUID(ft.UUID)
+		case fieldtype.FieldIP:
+			ftuo.SetIP(ft.IP)
+		case fieldtype.FieldMac:
+			if ft.Mac == nil {
+				ftuo.ClearMac()
+			} else {
+				ftuo.SetMac(*ft.Mac)
+			}
+		}
+	}
+	return ftuo
+}
+
 // Save executes the query and returns the updated entity.
-func (ftuo *FieldTypeUpdateOne) Save(ctx context.Context) (*FieldType, error) {
+func (ftuo *FieldTypeUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*FieldType, error) {
+	options := ent.NewCallOptions(opts...)
 	if ftuo.validate_optional_int32 != nil {
 		if err := fieldtype.ValidateOptionalInt32Validator(*ftuo.validate_optional_int32); err != nil {
 			return nil, fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)
@@ -1443,6 +2116,49 @@ func (ftuo *FieldTypeUpdateOne) Save(ctx context.Context) (*FieldType, error) {
 			return nil, fmt.Errorf("ent: validator failed for field \"state\": %v", err)
 		}
 	}
+	if ftuo.role != nil {
+		if err := fieldtype.RoleValidator(*ftuo.role); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"role\": %v", err)
+		}
+	}
+	if ftuo.ip != nil {
+		if err := fieldtype.IPValidator(*ftuo.ip); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"ip\": %v", err)
+		}
+	}
+	if ftuo.mac != nil {
+		if err := fieldtype.MacValidator(*ftuo.mac); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"mac\": %v", err)
+		}
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if ftuo.shadow != nil {
+		return ftuo.dualSave(ctx, opts...)
+	}
+	ft, err := ftuo.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		ftuo.bus.publish(&Event{Op: ent.OpUpdateOne, Type: fieldtype.Label, ID: ft.ID, Node: ft, N: 1})
+	}
+	return ft, nil
+}
+
+// save updates the FieldType in the storage of the client dialect.
+func (ftuo *FieldTypeUpdateOne) save(ctx context.Context) (*FieldType, error) {
+	predicates, err := interceptFieldType(ctx, ftuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *ftuo
+		intercepted.predicates = append(predicates, ftuo.predicates...)
+		ftuo = &intercepted
+	}
 	switch ftuo.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ftuo.sqlSave(ctx)
@@ -1454,8 +2170,8 @@ func (ftuo *FieldTypeUpdateOne) Save(ctx context.Context) (*FieldType, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (ftuo *FieldTypeUpdateOne) SaveX(ctx context.Context) *FieldType {
-	ft, err := ftuo.Save(ctx)
+func (ftuo *FieldTypeUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *FieldType {
+	ft, err := ftuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -1463,21 +2179,29 @@ func (ftuo *FieldTypeUpdateOne) SaveX(ctx context.Context) *FieldType {
 }
 
 // Exec executes the query on the entity.
-func (ftuo *FieldTypeUpdateOne) Exec(ctx context.Context) error {
-	_, err := ftuo.Save(ctx)
+func (ftuo *FieldTypeUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := ftuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ftuo *FieldTypeUpdateOne) ExecX(ctx context.Context) {
-	if err := ftuo.Exec(ctx); err != nil {
+func (ftuo *FieldTypeUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := ftuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (ft *FieldType, err error) {
-	selector := sql.Select(fieldtype.Columns...).From(sql.Table(fieldtype.Table))
+	ctx = entcache.Invalidates(ctx, fieldtype.Tables...)
+	selector := sql.Select(fieldtype.Columns...).From(sql.Table(fieldtype.Table)).SetDialect(ftuo.driver.Dialect())
 	fieldtype.ID(ftuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range ftuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = ftuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -1716,6 +2440,93 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (ft *FieldType, err
 		ft.State = value
 		builder.SetNull(fieldtype.FieldState)
 	}
+	if value := ftuo.link; value != nil {
+		builder.Set(fieldtype.FieldLink, *value)
+		ft.Link = *value
+	}
+	if ftuo.clearlink {
+		var value schema.Link
+		ft.Link = value
+		builder.SetNull(fieldtype.FieldLink)
+	}
+	if value := ftuo.null_link; value != nil {
+		builder.Set(fieldtype.FieldNullLink, *value)
+		ft.NullLink = value
+	}
+	if ftuo.clearnull_link {
+		ft.NullLink = nil
+		builder.SetNull(fieldtype.FieldNullLink)
+	}
+	if value := ftuo.priority; value != nil {
+		builder.Set(fieldtype.FieldPriority, *value)
+		ft.Priority = *value
+	}
+	if ftuo.clearpriority {
+		var value schema.Priority
+		ft.Priority = value
+		builder.SetNull(fieldtype.FieldPriority)
+	}
+	if value := ftuo.role; value != nil {
+		builder.Set(fieldtype.FieldRole, *value)
+		ft.Role = *value
+	}
+	if ftuo.clearrole {
+		var value schema.Role
+		ft.Role = value
+		builder.SetNull(fieldtype.FieldRole)
+	}
+	if value := ftuo.nullable_int; value != nil {
+		builder.Set(fieldtype.FieldNullableInt, *value)
+		ft.NullableInt = *value
+		ft.nullNullableInt = false
+	}
+	if value := ftuo.addnullable_int; value != nil {
+		builder.Add(fieldtype.FieldNullableInt, *value)
+		ft.NullableInt += *value
+	}
+	if ftuo.clearnullable_int {
+		var value int
+		ft.NullableInt = value
+		ft.nullNullableInt = true
+		builder.SetNull(fieldtype.FieldNullableInt)
+	}
+	if value := ftuo.nullable_string; value != nil {
+		builder.Set(fieldtype.FieldNullableString, *value)
+		ft.NullableString = *value
+		ft.nullNullableString = false
+	}
+	if ftuo.clearnullable_string {
+		var value string
+		ft.NullableString = value
+		ft.nullNullableString = true
+		builder.SetNull(fieldtype.FieldNullableString)
+	}
+	if value := ftuo.uuid; value != nil {
+		builder.Set(fieldtype.FieldUUID, (*value)[:])
+		ft.UUID = *value
+	}
+	if ftuo.clearuuid {
+		var value uuid.UUID
+		ft.UUID = value
+		builder.SetNull(fieldtype.FieldUUID)
+	}
+	if value := ftuo.ip; value != nil {
+		builder.Set(fieldtype.FieldIP, sql.NullString{String: (*value).String(), Valid: (*value) != nil})
+		ft.IP = *value
+	}
+	if ftuo.clearip {
+		var value net.IP
+		ft.IP = value
+		builder.SetNull(fieldtype.FieldIP)
+	}
+	if value := ftuo.mac; value != nil {
+		builder.Set(fieldtype.FieldMac, sql.NullString{String: (*value).String(), Valid: (*value) != nil})
+		ft.Mac = value
+	}
+	if ftuo.clearmac {
+		ft.Mac = nil
+		builder.SetNull(fieldtype.FieldMac)
+	}
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
@@ -1730,8 +2541,9 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (ft *FieldType, err
 
 func (ftuo *FieldTypeUpdateOne) gremlinSave(ctx context.Context) (*FieldType, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftuo.gremlin(ftuo.id).Query()
-	if err := ftuo.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftuo.gremlin(ftuo.id)
+	query, _ := traversal.Query()
+	if err := ftuo.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -1746,6 +2558,9 @@ func (ftuo *FieldTypeUpdateOne) gremlinSave(ctx context.Context) (*FieldType, er
 
 func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	v := g.V(id)
+	for _, p := range ftuo.predicates {
+		p(v)
+	}
 	var (
 		trs []*dsl.Traversal
 	)
@@ -1848,6 +2663,36 @@ func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value := ftuo.state; value != nil {
 		v.Property(dsl.Single, fieldtype.FieldState, *value)
 	}
+	if value := ftuo.link; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldLink, *value)
+	}
+	if value := ftuo.null_link; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldNullLink, *value)
+	}
+	if value := ftuo.priority; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldPriority, *value)
+	}
+	if value := ftuo.role; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldRole, *value)
+	}
+	if value := ftuo.nullable_int; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldNullableInt, *value)
+	}
+	if value := ftuo.addnullable_int; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldNullableInt, __.Union(__.Values(fieldtype.FieldNullableInt), __.Constant(*value)).Sum())
+	}
+	if value := ftuo.nullable_string; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldNullableString, *value)
+	}
+	if value := ftuo.uuid; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldUUID, (*value).String())
+	}
+	if value := ftuo.ip; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldIP, (*value).String())
+	}
+	if value := ftuo.mac; value != nil {
+		v.Property(dsl.Single, fieldtype.FieldMac, (*value).String())
+	}
 	var properties []interface{}
 	if ftuo.clearoptional_int {
 		properties = append(properties, fieldtype.FieldOptionalInt)
@@ -1885,6 +2730,33 @@ func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if ftuo.clearstate {
 		properties = append(properties, fieldtype.FieldState)
 	}
+	if ftuo.clearlink {
+		properties = append(properties, fieldtype.FieldLink)
+	}
+	if ftuo.clearnull_link {
+		properties = append(properties, fieldtype.FieldNullLink)
+	}
+	if ftuo.clearpriority {
+		properties = append(properties, fieldtype.FieldPriority)
+	}
+	if ftuo.clearrole {
+		properties = append(properties, fieldtype.FieldRole)
+	}
+	if ftuo.clearnullable_int {
+		properties = append(properties, fieldtype.FieldNullableInt)
+	}
+	if ftuo.clearnullable_string {
+		properties = append(properties, fieldtype.FieldNullableString)
+	}
+	if ftuo.clearuuid {
+		properties = append(properties, fieldtype.FieldUUID)
+	}
+	if ftuo.clearip {
+		properties = append(properties, fieldtype.FieldIP)
+	}
+	if ftuo.clearmac {
+		properties = append(properties, fieldtype.FieldMac)
+	}
 	if len(properties) > 0 {
 		v.SideEffect(__.Properties(properties...).Drop())
 	}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/ent/file"
 )
 
 // File is the model entity for the File schema.
@@ -28,10 +29,27 @@ type File struct {
 	User *string `json:"user,omitempty"`
 	// Group holds the value of the "group" field.
 	Group string `json:"group,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the FileQuery when eager-loading is set.
+	Edges FileEdges `json:"edges"`
+}
+
+// FileEdges holds the relations/edges of other nodes in the graph.
+type FileEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Type holds the value of the type edge.
+	Type *FileType `json:"type,omitempty"`
 }
 
 // FromRows scans the sql response data into File.
 func (f *File) FromRows(rows *sql.Rows) error {
+	return f.scan(rows, file.Columns)
+}
+
+// scan scans the given columns of the sql response data into File.
+// Fields that their columns were not selected are left with their zero values.
+func (f *File) scan(rows *sql.Rows, columns []string) error {
 	var vf struct {
 		ID    int
 		Size  sql.NullInt64
@@ -39,14 +57,24 @@ func (f *File) FromRows(rows *sql.Rows) error {
 		User  sql.NullString
 		Group sql.NullString
 	}
-	// the order here should be the same as in the `file.Columns`.
-	if err := rows.Scan(
-		&vf.ID,
-		&vf.Size,
-		&vf.Name,
-		&vf.User,
-		&vf.Group,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case file.FieldID:
+			values[i] = &vf.ID
+		case file.FieldSize:
+			values[i] = &vf.Size
+		case file.FieldName:
+			values[i] = &vf.Name
+		case file.FieldUser:
+			values[i] = &vf.User
+		case file.FieldGroup:
+			values[i] = &vf.Group
+		default:
+			return fmt.Errorf("unexpected column %q for type File", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	f.ID = strconv.Itoa(vf.ID)
@@ -112,6 +140,35 @@ func (f *File) Unwrap() *File {
 	return f
 }
 
+// Clone returns a deep copy of the File, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (f *File) Clone() *File {
+	return f.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the File, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (f *File) clone(seen map[interface{}]interface{}) *File {
+	if f == nil {
+		return nil
+	}
+	if _c, ok := seen[f]; ok {
+		return _c.(*File)
+	}
+	_c := *f
+	_c.config = config{}
+	seen[f] = &_c
+	if v := f.User; v != nil {
+		_v := *v
+		_c.User = &_v
+	}
+	_c.Edges.Owner = f.Edges.Owner.clone(seen)
+	_c.Edges.Type = f.Edges.Type.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (f *File) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -127,6 +184,26 @@ func (f *File) String() string {
 	return buf.String()
 }
 
+// DiffFile returns the fields that have different values in the given Files,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffFile(a, b *File) []FieldChange {
+	var changes []FieldChange
+	if a.Size != b.Size {
+		changes = append(changes, FieldChange{Field: file.FieldSize, Old: a.Size, New: b.Size})
+	}
+	if a.Name != b.Name {
+		changes = append(changes, FieldChange{Field: file.FieldName, Old: a.Name, New: b.Name})
+	}
+	if (a.User == nil) != (b.User == nil) || a.User != nil && (*a.User) != (*b.User) {
+		changes = append(changes, FieldChange{Field: file.FieldUser, Old: a.User, New: b.User})
+	}
+	if a.Group != b.Group {
+		changes = append(changes, FieldChange{Field: file.FieldGroup, Old: a.Group, New: b.Group})
+	}
+	return changes
+}
+
 // id returns the int representation of the ID field.
 func (f *File) id() int {
 	id, _ := strconv.Atoi(f.ID)
@@ -138,9 +215,14 @@ type Files []*File
 
 // FromRows scans the sql response data into Files.
 func (f *Files) FromRows(rows *sql.Rows) error {
+	return f.scan(rows, file.Columns)
+}
+
+// scan scans the given columns of the sql response data into Files.
+func (f *Files) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vf := &File{}
-		if err := vf.FromRows(rows); err != nil {
+		if err := vf.scan(rows, columns); err != nil {
 			return err
 		}
 		*f = append(*f, vf)
@@ -7,7 +7,12 @@
 package file
 
 import (
+	"fmt"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
 )
 
 const (
@@ -39,7 +44,7 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "filetype" package.
 	TypeInverseTable = "file_types"
 	// TypeColumn is the table column denoting the type relation/edge.
-	TypeColumn = "type_id"
+	TypeColumn = "file_type_id"
 
 	// OwnerInverseLabel holds the string label denoting the owner inverse edge type in the database.
 	OwnerInverseLabel = "user_files"
@@ -56,6 +61,22 @@ var Columns = []string{
 	FieldGroup,
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Tables holds the tables that may be changed by the mutations of the file. The mutations
+// invalidate only the query results of these tables that were cached by the entcache driver.
+var Tables = []string{
+	"files",
+}
+
 var (
 	fields = schema.File{}.Fields()
 
@@ -66,3 +87,91 @@ var (
 	// SizeValidator is a validator for the "size" field. It is called by the builders before save.
 	SizeValidator = descSize.Validators[0].(func(int) error)
 )
+
+// Scope is a reusable named scope of the File queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.File
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the File type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "File",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeString,
+		GoType:     "string",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "size",
+			StorageKey: FieldSize,
+			Type:       field.TypeInt,
+			GoType:     "int",
+			Default:    true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(int)
+				if !ok {
+					return fmt.Errorf("file: unexpected type %T for field size", v)
+				}
+				return SizeValidator(vv)
+			},
+		},
+		{
+			Name:       "name",
+			StorageKey: FieldName,
+			Type:       field.TypeString,
+			GoType:     "string",
+		},
+		{
+			Name:       "user",
+			StorageKey: FieldUser,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+			Nillable:   true,
+		},
+		{
+			Name:       "group",
+			StorageKey: FieldGroup,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "owner",
+			Type:     "User",
+			Rel:      "M2O",
+			Unique:   true,
+			Optional: true,
+			Inverse:  true,
+			Table:    "files",
+			Columns:  []string{"owner_id"},
+		},
+		{
+			Name:     "type",
+			Type:     "FileType",
+			Rel:      "M2O",
+			Unique:   true,
+			Optional: true,
+			Inverse:  true,
+			Table:    "files",
+			Columns:  []string{"file_type_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the File type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
@@ -7,8 +7,13 @@
 package file
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
@@ -475,6 +480,30 @@ func NameHasSuffix(v string) predicate.File {
 	)
 }
 
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.File {
+	return predicate.FilePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldName), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldName, p.EqualFold(v))
+		},
+	)
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.File {
+	return predicate.FilePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldName), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldName, p.ContainsFold(v))
+		},
+	)
+}
+
 // UserEQ applies the EQ predicate on the "user" field.
 func UserEQ(v string) predicate.File {
 	return predicate.FilePerDialect(
@@ -651,6 +680,30 @@ func UserNotNil() predicate.File {
 	)
 }
 
+// UserEqualFold applies the EqualFold predicate on the "user" field.
+func UserEqualFold(v string) predicate.File {
+	return predicate.FilePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldUser), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUser, p.EqualFold(v))
+		},
+	)
+}
+
+// UserContainsFold applies the ContainsFold predicate on the "user" field.
+func UserContainsFold(v string) predicate.File {
+	return predicate.FilePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldUser), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUser, p.ContainsFold(v))
+		},
+	)
+}
+
 // GroupEQ applies the EQ predicate on the "group" field.
 func GroupEQ(v string) predicate.File {
 	return predicate.FilePerDialect(
@@ -827,6 +880,76 @@ func GroupNotNil() predicate.File {
 	)
 }
 
+// GroupEqualFold applies the EqualFold predicate on the "group" field.
+func GroupEqualFold(v string) predicate.File {
+	return predicate.FilePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldGroup), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldGroup, p.EqualFold(v))
+		},
+	)
+}
+
+// GroupContainsFold applies the ContainsFold predicate on the "group" field.
+func GroupContainsFold(v string) predicate.File {
+	return predicate.FilePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldGroup), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldGroup, p.ContainsFold(v))
+		},
+	)
+}
+
+// NameUser is the composite key of the unique index on the "name", "user" fields.
+type NameUser struct {
+	Name string
+	User string
+}
+
+// NameUserIn applies the In predicate on the composite key of the "name", "user" fields.
+// It's used for batch lookups of entities by their composite key in a single query.
+func NameUserIn(vs ...NameUser) predicate.File {
+	return predicate.FilePerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			// row-value lists are supported only by MySQL, and the tuples
+			// are grouped with the OR operator for the rest of the dialects.
+			if s.Dialect() != dialect.MySQL {
+				preds := make([]*sql.Predicate, len(vs))
+				for i := range vs {
+					preds[i] = sql.And(
+						sql.EQ(s.C(FieldName), vs[i].Name),
+						sql.EQ(s.C(FieldUser), vs[i].User),
+					)
+				}
+				s.Where(sql.Or(preds...))
+				return
+			}
+			tuples := make([][]interface{}, len(vs))
+			for i := range vs {
+				tuples[i] = []interface{}{vs[i].Name, vs[i].User}
+			}
+			s.Where(sql.InTuple([]string{s.C(FieldName), s.C(FieldUser)}, tuples...))
+		},
+		func(t *dsl.Traversal) {
+			trs := make([]interface{}, len(vs))
+			for i := range vs {
+				trs[i] = __.Has(Label, FieldName, p.EQ(vs[i].Name)).Has(Label, FieldUser, p.EQ(vs[i].User))
+			}
+			t.Where(__.Or(trs...))
+		},
+	)
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.File {
 	return predicate.FilePerDialect(
@@ -840,15 +963,31 @@ func HasOwner() predicate.File {
 	)
 }
 
+// HasNoOwner applies a predicate that checks that the "owner" edge is empty. It's identical
+// to Not(HasOwner()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the File nodes.
+func HasNoOwner() predicate.File {
+	return predicate.FilePerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(sql.IsNull(t1.C(OwnerColumn)))
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.InE(OwnerInverseLabel))
+		},
+	)
+}
+
 // HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
 func HasOwnerWith(preds ...predicate.User) predicate.File {
 	return predicate.FilePerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(FieldID).From(sql.Table(OwnerInverseTable))
+			t2 := sql.Select(FieldID).From(sql.Table(OwnerInverseTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(OwnerColumn), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -874,15 +1013,31 @@ func HasType() predicate.File {
 	)
 }
 
+// HasNoType applies a predicate that checks that the "type" edge is empty. It's identical
+// to Not(HasType()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the File nodes.
+func HasNoType() predicate.File {
+	return predicate.FilePerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(sql.IsNull(t1.C(TypeColumn)))
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.InE(TypeInverseLabel))
+		},
+	)
+}
+
 // HasTypeWith applies the HasEdge predicate on the "type" edge with a given conditions (other predicates).
 func HasTypeWith(preds ...predicate.FileType) predicate.File {
 	return predicate.FilePerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(FieldID).From(sql.Table(TypeInverseTable))
+			t2 := sql.Select(FieldID).From(sql.Table(TypeInverseTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(TypeColumn), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -895,6 +1050,317 @@ func HasTypeWith(preds ...predicate.FileType) predicate.File {
 	)
 }
 
+// PredicateFunc returns a predicate from the given per-dialect functions.
+// It allows adding custom SQL/Gremlin fragments to the File builders.
+func PredicateFunc(f0 func(*sql.Selector), f1 func(*dsl.Traversal)) predicate.File {
+	return predicate.FilePerDialect(f0, f1)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.File, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.File, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.File, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldSize:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return SizeEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return SizeNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return SizeIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return SizeNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return SizeGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return SizeGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return SizeLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return SizeLTE(v), nil
+			}
+		}
+	case FieldName:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasSuffix(v), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContainsFold(v), nil
+			}
+		}
+	case FieldUser:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UserEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UserNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return UserIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return UserNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UserGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UserGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UserLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UserLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UserContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UserHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UserHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return UserIsNil(), nil
+				}
+				return Not(UserIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return UserNotNil(), nil
+				}
+				return Not(UserNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UserEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UserContainsFold(v), nil
+			}
+		}
+	case FieldGroup:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return GroupEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return GroupNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return GroupIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return GroupNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return GroupGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return GroupGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return GroupLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return GroupLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return GroupContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return GroupHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return GroupHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return GroupIsNil(), nil
+				}
+				return Not(GroupIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return GroupNotNil(), nil
+				}
+				return Not(GroupNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return GroupEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return GroupContainsFold(v), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("file: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.File) predicate.File {
 	return predicate.FilePerDialect(
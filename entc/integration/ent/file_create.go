@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -20,17 +21,20 @@ import (
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
 	"github.com/facebookincubator/ent/entc/integration/ent/filetype"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // FileCreate is the builder for creating a File entity.
 type FileCreate struct {
 	config
-	size  *int
-	name  *string
-	user  *string
-	group *string
-	owner map[string]struct{}
-	_type map[string]struct{}
+	fileMutation
+	// id of the node, if it's mirrored from the primary storage.
+	id *string
+}
+
+// Mutation returns the mutation of the builder.
+func (fc *FileCreate) Mutation() *FileMutation {
+	return &FileMutation{fileMutation: &fc.fileMutation, op: ent.OpCreate}
 }
 
 // SetSize sets the size field.
@@ -126,7 +130,8 @@ func (fc *FileCreate) SetType(f *FileType) *FileCreate {
 }
 
 // Save creates the File in the database.
-func (fc *FileCreate) Save(ctx context.Context) (*File, error) {
+func (fc *FileCreate) Save(ctx context.Context, opts ...ent.CallOption) (*File, error) {
+	options := ent.NewCallOptions(opts...)
 	if fc.size == nil {
 		v := file.DefaultSize
 		fc.size = &v
@@ -143,6 +148,24 @@ func (fc *FileCreate) Save(ctx context.Context) (*File, error) {
 	if len(fc._type) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"type\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if fc.shadow != nil {
+		return fc.dualSave(ctx, opts...)
+	}
+	v, err := fc.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		fc.bus.publish(&Event{Op: ent.OpCreate, Type: file.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save creates the File in the storage of the client dialect.
+func (fc *FileCreate) save(ctx context.Context) (*File, error) {
 	switch fc.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return fc.sqlSave(ctx)
@@ -154,8 +177,8 @@ func (fc *FileCreate) Save(ctx context.Context) (*File, error) {
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (fc *FileCreate) SaveX(ctx context.Context) *File {
-	v, err := fc.Save(ctx)
+func (fc *FileCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *File {
+	v, err := fc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -163,6 +186,7 @@ func (fc *FileCreate) SaveX(ctx context.Context) *File {
 }
 
 func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
+	ctx = entcache.Invalidates(ctx, file.Tables...)
 	var (
 		res sql.Result
 		f   = &File{config: fc.config}
@@ -172,6 +196,13 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 		return nil, err
 	}
 	builder := sql.Insert(file.Table).Default(fc.driver.Dialect())
+	if id := fc.id; id != nil {
+		id, err := strconv.Atoi(*id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(file.FieldID, id)
+	}
 	if value := fc.size; value != nil {
 		builder.Set(file.FieldSize, *value)
 		f.Size = *value
@@ -188,6 +219,30 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 		builder.Set(file.FieldGroup, *value)
 		f.Group = *value
 	}
+	if len(fc.owner) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "owner", fc.owner); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	for eid := range fc.owner {
+		eid, err := strconv.Atoi(eid)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(file.OwnerColumn, eid)
+	}
+	if len(fc._type) > 0 {
+		if err := checkRefs(ctx, tx, filetype.Table, filetype.FieldID, "type", fc._type); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	for eid := range fc._type {
+		eid, err := strconv.Atoi(eid)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(file.TypeColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -197,36 +252,6 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 		return nil, rollback(tx, err)
 	}
 	f.ID = strconv.FormatInt(id, 10)
-	if len(fc.owner) > 0 {
-		for eid := range fc.owner {
-			eid, err := strconv.Atoi(eid)
-			if err != nil {
-				return nil, rollback(tx, err)
-			}
-			query, args := sql.Update(file.OwnerTable).
-				Set(file.OwnerColumn, eid).
-				Where(sql.EQ(file.FieldID, id)).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
-		}
-	}
-	if len(fc._type) > 0 {
-		for eid := range fc._type {
-			eid, err := strconv.Atoi(eid)
-			if err != nil {
-				return nil, rollback(tx, err)
-			}
-			query, args := sql.Update(file.TypeTable).
-				Set(file.TypeColumn, eid).
-				Where(sql.EQ(file.FieldID, id)).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
-		}
-	}
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
@@ -235,8 +260,9 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 
 func (fc *FileCreate) gremlinSave(ctx context.Context) (*File, error) {
 	res := &gremlin.Response{}
-	query, bindings := fc.gremlin().Query()
-	if err := fc.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := fc.gremlin()
+	query, _ := traversal.Query()
+	if err := fc.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
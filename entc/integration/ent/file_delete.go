@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -18,12 +19,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // FileDelete is the builder for deleting a File entity.
 type FileDelete struct {
 	config
 	predicates []predicate.File
+	// id of the node, if it's deleted using a FileDeleteOne builder.
+	id *string
 }
 
 // Where adds a new predicate to the delete builder.
@@ -33,7 +37,37 @@ func (fd *FileDelete) Where(ps ...predicate.File) *FileDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (fd *FileDelete) Exec(ctx context.Context) (int, error) {
+func (fd *FileDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	if fd.shadow != nil {
+		return fd.dualExec(ctx, opts...)
+	}
+	n, err := fd.exec(ctx)
+	if err != nil || n == 0 || options.SkipHooks {
+		return n, err
+	}
+	e := &Event{Op: ent.OpDelete, Type: file.Label, N: n}
+	if fd.id != nil {
+		e.Op, e.ID = ent.OpDeleteOne, *fd.id
+	}
+	fd.bus.publish(e)
+	return n, nil
+}
+
+// exec deletes the File nodes from the storage of the client dialect.
+func (fd *FileDelete) exec(ctx context.Context) (int, error) {
+	predicates, err := interceptFile(ctx, fd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *fd
+		intercepted.predicates = append(predicates, fd.predicates...)
+		fd = &intercepted
+	}
 	switch fd.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return fd.sqlExec(ctx)
@@ -45,8 +79,8 @@ func (fd *FileDelete) Exec(ctx context.Context) (int, error) {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (fd *FileDelete) ExecX(ctx context.Context) int {
-	n, err := fd.Exec(ctx)
+func (fd *FileDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := fd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,11 +88,15 @@ func (fd *FileDelete) ExecX(ctx context.Context) int {
 }
 
 func (fd *FileDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = entcache.Invalidates(ctx, file.Tables...)
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(file.Table))
+	selector := sql.Select().From(sql.Table(file.Table)).SetDialect(fd.driver.Dialect()).WithContext(ctx)
 	for _, p := range fd.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(file.Table).FromSelect(selector).Query()
 	if err := fd.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -72,8 +110,9 @@ func (fd *FileDelete) sqlExec(ctx context.Context) (int, error) {
 
 func (fd *FileDelete) gremlinExec(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := fd.gremlin().Query()
-	if err := fd.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := fd.gremlin()
+	query, _ := traversal.Query()
+	if err := fd.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -93,12 +132,12 @@ type FileDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (fdo *FileDeleteOne) Exec(ctx context.Context) error {
-	n, err := fdo.fd.Exec(ctx)
+func (fdo *FileDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := fdo.fd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{file.Label}
 	default:
 		return nil
@@ -106,6 +145,8 @@ func (fdo *FileDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (fdo *FileDeleteOne) ExecX(ctx context.Context) {
-	fdo.fd.ExecX(ctx)
+func (fdo *FileDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := fdo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -27,14 +29,27 @@ import (
 // FileQuery is the builder for querying File entities.
 type FileQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit     *int
+	offset    *int
+	order     []Order
+	unique    []string
+	fields    []string
+	unordered bool
+	hints     []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.File
+	// eager-loading edges.
+	withOwner *UserQuery
+	withType  *FileTypeQuery
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath     func(context.Context) (*sql.Selector, error)
+	gremlinPath func(context.Context) (*dsl.Traversal, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -61,21 +76,135 @@ func (fq *FileQuery) Order(o ...Order) *FileQuery {
 	return fq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored. Note that, hints are ignored by Gremlin queries.
+func (fq *FileQuery) Hint(hints ...sql.Hint) *FileQuery {
+	fq.hints = append(fq.hints, hints...)
+	return fq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	fs, err := client.File.Query().
+//		Fields(file.FieldSize, file.FieldName).
+//		All(ctx)
+//
+func (fq *FileQuery) Fields(fields ...string) *FileQuery {
+	fq.fields = append(fq.fields, fields...)
+	return fq
+}
+
+// Unordered disables the ordering by id that is added to the Gremlin queries that are paginated
+// using Limit or Offset. The order of the vertices
+// (and therefore, the content of the pages) is not guaranteed without it, but it can be removed when
+// the order is irrelevant (e.g. sampling).
+func (fq *FileQuery) Unordered() *FileQuery {
+	fq.unordered = true
+	return fq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (fq *FileQuery) Scope(scopes ...file.Scope) *FileQuery {
+	for _, s := range scopes {
+		fq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				fq.Order(Desc(o.Field))
+			} else {
+				fq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			fq.Limit(s.Limit)
+		}
+	}
+	return fq
+}
+
+// FileInterceptor is a query interceptor of the FileClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the FileClient.Intercept method.
+type FileInterceptor func(context.Context, *FileQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (fq *FileQuery) prepare(ctx context.Context) (*FileQuery, error) {
+	var interceptors []FileInterceptor
+	if fq.inters != nil {
+		fq.inters.mu.RLock()
+		interceptors = fq.inters.File
+		fq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && fq.sqlPath == nil && fq.gremlinPath == nil {
+		return fq, nil
+	}
+	query := fq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	if path := query.gremlinPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.gremlin, query.gremlinPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptFile returns the predicates that the interceptors of the client add to a File query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptFile(ctx context.Context, cfg config) ([]predicate.File, error) {
+	query, err := (&FileQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryOwner chains the current query on the owner edge.
 func (fq *FileQuery) QueryOwner() *UserQuery {
 	query := &UserQuery{config: fq.config}
 	switch fq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := fq.sqlQuery()
-		t2.Select(t2.C(file.OwnerColumn))
-		query.sql = sql.Select(t1.Columns(user.Columns...)...).
-			From(t1).
-			Join(t2).
-			On(t1.C(user.FieldID), t2.C(file.OwnerColumn))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := fq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(file.OwnerColumn))
+			return sql.Select(t1.Columns(user.Columns...)...).
+				From(t1).
+				Join(t2).
+				On(t1.C(user.FieldID), t2.C(file.OwnerColumn)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := fq.gremlinQuery()
-		query.gremlin = gremlin.InE(user.FilesLabel).OutV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := fq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.InE(user.FilesLabel).OutV(), nil
+		}
 	}
 	return query
 }
@@ -85,20 +214,71 @@ func (fq *FileQuery) QueryType() *FileTypeQuery {
 	query := &FileTypeQuery{config: fq.config}
 	switch fq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(filetype.Table)
-		t2 := fq.sqlQuery()
-		t2.Select(t2.C(file.TypeColumn))
-		query.sql = sql.Select(t1.Columns(filetype.Columns...)...).
-			From(t1).
-			Join(t2).
-			On(t1.C(filetype.FieldID), t2.C(file.TypeColumn))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := fq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(filetype.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(file.TypeColumn))
+			return sql.Select(t1.Columns(filetype.Columns...)...).
+				From(t1).
+				Join(t2).
+				On(t1.C(filetype.FieldID), t2.C(file.TypeColumn)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := fq.gremlinQuery()
-		query.gremlin = gremlin.InE(filetype.FilesLabel).OutV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := fq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.InE(filetype.FilesLabel).OutV(), nil
+		}
 	}
 	return query
 }
 
+// WithOwner tells the query-builder to eager-load the nodes that are connected to the "owner" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.File.Query().
+//		WithOwner(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (fq *FileQuery) WithOwner(opts ...func(*UserQuery)) *FileQuery {
+	query := &UserQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withOwner = query
+	return fq
+}
+
+// WithType tells the query-builder to eager-load the nodes that are connected to the "type" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.File.Query().
+//		WithType(func(q *ent.FileTypeQuery) {
+//			q.Order(ent.Desc(filetype.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (fq *FileQuery) WithType(opts ...func(*FileTypeQuery)) *FileQuery {
+	query := &FileTypeQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withType = query
+	return fq
+}
+
 // First returns the first File entity in the query. Returns *ErrNotFound when no file was found.
 func (fq *FileQuery) First(ctx context.Context) (*File, error) {
 	fs, err := fq.Limit(1).All(ctx)
@@ -144,7 +324,7 @@ func (fq *FileQuery) FirstXID(ctx context.Context) string {
 
 // Only returns the only File entity in the query, returns an error if not exactly one entity was returned.
 func (fq *FileQuery) Only(ctx context.Context) (*File, error) {
-	fs, err := fq.Limit(2).All(ctx)
+	fs, err := fq.Limit(2).Unordered().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +350,7 @@ func (fq *FileQuery) OnlyX(ctx context.Context) *File {
 // OnlyID returns the only File id in the query, returns an error if not exactly one id was returned.
 func (fq *FileQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = fq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = fq.Limit(2).Unordered().IDs(ctx); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -194,33 +374,63 @@ func (fq *FileQuery) OnlyXID(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of Files.
-func (fq *FileQuery) All(ctx context.Context) ([]*File, error) {
-	switch fq.driver.Dialect() {
+func (fq *FileQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*File, error) {
+	query, err := fq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.shadow != nil && !ent.NewCallOptions(opts...).SkipHooks {
+		return query.dualAll(ctx)
+	}
+
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return fq.sqlAll(ctx)
+		return query.sqlAll(ctx)
 	case dialect.Gremlin:
-		return fq.gremlinAll(ctx)
+		return query.gremlinAll(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
 // AllX is like All, but panics if an error occurs.
-func (fq *FileQuery) AllX(ctx context.Context) []*File {
-	fs, err := fq.All(ctx)
+func (fq *FileQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*File {
+	fs, err := fq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return fs
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (fq *FileQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := fq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlQueryString(ctx)
+	case dialect.Gremlin:
+		return query.gremlinQueryString(ctx)
+	default:
+		return "", nil, errors.New("ent: unsupported dialect")
+	}
+}
+
 // IDs executes the query and returns a list of File ids.
 func (fq *FileQuery) IDs(ctx context.Context) ([]string, error) {
-	switch fq.driver.Dialect() {
+	query, err := fq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return fq.sqlIDs(ctx)
+		return query.sqlIDs(ctx)
 	case dialect.Gremlin:
-		return fq.gremlinIDs(ctx)
+		return query.gremlinIDs(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
@@ -235,13 +445,46 @@ func (fq *FileQuery) IDsX(ctx context.Context) []string {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Files that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (fq *FileQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if fq.sql != nil || fq.gremlin != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &FileQuery{
+		config:     fq.config,
+		predicates: append([]predicate.File{}, fq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(string)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for File", after)
+		}
+		query.Where(file.IDGT(id))
+	}
+	ids, err := query.Order(Asc(file.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (fq *FileQuery) Count(ctx context.Context) (int, error) {
-	switch fq.driver.Dialect() {
+	query, err := fq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return fq.sqlCount(ctx)
+		return query.sqlCount(ctx)
 	case dialect.Gremlin:
-		return fq.gremlinCount(ctx)
+		return query.gremlinCount(ctx)
 	default:
 		return 0, errors.New("ent: unsupported dialect")
 	}
@@ -258,11 +501,15 @@ func (fq *FileQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (fq *FileQuery) Exist(ctx context.Context) (bool, error) {
-	switch fq.driver.Dialect() {
+	query, err := fq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return fq.sqlExist(ctx)
+		return query.sqlExist(ctx)
 	case dialect.Gremlin:
-		return fq.gremlinExist(ctx)
+		return query.gremlinExist(ctx)
 	default:
 		return false, errors.New("ent: unsupported dialect")
 	}
@@ -286,10 +533,18 @@ func (fq *FileQuery) Clone() *FileQuery {
 		offset:     fq.offset,
 		order:      append([]Order{}, fq.order...),
 		unique:     append([]string{}, fq.unique...),
+		fields:     append([]string{}, fq.fields...),
+		unordered:  fq.unordered,
+		hints:      append([]sql.Hint{}, fq.hints...),
+		edgeCount:  fq.edgeCount,
 		predicates: append([]predicate.File{}, fq.predicates...),
+		withOwner:  fq.withOwner,
+		withType:   fq.withType,
 		// clone intermediate queries.
-		sql:     fq.sql.Clone(),
-		gremlin: fq.gremlin.Clone(),
+		sql:         fq.sql.Clone(),
+		sqlPath:     fq.sqlPath,
+		gremlin:     fq.gremlin.Clone(),
+		gremlinPath: fq.gremlinPath,
 	}
 }
 
@@ -311,11 +566,18 @@ func (fq *FileQuery) Clone() *FileQuery {
 func (fq *FileQuery) GroupBy(field string, fields ...string) *FileGroupBy {
 	group := &FileGroupBy{config: fq.config}
 	group.fields = append([]string{field}, fields...)
-	switch fq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		group.sql = fq.sqlQuery()
-	case dialect.Gremlin:
-		group.gremlin = fq.gremlinQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := fq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			group.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			group.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return group
 }
@@ -335,20 +597,27 @@ func (fq *FileQuery) GroupBy(field string, fields ...string) *FileGroupBy {
 func (fq *FileQuery) Select(field string, fields ...string) *FileSelect {
 	selector := &FileSelect{config: fq.config}
 	selector.fields = append([]string{field}, fields...)
-	switch fq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		selector.sql = fq.sqlQuery()
-	case dialect.Gremlin:
-		selector.gremlin = fq.gremlinQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := fq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			selector.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			selector.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return selector
 }
 
 func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
 	rows := &sql.Rows{}
-	selector := fq.sqlQuery()
-	if unique := fq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := fq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := fq.driver.Query(ctx, query, args, rows); err != nil {
@@ -356,21 +625,219 @@ func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
 	}
 	defer rows.Close()
 	var fs Files
-	if err := fs.FromRows(rows); err != nil {
+	if err := fs.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	fs.config(fq.config)
+	if query := fq.withOwner; query != nil {
+		if err := fq.sqlLoadOwner(ctx, query, fs); err != nil {
+			return nil, err
+		}
+	}
+	if query := fq.withType; query != nil {
+		if err := fq.sqlLoadType(ctx, query, fs); err != nil {
+			return nil, err
+		}
+	}
 	return fs, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (fq *FileQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := fq.sqlQuery(ctx)
+	if unique := fq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := file.Columns
+	if fields := fq.fields; len(fields) > 0 {
+		columns = append([]string{file.FieldID}, fields...)
+		for _, c := range fields {
+			if !file.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (fq *FileQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := fq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadOwner loads the nodes of the owner edge of the given Files using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (fq *FileQuery) sqlLoadOwner(ctx context.Context, query *UserQuery, nodes []*File) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*File, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(file.OwnerTable)
+	selector.Join(t1).On(t1.C(file.OwnerColumn), selector.C(user.FieldID))
+	key := t1.C(file.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := fq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Owner = edge
+	}
+	return nil
+}
+
+// sqlLoadType loads the nodes of the type edge of the given Files using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (fq *FileQuery) sqlLoadType(ctx context.Context, query *FileTypeQuery, nodes []*File) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*File, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(file.TypeTable)
+	selector.Join(t1).On(t1.C(file.TypeColumn), selector.C(filetype.FieldID))
+	key := t1.C(file.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(filetype.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := fq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.FileType{filetype.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*FileType, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Type = edge
+	}
+	return nil
+}
+
 func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := fq.sqlQuery()
+	selector := fq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{file.FieldID}
 	if len(fq.unique) > 0 {
 		unique = fq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := fq.edgeCount; c != nil && len(fq.predicates) == 0 && len(fq.unique) == 0 &&
+		len(fq.hints) == 0 && fq.limit == nil && fq.offset == nil {
+		selector = c.Clone().SetDialect(fq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := fq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -387,11 +854,22 @@ func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (fq *FileQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := fq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := fq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(file.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := fq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := fq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (fq *FileQuery) sqlIDs(ctx context.Context) ([]string, error) {
@@ -406,13 +884,33 @@ func (fq *FileQuery) sqlIDs(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
-func (fq *FileQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (fq *FileQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := fq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(file.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (fq *FileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(file.Table)
 	selector := sql.Select(t1.Columns(file.Columns...)...).From(t1)
 	if fq.sql != nil {
 		selector = fq.sql
 		selector.Select(selector.Columns(file.Columns...)...)
 	}
+	selector.SetDialect(fq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(fq.hints...)
 	for _, p := range fq.predicates {
 		p(selector)
 	}
@@ -432,8 +930,9 @@ func (fq *FileQuery) sqlQuery() *sql.Selector {
 
 func (fq *FileQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := fq.gremlinQuery().Query()
-	if err := fq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := fq.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := fq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	vertices, err := res.ReadVertices()
@@ -449,8 +948,9 @@ func (fq *FileQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 
 func (fq *FileQuery) gremlinAll(ctx context.Context) ([]*File, error) {
 	res := &gremlin.Response{}
-	query, bindings := fq.gremlinQuery().ValueMap(true).Query()
-	if err := fq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := fq.gremlinAllQuery()
+	query, _ := traversal.Query()
+	if err := fq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	var fs Files
@@ -458,13 +958,82 @@ func (fq *FileQuery) gremlinAll(ctx context.Context) ([]*File, error) {
 		return nil, err
 	}
 	fs.config(fq.config)
+	if query := fq.withOwner; query != nil {
+		if err := fq.gremlinLoadOwner(ctx, query, fs); err != nil {
+			return nil, err
+		}
+	}
+	if query := fq.withType; query != nil {
+		if err := fq.gremlinLoadType(ctx, query, fs); err != nil {
+			return nil, err
+		}
+	}
 	return fs, nil
 }
 
+// gremlinAllQuery returns the traversal of All, that returns the value maps of the vertices.
+func (fq *FileQuery) gremlinAllQuery() *dsl.Traversal {
+	args := []interface{}{true}
+	for _, f := range fq.fields {
+		args = append(args, f)
+	}
+	return fq.gremlinQuery().ValueMap(args...)
+}
+
+func (fq *FileQuery) gremlinQueryString(context.Context) (string, interface{}, error) {
+	query, bindings := fq.gremlinAllQuery().Query()
+	return query, bindings, nil
+}
+
+// gremlinLoadOwner loads the nodes of the owner edge of the given Files using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (fq *FileQuery) gremlinLoadOwner(ctx context.Context, query *UserQuery, nodes []*File) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&FileClient{config: fq.config}).QueryOwner(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Owner = edges[0]
+		}
+	}
+	return nil
+}
+
+// gremlinLoadType loads the nodes of the type edge of the given Files using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (fq *FileQuery) gremlinLoadType(ctx context.Context, query *FileTypeQuery, nodes []*File) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&FileClient{config: fq.config}).QueryType(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Type = edges[0]
+		}
+	}
+	return nil
+}
+
 func (fq *FileQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := fq.gremlinQuery().Count().Query()
-	if err := fq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := fq.gremlinTraversal(false).Count()
+	query, _ := traversal.Query()
+	if err := fq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -472,14 +1041,22 @@ func (fq *FileQuery) gremlinCount(ctx context.Context) (int, error) {
 
 func (fq *FileQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
-	query, bindings := fq.gremlinQuery().HasNext().Query()
-	if err := fq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := fq.gremlinTraversal(false).HasNext()
+	query, _ := traversal.Query()
+	if err := fq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
 }
 
+// gremlinQuery returns the traversal of the query for listing its vertices, with its default orders.
 func (fq *FileQuery) gremlinQuery() *dsl.Traversal {
+	return fq.gremlinTraversal(true)
+}
+
+// gremlinTraversal returns the traversal of the query. The default orders are added only to the traversals
+// of listing queries (list), since they don't change the results of the others (e.g. Count and Exist).
+func (fq *FileQuery) gremlinTraversal(list bool) *dsl.Traversal {
 	v := g.V().HasLabel(file.Label)
 	if fq.gremlin != nil {
 		v = fq.gremlin.Clone()
@@ -487,13 +1064,22 @@ func (fq *FileQuery) gremlinQuery() *dsl.Traversal {
 	for _, p := range fq.predicates {
 		p(v)
 	}
-	if len(fq.order) > 0 {
+	limit, offset := fq.limit, fq.offset
+	list = list && !fq.unordered
+	// paginated queries are ordered by id (also as a tie-breaker of the
+	// query orders), in order to keep the pages stable between calls.
+	stable := (limit != nil || offset != nil) && list
+	order := fq.order
+	if len(order) > 0 || stable {
 		v.Order()
-		for _, p := range fq.order {
+		for _, p := range order {
 			p(v)
 		}
+		if stable {
+			v.By(dsl.ID, dsl.Incr)
+		}
 	}
-	switch limit, offset := fq.limit, fq.offset; {
+	switch {
 	case limit != nil && offset != nil:
 		v.Range(*offset, *offset+*limit)
 	case offset != nil:
@@ -515,6 +1101,11 @@ type FileGroupBy struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving     *sql.Predicate
+	gremlinHaving *dsl.Traversal
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -523,8 +1114,27 @@ func (fgb *FileGroupBy) Aggregate(fns ...Aggregate) *FileGroupBy {
 	return fgb
 }
 
+// Having adds per-dialect predicates on the aggregated values of the groups.
+// In Gremlin, the predicate is applied using a `where` step on the values of each group.
+// For example:
+//
+//	client.File.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5), __.Select(ent.DefaultCountLabel).Is(p.GT(5))).
+//		Scan(ctx, &v)
+//
+func (fgb *FileGroupBy) Having(p0 *sql.Predicate, p1 *dsl.Traversal) *FileGroupBy {
+	fgb.sqlHaving = p0
+	fgb.gremlinHaving = p1
+	return fgb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (fgb *FileGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := fgb.path(ctx); err != nil {
+		return err
+	}
 	switch fgb.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return fgb.sqlScan(ctx, v)
@@ -627,6 +1237,9 @@ func (fgb *FileGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (fgb *FileGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := fgb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := fgb.sqlQuery().Query()
 	if err := fgb.driver.Query(ctx, query, args, rows); err != nil {
@@ -643,13 +1256,18 @@ func (fgb *FileGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range fgb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(fgb.fields...)
+	selector.Select(columns...).GroupBy(fgb.fields...)
+	if fgb.sqlHaving != nil {
+		selector.Having(fgb.sqlHaving)
+	}
+	return selector
 }
 
 func (fgb *FileGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
-	query, bindings := fgb.gremlinQuery().Query()
-	if err := fgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := fgb.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := fgb.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(fgb.fields)+len(fgb.fns) == 1 {
@@ -676,11 +1294,14 @@ func (fgb *FileGroupBy) gremlinQuery() *dsl.Traversal {
 		names = append(names, f)
 		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
 	}
-	return fgb.gremlin.Group().
+	v := fgb.gremlin.Group().
 		By(__.Values(fgb.fields...).Fold()).
 		By(__.Fold().Match(trs...).Select(names...)).
-		Select(dsl.Values).
-		Next()
+		Select(dsl.Values)
+	if fgb.gremlinHaving != nil {
+		v = v.Unfold().Where(fgb.gremlinHaving).Fold()
+	}
+	return v.Next()
 }
 
 // FileSelect is the builder for select fields of File entities.
@@ -690,10 +1311,15 @@ type FileSelect struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (fs *FileSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := fs.path(ctx); err != nil {
+		return err
+	}
 	switch fs.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return fs.sqlScan(ctx, v)
@@ -796,6 +1422,9 @@ func (fs *FileSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (fs *FileSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := fs.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := fs.sqlQuery().Query()
 	if err := fs.driver.Query(ctx, query, args, rows); err != nil {
@@ -824,8 +1453,8 @@ func (fs *FileSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		}
 		traversal = fs.gremlin.ValueMap(fields...)
 	}
-	query, bindings := traversal.Query()
-	if err := fs.driver.Exec(ctx, query, bindings, res); err != nil {
+	query, _ := traversal.Query()
+	if err := fs.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(fs.fields) == 1 {
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -22,23 +23,17 @@ import (
 	"github.com/facebookincubator/ent/entc/integration/ent/filetype"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // FileUpdate is the builder for updating File entities.
 type FileUpdate struct {
 	config
-	size         *int
-	addsize      *int
-	name         *string
-	user         *string
-	clearuser    bool
-	group        *string
-	cleargroup   bool
-	owner        map[string]struct{}
-	_type        map[string]struct{}
-	clearedOwner bool
-	clearedType  bool
-	predicates   []predicate.File
+	fileMutation
+	predicates []predicate.File
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*File
 }
 
 // Where adds a new predicate for the builder.
@@ -47,6 +42,11 @@ func (fu *FileUpdate) Where(ps ...predicate.File) *FileUpdate {
 	return fu
 }
 
+// Mutation returns the mutation of the builder.
+func (fu *FileUpdate) Mutation() *FileMutation {
+	return &FileMutation{fileMutation: &fu.fileMutation, op: ent.OpUpdate}
+}
+
 // SetSize sets the size field.
 func (fu *FileUpdate) SetSize(i int) *FileUpdate {
 	fu.size = &i
@@ -177,17 +177,52 @@ func (fu *FileUpdate) ClearType() *FileUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
+func (fu *FileUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := fu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (fu *FileUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]string, error) {
+	options := ent.NewCallOptions(opts...)
 	if fu.size != nil {
 		if err := file.SizeValidator(*fu.size); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
+			return nil, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
 		}
 	}
 	if len(fu.owner) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"owner\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
 	}
 	if len(fu._type) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"type\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"type\"")
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if fu.shadow != nil {
+		return fu.dualSave(ctx, opts...)
+	}
+	ids, err := fu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 && !options.SkipHooks {
+		fu.bus.publish(&Event{Op: ent.OpUpdate, Type: file.Label, N: len(ids)})
+	}
+	return ids, nil
+}
+
+// save updates the File nodes in the storage of the client dialect.
+func (fu *FileUpdate) save(ctx context.Context) ([]string, error) {
+	predicates, err := interceptFile(ctx, fu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *fu
+		intercepted.predicates = append(predicates, fu.predicates...)
+		fu = &intercepted
 	}
 	switch fu.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
@@ -195,13 +230,26 @@ func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
 	case dialect.Gremlin:
 		return fu.gremlinSave(ctx)
 	default:
-		return 0, errors.New("ent: unsupported dialect")
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (fu *FileUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*File, error) {
+	var fs []*File
+	fu.returning = &fs
+	defer func() { fu.returning = nil }()
+	if _, err := fu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
 	}
+	return fs, nil
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (fu *FileUpdate) SaveX(ctx context.Context) int {
-	affected, err := fu.Save(ctx)
+func (fu *FileUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := fu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -209,44 +257,49 @@ func (fu *FileUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (fu *FileUpdate) Exec(ctx context.Context) error {
-	_, err := fu.Save(ctx)
+func (fu *FileUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := fu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (fu *FileUpdate) ExecX(ctx context.Context) {
-	if err := fu.Exec(ctx); err != nil {
+func (fu *FileUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := fu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(file.FieldID).From(sql.Table(file.Table))
+func (fu *FileUpdate) sqlSave(ctx context.Context) (_ []string, err error) {
+	ctx = entcache.Invalidates(ctx, file.Tables...)
+	selector := sql.Select(file.FieldID).From(sql.Table(file.Table)).SetDialect(fu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range fu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = fu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := fu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -276,19 +329,22 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if fu.clearedOwner {
+	if fu.clearedOwner && len(fu.owner) == 0 {
 		query, args := sql.Update(file.OwnerTable).
 			SetNull(file.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(fu.owner) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "owner", fu.owner); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for eid := range fu.owner {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -300,20 +356,23 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.InInts(file.FieldID, ids...)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 		}
 	}
-	if fu.clearedType {
+	if fu.clearedType && len(fu._type) == 0 {
 		query, args := sql.Update(file.TypeTable).
 			SetNull(file.TypeColumn).
 			Where(sql.InInts(filetype.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(fu._type) > 0 {
+		if err := checkRefs(ctx, tx, filetype.Table, filetype.FieldID, "type", fu._type); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for eid := range fu._type {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -325,26 +384,71 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.InInts(file.FieldID, ids...)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 		}
 	}
+	if fu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(file.Columns...).
+			From(sql.Table(file.Table)).
+			Where(sql.InInts(file.FieldID, ids...)).
+			SetDialect(fu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var fs Files
+		err := fs.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into File: %v", err))
+		}
+		fs.config(fu.config)
+		*fu.returning = fs
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	vs := make([]string, len(ids))
+	for i, id := range ids {
+		vs[i] = strconv.Itoa(id)
+	}
+	return vs, nil
 }
 
-func (fu *FileUpdate) gremlinSave(ctx context.Context) (int, error) {
+func (fu *FileUpdate) gremlinSave(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := fu.gremlin().Query()
-	if err := fu.driver.Exec(ctx, query, bindings, res); err != nil {
-		return 0, err
+	traversal := fu.gremlin()
+	query, _ := traversal.Query()
+	if err := fu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
-		return 0, err
+		return nil, err
+	}
+	if fu.returning != nil {
+		var fs Files
+		if err := fs.FromResponse(res); err != nil {
+			return nil, err
+		}
+		fs.config(fu.config)
+		*fu.returning = fs
+		ids := make([]string, 0, len(fs))
+		for _, f := range fs {
+			ids = append(ids, f.ID)
+		}
+		return ids, nil
 	}
-	return res.ReadInt()
+	vertices, err := res.ReadVertices()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vertices))
+	for _, vertex := range vertices {
+		ids = append(ids, vertex.ID.(string))
+	}
+	return ids, nil
 }
 
 func (fu *FileUpdate) gremlin() *dsl.Traversal {
@@ -397,7 +501,9 @@ func (fu *FileUpdate) gremlin() *dsl.Traversal {
 	for id := range fu._type {
 		v.AddE(filetype.FilesLabel).From(g.V(id)).InV()
 	}
-	v.Count()
+	if fu.returning != nil {
+		v.ValueMap(true)
+	}
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
@@ -405,18 +511,16 @@ func (fu *FileUpdate) gremlin() *dsl.Traversal {
 // FileUpdateOne is the builder for updating a single File entity.
 type FileUpdateOne struct {
 	config
-	id           string
-	size         *int
-	addsize      *int
-	name         *string
-	user         *string
-	clearuser    bool
-	group        *string
-	cleargroup   bool
-	owner        map[string]struct{}
-	_type        map[string]struct{}
-	clearedOwner bool
-	clearedType  bool
+	id     string
+	entity *File
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.File
+	fileMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (fuo *FileUpdateOne) Mutation() *FileMutation {
+	return &FileMutation{fileMutation: &fuo.fileMutation, op: ent.OpUpdateOne, id: &fuo.id}
 }
 
 // SetSize sets the size field.
@@ -548,8 +652,36 @@ func (fuo *FileUpdateOne) ClearType() *FileUpdateOne {
 	return fuo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (fuo *FileUpdateOne) SetChangedFrom(original *File) *FileUpdateOne {
+	f := fuo.entity
+	if f == nil || original == nil {
+		return fuo
+	}
+	for _, change := range DiffFile(original, f) {
+		switch change.Field {
+		case file.FieldSize:
+			fuo.SetSize(f.Size)
+		case file.FieldName:
+			fuo.SetName(f.Name)
+		case file.FieldUser:
+			if f.User == nil {
+				fuo.ClearUser()
+			} else {
+				fuo.SetUser(*f.User)
+			}
+		case file.FieldGroup:
+			fuo.SetGroup(f.Group)
+		}
+	}
+	return fuo
+}
+
 // Save executes the query and returns the updated entity.
-func (fuo *FileUpdateOne) Save(ctx context.Context) (*File, error) {
+func (fuo *FileUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*File, error) {
+	options := ent.NewCallOptions(opts...)
 	if fuo.size != nil {
 		if err := file.SizeValidator(*fuo.size); err != nil {
 			return nil, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
@@ -561,6 +693,34 @@ func (fuo *FileUpdateOne) Save(ctx context.Context) (*File, error) {
 	if len(fuo._type) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"type\"")
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if fuo.shadow != nil {
+		return fuo.dualSave(ctx, opts...)
+	}
+	f, err := fuo.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		fuo.bus.publish(&Event{Op: ent.OpUpdateOne, Type: file.Label, ID: f.ID, Node: f, N: 1})
+	}
+	return f, nil
+}
+
+// save updates the File in the storage of the client dialect.
+func (fuo *FileUpdateOne) save(ctx context.Context) (*File, error) {
+	predicates, err := interceptFile(ctx, fuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *fuo
+		intercepted.predicates = append(predicates, fuo.predicates...)
+		fuo = &intercepted
+	}
 	switch fuo.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return fuo.sqlSave(ctx)
@@ -572,8 +732,8 @@ func (fuo *FileUpdateOne) Save(ctx context.Context) (*File, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (fuo *FileUpdateOne) SaveX(ctx context.Context) *File {
-	f, err := fuo.Save(ctx)
+func (fuo *FileUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *File {
+	f, err := fuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -581,21 +741,29 @@ func (fuo *FileUpdateOne) SaveX(ctx context.Context) *File {
 }
 
 // Exec executes the query on the entity.
-func (fuo *FileUpdateOne) Exec(ctx context.Context) error {
-	_, err := fuo.Save(ctx)
+func (fuo *FileUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := fuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (fuo *FileUpdateOne) ExecX(ctx context.Context) {
-	if err := fuo.Exec(ctx); err != nil {
+func (fuo *FileUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := fuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
-	selector := sql.Select(file.Columns...).From(sql.Table(file.Table))
+	ctx = entcache.Invalidates(ctx, file.Tables...)
+	selector := sql.Select(file.Columns...).From(sql.Table(file.Table)).SetDialect(fuo.driver.Dialect())
 	file.ID(fuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range fuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = fuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -662,7 +830,7 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if fuo.clearedOwner {
+	if fuo.clearedOwner && len(fuo.owner) == 0 {
 		query, args := sql.Update(file.OwnerTable).
 			SetNull(file.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
@@ -672,6 +840,9 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 		}
 	}
 	if len(fuo.owner) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "owner", fuo.owner); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for eid := range fuo.owner {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -687,7 +858,7 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 			}
 		}
 	}
-	if fuo.clearedType {
+	if fuo.clearedType && len(fuo._type) == 0 {
 		query, args := sql.Update(file.TypeTable).
 			SetNull(file.TypeColumn).
 			Where(sql.InInts(filetype.FieldID, ids...)).
@@ -697,6 +868,9 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 		}
 	}
 	if len(fuo._type) > 0 {
+		if err := checkRefs(ctx, tx, filetype.Table, filetype.FieldID, "type", fuo._type); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for eid := range fuo._type {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -720,8 +894,9 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 
 func (fuo *FileUpdateOne) gremlinSave(ctx context.Context) (*File, error) {
 	res := &gremlin.Response{}
-	query, bindings := fuo.gremlin(fuo.id).Query()
-	if err := fuo.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := fuo.gremlin(fuo.id)
+	query, _ := traversal.Query()
+	if err := fuo.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -736,6 +911,9 @@ func (fuo *FileUpdateOne) gremlinSave(ctx context.Context) (*File, error) {
 
 func (fuo *FileUpdateOne) gremlin(id string) *dsl.Traversal {
 	v := g.V(id)
+	for _, p := range fuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
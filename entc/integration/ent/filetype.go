@@ -13,6 +13,7 @@ import (
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/ent/filetype"
 )
 
 // FileType is the model entity for the FileType schema.
@@ -22,19 +23,41 @@ type FileType struct {
 	ID string `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the FileTypeQuery when eager-loading is set.
+	Edges FileTypeEdges `json:"edges"`
+}
+
+// FileTypeEdges holds the relations/edges of other nodes in the graph.
+type FileTypeEdges struct {
+	// Files holds the value of the files edge.
+	Files []*File `json:"files,omitempty"`
 }
 
 // FromRows scans the sql response data into FileType.
 func (ft *FileType) FromRows(rows *sql.Rows) error {
+	return ft.scan(rows, filetype.Columns)
+}
+
+// scan scans the given columns of the sql response data into FileType.
+// Fields that their columns were not selected are left with their zero values.
+func (ft *FileType) scan(rows *sql.Rows, columns []string) error {
 	var vft struct {
 		ID   int
 		Name sql.NullString
 	}
-	// the order here should be the same as in the `filetype.Columns`.
-	if err := rows.Scan(
-		&vft.ID,
-		&vft.Name,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case filetype.FieldID:
+			values[i] = &vft.ID
+		case filetype.FieldName:
+			values[i] = &vft.Name
+		default:
+			return fmt.Errorf("unexpected column %q for type FileType", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	ft.ID = strconv.Itoa(vft.ID)
@@ -83,6 +106,35 @@ func (ft *FileType) Unwrap() *FileType {
 	return ft
 }
 
+// Clone returns a deep copy of the FileType, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (ft *FileType) Clone() *FileType {
+	return ft.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the FileType, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (ft *FileType) clone(seen map[interface{}]interface{}) *FileType {
+	if ft == nil {
+		return nil
+	}
+	if _c, ok := seen[ft]; ok {
+		return _c.(*FileType)
+	}
+	_c := *ft
+	_c.config = config{}
+	seen[ft] = &_c
+	if nodes := ft.Edges.Files; nodes != nil {
+		_c.Edges.Files = make([]*File, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Files[_i] = _n.clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (ft *FileType) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -93,6 +145,17 @@ func (ft *FileType) String() string {
 	return buf.String()
 }
 
+// DiffFileType returns the fields that have different values in the given FileTypes,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffFileType(a, b *FileType) []FieldChange {
+	var changes []FieldChange
+	if a.Name != b.Name {
+		changes = append(changes, FieldChange{Field: filetype.FieldName, Old: a.Name, New: b.Name})
+	}
+	return changes
+}
+
 // id returns the int representation of the ID field.
 func (ft *FileType) id() int {
 	id, _ := strconv.Atoi(ft.ID)
@@ -104,9 +167,14 @@ type FileTypes []*FileType
 
 // FromRows scans the sql response data into FileTypes.
 func (ft *FileTypes) FromRows(rows *sql.Rows) error {
+	return ft.scan(rows, filetype.Columns)
+}
+
+// scan scans the given columns of the sql response data into FileTypes.
+func (ft *FileTypes) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vft := &FileType{}
-		if err := vft.FromRows(rows); err != nil {
+		if err := vft.scan(rows, columns); err != nil {
 			return err
 		}
 		*ft = append(*ft, vft)
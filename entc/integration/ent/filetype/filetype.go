@@ -6,6 +6,12 @@
 
 package filetype
 
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the filetype type in the database.
 	Label = "file_type"
@@ -22,7 +28,7 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "file" package.
 	FilesInverseTable = "files"
 	// FilesColumn is the table column denoting the files relation/edge.
-	FilesColumn = "type_id"
+	FilesColumn = "file_type_id"
 
 	// FilesLabel holds the string label denoting the files edge type in the database.
 	FilesLabel = "file_type_files"
@@ -33,3 +39,68 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Tables holds the tables that may be changed by the mutations of the filetype. The mutations
+// invalidate only the query results of these tables that were cached by the entcache driver.
+var Tables = []string{
+	"file_types",
+	"files",
+}
+
+// Scope is a reusable named scope of the FileType queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.FileType
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the FileType type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "FileType",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeString,
+		GoType:     "string",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "name",
+			StorageKey: FieldName,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Unique:     true,
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "files",
+			Type:     "File",
+			Rel:      "O2M",
+			Optional: true,
+			Table:    "files",
+			Columns:  []string{"file_type_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the FileType type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
@@ -7,7 +7,11 @@
 package filetype
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
@@ -323,6 +327,30 @@ func NameHasSuffix(v string) predicate.FileType {
 	)
 }
 
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.FileType {
+	return predicate.FileTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldName), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldName, p.EqualFold(v))
+		},
+	)
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.FileType {
+	return predicate.FileTypePerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldName), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldName, p.ContainsFold(v))
+		},
+	)
+}
+
 // HasFiles applies the HasEdge predicate on the "files" edge.
 func HasFiles() predicate.FileType {
 	return predicate.FileTypePerDialect(
@@ -343,15 +371,38 @@ func HasFiles() predicate.FileType {
 	)
 }
 
+// HasNoFiles applies a predicate that checks that the "files" edge is empty. It's identical
+// to Not(HasFiles()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the FileType nodes.
+func HasNoFiles() predicate.FileType {
+	return predicate.FileTypePerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(FilesColumn).
+						From(sql.Table(FilesTable)).
+						Where(sql.NotNull(FilesColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(FilesLabel))
+		},
+	)
+}
+
 // HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
 func HasFilesWith(preds ...predicate.File) predicate.FileType {
 	return predicate.FileTypePerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(FilesColumn).From(sql.Table(FilesTable))
+			t2 := sql.Select(FilesColumn).From(sql.Table(FilesTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(FieldID), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -364,6 +415,183 @@ func HasFilesWith(preds ...predicate.File) predicate.FileType {
 	)
 }
 
+// ByFilesCount orders the results by the number of "files" edges,
+// in descending order if desc is true. It's used as an order option of the
+// FileType queries. For example:
+//
+//	client.FileType.Query().Order(filetype.ByFilesCount(true))
+//
+func ByFilesCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(FilesTable).As("files_count")
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(FilesColumn), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.OutE(FilesLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// PredicateFunc returns a predicate from the given per-dialect functions.
+// It allows adding custom SQL/Gremlin fragments to the FileType builders.
+func PredicateFunc(f0 func(*sql.Selector), f1 func(*dsl.Traversal)) predicate.FileType {
+	return predicate.FileTypePerDialect(f0, f1)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.FileType, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.FileType, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.FileType, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldName:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasSuffix(v), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContainsFold(v), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("filetype: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.FileType) predicate.FileType {
 	return predicate.FileTypePerDialect(
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -21,13 +22,21 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
 	"github.com/facebookincubator/ent/entc/integration/ent/filetype"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // FileTypeCreate is the builder for creating a FileType entity.
 type FileTypeCreate struct {
 	config
-	name  *string
-	files map[string]struct{}
+	filetypeMutation
+	// id of the node, if it's mirrored from the primary storage.
+	id *string
+}
+
+// Mutation returns the mutation of the builder.
+func (ftc *FileTypeCreate) Mutation() *FileTypeMutation {
+	return &FileTypeMutation{filetypeMutation: &ftc.filetypeMutation, op: ent.OpCreate}
 }
 
 // SetName sets the name field.
@@ -57,10 +66,29 @@ func (ftc *FileTypeCreate) AddFiles(f ...*File) *FileTypeCreate {
 }
 
 // Save creates the FileType in the database.
-func (ftc *FileTypeCreate) Save(ctx context.Context) (*FileType, error) {
+func (ftc *FileTypeCreate) Save(ctx context.Context, opts ...ent.CallOption) (*FileType, error) {
+	options := ent.NewCallOptions(opts...)
 	if ftc.name == nil {
 		return nil, errors.New("ent: missing required field \"name\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if ftc.shadow != nil {
+		return ftc.dualSave(ctx, opts...)
+	}
+	v, err := ftc.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		ftc.bus.publish(&Event{Op: ent.OpCreate, Type: filetype.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save creates the FileType in the storage of the client dialect.
+func (ftc *FileTypeCreate) save(ctx context.Context) (*FileType, error) {
 	switch ftc.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ftc.sqlSave(ctx)
@@ -72,8 +100,8 @@ func (ftc *FileTypeCreate) Save(ctx context.Context) (*FileType, error) {
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (ftc *FileTypeCreate) SaveX(ctx context.Context) *FileType {
-	v, err := ftc.Save(ctx)
+func (ftc *FileTypeCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *FileType {
+	v, err := ftc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -81,6 +109,7 @@ func (ftc *FileTypeCreate) SaveX(ctx context.Context) *FileType {
 }
 
 func (ftc *FileTypeCreate) sqlSave(ctx context.Context) (*FileType, error) {
+	ctx = entcache.Invalidates(ctx, filetype.Tables...)
 	var (
 		res sql.Result
 		ft  = &FileType{config: ftc.config}
@@ -90,6 +119,13 @@ func (ftc *FileTypeCreate) sqlSave(ctx context.Context) (*FileType, error) {
 		return nil, err
 	}
 	builder := sql.Insert(filetype.Table).Default(ftc.driver.Dialect())
+	if id := ftc.id; id != nil {
+		id, err := strconv.Atoi(*id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(filetype.FieldID, id)
+	}
 	if value := ftc.name; value != nil {
 		builder.Set(filetype.FieldName, *value)
 		ft.Name = *value
@@ -124,7 +160,7 @@ func (ftc *FileTypeCreate) sqlSave(ctx context.Context) (*FileType, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(ftc.files) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"FileType\"", keys(ftc.files))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"FileType\"", keys(ftc.files))})
 		}
 	}
 	if err := tx.Commit(); err != nil {
@@ -135,8 +171,9 @@ func (ftc *FileTypeCreate) sqlSave(ctx context.Context) (*FileType, error) {
 
 func (ftc *FileTypeCreate) gremlinSave(ctx context.Context) (*FileType, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftc.gremlin().Query()
-	if err := ftc.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftc.gremlin()
+	query, _ := traversal.Query()
+	if err := ftc.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -179,3 +216,198 @@ func (ftc *FileTypeCreate) gremlin() *dsl.Traversal {
 	}
 	return tr
 }
+
+// FileTypeUpsert is the builder for creating a FileType entity, or updating the
+// fields of an existing one if it has the same value in the unique key field of the builder.
+type FileTypeUpsert struct {
+	config
+	key  string
+	name *string
+}
+
+// SetName sets the name field.
+func (ftu *FileTypeUpsert) SetName(s string) *FileTypeUpsert {
+	ftu.name = &s
+	return ftu
+}
+
+// Save creates the FileType in the database, or updates the entity that has
+// the same value in the key field. Immutable fields are set only on creation.
+func (ftu *FileTypeUpsert) Save(ctx context.Context, opts ...ent.CallOption) (*FileType, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	v, err := ftu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		ftu.bus.publish(&Event{Op: ent.OpUpdateOne, Type: filetype.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save upserts the FileType in the storage of the client dialect.
+func (ftu *FileTypeUpsert) save(ctx context.Context) (*FileType, error) {
+	switch ftu.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return ftu.sqlSave(ctx)
+	case dialect.Gremlin:
+		return ftu.gremlinSave(ctx)
+	default:
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ftu *FileTypeUpsert) SaveX(ctx context.Context, opts ...ent.CallOption) *FileType {
+	v, err := ftu.Save(ctx, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ftu *FileTypeUpsert) sqlSave(ctx context.Context) (*FileType, error) {
+	ctx = entcache.Invalidates(ctx, filetype.Tables...)
+	var (
+		res    sql.Result
+		key    interface{}
+		update []string
+	)
+	tx, err := ftu.driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	builder := sql.Insert(filetype.Table)
+	if value := ftu.name; value != nil {
+		builder.Set(filetype.FieldName, *value)
+		update = append(update, filetype.FieldName)
+		if ftu.key == filetype.FieldName {
+			key = *value
+		}
+	}
+	rows := &sql.Rows{}
+	query, args := builder.OnConflict(ftu.driver.Dialect(), []string{ftu.key}, update...).Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return nil, rollback(tx, err)
+	}
+	query, args = sql.Select(filetype.Columns...).From(sql.Table(filetype.Table)).Where(sql.EQ(ftu.key, key)).Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return nil, rollback(tx, err)
+	}
+	ft := &FileType{config: ftu.config}
+	if !rows.Next() {
+		rows.Close()
+		return nil, rollback(tx, &ErrNotFound{filetype.Label})
+	}
+	if err := ft.FromRows(rows); err != nil {
+		rows.Close()
+		return nil, rollback(tx, err)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return ft, nil
+}
+
+func (ftu *FileTypeUpsert) gremlinSave(ctx context.Context) (*FileType, error) {
+	res := &gremlin.Response{}
+	traversal := ftu.gremlin()
+	query, _ := traversal.Query()
+	if err := ftu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
+	}
+	ft := &FileType{config: ftu.config}
+	if err := ft.FromResponse(res); err != nil {
+		return nil, err
+	}
+	return ft, nil
+}
+
+func (ftu *FileTypeUpsert) gremlin() *dsl.Traversal {
+	var key interface{}
+	if ftu.key == filetype.FieldName && ftu.name != nil {
+		key = *ftu.name
+	}
+	// properties of new vertices (immutable fields and default values).
+	create := __.AddV(filetype.Label)
+	v := g.V().Has(filetype.Label, ftu.key, key).Fold().Coalesce(__.Unfold(), create)
+	if ftu.name != nil {
+		v.Property(dsl.Single, filetype.FieldName, *ftu.name)
+	}
+	return v.ValueMap(true)
+}
+
+// FileTypeGetOrCreate is the builder for creating a FileType entity, or getting the existing
+// one if its creation violates the uniqueness of one of the fields of the builder.
+type FileTypeGetOrCreate struct {
+	config
+	filetypeMutation
+}
+
+// SetName sets the name field.
+func (ftgoc *FileTypeGetOrCreate) SetName(s string) *FileTypeGetOrCreate {
+	ftgoc.name = &s
+	return ftgoc
+}
+
+// AddFileIDs adds the files edge to File by ids.
+func (ftgoc *FileTypeGetOrCreate) AddFileIDs(ids ...string) *FileTypeGetOrCreate {
+	if ftgoc.files == nil {
+		ftgoc.files = make(map[string]struct{})
+	}
+	for i := range ids {
+		ftgoc.files[ids[i]] = struct{}{}
+	}
+	return ftgoc
+}
+
+// AddFiles adds the files edges to File.
+func (ftgoc *FileTypeGetOrCreate) AddFiles(f ...*File) *FileTypeGetOrCreate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ftgoc.AddFileIDs(ids...)
+}
+
+// Save creates the FileType in the database. If the creation fails on a constraint violation, the
+// FileType that has the same value in one of the unique fields of the builder is returned instead.
+// Unlike querying the entity before creating it, it's safe for concurrent callers, since the uniqueness
+// is enforced by the storage. The constraint error is returned if there is no such FileType.
+func (ftgoc *FileTypeGetOrCreate) Save(ctx context.Context, opts ...ent.CallOption) (*FileType, error) {
+	create := &FileTypeCreate{config: ftgoc.config, filetypeMutation: ftgoc.filetypeMutation}
+	v, err := create.Save(ctx, opts...)
+	if err == nil || !IsConstraintFailure(err) {
+		return v, err
+	}
+	var ps []predicate.FileType
+	if create.name != nil {
+		ps = append(ps, filetype.NameEQ(*create.name))
+	}
+	if len(ps) == 0 {
+		return nil, err
+	}
+	v, qerr := (&FileTypeQuery{config: ftgoc.config}).Where(filetype.Or(ps...)).Only(ctx)
+	switch {
+	case IsNotFound(qerr):
+		return nil, err
+	case qerr != nil:
+		return nil, qerr
+	}
+	return v, nil
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ftgoc *FileTypeGetOrCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *FileType {
+	v, err := ftgoc.Save(ctx, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -18,12 +20,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/filetype"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // FileTypeDelete is the builder for deleting a FileType entity.
 type FileTypeDelete struct {
 	config
 	predicates []predicate.FileType
+	// id of the node, if it's deleted using a FileTypeDeleteOne builder.
+	id *string
 }
 
 // Where adds a new predicate to the delete builder.
@@ -33,7 +38,37 @@ func (ftd *FileTypeDelete) Where(ps ...predicate.FileType) *FileTypeDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (ftd *FileTypeDelete) Exec(ctx context.Context) (int, error) {
+func (ftd *FileTypeDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	if ftd.shadow != nil {
+		return ftd.dualExec(ctx, opts...)
+	}
+	n, err := ftd.exec(ctx)
+	if err != nil || n == 0 || options.SkipHooks {
+		return n, err
+	}
+	e := &Event{Op: ent.OpDelete, Type: filetype.Label, N: n}
+	if ftd.id != nil {
+		e.Op, e.ID = ent.OpDeleteOne, *ftd.id
+	}
+	ftd.bus.publish(e)
+	return n, nil
+}
+
+// exec deletes the FileType nodes from the storage of the client dialect.
+func (ftd *FileTypeDelete) exec(ctx context.Context) (int, error) {
+	predicates, err := interceptFileType(ctx, ftd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *ftd
+		intercepted.predicates = append(predicates, ftd.predicates...)
+		ftd = &intercepted
+	}
 	switch ftd.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ftd.sqlExec(ctx)
@@ -45,8 +80,8 @@ func (ftd *FileTypeDelete) Exec(ctx context.Context) (int, error) {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ftd *FileTypeDelete) ExecX(ctx context.Context) int {
-	n, err := ftd.Exec(ctx)
+func (ftd *FileTypeDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := ftd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,17 +89,51 @@ func (ftd *FileTypeDelete) ExecX(ctx context.Context) int {
 }
 
 func (ftd *FileTypeDelete) sqlExec(ctx context.Context) (int, error) {
-	var res sql.Result
-	selector := sql.Select().From(sql.Table(filetype.Table))
+	ctx = entcache.Invalidates(ctx, filetype.Tables...)
+	tx, err := ftd.driver.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	selector := sql.Select(filetype.FieldID).From(sql.Table(filetype.Table)).SetDialect(ftd.driver.Dialect()).WithContext(ctx)
 	for _, p := range ftd.predicates {
 		p(selector)
 	}
-	query, args := sql.Delete(filetype.Table).FromSelect(selector).Query()
-	if err := ftd.driver.Exec(ctx, query, args, &res); err != nil {
-		return 0, err
+	if err := selector.Err(); err != nil {
+		return 0, rollback(tx, err)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return 0, rollback(tx, err)
+	}
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, rollback(tx, fmt.Errorf("ent: failed reading id: %v", err))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if len(ids) == 0 {
+		return 0, tx.Commit()
+	}
+	if err := nullRefs(ctx, tx, "files", "file_type_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	var res sql.Result
+	query, args = sql.Delete(filetype.Table).Where(sql.InInts(filetype.FieldID, ids...)).Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return 0, rollback(tx, err)
 	}
 	affected, err := res.RowsAffected()
 	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
 		return 0, err
 	}
 	return int(affected), nil
@@ -72,8 +141,9 @@ func (ftd *FileTypeDelete) sqlExec(ctx context.Context) (int, error) {
 
 func (ftd *FileTypeDelete) gremlinExec(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftd.gremlin().Query()
-	if err := ftd.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftd.gremlin()
+	query, _ := traversal.Query()
+	if err := ftd.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -93,12 +163,12 @@ type FileTypeDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (ftdo *FileTypeDeleteOne) Exec(ctx context.Context) error {
-	n, err := ftdo.ftd.Exec(ctx)
+func (ftdo *FileTypeDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := ftdo.ftd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{filetype.Label}
 	default:
 		return nil
@@ -106,6 +176,8 @@ func (ftdo *FileTypeDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ftdo *FileTypeDeleteOne) ExecX(ctx context.Context) {
-	ftdo.ftd.ExecX(ctx)
+func (ftdo *FileTypeDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := ftdo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -26,14 +28,26 @@ import (
 // FileTypeQuery is the builder for querying FileType entities.
 type FileTypeQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit     *int
+	offset    *int
+	order     []Order
+	unique    []string
+	fields    []string
+	unordered bool
+	hints     []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.FileType
+	// eager-loading edges.
+	withFiles *FileQuery
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath     func(context.Context) (*sql.Selector, error)
+	gremlinPath func(context.Context) (*dsl.Traversal, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -60,25 +74,158 @@ func (ftq *FileTypeQuery) Order(o ...Order) *FileTypeQuery {
 	return ftq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored. Note that, hints are ignored by Gremlin queries.
+func (ftq *FileTypeQuery) Hint(hints ...sql.Hint) *FileTypeQuery {
+	ftq.hints = append(ftq.hints, hints...)
+	return ftq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	fts, err := client.FileType.Query().
+//		Fields(filetype.FieldName).
+//		All(ctx)
+//
+func (ftq *FileTypeQuery) Fields(fields ...string) *FileTypeQuery {
+	ftq.fields = append(ftq.fields, fields...)
+	return ftq
+}
+
+// Unordered disables the ordering by id that is added to the Gremlin queries that are paginated
+// using Limit or Offset. The order of the vertices
+// (and therefore, the content of the pages) is not guaranteed without it, but it can be removed when
+// the order is irrelevant (e.g. sampling).
+func (ftq *FileTypeQuery) Unordered() *FileTypeQuery {
+	ftq.unordered = true
+	return ftq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (ftq *FileTypeQuery) Scope(scopes ...filetype.Scope) *FileTypeQuery {
+	for _, s := range scopes {
+		ftq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				ftq.Order(Desc(o.Field))
+			} else {
+				ftq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			ftq.Limit(s.Limit)
+		}
+	}
+	return ftq
+}
+
+// FileTypeInterceptor is a query interceptor of the FileTypeClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the FileTypeClient.Intercept method.
+type FileTypeInterceptor func(context.Context, *FileTypeQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (ftq *FileTypeQuery) prepare(ctx context.Context) (*FileTypeQuery, error) {
+	var interceptors []FileTypeInterceptor
+	if ftq.inters != nil {
+		ftq.inters.mu.RLock()
+		interceptors = ftq.inters.FileType
+		ftq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && ftq.sqlPath == nil && ftq.gremlinPath == nil {
+		return ftq, nil
+	}
+	query := ftq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	if path := query.gremlinPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.gremlin, query.gremlinPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptFileType returns the predicates that the interceptors of the client add to a FileType query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptFileType(ctx context.Context, cfg config) ([]predicate.FileType, error) {
+	query, err := (&FileTypeQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryFiles chains the current query on the files edge.
 func (ftq *FileTypeQuery) QueryFiles() *FileQuery {
 	query := &FileQuery{config: ftq.config}
 	switch ftq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(file.Table)
-		t2 := ftq.sqlQuery()
-		t2.Select(t2.C(filetype.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t2).
-			On(t1.C(filetype.FilesColumn), t2.C(filetype.FieldID))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := ftq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(file.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(filetype.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t2).
+				On(t1.C(filetype.FilesColumn), t2.C(filetype.FieldID)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := ftq.gremlinQuery()
-		query.gremlin = gremlin.OutE(filetype.FilesLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := ftq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(filetype.FilesLabel).InV(), nil
+		}
 	}
 	return query
 }
 
+// WithFiles tells the query-builder to eager-load the nodes that are connected to the "files" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.FileType.Query().
+//		WithFiles(func(q *ent.FileQuery) {
+//			q.Order(ent.Desc(file.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (ftq *FileTypeQuery) WithFiles(opts ...func(*FileQuery)) *FileTypeQuery {
+	query := &FileQuery{config: ftq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ftq.withFiles = query
+	return ftq
+}
+
 // First returns the first FileType entity in the query. Returns *ErrNotFound when no filetype was found.
 func (ftq *FileTypeQuery) First(ctx context.Context) (*FileType, error) {
 	fts, err := ftq.Limit(1).All(ctx)
@@ -124,7 +271,7 @@ func (ftq *FileTypeQuery) FirstXID(ctx context.Context) string {
 
 // Only returns the only FileType entity in the query, returns an error if not exactly one entity was returned.
 func (ftq *FileTypeQuery) Only(ctx context.Context) (*FileType, error) {
-	fts, err := ftq.Limit(2).All(ctx)
+	fts, err := ftq.Limit(2).Unordered().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +297,7 @@ func (ftq *FileTypeQuery) OnlyX(ctx context.Context) *FileType {
 // OnlyID returns the only FileType id in the query, returns an error if not exactly one id was returned.
 func (ftq *FileTypeQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = ftq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = ftq.Limit(2).Unordered().IDs(ctx); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -174,33 +321,63 @@ func (ftq *FileTypeQuery) OnlyXID(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of FileTypes.
-func (ftq *FileTypeQuery) All(ctx context.Context) ([]*FileType, error) {
-	switch ftq.driver.Dialect() {
+func (ftq *FileTypeQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*FileType, error) {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.shadow != nil && !ent.NewCallOptions(opts...).SkipHooks {
+		return query.dualAll(ctx)
+	}
+
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return ftq.sqlAll(ctx)
+		return query.sqlAll(ctx)
 	case dialect.Gremlin:
-		return ftq.gremlinAll(ctx)
+		return query.gremlinAll(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
 // AllX is like All, but panics if an error occurs.
-func (ftq *FileTypeQuery) AllX(ctx context.Context) []*FileType {
-	fts, err := ftq.All(ctx)
+func (ftq *FileTypeQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*FileType {
+	fts, err := ftq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return fts
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (ftq *FileTypeQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlQueryString(ctx)
+	case dialect.Gremlin:
+		return query.gremlinQueryString(ctx)
+	default:
+		return "", nil, errors.New("ent: unsupported dialect")
+	}
+}
+
 // IDs executes the query and returns a list of FileType ids.
 func (ftq *FileTypeQuery) IDs(ctx context.Context) ([]string, error) {
-	switch ftq.driver.Dialect() {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return ftq.sqlIDs(ctx)
+		return query.sqlIDs(ctx)
 	case dialect.Gremlin:
-		return ftq.gremlinIDs(ctx)
+		return query.gremlinIDs(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
@@ -215,13 +392,46 @@ func (ftq *FileTypeQuery) IDsX(ctx context.Context) []string {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit FileTypes that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (ftq *FileTypeQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if ftq.sql != nil || ftq.gremlin != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &FileTypeQuery{
+		config:     ftq.config,
+		predicates: append([]predicate.FileType{}, ftq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(string)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for FileType", after)
+		}
+		query.Where(filetype.IDGT(id))
+	}
+	ids, err := query.Order(Asc(filetype.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (ftq *FileTypeQuery) Count(ctx context.Context) (int, error) {
-	switch ftq.driver.Dialect() {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return ftq.sqlCount(ctx)
+		return query.sqlCount(ctx)
 	case dialect.Gremlin:
-		return ftq.gremlinCount(ctx)
+		return query.gremlinCount(ctx)
 	default:
 		return 0, errors.New("ent: unsupported dialect")
 	}
@@ -238,11 +448,15 @@ func (ftq *FileTypeQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (ftq *FileTypeQuery) Exist(ctx context.Context) (bool, error) {
-	switch ftq.driver.Dialect() {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return ftq.sqlExist(ctx)
+		return query.sqlExist(ctx)
 	case dialect.Gremlin:
-		return ftq.gremlinExist(ctx)
+		return query.gremlinExist(ctx)
 	default:
 		return false, errors.New("ent: unsupported dialect")
 	}
@@ -266,10 +480,17 @@ func (ftq *FileTypeQuery) Clone() *FileTypeQuery {
 		offset:     ftq.offset,
 		order:      append([]Order{}, ftq.order...),
 		unique:     append([]string{}, ftq.unique...),
+		fields:     append([]string{}, ftq.fields...),
+		unordered:  ftq.unordered,
+		hints:      append([]sql.Hint{}, ftq.hints...),
+		edgeCount:  ftq.edgeCount,
 		predicates: append([]predicate.FileType{}, ftq.predicates...),
+		withFiles:  ftq.withFiles,
 		// clone intermediate queries.
-		sql:     ftq.sql.Clone(),
-		gremlin: ftq.gremlin.Clone(),
+		sql:         ftq.sql.Clone(),
+		sqlPath:     ftq.sqlPath,
+		gremlin:     ftq.gremlin.Clone(),
+		gremlinPath: ftq.gremlinPath,
 	}
 }
 
@@ -291,11 +512,18 @@ func (ftq *FileTypeQuery) Clone() *FileTypeQuery {
 func (ftq *FileTypeQuery) GroupBy(field string, fields ...string) *FileTypeGroupBy {
 	group := &FileTypeGroupBy{config: ftq.config}
 	group.fields = append([]string{field}, fields...)
-	switch ftq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		group.sql = ftq.sqlQuery()
-	case dialect.Gremlin:
-		group.gremlin = ftq.gremlinQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := ftq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			group.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			group.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return group
 }
@@ -315,20 +543,27 @@ func (ftq *FileTypeQuery) GroupBy(field string, fields ...string) *FileTypeGroup
 func (ftq *FileTypeQuery) Select(field string, fields ...string) *FileTypeSelect {
 	selector := &FileTypeSelect{config: ftq.config}
 	selector.fields = append([]string{field}, fields...)
-	switch ftq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		selector.sql = ftq.sqlQuery()
-	case dialect.Gremlin:
-		selector.gremlin = ftq.gremlinQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := ftq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			selector.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			selector.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return selector
 }
 
 func (ftq *FileTypeQuery) sqlAll(ctx context.Context) ([]*FileType, error) {
 	rows := &sql.Rows{}
-	selector := ftq.sqlQuery()
-	if unique := ftq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := ftq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := ftq.driver.Query(ctx, query, args, rows); err != nil {
@@ -336,21 +571,138 @@ func (ftq *FileTypeQuery) sqlAll(ctx context.Context) ([]*FileType, error) {
 	}
 	defer rows.Close()
 	var fts FileTypes
-	if err := fts.FromRows(rows); err != nil {
+	if err := fts.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	fts.config(ftq.config)
+	if query := ftq.withFiles; query != nil {
+		if err := ftq.sqlLoadFiles(ctx, query, fts); err != nil {
+			return nil, err
+		}
+	}
 	return fts, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (ftq *FileTypeQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := ftq.sqlQuery(ctx)
+	if unique := ftq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := filetype.Columns
+	if fields := ftq.fields; len(fields) > 0 {
+		columns = append([]string{filetype.FieldID}, fields...)
+		for _, c := range fields {
+			if !filetype.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (ftq *FileTypeQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := ftq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadFiles loads the nodes of the files edge of the given FileTypes using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (ftq *FileTypeQuery) sqlLoadFiles(ctx context.Context, query *FileQuery, nodes []*FileType) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*FileType, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	key := selector.C(filetype.FilesColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(file.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := ftq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.File{file.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*File, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Files = append(node.Edges.Files, edge)
+	}
+	return nil
+}
+
 func (ftq *FileTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := ftq.sqlQuery()
+	selector := ftq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{filetype.FieldID}
 	if len(ftq.unique) > 0 {
 		unique = ftq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := ftq.edgeCount; c != nil && len(ftq.predicates) == 0 && len(ftq.unique) == 0 &&
+		len(ftq.hints) == 0 && ftq.limit == nil && ftq.offset == nil {
+		selector = c.Clone().SetDialect(ftq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := ftq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -367,11 +719,22 @@ func (ftq *FileTypeQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (ftq *FileTypeQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := ftq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := ftq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(filetype.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := ftq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := ftq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (ftq *FileTypeQuery) sqlIDs(ctx context.Context) ([]string, error) {
@@ -386,13 +749,33 @@ func (ftq *FileTypeQuery) sqlIDs(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
-func (ftq *FileTypeQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (ftq *FileTypeQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := ftq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(filetype.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (ftq *FileTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(filetype.Table)
 	selector := sql.Select(t1.Columns(filetype.Columns...)...).From(t1)
 	if ftq.sql != nil {
 		selector = ftq.sql
 		selector.Select(selector.Columns(filetype.Columns...)...)
 	}
+	selector.SetDialect(ftq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(ftq.hints...)
 	for _, p := range ftq.predicates {
 		p(selector)
 	}
@@ -412,8 +795,9 @@ func (ftq *FileTypeQuery) sqlQuery() *sql.Selector {
 
 func (ftq *FileTypeQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftq.gremlinQuery().Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftq.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := ftq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	vertices, err := res.ReadVertices()
@@ -429,8 +813,9 @@ func (ftq *FileTypeQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 
 func (ftq *FileTypeQuery) gremlinAll(ctx context.Context) ([]*FileType, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftq.gremlinQuery().ValueMap(true).Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftq.gremlinAllQuery()
+	query, _ := traversal.Query()
+	if err := ftq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	var fts FileTypes
@@ -438,13 +823,53 @@ func (ftq *FileTypeQuery) gremlinAll(ctx context.Context) ([]*FileType, error) {
 		return nil, err
 	}
 	fts.config(ftq.config)
+	if query := ftq.withFiles; query != nil {
+		if err := ftq.gremlinLoadFiles(ctx, query, fts); err != nil {
+			return nil, err
+		}
+	}
 	return fts, nil
 }
 
+// gremlinAllQuery returns the traversal of All, that returns the value maps of the vertices.
+func (ftq *FileTypeQuery) gremlinAllQuery() *dsl.Traversal {
+	args := []interface{}{true}
+	for _, f := range ftq.fields {
+		args = append(args, f)
+	}
+	return ftq.gremlinQuery().ValueMap(args...)
+}
+
+func (ftq *FileTypeQuery) gremlinQueryString(context.Context) (string, interface{}, error) {
+	query, bindings := ftq.gremlinAllQuery().Query()
+	return query, bindings, nil
+}
+
+// gremlinLoadFiles loads the nodes of the files edge of the given FileTypes using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (ftq *FileTypeQuery) gremlinLoadFiles(ctx context.Context, query *FileQuery, nodes []*FileType) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&FileTypeClient{config: ftq.config}).QueryFiles(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Files = edges
+	}
+	return nil
+}
+
 func (ftq *FileTypeQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftq.gremlinQuery().Count().Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftq.gremlinTraversal(false).Count()
+	query, _ := traversal.Query()
+	if err := ftq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -452,14 +877,22 @@ func (ftq *FileTypeQuery) gremlinCount(ctx context.Context) (int, error) {
 
 func (ftq *FileTypeQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftq.gremlinQuery().HasNext().Query()
-	if err := ftq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftq.gremlinTraversal(false).HasNext()
+	query, _ := traversal.Query()
+	if err := ftq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
 }
 
+// gremlinQuery returns the traversal of the query for listing its vertices, with its default orders.
 func (ftq *FileTypeQuery) gremlinQuery() *dsl.Traversal {
+	return ftq.gremlinTraversal(true)
+}
+
+// gremlinTraversal returns the traversal of the query. The default orders are added only to the traversals
+// of listing queries (list), since they don't change the results of the others (e.g. Count and Exist).
+func (ftq *FileTypeQuery) gremlinTraversal(list bool) *dsl.Traversal {
 	v := g.V().HasLabel(filetype.Label)
 	if ftq.gremlin != nil {
 		v = ftq.gremlin.Clone()
@@ -467,13 +900,22 @@ func (ftq *FileTypeQuery) gremlinQuery() *dsl.Traversal {
 	for _, p := range ftq.predicates {
 		p(v)
 	}
-	if len(ftq.order) > 0 {
+	limit, offset := ftq.limit, ftq.offset
+	list = list && !ftq.unordered
+	// paginated queries are ordered by id (also as a tie-breaker of the
+	// query orders), in order to keep the pages stable between calls.
+	stable := (limit != nil || offset != nil) && list
+	order := ftq.order
+	if len(order) > 0 || stable {
 		v.Order()
-		for _, p := range ftq.order {
+		for _, p := range order {
 			p(v)
 		}
+		if stable {
+			v.By(dsl.ID, dsl.Incr)
+		}
 	}
-	switch limit, offset := ftq.limit, ftq.offset; {
+	switch {
 	case limit != nil && offset != nil:
 		v.Range(*offset, *offset+*limit)
 	case offset != nil:
@@ -495,6 +937,11 @@ type FileTypeGroupBy struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving     *sql.Predicate
+	gremlinHaving *dsl.Traversal
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -503,8 +950,27 @@ func (ftgb *FileTypeGroupBy) Aggregate(fns ...Aggregate) *FileTypeGroupBy {
 	return ftgb
 }
 
+// Having adds per-dialect predicates on the aggregated values of the groups.
+// In Gremlin, the predicate is applied using a `where` step on the values of each group.
+// For example:
+//
+//	client.FileType.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5), __.Select(ent.DefaultCountLabel).Is(p.GT(5))).
+//		Scan(ctx, &v)
+//
+func (ftgb *FileTypeGroupBy) Having(p0 *sql.Predicate, p1 *dsl.Traversal) *FileTypeGroupBy {
+	ftgb.sqlHaving = p0
+	ftgb.gremlinHaving = p1
+	return ftgb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (ftgb *FileTypeGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := ftgb.path(ctx); err != nil {
+		return err
+	}
 	switch ftgb.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ftgb.sqlScan(ctx, v)
@@ -607,6 +1073,9 @@ func (ftgb *FileTypeGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (ftgb *FileTypeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ftgb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ftgb.sqlQuery().Query()
 	if err := ftgb.driver.Query(ctx, query, args, rows); err != nil {
@@ -623,13 +1092,18 @@ func (ftgb *FileTypeGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range ftgb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(ftgb.fields...)
+	selector.Select(columns...).GroupBy(ftgb.fields...)
+	if ftgb.sqlHaving != nil {
+		selector.Having(ftgb.sqlHaving)
+	}
+	return selector
 }
 
 func (ftgb *FileTypeGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
-	query, bindings := ftgb.gremlinQuery().Query()
-	if err := ftgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftgb.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := ftgb.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(ftgb.fields)+len(ftgb.fns) == 1 {
@@ -656,11 +1130,14 @@ func (ftgb *FileTypeGroupBy) gremlinQuery() *dsl.Traversal {
 		names = append(names, f)
 		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
 	}
-	return ftgb.gremlin.Group().
+	v := ftgb.gremlin.Group().
 		By(__.Values(ftgb.fields...).Fold()).
 		By(__.Fold().Match(trs...).Select(names...)).
-		Select(dsl.Values).
-		Next()
+		Select(dsl.Values)
+	if ftgb.gremlinHaving != nil {
+		v = v.Unfold().Where(ftgb.gremlinHaving).Fold()
+	}
+	return v.Next()
 }
 
 // FileTypeSelect is the builder for select fields of FileType entities.
@@ -670,10 +1147,15 @@ type FileTypeSelect struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (fts *FileTypeSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := fts.path(ctx); err != nil {
+		return err
+	}
 	switch fts.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return fts.sqlScan(ctx, v)
@@ -776,6 +1258,9 @@ func (fts *FileTypeSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (fts *FileTypeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := fts.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := fts.sqlQuery().Query()
 	if err := fts.driver.Query(ctx, query, args, rows); err != nil {
@@ -804,8 +1289,8 @@ func (fts *FileTypeSelect) gremlinScan(ctx context.Context, v interface{}) error
 		}
 		traversal = fts.gremlin.ValueMap(fields...)
 	}
-	query, bindings := traversal.Query()
-	if err := fts.driver.Exec(ctx, query, bindings, res); err != nil {
+	query, _ := traversal.Query()
+	if err := fts.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(fts.fields) == 1 {
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -22,15 +23,17 @@ import (
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
 	"github.com/facebookincubator/ent/entc/integration/ent/filetype"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // FileTypeUpdate is the builder for updating FileType entities.
 type FileTypeUpdate struct {
 	config
-	name         *string
-	files        map[string]struct{}
-	removedFiles map[string]struct{}
-	predicates   []predicate.FileType
+	filetypeMutation
+	predicates []predicate.FileType
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*FileType
 }
 
 // Where adds a new predicate for the builder.
@@ -39,6 +42,11 @@ func (ftu *FileTypeUpdate) Where(ps ...predicate.FileType) *FileTypeUpdate {
 	return ftu
 }
 
+// Mutation returns the mutation of the builder.
+func (ftu *FileTypeUpdate) Mutation() *FileTypeMutation {
+	return &FileTypeMutation{filetypeMutation: &ftu.filetypeMutation, op: ent.OpUpdate}
+}
+
 // SetName sets the name field.
 func (ftu *FileTypeUpdate) SetName(s string) *FileTypeUpdate {
 	ftu.name = &s
@@ -65,6 +73,12 @@ func (ftu *FileTypeUpdate) AddFiles(f ...*File) *FileTypeUpdate {
 	return ftu.AddFileIDs(ids...)
 }
 
+// ClearFiles clears all files edges to File.
+func (ftu *FileTypeUpdate) ClearFiles() *FileTypeUpdate {
+	ftu.clearedFiles = true
+	return ftu
+}
+
 // RemoveFileIDs removes the files edge to File by ids.
 func (ftu *FileTypeUpdate) RemoveFileIDs(ids ...string) *FileTypeUpdate {
 	if ftu.removedFiles == nil {
@@ -86,20 +100,68 @@ func (ftu *FileTypeUpdate) RemoveFiles(f ...*File) *FileTypeUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (ftu *FileTypeUpdate) Save(ctx context.Context) (int, error) {
+func (ftu *FileTypeUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := ftu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (ftu *FileTypeUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]string, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if ftu.shadow != nil {
+		return ftu.dualSave(ctx, opts...)
+	}
+	ids, err := ftu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 && !options.SkipHooks {
+		ftu.bus.publish(&Event{Op: ent.OpUpdate, Type: filetype.Label, N: len(ids)})
+	}
+	return ids, nil
+}
+
+// save updates the FileType nodes in the storage of the client dialect.
+func (ftu *FileTypeUpdate) save(ctx context.Context) ([]string, error) {
+	predicates, err := interceptFileType(ctx, ftu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *ftu
+		intercepted.predicates = append(predicates, ftu.predicates...)
+		ftu = &intercepted
+	}
 	switch ftu.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ftu.sqlSave(ctx)
 	case dialect.Gremlin:
 		return ftu.gremlinSave(ctx)
 	default:
-		return 0, errors.New("ent: unsupported dialect")
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (ftu *FileTypeUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*FileType, error) {
+	var fts []*FileType
+	ftu.returning = &fts
+	defer func() { ftu.returning = nil }()
+	if _, err := ftu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
 	}
+	return fts, nil
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (ftu *FileTypeUpdate) SaveX(ctx context.Context) int {
-	affected, err := ftu.Save(ctx)
+func (ftu *FileTypeUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := ftu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -107,44 +169,49 @@ func (ftu *FileTypeUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (ftu *FileTypeUpdate) Exec(ctx context.Context) error {
-	_, err := ftu.Save(ctx)
+func (ftu *FileTypeUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := ftu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ftu *FileTypeUpdate) ExecX(ctx context.Context) {
-	if err := ftu.Exec(ctx); err != nil {
+func (ftu *FileTypeUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := ftu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(filetype.FieldID).From(sql.Table(filetype.Table))
+func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (_ []string, err error) {
+	ctx = entcache.Invalidates(ctx, filetype.Tables...)
+	selector := sql.Select(filetype.FieldID).From(sql.Table(filetype.Table)).SetDialect(ftu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range ftu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = ftu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := ftu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -156,11 +223,19 @@ func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if len(ftu.removedFiles) > 0 {
-		eids := make([]int, len(ftu.removedFiles))
+	if ftu.clearedFiles {
+		query, args := sql.Update(filetype.FilesTable).
+			SetNull(filetype.FilesColumn).
+			Where(sql.InInts(filetype.FilesColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(ftu.removedFiles) > 0 {
+		eids := make([]int, 0, len(ftu.removedFiles))
 		for eid := range ftu.removedFiles {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -175,7 +250,7 @@ func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(file.FieldID, eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(ftu.files) > 0 {
@@ -194,33 +269,78 @@ func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.And(p, sql.IsNull(filetype.FilesColumn))).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(ftu.files) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"FileType\"", keys(ftu.files))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"FileType\"", keys(ftu.files))})
 			}
 		}
 	}
+	if ftu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(filetype.Columns...).
+			From(sql.Table(filetype.Table)).
+			Where(sql.InInts(filetype.FieldID, ids...)).
+			SetDialect(ftu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var fts FileTypes
+		err := fts.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into FileType: %v", err))
+		}
+		fts.config(ftu.config)
+		*ftu.returning = fts
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	vs := make([]string, len(ids))
+	for i, id := range ids {
+		vs[i] = strconv.Itoa(id)
+	}
+	return vs, nil
 }
 
-func (ftu *FileTypeUpdate) gremlinSave(ctx context.Context) (int, error) {
+func (ftu *FileTypeUpdate) gremlinSave(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftu.gremlin().Query()
-	if err := ftu.driver.Exec(ctx, query, bindings, res); err != nil {
-		return 0, err
+	traversal := ftu.gremlin()
+	query, _ := traversal.Query()
+	if err := ftu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
-		return 0, err
+		return nil, err
 	}
-	return res.ReadInt()
+	if ftu.returning != nil {
+		var fts FileTypes
+		if err := fts.FromResponse(res); err != nil {
+			return nil, err
+		}
+		fts.config(ftu.config)
+		*ftu.returning = fts
+		ids := make([]string, 0, len(fts))
+		for _, ft := range fts {
+			ids = append(ids, ft.ID)
+		}
+		return ids, nil
+	}
+	vertices, err := res.ReadVertices()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vertices))
+	for _, vertex := range vertices {
+		ids = append(ids, vertex.ID.(string))
+	}
+	return ids, nil
 }
 
 func (ftu *FileTypeUpdate) gremlin() *dsl.Traversal {
@@ -246,6 +366,10 @@ func (ftu *FileTypeUpdate) gremlin() *dsl.Traversal {
 		})
 		v.Property(dsl.Single, filetype.FieldName, *value)
 	}
+	if ftu.clearedFiles {
+		tr := rv.Clone().OutE(filetype.FilesLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range ftu.removedFiles {
 		tr := rv.Clone().OutE(filetype.FilesLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -257,11 +381,13 @@ func (ftu *FileTypeUpdate) gremlin() *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(filetype.Label, filetype.FilesLabel, id)),
 		})
 	}
-	v.Count()
+	if ftu.returning != nil {
+		v.ValueMap(true)
+	}
 	if len(constraints) > 0 {
 		constraints = append(constraints, &constraint{
 			pred: rv.Count(),
-			test: __.Is(p.GT(1)).Constant(&ErrConstraintFailed{msg: "update traversal contains more than one vertex"}),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
 		})
 		v = constraints[0].pred.Coalesce(constraints[0].test, v)
 		for _, cr := range constraints[1:] {
@@ -275,10 +401,16 @@ func (ftu *FileTypeUpdate) gremlin() *dsl.Traversal {
 // FileTypeUpdateOne is the builder for updating a single FileType entity.
 type FileTypeUpdateOne struct {
 	config
-	id           string
-	name         *string
-	files        map[string]struct{}
-	removedFiles map[string]struct{}
+	id     string
+	entity *FileType
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.FileType
+	filetypeMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (ftuo *FileTypeUpdateOne) Mutation() *FileTypeMutation {
+	return &FileTypeMutation{filetypeMutation: &ftuo.filetypeMutation, op: ent.OpUpdateOne, id: &ftuo.id}
 }
 
 // SetName sets the name field.
@@ -307,6 +439,12 @@ func (ftuo *FileTypeUpdateOne) AddFiles(f ...*File) *FileTypeUpdateOne {
 	return ftuo.AddFileIDs(ids...)
 }
 
+// ClearFiles clears all files edges to File.
+func (ftuo *FileTypeUpdateOne) ClearFiles() *FileTypeUpdateOne {
+	ftuo.clearedFiles = true
+	return ftuo
+}
+
 // RemoveFileIDs removes the files edge to File by ids.
 func (ftuo *FileTypeUpdateOne) RemoveFileIDs(ids ...string) *FileTypeUpdateOne {
 	if ftuo.removedFiles == nil {
@@ -327,8 +465,54 @@ func (ftuo *FileTypeUpdateOne) RemoveFiles(f ...*File) *FileTypeUpdateOne {
 	return ftuo.RemoveFileIDs(ids...)
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (ftuo *FileTypeUpdateOne) SetChangedFrom(original *FileType) *FileTypeUpdateOne {
+	ft := ftuo.entity
+	if ft == nil || original == nil {
+		return ftuo
+	}
+	for _, change := range DiffFileType(original, ft) {
+		switch change.Field {
+		case filetype.FieldName:
+			ftuo.SetName(ft.Name)
+		}
+	}
+	return ftuo
+}
+
 // Save executes the query and returns the updated entity.
-func (ftuo *FileTypeUpdateOne) Save(ctx context.Context) (*FileType, error) {
+func (ftuo *FileTypeUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*FileType, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if ftuo.shadow != nil {
+		return ftuo.dualSave(ctx, opts...)
+	}
+	ft, err := ftuo.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		ftuo.bus.publish(&Event{Op: ent.OpUpdateOne, Type: filetype.Label, ID: ft.ID, Node: ft, N: 1})
+	}
+	return ft, nil
+}
+
+// save updates the FileType in the storage of the client dialect.
+func (ftuo *FileTypeUpdateOne) save(ctx context.Context) (*FileType, error) {
+	predicates, err := interceptFileType(ctx, ftuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *ftuo
+		intercepted.predicates = append(predicates, ftuo.predicates...)
+		ftuo = &intercepted
+	}
 	switch ftuo.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ftuo.sqlSave(ctx)
@@ -340,8 +524,8 @@ func (ftuo *FileTypeUpdateOne) Save(ctx context.Context) (*FileType, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (ftuo *FileTypeUpdateOne) SaveX(ctx context.Context) *FileType {
-	ft, err := ftuo.Save(ctx)
+func (ftuo *FileTypeUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *FileType {
+	ft, err := ftuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -349,21 +533,29 @@ func (ftuo *FileTypeUpdateOne) SaveX(ctx context.Context) *FileType {
 }
 
 // Exec executes the query on the entity.
-func (ftuo *FileTypeUpdateOne) Exec(ctx context.Context) error {
-	_, err := ftuo.Save(ctx)
+func (ftuo *FileTypeUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := ftuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ftuo *FileTypeUpdateOne) ExecX(ctx context.Context) {
-	if err := ftuo.Exec(ctx); err != nil {
+func (ftuo *FileTypeUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := ftuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (ft *FileType, err error) {
-	selector := sql.Select(filetype.Columns...).From(sql.Table(filetype.Table))
+	ctx = entcache.Invalidates(ctx, filetype.Tables...)
+	selector := sql.Select(filetype.Columns...).From(sql.Table(filetype.Table)).SetDialect(ftuo.driver.Dialect())
 	filetype.ID(ftuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range ftuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = ftuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -405,8 +597,16 @@ func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (ft *FileType, err e
 			return nil, rollback(tx, err)
 		}
 	}
-	if len(ftuo.removedFiles) > 0 {
-		eids := make([]int, len(ftuo.removedFiles))
+	if ftuo.clearedFiles {
+		query, args := sql.Update(filetype.FilesTable).
+			SetNull(filetype.FilesColumn).
+			Where(sql.InInts(filetype.FilesColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(ftuo.removedFiles) > 0 {
+		eids := make([]int, 0, len(ftuo.removedFiles))
 		for eid := range ftuo.removedFiles {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -447,7 +647,7 @@ func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (ft *FileType, err e
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(ftuo.files) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"FileType\"", keys(ftuo.files))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"FileType\"", keys(ftuo.files))})
 			}
 		}
 	}
@@ -459,8 +659,9 @@ func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (ft *FileType, err e
 
 func (ftuo *FileTypeUpdateOne) gremlinSave(ctx context.Context) (*FileType, error) {
 	res := &gremlin.Response{}
-	query, bindings := ftuo.gremlin(ftuo.id).Query()
-	if err := ftuo.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ftuo.gremlin(ftuo.id)
+	query, _ := traversal.Query()
+	if err := ftuo.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -480,6 +681,9 @@ func (ftuo *FileTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 2)
 	v := g.V(id)
+	for _, p := range ftuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -493,6 +697,10 @@ func (ftuo *FileTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 		})
 		v.Property(dsl.Single, filetype.FieldName, *value)
 	}
+	if ftuo.clearedFiles {
+		tr := rv.Clone().OutE(filetype.FilesLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range ftuo.removedFiles {
 		tr := rv.Clone().OutE(filetype.FilesLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/ent/group"
 )
 
 // Group is the model entity for the Group schema.
@@ -31,10 +32,31 @@ type Group struct {
 	MaxUsers int `json:"max_users,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the GroupQuery when eager-loading is set.
+	Edges GroupEdges `json:"edges"`
+}
+
+// GroupEdges holds the relations/edges of other nodes in the graph.
+type GroupEdges struct {
+	// Files holds the value of the files edge.
+	Files []*File `json:"files,omitempty"`
+	// Blocked holds the value of the blocked edge.
+	Blocked []*User `json:"blocked,omitempty"`
+	// Users holds the value of the users edge.
+	Users []*User `json:"users,omitempty"`
+	// Info holds the value of the info edge.
+	Info *GroupInfo `json:"info,omitempty"`
 }
 
 // FromRows scans the sql response data into Group.
 func (gr *Group) FromRows(rows *sql.Rows) error {
+	return gr.scan(rows, group.Columns)
+}
+
+// scan scans the given columns of the sql response data into Group.
+// Fields that their columns were not selected are left with their zero values.
+func (gr *Group) scan(rows *sql.Rows, columns []string) error {
 	var vgr struct {
 		ID       int
 		Active   sql.NullBool
@@ -43,15 +65,26 @@ func (gr *Group) FromRows(rows *sql.Rows) error {
 		MaxUsers sql.NullInt64
 		Name     sql.NullString
 	}
-	// the order here should be the same as in the `group.Columns`.
-	if err := rows.Scan(
-		&vgr.ID,
-		&vgr.Active,
-		&vgr.Expire,
-		&vgr.Type,
-		&vgr.MaxUsers,
-		&vgr.Name,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case group.FieldID:
+			values[i] = &vgr.ID
+		case group.FieldActive:
+			values[i] = &vgr.Active
+		case group.FieldExpire:
+			values[i] = &vgr.Expire
+		case group.FieldType:
+			values[i] = &vgr.Type
+		case group.FieldMaxUsers:
+			values[i] = &vgr.MaxUsers
+		case group.FieldName:
+			values[i] = &vgr.Name
+		default:
+			return fmt.Errorf("unexpected column %q for type Group", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	gr.ID = strconv.Itoa(vgr.ID)
@@ -73,19 +106,19 @@ func (gr *Group) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var vgr struct {
-		ID       string  `json:"id,omitempty"`
-		Active   bool    `json:"active,omitempty"`
-		Expire   int64   `json:"expire,omitempty"`
-		Type     *string `json:"type,omitempty"`
-		MaxUsers int     `json:"max_users,omitempty"`
-		Name     string  `json:"name,omitempty"`
+		ID       string    `json:"id,omitempty"`
+		Active   bool      `json:"active,omitempty"`
+		Expire   time.Time `json:"expire,omitempty"`
+		Type     *string   `json:"type,omitempty"`
+		MaxUsers int       `json:"max_users,omitempty"`
+		Name     string    `json:"name,omitempty"`
 	}
 	if err := vmap.Decode(&vgr); err != nil {
 		return err
 	}
 	gr.ID = vgr.ID
 	gr.Active = vgr.Active
-	gr.Expire = time.Unix(0, vgr.Expire)
+	gr.Expire = vgr.Expire
 	gr.Type = vgr.Type
 	gr.MaxUsers = vgr.MaxUsers
 	gr.Name = vgr.Name
@@ -130,6 +163,52 @@ func (gr *Group) Unwrap() *Group {
 	return gr
 }
 
+// Clone returns a deep copy of the Group, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (gr *Group) Clone() *Group {
+	return gr.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Group, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (gr *Group) clone(seen map[interface{}]interface{}) *Group {
+	if gr == nil {
+		return nil
+	}
+	if _c, ok := seen[gr]; ok {
+		return _c.(*Group)
+	}
+	_c := *gr
+	_c.config = config{}
+	seen[gr] = &_c
+	if v := gr.Type; v != nil {
+		_v := *v
+		_c.Type = &_v
+	}
+	if nodes := gr.Edges.Files; nodes != nil {
+		_c.Edges.Files = make([]*File, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Files[_i] = _n.clone(seen)
+		}
+	}
+	if nodes := gr.Edges.Blocked; nodes != nil {
+		_c.Edges.Blocked = make([]*User, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Blocked[_i] = _n.clone(seen)
+		}
+	}
+	if nodes := gr.Edges.Users; nodes != nil {
+		_c.Edges.Users = make([]*User, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Users[_i] = _n.clone(seen)
+		}
+	}
+	_c.Edges.Info = gr.Edges.Info.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (gr *Group) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -146,6 +225,29 @@ func (gr *Group) String() string {
 	return buf.String()
 }
 
+// DiffGroup returns the fields that have different values in the given Groups,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffGroup(a, b *Group) []FieldChange {
+	var changes []FieldChange
+	if a.Active != b.Active {
+		changes = append(changes, FieldChange{Field: group.FieldActive, Old: a.Active, New: b.Active})
+	}
+	if !a.Expire.Equal(b.Expire) {
+		changes = append(changes, FieldChange{Field: group.FieldExpire, Old: a.Expire, New: b.Expire})
+	}
+	if (a.Type == nil) != (b.Type == nil) || a.Type != nil && (*a.Type) != (*b.Type) {
+		changes = append(changes, FieldChange{Field: group.FieldType, Old: a.Type, New: b.Type})
+	}
+	if a.MaxUsers != b.MaxUsers {
+		changes = append(changes, FieldChange{Field: group.FieldMaxUsers, Old: a.MaxUsers, New: b.MaxUsers})
+	}
+	if a.Name != b.Name {
+		changes = append(changes, FieldChange{Field: group.FieldName, Old: a.Name, New: b.Name})
+	}
+	return changes
+}
+
 // id returns the int representation of the ID field.
 func (gr *Group) id() int {
 	id, _ := strconv.Atoi(gr.ID)
@@ -157,9 +259,14 @@ type Groups []*Group
 
 // FromRows scans the sql response data into Groups.
 func (gr *Groups) FromRows(rows *sql.Rows) error {
+	return gr.scan(rows, group.Columns)
+}
+
+// scan scans the given columns of the sql response data into Groups.
+func (gr *Groups) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vgr := &Group{}
-		if err := vgr.FromRows(rows); err != nil {
+		if err := vgr.scan(rows, columns); err != nil {
 			return err
 		}
 		*gr = append(*gr, vgr)
@@ -174,12 +281,12 @@ func (gr *Groups) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var vgr []struct {
-		ID       string  `json:"id,omitempty"`
-		Active   bool    `json:"active,omitempty"`
-		Expire   int64   `json:"expire,omitempty"`
-		Type     *string `json:"type,omitempty"`
-		MaxUsers int     `json:"max_users,omitempty"`
-		Name     string  `json:"name,omitempty"`
+		ID       string    `json:"id,omitempty"`
+		Active   bool      `json:"active,omitempty"`
+		Expire   time.Time `json:"expire,omitempty"`
+		Type     *string   `json:"type,omitempty"`
+		MaxUsers int       `json:"max_users,omitempty"`
+		Name     string    `json:"name,omitempty"`
 	}
 	if err := vmap.Decode(&vgr); err != nil {
 		return err
@@ -188,7 +295,7 @@ func (gr *Groups) FromResponse(res *gremlin.Response) error {
 		*gr = append(*gr, &Group{
 			ID:       v.ID,
 			Active:   v.Active,
-			Expire:   time.Unix(0, v.Expire),
+			Expire:   v.Expire,
 			Type:     v.Type,
 			MaxUsers: v.MaxUsers,
 			Name:     v.Name,
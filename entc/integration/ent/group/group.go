@@ -7,7 +7,12 @@
 package group
 
 import (
+	"fmt"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
 )
 
 const (
@@ -43,7 +48,7 @@ const (
 	// BlockedColumn is the table column denoting the blocked relation/edge.
 	BlockedColumn = "group_blocked_id"
 	// UsersTable is the table the holds the users relation/edge. The primary key declared below.
-	UsersTable = "user_groups"
+	UsersTable = "group_members"
 	// UsersInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	UsersInverseTable = "users"
@@ -75,10 +80,29 @@ var Columns = []string{
 	FieldName,
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Tables holds the tables that may be changed by the mutations of the group. The mutations
+// invalidate only the query results of these tables that were cached by the entcache driver.
+var Tables = []string{
+	"groups",
+	"files",
+	"users",
+	"group_members",
+}
+
 var (
 	// UsersPrimaryKey and UsersColumn2 are the table columns denoting the
 	// primary key for the users relation (M2M).
-	UsersPrimaryKey = []string{"user_id", "group_id"}
+	UsersPrimaryKey = []string{"member_id", "group_id"}
 )
 
 var (
@@ -120,3 +144,128 @@ var (
 		}
 	}()
 )
+
+// Validators holds the schema-level validators of the group mutations. They are called by the builders before save.
+var Validators = schema.Group{}.Validators()
+
+// Scope is a reusable named scope of the Group queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.Group
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the Group type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "Group",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeString,
+		GoType:     "string",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "active",
+			StorageKey: FieldActive,
+			Type:       field.TypeBool,
+			GoType:     "bool",
+			Default:    true,
+		},
+		{
+			Name:       "expire",
+			StorageKey: FieldExpire,
+			Type:       field.TypeTime,
+			GoType:     "time.Time",
+		},
+		{
+			Name:       "type",
+			StorageKey: FieldType,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+			Nillable:   true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(string)
+				if !ok {
+					return fmt.Errorf("group: unexpected type %T for field type", v)
+				}
+				return TypeValidator(vv)
+			},
+		},
+		{
+			Name:       "max_users",
+			StorageKey: FieldMaxUsers,
+			Type:       field.TypeInt,
+			GoType:     "int",
+			Optional:   true,
+			Default:    true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(int)
+				if !ok {
+					return fmt.Errorf("group: unexpected type %T for field max_users", v)
+				}
+				return MaxUsersValidator(vv)
+			},
+		},
+		{
+			Name:       "name",
+			StorageKey: FieldName,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(string)
+				if !ok {
+					return fmt.Errorf("group: unexpected type %T for field name", v)
+				}
+				return NameValidator(vv)
+			},
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "files",
+			Type:     "File",
+			Rel:      "O2M",
+			Optional: true,
+			Table:    "files",
+			Columns:  []string{"group_file_id"},
+		},
+		{
+			Name:     "blocked",
+			Type:     "User",
+			Rel:      "O2M",
+			Optional: true,
+			Table:    "users",
+			Columns:  []string{"group_blocked_id"},
+		},
+		{
+			Name:     "users",
+			Type:     "User",
+			Rel:      "M2M",
+			Optional: true,
+			Inverse:  true,
+			Table:    "group_members",
+			Columns:  []string{"member_id", "group_id"},
+		},
+		{
+			Name:    "info",
+			Type:    "GroupInfo",
+			Rel:     "M2O",
+			Unique:  true,
+			Table:   "groups",
+			Columns: []string{"info_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the Group type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
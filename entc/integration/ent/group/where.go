@@ -7,7 +7,11 @@
 package group
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -536,6 +540,30 @@ func TypeNotNil() predicate.Group {
 	)
 }
 
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.Group {
+	return predicate.GroupPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldType), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldType, p.EqualFold(v))
+		},
+	)
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.Group {
+	return predicate.GroupPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldType), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldType, p.ContainsFold(v))
+		},
+	)
+}
+
 // MaxUsersEQ applies the EQ predicate on the "max_users" field.
 func MaxUsersEQ(v int) predicate.Group {
 	return predicate.GroupPerDialect(
@@ -828,6 +856,49 @@ func NameHasSuffix(v string) predicate.Group {
 	)
 }
 
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Group {
+	return predicate.GroupPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldName), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldName, p.EqualFold(v))
+		},
+	)
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Group {
+	return predicate.GroupPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldName), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldName, p.ContainsFold(v))
+		},
+	)
+}
+
+// ExpireBetween applies the Between predicate on the "expire" field.
+// The range is inclusive, and it's identical to ExpireGTE(a) and ExpireLTE(b).
+func ExpireBetween(a, b time.Time) predicate.Group {
+	return predicate.GroupPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.Between(s.C(FieldExpire), a, b))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldExpire, p.GTE(a)).Has(Label, FieldExpire, p.LTE(b))
+		},
+	)
+}
+
+// ExpireWithinLast applies a predicate on the "expire" field, that checks if its value
+// is within the last d duration. It's identical to ExpireGTE(time.Now().Add(-d)).
+func ExpireWithinLast(d time.Duration) predicate.Group {
+	return ExpireGTE(time.Now().Add(-d))
+}
+
 // HasFiles applies the HasEdge predicate on the "files" edge.
 func HasFiles() predicate.Group {
 	return predicate.GroupPerDialect(
@@ -848,15 +919,38 @@ func HasFiles() predicate.Group {
 	)
 }
 
+// HasNoFiles applies a predicate that checks that the "files" edge is empty. It's identical
+// to Not(HasFiles()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the Group nodes.
+func HasNoFiles() predicate.Group {
+	return predicate.GroupPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(FilesColumn).
+						From(sql.Table(FilesTable)).
+						Where(sql.NotNull(FilesColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(FilesLabel))
+		},
+	)
+}
+
 // HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
 func HasFilesWith(preds ...predicate.File) predicate.Group {
 	return predicate.GroupPerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(FilesColumn).From(sql.Table(FilesTable))
+			t2 := sql.Select(FilesColumn).From(sql.Table(FilesTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(FieldID), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -889,15 +983,38 @@ func HasBlocked() predicate.Group {
 	)
 }
 
+// HasNoBlocked applies a predicate that checks that the "blocked" edge is empty. It's identical
+// to Not(HasBlocked()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the Group nodes.
+func HasNoBlocked() predicate.Group {
+	return predicate.GroupPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(BlockedColumn).
+						From(sql.Table(BlockedTable)).
+						Where(sql.NotNull(BlockedColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(BlockedLabel))
+		},
+	)
+}
+
 // HasBlockedWith applies the HasEdge predicate on the "blocked" edge with a given conditions (other predicates).
 func HasBlockedWith(preds ...predicate.User) predicate.Group {
 	return predicate.GroupPerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(BlockedColumn).From(sql.Table(BlockedTable))
+			t2 := sql.Select(BlockedColumn).From(sql.Table(BlockedTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(FieldID), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -928,6 +1045,26 @@ func HasUsers() predicate.Group {
 	)
 }
 
+// HasNoUsers applies a predicate that checks that the "users" edge is empty. It's identical
+// to Not(HasUsers()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the Group nodes.
+func HasNoUsers() predicate.Group {
+	return predicate.GroupPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(UsersPrimaryKey[1]).From(sql.Table(UsersTable)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.InE(UsersInverseLabel))
+		},
+	)
+}
+
 // HasUsersWith applies the HasEdge predicate on the "users" edge with a given conditions (other predicates).
 func HasUsersWith(preds ...predicate.User) predicate.Group {
 	return predicate.GroupPerDialect(
@@ -939,10 +1076,11 @@ func HasUsersWith(preds ...predicate.User) predicate.Group {
 				From(t3).
 				Join(t2).
 				On(t3.C(UsersPrimaryKey[0]), t2.C(FieldID))
-			t5 := sql.Select().From(t2)
+			t5 := sql.Select().From(t2).WithContext(s.Context())
 			for _, p := range preds {
 				p(t5)
 			}
+			s.AddError(t5.Err())
 			t4.FromSelect(t5)
 			s.Where(sql.In(t1.C(FieldID), t4))
 		},
@@ -969,15 +1107,31 @@ func HasInfo() predicate.Group {
 	)
 }
 
+// HasNoInfo applies a predicate that checks that the "info" edge is empty. It's identical
+// to Not(HasInfo()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the Group nodes.
+func HasNoInfo() predicate.Group {
+	return predicate.GroupPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(sql.IsNull(t1.C(InfoColumn)))
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(InfoLabel))
+		},
+	)
+}
+
 // HasInfoWith applies the HasEdge predicate on the "info" edge with a given conditions (other predicates).
 func HasInfoWith(preds ...predicate.GroupInfo) predicate.Group {
 	return predicate.GroupPerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(FieldID).From(sql.Table(InfoInverseTable))
+			t2 := sql.Select(FieldID).From(sql.Table(InfoInverseTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(InfoColumn), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -990,6 +1144,425 @@ func HasInfoWith(preds ...predicate.GroupInfo) predicate.Group {
 	)
 }
 
+// ByFilesCount orders the results by the number of "files" edges,
+// in descending order if desc is true. It's used as an order option of the
+// Group queries. For example:
+//
+//	client.Group.Query().Order(group.ByFilesCount(true))
+//
+func ByFilesCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(FilesTable).As("files_count")
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(FilesColumn), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.OutE(FilesLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// ByBlockedCount orders the results by the number of "blocked" edges,
+// in descending order if desc is true. It's used as an order option of the
+// Group queries. For example:
+//
+//	client.Group.Query().Order(group.ByBlockedCount(true))
+//
+func ByBlockedCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(BlockedTable).As("blocked_count")
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(BlockedColumn), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.OutE(BlockedLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// ByUsersCount orders the results by the number of "users" edges,
+// in descending order if desc is true. It's used as an order option of the
+// Group queries. For example:
+//
+//	client.Group.Query().Order(group.ByUsersCount(true))
+//
+func ByUsersCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(UsersTable)
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(UsersPrimaryKey[1]), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.InE(UsersInverseLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// PredicateFunc returns a predicate from the given per-dialect functions.
+// It allows adding custom SQL/Gremlin fragments to the Group builders.
+func PredicateFunc(f0 func(*sql.Selector), f1 func(*dsl.Traversal)) predicate.Group {
+	return predicate.GroupPerDialect(f0, f1)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.Group, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.Group, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.Group, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldActive:
+		switch op {
+		case "eq":
+			if v, ok := value.(bool); ok || convert(value, &v) {
+				return ActiveEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(bool); ok || convert(value, &v) {
+				return ActiveNEQ(v), nil
+			}
+		}
+	case FieldExpire:
+		switch op {
+		case "eq":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return ExpireEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return ExpireNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]time.Time); ok || convert(value, &vs) {
+				return ExpireIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]time.Time); ok || convert(value, &vs) {
+				return ExpireNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return ExpireGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return ExpireGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return ExpireLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return ExpireLTE(v), nil
+			}
+		}
+	case FieldType:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return TypeEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return TypeNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return TypeIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return TypeNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return TypeGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return TypeGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return TypeLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return TypeLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return TypeContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return TypeHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return TypeHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return TypeIsNil(), nil
+				}
+				return Not(TypeIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return TypeNotNil(), nil
+				}
+				return Not(TypeNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return TypeEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return TypeContainsFold(v), nil
+			}
+		}
+	case FieldMaxUsers:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return MaxUsersIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return MaxUsersNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return MaxUsersIsNil(), nil
+				}
+				return Not(MaxUsersIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return MaxUsersNotNil(), nil
+				}
+				return Not(MaxUsersNotNil()), nil
+			}
+		}
+	case FieldName:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasSuffix(v), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContainsFold(v), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("group: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Group) predicate.Group {
 	return predicate.GroupPerDialect(
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -22,21 +23,22 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
 	"github.com/facebookincubator/ent/entc/integration/ent/group"
+	"github.com/facebookincubator/ent/entc/integration/ent/groupinfo"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // GroupCreate is the builder for creating a Group entity.
 type GroupCreate struct {
 	config
-	active    *bool
-	expire    *time.Time
-	_type     *string
-	max_users *int
-	name      *string
-	files     map[string]struct{}
-	blocked   map[string]struct{}
-	users     map[string]struct{}
-	info      map[string]struct{}
+	groupMutation
+	// id of the node, if it's mirrored from the primary storage.
+	id *string
+}
+
+// Mutation returns the mutation of the builder.
+func (gc *GroupCreate) Mutation() *GroupMutation {
+	return &GroupMutation{groupMutation: &gc.groupMutation, op: ent.OpCreate}
 }
 
 // SetActive sets the active field.
@@ -168,7 +170,8 @@ func (gc *GroupCreate) SetInfo(g *GroupInfo) *GroupCreate {
 }
 
 // Save creates the Group in the database.
-func (gc *GroupCreate) Save(ctx context.Context) (*Group, error) {
+func (gc *GroupCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Group, error) {
+	options := ent.NewCallOptions(opts...)
 	if gc.active == nil {
 		v := group.DefaultActive
 		gc.active = &v
@@ -200,6 +203,29 @@ func (gc *GroupCreate) Save(ctx context.Context) (*Group, error) {
 	if gc.info == nil {
 		return nil, errors.New("ent: missing required edge \"info\"")
 	}
+	for _, fn := range group.Validators {
+		if err := fn(gc.Mutation()); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for group: %v", err)
+		}
+	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if gc.shadow != nil {
+		return gc.dualSave(ctx, opts...)
+	}
+	v, err := gc.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		gc.bus.publish(&Event{Op: ent.OpCreate, Type: group.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save creates the Group in the storage of the client dialect.
+func (gc *GroupCreate) save(ctx context.Context) (*Group, error) {
 	switch gc.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return gc.sqlSave(ctx)
@@ -211,8 +237,8 @@ func (gc *GroupCreate) Save(ctx context.Context) (*Group, error) {
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (gc *GroupCreate) SaveX(ctx context.Context) *Group {
-	v, err := gc.Save(ctx)
+func (gc *GroupCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Group {
+	v, err := gc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -220,6 +246,7 @@ func (gc *GroupCreate) SaveX(ctx context.Context) *Group {
 }
 
 func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
+	ctx = entcache.Invalidates(ctx, group.Tables...)
 	var (
 		res sql.Result
 		gr  = &Group{config: gc.config}
@@ -229,6 +256,13 @@ func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
 		return nil, err
 	}
 	builder := sql.Insert(group.Table).Default(gc.driver.Dialect())
+	if id := gc.id; id != nil {
+		id, err := strconv.Atoi(*id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(group.FieldID, id)
+	}
 	if value := gc.active; value != nil {
 		builder.Set(group.FieldActive, *value)
 		gr.Active = *value
@@ -249,6 +283,18 @@ func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
 		builder.Set(group.FieldName, *value)
 		gr.Name = *value
 	}
+	if len(gc.info) > 0 {
+		if err := checkRefs(ctx, tx, groupinfo.Table, groupinfo.FieldID, "info", gc.info); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	for eid := range gc.info {
+		eid, err := strconv.Atoi(eid)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(group.InfoColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -279,7 +325,7 @@ func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(gc.files) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"Group\"", keys(gc.files))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"Group\"", keys(gc.files))})
 		}
 	}
 	if len(gc.blocked) > 0 {
@@ -303,38 +349,26 @@ func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(gc.blocked) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"blocked\" %v already connected to a different \"Group\"", keys(gc.blocked))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"blocked\" %v already connected to a different \"Group\"", keys(gc.blocked))})
 		}
 	}
 	if len(gc.users) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "users", gc.users); err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		builder := sql.Insert(group.UsersTable).
+			Columns(group.UsersPrimaryKey[1], group.UsersPrimaryKey[0])
 		for eid := range gc.users {
 			eid, err := strconv.Atoi(eid)
 			if err != nil {
 				return nil, rollback(tx, err)
 			}
-
-			query, args := sql.Insert(group.UsersTable).
-				Columns(group.UsersPrimaryKey[1], group.UsersPrimaryKey[0]).
-				Values(id, eid).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+			builder.Values(id, eid)
 		}
-	}
-	if len(gc.info) > 0 {
-		for eid := range gc.info {
-			eid, err := strconv.Atoi(eid)
-			if err != nil {
-				return nil, rollback(tx, err)
-			}
-			query, args := sql.Update(group.InfoTable).
-				Set(group.InfoColumn, eid).
-				Where(sql.EQ(group.FieldID, id)).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if err := tx.Commit(); err != nil {
@@ -345,8 +379,9 @@ func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
 
 func (gc *GroupCreate) gremlinSave(ctx context.Context) (*Group, error) {
 	res := &gremlin.Response{}
-	query, bindings := gc.gremlin().Query()
-	if err := gc.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := gc.gremlin()
+	query, _ := traversal.Query()
+	if err := gc.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
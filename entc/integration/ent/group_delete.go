@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -18,12 +20,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // GroupDelete is the builder for deleting a Group entity.
 type GroupDelete struct {
 	config
 	predicates []predicate.Group
+	// id of the node, if it's deleted using a GroupDeleteOne builder.
+	id *string
 }
 
 // Where adds a new predicate to the delete builder.
@@ -33,7 +38,37 @@ func (gd *GroupDelete) Where(ps ...predicate.Group) *GroupDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (gd *GroupDelete) Exec(ctx context.Context) (int, error) {
+func (gd *GroupDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	if gd.shadow != nil {
+		return gd.dualExec(ctx, opts...)
+	}
+	n, err := gd.exec(ctx)
+	if err != nil || n == 0 || options.SkipHooks {
+		return n, err
+	}
+	e := &Event{Op: ent.OpDelete, Type: group.Label, N: n}
+	if gd.id != nil {
+		e.Op, e.ID = ent.OpDeleteOne, *gd.id
+	}
+	gd.bus.publish(e)
+	return n, nil
+}
+
+// exec deletes the Group nodes from the storage of the client dialect.
+func (gd *GroupDelete) exec(ctx context.Context) (int, error) {
+	predicates, err := interceptGroup(ctx, gd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *gd
+		intercepted.predicates = append(predicates, gd.predicates...)
+		gd = &intercepted
+	}
 	switch gd.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return gd.sqlExec(ctx)
@@ -45,8 +80,8 @@ func (gd *GroupDelete) Exec(ctx context.Context) (int, error) {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (gd *GroupDelete) ExecX(ctx context.Context) int {
-	n, err := gd.Exec(ctx)
+func (gd *GroupDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := gd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,17 +89,57 @@ func (gd *GroupDelete) ExecX(ctx context.Context) int {
 }
 
 func (gd *GroupDelete) sqlExec(ctx context.Context) (int, error) {
-	var res sql.Result
-	selector := sql.Select().From(sql.Table(group.Table))
+	ctx = entcache.Invalidates(ctx, group.Tables...)
+	tx, err := gd.driver.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	selector := sql.Select(group.FieldID).From(sql.Table(group.Table)).SetDialect(gd.driver.Dialect()).WithContext(ctx)
 	for _, p := range gd.predicates {
 		p(selector)
 	}
-	query, args := sql.Delete(group.Table).FromSelect(selector).Query()
-	if err := gd.driver.Exec(ctx, query, args, &res); err != nil {
-		return 0, err
+	if err := selector.Err(); err != nil {
+		return 0, rollback(tx, err)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return 0, rollback(tx, err)
+	}
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, rollback(tx, fmt.Errorf("ent: failed reading id: %v", err))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if len(ids) == 0 {
+		return 0, tx.Commit()
+	}
+	if err := nullRefs(ctx, tx, "files", "group_file_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := nullRefs(ctx, tx, "users", "group_blocked_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := deleteRefs(ctx, tx, "group_members", "group_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	var res sql.Result
+	query, args = sql.Delete(group.Table).Where(sql.InInts(group.FieldID, ids...)).Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return 0, rollback(tx, err)
 	}
 	affected, err := res.RowsAffected()
 	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
 		return 0, err
 	}
 	return int(affected), nil
@@ -72,8 +147,9 @@ func (gd *GroupDelete) sqlExec(ctx context.Context) (int, error) {
 
 func (gd *GroupDelete) gremlinExec(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := gd.gremlin().Query()
-	if err := gd.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := gd.gremlin()
+	query, _ := traversal.Query()
+	if err := gd.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -93,12 +169,12 @@ type GroupDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (gdo *GroupDeleteOne) Exec(ctx context.Context) error {
-	n, err := gdo.gd.Exec(ctx)
+func (gdo *GroupDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := gdo.gd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{group.Label}
 	default:
 		return nil
@@ -106,6 +182,8 @@ func (gdo *GroupDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (gdo *GroupDeleteOne) ExecX(ctx context.Context) {
-	gdo.gd.ExecX(ctx)
+func (gdo *GroupDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := gdo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
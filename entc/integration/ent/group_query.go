@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -28,14 +30,29 @@ import (
 // GroupQuery is the builder for querying Group entities.
 type GroupQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit     *int
+	offset    *int
+	order     []Order
+	unique    []string
+	fields    []string
+	unordered bool
+	hints     []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.Group
+	// eager-loading edges.
+	withFiles   *FileQuery
+	withBlocked *UserQuery
+	withUsers   *UserQuery
+	withInfo    *GroupInfoQuery
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath     func(context.Context) (*sql.Selector, error)
+	gremlinPath func(context.Context) (*dsl.Traversal, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -62,21 +79,135 @@ func (gq *GroupQuery) Order(o ...Order) *GroupQuery {
 	return gq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored. Note that, hints are ignored by Gremlin queries.
+func (gq *GroupQuery) Hint(hints ...sql.Hint) *GroupQuery {
+	gq.hints = append(gq.hints, hints...)
+	return gq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	grs, err := client.Group.Query().
+//		Fields(group.FieldActive, group.FieldExpire).
+//		All(ctx)
+//
+func (gq *GroupQuery) Fields(fields ...string) *GroupQuery {
+	gq.fields = append(gq.fields, fields...)
+	return gq
+}
+
+// Unordered disables the ordering by id that is added to the Gremlin queries that are paginated
+// using Limit or Offset. The order of the vertices
+// (and therefore, the content of the pages) is not guaranteed without it, but it can be removed when
+// the order is irrelevant (e.g. sampling).
+func (gq *GroupQuery) Unordered() *GroupQuery {
+	gq.unordered = true
+	return gq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (gq *GroupQuery) Scope(scopes ...group.Scope) *GroupQuery {
+	for _, s := range scopes {
+		gq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				gq.Order(Desc(o.Field))
+			} else {
+				gq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			gq.Limit(s.Limit)
+		}
+	}
+	return gq
+}
+
+// GroupInterceptor is a query interceptor of the GroupClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the GroupClient.Intercept method.
+type GroupInterceptor func(context.Context, *GroupQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (gq *GroupQuery) prepare(ctx context.Context) (*GroupQuery, error) {
+	var interceptors []GroupInterceptor
+	if gq.inters != nil {
+		gq.inters.mu.RLock()
+		interceptors = gq.inters.Group
+		gq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && gq.sqlPath == nil && gq.gremlinPath == nil {
+		return gq, nil
+	}
+	query := gq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	if path := query.gremlinPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.gremlin, query.gremlinPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptGroup returns the predicates that the interceptors of the client add to a Group query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptGroup(ctx context.Context, cfg config) ([]predicate.Group, error) {
+	query, err := (&GroupQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryFiles chains the current query on the files edge.
 func (gq *GroupQuery) QueryFiles() *FileQuery {
 	query := &FileQuery{config: gq.config}
 	switch gq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(file.Table)
-		t2 := gq.sqlQuery()
-		t2.Select(t2.C(group.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t2).
-			On(t1.C(group.FilesColumn), t2.C(group.FieldID))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := gq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(file.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(group.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t2).
+				On(t1.C(group.FilesColumn), t2.C(group.FieldID)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := gq.gremlinQuery()
-		query.gremlin = gremlin.OutE(group.FilesLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := gq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(group.FilesLabel).InV(), nil
+		}
 	}
 	return query
 }
@@ -86,16 +217,29 @@ func (gq *GroupQuery) QueryBlocked() *UserQuery {
 	query := &UserQuery{config: gq.config}
 	switch gq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := gq.sqlQuery()
-		t2.Select(t2.C(group.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t2).
-			On(t1.C(group.BlockedColumn), t2.C(group.FieldID))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := gq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(group.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t2).
+				On(t1.C(group.BlockedColumn), t2.C(group.FieldID)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := gq.gremlinQuery()
-		query.gremlin = gremlin.OutE(group.BlockedLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := gq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(group.BlockedLabel).InV(), nil
+		}
 	}
 	return query
 }
@@ -105,21 +249,34 @@ func (gq *GroupQuery) QueryUsers() *UserQuery {
 	query := &UserQuery{config: gq.config}
 	switch gq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := gq.sqlQuery()
-		t2.Select(t2.C(group.FieldID))
-		t3 := sql.Table(group.UsersTable)
-		t4 := sql.Select(t3.C(group.UsersPrimaryKey[0])).
-			From(t3).
-			Join(t2).
-			On(t3.C(group.UsersPrimaryKey[1]), t2.C(group.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t4).
-			On(t1.C(user.FieldID), t4.C(group.UsersPrimaryKey[0]))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := gq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(group.FieldID))
+			t3 := sql.Table(group.UsersTable)
+			t4 := sql.Select(t3.C(group.UsersPrimaryKey[0])).
+				From(t3).
+				Join(t2).
+				On(t3.C(group.UsersPrimaryKey[1]), t2.C(group.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t4).
+				On(t1.C(user.FieldID), t4.C(group.UsersPrimaryKey[0])), nil
+		}
 	case dialect.Gremlin:
-		gremlin := gq.gremlinQuery()
-		query.gremlin = gremlin.InE(user.GroupsLabel).OutV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := gq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.InE(user.GroupsLabel).OutV(), nil
+		}
 	}
 	return query
 }
@@ -129,20 +286,109 @@ func (gq *GroupQuery) QueryInfo() *GroupInfoQuery {
 	query := &GroupInfoQuery{config: gq.config}
 	switch gq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(groupinfo.Table)
-		t2 := gq.sqlQuery()
-		t2.Select(t2.C(group.InfoColumn))
-		query.sql = sql.Select(t1.Columns(groupinfo.Columns...)...).
-			From(t1).
-			Join(t2).
-			On(t1.C(groupinfo.FieldID), t2.C(group.InfoColumn))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := gq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(groupinfo.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(group.InfoColumn))
+			return sql.Select(t1.Columns(groupinfo.Columns...)...).
+				From(t1).
+				Join(t2).
+				On(t1.C(groupinfo.FieldID), t2.C(group.InfoColumn)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := gq.gremlinQuery()
-		query.gremlin = gremlin.OutE(group.InfoLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := gq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(group.InfoLabel).InV(), nil
+		}
 	}
 	return query
 }
 
+// WithFiles tells the query-builder to eager-load the nodes that are connected to the "files" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Group.Query().
+//		WithFiles(func(q *ent.FileQuery) {
+//			q.Order(ent.Desc(file.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (gq *GroupQuery) WithFiles(opts ...func(*FileQuery)) *GroupQuery {
+	query := &FileQuery{config: gq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withFiles = query
+	return gq
+}
+
+// WithBlocked tells the query-builder to eager-load the nodes that are connected to the "blocked" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Group.Query().
+//		WithBlocked(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (gq *GroupQuery) WithBlocked(opts ...func(*UserQuery)) *GroupQuery {
+	query := &UserQuery{config: gq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withBlocked = query
+	return gq
+}
+
+// WithUsers tells the query-builder to eager-load the nodes that are connected to the "users" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Group.Query().
+//		WithUsers(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (gq *GroupQuery) WithUsers(opts ...func(*UserQuery)) *GroupQuery {
+	query := &UserQuery{config: gq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withUsers = query
+	return gq
+}
+
+// WithInfo tells the query-builder to eager-load the nodes that are connected to the "info" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Group.Query().
+//		WithInfo(func(q *ent.GroupInfoQuery) {
+//			q.Order(ent.Desc(groupinfo.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (gq *GroupQuery) WithInfo(opts ...func(*GroupInfoQuery)) *GroupQuery {
+	query := &GroupInfoQuery{config: gq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withInfo = query
+	return gq
+}
+
 // First returns the first Group entity in the query. Returns *ErrNotFound when no group was found.
 func (gq *GroupQuery) First(ctx context.Context) (*Group, error) {
 	grs, err := gq.Limit(1).All(ctx)
@@ -188,7 +434,7 @@ func (gq *GroupQuery) FirstXID(ctx context.Context) string {
 
 // Only returns the only Group entity in the query, returns an error if not exactly one entity was returned.
 func (gq *GroupQuery) Only(ctx context.Context) (*Group, error) {
-	grs, err := gq.Limit(2).All(ctx)
+	grs, err := gq.Limit(2).Unordered().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -214,7 +460,7 @@ func (gq *GroupQuery) OnlyX(ctx context.Context) *Group {
 // OnlyID returns the only Group id in the query, returns an error if not exactly one id was returned.
 func (gq *GroupQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = gq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = gq.Limit(2).Unordered().IDs(ctx); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -238,33 +484,63 @@ func (gq *GroupQuery) OnlyXID(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of Groups.
-func (gq *GroupQuery) All(ctx context.Context) ([]*Group, error) {
-	switch gq.driver.Dialect() {
+func (gq *GroupQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*Group, error) {
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.shadow != nil && !ent.NewCallOptions(opts...).SkipHooks {
+		return query.dualAll(ctx)
+	}
+
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return gq.sqlAll(ctx)
+		return query.sqlAll(ctx)
 	case dialect.Gremlin:
-		return gq.gremlinAll(ctx)
+		return query.gremlinAll(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
 // AllX is like All, but panics if an error occurs.
-func (gq *GroupQuery) AllX(ctx context.Context) []*Group {
-	grs, err := gq.All(ctx)
+func (gq *GroupQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*Group {
+	grs, err := gq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return grs
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (gq *GroupQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlQueryString(ctx)
+	case dialect.Gremlin:
+		return query.gremlinQueryString(ctx)
+	default:
+		return "", nil, errors.New("ent: unsupported dialect")
+	}
+}
+
 // IDs executes the query and returns a list of Group ids.
 func (gq *GroupQuery) IDs(ctx context.Context) ([]string, error) {
-	switch gq.driver.Dialect() {
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return gq.sqlIDs(ctx)
+		return query.sqlIDs(ctx)
 	case dialect.Gremlin:
-		return gq.gremlinIDs(ctx)
+		return query.gremlinIDs(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
@@ -279,13 +555,46 @@ func (gq *GroupQuery) IDsX(ctx context.Context) []string {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Groups that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (gq *GroupQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if gq.sql != nil || gq.gremlin != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &GroupQuery{
+		config:     gq.config,
+		predicates: append([]predicate.Group{}, gq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(string)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for Group", after)
+		}
+		query.Where(group.IDGT(id))
+	}
+	ids, err := query.Order(Asc(group.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (gq *GroupQuery) Count(ctx context.Context) (int, error) {
-	switch gq.driver.Dialect() {
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return gq.sqlCount(ctx)
+		return query.sqlCount(ctx)
 	case dialect.Gremlin:
-		return gq.gremlinCount(ctx)
+		return query.gremlinCount(ctx)
 	default:
 		return 0, errors.New("ent: unsupported dialect")
 	}
@@ -302,11 +611,15 @@ func (gq *GroupQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (gq *GroupQuery) Exist(ctx context.Context) (bool, error) {
-	switch gq.driver.Dialect() {
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return gq.sqlExist(ctx)
+		return query.sqlExist(ctx)
 	case dialect.Gremlin:
-		return gq.gremlinExist(ctx)
+		return query.gremlinExist(ctx)
 	default:
 		return false, errors.New("ent: unsupported dialect")
 	}
@@ -325,15 +638,25 @@ func (gq *GroupQuery) ExistX(ctx context.Context) bool {
 // used to prepare common query builders and use them differently after the clone is made.
 func (gq *GroupQuery) Clone() *GroupQuery {
 	return &GroupQuery{
-		config:     gq.config,
-		limit:      gq.limit,
-		offset:     gq.offset,
-		order:      append([]Order{}, gq.order...),
-		unique:     append([]string{}, gq.unique...),
-		predicates: append([]predicate.Group{}, gq.predicates...),
+		config:      gq.config,
+		limit:       gq.limit,
+		offset:      gq.offset,
+		order:       append([]Order{}, gq.order...),
+		unique:      append([]string{}, gq.unique...),
+		fields:      append([]string{}, gq.fields...),
+		unordered:   gq.unordered,
+		hints:       append([]sql.Hint{}, gq.hints...),
+		edgeCount:   gq.edgeCount,
+		predicates:  append([]predicate.Group{}, gq.predicates...),
+		withFiles:   gq.withFiles,
+		withBlocked: gq.withBlocked,
+		withUsers:   gq.withUsers,
+		withInfo:    gq.withInfo,
 		// clone intermediate queries.
-		sql:     gq.sql.Clone(),
-		gremlin: gq.gremlin.Clone(),
+		sql:         gq.sql.Clone(),
+		sqlPath:     gq.sqlPath,
+		gremlin:     gq.gremlin.Clone(),
+		gremlinPath: gq.gremlinPath,
 	}
 }
 
@@ -355,11 +678,18 @@ func (gq *GroupQuery) Clone() *GroupQuery {
 func (gq *GroupQuery) GroupBy(field string, fields ...string) *GroupGroupBy {
 	group := &GroupGroupBy{config: gq.config}
 	group.fields = append([]string{field}, fields...)
-	switch gq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		group.sql = gq.sqlQuery()
-	case dialect.Gremlin:
-		group.gremlin = gq.gremlinQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := gq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			group.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			group.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return group
 }
@@ -379,20 +709,27 @@ func (gq *GroupQuery) GroupBy(field string, fields ...string) *GroupGroupBy {
 func (gq *GroupQuery) Select(field string, fields ...string) *GroupSelect {
 	selector := &GroupSelect{config: gq.config}
 	selector.fields = append([]string{field}, fields...)
-	switch gq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		selector.sql = gq.sqlQuery()
-	case dialect.Gremlin:
-		selector.gremlin = gq.gremlinQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := gq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			selector.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			selector.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return selector
 }
 
 func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 	rows := &sql.Rows{}
-	selector := gq.sqlQuery()
-	if unique := gq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := gq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := gq.driver.Query(ctx, query, args, rows); err != nil {
@@ -400,21 +737,373 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 	}
 	defer rows.Close()
 	var grs Groups
-	if err := grs.FromRows(rows); err != nil {
+	if err := grs.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	grs.config(gq.config)
+	if query := gq.withFiles; query != nil {
+		if err := gq.sqlLoadFiles(ctx, query, grs); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withBlocked; query != nil {
+		if err := gq.sqlLoadBlocked(ctx, query, grs); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withUsers; query != nil {
+		if err := gq.sqlLoadUsers(ctx, query, grs); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withInfo; query != nil {
+		if err := gq.sqlLoadInfo(ctx, query, grs); err != nil {
+			return nil, err
+		}
+	}
 	return grs, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (gq *GroupQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := gq.sqlQuery(ctx)
+	if unique := gq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := group.Columns
+	if fields := gq.fields; len(fields) > 0 {
+		columns = append([]string{group.FieldID}, fields...)
+		for _, c := range fields {
+			if !group.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (gq *GroupQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := gq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadFiles loads the nodes of the files edge of the given Groups using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (gq *GroupQuery) sqlLoadFiles(ctx context.Context, query *FileQuery, nodes []*Group) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	key := selector.C(group.FilesColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(file.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := gq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.File{file.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*File, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Files = append(node.Edges.Files, edge)
+	}
+	return nil
+}
+
+// sqlLoadBlocked loads the nodes of the blocked edge of the given Groups using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (gq *GroupQuery) sqlLoadBlocked(ctx context.Context, query *UserQuery, nodes []*Group) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	key := selector.C(group.BlockedColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := gq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Blocked = append(node.Edges.Blocked, edge)
+	}
+	return nil
+}
+
+// sqlLoadUsers loads the nodes of the users edge of the given Groups using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (gq *GroupQuery) sqlLoadUsers(ctx context.Context, query *UserQuery, nodes []*Group) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(group.UsersTable)
+	selector.Join(t1).On(t1.C(group.UsersPrimaryKey[0]), selector.C(user.FieldID))
+	key := t1.C(group.UsersPrimaryKey[1])
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := gq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Users = append(node.Edges.Users, edge)
+	}
+	return nil
+}
+
+// sqlLoadInfo loads the nodes of the info edge of the given Groups using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (gq *GroupQuery) sqlLoadInfo(ctx context.Context, query *GroupInfoQuery, nodes []*Group) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(group.InfoTable)
+	selector.Join(t1).On(t1.C(group.InfoColumn), selector.C(groupinfo.FieldID))
+	key := t1.C(group.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(groupinfo.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := gq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.GroupInfo{groupinfo.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*GroupInfo, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Info = edge
+	}
+	return nil
+}
+
 func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := gq.sqlQuery()
+	selector := gq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{group.FieldID}
 	if len(gq.unique) > 0 {
 		unique = gq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := gq.edgeCount; c != nil && len(gq.predicates) == 0 && len(gq.unique) == 0 &&
+		len(gq.hints) == 0 && gq.limit == nil && gq.offset == nil {
+		selector = c.Clone().SetDialect(gq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := gq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -431,11 +1120,22 @@ func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (gq *GroupQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := gq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := gq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(group.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := gq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := gq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (gq *GroupQuery) sqlIDs(ctx context.Context) ([]string, error) {
@@ -450,13 +1150,33 @@ func (gq *GroupQuery) sqlIDs(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
-func (gq *GroupQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (gq *GroupQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(group.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (gq *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(group.Table)
 	selector := sql.Select(t1.Columns(group.Columns...)...).From(t1)
 	if gq.sql != nil {
 		selector = gq.sql
 		selector.Select(selector.Columns(group.Columns...)...)
 	}
+	selector.SetDialect(gq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(gq.hints...)
 	for _, p := range gq.predicates {
 		p(selector)
 	}
@@ -476,8 +1196,9 @@ func (gq *GroupQuery) sqlQuery() *sql.Selector {
 
 func (gq *GroupQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := gq.gremlinQuery().Query()
-	if err := gq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := gq.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := gq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	vertices, err := res.ReadVertices()
@@ -493,8 +1214,9 @@ func (gq *GroupQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 
 func (gq *GroupQuery) gremlinAll(ctx context.Context) ([]*Group, error) {
 	res := &gremlin.Response{}
-	query, bindings := gq.gremlinQuery().ValueMap(true).Query()
-	if err := gq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := gq.gremlinAllQuery()
+	query, _ := traversal.Query()
+	if err := gq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	var grs Groups
@@ -502,13 +1224,130 @@ func (gq *GroupQuery) gremlinAll(ctx context.Context) ([]*Group, error) {
 		return nil, err
 	}
 	grs.config(gq.config)
+	if query := gq.withFiles; query != nil {
+		if err := gq.gremlinLoadFiles(ctx, query, grs); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withBlocked; query != nil {
+		if err := gq.gremlinLoadBlocked(ctx, query, grs); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withUsers; query != nil {
+		if err := gq.gremlinLoadUsers(ctx, query, grs); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withInfo; query != nil {
+		if err := gq.gremlinLoadInfo(ctx, query, grs); err != nil {
+			return nil, err
+		}
+	}
 	return grs, nil
 }
 
+// gremlinAllQuery returns the traversal of All, that returns the value maps of the vertices.
+func (gq *GroupQuery) gremlinAllQuery() *dsl.Traversal {
+	args := []interface{}{true}
+	for _, f := range gq.fields {
+		args = append(args, f)
+	}
+	return gq.gremlinQuery().ValueMap(args...)
+}
+
+func (gq *GroupQuery) gremlinQueryString(context.Context) (string, interface{}, error) {
+	query, bindings := gq.gremlinAllQuery().Query()
+	return query, bindings, nil
+}
+
+// gremlinLoadFiles loads the nodes of the files edge of the given Groups using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (gq *GroupQuery) gremlinLoadFiles(ctx context.Context, query *FileQuery, nodes []*Group) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&GroupClient{config: gq.config}).QueryFiles(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Files = edges
+	}
+	return nil
+}
+
+// gremlinLoadBlocked loads the nodes of the blocked edge of the given Groups using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (gq *GroupQuery) gremlinLoadBlocked(ctx context.Context, query *UserQuery, nodes []*Group) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&GroupClient{config: gq.config}).QueryBlocked(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Blocked = edges
+	}
+	return nil
+}
+
+// gremlinLoadUsers loads the nodes of the users edge of the given Groups using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (gq *GroupQuery) gremlinLoadUsers(ctx context.Context, query *UserQuery, nodes []*Group) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&GroupClient{config: gq.config}).QueryUsers(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Users = edges
+	}
+	return nil
+}
+
+// gremlinLoadInfo loads the nodes of the info edge of the given Groups using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (gq *GroupQuery) gremlinLoadInfo(ctx context.Context, query *GroupInfoQuery, nodes []*Group) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&GroupClient{config: gq.config}).QueryInfo(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Info = edges[0]
+		}
+	}
+	return nil
+}
+
 func (gq *GroupQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := gq.gremlinQuery().Count().Query()
-	if err := gq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := gq.gremlinTraversal(false).Count()
+	query, _ := traversal.Query()
+	if err := gq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -516,14 +1355,22 @@ func (gq *GroupQuery) gremlinCount(ctx context.Context) (int, error) {
 
 func (gq *GroupQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
-	query, bindings := gq.gremlinQuery().HasNext().Query()
-	if err := gq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := gq.gremlinTraversal(false).HasNext()
+	query, _ := traversal.Query()
+	if err := gq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
 }
 
+// gremlinQuery returns the traversal of the query for listing its vertices, with its default orders.
 func (gq *GroupQuery) gremlinQuery() *dsl.Traversal {
+	return gq.gremlinTraversal(true)
+}
+
+// gremlinTraversal returns the traversal of the query. The default orders are added only to the traversals
+// of listing queries (list), since they don't change the results of the others (e.g. Count and Exist).
+func (gq *GroupQuery) gremlinTraversal(list bool) *dsl.Traversal {
 	v := g.V().HasLabel(group.Label)
 	if gq.gremlin != nil {
 		v = gq.gremlin.Clone()
@@ -531,13 +1378,22 @@ func (gq *GroupQuery) gremlinQuery() *dsl.Traversal {
 	for _, p := range gq.predicates {
 		p(v)
 	}
-	if len(gq.order) > 0 {
+	limit, offset := gq.limit, gq.offset
+	list = list && !gq.unordered
+	// paginated queries are ordered by id (also as a tie-breaker of the
+	// query orders), in order to keep the pages stable between calls.
+	stable := (limit != nil || offset != nil) && list
+	order := gq.order
+	if len(order) > 0 || stable {
 		v.Order()
-		for _, p := range gq.order {
+		for _, p := range order {
 			p(v)
 		}
+		if stable {
+			v.By(dsl.ID, dsl.Incr)
+		}
 	}
-	switch limit, offset := gq.limit, gq.offset; {
+	switch {
 	case limit != nil && offset != nil:
 		v.Range(*offset, *offset+*limit)
 	case offset != nil:
@@ -559,6 +1415,11 @@ type GroupGroupBy struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving     *sql.Predicate
+	gremlinHaving *dsl.Traversal
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -567,8 +1428,27 @@ func (ggb *GroupGroupBy) Aggregate(fns ...Aggregate) *GroupGroupBy {
 	return ggb
 }
 
+// Having adds per-dialect predicates on the aggregated values of the groups.
+// In Gremlin, the predicate is applied using a `where` step on the values of each group.
+// For example:
+//
+//	client.Group.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5), __.Select(ent.DefaultCountLabel).Is(p.GT(5))).
+//		Scan(ctx, &v)
+//
+func (ggb *GroupGroupBy) Having(p0 *sql.Predicate, p1 *dsl.Traversal) *GroupGroupBy {
+	ggb.sqlHaving = p0
+	ggb.gremlinHaving = p1
+	return ggb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (ggb *GroupGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := ggb.path(ctx); err != nil {
+		return err
+	}
 	switch ggb.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ggb.sqlScan(ctx, v)
@@ -671,6 +1551,9 @@ func (ggb *GroupGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (ggb *GroupGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ggb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ggb.sqlQuery().Query()
 	if err := ggb.driver.Query(ctx, query, args, rows); err != nil {
@@ -687,13 +1570,18 @@ func (ggb *GroupGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range ggb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(ggb.fields...)
+	selector.Select(columns...).GroupBy(ggb.fields...)
+	if ggb.sqlHaving != nil {
+		selector.Having(ggb.sqlHaving)
+	}
+	return selector
 }
 
 func (ggb *GroupGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
-	query, bindings := ggb.gremlinQuery().Query()
-	if err := ggb.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ggb.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := ggb.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(ggb.fields)+len(ggb.fns) == 1 {
@@ -720,11 +1608,14 @@ func (ggb *GroupGroupBy) gremlinQuery() *dsl.Traversal {
 		names = append(names, f)
 		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
 	}
-	return ggb.gremlin.Group().
+	v := ggb.gremlin.Group().
 		By(__.Values(ggb.fields...).Fold()).
 		By(__.Fold().Match(trs...).Select(names...)).
-		Select(dsl.Values).
-		Next()
+		Select(dsl.Values)
+	if ggb.gremlinHaving != nil {
+		v = v.Unfold().Where(ggb.gremlinHaving).Fold()
+	}
+	return v.Next()
 }
 
 // GroupSelect is the builder for select fields of Group entities.
@@ -734,10 +1625,15 @@ type GroupSelect struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (gs *GroupSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := gs.path(ctx); err != nil {
+		return err
+	}
 	switch gs.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return gs.sqlScan(ctx, v)
@@ -840,6 +1736,9 @@ func (gs *GroupSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (gs *GroupSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := gs.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := gs.sqlQuery().Query()
 	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
@@ -868,8 +1767,8 @@ func (gs *GroupSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		}
 		traversal = gs.gremlin.ValueMap(fields...)
 	}
-	query, bindings := traversal.Query()
-	if err := gs.driver.Exec(ctx, query, bindings, res); err != nil {
+	query, _ := traversal.Query()
+	if err := gs.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(gs.fields) == 1 {
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -25,28 +26,17 @@ import (
 	"github.com/facebookincubator/ent/entc/integration/ent/groupinfo"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // GroupUpdate is the builder for updating Group entities.
 type GroupUpdate struct {
 	config
-	active         *bool
-	expire         *time.Time
-	_type          *string
-	clear_type     bool
-	max_users      *int
-	addmax_users   *int
-	clearmax_users bool
-	name           *string
-	files          map[string]struct{}
-	blocked        map[string]struct{}
-	users          map[string]struct{}
-	info           map[string]struct{}
-	removedFiles   map[string]struct{}
-	removedBlocked map[string]struct{}
-	removedUsers   map[string]struct{}
-	clearedInfo    bool
-	predicates     []predicate.Group
+	groupMutation
+	predicates []predicate.Group
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Group
 }
 
 // Where adds a new predicate for the builder.
@@ -55,6 +45,11 @@ func (gu *GroupUpdate) Where(ps ...predicate.Group) *GroupUpdate {
 	return gu
 }
 
+// Mutation returns the mutation of the builder.
+func (gu *GroupUpdate) Mutation() *GroupMutation {
+	return &GroupMutation{groupMutation: &gu.groupMutation, op: ent.OpUpdate}
+}
+
 // SetActive sets the active field.
 func (gu *GroupUpdate) SetActive(b bool) *GroupUpdate {
 	gu.active = &b
@@ -208,6 +203,12 @@ func (gu *GroupUpdate) SetInfo(g *GroupInfo) *GroupUpdate {
 	return gu.SetInfoID(g.ID)
 }
 
+// ClearFiles clears all files edges to File.
+func (gu *GroupUpdate) ClearFiles() *GroupUpdate {
+	gu.clearedFiles = true
+	return gu
+}
+
 // RemoveFileIDs removes the files edge to File by ids.
 func (gu *GroupUpdate) RemoveFileIDs(ids ...string) *GroupUpdate {
 	if gu.removedFiles == nil {
@@ -228,6 +229,12 @@ func (gu *GroupUpdate) RemoveFiles(f ...*File) *GroupUpdate {
 	return gu.RemoveFileIDs(ids...)
 }
 
+// ClearBlocked clears all blocked edges to User.
+func (gu *GroupUpdate) ClearBlocked() *GroupUpdate {
+	gu.clearedBlocked = true
+	return gu
+}
+
 // RemoveBlockedIDs removes the blocked edge to User by ids.
 func (gu *GroupUpdate) RemoveBlockedIDs(ids ...string) *GroupUpdate {
 	if gu.removedBlocked == nil {
@@ -248,6 +255,12 @@ func (gu *GroupUpdate) RemoveBlocked(u ...*User) *GroupUpdate {
 	return gu.RemoveBlockedIDs(ids...)
 }
 
+// ClearUsers clears all users edges to User.
+func (gu *GroupUpdate) ClearUsers() *GroupUpdate {
+	gu.clearedUsers = true
+	return gu
+}
+
 // RemoveUserIDs removes the users edge to User by ids.
 func (gu *GroupUpdate) RemoveUserIDs(ids ...string) *GroupUpdate {
 	if gu.removedUsers == nil {
@@ -275,27 +288,67 @@ func (gu *GroupUpdate) ClearInfo() *GroupUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
+func (gu *GroupUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := gu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (gu *GroupUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]string, error) {
+	options := ent.NewCallOptions(opts...)
 	if gu._type != nil {
 		if err := group.TypeValidator(*gu._type); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
+			return nil, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
 		}
 	}
 	if gu.max_users != nil {
 		if err := group.MaxUsersValidator(*gu.max_users); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)
+			return nil, fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)
 		}
 	}
 	if gu.name != nil {
 		if err := group.NameValidator(*gu.name); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
+			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
 		}
 	}
 	if len(gu.info) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"info\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"info\"")
 	}
 	if gu.clearedInfo && gu.info == nil {
-		return 0, errors.New("ent: clearing a unique edge \"info\"")
+		return nil, errors.New("ent: clearing a unique edge \"info\"")
+	}
+	for _, fn := range group.Validators {
+		if err := fn(gu.Mutation()); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for group: %v", err)
+		}
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if gu.shadow != nil {
+		return gu.dualSave(ctx, opts...)
+	}
+	ids, err := gu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 && !options.SkipHooks {
+		gu.bus.publish(&Event{Op: ent.OpUpdate, Type: group.Label, N: len(ids)})
+	}
+	return ids, nil
+}
+
+// save updates the Group nodes in the storage of the client dialect.
+func (gu *GroupUpdate) save(ctx context.Context) ([]string, error) {
+	predicates, err := interceptGroup(ctx, gu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *gu
+		intercepted.predicates = append(predicates, gu.predicates...)
+		gu = &intercepted
 	}
 	switch gu.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
@@ -303,13 +356,26 @@ func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
 	case dialect.Gremlin:
 		return gu.gremlinSave(ctx)
 	default:
-		return 0, errors.New("ent: unsupported dialect")
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (gu *GroupUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Group, error) {
+	var grs []*Group
+	gu.returning = &grs
+	defer func() { gu.returning = nil }()
+	if _, err := gu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
 	}
+	return grs, nil
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (gu *GroupUpdate) SaveX(ctx context.Context) int {
-	affected, err := gu.Save(ctx)
+func (gu *GroupUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := gu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -317,44 +383,49 @@ func (gu *GroupUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (gu *GroupUpdate) Exec(ctx context.Context) error {
-	_, err := gu.Save(ctx)
+func (gu *GroupUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := gu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (gu *GroupUpdate) ExecX(ctx context.Context) {
-	if err := gu.Exec(ctx); err != nil {
+func (gu *GroupUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := gu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(group.FieldID).From(sql.Table(group.Table))
+func (gu *GroupUpdate) sqlSave(ctx context.Context) (_ []string, err error) {
+	ctx = entcache.Invalidates(ctx, group.Tables...)
+	selector := sql.Select(group.FieldID).From(sql.Table(group.Table)).SetDialect(gu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range gu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = gu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := gu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -387,11 +458,19 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if len(gu.removedFiles) > 0 {
-		eids := make([]int, len(gu.removedFiles))
+	if gu.clearedFiles {
+		query, args := sql.Update(group.FilesTable).
+			SetNull(group.FilesColumn).
+			Where(sql.InInts(group.FilesColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(gu.removedFiles) > 0 {
+		eids := make([]int, 0, len(gu.removedFiles))
 		for eid := range gu.removedFiles {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -406,7 +485,7 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(file.FieldID, eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(gu.files) > 0 {
@@ -425,19 +504,27 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.And(p, sql.IsNull(group.FilesColumn))).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(gu.files) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"Group\"", keys(gu.files))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"Group\"", keys(gu.files))})
 			}
 		}
 	}
-	if len(gu.removedBlocked) > 0 {
-		eids := make([]int, len(gu.removedBlocked))
+	if gu.clearedBlocked {
+		query, args := sql.Update(group.BlockedTable).
+			SetNull(group.BlockedColumn).
+			Where(sql.InInts(group.BlockedColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(gu.removedBlocked) > 0 {
+		eids := make([]int, 0, len(gu.removedBlocked))
 		for eid := range gu.removedBlocked {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -452,7 +539,7 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(user.FieldID, eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(gu.blocked) > 0 {
@@ -471,19 +558,26 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.And(p, sql.IsNull(group.BlockedColumn))).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(gu.blocked) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"blocked\" %v already connected to a different \"Group\"", keys(gu.blocked))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"blocked\" %v already connected to a different \"Group\"", keys(gu.blocked))})
 			}
 		}
 	}
-	if len(gu.removedUsers) > 0 {
-		eids := make([]int, len(gu.removedUsers))
+	if gu.clearedUsers {
+		query, args := sql.Delete(group.UsersTable).
+			Where(sql.InInts(group.UsersPrimaryKey[1], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(gu.removedUsers) > 0 {
+		eids := make([]int, 0, len(gu.removedUsers))
 		for eid := range gu.removedUsers {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -497,10 +591,13 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(group.UsersPrimaryKey[0], eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(gu.users) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "users", gu.users); err != nil {
+			return nil, rollback(tx, err)
+		}
 		values := make([][]int, 0, len(ids))
 		for _, id := range ids {
 			for eid := range gu.users {
@@ -519,19 +616,22 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if gu.clearedInfo {
+	if gu.clearedInfo && len(gu.info) == 0 {
 		query, args := sql.Update(group.InfoTable).
 			SetNull(group.InfoColumn).
 			Where(sql.InInts(groupinfo.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(gu.info) > 0 {
+		if err := checkRefs(ctx, tx, groupinfo.Table, groupinfo.FieldID, "info", gu.info); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for eid := range gu.info {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -543,26 +643,71 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.InInts(group.FieldID, ids...)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 		}
 	}
+	if gu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(group.Columns...).
+			From(sql.Table(group.Table)).
+			Where(sql.InInts(group.FieldID, ids...)).
+			SetDialect(gu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var grs Groups
+		err := grs.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Group: %v", err))
+		}
+		grs.config(gu.config)
+		*gu.returning = grs
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	vs := make([]string, len(ids))
+	for i, id := range ids {
+		vs[i] = strconv.Itoa(id)
+	}
+	return vs, nil
 }
 
-func (gu *GroupUpdate) gremlinSave(ctx context.Context) (int, error) {
+func (gu *GroupUpdate) gremlinSave(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := gu.gremlin().Query()
-	if err := gu.driver.Exec(ctx, query, bindings, res); err != nil {
-		return 0, err
+	traversal := gu.gremlin()
+	query, _ := traversal.Query()
+	if err := gu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
-		return 0, err
+		return nil, err
+	}
+	if gu.returning != nil {
+		var grs Groups
+		if err := grs.FromResponse(res); err != nil {
+			return nil, err
+		}
+		grs.config(gu.config)
+		*gu.returning = grs
+		ids := make([]string, 0, len(grs))
+		for _, gr := range grs {
+			ids = append(ids, gr.ID)
+		}
+		return ids, nil
 	}
-	return res.ReadInt()
+	vertices, err := res.ReadVertices()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vertices))
+	for _, vertex := range vertices {
+		ids = append(ids, vertex.ID.(string))
+	}
+	return ids, nil
 }
 
 func (gu *GroupUpdate) gremlin() *dsl.Traversal {
@@ -609,6 +754,10 @@ func (gu *GroupUpdate) gremlin() *dsl.Traversal {
 	if len(properties) > 0 {
 		v.SideEffect(__.Properties(properties...).Drop())
 	}
+	if gu.clearedFiles {
+		tr := rv.Clone().OutE(group.FilesLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range gu.removedFiles {
 		tr := rv.Clone().OutE(group.FilesLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -620,6 +769,10 @@ func (gu *GroupUpdate) gremlin() *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(group.Label, group.FilesLabel, id)),
 		})
 	}
+	if gu.clearedBlocked {
+		tr := rv.Clone().OutE(group.BlockedLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range gu.removedBlocked {
 		tr := rv.Clone().OutE(group.BlockedLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -631,6 +784,10 @@ func (gu *GroupUpdate) gremlin() *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(group.Label, group.BlockedLabel, id)),
 		})
 	}
+	if gu.clearedUsers {
+		tr := rv.Clone().InE(user.GroupsLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range gu.removedUsers {
 		tr := rv.Clone().InE(user.GroupsLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -645,11 +802,13 @@ func (gu *GroupUpdate) gremlin() *dsl.Traversal {
 	for id := range gu.info {
 		v.AddE(group.InfoLabel).To(g.V(id)).OutV()
 	}
-	v.Count()
+	if gu.returning != nil {
+		v.ValueMap(true)
+	}
 	if len(constraints) > 0 {
 		constraints = append(constraints, &constraint{
 			pred: rv.Count(),
-			test: __.Is(p.GT(1)).Constant(&ErrConstraintFailed{msg: "update traversal contains more than one vertex"}),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
 		})
 		v = constraints[0].pred.Coalesce(constraints[0].test, v)
 		for _, cr := range constraints[1:] {
@@ -663,23 +822,16 @@ func (gu *GroupUpdate) gremlin() *dsl.Traversal {
 // GroupUpdateOne is the builder for updating a single Group entity.
 type GroupUpdateOne struct {
 	config
-	id             string
-	active         *bool
-	expire         *time.Time
-	_type          *string
-	clear_type     bool
-	max_users      *int
-	addmax_users   *int
-	clearmax_users bool
-	name           *string
-	files          map[string]struct{}
-	blocked        map[string]struct{}
-	users          map[string]struct{}
-	info           map[string]struct{}
-	removedFiles   map[string]struct{}
-	removedBlocked map[string]struct{}
-	removedUsers   map[string]struct{}
-	clearedInfo    bool
+	id     string
+	entity *Group
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Group
+	groupMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (guo *GroupUpdateOne) Mutation() *GroupMutation {
+	return &GroupMutation{groupMutation: &guo.groupMutation, op: ent.OpUpdateOne, id: &guo.id}
 }
 
 // SetActive sets the active field.
@@ -835,6 +987,12 @@ func (guo *GroupUpdateOne) SetInfo(g *GroupInfo) *GroupUpdateOne {
 	return guo.SetInfoID(g.ID)
 }
 
+// ClearFiles clears all files edges to File.
+func (guo *GroupUpdateOne) ClearFiles() *GroupUpdateOne {
+	guo.clearedFiles = true
+	return guo
+}
+
 // RemoveFileIDs removes the files edge to File by ids.
 func (guo *GroupUpdateOne) RemoveFileIDs(ids ...string) *GroupUpdateOne {
 	if guo.removedFiles == nil {
@@ -855,6 +1013,12 @@ func (guo *GroupUpdateOne) RemoveFiles(f ...*File) *GroupUpdateOne {
 	return guo.RemoveFileIDs(ids...)
 }
 
+// ClearBlocked clears all blocked edges to User.
+func (guo *GroupUpdateOne) ClearBlocked() *GroupUpdateOne {
+	guo.clearedBlocked = true
+	return guo
+}
+
 // RemoveBlockedIDs removes the blocked edge to User by ids.
 func (guo *GroupUpdateOne) RemoveBlockedIDs(ids ...string) *GroupUpdateOne {
 	if guo.removedBlocked == nil {
@@ -875,6 +1039,12 @@ func (guo *GroupUpdateOne) RemoveBlocked(u ...*User) *GroupUpdateOne {
 	return guo.RemoveBlockedIDs(ids...)
 }
 
+// ClearUsers clears all users edges to User.
+func (guo *GroupUpdateOne) ClearUsers() *GroupUpdateOne {
+	guo.clearedUsers = true
+	return guo
+}
+
 // RemoveUserIDs removes the users edge to User by ids.
 func (guo *GroupUpdateOne) RemoveUserIDs(ids ...string) *GroupUpdateOne {
 	if guo.removedUsers == nil {
@@ -901,8 +1071,38 @@ func (guo *GroupUpdateOne) ClearInfo() *GroupUpdateOne {
 	return guo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (guo *GroupUpdateOne) SetChangedFrom(original *Group) *GroupUpdateOne {
+	gr := guo.entity
+	if gr == nil || original == nil {
+		return guo
+	}
+	for _, change := range DiffGroup(original, gr) {
+		switch change.Field {
+		case group.FieldActive:
+			guo.SetActive(gr.Active)
+		case group.FieldExpire:
+			guo.SetExpire(gr.Expire)
+		case group.FieldType:
+			if gr.Type == nil {
+				guo.ClearType()
+			} else {
+				guo.SetType(*gr.Type)
+			}
+		case group.FieldMaxUsers:
+			guo.SetMaxUsers(gr.MaxUsers)
+		case group.FieldName:
+			guo.SetName(gr.Name)
+		}
+	}
+	return guo
+}
+
 // Save executes the query and returns the updated entity.
-func (guo *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
+func (guo *GroupUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Group, error) {
+	options := ent.NewCallOptions(opts...)
 	if guo._type != nil {
 		if err := group.TypeValidator(*guo._type); err != nil {
 			return nil, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
@@ -924,6 +1124,39 @@ func (guo *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
 	if guo.clearedInfo && guo.info == nil {
 		return nil, errors.New("ent: clearing a unique edge \"info\"")
 	}
+	for _, fn := range group.Validators {
+		if err := fn(guo.Mutation()); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for group: %v", err)
+		}
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if guo.shadow != nil {
+		return guo.dualSave(ctx, opts...)
+	}
+	gr, err := guo.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		guo.bus.publish(&Event{Op: ent.OpUpdateOne, Type: group.Label, ID: gr.ID, Node: gr, N: 1})
+	}
+	return gr, nil
+}
+
+// save updates the Group in the storage of the client dialect.
+func (guo *GroupUpdateOne) save(ctx context.Context) (*Group, error) {
+	predicates, err := interceptGroup(ctx, guo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *guo
+		intercepted.predicates = append(predicates, guo.predicates...)
+		guo = &intercepted
+	}
 	switch guo.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return guo.sqlSave(ctx)
@@ -935,8 +1168,8 @@ func (guo *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (guo *GroupUpdateOne) SaveX(ctx context.Context) *Group {
-	gr, err := guo.Save(ctx)
+func (guo *GroupUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Group {
+	gr, err := guo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -944,21 +1177,29 @@ func (guo *GroupUpdateOne) SaveX(ctx context.Context) *Group {
 }
 
 // Exec executes the query on the entity.
-func (guo *GroupUpdateOne) Exec(ctx context.Context) error {
-	_, err := guo.Save(ctx)
+func (guo *GroupUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := guo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
-	if err := guo.Exec(ctx); err != nil {
+func (guo *GroupUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := guo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
-	selector := sql.Select(group.Columns...).From(sql.Table(group.Table))
+	ctx = entcache.Invalidates(ctx, group.Tables...)
+	selector := sql.Select(group.Columns...).From(sql.Table(group.Table)).SetDialect(guo.driver.Dialect())
 	group.ID(guo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range guo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = guo.driver.Query(ctx, query, args, rows); err != nil {
@@ -1029,8 +1270,16 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if len(guo.removedFiles) > 0 {
-		eids := make([]int, len(guo.removedFiles))
+	if guo.clearedFiles {
+		query, args := sql.Update(group.FilesTable).
+			SetNull(group.FilesColumn).
+			Where(sql.InInts(group.FilesColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(guo.removedFiles) > 0 {
+		eids := make([]int, 0, len(guo.removedFiles))
 		for eid := range guo.removedFiles {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -1071,12 +1320,20 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(guo.files) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"Group\"", keys(guo.files))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"Group\"", keys(guo.files))})
 			}
 		}
 	}
-	if len(guo.removedBlocked) > 0 {
-		eids := make([]int, len(guo.removedBlocked))
+	if guo.clearedBlocked {
+		query, args := sql.Update(group.BlockedTable).
+			SetNull(group.BlockedColumn).
+			Where(sql.InInts(group.BlockedColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(guo.removedBlocked) > 0 {
+		eids := make([]int, 0, len(guo.removedBlocked))
 		for eid := range guo.removedBlocked {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -1117,12 +1374,19 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(guo.blocked) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"blocked\" %v already connected to a different \"Group\"", keys(guo.blocked))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"blocked\" %v already connected to a different \"Group\"", keys(guo.blocked))})
 			}
 		}
 	}
-	if len(guo.removedUsers) > 0 {
-		eids := make([]int, len(guo.removedUsers))
+	if guo.clearedUsers {
+		query, args := sql.Delete(group.UsersTable).
+			Where(sql.InInts(group.UsersPrimaryKey[1], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(guo.removedUsers) > 0 {
+		eids := make([]int, 0, len(guo.removedUsers))
 		for eid := range guo.removedUsers {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -1140,6 +1404,9 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 		}
 	}
 	if len(guo.users) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "users", guo.users); err != nil {
+			return nil, rollback(tx, err)
+		}
 		values := make([][]int, 0, len(ids))
 		for _, id := range ids {
 			for eid := range guo.users {
@@ -1161,7 +1428,7 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if guo.clearedInfo {
+	if guo.clearedInfo && len(guo.info) == 0 {
 		query, args := sql.Update(group.InfoTable).
 			SetNull(group.InfoColumn).
 			Where(sql.InInts(groupinfo.FieldID, ids...)).
@@ -1171,6 +1438,9 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 		}
 	}
 	if len(guo.info) > 0 {
+		if err := checkRefs(ctx, tx, groupinfo.Table, groupinfo.FieldID, "info", guo.info); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for eid := range guo.info {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -1194,8 +1464,9 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 
 func (guo *GroupUpdateOne) gremlinSave(ctx context.Context) (*Group, error) {
 	res := &gremlin.Response{}
-	query, bindings := guo.gremlin(guo.id).Query()
-	if err := guo.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := guo.gremlin(guo.id)
+	query, _ := traversal.Query()
+	if err := guo.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -1215,6 +1486,9 @@ func (guo *GroupUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 2)
 	v := g.V(id)
+	for _, p := range guo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -1249,6 +1523,10 @@ func (guo *GroupUpdateOne) gremlin(id string) *dsl.Traversal {
 	if len(properties) > 0 {
 		v.SideEffect(__.Properties(properties...).Drop())
 	}
+	if guo.clearedFiles {
+		tr := rv.Clone().OutE(group.FilesLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range guo.removedFiles {
 		tr := rv.Clone().OutE(group.FilesLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -1260,6 +1538,10 @@ func (guo *GroupUpdateOne) gremlin(id string) *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(group.Label, group.FilesLabel, id)),
 		})
 	}
+	if guo.clearedBlocked {
+		tr := rv.Clone().OutE(group.BlockedLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range guo.removedBlocked {
 		tr := rv.Clone().OutE(group.BlockedLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -1271,6 +1553,10 @@ func (guo *GroupUpdateOne) gremlin(id string) *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(group.Label, group.BlockedLabel, id)),
 		})
 	}
+	if guo.clearedUsers {
+		tr := rv.Clone().InE(user.GroupsLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range guo.removedUsers {
 		tr := rv.Clone().InE(user.GroupsLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/ent/groupinfo"
 )
 
 // GroupInfo is the model entity for the GroupInfo schema.
@@ -24,21 +25,44 @@ type GroupInfo struct {
 	Desc string `json:"desc,omitempty"`
 	// MaxUsers holds the value of the "max_users" field.
 	MaxUsers int `json:"max_users,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the GroupInfoQuery when eager-loading is set.
+	Edges GroupInfoEdges `json:"edges"`
+}
+
+// GroupInfoEdges holds the relations/edges of other nodes in the graph.
+type GroupInfoEdges struct {
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
 }
 
 // FromRows scans the sql response data into GroupInfo.
 func (gi *GroupInfo) FromRows(rows *sql.Rows) error {
+	return gi.scan(rows, groupinfo.Columns)
+}
+
+// scan scans the given columns of the sql response data into GroupInfo.
+// Fields that their columns were not selected are left with their zero values.
+func (gi *GroupInfo) scan(rows *sql.Rows, columns []string) error {
 	var vgi struct {
 		ID       int
 		Desc     sql.NullString
 		MaxUsers sql.NullInt64
 	}
-	// the order here should be the same as in the `groupinfo.Columns`.
-	if err := rows.Scan(
-		&vgi.ID,
-		&vgi.Desc,
-		&vgi.MaxUsers,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groupinfo.FieldID:
+			values[i] = &vgi.ID
+		case groupinfo.FieldDesc:
+			values[i] = &vgi.Desc
+		case groupinfo.FieldMaxUsers:
+			values[i] = &vgi.MaxUsers
+		default:
+			return fmt.Errorf("unexpected column %q for type GroupInfo", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	gi.ID = strconv.Itoa(vgi.ID)
@@ -90,6 +114,35 @@ func (gi *GroupInfo) Unwrap() *GroupInfo {
 	return gi
 }
 
+// Clone returns a deep copy of the GroupInfo, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (gi *GroupInfo) Clone() *GroupInfo {
+	return gi.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the GroupInfo, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (gi *GroupInfo) clone(seen map[interface{}]interface{}) *GroupInfo {
+	if gi == nil {
+		return nil
+	}
+	if _c, ok := seen[gi]; ok {
+		return _c.(*GroupInfo)
+	}
+	_c := *gi
+	_c.config = config{}
+	seen[gi] = &_c
+	if nodes := gi.Edges.Groups; nodes != nil {
+		_c.Edges.Groups = make([]*Group, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Groups[_i] = _n.clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (gi *GroupInfo) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -101,6 +154,20 @@ func (gi *GroupInfo) String() string {
 	return buf.String()
 }
 
+// DiffGroupInfo returns the fields that have different values in the given GroupInfos,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffGroupInfo(a, b *GroupInfo) []FieldChange {
+	var changes []FieldChange
+	if a.Desc != b.Desc {
+		changes = append(changes, FieldChange{Field: groupinfo.FieldDesc, Old: a.Desc, New: b.Desc})
+	}
+	if a.MaxUsers != b.MaxUsers {
+		changes = append(changes, FieldChange{Field: groupinfo.FieldMaxUsers, Old: a.MaxUsers, New: b.MaxUsers})
+	}
+	return changes
+}
+
 // id returns the int representation of the ID field.
 func (gi *GroupInfo) id() int {
 	id, _ := strconv.Atoi(gi.ID)
@@ -112,9 +179,14 @@ type GroupInfos []*GroupInfo
 
 // FromRows scans the sql response data into GroupInfos.
 func (gi *GroupInfos) FromRows(rows *sql.Rows) error {
+	return gi.scan(rows, groupinfo.Columns)
+}
+
+// scan scans the given columns of the sql response data into GroupInfos.
+func (gi *GroupInfos) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vgi := &GroupInfo{}
-		if err := vgi.FromRows(rows); err != nil {
+		if err := vgi.scan(rows, columns); err != nil {
 			return err
 		}
 		*gi = append(*gi, vgi)
@@ -7,7 +7,10 @@
 package groupinfo
 
 import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
 )
 
 const (
@@ -41,6 +44,23 @@ var Columns = []string{
 	FieldMaxUsers,
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Tables holds the tables that may be changed by the mutations of the groupinfo. The mutations
+// invalidate only the query results of these tables that were cached by the entcache driver.
+var Tables = []string{
+	"group_infos",
+	"groups",
+}
+
 var (
 	fields = schema.GroupInfo{}.Fields()
 
@@ -49,3 +69,58 @@ var (
 	// DefaultMaxUsers holds the default value on creation for the max_users field.
 	DefaultMaxUsers = descMaxUsers.Default.(int)
 )
+
+// Scope is a reusable named scope of the GroupInfo queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.GroupInfo
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the GroupInfo type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "GroupInfo",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeString,
+		GoType:     "string",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "desc",
+			StorageKey: FieldDesc,
+			Type:       field.TypeString,
+			GoType:     "string",
+		},
+		{
+			Name:       "max_users",
+			StorageKey: FieldMaxUsers,
+			Type:       field.TypeInt,
+			GoType:     "int",
+			Default:    true,
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "groups",
+			Type:     "Group",
+			Rel:      "O2M",
+			Optional: true,
+			Inverse:  true,
+			Table:    "groups",
+			Columns:  []string{"info_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the GroupInfo type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
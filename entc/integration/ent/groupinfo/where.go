@@ -7,7 +7,11 @@
 package groupinfo
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
@@ -335,6 +339,30 @@ func DescHasSuffix(v string) predicate.GroupInfo {
 	)
 }
 
+// DescEqualFold applies the EqualFold predicate on the "desc" field.
+func DescEqualFold(v string) predicate.GroupInfo {
+	return predicate.GroupInfoPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldDesc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldDesc, p.EqualFold(v))
+		},
+	)
+}
+
+// DescContainsFold applies the ContainsFold predicate on the "desc" field.
+func DescContainsFold(v string) predicate.GroupInfo {
+	return predicate.GroupInfoPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldDesc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldDesc, p.ContainsFold(v))
+		},
+	)
+}
+
 // MaxUsersEQ applies the EQ predicate on the "max_users" field.
 func MaxUsersEQ(v int) predicate.GroupInfo {
 	return predicate.GroupInfoPerDialect(
@@ -471,15 +499,38 @@ func HasGroups() predicate.GroupInfo {
 	)
 }
 
+// HasNoGroups applies a predicate that checks that the "groups" edge is empty. It's identical
+// to Not(HasGroups()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the GroupInfo nodes.
+func HasNoGroups() predicate.GroupInfo {
+	return predicate.GroupInfoPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(GroupsColumn).
+						From(sql.Table(GroupsTable)).
+						Where(sql.NotNull(GroupsColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.InE(GroupsInverseLabel))
+		},
+	)
+}
+
 // HasGroupsWith applies the HasEdge predicate on the "groups" edge with a given conditions (other predicates).
 func HasGroupsWith(preds ...predicate.Group) predicate.GroupInfo {
 	return predicate.GroupInfoPerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(GroupsColumn).From(sql.Table(GroupsTable))
+			t2 := sql.Select(GroupsColumn).From(sql.Table(GroupsTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(FieldID), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -492,6 +543,218 @@ func HasGroupsWith(preds ...predicate.Group) predicate.GroupInfo {
 	)
 }
 
+// ByGroupsCount orders the results by the number of "groups" edges,
+// in descending order if desc is true. It's used as an order option of the
+// GroupInfo queries. For example:
+//
+//	client.GroupInfo.Query().Order(groupinfo.ByGroupsCount(true))
+//
+func ByGroupsCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(GroupsTable).As("groups_count")
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(GroupsColumn), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.InE(GroupsInverseLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// PredicateFunc returns a predicate from the given per-dialect functions.
+// It allows adding custom SQL/Gremlin fragments to the GroupInfo builders.
+func PredicateFunc(f0 func(*sql.Selector), f1 func(*dsl.Traversal)) predicate.GroupInfo {
+	return predicate.GroupInfoPerDialect(f0, f1)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.GroupInfo, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.GroupInfo, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.GroupInfo, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldDesc:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return DescEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return DescNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return DescIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return DescNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return DescGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return DescGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return DescLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return DescLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return DescContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return DescHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return DescHasSuffix(v), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return DescEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return DescContainsFold(v), nil
+			}
+		}
+	case FieldMaxUsers:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return MaxUsersIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return MaxUsersNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return MaxUsersLTE(v), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("groupinfo: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.GroupInfo) predicate.GroupInfo {
 	return predicate.GroupInfoPerDialect(
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -21,14 +22,20 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/ent/groupinfo"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // GroupInfoCreate is the builder for creating a GroupInfo entity.
 type GroupInfoCreate struct {
 	config
-	desc      *string
-	max_users *int
-	groups    map[string]struct{}
+	groupinfoMutation
+	// id of the node, if it's mirrored from the primary storage.
+	id *string
+}
+
+// Mutation returns the mutation of the builder.
+func (gic *GroupInfoCreate) Mutation() *GroupInfoMutation {
+	return &GroupInfoMutation{groupinfoMutation: &gic.groupinfoMutation, op: ent.OpCreate}
 }
 
 // SetDesc sets the desc field.
@@ -72,7 +79,8 @@ func (gic *GroupInfoCreate) AddGroups(g ...*Group) *GroupInfoCreate {
 }
 
 // Save creates the GroupInfo in the database.
-func (gic *GroupInfoCreate) Save(ctx context.Context) (*GroupInfo, error) {
+func (gic *GroupInfoCreate) Save(ctx context.Context, opts ...ent.CallOption) (*GroupInfo, error) {
+	options := ent.NewCallOptions(opts...)
 	if gic.desc == nil {
 		return nil, errors.New("ent: missing required field \"desc\"")
 	}
@@ -80,6 +88,24 @@ func (gic *GroupInfoCreate) Save(ctx context.Context) (*GroupInfo, error) {
 		v := groupinfo.DefaultMaxUsers
 		gic.max_users = &v
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if gic.shadow != nil {
+		return gic.dualSave(ctx, opts...)
+	}
+	v, err := gic.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		gic.bus.publish(&Event{Op: ent.OpCreate, Type: groupinfo.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save creates the GroupInfo in the storage of the client dialect.
+func (gic *GroupInfoCreate) save(ctx context.Context) (*GroupInfo, error) {
 	switch gic.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return gic.sqlSave(ctx)
@@ -91,8 +117,8 @@ func (gic *GroupInfoCreate) Save(ctx context.Context) (*GroupInfo, error) {
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (gic *GroupInfoCreate) SaveX(ctx context.Context) *GroupInfo {
-	v, err := gic.Save(ctx)
+func (gic *GroupInfoCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *GroupInfo {
+	v, err := gic.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -100,6 +126,7 @@ func (gic *GroupInfoCreate) SaveX(ctx context.Context) *GroupInfo {
 }
 
 func (gic *GroupInfoCreate) sqlSave(ctx context.Context) (*GroupInfo, error) {
+	ctx = entcache.Invalidates(ctx, groupinfo.Tables...)
 	var (
 		res sql.Result
 		gi  = &GroupInfo{config: gic.config}
@@ -109,6 +136,13 @@ func (gic *GroupInfoCreate) sqlSave(ctx context.Context) (*GroupInfo, error) {
 		return nil, err
 	}
 	builder := sql.Insert(groupinfo.Table).Default(gic.driver.Dialect())
+	if id := gic.id; id != nil {
+		id, err := strconv.Atoi(*id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(groupinfo.FieldID, id)
+	}
 	if value := gic.desc; value != nil {
 		builder.Set(groupinfo.FieldDesc, *value)
 		gi.Desc = *value
@@ -147,7 +181,7 @@ func (gic *GroupInfoCreate) sqlSave(ctx context.Context) (*GroupInfo, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(gic.groups) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"groups\" %v already connected to a different \"GroupInfo\"", keys(gic.groups))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"groups\" %v already connected to a different \"GroupInfo\"", keys(gic.groups))})
 		}
 	}
 	if err := tx.Commit(); err != nil {
@@ -158,8 +192,9 @@ func (gic *GroupInfoCreate) sqlSave(ctx context.Context) (*GroupInfo, error) {
 
 func (gic *GroupInfoCreate) gremlinSave(ctx context.Context) (*GroupInfo, error) {
 	res := &gremlin.Response{}
-	query, bindings := gic.gremlin().Query()
-	if err := gic.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := gic.gremlin()
+	query, _ := traversal.Query()
+	if err := gic.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
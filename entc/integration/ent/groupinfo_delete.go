@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -18,12 +20,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/groupinfo"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // GroupInfoDelete is the builder for deleting a GroupInfo entity.
 type GroupInfoDelete struct {
 	config
 	predicates []predicate.GroupInfo
+	// id of the node, if it's deleted using a GroupInfoDeleteOne builder.
+	id *string
 }
 
 // Where adds a new predicate to the delete builder.
@@ -33,7 +38,37 @@ func (gid *GroupInfoDelete) Where(ps ...predicate.GroupInfo) *GroupInfoDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (gid *GroupInfoDelete) Exec(ctx context.Context) (int, error) {
+func (gid *GroupInfoDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	if gid.shadow != nil {
+		return gid.dualExec(ctx, opts...)
+	}
+	n, err := gid.exec(ctx)
+	if err != nil || n == 0 || options.SkipHooks {
+		return n, err
+	}
+	e := &Event{Op: ent.OpDelete, Type: groupinfo.Label, N: n}
+	if gid.id != nil {
+		e.Op, e.ID = ent.OpDeleteOne, *gid.id
+	}
+	gid.bus.publish(e)
+	return n, nil
+}
+
+// exec deletes the GroupInfo nodes from the storage of the client dialect.
+func (gid *GroupInfoDelete) exec(ctx context.Context) (int, error) {
+	predicates, err := interceptGroupInfo(ctx, gid.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *gid
+		intercepted.predicates = append(predicates, gid.predicates...)
+		gid = &intercepted
+	}
 	switch gid.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return gid.sqlExec(ctx)
@@ -45,8 +80,8 @@ func (gid *GroupInfoDelete) Exec(ctx context.Context) (int, error) {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (gid *GroupInfoDelete) ExecX(ctx context.Context) int {
-	n, err := gid.Exec(ctx)
+func (gid *GroupInfoDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := gid.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,17 +89,51 @@ func (gid *GroupInfoDelete) ExecX(ctx context.Context) int {
 }
 
 func (gid *GroupInfoDelete) sqlExec(ctx context.Context) (int, error) {
-	var res sql.Result
-	selector := sql.Select().From(sql.Table(groupinfo.Table))
+	ctx = entcache.Invalidates(ctx, groupinfo.Tables...)
+	tx, err := gid.driver.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	selector := sql.Select(groupinfo.FieldID).From(sql.Table(groupinfo.Table)).SetDialect(gid.driver.Dialect()).WithContext(ctx)
 	for _, p := range gid.predicates {
 		p(selector)
 	}
-	query, args := sql.Delete(groupinfo.Table).FromSelect(selector).Query()
-	if err := gid.driver.Exec(ctx, query, args, &res); err != nil {
-		return 0, err
+	if err := selector.Err(); err != nil {
+		return 0, rollback(tx, err)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return 0, rollback(tx, err)
+	}
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, rollback(tx, fmt.Errorf("ent: failed reading id: %v", err))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if len(ids) == 0 {
+		return 0, tx.Commit()
+	}
+	if err := checkNoRefs(ctx, tx, "groups", "info_id", "", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	var res sql.Result
+	query, args = sql.Delete(groupinfo.Table).Where(sql.InInts(groupinfo.FieldID, ids...)).Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return 0, rollback(tx, err)
 	}
 	affected, err := res.RowsAffected()
 	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
 		return 0, err
 	}
 	return int(affected), nil
@@ -72,8 +141,9 @@ func (gid *GroupInfoDelete) sqlExec(ctx context.Context) (int, error) {
 
 func (gid *GroupInfoDelete) gremlinExec(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := gid.gremlin().Query()
-	if err := gid.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := gid.gremlin()
+	query, _ := traversal.Query()
+	if err := gid.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -93,12 +163,12 @@ type GroupInfoDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (gido *GroupInfoDeleteOne) Exec(ctx context.Context) error {
-	n, err := gido.gid.Exec(ctx)
+func (gido *GroupInfoDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := gido.gid.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{groupinfo.Label}
 	default:
 		return nil
@@ -106,6 +176,8 @@ func (gido *GroupInfoDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (gido *GroupInfoDeleteOne) ExecX(ctx context.Context) {
-	gido.gid.ExecX(ctx)
+func (gido *GroupInfoDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := gido.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -26,14 +28,26 @@ import (
 // GroupInfoQuery is the builder for querying GroupInfo entities.
 type GroupInfoQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit     *int
+	offset    *int
+	order     []Order
+	unique    []string
+	fields    []string
+	unordered bool
+	hints     []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.GroupInfo
+	// eager-loading edges.
+	withGroups *GroupQuery
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath     func(context.Context) (*sql.Selector, error)
+	gremlinPath func(context.Context) (*dsl.Traversal, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -60,25 +74,158 @@ func (giq *GroupInfoQuery) Order(o ...Order) *GroupInfoQuery {
 	return giq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored. Note that, hints are ignored by Gremlin queries.
+func (giq *GroupInfoQuery) Hint(hints ...sql.Hint) *GroupInfoQuery {
+	giq.hints = append(giq.hints, hints...)
+	return giq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	gis, err := client.GroupInfo.Query().
+//		Fields(groupinfo.FieldDesc, groupinfo.FieldMaxUsers).
+//		All(ctx)
+//
+func (giq *GroupInfoQuery) Fields(fields ...string) *GroupInfoQuery {
+	giq.fields = append(giq.fields, fields...)
+	return giq
+}
+
+// Unordered disables the ordering by id that is added to the Gremlin queries that are paginated
+// using Limit or Offset. The order of the vertices
+// (and therefore, the content of the pages) is not guaranteed without it, but it can be removed when
+// the order is irrelevant (e.g. sampling).
+func (giq *GroupInfoQuery) Unordered() *GroupInfoQuery {
+	giq.unordered = true
+	return giq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (giq *GroupInfoQuery) Scope(scopes ...groupinfo.Scope) *GroupInfoQuery {
+	for _, s := range scopes {
+		giq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				giq.Order(Desc(o.Field))
+			} else {
+				giq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			giq.Limit(s.Limit)
+		}
+	}
+	return giq
+}
+
+// GroupInfoInterceptor is a query interceptor of the GroupInfoClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the GroupInfoClient.Intercept method.
+type GroupInfoInterceptor func(context.Context, *GroupInfoQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (giq *GroupInfoQuery) prepare(ctx context.Context) (*GroupInfoQuery, error) {
+	var interceptors []GroupInfoInterceptor
+	if giq.inters != nil {
+		giq.inters.mu.RLock()
+		interceptors = giq.inters.GroupInfo
+		giq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && giq.sqlPath == nil && giq.gremlinPath == nil {
+		return giq, nil
+	}
+	query := giq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	if path := query.gremlinPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.gremlin, query.gremlinPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptGroupInfo returns the predicates that the interceptors of the client add to a GroupInfo query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptGroupInfo(ctx context.Context, cfg config) ([]predicate.GroupInfo, error) {
+	query, err := (&GroupInfoQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryGroups chains the current query on the groups edge.
 func (giq *GroupInfoQuery) QueryGroups() *GroupQuery {
 	query := &GroupQuery{config: giq.config}
 	switch giq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(group.Table)
-		t2 := giq.sqlQuery()
-		t2.Select(t2.C(groupinfo.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t2).
-			On(t1.C(groupinfo.GroupsColumn), t2.C(groupinfo.FieldID))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := giq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(group.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(groupinfo.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t2).
+				On(t1.C(groupinfo.GroupsColumn), t2.C(groupinfo.FieldID)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := giq.gremlinQuery()
-		query.gremlin = gremlin.InE(group.InfoLabel).OutV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := giq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.InE(group.InfoLabel).OutV(), nil
+		}
 	}
 	return query
 }
 
+// WithGroups tells the query-builder to eager-load the nodes that are connected to the "groups" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.GroupInfo.Query().
+//		WithGroups(func(q *ent.GroupQuery) {
+//			q.Order(ent.Desc(group.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (giq *GroupInfoQuery) WithGroups(opts ...func(*GroupQuery)) *GroupInfoQuery {
+	query := &GroupQuery{config: giq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	giq.withGroups = query
+	return giq
+}
+
 // First returns the first GroupInfo entity in the query. Returns *ErrNotFound when no groupinfo was found.
 func (giq *GroupInfoQuery) First(ctx context.Context) (*GroupInfo, error) {
 	gis, err := giq.Limit(1).All(ctx)
@@ -124,7 +271,7 @@ func (giq *GroupInfoQuery) FirstXID(ctx context.Context) string {
 
 // Only returns the only GroupInfo entity in the query, returns an error if not exactly one entity was returned.
 func (giq *GroupInfoQuery) Only(ctx context.Context) (*GroupInfo, error) {
-	gis, err := giq.Limit(2).All(ctx)
+	gis, err := giq.Limit(2).Unordered().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +297,7 @@ func (giq *GroupInfoQuery) OnlyX(ctx context.Context) *GroupInfo {
 // OnlyID returns the only GroupInfo id in the query, returns an error if not exactly one id was returned.
 func (giq *GroupInfoQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = giq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = giq.Limit(2).Unordered().IDs(ctx); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -174,33 +321,63 @@ func (giq *GroupInfoQuery) OnlyXID(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of GroupInfos.
-func (giq *GroupInfoQuery) All(ctx context.Context) ([]*GroupInfo, error) {
-	switch giq.driver.Dialect() {
+func (giq *GroupInfoQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*GroupInfo, error) {
+	query, err := giq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.shadow != nil && !ent.NewCallOptions(opts...).SkipHooks {
+		return query.dualAll(ctx)
+	}
+
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return giq.sqlAll(ctx)
+		return query.sqlAll(ctx)
 	case dialect.Gremlin:
-		return giq.gremlinAll(ctx)
+		return query.gremlinAll(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
 // AllX is like All, but panics if an error occurs.
-func (giq *GroupInfoQuery) AllX(ctx context.Context) []*GroupInfo {
-	gis, err := giq.All(ctx)
+func (giq *GroupInfoQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*GroupInfo {
+	gis, err := giq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return gis
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (giq *GroupInfoQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := giq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlQueryString(ctx)
+	case dialect.Gremlin:
+		return query.gremlinQueryString(ctx)
+	default:
+		return "", nil, errors.New("ent: unsupported dialect")
+	}
+}
+
 // IDs executes the query and returns a list of GroupInfo ids.
 func (giq *GroupInfoQuery) IDs(ctx context.Context) ([]string, error) {
-	switch giq.driver.Dialect() {
+	query, err := giq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return giq.sqlIDs(ctx)
+		return query.sqlIDs(ctx)
 	case dialect.Gremlin:
-		return giq.gremlinIDs(ctx)
+		return query.gremlinIDs(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
@@ -215,13 +392,46 @@ func (giq *GroupInfoQuery) IDsX(ctx context.Context) []string {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit GroupInfos that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (giq *GroupInfoQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if giq.sql != nil || giq.gremlin != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &GroupInfoQuery{
+		config:     giq.config,
+		predicates: append([]predicate.GroupInfo{}, giq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(string)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for GroupInfo", after)
+		}
+		query.Where(groupinfo.IDGT(id))
+	}
+	ids, err := query.Order(Asc(groupinfo.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (giq *GroupInfoQuery) Count(ctx context.Context) (int, error) {
-	switch giq.driver.Dialect() {
+	query, err := giq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return giq.sqlCount(ctx)
+		return query.sqlCount(ctx)
 	case dialect.Gremlin:
-		return giq.gremlinCount(ctx)
+		return query.gremlinCount(ctx)
 	default:
 		return 0, errors.New("ent: unsupported dialect")
 	}
@@ -238,11 +448,15 @@ func (giq *GroupInfoQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (giq *GroupInfoQuery) Exist(ctx context.Context) (bool, error) {
-	switch giq.driver.Dialect() {
+	query, err := giq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return giq.sqlExist(ctx)
+		return query.sqlExist(ctx)
 	case dialect.Gremlin:
-		return giq.gremlinExist(ctx)
+		return query.gremlinExist(ctx)
 	default:
 		return false, errors.New("ent: unsupported dialect")
 	}
@@ -266,10 +480,17 @@ func (giq *GroupInfoQuery) Clone() *GroupInfoQuery {
 		offset:     giq.offset,
 		order:      append([]Order{}, giq.order...),
 		unique:     append([]string{}, giq.unique...),
+		fields:     append([]string{}, giq.fields...),
+		unordered:  giq.unordered,
+		hints:      append([]sql.Hint{}, giq.hints...),
+		edgeCount:  giq.edgeCount,
 		predicates: append([]predicate.GroupInfo{}, giq.predicates...),
+		withGroups: giq.withGroups,
 		// clone intermediate queries.
-		sql:     giq.sql.Clone(),
-		gremlin: giq.gremlin.Clone(),
+		sql:         giq.sql.Clone(),
+		sqlPath:     giq.sqlPath,
+		gremlin:     giq.gremlin.Clone(),
+		gremlinPath: giq.gremlinPath,
 	}
 }
 
@@ -291,11 +512,18 @@ func (giq *GroupInfoQuery) Clone() *GroupInfoQuery {
 func (giq *GroupInfoQuery) GroupBy(field string, fields ...string) *GroupInfoGroupBy {
 	group := &GroupInfoGroupBy{config: giq.config}
 	group.fields = append([]string{field}, fields...)
-	switch giq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		group.sql = giq.sqlQuery()
-	case dialect.Gremlin:
-		group.gremlin = giq.gremlinQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := giq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			group.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			group.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return group
 }
@@ -315,20 +543,27 @@ func (giq *GroupInfoQuery) GroupBy(field string, fields ...string) *GroupInfoGro
 func (giq *GroupInfoQuery) Select(field string, fields ...string) *GroupInfoSelect {
 	selector := &GroupInfoSelect{config: giq.config}
 	selector.fields = append([]string{field}, fields...)
-	switch giq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		selector.sql = giq.sqlQuery()
-	case dialect.Gremlin:
-		selector.gremlin = giq.gremlinQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := giq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			selector.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			selector.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return selector
 }
 
 func (giq *GroupInfoQuery) sqlAll(ctx context.Context) ([]*GroupInfo, error) {
 	rows := &sql.Rows{}
-	selector := giq.sqlQuery()
-	if unique := giq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := giq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := giq.driver.Query(ctx, query, args, rows); err != nil {
@@ -336,21 +571,138 @@ func (giq *GroupInfoQuery) sqlAll(ctx context.Context) ([]*GroupInfo, error) {
 	}
 	defer rows.Close()
 	var gis GroupInfos
-	if err := gis.FromRows(rows); err != nil {
+	if err := gis.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	gis.config(giq.config)
+	if query := giq.withGroups; query != nil {
+		if err := giq.sqlLoadGroups(ctx, query, gis); err != nil {
+			return nil, err
+		}
+	}
 	return gis, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (giq *GroupInfoQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := giq.sqlQuery(ctx)
+	if unique := giq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := groupinfo.Columns
+	if fields := giq.fields; len(fields) > 0 {
+		columns = append([]string{groupinfo.FieldID}, fields...)
+		for _, c := range fields {
+			if !groupinfo.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (giq *GroupInfoQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := giq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadGroups loads the nodes of the groups edge of the given GroupInfos using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (giq *GroupInfoQuery) sqlLoadGroups(ctx context.Context, query *GroupQuery, nodes []*GroupInfo) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*GroupInfo, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	key := selector.C(groupinfo.GroupsColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(group.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := giq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.Group{group.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*Group, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Groups = append(node.Edges.Groups, edge)
+	}
+	return nil
+}
+
 func (giq *GroupInfoQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := giq.sqlQuery()
+	selector := giq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{groupinfo.FieldID}
 	if len(giq.unique) > 0 {
 		unique = giq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := giq.edgeCount; c != nil && len(giq.predicates) == 0 && len(giq.unique) == 0 &&
+		len(giq.hints) == 0 && giq.limit == nil && giq.offset == nil {
+		selector = c.Clone().SetDialect(giq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := giq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -367,11 +719,22 @@ func (giq *GroupInfoQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (giq *GroupInfoQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := giq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := giq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(groupinfo.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := giq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := giq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (giq *GroupInfoQuery) sqlIDs(ctx context.Context) ([]string, error) {
@@ -386,13 +749,33 @@ func (giq *GroupInfoQuery) sqlIDs(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
-func (giq *GroupInfoQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (giq *GroupInfoQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := giq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(groupinfo.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (giq *GroupInfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(groupinfo.Table)
 	selector := sql.Select(t1.Columns(groupinfo.Columns...)...).From(t1)
 	if giq.sql != nil {
 		selector = giq.sql
 		selector.Select(selector.Columns(groupinfo.Columns...)...)
 	}
+	selector.SetDialect(giq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(giq.hints...)
 	for _, p := range giq.predicates {
 		p(selector)
 	}
@@ -412,8 +795,9 @@ func (giq *GroupInfoQuery) sqlQuery() *sql.Selector {
 
 func (giq *GroupInfoQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := giq.gremlinQuery().Query()
-	if err := giq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := giq.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := giq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	vertices, err := res.ReadVertices()
@@ -429,8 +813,9 @@ func (giq *GroupInfoQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 
 func (giq *GroupInfoQuery) gremlinAll(ctx context.Context) ([]*GroupInfo, error) {
 	res := &gremlin.Response{}
-	query, bindings := giq.gremlinQuery().ValueMap(true).Query()
-	if err := giq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := giq.gremlinAllQuery()
+	query, _ := traversal.Query()
+	if err := giq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	var gis GroupInfos
@@ -438,13 +823,53 @@ func (giq *GroupInfoQuery) gremlinAll(ctx context.Context) ([]*GroupInfo, error)
 		return nil, err
 	}
 	gis.config(giq.config)
+	if query := giq.withGroups; query != nil {
+		if err := giq.gremlinLoadGroups(ctx, query, gis); err != nil {
+			return nil, err
+		}
+	}
 	return gis, nil
 }
 
+// gremlinAllQuery returns the traversal of All, that returns the value maps of the vertices.
+func (giq *GroupInfoQuery) gremlinAllQuery() *dsl.Traversal {
+	args := []interface{}{true}
+	for _, f := range giq.fields {
+		args = append(args, f)
+	}
+	return giq.gremlinQuery().ValueMap(args...)
+}
+
+func (giq *GroupInfoQuery) gremlinQueryString(context.Context) (string, interface{}, error) {
+	query, bindings := giq.gremlinAllQuery().Query()
+	return query, bindings, nil
+}
+
+// gremlinLoadGroups loads the nodes of the groups edge of the given GroupInfos using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (giq *GroupInfoQuery) gremlinLoadGroups(ctx context.Context, query *GroupQuery, nodes []*GroupInfo) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&GroupInfoClient{config: giq.config}).QueryGroups(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Groups = edges
+	}
+	return nil
+}
+
 func (giq *GroupInfoQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := giq.gremlinQuery().Count().Query()
-	if err := giq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := giq.gremlinTraversal(false).Count()
+	query, _ := traversal.Query()
+	if err := giq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -452,14 +877,22 @@ func (giq *GroupInfoQuery) gremlinCount(ctx context.Context) (int, error) {
 
 func (giq *GroupInfoQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
-	query, bindings := giq.gremlinQuery().HasNext().Query()
-	if err := giq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := giq.gremlinTraversal(false).HasNext()
+	query, _ := traversal.Query()
+	if err := giq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
 }
 
+// gremlinQuery returns the traversal of the query for listing its vertices, with its default orders.
 func (giq *GroupInfoQuery) gremlinQuery() *dsl.Traversal {
+	return giq.gremlinTraversal(true)
+}
+
+// gremlinTraversal returns the traversal of the query. The default orders are added only to the traversals
+// of listing queries (list), since they don't change the results of the others (e.g. Count and Exist).
+func (giq *GroupInfoQuery) gremlinTraversal(list bool) *dsl.Traversal {
 	v := g.V().HasLabel(groupinfo.Label)
 	if giq.gremlin != nil {
 		v = giq.gremlin.Clone()
@@ -467,13 +900,22 @@ func (giq *GroupInfoQuery) gremlinQuery() *dsl.Traversal {
 	for _, p := range giq.predicates {
 		p(v)
 	}
-	if len(giq.order) > 0 {
+	limit, offset := giq.limit, giq.offset
+	list = list && !giq.unordered
+	// paginated queries are ordered by id (also as a tie-breaker of the
+	// query orders), in order to keep the pages stable between calls.
+	stable := (limit != nil || offset != nil) && list
+	order := giq.order
+	if len(order) > 0 || stable {
 		v.Order()
-		for _, p := range giq.order {
+		for _, p := range order {
 			p(v)
 		}
+		if stable {
+			v.By(dsl.ID, dsl.Incr)
+		}
 	}
-	switch limit, offset := giq.limit, giq.offset; {
+	switch {
 	case limit != nil && offset != nil:
 		v.Range(*offset, *offset+*limit)
 	case offset != nil:
@@ -495,6 +937,11 @@ type GroupInfoGroupBy struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving     *sql.Predicate
+	gremlinHaving *dsl.Traversal
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -503,8 +950,27 @@ func (gigb *GroupInfoGroupBy) Aggregate(fns ...Aggregate) *GroupInfoGroupBy {
 	return gigb
 }
 
+// Having adds per-dialect predicates on the aggregated values of the groups.
+// In Gremlin, the predicate is applied using a `where` step on the values of each group.
+// For example:
+//
+//	client.GroupInfo.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5), __.Select(ent.DefaultCountLabel).Is(p.GT(5))).
+//		Scan(ctx, &v)
+//
+func (gigb *GroupInfoGroupBy) Having(p0 *sql.Predicate, p1 *dsl.Traversal) *GroupInfoGroupBy {
+	gigb.sqlHaving = p0
+	gigb.gremlinHaving = p1
+	return gigb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (gigb *GroupInfoGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := gigb.path(ctx); err != nil {
+		return err
+	}
 	switch gigb.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return gigb.sqlScan(ctx, v)
@@ -607,6 +1073,9 @@ func (gigb *GroupInfoGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (gigb *GroupInfoGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := gigb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := gigb.sqlQuery().Query()
 	if err := gigb.driver.Query(ctx, query, args, rows); err != nil {
@@ -623,13 +1092,18 @@ func (gigb *GroupInfoGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range gigb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(gigb.fields...)
+	selector.Select(columns...).GroupBy(gigb.fields...)
+	if gigb.sqlHaving != nil {
+		selector.Having(gigb.sqlHaving)
+	}
+	return selector
 }
 
 func (gigb *GroupInfoGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
-	query, bindings := gigb.gremlinQuery().Query()
-	if err := gigb.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := gigb.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := gigb.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(gigb.fields)+len(gigb.fns) == 1 {
@@ -656,11 +1130,14 @@ func (gigb *GroupInfoGroupBy) gremlinQuery() *dsl.Traversal {
 		names = append(names, f)
 		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
 	}
-	return gigb.gremlin.Group().
+	v := gigb.gremlin.Group().
 		By(__.Values(gigb.fields...).Fold()).
 		By(__.Fold().Match(trs...).Select(names...)).
-		Select(dsl.Values).
-		Next()
+		Select(dsl.Values)
+	if gigb.gremlinHaving != nil {
+		v = v.Unfold().Where(gigb.gremlinHaving).Fold()
+	}
+	return v.Next()
 }
 
 // GroupInfoSelect is the builder for select fields of GroupInfo entities.
@@ -670,10 +1147,15 @@ type GroupInfoSelect struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (gis *GroupInfoSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := gis.path(ctx); err != nil {
+		return err
+	}
 	switch gis.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return gis.sqlScan(ctx, v)
@@ -776,6 +1258,9 @@ func (gis *GroupInfoSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (gis *GroupInfoSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := gis.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := gis.sqlQuery().Query()
 	if err := gis.driver.Query(ctx, query, args, rows); err != nil {
@@ -804,8 +1289,8 @@ func (gis *GroupInfoSelect) gremlinScan(ctx context.Context, v interface{}) erro
 		}
 		traversal = gis.gremlin.ValueMap(fields...)
 	}
-	query, bindings := traversal.Query()
-	if err := gis.driver.Exec(ctx, query, bindings, res); err != nil {
+	query, _ := traversal.Query()
+	if err := gis.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(gis.fields) == 1 {
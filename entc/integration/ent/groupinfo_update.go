@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -22,17 +23,17 @@ import (
 	"github.com/facebookincubator/ent/entc/integration/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/ent/groupinfo"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // GroupInfoUpdate is the builder for updating GroupInfo entities.
 type GroupInfoUpdate struct {
 	config
-	desc          *string
-	max_users     *int
-	addmax_users  *int
-	groups        map[string]struct{}
-	removedGroups map[string]struct{}
-	predicates    []predicate.GroupInfo
+	groupinfoMutation
+	predicates []predicate.GroupInfo
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*GroupInfo
 }
 
 // Where adds a new predicate for the builder.
@@ -41,6 +42,11 @@ func (giu *GroupInfoUpdate) Where(ps ...predicate.GroupInfo) *GroupInfoUpdate {
 	return giu
 }
 
+// Mutation returns the mutation of the builder.
+func (giu *GroupInfoUpdate) Mutation() *GroupInfoMutation {
+	return &GroupInfoMutation{groupinfoMutation: &giu.groupinfoMutation, op: ent.OpUpdate}
+}
+
 // SetDesc sets the desc field.
 func (giu *GroupInfoUpdate) SetDesc(s string) *GroupInfoUpdate {
 	giu.desc = &s
@@ -92,6 +98,12 @@ func (giu *GroupInfoUpdate) AddGroups(g ...*Group) *GroupInfoUpdate {
 	return giu.AddGroupIDs(ids...)
 }
 
+// ClearGroups clears all groups edges to Group.
+func (giu *GroupInfoUpdate) ClearGroups() *GroupInfoUpdate {
+	giu.clearedGroups = true
+	return giu
+}
+
 // RemoveGroupIDs removes the groups edge to Group by ids.
 func (giu *GroupInfoUpdate) RemoveGroupIDs(ids ...string) *GroupInfoUpdate {
 	if giu.removedGroups == nil {
@@ -113,20 +125,68 @@ func (giu *GroupInfoUpdate) RemoveGroups(g ...*Group) *GroupInfoUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (giu *GroupInfoUpdate) Save(ctx context.Context) (int, error) {
+func (giu *GroupInfoUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := giu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (giu *GroupInfoUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]string, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if giu.shadow != nil {
+		return giu.dualSave(ctx, opts...)
+	}
+	ids, err := giu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 && !options.SkipHooks {
+		giu.bus.publish(&Event{Op: ent.OpUpdate, Type: groupinfo.Label, N: len(ids)})
+	}
+	return ids, nil
+}
+
+// save updates the GroupInfo nodes in the storage of the client dialect.
+func (giu *GroupInfoUpdate) save(ctx context.Context) ([]string, error) {
+	predicates, err := interceptGroupInfo(ctx, giu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *giu
+		intercepted.predicates = append(predicates, giu.predicates...)
+		giu = &intercepted
+	}
 	switch giu.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return giu.sqlSave(ctx)
 	case dialect.Gremlin:
 		return giu.gremlinSave(ctx)
 	default:
-		return 0, errors.New("ent: unsupported dialect")
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (giu *GroupInfoUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*GroupInfo, error) {
+	var gis []*GroupInfo
+	giu.returning = &gis
+	defer func() { giu.returning = nil }()
+	if _, err := giu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
 	}
+	return gis, nil
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (giu *GroupInfoUpdate) SaveX(ctx context.Context) int {
-	affected, err := giu.Save(ctx)
+func (giu *GroupInfoUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := giu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -134,44 +194,49 @@ func (giu *GroupInfoUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (giu *GroupInfoUpdate) Exec(ctx context.Context) error {
-	_, err := giu.Save(ctx)
+func (giu *GroupInfoUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := giu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (giu *GroupInfoUpdate) ExecX(ctx context.Context) {
-	if err := giu.Exec(ctx); err != nil {
+func (giu *GroupInfoUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := giu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(groupinfo.FieldID).From(sql.Table(groupinfo.Table))
+func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (_ []string, err error) {
+	ctx = entcache.Invalidates(ctx, groupinfo.Tables...)
+	selector := sql.Select(groupinfo.FieldID).From(sql.Table(groupinfo.Table)).SetDialect(giu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range giu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = giu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := giu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -189,11 +254,19 @@ func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if len(giu.removedGroups) > 0 {
-		eids := make([]int, len(giu.removedGroups))
+	if giu.clearedGroups {
+		query, args := sql.Update(groupinfo.GroupsTable).
+			SetNull(groupinfo.GroupsColumn).
+			Where(sql.InInts(groupinfo.GroupsColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(giu.removedGroups) > 0 {
+		eids := make([]int, 0, len(giu.removedGroups))
 		for eid := range giu.removedGroups {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -208,7 +281,7 @@ func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(group.FieldID, eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(giu.groups) > 0 {
@@ -227,33 +300,78 @@ func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.And(p, sql.IsNull(groupinfo.GroupsColumn))).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(giu.groups) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"groups\" %v already connected to a different \"GroupInfo\"", keys(giu.groups))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"groups\" %v already connected to a different \"GroupInfo\"", keys(giu.groups))})
 			}
 		}
 	}
+	if giu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(groupinfo.Columns...).
+			From(sql.Table(groupinfo.Table)).
+			Where(sql.InInts(groupinfo.FieldID, ids...)).
+			SetDialect(giu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var gis GroupInfos
+		err := gis.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into GroupInfo: %v", err))
+		}
+		gis.config(giu.config)
+		*giu.returning = gis
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	vs := make([]string, len(ids))
+	for i, id := range ids {
+		vs[i] = strconv.Itoa(id)
+	}
+	return vs, nil
 }
 
-func (giu *GroupInfoUpdate) gremlinSave(ctx context.Context) (int, error) {
+func (giu *GroupInfoUpdate) gremlinSave(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := giu.gremlin().Query()
-	if err := giu.driver.Exec(ctx, query, bindings, res); err != nil {
-		return 0, err
+	traversal := giu.gremlin()
+	query, _ := traversal.Query()
+	if err := giu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
-		return 0, err
+		return nil, err
 	}
-	return res.ReadInt()
+	if giu.returning != nil {
+		var gis GroupInfos
+		if err := gis.FromResponse(res); err != nil {
+			return nil, err
+		}
+		gis.config(giu.config)
+		*giu.returning = gis
+		ids := make([]string, 0, len(gis))
+		for _, gi := range gis {
+			ids = append(ids, gi.ID)
+		}
+		return ids, nil
+	}
+	vertices, err := res.ReadVertices()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vertices))
+	for _, vertex := range vertices {
+		ids = append(ids, vertex.ID.(string))
+	}
+	return ids, nil
 }
 
 func (giu *GroupInfoUpdate) gremlin() *dsl.Traversal {
@@ -281,6 +399,10 @@ func (giu *GroupInfoUpdate) gremlin() *dsl.Traversal {
 	if value := giu.addmax_users; value != nil {
 		v.Property(dsl.Single, groupinfo.FieldMaxUsers, __.Union(__.Values(groupinfo.FieldMaxUsers), __.Constant(*value)).Sum())
 	}
+	if giu.clearedGroups {
+		tr := rv.Clone().InE(group.InfoLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range giu.removedGroups {
 		tr := rv.Clone().InE(group.InfoLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -292,11 +414,13 @@ func (giu *GroupInfoUpdate) gremlin() *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(groupinfo.Label, group.InfoLabel, id)),
 		})
 	}
-	v.Count()
+	if giu.returning != nil {
+		v.ValueMap(true)
+	}
 	if len(constraints) > 0 {
 		constraints = append(constraints, &constraint{
 			pred: rv.Count(),
-			test: __.Is(p.GT(1)).Constant(&ErrConstraintFailed{msg: "update traversal contains more than one vertex"}),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
 		})
 		v = constraints[0].pred.Coalesce(constraints[0].test, v)
 		for _, cr := range constraints[1:] {
@@ -310,12 +434,16 @@ func (giu *GroupInfoUpdate) gremlin() *dsl.Traversal {
 // GroupInfoUpdateOne is the builder for updating a single GroupInfo entity.
 type GroupInfoUpdateOne struct {
 	config
-	id            string
-	desc          *string
-	max_users     *int
-	addmax_users  *int
-	groups        map[string]struct{}
-	removedGroups map[string]struct{}
+	id     string
+	entity *GroupInfo
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.GroupInfo
+	groupinfoMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (giuo *GroupInfoUpdateOne) Mutation() *GroupInfoMutation {
+	return &GroupInfoMutation{groupinfoMutation: &giuo.groupinfoMutation, op: ent.OpUpdateOne, id: &giuo.id}
 }
 
 // SetDesc sets the desc field.
@@ -369,6 +497,12 @@ func (giuo *GroupInfoUpdateOne) AddGroups(g ...*Group) *GroupInfoUpdateOne {
 	return giuo.AddGroupIDs(ids...)
 }
 
+// ClearGroups clears all groups edges to Group.
+func (giuo *GroupInfoUpdateOne) ClearGroups() *GroupInfoUpdateOne {
+	giuo.clearedGroups = true
+	return giuo
+}
+
 // RemoveGroupIDs removes the groups edge to Group by ids.
 func (giuo *GroupInfoUpdateOne) RemoveGroupIDs(ids ...string) *GroupInfoUpdateOne {
 	if giuo.removedGroups == nil {
@@ -389,8 +523,56 @@ func (giuo *GroupInfoUpdateOne) RemoveGroups(g ...*Group) *GroupInfoUpdateOne {
 	return giuo.RemoveGroupIDs(ids...)
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (giuo *GroupInfoUpdateOne) SetChangedFrom(original *GroupInfo) *GroupInfoUpdateOne {
+	gi := giuo.entity
+	if gi == nil || original == nil {
+		return giuo
+	}
+	for _, change := range DiffGroupInfo(original, gi) {
+		switch change.Field {
+		case groupinfo.FieldDesc:
+			giuo.SetDesc(gi.Desc)
+		case groupinfo.FieldMaxUsers:
+			giuo.SetMaxUsers(gi.MaxUsers)
+		}
+	}
+	return giuo
+}
+
 // Save executes the query and returns the updated entity.
-func (giuo *GroupInfoUpdateOne) Save(ctx context.Context) (*GroupInfo, error) {
+func (giuo *GroupInfoUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*GroupInfo, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if giuo.shadow != nil {
+		return giuo.dualSave(ctx, opts...)
+	}
+	gi, err := giuo.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		giuo.bus.publish(&Event{Op: ent.OpUpdateOne, Type: groupinfo.Label, ID: gi.ID, Node: gi, N: 1})
+	}
+	return gi, nil
+}
+
+// save updates the GroupInfo in the storage of the client dialect.
+func (giuo *GroupInfoUpdateOne) save(ctx context.Context) (*GroupInfo, error) {
+	predicates, err := interceptGroupInfo(ctx, giuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *giuo
+		intercepted.predicates = append(predicates, giuo.predicates...)
+		giuo = &intercepted
+	}
 	switch giuo.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return giuo.sqlSave(ctx)
@@ -402,8 +584,8 @@ func (giuo *GroupInfoUpdateOne) Save(ctx context.Context) (*GroupInfo, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (giuo *GroupInfoUpdateOne) SaveX(ctx context.Context) *GroupInfo {
-	gi, err := giuo.Save(ctx)
+func (giuo *GroupInfoUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *GroupInfo {
+	gi, err := giuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -411,21 +593,29 @@ func (giuo *GroupInfoUpdateOne) SaveX(ctx context.Context) *GroupInfo {
 }
 
 // Exec executes the query on the entity.
-func (giuo *GroupInfoUpdateOne) Exec(ctx context.Context) error {
-	_, err := giuo.Save(ctx)
+func (giuo *GroupInfoUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := giuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (giuo *GroupInfoUpdateOne) ExecX(ctx context.Context) {
-	if err := giuo.Exec(ctx); err != nil {
+func (giuo *GroupInfoUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := giuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (gi *GroupInfo, err error) {
-	selector := sql.Select(groupinfo.Columns...).From(sql.Table(groupinfo.Table))
+	ctx = entcache.Invalidates(ctx, groupinfo.Tables...)
+	selector := sql.Select(groupinfo.Columns...).From(sql.Table(groupinfo.Table)).SetDialect(giuo.driver.Dialect())
 	groupinfo.ID(giuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range giuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = giuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -475,8 +665,16 @@ func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (gi *GroupInfo, err
 			return nil, rollback(tx, err)
 		}
 	}
-	if len(giuo.removedGroups) > 0 {
-		eids := make([]int, len(giuo.removedGroups))
+	if giuo.clearedGroups {
+		query, args := sql.Update(groupinfo.GroupsTable).
+			SetNull(groupinfo.GroupsColumn).
+			Where(sql.InInts(groupinfo.GroupsColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(giuo.removedGroups) > 0 {
+		eids := make([]int, 0, len(giuo.removedGroups))
 		for eid := range giuo.removedGroups {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -517,7 +715,7 @@ func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (gi *GroupInfo, err
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(giuo.groups) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"groups\" %v already connected to a different \"GroupInfo\"", keys(giuo.groups))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"groups\" %v already connected to a different \"GroupInfo\"", keys(giuo.groups))})
 			}
 		}
 	}
@@ -529,8 +727,9 @@ func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (gi *GroupInfo, err
 
 func (giuo *GroupInfoUpdateOne) gremlinSave(ctx context.Context) (*GroupInfo, error) {
 	res := &gremlin.Response{}
-	query, bindings := giuo.gremlin(giuo.id).Query()
-	if err := giuo.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := giuo.gremlin(giuo.id)
+	query, _ := traversal.Query()
+	if err := giuo.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -550,6 +749,9 @@ func (giuo *GroupInfoUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 1)
 	v := g.V(id)
+	for _, p := range giuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -565,6 +767,10 @@ func (giuo *GroupInfoUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value := giuo.addmax_users; value != nil {
 		v.Property(dsl.Single, groupinfo.FieldMaxUsers, __.Union(__.Values(groupinfo.FieldMaxUsers), __.Constant(*value)).Sum())
 	}
+	if giuo.clearedGroups {
+		tr := rv.Clone().InE(group.InfoLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range giuo.removedGroups {
 		tr := rv.Clone().InE(group.InfoLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -13,27 +13,64 @@ import (
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/ent/item"
 )
 
 // Item is the model entity for the Item schema.
 type Item struct {
-	config
+	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// UpdateField holds the value of the "update_field" field.
+	UpdateField string `json:"update,omitempty"`
+	// LabelField holds the value of the "label_field" field.
+	LabelField string `json:"label,omitempty"`
+	// Type holds the value of the "type" field.
+	Type item.Type `json:"type,omitempty"`
+	// Func holds the value of the "func" field.
+	Func string `json:"func,omitempty"`
 }
 
 // FromRows scans the sql response data into Item.
 func (i *Item) FromRows(rows *sql.Rows) error {
+	return i.scan(rows, item.Columns)
+}
+
+// scan scans the given columns of the sql response data into Item.
+// Fields that their columns were not selected are left with their zero values.
+func (i *Item) scan(rows *sql.Rows, columns []string) error {
 	var vi struct {
-		ID int
+		ID          int
+		UpdateField sql.NullString
+		LabelField  sql.NullString
+		Type        sql.NullString
+		Func        sql.NullString
 	}
-	// the order here should be the same as in the `item.Columns`.
-	if err := rows.Scan(
-		&vi.ID,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case item.FieldID:
+			values[i] = &vi.ID
+		case item.FieldUpdateField:
+			values[i] = &vi.UpdateField
+		case item.FieldLabelField:
+			values[i] = &vi.LabelField
+		case item.FieldType:
+			values[i] = &vi.Type
+		case item.FieldFunc:
+			values[i] = &vi.Func
+		default:
+			return fmt.Errorf("unexpected column %q for type Item", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	i.ID = strconv.Itoa(vi.ID)
+	i.UpdateField = vi.UpdateField.String
+	i.LabelField = vi.LabelField.String
+	i.Type = item.Type(vi.Type.String)
+	i.Func = vi.Func.String
 	return nil
 }
 
@@ -44,12 +81,20 @@ func (i *Item) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var vi struct {
-		ID string `json:"id,omitempty"`
+		ID          string    `json:"id,omitempty"`
+		UpdateField string    `json:"update,omitempty"`
+		LabelField  string    `json:"label,omitempty"`
+		Type        item.Type `json:"type,omitempty"`
+		Func        string    `json:"func,omitempty"`
 	}
 	if err := vmap.Decode(&vi); err != nil {
 		return err
 	}
 	i.ID = vi.ID
+	i.UpdateField = vi.UpdateField
+	i.LabelField = vi.LabelField
+	i.Type = vi.Type
+	i.Func = vi.Func
 	return nil
 }
 
@@ -71,15 +116,62 @@ func (i *Item) Unwrap() *Item {
 	return i
 }
 
+// Clone returns a deep copy of the Item, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (i *Item) Clone() *Item {
+	return i.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Item, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (i *Item) clone(seen map[interface{}]interface{}) *Item {
+	if i == nil {
+		return nil
+	}
+	if _c, ok := seen[i]; ok {
+		return _c.(*Item)
+	}
+	_c := *i
+	_c.config = config{}
+	seen[i] = &_c
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (i *Item) String() string {
 	buf := bytes.NewBuffer(nil)
 	buf.WriteString("Item(")
 	buf.WriteString(fmt.Sprintf("id=%v", i.ID))
+	buf.WriteString(fmt.Sprintf(", update_field=%v", i.UpdateField))
+	buf.WriteString(fmt.Sprintf(", label_field=%v", i.LabelField))
+	buf.WriteString(fmt.Sprintf(", type=%v", i.Type))
+	buf.WriteString(fmt.Sprintf(", func=%v", i.Func))
 	buf.WriteString(")")
 	return buf.String()
 }
 
+// DiffItem returns the fields that have different values in the given Items,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffItem(a, b *Item) []FieldChange {
+	var changes []FieldChange
+	if a.UpdateField != b.UpdateField {
+		changes = append(changes, FieldChange{Field: item.FieldUpdateField, Old: a.UpdateField, New: b.UpdateField})
+	}
+	if a.LabelField != b.LabelField {
+		changes = append(changes, FieldChange{Field: item.FieldLabelField, Old: a.LabelField, New: b.LabelField})
+	}
+	if a.Type != b.Type {
+		changes = append(changes, FieldChange{Field: item.FieldType, Old: a.Type, New: b.Type})
+	}
+	if a.Func != b.Func {
+		changes = append(changes, FieldChange{Field: item.FieldFunc, Old: a.Func, New: b.Func})
+	}
+	return changes
+}
+
 // id returns the int representation of the ID field.
 func (i *Item) id() int {
 	id, _ := strconv.Atoi(i.ID)
@@ -91,9 +183,14 @@ type Items []*Item
 
 // FromRows scans the sql response data into Items.
 func (i *Items) FromRows(rows *sql.Rows) error {
+	return i.scan(rows, item.Columns)
+}
+
+// scan scans the given columns of the sql response data into Items.
+func (i *Items) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vi := &Item{}
-		if err := vi.FromRows(rows); err != nil {
+		if err := vi.scan(rows, columns); err != nil {
 			return err
 		}
 		*i = append(*i, vi)
@@ -108,14 +205,22 @@ func (i *Items) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var vi []struct {
-		ID string `json:"id,omitempty"`
+		ID          string    `json:"id,omitempty"`
+		UpdateField string    `json:"update,omitempty"`
+		LabelField  string    `json:"label,omitempty"`
+		Type        item.Type `json:"type,omitempty"`
+		Func        string    `json:"func,omitempty"`
 	}
 	if err := vmap.Decode(&vi); err != nil {
 		return err
 	}
 	for _, v := range vi {
 		*i = append(*i, &Item{
-			ID: v.ID,
+			ID:          v.ID,
+			UpdateField: v.UpdateField,
+			LabelField:  v.LabelField,
+			Type:        v.Type,
+			Func:        v.Func,
 		})
 	}
 	return nil
@@ -6,11 +6,28 @@
 
 package item
 
+import (
+	"fmt"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the item type in the database.
 	Label = "item"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldUpdateField holds the string denoting the update_field vertex property in the database.
+	FieldUpdateField = "update"
+	// FieldLabelField holds the string denoting the label_field vertex property in the database.
+	FieldLabelField = "label"
+	// FieldType holds the string denoting the type vertex property in the database.
+	FieldType = "type"
+	// FieldFunc holds the string denoting the func vertex property in the database.
+	FieldFunc = "func"
 
 	// Table holds the table name of the item in the database.
 	Table = "items"
@@ -19,4 +36,144 @@ const (
 // Columns holds all SQL columns are item fields.
 var Columns = []string{
 	FieldID,
+	FieldUpdateField,
+	FieldLabelField,
+	FieldType,
+	FieldFunc,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Tables holds the tables that may be changed by the mutations of the item. The mutations
+// invalidate only the query results of these tables that were cached by the entcache driver.
+var Tables = []string{
+	"items",
+}
+
+var (
+	fields = schema.Item{}.Fields()
+
+	// descFunc is the schema descriptor for func field.
+	descFunc = fields[3].Descriptor()
+	// FuncValidator is a validator for the "func" field. It is called by the builders before save.
+	FuncValidator = func() func(string) error {
+		validators := descFunc.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(_func string) error {
+			for _, fn := range fns {
+				if err := fn(_func); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+)
+
+// Scope is a reusable named scope of the Item queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.Item
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
 }
+
+// Type defines the type for the type enum field.
+type Type string
+
+const (
+	TypeA Type = "a"
+	TypeB Type = "b"
+)
+
+func (s Type) String() string {
+	return string(s)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeA, TypeB:
+		return nil
+	default:
+		return fmt.Errorf("item: invalid enum value for type field: %q", _type)
+	}
+}
+
+// descriptor holds the runtime descriptor of the Item type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "Item",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeString,
+		GoType:     "string",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "update_field",
+			StorageKey: FieldUpdateField,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+		},
+		{
+			Name:       "label_field",
+			StorageKey: FieldLabelField,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+		},
+		{
+			Name:       "type",
+			StorageKey: FieldType,
+			Type:       field.TypeEnum,
+			GoType:     "item.Type",
+			Enums:      []string{"a", "b"},
+			Optional:   true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(Type)
+				if !ok {
+					return fmt.Errorf("item: unexpected type %T for field type", v)
+				}
+				return TypeValidator(vv)
+			},
+		},
+		{
+			Name:       "func",
+			StorageKey: FieldFunc,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(string)
+				if !ok {
+					return fmt.Errorf("item: unexpected type %T for field func", v)
+				}
+				return FuncValidator(vv)
+			},
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{},
+}
+
+// Descriptor returns the runtime descriptor of the Item type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
@@ -7,7 +7,11 @@
 package item
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
@@ -159,6 +163,1057 @@ func IDLTE(id string) predicate.Item {
 	)
 }
 
+// UpdateField applies equality check predicate on the "update_field" field. It's identical to UpdateFieldEQ.
+func UpdateField(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.EQ(v))
+		},
+	)
+}
+
+// LabelField applies equality check predicate on the "label_field" field. It's identical to LabelFieldEQ.
+func LabelField(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.EQ(v))
+		},
+	)
+}
+
+// Func applies equality check predicate on the "func" field. It's identical to FuncEQ.
+func Func(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.EQ(v))
+		},
+	)
+}
+
+// UpdateFieldEQ applies the EQ predicate on the "update_field" field.
+func UpdateFieldEQ(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.EQ(v))
+		},
+	)
+}
+
+// UpdateFieldNEQ applies the NEQ predicate on the "update_field" field.
+func UpdateFieldNEQ(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.NEQ(v))
+		},
+	)
+}
+
+// UpdateFieldIn applies the In predicate on the "update_field" field.
+func UpdateFieldIn(vs ...string) predicate.Item {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldUpdateField), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.Within(v...))
+		},
+	)
+}
+
+// UpdateFieldNotIn applies the NotIn predicate on the "update_field" field.
+func UpdateFieldNotIn(vs ...string) predicate.Item {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.NotIn(s.C(FieldUpdateField), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.Without(v...))
+		},
+	)
+}
+
+// UpdateFieldGT applies the GT predicate on the "update_field" field.
+func UpdateFieldGT(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GT(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.GT(v))
+		},
+	)
+}
+
+// UpdateFieldGTE applies the GTE predicate on the "update_field" field.
+func UpdateFieldGTE(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GTE(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.GTE(v))
+		},
+	)
+}
+
+// UpdateFieldLT applies the LT predicate on the "update_field" field.
+func UpdateFieldLT(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LT(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.LT(v))
+		},
+	)
+}
+
+// UpdateFieldLTE applies the LTE predicate on the "update_field" field.
+func UpdateFieldLTE(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LTE(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.LTE(v))
+		},
+	)
+}
+
+// UpdateFieldContains applies the Contains predicate on the "update_field" field.
+func UpdateFieldContains(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.Contains(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.Containing(v))
+		},
+	)
+}
+
+// UpdateFieldHasPrefix applies the HasPrefix predicate on the "update_field" field.
+func UpdateFieldHasPrefix(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.HasPrefix(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.StartingWith(v))
+		},
+	)
+}
+
+// UpdateFieldHasSuffix applies the HasSuffix predicate on the "update_field" field.
+func UpdateFieldHasSuffix(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.HasSuffix(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.EndingWith(v))
+		},
+	)
+}
+
+// UpdateFieldIsNil applies the IsNil predicate on the "update_field" field.
+func UpdateFieldIsNil() predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldUpdateField)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldUpdateField)
+		},
+	)
+}
+
+// UpdateFieldNotNil applies the NotNil predicate on the "update_field" field.
+func UpdateFieldNotNil() predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldUpdateField)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldUpdateField)
+		},
+	)
+}
+
+// UpdateFieldEqualFold applies the EqualFold predicate on the "update_field" field.
+func UpdateFieldEqualFold(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.EqualFold(v))
+		},
+	)
+}
+
+// UpdateFieldContainsFold applies the ContainsFold predicate on the "update_field" field.
+func UpdateFieldContainsFold(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldUpdateField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldUpdateField, p.ContainsFold(v))
+		},
+	)
+}
+
+// LabelFieldEQ applies the EQ predicate on the "label_field" field.
+func LabelFieldEQ(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.EQ(v))
+		},
+	)
+}
+
+// LabelFieldNEQ applies the NEQ predicate on the "label_field" field.
+func LabelFieldNEQ(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.NEQ(v))
+		},
+	)
+}
+
+// LabelFieldIn applies the In predicate on the "label_field" field.
+func LabelFieldIn(vs ...string) predicate.Item {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldLabelField), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.Within(v...))
+		},
+	)
+}
+
+// LabelFieldNotIn applies the NotIn predicate on the "label_field" field.
+func LabelFieldNotIn(vs ...string) predicate.Item {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.NotIn(s.C(FieldLabelField), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.Without(v...))
+		},
+	)
+}
+
+// LabelFieldGT applies the GT predicate on the "label_field" field.
+func LabelFieldGT(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GT(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.GT(v))
+		},
+	)
+}
+
+// LabelFieldGTE applies the GTE predicate on the "label_field" field.
+func LabelFieldGTE(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GTE(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.GTE(v))
+		},
+	)
+}
+
+// LabelFieldLT applies the LT predicate on the "label_field" field.
+func LabelFieldLT(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LT(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.LT(v))
+		},
+	)
+}
+
+// LabelFieldLTE applies the LTE predicate on the "label_field" field.
+func LabelFieldLTE(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LTE(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.LTE(v))
+		},
+	)
+}
+
+// LabelFieldContains applies the Contains predicate on the "label_field" field.
+func LabelFieldContains(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.Contains(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.Containing(v))
+		},
+	)
+}
+
+// LabelFieldHasPrefix applies the HasPrefix predicate on the "label_field" field.
+func LabelFieldHasPrefix(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.HasPrefix(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.StartingWith(v))
+		},
+	)
+}
+
+// LabelFieldHasSuffix applies the HasSuffix predicate on the "label_field" field.
+func LabelFieldHasSuffix(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.HasSuffix(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.EndingWith(v))
+		},
+	)
+}
+
+// LabelFieldIsNil applies the IsNil predicate on the "label_field" field.
+func LabelFieldIsNil() predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldLabelField)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldLabelField)
+		},
+	)
+}
+
+// LabelFieldNotNil applies the NotNil predicate on the "label_field" field.
+func LabelFieldNotNil() predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldLabelField)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldLabelField)
+		},
+	)
+}
+
+// LabelFieldEqualFold applies the EqualFold predicate on the "label_field" field.
+func LabelFieldEqualFold(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.EqualFold(v))
+		},
+	)
+}
+
+// LabelFieldContainsFold applies the ContainsFold predicate on the "label_field" field.
+func LabelFieldContainsFold(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldLabelField), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLabelField, p.ContainsFold(v))
+		},
+	)
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldType), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldType, p.EQ(v))
+		},
+	)
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldType), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldType, p.NEQ(v))
+		},
+	)
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.Item {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldType), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldType, p.Within(v...))
+		},
+	)
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.Item {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.NotIn(s.C(FieldType), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldType, p.Without(v...))
+		},
+	)
+}
+
+// TypeIsNil applies the IsNil predicate on the "type" field.
+func TypeIsNil() predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldType)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldType)
+		},
+	)
+}
+
+// TypeNotNil applies the NotNil predicate on the "type" field.
+func TypeNotNil() predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldType)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldType)
+		},
+	)
+}
+
+// FuncEQ applies the EQ predicate on the "func" field.
+func FuncEQ(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.EQ(v))
+		},
+	)
+}
+
+// FuncNEQ applies the NEQ predicate on the "func" field.
+func FuncNEQ(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.NEQ(v))
+		},
+	)
+}
+
+// FuncIn applies the In predicate on the "func" field.
+func FuncIn(vs ...string) predicate.Item {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldFunc), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.Within(v...))
+		},
+	)
+}
+
+// FuncNotIn applies the NotIn predicate on the "func" field.
+func FuncNotIn(vs ...string) predicate.Item {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.NotIn(s.C(FieldFunc), v...))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.Without(v...))
+		},
+	)
+}
+
+// FuncGT applies the GT predicate on the "func" field.
+func FuncGT(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GT(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.GT(v))
+		},
+	)
+}
+
+// FuncGTE applies the GTE predicate on the "func" field.
+func FuncGTE(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.GTE(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.GTE(v))
+		},
+	)
+}
+
+// FuncLT applies the LT predicate on the "func" field.
+func FuncLT(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LT(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.LT(v))
+		},
+	)
+}
+
+// FuncLTE applies the LTE predicate on the "func" field.
+func FuncLTE(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.LTE(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.LTE(v))
+		},
+	)
+}
+
+// FuncContains applies the Contains predicate on the "func" field.
+func FuncContains(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.Contains(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.Containing(v))
+		},
+	)
+}
+
+// FuncHasPrefix applies the HasPrefix predicate on the "func" field.
+func FuncHasPrefix(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.HasPrefix(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.StartingWith(v))
+		},
+	)
+}
+
+// FuncHasSuffix applies the HasSuffix predicate on the "func" field.
+func FuncHasSuffix(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.HasSuffix(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.EndingWith(v))
+		},
+	)
+}
+
+// FuncIsNil applies the IsNil predicate on the "func" field.
+func FuncIsNil() predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldFunc)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldFunc)
+		},
+	)
+}
+
+// FuncNotNil applies the NotNil predicate on the "func" field.
+func FuncNotNil() predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldFunc)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldFunc)
+		},
+	)
+}
+
+// FuncEqualFold applies the EqualFold predicate on the "func" field.
+func FuncEqualFold(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.EqualFold(v))
+		},
+	)
+}
+
+// FuncContainsFold applies the ContainsFold predicate on the "func" field.
+func FuncContainsFold(v string) predicate.Item {
+	return predicate.ItemPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldFunc), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldFunc, p.ContainsFold(v))
+		},
+	)
+}
+
+// PredicateFunc returns a predicate from the given per-dialect functions.
+// It allows adding custom SQL/Gremlin fragments to the Item builders.
+func PredicateFunc(f0 func(*sql.Selector), f1 func(*dsl.Traversal)) predicate.Item {
+	return predicate.ItemPerDialect(f0, f1)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.Item, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.Item, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.Item, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldUpdateField:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UpdateFieldEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UpdateFieldNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return UpdateFieldIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return UpdateFieldNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UpdateFieldGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UpdateFieldGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UpdateFieldLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UpdateFieldLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UpdateFieldContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UpdateFieldHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UpdateFieldHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return UpdateFieldIsNil(), nil
+				}
+				return Not(UpdateFieldIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return UpdateFieldNotNil(), nil
+				}
+				return Not(UpdateFieldNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UpdateFieldEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return UpdateFieldContainsFold(v), nil
+			}
+		}
+	case FieldLabelField:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LabelFieldEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LabelFieldNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return LabelFieldIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return LabelFieldNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LabelFieldGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LabelFieldGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LabelFieldLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LabelFieldLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LabelFieldContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LabelFieldHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LabelFieldHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return LabelFieldIsNil(), nil
+				}
+				return Not(LabelFieldIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return LabelFieldNotNil(), nil
+				}
+				return Not(LabelFieldNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LabelFieldEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LabelFieldContainsFold(v), nil
+			}
+		}
+	case FieldType:
+		switch op {
+		case "eq":
+			if v, ok := value.(Type); ok || convert(value, &v) {
+				return TypeEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(Type); ok || convert(value, &v) {
+				return TypeNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]Type); ok || convert(value, &vs) {
+				return TypeIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]Type); ok || convert(value, &vs) {
+				return TypeNotIn(vs...), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return TypeIsNil(), nil
+				}
+				return Not(TypeIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return TypeNotNil(), nil
+				}
+				return Not(TypeNotNil()), nil
+			}
+		}
+	case FieldFunc:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return FuncEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return FuncNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return FuncIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return FuncNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return FuncGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return FuncGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return FuncLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return FuncLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return FuncContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return FuncHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return FuncHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return FuncIsNil(), nil
+				}
+				return Not(FuncIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return FuncNotNil(), nil
+				}
+				return Not(FuncNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return FuncEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return FuncContainsFold(v), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("item: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Item) predicate.Item {
 	return predicate.ItemPerDialect(
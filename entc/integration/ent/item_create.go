@@ -9,23 +9,119 @@ package ent
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/g"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/item"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // ItemCreate is the builder for creating a Item entity.
 type ItemCreate struct {
 	config
+	itemMutation
+	// id of the node, if it's mirrored from the primary storage.
+	id *string
+}
+
+// Mutation returns the mutation of the builder.
+func (ic *ItemCreate) Mutation() *ItemMutation {
+	return &ItemMutation{itemMutation: &ic.itemMutation, op: ent.OpCreate}
+}
+
+// SetUpdateField sets the update_field field.
+func (ic *ItemCreate) SetUpdateField(s string) *ItemCreate {
+	ic.update_field = &s
+	return ic
+}
+
+// SetNillableUpdateField sets the update_field field if the given value is not nil.
+func (ic *ItemCreate) SetNillableUpdateField(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetUpdateField(*s)
+	}
+	return ic
+}
+
+// SetLabelField sets the label_field field.
+func (ic *ItemCreate) SetLabelField(s string) *ItemCreate {
+	ic.label_field = &s
+	return ic
+}
+
+// SetNillableLabelField sets the label_field field if the given value is not nil.
+func (ic *ItemCreate) SetNillableLabelField(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetLabelField(*s)
+	}
+	return ic
+}
+
+// SetType sets the type field.
+func (ic *ItemCreate) SetType(i item.Type) *ItemCreate {
+	ic._type = &i
+	return ic
+}
+
+// SetNillableType sets the type field if the given value is not nil.
+func (ic *ItemCreate) SetNillableType(i *item.Type) *ItemCreate {
+	if i != nil {
+		ic.SetType(*i)
+	}
+	return ic
+}
+
+// SetFunc sets the func field.
+func (ic *ItemCreate) SetFunc(s string) *ItemCreate {
+	ic._func = &s
+	return ic
+}
+
+// SetNillableFunc sets the func field if the given value is not nil.
+func (ic *ItemCreate) SetNillableFunc(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetFunc(*s)
+	}
+	return ic
 }
 
 // Save creates the Item in the database.
-func (ic *ItemCreate) Save(ctx context.Context) (*Item, error) {
+func (ic *ItemCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Item, error) {
+	options := ent.NewCallOptions(opts...)
+	if ic._type != nil {
+		if err := item.TypeValidator(*ic._type); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
+		}
+	}
+	if ic._func != nil {
+		if err := item.FuncValidator(*ic._func); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"func\": %v", err)
+		}
+	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if ic.shadow != nil {
+		return ic.dualSave(ctx, opts...)
+	}
+	v, err := ic.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		ic.bus.publish(&Event{Op: ent.OpCreate, Type: item.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save creates the Item in the storage of the client dialect.
+func (ic *ItemCreate) save(ctx context.Context) (*Item, error) {
 	switch ic.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ic.sqlSave(ctx)
@@ -37,8 +133,8 @@ func (ic *ItemCreate) Save(ctx context.Context) (*Item, error) {
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (ic *ItemCreate) SaveX(ctx context.Context) *Item {
-	v, err := ic.Save(ctx)
+func (ic *ItemCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Item {
+	v, err := ic.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -46,6 +142,7 @@ func (ic *ItemCreate) SaveX(ctx context.Context) *Item {
 }
 
 func (ic *ItemCreate) sqlSave(ctx context.Context) (*Item, error) {
+	ctx = entcache.Invalidates(ctx, item.Tables...)
 	var (
 		res sql.Result
 		i   = &Item{config: ic.config}
@@ -55,6 +152,29 @@ func (ic *ItemCreate) sqlSave(ctx context.Context) (*Item, error) {
 		return nil, err
 	}
 	builder := sql.Insert(item.Table).Default(ic.driver.Dialect())
+	if id := ic.id; id != nil {
+		id, err := strconv.Atoi(*id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(item.FieldID, id)
+	}
+	if value := ic.update_field; value != nil {
+		builder.Set(item.FieldUpdateField, *value)
+		i.UpdateField = *value
+	}
+	if value := ic.label_field; value != nil {
+		builder.Set(item.FieldLabelField, *value)
+		i.LabelField = *value
+	}
+	if value := ic._type; value != nil {
+		builder.Set(item.FieldType, *value)
+		i.Type = *value
+	}
+	if value := ic._func; value != nil {
+		builder.Set(item.FieldFunc, *value)
+		i.Func = *value
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -72,8 +192,9 @@ func (ic *ItemCreate) sqlSave(ctx context.Context) (*Item, error) {
 
 func (ic *ItemCreate) gremlinSave(ctx context.Context) (*Item, error) {
 	res := &gremlin.Response{}
-	query, bindings := ic.gremlin().Query()
-	if err := ic.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ic.gremlin()
+	query, _ := traversal.Query()
+	if err := ic.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -88,5 +209,17 @@ func (ic *ItemCreate) gremlinSave(ctx context.Context) (*Item, error) {
 
 func (ic *ItemCreate) gremlin() *dsl.Traversal {
 	v := g.AddV(item.Label)
+	if ic.update_field != nil {
+		v.Property(dsl.Single, item.FieldUpdateField, *ic.update_field)
+	}
+	if ic.label_field != nil {
+		v.Property(dsl.Single, item.FieldLabelField, *ic.label_field)
+	}
+	if ic._type != nil {
+		v.Property(dsl.Single, item.FieldType, *ic._type)
+	}
+	if ic._func != nil {
+		v.Property(dsl.Single, item.FieldFunc, *ic._func)
+	}
 	return v.ValueMap(true)
 }
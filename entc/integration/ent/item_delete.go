@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -18,12 +19,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/item"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // ItemDelete is the builder for deleting a Item entity.
 type ItemDelete struct {
 	config
 	predicates []predicate.Item
+	// id of the node, if it's deleted using a ItemDeleteOne builder.
+	id *string
 }
 
 // Where adds a new predicate to the delete builder.
@@ -33,7 +37,37 @@ func (id *ItemDelete) Where(ps ...predicate.Item) *ItemDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (id *ItemDelete) Exec(ctx context.Context) (int, error) {
+func (id *ItemDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	if id.shadow != nil {
+		return id.dualExec(ctx, opts...)
+	}
+	n, err := id.exec(ctx)
+	if err != nil || n == 0 || options.SkipHooks {
+		return n, err
+	}
+	e := &Event{Op: ent.OpDelete, Type: item.Label, N: n}
+	if id.id != nil {
+		e.Op, e.ID = ent.OpDeleteOne, *id.id
+	}
+	id.bus.publish(e)
+	return n, nil
+}
+
+// exec deletes the Item nodes from the storage of the client dialect.
+func (id *ItemDelete) exec(ctx context.Context) (int, error) {
+	predicates, err := interceptItem(ctx, id.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *id
+		intercepted.predicates = append(predicates, id.predicates...)
+		id = &intercepted
+	}
 	switch id.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return id.sqlExec(ctx)
@@ -45,8 +79,8 @@ func (id *ItemDelete) Exec(ctx context.Context) (int, error) {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (id *ItemDelete) ExecX(ctx context.Context) int {
-	n, err := id.Exec(ctx)
+func (id *ItemDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := id.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,11 +88,15 @@ func (id *ItemDelete) ExecX(ctx context.Context) int {
 }
 
 func (id *ItemDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = entcache.Invalidates(ctx, item.Tables...)
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(item.Table))
+	selector := sql.Select().From(sql.Table(item.Table)).SetDialect(id.driver.Dialect()).WithContext(ctx)
 	for _, p := range id.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(item.Table).FromSelect(selector).Query()
 	if err := id.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -72,8 +110,9 @@ func (id *ItemDelete) sqlExec(ctx context.Context) (int, error) {
 
 func (id *ItemDelete) gremlinExec(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := id.gremlin().Query()
-	if err := id.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := id.gremlin()
+	query, _ := traversal.Query()
+	if err := id.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -93,12 +132,12 @@ type ItemDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (ido *ItemDeleteOne) Exec(ctx context.Context) error {
-	n, err := ido.id.Exec(ctx)
+func (ido *ItemDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := ido.id.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{item.Label}
 	default:
 		return nil
@@ -106,6 +145,8 @@ func (ido *ItemDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ido *ItemDeleteOne) ExecX(ctx context.Context) {
-	ido.id.ExecX(ctx)
+func (ido *ItemDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := ido.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
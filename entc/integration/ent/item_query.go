@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -25,14 +26,24 @@ import (
 // ItemQuery is the builder for querying Item entities.
 type ItemQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit     *int
+	offset    *int
+	order     []Order
+	unique    []string
+	fields    []string
+	unordered bool
+	hints     []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.Item
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath     func(context.Context) (*sql.Selector, error)
+	gremlinPath func(context.Context) (*dsl.Traversal, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -59,6 +70,107 @@ func (iq *ItemQuery) Order(o ...Order) *ItemQuery {
 	return iq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored. Note that, hints are ignored by Gremlin queries.
+func (iq *ItemQuery) Hint(hints ...sql.Hint) *ItemQuery {
+	iq.hints = append(iq.hints, hints...)
+	return iq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	is, err := client.Item.Query().
+//		Fields(item.FieldUpdateField, item.FieldLabelField).
+//		All(ctx)
+//
+func (iq *ItemQuery) Fields(fields ...string) *ItemQuery {
+	iq.fields = append(iq.fields, fields...)
+	return iq
+}
+
+// Unordered disables the ordering by id that is added to the Gremlin queries that are paginated
+// using Limit or Offset. The order of the vertices
+// (and therefore, the content of the pages) is not guaranteed without it, but it can be removed when
+// the order is irrelevant (e.g. sampling).
+func (iq *ItemQuery) Unordered() *ItemQuery {
+	iq.unordered = true
+	return iq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (iq *ItemQuery) Scope(scopes ...item.Scope) *ItemQuery {
+	for _, s := range scopes {
+		iq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				iq.Order(Desc(o.Field))
+			} else {
+				iq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			iq.Limit(s.Limit)
+		}
+	}
+	return iq
+}
+
+// ItemInterceptor is a query interceptor of the ItemClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the ItemClient.Intercept method.
+type ItemInterceptor func(context.Context, *ItemQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (iq *ItemQuery) prepare(ctx context.Context) (*ItemQuery, error) {
+	var interceptors []ItemInterceptor
+	if iq.inters != nil {
+		iq.inters.mu.RLock()
+		interceptors = iq.inters.Item
+		iq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && iq.sqlPath == nil && iq.gremlinPath == nil {
+		return iq, nil
+	}
+	query := iq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	if path := query.gremlinPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.gremlin, query.gremlinPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptItem returns the predicates that the interceptors of the client add to a Item query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptItem(ctx context.Context, cfg config) ([]predicate.Item, error) {
+	query, err := (&ItemQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // First returns the first Item entity in the query. Returns *ErrNotFound when no item was found.
 func (iq *ItemQuery) First(ctx context.Context) (*Item, error) {
 	is, err := iq.Limit(1).All(ctx)
@@ -104,7 +216,7 @@ func (iq *ItemQuery) FirstXID(ctx context.Context) string {
 
 // Only returns the only Item entity in the query, returns an error if not exactly one entity was returned.
 func (iq *ItemQuery) Only(ctx context.Context) (*Item, error) {
-	is, err := iq.Limit(2).All(ctx)
+	is, err := iq.Limit(2).Unordered().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +242,7 @@ func (iq *ItemQuery) OnlyX(ctx context.Context) *Item {
 // OnlyID returns the only Item id in the query, returns an error if not exactly one id was returned.
 func (iq *ItemQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = iq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = iq.Limit(2).Unordered().IDs(ctx); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -154,33 +266,63 @@ func (iq *ItemQuery) OnlyXID(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of Items.
-func (iq *ItemQuery) All(ctx context.Context) ([]*Item, error) {
-	switch iq.driver.Dialect() {
+func (iq *ItemQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*Item, error) {
+	query, err := iq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.shadow != nil && !ent.NewCallOptions(opts...).SkipHooks {
+		return query.dualAll(ctx)
+	}
+
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return iq.sqlAll(ctx)
+		return query.sqlAll(ctx)
 	case dialect.Gremlin:
-		return iq.gremlinAll(ctx)
+		return query.gremlinAll(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
 // AllX is like All, but panics if an error occurs.
-func (iq *ItemQuery) AllX(ctx context.Context) []*Item {
-	is, err := iq.All(ctx)
+func (iq *ItemQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*Item {
+	is, err := iq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return is
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (iq *ItemQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := iq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlQueryString(ctx)
+	case dialect.Gremlin:
+		return query.gremlinQueryString(ctx)
+	default:
+		return "", nil, errors.New("ent: unsupported dialect")
+	}
+}
+
 // IDs executes the query and returns a list of Item ids.
 func (iq *ItemQuery) IDs(ctx context.Context) ([]string, error) {
-	switch iq.driver.Dialect() {
+	query, err := iq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return iq.sqlIDs(ctx)
+		return query.sqlIDs(ctx)
 	case dialect.Gremlin:
-		return iq.gremlinIDs(ctx)
+		return query.gremlinIDs(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
@@ -195,13 +337,46 @@ func (iq *ItemQuery) IDsX(ctx context.Context) []string {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Items that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (iq *ItemQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if iq.sql != nil || iq.gremlin != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &ItemQuery{
+		config:     iq.config,
+		predicates: append([]predicate.Item{}, iq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(string)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for Item", after)
+		}
+		query.Where(item.IDGT(id))
+	}
+	ids, err := query.Order(Asc(item.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (iq *ItemQuery) Count(ctx context.Context) (int, error) {
-	switch iq.driver.Dialect() {
+	query, err := iq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return iq.sqlCount(ctx)
+		return query.sqlCount(ctx)
 	case dialect.Gremlin:
-		return iq.gremlinCount(ctx)
+		return query.gremlinCount(ctx)
 	default:
 		return 0, errors.New("ent: unsupported dialect")
 	}
@@ -218,11 +393,15 @@ func (iq *ItemQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (iq *ItemQuery) Exist(ctx context.Context) (bool, error) {
-	switch iq.driver.Dialect() {
+	query, err := iq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return iq.sqlExist(ctx)
+		return query.sqlExist(ctx)
 	case dialect.Gremlin:
-		return iq.gremlinExist(ctx)
+		return query.gremlinExist(ctx)
 	default:
 		return false, errors.New("ent: unsupported dialect")
 	}
@@ -246,45 +425,89 @@ func (iq *ItemQuery) Clone() *ItemQuery {
 		offset:     iq.offset,
 		order:      append([]Order{}, iq.order...),
 		unique:     append([]string{}, iq.unique...),
+		fields:     append([]string{}, iq.fields...),
+		unordered:  iq.unordered,
+		hints:      append([]sql.Hint{}, iq.hints...),
+		edgeCount:  iq.edgeCount,
 		predicates: append([]predicate.Item{}, iq.predicates...),
 		// clone intermediate queries.
-		sql:     iq.sql.Clone(),
-		gremlin: iq.gremlin.Clone(),
+		sql:         iq.sql.Clone(),
+		sqlPath:     iq.sqlPath,
+		gremlin:     iq.gremlin.Clone(),
+		gremlinPath: iq.gremlinPath,
 	}
 }
 
 // GroupBy used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdateField string `json:"update,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Item.Query().
+//		GroupBy(item.FieldUpdateField).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
 func (iq *ItemQuery) GroupBy(field string, fields ...string) *ItemGroupBy {
 	group := &ItemGroupBy{config: iq.config}
 	group.fields = append([]string{field}, fields...)
-	switch iq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		group.sql = iq.sqlQuery()
-	case dialect.Gremlin:
-		group.gremlin = iq.gremlinQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := iq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			group.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			group.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return group
 }
 
 // Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdateField string `json:"update,omitempty"`
+//	}
+//
+//	client.Item.Query().
+//		Select(item.FieldUpdateField).
+//		Scan(ctx, &v)
+//
 func (iq *ItemQuery) Select(field string, fields ...string) *ItemSelect {
 	selector := &ItemSelect{config: iq.config}
 	selector.fields = append([]string{field}, fields...)
-	switch iq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		selector.sql = iq.sqlQuery()
-	case dialect.Gremlin:
-		selector.gremlin = iq.gremlinQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := iq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			selector.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			selector.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return selector
 }
 
 func (iq *ItemQuery) sqlAll(ctx context.Context) ([]*Item, error) {
 	rows := &sql.Rows{}
-	selector := iq.sqlQuery()
-	if unique := iq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := iq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := iq.driver.Query(ctx, query, args, rows); err != nil {
@@ -292,21 +515,61 @@ func (iq *ItemQuery) sqlAll(ctx context.Context) ([]*Item, error) {
 	}
 	defer rows.Close()
 	var is Items
-	if err := is.FromRows(rows); err != nil {
+	if err := is.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	is.config(iq.config)
 	return is, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (iq *ItemQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := iq.sqlQuery(ctx)
+	if unique := iq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := item.Columns
+	if fields := iq.fields; len(fields) > 0 {
+		columns = append([]string{item.FieldID}, fields...)
+		for _, c := range fields {
+			if !item.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (iq *ItemQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := iq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
 func (iq *ItemQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := iq.sqlQuery()
+	selector := iq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{item.FieldID}
 	if len(iq.unique) > 0 {
 		unique = iq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := iq.edgeCount; c != nil && len(iq.predicates) == 0 && len(iq.unique) == 0 &&
+		len(iq.hints) == 0 && iq.limit == nil && iq.offset == nil {
+		selector = c.Clone().SetDialect(iq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := iq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -323,11 +586,22 @@ func (iq *ItemQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (iq *ItemQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := iq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := iq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(item.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := iq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := iq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (iq *ItemQuery) sqlIDs(ctx context.Context) ([]string, error) {
@@ -342,13 +616,33 @@ func (iq *ItemQuery) sqlIDs(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
-func (iq *ItemQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (iq *ItemQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := iq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(item.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (iq *ItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(item.Table)
 	selector := sql.Select(t1.Columns(item.Columns...)...).From(t1)
 	if iq.sql != nil {
 		selector = iq.sql
 		selector.Select(selector.Columns(item.Columns...)...)
 	}
+	selector.SetDialect(iq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(iq.hints...)
 	for _, p := range iq.predicates {
 		p(selector)
 	}
@@ -368,8 +662,9 @@ func (iq *ItemQuery) sqlQuery() *sql.Selector {
 
 func (iq *ItemQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := iq.gremlinQuery().Query()
-	if err := iq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := iq.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := iq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	vertices, err := res.ReadVertices()
@@ -385,8 +680,9 @@ func (iq *ItemQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 
 func (iq *ItemQuery) gremlinAll(ctx context.Context) ([]*Item, error) {
 	res := &gremlin.Response{}
-	query, bindings := iq.gremlinQuery().ValueMap(true).Query()
-	if err := iq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := iq.gremlinAllQuery()
+	query, _ := traversal.Query()
+	if err := iq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	var is Items
@@ -397,10 +693,25 @@ func (iq *ItemQuery) gremlinAll(ctx context.Context) ([]*Item, error) {
 	return is, nil
 }
 
+// gremlinAllQuery returns the traversal of All, that returns the value maps of the vertices.
+func (iq *ItemQuery) gremlinAllQuery() *dsl.Traversal {
+	args := []interface{}{true}
+	for _, f := range iq.fields {
+		args = append(args, f)
+	}
+	return iq.gremlinQuery().ValueMap(args...)
+}
+
+func (iq *ItemQuery) gremlinQueryString(context.Context) (string, interface{}, error) {
+	query, bindings := iq.gremlinAllQuery().Query()
+	return query, bindings, nil
+}
+
 func (iq *ItemQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := iq.gremlinQuery().Count().Query()
-	if err := iq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := iq.gremlinTraversal(false).Count()
+	query, _ := traversal.Query()
+	if err := iq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -408,14 +719,22 @@ func (iq *ItemQuery) gremlinCount(ctx context.Context) (int, error) {
 
 func (iq *ItemQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
-	query, bindings := iq.gremlinQuery().HasNext().Query()
-	if err := iq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := iq.gremlinTraversal(false).HasNext()
+	query, _ := traversal.Query()
+	if err := iq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
 }
 
+// gremlinQuery returns the traversal of the query for listing its vertices, with its default orders.
 func (iq *ItemQuery) gremlinQuery() *dsl.Traversal {
+	return iq.gremlinTraversal(true)
+}
+
+// gremlinTraversal returns the traversal of the query. The default orders are added only to the traversals
+// of listing queries (list), since they don't change the results of the others (e.g. Count and Exist).
+func (iq *ItemQuery) gremlinTraversal(list bool) *dsl.Traversal {
 	v := g.V().HasLabel(item.Label)
 	if iq.gremlin != nil {
 		v = iq.gremlin.Clone()
@@ -423,13 +742,22 @@ func (iq *ItemQuery) gremlinQuery() *dsl.Traversal {
 	for _, p := range iq.predicates {
 		p(v)
 	}
-	if len(iq.order) > 0 {
+	limit, offset := iq.limit, iq.offset
+	list = list && !iq.unordered
+	// paginated queries are ordered by id (also as a tie-breaker of the
+	// query orders), in order to keep the pages stable between calls.
+	stable := (limit != nil || offset != nil) && list
+	order := iq.order
+	if len(order) > 0 || stable {
 		v.Order()
-		for _, p := range iq.order {
+		for _, p := range order {
 			p(v)
 		}
+		if stable {
+			v.By(dsl.ID, dsl.Incr)
+		}
 	}
-	switch limit, offset := iq.limit, iq.offset; {
+	switch {
 	case limit != nil && offset != nil:
 		v.Range(*offset, *offset+*limit)
 	case offset != nil:
@@ -451,6 +779,11 @@ type ItemGroupBy struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving     *sql.Predicate
+	gremlinHaving *dsl.Traversal
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -459,8 +792,27 @@ func (igb *ItemGroupBy) Aggregate(fns ...Aggregate) *ItemGroupBy {
 	return igb
 }
 
+// Having adds per-dialect predicates on the aggregated values of the groups.
+// In Gremlin, the predicate is applied using a `where` step on the values of each group.
+// For example:
+//
+//	client.Item.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5), __.Select(ent.DefaultCountLabel).Is(p.GT(5))).
+//		Scan(ctx, &v)
+//
+func (igb *ItemGroupBy) Having(p0 *sql.Predicate, p1 *dsl.Traversal) *ItemGroupBy {
+	igb.sqlHaving = p0
+	igb.gremlinHaving = p1
+	return igb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (igb *ItemGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := igb.path(ctx); err != nil {
+		return err
+	}
 	switch igb.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return igb.sqlScan(ctx, v)
@@ -563,6 +915,9 @@ func (igb *ItemGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (igb *ItemGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := igb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := igb.sqlQuery().Query()
 	if err := igb.driver.Query(ctx, query, args, rows); err != nil {
@@ -579,13 +934,18 @@ func (igb *ItemGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range igb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(igb.fields...)
+	selector.Select(columns...).GroupBy(igb.fields...)
+	if igb.sqlHaving != nil {
+		selector.Having(igb.sqlHaving)
+	}
+	return selector
 }
 
 func (igb *ItemGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
-	query, bindings := igb.gremlinQuery().Query()
-	if err := igb.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := igb.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := igb.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(igb.fields)+len(igb.fns) == 1 {
@@ -612,11 +972,14 @@ func (igb *ItemGroupBy) gremlinQuery() *dsl.Traversal {
 		names = append(names, f)
 		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
 	}
-	return igb.gremlin.Group().
+	v := igb.gremlin.Group().
 		By(__.Values(igb.fields...).Fold()).
 		By(__.Fold().Match(trs...).Select(names...)).
-		Select(dsl.Values).
-		Next()
+		Select(dsl.Values)
+	if igb.gremlinHaving != nil {
+		v = v.Unfold().Where(igb.gremlinHaving).Fold()
+	}
+	return v.Next()
 }
 
 // ItemSelect is the builder for select fields of Item entities.
@@ -626,10 +989,15 @@ type ItemSelect struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (is *ItemSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := is.path(ctx); err != nil {
+		return err
+	}
 	switch is.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return is.sqlScan(ctx, v)
@@ -732,6 +1100,9 @@ func (is *ItemSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (is *ItemSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := is.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := is.sqlQuery().Query()
 	if err := is.driver.Query(ctx, query, args, rows); err != nil {
@@ -760,8 +1131,8 @@ func (is *ItemSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		}
 		traversal = is.gremlin.ValueMap(fields...)
 	}
-	query, bindings := traversal.Query()
-	if err := is.driver.Exec(ctx, query, bindings, res); err != nil {
+	query, _ := traversal.Query()
+	if err := is.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(is.fields) == 1 {
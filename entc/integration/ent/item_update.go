@@ -10,20 +10,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/g"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/item"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // ItemUpdate is the builder for updating Item entities.
 type ItemUpdate struct {
 	config
+	itemMutation
 	predicates []predicate.Item
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Item
 }
 
 // Where adds a new predicate for the builder.
@@ -32,21 +40,168 @@ func (iu *ItemUpdate) Where(ps ...predicate.Item) *ItemUpdate {
 	return iu
 }
 
+// Mutation returns the mutation of the builder.
+func (iu *ItemUpdate) Mutation() *ItemMutation {
+	return &ItemMutation{itemMutation: &iu.itemMutation, op: ent.OpUpdate}
+}
+
+// SetUpdateField sets the update_field field.
+func (iu *ItemUpdate) SetUpdateField(s string) *ItemUpdate {
+	iu.update_field = &s
+	return iu
+}
+
+// SetNillableUpdateField sets the update_field field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableUpdateField(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetUpdateField(*s)
+	}
+	return iu
+}
+
+// ClearUpdateField clears the value of update_field.
+func (iu *ItemUpdate) ClearUpdateField() *ItemUpdate {
+	iu.update_field = nil
+	iu.clearupdate_field = true
+	return iu
+}
+
+// SetLabelField sets the label_field field.
+func (iu *ItemUpdate) SetLabelField(s string) *ItemUpdate {
+	iu.label_field = &s
+	return iu
+}
+
+// SetNillableLabelField sets the label_field field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableLabelField(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetLabelField(*s)
+	}
+	return iu
+}
+
+// ClearLabelField clears the value of label_field.
+func (iu *ItemUpdate) ClearLabelField() *ItemUpdate {
+	iu.label_field = nil
+	iu.clearlabel_field = true
+	return iu
+}
+
+// SetType sets the type field.
+func (iu *ItemUpdate) SetType(i item.Type) *ItemUpdate {
+	iu._type = &i
+	return iu
+}
+
+// SetNillableType sets the type field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableType(i *item.Type) *ItemUpdate {
+	if i != nil {
+		iu.SetType(*i)
+	}
+	return iu
+}
+
+// ClearType clears the value of type.
+func (iu *ItemUpdate) ClearType() *ItemUpdate {
+	iu._type = nil
+	iu.clear_type = true
+	return iu
+}
+
+// SetFunc sets the func field.
+func (iu *ItemUpdate) SetFunc(s string) *ItemUpdate {
+	iu._func = &s
+	return iu
+}
+
+// SetNillableFunc sets the func field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableFunc(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetFunc(*s)
+	}
+	return iu
+}
+
+// ClearFunc clears the value of func.
+func (iu *ItemUpdate) ClearFunc() *ItemUpdate {
+	iu._func = nil
+	iu.clear_func = true
+	return iu
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (iu *ItemUpdate) Save(ctx context.Context) (int, error) {
+func (iu *ItemUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := iu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (iu *ItemUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]string, error) {
+	options := ent.NewCallOptions(opts...)
+	if iu._type != nil {
+		if err := item.TypeValidator(*iu._type); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
+		}
+	}
+	if iu._func != nil {
+		if err := item.FuncValidator(*iu._func); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"func\": %v", err)
+		}
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if iu.shadow != nil {
+		return iu.dualSave(ctx, opts...)
+	}
+	ids, err := iu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 && !options.SkipHooks {
+		iu.bus.publish(&Event{Op: ent.OpUpdate, Type: item.Label, N: len(ids)})
+	}
+	return ids, nil
+}
+
+// save updates the Item nodes in the storage of the client dialect.
+func (iu *ItemUpdate) save(ctx context.Context) ([]string, error) {
+	predicates, err := interceptItem(ctx, iu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *iu
+		intercepted.predicates = append(predicates, iu.predicates...)
+		iu = &intercepted
+	}
 	switch iu.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return iu.sqlSave(ctx)
 	case dialect.Gremlin:
 		return iu.gremlinSave(ctx)
 	default:
-		return 0, errors.New("ent: unsupported dialect")
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (iu *ItemUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Item, error) {
+	var is []*Item
+	iu.returning = &is
+	defer func() { iu.returning = nil }()
+	if _, err := iu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
 	}
+	return is, nil
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (iu *ItemUpdate) SaveX(ctx context.Context) int {
-	affected, err := iu.Save(ctx)
+func (iu *ItemUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := iu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,61 +209,145 @@ func (iu *ItemUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (iu *ItemUpdate) Exec(ctx context.Context) error {
-	_, err := iu.Save(ctx)
+func (iu *ItemUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := iu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (iu *ItemUpdate) ExecX(ctx context.Context) {
-	if err := iu.Exec(ctx); err != nil {
+func (iu *ItemUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := iu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(item.FieldID).From(sql.Table(item.Table))
+func (iu *ItemUpdate) sqlSave(ctx context.Context) (_ []string, err error) {
+	ctx = entcache.Invalidates(ctx, item.Tables...)
+	selector := sql.Select(item.FieldID).From(sql.Table(item.Table)).SetDialect(iu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range iu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = iu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := iu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+	var (
+		res     sql.Result
+		builder = sql.Update(item.Table).Where(sql.InInts(item.FieldID, ids...))
+	)
+	if value := iu.update_field; value != nil {
+		builder.Set(item.FieldUpdateField, *value)
+	}
+	if iu.clearupdate_field {
+		builder.SetNull(item.FieldUpdateField)
+	}
+	if value := iu.label_field; value != nil {
+		builder.Set(item.FieldLabelField, *value)
+	}
+	if iu.clearlabel_field {
+		builder.SetNull(item.FieldLabelField)
+	}
+	if value := iu._type; value != nil {
+		builder.Set(item.FieldType, *value)
+	}
+	if iu.clear_type {
+		builder.SetNull(item.FieldType)
+	}
+	if value := iu._func; value != nil {
+		builder.Set(item.FieldFunc, *value)
+	}
+	if iu.clear_func {
+		builder.SetNull(item.FieldFunc)
+	}
+	if !builder.Empty() {
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if iu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(item.Columns...).
+			From(sql.Table(item.Table)).
+			Where(sql.InInts(item.FieldID, ids...)).
+			SetDialect(iu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var is Items
+		err := is.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Item: %v", err))
+		}
+		is.config(iu.config)
+		*iu.returning = is
 	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	vs := make([]string, len(ids))
+	for i, id := range ids {
+		vs[i] = strconv.Itoa(id)
+	}
+	return vs, nil
 }
 
-func (iu *ItemUpdate) gremlinSave(ctx context.Context) (int, error) {
+func (iu *ItemUpdate) gremlinSave(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := iu.gremlin().Query()
-	if err := iu.driver.Exec(ctx, query, bindings, res); err != nil {
-		return 0, err
+	traversal := iu.gremlin()
+	query, _ := traversal.Query()
+	if err := iu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
-		return 0, err
+		return nil, err
 	}
-	return res.ReadInt()
+	if iu.returning != nil {
+		var is Items
+		if err := is.FromResponse(res); err != nil {
+			return nil, err
+		}
+		is.config(iu.config)
+		*iu.returning = is
+		ids := make([]string, 0, len(is))
+		for _, i := range is {
+			ids = append(ids, i.ID)
+		}
+		return ids, nil
+	}
+	vertices, err := res.ReadVertices()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vertices))
+	for _, vertex := range vertices {
+		ids = append(ids, vertex.ID.(string))
+	}
+	return ids, nil
 }
 
 func (iu *ItemUpdate) gremlin() *dsl.Traversal {
@@ -119,7 +358,37 @@ func (iu *ItemUpdate) gremlin() *dsl.Traversal {
 	var (
 		trs []*dsl.Traversal
 	)
-	v.Count()
+	if value := iu.update_field; value != nil {
+		v.Property(dsl.Single, item.FieldUpdateField, *value)
+	}
+	if value := iu.label_field; value != nil {
+		v.Property(dsl.Single, item.FieldLabelField, *value)
+	}
+	if value := iu._type; value != nil {
+		v.Property(dsl.Single, item.FieldType, *value)
+	}
+	if value := iu._func; value != nil {
+		v.Property(dsl.Single, item.FieldFunc, *value)
+	}
+	var properties []interface{}
+	if iu.clearupdate_field {
+		properties = append(properties, item.FieldUpdateField)
+	}
+	if iu.clearlabel_field {
+		properties = append(properties, item.FieldLabelField)
+	}
+	if iu.clear_type {
+		properties = append(properties, item.FieldType)
+	}
+	if iu.clear_func {
+		properties = append(properties, item.FieldFunc)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
+	if iu.returning != nil {
+		v.ValueMap(true)
+	}
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
@@ -127,11 +396,166 @@ func (iu *ItemUpdate) gremlin() *dsl.Traversal {
 // ItemUpdateOne is the builder for updating a single Item entity.
 type ItemUpdateOne struct {
 	config
-	id string
+	id     string
+	entity *Item
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Item
+	itemMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (iuo *ItemUpdateOne) Mutation() *ItemMutation {
+	return &ItemMutation{itemMutation: &iuo.itemMutation, op: ent.OpUpdateOne, id: &iuo.id}
+}
+
+// SetUpdateField sets the update_field field.
+func (iuo *ItemUpdateOne) SetUpdateField(s string) *ItemUpdateOne {
+	iuo.update_field = &s
+	return iuo
+}
+
+// SetNillableUpdateField sets the update_field field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableUpdateField(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetUpdateField(*s)
+	}
+	return iuo
+}
+
+// ClearUpdateField clears the value of update_field.
+func (iuo *ItemUpdateOne) ClearUpdateField() *ItemUpdateOne {
+	iuo.update_field = nil
+	iuo.clearupdate_field = true
+	return iuo
+}
+
+// SetLabelField sets the label_field field.
+func (iuo *ItemUpdateOne) SetLabelField(s string) *ItemUpdateOne {
+	iuo.label_field = &s
+	return iuo
+}
+
+// SetNillableLabelField sets the label_field field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableLabelField(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetLabelField(*s)
+	}
+	return iuo
+}
+
+// ClearLabelField clears the value of label_field.
+func (iuo *ItemUpdateOne) ClearLabelField() *ItemUpdateOne {
+	iuo.label_field = nil
+	iuo.clearlabel_field = true
+	return iuo
+}
+
+// SetType sets the type field.
+func (iuo *ItemUpdateOne) SetType(i item.Type) *ItemUpdateOne {
+	iuo._type = &i
+	return iuo
+}
+
+// SetNillableType sets the type field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableType(i *item.Type) *ItemUpdateOne {
+	if i != nil {
+		iuo.SetType(*i)
+	}
+	return iuo
+}
+
+// ClearType clears the value of type.
+func (iuo *ItemUpdateOne) ClearType() *ItemUpdateOne {
+	iuo._type = nil
+	iuo.clear_type = true
+	return iuo
+}
+
+// SetFunc sets the func field.
+func (iuo *ItemUpdateOne) SetFunc(s string) *ItemUpdateOne {
+	iuo._func = &s
+	return iuo
+}
+
+// SetNillableFunc sets the func field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableFunc(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetFunc(*s)
+	}
+	return iuo
+}
+
+// ClearFunc clears the value of func.
+func (iuo *ItemUpdateOne) ClearFunc() *ItemUpdateOne {
+	iuo._func = nil
+	iuo.clear_func = true
+	return iuo
+}
+
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (iuo *ItemUpdateOne) SetChangedFrom(original *Item) *ItemUpdateOne {
+	i := iuo.entity
+	if i == nil || original == nil {
+		return iuo
+	}
+	for _, change := range DiffItem(original, i) {
+		switch change.Field {
+		case item.FieldUpdateField:
+			iuo.SetUpdateField(i.UpdateField)
+		case item.FieldLabelField:
+			iuo.SetLabelField(i.LabelField)
+		case item.FieldType:
+			iuo.SetType(i.Type)
+		case item.FieldFunc:
+			iuo.SetFunc(i.Func)
+		}
+	}
+	return iuo
 }
 
 // Save executes the query and returns the updated entity.
-func (iuo *ItemUpdateOne) Save(ctx context.Context) (*Item, error) {
+func (iuo *ItemUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Item, error) {
+	options := ent.NewCallOptions(opts...)
+	if iuo._type != nil {
+		if err := item.TypeValidator(*iuo._type); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
+		}
+	}
+	if iuo._func != nil {
+		if err := item.FuncValidator(*iuo._func); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"func\": %v", err)
+		}
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if iuo.shadow != nil {
+		return iuo.dualSave(ctx, opts...)
+	}
+	i, err := iuo.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		iuo.bus.publish(&Event{Op: ent.OpUpdateOne, Type: item.Label, ID: i.ID, Node: i, N: 1})
+	}
+	return i, nil
+}
+
+// save updates the Item in the storage of the client dialect.
+func (iuo *ItemUpdateOne) save(ctx context.Context) (*Item, error) {
+	predicates, err := interceptItem(ctx, iuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *iuo
+		intercepted.predicates = append(predicates, iuo.predicates...)
+		iuo = &intercepted
+	}
 	switch iuo.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return iuo.sqlSave(ctx)
@@ -143,8 +567,8 @@ func (iuo *ItemUpdateOne) Save(ctx context.Context) (*Item, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (iuo *ItemUpdateOne) SaveX(ctx context.Context) *Item {
-	i, err := iuo.Save(ctx)
+func (iuo *ItemUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Item {
+	i, err := iuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -152,21 +576,29 @@ func (iuo *ItemUpdateOne) SaveX(ctx context.Context) *Item {
 }
 
 // Exec executes the query on the entity.
-func (iuo *ItemUpdateOne) Exec(ctx context.Context) error {
-	_, err := iuo.Save(ctx)
+func (iuo *ItemUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := iuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (iuo *ItemUpdateOne) ExecX(ctx context.Context) {
-	if err := iuo.Exec(ctx); err != nil {
+func (iuo *ItemUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := iuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (i *Item, err error) {
-	selector := sql.Select(item.Columns...).From(sql.Table(item.Table))
+	ctx = entcache.Invalidates(ctx, item.Tables...)
+	selector := sql.Select(item.Columns...).From(sql.Table(item.Table)).SetDialect(iuo.driver.Dialect())
 	item.ID(iuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range iuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = iuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -194,6 +626,52 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (i *Item, err error) {
 	if err != nil {
 		return nil, err
 	}
+	var (
+		res     sql.Result
+		builder = sql.Update(item.Table).Where(sql.InInts(item.FieldID, ids...))
+	)
+	if value := iuo.update_field; value != nil {
+		builder.Set(item.FieldUpdateField, *value)
+		i.UpdateField = *value
+	}
+	if iuo.clearupdate_field {
+		var value string
+		i.UpdateField = value
+		builder.SetNull(item.FieldUpdateField)
+	}
+	if value := iuo.label_field; value != nil {
+		builder.Set(item.FieldLabelField, *value)
+		i.LabelField = *value
+	}
+	if iuo.clearlabel_field {
+		var value string
+		i.LabelField = value
+		builder.SetNull(item.FieldLabelField)
+	}
+	if value := iuo._type; value != nil {
+		builder.Set(item.FieldType, *value)
+		i.Type = *value
+	}
+	if iuo.clear_type {
+		var value item.Type
+		i.Type = value
+		builder.SetNull(item.FieldType)
+	}
+	if value := iuo._func; value != nil {
+		builder.Set(item.FieldFunc, *value)
+		i.Func = *value
+	}
+	if iuo.clear_func {
+		var value string
+		i.Func = value
+		builder.SetNull(item.FieldFunc)
+	}
+	if !builder.Empty() {
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
 	if err = tx.Commit(); err != nil {
 		return nil, err
 	}
@@ -202,8 +680,9 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (i *Item, err error) {
 
 func (iuo *ItemUpdateOne) gremlinSave(ctx context.Context) (*Item, error) {
 	res := &gremlin.Response{}
-	query, bindings := iuo.gremlin(iuo.id).Query()
-	if err := iuo.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := iuo.gremlin(iuo.id)
+	query, _ := traversal.Query()
+	if err := iuo.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -218,9 +697,40 @@ func (iuo *ItemUpdateOne) gremlinSave(ctx context.Context) (*Item, error) {
 
 func (iuo *ItemUpdateOne) gremlin(id string) *dsl.Traversal {
 	v := g.V(id)
+	for _, p := range iuo.predicates {
+		p(v)
+	}
 	var (
 		trs []*dsl.Traversal
 	)
+	if value := iuo.update_field; value != nil {
+		v.Property(dsl.Single, item.FieldUpdateField, *value)
+	}
+	if value := iuo.label_field; value != nil {
+		v.Property(dsl.Single, item.FieldLabelField, *value)
+	}
+	if value := iuo._type; value != nil {
+		v.Property(dsl.Single, item.FieldType, *value)
+	}
+	if value := iuo._func; value != nil {
+		v.Property(dsl.Single, item.FieldFunc, *value)
+	}
+	var properties []interface{}
+	if iuo.clearupdate_field {
+		properties = append(properties, item.FieldUpdateField)
+	}
+	if iuo.clearlabel_field {
+		properties = append(properties, item.FieldLabelField)
+	}
+	if iuo.clear_type {
+		properties = append(properties, item.FieldType)
+	}
+	if iuo.clear_func {
+		properties = append(properties, item.FieldFunc)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
 	v.ValueMap(true)
 	trs = append(trs, v)
 	return dsl.Join(trs...)
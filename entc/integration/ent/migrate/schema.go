@@ -7,6 +7,7 @@
 package migrate
 
 import (
+	"github.com/facebookincubator/ent/entc/integration/ent/fieldtype"
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
 	"github.com/facebookincubator/ent/entc/integration/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/ent/groupinfo"
@@ -20,10 +21,10 @@ var (
 	// CardsColumns holds the columns for the "cards" table.
 	CardsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "created_at", Type: field.TypeTime},
-		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "number", Type: field.TypeString},
 		{Name: "owner_id", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
 	}
 	// CardsTable holds the schema information for the "cards" table.
 	CardsTable = &schema.Table{
@@ -33,7 +34,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:  "cards_users_card",
-				Columns: []*schema.Column{CardsColumns[4]},
+				Columns: []*schema.Column{CardsColumns[2]},
 
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
@@ -74,6 +75,15 @@ var (
 		{Name: "nillable_int64", Type: field.TypeInt64, Nullable: true},
 		{Name: "validate_optional_int32", Type: field.TypeInt32, Nullable: true},
 		{Name: "state", Type: field.TypeEnum, Nullable: true, Enums: []string{"on", "off"}},
+		{Name: "link", Type: field.TypeString, Nullable: true},
+		{Name: "null_link", Type: field.TypeString, Nullable: true},
+		{Name: "priority", Type: field.TypeInt, Nullable: true},
+		{Name: "role", Type: field.TypeInt, Nullable: true},
+		{Name: "nullable_int", Type: field.TypeInt, Nullable: true},
+		{Name: "nullable_string", Type: field.TypeString, Nullable: true, Default: fieldtype.DefaultNullableString},
+		{Name: "uuid", Type: field.TypeUUID, Nullable: true},
+		{Name: "ip", Type: field.TypeIP, Nullable: true},
+		{Name: "mac", Type: field.TypeMAC, Nullable: true},
 	}
 	// FieldTypesTable holds the schema information for the "field_types" table.
 	FieldTypesTable = &schema.Table{
@@ -89,7 +99,7 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "user", Type: field.TypeString, Nullable: true},
 		{Name: "group", Type: field.TypeString, Nullable: true},
-		{Name: "type_id", Type: field.TypeInt, Nullable: true},
+		{Name: "file_type_id", Type: field.TypeInt, Nullable: true},
 		{Name: "group_file_id", Type: field.TypeInt, Nullable: true},
 		{Name: "owner_id", Type: field.TypeInt, Nullable: true},
 	}
@@ -133,10 +143,19 @@ var (
 				Columns: []*schema.Column{FilesColumns[2], FilesColumns[3]},
 			},
 			{
-				Name:    "name_owner_id_type_id",
+				Name:    "name_owner_id_file_type_id",
 				Unique:  true,
 				Columns: []*schema.Column{FilesColumns[2], FilesColumns[7], FilesColumns[5]},
 			},
+			{
+				Name:    "group_name",
+				Unique:  false,
+				Type:    "BTREE",
+				Columns: []*schema.Column{FilesColumns[4], FilesColumns[2]},
+				Prefixes: map[string]int{
+					"name": 10,
+				},
+			},
 		},
 	}
 	// FileTypesColumns holds the columns for the "file_types" table.
@@ -159,7 +178,7 @@ var (
 		{Name: "type", Type: field.TypeString, Nullable: true},
 		{Name: "max_users", Type: field.TypeInt, Nullable: true, Default: group.DefaultMaxUsers},
 		{Name: "name", Type: field.TypeString},
-		{Name: "info_id", Type: field.TypeInt, Nullable: true},
+		{Name: "info_id", Type: field.TypeInt},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
 	GroupsTable = &schema.Table{
@@ -172,7 +191,6 @@ var (
 				Columns: []*schema.Column{GroupsColumns[6]},
 
 				RefColumns: []*schema.Column{GroupInfosColumns[0]},
-				OnDelete:   schema.SetNull,
 			},
 		},
 	}
@@ -192,6 +210,10 @@ var (
 	// ItemsColumns holds the columns for the "items" table.
 	ItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "update", Type: field.TypeString, Nullable: true},
+		{Name: "label", Type: field.TypeString, Nullable: true},
+		{Name: "type", Type: field.TypeEnum, Nullable: true, Enums: []string{"a", "b"}},
+		{Name: "func", Type: field.TypeString, Nullable: true, Size: 10},
 	}
 	// ItemsTable holds the schema information for the "items" table.
 	ItemsTable = &schema.Table{
@@ -258,6 +280,7 @@ var (
 		{Name: "last", Type: field.TypeString, Default: user.DefaultLast},
 		{Name: "nickname", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "phone", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "password", Type: field.TypeString, Nullable: true},
 		{Name: "group_blocked_id", Type: field.TypeInt, Nullable: true},
 		{Name: "user_spouse_id", Type: field.TypeInt, Unique: true, Nullable: true},
 		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
@@ -270,53 +293,60 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:  "users_groups_blocked",
-				Columns: []*schema.Column{UsersColumns[6]},
+				Columns: []*schema.Column{UsersColumns[7]},
 
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:  "users_users_spouse",
-				Columns: []*schema.Column{UsersColumns[7]},
+				Columns: []*schema.Column{UsersColumns[8]},
 
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:  "users_users_parent",
-				Columns: []*schema.Column{UsersColumns[8]},
+				Columns: []*schema.Column{UsersColumns[9]},
 
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
-	// UserGroupsColumns holds the columns for the "user_groups" table.
-	UserGroupsColumns = []*schema.Column{
-		{Name: "user_id", Type: field.TypeInt},
+	// GroupMembersColumns holds the columns for the "group_members" table.
+	GroupMembersColumns = []*schema.Column{
+		{Name: "member_id", Type: field.TypeInt},
 		{Name: "group_id", Type: field.TypeInt},
 	}
-	// UserGroupsTable holds the schema information for the "user_groups" table.
-	UserGroupsTable = &schema.Table{
-		Name:       "user_groups",
-		Columns:    UserGroupsColumns,
-		PrimaryKey: []*schema.Column{UserGroupsColumns[0], UserGroupsColumns[1]},
+	// GroupMembersTable holds the schema information for the "group_members" table.
+	GroupMembersTable = &schema.Table{
+		Name:       "group_members",
+		Columns:    GroupMembersColumns,
+		PrimaryKey: []*schema.Column{GroupMembersColumns[0], GroupMembersColumns[1]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:  "user_groups_user_id",
-				Columns: []*schema.Column{UserGroupsColumns[0]},
+				Symbol:  "group_members_member_id",
+				Columns: []*schema.Column{GroupMembersColumns[0]},
 
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
-				Symbol:  "user_groups_group_id",
-				Columns: []*schema.Column{UserGroupsColumns[1]},
+				Symbol:  "group_members_group_id",
+				Columns: []*schema.Column{GroupMembersColumns[1]},
 
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "group_members_group_id_member_id",
+				Unique:  false,
+				Columns: []*schema.Column{GroupMembersColumns[1], GroupMembersColumns[0]},
+			},
+		},
 	}
 	// UserFriendsColumns holds the columns for the "user_friends" table.
 	UserFriendsColumns = []*schema.Column{
@@ -385,7 +415,7 @@ var (
 		NodesTable,
 		PetsTable,
 		UsersTable,
-		UserGroupsTable,
+		GroupMembersTable,
 		UserFriendsTable,
 		UserFollowingTable,
 	}
@@ -403,8 +433,8 @@ func init() {
 	UsersTable.ForeignKeys[0].RefTable = GroupsTable
 	UsersTable.ForeignKeys[1].RefTable = UsersTable
 	UsersTable.ForeignKeys[2].RefTable = UsersTable
-	UserGroupsTable.ForeignKeys[0].RefTable = UsersTable
-	UserGroupsTable.ForeignKeys[1].RefTable = GroupsTable
+	GroupMembersTable.ForeignKeys[0].RefTable = UsersTable
+	GroupMembersTable.ForeignKeys[1].RefTable = GroupsTable
 	UserFriendsTable.ForeignKeys[0].RefTable = UsersTable
 	UserFriendsTable.ForeignKeys[1].RefTable = UsersTable
 	UserFollowingTable.ForeignKeys[0].RefTable = UsersTable
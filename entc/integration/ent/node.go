@@ -13,6 +13,7 @@ import (
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/ent/node"
 )
 
 // Node is the model entity for the Node schema.
@@ -22,19 +23,43 @@ type Node struct {
 	ID string `json:"id,omitempty"`
 	// Value holds the value of the "value" field.
 	Value int `json:"value,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the NodeQuery when eager-loading is set.
+	Edges NodeEdges `json:"edges"`
+}
+
+// NodeEdges holds the relations/edges of other nodes in the graph.
+type NodeEdges struct {
+	// Prev holds the value of the prev edge.
+	Prev *Node `json:"prev,omitempty"`
+	// Next holds the value of the next edge.
+	Next *Node `json:"next,omitempty"`
 }
 
 // FromRows scans the sql response data into Node.
 func (n *Node) FromRows(rows *sql.Rows) error {
+	return n.scan(rows, node.Columns)
+}
+
+// scan scans the given columns of the sql response data into Node.
+// Fields that their columns were not selected are left with their zero values.
+func (n *Node) scan(rows *sql.Rows, columns []string) error {
 	var vn struct {
 		ID    int
 		Value sql.NullInt64
 	}
-	// the order here should be the same as in the `node.Columns`.
-	if err := rows.Scan(
-		&vn.ID,
-		&vn.Value,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case node.FieldID:
+			values[i] = &vn.ID
+		case node.FieldValue:
+			values[i] = &vn.Value
+		default:
+			return fmt.Errorf("unexpected column %q for type Node", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	n.ID = strconv.Itoa(vn.ID)
@@ -88,6 +113,31 @@ func (n *Node) Unwrap() *Node {
 	return n
 }
 
+// Clone returns a deep copy of the Node, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (n *Node) Clone() *Node {
+	return n.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Node, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (n *Node) clone(seen map[interface{}]interface{}) *Node {
+	if n == nil {
+		return nil
+	}
+	if _c, ok := seen[n]; ok {
+		return _c.(*Node)
+	}
+	_c := *n
+	_c.config = config{}
+	seen[n] = &_c
+	_c.Edges.Prev = n.Edges.Prev.clone(seen)
+	_c.Edges.Next = n.Edges.Next.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (n *Node) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -98,6 +148,17 @@ func (n *Node) String() string {
 	return buf.String()
 }
 
+// DiffNode returns the fields that have different values in the given Nodes,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffNode(a, b *Node) []FieldChange {
+	var changes []FieldChange
+	if a.Value != b.Value {
+		changes = append(changes, FieldChange{Field: node.FieldValue, Old: a.Value, New: b.Value})
+	}
+	return changes
+}
+
 // id returns the int representation of the ID field.
 func (n *Node) id() int {
 	id, _ := strconv.Atoi(n.ID)
@@ -109,9 +170,14 @@ type Nodes []*Node
 
 // FromRows scans the sql response data into Nodes.
 func (n *Nodes) FromRows(rows *sql.Rows) error {
+	return n.scan(rows, node.Columns)
+}
+
+// scan scans the given columns of the sql response data into Nodes.
+func (n *Nodes) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vn := &Node{}
-		if err := vn.FromRows(rows); err != nil {
+		if err := vn.scan(rows, columns); err != nil {
 			return err
 		}
 		*n = append(*n, vn)
@@ -6,6 +6,12 @@
 
 package node
 
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the node type in the database.
 	Label = "node"
@@ -36,3 +42,78 @@ var Columns = []string{
 	FieldID,
 	FieldValue,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Tables holds the tables that may be changed by the mutations of the node. The mutations
+// invalidate only the query results of these tables that were cached by the entcache driver.
+var Tables = []string{
+	"nodes",
+}
+
+// Scope is a reusable named scope of the Node queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.Node
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the Node type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "Node",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeString,
+		GoType:     "string",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "value",
+			StorageKey: FieldValue,
+			Type:       field.TypeInt,
+			GoType:     "int",
+			Optional:   true,
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "prev",
+			Type:     "Node",
+			Rel:      "O2O",
+			Unique:   true,
+			Optional: true,
+			Inverse:  true,
+			Table:    "nodes",
+			Columns:  []string{"prev_id"},
+		},
+		{
+			Name:     "next",
+			Type:     "Node",
+			Rel:      "O2O",
+			Unique:   true,
+			Optional: true,
+			Table:    "nodes",
+			Columns:  []string{"prev_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the Node type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
@@ -7,7 +7,11 @@
 package node
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
@@ -324,15 +328,31 @@ func HasPrev() predicate.Node {
 	)
 }
 
+// HasNoPrev applies a predicate that checks that the "prev" edge is empty. It's identical
+// to Not(HasPrev()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the Node nodes.
+func HasNoPrev() predicate.Node {
+	return predicate.NodePerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(sql.IsNull(t1.C(PrevColumn)))
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.InE(PrevInverseLabel))
+		},
+	)
+}
+
 // HasPrevWith applies the HasEdge predicate on the "prev" edge with a given conditions (other predicates).
 func HasPrevWith(preds ...predicate.Node) predicate.Node {
 	return predicate.NodePerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(FieldID).From(sql.Table(PrevTable))
+			t2 := sql.Select(FieldID).From(sql.Table(PrevTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(PrevColumn), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -345,6 +365,35 @@ func HasPrevWith(preds ...predicate.Node) predicate.Node {
 	)
 }
 
+// HasPrevWithRecursive applies the HasEdge predicate on the "prev" edge recursively. It matches the nodes
+// that reach a node with the given conditions by following the "prev" edge one or more times.
+// In SQL dialects, it's translated to a recursive common table expression (WITH RECURSIVE).
+func HasPrevWithRecursive(preds ...predicate.Node) predicate.Node {
+	return predicate.NodePerDialect(
+		func(s *sql.Selector) {
+			t1, t2 := s.Table(), sql.Table(Table)
+			t3 := sql.Select(FieldID).From(sql.Table(Table)).WithContext(s.Context())
+			for _, p := range preds {
+				p(t3)
+			}
+			s.AddError(t3.Err())
+			w := sql.WithRecursive("prev_recursive", FieldID)
+			w.As(
+				sql.Select(t2.C(FieldID)).From(t2).Where(sql.In(t2.C(PrevColumn), t3)).
+					Union(sql.Select(t2.C(FieldID)).From(t2).Join(w).On(t2.C(PrevColumn), w.C(FieldID))),
+			)
+			s.Where(sql.In(t1.C(FieldID), sql.Select(w.C(FieldID)).From(w).With(w)))
+		},
+		func(t *dsl.Traversal) {
+			tr := __.Repeat(__.In(PrevInverseLabel).SimplePath()).Emit()
+			for _, p := range preds {
+				p(tr)
+			}
+			t.Where(tr)
+		},
+	)
+}
+
 // HasNext applies the HasEdge predicate on the "next" edge.
 func HasNext() predicate.Node {
 	return predicate.NodePerDialect(
@@ -365,15 +414,38 @@ func HasNext() predicate.Node {
 	)
 }
 
+// HasNoNext applies a predicate that checks that the "next" edge is empty. It's identical
+// to Not(HasNext()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the Node nodes.
+func HasNoNext() predicate.Node {
+	return predicate.NodePerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(NextColumn).
+						From(sql.Table(NextTable)).
+						Where(sql.NotNull(NextColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(NextLabel))
+		},
+	)
+}
+
 // HasNextWith applies the HasEdge predicate on the "next" edge with a given conditions (other predicates).
 func HasNextWith(preds ...predicate.Node) predicate.Node {
 	return predicate.NodePerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(NextColumn).From(sql.Table(NextTable))
+			t2 := sql.Select(NextColumn).From(sql.Table(NextTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(FieldID), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -386,6 +458,167 @@ func HasNextWith(preds ...predicate.Node) predicate.Node {
 	)
 }
 
+// HasNextWithRecursive applies the HasEdge predicate on the "next" edge recursively. It matches the nodes
+// that reach a node with the given conditions by following the "next" edge one or more times.
+// In SQL dialects, it's translated to a recursive common table expression (WITH RECURSIVE).
+func HasNextWithRecursive(preds ...predicate.Node) predicate.Node {
+	return predicate.NodePerDialect(
+		func(s *sql.Selector) {
+			t1, t2 := s.Table(), sql.Table(Table)
+			t3 := sql.Select(FieldID).From(sql.Table(Table)).WithContext(s.Context())
+			for _, p := range preds {
+				p(t3)
+			}
+			s.AddError(t3.Err())
+			w := sql.WithRecursive("next_recursive", FieldID)
+			w.As(
+				sql.Select(t2.C(NextColumn)).From(t2).Where(sql.And(sql.In(t2.C(FieldID), t3), sql.NotNull(t2.C(NextColumn)))).
+					Union(sql.Select(t2.C(NextColumn)).From(t2).Join(w).On(t2.C(FieldID), w.C(FieldID)).Where(sql.NotNull(t2.C(NextColumn)))),
+			)
+			s.Where(sql.In(t1.C(FieldID), sql.Select(w.C(FieldID)).From(w).With(w)))
+		},
+		func(t *dsl.Traversal) {
+			tr := __.Repeat(__.Out(NextLabel).SimplePath()).Emit()
+			for _, p := range preds {
+				p(tr)
+			}
+			t.Where(tr)
+		},
+	)
+}
+
+// PredicateFunc returns a predicate from the given per-dialect functions.
+// It allows adding custom SQL/Gremlin fragments to the Node builders.
+func PredicateFunc(f0 func(*sql.Selector), f1 func(*dsl.Traversal)) predicate.Node {
+	return predicate.NodePerDialect(f0, f1)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.Node, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.Node, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.Node, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldValue:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return ValueIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return ValueNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return ValueIsNil(), nil
+				}
+				return Not(ValueIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return ValueNotNil(), nil
+				}
+				return Not(ValueNotNil()), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("node: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Node) predicate.Node {
 	return predicate.NodePerDialect(
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -20,14 +21,20 @@ import (
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/node"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // NodeCreate is the builder for creating a Node entity.
 type NodeCreate struct {
 	config
-	value *int
-	prev  map[string]struct{}
-	next  map[string]struct{}
+	nodeMutation
+	// id of the node, if it's mirrored from the primary storage.
+	id *string
+}
+
+// Mutation returns the mutation of the builder.
+func (nc *NodeCreate) Mutation() *NodeMutation {
+	return &NodeMutation{nodeMutation: &nc.nodeMutation, op: ent.OpCreate}
 }
 
 // SetValue sets the value field.
@@ -89,13 +96,32 @@ func (nc *NodeCreate) SetNext(n *Node) *NodeCreate {
 }
 
 // Save creates the Node in the database.
-func (nc *NodeCreate) Save(ctx context.Context) (*Node, error) {
+func (nc *NodeCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Node, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(nc.prev) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"prev\"")
 	}
 	if len(nc.next) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"next\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if nc.shadow != nil {
+		return nc.dualSave(ctx, opts...)
+	}
+	v, err := nc.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		nc.bus.publish(&Event{Op: ent.OpCreate, Type: node.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save creates the Node in the storage of the client dialect.
+func (nc *NodeCreate) save(ctx context.Context) (*Node, error) {
 	switch nc.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return nc.sqlSave(ctx)
@@ -107,8 +133,8 @@ func (nc *NodeCreate) Save(ctx context.Context) (*Node, error) {
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (nc *NodeCreate) SaveX(ctx context.Context) *Node {
-	v, err := nc.Save(ctx)
+func (nc *NodeCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Node {
+	v, err := nc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -116,6 +142,7 @@ func (nc *NodeCreate) SaveX(ctx context.Context) *Node {
 }
 
 func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
+	ctx = entcache.Invalidates(ctx, node.Tables...)
 	var (
 		res sql.Result
 		n   = &Node{config: nc.config}
@@ -125,10 +152,29 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 		return nil, err
 	}
 	builder := sql.Insert(node.Table).Default(nc.driver.Dialect())
+	if id := nc.id; id != nil {
+		id, err := strconv.Atoi(*id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(node.FieldID, id)
+	}
 	if value := nc.value; value != nil {
 		builder.Set(node.FieldValue, *value)
 		n.Value = *value
 	}
+	if len(nc.prev) > 0 {
+		if err := checkRefs(ctx, tx, node.Table, node.FieldID, "prev", nc.prev); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	for eid := range nc.prev {
+		eid, err := strconv.Atoi(eid)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(node.PrevColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -138,26 +184,6 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 		return nil, rollback(tx, err)
 	}
 	n.ID = strconv.FormatInt(id, 10)
-	if len(nc.prev) > 0 {
-		eid, err := strconv.Atoi(keys(nc.prev)[0])
-		if err != nil {
-			return nil, err
-		}
-		query, args := sql.Update(node.PrevTable).
-			Set(node.PrevColumn, eid).
-			Where(sql.EQ(node.FieldID, id).And().IsNull(node.PrevColumn)).
-			Query()
-		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return nil, rollback(tx, err)
-		}
-		affected, err := res.RowsAffected()
-		if err != nil {
-			return nil, rollback(tx, err)
-		}
-		if int(affected) < len(nc.prev) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"prev\" %v already connected to a different \"Node\"", keys(nc.prev))})
-		}
-	}
 	if len(nc.next) > 0 {
 		eid, err := strconv.Atoi(keys(nc.next)[0])
 		if err != nil {
@@ -175,7 +201,7 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(nc.next) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nc.next))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nc.next))})
 		}
 	}
 	if err := tx.Commit(); err != nil {
@@ -186,8 +212,9 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 
 func (nc *NodeCreate) gremlinSave(ctx context.Context) (*Node, error) {
 	res := &gremlin.Response{}
-	query, bindings := nc.gremlin().Query()
-	if err := nc.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := nc.gremlin()
+	query, _ := traversal.Query()
+	if err := nc.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -18,12 +20,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/node"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // NodeDelete is the builder for deleting a Node entity.
 type NodeDelete struct {
 	config
 	predicates []predicate.Node
+	// id of the node, if it's deleted using a NodeDeleteOne builder.
+	id *string
 }
 
 // Where adds a new predicate to the delete builder.
@@ -33,7 +38,37 @@ func (nd *NodeDelete) Where(ps ...predicate.Node) *NodeDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (nd *NodeDelete) Exec(ctx context.Context) (int, error) {
+func (nd *NodeDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	if nd.shadow != nil {
+		return nd.dualExec(ctx, opts...)
+	}
+	n, err := nd.exec(ctx)
+	if err != nil || n == 0 || options.SkipHooks {
+		return n, err
+	}
+	e := &Event{Op: ent.OpDelete, Type: node.Label, N: n}
+	if nd.id != nil {
+		e.Op, e.ID = ent.OpDeleteOne, *nd.id
+	}
+	nd.bus.publish(e)
+	return n, nil
+}
+
+// exec deletes the Node nodes from the storage of the client dialect.
+func (nd *NodeDelete) exec(ctx context.Context) (int, error) {
+	predicates, err := interceptNode(ctx, nd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *nd
+		intercepted.predicates = append(predicates, nd.predicates...)
+		nd = &intercepted
+	}
 	switch nd.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return nd.sqlExec(ctx)
@@ -45,8 +80,8 @@ func (nd *NodeDelete) Exec(ctx context.Context) (int, error) {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (nd *NodeDelete) ExecX(ctx context.Context) int {
-	n, err := nd.Exec(ctx)
+func (nd *NodeDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := nd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,17 +89,51 @@ func (nd *NodeDelete) ExecX(ctx context.Context) int {
 }
 
 func (nd *NodeDelete) sqlExec(ctx context.Context) (int, error) {
-	var res sql.Result
-	selector := sql.Select().From(sql.Table(node.Table))
+	ctx = entcache.Invalidates(ctx, node.Tables...)
+	tx, err := nd.driver.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	selector := sql.Select(node.FieldID).From(sql.Table(node.Table)).SetDialect(nd.driver.Dialect()).WithContext(ctx)
 	for _, p := range nd.predicates {
 		p(selector)
 	}
-	query, args := sql.Delete(node.Table).FromSelect(selector).Query()
-	if err := nd.driver.Exec(ctx, query, args, &res); err != nil {
-		return 0, err
+	if err := selector.Err(); err != nil {
+		return 0, rollback(tx, err)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return 0, rollback(tx, err)
+	}
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, rollback(tx, fmt.Errorf("ent: failed reading id: %v", err))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if len(ids) == 0 {
+		return 0, tx.Commit()
+	}
+	if err := nullRefs(ctx, tx, "nodes", "prev_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	var res sql.Result
+	query, args = sql.Delete(node.Table).Where(sql.InInts(node.FieldID, ids...)).Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return 0, rollback(tx, err)
 	}
 	affected, err := res.RowsAffected()
 	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
 		return 0, err
 	}
 	return int(affected), nil
@@ -72,8 +141,9 @@ func (nd *NodeDelete) sqlExec(ctx context.Context) (int, error) {
 
 func (nd *NodeDelete) gremlinExec(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := nd.gremlin().Query()
-	if err := nd.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := nd.gremlin()
+	query, _ := traversal.Query()
+	if err := nd.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -93,12 +163,12 @@ type NodeDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (ndo *NodeDeleteOne) Exec(ctx context.Context) error {
-	n, err := ndo.nd.Exec(ctx)
+func (ndo *NodeDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := ndo.nd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{node.Label}
 	default:
 		return nil
@@ -106,6 +176,8 @@ func (ndo *NodeDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ndo *NodeDeleteOne) ExecX(ctx context.Context) {
-	ndo.nd.ExecX(ctx)
+func (ndo *NodeDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := ndo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -25,14 +27,27 @@ import (
 // NodeQuery is the builder for querying Node entities.
 type NodeQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit     *int
+	offset    *int
+	order     []Order
+	unique    []string
+	fields    []string
+	unordered bool
+	hints     []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.Node
+	// eager-loading edges.
+	withPrev *NodeQuery
+	withNext *NodeQuery
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath     func(context.Context) (*sql.Selector, error)
+	gremlinPath func(context.Context) (*dsl.Traversal, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -59,21 +74,135 @@ func (nq *NodeQuery) Order(o ...Order) *NodeQuery {
 	return nq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored. Note that, hints are ignored by Gremlin queries.
+func (nq *NodeQuery) Hint(hints ...sql.Hint) *NodeQuery {
+	nq.hints = append(nq.hints, hints...)
+	return nq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	ns, err := client.Node.Query().
+//		Fields(node.FieldValue).
+//		All(ctx)
+//
+func (nq *NodeQuery) Fields(fields ...string) *NodeQuery {
+	nq.fields = append(nq.fields, fields...)
+	return nq
+}
+
+// Unordered disables the ordering by id that is added to the Gremlin queries that are paginated
+// using Limit or Offset. The order of the vertices
+// (and therefore, the content of the pages) is not guaranteed without it, but it can be removed when
+// the order is irrelevant (e.g. sampling).
+func (nq *NodeQuery) Unordered() *NodeQuery {
+	nq.unordered = true
+	return nq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (nq *NodeQuery) Scope(scopes ...node.Scope) *NodeQuery {
+	for _, s := range scopes {
+		nq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				nq.Order(Desc(o.Field))
+			} else {
+				nq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			nq.Limit(s.Limit)
+		}
+	}
+	return nq
+}
+
+// NodeInterceptor is a query interceptor of the NodeClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the NodeClient.Intercept method.
+type NodeInterceptor func(context.Context, *NodeQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (nq *NodeQuery) prepare(ctx context.Context) (*NodeQuery, error) {
+	var interceptors []NodeInterceptor
+	if nq.inters != nil {
+		nq.inters.mu.RLock()
+		interceptors = nq.inters.Node
+		nq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && nq.sqlPath == nil && nq.gremlinPath == nil {
+		return nq, nil
+	}
+	query := nq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	if path := query.gremlinPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.gremlin, query.gremlinPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptNode returns the predicates that the interceptors of the client add to a Node query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptNode(ctx context.Context, cfg config) ([]predicate.Node, error) {
+	query, err := (&NodeQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryPrev chains the current query on the prev edge.
 func (nq *NodeQuery) QueryPrev() *NodeQuery {
 	query := &NodeQuery{config: nq.config}
 	switch nq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(node.Table)
-		t2 := nq.sqlQuery()
-		t2.Select(t2.C(node.PrevColumn))
-		query.sql = sql.Select(t1.Columns(node.Columns...)...).
-			From(t1).
-			Join(t2).
-			On(t1.C(node.FieldID), t2.C(node.PrevColumn))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := nq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(node.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(node.PrevColumn))
+			return sql.Select(t1.Columns(node.Columns...)...).
+				From(t1).
+				Join(t2).
+				On(t1.C(node.FieldID), t2.C(node.PrevColumn)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := nq.gremlinQuery()
-		query.gremlin = gremlin.InE(node.NextLabel).OutV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := nq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.InE(node.NextLabel).OutV(), nil
+		}
 	}
 	return query
 }
@@ -83,20 +212,71 @@ func (nq *NodeQuery) QueryNext() *NodeQuery {
 	query := &NodeQuery{config: nq.config}
 	switch nq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(node.Table)
-		t2 := nq.sqlQuery()
-		t2.Select(t2.C(node.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t2).
-			On(t1.C(node.NextColumn), t2.C(node.FieldID))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := nq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(node.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(node.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t2).
+				On(t1.C(node.NextColumn), t2.C(node.FieldID)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := nq.gremlinQuery()
-		query.gremlin = gremlin.OutE(node.NextLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := nq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(node.NextLabel).InV(), nil
+		}
 	}
 	return query
 }
 
+// WithPrev tells the query-builder to eager-load the nodes that are connected to the "prev" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Node.Query().
+//		WithPrev(func(q *ent.NodeQuery) {
+//			q.Order(ent.Desc(node.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (nq *NodeQuery) WithPrev(opts ...func(*NodeQuery)) *NodeQuery {
+	query := &NodeQuery{config: nq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withPrev = query
+	return nq
+}
+
+// WithNext tells the query-builder to eager-load the nodes that are connected to the "next" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Node.Query().
+//		WithNext(func(q *ent.NodeQuery) {
+//			q.Order(ent.Desc(node.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (nq *NodeQuery) WithNext(opts ...func(*NodeQuery)) *NodeQuery {
+	query := &NodeQuery{config: nq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withNext = query
+	return nq
+}
+
 // First returns the first Node entity in the query. Returns *ErrNotFound when no node was found.
 func (nq *NodeQuery) First(ctx context.Context) (*Node, error) {
 	ns, err := nq.Limit(1).All(ctx)
@@ -142,7 +322,7 @@ func (nq *NodeQuery) FirstXID(ctx context.Context) string {
 
 // Only returns the only Node entity in the query, returns an error if not exactly one entity was returned.
 func (nq *NodeQuery) Only(ctx context.Context) (*Node, error) {
-	ns, err := nq.Limit(2).All(ctx)
+	ns, err := nq.Limit(2).Unordered().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -168,7 +348,7 @@ func (nq *NodeQuery) OnlyX(ctx context.Context) *Node {
 // OnlyID returns the only Node id in the query, returns an error if not exactly one id was returned.
 func (nq *NodeQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = nq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = nq.Limit(2).Unordered().IDs(ctx); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -192,33 +372,63 @@ func (nq *NodeQuery) OnlyXID(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of Nodes.
-func (nq *NodeQuery) All(ctx context.Context) ([]*Node, error) {
-	switch nq.driver.Dialect() {
+func (nq *NodeQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*Node, error) {
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.shadow != nil && !ent.NewCallOptions(opts...).SkipHooks {
+		return query.dualAll(ctx)
+	}
+
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return nq.sqlAll(ctx)
+		return query.sqlAll(ctx)
 	case dialect.Gremlin:
-		return nq.gremlinAll(ctx)
+		return query.gremlinAll(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
 // AllX is like All, but panics if an error occurs.
-func (nq *NodeQuery) AllX(ctx context.Context) []*Node {
-	ns, err := nq.All(ctx)
+func (nq *NodeQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*Node {
+	ns, err := nq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return ns
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (nq *NodeQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlQueryString(ctx)
+	case dialect.Gremlin:
+		return query.gremlinQueryString(ctx)
+	default:
+		return "", nil, errors.New("ent: unsupported dialect")
+	}
+}
+
 // IDs executes the query and returns a list of Node ids.
 func (nq *NodeQuery) IDs(ctx context.Context) ([]string, error) {
-	switch nq.driver.Dialect() {
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return nq.sqlIDs(ctx)
+		return query.sqlIDs(ctx)
 	case dialect.Gremlin:
-		return nq.gremlinIDs(ctx)
+		return query.gremlinIDs(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
@@ -233,13 +443,46 @@ func (nq *NodeQuery) IDsX(ctx context.Context) []string {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Nodes that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (nq *NodeQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if nq.sql != nil || nq.gremlin != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &NodeQuery{
+		config:     nq.config,
+		predicates: append([]predicate.Node{}, nq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(string)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for Node", after)
+		}
+		query.Where(node.IDGT(id))
+	}
+	ids, err := query.Order(Asc(node.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (nq *NodeQuery) Count(ctx context.Context) (int, error) {
-	switch nq.driver.Dialect() {
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return nq.sqlCount(ctx)
+		return query.sqlCount(ctx)
 	case dialect.Gremlin:
-		return nq.gremlinCount(ctx)
+		return query.gremlinCount(ctx)
 	default:
 		return 0, errors.New("ent: unsupported dialect")
 	}
@@ -256,11 +499,15 @@ func (nq *NodeQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (nq *NodeQuery) Exist(ctx context.Context) (bool, error) {
-	switch nq.driver.Dialect() {
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return nq.sqlExist(ctx)
+		return query.sqlExist(ctx)
 	case dialect.Gremlin:
-		return nq.gremlinExist(ctx)
+		return query.gremlinExist(ctx)
 	default:
 		return false, errors.New("ent: unsupported dialect")
 	}
@@ -284,10 +531,18 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 		offset:     nq.offset,
 		order:      append([]Order{}, nq.order...),
 		unique:     append([]string{}, nq.unique...),
+		fields:     append([]string{}, nq.fields...),
+		unordered:  nq.unordered,
+		hints:      append([]sql.Hint{}, nq.hints...),
+		edgeCount:  nq.edgeCount,
 		predicates: append([]predicate.Node{}, nq.predicates...),
+		withPrev:   nq.withPrev,
+		withNext:   nq.withNext,
 		// clone intermediate queries.
-		sql:     nq.sql.Clone(),
-		gremlin: nq.gremlin.Clone(),
+		sql:         nq.sql.Clone(),
+		sqlPath:     nq.sqlPath,
+		gremlin:     nq.gremlin.Clone(),
+		gremlinPath: nq.gremlinPath,
 	}
 }
 
@@ -309,11 +564,18 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 func (nq *NodeQuery) GroupBy(field string, fields ...string) *NodeGroupBy {
 	group := &NodeGroupBy{config: nq.config}
 	group.fields = append([]string{field}, fields...)
-	switch nq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		group.sql = nq.sqlQuery()
-	case dialect.Gremlin:
-		group.gremlin = nq.gremlinQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := nq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			group.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			group.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return group
 }
@@ -333,20 +595,27 @@ func (nq *NodeQuery) GroupBy(field string, fields ...string) *NodeGroupBy {
 func (nq *NodeQuery) Select(field string, fields ...string) *NodeSelect {
 	selector := &NodeSelect{config: nq.config}
 	selector.fields = append([]string{field}, fields...)
-	switch nq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		selector.sql = nq.sqlQuery()
-	case dialect.Gremlin:
-		selector.gremlin = nq.gremlinQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := nq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			selector.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			selector.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return selector
 }
 
 func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
 	rows := &sql.Rows{}
-	selector := nq.sqlQuery()
-	if unique := nq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := nq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := nq.driver.Query(ctx, query, args, rows); err != nil {
@@ -354,21 +623,217 @@ func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
 	}
 	defer rows.Close()
 	var ns Nodes
-	if err := ns.FromRows(rows); err != nil {
+	if err := ns.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	ns.config(nq.config)
+	if query := nq.withPrev; query != nil {
+		if err := nq.sqlLoadPrev(ctx, query, ns); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withNext; query != nil {
+		if err := nq.sqlLoadNext(ctx, query, ns); err != nil {
+			return nil, err
+		}
+	}
 	return ns, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (nq *NodeQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := nq.sqlQuery(ctx)
+	if unique := nq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := node.Columns
+	if fields := nq.fields; len(fields) > 0 {
+		columns = append([]string{node.FieldID}, fields...)
+		for _, c := range fields {
+			if !node.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (nq *NodeQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := nq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadPrev loads the nodes of the prev edge of the given Nodes using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (nq *NodeQuery) sqlLoadPrev(ctx context.Context, query *NodeQuery, nodes []*Node) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*Node, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(node.PrevTable)
+	selector.Join(t1).On(t1.C(node.PrevColumn), selector.C(node.FieldID))
+	key := t1.C(node.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(node.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := nq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.Node{node.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*Node, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Prev = edge
+	}
+	return nil
+}
+
+// sqlLoadNext loads the nodes of the next edge of the given Nodes using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (nq *NodeQuery) sqlLoadNext(ctx context.Context, query *NodeQuery, nodes []*Node) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*Node, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	key := selector.C(node.NextColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(node.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := nq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.Node{node.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*Node, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Next = edge
+	}
+	return nil
+}
+
 func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := nq.sqlQuery()
+	selector := nq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{node.FieldID}
 	if len(nq.unique) > 0 {
 		unique = nq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := nq.edgeCount; c != nil && len(nq.predicates) == 0 && len(nq.unique) == 0 &&
+		len(nq.hints) == 0 && nq.limit == nil && nq.offset == nil {
+		selector = c.Clone().SetDialect(nq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := nq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -385,11 +850,22 @@ func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (nq *NodeQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := nq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := nq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(node.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := nq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := nq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (nq *NodeQuery) sqlIDs(ctx context.Context) ([]string, error) {
@@ -404,13 +880,33 @@ func (nq *NodeQuery) sqlIDs(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
-func (nq *NodeQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (nq *NodeQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(node.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (nq *NodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(node.Table)
 	selector := sql.Select(t1.Columns(node.Columns...)...).From(t1)
 	if nq.sql != nil {
 		selector = nq.sql
 		selector.Select(selector.Columns(node.Columns...)...)
 	}
+	selector.SetDialect(nq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(nq.hints...)
 	for _, p := range nq.predicates {
 		p(selector)
 	}
@@ -430,8 +926,9 @@ func (nq *NodeQuery) sqlQuery() *sql.Selector {
 
 func (nq *NodeQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := nq.gremlinQuery().Query()
-	if err := nq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := nq.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := nq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	vertices, err := res.ReadVertices()
@@ -447,8 +944,9 @@ func (nq *NodeQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 
 func (nq *NodeQuery) gremlinAll(ctx context.Context) ([]*Node, error) {
 	res := &gremlin.Response{}
-	query, bindings := nq.gremlinQuery().ValueMap(true).Query()
-	if err := nq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := nq.gremlinAllQuery()
+	query, _ := traversal.Query()
+	if err := nq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	var ns Nodes
@@ -456,13 +954,82 @@ func (nq *NodeQuery) gremlinAll(ctx context.Context) ([]*Node, error) {
 		return nil, err
 	}
 	ns.config(nq.config)
+	if query := nq.withPrev; query != nil {
+		if err := nq.gremlinLoadPrev(ctx, query, ns); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withNext; query != nil {
+		if err := nq.gremlinLoadNext(ctx, query, ns); err != nil {
+			return nil, err
+		}
+	}
 	return ns, nil
 }
 
+// gremlinAllQuery returns the traversal of All, that returns the value maps of the vertices.
+func (nq *NodeQuery) gremlinAllQuery() *dsl.Traversal {
+	args := []interface{}{true}
+	for _, f := range nq.fields {
+		args = append(args, f)
+	}
+	return nq.gremlinQuery().ValueMap(args...)
+}
+
+func (nq *NodeQuery) gremlinQueryString(context.Context) (string, interface{}, error) {
+	query, bindings := nq.gremlinAllQuery().Query()
+	return query, bindings, nil
+}
+
+// gremlinLoadPrev loads the nodes of the prev edge of the given Nodes using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (nq *NodeQuery) gremlinLoadPrev(ctx context.Context, query *NodeQuery, nodes []*Node) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&NodeClient{config: nq.config}).QueryPrev(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Prev = edges[0]
+		}
+	}
+	return nil
+}
+
+// gremlinLoadNext loads the nodes of the next edge of the given Nodes using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (nq *NodeQuery) gremlinLoadNext(ctx context.Context, query *NodeQuery, nodes []*Node) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&NodeClient{config: nq.config}).QueryNext(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Next = edges[0]
+		}
+	}
+	return nil
+}
+
 func (nq *NodeQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := nq.gremlinQuery().Count().Query()
-	if err := nq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := nq.gremlinTraversal(false).Count()
+	query, _ := traversal.Query()
+	if err := nq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -470,14 +1037,22 @@ func (nq *NodeQuery) gremlinCount(ctx context.Context) (int, error) {
 
 func (nq *NodeQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
-	query, bindings := nq.gremlinQuery().HasNext().Query()
-	if err := nq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := nq.gremlinTraversal(false).HasNext()
+	query, _ := traversal.Query()
+	if err := nq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
 }
 
+// gremlinQuery returns the traversal of the query for listing its vertices, with its default orders.
 func (nq *NodeQuery) gremlinQuery() *dsl.Traversal {
+	return nq.gremlinTraversal(true)
+}
+
+// gremlinTraversal returns the traversal of the query. The default orders are added only to the traversals
+// of listing queries (list), since they don't change the results of the others (e.g. Count and Exist).
+func (nq *NodeQuery) gremlinTraversal(list bool) *dsl.Traversal {
 	v := g.V().HasLabel(node.Label)
 	if nq.gremlin != nil {
 		v = nq.gremlin.Clone()
@@ -485,13 +1060,22 @@ func (nq *NodeQuery) gremlinQuery() *dsl.Traversal {
 	for _, p := range nq.predicates {
 		p(v)
 	}
-	if len(nq.order) > 0 {
+	limit, offset := nq.limit, nq.offset
+	list = list && !nq.unordered
+	// paginated queries are ordered by id (also as a tie-breaker of the
+	// query orders), in order to keep the pages stable between calls.
+	stable := (limit != nil || offset != nil) && list
+	order := nq.order
+	if len(order) > 0 || stable {
 		v.Order()
-		for _, p := range nq.order {
+		for _, p := range order {
 			p(v)
 		}
+		if stable {
+			v.By(dsl.ID, dsl.Incr)
+		}
 	}
-	switch limit, offset := nq.limit, nq.offset; {
+	switch {
 	case limit != nil && offset != nil:
 		v.Range(*offset, *offset+*limit)
 	case offset != nil:
@@ -513,6 +1097,11 @@ type NodeGroupBy struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving     *sql.Predicate
+	gremlinHaving *dsl.Traversal
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -521,8 +1110,27 @@ func (ngb *NodeGroupBy) Aggregate(fns ...Aggregate) *NodeGroupBy {
 	return ngb
 }
 
+// Having adds per-dialect predicates on the aggregated values of the groups.
+// In Gremlin, the predicate is applied using a `where` step on the values of each group.
+// For example:
+//
+//	client.Node.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5), __.Select(ent.DefaultCountLabel).Is(p.GT(5))).
+//		Scan(ctx, &v)
+//
+func (ngb *NodeGroupBy) Having(p0 *sql.Predicate, p1 *dsl.Traversal) *NodeGroupBy {
+	ngb.sqlHaving = p0
+	ngb.gremlinHaving = p1
+	return ngb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (ngb *NodeGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := ngb.path(ctx); err != nil {
+		return err
+	}
 	switch ngb.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ngb.sqlScan(ctx, v)
@@ -625,6 +1233,9 @@ func (ngb *NodeGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (ngb *NodeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ngb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ngb.sqlQuery().Query()
 	if err := ngb.driver.Query(ctx, query, args, rows); err != nil {
@@ -641,13 +1252,18 @@ func (ngb *NodeGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range ngb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(ngb.fields...)
+	selector.Select(columns...).GroupBy(ngb.fields...)
+	if ngb.sqlHaving != nil {
+		selector.Having(ngb.sqlHaving)
+	}
+	return selector
 }
 
 func (ngb *NodeGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
-	query, bindings := ngb.gremlinQuery().Query()
-	if err := ngb.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ngb.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := ngb.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(ngb.fields)+len(ngb.fns) == 1 {
@@ -674,11 +1290,14 @@ func (ngb *NodeGroupBy) gremlinQuery() *dsl.Traversal {
 		names = append(names, f)
 		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
 	}
-	return ngb.gremlin.Group().
+	v := ngb.gremlin.Group().
 		By(__.Values(ngb.fields...).Fold()).
 		By(__.Fold().Match(trs...).Select(names...)).
-		Select(dsl.Values).
-		Next()
+		Select(dsl.Values)
+	if ngb.gremlinHaving != nil {
+		v = v.Unfold().Where(ngb.gremlinHaving).Fold()
+	}
+	return v.Next()
 }
 
 // NodeSelect is the builder for select fields of Node entities.
@@ -688,10 +1307,15 @@ type NodeSelect struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (ns *NodeSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ns.path(ctx); err != nil {
+		return err
+	}
 	switch ns.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ns.sqlScan(ctx, v)
@@ -794,6 +1418,9 @@ func (ns *NodeSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (ns *NodeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ns.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ns.sqlQuery().Query()
 	if err := ns.driver.Query(ctx, query, args, rows); err != nil {
@@ -822,8 +1449,8 @@ func (ns *NodeSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		}
 		traversal = ns.gremlin.ValueMap(fields...)
 	}
-	query, bindings := traversal.Query()
-	if err := ns.driver.Exec(ctx, query, bindings, res); err != nil {
+	query, _ := traversal.Query()
+	if err := ns.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(ns.fields) == 1 {
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -21,19 +22,17 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/node"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // NodeUpdate is the builder for updating Node entities.
 type NodeUpdate struct {
 	config
-	value       *int
-	addvalue    *int
-	clearvalue  bool
-	prev        map[string]struct{}
-	next        map[string]struct{}
-	clearedPrev bool
-	clearedNext bool
-	predicates  []predicate.Node
+	nodeMutation
+	predicates []predicate.Node
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Node
 }
 
 // Where adds a new predicate for the builder.
@@ -42,6 +41,11 @@ func (nu *NodeUpdate) Where(ps ...predicate.Node) *NodeUpdate {
 	return nu
 }
 
+// Mutation returns the mutation of the builder.
+func (nu *NodeUpdate) Mutation() *NodeMutation {
+	return &NodeMutation{nodeMutation: &nu.nodeMutation, op: ent.OpUpdate}
+}
+
 // SetValue sets the value field.
 func (nu *NodeUpdate) SetValue(i int) *NodeUpdate {
 	nu.value = &i
@@ -131,12 +135,47 @@ func (nu *NodeUpdate) ClearNext() *NodeUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (nu *NodeUpdate) Save(ctx context.Context) (int, error) {
+func (nu *NodeUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := nu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (nu *NodeUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]string, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(nu.prev) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"prev\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"prev\"")
 	}
 	if len(nu.next) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"next\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"next\"")
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if nu.shadow != nil {
+		return nu.dualSave(ctx, opts...)
+	}
+	ids, err := nu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 && !options.SkipHooks {
+		nu.bus.publish(&Event{Op: ent.OpUpdate, Type: node.Label, N: len(ids)})
+	}
+	return ids, nil
+}
+
+// save updates the Node nodes in the storage of the client dialect.
+func (nu *NodeUpdate) save(ctx context.Context) ([]string, error) {
+	predicates, err := interceptNode(ctx, nu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *nu
+		intercepted.predicates = append(predicates, nu.predicates...)
+		nu = &intercepted
 	}
 	switch nu.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
@@ -144,13 +183,26 @@ func (nu *NodeUpdate) Save(ctx context.Context) (int, error) {
 	case dialect.Gremlin:
 		return nu.gremlinSave(ctx)
 	default:
-		return 0, errors.New("ent: unsupported dialect")
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (nu *NodeUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Node, error) {
+	var ns []*Node
+	nu.returning = &ns
+	defer func() { nu.returning = nil }()
+	if _, err := nu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
 	}
+	return ns, nil
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (nu *NodeUpdate) SaveX(ctx context.Context) int {
-	affected, err := nu.Save(ctx)
+func (nu *NodeUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := nu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -158,44 +210,49 @@ func (nu *NodeUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (nu *NodeUpdate) Exec(ctx context.Context) error {
-	_, err := nu.Save(ctx)
+func (nu *NodeUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := nu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (nu *NodeUpdate) ExecX(ctx context.Context) {
-	if err := nu.Exec(ctx); err != nil {
+func (nu *NodeUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := nu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(node.FieldID).From(sql.Table(node.Table))
+func (nu *NodeUpdate) sqlSave(ctx context.Context) (_ []string, err error) {
+	ctx = entcache.Invalidates(ctx, node.Tables...)
+	selector := sql.Select(node.FieldID).From(sql.Table(node.Table)).SetDialect(nu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range nu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = nu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := nu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -213,37 +270,44 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if nu.clearedPrev {
+	if nu.clearedPrev && len(nu.prev) == 0 {
 		query, args := sql.Update(node.PrevTable).
 			SetNull(node.PrevColumn).
 			Where(sql.InInts(node.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(nu.prev) > 0 {
+		if err := checkRefs(ctx, tx, node.Table, node.FieldID, "prev", nu.prev); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for _, id := range ids {
 			eid, serr := strconv.Atoi(keys(nu.prev)[0])
 			if serr != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
+			}
+			p := sql.EQ(node.FieldID, id)
+			if !nu.clearedPrev {
+				p = p.And().IsNull(node.PrevColumn)
 			}
 			query, args := sql.Update(node.PrevTable).
 				Set(node.PrevColumn, eid).
-				Where(sql.EQ(node.FieldID, id).And().IsNull(node.PrevColumn)).
+				Where(p).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(nu.prev) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"prev\" %v already connected to a different \"Node\"", keys(nu.prev))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"prev\" %v already connected to a different \"Node\"", keys(nu.prev))})
 			}
 		}
 	}
@@ -253,47 +317,92 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(node.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(nu.next) > 0 {
 		for _, id := range ids {
 			eid, serr := strconv.Atoi(keys(nu.next)[0])
 			if serr != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			query, args := sql.Update(node.NextTable).
 				Set(node.NextColumn, id).
 				Where(sql.EQ(node.FieldID, eid).And().IsNull(node.NextColumn)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(nu.next) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nu.next))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nu.next))})
 			}
 		}
 	}
+	if nu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(node.Columns...).
+			From(sql.Table(node.Table)).
+			Where(sql.InInts(node.FieldID, ids...)).
+			SetDialect(nu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var ns Nodes
+		err := ns.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Node: %v", err))
+		}
+		ns.config(nu.config)
+		*nu.returning = ns
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
+	}
+	vs := make([]string, len(ids))
+	for i, id := range ids {
+		vs[i] = strconv.Itoa(id)
 	}
-	return len(ids), nil
+	return vs, nil
 }
 
-func (nu *NodeUpdate) gremlinSave(ctx context.Context) (int, error) {
+func (nu *NodeUpdate) gremlinSave(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := nu.gremlin().Query()
-	if err := nu.driver.Exec(ctx, query, bindings, res); err != nil {
-		return 0, err
+	traversal := nu.gremlin()
+	query, _ := traversal.Query()
+	if err := nu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
-		return 0, err
+		return nil, err
+	}
+	if nu.returning != nil {
+		var ns Nodes
+		if err := ns.FromResponse(res); err != nil {
+			return nil, err
+		}
+		ns.config(nu.config)
+		*nu.returning = ns
+		ids := make([]string, 0, len(ns))
+		for _, n := range ns {
+			ids = append(ids, n.ID)
+		}
+		return ids, nil
+	}
+	vertices, err := res.ReadVertices()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vertices))
+	for _, vertex := range vertices {
+		ids = append(ids, vertex.ID.(string))
 	}
-	return res.ReadInt()
+	return ids, nil
 }
 
 func (nu *NodeUpdate) gremlin() *dsl.Traversal {
@@ -347,11 +456,13 @@ func (nu *NodeUpdate) gremlin() *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(node.Label, node.NextLabel, id)),
 		})
 	}
-	v.Count()
+	if nu.returning != nil {
+		v.ValueMap(true)
+	}
 	if len(constraints) > 0 {
 		constraints = append(constraints, &constraint{
 			pred: rv.Count(),
-			test: __.Is(p.GT(1)).Constant(&ErrConstraintFailed{msg: "update traversal contains more than one vertex"}),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
 		})
 		v = constraints[0].pred.Coalesce(constraints[0].test, v)
 		for _, cr := range constraints[1:] {
@@ -365,14 +476,16 @@ func (nu *NodeUpdate) gremlin() *dsl.Traversal {
 // NodeUpdateOne is the builder for updating a single Node entity.
 type NodeUpdateOne struct {
 	config
-	id          string
-	value       *int
-	addvalue    *int
-	clearvalue  bool
-	prev        map[string]struct{}
-	next        map[string]struct{}
-	clearedPrev bool
-	clearedNext bool
+	id     string
+	entity *Node
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Node
+	nodeMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (nuo *NodeUpdateOne) Mutation() *NodeMutation {
+	return &NodeMutation{nodeMutation: &nuo.nodeMutation, op: ent.OpUpdateOne, id: &nuo.id}
 }
 
 // SetValue sets the value field.
@@ -463,14 +576,60 @@ func (nuo *NodeUpdateOne) ClearNext() *NodeUpdateOne {
 	return nuo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (nuo *NodeUpdateOne) SetChangedFrom(original *Node) *NodeUpdateOne {
+	n := nuo.entity
+	if n == nil || original == nil {
+		return nuo
+	}
+	for _, change := range DiffNode(original, n) {
+		switch change.Field {
+		case node.FieldValue:
+			nuo.SetValue(n.Value)
+		}
+	}
+	return nuo
+}
+
 // Save executes the query and returns the updated entity.
-func (nuo *NodeUpdateOne) Save(ctx context.Context) (*Node, error) {
+func (nuo *NodeUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Node, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(nuo.prev) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"prev\"")
 	}
 	if len(nuo.next) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"next\"")
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if nuo.shadow != nil {
+		return nuo.dualSave(ctx, opts...)
+	}
+	n, err := nuo.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		nuo.bus.publish(&Event{Op: ent.OpUpdateOne, Type: node.Label, ID: n.ID, Node: n, N: 1})
+	}
+	return n, nil
+}
+
+// save updates the Node in the storage of the client dialect.
+func (nuo *NodeUpdateOne) save(ctx context.Context) (*Node, error) {
+	predicates, err := interceptNode(ctx, nuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *nuo
+		intercepted.predicates = append(predicates, nuo.predicates...)
+		nuo = &intercepted
+	}
 	switch nuo.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return nuo.sqlSave(ctx)
@@ -482,8 +641,8 @@ func (nuo *NodeUpdateOne) Save(ctx context.Context) (*Node, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (nuo *NodeUpdateOne) SaveX(ctx context.Context) *Node {
-	n, err := nuo.Save(ctx)
+func (nuo *NodeUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Node {
+	n, err := nuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -491,21 +650,29 @@ func (nuo *NodeUpdateOne) SaveX(ctx context.Context) *Node {
 }
 
 // Exec executes the query on the entity.
-func (nuo *NodeUpdateOne) Exec(ctx context.Context) error {
-	_, err := nuo.Save(ctx)
+func (nuo *NodeUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := nuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (nuo *NodeUpdateOne) ExecX(ctx context.Context) {
-	if err := nuo.Exec(ctx); err != nil {
+func (nuo *NodeUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := nuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
-	selector := sql.Select(node.Columns...).From(sql.Table(node.Table))
+	ctx = entcache.Invalidates(ctx, node.Tables...)
+	selector := sql.Select(node.Columns...).From(sql.Table(node.Table)).SetDialect(nuo.driver.Dialect())
 	node.ID(nuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range nuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = nuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -556,7 +723,7 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if nuo.clearedPrev {
+	if nuo.clearedPrev && len(nuo.prev) == 0 {
 		query, args := sql.Update(node.PrevTable).
 			SetNull(node.PrevColumn).
 			Where(sql.InInts(node.FieldID, ids...)).
@@ -566,14 +733,21 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 		}
 	}
 	if len(nuo.prev) > 0 {
+		if err := checkRefs(ctx, tx, node.Table, node.FieldID, "prev", nuo.prev); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for _, id := range ids {
 			eid, serr := strconv.Atoi(keys(nuo.prev)[0])
 			if serr != nil {
 				return nil, rollback(tx, err)
 			}
+			p := sql.EQ(node.FieldID, id)
+			if !nuo.clearedPrev {
+				p = p.And().IsNull(node.PrevColumn)
+			}
 			query, args := sql.Update(node.PrevTable).
 				Set(node.PrevColumn, eid).
-				Where(sql.EQ(node.FieldID, id).And().IsNull(node.PrevColumn)).
+				Where(p).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
 				return nil, rollback(tx, err)
@@ -583,7 +757,7 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(nuo.prev) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"prev\" %v already connected to a different \"Node\"", keys(nuo.prev))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"prev\" %v already connected to a different \"Node\"", keys(nuo.prev))})
 			}
 		}
 	}
@@ -614,7 +788,7 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(nuo.next) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nuo.next))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nuo.next))})
 			}
 		}
 	}
@@ -626,8 +800,9 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 
 func (nuo *NodeUpdateOne) gremlinSave(ctx context.Context) (*Node, error) {
 	res := &gremlin.Response{}
-	query, bindings := nuo.gremlin(nuo.id).Query()
-	if err := nuo.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := nuo.gremlin(nuo.id)
+	query, _ := traversal.Query()
+	if err := nuo.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -647,6 +822,9 @@ func (nuo *NodeUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 2)
 	v := g.V(id)
+	for _, p := range nuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -9,9 +9,9 @@ package ent
 import (
 	"context"
 	"errors"
-	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -21,14 +21,20 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // PetCreate is the builder for creating a Pet entity.
 type PetCreate struct {
 	config
-	name  *string
-	team  map[string]struct{}
-	owner map[string]struct{}
+	petMutation
+	// id of the node, if it's mirrored from the primary storage.
+	id *string
+}
+
+// Mutation returns the mutation of the builder.
+func (pc *PetCreate) Mutation() *PetMutation {
+	return &PetMutation{petMutation: &pc.petMutation, op: ent.OpCreate}
 }
 
 // SetName sets the name field.
@@ -82,7 +88,8 @@ func (pc *PetCreate) SetOwner(u *User) *PetCreate {
 }
 
 // Save creates the Pet in the database.
-func (pc *PetCreate) Save(ctx context.Context) (*Pet, error) {
+func (pc *PetCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Pet, error) {
+	options := ent.NewCallOptions(opts...)
 	if pc.name == nil {
 		return nil, errors.New("ent: missing required field \"name\"")
 	}
@@ -92,6 +99,24 @@ func (pc *PetCreate) Save(ctx context.Context) (*Pet, error) {
 	if len(pc.owner) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if pc.shadow != nil {
+		return pc.dualSave(ctx, opts...)
+	}
+	v, err := pc.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		pc.bus.publish(&Event{Op: ent.OpCreate, Type: pet.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save creates the Pet in the storage of the client dialect.
+func (pc *PetCreate) save(ctx context.Context) (*Pet, error) {
 	switch pc.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return pc.sqlSave(ctx)
@@ -103,8 +128,8 @@ func (pc *PetCreate) Save(ctx context.Context) (*Pet, error) {
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (pc *PetCreate) SaveX(ctx context.Context) *Pet {
-	v, err := pc.Save(ctx)
+func (pc *PetCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Pet {
+	v, err := pc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -112,6 +137,7 @@ func (pc *PetCreate) SaveX(ctx context.Context) *Pet {
 }
 
 func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
+	ctx = entcache.Invalidates(ctx, pet.Tables...)
 	var (
 		res sql.Result
 		pe  = &Pet{config: pc.config}
@@ -121,54 +147,50 @@ func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
 		return nil, err
 	}
 	builder := sql.Insert(pet.Table).Default(pc.driver.Dialect())
+	if id := pc.id; id != nil {
+		id, err := strconv.Atoi(*id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(pet.FieldID, id)
+	}
 	if value := pc.name; value != nil {
 		builder.Set(pet.FieldName, *value)
 		pe.Name = *value
 	}
-	query, args := builder.Query()
-	if err := tx.Exec(ctx, query, args, &res); err != nil {
-		return nil, rollback(tx, err)
-	}
-	id, err := res.LastInsertId()
-	if err != nil {
-		return nil, rollback(tx, err)
-	}
-	pe.ID = strconv.FormatInt(id, 10)
 	if len(pc.team) > 0 {
-		eid, err := strconv.Atoi(keys(pc.team)[0])
-		if err != nil {
-			return nil, err
-		}
-		query, args := sql.Update(pet.TeamTable).
-			Set(pet.TeamColumn, eid).
-			Where(sql.EQ(pet.FieldID, id).And().IsNull(pet.TeamColumn)).
-			Query()
-		if err := tx.Exec(ctx, query, args, &res); err != nil {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "team", pc.team); err != nil {
 			return nil, rollback(tx, err)
 		}
-		affected, err := res.RowsAffected()
+	}
+	for eid := range pc.team {
+		eid, err := strconv.Atoi(eid)
 		if err != nil {
 			return nil, rollback(tx, err)
 		}
-		if int(affected) < len(pc.team) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"team\" %v already connected to a different \"Pet\"", keys(pc.team))})
-		}
+		builder.Set(pet.TeamColumn, eid)
 	}
 	if len(pc.owner) > 0 {
-		for eid := range pc.owner {
-			eid, err := strconv.Atoi(eid)
-			if err != nil {
-				return nil, rollback(tx, err)
-			}
-			query, args := sql.Update(pet.OwnerTable).
-				Set(pet.OwnerColumn, eid).
-				Where(sql.EQ(pet.FieldID, id)).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "owner", pc.owner); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	for eid := range pc.owner {
+		eid, err := strconv.Atoi(eid)
+		if err != nil {
+			return nil, rollback(tx, err)
 		}
+		builder.Set(pet.OwnerColumn, eid)
+	}
+	query, args := builder.Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return nil, rollback(tx, err)
 	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	pe.ID = strconv.FormatInt(id, 10)
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
@@ -177,8 +199,9 @@ func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
 
 func (pc *PetCreate) gremlinSave(ctx context.Context) (*Pet, error) {
 	res := &gremlin.Response{}
-	query, bindings := pc.gremlin().Query()
-	if err := pc.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := pc.gremlin()
+	query, _ := traversal.Query()
+	if err := pc.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
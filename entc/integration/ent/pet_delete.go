@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -18,12 +19,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // PetDelete is the builder for deleting a Pet entity.
 type PetDelete struct {
 	config
 	predicates []predicate.Pet
+	// id of the node, if it's deleted using a PetDeleteOne builder.
+	id *string
 }
 
 // Where adds a new predicate to the delete builder.
@@ -33,7 +37,37 @@ func (pd *PetDelete) Where(ps ...predicate.Pet) *PetDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (pd *PetDelete) Exec(ctx context.Context) (int, error) {
+func (pd *PetDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	if pd.shadow != nil {
+		return pd.dualExec(ctx, opts...)
+	}
+	n, err := pd.exec(ctx)
+	if err != nil || n == 0 || options.SkipHooks {
+		return n, err
+	}
+	e := &Event{Op: ent.OpDelete, Type: pet.Label, N: n}
+	if pd.id != nil {
+		e.Op, e.ID = ent.OpDeleteOne, *pd.id
+	}
+	pd.bus.publish(e)
+	return n, nil
+}
+
+// exec deletes the Pet nodes from the storage of the client dialect.
+func (pd *PetDelete) exec(ctx context.Context) (int, error) {
+	predicates, err := interceptPet(ctx, pd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *pd
+		intercepted.predicates = append(predicates, pd.predicates...)
+		pd = &intercepted
+	}
 	switch pd.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return pd.sqlExec(ctx)
@@ -45,8 +79,8 @@ func (pd *PetDelete) Exec(ctx context.Context) (int, error) {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pd *PetDelete) ExecX(ctx context.Context) int {
-	n, err := pd.Exec(ctx)
+func (pd *PetDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := pd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,11 +88,15 @@ func (pd *PetDelete) ExecX(ctx context.Context) int {
 }
 
 func (pd *PetDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = entcache.Invalidates(ctx, pet.Tables...)
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(pet.Table))
+	selector := sql.Select().From(sql.Table(pet.Table)).SetDialect(pd.driver.Dialect()).WithContext(ctx)
 	for _, p := range pd.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(pet.Table).FromSelect(selector).Query()
 	if err := pd.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -72,8 +110,9 @@ func (pd *PetDelete) sqlExec(ctx context.Context) (int, error) {
 
 func (pd *PetDelete) gremlinExec(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := pd.gremlin().Query()
-	if err := pd.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := pd.gremlin()
+	query, _ := traversal.Query()
+	if err := pd.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -93,12 +132,12 @@ type PetDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (pdo *PetDeleteOne) Exec(ctx context.Context) error {
-	n, err := pdo.pd.Exec(ctx)
+func (pdo *PetDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := pdo.pd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{pet.Label}
 	default:
 		return nil
@@ -106,6 +145,8 @@ func (pdo *PetDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pdo *PetDeleteOne) ExecX(ctx context.Context) {
-	pdo.pd.ExecX(ctx)
+func (pdo *PetDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := pdo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
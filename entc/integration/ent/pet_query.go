@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -26,14 +28,27 @@ import (
 // PetQuery is the builder for querying Pet entities.
 type PetQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit     *int
+	offset    *int
+	order     []Order
+	unique    []string
+	fields    []string
+	unordered bool
+	hints     []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.Pet
+	// eager-loading edges.
+	withTeam  *UserQuery
+	withOwner *UserQuery
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath     func(context.Context) (*sql.Selector, error)
+	gremlinPath func(context.Context) (*dsl.Traversal, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -60,21 +75,135 @@ func (pq *PetQuery) Order(o ...Order) *PetQuery {
 	return pq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored. Note that, hints are ignored by Gremlin queries.
+func (pq *PetQuery) Hint(hints ...sql.Hint) *PetQuery {
+	pq.hints = append(pq.hints, hints...)
+	return pq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	pes, err := client.Pet.Query().
+//		Fields(pet.FieldName).
+//		All(ctx)
+//
+func (pq *PetQuery) Fields(fields ...string) *PetQuery {
+	pq.fields = append(pq.fields, fields...)
+	return pq
+}
+
+// Unordered disables the ordering by id that is added to the Gremlin queries that are paginated
+// using Limit or Offset. The order of the vertices
+// (and therefore, the content of the pages) is not guaranteed without it, but it can be removed when
+// the order is irrelevant (e.g. sampling).
+func (pq *PetQuery) Unordered() *PetQuery {
+	pq.unordered = true
+	return pq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (pq *PetQuery) Scope(scopes ...pet.Scope) *PetQuery {
+	for _, s := range scopes {
+		pq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				pq.Order(Desc(o.Field))
+			} else {
+				pq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			pq.Limit(s.Limit)
+		}
+	}
+	return pq
+}
+
+// PetInterceptor is a query interceptor of the PetClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the PetClient.Intercept method.
+type PetInterceptor func(context.Context, *PetQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (pq *PetQuery) prepare(ctx context.Context) (*PetQuery, error) {
+	var interceptors []PetInterceptor
+	if pq.inters != nil {
+		pq.inters.mu.RLock()
+		interceptors = pq.inters.Pet
+		pq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && pq.sqlPath == nil && pq.gremlinPath == nil {
+		return pq, nil
+	}
+	query := pq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	if path := query.gremlinPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.gremlin, query.gremlinPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptPet returns the predicates that the interceptors of the client add to a Pet query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptPet(ctx context.Context, cfg config) ([]predicate.Pet, error) {
+	query, err := (&PetQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryTeam chains the current query on the team edge.
 func (pq *PetQuery) QueryTeam() *UserQuery {
 	query := &UserQuery{config: pq.config}
 	switch pq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := pq.sqlQuery()
-		t2.Select(t2.C(pet.TeamColumn))
-		query.sql = sql.Select(t1.Columns(user.Columns...)...).
-			From(t1).
-			Join(t2).
-			On(t1.C(user.FieldID), t2.C(pet.TeamColumn))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := pq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(pet.TeamColumn))
+			return sql.Select(t1.Columns(user.Columns...)...).
+				From(t1).
+				Join(t2).
+				On(t1.C(user.FieldID), t2.C(pet.TeamColumn)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := pq.gremlinQuery()
-		query.gremlin = gremlin.InE(user.TeamLabel).OutV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := pq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.InE(user.TeamLabel).OutV(), nil
+		}
 	}
 	return query
 }
@@ -84,20 +213,71 @@ func (pq *PetQuery) QueryOwner() *UserQuery {
 	query := &UserQuery{config: pq.config}
 	switch pq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := pq.sqlQuery()
-		t2.Select(t2.C(pet.OwnerColumn))
-		query.sql = sql.Select(t1.Columns(user.Columns...)...).
-			From(t1).
-			Join(t2).
-			On(t1.C(user.FieldID), t2.C(pet.OwnerColumn))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := pq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(pet.OwnerColumn))
+			return sql.Select(t1.Columns(user.Columns...)...).
+				From(t1).
+				Join(t2).
+				On(t1.C(user.FieldID), t2.C(pet.OwnerColumn)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := pq.gremlinQuery()
-		query.gremlin = gremlin.InE(user.PetsLabel).OutV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := pq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.InE(user.PetsLabel).OutV(), nil
+		}
 	}
 	return query
 }
 
+// WithTeam tells the query-builder to eager-load the nodes that are connected to the "team" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Pet.Query().
+//		WithTeam(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (pq *PetQuery) WithTeam(opts ...func(*UserQuery)) *PetQuery {
+	query := &UserQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withTeam = query
+	return pq
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to the "owner" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Pet.Query().
+//		WithOwner(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (pq *PetQuery) WithOwner(opts ...func(*UserQuery)) *PetQuery {
+	query := &UserQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withOwner = query
+	return pq
+}
+
 // First returns the first Pet entity in the query. Returns *ErrNotFound when no pet was found.
 func (pq *PetQuery) First(ctx context.Context) (*Pet, error) {
 	pes, err := pq.Limit(1).All(ctx)
@@ -143,7 +323,7 @@ func (pq *PetQuery) FirstXID(ctx context.Context) string {
 
 // Only returns the only Pet entity in the query, returns an error if not exactly one entity was returned.
 func (pq *PetQuery) Only(ctx context.Context) (*Pet, error) {
-	pes, err := pq.Limit(2).All(ctx)
+	pes, err := pq.Limit(2).Unordered().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +349,7 @@ func (pq *PetQuery) OnlyX(ctx context.Context) *Pet {
 // OnlyID returns the only Pet id in the query, returns an error if not exactly one id was returned.
 func (pq *PetQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = pq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = pq.Limit(2).Unordered().IDs(ctx); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -193,33 +373,63 @@ func (pq *PetQuery) OnlyXID(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of Pets.
-func (pq *PetQuery) All(ctx context.Context) ([]*Pet, error) {
-	switch pq.driver.Dialect() {
+func (pq *PetQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*Pet, error) {
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.shadow != nil && !ent.NewCallOptions(opts...).SkipHooks {
+		return query.dualAll(ctx)
+	}
+
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return pq.sqlAll(ctx)
+		return query.sqlAll(ctx)
 	case dialect.Gremlin:
-		return pq.gremlinAll(ctx)
+		return query.gremlinAll(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
 // AllX is like All, but panics if an error occurs.
-func (pq *PetQuery) AllX(ctx context.Context) []*Pet {
-	pes, err := pq.All(ctx)
+func (pq *PetQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*Pet {
+	pes, err := pq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return pes
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (pq *PetQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlQueryString(ctx)
+	case dialect.Gremlin:
+		return query.gremlinQueryString(ctx)
+	default:
+		return "", nil, errors.New("ent: unsupported dialect")
+	}
+}
+
 // IDs executes the query and returns a list of Pet ids.
 func (pq *PetQuery) IDs(ctx context.Context) ([]string, error) {
-	switch pq.driver.Dialect() {
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return pq.sqlIDs(ctx)
+		return query.sqlIDs(ctx)
 	case dialect.Gremlin:
-		return pq.gremlinIDs(ctx)
+		return query.gremlinIDs(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
@@ -234,13 +444,46 @@ func (pq *PetQuery) IDsX(ctx context.Context) []string {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Pets that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (pq *PetQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if pq.sql != nil || pq.gremlin != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &PetQuery{
+		config:     pq.config,
+		predicates: append([]predicate.Pet{}, pq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(string)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for Pet", after)
+		}
+		query.Where(pet.IDGT(id))
+	}
+	ids, err := query.Order(Asc(pet.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (pq *PetQuery) Count(ctx context.Context) (int, error) {
-	switch pq.driver.Dialect() {
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return pq.sqlCount(ctx)
+		return query.sqlCount(ctx)
 	case dialect.Gremlin:
-		return pq.gremlinCount(ctx)
+		return query.gremlinCount(ctx)
 	default:
 		return 0, errors.New("ent: unsupported dialect")
 	}
@@ -257,11 +500,15 @@ func (pq *PetQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (pq *PetQuery) Exist(ctx context.Context) (bool, error) {
-	switch pq.driver.Dialect() {
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return pq.sqlExist(ctx)
+		return query.sqlExist(ctx)
 	case dialect.Gremlin:
-		return pq.gremlinExist(ctx)
+		return query.gremlinExist(ctx)
 	default:
 		return false, errors.New("ent: unsupported dialect")
 	}
@@ -285,10 +532,18 @@ func (pq *PetQuery) Clone() *PetQuery {
 		offset:     pq.offset,
 		order:      append([]Order{}, pq.order...),
 		unique:     append([]string{}, pq.unique...),
+		fields:     append([]string{}, pq.fields...),
+		unordered:  pq.unordered,
+		hints:      append([]sql.Hint{}, pq.hints...),
+		edgeCount:  pq.edgeCount,
 		predicates: append([]predicate.Pet{}, pq.predicates...),
+		withTeam:   pq.withTeam,
+		withOwner:  pq.withOwner,
 		// clone intermediate queries.
-		sql:     pq.sql.Clone(),
-		gremlin: pq.gremlin.Clone(),
+		sql:         pq.sql.Clone(),
+		sqlPath:     pq.sqlPath,
+		gremlin:     pq.gremlin.Clone(),
+		gremlinPath: pq.gremlinPath,
 	}
 }
 
@@ -310,11 +565,18 @@ func (pq *PetQuery) Clone() *PetQuery {
 func (pq *PetQuery) GroupBy(field string, fields ...string) *PetGroupBy {
 	group := &PetGroupBy{config: pq.config}
 	group.fields = append([]string{field}, fields...)
-	switch pq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		group.sql = pq.sqlQuery()
-	case dialect.Gremlin:
-		group.gremlin = pq.gremlinQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := pq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			group.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			group.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return group
 }
@@ -334,20 +596,27 @@ func (pq *PetQuery) GroupBy(field string, fields ...string) *PetGroupBy {
 func (pq *PetQuery) Select(field string, fields ...string) *PetSelect {
 	selector := &PetSelect{config: pq.config}
 	selector.fields = append([]string{field}, fields...)
-	switch pq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		selector.sql = pq.sqlQuery()
-	case dialect.Gremlin:
-		selector.gremlin = pq.gremlinQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := pq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			selector.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			selector.gremlin = query.gremlinQuery()
+		}
+		return nil
 	}
 	return selector
 }
 
 func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
 	rows := &sql.Rows{}
-	selector := pq.sqlQuery()
-	if unique := pq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := pq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := pq.driver.Query(ctx, query, args, rows); err != nil {
@@ -355,21 +624,219 @@ func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
 	}
 	defer rows.Close()
 	var pes Pets
-	if err := pes.FromRows(rows); err != nil {
+	if err := pes.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	pes.config(pq.config)
+	if query := pq.withTeam; query != nil {
+		if err := pq.sqlLoadTeam(ctx, query, pes); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withOwner; query != nil {
+		if err := pq.sqlLoadOwner(ctx, query, pes); err != nil {
+			return nil, err
+		}
+	}
 	return pes, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (pq *PetQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := pq.sqlQuery(ctx)
+	if unique := pq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := pet.Columns
+	if fields := pq.fields; len(fields) > 0 {
+		columns = append([]string{pet.FieldID}, fields...)
+		for _, c := range fields {
+			if !pet.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (pq *PetQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := pq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadTeam loads the nodes of the team edge of the given Pets using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (pq *PetQuery) sqlLoadTeam(ctx context.Context, query *UserQuery, nodes []*Pet) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*Pet, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(pet.TeamTable)
+	selector.Join(t1).On(t1.C(pet.TeamColumn), selector.C(user.FieldID))
+	key := t1.C(pet.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := pq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Team = edge
+	}
+	return nil
+}
+
+// sqlLoadOwner loads the nodes of the owner edge of the given Pets using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (pq *PetQuery) sqlLoadOwner(ctx context.Context, query *UserQuery, nodes []*Pet) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*Pet, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(pet.OwnerTable)
+	selector.Join(t1).On(t1.C(pet.OwnerColumn), selector.C(user.FieldID))
+	key := t1.C(pet.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := pq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Owner = edge
+	}
+	return nil
+}
+
 func (pq *PetQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := pq.sqlQuery()
+	selector := pq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{pet.FieldID}
 	if len(pq.unique) > 0 {
 		unique = pq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := pq.edgeCount; c != nil && len(pq.predicates) == 0 && len(pq.unique) == 0 &&
+		len(pq.hints) == 0 && pq.limit == nil && pq.offset == nil {
+		selector = c.Clone().SetDialect(pq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := pq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -386,11 +853,22 @@ func (pq *PetQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (pq *PetQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := pq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := pq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(pet.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := pq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := pq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (pq *PetQuery) sqlIDs(ctx context.Context) ([]string, error) {
@@ -405,13 +883,33 @@ func (pq *PetQuery) sqlIDs(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
-func (pq *PetQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (pq *PetQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(pet.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (pq *PetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(pet.Table)
 	selector := sql.Select(t1.Columns(pet.Columns...)...).From(t1)
 	if pq.sql != nil {
 		selector = pq.sql
 		selector.Select(selector.Columns(pet.Columns...)...)
 	}
+	selector.SetDialect(pq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(pq.hints...)
 	for _, p := range pq.predicates {
 		p(selector)
 	}
@@ -431,8 +929,9 @@ func (pq *PetQuery) sqlQuery() *sql.Selector {
 
 func (pq *PetQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := pq.gremlinQuery().Query()
-	if err := pq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := pq.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := pq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	vertices, err := res.ReadVertices()
@@ -448,8 +947,9 @@ func (pq *PetQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 
 func (pq *PetQuery) gremlinAll(ctx context.Context) ([]*Pet, error) {
 	res := &gremlin.Response{}
-	query, bindings := pq.gremlinQuery().ValueMap(true).Query()
-	if err := pq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := pq.gremlinAllQuery()
+	query, _ := traversal.Query()
+	if err := pq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	var pes Pets
@@ -457,13 +957,82 @@ func (pq *PetQuery) gremlinAll(ctx context.Context) ([]*Pet, error) {
 		return nil, err
 	}
 	pes.config(pq.config)
+	if query := pq.withTeam; query != nil {
+		if err := pq.gremlinLoadTeam(ctx, query, pes); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withOwner; query != nil {
+		if err := pq.gremlinLoadOwner(ctx, query, pes); err != nil {
+			return nil, err
+		}
+	}
 	return pes, nil
 }
 
+// gremlinAllQuery returns the traversal of All, that returns the value maps of the vertices.
+func (pq *PetQuery) gremlinAllQuery() *dsl.Traversal {
+	args := []interface{}{true}
+	for _, f := range pq.fields {
+		args = append(args, f)
+	}
+	return pq.gremlinQuery().ValueMap(args...)
+}
+
+func (pq *PetQuery) gremlinQueryString(context.Context) (string, interface{}, error) {
+	query, bindings := pq.gremlinAllQuery().Query()
+	return query, bindings, nil
+}
+
+// gremlinLoadTeam loads the nodes of the team edge of the given Pets using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (pq *PetQuery) gremlinLoadTeam(ctx context.Context, query *UserQuery, nodes []*Pet) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&PetClient{config: pq.config}).QueryTeam(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Team = edges[0]
+		}
+	}
+	return nil
+}
+
+// gremlinLoadOwner loads the nodes of the owner edge of the given Pets using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (pq *PetQuery) gremlinLoadOwner(ctx context.Context, query *UserQuery, nodes []*Pet) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&PetClient{config: pq.config}).QueryOwner(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Owner = edges[0]
+		}
+	}
+	return nil
+}
+
 func (pq *PetQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := pq.gremlinQuery().Count().Query()
-	if err := pq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := pq.gremlinTraversal(false).Count()
+	query, _ := traversal.Query()
+	if err := pq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -471,14 +1040,22 @@ func (pq *PetQuery) gremlinCount(ctx context.Context) (int, error) {
 
 func (pq *PetQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
-	query, bindings := pq.gremlinQuery().HasNext().Query()
-	if err := pq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := pq.gremlinTraversal(false).HasNext()
+	query, _ := traversal.Query()
+	if err := pq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
 }
 
+// gremlinQuery returns the traversal of the query for listing its vertices, with its default orders.
 func (pq *PetQuery) gremlinQuery() *dsl.Traversal {
+	return pq.gremlinTraversal(true)
+}
+
+// gremlinTraversal returns the traversal of the query. The default orders are added only to the traversals
+// of listing queries (list), since they don't change the results of the others (e.g. Count and Exist).
+func (pq *PetQuery) gremlinTraversal(list bool) *dsl.Traversal {
 	v := g.V().HasLabel(pet.Label)
 	if pq.gremlin != nil {
 		v = pq.gremlin.Clone()
@@ -486,13 +1063,22 @@ func (pq *PetQuery) gremlinQuery() *dsl.Traversal {
 	for _, p := range pq.predicates {
 		p(v)
 	}
-	if len(pq.order) > 0 {
+	limit, offset := pq.limit, pq.offset
+	list = list && !pq.unordered
+	// paginated queries are ordered by id (also as a tie-breaker of the
+	// query orders), in order to keep the pages stable between calls.
+	stable := (limit != nil || offset != nil) && list
+	order := pq.order
+	if len(order) > 0 || stable {
 		v.Order()
-		for _, p := range pq.order {
+		for _, p := range order {
 			p(v)
 		}
+		if stable {
+			v.By(dsl.ID, dsl.Incr)
+		}
 	}
-	switch limit, offset := pq.limit, pq.offset; {
+	switch {
 	case limit != nil && offset != nil:
 		v.Range(*offset, *offset+*limit)
 	case offset != nil:
@@ -514,6 +1100,11 @@ type PetGroupBy struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving     *sql.Predicate
+	gremlinHaving *dsl.Traversal
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -522,8 +1113,27 @@ func (pgb *PetGroupBy) Aggregate(fns ...Aggregate) *PetGroupBy {
 	return pgb
 }
 
+// Having adds per-dialect predicates on the aggregated values of the groups.
+// In Gremlin, the predicate is applied using a `where` step on the values of each group.
+// For example:
+//
+//	client.Pet.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5), __.Select(ent.DefaultCountLabel).Is(p.GT(5))).
+//		Scan(ctx, &v)
+//
+func (pgb *PetGroupBy) Having(p0 *sql.Predicate, p1 *dsl.Traversal) *PetGroupBy {
+	pgb.sqlHaving = p0
+	pgb.gremlinHaving = p1
+	return pgb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (pgb *PetGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := pgb.path(ctx); err != nil {
+		return err
+	}
 	switch pgb.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return pgb.sqlScan(ctx, v)
@@ -626,6 +1236,9 @@ func (pgb *PetGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (pgb *PetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := pgb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := pgb.sqlQuery().Query()
 	if err := pgb.driver.Query(ctx, query, args, rows); err != nil {
@@ -642,13 +1255,18 @@ func (pgb *PetGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range pgb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(pgb.fields...)
+	selector.Select(columns...).GroupBy(pgb.fields...)
+	if pgb.sqlHaving != nil {
+		selector.Having(pgb.sqlHaving)
+	}
+	return selector
 }
 
 func (pgb *PetGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
-	query, bindings := pgb.gremlinQuery().Query()
-	if err := pgb.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := pgb.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := pgb.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(pgb.fields)+len(pgb.fns) == 1 {
@@ -675,11 +1293,14 @@ func (pgb *PetGroupBy) gremlinQuery() *dsl.Traversal {
 		names = append(names, f)
 		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
 	}
-	return pgb.gremlin.Group().
+	v := pgb.gremlin.Group().
 		By(__.Values(pgb.fields...).Fold()).
 		By(__.Fold().Match(trs...).Select(names...)).
-		Select(dsl.Values).
-		Next()
+		Select(dsl.Values)
+	if pgb.gremlinHaving != nil {
+		v = v.Unfold().Where(pgb.gremlinHaving).Fold()
+	}
+	return v.Next()
 }
 
 // PetSelect is the builder for select fields of Pet entities.
@@ -689,10 +1310,15 @@ type PetSelect struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (ps *PetSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ps.path(ctx); err != nil {
+		return err
+	}
 	switch ps.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ps.sqlScan(ctx, v)
@@ -795,6 +1421,9 @@ func (ps *PetSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (ps *PetSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ps.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ps.sqlQuery().Query()
 	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
@@ -823,8 +1452,8 @@ func (ps *PetSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		}
 		traversal = ps.gremlin.ValueMap(fields...)
 	}
-	query, bindings := traversal.Query()
-	if err := ps.driver.Exec(ctx, query, bindings, res); err != nil {
+	query, _ := traversal.Query()
+	if err := ps.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(ps.fields) == 1 {
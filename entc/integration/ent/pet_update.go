@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -22,17 +23,17 @@ import (
 	"github.com/facebookincubator/ent/entc/integration/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // PetUpdate is the builder for updating Pet entities.
 type PetUpdate struct {
 	config
-	name         *string
-	team         map[string]struct{}
-	owner        map[string]struct{}
-	clearedTeam  bool
-	clearedOwner bool
-	predicates   []predicate.Pet
+	petMutation
+	predicates []predicate.Pet
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Pet
 }
 
 // Where adds a new predicate for the builder.
@@ -41,6 +42,11 @@ func (pu *PetUpdate) Where(ps ...predicate.Pet) *PetUpdate {
 	return pu
 }
 
+// Mutation returns the mutation of the builder.
+func (pu *PetUpdate) Mutation() *PetMutation {
+	return &PetMutation{petMutation: &pu.petMutation, op: ent.OpUpdate}
+}
+
 // SetName sets the name field.
 func (pu *PetUpdate) SetName(s string) *PetUpdate {
 	pu.name = &s
@@ -104,12 +110,47 @@ func (pu *PetUpdate) ClearOwner() *PetUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (pu *PetUpdate) Save(ctx context.Context) (int, error) {
+func (pu *PetUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := pu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (pu *PetUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]string, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(pu.team) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"team\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"team\"")
 	}
 	if len(pu.owner) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"owner\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if pu.shadow != nil {
+		return pu.dualSave(ctx, opts...)
+	}
+	ids, err := pu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 && !options.SkipHooks {
+		pu.bus.publish(&Event{Op: ent.OpUpdate, Type: pet.Label, N: len(ids)})
+	}
+	return ids, nil
+}
+
+// save updates the Pet nodes in the storage of the client dialect.
+func (pu *PetUpdate) save(ctx context.Context) ([]string, error) {
+	predicates, err := interceptPet(ctx, pu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *pu
+		intercepted.predicates = append(predicates, pu.predicates...)
+		pu = &intercepted
 	}
 	switch pu.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
@@ -117,13 +158,26 @@ func (pu *PetUpdate) Save(ctx context.Context) (int, error) {
 	case dialect.Gremlin:
 		return pu.gremlinSave(ctx)
 	default:
-		return 0, errors.New("ent: unsupported dialect")
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (pu *PetUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Pet, error) {
+	var pes []*Pet
+	pu.returning = &pes
+	defer func() { pu.returning = nil }()
+	if _, err := pu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
 	}
+	return pes, nil
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (pu *PetUpdate) SaveX(ctx context.Context) int {
-	affected, err := pu.Save(ctx)
+func (pu *PetUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := pu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -131,44 +185,49 @@ func (pu *PetUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (pu *PetUpdate) Exec(ctx context.Context) error {
-	_, err := pu.Save(ctx)
+func (pu *PetUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := pu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pu *PetUpdate) ExecX(ctx context.Context) {
-	if err := pu.Exec(ctx); err != nil {
+func (pu *PetUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := pu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(pet.FieldID).From(sql.Table(pet.Table))
+func (pu *PetUpdate) sqlSave(ctx context.Context) (_ []string, err error) {
+	ctx = entcache.Invalidates(ctx, pet.Tables...)
+	selector := sql.Select(pet.FieldID).From(sql.Table(pet.Table)).SetDialect(pu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range pu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = pu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := pu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -180,50 +239,60 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if pu.clearedTeam {
+	if pu.clearedTeam && len(pu.team) == 0 {
 		query, args := sql.Update(pet.TeamTable).
 			SetNull(pet.TeamColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(pu.team) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "team", pu.team); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for _, id := range ids {
 			eid, serr := strconv.Atoi(keys(pu.team)[0])
 			if serr != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
+			}
+			p := sql.EQ(pet.FieldID, id)
+			if !pu.clearedTeam {
+				p = p.And().IsNull(pet.TeamColumn)
 			}
 			query, args := sql.Update(pet.TeamTable).
 				Set(pet.TeamColumn, eid).
-				Where(sql.EQ(pet.FieldID, id).And().IsNull(pet.TeamColumn)).
+				Where(p).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(pu.team) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"team\" %v already connected to a different \"Pet\"", keys(pu.team))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"team\" %v already connected to a different \"Pet\"", keys(pu.team))})
 			}
 		}
 	}
-	if pu.clearedOwner {
+	if pu.clearedOwner && len(pu.owner) == 0 {
 		query, args := sql.Update(pet.OwnerTable).
 			SetNull(pet.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(pu.owner) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "owner", pu.owner); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for eid := range pu.owner {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -235,26 +304,71 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.InInts(pet.FieldID, ids...)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 		}
 	}
+	if pu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(pet.Columns...).
+			From(sql.Table(pet.Table)).
+			Where(sql.InInts(pet.FieldID, ids...)).
+			SetDialect(pu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var pes Pets
+		err := pes.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Pet: %v", err))
+		}
+		pes.config(pu.config)
+		*pu.returning = pes
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
+	}
+	vs := make([]string, len(ids))
+	for i, id := range ids {
+		vs[i] = strconv.Itoa(id)
 	}
-	return len(ids), nil
+	return vs, nil
 }
 
-func (pu *PetUpdate) gremlinSave(ctx context.Context) (int, error) {
+func (pu *PetUpdate) gremlinSave(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := pu.gremlin().Query()
-	if err := pu.driver.Exec(ctx, query, bindings, res); err != nil {
-		return 0, err
+	traversal := pu.gremlin()
+	query, _ := traversal.Query()
+	if err := pu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
-		return 0, err
+		return nil, err
+	}
+	if pu.returning != nil {
+		var pes Pets
+		if err := pes.FromResponse(res); err != nil {
+			return nil, err
+		}
+		pes.config(pu.config)
+		*pu.returning = pes
+		ids := make([]string, 0, len(pes))
+		for _, pe := range pes {
+			ids = append(ids, pe.ID)
+		}
+		return ids, nil
+	}
+	vertices, err := res.ReadVertices()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vertices))
+	for _, vertex := range vertices {
+		ids = append(ids, vertex.ID.(string))
 	}
-	return res.ReadInt()
+	return ids, nil
 }
 
 func (pu *PetUpdate) gremlin() *dsl.Traversal {
@@ -294,11 +408,13 @@ func (pu *PetUpdate) gremlin() *dsl.Traversal {
 	for id := range pu.owner {
 		v.AddE(user.PetsLabel).From(g.V(id)).InV()
 	}
-	v.Count()
+	if pu.returning != nil {
+		v.ValueMap(true)
+	}
 	if len(constraints) > 0 {
 		constraints = append(constraints, &constraint{
 			pred: rv.Count(),
-			test: __.Is(p.GT(1)).Constant(&ErrConstraintFailed{msg: "update traversal contains more than one vertex"}),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
 		})
 		v = constraints[0].pred.Coalesce(constraints[0].test, v)
 		for _, cr := range constraints[1:] {
@@ -312,12 +428,16 @@ func (pu *PetUpdate) gremlin() *dsl.Traversal {
 // PetUpdateOne is the builder for updating a single Pet entity.
 type PetUpdateOne struct {
 	config
-	id           string
-	name         *string
-	team         map[string]struct{}
-	owner        map[string]struct{}
-	clearedTeam  bool
-	clearedOwner bool
+	id     string
+	entity *Pet
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Pet
+	petMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (puo *PetUpdateOne) Mutation() *PetMutation {
+	return &PetMutation{petMutation: &puo.petMutation, op: ent.OpUpdateOne, id: &puo.id}
 }
 
 // SetName sets the name field.
@@ -382,14 +502,60 @@ func (puo *PetUpdateOne) ClearOwner() *PetUpdateOne {
 	return puo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (puo *PetUpdateOne) SetChangedFrom(original *Pet) *PetUpdateOne {
+	pe := puo.entity
+	if pe == nil || original == nil {
+		return puo
+	}
+	for _, change := range DiffPet(original, pe) {
+		switch change.Field {
+		case pet.FieldName:
+			puo.SetName(pe.Name)
+		}
+	}
+	return puo
+}
+
 // Save executes the query and returns the updated entity.
-func (puo *PetUpdateOne) Save(ctx context.Context) (*Pet, error) {
+func (puo *PetUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Pet, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(puo.team) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"team\"")
 	}
 	if len(puo.owner) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if puo.shadow != nil {
+		return puo.dualSave(ctx, opts...)
+	}
+	pe, err := puo.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		puo.bus.publish(&Event{Op: ent.OpUpdateOne, Type: pet.Label, ID: pe.ID, Node: pe, N: 1})
+	}
+	return pe, nil
+}
+
+// save updates the Pet in the storage of the client dialect.
+func (puo *PetUpdateOne) save(ctx context.Context) (*Pet, error) {
+	predicates, err := interceptPet(ctx, puo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *puo
+		intercepted.predicates = append(predicates, puo.predicates...)
+		puo = &intercepted
+	}
 	switch puo.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return puo.sqlSave(ctx)
@@ -401,8 +567,8 @@ func (puo *PetUpdateOne) Save(ctx context.Context) (*Pet, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (puo *PetUpdateOne) SaveX(ctx context.Context) *Pet {
-	pe, err := puo.Save(ctx)
+func (puo *PetUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Pet {
+	pe, err := puo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -410,21 +576,29 @@ func (puo *PetUpdateOne) SaveX(ctx context.Context) *Pet {
 }
 
 // Exec executes the query on the entity.
-func (puo *PetUpdateOne) Exec(ctx context.Context) error {
-	_, err := puo.Save(ctx)
+func (puo *PetUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := puo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (puo *PetUpdateOne) ExecX(ctx context.Context) {
-	if err := puo.Exec(ctx); err != nil {
+func (puo *PetUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := puo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
-	selector := sql.Select(pet.Columns...).From(sql.Table(pet.Table))
+	ctx = entcache.Invalidates(ctx, pet.Tables...)
+	selector := sql.Select(pet.Columns...).From(sql.Table(pet.Table)).SetDialect(puo.driver.Dialect())
 	pet.ID(puo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range puo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = puo.driver.Query(ctx, query, args, rows); err != nil {
@@ -466,7 +640,7 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if puo.clearedTeam {
+	if puo.clearedTeam && len(puo.team) == 0 {
 		query, args := sql.Update(pet.TeamTable).
 			SetNull(pet.TeamColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
@@ -476,14 +650,21 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
 		}
 	}
 	if len(puo.team) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "team", puo.team); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for _, id := range ids {
 			eid, serr := strconv.Atoi(keys(puo.team)[0])
 			if serr != nil {
 				return nil, rollback(tx, err)
 			}
+			p := sql.EQ(pet.FieldID, id)
+			if !puo.clearedTeam {
+				p = p.And().IsNull(pet.TeamColumn)
+			}
 			query, args := sql.Update(pet.TeamTable).
 				Set(pet.TeamColumn, eid).
-				Where(sql.EQ(pet.FieldID, id).And().IsNull(pet.TeamColumn)).
+				Where(p).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
 				return nil, rollback(tx, err)
@@ -493,11 +674,11 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(puo.team) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"team\" %v already connected to a different \"Pet\"", keys(puo.team))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"team\" %v already connected to a different \"Pet\"", keys(puo.team))})
 			}
 		}
 	}
-	if puo.clearedOwner {
+	if puo.clearedOwner && len(puo.owner) == 0 {
 		query, args := sql.Update(pet.OwnerTable).
 			SetNull(pet.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
@@ -507,6 +688,9 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
 		}
 	}
 	if len(puo.owner) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "owner", puo.owner); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for eid := range puo.owner {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -530,8 +714,9 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
 
 func (puo *PetUpdateOne) gremlinSave(ctx context.Context) (*Pet, error) {
 	res := &gremlin.Response{}
-	query, bindings := puo.gremlin(puo.id).Query()
-	if err := puo.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := puo.gremlin(puo.id)
+	query, _ := traversal.Query()
+	if err := puo.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -551,6 +736,9 @@ func (puo *PetUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 1)
 	v := g.V(id)
+	for _, p := range puo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
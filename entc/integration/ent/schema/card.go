@@ -6,6 +6,7 @@ package schema
 
 import (
 	"log"
+	"strings"
 	"time"
 
 	"github.com/facebookincubator/ent"
@@ -47,6 +48,11 @@ func (Card) Mixin() []ent.Mixin {
 func (Card) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("number").
+			Comment("field with multiple transformers").
+			Transform(strings.TrimSpace).
+			Transform(func(s string) string {
+				return strings.Replace(s, "-", "", -1)
+			}).
 			NotEmpty(),
 	}
 }
@@ -60,3 +66,14 @@ func (Card) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Config of the Card.
+func (Card) Config() ent.Config {
+	return ent.Config{
+		Order: []ent.OrderField{
+			{Field: "number", Desc: true},
+		},
+		// keep the foreign key next to the card number.
+		ColumnOrder: []string{"id", "number", "owner_id"},
+	}
+}
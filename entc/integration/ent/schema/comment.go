@@ -5,6 +5,8 @@
 package schema
 
 import (
+	"errors"
+
 	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/schema/field"
 )
@@ -31,3 +33,17 @@ func (Comment) Fields() []ent.Field {
 func (Comment) Edges() []ent.Edge {
 	return nil
 }
+
+// Validators of the Comment.
+func (Comment) Validators() []ent.Validator {
+	return []ent.Validator{
+		func(m ent.Mutation) error {
+			u, ok1 := m.Field("unique_int")
+			n, ok2 := m.Field("nillable_int")
+			if ok1 && ok2 && n.(int) > u.(int) {
+				return errors.New("nillable_int must not be greater than unique_int")
+			}
+			return nil
+		},
+	}
+}
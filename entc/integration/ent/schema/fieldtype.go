@@ -5,8 +5,14 @@
 package schema
 
 import (
+	"database/sql/driver"
+	"fmt"
+	"net/url"
+
 	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/schema/field"
+
+	"github.com/google/uuid"
 )
 
 // FieldType holds the schema definition for the FieldType entity.
@@ -39,5 +45,122 @@ func (FieldType) Fields() []ent.Field {
 		field.Enum("state").
 			Values("on", "off").
 			Optional(),
+		field.String("link").
+			GoType(&Link{}).
+			Optional(),
+		field.String("null_link").
+			GoType(&Link{}).
+			Optional().
+			Nillable(),
+		field.Int("priority").
+			GoType(Priority(0)).
+			Optional(),
+		field.Enum("role").
+			GoType(RoleUser).
+			Optional(),
+		field.Int("nullable_int").
+			NillableStorage(),
+		field.String("nullable_string").
+			Default("default").
+			NillableStorage(),
+		field.UUID("uuid").
+			Default(uuid.New).
+			Optional(),
+		field.IP("ip").
+			Optional(),
+		field.MAC("mac").
+			Optional().
+			Nillable(),
 	}
 }
+
+// Link is a custom Go type for URL fields.
+type Link struct {
+	*url.URL
+}
+
+// Scan implements the sql.Scanner interface.
+func (l *Link) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case nil:
+	case []byte:
+		l.URL, err = url.Parse(string(v))
+	case string:
+		l.URL, err = url.Parse(v)
+	default:
+		err = fmt.Errorf("unexpected type %T", v)
+	}
+	return
+}
+
+// Value implements the driver.Valuer interface.
+func (l Link) Value() (driver.Value, error) {
+	if l.URL == nil {
+		return nil, nil
+	}
+	return l.String(), nil
+}
+
+// Priority is a custom Go type for integer fields.
+type Priority int
+
+// Scan implements the sql.Scanner interface.
+func (p *Priority) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*p = 0
+	case int64:
+		*p = Priority(v)
+	default:
+		return fmt.Errorf("unexpected type %T", v)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (p Priority) Value() (driver.Value, error) {
+	return int64(p), nil
+}
+
+// Role is a custom Go type for enum fields that is stored as an integer.
+type Role int
+
+// Role values.
+const (
+	RoleUser Role = iota
+	RoleAdmin
+	RoleOwner
+)
+
+var roles = [...]string{"user", "admin", "owner"}
+
+// String implements the fmt.Stringer interface.
+func (r Role) String() string {
+	if r < 0 || int(r) >= len(roles) {
+		return fmt.Sprintf("Role(%d)", r)
+	}
+	return roles[r]
+}
+
+// EnumValues implements the field.EnumValues interface.
+func (Role) EnumValues() []string {
+	return roles[:]
+}
+
+// Scan implements the sql.Scanner interface.
+func (r *Role) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*r = RoleUser
+	case int64:
+		*r = Role(v)
+	default:
+		return fmt.Errorf("unexpected type %T", v)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (r Role) Value() (driver.Value, error) {
+	return int64(r), nil
+}
@@ -58,5 +58,9 @@ func (File) Indexes() []ent.Index {
 		index.Fields("name").
 			Edges("owner", "type").
 			Unique(),
+		// index on the name prefix of the files in the group (MySQL only).
+		index.Fields("group", "name").
+			Type("BTREE").
+			Prefix("name", 10),
 	}
 }
@@ -26,6 +26,7 @@ func (FileType) Fields() []ent.Field {
 // Edges of the FileType.
 func (FileType) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("files", File.Type),
+		edge.To("files", File.Type).
+			StorageKey(edge.Column("file_type_id")),
 	}
 }
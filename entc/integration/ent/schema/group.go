@@ -54,3 +54,17 @@ func (Group) Edges() []ent.Edge {
 		edge.To("info", GroupInfo.Type).Unique().Required(),
 	}
 }
+
+// Validators of the group.
+func (Group) Validators() []ent.Validator {
+	return []ent.Validator{
+		func(m ent.Mutation) error {
+			typ, ok1 := m.Field("type")
+			max, ok2 := m.Field("max_users")
+			if ok1 && ok2 && typ.(string) == "private" && max.(int) > 100 {
+				return errors.New("private groups are limited to 100 users")
+			}
+			return nil
+		},
+	}
+}
@@ -1,6 +1,9 @@
 package schema
 
-import "github.com/facebookincubator/ent"
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/field"
+)
 
 // Item holds the schema definition for the Item entity.
 type Item struct {
@@ -9,7 +12,21 @@ type Item struct {
 
 // Fields of the Item.
 func (Item) Fields() []ent.Field {
-	return nil
+	return []ent.Field{
+		// fields that collide with generated names.
+		field.String("update").
+			Optional(),
+		field.String("label").
+			Optional(),
+		// fields that are named as Go keywords.
+		field.Enum("type").
+			Values("a", "b").
+			Optional(),
+		field.String("func").
+			Optional().
+			MinLen(1).
+			MaxLen(10),
+	}
 }
 
 // Edges of the Item.
@@ -30,6 +30,9 @@ func (User) Fields() []ent.Field {
 		field.String("phone").
 			Optional().
 			Unique(),
+		field.String("password").
+			Optional().
+			Sensitive(),
 	}
 }
 
@@ -39,7 +42,8 @@ func (User) Edges() []ent.Edge {
 		edge.To("card", Card.Type).Comment("O2O edge").Unique(),
 		edge.To("pets", Pet.Type),
 		edge.To("files", File.Type),
-		edge.To("groups", Group.Type),
+		edge.To("groups", Group.Type).
+			StorageKey(edge.Table("group_members"), edge.Columns("member_id", "group_id"), edge.ReverseIndex()),
 		edge.To("friends", User.Type),
 		edge.To("following", User.Type).From("followers"),
 		edge.To("team", Pet.Type).Unique(),
@@ -47,3 +51,13 @@ func (User) Edges() []ent.Edge {
 		edge.To("parent", User.Type).Unique().From("children"),
 	}
 }
+
+// Config of the user.
+func (User) Config() ent.Config {
+	return ent.Config{
+		Scopes: []ent.Scope{
+			{Name: "adults", Where: map[string]interface{}{"age.gte": 18}},
+			{Name: "oldest", Order: []ent.OrderField{{Field: "age", Desc: true}}, Limit: 1},
+		},
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/ent/user"
 )
 
 // User is the model entity for the User schema.
@@ -30,10 +31,47 @@ type User struct {
 	Nickname string `json:"nickname,omitempty"`
 	// Phone holds the value of the "phone" field.
 	Phone string `json:"phone,omitempty"`
+	// Password holds the value of the "password" field.
+	Password string `json:"-"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges UserEdges `json:"edges"`
+}
+
+// UserEdges holds the relations/edges of other nodes in the graph.
+type UserEdges struct {
+	// Card holds the value of the card edge.
+	Card *Card `json:"card,omitempty"`
+	// Pets holds the value of the pets edge.
+	Pets []*Pet `json:"pets,omitempty"`
+	// Files holds the value of the files edge.
+	Files []*File `json:"files,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
+	// Friends holds the value of the friends edge.
+	Friends []*User `json:"friends,omitempty"`
+	// Followers holds the value of the followers edge.
+	Followers []*User `json:"followers,omitempty"`
+	// Following holds the value of the following edge.
+	Following []*User `json:"following,omitempty"`
+	// Team holds the value of the team edge.
+	Team *Pet `json:"team,omitempty"`
+	// Spouse holds the value of the spouse edge.
+	Spouse *User `json:"spouse,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*User `json:"children,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *User `json:"parent,omitempty"`
 }
 
 // FromRows scans the sql response data into User.
 func (u *User) FromRows(rows *sql.Rows) error {
+	return u.scan(rows, user.Columns)
+}
+
+// scan scans the given columns of the sql response data into User.
+// Fields that their columns were not selected are left with their zero values.
+func (u *User) scan(rows *sql.Rows, columns []string) error {
 	var vu struct {
 		ID       int
 		Age      sql.NullInt64
@@ -41,16 +79,30 @@ func (u *User) FromRows(rows *sql.Rows) error {
 		Last     sql.NullString
 		Nickname sql.NullString
 		Phone    sql.NullString
+		Password sql.NullString
 	}
-	// the order here should be the same as in the `user.Columns`.
-	if err := rows.Scan(
-		&vu.ID,
-		&vu.Age,
-		&vu.Name,
-		&vu.Last,
-		&vu.Nickname,
-		&vu.Phone,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			values[i] = &vu.ID
+		case user.FieldAge:
+			values[i] = &vu.Age
+		case user.FieldName:
+			values[i] = &vu.Name
+		case user.FieldLast:
+			values[i] = &vu.Last
+		case user.FieldNickname:
+			values[i] = &vu.Nickname
+		case user.FieldPhone:
+			values[i] = &vu.Phone
+		case user.FieldPassword:
+			values[i] = &vu.Password
+		default:
+			return fmt.Errorf("unexpected column %q for type User", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	u.ID = strconv.Itoa(vu.ID)
@@ -59,6 +111,7 @@ func (u *User) FromRows(rows *sql.Rows) error {
 	u.Last = vu.Last.String
 	u.Nickname = vu.Nickname.String
 	u.Phone = vu.Phone.String
+	u.Password = vu.Password.String
 	return nil
 }
 
@@ -75,6 +128,7 @@ func (u *User) FromResponse(res *gremlin.Response) error {
 		Last     string `json:"last,omitempty"`
 		Nickname string `json:"nickname,omitempty"`
 		Phone    string `json:"phone,omitempty"`
+		Password string `json:"password,omitempty"`
 	}
 	if err := vmap.Decode(&vu); err != nil {
 		return err
@@ -85,6 +139,7 @@ func (u *User) FromResponse(res *gremlin.Response) error {
 	u.Last = vu.Last
 	u.Nickname = vu.Nickname
 	u.Phone = vu.Phone
+	u.Password = vu.Password
 	return nil
 }
 
@@ -161,6 +216,75 @@ func (u *User) Unwrap() *User {
 	return u
 }
 
+// Clone returns a deep copy of the User, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (u *User) Clone() *User {
+	return u.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the User, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (u *User) clone(seen map[interface{}]interface{}) *User {
+	if u == nil {
+		return nil
+	}
+	if _c, ok := seen[u]; ok {
+		return _c.(*User)
+	}
+	_c := *u
+	_c.config = config{}
+	seen[u] = &_c
+	_c.Edges.Card = u.Edges.Card.clone(seen)
+	if nodes := u.Edges.Pets; nodes != nil {
+		_c.Edges.Pets = make([]*Pet, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Pets[_i] = _n.clone(seen)
+		}
+	}
+	if nodes := u.Edges.Files; nodes != nil {
+		_c.Edges.Files = make([]*File, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Files[_i] = _n.clone(seen)
+		}
+	}
+	if nodes := u.Edges.Groups; nodes != nil {
+		_c.Edges.Groups = make([]*Group, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Groups[_i] = _n.clone(seen)
+		}
+	}
+	if nodes := u.Edges.Friends; nodes != nil {
+		_c.Edges.Friends = make([]*User, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Friends[_i] = _n.clone(seen)
+		}
+	}
+	if nodes := u.Edges.Followers; nodes != nil {
+		_c.Edges.Followers = make([]*User, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Followers[_i] = _n.clone(seen)
+		}
+	}
+	if nodes := u.Edges.Following; nodes != nil {
+		_c.Edges.Following = make([]*User, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Following[_i] = _n.clone(seen)
+		}
+	}
+	_c.Edges.Team = u.Edges.Team.clone(seen)
+	_c.Edges.Spouse = u.Edges.Spouse.clone(seen)
+	if nodes := u.Edges.Children; nodes != nil {
+		_c.Edges.Children = make([]*User, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Children[_i] = _n.clone(seen)
+		}
+	}
+	_c.Edges.Parent = u.Edges.Parent.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (u *User) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -175,6 +299,32 @@ func (u *User) String() string {
 	return buf.String()
 }
 
+// DiffUser returns the fields that have different values in the given Users,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffUser(a, b *User) []FieldChange {
+	var changes []FieldChange
+	if a.Age != b.Age {
+		changes = append(changes, FieldChange{Field: user.FieldAge, Old: a.Age, New: b.Age})
+	}
+	if a.Name != b.Name {
+		changes = append(changes, FieldChange{Field: user.FieldName, Old: a.Name, New: b.Name})
+	}
+	if a.Last != b.Last {
+		changes = append(changes, FieldChange{Field: user.FieldLast, Old: a.Last, New: b.Last})
+	}
+	if a.Nickname != b.Nickname {
+		changes = append(changes, FieldChange{Field: user.FieldNickname, Old: a.Nickname, New: b.Nickname})
+	}
+	if a.Phone != b.Phone {
+		changes = append(changes, FieldChange{Field: user.FieldPhone, Old: a.Phone, New: b.Phone})
+	}
+	if a.Password != b.Password {
+		changes = append(changes, FieldChange{Field: user.FieldPassword, Old: Redacted, New: Redacted})
+	}
+	return changes
+}
+
 // id returns the int representation of the ID field.
 func (u *User) id() int {
 	id, _ := strconv.Atoi(u.ID)
@@ -186,9 +336,14 @@ type Users []*User
 
 // FromRows scans the sql response data into Users.
 func (u *Users) FromRows(rows *sql.Rows) error {
+	return u.scan(rows, user.Columns)
+}
+
+// scan scans the given columns of the sql response data into Users.
+func (u *Users) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vu := &User{}
-		if err := vu.FromRows(rows); err != nil {
+		if err := vu.scan(rows, columns); err != nil {
 			return err
 		}
 		*u = append(*u, vu)
@@ -209,6 +364,7 @@ func (u *Users) FromResponse(res *gremlin.Response) error {
 		Last     string `json:"last,omitempty"`
 		Nickname string `json:"nickname,omitempty"`
 		Phone    string `json:"phone,omitempty"`
+		Password string `json:"password,omitempty"`
 	}
 	if err := vmap.Decode(&vu); err != nil {
 		return err
@@ -221,6 +377,7 @@ func (u *Users) FromResponse(res *gremlin.Response) error {
 			Last:     v.Last,
 			Nickname: v.Nickname,
 			Phone:    v.Phone,
+			Password: v.Password,
 		})
 	}
 	return nil
@@ -7,7 +7,12 @@
 package user
 
 import (
+	"fmt"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
 )
 
 const (
@@ -25,6 +30,8 @@ const (
 	FieldNickname = "nickname"
 	// FieldPhone holds the string denoting the phone vertex property in the database.
 	FieldPhone = "phone"
+	// FieldPassword holds the string denoting the password vertex property in the database.
+	FieldPassword = "password"
 
 	// Table holds the table name of the user in the database.
 	Table = "users"
@@ -50,7 +57,7 @@ const (
 	// FilesColumn is the table column denoting the files relation/edge.
 	FilesColumn = "owner_id"
 	// GroupsTable is the table the holds the groups relation/edge. The primary key declared below.
-	GroupsTable = "user_groups"
+	GroupsTable = "group_members"
 	// GroupsInverseTable is the table name for the Group entity.
 	// It exists in this package in order to avoid circular dependency with the "group" package.
 	GroupsInverseTable = "groups"
@@ -112,12 +119,35 @@ var Columns = []string{
 	FieldLast,
 	FieldNickname,
 	FieldPhone,
+	FieldPassword,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Tables holds the tables that may be changed by the mutations of the user. The mutations
+// invalidate only the query results of these tables that were cached by the entcache driver.
+var Tables = []string{
+	"users",
+	"cards",
+	"pets",
+	"files",
+	"group_members",
+	"user_friends",
+	"user_following",
 }
 
 var (
 	// GroupsPrimaryKey and GroupsColumn2 are the table columns denoting the
 	// primary key for the groups relation (M2M).
-	GroupsPrimaryKey = []string{"user_id", "group_id"}
+	GroupsPrimaryKey = []string{"member_id", "group_id"}
 	// FriendsPrimaryKey and FriendsColumn2 are the table columns denoting the
 	// primary key for the friends relation (M2M).
 	FriendsPrimaryKey = []string{"user_id", "friend_id"}
@@ -137,3 +167,207 @@ var (
 	// DefaultLast holds the default value on creation for the last field.
 	DefaultLast = descLast.Default.(string)
 )
+
+// Scope is a reusable named scope of the User queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.User
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// scopes holds the predicates of the scopes that are declared in the schema.
+var scopes = func() []predicate.User {
+	scopes := schema.User{}.Config().Scopes
+	predicates := make([]predicate.User, len(scopes))
+	for i, s := range scopes {
+		if len(s.Where) == 0 {
+			continue
+		}
+		p, err := FilterMap(s.Where)
+		if err != nil {
+			panic(fmt.Sprintf("user: invalid predicates of scope %q: %v", s.Name, err))
+		}
+		predicates[i] = p
+	}
+	return predicates
+}()
+
+// Adults returns the "adults" scope of the User queries, as declared in the schema.
+func Adults() Scope {
+	return Scope{
+		Predicates: []predicate.User{scopes[0]},
+	}
+}
+
+// Oldest returns the "oldest" scope of the User queries, as declared in the schema.
+func Oldest() Scope {
+	return Scope{
+		Order: []ent.OrderField{
+			{Field: FieldAge, Desc: true},
+		},
+		Limit: 1,
+	}
+}
+
+// descriptor holds the runtime descriptor of the User type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "User",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeString,
+		GoType:     "string",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "age",
+			StorageKey: FieldAge,
+			Type:       field.TypeInt,
+			GoType:     "int",
+		},
+		{
+			Name:       "name",
+			StorageKey: FieldName,
+			Type:       field.TypeString,
+			GoType:     "string",
+		},
+		{
+			Name:       "last",
+			StorageKey: FieldLast,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Default:    true,
+		},
+		{
+			Name:       "nickname",
+			StorageKey: FieldNickname,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Unique:     true,
+			Optional:   true,
+		},
+		{
+			Name:       "phone",
+			StorageKey: FieldPhone,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Unique:     true,
+			Optional:   true,
+		},
+		{
+			Name:       "password",
+			StorageKey: FieldPassword,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+			Sensitive:  true,
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "card",
+			Type:     "Card",
+			Rel:      "O2O",
+			Unique:   true,
+			Optional: true,
+			Table:    "cards",
+			Columns:  []string{"owner_id"},
+		},
+		{
+			Name:     "pets",
+			Type:     "Pet",
+			Rel:      "O2M",
+			Optional: true,
+			Table:    "pets",
+			Columns:  []string{"owner_id"},
+		},
+		{
+			Name:     "files",
+			Type:     "File",
+			Rel:      "O2M",
+			Optional: true,
+			Table:    "files",
+			Columns:  []string{"owner_id"},
+		},
+		{
+			Name:     "groups",
+			Type:     "Group",
+			Rel:      "M2M",
+			Optional: true,
+			Table:    "group_members",
+			Columns:  []string{"member_id", "group_id"},
+		},
+		{
+			Name:     "friends",
+			Type:     "User",
+			Rel:      "M2M",
+			Optional: true,
+			Table:    "user_friends",
+			Columns:  []string{"user_id", "friend_id"},
+		},
+		{
+			Name:     "followers",
+			Type:     "User",
+			Rel:      "M2M",
+			Optional: true,
+			Inverse:  true,
+			Table:    "user_following",
+			Columns:  []string{"user_id", "follower_id"},
+		},
+		{
+			Name:     "following",
+			Type:     "User",
+			Rel:      "M2M",
+			Optional: true,
+			Table:    "user_following",
+			Columns:  []string{"user_id", "follower_id"},
+		},
+		{
+			Name:     "team",
+			Type:     "Pet",
+			Rel:      "O2O",
+			Unique:   true,
+			Optional: true,
+			Table:    "pets",
+			Columns:  []string{"team_id"},
+		},
+		{
+			Name:     "spouse",
+			Type:     "User",
+			Rel:      "O2O",
+			Unique:   true,
+			Optional: true,
+			Table:    "users",
+			Columns:  []string{"user_spouse_id"},
+		},
+		{
+			Name:     "children",
+			Type:     "User",
+			Rel:      "O2M",
+			Optional: true,
+			Inverse:  true,
+			Table:    "users",
+			Columns:  []string{"parent_id"},
+		},
+		{
+			Name:     "parent",
+			Type:     "User",
+			Rel:      "M2O",
+			Unique:   true,
+			Optional: true,
+			Table:    "users",
+			Columns:  []string{"parent_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the User type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
@@ -7,7 +7,11 @@
 package user
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
@@ -219,6 +223,18 @@ func Phone(v string) predicate.User {
 	)
 }
 
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v string) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldPassword), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPassword, p.EQ(v))
+		},
+	)
+}
+
 // AgeEQ applies the EQ predicate on the "age" field.
 func AgeEQ(v int) predicate.User {
 	return predicate.UserPerDialect(
@@ -487,6 +503,30 @@ func NameHasSuffix(v string) predicate.User {
 	)
 }
 
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldName), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldName, p.EqualFold(v))
+		},
+	)
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldName), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldName, p.ContainsFold(v))
+		},
+	)
+}
+
 // LastEQ applies the EQ predicate on the "last" field.
 func LastEQ(v string) predicate.User {
 	return predicate.UserPerDialect(
@@ -639,6 +679,30 @@ func LastHasSuffix(v string) predicate.User {
 	)
 }
 
+// LastEqualFold applies the EqualFold predicate on the "last" field.
+func LastEqualFold(v string) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldLast), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLast, p.EqualFold(v))
+		},
+	)
+}
+
+// LastContainsFold applies the ContainsFold predicate on the "last" field.
+func LastContainsFold(v string) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldLast), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldLast, p.ContainsFold(v))
+		},
+	)
+}
+
 // NicknameEQ applies the EQ predicate on the "nickname" field.
 func NicknameEQ(v string) predicate.User {
 	return predicate.UserPerDialect(
@@ -815,6 +879,30 @@ func NicknameNotNil() predicate.User {
 	)
 }
 
+// NicknameEqualFold applies the EqualFold predicate on the "nickname" field.
+func NicknameEqualFold(v string) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldNickname), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNickname, p.EqualFold(v))
+		},
+	)
+}
+
+// NicknameContainsFold applies the ContainsFold predicate on the "nickname" field.
+func NicknameContainsFold(v string) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldNickname), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldNickname, p.ContainsFold(v))
+		},
+	)
+}
+
 // PhoneEQ applies the EQ predicate on the "phone" field.
 func PhoneEQ(v string) predicate.User {
 	return predicate.UserPerDialect(
@@ -991,306 +1079,683 @@ func PhoneNotNil() predicate.User {
 	)
 }
 
-// HasCard applies the HasEdge predicate on the "card" edge.
-func HasCard() predicate.User {
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			s.Where(
-				sql.In(
-					t1.C(FieldID),
-					sql.Select(CardColumn).
-						From(sql.Table(CardTable)).
-						Where(sql.NotNull(CardColumn)),
-				),
-			)
+			s.Where(sql.EqualFold(s.C(FieldPhone), v))
 		},
 		func(t *dsl.Traversal) {
-			t.OutE(CardLabel).OutV()
+			t.Has(Label, FieldPhone, p.EqualFold(v))
 		},
 	)
 }
 
-// HasCardWith applies the HasEdge predicate on the "card" edge with a given conditions (other predicates).
-func HasCardWith(preds ...predicate.Card) predicate.User {
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			t2 := sql.Select(CardColumn).From(sql.Table(CardTable))
-			for _, p := range preds {
-				p(t2)
-			}
-			s.Where(sql.In(t1.C(FieldID), t2))
+			s.Where(sql.ContainsFold(s.C(FieldPhone), v))
 		},
 		func(t *dsl.Traversal) {
-			tr := __.InV()
-			for _, p := range preds {
-				p(tr)
-			}
-			t.OutE(CardLabel).Where(tr).OutV()
+			t.Has(Label, FieldPhone, p.ContainsFold(v))
 		},
 	)
 }
 
-// HasPets applies the HasEdge predicate on the "pets" edge.
-func HasPets() predicate.User {
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v string) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			s.Where(
-				sql.In(
-					t1.C(FieldID),
-					sql.Select(PetsColumn).
-						From(sql.Table(PetsTable)).
-						Where(sql.NotNull(PetsColumn)),
-				),
-			)
+			s.Where(sql.EQ(s.C(FieldPassword), v))
 		},
 		func(t *dsl.Traversal) {
-			t.OutE(PetsLabel).OutV()
+			t.Has(Label, FieldPassword, p.EQ(v))
 		},
 	)
 }
 
-// HasPetsWith applies the HasEdge predicate on the "pets" edge with a given conditions (other predicates).
-func HasPetsWith(preds ...predicate.Pet) predicate.User {
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v string) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			t2 := sql.Select(PetsColumn).From(sql.Table(PetsTable))
-			for _, p := range preds {
-				p(t2)
-			}
-			s.Where(sql.In(t1.C(FieldID), t2))
+			s.Where(sql.NEQ(s.C(FieldPassword), v))
 		},
 		func(t *dsl.Traversal) {
-			tr := __.InV()
-			for _, p := range preds {
-				p(tr)
-			}
-			t.OutE(PetsLabel).Where(tr).OutV()
+			t.Has(Label, FieldPassword, p.NEQ(v))
 		},
 	)
 }
 
-// HasFiles applies the HasEdge predicate on the "files" edge.
-func HasFiles() predicate.User {
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			s.Where(
-				sql.In(
-					t1.C(FieldID),
-					sql.Select(FilesColumn).
-						From(sql.Table(FilesTable)).
-						Where(sql.NotNull(FilesColumn)),
-				),
-			)
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldPassword), v...))
 		},
 		func(t *dsl.Traversal) {
-			t.OutE(FilesLabel).OutV()
+			t.Has(Label, FieldPassword, p.Within(v...))
 		},
 	)
 }
 
-// HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
-func HasFilesWith(preds ...predicate.File) predicate.User {
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			t2 := sql.Select(FilesColumn).From(sql.Table(FilesTable))
-			for _, p := range preds {
-				p(t2)
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
 			}
-			s.Where(sql.In(t1.C(FieldID), t2))
+			s.Where(sql.NotIn(s.C(FieldPassword), v...))
 		},
 		func(t *dsl.Traversal) {
-			tr := __.InV()
-			for _, p := range preds {
-				p(tr)
-			}
-			t.OutE(FilesLabel).Where(tr).OutV()
+			t.Has(Label, FieldPassword, p.Without(v...))
 		},
 	)
 }
 
-// HasGroups applies the HasEdge predicate on the "groups" edge.
-func HasGroups() predicate.User {
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v string) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			s.Where(
-				sql.In(
-					t1.C(FieldID),
-					sql.Select(GroupsPrimaryKey[0]).From(sql.Table(GroupsTable)),
-				),
-			)
+			s.Where(sql.GT(s.C(FieldPassword), v))
 		},
 		func(t *dsl.Traversal) {
-			t.OutE(GroupsLabel).OutV()
+			t.Has(Label, FieldPassword, p.GT(v))
 		},
 	)
 }
 
-// HasGroupsWith applies the HasEdge predicate on the "groups" edge with a given conditions (other predicates).
-func HasGroupsWith(preds ...predicate.Group) predicate.User {
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v string) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			t2 := sql.Table(GroupsInverseTable)
-			t3 := sql.Table(GroupsTable)
-			t4 := sql.Select(t3.C(GroupsPrimaryKey[0])).
-				From(t3).
-				Join(t2).
-				On(t3.C(GroupsPrimaryKey[1]), t2.C(FieldID))
-			t5 := sql.Select().From(t2)
-			for _, p := range preds {
-				p(t5)
-			}
-			t4.FromSelect(t5)
-			s.Where(sql.In(t1.C(FieldID), t4))
+			s.Where(sql.GTE(s.C(FieldPassword), v))
 		},
 		func(t *dsl.Traversal) {
-			tr := __.InV()
-			for _, p := range preds {
-				p(tr)
-			}
-			t.OutE(GroupsLabel).Where(tr).OutV()
+			t.Has(Label, FieldPassword, p.GTE(v))
 		},
 	)
 }
 
-// HasFriends applies the HasEdge predicate on the "friends" edge.
-func HasFriends() predicate.User {
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v string) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			s.Where(
-				sql.In(
-					t1.C(FieldID),
-					sql.Select(FriendsPrimaryKey[0]).From(sql.Table(FriendsTable)),
-				),
-			)
+			s.Where(sql.LT(s.C(FieldPassword), v))
 		},
 		func(t *dsl.Traversal) {
-			t.Both(FriendsLabel)
+			t.Has(Label, FieldPassword, p.LT(v))
 		},
 	)
 }
 
-// HasFriendsWith applies the HasEdge predicate on the "friends" edge with a given conditions (other predicates).
-func HasFriendsWith(preds ...predicate.User) predicate.User {
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v string) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			t2 := sql.Table(Table)
-			t3 := sql.Table(FriendsTable)
-			t4 := sql.Select(t3.C(FriendsPrimaryKey[0])).
-				From(t3).
-				Join(t2).
-				On(t3.C(FriendsPrimaryKey[1]), t2.C(FieldID))
-			t5 := sql.Select().From(t2)
-			for _, p := range preds {
-				p(t5)
-			}
-			t4.FromSelect(t5)
-			s.Where(sql.In(t1.C(FieldID), t4))
+			s.Where(sql.LTE(s.C(FieldPassword), v))
 		},
 		func(t *dsl.Traversal) {
-			in, out := __.InV(), __.OutV()
-			for _, p := range preds {
-				p(in)
-				p(out)
-			}
-			t.Where(
-				__.Or(
-					__.OutE(FriendsLabel).Where(in),
-					__.InE(FriendsLabel).Where(out),
-				),
-			)
+			t.Has(Label, FieldPassword, p.LTE(v))
 		},
 	)
 }
 
-// HasFollowers applies the HasEdge predicate on the "followers" edge.
-func HasFollowers() predicate.User {
+// PasswordContains applies the Contains predicate on the "password" field.
+func PasswordContains(v string) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			s.Where(
-				sql.In(
-					t1.C(FieldID),
-					sql.Select(FollowersPrimaryKey[1]).From(sql.Table(FollowersTable)),
-				),
-			)
+			s.Where(sql.Contains(s.C(FieldPassword), v))
 		},
 		func(t *dsl.Traversal) {
-			t.InE(FollowersInverseLabel).InV()
+			t.Has(Label, FieldPassword, p.Containing(v))
 		},
 	)
 }
 
-// HasFollowersWith applies the HasEdge predicate on the "followers" edge with a given conditions (other predicates).
-func HasFollowersWith(preds ...predicate.User) predicate.User {
+// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
+func PasswordHasPrefix(v string) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			t2 := sql.Table(Table)
-			t3 := sql.Table(FollowersTable)
-			t4 := sql.Select(t3.C(FollowersPrimaryKey[1])).
-				From(t3).
-				Join(t2).
-				On(t3.C(FollowersPrimaryKey[0]), t2.C(FieldID))
-			t5 := sql.Select().From(t2)
-			for _, p := range preds {
-				p(t5)
-			}
-			t4.FromSelect(t5)
-			s.Where(sql.In(t1.C(FieldID), t4))
+			s.Where(sql.HasPrefix(s.C(FieldPassword), v))
 		},
 		func(t *dsl.Traversal) {
-			tr := __.OutV()
-			for _, p := range preds {
-				p(tr)
-			}
-			t.InE(FollowersInverseLabel).Where(tr).InV()
+			t.Has(Label, FieldPassword, p.StartingWith(v))
 		},
 	)
 }
 
-// HasFollowing applies the HasEdge predicate on the "following" edge.
-func HasFollowing() predicate.User {
+// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
+func PasswordHasSuffix(v string) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			s.Where(
-				sql.In(
-					t1.C(FieldID),
-					sql.Select(FollowingPrimaryKey[0]).From(sql.Table(FollowingTable)),
-				),
-			)
+			s.Where(sql.HasSuffix(s.C(FieldPassword), v))
 		},
 		func(t *dsl.Traversal) {
-			t.OutE(FollowingLabel).OutV()
+			t.Has(Label, FieldPassword, p.EndingWith(v))
 		},
 	)
 }
 
-// HasFollowingWith applies the HasEdge predicate on the "following" edge with a given conditions (other predicates).
-func HasFollowingWith(preds ...predicate.User) predicate.User {
+// PasswordIsNil applies the IsNil predicate on the "password" field.
+func PasswordIsNil() predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
-			t1 := s.Table()
-			t2 := sql.Table(Table)
-			t3 := sql.Table(FollowingTable)
-			t4 := sql.Select(t3.C(FollowingPrimaryKey[0])).
-				From(t3).
+			s.Where(sql.IsNull(s.C(FieldPassword)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).HasNot(FieldPassword)
+		},
+	)
+}
+
+// PasswordNotNil applies the NotNil predicate on the "password" field.
+func PasswordNotNil() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldPassword)))
+		},
+		func(t *dsl.Traversal) {
+			t.HasLabel(Label).Has(FieldPassword)
+		},
+	)
+}
+
+// PasswordEqualFold applies the EqualFold predicate on the "password" field.
+func PasswordEqualFold(v string) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldPassword), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPassword, p.EqualFold(v))
+		},
+	)
+}
+
+// PasswordContainsFold applies the ContainsFold predicate on the "password" field.
+func PasswordContainsFold(v string) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldPassword), v))
+		},
+		func(t *dsl.Traversal) {
+			t.Has(Label, FieldPassword, p.ContainsFold(v))
+		},
+	)
+}
+
+// HasCard applies the HasEdge predicate on the "card" edge.
+func HasCard() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.In(
+					t1.C(FieldID),
+					sql.Select(CardColumn).
+						From(sql.Table(CardTable)).
+						Where(sql.NotNull(CardColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.OutE(CardLabel).OutV()
+		},
+	)
+}
+
+// HasNoCard applies a predicate that checks that the "card" edge is empty. It's identical
+// to Not(HasCard()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoCard() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(CardColumn).
+						From(sql.Table(CardTable)).
+						Where(sql.NotNull(CardColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(CardLabel))
+		},
+	)
+}
+
+// HasCardWith applies the HasEdge predicate on the "card" edge with a given conditions (other predicates).
+func HasCardWith(preds ...predicate.Card) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			t2 := sql.Select(CardColumn).From(sql.Table(CardTable)).WithContext(s.Context())
+			for _, p := range preds {
+				p(t2)
+			}
+			s.AddError(t2.Err())
+			s.Where(sql.In(t1.C(FieldID), t2))
+		},
+		func(t *dsl.Traversal) {
+			tr := __.InV()
+			for _, p := range preds {
+				p(tr)
+			}
+			t.OutE(CardLabel).Where(tr).OutV()
+		},
+	)
+}
+
+// HasPets applies the HasEdge predicate on the "pets" edge.
+func HasPets() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.In(
+					t1.C(FieldID),
+					sql.Select(PetsColumn).
+						From(sql.Table(PetsTable)).
+						Where(sql.NotNull(PetsColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.OutE(PetsLabel).OutV()
+		},
+	)
+}
+
+// HasNoPets applies a predicate that checks that the "pets" edge is empty. It's identical
+// to Not(HasPets()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoPets() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(PetsColumn).
+						From(sql.Table(PetsTable)).
+						Where(sql.NotNull(PetsColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(PetsLabel))
+		},
+	)
+}
+
+// HasPetsWith applies the HasEdge predicate on the "pets" edge with a given conditions (other predicates).
+func HasPetsWith(preds ...predicate.Pet) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			t2 := sql.Select(PetsColumn).From(sql.Table(PetsTable)).WithContext(s.Context())
+			for _, p := range preds {
+				p(t2)
+			}
+			s.AddError(t2.Err())
+			s.Where(sql.In(t1.C(FieldID), t2))
+		},
+		func(t *dsl.Traversal) {
+			tr := __.InV()
+			for _, p := range preds {
+				p(tr)
+			}
+			t.OutE(PetsLabel).Where(tr).OutV()
+		},
+	)
+}
+
+// HasFiles applies the HasEdge predicate on the "files" edge.
+func HasFiles() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.In(
+					t1.C(FieldID),
+					sql.Select(FilesColumn).
+						From(sql.Table(FilesTable)).
+						Where(sql.NotNull(FilesColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.OutE(FilesLabel).OutV()
+		},
+	)
+}
+
+// HasNoFiles applies a predicate that checks that the "files" edge is empty. It's identical
+// to Not(HasFiles()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoFiles() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(FilesColumn).
+						From(sql.Table(FilesTable)).
+						Where(sql.NotNull(FilesColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(FilesLabel))
+		},
+	)
+}
+
+// HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
+func HasFilesWith(preds ...predicate.File) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			t2 := sql.Select(FilesColumn).From(sql.Table(FilesTable)).WithContext(s.Context())
+			for _, p := range preds {
+				p(t2)
+			}
+			s.AddError(t2.Err())
+			s.Where(sql.In(t1.C(FieldID), t2))
+		},
+		func(t *dsl.Traversal) {
+			tr := __.InV()
+			for _, p := range preds {
+				p(tr)
+			}
+			t.OutE(FilesLabel).Where(tr).OutV()
+		},
+	)
+}
+
+// HasGroups applies the HasEdge predicate on the "groups" edge.
+func HasGroups() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.In(
+					t1.C(FieldID),
+					sql.Select(GroupsPrimaryKey[0]).From(sql.Table(GroupsTable)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.OutE(GroupsLabel).OutV()
+		},
+	)
+}
+
+// HasNoGroups applies a predicate that checks that the "groups" edge is empty. It's identical
+// to Not(HasGroups()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoGroups() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(GroupsPrimaryKey[0]).From(sql.Table(GroupsTable)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(GroupsLabel))
+		},
+	)
+}
+
+// HasGroupsWith applies the HasEdge predicate on the "groups" edge with a given conditions (other predicates).
+func HasGroupsWith(preds ...predicate.Group) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			t2 := sql.Table(GroupsInverseTable)
+			t3 := sql.Table(GroupsTable)
+			t4 := sql.Select(t3.C(GroupsPrimaryKey[0])).
+				From(t3).
+				Join(t2).
+				On(t3.C(GroupsPrimaryKey[1]), t2.C(FieldID))
+			t5 := sql.Select().From(t2).WithContext(s.Context())
+			for _, p := range preds {
+				p(t5)
+			}
+			s.AddError(t5.Err())
+			t4.FromSelect(t5)
+			s.Where(sql.In(t1.C(FieldID), t4))
+		},
+		func(t *dsl.Traversal) {
+			tr := __.InV()
+			for _, p := range preds {
+				p(tr)
+			}
+			t.OutE(GroupsLabel).Where(tr).OutV()
+		},
+	)
+}
+
+// HasFriends applies the HasEdge predicate on the "friends" edge.
+func HasFriends() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.In(
+					t1.C(FieldID),
+					sql.Select(FriendsPrimaryKey[0]).From(sql.Table(FriendsTable)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Both(FriendsLabel)
+		},
+	)
+}
+
+// HasNoFriends applies a predicate that checks that the "friends" edge is empty. It's identical
+// to Not(HasFriends()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoFriends() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(FriendsPrimaryKey[0]).From(sql.Table(FriendsTable)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.BothE(FriendsLabel))
+		},
+	)
+}
+
+// HasFriendsWith applies the HasEdge predicate on the "friends" edge with a given conditions (other predicates).
+func HasFriendsWith(preds ...predicate.User) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			t2 := sql.Table(Table)
+			t3 := sql.Table(FriendsTable)
+			t4 := sql.Select(t3.C(FriendsPrimaryKey[0])).
+				From(t3).
+				Join(t2).
+				On(t3.C(FriendsPrimaryKey[1]), t2.C(FieldID))
+			t5 := sql.Select().From(t2).WithContext(s.Context())
+			for _, p := range preds {
+				p(t5)
+			}
+			s.AddError(t5.Err())
+			t4.FromSelect(t5)
+			s.Where(sql.In(t1.C(FieldID), t4))
+		},
+		func(t *dsl.Traversal) {
+			in, out := __.InV(), __.OutV()
+			for _, p := range preds {
+				p(in)
+				p(out)
+			}
+			t.Where(
+				__.Or(
+					__.OutE(FriendsLabel).Where(in),
+					__.InE(FriendsLabel).Where(out),
+				),
+			)
+		},
+	)
+}
+
+// HasFollowers applies the HasEdge predicate on the "followers" edge.
+func HasFollowers() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.In(
+					t1.C(FieldID),
+					sql.Select(FollowersPrimaryKey[1]).From(sql.Table(FollowersTable)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.InE(FollowersInverseLabel).InV()
+		},
+	)
+}
+
+// HasNoFollowers applies a predicate that checks that the "followers" edge is empty. It's identical
+// to Not(HasFollowers()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoFollowers() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(FollowersPrimaryKey[1]).From(sql.Table(FollowersTable)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.InE(FollowersInverseLabel))
+		},
+	)
+}
+
+// HasFollowersWith applies the HasEdge predicate on the "followers" edge with a given conditions (other predicates).
+func HasFollowersWith(preds ...predicate.User) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			t2 := sql.Table(Table)
+			t3 := sql.Table(FollowersTable)
+			t4 := sql.Select(t3.C(FollowersPrimaryKey[1])).
+				From(t3).
+				Join(t2).
+				On(t3.C(FollowersPrimaryKey[0]), t2.C(FieldID))
+			t5 := sql.Select().From(t2).WithContext(s.Context())
+			for _, p := range preds {
+				p(t5)
+			}
+			s.AddError(t5.Err())
+			t4.FromSelect(t5)
+			s.Where(sql.In(t1.C(FieldID), t4))
+		},
+		func(t *dsl.Traversal) {
+			tr := __.OutV()
+			for _, p := range preds {
+				p(tr)
+			}
+			t.InE(FollowersInverseLabel).Where(tr).InV()
+		},
+	)
+}
+
+// HasFollowing applies the HasEdge predicate on the "following" edge.
+func HasFollowing() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.In(
+					t1.C(FieldID),
+					sql.Select(FollowingPrimaryKey[0]).From(sql.Table(FollowingTable)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.OutE(FollowingLabel).OutV()
+		},
+	)
+}
+
+// HasNoFollowing applies a predicate that checks that the "following" edge is empty. It's identical
+// to Not(HasFollowing()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoFollowing() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(FollowingPrimaryKey[0]).From(sql.Table(FollowingTable)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(FollowingLabel))
+		},
+	)
+}
+
+// HasFollowingWith applies the HasEdge predicate on the "following" edge with a given conditions (other predicates).
+func HasFollowingWith(preds ...predicate.User) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			t2 := sql.Table(Table)
+			t3 := sql.Table(FollowingTable)
+			t4 := sql.Select(t3.C(FollowingPrimaryKey[0])).
+				From(t3).
 				Join(t2).
 				On(t3.C(FollowingPrimaryKey[1]), t2.C(FieldID))
-			t5 := sql.Select().From(t2)
+			t5 := sql.Select().From(t2).WithContext(s.Context())
 			for _, p := range preds {
 				p(t5)
 			}
+			s.AddError(t5.Err())
 			t4.FromSelect(t5)
 			s.Where(sql.In(t1.C(FieldID), t4))
 		},
@@ -1324,15 +1789,38 @@ func HasTeam() predicate.User {
 	)
 }
 
+// HasNoTeam applies a predicate that checks that the "team" edge is empty. It's identical
+// to Not(HasTeam()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoTeam() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(TeamColumn).
+						From(sql.Table(TeamTable)).
+						Where(sql.NotNull(TeamColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(TeamLabel))
+		},
+	)
+}
+
 // HasTeamWith applies the HasEdge predicate on the "team" edge with a given conditions (other predicates).
 func HasTeamWith(preds ...predicate.Pet) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(TeamColumn).From(sql.Table(TeamTable))
+			t2 := sql.Select(TeamColumn).From(sql.Table(TeamTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(FieldID), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -1365,15 +1853,38 @@ func HasSpouse() predicate.User {
 	)
 }
 
+// HasNoSpouse applies a predicate that checks that the "spouse" edge is empty. It's identical
+// to Not(HasSpouse()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoSpouse() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(SpouseColumn).
+						From(sql.Table(SpouseTable)).
+						Where(sql.NotNull(SpouseColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.BothE(SpouseLabel))
+		},
+	)
+}
+
 // HasSpouseWith applies the HasEdge predicate on the "spouse" edge with a given conditions (other predicates).
 func HasSpouseWith(preds ...predicate.User) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(SpouseColumn).From(sql.Table(SpouseTable))
+			t2 := sql.Select(SpouseColumn).From(sql.Table(SpouseTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(FieldID), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -1412,15 +1923,38 @@ func HasChildren() predicate.User {
 	)
 }
 
+// HasNoChildren applies a predicate that checks that the "children" edge is empty. It's identical
+// to Not(HasChildren()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoChildren() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(ChildrenColumn).
+						From(sql.Table(ChildrenTable)).
+						Where(sql.NotNull(ChildrenColumn)),
+				),
+			)
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.InE(ChildrenInverseLabel))
+		},
+	)
+}
+
 // HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
 func HasChildrenWith(preds ...predicate.User) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(ChildrenColumn).From(sql.Table(ChildrenTable))
+			t2 := sql.Select(ChildrenColumn).From(sql.Table(ChildrenTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(FieldID), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -1433,6 +1967,35 @@ func HasChildrenWith(preds ...predicate.User) predicate.User {
 	)
 }
 
+// HasChildrenWithRecursive applies the HasEdge predicate on the "children" edge recursively. It matches the nodes
+// that reach a node with the given conditions by following the "children" edge one or more times.
+// In SQL dialects, it's translated to a recursive common table expression (WITH RECURSIVE).
+func HasChildrenWithRecursive(preds ...predicate.User) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1, t2 := s.Table(), sql.Table(Table)
+			t3 := sql.Select(FieldID).From(sql.Table(Table)).WithContext(s.Context())
+			for _, p := range preds {
+				p(t3)
+			}
+			s.AddError(t3.Err())
+			w := sql.WithRecursive("children_recursive", FieldID)
+			w.As(
+				sql.Select(t2.C(ChildrenColumn)).From(t2).Where(sql.And(sql.In(t2.C(FieldID), t3), sql.NotNull(t2.C(ChildrenColumn)))).
+					Union(sql.Select(t2.C(ChildrenColumn)).From(t2).Join(w).On(t2.C(FieldID), w.C(FieldID)).Where(sql.NotNull(t2.C(ChildrenColumn)))),
+			)
+			s.Where(sql.In(t1.C(FieldID), sql.Select(w.C(FieldID)).From(w).With(w)))
+		},
+		func(t *dsl.Traversal) {
+			tr := __.Repeat(__.In(ChildrenInverseLabel).SimplePath()).Emit()
+			for _, p := range preds {
+				p(tr)
+			}
+			t.Where(tr)
+		},
+	)
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.User {
 	return predicate.UserPerDialect(
@@ -1446,15 +2009,31 @@ func HasParent() predicate.User {
 	)
 }
 
+// HasNoParent applies a predicate that checks that the "parent" edge is empty. It's identical
+// to Not(HasParent()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoParent() predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(sql.IsNull(t1.C(ParentColumn)))
+		},
+		func(t *dsl.Traversal) {
+			t.Not(__.OutE(ParentLabel))
+		},
+	)
+}
+
 // HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
 func HasParentWith(preds ...predicate.User) predicate.User {
 	return predicate.UserPerDialect(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(FieldID).From(sql.Table(ParentTable))
+			t2 := sql.Select(FieldID).From(sql.Table(ParentTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(ParentColumn), t2))
 		},
 		func(t *dsl.Traversal) {
@@ -1467,6 +2046,743 @@ func HasParentWith(preds ...predicate.User) predicate.User {
 	)
 }
 
+// HasParentWithRecursive applies the HasEdge predicate on the "parent" edge recursively. It matches the nodes
+// that reach a node with the given conditions by following the "parent" edge one or more times.
+// In SQL dialects, it's translated to a recursive common table expression (WITH RECURSIVE).
+func HasParentWithRecursive(preds ...predicate.User) predicate.User {
+	return predicate.UserPerDialect(
+		func(s *sql.Selector) {
+			t1, t2 := s.Table(), sql.Table(Table)
+			t3 := sql.Select(FieldID).From(sql.Table(Table)).WithContext(s.Context())
+			for _, p := range preds {
+				p(t3)
+			}
+			s.AddError(t3.Err())
+			w := sql.WithRecursive("parent_recursive", FieldID)
+			w.As(
+				sql.Select(t2.C(FieldID)).From(t2).Where(sql.In(t2.C(ParentColumn), t3)).
+					Union(sql.Select(t2.C(FieldID)).From(t2).Join(w).On(t2.C(ParentColumn), w.C(FieldID))),
+			)
+			s.Where(sql.In(t1.C(FieldID), sql.Select(w.C(FieldID)).From(w).With(w)))
+		},
+		func(t *dsl.Traversal) {
+			tr := __.Repeat(__.Out(ParentLabel).SimplePath()).Emit()
+			for _, p := range preds {
+				p(tr)
+			}
+			t.Where(tr)
+		},
+	)
+}
+
+// ByPetsCount orders the results by the number of "pets" edges,
+// in descending order if desc is true. It's used as an order option of the
+// User queries. For example:
+//
+//	client.User.Query().Order(user.ByPetsCount(true))
+//
+func ByPetsCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(PetsTable).As("pets_count")
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(PetsColumn), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.OutE(PetsLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// ByFilesCount orders the results by the number of "files" edges,
+// in descending order if desc is true. It's used as an order option of the
+// User queries. For example:
+//
+//	client.User.Query().Order(user.ByFilesCount(true))
+//
+func ByFilesCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(FilesTable).As("files_count")
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(FilesColumn), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.OutE(FilesLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// ByGroupsCount orders the results by the number of "groups" edges,
+// in descending order if desc is true. It's used as an order option of the
+// User queries. For example:
+//
+//	client.User.Query().Order(user.ByGroupsCount(true))
+//
+func ByGroupsCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(GroupsTable)
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(GroupsPrimaryKey[0]), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.OutE(GroupsLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// ByFriendsCount orders the results by the number of "friends" edges,
+// in descending order if desc is true. It's used as an order option of the
+// User queries. For example:
+//
+//	client.User.Query().Order(user.ByFriendsCount(true))
+//
+func ByFriendsCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(FriendsTable)
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(FriendsPrimaryKey[0]), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.BothE(FriendsLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// ByFollowersCount orders the results by the number of "followers" edges,
+// in descending order if desc is true. It's used as an order option of the
+// User queries. For example:
+//
+//	client.User.Query().Order(user.ByFollowersCount(true))
+//
+func ByFollowersCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(FollowersTable)
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(FollowersPrimaryKey[1]), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.InE(FollowersInverseLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// ByFollowingCount orders the results by the number of "following" edges,
+// in descending order if desc is true. It's used as an order option of the
+// User queries. For example:
+//
+//	client.User.Query().Order(user.ByFollowingCount(true))
+//
+func ByFollowingCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(FollowingTable)
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(FollowingPrimaryKey[0]), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.OutE(FollowingLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// ByChildrenCount orders the results by the number of "children" edges,
+// in descending order if desc is true. It's used as an order option of the
+// User queries. For example:
+//
+//	client.User.Query().Order(user.ByChildrenCount(true))
+//
+func ByChildrenCount(desc bool) func(interface{}) {
+	f0 := func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(ChildrenTable).As("children_count")
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(ChildrenColumn), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+	f1 := func(t *dsl.Traversal) {
+		order := dsl.Incr
+		if desc {
+			order = dsl.Decr
+		}
+		t.By(__.InE(ChildrenInverseLabel).Count(), order)
+	}
+	return func(v interface{}) {
+		switch v := v.(type) {
+		case *sql.Selector:
+			f0(v)
+		case *dsl.Traversal:
+			f1(v)
+		default:
+			panic(fmt.Sprintf("unknown type for order: %T", v))
+		}
+	}
+}
+
+// PredicateFunc returns a predicate from the given per-dialect functions.
+// It allows adding custom SQL/Gremlin fragments to the User builders.
+func PredicateFunc(f0 func(*sql.Selector), f1 func(*dsl.Traversal)) predicate.User {
+	return predicate.UserPerDialect(f0, f1)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.User, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.User, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.User, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldAge:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return AgeIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return AgeNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeLTE(v), nil
+			}
+		}
+	case FieldName:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasSuffix(v), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContainsFold(v), nil
+			}
+		}
+	case FieldLast:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LastEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LastNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return LastIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return LastNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LastGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LastGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LastLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LastLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LastContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LastHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LastHasSuffix(v), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LastEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return LastContainsFold(v), nil
+			}
+		}
+	case FieldNickname:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NicknameIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NicknameNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NicknameIsNil(), nil
+				}
+				return Not(NicknameIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NicknameNotNil(), nil
+				}
+				return Not(NicknameNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameContainsFold(v), nil
+			}
+		}
+	case FieldPhone:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PhoneEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PhoneNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return PhoneIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return PhoneNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PhoneGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PhoneGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PhoneLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PhoneLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PhoneContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PhoneHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PhoneHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return PhoneIsNil(), nil
+				}
+				return Not(PhoneIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return PhoneNotNil(), nil
+				}
+				return Not(PhoneNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PhoneEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PhoneContainsFold(v), nil
+			}
+		}
+	case FieldPassword:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PasswordEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PasswordNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return PasswordIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return PasswordNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PasswordGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PasswordGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PasswordLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PasswordLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PasswordContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PasswordHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PasswordHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return PasswordIsNil(), nil
+				}
+				return Not(PasswordIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return PasswordNotNil(), nil
+				}
+				return Not(PasswordNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PasswordEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return PasswordContainsFold(v), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("user: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.UserPerDialect(
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -21,29 +22,24 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
+	"github.com/facebookincubator/ent/entc/integration/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/ent/pet"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // UserCreate is the builder for creating a User entity.
 type UserCreate struct {
 	config
-	age       *int
-	name      *string
-	last      *string
-	nickname  *string
-	phone     *string
-	card      map[string]struct{}
-	pets      map[string]struct{}
-	files     map[string]struct{}
-	groups    map[string]struct{}
-	friends   map[string]struct{}
-	followers map[string]struct{}
-	following map[string]struct{}
-	team      map[string]struct{}
-	spouse    map[string]struct{}
-	children  map[string]struct{}
-	parent    map[string]struct{}
+	userMutation
+	// id of the node, if it's mirrored from the primary storage.
+	id *string
+}
+
+// Mutation returns the mutation of the builder.
+func (uc *UserCreate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uc.userMutation, op: ent.OpCreate}
 }
 
 // SetAge sets the age field.
@@ -100,6 +96,20 @@ func (uc *UserCreate) SetNillablePhone(s *string) *UserCreate {
 	return uc
 }
 
+// SetPassword sets the password field.
+func (uc *UserCreate) SetPassword(s string) *UserCreate {
+	uc.password = &s
+	return uc
+}
+
+// SetNillablePassword sets the password field if the given value is not nil.
+func (uc *UserCreate) SetNillablePassword(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPassword(*s)
+	}
+	return uc
+}
+
 // SetCardID sets the card edge to Card by id.
 func (uc *UserCreate) SetCardID(id string) *UserCreate {
 	if uc.card == nil {
@@ -329,7 +339,8 @@ func (uc *UserCreate) SetParent(u *User) *UserCreate {
 }
 
 // Save creates the User in the database.
-func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+func (uc *UserCreate) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
 	if uc.age == nil {
 		return nil, errors.New("ent: missing required field \"age\"")
 	}
@@ -352,6 +363,24 @@ func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
 	if len(uc.parent) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"parent\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if uc.shadow != nil {
+		return uc.dualSave(ctx, opts...)
+	}
+	v, err := uc.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		uc.bus.publish(&Event{Op: ent.OpCreate, Type: user.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save creates the User in the storage of the client dialect.
+func (uc *UserCreate) save(ctx context.Context) (*User, error) {
 	switch uc.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return uc.sqlSave(ctx)
@@ -363,8 +392,8 @@ func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (uc *UserCreate) SaveX(ctx context.Context) *User {
-	v, err := uc.Save(ctx)
+func (uc *UserCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	v, err := uc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -372,6 +401,7 @@ func (uc *UserCreate) SaveX(ctx context.Context) *User {
 }
 
 func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
+	ctx = entcache.Invalidates(ctx, user.Tables...)
 	var (
 		res sql.Result
 		u   = &User{config: uc.config}
@@ -381,6 +411,13 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 		return nil, err
 	}
 	builder := sql.Insert(user.Table).Default(uc.driver.Dialect())
+	if id := uc.id; id != nil {
+		id, err := strconv.Atoi(*id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(user.FieldID, id)
+	}
 	if value := uc.age; value != nil {
 		builder.Set(user.FieldAge, *value)
 		u.Age = *value
@@ -401,6 +438,22 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 		builder.Set(user.FieldPhone, *value)
 		u.Phone = *value
 	}
+	if value := uc.password; value != nil {
+		builder.Set(user.FieldPassword, *value)
+		u.Password = *value
+	}
+	if len(uc.parent) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "parent", uc.parent); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	for eid := range uc.parent {
+		eid, err := strconv.Atoi(eid)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		builder.Set(user.ParentColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -427,7 +480,7 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(uc.card) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"card\" %v already connected to a different \"User\"", keys(uc.card))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"card\" %v already connected to a different \"User\"", keys(uc.card))})
 		}
 	}
 	if len(uc.pets) > 0 {
@@ -451,7 +504,7 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(uc.pets) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uc.pets))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uc.pets))})
 		}
 	}
 	if len(uc.files) > 0 {
@@ -475,72 +528,84 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(uc.files) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"User\"", keys(uc.files))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"User\"", keys(uc.files))})
 		}
 	}
 	if len(uc.groups) > 0 {
+		if err := checkRefs(ctx, tx, group.Table, group.FieldID, "groups", uc.groups); err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		builder := sql.Insert(user.GroupsTable).
+			Columns(user.GroupsPrimaryKey[0], user.GroupsPrimaryKey[1])
 		for eid := range uc.groups {
 			eid, err := strconv.Atoi(eid)
 			if err != nil {
 				return nil, rollback(tx, err)
 			}
-
-			query, args := sql.Insert(user.GroupsTable).
-				Columns(user.GroupsPrimaryKey[0], user.GroupsPrimaryKey[1]).
-				Values(id, eid).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+			builder.Values(id, eid)
+		}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uc.friends) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "friends", uc.friends); err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		builder := sql.Insert(user.FriendsTable).
+			Columns(user.FriendsPrimaryKey[0], user.FriendsPrimaryKey[1])
 		for eid := range uc.friends {
 			eid, err := strconv.Atoi(eid)
 			if err != nil {
 				return nil, rollback(tx, err)
 			}
-
-			query, args := sql.Insert(user.FriendsTable).
-				Columns(user.FriendsPrimaryKey[0], user.FriendsPrimaryKey[1]).
-				Values(id, eid).
-				Values(eid, id).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+			builder.Values(id, eid)
+			builder.Values(eid, id)
+		}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uc.followers) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "followers", uc.followers); err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		builder := sql.Insert(user.FollowersTable).
+			Columns(user.FollowersPrimaryKey[1], user.FollowersPrimaryKey[0])
 		for eid := range uc.followers {
 			eid, err := strconv.Atoi(eid)
 			if err != nil {
 				return nil, rollback(tx, err)
 			}
-
-			query, args := sql.Insert(user.FollowersTable).
-				Columns(user.FollowersPrimaryKey[1], user.FollowersPrimaryKey[0]).
-				Values(id, eid).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+			builder.Values(id, eid)
+		}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uc.following) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "following", uc.following); err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		builder := sql.Insert(user.FollowingTable).
+			Columns(user.FollowingPrimaryKey[0], user.FollowingPrimaryKey[1])
 		for eid := range uc.following {
 			eid, err := strconv.Atoi(eid)
 			if err != nil {
 				return nil, rollback(tx, err)
 			}
-
-			query, args := sql.Insert(user.FollowingTable).
-				Columns(user.FollowingPrimaryKey[0], user.FollowingPrimaryKey[1]).
-				Values(id, eid).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+			builder.Values(id, eid)
+		}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uc.team) > 0 {
@@ -560,7 +625,7 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(uc.team) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"team\" %v already connected to a different \"User\"", keys(uc.team))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"team\" %v already connected to a different \"User\"", keys(uc.team))})
 		}
 	}
 	if len(uc.spouse) > 0 {
@@ -586,7 +651,7 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uc.spouse) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
 			}
 		}
 	}
@@ -611,22 +676,7 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(uc.children) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"children\" %v already connected to a different \"User\"", keys(uc.children))})
-		}
-	}
-	if len(uc.parent) > 0 {
-		for eid := range uc.parent {
-			eid, err := strconv.Atoi(eid)
-			if err != nil {
-				return nil, rollback(tx, err)
-			}
-			query, args := sql.Update(user.ParentTable).
-				Set(user.ParentColumn, eid).
-				Where(sql.EQ(user.FieldID, id)).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"children\" %v already connected to a different \"User\"", keys(uc.children))})
 		}
 	}
 	if err := tx.Commit(); err != nil {
@@ -637,8 +687,9 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 
 func (uc *UserCreate) gremlinSave(ctx context.Context) (*User, error) {
 	res := &gremlin.Response{}
-	query, bindings := uc.gremlin().Query()
-	if err := uc.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := uc.gremlin()
+	query, _ := traversal.Query()
+	if err := uc.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -681,6 +732,9 @@ func (uc *UserCreate) gremlin() *dsl.Traversal {
 		})
 		v.Property(dsl.Single, user.FieldPhone, *uc.phone)
 	}
+	if uc.password != nil {
+		v.Property(dsl.Single, user.FieldPassword, *uc.password)
+	}
 	for id := range uc.card {
 		v.AddE(user.CardLabel).To(g.V(id)).OutV()
 		constraints = append(constraints, &constraint{
@@ -747,3 +801,585 @@ func (uc *UserCreate) gremlin() *dsl.Traversal {
 	}
 	return tr
 }
+
+// UserUpsert is the builder for creating a User entity, or updating the
+// fields of an existing one if it has the same value in the unique key field of the builder.
+type UserUpsert struct {
+	config
+	key      string
+	age      *int
+	name     *string
+	last     *string
+	nickname *string
+	phone    *string
+	password *string
+}
+
+// SetAge sets the age field.
+func (uu *UserUpsert) SetAge(i int) *UserUpsert {
+	uu.age = &i
+	return uu
+}
+
+// SetName sets the name field.
+func (uu *UserUpsert) SetName(s string) *UserUpsert {
+	uu.name = &s
+	return uu
+}
+
+// SetLast sets the last field.
+func (uu *UserUpsert) SetLast(s string) *UserUpsert {
+	uu.last = &s
+	return uu
+}
+
+// SetNillableLast sets the last field if the given value is not nil.
+func (uu *UserUpsert) SetNillableLast(s *string) *UserUpsert {
+	if s != nil {
+		uu.SetLast(*s)
+	}
+	return uu
+}
+
+// SetNickname sets the nickname field.
+func (uu *UserUpsert) SetNickname(s string) *UserUpsert {
+	uu.nickname = &s
+	return uu
+}
+
+// SetNillableNickname sets the nickname field if the given value is not nil.
+func (uu *UserUpsert) SetNillableNickname(s *string) *UserUpsert {
+	if s != nil {
+		uu.SetNickname(*s)
+	}
+	return uu
+}
+
+// SetPhone sets the phone field.
+func (uu *UserUpsert) SetPhone(s string) *UserUpsert {
+	uu.phone = &s
+	return uu
+}
+
+// SetNillablePhone sets the phone field if the given value is not nil.
+func (uu *UserUpsert) SetNillablePhone(s *string) *UserUpsert {
+	if s != nil {
+		uu.SetPhone(*s)
+	}
+	return uu
+}
+
+// SetPassword sets the password field.
+func (uu *UserUpsert) SetPassword(s string) *UserUpsert {
+	uu.password = &s
+	return uu
+}
+
+// SetNillablePassword sets the password field if the given value is not nil.
+func (uu *UserUpsert) SetNillablePassword(s *string) *UserUpsert {
+	if s != nil {
+		uu.SetPassword(*s)
+	}
+	return uu
+}
+
+// Save creates the User in the database, or updates the entity that has
+// the same value in the key field. Immutable fields are set only on creation.
+func (uu *UserUpsert) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	v, err := uu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		uu.bus.publish(&Event{Op: ent.OpUpdateOne, Type: user.Label, ID: v.ID, Node: v, N: 1})
+	}
+	return v, nil
+}
+
+// save upserts the User in the storage of the client dialect.
+func (uu *UserUpsert) save(ctx context.Context) (*User, error) {
+	switch uu.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return uu.sqlSave(ctx)
+	case dialect.Gremlin:
+		return uu.gremlinSave(ctx)
+	default:
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uu *UserUpsert) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	v, err := uu.Save(ctx, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (uu *UserUpsert) sqlSave(ctx context.Context) (*User, error) {
+	ctx = entcache.Invalidates(ctx, user.Tables...)
+	var (
+		res    sql.Result
+		key    interface{}
+		update []string
+	)
+	tx, err := uu.driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	builder := sql.Insert(user.Table)
+	if value := uu.age; value != nil {
+		builder.Set(user.FieldAge, *value)
+		update = append(update, user.FieldAge)
+	}
+	if value := uu.name; value != nil {
+		builder.Set(user.FieldName, *value)
+		update = append(update, user.FieldName)
+	}
+	if value := uu.last; value != nil {
+		builder.Set(user.FieldLast, *value)
+		update = append(update, user.FieldLast)
+	} else {
+		value := user.DefaultLast
+		builder.Set(user.FieldLast, value)
+	}
+	if value := uu.nickname; value != nil {
+		builder.Set(user.FieldNickname, *value)
+		update = append(update, user.FieldNickname)
+		if uu.key == user.FieldNickname {
+			key = *value
+		}
+	}
+	if value := uu.phone; value != nil {
+		builder.Set(user.FieldPhone, *value)
+		update = append(update, user.FieldPhone)
+		if uu.key == user.FieldPhone {
+			key = *value
+		}
+	}
+	if value := uu.password; value != nil {
+		builder.Set(user.FieldPassword, *value)
+		update = append(update, user.FieldPassword)
+	}
+	rows := &sql.Rows{}
+	query, args := builder.OnConflict(uu.driver.Dialect(), []string{uu.key}, update...).Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return nil, rollback(tx, err)
+	}
+	query, args = sql.Select(user.Columns...).From(sql.Table(user.Table)).Where(sql.EQ(uu.key, key)).Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return nil, rollback(tx, err)
+	}
+	u := &User{config: uu.config}
+	if !rows.Next() {
+		rows.Close()
+		return nil, rollback(tx, &ErrNotFound{user.Label})
+	}
+	if err := u.FromRows(rows); err != nil {
+		rows.Close()
+		return nil, rollback(tx, err)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (uu *UserUpsert) gremlinSave(ctx context.Context) (*User, error) {
+	res := &gremlin.Response{}
+	traversal := uu.gremlin()
+	query, _ := traversal.Query()
+	if err := uu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
+	}
+	u := &User{config: uu.config}
+	if err := u.FromResponse(res); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (uu *UserUpsert) gremlin() *dsl.Traversal {
+	var key interface{}
+	if uu.key == user.FieldNickname && uu.nickname != nil {
+		key = *uu.nickname
+	}
+	if uu.key == user.FieldPhone && uu.phone != nil {
+		key = *uu.phone
+	}
+	// properties of new vertices (immutable fields and default values).
+	create := __.AddV(user.Label)
+	if uu.last == nil {
+		create.Property(dsl.Single, user.FieldLast, user.DefaultLast)
+	}
+	v := g.V().Has(user.Label, uu.key, key).Fold().Coalesce(__.Unfold(), create)
+	if uu.age != nil {
+		v.Property(dsl.Single, user.FieldAge, *uu.age)
+	}
+	if uu.name != nil {
+		v.Property(dsl.Single, user.FieldName, *uu.name)
+	}
+	if uu.last != nil {
+		v.Property(dsl.Single, user.FieldLast, *uu.last)
+	}
+	if uu.nickname != nil {
+		v.Property(dsl.Single, user.FieldNickname, *uu.nickname)
+	}
+	if uu.phone != nil {
+		v.Property(dsl.Single, user.FieldPhone, *uu.phone)
+	}
+	if uu.password != nil {
+		v.Property(dsl.Single, user.FieldPassword, *uu.password)
+	}
+	return v.ValueMap(true)
+}
+
+// UserGetOrCreate is the builder for creating a User entity, or getting the existing
+// one if its creation violates the uniqueness of one of the fields of the builder.
+type UserGetOrCreate struct {
+	config
+	userMutation
+}
+
+// SetAge sets the age field.
+func (ugoc *UserGetOrCreate) SetAge(i int) *UserGetOrCreate {
+	ugoc.age = &i
+	return ugoc
+}
+
+// SetName sets the name field.
+func (ugoc *UserGetOrCreate) SetName(s string) *UserGetOrCreate {
+	ugoc.name = &s
+	return ugoc
+}
+
+// SetLast sets the last field.
+func (ugoc *UserGetOrCreate) SetLast(s string) *UserGetOrCreate {
+	ugoc.last = &s
+	return ugoc
+}
+
+// SetNillableLast sets the last field if the given value is not nil.
+func (ugoc *UserGetOrCreate) SetNillableLast(s *string) *UserGetOrCreate {
+	if s != nil {
+		ugoc.SetLast(*s)
+	}
+	return ugoc
+}
+
+// SetNickname sets the nickname field.
+func (ugoc *UserGetOrCreate) SetNickname(s string) *UserGetOrCreate {
+	ugoc.nickname = &s
+	return ugoc
+}
+
+// SetNillableNickname sets the nickname field if the given value is not nil.
+func (ugoc *UserGetOrCreate) SetNillableNickname(s *string) *UserGetOrCreate {
+	if s != nil {
+		ugoc.SetNickname(*s)
+	}
+	return ugoc
+}
+
+// SetPhone sets the phone field.
+func (ugoc *UserGetOrCreate) SetPhone(s string) *UserGetOrCreate {
+	ugoc.phone = &s
+	return ugoc
+}
+
+// SetNillablePhone sets the phone field if the given value is not nil.
+func (ugoc *UserGetOrCreate) SetNillablePhone(s *string) *UserGetOrCreate {
+	if s != nil {
+		ugoc.SetPhone(*s)
+	}
+	return ugoc
+}
+
+// SetPassword sets the password field.
+func (ugoc *UserGetOrCreate) SetPassword(s string) *UserGetOrCreate {
+	ugoc.password = &s
+	return ugoc
+}
+
+// SetNillablePassword sets the password field if the given value is not nil.
+func (ugoc *UserGetOrCreate) SetNillablePassword(s *string) *UserGetOrCreate {
+	if s != nil {
+		ugoc.SetPassword(*s)
+	}
+	return ugoc
+}
+
+// SetCardID sets the card edge to Card by id.
+func (ugoc *UserGetOrCreate) SetCardID(id string) *UserGetOrCreate {
+	if ugoc.card == nil {
+		ugoc.card = make(map[string]struct{})
+	}
+	ugoc.card[id] = struct{}{}
+	return ugoc
+}
+
+// SetNillableCardID sets the card edge to Card by id if the given value is not nil.
+func (ugoc *UserGetOrCreate) SetNillableCardID(id *string) *UserGetOrCreate {
+	if id != nil {
+		ugoc = ugoc.SetCardID(*id)
+	}
+	return ugoc
+}
+
+// SetCard sets the card edge to Card.
+func (ugoc *UserGetOrCreate) SetCard(c *Card) *UserGetOrCreate {
+	return ugoc.SetCardID(c.ID)
+}
+
+// AddPetIDs adds the pets edge to Pet by ids.
+func (ugoc *UserGetOrCreate) AddPetIDs(ids ...string) *UserGetOrCreate {
+	if ugoc.pets == nil {
+		ugoc.pets = make(map[string]struct{})
+	}
+	for i := range ids {
+		ugoc.pets[ids[i]] = struct{}{}
+	}
+	return ugoc
+}
+
+// AddPets adds the pets edges to Pet.
+func (ugoc *UserGetOrCreate) AddPets(p ...*Pet) *UserGetOrCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ugoc.AddPetIDs(ids...)
+}
+
+// AddFileIDs adds the files edge to File by ids.
+func (ugoc *UserGetOrCreate) AddFileIDs(ids ...string) *UserGetOrCreate {
+	if ugoc.files == nil {
+		ugoc.files = make(map[string]struct{})
+	}
+	for i := range ids {
+		ugoc.files[ids[i]] = struct{}{}
+	}
+	return ugoc
+}
+
+// AddFiles adds the files edges to File.
+func (ugoc *UserGetOrCreate) AddFiles(f ...*File) *UserGetOrCreate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ugoc.AddFileIDs(ids...)
+}
+
+// AddGroupIDs adds the groups edge to Group by ids.
+func (ugoc *UserGetOrCreate) AddGroupIDs(ids ...string) *UserGetOrCreate {
+	if ugoc.groups == nil {
+		ugoc.groups = make(map[string]struct{})
+	}
+	for i := range ids {
+		ugoc.groups[ids[i]] = struct{}{}
+	}
+	return ugoc
+}
+
+// AddGroups adds the groups edges to Group.
+func (ugoc *UserGetOrCreate) AddGroups(g ...*Group) *UserGetOrCreate {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ugoc.AddGroupIDs(ids...)
+}
+
+// AddFriendIDs adds the friends edge to User by ids.
+func (ugoc *UserGetOrCreate) AddFriendIDs(ids ...string) *UserGetOrCreate {
+	if ugoc.friends == nil {
+		ugoc.friends = make(map[string]struct{})
+	}
+	for i := range ids {
+		ugoc.friends[ids[i]] = struct{}{}
+	}
+	return ugoc
+}
+
+// AddFriends adds the friends edges to User.
+func (ugoc *UserGetOrCreate) AddFriends(u ...*User) *UserGetOrCreate {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ugoc.AddFriendIDs(ids...)
+}
+
+// AddFollowerIDs adds the followers edge to User by ids.
+func (ugoc *UserGetOrCreate) AddFollowerIDs(ids ...string) *UserGetOrCreate {
+	if ugoc.followers == nil {
+		ugoc.followers = make(map[string]struct{})
+	}
+	for i := range ids {
+		ugoc.followers[ids[i]] = struct{}{}
+	}
+	return ugoc
+}
+
+// AddFollowers adds the followers edges to User.
+func (ugoc *UserGetOrCreate) AddFollowers(u ...*User) *UserGetOrCreate {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ugoc.AddFollowerIDs(ids...)
+}
+
+// AddFollowingIDs adds the following edge to User by ids.
+func (ugoc *UserGetOrCreate) AddFollowingIDs(ids ...string) *UserGetOrCreate {
+	if ugoc.following == nil {
+		ugoc.following = make(map[string]struct{})
+	}
+	for i := range ids {
+		ugoc.following[ids[i]] = struct{}{}
+	}
+	return ugoc
+}
+
+// AddFollowing adds the following edges to User.
+func (ugoc *UserGetOrCreate) AddFollowing(u ...*User) *UserGetOrCreate {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ugoc.AddFollowingIDs(ids...)
+}
+
+// SetTeamID sets the team edge to Pet by id.
+func (ugoc *UserGetOrCreate) SetTeamID(id string) *UserGetOrCreate {
+	if ugoc.team == nil {
+		ugoc.team = make(map[string]struct{})
+	}
+	ugoc.team[id] = struct{}{}
+	return ugoc
+}
+
+// SetNillableTeamID sets the team edge to Pet by id if the given value is not nil.
+func (ugoc *UserGetOrCreate) SetNillableTeamID(id *string) *UserGetOrCreate {
+	if id != nil {
+		ugoc = ugoc.SetTeamID(*id)
+	}
+	return ugoc
+}
+
+// SetTeam sets the team edge to Pet.
+func (ugoc *UserGetOrCreate) SetTeam(p *Pet) *UserGetOrCreate {
+	return ugoc.SetTeamID(p.ID)
+}
+
+// SetSpouseID sets the spouse edge to User by id.
+func (ugoc *UserGetOrCreate) SetSpouseID(id string) *UserGetOrCreate {
+	if ugoc.spouse == nil {
+		ugoc.spouse = make(map[string]struct{})
+	}
+	ugoc.spouse[id] = struct{}{}
+	return ugoc
+}
+
+// SetNillableSpouseID sets the spouse edge to User by id if the given value is not nil.
+func (ugoc *UserGetOrCreate) SetNillableSpouseID(id *string) *UserGetOrCreate {
+	if id != nil {
+		ugoc = ugoc.SetSpouseID(*id)
+	}
+	return ugoc
+}
+
+// SetSpouse sets the spouse edge to User.
+func (ugoc *UserGetOrCreate) SetSpouse(u *User) *UserGetOrCreate {
+	return ugoc.SetSpouseID(u.ID)
+}
+
+// AddChildIDs adds the children edge to User by ids.
+func (ugoc *UserGetOrCreate) AddChildIDs(ids ...string) *UserGetOrCreate {
+	if ugoc.children == nil {
+		ugoc.children = make(map[string]struct{})
+	}
+	for i := range ids {
+		ugoc.children[ids[i]] = struct{}{}
+	}
+	return ugoc
+}
+
+// AddChildren adds the children edges to User.
+func (ugoc *UserGetOrCreate) AddChildren(u ...*User) *UserGetOrCreate {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ugoc.AddChildIDs(ids...)
+}
+
+// SetParentID sets the parent edge to User by id.
+func (ugoc *UserGetOrCreate) SetParentID(id string) *UserGetOrCreate {
+	if ugoc.parent == nil {
+		ugoc.parent = make(map[string]struct{})
+	}
+	ugoc.parent[id] = struct{}{}
+	return ugoc
+}
+
+// SetNillableParentID sets the parent edge to User by id if the given value is not nil.
+func (ugoc *UserGetOrCreate) SetNillableParentID(id *string) *UserGetOrCreate {
+	if id != nil {
+		ugoc = ugoc.SetParentID(*id)
+	}
+	return ugoc
+}
+
+// SetParent sets the parent edge to User.
+func (ugoc *UserGetOrCreate) SetParent(u *User) *UserGetOrCreate {
+	return ugoc.SetParentID(u.ID)
+}
+
+// Save creates the User in the database. If the creation fails on a constraint violation, the
+// User that has the same value in one of the unique fields of the builder is returned instead.
+// Unlike querying the entity before creating it, it's safe for concurrent callers, since the uniqueness
+// is enforced by the storage. The constraint error is returned if there is no such User.
+func (ugoc *UserGetOrCreate) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	create := &UserCreate{config: ugoc.config, userMutation: ugoc.userMutation}
+	v, err := create.Save(ctx, opts...)
+	if err == nil || !IsConstraintFailure(err) {
+		return v, err
+	}
+	var ps []predicate.User
+	if create.nickname != nil {
+		ps = append(ps, user.NicknameEQ(*create.nickname))
+	}
+	if create.phone != nil {
+		ps = append(ps, user.PhoneEQ(*create.phone))
+	}
+	if len(ps) == 0 {
+		return nil, err
+	}
+	v, qerr := (&UserQuery{config: ugoc.config}).Where(user.Or(ps...)).Only(ctx)
+	switch {
+	case IsNotFound(qerr):
+		return nil, err
+	case qerr != nil:
+		return nil, qerr
+	}
+	return v, nil
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ugoc *UserGetOrCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	v, err := ugoc.Save(ctx, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
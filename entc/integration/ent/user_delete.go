@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -18,12 +20,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // UserDelete is the builder for deleting a User entity.
 type UserDelete struct {
 	config
 	predicates []predicate.User
+	// id of the node, if it's deleted using a UserDeleteOne builder.
+	id *string
 }
 
 // Where adds a new predicate to the delete builder.
@@ -33,7 +38,37 @@ func (ud *UserDelete) Where(ps ...predicate.User) *UserDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (ud *UserDelete) Exec(ctx context.Context) (int, error) {
+func (ud *UserDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	if ud.shadow != nil {
+		return ud.dualExec(ctx, opts...)
+	}
+	n, err := ud.exec(ctx)
+	if err != nil || n == 0 || options.SkipHooks {
+		return n, err
+	}
+	e := &Event{Op: ent.OpDelete, Type: user.Label, N: n}
+	if ud.id != nil {
+		e.Op, e.ID = ent.OpDeleteOne, *ud.id
+	}
+	ud.bus.publish(e)
+	return n, nil
+}
+
+// exec deletes the User nodes from the storage of the client dialect.
+func (ud *UserDelete) exec(ctx context.Context) (int, error) {
+	predicates, err := interceptUser(ctx, ud.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *ud
+		intercepted.predicates = append(predicates, ud.predicates...)
+		ud = &intercepted
+	}
 	switch ud.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ud.sqlExec(ctx)
@@ -45,8 +80,8 @@ func (ud *UserDelete) Exec(ctx context.Context) (int, error) {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ud *UserDelete) ExecX(ctx context.Context) int {
-	n, err := ud.Exec(ctx)
+func (ud *UserDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := ud.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -54,17 +89,81 @@ func (ud *UserDelete) ExecX(ctx context.Context) int {
 }
 
 func (ud *UserDelete) sqlExec(ctx context.Context) (int, error) {
-	var res sql.Result
-	selector := sql.Select().From(sql.Table(user.Table))
+	ctx = entcache.Invalidates(ctx, user.Tables...)
+	tx, err := ud.driver.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	selector := sql.Select(user.FieldID).From(sql.Table(user.Table)).SetDialect(ud.driver.Dialect()).WithContext(ctx)
 	for _, p := range ud.predicates {
 		p(selector)
 	}
-	query, args := sql.Delete(user.Table).FromSelect(selector).Query()
-	if err := ud.driver.Exec(ctx, query, args, &res); err != nil {
-		return 0, err
+	if err := selector.Err(); err != nil {
+		return 0, rollback(tx, err)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return 0, rollback(tx, err)
+	}
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, rollback(tx, fmt.Errorf("ent: failed reading id: %v", err))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if len(ids) == 0 {
+		return 0, tx.Commit()
+	}
+	if err := nullRefs(ctx, tx, "cards", "owner_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := nullRefs(ctx, tx, "files", "owner_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := nullRefs(ctx, tx, "pets", "owner_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := nullRefs(ctx, tx, "pets", "team_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := nullRefs(ctx, tx, "users", "user_spouse_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := nullRefs(ctx, tx, "users", "parent_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := deleteRefs(ctx, tx, "group_members", "member_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := deleteRefs(ctx, tx, "user_friends", "user_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := deleteRefs(ctx, tx, "user_friends", "friend_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := deleteRefs(ctx, tx, "user_following", "user_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := deleteRefs(ctx, tx, "user_following", "follower_id", ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	var res sql.Result
+	query, args = sql.Delete(user.Table).Where(sql.InInts(user.FieldID, ids...)).Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return 0, rollback(tx, err)
 	}
 	affected, err := res.RowsAffected()
 	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
 		return 0, err
 	}
 	return int(affected), nil
@@ -72,8 +171,9 @@ func (ud *UserDelete) sqlExec(ctx context.Context) (int, error) {
 
 func (ud *UserDelete) gremlinExec(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := ud.gremlin().Query()
-	if err := ud.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ud.gremlin()
+	query, _ := traversal.Query()
+	if err := ud.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -93,12 +193,12 @@ type UserDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (udo *UserDeleteOne) Exec(ctx context.Context) error {
-	n, err := udo.ud.Exec(ctx)
+func (udo *UserDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := udo.ud.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{user.Label}
 	default:
 		return nil
@@ -106,6 +206,8 @@ func (udo *UserDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (udo *UserDeleteOne) ExecX(ctx context.Context) {
-	udo.ud.ExecX(ctx)
+func (udo *UserDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := udo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
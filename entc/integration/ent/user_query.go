@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -29,14 +31,36 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit     *int
+	offset    *int
+	order     []Order
+	unique    []string
+	fields    []string
+	unordered bool
+	hints     []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.User
+	// eager-loading edges.
+	withCard      *CardQuery
+	withPets      *PetQuery
+	withFiles     *FileQuery
+	withGroups    *GroupQuery
+	withFriends   *UserQuery
+	withFollowers *UserQuery
+	withFollowing *UserQuery
+	withTeam      *PetQuery
+	withSpouse    *UserQuery
+	withChildren  *UserQuery
+	withParent    *UserQuery
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath     func(context.Context) (*sql.Selector, error)
+	gremlinPath func(context.Context) (*dsl.Traversal, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -63,21 +87,135 @@ func (uq *UserQuery) Order(o ...Order) *UserQuery {
 	return uq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored. Note that, hints are ignored by Gremlin queries.
+func (uq *UserQuery) Hint(hints ...sql.Hint) *UserQuery {
+	uq.hints = append(uq.hints, hints...)
+	return uq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	us, err := client.User.Query().
+//		Fields(user.FieldAge, user.FieldName).
+//		All(ctx)
+//
+func (uq *UserQuery) Fields(fields ...string) *UserQuery {
+	uq.fields = append(uq.fields, fields...)
+	return uq
+}
+
+// Unordered disables the ordering by id that is added to the Gremlin queries that are paginated
+// using Limit or Offset. The order of the vertices
+// (and therefore, the content of the pages) is not guaranteed without it, but it can be removed when
+// the order is irrelevant (e.g. sampling).
+func (uq *UserQuery) Unordered() *UserQuery {
+	uq.unordered = true
+	return uq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (uq *UserQuery) Scope(scopes ...user.Scope) *UserQuery {
+	for _, s := range scopes {
+		uq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				uq.Order(Desc(o.Field))
+			} else {
+				uq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			uq.Limit(s.Limit)
+		}
+	}
+	return uq
+}
+
+// UserInterceptor is a query interceptor of the UserClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the UserClient.Intercept method.
+type UserInterceptor func(context.Context, *UserQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (uq *UserQuery) prepare(ctx context.Context) (*UserQuery, error) {
+	var interceptors []UserInterceptor
+	if uq.inters != nil {
+		uq.inters.mu.RLock()
+		interceptors = uq.inters.User
+		uq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && uq.sqlPath == nil && uq.gremlinPath == nil {
+		return uq, nil
+	}
+	query := uq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	if path := query.gremlinPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.gremlin, query.gremlinPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptUser returns the predicates that the interceptors of the client add to a User query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptUser(ctx context.Context, cfg config) ([]predicate.User, error) {
+	query, err := (&UserQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryCard chains the current query on the card edge.
 func (uq *UserQuery) QueryCard() *CardQuery {
 	query := &CardQuery{config: uq.config}
 	switch uq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(card.Table)
-		t2 := uq.sqlQuery()
-		t2.Select(t2.C(user.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t2).
-			On(t1.C(user.CardColumn), t2.C(user.FieldID))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(card.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(user.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t2).
+				On(t1.C(user.CardColumn), t2.C(user.FieldID)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := uq.gremlinQuery()
-		query.gremlin = gremlin.OutE(user.CardLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(user.CardLabel).InV(), nil
+		}
 	}
 	return query
 }
@@ -87,16 +225,29 @@ func (uq *UserQuery) QueryPets() *PetQuery {
 	query := &PetQuery{config: uq.config}
 	switch uq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(pet.Table)
-		t2 := uq.sqlQuery()
-		t2.Select(t2.C(user.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t2).
-			On(t1.C(user.PetsColumn), t2.C(user.FieldID))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(pet.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(user.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t2).
+				On(t1.C(user.PetsColumn), t2.C(user.FieldID)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := uq.gremlinQuery()
-		query.gremlin = gremlin.OutE(user.PetsLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(user.PetsLabel).InV(), nil
+		}
 	}
 	return query
 }
@@ -106,16 +257,29 @@ func (uq *UserQuery) QueryFiles() *FileQuery {
 	query := &FileQuery{config: uq.config}
 	switch uq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(file.Table)
-		t2 := uq.sqlQuery()
-		t2.Select(t2.C(user.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t2).
-			On(t1.C(user.FilesColumn), t2.C(user.FieldID))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(file.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(user.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t2).
+				On(t1.C(user.FilesColumn), t2.C(user.FieldID)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := uq.gremlinQuery()
-		query.gremlin = gremlin.OutE(user.FilesLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(user.FilesLabel).InV(), nil
+		}
 	}
 	return query
 }
@@ -125,21 +289,34 @@ func (uq *UserQuery) QueryGroups() *GroupQuery {
 	query := &GroupQuery{config: uq.config}
 	switch uq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(group.Table)
-		t2 := uq.sqlQuery()
-		t2.Select(t2.C(user.FieldID))
-		t3 := sql.Table(user.GroupsTable)
-		t4 := sql.Select(t3.C(user.GroupsPrimaryKey[1])).
-			From(t3).
-			Join(t2).
-			On(t3.C(user.GroupsPrimaryKey[0]), t2.C(user.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t4).
-			On(t1.C(group.FieldID), t4.C(user.GroupsPrimaryKey[1]))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(group.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(user.FieldID))
+			t3 := sql.Table(user.GroupsTable)
+			t4 := sql.Select(t3.C(user.GroupsPrimaryKey[1])).
+				From(t3).
+				Join(t2).
+				On(t3.C(user.GroupsPrimaryKey[0]), t2.C(user.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t4).
+				On(t1.C(group.FieldID), t4.C(user.GroupsPrimaryKey[1])), nil
+		}
 	case dialect.Gremlin:
-		gremlin := uq.gremlinQuery()
-		query.gremlin = gremlin.OutE(user.GroupsLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(user.GroupsLabel).InV(), nil
+		}
 	}
 	return query
 }
@@ -149,21 +326,34 @@ func (uq *UserQuery) QueryFriends() *UserQuery {
 	query := &UserQuery{config: uq.config}
 	switch uq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := uq.sqlQuery()
-		t2.Select(t2.C(user.FieldID))
-		t3 := sql.Table(user.FriendsTable)
-		t4 := sql.Select(t3.C(user.FriendsPrimaryKey[1])).
-			From(t3).
-			Join(t2).
-			On(t3.C(user.FriendsPrimaryKey[0]), t2.C(user.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t4).
-			On(t1.C(user.FieldID), t4.C(user.FriendsPrimaryKey[1]))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(user.FieldID))
+			t3 := sql.Table(user.FriendsTable)
+			t4 := sql.Select(t3.C(user.FriendsPrimaryKey[1])).
+				From(t3).
+				Join(t2).
+				On(t3.C(user.FriendsPrimaryKey[0]), t2.C(user.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t4).
+				On(t1.C(user.FieldID), t4.C(user.FriendsPrimaryKey[1])), nil
+		}
 	case dialect.Gremlin:
-		gremlin := uq.gremlinQuery()
-		query.gremlin = gremlin.Both(user.FriendsLabel)
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.Both(user.FriendsLabel), nil
+		}
 	}
 	return query
 }
@@ -173,21 +363,34 @@ func (uq *UserQuery) QueryFollowers() *UserQuery {
 	query := &UserQuery{config: uq.config}
 	switch uq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := uq.sqlQuery()
-		t2.Select(t2.C(user.FieldID))
-		t3 := sql.Table(user.FollowersTable)
-		t4 := sql.Select(t3.C(user.FollowersPrimaryKey[0])).
-			From(t3).
-			Join(t2).
-			On(t3.C(user.FollowersPrimaryKey[1]), t2.C(user.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t4).
-			On(t1.C(user.FieldID), t4.C(user.FollowersPrimaryKey[0]))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(user.FieldID))
+			t3 := sql.Table(user.FollowersTable)
+			t4 := sql.Select(t3.C(user.FollowersPrimaryKey[0])).
+				From(t3).
+				Join(t2).
+				On(t3.C(user.FollowersPrimaryKey[1]), t2.C(user.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t4).
+				On(t1.C(user.FieldID), t4.C(user.FollowersPrimaryKey[0])), nil
+		}
 	case dialect.Gremlin:
-		gremlin := uq.gremlinQuery()
-		query.gremlin = gremlin.InE(user.FollowingLabel).OutV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.InE(user.FollowingLabel).OutV(), nil
+		}
 	}
 	return query
 }
@@ -197,21 +400,34 @@ func (uq *UserQuery) QueryFollowing() *UserQuery {
 	query := &UserQuery{config: uq.config}
 	switch uq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := uq.sqlQuery()
-		t2.Select(t2.C(user.FieldID))
-		t3 := sql.Table(user.FollowingTable)
-		t4 := sql.Select(t3.C(user.FollowingPrimaryKey[1])).
-			From(t3).
-			Join(t2).
-			On(t3.C(user.FollowingPrimaryKey[0]), t2.C(user.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t4).
-			On(t1.C(user.FieldID), t4.C(user.FollowingPrimaryKey[1]))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(user.FieldID))
+			t3 := sql.Table(user.FollowingTable)
+			t4 := sql.Select(t3.C(user.FollowingPrimaryKey[1])).
+				From(t3).
+				Join(t2).
+				On(t3.C(user.FollowingPrimaryKey[0]), t2.C(user.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t4).
+				On(t1.C(user.FieldID), t4.C(user.FollowingPrimaryKey[1])), nil
+		}
 	case dialect.Gremlin:
-		gremlin := uq.gremlinQuery()
-		query.gremlin = gremlin.OutE(user.FollowingLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(user.FollowingLabel).InV(), nil
+		}
 	}
 	return query
 }
@@ -221,16 +437,29 @@ func (uq *UserQuery) QueryTeam() *PetQuery {
 	query := &PetQuery{config: uq.config}
 	switch uq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(pet.Table)
-		t2 := uq.sqlQuery()
-		t2.Select(t2.C(user.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t2).
-			On(t1.C(user.TeamColumn), t2.C(user.FieldID))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(pet.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(user.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t2).
+				On(t1.C(user.TeamColumn), t2.C(user.FieldID)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := uq.gremlinQuery()
-		query.gremlin = gremlin.OutE(user.TeamLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(user.TeamLabel).InV(), nil
+		}
 	}
 	return query
 }
@@ -240,16 +469,29 @@ func (uq *UserQuery) QuerySpouse() *UserQuery {
 	query := &UserQuery{config: uq.config}
 	switch uq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := uq.sqlQuery()
-		t2.Select(t2.C(user.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t2).
-			On(t1.C(user.SpouseColumn), t2.C(user.FieldID))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(user.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t2).
+				On(t1.C(user.SpouseColumn), t2.C(user.FieldID)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := uq.gremlinQuery()
-		query.gremlin = gremlin.Both(user.SpouseLabel)
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.Both(user.SpouseLabel), nil
+		}
 	}
 	return query
 }
@@ -259,16 +501,29 @@ func (uq *UserQuery) QueryChildren() *UserQuery {
 	query := &UserQuery{config: uq.config}
 	switch uq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := uq.sqlQuery()
-		t2.Select(t2.C(user.FieldID))
-		query.sql = sql.Select().
-			From(t1).
-			Join(t2).
-			On(t1.C(user.ChildrenColumn), t2.C(user.FieldID))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(user.FieldID))
+			return sql.Select().
+				From(t1).
+				Join(t2).
+				On(t1.C(user.ChildrenColumn), t2.C(user.FieldID)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := uq.gremlinQuery()
-		query.gremlin = gremlin.InE(user.ParentLabel).OutV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.InE(user.ParentLabel).OutV(), nil
+		}
 	}
 	return query
 }
@@ -278,20 +533,242 @@ func (uq *UserQuery) QueryParent() *UserQuery {
 	query := &UserQuery{config: uq.config}
 	switch uq.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		t1 := sql.Table(user.Table)
-		t2 := uq.sqlQuery()
-		t2.Select(t2.C(user.ParentColumn))
-		query.sql = sql.Select(t1.Columns(user.Columns...)...).
-			From(t1).
-			Join(t2).
-			On(t1.C(user.FieldID), t2.C(user.ParentColumn))
+		query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t1 := sql.Table(user.Table)
+			t2 := prev.sqlQuery(ctx)
+			t2.Select(t2.C(user.ParentColumn))
+			return sql.Select(t1.Columns(user.Columns...)...).
+				From(t1).
+				Join(t2).
+				On(t1.C(user.FieldID), t2.C(user.ParentColumn)), nil
+		}
 	case dialect.Gremlin:
-		gremlin := uq.gremlinQuery()
-		query.gremlin = gremlin.OutE(user.ParentLabel).InV()
+		query.gremlinPath = func(ctx context.Context) (*dsl.Traversal, error) {
+			prev, err := uq.prepare(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// the default orders select the vertices of paginated queries.
+			gremlin := prev.gremlinTraversal(prev.limit != nil || prev.offset != nil)
+			return gremlin.OutE(user.ParentLabel).InV(), nil
+		}
 	}
 	return query
 }
 
+// WithCard tells the query-builder to eager-load the nodes that are connected to the "card" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.User.Query().
+//		WithCard(func(q *ent.CardQuery) {
+//			q.Order(ent.Desc(card.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (uq *UserQuery) WithCard(opts ...func(*CardQuery)) *UserQuery {
+	query := &CardQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withCard = query
+	return uq
+}
+
+// WithPets tells the query-builder to eager-load the nodes that are connected to the "pets" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.User.Query().
+//		WithPets(func(q *ent.PetQuery) {
+//			q.Order(ent.Desc(pet.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (uq *UserQuery) WithPets(opts ...func(*PetQuery)) *UserQuery {
+	query := &PetQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withPets = query
+	return uq
+}
+
+// WithFiles tells the query-builder to eager-load the nodes that are connected to the "files" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.User.Query().
+//		WithFiles(func(q *ent.FileQuery) {
+//			q.Order(ent.Desc(file.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (uq *UserQuery) WithFiles(opts ...func(*FileQuery)) *UserQuery {
+	query := &FileQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withFiles = query
+	return uq
+}
+
+// WithGroups tells the query-builder to eager-load the nodes that are connected to the "groups" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.User.Query().
+//		WithGroups(func(q *ent.GroupQuery) {
+//			q.Order(ent.Desc(group.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (uq *UserQuery) WithGroups(opts ...func(*GroupQuery)) *UserQuery {
+	query := &GroupQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withGroups = query
+	return uq
+}
+
+// WithFriends tells the query-builder to eager-load the nodes that are connected to the "friends" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.User.Query().
+//		WithFriends(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (uq *UserQuery) WithFriends(opts ...func(*UserQuery)) *UserQuery {
+	query := &UserQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withFriends = query
+	return uq
+}
+
+// WithFollowers tells the query-builder to eager-load the nodes that are connected to the "followers" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.User.Query().
+//		WithFollowers(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (uq *UserQuery) WithFollowers(opts ...func(*UserQuery)) *UserQuery {
+	query := &UserQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withFollowers = query
+	return uq
+}
+
+// WithFollowing tells the query-builder to eager-load the nodes that are connected to the "following" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.User.Query().
+//		WithFollowing(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (uq *UserQuery) WithFollowing(opts ...func(*UserQuery)) *UserQuery {
+	query := &UserQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withFollowing = query
+	return uq
+}
+
+// WithTeam tells the query-builder to eager-load the nodes that are connected to the "team" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.User.Query().
+//		WithTeam(func(q *ent.PetQuery) {
+//			q.Order(ent.Desc(pet.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (uq *UserQuery) WithTeam(opts ...func(*PetQuery)) *UserQuery {
+	query := &PetQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withTeam = query
+	return uq
+}
+
+// WithSpouse tells the query-builder to eager-load the nodes that are connected to the "spouse" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.User.Query().
+//		WithSpouse(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (uq *UserQuery) WithSpouse(opts ...func(*UserQuery)) *UserQuery {
+	query := &UserQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSpouse = query
+	return uq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to the "children" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.User.Query().
+//		WithChildren(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (uq *UserQuery) WithChildren(opts ...func(*UserQuery)) *UserQuery {
+	query := &UserQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withChildren = query
+	return uq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to the "parent" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.User.Query().
+//		WithParent(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (uq *UserQuery) WithParent(opts ...func(*UserQuery)) *UserQuery {
+	query := &UserQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withParent = query
+	return uq
+}
+
 // First returns the first User entity in the query. Returns *ErrNotFound when no user was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
 	us, err := uq.Limit(1).All(ctx)
@@ -337,7 +814,7 @@ func (uq *UserQuery) FirstXID(ctx context.Context) string {
 
 // Only returns the only User entity in the query, returns an error if not exactly one entity was returned.
 func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
-	us, err := uq.Limit(2).All(ctx)
+	us, err := uq.Limit(2).Unordered().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -363,7 +840,7 @@ func (uq *UserQuery) OnlyX(ctx context.Context) *User {
 // OnlyID returns the only User id in the query, returns an error if not exactly one id was returned.
 func (uq *UserQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = uq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = uq.Limit(2).Unordered().IDs(ctx); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -387,33 +864,63 @@ func (uq *UserQuery) OnlyXID(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of Users.
-func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
-	switch uq.driver.Dialect() {
+func (uq *UserQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*User, error) {
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if query.shadow != nil && !ent.NewCallOptions(opts...).SkipHooks {
+		return query.dualAll(ctx)
+	}
+
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return uq.sqlAll(ctx)
+		return query.sqlAll(ctx)
 	case dialect.Gremlin:
-		return uq.gremlinAll(ctx)
+		return query.gremlinAll(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
 }
 
 // AllX is like All, but panics if an error occurs.
-func (uq *UserQuery) AllX(ctx context.Context) []*User {
-	us, err := uq.All(ctx)
+func (uq *UserQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*User {
+	us, err := uq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return us
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (uq *UserQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlQueryString(ctx)
+	case dialect.Gremlin:
+		return query.gremlinQueryString(ctx)
+	default:
+		return "", nil, errors.New("ent: unsupported dialect")
+	}
+}
+
 // IDs executes the query and returns a list of User ids.
 func (uq *UserQuery) IDs(ctx context.Context) ([]string, error) {
-	switch uq.driver.Dialect() {
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return uq.sqlIDs(ctx)
+		return query.sqlIDs(ctx)
 	case dialect.Gremlin:
-		return uq.gremlinIDs(ctx)
+		return query.gremlinIDs(ctx)
 	default:
 		return nil, errors.New("ent: unsupported dialect")
 	}
@@ -428,142 +935,1107 @@ func (uq *UserQuery) IDsX(ctx context.Context) []string {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Users that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (uq *UserQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if uq.sql != nil || uq.gremlin != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &UserQuery{
+		config:     uq.config,
+		predicates: append([]predicate.User{}, uq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(string)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for User", after)
+		}
+		query.Where(user.IDGT(id))
+	}
+	ids, err := query.Order(Asc(user.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (uq *UserQuery) Count(ctx context.Context) (int, error) {
-	switch uq.driver.Dialect() {
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	switch query.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
-		return uq.sqlCount(ctx)
+		return query.sqlCount(ctx)
 	case dialect.Gremlin:
-		return uq.gremlinCount(ctx)
+		return query.gremlinCount(ctx)
 	default:
 		return 0, errors.New("ent: unsupported dialect")
 	}
-}
-
-// CountX is like Count, but panics if an error occurs.
-func (uq *UserQuery) CountX(ctx context.Context) int {
-	count, err := uq.Count(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (uq *UserQuery) CountX(ctx context.Context) int {
+	count, err := uq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (uq *UserQuery) Exist(ctx context.Context) (bool, error) {
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch query.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		return query.sqlExist(ctx)
+	case dialect.Gremlin:
+		return query.gremlinExist(ctx)
+	default:
+		return false, errors.New("ent: unsupported dialect")
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (uq *UserQuery) ExistX(ctx context.Context) bool {
+	exist, err := uq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (uq *UserQuery) Clone() *UserQuery {
+	return &UserQuery{
+		config:        uq.config,
+		limit:         uq.limit,
+		offset:        uq.offset,
+		order:         append([]Order{}, uq.order...),
+		unique:        append([]string{}, uq.unique...),
+		fields:        append([]string{}, uq.fields...),
+		unordered:     uq.unordered,
+		hints:         append([]sql.Hint{}, uq.hints...),
+		edgeCount:     uq.edgeCount,
+		predicates:    append([]predicate.User{}, uq.predicates...),
+		withCard:      uq.withCard,
+		withPets:      uq.withPets,
+		withFiles:     uq.withFiles,
+		withGroups:    uq.withGroups,
+		withFriends:   uq.withFriends,
+		withFollowers: uq.withFollowers,
+		withFollowing: uq.withFollowing,
+		withTeam:      uq.withTeam,
+		withSpouse:    uq.withSpouse,
+		withChildren:  uq.withChildren,
+		withParent:    uq.withParent,
+		// clone intermediate queries.
+		sql:         uq.sql.Clone(),
+		sqlPath:     uq.sqlPath,
+		gremlin:     uq.gremlin.Clone(),
+		gremlinPath: uq.gremlinPath,
+	}
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Age int `json:"age,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		GroupBy(user.FieldAge).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
+	group := &UserGroupBy{config: uq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) error {
+		query, err := uq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			group.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			group.gremlin = query.gremlinQuery()
+		}
+		return nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Age int `json:"age,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		Select(user.FieldAge).
+//		Scan(ctx, &v)
+//
+func (uq *UserQuery) Select(field string, fields ...string) *UserSelect {
+	selector := &UserSelect{config: uq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) error {
+		query, err := uq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		switch query.driver.Dialect() {
+		case dialect.MySQL, dialect.SQLite:
+			selector.sql = query.sqlQuery(ctx)
+		case dialect.Gremlin:
+			selector.gremlin = query.gremlinQuery()
+		}
+		return nil
+	}
+	return selector
+}
+
+func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
+	rows := &sql.Rows{}
+	selector, columns, err := uq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, args := selector.Query()
+	if err := uq.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var us Users
+	if err := us.scan(rows, columns); err != nil {
+		return nil, err
+	}
+	us.config(uq.config)
+	if query := uq.withCard; query != nil {
+		if err := uq.sqlLoadCard(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withPets; query != nil {
+		if err := uq.sqlLoadPets(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFiles; query != nil {
+		if err := uq.sqlLoadFiles(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withGroups; query != nil {
+		if err := uq.sqlLoadGroups(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFriends; query != nil {
+		if err := uq.sqlLoadFriends(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFollowers; query != nil {
+		if err := uq.sqlLoadFollowers(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFollowing; query != nil {
+		if err := uq.sqlLoadFollowing(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withTeam; query != nil {
+		if err := uq.sqlLoadTeam(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSpouse; query != nil {
+		if err := uq.sqlLoadSpouse(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withChildren; query != nil {
+		if err := uq.sqlLoadChildren(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withParent; query != nil {
+		if err := uq.sqlLoadParent(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	return us, nil
+}
+
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (uq *UserQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := uq.sqlQuery(ctx)
+	if unique := uq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := user.Columns
+	if fields := uq.fields; len(fields) > 0 {
+		columns = append([]string{user.FieldID}, fields...)
+		for _, c := range fields {
+			if !user.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (uq *UserQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := uq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadCard loads the nodes of the card edge of the given Users using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (uq *UserQuery) sqlLoadCard(ctx context.Context, query *CardQuery, nodes []*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	key := selector.C(user.CardColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(card.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := uq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.Card{card.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*Card, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Card = edge
+	}
+	return nil
+}
+
+// sqlLoadPets loads the nodes of the pets edge of the given Users using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (uq *UserQuery) sqlLoadPets(ctx context.Context, query *PetQuery, nodes []*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	key := selector.C(user.PetsColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(pet.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := uq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.Pet{pet.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*Pet, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Pets = append(node.Edges.Pets, edge)
+	}
+	return nil
+}
+
+// sqlLoadFiles loads the nodes of the files edge of the given Users using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (uq *UserQuery) sqlLoadFiles(ctx context.Context, query *FileQuery, nodes []*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	key := selector.C(user.FilesColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(file.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := uq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.File{file.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*File, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Files = append(node.Edges.Files, edge)
+	}
+	return nil
+}
+
+// sqlLoadGroups loads the nodes of the groups edge of the given Users using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (uq *UserQuery) sqlLoadGroups(ctx context.Context, query *GroupQuery, nodes []*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(user.GroupsTable)
+	selector.Join(t1).On(t1.C(user.GroupsPrimaryKey[1]), selector.C(group.FieldID))
+	key := t1.C(user.GroupsPrimaryKey[0])
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(group.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := uq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.Group{group.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*Group, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Groups = append(node.Edges.Groups, edge)
+	}
+	return nil
+}
+
+// sqlLoadFriends loads the nodes of the friends edge of the given Users using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (uq *UserQuery) sqlLoadFriends(ctx context.Context, query *UserQuery, nodes []*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(user.FriendsTable)
+	selector.Join(t1).On(t1.C(user.FriendsPrimaryKey[1]), selector.C(user.FieldID))
+	key := t1.C(user.FriendsPrimaryKey[0])
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := uq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Friends = append(node.Edges.Friends, edge)
+	}
+	return nil
+}
+
+// sqlLoadFollowers loads the nodes of the followers edge of the given Users using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (uq *UserQuery) sqlLoadFollowers(ctx context.Context, query *UserQuery, nodes []*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(user.FollowersTable)
+	selector.Join(t1).On(t1.C(user.FollowersPrimaryKey[0]), selector.C(user.FieldID))
+	key := t1.C(user.FollowersPrimaryKey[1])
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := uq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Followers = append(node.Edges.Followers, edge)
+	}
+	return nil
+}
+
+// sqlLoadFollowing loads the nodes of the following edge of the given Users using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (uq *UserQuery) sqlLoadFollowing(ctx context.Context, query *UserQuery, nodes []*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(user.FollowingTable)
+	selector.Join(t1).On(t1.C(user.FollowingPrimaryKey[1]), selector.C(user.FieldID))
+	key := t1.C(user.FollowingPrimaryKey[0])
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := uq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Following = append(node.Edges.Following, edge)
+	}
+	return nil
+}
+
+// sqlLoadTeam loads the nodes of the team edge of the given Users using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (uq *UserQuery) sqlLoadTeam(ctx context.Context, query *PetQuery, nodes []*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	key := selector.C(user.TeamColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(pet.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := uq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.Pet{pet.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*Pet, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Team = edge
+	}
+	return nil
+}
+
+// sqlLoadSpouse loads the nodes of the spouse edge of the given Users using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (uq *UserQuery) sqlLoadSpouse(ctx context.Context, query *UserQuery, nodes []*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	key := selector.C(user.SpouseColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := uq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	return count
-}
-
-// Exist returns true if the query has elements in the graph.
-func (uq *UserQuery) Exist(ctx context.Context) (bool, error) {
-	switch uq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		return uq.sqlExist(ctx)
-	case dialect.Gremlin:
-		return uq.gremlinExist(ctx)
-	default:
-		return false, errors.New("ent: unsupported dialect")
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Spouse = edge
 	}
+	return nil
 }
 
-// ExistX is like Exist, but panics if an error occurs.
-func (uq *UserQuery) ExistX(ctx context.Context) bool {
-	exist, err := uq.Exist(ctx)
+// sqlLoadChildren loads the nodes of the children edge of the given Users using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (uq *UserQuery) sqlLoadChildren(ctx context.Context, query *UserQuery, nodes []*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	return exist
-}
-
-// Clone returns a duplicate of the query builder, including all associated steps. It can be
-// used to prepare common query builders and use them differently after the clone is made.
-func (uq *UserQuery) Clone() *UserQuery {
-	return &UserQuery{
-		config:     uq.config,
-		limit:      uq.limit,
-		offset:     uq.offset,
-		order:      append([]Order{}, uq.order...),
-		unique:     append([]string{}, uq.unique...),
-		predicates: append([]predicate.User{}, uq.predicates...),
-		// clone intermediate queries.
-		sql:     uq.sql.Clone(),
-		gremlin: uq.gremlin.Clone(),
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
 	}
-}
-
-// GroupBy used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		Age int `json:"age,omitempty"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		GroupBy(user.FieldAge).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-//
-func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
-	group := &UserGroupBy{config: uq.config}
-	group.fields = append([]string{field}, fields...)
-	switch uq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		group.sql = uq.sqlQuery()
-	case dialect.Gremlin:
-		group.gremlin = uq.gremlinQuery()
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
 	}
-	return group
-}
-
-// Select one or more fields from the given query.
-//
-// Example:
-//
-//	var v []struct {
-//		Age int `json:"age,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		Select(user.FieldAge).
-//		Scan(ctx, &v)
-//
-func (uq *UserQuery) Select(field string, fields ...string) *UserSelect {
-	selector := &UserSelect{config: uq.config}
-	selector.fields = append([]string{field}, fields...)
-	switch uq.driver.Dialect() {
-	case dialect.MySQL, dialect.SQLite:
-		selector.sql = uq.sqlQuery()
-	case dialect.Gremlin:
-		selector.gremlin = uq.gremlinQuery()
+	key := selector.C(user.ChildrenColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
 	}
-	return selector
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := uq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Children = append(node.Edges.Children, edge)
+	}
+	return nil
 }
 
-func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
-	rows := &sql.Rows{}
-	selector := uq.sqlQuery()
-	if unique := uq.unique; len(unique) == 0 {
-		selector.Distinct()
+// sqlLoadParent loads the nodes of the parent edge of the given Users using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (uq *UserQuery) sqlLoadParent(ctx context.Context, query *UserQuery, nodes []*User) error {
+	if len(nodes) == 0 {
+		return nil
 	}
-	query, args := selector.Query()
-	if err := uq.driver.Query(ctx, query, args, rows); err != nil {
-		return nil, err
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[string]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i], _ = strconv.Atoi(node.ID)
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(user.ParentTable)
+	selector.Join(t1).On(t1.C(user.ParentColumn), selector.C(user.FieldID))
+	key := t1.C(user.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := uq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
 	}
 	defer rows.Close()
-	var us Users
-	if err := us.FromRows(rows); err != nil {
-		return nil, err
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []string
+		eids []string
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, strconv.Itoa(nid))
+		eids = append(eids, strconv.Itoa(eid))
 	}
-	us.config(uq.config)
-	return us, nil
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[string]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Parent = edge
+	}
+	return nil
 }
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := uq.sqlQuery()
+	selector := uq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{user.FieldID}
 	if len(uq.unique) > 0 {
 		unique = uq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := uq.edgeCount; c != nil && len(uq.predicates) == 0 && len(uq.unique) == 0 &&
+		len(uq.hints) == 0 && uq.limit == nil && uq.offset == nil {
+		selector = c.Clone().SetDialect(uq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := uq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -580,11 +2052,22 @@ func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (uq *UserQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := uq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := uq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(user.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := uq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := uq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (uq *UserQuery) sqlIDs(ctx context.Context) ([]string, error) {
@@ -599,13 +2082,33 @@ func (uq *UserQuery) sqlIDs(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
-func (uq *UserQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (uq *UserQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(user.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(user.Table)
 	selector := sql.Select(t1.Columns(user.Columns...)...).From(t1)
 	if uq.sql != nil {
 		selector = uq.sql
 		selector.Select(selector.Columns(user.Columns...)...)
 	}
+	selector.SetDialect(uq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(uq.hints...)
 	for _, p := range uq.predicates {
 		p(selector)
 	}
@@ -625,8 +2128,9 @@ func (uq *UserQuery) sqlQuery() *sql.Selector {
 
 func (uq *UserQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := uq.gremlinQuery().Query()
-	if err := uq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := uq.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := uq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	vertices, err := res.ReadVertices()
@@ -642,8 +2146,9 @@ func (uq *UserQuery) gremlinIDs(ctx context.Context) ([]string, error) {
 
 func (uq *UserQuery) gremlinAll(ctx context.Context) ([]*User, error) {
 	res := &gremlin.Response{}
-	query, bindings := uq.gremlinQuery().ValueMap(true).Query()
-	if err := uq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := uq.gremlinAllQuery()
+	query, _ := traversal.Query()
+	if err := uq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	var us Users
@@ -651,13 +2156,311 @@ func (uq *UserQuery) gremlinAll(ctx context.Context) ([]*User, error) {
 		return nil, err
 	}
 	us.config(uq.config)
+	if query := uq.withCard; query != nil {
+		if err := uq.gremlinLoadCard(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withPets; query != nil {
+		if err := uq.gremlinLoadPets(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFiles; query != nil {
+		if err := uq.gremlinLoadFiles(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withGroups; query != nil {
+		if err := uq.gremlinLoadGroups(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFriends; query != nil {
+		if err := uq.gremlinLoadFriends(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFollowers; query != nil {
+		if err := uq.gremlinLoadFollowers(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFollowing; query != nil {
+		if err := uq.gremlinLoadFollowing(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withTeam; query != nil {
+		if err := uq.gremlinLoadTeam(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSpouse; query != nil {
+		if err := uq.gremlinLoadSpouse(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withChildren; query != nil {
+		if err := uq.gremlinLoadChildren(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withParent; query != nil {
+		if err := uq.gremlinLoadParent(ctx, query, us); err != nil {
+			return nil, err
+		}
+	}
 	return us, nil
 }
 
+// gremlinAllQuery returns the traversal of All, that returns the value maps of the vertices.
+func (uq *UserQuery) gremlinAllQuery() *dsl.Traversal {
+	args := []interface{}{true}
+	for _, f := range uq.fields {
+		args = append(args, f)
+	}
+	return uq.gremlinQuery().ValueMap(args...)
+}
+
+func (uq *UserQuery) gremlinQueryString(context.Context) (string, interface{}, error) {
+	query, bindings := uq.gremlinAllQuery().Query()
+	return query, bindings, nil
+}
+
+// gremlinLoadCard loads the nodes of the card edge of the given Users using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (uq *UserQuery) gremlinLoadCard(ctx context.Context, query *CardQuery, nodes []*User) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&UserClient{config: uq.config}).QueryCard(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Card = edges[0]
+		}
+	}
+	return nil
+}
+
+// gremlinLoadPets loads the nodes of the pets edge of the given Users using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (uq *UserQuery) gremlinLoadPets(ctx context.Context, query *PetQuery, nodes []*User) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&UserClient{config: uq.config}).QueryPets(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Pets = edges
+	}
+	return nil
+}
+
+// gremlinLoadFiles loads the nodes of the files edge of the given Users using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (uq *UserQuery) gremlinLoadFiles(ctx context.Context, query *FileQuery, nodes []*User) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&UserClient{config: uq.config}).QueryFiles(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Files = edges
+	}
+	return nil
+}
+
+// gremlinLoadGroups loads the nodes of the groups edge of the given Users using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (uq *UserQuery) gremlinLoadGroups(ctx context.Context, query *GroupQuery, nodes []*User) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&UserClient{config: uq.config}).QueryGroups(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Groups = edges
+	}
+	return nil
+}
+
+// gremlinLoadFriends loads the nodes of the friends edge of the given Users using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (uq *UserQuery) gremlinLoadFriends(ctx context.Context, query *UserQuery, nodes []*User) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&UserClient{config: uq.config}).QueryFriends(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Friends = edges
+	}
+	return nil
+}
+
+// gremlinLoadFollowers loads the nodes of the followers edge of the given Users using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (uq *UserQuery) gremlinLoadFollowers(ctx context.Context, query *UserQuery, nodes []*User) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&UserClient{config: uq.config}).QueryFollowers(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Followers = edges
+	}
+	return nil
+}
+
+// gremlinLoadFollowing loads the nodes of the following edge of the given Users using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (uq *UserQuery) gremlinLoadFollowing(ctx context.Context, query *UserQuery, nodes []*User) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&UserClient{config: uq.config}).QueryFollowing(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Following = edges
+	}
+	return nil
+}
+
+// gremlinLoadTeam loads the nodes of the team edge of the given Users using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (uq *UserQuery) gremlinLoadTeam(ctx context.Context, query *PetQuery, nodes []*User) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&UserClient{config: uq.config}).QueryTeam(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Team = edges[0]
+		}
+	}
+	return nil
+}
+
+// gremlinLoadSpouse loads the nodes of the spouse edge of the given Users using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (uq *UserQuery) gremlinLoadSpouse(ctx context.Context, query *UserQuery, nodes []*User) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&UserClient{config: uq.config}).QuerySpouse(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Spouse = edges[0]
+		}
+	}
+	return nil
+}
+
+// gremlinLoadChildren loads the nodes of the children edge of the given Users using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (uq *UserQuery) gremlinLoadChildren(ctx context.Context, query *UserQuery, nodes []*User) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&UserClient{config: uq.config}).QueryChildren(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		node.Edges.Children = edges
+	}
+	return nil
+}
+
+// gremlinLoadParent loads the nodes of the parent edge of the given Users using the given query.
+// The edges are queried separately for each node, and therefore, the limit and the offset of the query
+// are applied on the edges of each node.
+func (uq *UserQuery) gremlinLoadParent(ctx context.Context, query *UserQuery, nodes []*User) error {
+	for _, node := range nodes {
+		q := query.Clone()
+		q.gremlin = (&UserClient{config: uq.config}).QueryParent(node).gremlin
+		q, err := q.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		edges, err := q.gremlinAll(ctx)
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			node.Edges.Parent = edges[0]
+		}
+	}
+	return nil
+}
+
 func (uq *UserQuery) gremlinCount(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
-	query, bindings := uq.gremlinQuery().Count().Query()
-	if err := uq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := uq.gremlinTraversal(false).Count()
+	query, _ := traversal.Query()
+	if err := uq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return 0, err
 	}
 	return res.ReadInt()
@@ -665,14 +2468,22 @@ func (uq *UserQuery) gremlinCount(ctx context.Context) (int, error) {
 
 func (uq *UserQuery) gremlinExist(ctx context.Context) (bool, error) {
 	res := &gremlin.Response{}
-	query, bindings := uq.gremlinQuery().HasNext().Query()
-	if err := uq.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := uq.gremlinTraversal(false).HasNext()
+	query, _ := traversal.Query()
+	if err := uq.driver.Exec(ctx, query, traversal, res); err != nil {
 		return false, err
 	}
 	return res.ReadBool()
 }
 
+// gremlinQuery returns the traversal of the query for listing its vertices, with its default orders.
 func (uq *UserQuery) gremlinQuery() *dsl.Traversal {
+	return uq.gremlinTraversal(true)
+}
+
+// gremlinTraversal returns the traversal of the query. The default orders are added only to the traversals
+// of listing queries (list), since they don't change the results of the others (e.g. Count and Exist).
+func (uq *UserQuery) gremlinTraversal(list bool) *dsl.Traversal {
 	v := g.V().HasLabel(user.Label)
 	if uq.gremlin != nil {
 		v = uq.gremlin.Clone()
@@ -680,13 +2491,22 @@ func (uq *UserQuery) gremlinQuery() *dsl.Traversal {
 	for _, p := range uq.predicates {
 		p(v)
 	}
-	if len(uq.order) > 0 {
+	limit, offset := uq.limit, uq.offset
+	list = list && !uq.unordered
+	// paginated queries are ordered by id (also as a tie-breaker of the
+	// query orders), in order to keep the pages stable between calls.
+	stable := (limit != nil || offset != nil) && list
+	order := uq.order
+	if len(order) > 0 || stable {
 		v.Order()
-		for _, p := range uq.order {
+		for _, p := range order {
 			p(v)
 		}
+		if stable {
+			v.By(dsl.ID, dsl.Incr)
+		}
 	}
-	switch limit, offset := uq.limit, uq.offset; {
+	switch {
 	case limit != nil && offset != nil:
 		v.Range(*offset, *offset+*limit)
 	case offset != nil:
@@ -708,6 +2528,11 @@ type UserGroupBy struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving     *sql.Predicate
+	gremlinHaving *dsl.Traversal
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -716,8 +2541,27 @@ func (ugb *UserGroupBy) Aggregate(fns ...Aggregate) *UserGroupBy {
 	return ugb
 }
 
+// Having adds per-dialect predicates on the aggregated values of the groups.
+// In Gremlin, the predicate is applied using a `where` step on the values of each group.
+// For example:
+//
+//	client.User.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5), __.Select(ent.DefaultCountLabel).Is(p.GT(5))).
+//		Scan(ctx, &v)
+//
+func (ugb *UserGroupBy) Having(p0 *sql.Predicate, p1 *dsl.Traversal) *UserGroupBy {
+	ugb.sqlHaving = p0
+	ugb.gremlinHaving = p1
+	return ugb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (ugb *UserGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := ugb.path(ctx); err != nil {
+		return err
+	}
 	switch ugb.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return ugb.sqlScan(ctx, v)
@@ -820,6 +2664,9 @@ func (ugb *UserGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (ugb *UserGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ugb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ugb.sqlQuery().Query()
 	if err := ugb.driver.Query(ctx, query, args, rows); err != nil {
@@ -836,13 +2683,18 @@ func (ugb *UserGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range ugb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(ugb.fields...)
+	selector.Select(columns...).GroupBy(ugb.fields...)
+	if ugb.sqlHaving != nil {
+		selector.Having(ugb.sqlHaving)
+	}
+	return selector
 }
 
 func (ugb *UserGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
 	res := &gremlin.Response{}
-	query, bindings := ugb.gremlinQuery().Query()
-	if err := ugb.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := ugb.gremlinQuery()
+	query, _ := traversal.Query()
+	if err := ugb.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(ugb.fields)+len(ugb.fns) == 1 {
@@ -869,11 +2721,14 @@ func (ugb *UserGroupBy) gremlinQuery() *dsl.Traversal {
 		names = append(names, f)
 		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
 	}
-	return ugb.gremlin.Group().
+	v := ugb.gremlin.Group().
 		By(__.Values(ugb.fields...).Fold()).
 		By(__.Fold().Match(trs...).Select(names...)).
-		Select(dsl.Values).
-		Next()
+		Select(dsl.Values)
+	if ugb.gremlinHaving != nil {
+		v = v.Unfold().Where(ugb.gremlinHaving).Fold()
+	}
+	return v.Next()
 }
 
 // UserSelect is the builder for select fields of User entities.
@@ -883,10 +2738,15 @@ type UserSelect struct {
 	// intermediate queries.
 	sql     *sql.Selector
 	gremlin *dsl.Traversal
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (us *UserSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := us.path(ctx); err != nil {
+		return err
+	}
 	switch us.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return us.sqlScan(ctx, v)
@@ -989,6 +2849,9 @@ func (us *UserSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (us *UserSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := us.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := us.sqlQuery().Query()
 	if err := us.driver.Query(ctx, query, args, rows); err != nil {
@@ -1017,8 +2880,8 @@ func (us *UserSelect) gremlinScan(ctx context.Context, v interface{}) error {
 		}
 		traversal = us.gremlin.ValueMap(fields...)
 	}
-	query, bindings := traversal.Query()
-	if err := us.driver.Exec(ctx, query, bindings, res); err != nil {
+	query, _ := traversal.Query()
+	if err := us.driver.Exec(ctx, query, traversal, res); err != nil {
 		return err
 	}
 	if len(us.fields) == 1 {
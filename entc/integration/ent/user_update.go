@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -21,45 +22,21 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
+	"github.com/facebookincubator/ent/entc/integration/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
 )
 
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	age              *int
-	addage           *int
-	name             *string
-	last             *string
-	nickname         *string
-	clearnickname    bool
-	phone            *string
-	clearphone       bool
-	card             map[string]struct{}
-	pets             map[string]struct{}
-	files            map[string]struct{}
-	groups           map[string]struct{}
-	friends          map[string]struct{}
-	followers        map[string]struct{}
-	following        map[string]struct{}
-	team             map[string]struct{}
-	spouse           map[string]struct{}
-	children         map[string]struct{}
-	parent           map[string]struct{}
-	clearedCard      bool
-	removedPets      map[string]struct{}
-	removedFiles     map[string]struct{}
-	removedGroups    map[string]struct{}
-	removedFriends   map[string]struct{}
-	removedFollowers map[string]struct{}
-	removedFollowing map[string]struct{}
-	clearedTeam      bool
-	clearedSpouse    bool
-	removedChildren  map[string]struct{}
-	clearedParent    bool
-	predicates       []predicate.User
+	userMutation
+	predicates []predicate.User
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*User
 }
 
 // Where adds a new predicate for the builder.
@@ -68,6 +45,11 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Mutation returns the mutation of the builder.
+func (uu *UserUpdate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uu.userMutation, op: ent.OpUpdate}
+}
+
 // SetAge sets the age field.
 func (uu *UserUpdate) SetAge(i int) *UserUpdate {
 	uu.age = &i
@@ -147,6 +129,27 @@ func (uu *UserUpdate) ClearPhone() *UserUpdate {
 	return uu
 }
 
+// SetPassword sets the password field.
+func (uu *UserUpdate) SetPassword(s string) *UserUpdate {
+	uu.password = &s
+	return uu
+}
+
+// SetNillablePassword sets the password field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePassword(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetPassword(*s)
+	}
+	return uu
+}
+
+// ClearPassword clears the value of password.
+func (uu *UserUpdate) ClearPassword() *UserUpdate {
+	uu.password = nil
+	uu.clearpassword = true
+	return uu
+}
+
 // SetCardID sets the card edge to Card by id.
 func (uu *UserUpdate) SetCardID(id string) *UserUpdate {
 	if uu.card == nil {
@@ -381,6 +384,12 @@ func (uu *UserUpdate) ClearCard() *UserUpdate {
 	return uu
 }
 
+// ClearPets clears all pets edges to Pet.
+func (uu *UserUpdate) ClearPets() *UserUpdate {
+	uu.clearedPets = true
+	return uu
+}
+
 // RemovePetIDs removes the pets edge to Pet by ids.
 func (uu *UserUpdate) RemovePetIDs(ids ...string) *UserUpdate {
 	if uu.removedPets == nil {
@@ -401,6 +410,12 @@ func (uu *UserUpdate) RemovePets(p ...*Pet) *UserUpdate {
 	return uu.RemovePetIDs(ids...)
 }
 
+// ClearFiles clears all files edges to File.
+func (uu *UserUpdate) ClearFiles() *UserUpdate {
+	uu.clearedFiles = true
+	return uu
+}
+
 // RemoveFileIDs removes the files edge to File by ids.
 func (uu *UserUpdate) RemoveFileIDs(ids ...string) *UserUpdate {
 	if uu.removedFiles == nil {
@@ -421,6 +436,12 @@ func (uu *UserUpdate) RemoveFiles(f ...*File) *UserUpdate {
 	return uu.RemoveFileIDs(ids...)
 }
 
+// ClearGroups clears all groups edges to Group.
+func (uu *UserUpdate) ClearGroups() *UserUpdate {
+	uu.clearedGroups = true
+	return uu
+}
+
 // RemoveGroupIDs removes the groups edge to Group by ids.
 func (uu *UserUpdate) RemoveGroupIDs(ids ...string) *UserUpdate {
 	if uu.removedGroups == nil {
@@ -441,6 +462,12 @@ func (uu *UserUpdate) RemoveGroups(g ...*Group) *UserUpdate {
 	return uu.RemoveGroupIDs(ids...)
 }
 
+// ClearFriends clears all friends edges to User.
+func (uu *UserUpdate) ClearFriends() *UserUpdate {
+	uu.clearedFriends = true
+	return uu
+}
+
 // RemoveFriendIDs removes the friends edge to User by ids.
 func (uu *UserUpdate) RemoveFriendIDs(ids ...string) *UserUpdate {
 	if uu.removedFriends == nil {
@@ -461,6 +488,12 @@ func (uu *UserUpdate) RemoveFriends(u ...*User) *UserUpdate {
 	return uu.RemoveFriendIDs(ids...)
 }
 
+// ClearFollowers clears all followers edges to User.
+func (uu *UserUpdate) ClearFollowers() *UserUpdate {
+	uu.clearedFollowers = true
+	return uu
+}
+
 // RemoveFollowerIDs removes the followers edge to User by ids.
 func (uu *UserUpdate) RemoveFollowerIDs(ids ...string) *UserUpdate {
 	if uu.removedFollowers == nil {
@@ -481,6 +514,12 @@ func (uu *UserUpdate) RemoveFollowers(u ...*User) *UserUpdate {
 	return uu.RemoveFollowerIDs(ids...)
 }
 
+// ClearFollowing clears all following edges to User.
+func (uu *UserUpdate) ClearFollowing() *UserUpdate {
+	uu.clearedFollowing = true
+	return uu
+}
+
 // RemoveFollowingIDs removes the following edge to User by ids.
 func (uu *UserUpdate) RemoveFollowingIDs(ids ...string) *UserUpdate {
 	if uu.removedFollowing == nil {
@@ -513,6 +552,12 @@ func (uu *UserUpdate) ClearSpouse() *UserUpdate {
 	return uu
 }
 
+// ClearChildren clears all children edges to User.
+func (uu *UserUpdate) ClearChildren() *UserUpdate {
+	uu.clearedChildren = true
+	return uu
+}
+
 // RemoveChildIDs removes the children edge to User by ids.
 func (uu *UserUpdate) RemoveChildIDs(ids ...string) *UserUpdate {
 	if uu.removedChildren == nil {
@@ -540,18 +585,53 @@ func (uu *UserUpdate) ClearParent() *UserUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+func (uu *UserUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := uu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (uu *UserUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]string, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(uu.card) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"card\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"card\"")
 	}
 	if len(uu.team) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"team\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"team\"")
 	}
 	if len(uu.spouse) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"spouse\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"spouse\"")
 	}
 	if len(uu.parent) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"parent\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"parent\"")
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if uu.shadow != nil {
+		return uu.dualSave(ctx, opts...)
+	}
+	ids, err := uu.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 && !options.SkipHooks {
+		uu.bus.publish(&Event{Op: ent.OpUpdate, Type: user.Label, N: len(ids)})
+	}
+	return ids, nil
+}
+
+// save updates the User nodes in the storage of the client dialect.
+func (uu *UserUpdate) save(ctx context.Context) ([]string, error) {
+	predicates, err := interceptUser(ctx, uu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uu
+		intercepted.predicates = append(predicates, uu.predicates...)
+		uu = &intercepted
 	}
 	switch uu.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
@@ -559,13 +639,26 @@ func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	case dialect.Gremlin:
 		return uu.gremlinSave(ctx)
 	default:
-		return 0, errors.New("ent: unsupported dialect")
+		return nil, errors.New("ent: unsupported dialect")
+	}
+}
+
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (uu *UserUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*User, error) {
+	var us []*User
+	uu.returning = &us
+	defer func() { uu.returning = nil }()
+	if _, err := uu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
 	}
+	return us, nil
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
+func (uu *UserUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := uu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -573,44 +666,49 @@ func (uu *UserUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
+func (uu *UserUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
+func (uu *UserUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(user.FieldID).From(sql.Table(user.Table))
+func (uu *UserUpdate) sqlSave(ctx context.Context) (_ []string, err error) {
+	ctx = entcache.Invalidates(ctx, user.Tables...)
+	selector := sql.Select(user.FieldID).From(sql.Table(user.Table)).SetDialect(uu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range uu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := uu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -640,10 +738,16 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.clearphone {
 		builder.SetNull(user.FieldPhone)
 	}
+	if value := uu.password; value != nil {
+		builder.Set(user.FieldPassword, *value)
+	}
+	if uu.clearpassword {
+		builder.SetNull(user.FieldPassword)
+	}
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if uu.clearedCard {
@@ -652,33 +756,41 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(card.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.card) > 0 {
 		for _, id := range ids {
 			eid, serr := strconv.Atoi(keys(uu.card)[0])
 			if serr != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			query, args := sql.Update(user.CardTable).
 				Set(user.CardColumn, id).
 				Where(sql.EQ(card.FieldID, eid).And().IsNull(user.CardColumn)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uu.card) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"card\" %v already connected to a different \"User\"", keys(uu.card))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"card\" %v already connected to a different \"User\"", keys(uu.card))})
 			}
 		}
 	}
-	if len(uu.removedPets) > 0 {
-		eids := make([]int, len(uu.removedPets))
+	if uu.clearedPets {
+		query, args := sql.Update(user.PetsTable).
+			SetNull(user.PetsColumn).
+			Where(sql.InInts(user.PetsColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uu.removedPets) > 0 {
+		eids := make([]int, 0, len(uu.removedPets))
 		for eid := range uu.removedPets {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -693,7 +805,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(pet.FieldID, eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.pets) > 0 {
@@ -712,19 +824,27 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.And(p, sql.IsNull(user.PetsColumn))).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uu.pets) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uu.pets))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uu.pets))})
 			}
 		}
 	}
-	if len(uu.removedFiles) > 0 {
-		eids := make([]int, len(uu.removedFiles))
+	if uu.clearedFiles {
+		query, args := sql.Update(user.FilesTable).
+			SetNull(user.FilesColumn).
+			Where(sql.InInts(user.FilesColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uu.removedFiles) > 0 {
+		eids := make([]int, 0, len(uu.removedFiles))
 		for eid := range uu.removedFiles {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -739,7 +859,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(file.FieldID, eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.files) > 0 {
@@ -758,19 +878,26 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.And(p, sql.IsNull(user.FilesColumn))).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uu.files) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"User\"", keys(uu.files))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"User\"", keys(uu.files))})
 			}
 		}
 	}
-	if len(uu.removedGroups) > 0 {
-		eids := make([]int, len(uu.removedGroups))
+	if uu.clearedGroups {
+		query, args := sql.Delete(user.GroupsTable).
+			Where(sql.InInts(user.GroupsPrimaryKey[0], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uu.removedGroups) > 0 {
+		eids := make([]int, 0, len(uu.removedGroups))
 		for eid := range uu.removedGroups {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -784,10 +911,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(user.GroupsPrimaryKey[1], eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.groups) > 0 {
+		if err := checkRefs(ctx, tx, group.Table, group.FieldID, "groups", uu.groups); err != nil {
+			return nil, rollback(tx, err)
+		}
 		values := make([][]int, 0, len(ids))
 		for _, id := range ids {
 			for eid := range uu.groups {
@@ -806,11 +936,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if len(uu.removedFriends) > 0 {
-		eids := make([]int, len(uu.removedFriends))
+	if uu.clearedFriends {
+		query, args := sql.Delete(user.FriendsTable).
+			Where(sql.Or(sql.InInts(user.FriendsPrimaryKey[0], ids...), sql.InInts(user.FriendsPrimaryKey[1], ids...))).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uu.removedFriends) > 0 {
+		eids := make([]int, 0, len(uu.removedFriends))
 		for eid := range uu.removedFriends {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -820,21 +957,19 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			eids = append(eids, eid)
 		}
 		query, args := sql.Delete(user.FriendsTable).
-			Where(sql.InInts(user.FriendsPrimaryKey[0], ids...)).
-			Where(sql.InInts(user.FriendsPrimaryKey[1], eids...)).
+			Where(sql.Or(
+				sql.And(sql.InInts(user.FriendsPrimaryKey[0], ids...), sql.InInts(user.FriendsPrimaryKey[1], eids...)),
+				sql.And(sql.InInts(user.FriendsPrimaryKey[1], ids...), sql.InInts(user.FriendsPrimaryKey[0], eids...)),
+			)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
-		}
-		query, args = sql.Delete(user.FriendsTable).
-			Where(sql.InInts(user.FriendsPrimaryKey[1], ids...)).
-			Where(sql.InInts(user.FriendsPrimaryKey[0], eids...)).
-			Query()
-		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.friends) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "friends", uu.friends); err != nil {
+			return nil, rollback(tx, err)
+		}
 		values := make([][]int, 0, len(ids))
 		for _, id := range ids {
 			for eid := range uu.friends {
@@ -853,11 +988,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if len(uu.removedFollowers) > 0 {
-		eids := make([]int, len(uu.removedFollowers))
+	if uu.clearedFollowers {
+		query, args := sql.Delete(user.FollowersTable).
+			Where(sql.InInts(user.FollowersPrimaryKey[1], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uu.removedFollowers) > 0 {
+		eids := make([]int, 0, len(uu.removedFollowers))
 		for eid := range uu.removedFollowers {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -871,10 +1013,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(user.FollowersPrimaryKey[0], eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.followers) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "followers", uu.followers); err != nil {
+			return nil, rollback(tx, err)
+		}
 		values := make([][]int, 0, len(ids))
 		for _, id := range ids {
 			for eid := range uu.followers {
@@ -893,11 +1038,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if len(uu.removedFollowing) > 0 {
-		eids := make([]int, len(uu.removedFollowing))
+	if uu.clearedFollowing {
+		query, args := sql.Delete(user.FollowingTable).
+			Where(sql.InInts(user.FollowingPrimaryKey[0], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uu.removedFollowing) > 0 {
+		eids := make([]int, 0, len(uu.removedFollowing))
 		for eid := range uu.removedFollowing {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -911,10 +1063,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(user.FollowingPrimaryKey[1], eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.following) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "following", uu.following); err != nil {
+			return nil, rollback(tx, err)
+		}
 		values := make([][]int, 0, len(ids))
 		for _, id := range ids {
 			for eid := range uu.following {
@@ -933,7 +1088,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if uu.clearedTeam {
@@ -942,28 +1097,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(pet.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.team) > 0 {
 		for _, id := range ids {
 			eid, serr := strconv.Atoi(keys(uu.team)[0])
 			if serr != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			query, args := sql.Update(user.TeamTable).
 				Set(user.TeamColumn, id).
 				Where(sql.EQ(pet.FieldID, eid).And().IsNull(user.TeamColumn)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uu.team) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"team\" %v already connected to a different \"User\"", keys(uu.team))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"team\" %v already connected to a different \"User\"", keys(uu.team))})
 			}
 		}
 	}
@@ -973,19 +1128,19 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(user.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 		query, args = sql.Update(user.SpouseTable).
 			SetNull(user.SpouseColumn).
 			Where(sql.InInts(user.SpouseColumn, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.spouse) > 0 {
 		if n := len(ids); n > 1 {
-			return 0, rollback(tx, fmt.Errorf("ent: can't link O2O edge \"spouse\" to %d vertices (> 1)", n))
+			return nil, rollback(tx, fmt.Errorf("ent: can't link O2O edge \"spouse\" to %d vertices (> 1)", n))
 		}
 		for eid := range uu.spouse {
 			eid, serr := strconv.Atoi(eid)
@@ -997,25 +1152,33 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Set(user.SpouseColumn, eid).
 				Where(sql.EQ(user.FieldID, ids[0])).Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			query, args = sql.Update(user.SpouseTable).
 				Set(user.SpouseColumn, ids[0]).
 				Where(sql.EQ(user.FieldID, eid).And().IsNull(user.SpouseColumn)).Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uu.spouse) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
 			}
 		}
 	}
-	if len(uu.removedChildren) > 0 {
-		eids := make([]int, len(uu.removedChildren))
+	if uu.clearedChildren {
+		query, args := sql.Update(user.ChildrenTable).
+			SetNull(user.ChildrenColumn).
+			Where(sql.InInts(user.ChildrenColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uu.removedChildren) > 0 {
+		eids := make([]int, 0, len(uu.removedChildren))
 		for eid := range uu.removedChildren {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -1030,7 +1193,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(user.FieldID, eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.children) > 0 {
@@ -1049,27 +1212,30 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.And(p, sql.IsNull(user.ChildrenColumn))).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uu.children) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"children\" %v already connected to a different \"User\"", keys(uu.children))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"children\" %v already connected to a different \"User\"", keys(uu.children))})
 			}
 		}
 	}
-	if uu.clearedParent {
+	if uu.clearedParent && len(uu.parent) == 0 {
 		query, args := sql.Update(user.ParentTable).
 			SetNull(user.ParentColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.parent) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "parent", uu.parent); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for eid := range uu.parent {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -1081,26 +1247,71 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.InInts(user.FieldID, ids...)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 		}
 	}
+	if uu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(user.Columns...).
+			From(sql.Table(user.Table)).
+			Where(sql.InInts(user.FieldID, ids...)).
+			SetDialect(uu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var us Users
+		err := us.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into User: %v", err))
+		}
+		us.config(uu.config)
+		*uu.returning = us
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
+	}
+	vs := make([]string, len(ids))
+	for i, id := range ids {
+		vs[i] = strconv.Itoa(id)
 	}
-	return len(ids), nil
+	return vs, nil
 }
 
-func (uu *UserUpdate) gremlinSave(ctx context.Context) (int, error) {
+func (uu *UserUpdate) gremlinSave(ctx context.Context) ([]string, error) {
 	res := &gremlin.Response{}
-	query, bindings := uu.gremlin().Query()
-	if err := uu.driver.Exec(ctx, query, bindings, res); err != nil {
-		return 0, err
+	traversal := uu.gremlin()
+	query, _ := traversal.Query()
+	if err := uu.driver.Exec(ctx, query, traversal, res); err != nil {
+		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
-		return 0, err
+		return nil, err
+	}
+	if uu.returning != nil {
+		var us Users
+		if err := us.FromResponse(res); err != nil {
+			return nil, err
+		}
+		us.config(uu.config)
+		*uu.returning = us
+		ids := make([]string, 0, len(us))
+		for _, u := range us {
+			ids = append(ids, u.ID)
+		}
+		return ids, nil
 	}
-	return res.ReadInt()
+	vertices, err := res.ReadVertices()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vertices))
+	for _, vertex := range vertices {
+		ids = append(ids, vertex.ID.(string))
+	}
+	return ids, nil
 }
 
 func (uu *UserUpdate) gremlin() *dsl.Traversal {
@@ -1145,6 +1356,9 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 		})
 		v.Property(dsl.Single, user.FieldPhone, *value)
 	}
+	if value := uu.password; value != nil {
+		v.Property(dsl.Single, user.FieldPassword, *value)
+	}
 	var properties []interface{}
 	if uu.clearnickname {
 		properties = append(properties, user.FieldNickname)
@@ -1152,6 +1366,9 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 	if uu.clearphone {
 		properties = append(properties, user.FieldPhone)
 	}
+	if uu.clearpassword {
+		properties = append(properties, user.FieldPassword)
+	}
 	if len(properties) > 0 {
 		v.SideEffect(__.Properties(properties...).Drop())
 	}
@@ -1166,6 +1383,10 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(user.Label, user.CardLabel, id)),
 		})
 	}
+	if uu.clearedPets {
+		tr := rv.Clone().OutE(user.PetsLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uu.removedPets {
 		tr := rv.Clone().OutE(user.PetsLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -1177,6 +1398,10 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(user.Label, user.PetsLabel, id)),
 		})
 	}
+	if uu.clearedFiles {
+		tr := rv.Clone().OutE(user.FilesLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uu.removedFiles {
 		tr := rv.Clone().OutE(user.FilesLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -1188,6 +1413,10 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(user.Label, user.FilesLabel, id)),
 		})
 	}
+	if uu.clearedGroups {
+		tr := rv.Clone().OutE(user.GroupsLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uu.removedGroups {
 		tr := rv.Clone().OutE(user.GroupsLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -1195,6 +1424,10 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 	for id := range uu.groups {
 		v.AddE(user.GroupsLabel).To(g.V(id)).OutV()
 	}
+	if uu.clearedFriends {
+		tr := rv.Clone().BothE(user.FriendsLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uu.removedFriends {
 		tr := rv.Clone().BothE(user.FriendsLabel).Where(__.Or(__.InV().HasID(id), __.OutV().HasID(id))).Drop().Iterate()
 		trs = append(trs, tr)
@@ -1202,6 +1435,10 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 	for id := range uu.friends {
 		v.AddE(user.FriendsLabel).To(g.V(id)).OutV()
 	}
+	if uu.clearedFollowers {
+		tr := rv.Clone().InE(user.FollowingLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uu.removedFollowers {
 		tr := rv.Clone().InE(user.FollowingLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -1209,6 +1446,10 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 	for id := range uu.followers {
 		v.AddE(user.FollowingLabel).From(g.V(id)).InV()
 	}
+	if uu.clearedFollowing {
+		tr := rv.Clone().OutE(user.FollowingLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uu.removedFollowing {
 		tr := rv.Clone().OutE(user.FollowingLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -1242,6 +1483,10 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(user.Label, user.SpouseLabel, id)),
 		})
 	}
+	if uu.clearedChildren {
+		tr := rv.Clone().InE(user.ParentLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uu.removedChildren {
 		tr := rv.Clone().InE(user.ParentLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -1260,11 +1505,13 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 	for id := range uu.parent {
 		v.AddE(user.ParentLabel).To(g.V(id)).OutV()
 	}
-	v.Count()
+	if uu.returning != nil {
+		v.ValueMap(true)
+	}
 	if len(constraints) > 0 {
 		constraints = append(constraints, &constraint{
 			pred: rv.Count(),
-			test: __.Is(p.GT(1)).Constant(&ErrConstraintFailed{msg: "update traversal contains more than one vertex"}),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
 		})
 		v = constraints[0].pred.Coalesce(constraints[0].test, v)
 		for _, cr := range constraints[1:] {
@@ -1278,37 +1525,16 @@ func (uu *UserUpdate) gremlin() *dsl.Traversal {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	id               string
-	age              *int
-	addage           *int
-	name             *string
-	last             *string
-	nickname         *string
-	clearnickname    bool
-	phone            *string
-	clearphone       bool
-	card             map[string]struct{}
-	pets             map[string]struct{}
-	files            map[string]struct{}
-	groups           map[string]struct{}
-	friends          map[string]struct{}
-	followers        map[string]struct{}
-	following        map[string]struct{}
-	team             map[string]struct{}
-	spouse           map[string]struct{}
-	children         map[string]struct{}
-	parent           map[string]struct{}
-	clearedCard      bool
-	removedPets      map[string]struct{}
-	removedFiles     map[string]struct{}
-	removedGroups    map[string]struct{}
-	removedFriends   map[string]struct{}
-	removedFollowers map[string]struct{}
-	removedFollowing map[string]struct{}
-	clearedTeam      bool
-	clearedSpouse    bool
-	removedChildren  map[string]struct{}
-	clearedParent    bool
+	id     string
+	entity *User
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.User
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uuo.userMutation, op: ent.OpUpdateOne, id: &uuo.id}
 }
 
 // SetAge sets the age field.
@@ -1390,6 +1616,27 @@ func (uuo *UserUpdateOne) ClearPhone() *UserUpdateOne {
 	return uuo
 }
 
+// SetPassword sets the password field.
+func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
+	uuo.password = &s
+	return uuo
+}
+
+// SetNillablePassword sets the password field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPassword(*s)
+	}
+	return uuo
+}
+
+// ClearPassword clears the value of password.
+func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
+	uuo.password = nil
+	uuo.clearpassword = true
+	return uuo
+}
+
 // SetCardID sets the card edge to Card by id.
 func (uuo *UserUpdateOne) SetCardID(id string) *UserUpdateOne {
 	if uuo.card == nil {
@@ -1624,6 +1871,12 @@ func (uuo *UserUpdateOne) ClearCard() *UserUpdateOne {
 	return uuo
 }
 
+// ClearPets clears all pets edges to Pet.
+func (uuo *UserUpdateOne) ClearPets() *UserUpdateOne {
+	uuo.clearedPets = true
+	return uuo
+}
+
 // RemovePetIDs removes the pets edge to Pet by ids.
 func (uuo *UserUpdateOne) RemovePetIDs(ids ...string) *UserUpdateOne {
 	if uuo.removedPets == nil {
@@ -1644,6 +1897,12 @@ func (uuo *UserUpdateOne) RemovePets(p ...*Pet) *UserUpdateOne {
 	return uuo.RemovePetIDs(ids...)
 }
 
+// ClearFiles clears all files edges to File.
+func (uuo *UserUpdateOne) ClearFiles() *UserUpdateOne {
+	uuo.clearedFiles = true
+	return uuo
+}
+
 // RemoveFileIDs removes the files edge to File by ids.
 func (uuo *UserUpdateOne) RemoveFileIDs(ids ...string) *UserUpdateOne {
 	if uuo.removedFiles == nil {
@@ -1664,6 +1923,12 @@ func (uuo *UserUpdateOne) RemoveFiles(f ...*File) *UserUpdateOne {
 	return uuo.RemoveFileIDs(ids...)
 }
 
+// ClearGroups clears all groups edges to Group.
+func (uuo *UserUpdateOne) ClearGroups() *UserUpdateOne {
+	uuo.clearedGroups = true
+	return uuo
+}
+
 // RemoveGroupIDs removes the groups edge to Group by ids.
 func (uuo *UserUpdateOne) RemoveGroupIDs(ids ...string) *UserUpdateOne {
 	if uuo.removedGroups == nil {
@@ -1684,6 +1949,12 @@ func (uuo *UserUpdateOne) RemoveGroups(g ...*Group) *UserUpdateOne {
 	return uuo.RemoveGroupIDs(ids...)
 }
 
+// ClearFriends clears all friends edges to User.
+func (uuo *UserUpdateOne) ClearFriends() *UserUpdateOne {
+	uuo.clearedFriends = true
+	return uuo
+}
+
 // RemoveFriendIDs removes the friends edge to User by ids.
 func (uuo *UserUpdateOne) RemoveFriendIDs(ids ...string) *UserUpdateOne {
 	if uuo.removedFriends == nil {
@@ -1704,6 +1975,12 @@ func (uuo *UserUpdateOne) RemoveFriends(u ...*User) *UserUpdateOne {
 	return uuo.RemoveFriendIDs(ids...)
 }
 
+// ClearFollowers clears all followers edges to User.
+func (uuo *UserUpdateOne) ClearFollowers() *UserUpdateOne {
+	uuo.clearedFollowers = true
+	return uuo
+}
+
 // RemoveFollowerIDs removes the followers edge to User by ids.
 func (uuo *UserUpdateOne) RemoveFollowerIDs(ids ...string) *UserUpdateOne {
 	if uuo.removedFollowers == nil {
@@ -1724,6 +2001,12 @@ func (uuo *UserUpdateOne) RemoveFollowers(u ...*User) *UserUpdateOne {
 	return uuo.RemoveFollowerIDs(ids...)
 }
 
+// ClearFollowing clears all following edges to User.
+func (uuo *UserUpdateOne) ClearFollowing() *UserUpdateOne {
+	uuo.clearedFollowing = true
+	return uuo
+}
+
 // RemoveFollowingIDs removes the following edge to User by ids.
 func (uuo *UserUpdateOne) RemoveFollowingIDs(ids ...string) *UserUpdateOne {
 	if uuo.removedFollowing == nil {
@@ -1756,6 +2039,12 @@ func (uuo *UserUpdateOne) ClearSpouse() *UserUpdateOne {
 	return uuo
 }
 
+// ClearChildren clears all children edges to User.
+func (uuo *UserUpdateOne) ClearChildren() *UserUpdateOne {
+	uuo.clearedChildren = true
+	return uuo
+}
+
 // RemoveChildIDs removes the children edge to User by ids.
 func (uuo *UserUpdateOne) RemoveChildIDs(ids ...string) *UserUpdateOne {
 	if uuo.removedChildren == nil {
@@ -1782,8 +2071,36 @@ func (uuo *UserUpdateOne) ClearParent() *UserUpdateOne {
 	return uuo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (uuo *UserUpdateOne) SetChangedFrom(original *User) *UserUpdateOne {
+	u := uuo.entity
+	if u == nil || original == nil {
+		return uuo
+	}
+	for _, change := range DiffUser(original, u) {
+		switch change.Field {
+		case user.FieldAge:
+			uuo.SetAge(u.Age)
+		case user.FieldName:
+			uuo.SetName(u.Name)
+		case user.FieldLast:
+			uuo.SetLast(u.Last)
+		case user.FieldNickname:
+			uuo.SetNickname(u.Nickname)
+		case user.FieldPhone:
+			uuo.SetPhone(u.Phone)
+		case user.FieldPassword:
+			uuo.SetPassword(u.Password)
+		}
+	}
+	return uuo
+}
+
 // Save executes the query and returns the updated entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+func (uuo *UserUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(uuo.card) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"card\"")
 	}
@@ -1796,6 +2113,34 @@ func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
 	if len(uuo.parent) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"parent\"")
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	if uuo.shadow != nil {
+		return uuo.dualSave(ctx, opts...)
+	}
+	u, err := uuo.save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !options.SkipHooks {
+		uuo.bus.publish(&Event{Op: ent.OpUpdateOne, Type: user.Label, ID: u.ID, Node: u, N: 1})
+	}
+	return u, nil
+}
+
+// save updates the User in the storage of the client dialect.
+func (uuo *UserUpdateOne) save(ctx context.Context) (*User, error) {
+	predicates, err := interceptUser(ctx, uuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uuo
+		intercepted.predicates = append(predicates, uuo.predicates...)
+		uuo = &intercepted
+	}
 	switch uuo.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		return uuo.sqlSave(ctx)
@@ -1807,8 +2152,8 @@ func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	u, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	u, err := uuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -1816,21 +2161,29 @@ func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
 }
 
 // Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
+func (uuo *UserUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
-	selector := sql.Select(user.Columns...).From(sql.Table(user.Table))
+	ctx = entcache.Invalidates(ctx, user.Tables...)
+	selector := sql.Select(user.Columns...).From(sql.Table(user.Table)).SetDialect(uuo.driver.Dialect())
 	user.ID(uuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range uuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -1896,6 +2249,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		u.Phone = value
 		builder.SetNull(user.FieldPhone)
 	}
+	if value := uuo.password; value != nil {
+		builder.Set(user.FieldPassword, *value)
+		u.Password = *value
+	}
+	if uuo.clearpassword {
+		var value string
+		u.Password = value
+		builder.SetNull(user.FieldPassword)
+	}
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
@@ -1929,12 +2291,20 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uuo.card) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"card\" %v already connected to a different \"User\"", keys(uuo.card))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"card\" %v already connected to a different \"User\"", keys(uuo.card))})
 			}
 		}
 	}
-	if len(uuo.removedPets) > 0 {
-		eids := make([]int, len(uuo.removedPets))
+	if uuo.clearedPets {
+		query, args := sql.Update(user.PetsTable).
+			SetNull(user.PetsColumn).
+			Where(sql.InInts(user.PetsColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uuo.removedPets) > 0 {
+		eids := make([]int, 0, len(uuo.removedPets))
 		for eid := range uuo.removedPets {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -1975,12 +2345,20 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uuo.pets) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uuo.pets))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uuo.pets))})
 			}
 		}
 	}
-	if len(uuo.removedFiles) > 0 {
-		eids := make([]int, len(uuo.removedFiles))
+	if uuo.clearedFiles {
+		query, args := sql.Update(user.FilesTable).
+			SetNull(user.FilesColumn).
+			Where(sql.InInts(user.FilesColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uuo.removedFiles) > 0 {
+		eids := make([]int, 0, len(uuo.removedFiles))
 		for eid := range uuo.removedFiles {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -2021,12 +2399,19 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uuo.files) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"User\"", keys(uuo.files))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"files\" %v already connected to a different \"User\"", keys(uuo.files))})
 			}
 		}
 	}
-	if len(uuo.removedGroups) > 0 {
-		eids := make([]int, len(uuo.removedGroups))
+	if uuo.clearedGroups {
+		query, args := sql.Delete(user.GroupsTable).
+			Where(sql.InInts(user.GroupsPrimaryKey[0], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uuo.removedGroups) > 0 {
+		eids := make([]int, 0, len(uuo.removedGroups))
 		for eid := range uuo.removedGroups {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -2044,6 +2429,9 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		}
 	}
 	if len(uuo.groups) > 0 {
+		if err := checkRefs(ctx, tx, group.Table, group.FieldID, "groups", uuo.groups); err != nil {
+			return nil, rollback(tx, err)
+		}
 		values := make([][]int, 0, len(ids))
 		for _, id := range ids {
 			for eid := range uuo.groups {
@@ -2065,8 +2453,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if len(uuo.removedFriends) > 0 {
-		eids := make([]int, len(uuo.removedFriends))
+	if uuo.clearedFriends {
+		query, args := sql.Delete(user.FriendsTable).
+			Where(sql.Or(sql.InInts(user.FriendsPrimaryKey[0], ids...), sql.InInts(user.FriendsPrimaryKey[1], ids...))).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uuo.removedFriends) > 0 {
+		eids := make([]int, 0, len(uuo.removedFriends))
 		for eid := range uuo.removedFriends {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -2076,21 +2471,19 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			eids = append(eids, eid)
 		}
 		query, args := sql.Delete(user.FriendsTable).
-			Where(sql.InInts(user.FriendsPrimaryKey[0], ids...)).
-			Where(sql.InInts(user.FriendsPrimaryKey[1], eids...)).
-			Query()
-		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return nil, rollback(tx, err)
-		}
-		query, args = sql.Delete(user.FriendsTable).
-			Where(sql.InInts(user.FriendsPrimaryKey[1], ids...)).
-			Where(sql.InInts(user.FriendsPrimaryKey[0], eids...)).
+			Where(sql.Or(
+				sql.And(sql.InInts(user.FriendsPrimaryKey[0], ids...), sql.InInts(user.FriendsPrimaryKey[1], eids...)),
+				sql.And(sql.InInts(user.FriendsPrimaryKey[1], ids...), sql.InInts(user.FriendsPrimaryKey[0], eids...)),
+			)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
 			return nil, rollback(tx, err)
 		}
 	}
 	if len(uuo.friends) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "friends", uuo.friends); err != nil {
+			return nil, rollback(tx, err)
+		}
 		values := make([][]int, 0, len(ids))
 		for _, id := range ids {
 			for eid := range uuo.friends {
@@ -2112,8 +2505,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if len(uuo.removedFollowers) > 0 {
-		eids := make([]int, len(uuo.removedFollowers))
+	if uuo.clearedFollowers {
+		query, args := sql.Delete(user.FollowersTable).
+			Where(sql.InInts(user.FollowersPrimaryKey[1], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uuo.removedFollowers) > 0 {
+		eids := make([]int, 0, len(uuo.removedFollowers))
 		for eid := range uuo.removedFollowers {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -2131,6 +2531,9 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		}
 	}
 	if len(uuo.followers) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "followers", uuo.followers); err != nil {
+			return nil, rollback(tx, err)
+		}
 		values := make([][]int, 0, len(ids))
 		for _, id := range ids {
 			for eid := range uuo.followers {
@@ -2152,8 +2555,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if len(uuo.removedFollowing) > 0 {
-		eids := make([]int, len(uuo.removedFollowing))
+	if uuo.clearedFollowing {
+		query, args := sql.Delete(user.FollowingTable).
+			Where(sql.InInts(user.FollowingPrimaryKey[0], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uuo.removedFollowing) > 0 {
+		eids := make([]int, 0, len(uuo.removedFollowing))
 		for eid := range uuo.removedFollowing {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -2171,6 +2581,9 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		}
 	}
 	if len(uuo.following) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "following", uuo.following); err != nil {
+			return nil, rollback(tx, err)
+		}
 		values := make([][]int, 0, len(ids))
 		for _, id := range ids {
 			for eid := range uuo.following {
@@ -2219,7 +2632,7 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uuo.team) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"team\" %v already connected to a different \"User\"", keys(uuo.team))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"team\" %v already connected to a different \"User\"", keys(uuo.team))})
 			}
 		}
 	}
@@ -2266,12 +2679,20 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uuo.spouse) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
 			}
 		}
 	}
-	if len(uuo.removedChildren) > 0 {
-		eids := make([]int, len(uuo.removedChildren))
+	if uuo.clearedChildren {
+		query, args := sql.Update(user.ChildrenTable).
+			SetNull(user.ChildrenColumn).
+			Where(sql.InInts(user.ChildrenColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uuo.removedChildren) > 0 {
+		eids := make([]int, 0, len(uuo.removedChildren))
 		for eid := range uuo.removedChildren {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -2312,11 +2733,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uuo.children) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"children\" %v already connected to a different \"User\"", keys(uuo.children))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"children\" %v already connected to a different \"User\"", keys(uuo.children))})
 			}
 		}
 	}
-	if uuo.clearedParent {
+	if uuo.clearedParent && len(uuo.parent) == 0 {
 		query, args := sql.Update(user.ParentTable).
 			SetNull(user.ParentColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
@@ -2326,6 +2747,9 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		}
 	}
 	if len(uuo.parent) > 0 {
+		if err := checkRefs(ctx, tx, user.Table, user.FieldID, "parent", uuo.parent); err != nil {
+			return nil, rollback(tx, err)
+		}
 		for eid := range uuo.parent {
 			eid, serr := strconv.Atoi(eid)
 			if serr != nil {
@@ -2349,8 +2773,9 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 
 func (uuo *UserUpdateOne) gremlinSave(ctx context.Context) (*User, error) {
 	res := &gremlin.Response{}
-	query, bindings := uuo.gremlin(uuo.id).Query()
-	if err := uuo.driver.Exec(ctx, query, bindings, res); err != nil {
+	traversal := uuo.gremlin(uuo.id)
+	query, _ := traversal.Query()
+	if err := uuo.driver.Exec(ctx, query, traversal, res); err != nil {
 		return nil, err
 	}
 	if err, ok := isConstantError(res); ok {
@@ -2370,6 +2795,9 @@ func (uuo *UserUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 8)
 	v := g.V(id)
+	for _, p := range uuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -2402,6 +2830,9 @@ func (uuo *UserUpdateOne) gremlin(id string) *dsl.Traversal {
 		})
 		v.Property(dsl.Single, user.FieldPhone, *value)
 	}
+	if value := uuo.password; value != nil {
+		v.Property(dsl.Single, user.FieldPassword, *value)
+	}
 	var properties []interface{}
 	if uuo.clearnickname {
 		properties = append(properties, user.FieldNickname)
@@ -2409,6 +2840,9 @@ func (uuo *UserUpdateOne) gremlin(id string) *dsl.Traversal {
 	if uuo.clearphone {
 		properties = append(properties, user.FieldPhone)
 	}
+	if uuo.clearpassword {
+		properties = append(properties, user.FieldPassword)
+	}
 	if len(properties) > 0 {
 		v.SideEffect(__.Properties(properties...).Drop())
 	}
@@ -2423,6 +2857,10 @@ func (uuo *UserUpdateOne) gremlin(id string) *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(user.Label, user.CardLabel, id)),
 		})
 	}
+	if uuo.clearedPets {
+		tr := rv.Clone().OutE(user.PetsLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uuo.removedPets {
 		tr := rv.Clone().OutE(user.PetsLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -2434,6 +2872,10 @@ func (uuo *UserUpdateOne) gremlin(id string) *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(user.Label, user.PetsLabel, id)),
 		})
 	}
+	if uuo.clearedFiles {
+		tr := rv.Clone().OutE(user.FilesLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uuo.removedFiles {
 		tr := rv.Clone().OutE(user.FilesLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -2445,6 +2887,10 @@ func (uuo *UserUpdateOne) gremlin(id string) *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(user.Label, user.FilesLabel, id)),
 		})
 	}
+	if uuo.clearedGroups {
+		tr := rv.Clone().OutE(user.GroupsLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uuo.removedGroups {
 		tr := rv.Clone().OutE(user.GroupsLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -2452,6 +2898,10 @@ func (uuo *UserUpdateOne) gremlin(id string) *dsl.Traversal {
 	for id := range uuo.groups {
 		v.AddE(user.GroupsLabel).To(g.V(id)).OutV()
 	}
+	if uuo.clearedFriends {
+		tr := rv.Clone().BothE(user.FriendsLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uuo.removedFriends {
 		tr := rv.Clone().BothE(user.FriendsLabel).Where(__.Or(__.InV().HasID(id), __.OutV().HasID(id))).Drop().Iterate()
 		trs = append(trs, tr)
@@ -2459,6 +2909,10 @@ func (uuo *UserUpdateOne) gremlin(id string) *dsl.Traversal {
 	for id := range uuo.friends {
 		v.AddE(user.FriendsLabel).To(g.V(id)).OutV()
 	}
+	if uuo.clearedFollowers {
+		tr := rv.Clone().InE(user.FollowingLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uuo.removedFollowers {
 		tr := rv.Clone().InE(user.FollowingLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -2466,6 +2920,10 @@ func (uuo *UserUpdateOne) gremlin(id string) *dsl.Traversal {
 	for id := range uuo.followers {
 		v.AddE(user.FollowingLabel).From(g.V(id)).InV()
 	}
+	if uuo.clearedFollowing {
+		tr := rv.Clone().OutE(user.FollowingLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uuo.removedFollowing {
 		tr := rv.Clone().OutE(user.FollowingLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -2499,6 +2957,10 @@ func (uuo *UserUpdateOne) gremlin(id string) *dsl.Traversal {
 			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(user.Label, user.SpouseLabel, id)),
 		})
 	}
+	if uuo.clearedChildren {
+		tr := rv.Clone().InE(user.ParentLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
 	for id := range uuo.removedChildren {
 		tr := rv.Clone().InE(user.ParentLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/idtype/ent/migrate"
 )
 
@@ -20,14 +22,42 @@ type Tx struct {
 	User *UserClient
 }
 
-// Commit commits the transaction.
+// Commit commits the transaction, or releases the savepoint of a nested transaction.
 func (tx *Tx) Commit() error {
-	return tx.config.driver.(*txDriver).tx.Commit()
+	return tx.config.driver.(*txDriver).commit()
 }
 
-// Rollback rollbacks the transaction.
+// Rollback rollbacks the transaction, or the changes of a nested transaction.
 func (tx *Tx) Rollback() error {
-	return tx.config.driver.(*txDriver).tx.Rollback()
+	return tx.config.driver.(*txDriver).rollback()
+}
+
+// Begin starts a nested transaction within the transaction, using a SQL savepoint. Committing the
+// nested transaction releases its savepoint, and rolling it back discards only the changes that
+// were applied after it was started, and keeps the enclosing transaction open. The changes of the
+// nested transaction are persisted only when the enclosing transaction is committed.
+//
+//	nested, err := tx.Begin(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	if err := helper(ctx, nested.Client()); err != nil {
+//		return nested.Rollback()
+//	}
+//	return nested.Commit()
+//
+func (tx *Tx) Begin(ctx context.Context) (*Tx, error) {
+	drv := tx.config.driver.(*txDriver)
+	sp, err := drv.nest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a nested transaction: %v", err)
+	}
+	cfg := tx.config
+	cfg.driver = sp
+	return &Tx{
+		config: cfg,
+		User:   NewUserClient(cfg),
+	}, nil
 }
 
 // Client returns a Client that binds to current transaction.
@@ -55,6 +85,13 @@ type txDriver struct {
 	drv dialect.Driver
 	// tx is the underlying transaction.
 	tx dialect.Tx
+	// savepoint is the name of the savepoint of nested transactions,
+	// and done reports if the savepoint was released or rolled back.
+	savepoint string
+	done      bool
+	// seq is the number of the savepoints that were created in the
+	// transaction, and it's shared with its nested transactions.
+	seq *int
 }
 
 // newTx creates a new transactional driver.
@@ -63,7 +100,47 @@ func newTx(ctx context.Context, drv dialect.Driver) (*txDriver, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &txDriver{tx: tx, drv: drv}, nil
+	return &txDriver{tx: tx, drv: drv, seq: new(int)}, nil
+}
+
+// commit commits the underlying transaction, or releases the savepoint of a nested transaction.
+func (tx *txDriver) commit() error {
+	if tx.savepoint != "" {
+		return tx.endSavepoint("RELEASE SAVEPOINT ")
+	}
+	return tx.tx.Commit()
+}
+
+// rollback rolls back the underlying transaction, or rolls back to the savepoint of a nested transaction.
+func (tx *txDriver) rollback() error {
+	if tx.savepoint != "" {
+		return tx.endSavepoint("ROLLBACK TO SAVEPOINT ")
+	}
+	return tx.tx.Rollback()
+}
+
+// nest creates a savepoint in the transaction, and returns the driver of its nested transaction.
+func (tx *txDriver) nest(ctx context.Context) (*txDriver, error) {
+	*tx.seq++
+	savepoint := fmt.Sprintf("ent_savepoint_%d", *tx.seq)
+	var res sql.Result
+	if err := tx.tx.Exec(ctx, "SAVEPOINT "+savepoint, []interface{}{}, &res); err != nil {
+		return nil, err
+	}
+	return &txDriver{tx: tx.tx, drv: tx.drv, savepoint: savepoint, seq: tx.seq}, nil
+}
+
+// endSavepoint executes the statement that ends the savepoint of a nested transaction.
+func (tx *txDriver) endSavepoint(stmt string) error {
+	if tx.done {
+		return fmt.Errorf("ent: savepoint %s was already released or rolled back", tx.savepoint)
+	}
+	var res sql.Result
+	if err := tx.tx.Exec(context.Background(), stmt+tx.savepoint, []interface{}{}, &res); err != nil {
+		return err
+	}
+	tx.done = true
+	return nil
 }
 
 // Tx returns the transaction wrapper (txDriver) to avoid Commit or Rollback calls
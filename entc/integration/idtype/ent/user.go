@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/idtype/ent/user"
 )
 
 // User is the model entity for the User schema.
@@ -20,19 +21,45 @@ type User struct {
 	ID uint64 `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges UserEdges `json:"edges"`
+}
+
+// UserEdges holds the relations/edges of other nodes in the graph.
+type UserEdges struct {
+	// Spouse holds the value of the spouse edge.
+	Spouse *User `json:"spouse,omitempty"`
+	// Followers holds the value of the followers edge.
+	Followers []*User `json:"followers,omitempty"`
+	// Following holds the value of the following edge.
+	Following []*User `json:"following,omitempty"`
 }
 
 // FromRows scans the sql response data into User.
 func (u *User) FromRows(rows *sql.Rows) error {
+	return u.scan(rows, user.Columns)
+}
+
+// scan scans the given columns of the sql response data into User.
+// Fields that their columns were not selected are left with their zero values.
+func (u *User) scan(rows *sql.Rows, columns []string) error {
 	var vu struct {
 		ID   uint64
 		Name sql.NullString
 	}
-	// the order here should be the same as in the `user.Columns`.
-	if err := rows.Scan(
-		&vu.ID,
-		&vu.Name,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			values[i] = &vu.ID
+		case user.FieldName:
+			values[i] = &vu.Name
+		default:
+			return fmt.Errorf("unexpected column %q for type User", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	u.ID = vu.ID
@@ -73,6 +100,42 @@ func (u *User) Unwrap() *User {
 	return u
 }
 
+// Clone returns a deep copy of the User, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (u *User) Clone() *User {
+	return u.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the User, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (u *User) clone(seen map[interface{}]interface{}) *User {
+	if u == nil {
+		return nil
+	}
+	if _c, ok := seen[u]; ok {
+		return _c.(*User)
+	}
+	_c := *u
+	_c.config = config{}
+	seen[u] = &_c
+	_c.Edges.Spouse = u.Edges.Spouse.clone(seen)
+	if nodes := u.Edges.Followers; nodes != nil {
+		_c.Edges.Followers = make([]*User, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Followers[_i] = _n.clone(seen)
+		}
+	}
+	if nodes := u.Edges.Following; nodes != nil {
+		_c.Edges.Following = make([]*User, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Following[_i] = _n.clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (u *User) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -83,14 +146,30 @@ func (u *User) String() string {
 	return buf.String()
 }
 
+// DiffUser returns the fields that have different values in the given Users,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffUser(a, b *User) []FieldChange {
+	var changes []FieldChange
+	if a.Name != b.Name {
+		changes = append(changes, FieldChange{Field: user.FieldName, Old: a.Name, New: b.Name})
+	}
+	return changes
+}
+
 // Users is a parsable slice of User.
 type Users []*User
 
 // FromRows scans the sql response data into Users.
 func (u *Users) FromRows(rows *sql.Rows) error {
+	return u.scan(rows, user.Columns)
+}
+
+// scan scans the given columns of the sql response data into Users.
+func (u *Users) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vu := &User{}
-		if err := vu.FromRows(rows); err != nil {
+		if err := vu.scan(rows, columns); err != nil {
 			return err
 		}
 		*u = append(*u, vu)
@@ -6,6 +6,12 @@
 
 package user
 
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/idtype/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the user type in the database.
 	Label = "user"
@@ -32,6 +38,16 @@ var Columns = []string{
 	FieldName,
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// FollowersPrimaryKey and FollowersColumn2 are the table columns denoting the
 	// primary key for the followers relation (M2M).
@@ -40,3 +56,68 @@ var (
 	// primary key for the following relation (M2M).
 	FollowingPrimaryKey = []string{"user_id", "follower_id"}
 )
+
+// Scope is a reusable named scope of the User queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.User
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the User type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "User",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeUint64,
+		GoType:     "uint64",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "name",
+			StorageKey: FieldName,
+			Type:       field.TypeString,
+			GoType:     "string",
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "spouse",
+			Type:     "User",
+			Rel:      "O2O",
+			Unique:   true,
+			Optional: true,
+			Table:    "users",
+			Columns:  []string{"user_spouse_id"},
+		},
+		{
+			Name:     "followers",
+			Type:     "User",
+			Rel:      "M2M",
+			Optional: true,
+			Inverse:  true,
+			Table:    "user_following",
+			Columns:  []string{"user_id", "follower_id"},
+		},
+		{
+			Name:     "following",
+			Type:     "User",
+			Rel:      "M2M",
+			Optional: true,
+			Table:    "user_following",
+			Columns:  []string{"user_id", "follower_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the User type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
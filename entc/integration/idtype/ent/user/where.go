@@ -7,6 +7,11 @@
 package user
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/idtype/ent/predicate"
 )
@@ -112,6 +117,36 @@ func IDLTE(id uint64) predicate.User {
 	)
 }
 
+// IDInQuery applies the In predicate on the ID field, with the ids of the given query as a subquery.
+// It's used for filtering by the results of another query, without fetching its ids first. For example:
+//
+//	client.User.Query().Where(user.IDInQuery(client.User.Query().Where(...)))
+//
+// The interceptors of the client are applied on the subquery with the context of the query, and
+// their errors fail the query.
+func IDInQuery(q predicate.Subquery) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		t, err := q.IDsSubquery(s.Context())
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(FieldID), t))
+	})
+}
+
+// IDNotInQuery applies the NotIn predicate on the ID field, with the ids of the given query as a subquery.
+func IDNotInQuery(q predicate.Subquery) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		t, err := q.IDsSubquery(s.Context())
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldID), t))
+	})
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.User {
 	return predicate.User(
@@ -275,15 +310,35 @@ func HasSpouse() predicate.User {
 	)
 }
 
+// HasNoSpouse applies a predicate that checks that the "spouse" edge is empty. It's identical
+// to Not(HasSpouse()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoSpouse() predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(SpouseColumn).
+						From(sql.Table(SpouseTable)).
+						Where(sql.NotNull(SpouseColumn)),
+				),
+			)
+		},
+	)
+}
+
 // HasSpouseWith applies the HasEdge predicate on the "spouse" edge with a given conditions (other predicates).
 func HasSpouseWith(preds ...predicate.User) predicate.User {
 	return predicate.User(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(SpouseColumn).From(sql.Table(SpouseTable))
+			t2 := sql.Select(SpouseColumn).From(sql.Table(SpouseTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(FieldID), t2))
 		},
 	)
@@ -304,6 +359,23 @@ func HasFollowers() predicate.User {
 	)
 }
 
+// HasNoFollowers applies a predicate that checks that the "followers" edge is empty. It's identical
+// to Not(HasFollowers()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoFollowers() predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(FollowersPrimaryKey[1]).From(sql.Table(FollowersTable)),
+				),
+			)
+		},
+	)
+}
+
 // HasFollowersWith applies the HasEdge predicate on the "followers" edge with a given conditions (other predicates).
 func HasFollowersWith(preds ...predicate.User) predicate.User {
 	return predicate.User(
@@ -315,10 +387,11 @@ func HasFollowersWith(preds ...predicate.User) predicate.User {
 				From(t3).
 				Join(t2).
 				On(t3.C(FollowersPrimaryKey[0]), t2.C(FieldID))
-			t5 := sql.Select().From(t2)
+			t5 := sql.Select().From(t2).WithContext(s.Context())
 			for _, p := range preds {
 				p(t5)
 			}
+			s.AddError(t5.Err())
 			t4.FromSelect(t5)
 			s.Where(sql.In(t1.C(FieldID), t4))
 		},
@@ -340,6 +413,23 @@ func HasFollowing() predicate.User {
 	)
 }
 
+// HasNoFollowing applies a predicate that checks that the "following" edge is empty. It's identical
+// to Not(HasFollowing()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the User nodes.
+func HasNoFollowing() predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(FollowingPrimaryKey[0]).From(sql.Table(FollowingTable)),
+				),
+			)
+		},
+	)
+}
+
 // HasFollowingWith applies the HasEdge predicate on the "following" edge with a given conditions (other predicates).
 func HasFollowingWith(preds ...predicate.User) predicate.User {
 	return predicate.User(
@@ -351,16 +441,199 @@ func HasFollowingWith(preds ...predicate.User) predicate.User {
 				From(t3).
 				Join(t2).
 				On(t3.C(FollowingPrimaryKey[1]), t2.C(FieldID))
-			t5 := sql.Select().From(t2)
+			t5 := sql.Select().From(t2).WithContext(s.Context())
 			for _, p := range preds {
 				p(t5)
 			}
+			s.AddError(t5.Err())
 			t4.FromSelect(t5)
 			s.Where(sql.In(t1.C(FieldID), t4))
 		},
 	)
 }
 
+// ByFollowersCount orders the results by the number of "followers" edges,
+// in descending order if desc is true. It's used as an order option of the
+// User queries. For example:
+//
+//	client.User.Query().Order(user.ByFollowersCount(true))
+//
+func ByFollowersCount(desc bool) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(FollowersTable)
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(FollowersPrimaryKey[1]), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+}
+
+// ByFollowingCount orders the results by the number of "following" edges,
+// in descending order if desc is true. It's used as an order option of the
+// User queries. For example:
+//
+//	client.User.Query().Order(user.ByFollowingCount(true))
+//
+func ByFollowingCount(desc bool) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(FollowingTable)
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(FollowingPrimaryKey[0]), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+}
+
+// PredicateFunc returns a predicate from the given function.
+// It allows adding custom SQL fragments to the User builders.
+func PredicateFunc(f func(*sql.Selector)) predicate.User {
+	return predicate.User(f)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.User, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.User, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.User, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(uint64); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(uint64); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]uint64); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]uint64); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(uint64); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(uint64); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(uint64); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(uint64); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldName:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasSuffix(v), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContainsFold(v), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("user: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/idtype/ent/user"
 )
@@ -18,10 +19,12 @@ import (
 // UserCreate is the builder for creating a User entity.
 type UserCreate struct {
 	config
-	name      *string
-	spouse    map[uint64]struct{}
-	followers map[uint64]struct{}
-	following map[uint64]struct{}
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uc *UserCreate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uc.userMutation, op: ent.OpCreate}
 }
 
 // SetName sets the name field.
@@ -93,19 +96,23 @@ func (uc *UserCreate) AddFollowing(u ...*User) *UserCreate {
 }
 
 // Save creates the User in the database.
-func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+func (uc *UserCreate) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
 	if uc.name == nil {
 		return nil, errors.New("ent: missing required field \"name\"")
 	}
 	if len(uc.spouse) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"spouse\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return uc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (uc *UserCreate) SaveX(ctx context.Context) *User {
-	v, err := uc.Save(ctx)
+func (uc *UserCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	v, err := uc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -154,32 +161,32 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uc.spouse) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
 			}
 		}
 	}
 	if len(uc.followers) > 0 {
-		for eid := range uc.followers {
 
-			query, args := sql.Insert(user.FollowersTable).
-				Columns(user.FollowersPrimaryKey[1], user.FollowersPrimaryKey[0]).
-				Values(id, eid).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+		builder := sql.Insert(user.FollowersTable).
+			Columns(user.FollowersPrimaryKey[1], user.FollowersPrimaryKey[0])
+		for eid := range uc.followers {
+			builder.Values(id, eid)
+		}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uc.following) > 0 {
-		for eid := range uc.following {
 
-			query, args := sql.Insert(user.FollowingTable).
-				Columns(user.FollowingPrimaryKey[0], user.FollowingPrimaryKey[1]).
-				Values(id, eid).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+		builder := sql.Insert(user.FollowingTable).
+			Columns(user.FollowingPrimaryKey[0], user.FollowingPrimaryKey[1])
+		for eid := range uc.following {
+			builder.Values(id, eid)
+		}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if err := tx.Commit(); err != nil {
@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/idtype/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/idtype/ent/user"
@@ -27,13 +28,26 @@ func (ud *UserDelete) Where(ps ...predicate.User) *UserDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (ud *UserDelete) Exec(ctx context.Context) (int, error) {
+func (ud *UserDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	predicates, err := interceptUser(ctx, ud.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *ud
+		intercepted.predicates = append(predicates, ud.predicates...)
+		ud = &intercepted
+	}
 	return ud.sqlExec(ctx)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ud *UserDelete) ExecX(ctx context.Context) int {
-	n, err := ud.Exec(ctx)
+func (ud *UserDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := ud.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -42,10 +56,13 @@ func (ud *UserDelete) ExecX(ctx context.Context) int {
 
 func (ud *UserDelete) sqlExec(ctx context.Context) (int, error) {
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(user.Table))
+	selector := sql.Select().From(sql.Table(user.Table)).SetDialect(ud.driver.Dialect()).WithContext(ctx)
 	for _, p := range ud.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(user.Table).FromSelect(selector).Query()
 	if err := ud.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -63,12 +80,12 @@ type UserDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (udo *UserDeleteOne) Exec(ctx context.Context) error {
-	n, err := udo.ud.Exec(ctx)
+func (udo *UserDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := udo.ud.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{user.Label}
 	default:
 		return nil
@@ -76,6 +93,8 @@ func (udo *UserDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (udo *UserDeleteOne) ExecX(ctx context.Context) {
-	udo.ud.ExecX(ctx)
+func (udo *UserDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := udo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
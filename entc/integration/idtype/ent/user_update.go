@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/idtype/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/idtype/ent/user"
@@ -19,14 +20,11 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	name             *string
-	spouse           map[uint64]struct{}
-	followers        map[uint64]struct{}
-	following        map[uint64]struct{}
-	clearedSpouse    bool
-	removedFollowers map[uint64]struct{}
-	removedFollowing map[uint64]struct{}
-	predicates       []predicate.User
+	userMutation
+	predicates []predicate.User
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*User
 }
 
 // Where adds a new predicate for the builder.
@@ -35,6 +33,11 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Mutation returns the mutation of the builder.
+func (uu *UserUpdate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uu.userMutation, op: ent.OpUpdate}
+}
+
 // SetName sets the name field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.name = &s
@@ -109,6 +112,12 @@ func (uu *UserUpdate) ClearSpouse() *UserUpdate {
 	return uu
 }
 
+// ClearFollowers clears all followers edges to User.
+func (uu *UserUpdate) ClearFollowers() *UserUpdate {
+	uu.clearedFollowers = true
+	return uu
+}
+
 // RemoveFollowerIDs removes the followers edge to User by ids.
 func (uu *UserUpdate) RemoveFollowerIDs(ids ...uint64) *UserUpdate {
 	if uu.removedFollowers == nil {
@@ -129,6 +138,12 @@ func (uu *UserUpdate) RemoveFollowers(u ...*User) *UserUpdate {
 	return uu.RemoveFollowerIDs(ids...)
 }
 
+// ClearFollowing clears all following edges to User.
+func (uu *UserUpdate) ClearFollowing() *UserUpdate {
+	uu.clearedFollowing = true
+	return uu
+}
+
 // RemoveFollowingIDs removes the following edge to User by ids.
 func (uu *UserUpdate) RemoveFollowingIDs(ids ...uint64) *UserUpdate {
 	if uu.removedFollowing == nil {
@@ -150,16 +165,49 @@ func (uu *UserUpdate) RemoveFollowing(u ...*User) *UserUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+func (uu *UserUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := uu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (uu *UserUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]uint64, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(uu.spouse) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"spouse\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"spouse\"")
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uu
+		intercepted.predicates = append(predicates, uu.predicates...)
+		uu = &intercepted
 	}
 	return uu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (uu *UserUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*User, error) {
+	var us []*User
+	uu.returning = &us
+	defer func() { uu.returning = nil }()
+	if _, err := uu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return us, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
+func (uu *UserUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := uu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -167,44 +215,48 @@ func (uu *UserUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
+func (uu *UserUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
+func (uu *UserUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(user.FieldID).From(sql.Table(user.Table))
+func (uu *UserUpdate) sqlSave(ctx context.Context) (_ []uint64, err error) {
+	selector := sql.Select(user.FieldID).From(sql.Table(user.Table)).SetDialect(uu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range uu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := uu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -216,7 +268,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if uu.clearedSpouse {
@@ -225,19 +277,19 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(user.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 		query, args = sql.Update(user.SpouseTable).
 			SetNull(user.SpouseColumn).
 			Where(sql.InInts(user.SpouseColumn, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.spouse) > 0 {
 		if n := len(ids); n > 1 {
-			return 0, rollback(tx, fmt.Errorf("ent: can't link O2O edge \"spouse\" to %d vertices (> 1)", n))
+			return nil, rollback(tx, fmt.Errorf("ent: can't link O2O edge \"spouse\" to %d vertices (> 1)", n))
 		}
 		for eid := range uu.spouse {
 			eid := int(eid)
@@ -245,25 +297,32 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Set(user.SpouseColumn, eid).
 				Where(sql.EQ(user.FieldID, ids[0])).Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			query, args = sql.Update(user.SpouseTable).
 				Set(user.SpouseColumn, ids[0]).
 				Where(sql.EQ(user.FieldID, eid).And().IsNull(user.SpouseColumn)).Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uu.spouse) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
 			}
 		}
 	}
-	if len(uu.removedFollowers) > 0 {
-		eids := make([]int, len(uu.removedFollowers))
+	if uu.clearedFollowers {
+		query, args := sql.Delete(user.FollowersTable).
+			Where(sql.InInts(user.FollowersPrimaryKey[1], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uu.removedFollowers) > 0 {
+		eids := make([]int, 0, len(uu.removedFollowers))
 		for eid := range uu.removedFollowers {
 			eid := int(eid)
 			eids = append(eids, eid)
@@ -273,7 +332,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(user.FollowersPrimaryKey[0], eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.followers) > 0 {
@@ -291,11 +350,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if len(uu.removedFollowing) > 0 {
-		eids := make([]int, len(uu.removedFollowing))
+	if uu.clearedFollowing {
+		query, args := sql.Delete(user.FollowingTable).
+			Where(sql.InInts(user.FollowingPrimaryKey[0], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uu.removedFollowing) > 0 {
+		eids := make([]int, 0, len(uu.removedFollowing))
 		for eid := range uu.removedFollowing {
 			eid := int(eid)
 			eids = append(eids, eid)
@@ -305,7 +371,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(user.FollowingPrimaryKey[1], eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.following) > 0 {
@@ -323,26 +389,51 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
+		}
+	}
+	if uu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(user.Columns...).
+			From(sql.Table(user.Table)).
+			Where(sql.InInts(user.FieldID, ids...)).
+			SetDialect(uu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var us Users
+		err := us.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into User: %v", err))
 		}
+		us.config(uu.config)
+		*uu.returning = us
 	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	vs := make([]uint64, len(ids))
+	for i, id := range ids {
+		vs[i] = uint64(id)
+	}
+	return vs, nil
 }
 
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	id               uint64
-	name             *string
-	spouse           map[uint64]struct{}
-	followers        map[uint64]struct{}
-	following        map[uint64]struct{}
-	clearedSpouse    bool
-	removedFollowers map[uint64]struct{}
-	removedFollowing map[uint64]struct{}
+	id     uint64
+	entity *User
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.User
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uuo.userMutation, op: ent.OpUpdateOne, id: &uuo.id}
 }
 
 // SetName sets the name field.
@@ -419,6 +510,12 @@ func (uuo *UserUpdateOne) ClearSpouse() *UserUpdateOne {
 	return uuo
 }
 
+// ClearFollowers clears all followers edges to User.
+func (uuo *UserUpdateOne) ClearFollowers() *UserUpdateOne {
+	uuo.clearedFollowers = true
+	return uuo
+}
+
 // RemoveFollowerIDs removes the followers edge to User by ids.
 func (uuo *UserUpdateOne) RemoveFollowerIDs(ids ...uint64) *UserUpdateOne {
 	if uuo.removedFollowers == nil {
@@ -439,6 +536,12 @@ func (uuo *UserUpdateOne) RemoveFollowers(u ...*User) *UserUpdateOne {
 	return uuo.RemoveFollowerIDs(ids...)
 }
 
+// ClearFollowing clears all following edges to User.
+func (uuo *UserUpdateOne) ClearFollowing() *UserUpdateOne {
+	uuo.clearedFollowing = true
+	return uuo
+}
+
 // RemoveFollowingIDs removes the following edge to User by ids.
 func (uuo *UserUpdateOne) RemoveFollowingIDs(ids ...uint64) *UserUpdateOne {
 	if uuo.removedFollowing == nil {
@@ -459,17 +562,48 @@ func (uuo *UserUpdateOne) RemoveFollowing(u ...*User) *UserUpdateOne {
 	return uuo.RemoveFollowingIDs(ids...)
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (uuo *UserUpdateOne) SetChangedFrom(original *User) *UserUpdateOne {
+	u := uuo.entity
+	if u == nil || original == nil {
+		return uuo
+	}
+	for _, change := range DiffUser(original, u) {
+		switch change.Field {
+		case user.FieldName:
+			uuo.SetName(u.Name)
+		}
+	}
+	return uuo
+}
+
 // Save executes the query and returns the updated entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+func (uuo *UserUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(uuo.spouse) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"spouse\"")
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uuo
+		intercepted.predicates = append(predicates, uuo.predicates...)
+		uuo = &intercepted
+	}
 	return uuo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	u, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	u, err := uuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -477,21 +611,28 @@ func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
 }
 
 // Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
+func (uuo *UserUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
-	selector := sql.Select(user.Columns...).From(sql.Table(user.Table))
+	selector := sql.Select(user.Columns...).From(sql.Table(user.Table)).SetDialect(uuo.driver.Dialect())
 	user.ID(uuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range uuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -572,12 +713,19 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uuo.spouse) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("\"spouse\" (%v) already connected to a different \"User\"", eid)})
 			}
 		}
 	}
-	if len(uuo.removedFollowers) > 0 {
-		eids := make([]int, len(uuo.removedFollowers))
+	if uuo.clearedFollowers {
+		query, args := sql.Delete(user.FollowersTable).
+			Where(sql.InInts(user.FollowersPrimaryKey[1], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uuo.removedFollowers) > 0 {
+		eids := make([]int, 0, len(uuo.removedFollowers))
 		for eid := range uuo.removedFollowers {
 			eid := int(eid)
 			eids = append(eids, eid)
@@ -608,8 +756,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if len(uuo.removedFollowing) > 0 {
-		eids := make([]int, len(uuo.removedFollowing))
+	if uuo.clearedFollowing {
+		query, args := sql.Delete(user.FollowingTable).
+			Where(sql.InInts(user.FollowingPrimaryKey[0], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uuo.removedFollowing) > 0 {
+		eids := make([]int, 0, len(uuo.removedFollowing))
 		for eid := range uuo.removedFollowing {
 			eid := int(eid)
 			eids = append(eids, eid)
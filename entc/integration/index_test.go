@@ -6,6 +6,8 @@ package integration
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/facebookincubator/ent/entc/integration/ent"
@@ -25,6 +27,9 @@ func Indexes(t *testing.T, client *ent.Client) {
 	require.Nil(f2)
 	require.Error(err)
 	require.True(ent.IsConstraintFailure(err))
+	require.True(errors.Is(fmt.Errorf("create file: %w", err), ent.ErrConstraint))
+	var cerr *ent.ConstraintError
+	require.True(errors.As(err, &cerr))
 
 	t.Log("deletion should allow recreation")
 	client.File.DeleteOne(f1).ExecX(ctx)
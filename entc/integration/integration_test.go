@@ -6,8 +6,12 @@ package integration
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"runtime"
 	"sort"
@@ -15,14 +19,30 @@ import (
 	"testing"
 	"time"
 
+	entgo "github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
+	"github.com/facebookincubator/ent/dialect/record"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/schema"
+	"github.com/facebookincubator/ent/entbackfill"
 	"github.com/facebookincubator/ent/entc/integration/ent"
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
+	"github.com/facebookincubator/ent/entc/integration/ent/fieldtype"
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
 	"github.com/facebookincubator/ent/entc/integration/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/ent/groupinfo"
+	"github.com/facebookincubator/ent/entc/integration/ent/item"
+	"github.com/facebookincubator/ent/entc/integration/ent/migrate"
 	"github.com/facebookincubator/ent/entc/integration/ent/node"
 	"github.com/facebookincubator/ent/entc/integration/ent/pet"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/ent/rest"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
+	"github.com/facebookincubator/ent/entcache"
+	"github.com/facebookincubator/ent/schema/field"
 
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/mattn/go-sqlite3"
@@ -34,6 +54,19 @@ func TestSQLite(t *testing.T) {
 	require.NoError(t, err)
 	defer client.Close()
 	require.NoError(t, client.Schema.Create(context.Background()))
+	require.NoError(t, client.Ready(context.Background()))
+	verified, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", ent.VerifySchema(true))
+	require.NoError(t, err)
+	require.NoError(t, verified.Close())
+	_, err = ent.Open("sqlite3", "file:unmigrated?mode=memory&cache=shared&_fk=1", ent.VerifySchema(true))
+	require.True(t, ent.IsSchemaVersion(err))
+	require.NoError(t, client.Schema.VerifyIntegrity(context.Background()))
+	unmigrated, err := ent.Open("sqlite3", "file:unmigrated?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer unmigrated.Close()
+	err = unmigrated.Schema.VerifyIntegrity(context.Background())
+	require.IsType(t, &schema.DriftError{}, err)
+	require.Len(t, err.(*schema.DriftError).Drifts, len(migrate.Tables))
 	for _, tt := range tests {
 		name := runtime.FuncForPC(reflect.ValueOf(tt).Pointer()).Name()
 		t.Run(name[strings.LastIndex(name, ".")+1:], func(t *testing.T) {
@@ -43,6 +76,355 @@ func TestSQLite(t *testing.T) {
 	}
 }
 
+func TestSoftFK(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:softfk?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx, migrate.WithForeignKeys(false)))
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+
+	t.Log("M2O and O2O references are checked on creation")
+	_, err = client.Pet.Create().SetName("pedro").SetOwnerID("1000").Save(ctx)
+	require.True(t, ent.IsConstraintFailure(err), "owner does not exist")
+	_, err = client.Pet.Create().SetName("pedro").SetTeamID("1000").Save(ctx)
+	require.True(t, ent.IsConstraintFailure(err), "team does not exist")
+	pedro := client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	require.Equal(t, a8m.ID, pedro.QueryOwner().OnlyXID(ctx))
+
+	t.Log("M2M references are checked on update")
+	_, err = a8m.Update().AddGroupIDs("1000").Save(ctx)
+	require.True(t, ent.IsConstraintFailure(err), "group does not exist")
+	_, err = client.User.Update().AddFriendIDs(a8m.ID, "1000").Save(ctx)
+	require.True(t, ent.IsConstraintFailure(err), "one of the friends does not exist")
+	require.Zero(t, a8m.QueryFriends().CountX(ctx))
+
+	t.Log("NO ACTION references are checked on deletion")
+	info := client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)
+	grp := client.Group.Create().SetName("Github").SetExpire(time.Now().Add(time.Hour)).SetInfo(info).SaveX(ctx)
+	err = client.GroupInfo.DeleteOne(info).Exec(ctx)
+	require.True(t, ent.IsConstraintFailure(err), "info is referenced by a group")
+	require.True(t, client.GroupInfo.Query().Where(groupinfo.ID(info.ID)).ExistX(ctx))
+
+	t.Log("SET NULL and M2M references are cleared on deletion")
+	a8m.Update().AddGroups(grp).SaveX(ctx)
+	client.User.DeleteOne(a8m).ExecX(ctx)
+	require.False(t, pedro.QueryOwner().ExistX(ctx))
+	require.Zero(t, grp.QueryUsers().CountX(ctx))
+}
+
+// TestRecursive runs on SQLite, since recursive common table expressions are not supported
+// by MySQL 5.6 and 5.7 that are tested by TestMySQL.
+func TestRecursive(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:recursive?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	t.Log("tree traversal over the parent edge")
+	root := client.User.Create().SetName("root").SetAge(60).SaveX(ctx)
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetParent(root).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SetParent(root).SaveX(ctx)
+	alex := client.User.Create().SetName("alex").SetAge(5).SetParent(a8m).SaveX(ctx)
+	client.User.Create().SetName("ariel").SetAge(1).SetParent(alex).SaveX(ctx)
+	names := client.User.Query().Where(user.HasParentWithRecursive(user.ID(root.ID))).Order(ent.Asc(user.FieldName)).Select(user.FieldName).StringsX(ctx)
+	require.Equal(t, []string{"a8m", "alex", "ariel", "nati"}, names, "descendants of root")
+	names = client.User.Query().Where(user.HasParentWithRecursive(user.Name("a8m"))).Order(ent.Asc(user.FieldName)).Select(user.FieldName).StringsX(ctx)
+	require.Equal(t, []string{"alex", "ariel"}, names, "descendants of a8m")
+	names = client.User.Query().Where(user.HasChildrenWithRecursive(user.AgeLT(2))).Order(ent.Asc(user.FieldName)).Select(user.FieldName).StringsX(ctx)
+	require.Equal(t, []string{"a8m", "alex", "root"}, names, "ancestors of ariel")
+	require.Zero(t, client.User.Query().Where(user.HasChildrenWithRecursive(user.ID(nati.ID))).Where(user.ID(nati.ID)).CountX(ctx))
+	require.Equal(t, root.ID, client.User.Query().Where(user.Not(user.HasParentWithRecursive(user.ID(root.ID)))).OnlyXID(ctx))
+
+	t.Log("list traversal over the next and prev edges")
+	head := client.Node.Create().SetValue(1).SaveX(ctx)
+	curr := head
+	for i := 2; i <= 5; i++ {
+		curr = client.Node.Create().SetValue(i).SetPrev(curr).SaveX(ctx)
+	}
+	values := client.Node.Query().Where(node.HasPrevWithRecursive(node.ID(head.ID))).Order(ent.Asc(node.FieldValue)).Select(node.FieldValue).IntsX(ctx)
+	require.Equal(t, []int{2, 3, 4, 5}, values, "nodes after the head")
+	values = client.Node.Query().Where(node.HasNextWithRecursive(node.Value(4))).Order(ent.Asc(node.FieldValue)).Select(node.FieldValue).IntsX(ctx)
+	require.Equal(t, []int{1, 2, 3}, values, "nodes before the 4th")
+	require.Zero(t, client.Node.Query().Where(node.HasNextWithRecursive(node.ID(head.ID))).CountX(ctx))
+}
+
+func TestDualWrite(t *testing.T) {
+	drv, err := sql.Open("sqlite3", "file:shadow?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	var reports []error
+	client, err := ent.Open("sqlite3", "file:primary?mode=memory&cache=shared&_fk=1", ent.Shadow(drv, func(err error) {
+		reports = append(reports, err)
+	}))
+	require.NoError(t, err)
+	defer client.Close()
+	shadow := ent.NewClient(ent.Driver(drv))
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+	require.NoError(t, shadow.Schema.Create(ctx))
+
+	t.Log("mutations are mirrored to the shadow storage")
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	pedro := client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	a8m = a8m.Update().SetNickname("a8m").SaveX(ctx)
+	client.User.Update().Where(user.Name("a8m")).AddAge(1).ExecX(ctx)
+	require.Len(t, client.User.Query().AllX(ctx), 1)
+	require.Empty(t, reports)
+	require.Equal(t, "a8m", shadow.User.GetX(ctx, a8m.ID).Nickname)
+	require.Equal(t, 31, shadow.User.GetX(ctx, a8m.ID).Age)
+	require.Equal(t, a8m.ID, shadow.Pet.GetX(ctx, pedro.ID).QueryOwner().OnlyXID(ctx))
+
+	t.Log("mismatches are reported")
+	shadow.User.UpdateOneID(a8m.ID).SetAge(40).ExecX(ctx)
+	require.Equal(t, 31, client.User.Query().OnlyX(ctx).Age)
+	require.Len(t, reports, 1)
+	serr, ok := reports[0].(*ent.ShadowError)
+	require.True(t, ok)
+	require.Equal(t, "query", serr.Op)
+	require.Equal(t, []ent.FieldChange{{Field: user.FieldAge, Old: 31, New: 40}}, serr.Changes)
+
+	t.Log("sensitive values are redacted from mismatches")
+	shadow.User.UpdateOneID(a8m.ID).SetAge(31).SetPassword("secret").ExecX(ctx)
+	client.User.Query().OnlyX(ctx)
+	require.Len(t, reports, 2)
+	serr, ok = reports[1].(*ent.ShadowError)
+	require.True(t, ok)
+	require.Equal(t, []ent.FieldChange{{Field: user.FieldPassword, Old: ent.Redacted, New: ent.Redacted}}, serr.Changes)
+	require.NotContains(t, serr.Error(), "secret")
+
+	t.Log("deletions are mirrored")
+	client.Pet.DeleteOne(pedro).ExecX(ctx)
+	require.Zero(t, shadow.Pet.Query().CountX(ctx))
+	require.Len(t, reports, 2)
+
+	t.Log("transactional mutations are mirrored on commit")
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	nati := tx.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	require.Len(t, tx.User.Query().AllX(ctx), 2)
+	require.False(t, shadow.User.Query().Where(user.Name("nati")).ExistX(ctx), "mutations are mirrored after commit")
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 28, shadow.User.GetX(ctx, nati.ID).Age)
+
+	t.Log("rolled back mutations are not mirrored")
+	tx, err = client.Tx(ctx)
+	require.NoError(t, err)
+	nested, err := tx.Begin(ctx)
+	require.NoError(t, err)
+	nested.User.UpdateOneID(nati.ID).SetAge(30).ExecX(ctx)
+	require.NoError(t, nested.Rollback())
+	tx.User.UpdateOneID(nati.ID).SetNickname("nati").ExecX(ctx)
+	require.NoError(t, tx.Commit())
+	tx, err = client.Tx(ctx)
+	require.NoError(t, err)
+	tx.User.DeleteOneID(nati.ID).ExecX(ctx)
+	require.NoError(t, tx.Rollback())
+	mirrored := shadow.User.GetX(ctx, nati.ID)
+	require.Equal(t, 28, mirrored.Age)
+	require.Equal(t, "nati", mirrored.Nickname)
+	require.Len(t, reports, 2)
+}
+
+func TestEntCache(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file:entcache?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	drv := entcache.NewDriver(db, entcache.WithTTL(time.Minute))
+	client := ent.NewClient(ent.Driver(drv))
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	require.Equal(t, 1, client.User.Query().CountX(ctx))
+	require.Zero(t, a8m.QueryPets().CountX(ctx))
+
+	t.Log("mutations of types without edges to users do not invalidate their cached results")
+	stats := drv.Stats()
+	client.Comment.Create().SetUniqueInt(1).SetUniqueFloat(1).SaveX(ctx)
+	require.Equal(t, 1, client.User.Query().CountX(ctx))
+	require.Equal(t, stats.Hits+1, drv.Stats().Hits)
+
+	t.Log("mutations invalidate the results of the tables they change")
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	require.Equal(t, 1, a8m.QueryPets().CountX(ctx))
+	client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	require.Equal(t, 2, client.User.Query().CountX(ctx))
+	client.Pet.Delete().ExecX(ctx)
+	require.Zero(t, a8m.QueryPets().CountX(ctx))
+}
+
+func TestREST(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:rest?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	require.NoError(t, client.Schema.Create(context.Background()))
+	srv := httptest.NewServer(rest.NewHandler(client))
+	defer srv.Close()
+	do := func(method, path, body string, v interface{}) int {
+		req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		if v != nil {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(v))
+		}
+		return resp.StatusCode
+	}
+
+	t.Log("create entities")
+	a8m, nati := &ent.User{}, &ent.User{}
+	require.Equal(t, http.StatusCreated, do(http.MethodPost, "/users", `{"name": "a8m", "age": 30}`, a8m))
+	require.Equal(t, "a8m", a8m.Name)
+	require.Equal(t, "unknown", a8m.Last, "default value")
+	require.Equal(t, http.StatusCreated, do(http.MethodPost, "/users", `{"name": "nati", "age": 28, "nickname": "nati"}`, nati))
+	require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users", `{"name": "noage"}`, nil), "missing required field")
+	require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users", `{"name": "a8m", "age": "30"}`, nil), "invalid field type")
+	require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users", `{"name": "a8m", "age": 30, "unknown": 1}`, nil), "unknown field")
+	require.Equal(t, http.StatusConflict, do(http.MethodPost, "/users", `{"name": "a8m", "age": 30, "nickname": "nati"}`, nil), "unique constraint")
+
+	t.Log("list entities")
+	var users []*ent.User
+	require.Equal(t, http.StatusOK, do(http.MethodGet, "/users?order=-age", "", &users))
+	require.Len(t, users, 2)
+	require.Equal(t, a8m.ID, users[0].ID)
+	require.Equal(t, http.StatusOK, do(http.MethodGet, "/users?age.lt=30&nickname.notnil=true", "", &users))
+	require.Len(t, users, 1)
+	require.Equal(t, nati.ID, users[0].ID)
+	require.Equal(t, http.StatusOK, do(http.MethodGet, "/users?age.in=28,30&order=age&limit=1&offset=1", "", &users))
+	require.Len(t, users, 1)
+	require.Equal(t, a8m.ID, users[0].ID)
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/users?age=a8m", "", nil))
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/users?order=unknown", "", nil))
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/users?unknown=1", "", nil))
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/users?password=secret", "", nil), "filter by sensitive field")
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/users?order=password", "", nil), "order by sensitive field")
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, fmt.Sprintf("/users?limit=%d", rest.MaxLimit+1), "", nil))
+	for i := 0; i < rest.DefaultLimit; i++ {
+		client.User.Create().SetName("user").SetAge(i).SaveX(context.Background())
+	}
+	require.Equal(t, http.StatusOK, do(http.MethodGet, "/users", "", &users))
+	require.Len(t, users, rest.DefaultLimit, "default limit")
+	require.Equal(t, http.StatusOK, do(http.MethodGet, fmt.Sprintf("/users?limit=%d", rest.MaxLimit), "", &users))
+	require.Len(t, users, rest.DefaultLimit+2)
+	client.User.Delete().Where(user.Name("user")).ExecX(context.Background())
+
+	t.Log("get, update and delete an entity")
+	u := &ent.User{}
+	require.Equal(t, http.StatusOK, do(http.MethodGet, "/users/"+a8m.ID, "", u))
+	require.Equal(t, a8m.Name, u.Name)
+	require.Equal(t, http.StatusOK, do(http.MethodPatch, "/users/"+a8m.ID, `{"nickname": "a8m", "age": 31}`, u))
+	require.Equal(t, "a8m", u.Nickname)
+	require.Equal(t, 31, u.Age)
+	u = &ent.User{}
+	require.Equal(t, http.StatusOK, do(http.MethodPatch, "/users/"+a8m.ID, `{"nickname": null}`, u))
+	require.Empty(t, u.Nickname)
+	require.False(t, client.User.Query().Where(user.NicknameNotNil(), user.ID(a8m.ID)).ExistX(context.Background()))
+	require.Equal(t, http.StatusNoContent, do(http.MethodDelete, "/users/"+a8m.ID, "", nil))
+	require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/users/"+a8m.ID, "", nil))
+	require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/unknown", "", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, do(http.MethodPut, "/users", "", nil))
+
+	t.Log("server errors are written without their details")
+	require.NoError(t, client.Close())
+	var body struct{ Error string }
+	require.Equal(t, http.StatusInternalServerError, do(http.MethodGet, "/users", "", &body))
+	require.Equal(t, http.StatusText(http.StatusInternalServerError), body.Error)
+}
+
+// gremlinStub is a Gremlin driver that fails all its executions.
+type gremlinStub struct{ dialect.Driver }
+
+func (gremlinStub) Dialect() string { return dialect.Gremlin }
+func (gremlinStub) Exec(context.Context, string, interface{}, interface{}) error {
+	return fmt.Errorf("gremlin stub")
+}
+
+func TestGremlinPaging(t *testing.T) {
+	ctx := context.Background()
+	drv := record.New(gremlinStub{})
+	client := ent.NewClient(ent.Driver(drv))
+	queries := func() (queries []string) {
+		for _, r := range drv.Records() {
+			queries = append(queries, r.Query)
+		}
+		drv.Reset()
+		return queries
+	}
+
+	client.User.Query().Limit(2).IDs(ctx)
+	require.Equal(t, []string{"g.V().hasLabel($0).order().by(T.id, incr).limit($1).dedup()"}, queries())
+	client.User.Query().Order(ent.Desc(user.FieldAge)).Offset(2).IDs(ctx)
+	require.Equal(t, []string{"g.V().hasLabel($0).order().by($1, decr).by(T.id, incr).range($2, $3).dedup()"}, queries())
+	client.User.Query().Limit(2).Unordered().IDs(ctx)
+	require.Equal(t, []string{"g.V().hasLabel($0).limit($1).dedup()"}, queries())
+	client.User.Query().IDs(ctx)
+	require.Equal(t, []string{"g.V().hasLabel($0).dedup()"}, queries(), "queries without pagination should not be ordered")
+
+	t.Log("only listing queries are ordered by default")
+	client.User.Query().OnlyID(ctx)
+	require.Equal(t, []string{"g.V().hasLabel($0).limit($1).dedup()"}, queries())
+	client.User.Query().Limit(2).Exist(ctx)
+	require.Equal(t, []string{"g.V().hasLabel($0).limit($1).dedup().hasNext()"}, queries())
+	client.User.Query().Offset(2).Count(ctx)
+	require.Equal(t, []string{"g.V().hasLabel($0).range($1, $2).dedup().count()"}, queries())
+	client.User.Query().Limit(2).QueryPets().IDs(ctx)
+	require.Equal(t, []string{"g.V().hasLabel($0).order().by(T.id, incr).limit($1).dedup().outE($2).inV().dedup()"}, queries(), "paginated paths are ordered")
+}
+
+// TestAtomicAdd verifies that numeric fields are added in the database (and not using
+// a stale copy of their value), in order to be safe for concurrent updates.
+func TestAtomicAdd(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", "file:add?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	drv := record.New(db)
+	client := ent.NewClient(ent.Driver(drv))
+	require.NoError(t, client.Schema.Create(ctx))
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	drv.Reset()
+	require.Equal(t, 31, a8m.Update().AddAge(1).SaveX(ctx).Age)
+	var updates []string
+	for _, r := range drv.Records() {
+		if strings.HasPrefix(r.Query, "UPDATE") {
+			updates = append(updates, r.Query)
+		}
+	}
+	require.Equal(t, []string{"UPDATE `users` SET `age` = COALESCE(`age`, ?) + ? WHERE `id` IN (?)"}, updates)
+
+	gdrv := record.New(gremlinStub{})
+	client = ent.NewClient(ent.Driver(gdrv))
+	client.User.UpdateOneID("1").AddAge(1).Exec(ctx)
+	records := gdrv.Records()
+	require.Len(t, records, 1)
+	require.Contains(t, records[0].Query, `property(single, $1, __.union(__.values($2), __.constant($3)).sum())`)
+}
+
+func TestEdgeCount(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", "file:count?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	drv := record.New(db)
+	client := ent.NewClient(ent.Driver(drv))
+	require.NoError(t, client.Schema.Create(ctx))
+	hub := client.Group.Create().SetName("GitHub").SetExpire(time.Now()).SetInfo(client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)).SaveX(ctx)
+	a8m := client.User.Create().SetName("a8m").SetAge(30).AddGroups(hub).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	count := func(q interface{ CountX(context.Context) int }, query string) {
+		drv.Reset()
+		require.Equal(t, 1, q.CountX(ctx))
+		records := drv.Records()
+		require.Len(t, records, 1)
+		require.Equal(t, query, records[0].Query)
+	}
+	count(a8m.QueryPets(), "SELECT COUNT(*) FROM `pets` WHERE `owner_id` = ?")
+	count(a8m.QueryGroups(), "SELECT COUNT(*) FROM `group_members` WHERE `group_members`.`member_id` = ?")
+	count(a8m.QueryGroups().Where(group.Name("GitHub")), "SELECT COUNT(DISTINCT `groups`.`id`) FROM `groups` JOIN (SELECT `group_members`.`group_id` FROM `group_members` WHERE `group_members`.`member_id` = ?) AS `t1` ON `groups`.`id` = `t1`.`group_id` WHERE `groups`.`name` = ?")
+}
+
 func TestMySQL(t *testing.T) {
 	for version, port := range map[string]int{"56": 3306, "57": 3307, "8": 3308} {
 		t.Run(version, func(t *testing.T) {
@@ -50,6 +432,7 @@ func TestMySQL(t *testing.T) {
 			require.NoError(t, err)
 			defer client.Close()
 			require.NoError(t, client.Schema.Create(context.Background()))
+			require.NoError(t, client.Ready(context.Background()))
 			for _, tt := range tests {
 				name := runtime.FuncForPC(reflect.ValueOf(tt).Pointer()).Name()
 				t.Run(name[strings.LastIndex(name, ".")+1:], func(t *testing.T) {
@@ -80,15 +463,47 @@ var tests = []func(*testing.T, *ent.Client){
 	Tx,
 	Indexes,
 	Types,
+	GoTypes,
+	AddrTypes,
+	NillableStorage,
 	Clone,
+	With,
 	Sanity,
 	Paging,
+	Order,
+	Backfill,
+	Diff,
+	ChangedFrom,
+	UniqueLookup,
+	FilterMap,
+	Upsert,
+	GetOrCreate,
 	Select,
+	Scope,
+	Intercept,
+	Sensitive,
+	Descriptor,
+	Touch,
+	SaveIDs,
+	Projection,
+	QueryString,
+	Hint,
+	EagerLoading,
+	Mutation,
+	Watch,
+	CallOptions,
+	SlowQuery,
+	Reload,
+	Queriers,
+	Fixtures,
 	Delete,
 	Relation,
 	Predicate,
+	TuplePredicate,
+	TimePredicate,
 	AddValues,
 	ClearFields,
+	RequiredEdges,
 	UniqueConstraint,
 	O2OTwoTypes,
 	O2OSameType,
@@ -99,7 +514,10 @@ var tests = []func(*testing.T, *ent.Client){
 	M2MSameType,
 	M2MTwoTypes,
 	DefaultValue,
+	TransformValue,
+	SchemaValidators,
 	ImmutableValue,
+	ReservedNames,
 }
 
 func Sanity(t *testing.T, client *ent.Client) {
@@ -135,6 +553,13 @@ func Sanity(t *testing.T, client *ent.Client) {
 	require.Len(client.User.Query().Where(user.HasPets()).AllX(ctx), 1)
 	require.Len(client.User.Query().Where(user.HasSpouse()).AllX(ctx), 2)
 	require.Len(client.User.Query().Where(user.Not(user.HasSpouse())).AllX(ctx), 1)
+	require.Equal(child.Name, client.User.Query().Where(user.HasNoSpouse()).OnlyX(ctx).Name)
+	require.Equal(2, client.User.Query().Where(user.HasNoPets()).CountX(ctx))
+	require.Equal(usr2.Name, client.User.Query().Where(user.HasNoGroups()).OnlyX(ctx).Name)
+	require.Equal(2, client.User.Query().Where(user.HasNoParent()).CountX(ctx))
+	require.Equal(child.Name, client.User.Query().Where(user.Not(user.HasNoChildren())).OnlyX(ctx).Name)
+	require.Zero(client.Pet.Query().Where(pet.HasNoOwner()).CountX(ctx))
+	require.Zero(client.Group.Query().Where(group.HasNoUsers()).CountX(ctx))
 	require.Len(client.User.Query().Where(user.HasGroups()).AllX(ctx), 2)
 	require.Len(client.Group.Query().Where(group.HasUsers()).AllX(ctx), 1)
 	require.Len(client.Group.Query().Where(group.HasUsersWith(user.Name("foo"))).AllX(ctx), 1)
@@ -144,6 +569,11 @@ func Sanity(t *testing.T, client *ent.Client) {
 	require.True(client.User.Query().ExistX(ctx))
 	require.True(client.User.Query().Where(user.HasPetsWith(pet.NameHasPrefix("ped"))).ExistX(ctx))
 	require.False(client.User.Query().Where(user.HasPetsWith(pet.NameHasPrefix("pan"))).ExistX(ctx))
+	require.Equal(1, usr.QueryGroups().CountX(ctx))
+	require.True(usr.QueryGroups().ExistX(ctx))
+	require.Equal(2, grp.QueryUsers().CountX(ctx))
+	require.True(grp.QueryUsers().Limit(1).ExistX(ctx))
+	require.False(grp.QueryUsers().Limit(0).ExistX(ctx))
 	require.Equal(child.Name, client.User.Query().Order(ent.Asc("name")).FirstX(ctx).Name)
 	require.Equal(usr2.Name, client.User.Query().Order(ent.Desc("name")).FirstX(ctx).Name)
 	// update fields.
@@ -197,6 +627,87 @@ func Clone(t *testing.T, client *ent.Client) {
 	base := client.File.Query().Where(file.Name("foo"))
 	require.Equal(t, f1.Size, base.Clone().Where(file.Size(f1.Size)).OnlyX(ctx).Size)
 	require.Equal(t, f2.Size, base.Clone().Where(file.Size(f2.Size)).OnlyX(ctx).Size)
+
+	t.Log("clone entities and their loaded edges")
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	a8m = client.User.Query().Where(user.ID(a8m.ID)).WithPets().OnlyX(ctx)
+	a8m.Edges.Pets[0].Edges.Owner = a8m
+	clone := a8m.Clone()
+	require.Equal(t, a8m.ID, clone.ID)
+	require.Equal(t, a8m.Name, clone.Name)
+	require.Len(t, clone.Edges.Pets, 1)
+	require.False(t, a8m.Edges.Pets[0] == clone.Edges.Pets[0], "loaded edges should be copied")
+	require.True(t, clone == clone.Edges.Pets[0].Edges.Owner, "cycles should be kept")
+	clone.Name = "mashraki"
+	clone.Edges.Pets[0].Name = "xabi"
+	require.Equal(t, "a8m", a8m.Name)
+	require.Equal(t, "pedro", a8m.Edges.Pets[0].Name)
+	require.Nil(t, (*ent.User)(nil).Clone())
+}
+
+func With(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	var logs []string
+	debug := client.With(ent.Debug(), ent.Log(func(v ...interface{}) { logs = append(logs, fmt.Sprint(v...)) }))
+	debug.File.Create().SetName("foo").SetSize(10).SaveX(ctx)
+	require.NotEmpty(t, logs)
+	n := len(logs)
+	client.File.Create().SetName("bar").SetSize(10).SaveX(ctx)
+	require.Len(t, logs, n, "original client should not be modified")
+	require.Equal(t, 2, debug.File.Query().CountX(ctx))
+	require.Len(t, debug.With().File.Query().AllX(ctx), 2)
+	require.True(t, len(logs) > n, "options of the client should be kept")
+
+	var logs2 []string
+	debug2 := debug.With(ent.Debug(), ent.Log(func(v ...interface{}) { logs2 = append(logs2, fmt.Sprint(v...)) }))
+	n = len(logs)
+	require.Equal(t, 2, debug2.File.Query().CountX(ctx))
+	require.Len(t, logs, n, "the previous logger should be replaced")
+	require.Len(t, logs2, 1, "the driver should be wrapped once")
+}
+
+func Order(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetNickname("a").SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	alex := client.User.Create().SetName("alex").SetAge(30).SetNickname("b").SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("luna").SetOwner(nati).SaveX(ctx)
+	a8m.Update().AddFriends(nati, alex).ExecX(ctx)
+
+	t.Log("order by the number of edges")
+	ids := client.User.Query().Order(user.ByPetsCount(true)).IDsX(ctx)
+	require.Equal([]string{a8m.ID, nati.ID, alex.ID}, ids)
+	ids = client.User.Query().Order(user.ByPetsCount(false)).IDsX(ctx)
+	require.Equal([]string{alex.ID, nati.ID, a8m.ID}, ids)
+	ids = client.User.Query().Order(ent.Desc(user.FieldAge), user.ByFriendsCount(false)).IDsX(ctx)
+	require.Equal([]string{alex.ID, a8m.ID, nati.ID}, ids)
+
+	t.Log("default order of the schema")
+	c1 := client.Card.Create().SetNumber("1").SetOwner(a8m).SaveX(ctx)
+	c3 := client.Card.Create().SetNumber("3").SetOwner(nati).SaveX(ctx)
+	c2 := client.Card.Create().SetNumber("2").SetOwner(alex).SaveX(ctx)
+	ids = client.Card.Query().IDsX(ctx)
+	require.Equal([]string{c3.ID, c2.ID, c1.ID}, ids)
+	require.Equal(c3.ID, client.Card.Query().FirstXID(ctx))
+	ids = client.User.Query().QueryCard().IDsX(ctx)
+	require.Equal([]string{c3.ID, c2.ID, c1.ID}, ids, "default order is applied on edge traversals")
+	ids = client.Card.Query().Order(ent.Asc(card.FieldNumber)).IDsX(ctx)
+	require.Equal([]string{c1.ID, c2.ID, c3.ID}, ids, "explicit order overrides the default")
+
+	if client.Dialect() == dialect.Gremlin {
+		return
+	}
+	t.Log("order with an explicit placement of NULL values")
+	ids = client.User.Query().Order(ent.AscNullsLast(user.FieldNickname)).IDsX(ctx)
+	require.Equal([]string{a8m.ID, alex.ID, nati.ID}, ids)
+	ids = client.User.Query().Order(ent.DescNullsFirst(user.FieldNickname)).IDsX(ctx)
+	require.Equal([]string{nati.ID, alex.ID, a8m.ID}, ids)
+	ids = client.User.Query().Order(ent.AscNullsFirst(user.FieldNickname)).IDsX(ctx)
+	require.Equal([]string{nati.ID, a8m.ID, alex.ID}, ids)
 }
 
 func Paging(t *testing.T, client *ent.Client) {
@@ -221,6 +732,559 @@ func Paging(t *testing.T, client *ent.Client) {
 	for i := 0; i < 10; i++ {
 		require.Equal(i+1, client.User.Query().Order(ent.Asc(user.FieldAge)).Offset(i).Limit(1).AllX(ctx)[0].Age)
 	}
+	// pages without order should cover all users, without duplicates.
+	ids := make(map[string]struct{})
+	for i := 0; i < 10; i += 3 {
+		for _, id := range client.User.Query().Offset(i).Limit(3).IDsX(ctx) {
+			ids[id] = struct{}{}
+		}
+	}
+	require.Len(ids, 10)
+	require.Len(client.User.Query().Unordered().Limit(3).IDsX(ctx), 3)
+}
+
+func Backfill(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	for i := 1; i <= 10; i++ {
+		client.User.Create().SetName(fmt.Sprintf("name-%d", i)).SetAge(i).SaveX(ctx)
+	}
+	var progress entbackfill.Progress
+	err := entbackfill.Run(ctx, client.User.Query().Where(user.AgeGT(2)), 3, func(ctx context.Context, b *entbackfill.Batch) error {
+		require.True(len(b.IDs) <= 3)
+		for _, id := range b.IDs {
+			client.User.UpdateOneID(id.(string)).SetLast("backfilled").ExecX(ctx)
+		}
+		return nil
+	}, entbackfill.WithProgress(func(p entbackfill.Progress) { progress = p }))
+	require.NoError(err)
+	require.Equal(3, progress.Batches)
+	require.Equal(8, progress.Done)
+	require.Equal(8, progress.Total)
+	require.Equal(8, client.User.Query().Where(user.Last("backfilled")).CountX(ctx))
+	require.Equal(2, client.User.Query().Where(user.Last("unknown")).CountX(ctx))
+}
+
+func Diff(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	require.Empty(ent.DiffUser(a8m, a8m))
+	updated := a8m.Update().SetAge(31).SetNickname("a8m").SaveX(ctx)
+	require.Equal([]ent.FieldChange{
+		{Field: user.FieldAge, Old: 30, New: 31},
+		{Field: user.FieldNickname, Old: "", New: "a8m"},
+	}, ent.DiffUser(a8m, updated))
+
+	pet := client.Pet.Create().SetName("pedro").SaveX(ctx)
+	require.Empty(ent.DiffPet(pet, client.Pet.GetX(ctx, pet.ID)))
+
+	group := client.Group.Create().SetInfo(client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)).SetName("Github").SetExpire(time.Now()).SaveX(ctx)
+	group2 := *group
+	group2.Expire = group.Expire.Add(time.Hour)
+	changes := ent.DiffGroup(group, &group2)
+	require.Len(changes, 1)
+	require.Equal(group.Expire, changes[0].Old)
+}
+
+func ChangedFrom(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	original := *a8m
+	a8m.Name = "Ariel"
+	a8m.Nickname = "a8m"
+	t.Log("fields that were not changed are not written")
+	client.User.UpdateOne(a8m).SetAge(31).ExecX(ctx)
+	updated := a8m.Update().SetChangedFrom(&original).SaveX(ctx)
+	require.Equal("Ariel", updated.Name)
+	require.Equal("a8m", updated.Nickname)
+	require.Equal(31, updated.Age)
+
+	t.Log("nop for unchanged entities and UpdateOneID")
+	updated = updated.Update().SetChangedFrom(updated).SaveX(ctx)
+	require.Equal("Ariel", updated.Name)
+	updated = client.User.UpdateOneID(a8m.ID).SetChangedFrom(&original).SaveX(ctx)
+	require.Equal("Ariel", updated.Name)
+}
+
+func UniqueLookup(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetNickname("a8m").SetPhone("972").SaveX(ctx)
+	require.Equal(a8m.ID, client.User.GetByNicknameX(ctx, "a8m").ID)
+	require.Equal(a8m.ID, client.User.GetByPhoneX(ctx, "972").ID)
+	_, err := client.User.GetByPhone(ctx, "1")
+	require.True(ent.IsNotFound(err))
+	exist, err := client.User.ExistsByNickname(ctx, "a8m")
+	require.NoError(err)
+	require.True(exist)
+	exist, err = client.User.ExistsByNickname(ctx, "nati")
+	require.NoError(err)
+	require.False(exist)
+
+	t.Log("lookup by id")
+	require.True(client.User.ExistX(ctx, a8m.ID))
+	u, err := client.User.GetNotFoundOK(ctx, a8m.ID)
+	require.NoError(err)
+	require.Equal(a8m.ID, u.ID)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.DeleteOne(nati).ExecX(ctx)
+	require.False(client.User.ExistX(ctx, nati.ID))
+	u, err = client.User.GetNotFoundOK(ctx, nati.ID)
+	require.NoError(err)
+	require.Nil(u)
+}
+
+func FilterMap(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	client.User.Create().SetName("a8m").SetAge(30).SetNickname("a8m").SaveX(ctx)
+	client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.Create().SetName("alex").SetAge(35).SaveX(ctx)
+
+	pred, err := user.FilterMap(map[string]interface{}{
+		"age.gt":         29,
+		"name.in":        []string{"a8m", "nati", "alex"},
+		"nickname.isnil": true,
+	})
+	require.NoError(err)
+	names := client.User.Query().Where(pred).Order(ent.Asc(user.FieldName)).Select(user.FieldName).StringsX(ctx)
+	require.Equal([]string{"alex"}, names)
+
+	pred, err = user.FilterMap(map[string]interface{}{"name": "nati"})
+	require.NoError(err)
+	require.Equal(28, client.User.Query().Where(pred).OnlyX(ctx).Age)
+
+	pred, err = user.FilterMap(nil)
+	require.NoError(err)
+	require.Equal(3, client.User.Query().Where(pred).CountX(ctx))
+
+	_, err = user.FilterMap(map[string]interface{}{"age": "30"})
+	require.Error(err, "mismatched value type")
+	_, err = user.FilterMap(map[string]interface{}{"unknown": 1})
+	require.Error(err, "unknown field")
+	_, err = user.FilterMap(map[string]interface{}{"age.contains": 1})
+	require.Error(err, "unknown operator")
+
+	t.Log("filters decoded by json.Unmarshal")
+	var filters map[string]interface{}
+	require.NoError(json.Unmarshal([]byte(`{"age.gte": 30, "name.in": ["a8m", "nati"], "nickname.notnil": true}`), &filters))
+	pred, err = user.FilterMap(filters)
+	require.NoError(err)
+	require.Equal("a8m", client.User.Query().Where(pred).OnlyX(ctx).Name)
+	filters = nil
+	require.NoError(json.Unmarshal([]byte(`{"age": 30.5}`), &filters))
+	_, err = user.FilterMap(filters)
+	require.Error(err, "fractional numbers are not converted to integers")
+	expire := time.Now().Add(time.Hour).Truncate(time.Second)
+	client.Group.Create().SetName("GitHub").SetExpire(expire).SetInfo(client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)).SaveX(ctx)
+	filters = nil
+	require.NoError(json.Unmarshal([]byte(fmt.Sprintf(`{"expire.gt": %q}`, expire.Add(-time.Minute).Format(time.RFC3339))), &filters))
+	gpred, err := group.FilterMap(filters)
+	require.NoError(err)
+	require.Equal("GitHub", client.Group.Query().Where(gpred).OnlyX(ctx).Name)
+
+	t.Log("predicate func")
+	older := user.PredicateFunc(
+		func(s *sql.Selector) {
+			s.Where(sql.GT(s.C(user.FieldAge), 29))
+		},
+		func(tr *dsl.Traversal) {
+			tr.Has(user.Label, user.FieldAge, p.GT(29))
+		},
+	)
+	require.Equal(2, client.User.Query().Where(older).CountX(ctx))
+}
+
+func Upsert(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	require.True(client.Dialect().Supports(dialect.Upsert))
+	require.False(client.Dialect().Supports(dialect.Returning))
+	a8m := client.User.CreateOrUpdateByPhone("0000").SetName("a8m").SetAge(30).SaveX(ctx)
+	require.Equal("a8m", a8m.Name)
+	require.Equal("unknown", a8m.Last, "default value on create")
+	require.Equal(1, client.User.Query().CountX(ctx))
+
+	t.Log("update existing entity by its phone")
+	u := client.User.CreateOrUpdateByPhone("0000").SetName("a8m").SetAge(31).SetLast("mashraki").SaveX(ctx)
+	require.Equal(a8m.ID, u.ID)
+	require.Equal(31, u.Age)
+	require.Equal("mashraki", u.Last)
+	require.Equal(1, client.User.Query().CountX(ctx))
+	require.Equal(31, client.User.GetX(ctx, a8m.ID).Age)
+
+	t.Log("create another entity")
+	nati := client.User.CreateOrUpdateByPhone("1111").SetName("nati").SetAge(28).SaveX(ctx)
+	require.NotEqual(a8m.ID, nati.ID)
+	require.Equal(2, client.User.Query().CountX(ctx))
+
+	t.Log("upsert by another unique field")
+	u = client.User.CreateOrUpdateByPhone("1111").SetName("nati").SetAge(28).SetNickname("nati").SaveX(ctx)
+	require.Equal(nati.ID, u.ID)
+	u = client.User.CreateOrUpdateByNickname("nati").SetName("nati").SetAge(29).SaveX(ctx)
+	require.Equal(nati.ID, u.ID)
+	require.Equal(29, u.Age)
+	require.Equal("1111", u.Phone)
+	require.Equal(2, client.User.Query().CountX(ctx))
+}
+
+func GetOrCreate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.GetOrCreate().SetPhone("0000").SetName("a8m").SetAge(30).SaveX(ctx)
+	require.Equal("a8m", a8m.Name)
+	require.Equal(1, client.User.Query().CountX(ctx))
+
+	t.Log("get existing entity by its phone")
+	u := client.User.GetOrCreate().SetPhone("0000").SetName("mashraki").SetAge(31).SaveX(ctx)
+	require.Equal(a8m.ID, u.ID)
+	require.Equal("a8m", u.Name, "existing entity is not updated")
+	require.Equal(30, u.Age)
+	require.Equal(1, client.User.Query().CountX(ctx))
+
+	t.Log("get existing entity by another unique field")
+	nati := client.User.GetOrCreate().SetPhone("1111").SetNickname("nati").SetName("nati").SetAge(28).SaveX(ctx)
+	require.NotEqual(a8m.ID, nati.ID)
+	u = client.User.GetOrCreate().SetNickname("nati").SetName("nati").SetAge(28).SaveX(ctx)
+	require.Equal(nati.ID, u.ID)
+	require.Equal(2, client.User.Query().CountX(ctx))
+
+	t.Log("fields of different entities")
+	_, err := client.User.GetOrCreate().SetPhone("0000").SetNickname("nati").SetName("nati").SetAge(28).Save(ctx)
+	require.True(ent.IsNotSingular(err))
+	require.Equal(2, client.User.Query().CountX(ctx))
+}
+
+func Scope(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).AddFriends(a8m).SaveX(ctx)
+	client.User.Create().SetName("alex").SetAge(10).AddFriends(a8m).SaveX(ctx)
+	require.Equal(2, client.User.Query().Scope(user.Adults()).CountX(ctx))
+	require.Equal(a8m.Name, client.User.Query().Scope(user.Adults(), user.Oldest()).FirstX(ctx).Name)
+	require.Len(client.User.Query().Scope(user.Oldest()).AllX(ctx), 1)
+	require.Equal(nati.Name, a8m.QueryFriends().Scope(user.Adults()).OnlyX(ctx).Name, "scope on edge query")
+	require.Empty(client.User.Query().Scope().Where(user.AgeGT(30)).AllX(ctx))
+
+	named := user.Scope{
+		Predicates: []predicate.User{user.NameHasPrefix("a")},
+		Order:      []entgo.OrderField{{Field: user.FieldAge}},
+	}
+	names := client.User.Query().Scope(named).Select(user.FieldName).StringsX(ctx)
+	require.Equal([]string{"alex", "a8m"}, names)
+}
+
+// nameKey is the context key of the name that is used by the interceptors of the Intercept test.
+type nameKey struct{}
+
+func Intercept(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).AddFriends(a8m).SaveX(ctx)
+	client.User.Create().SetName("a8m").SetAge(10).AddFriends(a8m, nati).SaveX(ctx)
+
+	scoped := client.With()
+	scoped.User.Intercept(func(ctx context.Context, q *ent.UserQuery) error {
+		name, ok := ctx.Value(nameKey{}).(string)
+		if !ok {
+			return errors.New("missing name in context")
+		}
+		q.Where(user.Name(name))
+		return nil
+	})
+	_, err := scoped.User.Query().All(ctx)
+	require.EqualError(err, "missing name in context")
+	require.Equal(3, client.User.Query().CountX(ctx), "the original client is not changed")
+
+	ctx = context.WithValue(ctx, nameKey{}, "a8m")
+	require.Equal(2, scoped.User.Query().CountX(ctx))
+	require.True(scoped.User.Query().Where(user.AgeLT(18)).ExistX(ctx))
+	require.Len(scoped.User.Query().IDsX(ctx), 2)
+	u, err := scoped.User.GetNotFoundOK(ctx, nati.ID)
+	require.NoError(err)
+	require.Nil(u)
+	require.Equal([]int{10, 30}, scoped.User.Query().Order(ent.Asc(user.FieldAge)).Select(user.FieldAge).IntsX(ctx))
+	require.Equal([]string{"a8m"}, scoped.User.Query().GroupBy(user.FieldName).StringsX(ctx))
+	a8m = scoped.User.GetX(ctx, a8m.ID)
+	require.Equal(1, a8m.QueryFriends().CountX(ctx), "interceptors are applied on edge queries")
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(nati).SaveX(ctx)
+	require.Equal(2, client.User.Query().QueryPets().CountX(ctx))
+	require.Equal("pedro", scoped.User.Query().QueryPets().OnlyX(ctx).Name, "interceptors are applied on the source of edge paths")
+	require.Equal(a8m.ID, scoped.User.Query().QueryPets().QueryOwner().OnlyX(ctx).ID)
+	_, err = scoped.User.Query().QueryPets().All(context.Background())
+	require.EqualError(err, "missing name in context", "the source of edge paths is prepared with the context of the query")
+
+	query := scoped.User.Query()
+	require.Equal(2, query.CountX(ctx))
+	require.Len(query.AllX(ctx), 2, "predicates of interceptors are not stacked on the query")
+	require.Equal(1, query.CountX(context.WithValue(ctx, nameKey{}, "nati")))
+
+	n, err := scoped.User.Update().Where(user.AgeGT(18)).AddAge(1).Save(ctx)
+	require.NoError(err)
+	require.Equal(1, n, "interceptors are applied on updates by predicates")
+	require.Equal(28, client.User.GetX(ctx, nati.ID).Age)
+	err = scoped.User.UpdateOneID(nati.ID).SetAge(18).Exec(ctx)
+	require.True(ent.IsNotFound(err), "interceptors are applied on updates by id")
+	require.Zero(scoped.User.Delete().Where(user.ID(nati.ID)).ExecX(ctx), "interceptors are applied on deletes by predicates")
+	err = scoped.User.DeleteOneID(nati.ID).Exec(ctx)
+	require.True(ent.IsNotFound(err), "interceptors are applied on deletes by id")
+	require.Equal(28, client.User.GetX(ctx, nati.ID).Age)
+	_, err = scoped.User.Delete().Exec(context.Background())
+	require.EqualError(err, "missing name in context")
+	require.Equal(3, client.User.Query().CountX(ctx))
+
+	tx, err := scoped.Tx(ctx)
+	require.NoError(err)
+	require.Equal(2, tx.User.Query().CountX(ctx), "interceptors are shared with transactions")
+	tx.User.Intercept(func(_ context.Context, q *ent.UserQuery) error {
+		q.Where(user.AgeGT(18))
+		return nil
+	})
+	require.Equal(1, tx.User.Query().CountX(ctx))
+	require.NoError(tx.Commit())
+	require.Equal(2, scoped.User.Query().CountX(ctx), "interceptors of transactions are not added to their client")
+}
+
+func Sensitive(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetPassword("secret").SaveX(ctx)
+	require.NotContains(a8m.String(), "secret")
+	buf, err := json.Marshal(a8m)
+	require.NoError(err)
+	require.NotContains(string(buf), "secret")
+	t.Log("sensitive fields are still supported by the builders")
+	require.Equal("secret", client.User.GetX(ctx, a8m.ID).Password)
+	a8m = a8m.Update().SetPassword("s3cret").SaveX(ctx)
+	require.Equal("s3cret", client.User.Query().Where(user.Password("s3cret")).OnlyX(ctx).Password)
+}
+
+func Descriptor(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	desc := card.Descriptor()
+	require.Equal("Card", desc.Name)
+	require.Equal(card.Label, desc.Label)
+	require.Equal(card.FieldID, desc.ID.StorageKey)
+	require.Len(desc.Fields, 3)
+	created, number := desc.Fields[0], desc.Fields[2]
+	require.Equal(card.FieldCreatedAt, created.StorageKey)
+	require.True(created.Immutable)
+	require.True(created.Default)
+	require.Nil(created.Validator)
+	require.Equal("number", number.Name)
+	require.Equal(field.TypeString, number.Type)
+	require.Equal("string", number.GoType)
+	require.NoError(number.Validator("1"))
+	require.Error(number.Validator(""), "number is not empty")
+	require.Error(number.Validator(1), "unexpected type")
+	require.Len(desc.Edges, 1)
+	owner := desc.Edges[0]
+	require.Equal("User", owner.Type)
+	require.Equal("O2O", owner.Rel)
+	require.True(owner.Inverse)
+	require.Equal([]string{card.OwnerColumn}, owner.Columns)
+
+	t.Log("enum validators")
+	var state *entgo.FieldDescriptor
+	for _, f := range fieldtype.Descriptor().Fields {
+		if f.Name == fieldtype.FieldState {
+			state = f
+		}
+	}
+	require.NotNil(state)
+	require.Equal([]string{"on", "off"}, state.Enums)
+	require.NoError(state.Validator(fieldtype.StateOn))
+	require.Error(state.Validator("on"), "enum values are typed")
+}
+
+func Touch(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	crd := client.Card.Create().SetNumber("1").SaveX(ctx)
+	require.NoError(client.Card.Touch(ctx, crd.ID))
+	touched := client.Card.GetX(ctx, crd.ID)
+	require.False(touched.UpdatedAt.Before(crd.UpdatedAt))
+	require.Equal(crd.CreatedAt.Unix(), touched.CreatedAt.Unix(), "created_at is not changed")
+	require.NoError(client.Card.Touch(ctx, crd.ID), "touch twice")
+	err := client.Card.Touch(ctx, crd.ID+"0")
+	require.True(ent.IsNotFound(err))
+}
+
+func SaveIDs(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.Create().SetName("alex").SetAge(40).SaveX(ctx)
+
+	ids, err := client.User.Update().Where(user.AgeLT(35)).AddAge(1).SaveIDs(ctx)
+	require.NoError(err)
+	require.ElementsMatch([]string{a8m.ID, nati.ID}, ids)
+
+	users, err := client.User.Update().Where(user.AgeLT(35)).SetLast("young").SaveReturning(ctx)
+	require.NoError(err)
+	require.Len(users, 2)
+	for _, u := range users {
+		require.Equal("young", u.Last)
+		require.Contains([]int{29, 31}, u.Age)
+	}
+	require.Equal("old", users[0].Update().SetLast("old").SaveX(ctx).Last)
+
+	ids, err = client.User.Update().Where(user.Name("unknown")).SetAge(1).SaveIDs(ctx)
+	require.NoError(err)
+	require.Empty(ids)
+	users, err = client.User.Update().Where(user.Name("unknown")).SetAge(1).SaveReturning(ctx)
+	require.NoError(err)
+	require.Empty(users)
+}
+
+func EagerLoading(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	alex := client.User.Create().SetName("alex").SetAge(30).SetParent(a8m).SaveX(ctx)
+	client.Card.Create().SetNumber("102030").SetOwner(a8m).SaveX(ctx)
+	for _, name := range []string{"a", "b", "c"} {
+		client.Pet.Create().SetName(name).SetOwner(a8m).SaveX(ctx)
+	}
+	client.Pet.Create().SetName("d").SetOwner(nati).SaveX(ctx)
+	hub := client.Group.Create().SetName("GitHub").SetExpire(time.Now().Add(time.Hour)).SetInfo(client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)).SaveX(ctx)
+	lab := client.Group.Create().SetName("GitLab").SetExpire(time.Now().Add(time.Hour)).SetInfo(client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)).SaveX(ctx)
+	a8m.Update().AddGroups(hub, lab).ExecX(ctx)
+	nati.Update().AddGroups(hub).ExecX(ctx)
+
+	users := client.User.Query().
+		WithCard().
+		WithPets(func(q *ent.PetQuery) {
+			q.Order(ent.Asc(pet.FieldName))
+		}).
+		WithGroups().
+		WithParent().
+		Order(ent.Asc(user.FieldName)).
+		AllX(ctx)
+	require.Len(users, 3)
+	require.Equal(a8m.ID, users[0].ID)
+	require.Equal("102030", users[0].Edges.Card.Number)
+	require.Len(users[0].Edges.Pets, 3)
+	require.Equal("a", users[0].Edges.Pets[0].Name)
+	require.Len(users[0].Edges.Groups, 2)
+	require.Nil(users[0].Edges.Parent)
+	require.Equal(alex.ID, users[1].ID)
+	require.Equal(a8m.ID, users[1].Edges.Parent.ID)
+	require.Nil(users[1].Edges.Card)
+	require.Empty(users[1].Edges.Pets)
+	require.Equal(nati.ID, users[2].ID)
+	require.Len(users[2].Edges.Pets, 1)
+	require.Len(users[2].Edges.Groups, 1)
+
+	t.Log("limit and offset are applied on the edges of each node")
+	users = client.User.Query().
+		Where(user.HasPets()).
+		WithPets(func(q *ent.PetQuery) {
+			q.Order(ent.Desc(pet.FieldName)).Limit(2)
+		}).
+		Order(ent.Asc(user.FieldName)).
+		AllX(ctx)
+	require.Len(users, 2)
+	require.Len(users[0].Edges.Pets, 2)
+	require.Equal("c", users[0].Edges.Pets[0].Name)
+	require.Equal("b", users[0].Edges.Pets[1].Name)
+	require.Len(users[1].Edges.Pets, 1)
+	a8m = client.User.Query().
+		Where(user.ID(a8m.ID)).
+		WithPets(func(q *ent.PetQuery) {
+			q.Order(ent.Asc(pet.FieldName)).Offset(1).Limit(1)
+		}).
+		OnlyX(ctx)
+	require.Len(a8m.Edges.Pets, 1)
+	require.Equal("b", a8m.Edges.Pets[0].Name)
+
+	t.Log("edges are loaded with their predicates and nested edges")
+	pets := client.Pet.Query().
+		WithOwner(func(q *ent.UserQuery) {
+			q.Where(user.Name("a8m")).WithGroups(func(q *ent.GroupQuery) {
+				q.Where(group.Name("GitLab"))
+			})
+		}).
+		Order(ent.Asc(pet.FieldName)).
+		AllX(ctx)
+	require.Len(pets, 4)
+	require.Equal(a8m.ID, pets[0].Edges.Owner.ID)
+	require.Len(pets[0].Edges.Owner.Edges.Groups, 1)
+	require.Equal(lab.ID, pets[0].Edges.Owner.Edges.Groups[0].ID)
+	require.Nil(pets[3].Edges.Owner)
+}
+
+func Projection(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetLast("mashraki").SaveX(ctx)
+	client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+
+	users := client.User.Query().Fields(user.FieldName).Order(ent.Asc(user.FieldName)).AllX(ctx)
+	require.Len(users, 2)
+	require.Equal(a8m.ID, users[0].ID)
+	require.Equal("a8m", users[0].Name)
+	require.Zero(users[0].Age, "fields that were not selected should be zero")
+	require.Empty(users[0].Last)
+	require.Equal("nati", users[1].Name)
+
+	u := client.User.Query().Where(user.Name("a8m")).Fields(user.FieldAge, user.FieldLast).OnlyX(ctx)
+	require.Equal(30, u.Age)
+	require.Equal("mashraki", u.Last)
+	require.Empty(u.Name)
+	require.Equal(a8m.ID, u.ID)
+
+	q := client.User.Query().Fields(user.FieldName)
+	require.Equal("a8m", q.Clone().Where(user.AgeGT(29)).OnlyX(ctx).Name)
+	if client.Dialect() != dialect.Gremlin {
+		_, err := client.User.Query().Fields("unknown").All(ctx)
+		require.Error(err)
+	}
+}
+
+func QueryString(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+
+	q := client.User.Query().Where(user.Name("a8m"))
+	query, args, err := q.QueryString(ctx)
+	require.NoError(err)
+	if client.Dialect() == dialect.Gremlin {
+		require.Contains(query, "valueMap")
+		require.NotEmpty(args)
+	} else {
+		require.Contains(query, user.Table)
+		require.Equal([]interface{}{"a8m"}, args)
+		_, _, err = client.User.Query().Fields("unknown").QueryString(ctx)
+		require.Error(err)
+	}
+	require.Equal(a8m.ID, q.OnlyX(ctx).ID, "query should not be executed by QueryString")
+}
+
+func Hint(t *testing.T, client *ent.Client) {
+	if client.Dialect() == dialect.Gremlin {
+		t.Skip("query hints are supported only by SQL dialects")
+	}
+	require := require.New(t)
+	ctx := context.Background()
+	client.File.Create().SetName("a").SetSize(10).SaveX(ctx)
+	client.File.Create().SetName("b").SetSize(20).SaveX(ctx)
+
+	q := client.File.Query().Where(file.Name("a")).Hint(sql.UseIndex("name_size"))
+	query, _, err := q.QueryString(ctx)
+	require.NoError(err)
+	require.Contains(query, "name_size")
+	require.Equal(10, q.OnlyX(ctx).Size)
+	require.Equal(1, q.Clone().CountX(ctx))
+	require.Equal(2, client.File.Query().Hint(sql.ForceIndex("name_size"), sql.StraightJoin()).CountX(ctx))
 }
 
 func Select(t *testing.T, client *ent.Client) {
@@ -334,6 +1398,51 @@ func Predicate(t *testing.T, client *ent.Client) {
 			).
 			CountX(ctx),
 	)
+
+	require.Equal(1, client.File.Query().Where(file.UserEqualFold("A8M")).CountX(ctx))
+	require.Zero(client.File.Query().Where(file.UserEqualFold("A8")).CountX(ctx))
+	require.Equal(2, client.File.Query().Where(file.UserContainsFold("A")).CountX(ctx))
+	require.Equal(f5.Name, client.File.Query().Where(file.UserContainsFold("SHRA")).OnlyX(ctx).Name)
+}
+
+func TuplePredicate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	client.File.Create().SetName("a").SetSize(10).SetUser("a8m").SaveX(ctx)
+	client.File.Create().SetName("a").SetSize(20).SetUser("nati").SaveX(ctx)
+	client.File.Create().SetName("b").SetSize(30).SetUser("a8m").SaveX(ctx)
+	client.File.Create().SetName("c").SetSize(40).SaveX(ctx)
+
+	files := client.File.Query().
+		Where(file.NameUserIn(file.NameUser{Name: "a", User: "a8m"}, file.NameUser{Name: "b", User: "a8m"}, file.NameUser{Name: "b", User: "nati"})).
+		Order(ent.Asc(file.FieldSize)).
+		AllX(ctx)
+	require.Len(files, 2)
+	require.Equal(10, files[0].Size)
+	require.Equal(30, files[1].Size)
+	require.Zero(client.File.Query().Where(file.NameUserIn()).CountX(ctx))
+
+	n := client.File.Update().Where(file.NameUserIn(file.NameUser{Name: "a", User: "nati"})).SetSize(25).SaveX(ctx)
+	require.Equal(1, n)
+	require.Equal(25, client.File.Query().Where(file.Name("a"), file.User("nati")).OnlyX(ctx).Size)
+}
+
+func TimePredicate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	for i := 3; i > 0; i-- {
+		client.Card.Create().SetNumber(fmt.Sprint(i)).SetCreatedAt(now.Add(-time.Duration(i) * time.Hour)).SaveX(ctx)
+	}
+	numbers := client.Card.Query().
+		Where(card.CreatedAtBetween(now.Add(-3*time.Hour), now.Add(-2*time.Hour))).
+		Order(ent.Asc(card.FieldNumber)).
+		Select(card.FieldNumber).
+		StringsX(ctx)
+	require.Equal([]string{"2", "3"}, numbers, "range is inclusive")
+	require.Zero(client.Card.Query().Where(card.CreatedAtBetween(now, now.Add(time.Hour))).CountX(ctx))
+	require.Equal(1, client.Card.Query().Where(card.CreatedAtWithinLast(90*time.Minute)).CountX(ctx))
+	require.Equal(3, client.Card.Query().Where(card.CreatedAtWithinLast(4*time.Hour)).CountX(ctx))
 }
 
 func AddValues(t *testing.T, client *ent.Client) {
@@ -360,6 +1469,272 @@ func AddValues(t *testing.T, client *ent.Client) {
 	require.Equal(30, client.Comment.GetX(ctx, cmt1.ID).UniqueInt)
 }
 
+func Mutation(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	create := client.User.Create().SetName("a8m").SetAge(30)
+	m := create.Mutation()
+	require.Equal(entgo.OpCreate, m.Op())
+	require.Equal("User", m.Type())
+	require.Equal([]string{"age", "name"}, m.Fields())
+	v, ok := m.Field("name")
+	require.True(ok)
+	require.Equal("a8m", v)
+	_, ok = m.Field("nickname")
+	require.False(ok)
+	require.NoError(m.SetField("name", "Ariel"))
+	require.Error(m.SetField("name", 1), "mismatched type")
+	require.Error(m.SetField("unknown", 1), "unknown field")
+	usr := create.SaveX(ctx)
+	require.Equal("Ariel", usr.Name)
+
+	pet := client.Pet.Create().SetName("pedro").SaveX(ctx)
+	update := usr.Update().AddPets(pet).ClearNickname()
+	m = update.Mutation()
+	require.Equal(entgo.OpUpdateOne, m.Op())
+	id, ok := m.ID()
+	require.True(ok)
+	require.Equal(usr.ID, id)
+	require.Equal([]string{"pets"}, m.AddedEdges())
+	require.Equal([]entgo.Value{pet.ID}, m.AddedIDs("pets"))
+	require.Equal([]string{"nickname"}, m.ClearedFields())
+	require.NoError(m.SetField("age", 31))
+	require.Equal(31, update.SaveX(ctx).Age)
+
+	crd := client.Card.Create().SetNumber("102030").SaveX(ctx)
+	err := crd.Update().Mutation().SetField("created_at", time.Now())
+	require.True(ent.IsImmutableField(err), "immutable fields can't be set on update")
+	require.EqualError(err, `ent: field "created_at" of Card is immutable`)
+	require.NoError(client.Card.Create().Mutation().SetField("created_at", time.Now()))
+
+	var pm entgo.Mutation = client.Pet.Update().ClearOwner().Mutation()
+	require.Equal(entgo.OpUpdate, pm.Op())
+	require.Equal([]string{"owner"}, pm.ClearedEdges())
+}
+
+func Watch(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	next := func(ch <-chan *ent.Event) *ent.Event {
+		select {
+		case e := <-ch:
+			return e
+		case <-time.After(5 * time.Second):
+			require.FailNow("event was not delivered")
+			return nil
+		}
+	}
+	users, adults, pets := client.User.Watch(ctx), client.User.Watch(ctx, user.AgeGT(18)), client.Pet.Watch(ctx)
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	e := next(users)
+	require.Equal(entgo.OpCreate, e.Op)
+	require.Equal(user.Label, e.Type)
+	require.Equal(a8m.ID, e.ID)
+	require.Equal(a8m, e.Node)
+	require.Equal(a8m.ID, next(adults).ID)
+
+	kid := client.User.Create().SetName("kid").SetAge(10).SaveX(ctx)
+	require.Equal(kid.ID, next(users).ID)
+	a8m = a8m.Update().SetAge(31).SaveX(ctx)
+	e = next(users)
+	require.Equal(entgo.OpUpdateOne, e.Op)
+	require.Equal(a8m.ID, e.ID)
+	e = next(adults)
+	require.Equal(entgo.OpUpdateOne, e.Op, "kid creation should be filtered by the predicate")
+	require.Equal(31, e.Node.(*ent.User).Age)
+
+	client.User.Update().Where(user.Name(kid.Name)).SetAge(11).ExecX(ctx)
+	e = next(users)
+	require.Equal(entgo.OpUpdate, e.Op)
+	require.Nil(e.ID)
+	require.Equal(1, e.N)
+
+	tx, err := client.Tx(ctx)
+	require.NoError(err)
+	tx.User.Create().SetName("rollback").SetAge(1).SaveX(ctx)
+	require.NoError(tx.Rollback())
+	tx, err = client.Tx(ctx)
+	require.NoError(err)
+	committed := tx.User.Create().SetName("commit").SetAge(1).SaveX(ctx)
+	select {
+	case e := <-users:
+		require.FailNow("event was delivered before commit", e)
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.NoError(tx.Commit())
+	require.Equal(committed.ID, next(users).ID, "events of rolled back transactions should be discarded")
+
+	client.User.DeleteOne(a8m).ExecX(ctx)
+	e = next(users)
+	require.Equal(entgo.OpDeleteOne, e.Op)
+	require.Equal(a8m.ID, e.ID)
+	require.Equal(entgo.OpUpdate, next(adults).Op)
+	require.Equal(entgo.OpDeleteOne, next(adults).Op)
+
+	pedro := client.Pet.Create().SetName("pedro").SaveX(ctx)
+	require.Equal(pedro.ID, next(pets).ID, "user events should not be delivered to pet watchers")
+	cancel()
+	for range users {
+	}
+}
+
+func SlowQuery(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	var logs []string
+	logf := func(v ...interface{}) { logs = append(logs, fmt.Sprint(v...)) }
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+
+	client.With(ent.Log(logf), ent.SlowQueryThreshold(time.Hour)).User.Query().AllX(ctx)
+	require.Empty(logs, "fast queries are not logged")
+	slow := client.With(ent.Log(logf), ent.SlowQueryThreshold(time.Nanosecond))
+	slow.User.Query().Where(user.ID(a8m.ID)).OnlyX(ctx)
+	require.Len(logs, 1)
+	require.Contains(logs[0], "slow query")
+	require.Contains(logs[0], "origin=ent.(*UserQuery)")
+
+	logs = logs[:0]
+	tx, err := slow.Tx(ctx)
+	require.NoError(err)
+	tx.User.UpdateOne(a8m).SetAge(31).ExecX(ctx)
+	require.NoError(tx.Commit())
+	require.NotEmpty(logs, "slow queries of transactions are logged")
+	require.Contains(logs[0], "origin=ent.(*UserUpdateOne)")
+}
+
+func CallOptions(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Log("validate mutations without applying them")
+	v, err := client.Item.Create().SetFunc("f").Save(ctx, entgo.WithValidationOnly())
+	require.NoError(err)
+	require.Nil(v)
+	require.Zero(client.Item.Query().CountX(ctx))
+	_, err = client.Item.Create().SetFunc("").Save(ctx, entgo.WithValidationOnly())
+	require.Error(err)
+	it := client.Item.Create().SetFunc("f").SaveX(ctx)
+	err = it.Update().SetFunc("long function name").Exec(ctx, entgo.WithValidationOnly())
+	require.Error(err)
+	it.Update().SetFunc("g").ExecX(ctx, entgo.WithValidationOnly())
+	require.Equal("f", client.Item.GetX(ctx, it.ID).Func)
+	n := client.Item.Update().SetFunc("g").SaveX(ctx, entgo.WithValidationOnly())
+	require.Zero(n)
+	client.Item.DeleteOne(it).ExecX(ctx, entgo.WithValidationOnly())
+	require.Equal(1, client.Item.Query().CountX(ctx))
+
+	t.Log("skip the change events of mutations")
+	items := client.Item.Watch(ctx)
+	client.Item.Create().SaveX(ctx, entgo.WithoutHooks())
+	client.Item.UpdateOne(it).SetFunc("g").ExecX(ctx, entgo.WithoutHooks())
+	client.Item.Create().SetFunc("h").SaveX(ctx)
+	select {
+	case e := <-items:
+		require.Equal(entgo.OpCreate, e.Op)
+		require.Equal("h", e.Node.(*ent.Item).Func)
+	case <-time.After(5 * time.Second):
+		require.FailNow("event was not delivered")
+	}
+	require.Len(client.Item.Query().AllX(ctx, entgo.WithoutHooks()), 3)
+}
+
+func Fixtures(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	require := require.New(t)
+	require.NoError(client.LoadFixtures(ctx, "testdata/fixtures.yaml"))
+
+	a8m := client.User.Query().Where(user.Name("a8m")).OnlyX(ctx)
+	require.Equal(30, a8m.Age)
+	nati := a8m.QuerySpouse().OnlyX(ctx)
+	require.Equal("nati", nati.Name)
+	require.Equal(nati.ID, a8m.QueryFriends().OnlyX(ctx).ID)
+	require.Equal("pedro", a8m.QueryPets().OnlyX(ctx).Name)
+	require.Equal("xabi", nati.QueryPets().OnlyX(ctx).Name)
+
+	ft := client.FieldType.Query().OnlyX(ctx)
+	require.Equal(int8(8), ft.Int8)
+	require.Equal(int8(10), *ft.NillableInt8)
+	require.Equal(fieldtype.StateOn, ft.State)
+
+	err := client.LoadFixtures(ctx, "testdata/fixtures.yaml", "testdata/fixtures.yaml")
+	require.Error(err, "references should not be redeclared")
+	require.Contains(err.Error(), "redeclared")
+}
+
+func Reload(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.Update().AddAge(1).ExecX(ctx)
+	require.Equal(30, a8m.Age)
+	client.User.ReloadX(ctx, a8m, nati)
+	require.Equal(31, a8m.Age)
+	require.Equal(29, nati.Age)
+
+	client.User.DeleteOne(nati).ExecX(ctx)
+	client.User.Update().AddAge(1).ExecX(ctx)
+	err := client.User.Reload(ctx, a8m, nati)
+	require.True(ent.IsNotFound(err))
+	require.Equal(31, a8m.Age, "entities should not be changed on error")
+	require.NoError(client.User.Reload(ctx))
+}
+
+// userAges is a service that depends only on the read API of users.
+type userAges struct{ users ent.UserQuerier }
+
+func (s userAges) Age(ctx context.Context, id string) (int, error) {
+	u, err := s.users.Get(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	return u.Age, nil
+}
+
+// mockUsers is a hand-written mock of the read API of users.
+type mockUsers struct {
+	ent.UserQuerier
+	users map[string]*ent.User
+}
+
+func (m mockUsers) Get(_ context.Context, id string) (*ent.User, error) {
+	u, ok := m.users[id]
+	if !ok {
+		return nil, &ent.ErrNotFound{}
+	}
+	return u, nil
+}
+
+func Queriers(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	age, err := userAges{client.User}.Age(ctx, a8m.ID)
+	require.NoError(err)
+	require.Equal(30, age)
+
+	mock := userAges{mockUsers{users: map[string]*ent.User{"a8m": {Age: 10}}}}
+	age, err = mock.Age(ctx, "a8m")
+	require.NoError(err)
+	require.Equal(10, age)
+	_, err = mock.Age(ctx, "nati")
+	require.True(ent.IsNotFound(err))
+
+	var users ent.UserQuerier = client.User
+	require.True(users.ExistX(ctx, a8m.ID))
+	nati := client.User.Create().SetName("nati").SetAge(30).SaveX(ctx)
+	client.User.DeleteOne(nati).ExecX(ctx)
+	require.False(users.ExistX(ctx, nati.ID))
+	u, err := users.GetNotFoundOK(ctx, nati.ID)
+	require.NoError(err)
+	require.Nil(u)
+}
+
 func Delete(t *testing.T, client *ent.Client) {
 	require := require.New(t)
 	ctx := context.Background()
@@ -624,6 +1999,20 @@ func Relation(t *testing.T, client *ent.Client) {
 	for i := range v2 {
 		require.Equal(2, v2[i].Total)
 	}
+
+	t.Log("group by with having")
+	v = nil
+	client.User.Query().
+		GroupBy(user.FieldName, user.FieldAge).
+		Aggregate(ent.Count(), ent.Sum(user.FieldAge)).
+		Having(sql.GT(sql.Count("*"), 1), __.Select(ent.DefaultCountLabel).Is(p.GT(1))).
+		ScanX(ctx, &v)
+	require.Len(v, 2)
+	sort.Slice(v, func(i, j int) bool { return v[i].Name < v[j].Name })
+	for i, usr := range []*ent.User{usr, neta} {
+		require.Equal(usr.Name, v[i].Name)
+		require.Equal(2, v[i].Count)
+	}
 }
 
 func ClearFields(t *testing.T, client *ent.Client) {
@@ -649,6 +2038,30 @@ func ClearFields(t *testing.T, client *ent.Client) {
 	require.Nil(t, img.User)
 }
 
+func RequiredEdges(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	inf := client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)
+
+	t.Log("create without a required edge")
+	_, err := client.Group.Create().SetName("Github").SetExpire(time.Now()).Save(ctx)
+	require.EqualError(err, `ent: missing required edge "info"`)
+	require.Zero(client.Group.Query().CountX(ctx))
+
+	t.Log("clear a required edge")
+	grp := client.Group.Create().SetName("Github").SetExpire(time.Now()).SetInfo(inf).SaveX(ctx)
+	_, err = grp.Update().ClearInfo().Save(ctx)
+	require.EqualError(err, `ent: clearing a unique edge "info"`)
+	require.Equal(inf.ID, grp.QueryInfo().OnlyXID(ctx))
+
+	t.Log("replace a required edge")
+	inf2 := client.GroupInfo.Create().SetDesc("desc2").SaveX(ctx)
+	grp.Update().ClearInfo().SetInfo(inf2).ExecX(ctx)
+	require.Equal(inf2.ID, grp.QueryInfo().OnlyXID(ctx))
+	client.Group.Update().SetInfo(inf).ExecX(ctx)
+	require.Equal(inf.ID, grp.QueryInfo().OnlyXID(ctx))
+}
+
 func UniqueConstraint(t *testing.T, client *ent.Client) {
 	require := require.New(t)
 	ctx := context.Background()
@@ -1537,6 +2950,15 @@ func M2MSelfRef(t *testing.T, client *ent.Client) {
 	require.Equal(foo.Name, baz.QueryFriends().OnlyX(ctx).Name)
 	require.Equal(3, client.User.Query().Where(user.HasFriends()).CountX(ctx))
 
+	t.Log("clear friendships using update")
+	foo.Update().ClearFriends().ExecX(ctx)
+	require.False(foo.QueryFriends().ExistX(ctx))
+	require.False(bar.QueryFriends().ExistX(ctx))
+	require.False(baz.QueryFriends().ExistX(ctx))
+	// return back the friendships.
+	foo.Update().AddFriends(bar, baz).ExecX(ctx)
+	require.Equal(3, client.User.Query().Where(user.HasFriends()).CountX(ctx))
+
 	t.Log("query with side lookup")
 	require.Equal(
 		[]string{bar.Name, baz.Name},
@@ -1822,6 +3244,15 @@ func M2MTwoTypes(t *testing.T, client *ent.Client) {
 	// add back the user.
 	hub.Update().AddUsers(foo).ExecX(ctx)
 
+	t.Log("clear groups using update (assoc)")
+	foo.Update().ClearGroups().ExecX(ctx)
+	require.False(foo.QueryGroups().ExistX(ctx))
+	require.False(hub.QueryUsers().ExistX(ctx))
+
+	t.Log("clear and add users in the same update (inverse)")
+	hub.Update().ClearUsers().AddUsers(foo).ExecX(ctx)
+	require.Equal(foo.Name, hub.QueryUsers().OnlyX(ctx).Name)
+
 	t.Log("multiple groups and users")
 	lab := client.Group.Create().SetName("Gitlab").SetExpire(time.Now()).SetInfo(inf).SaveX(ctx)
 	bar := client.User.Create().SetAge(10).SetName("bar").SaveX(ctx)
@@ -1927,6 +3358,29 @@ func Tx(t *testing.T, client *ent.Client) {
 	_, err = tx.Client().Tx(ctx)
 	require.Error(err, "cannot start a transaction within a transaction")
 	require.NoError(tx.Rollback())
+
+	t.Log("nested transactions")
+	count := client.Node.Query().CountX(ctx)
+	tx, err = client.Tx(ctx)
+	require.NoError(err)
+	tx.Node.Create().SetValue(1).SaveX(ctx)
+	nested, err := tx.Begin(ctx)
+	require.NoError(err)
+	nested.Node.Create().SetValue(2).SaveX(ctx)
+	require.NoError(nested.Rollback())
+	require.Error(nested.Commit(), "savepoint was already rolled back")
+	require.Equal(count+1, tx.Node.Query().CountX(ctx), "rollback should discard only the nested changes")
+	nested, err = tx.Begin(ctx)
+	require.NoError(err)
+	inner, err := nested.Begin(ctx)
+	require.NoError(err)
+	inner.Client().Node.Create().SetValue(3).SaveX(ctx)
+	require.NoError(inner.Commit())
+	require.NoError(nested.Commit())
+	require.Equal(count+2, tx.Node.Query().CountX(ctx), "committed nested changes are part of the transaction")
+	require.NoError(tx.Commit())
+	require.Equal(count+2, client.Node.Query().CountX(ctx))
+	require.Equal([]int{1, 3}, client.Node.Query().Where(node.ValueIn(1, 2, 3)).Order(ent.Asc(node.FieldValue)).Select(node.FieldValue).IntsX(ctx))
 }
 
 func DefaultValue(t *testing.T, client *ent.Client) {
@@ -1941,6 +3395,52 @@ func DefaultValue(t *testing.T, client *ent.Client) {
 	require.False(t, utime.Equal(c1.UpdatedAt))
 }
 
+func TransformValue(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	c1 := client.Card.Create().SetNumber(" 1020-3040 ").SaveX(ctx)
+	require.Equal("10203040", c1.Number)
+	require.Equal(c1.Number, client.Card.GetX(ctx, c1.ID).Number)
+	_, err := client.Card.Create().SetNumber(" - ").Save(ctx)
+	require.Error(err, "number should be validated after transformation")
+
+	c1 = c1.Update().SetNumber("3040-1020\t").SaveX(ctx)
+	require.Equal("30401020", c1.Number)
+	client.Card.Update().Where(card.ID(c1.ID)).SetNumber(" 5060 ").ExecX(ctx)
+	require.Equal("5060", client.Card.GetX(ctx, c1.ID).Number)
+	_, err = client.Card.Update().SetNumber("-").Save(ctx)
+	require.Error(err)
+}
+
+func SchemaValidators(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	inf := client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)
+	_, err := client.Group.Create().SetName("Github").SetExpire(time.Now()).SetInfo(inf).SetType("private").SetMaxUsers(101).Save(ctx)
+	require.Error(err)
+	require.Contains(err.Error(), "private groups are limited to 100 users")
+	_, err = client.Group.Create().SetName("Github").SetExpire(time.Now()).SetInfo(inf).SetType("private").SetMaxUsers(101).Save(ctx, entgo.WithValidationOnly())
+	require.Error(err, "schema validators should run in validation-only mode")
+
+	grp := client.Group.Create().SetName("Github").SetExpire(time.Now()).SetInfo(inf).SetType("private").SaveX(ctx)
+	_, err = grp.Update().SetType("private").SetMaxUsers(200).Save(ctx)
+	require.Error(err)
+	_, err = client.Group.Update().Where(group.ID(grp.ID)).SetType("private").SetMaxUsers(200).Save(ctx)
+	require.Error(err)
+	require.Equal(10, client.Group.GetX(ctx, grp.ID).MaxUsers)
+	grp = grp.Update().SetType("public").SetMaxUsers(200).SaveX(ctx)
+	require.Equal(200, grp.MaxUsers)
+
+	_, err = client.Comment.CreateOrUpdateByUniqueInt(1).SetUniqueFloat(1).SetNillableInt(2).Save(ctx)
+	require.Error(err, "schema validators should run in upsert")
+	require.Contains(err.Error(), "nillable_int must not be greater than unique_int")
+	_, err = client.Comment.CreateOrUpdateByUniqueInt(1).SetUniqueFloat(1).SetNillableInt(2).Save(ctx, entgo.WithValidationOnly())
+	require.Error(err)
+	require.Zero(client.Comment.Query().CountX(ctx))
+	cmt := client.Comment.CreateOrUpdateByUniqueInt(1).SetUniqueFloat(1).SetNillableInt(1).SaveX(ctx)
+	require.Equal(1, *cmt.NillableInt)
+}
+
 func ImmutableValue(t *testing.T, client *ent.Client) {
 	tests := []struct {
 		name    string
@@ -1981,3 +3481,28 @@ func drop(t *testing.T, client *ent.Client) {
 	client.FieldType.Delete().ExecX(ctx)
 	client.FileType.Delete().ExecX(ctx)
 }
+
+func ReservedNames(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	i1 := client.Item.Create().
+		SetUpdateField("update").
+		SetLabelField("label").
+		SetType(item.TypeA).
+		SetFunc("func").
+		SaveX(ctx)
+	require.Equal("update", i1.UpdateField)
+	require.Equal("label", i1.LabelField)
+	_, err := client.Item.Create().SetFunc("").Save(ctx)
+	require.Error(err, "keyword field should be validated")
+	_, err = client.Item.Create().SetType("c").Save(ctx)
+	require.Error(err, "keyword enum field should be validated")
+
+	i2 := client.Item.Query().Where(item.UpdateField("update"), item.LabelField("label"), item.TypeEQ(item.TypeA)).OnlyX(ctx)
+	require.Equal(i1.ID, i2.ID)
+	require.Equal(i1.Func, i2.Func)
+	i2 = i2.Update().SetUpdateField("updated").ClearLabelField().SaveX(ctx)
+	require.Equal("updated", client.Item.GetX(ctx, i1.ID).UpdateField)
+	require.Empty(client.Item.GetX(ctx, i1.ID).LabelField)
+	require.Equal("update", item.FieldUpdateField, "storage key should be preserved")
+}
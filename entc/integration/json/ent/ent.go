@@ -7,6 +7,9 @@
 package ent
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -28,6 +31,28 @@ func Asc(fields ...string) Order {
 	)
 }
 
+// AscNullsFirst applies the given fields in ASC order, and places NULL values first.
+func AscNullsFirst(fields ...string) Order {
+	return Order(
+		func(s *sql.Selector) {
+			for _, f := range fields {
+				s.OrderBy(sql.AscNullsFirst(f))
+			}
+		},
+	)
+}
+
+// AscNullsLast applies the given fields in ASC order, and places NULL values last.
+func AscNullsLast(fields ...string) Order {
+	return Order(
+		func(s *sql.Selector) {
+			for _, f := range fields {
+				s.OrderBy(sql.AscNullsLast(f))
+			}
+		},
+	)
+}
+
 // Desc applies the given fields in DESC order.
 func Desc(fields ...string) Order {
 	return Order(
@@ -39,6 +64,28 @@ func Desc(fields ...string) Order {
 	)
 }
 
+// DescNullsFirst applies the given fields in DESC order, and places NULL values first.
+func DescNullsFirst(fields ...string) Order {
+	return Order(
+		func(s *sql.Selector) {
+			for _, f := range fields {
+				s.OrderBy(sql.DescNullsFirst(f))
+			}
+		},
+	)
+}
+
+// DescNullsLast applies the given fields in DESC order, and places NULL values last.
+func DescNullsLast(fields ...string) Order {
+	return Order(
+		func(s *sql.Selector) {
+			for _, f := range fields {
+				s.OrderBy(sql.DescNullsLast(f))
+			}
+		},
+	)
+}
+
 // Aggregate applies an aggregation step on the group-by traversal/selector.
 type Aggregate struct {
 	// SQL the column wrapped with the aggregation function.
@@ -104,6 +151,27 @@ func Sum(field string) Aggregate {
 	}
 }
 
+// FieldChange describes a change of a field value. For example, the
+// values of a field that are different between two entities.
+type FieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old,omitempty"`
+	New   interface{} `json:"new,omitempty"`
+}
+
+// Redacted replaces the values of sensitive fields in field changes (e.g. the audit
+// log and the shadow mismatches), since their values should never leak into logs.
+const Redacted = "[REDACTED]"
+
+// ErrConstraint is the sentinel error of constraint failures. All constraint errors match it
+// using errors.Is, regardless of the storage driver that reported them. For example:
+//
+//	if errors.Is(err, ent.ErrConstraint) {
+//		return status.Error(codes.AlreadyExists, err.Error())
+//	}
+//
+var ErrConstraint = errors.New("ent: constraint failed")
+
 // ErrNotFound returns when trying to fetch a specific entity and it was not found in the database.
 type ErrNotFound struct {
 	label string
@@ -115,9 +183,10 @@ func (e *ErrNotFound) Error() string {
 }
 
 // IsNotFound returns a boolean indicating whether the error is a not found error.
+// Errors that wrap a not found error (using the %w verb) are also reported.
 func IsNotFound(err error) bool {
-	_, ok := err.(*ErrNotFound)
-	return ok
+	var e *ErrNotFound
+	return errors.As(err, &e)
 }
 
 // MaskNotFound masks nor found error.
@@ -139,38 +208,108 @@ func (e *ErrNotSingular) Error() string {
 }
 
 // IsNotSingular returns a boolean indicating whether the error is a not singular error.
+// Errors that wrap a not singular error (using the %w verb) are also reported.
 func IsNotSingular(err error) bool {
-	_, ok := err.(*ErrNotSingular)
-	return ok
+	var e *ErrNotSingular
+	return errors.As(err, &e)
+}
+
+// ErrSchemaVersion returns when the database schema was migrated by a different version of the
+// generated code. For example, when an old binary runs against a database migrated by a new one.
+type ErrSchemaVersion struct {
+	// Database is the schema version that was recorded by the last migration.
+	Database string
+	// Client is the schema version of the generated code (migrate.Hash).
+	Client string
+}
+
+// Error implements the error interface.
+func (e *ErrSchemaVersion) Error() string {
+	return fmt.Sprintf("ent: schema version mismatch: database=%q, client=%q", e.Database, e.Client)
+}
+
+// IsSchemaVersion returns a boolean indicating whether the error is a schema version mismatch error.
+func IsSchemaVersion(err error) bool {
+	var e *ErrSchemaVersion
+	return errors.As(err, &e)
 }
 
-// ErrConstraintFailed returns when trying to create/update one or more entities and
+// ErrImmutableField returns when trying to set an immutable field in an update operation
+// using the generic mutation API (Mutation.SetField), since update builders don't have
+// setters for immutable fields. Immutable fields can be set only on creation.
+type ErrImmutableField struct {
+	// Type is the name of the node type.
+	Type string
+	// Field is the name of the immutable field.
+	Field string
+}
+
+// Error implements the error interface.
+func (e *ErrImmutableField) Error() string {
+	return fmt.Sprintf("ent: field %q of %s is immutable", e.Field, e.Type)
+}
+
+// IsImmutableField returns a boolean indicating whether the error is an immutable field error.
+func IsImmutableField(err error) bool {
+	var e *ErrImmutableField
+	return errors.As(err, &e)
+}
+
+// ConstraintError returns when trying to create/update one or more entities and
 // one or more of their constraints failed. For example, violation of edge or field uniqueness.
-type ErrConstraintFailed struct {
-	msg  string
-	wrap error
+type ConstraintError struct {
+	msg string
+	// Constraint is the name of the failed constraint as reported by the database (e.g. an index
+	// name in MySQL, or the table and the column in SQLite). It may be empty if it's unknown.
+	Constraint string
+	// Wrapped is the driver error that caused the failure, if there is one.
+	Wrapped error
 }
 
+// ErrConstraintFailed is the former name of ConstraintError.
+//
+// Deprecated: use ConstraintError or the ErrConstraint sentinel instead.
+type ErrConstraintFailed = ConstraintError
+
 // Error implements the error interface.
-func (e ErrConstraintFailed) Error() string {
+func (e ConstraintError) Error() string {
 	return fmt.Sprintf("ent: unique constraint failed: %s", e.msg)
 }
 
 // Unwrap implements the errors.Wrapper interface.
-func (e *ErrConstraintFailed) Unwrap() error {
-	return e.wrap
+func (e *ConstraintError) Unwrap() error {
+	return e.Wrapped
+}
+
+// Is reports whether the target is the ErrConstraint sentinel.
+func (e *ConstraintError) Is(target error) bool {
+	return target == ErrConstraint
 }
 
 // IsConstraintFailure returns a boolean indicating whether the error is a constraint failure.
 func IsConstraintFailure(err error) bool {
-	_, ok := err.(*ErrConstraintFailed)
-	return ok
+	return errors.Is(err, ErrConstraint)
 }
 
-func isSQLConstraintError(err error) (*ErrConstraintFailed, bool) {
-	// Error number 1062 is ER_DUP_ENTRY in mysql, and "UNIQUE constraint failed" is SQLite prefix.
-	if msg := err.Error(); strings.HasPrefix(msg, "Error 1062") || strings.HasPrefix(msg, "UNIQUE constraint failed") {
-		return &ErrConstraintFailed{msg, err}, true
+// isSQLConstraintError reports whether the given error, or one of the errors it wraps, is a
+// uniqueness violation of the database. Error number 1062 is ER_DUP_ENTRY in MySQL, and the
+// "UNIQUE constraint failed" message is used by SQLite for SQLITE_CONSTRAINT_UNIQUE (2067).
+func isSQLConstraintError(err error) (*ConstraintError, bool) {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		msg := e.Error()
+		switch {
+		// Error 1062: Duplicate entry 'a8m' for key 'name'.
+		case strings.HasPrefix(msg, "Error 1062"):
+			var constraint string
+			if i := strings.LastIndex(msg, " for key "); i != -1 {
+				constraint = strings.Trim(msg[i+len(" for key "):], "'")
+			}
+			return &ConstraintError{msg: msg, Constraint: constraint, Wrapped: e}, true
+		// UNIQUE constraint failed: users.name.
+		case strings.HasPrefix(msg, "UNIQUE constraint failed"):
+			constraint := strings.TrimSpace(strings.TrimPrefix(msg, "UNIQUE constraint failed:"))
+			return &ConstraintError{msg: msg, Constraint: constraint, Wrapped: e}, true
+		}
 	}
 	return nil, false
 }
@@ -186,6 +325,62 @@ func rollback(tx dialect.Tx, err error) error {
 	return err
 }
 
+// appendJSON appends the given values to the JSON arrays that are stored in the column of the
+// given rows. The rows are read and updated in the given transaction, and they are locked for
+// update in dialects that support it, in order to avoid lost updates by concurrent writers.
+func appendJSON(ctx context.Context, tx dialect.Tx, name, table, idColumn, column string, ids []int, values interface{}) error {
+	buf, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	var added []json.RawMessage
+	if err := json.Unmarshal(buf, &added); err != nil {
+		return err
+	}
+	selector := sql.Select(idColumn, column).From(sql.Table(table)).Where(sql.InInts(idColumn, ids...))
+	if name == dialect.MySQL {
+		selector.ForUpdate()
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	current := make(map[int][]byte, len(ids))
+	for rows.Next() {
+		var (
+			id int
+			v  []byte
+		)
+		if err := rows.Scan(&id, &v); err != nil {
+			rows.Close()
+			return fmt.Errorf("ent: failed scanning column %q: %v", column, err)
+		}
+		current[id] = v
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	for id, v := range current {
+		var array []json.RawMessage
+		if len(v) > 0 {
+			if err := json.Unmarshal(v, &array); err != nil {
+				return fmt.Errorf("ent: failed decoding column %q: %v", column, err)
+			}
+		}
+		buf, err := json.Marshal(append(array, added...))
+		if err != nil {
+			return err
+		}
+		var res sql.Result
+		query, args := sql.Update(table).Set(column, buf).Where(sql.EQ(idColumn, id)).Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // keys returns the keys/ids from the edge map.
 func keys(m map[int]struct{}) []int {
 	s := make([]int, 0, len(m))
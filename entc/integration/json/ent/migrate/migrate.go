@@ -32,8 +32,42 @@ var (
 	// and therefore, it's recommended to enable this option to get more
 	// flexibility in the schema changes.
 	WithDropIndex = schema.WithDropIndex
+	// WithMode sets the migration mode (phase). Use ModeExpand for running only the
+	// additive changes before deploying a new version of the code, and ModeContract
+	// for running the destructive changes after the old version is no longer deployed.
+	// This defaults to ModeFull, which runs all changes at once.
+	WithMode = schema.WithMode
+	// WithForeignKeys sets the foreign-keys creation option to the migration.
+	// Disable it for databases that do not support foreign-key constraints
+	// (e.g. Vitess), and enable the "softfk" codegen feature for checking the
+	// references in the application. This defaults to true.
+	WithForeignKeys = schema.WithForeignKeys
+	// WithHooks adds hooks to the migration. Hooks wrap the creation of the schema
+	// resources, and can execute custom statements (e.g. triggers or data backfills)
+	// before or after it, in the transaction of the migration.
+	WithHooks = schema.WithHooks
+	// WithForce sets the option for applying lossy column changes, like shrinking
+	// column sizes or removing enum values. If this option is disabled, ent migration
+	// fails on such changes, and applies only the safe ones (e.g. int to bigint).
+	// Changing a column to a type of another family (e.g. varchar to int) always
+	// fails the migration. This defaults to false.
+	WithForce = schema.WithForce
 )
 
+const (
+	// ModeFull runs all changes of the migration at once.
+	ModeFull = schema.ModeFull
+	// ModeExpand runs only the additive (expand) changes of the migration.
+	ModeExpand = schema.ModeExpand
+	// ModeContract runs only the destructive (contract) changes of the migration.
+	ModeContract = schema.ModeContract
+)
+
+// Hash is the fingerprint of the generated schema. It's recorded in the
+// database by the migration, and used by the client for checking that the
+// database was migrated by the same version of the generated code.
+const Hash = "0745cf77e1ab6ff6a9a348a55065536ebedb404c9c37efd8b3737173235659f1"
+
 // Schema is the API for creating, migrating and dropping a schema.
 type Schema struct {
 	drv         dialect.Driver
@@ -45,7 +79,7 @@ func NewSchema(drv dialect.Driver) *Schema { return &Schema{drv: drv} }
 
 // Create creates all schema resources.
 func (s *Schema) Create(ctx context.Context, opts ...schema.MigrateOption) error {
-	migrate, err := schema.NewMigrate(s.drv, opts...)
+	migrate, err := schema.NewMigrate(s.drv, append([]schema.MigrateOption{schema.WithVersion(Hash)}, opts...)...)
 	if err != nil {
 		return fmt.Errorf("ent/migrate: %v", err)
 	}
@@ -63,9 +97,42 @@ func (s *Schema) WriteTo(ctx context.Context, w io.Writer, opts ...schema.Migrat
 		Writer: w,
 		Driver: s.drv,
 	}
-	migrate, err := schema.NewMigrate(drv, opts...)
+	migrate, err := schema.NewMigrate(drv, append([]schema.MigrateOption{schema.WithVersion(Hash)}, opts...)...)
 	if err != nil {
 		return fmt.Errorf("ent/migrate: %v", err)
 	}
 	return migrate.Create(ctx, Tables...)
 }
+
+// Version returns the schema version (Hash) that was recorded by the last migration.
+// An empty string is returned if the database was not migrated by ent.
+func (s *Schema) Version(ctx context.Context) (string, error) {
+	migrate, err := schema.NewMigrate(s.drv)
+	if err != nil {
+		return "", fmt.Errorf("ent/migrate: %v", err)
+	}
+	return migrate.Version(ctx)
+}
+
+// Versions returns the schema versions that are compatible with the database, starting with the last
+// recorded one. After a migration in ModeExpand, both the expanded version and the versions that preceded
+// it are compatible with the database, until the migration in ModeContract is executed.
+func (s *Schema) Versions(ctx context.Context) ([]string, error) {
+	migrate, err := schema.NewMigrate(s.drv)
+	if err != nil {
+		return nil, fmt.Errorf("ent/migrate: %v", err)
+	}
+	return migrate.Versions(ctx)
+}
+
+// VerifyIntegrity verifies that the database schema matches the schema of the generated code, without
+// migrating it. It returns a *schema.DriftError that describes the drift (e.g. missing tables or columns,
+// or columns with mismatched types), or if Hash is not one of the schema versions that are compatible with
+// the database (see Versions).
+func (s *Schema) VerifyIntegrity(ctx context.Context) error {
+	migrate, err := schema.NewMigrate(s.drv, schema.WithVersion(Hash))
+	if err != nil {
+		return fmt.Errorf("ent/migrate: %v", err)
+	}
+	return migrate.Verify(ctx, Tables...)
+}
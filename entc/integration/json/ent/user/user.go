@@ -6,6 +6,12 @@
 
 package user
 
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/json/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the user type in the database.
 	Label = "user"
@@ -38,3 +44,87 @@ var Columns = []string{
 	FieldFloats,
 	FieldStrings,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope is a reusable named scope of the User queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.User
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the User type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "User",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeInt,
+		GoType:     "int",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "url",
+			StorageKey: FieldURL,
+			Type:       field.TypeJSON,
+			GoType:     "*url.URL",
+			Optional:   true,
+		},
+		{
+			Name:       "raw",
+			StorageKey: FieldRaw,
+			Type:       field.TypeJSON,
+			GoType:     "json.RawMessage",
+			Optional:   true,
+		},
+		{
+			Name:       "dirs",
+			StorageKey: FieldDirs,
+			Type:       field.TypeJSON,
+			GoType:     "[]http.Dir",
+			Optional:   true,
+		},
+		{
+			Name:       "ints",
+			StorageKey: FieldInts,
+			Type:       field.TypeJSON,
+			GoType:     "[]int",
+			Optional:   true,
+		},
+		{
+			Name:       "floats",
+			StorageKey: FieldFloats,
+			Type:       field.TypeJSON,
+			GoType:     "[]float64",
+			Optional:   true,
+		},
+		{
+			Name:       "strings",
+			StorageKey: FieldStrings,
+			Type:       field.TypeJSON,
+			GoType:     "[]string",
+			Optional:   true,
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{},
+}
+
+// Descriptor returns the runtime descriptor of the User type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
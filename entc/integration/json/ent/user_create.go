@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/json/ent/user"
 )
@@ -19,12 +20,12 @@ import (
 // UserCreate is the builder for creating a User entity.
 type UserCreate struct {
 	config
-	url     **url.URL
-	raw     *json.RawMessage
-	dirs    *[]http.Dir
-	ints    *[]int
-	floats  *[]float64
-	strings *[]string
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uc *UserCreate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uc.userMutation, op: ent.OpCreate}
 }
 
 // SetURL sets the url field.
@@ -64,13 +65,17 @@ func (uc *UserCreate) SetStrings(s []string) *UserCreate {
 }
 
 // Save creates the User in the database.
-func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+func (uc *UserCreate) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return uc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (uc *UserCreate) SaveX(ctx context.Context) *User {
-	v, err := uc.Save(ctx)
+func (uc *UserCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	v, err := uc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
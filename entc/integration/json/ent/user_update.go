@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/json/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/json/ent/user"
@@ -21,19 +22,11 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	url          **url.URL
-	clearurl     bool
-	raw          *json.RawMessage
-	clearraw     bool
-	dirs         *[]http.Dir
-	cleardirs    bool
-	ints         *[]int
-	clearints    bool
-	floats       *[]float64
-	clearfloats  bool
-	strings      *[]string
-	clearstrings bool
-	predicates   []predicate.User
+	userMutation
+	predicates []predicate.User
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*User
 }
 
 // Where adds a new predicate for the builder.
@@ -42,6 +35,11 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Mutation returns the mutation of the builder.
+func (uu *UserUpdate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uu.userMutation, op: ent.OpUpdate}
+}
+
 // SetURL sets the url field.
 func (uu *UserUpdate) SetURL(u *url.URL) *UserUpdate {
 	uu.url = &u
@@ -71,12 +69,26 @@ func (uu *UserUpdate) ClearRaw() *UserUpdate {
 // SetDirs sets the dirs field.
 func (uu *UserUpdate) SetDirs(h []http.Dir) *UserUpdate {
 	uu.dirs = &h
+	uu.appenddirs = nil
+	return uu
+}
+
+// AppendDirs appends the given values to the dirs field. The values are appended to the
+// value that is stored in the database, in the transaction of the update, and not to a stale copy of it.
+func (uu *UserUpdate) AppendDirs(v ...http.Dir) *UserUpdate {
+	if uu.dirs != nil {
+		values := append(append([]http.Dir{}, *uu.dirs...), v...)
+		uu.dirs = &values
+	} else {
+		uu.appenddirs = append(uu.appenddirs, v...)
+	}
 	return uu
 }
 
 // ClearDirs clears the value of dirs.
 func (uu *UserUpdate) ClearDirs() *UserUpdate {
 	uu.dirs = nil
+	uu.appenddirs = nil
 	uu.cleardirs = true
 	return uu
 }
@@ -84,12 +96,26 @@ func (uu *UserUpdate) ClearDirs() *UserUpdate {
 // SetInts sets the ints field.
 func (uu *UserUpdate) SetInts(i []int) *UserUpdate {
 	uu.ints = &i
+	uu.appendints = nil
+	return uu
+}
+
+// AppendInts appends the given values to the ints field. The values are appended to the
+// value that is stored in the database, in the transaction of the update, and not to a stale copy of it.
+func (uu *UserUpdate) AppendInts(v ...int) *UserUpdate {
+	if uu.ints != nil {
+		values := append(append([]int{}, *uu.ints...), v...)
+		uu.ints = &values
+	} else {
+		uu.appendints = append(uu.appendints, v...)
+	}
 	return uu
 }
 
 // ClearInts clears the value of ints.
 func (uu *UserUpdate) ClearInts() *UserUpdate {
 	uu.ints = nil
+	uu.appendints = nil
 	uu.clearints = true
 	return uu
 }
@@ -97,12 +123,26 @@ func (uu *UserUpdate) ClearInts() *UserUpdate {
 // SetFloats sets the floats field.
 func (uu *UserUpdate) SetFloats(f []float64) *UserUpdate {
 	uu.floats = &f
+	uu.appendfloats = nil
+	return uu
+}
+
+// AppendFloats appends the given values to the floats field. The values are appended to the
+// value that is stored in the database, in the transaction of the update, and not to a stale copy of it.
+func (uu *UserUpdate) AppendFloats(v ...float64) *UserUpdate {
+	if uu.floats != nil {
+		values := append(append([]float64{}, *uu.floats...), v...)
+		uu.floats = &values
+	} else {
+		uu.appendfloats = append(uu.appendfloats, v...)
+	}
 	return uu
 }
 
 // ClearFloats clears the value of floats.
 func (uu *UserUpdate) ClearFloats() *UserUpdate {
 	uu.floats = nil
+	uu.appendfloats = nil
 	uu.clearfloats = true
 	return uu
 }
@@ -110,24 +150,71 @@ func (uu *UserUpdate) ClearFloats() *UserUpdate {
 // SetStrings sets the strings field.
 func (uu *UserUpdate) SetStrings(s []string) *UserUpdate {
 	uu.strings = &s
+	uu.appendstrings = nil
+	return uu
+}
+
+// AppendStrings appends the given values to the strings field. The values are appended to the
+// value that is stored in the database, in the transaction of the update, and not to a stale copy of it.
+func (uu *UserUpdate) AppendStrings(v ...string) *UserUpdate {
+	if uu.strings != nil {
+		values := append(append([]string{}, *uu.strings...), v...)
+		uu.strings = &values
+	} else {
+		uu.appendstrings = append(uu.appendstrings, v...)
+	}
 	return uu
 }
 
 // ClearStrings clears the value of strings.
 func (uu *UserUpdate) ClearStrings() *UserUpdate {
 	uu.strings = nil
+	uu.appendstrings = nil
 	uu.clearstrings = true
 	return uu
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+func (uu *UserUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := uu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (uu *UserUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uu
+		intercepted.predicates = append(predicates, uu.predicates...)
+		uu = &intercepted
+	}
 	return uu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (uu *UserUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*User, error) {
+	var us []*User
+	uu.returning = &us
+	defer func() { uu.returning = nil }()
+	if _, err := uu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return us, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
+func (uu *UserUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := uu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -135,44 +222,48 @@ func (uu *UserUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
+func (uu *UserUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
+func (uu *UserUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(user.FieldID).From(sql.Table(user.Table))
+func (uu *UserUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(user.FieldID).From(sql.Table(user.Table)).SetDialect(uu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range uu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := uu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -181,7 +272,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value := uu.url; value != nil {
 		buf, err := json.Marshal(*value)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		builder.Set(user.FieldURL, buf)
 	}
@@ -191,7 +282,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value := uu.raw; value != nil {
 		buf, err := json.Marshal(*value)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		builder.Set(user.FieldRaw, buf)
 	}
@@ -201,7 +292,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value := uu.dirs; value != nil {
 		buf, err := json.Marshal(*value)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		builder.Set(user.FieldDirs, buf)
 	}
@@ -211,7 +302,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value := uu.ints; value != nil {
 		buf, err := json.Marshal(*value)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		builder.Set(user.FieldInts, buf)
 	}
@@ -221,7 +312,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value := uu.floats; value != nil {
 		buf, err := json.Marshal(*value)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		builder.Set(user.FieldFloats, buf)
 	}
@@ -231,7 +322,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value := uu.strings; value != nil {
 		buf, err := json.Marshal(*value)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		builder.Set(user.FieldStrings, buf)
 	}
@@ -241,31 +332,67 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
+		}
+	}
+	if values := uu.appenddirs; len(values) > 0 {
+		if err := appendJSON(ctx, tx, uu.driver.Dialect(), user.Table, user.FieldID, user.FieldDirs, ids, values); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if values := uu.appendints; len(values) > 0 {
+		if err := appendJSON(ctx, tx, uu.driver.Dialect(), user.Table, user.FieldID, user.FieldInts, ids, values); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if values := uu.appendfloats; len(values) > 0 {
+		if err := appendJSON(ctx, tx, uu.driver.Dialect(), user.Table, user.FieldID, user.FieldFloats, ids, values); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if values := uu.appendstrings; len(values) > 0 {
+		if err := appendJSON(ctx, tx, uu.driver.Dialect(), user.Table, user.FieldID, user.FieldStrings, ids, values); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
+	if uu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(user.Columns...).
+			From(sql.Table(user.Table)).
+			Where(sql.InInts(user.FieldID, ids...)).
+			SetDialect(uu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var us Users
+		err := us.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into User: %v", err))
+		}
+		us.config(uu.config)
+		*uu.returning = us
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	id           int
-	url          **url.URL
-	clearurl     bool
-	raw          *json.RawMessage
-	clearraw     bool
-	dirs         *[]http.Dir
-	cleardirs    bool
-	ints         *[]int
-	clearints    bool
-	floats       *[]float64
-	clearfloats  bool
-	strings      *[]string
-	clearstrings bool
+	id     int
+	entity *User
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.User
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uuo.userMutation, op: ent.OpUpdateOne, id: &uuo.id}
 }
 
 // SetURL sets the url field.
@@ -297,12 +424,26 @@ func (uuo *UserUpdateOne) ClearRaw() *UserUpdateOne {
 // SetDirs sets the dirs field.
 func (uuo *UserUpdateOne) SetDirs(h []http.Dir) *UserUpdateOne {
 	uuo.dirs = &h
+	uuo.appenddirs = nil
+	return uuo
+}
+
+// AppendDirs appends the given values to the dirs field. The values are appended to the
+// value that is stored in the database, in the transaction of the update, and not to a stale copy of it.
+func (uuo *UserUpdateOne) AppendDirs(v ...http.Dir) *UserUpdateOne {
+	if uuo.dirs != nil {
+		values := append(append([]http.Dir{}, *uuo.dirs...), v...)
+		uuo.dirs = &values
+	} else {
+		uuo.appenddirs = append(uuo.appenddirs, v...)
+	}
 	return uuo
 }
 
 // ClearDirs clears the value of dirs.
 func (uuo *UserUpdateOne) ClearDirs() *UserUpdateOne {
 	uuo.dirs = nil
+	uuo.appenddirs = nil
 	uuo.cleardirs = true
 	return uuo
 }
@@ -310,12 +451,26 @@ func (uuo *UserUpdateOne) ClearDirs() *UserUpdateOne {
 // SetInts sets the ints field.
 func (uuo *UserUpdateOne) SetInts(i []int) *UserUpdateOne {
 	uuo.ints = &i
+	uuo.appendints = nil
+	return uuo
+}
+
+// AppendInts appends the given values to the ints field. The values are appended to the
+// value that is stored in the database, in the transaction of the update, and not to a stale copy of it.
+func (uuo *UserUpdateOne) AppendInts(v ...int) *UserUpdateOne {
+	if uuo.ints != nil {
+		values := append(append([]int{}, *uuo.ints...), v...)
+		uuo.ints = &values
+	} else {
+		uuo.appendints = append(uuo.appendints, v...)
+	}
 	return uuo
 }
 
 // ClearInts clears the value of ints.
 func (uuo *UserUpdateOne) ClearInts() *UserUpdateOne {
 	uuo.ints = nil
+	uuo.appendints = nil
 	uuo.clearints = true
 	return uuo
 }
@@ -323,12 +478,26 @@ func (uuo *UserUpdateOne) ClearInts() *UserUpdateOne {
 // SetFloats sets the floats field.
 func (uuo *UserUpdateOne) SetFloats(f []float64) *UserUpdateOne {
 	uuo.floats = &f
+	uuo.appendfloats = nil
+	return uuo
+}
+
+// AppendFloats appends the given values to the floats field. The values are appended to the
+// value that is stored in the database, in the transaction of the update, and not to a stale copy of it.
+func (uuo *UserUpdateOne) AppendFloats(v ...float64) *UserUpdateOne {
+	if uuo.floats != nil {
+		values := append(append([]float64{}, *uuo.floats...), v...)
+		uuo.floats = &values
+	} else {
+		uuo.appendfloats = append(uuo.appendfloats, v...)
+	}
 	return uuo
 }
 
 // ClearFloats clears the value of floats.
 func (uuo *UserUpdateOne) ClearFloats() *UserUpdateOne {
 	uuo.floats = nil
+	uuo.appendfloats = nil
 	uuo.clearfloats = true
 	return uuo
 }
@@ -336,24 +505,79 @@ func (uuo *UserUpdateOne) ClearFloats() *UserUpdateOne {
 // SetStrings sets the strings field.
 func (uuo *UserUpdateOne) SetStrings(s []string) *UserUpdateOne {
 	uuo.strings = &s
+	uuo.appendstrings = nil
+	return uuo
+}
+
+// AppendStrings appends the given values to the strings field. The values are appended to the
+// value that is stored in the database, in the transaction of the update, and not to a stale copy of it.
+func (uuo *UserUpdateOne) AppendStrings(v ...string) *UserUpdateOne {
+	if uuo.strings != nil {
+		values := append(append([]string{}, *uuo.strings...), v...)
+		uuo.strings = &values
+	} else {
+		uuo.appendstrings = append(uuo.appendstrings, v...)
+	}
 	return uuo
 }
 
 // ClearStrings clears the value of strings.
 func (uuo *UserUpdateOne) ClearStrings() *UserUpdateOne {
 	uuo.strings = nil
+	uuo.appendstrings = nil
 	uuo.clearstrings = true
 	return uuo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (uuo *UserUpdateOne) SetChangedFrom(original *User) *UserUpdateOne {
+	u := uuo.entity
+	if u == nil || original == nil {
+		return uuo
+	}
+	for _, change := range DiffUser(original, u) {
+		switch change.Field {
+		case user.FieldURL:
+			uuo.SetURL(u.URL)
+		case user.FieldRaw:
+			uuo.SetRaw(u.Raw)
+		case user.FieldDirs:
+			uuo.SetDirs(u.Dirs)
+		case user.FieldInts:
+			uuo.SetInts(u.Ints)
+		case user.FieldFloats:
+			uuo.SetFloats(u.Floats)
+		case user.FieldStrings:
+			uuo.SetStrings(u.Strings)
+		}
+	}
+	return uuo
+}
+
 // Save executes the query and returns the updated entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+func (uuo *UserUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uuo
+		intercepted.predicates = append(predicates, uuo.predicates...)
+		uuo = &intercepted
+	}
 	return uuo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	u, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	u, err := uuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -361,21 +585,28 @@ func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
 }
 
 // Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
+func (uuo *UserUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
-	selector := sql.Select(user.Columns...).From(sql.Table(user.Table))
+	selector := sql.Select(user.Columns...).From(sql.Table(user.Table)).SetDialect(uuo.driver.Dialect())
 	user.ID(uuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range uuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -491,6 +722,30 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
+	if values := uuo.appenddirs; len(values) > 0 {
+		if err := appendJSON(ctx, tx, uuo.driver.Dialect(), user.Table, user.FieldID, user.FieldDirs, ids, values); err != nil {
+			return nil, rollback(tx, err)
+		}
+		u.Dirs = append(u.Dirs, values...)
+	}
+	if values := uuo.appendints; len(values) > 0 {
+		if err := appendJSON(ctx, tx, uuo.driver.Dialect(), user.Table, user.FieldID, user.FieldInts, ids, values); err != nil {
+			return nil, rollback(tx, err)
+		}
+		u.Ints = append(u.Ints, values...)
+	}
+	if values := uuo.appendfloats; len(values) > 0 {
+		if err := appendJSON(ctx, tx, uuo.driver.Dialect(), user.Table, user.FieldID, user.FieldFloats, ids, values); err != nil {
+			return nil, rollback(tx, err)
+		}
+		u.Floats = append(u.Floats, values...)
+	}
+	if values := uuo.appendstrings; len(values) > 0 {
+		if err := appendJSON(ctx, tx, uuo.driver.Dialect(), user.Table, user.FieldID, user.FieldStrings, ids, values); err != nil {
+			return nil, rollback(tx, err)
+		}
+		u.Strings = append(u.Strings, values...)
+	}
 	if err = tx.Commit(); err != nil {
 		return nil, err
 	}
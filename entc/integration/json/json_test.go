@@ -19,9 +19,25 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
 )
 
+func TestSQLite(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	require.NoError(t, client.Schema.Create(context.Background()))
+
+	URL(t, client)
+	Dirs(t, client)
+	Ints(t, client)
+	Floats(t, client)
+	Strings(t, client)
+	RawMessage(t, client)
+	Append(t, client)
+}
+
 func TestMySQL(t *testing.T) {
 	for version, port := range map[string]int{"56": 3306, "57": 3307, "8": 3308} {
 		t.Run(version, func(t *testing.T) {
@@ -44,6 +60,7 @@ func TestMySQL(t *testing.T) {
 			Floats(t, client)
 			Strings(t, client)
 			RawMessage(t, client)
+			Append(t, client)
 		})
 	}
 }
@@ -92,6 +109,25 @@ func Strings(t *testing.T, client *ent.Client) {
 	require.Zero(t, client.User.Query().Where(user.StringsNotNil()).CountX(ctx))
 }
 
+func Append(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SaveX(ctx)
+	usr = usr.Update().AppendStrings("a").AppendInts(1, 2).SaveX(ctx)
+	require.Equal(t, []string{"a"}, usr.Strings)
+	require.Equal(t, []int{1, 2}, usr.Ints)
+	client.User.Update().Where(user.ID(usr.ID)).AppendStrings("b", "c").ExecX(ctx)
+	require.Equal(t, []string{"a", "b", "c"}, client.User.GetX(ctx, usr.ID).Strings)
+	stale := usr.Update().AppendDirs("/etc")
+	usr.Update().AppendDirs("/tmp").ExecX(ctx)
+	stale.ExecX(ctx)
+	require.Equal(t, []http.Dir{"/tmp", "/etc"}, client.User.GetX(ctx, usr.ID).Dirs, "append should not override concurrent updates")
+	usr = usr.Update().SetInts([]int{1}).AppendInts(3).SaveX(ctx)
+	require.Equal(t, []int{1, 3}, usr.Ints)
+	usr = usr.Update().AppendFloats(1).ClearFloats().SaveX(ctx)
+	require.Empty(t, usr.Floats)
+	require.Empty(t, client.User.GetX(ctx, usr.ID).Floats)
+}
+
 func RawMessage(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	raw := json.RawMessage("{}")
@@ -30,7 +30,7 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	c := config{log: log.Println}
+	c := config{log: log.Println, inters: &inters{}}
 	c.options(opts...)
 	return &Client{
 		config: c,
@@ -49,13 +49,83 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 		if err != nil {
 			return nil, err
 		}
-		return NewClient(append(options, Driver(drv))...), nil
+		return open(append(options, Driver(drv))...)
 
 	default:
 		return nil, fmt.Errorf("unsupported driver: %q", driverName)
 	}
 }
 
+// Ping verifies that the connection to the database is still alive.
+func (c *Client) Ping(ctx context.Context) error {
+	switch c.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		rows := &sql.Rows{}
+		if err := c.driver.Query(ctx, "SELECT 1", []interface{}{}, rows); err != nil {
+			return err
+		}
+		return rows.Close()
+
+	default:
+		return fmt.Errorf("entv1: unsupported dialect: %q", c.driver.Dialect())
+	}
+}
+
+// Ready reports whether the client is ready for serving requests. It checks that the
+// database is reachable, and that its schema was migrated by the same version of the
+// generated code.
+func (c *Client) Ready(ctx context.Context) error {
+	if err := c.Ping(ctx); err != nil {
+		return fmt.Errorf("entv1: ping database: %v", err)
+	}
+	return c.CheckSchema(ctx)
+}
+
+// CheckSchema checks that the database schema was migrated by the same version of the
+// generated code (see migrate.Hash), and returns an *ErrSchemaVersion if it was not.
+// While the schema is in its expanded state (see migrate.ModeExpand), both the expanded
+// version and the previous one are accepted. Dialects without migration support are not
+// checked.
+func (c *Client) CheckSchema(ctx context.Context) error {
+	switch c.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		versions, err := c.Schema.Versions(ctx)
+		if err != nil {
+			return fmt.Errorf("entv1: reading schema versions: %v", err)
+		}
+		if len(versions) == 0 {
+			return &ErrSchemaVersion{Client: migrate.Hash}
+		}
+		for _, v := range versions {
+			if v == migrate.Hash {
+				return nil
+			}
+		}
+		return &ErrSchemaVersion{Database: versions[0], Client: migrate.Hash}
+
+	default:
+		return fmt.Errorf("entv1: unsupported dialect: %q", c.driver.Dialect())
+	}
+}
+
+// open creates a new client configured with the given options, and runs the
+// schema verification in case it was configured using the VerifySchema option.
+func open(opts ...Option) (*Client, error) {
+	c := NewClient(opts...)
+	if c.verify == nil {
+		return c, nil
+	}
+	switch err := c.CheckSchema(context.Background()); {
+	case err == nil:
+	case IsSchemaVersion(err) && !*c.verify:
+		c.log(err)
+	default:
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
 // Tx returns a new transactional client.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
@@ -65,7 +135,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if err != nil {
 		return nil, fmt.Errorf("entv1: starting a transaction: %v", err)
 	}
-	cfg := config{driver: tx, log: c.log, debug: c.debug}
+	cfg := config{driver: tx, log: c.log, debug: c.debug, slow: c.slow, inters: c.inters.clone()}
 	return &Tx{
 		config: cfg,
 		User:   NewUserClient(cfg),
@@ -83,7 +153,30 @@ func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := config{driver: dialect.Debug(c.driver, c.log), log: c.log, debug: true}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	cfg.debug = true
+	return &Client{
+		config: cfg,
+		Schema: migrate.NewSchema(cfg.driver),
+		User:   NewUserClient(cfg),
+	}
+}
+
+// With returns a shallow copy of the client configured with the given options, in addition
+// to the options of the client. The original client is not modified, and therefore, it's safe
+// to call With concurrently on a shared client (e.g. for a debug client of one request). The
+// driver of a transactional client (see Tx.Client) is not wrapped again by the logging options.
+//
+//	client.With(ent.Debug(), ent.Log(logger.Println)).
+//		User.
+//		Query().
+//		Count(ctx)
+//
+func (c *Client) With(opts ...Option) *Client {
+	cfg := c.config
+	cfg.options(opts...)
+	cfg.inters = c.inters.clone()
 	return &Client{
 		config: cfg,
 		Schema: migrate.NewSchema(cfg.driver),
@@ -91,6 +184,17 @@ func (c *Client) Debug() *Client {
 	}
 }
 
+// Dialect returns the dialect of the client driver. It can be used to check
+// the features that are supported by the underlying database at runtime.
+//
+//	if client.Dialect().Supports(dialect.Upsert) {
+//		// ...
+//	}
+//
+func (c *Client) Dialect() dialect.Name {
+	return dialect.Name(c.driver.Dialect())
+}
+
 // Close closes the database connection and prevents new queries from starting.
 func (c *Client) Close() error {
 	return c.driver.Close()
@@ -101,11 +205,48 @@ type UserClient struct {
 	config
 }
 
+// UserQuerier is the read API of the UserClient. It's implemented by the UserClient, and it
+// can be used by services that depend only on reading Users, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type UserQuerier interface {
+	Get(ctx context.Context, id int) (*User, error)
+	GetX(ctx context.Context, id int) *User
+	GetNotFoundOK(ctx context.Context, id int) (*User, error)
+	Exist(ctx context.Context, id int) (bool, error)
+	ExistX(ctx context.Context, id int) bool
+	Reload(ctx context.Context, nodes ...*User) error
+	ReloadX(ctx context.Context, nodes ...*User)
+}
+
+var _ UserQuerier = (*UserClient)(nil)
+
 // NewUserClient returns a client for the User from the given config.
 func NewUserClient(c config) *UserClient {
 	return &UserClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the User queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.User.Intercept(func(ctx context.Context, q *UserQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(user.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *UserClient) Intercept(interceptors ...UserInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a create builder for User.
 func (c *UserClient) Create() *UserCreate {
 	return &UserCreate{config: c.config}
@@ -118,7 +259,7 @@ func (c *UserClient) Update() *UserUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
-	return c.UpdateOneID(u.ID)
+	return &UserUpdateOne{config: c.config, id: u.ID, entity: u}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -159,3 +300,72 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	}
 	return u
 }
+
+// GetNotFoundOK is like Get, but returns a nil User without an error, if there is no entity with the given id.
+func (c *UserClient) GetNotFoundOK(ctx context.Context, id int) (*User, error) {
+	u, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return u, err
+}
+
+// Exist reports if a User entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *UserClient) Exist(ctx context.Context, id int) (bool, error) {
+	return c.Query().Where(user.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *UserClient) ExistX(ctx context.Context, id int) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Users in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *UserClient) Reload(ctx context.Context, nodes ...*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]int, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int]*User, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, u := range nodes {
+		if _, ok := byID[u.ID]; !ok {
+			return &ErrNotFound{user.Label}
+		}
+	}
+	for _, u := range nodes {
+		v := byID[u.ID]
+		u.Age = v.Age
+		u.Name = v.Name
+		u.Address = v.Address
+		u.Renamed = v.Renamed
+		u.Nickname = v.Nickname
+		u.Blob = v.Blob
+		u.State = v.State
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *UserClient) ReloadX(ctx context.Context, nodes ...*User) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
@@ -41,6 +41,7 @@ func ExampleUser() {
 		SetName("string").
 		SetAddress("string").
 		SetRenamed("string").
+		SetNickname("string").
 		SetBlob(nil).
 		SetState(user.StateLoggedIn).
 		SaveX(ctx)
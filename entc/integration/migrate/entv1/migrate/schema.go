@@ -19,6 +19,7 @@ var (
 		{Name: "name", Type: field.TypeString, Size: 10},
 		{Name: "address", Type: field.TypeString, Nullable: true},
 		{Name: "renamed", Type: field.TypeString, Nullable: true},
+		{Name: "nickname", Type: field.TypeString, Nullable: true},
 		{Name: "blob", Type: field.TypeBytes, Nullable: true, Size: 255},
 		{Name: "state", Type: field.TypeEnum, Nullable: true, Enums: []string{"logged_in", "logged_out"}},
 	}
@@ -23,6 +23,8 @@ func (User) Fields() []ent.Field {
 		field.String("address").Optional(),
 		field.String("renamed").
 			Optional(),
+		field.String("nickname").
+			Optional(),
 		field.Bytes("blob").
 			Optional().
 			MaxLen(255),
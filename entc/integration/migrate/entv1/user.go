@@ -27,6 +27,8 @@ type User struct {
 	Address string `json:"address,omitempty"`
 	// Renamed holds the value of the "renamed" field.
 	Renamed string `json:"renamed,omitempty"`
+	// Nickname holds the value of the "nickname" field.
+	Nickname string `json:"nickname,omitempty"`
 	// Blob holds the value of the "blob" field.
 	Blob []byte `json:"blob,omitempty"`
 	// State holds the value of the "state" field.
@@ -35,25 +37,46 @@ type User struct {
 
 // FromRows scans the sql response data into User.
 func (u *User) FromRows(rows *sql.Rows) error {
+	return u.scan(rows, user.Columns)
+}
+
+// scan scans the given columns of the sql response data into User.
+// Fields that their columns were not selected are left with their zero values.
+func (u *User) scan(rows *sql.Rows, columns []string) error {
 	var vu struct {
-		ID      int
-		Age     sql.NullInt64
-		Name    sql.NullString
-		Address sql.NullString
-		Renamed sql.NullString
-		Blob    []byte
-		State   sql.NullString
-	}
-	// the order here should be the same as in the `user.Columns`.
-	if err := rows.Scan(
-		&vu.ID,
-		&vu.Age,
-		&vu.Name,
-		&vu.Address,
-		&vu.Renamed,
-		&vu.Blob,
-		&vu.State,
-	); err != nil {
+		ID       int
+		Age      sql.NullInt64
+		Name     sql.NullString
+		Address  sql.NullString
+		Renamed  sql.NullString
+		Nickname sql.NullString
+		Blob     []byte
+		State    sql.NullString
+	}
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			values[i] = &vu.ID
+		case user.FieldAge:
+			values[i] = &vu.Age
+		case user.FieldName:
+			values[i] = &vu.Name
+		case user.FieldAddress:
+			values[i] = &vu.Address
+		case user.FieldRenamed:
+			values[i] = &vu.Renamed
+		case user.FieldNickname:
+			values[i] = &vu.Nickname
+		case user.FieldBlob:
+			values[i] = &vu.Blob
+		case user.FieldState:
+			values[i] = &vu.State
+		default:
+			return fmt.Errorf("unexpected column %q for type User", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	u.ID = vu.ID
@@ -61,6 +84,7 @@ func (u *User) FromRows(rows *sql.Rows) error {
 	u.Name = vu.Name.String
 	u.Address = vu.Address.String
 	u.Renamed = vu.Renamed.String
+	u.Nickname = vu.Nickname.String
 	u.Blob = vu.Blob
 	u.State = user.State(vu.State.String)
 	return nil
@@ -84,6 +108,32 @@ func (u *User) Unwrap() *User {
 	return u
 }
 
+// Clone returns a deep copy of the User, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (u *User) Clone() *User {
+	return u.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the User, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (u *User) clone(seen map[interface{}]interface{}) *User {
+	if u == nil {
+		return nil
+	}
+	if _c, ok := seen[u]; ok {
+		return _c.(*User)
+	}
+	_c := *u
+	_c.config = config{}
+	seen[u] = &_c
+	if v := u.Blob; v != nil {
+		_c.Blob = append([]byte(nil), v...)
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (u *User) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -93,20 +143,55 @@ func (u *User) String() string {
 	buf.WriteString(fmt.Sprintf(", name=%v", u.Name))
 	buf.WriteString(fmt.Sprintf(", address=%v", u.Address))
 	buf.WriteString(fmt.Sprintf(", renamed=%v", u.Renamed))
+	buf.WriteString(fmt.Sprintf(", nickname=%v", u.Nickname))
 	buf.WriteString(fmt.Sprintf(", blob=%v", u.Blob))
 	buf.WriteString(fmt.Sprintf(", state=%v", u.State))
 	buf.WriteString(")")
 	return buf.String()
 }
 
+// DiffUser returns the fields that have different values in the given Users,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffUser(a, b *User) []FieldChange {
+	var changes []FieldChange
+	if a.Age != b.Age {
+		changes = append(changes, FieldChange{Field: user.FieldAge, Old: a.Age, New: b.Age})
+	}
+	if a.Name != b.Name {
+		changes = append(changes, FieldChange{Field: user.FieldName, Old: a.Name, New: b.Name})
+	}
+	if a.Address != b.Address {
+		changes = append(changes, FieldChange{Field: user.FieldAddress, Old: a.Address, New: b.Address})
+	}
+	if a.Renamed != b.Renamed {
+		changes = append(changes, FieldChange{Field: user.FieldRenamed, Old: a.Renamed, New: b.Renamed})
+	}
+	if a.Nickname != b.Nickname {
+		changes = append(changes, FieldChange{Field: user.FieldNickname, Old: a.Nickname, New: b.Nickname})
+	}
+	if !bytes.Equal(a.Blob, b.Blob) {
+		changes = append(changes, FieldChange{Field: user.FieldBlob, Old: a.Blob, New: b.Blob})
+	}
+	if a.State != b.State {
+		changes = append(changes, FieldChange{Field: user.FieldState, Old: a.State, New: b.State})
+	}
+	return changes
+}
+
 // Users is a parsable slice of User.
 type Users []*User
 
 // FromRows scans the sql response data into Users.
 func (u *Users) FromRows(rows *sql.Rows) error {
+	return u.scan(rows, user.Columns)
+}
+
+// scan scans the given columns of the sql response data into Users.
+func (u *Users) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vu := &User{}
-		if err := vu.FromRows(rows); err != nil {
+		if err := vu.scan(rows, columns); err != nil {
 			return err
 		}
 		*u = append(*u, vu)
@@ -9,7 +9,10 @@ package user
 import (
 	"fmt"
 
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/migrate/entv1/predicate"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv1/schema"
+	"github.com/facebookincubator/ent/schema/field"
 )
 
 const (
@@ -25,6 +28,8 @@ const (
 	FieldAddress = "address"
 	// FieldRenamed holds the string denoting the renamed vertex property in the database.
 	FieldRenamed = "renamed"
+	// FieldNickname holds the string denoting the nickname vertex property in the database.
+	FieldNickname = "nickname"
 	// FieldBlob holds the string denoting the blob vertex property in the database.
 	FieldBlob = "blob"
 	// FieldState holds the string denoting the state vertex property in the database.
@@ -41,10 +46,21 @@ var Columns = []string{
 	FieldName,
 	FieldAddress,
 	FieldRenamed,
+	FieldNickname,
 	FieldBlob,
 	FieldState,
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	fields = schema.User{}.Fields()
 
@@ -54,6 +70,19 @@ var (
 	NameValidator = descName.Validators[0].(func(string) error)
 )
 
+// Scope is a reusable named scope of the User queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.User
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
 // State defines the type for the state enum field.
 type State string
 
@@ -75,3 +104,84 @@ func StateValidator(state State) error {
 		return fmt.Errorf("user: invalid enum value for state field: %q", state)
 	}
 }
+
+// descriptor holds the runtime descriptor of the User type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "User",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeInt,
+		GoType:     "int",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "age",
+			StorageKey: FieldAge,
+			Type:       field.TypeInt32,
+			GoType:     "int32",
+		},
+		{
+			Name:       "name",
+			StorageKey: FieldName,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(string)
+				if !ok {
+					return fmt.Errorf("user: unexpected type %T for field name", v)
+				}
+				return NameValidator(vv)
+			},
+		},
+		{
+			Name:       "address",
+			StorageKey: FieldAddress,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+		},
+		{
+			Name:       "renamed",
+			StorageKey: FieldRenamed,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+		},
+		{
+			Name:       "nickname",
+			StorageKey: FieldNickname,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+		},
+		{
+			Name:       "blob",
+			StorageKey: FieldBlob,
+			Type:       field.TypeBytes,
+			GoType:     "[]byte",
+			Optional:   true,
+		},
+		{
+			Name:       "state",
+			StorageKey: FieldState,
+			Type:       field.TypeEnum,
+			GoType:     "user.State",
+			Enums:      []string{"logged_in", "logged_out"},
+			Optional:   true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(State)
+				if !ok {
+					return fmt.Errorf("user: unexpected type %T for field state", v)
+				}
+				return StateValidator(vv)
+			},
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{},
+}
+
+// Descriptor returns the runtime descriptor of the User type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
@@ -7,6 +7,12 @@
 package user
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv1/predicate"
 )
@@ -112,6 +118,36 @@ func IDLTE(id int) predicate.User {
 	)
 }
 
+// IDInQuery applies the In predicate on the ID field, with the ids of the given query as a subquery.
+// It's used for filtering by the results of another query, without fetching its ids first. For example:
+//
+//	client.User.Query().Where(user.IDInQuery(client.User.Query().Where(...)))
+//
+// The interceptors of the client are applied on the subquery with the context of the query, and
+// their errors fail the query.
+func IDInQuery(q predicate.Subquery) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		t, err := q.IDsSubquery(s.Context())
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(FieldID), t))
+	})
+}
+
+// IDNotInQuery applies the NotIn predicate on the ID field, with the ids of the given query as a subquery.
+func IDNotInQuery(q predicate.Subquery) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		t, err := q.IDsSubquery(s.Context())
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldID), t))
+	})
+}
+
 // Age applies equality check predicate on the "age" field. It's identical to AgeEQ.
 func Age(v int32) predicate.User {
 	return predicate.User(
@@ -148,6 +184,15 @@ func Renamed(v string) predicate.User {
 	)
 }
 
+// Nickname applies equality check predicate on the "nickname" field. It's identical to NicknameEQ.
+func Nickname(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldNickname), v))
+		},
+	)
+}
+
 // Blob applies equality check predicate on the "blob" field. It's identical to BlobEQ.
 func Blob(v []byte) predicate.User {
 	return predicate.User(
@@ -696,6 +741,161 @@ func RenamedContainsFold(v string) predicate.User {
 	)
 }
 
+// NicknameEQ applies the EQ predicate on the "nickname" field.
+func NicknameEQ(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(FieldNickname), v))
+		},
+	)
+}
+
+// NicknameNEQ applies the NEQ predicate on the "nickname" field.
+func NicknameNEQ(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(FieldNickname), v))
+		},
+	)
+}
+
+// NicknameIn applies the In predicate on the "nickname" field.
+func NicknameIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.In(s.C(FieldNickname), v...))
+		},
+	)
+}
+
+// NicknameNotIn applies the NotIn predicate on the "nickname" field.
+func NicknameNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			s.Where(sql.NotIn(s.C(FieldNickname), v...))
+		},
+	)
+}
+
+// NicknameGT applies the GT predicate on the "nickname" field.
+func NicknameGT(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.GT(s.C(FieldNickname), v))
+		},
+	)
+}
+
+// NicknameGTE applies the GTE predicate on the "nickname" field.
+func NicknameGTE(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.GTE(s.C(FieldNickname), v))
+		},
+	)
+}
+
+// NicknameLT applies the LT predicate on the "nickname" field.
+func NicknameLT(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.LT(s.C(FieldNickname), v))
+		},
+	)
+}
+
+// NicknameLTE applies the LTE predicate on the "nickname" field.
+func NicknameLTE(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.LTE(s.C(FieldNickname), v))
+		},
+	)
+}
+
+// NicknameContains applies the Contains predicate on the "nickname" field.
+func NicknameContains(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.Contains(s.C(FieldNickname), v))
+		},
+	)
+}
+
+// NicknameHasPrefix applies the HasPrefix predicate on the "nickname" field.
+func NicknameHasPrefix(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.HasPrefix(s.C(FieldNickname), v))
+		},
+	)
+}
+
+// NicknameHasSuffix applies the HasSuffix predicate on the "nickname" field.
+func NicknameHasSuffix(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.HasSuffix(s.C(FieldNickname), v))
+		},
+	)
+}
+
+// NicknameIsNil applies the IsNil predicate on the "nickname" field.
+func NicknameIsNil() predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.IsNull(s.C(FieldNickname)))
+		},
+	)
+}
+
+// NicknameNotNil applies the NotNil predicate on the "nickname" field.
+func NicknameNotNil() predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.NotNull(s.C(FieldNickname)))
+		},
+	)
+}
+
+// NicknameEqualFold applies the EqualFold predicate on the "nickname" field.
+func NicknameEqualFold(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.EqualFold(s.C(FieldNickname), v))
+		},
+	)
+}
+
+// NicknameContainsFold applies the ContainsFold predicate on the "nickname" field.
+func NicknameContainsFold(v string) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			s.Where(sql.ContainsFold(s.C(FieldNickname), v))
+		},
+	)
+}
+
 // BlobEQ applies the EQ predicate on the "blob" field.
 func BlobEQ(v []byte) predicate.User {
 	return predicate.User(
@@ -880,6 +1080,507 @@ func StateNotNil() predicate.User {
 	)
 }
 
+// NameAddress is the composite key of the unique index on the "name", "address" fields.
+type NameAddress struct {
+	Name    string
+	Address string
+}
+
+// NameAddressIn applies the In predicate on the composite key of the "name", "address" fields.
+// It's used for batch lookups of entities by their composite key in a single query.
+func NameAddressIn(vs ...NameAddress) predicate.User {
+	return predicate.User(
+		func(s *sql.Selector) {
+			// if not arguments were provided, append the FALSE constants,
+			// since we can't apply "IN ()". This will make this predicate falsy.
+			if len(vs) == 0 {
+				s.Where(sql.False())
+				return
+			}
+			// row-value lists are supported only by MySQL, and the tuples
+			// are grouped with the OR operator for the rest of the dialects.
+			if s.Dialect() != dialect.MySQL {
+				preds := make([]*sql.Predicate, len(vs))
+				for i := range vs {
+					preds[i] = sql.And(
+						sql.EQ(s.C(FieldName), vs[i].Name),
+						sql.EQ(s.C(FieldAddress), vs[i].Address),
+					)
+				}
+				s.Where(sql.Or(preds...))
+				return
+			}
+			tuples := make([][]interface{}, len(vs))
+			for i := range vs {
+				tuples[i] = []interface{}{vs[i].Name, vs[i].Address}
+			}
+			s.Where(sql.InTuple([]string{s.C(FieldName), s.C(FieldAddress)}, tuples...))
+		},
+	)
+}
+
+// PredicateFunc returns a predicate from the given function.
+// It allows adding custom SQL fragments to the User builders.
+func PredicateFunc(f func(*sql.Selector)) predicate.User {
+	return predicate.User(f)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.User, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.User, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.User, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldAge:
+		switch op {
+		case "eq":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return AgeEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return AgeNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int32); ok || convert(value, &vs) {
+				return AgeIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int32); ok || convert(value, &vs) {
+				return AgeNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return AgeGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return AgeGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return AgeLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int32); ok || convert(value, &v) {
+				return AgeLTE(v), nil
+			}
+		}
+	case FieldName:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NameNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameHasSuffix(v), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NameContainsFold(v), nil
+			}
+		}
+	case FieldAddress:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return AddressEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return AddressNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return AddressIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return AddressNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return AddressGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return AddressGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return AddressLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return AddressLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return AddressContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return AddressHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return AddressHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return AddressIsNil(), nil
+				}
+				return Not(AddressIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return AddressNotNil(), nil
+				}
+				return Not(AddressNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return AddressEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return AddressContainsFold(v), nil
+			}
+		}
+	case FieldRenamed:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return RenamedEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return RenamedNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return RenamedIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return RenamedNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return RenamedGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return RenamedGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return RenamedLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return RenamedLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return RenamedContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return RenamedHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return RenamedHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return RenamedIsNil(), nil
+				}
+				return Not(RenamedIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return RenamedNotNil(), nil
+				}
+				return Not(RenamedNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return RenamedEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return RenamedContainsFold(v), nil
+			}
+		}
+	case FieldNickname:
+		switch op {
+		case "eq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NicknameIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]string); ok || convert(value, &vs) {
+				return NicknameNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameLTE(v), nil
+			}
+		case "contains":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameContains(v), nil
+			}
+		case "hasprefix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameHasPrefix(v), nil
+			}
+		case "hassuffix":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameHasSuffix(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NicknameIsNil(), nil
+				}
+				return Not(NicknameIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return NicknameNotNil(), nil
+				}
+				return Not(NicknameNotNil()), nil
+			}
+		case "equalfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameEqualFold(v), nil
+			}
+		case "containsfold":
+			if v, ok := value.(string); ok || convert(value, &v) {
+				return NicknameContainsFold(v), nil
+			}
+		}
+	case FieldBlob:
+		switch op {
+		case "eq":
+			if v, ok := value.([]byte); ok || convert(value, &v) {
+				return BlobEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.([]byte); ok || convert(value, &v) {
+				return BlobNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([][]byte); ok || convert(value, &vs) {
+				return BlobIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([][]byte); ok || convert(value, &vs) {
+				return BlobNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.([]byte); ok || convert(value, &v) {
+				return BlobGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.([]byte); ok || convert(value, &v) {
+				return BlobGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.([]byte); ok || convert(value, &v) {
+				return BlobLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.([]byte); ok || convert(value, &v) {
+				return BlobLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return BlobIsNil(), nil
+				}
+				return Not(BlobIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return BlobNotNil(), nil
+				}
+				return Not(BlobNotNil()), nil
+			}
+		}
+	case FieldState:
+		switch op {
+		case "eq":
+			if v, ok := value.(State); ok || convert(value, &v) {
+				return StateEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(State); ok || convert(value, &v) {
+				return StateNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]State); ok || convert(value, &vs) {
+				return StateIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]State); ok || convert(value, &vs) {
+				return StateNotIn(vs...), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return StateIsNil(), nil
+				}
+				return Not(StateIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return StateNotNil(), nil
+				}
+				return Not(StateNotNil()), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("user: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(
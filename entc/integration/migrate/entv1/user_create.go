@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv1/user"
 )
@@ -18,12 +19,12 @@ import (
 // UserCreate is the builder for creating a User entity.
 type UserCreate struct {
 	config
-	age     *int32
-	name    *string
-	address *string
-	renamed *string
-	blob    *[]byte
-	state   *user.State
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uc *UserCreate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uc.userMutation, op: ent.OpCreate}
 }
 
 // SetAge sets the age field.
@@ -66,6 +67,20 @@ func (uc *UserCreate) SetNillableRenamed(s *string) *UserCreate {
 	return uc
 }
 
+// SetNickname sets the nickname field.
+func (uc *UserCreate) SetNickname(s string) *UserCreate {
+	uc.nickname = &s
+	return uc
+}
+
+// SetNillableNickname sets the nickname field if the given value is not nil.
+func (uc *UserCreate) SetNillableNickname(s *string) *UserCreate {
+	if s != nil {
+		uc.SetNickname(*s)
+	}
+	return uc
+}
+
 // SetBlob sets the blob field.
 func (uc *UserCreate) SetBlob(b []byte) *UserCreate {
 	uc.blob = &b
@@ -87,7 +102,8 @@ func (uc *UserCreate) SetNillableState(u *user.State) *UserCreate {
 }
 
 // Save creates the User in the database.
-func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+func (uc *UserCreate) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
 	if uc.age == nil {
 		return nil, errors.New("entv1: missing required field \"age\"")
 	}
@@ -102,12 +118,15 @@ func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
 			return nil, fmt.Errorf("entv1: validator failed for field \"state\": %v", err)
 		}
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return uc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (uc *UserCreate) SaveX(ctx context.Context) *User {
-	v, err := uc.Save(ctx)
+func (uc *UserCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	v, err := uc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -140,6 +159,10 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 		builder.Set(user.FieldRenamed, *value)
 		u.Renamed = *value
 	}
+	if value := uc.nickname; value != nil {
+		builder.Set(user.FieldNickname, *value)
+		u.Nickname = *value
+	}
 	if value := uc.blob; value != nil {
 		builder.Set(user.FieldBlob, *value)
 		u.Blob = *value
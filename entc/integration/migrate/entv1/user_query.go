@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv1/predicate"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv1/user"
@@ -20,13 +21,21 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit  *int
+	offset *int
+	order  []Order
+	unique []string
+	fields []string
+	hints  []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.User
 	// intermediate queries.
 	sql *sql.Selector
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath func(context.Context) (*sql.Selector, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -53,6 +62,91 @@ func (uq *UserQuery) Order(o ...Order) *UserQuery {
 	return uq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored.
+func (uq *UserQuery) Hint(hints ...sql.Hint) *UserQuery {
+	uq.hints = append(uq.hints, hints...)
+	return uq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	us, err := client.User.Query().
+//		Fields(user.FieldAge, user.FieldName).
+//		All(ctx)
+//
+func (uq *UserQuery) Fields(fields ...string) *UserQuery {
+	uq.fields = append(uq.fields, fields...)
+	return uq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (uq *UserQuery) Scope(scopes ...user.Scope) *UserQuery {
+	for _, s := range scopes {
+		uq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				uq.Order(Desc(o.Field))
+			} else {
+				uq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			uq.Limit(s.Limit)
+		}
+	}
+	return uq
+}
+
+// UserInterceptor is a query interceptor of the UserClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the UserClient.Intercept method.
+type UserInterceptor func(context.Context, *UserQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (uq *UserQuery) prepare(ctx context.Context) (*UserQuery, error) {
+	var interceptors []UserInterceptor
+	if uq.inters != nil {
+		uq.inters.mu.RLock()
+		interceptors = uq.inters.User
+		uq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && uq.sqlPath == nil {
+		return uq, nil
+	}
+	query := uq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptUser returns the predicates that the interceptors of the client add to a User query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptUser(ctx context.Context, cfg config) ([]predicate.User, error) {
+	query, err := (&UserQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // First returns the first User entity in the query. Returns *ErrNotFound when no user was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
 	us, err := uq.Limit(1).All(ctx)
@@ -148,22 +242,41 @@ func (uq *UserQuery) OnlyXID(ctx context.Context) int {
 }
 
 // All executes the query and returns a list of Users.
-func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
-	return uq.sqlAll(ctx)
+func (uq *UserQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*User, error) {
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlAll(ctx)
 }
 
 // AllX is like All, but panics if an error occurs.
-func (uq *UserQuery) AllX(ctx context.Context) []*User {
-	us, err := uq.All(ctx)
+func (uq *UserQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*User {
+	us, err := uq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return us
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (uq *UserQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return query.sqlQueryString(ctx)
+}
+
 // IDs executes the query and returns a list of User ids.
 func (uq *UserQuery) IDs(ctx context.Context) ([]int, error) {
-	return uq.sqlIDs(ctx)
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlIDs(ctx)
 }
 
 // IDsX is like IDs, but panics if an error occurs.
@@ -175,9 +288,42 @@ func (uq *UserQuery) IDsX(ctx context.Context) []int {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Users that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (uq *UserQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if uq.sql != nil {
+		return nil, errors.New("entv1: BatchIDs is not supported on edge queries")
+	}
+	query := &UserQuery{
+		config:     uq.config,
+		predicates: append([]predicate.User{}, uq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(int)
+		if !ok {
+			return nil, fmt.Errorf("entv1: unexpected id type %T for User", after)
+		}
+		query.Where(user.IDGT(id))
+	}
+	ids, err := query.Order(Asc(user.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (uq *UserQuery) Count(ctx context.Context) (int, error) {
-	return uq.sqlCount(ctx)
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return query.sqlCount(ctx)
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -191,7 +337,11 @@ func (uq *UserQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (uq *UserQuery) Exist(ctx context.Context) (bool, error) {
-	return uq.sqlExist(ctx)
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	return query.sqlExist(ctx)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -212,9 +362,13 @@ func (uq *UserQuery) Clone() *UserQuery {
 		offset:     uq.offset,
 		order:      append([]Order{}, uq.order...),
 		unique:     append([]string{}, uq.unique...),
+		fields:     append([]string{}, uq.fields...),
+		hints:      append([]sql.Hint{}, uq.hints...),
+		edgeCount:  uq.edgeCount,
 		predicates: append([]predicate.User{}, uq.predicates...),
 		// clone intermediate queries.
-		sql: uq.sql.Clone(),
+		sql:     uq.sql.Clone(),
+		sqlPath: uq.sqlPath,
 	}
 }
 
@@ -236,7 +390,14 @@ func (uq *UserQuery) Clone() *UserQuery {
 func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 	group := &UserGroupBy{config: uq.config}
 	group.fields = append([]string{field}, fields...)
-	group.sql = uq.sqlQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := uq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		group.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return group
 }
 
@@ -255,15 +416,22 @@ func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 func (uq *UserQuery) Select(field string, fields ...string) *UserSelect {
 	selector := &UserSelect{config: uq.config}
 	selector.fields = append([]string{field}, fields...)
-	selector.sql = uq.sqlQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := uq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		selector.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return selector
 }
 
 func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	rows := &sql.Rows{}
-	selector := uq.sqlQuery()
-	if unique := uq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := uq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := uq.driver.Query(ctx, query, args, rows); err != nil {
@@ -271,21 +439,61 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	}
 	defer rows.Close()
 	var us Users
-	if err := us.FromRows(rows); err != nil {
+	if err := us.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	us.config(uq.config)
 	return us, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (uq *UserQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := uq.sqlQuery(ctx)
+	if unique := uq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := user.Columns
+	if fields := uq.fields; len(fields) > 0 {
+		columns = append([]string{user.FieldID}, fields...)
+		for _, c := range fields {
+			if !user.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("entv1: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (uq *UserQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := uq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := uq.sqlQuery()
+	selector := uq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{user.FieldID}
 	if len(uq.unique) > 0 {
 		unique = uq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := uq.edgeCount; c != nil && len(uq.predicates) == 0 && len(uq.unique) == 0 &&
+		len(uq.hints) == 0 && uq.limit == nil && uq.offset == nil {
+		selector = c.Clone().SetDialect(uq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := uq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -302,11 +510,22 @@ func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (uq *UserQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := uq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := uq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(user.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := uq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := uq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("entv1: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (uq *UserQuery) sqlIDs(ctx context.Context) ([]int, error) {
@@ -321,13 +540,33 @@ func (uq *UserQuery) sqlIDs(ctx context.Context) ([]int, error) {
 	return ids, nil
 }
 
-func (uq *UserQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (uq *UserQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := uq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(user.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(user.Table)
 	selector := sql.Select(t1.Columns(user.Columns...)...).From(t1)
 	if uq.sql != nil {
 		selector = uq.sql
 		selector.Select(selector.Columns(user.Columns...)...)
 	}
+	selector.SetDialect(uq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(uq.hints...)
 	for _, p := range uq.predicates {
 		p(selector)
 	}
@@ -352,6 +591,10 @@ type UserGroupBy struct {
 	fns    []Aggregate
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving *sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -360,8 +603,25 @@ func (ugb *UserGroupBy) Aggregate(fns ...Aggregate) *UserGroupBy {
 	return ugb
 }
 
+// Having adds a predicate on the aggregated values of the groups.
+// For example:
+//
+//	client.User.Query().
+//		GroupBy(field).
+//		Aggregate(entv1.Count()).
+//		Having(sql.GT(sql.Count("*"), 5)).
+//		Scan(ctx, &v)
+//
+func (ugb *UserGroupBy) Having(p *sql.Predicate) *UserGroupBy {
+	ugb.sqlHaving = p
+	return ugb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (ugb *UserGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := ugb.path(ctx); err != nil {
+		return err
+	}
 	return ugb.sqlScan(ctx, v)
 }
 
@@ -457,6 +717,9 @@ func (ugb *UserGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (ugb *UserGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ugb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ugb.sqlQuery().Query()
 	if err := ugb.driver.Query(ctx, query, args, rows); err != nil {
@@ -473,7 +736,11 @@ func (ugb *UserGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range ugb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(ugb.fields...)
+	selector.Select(columns...).GroupBy(ugb.fields...)
+	if ugb.sqlHaving != nil {
+		selector.Having(ugb.sqlHaving)
+	}
+	return selector
 }
 
 // UserSelect is the builder for select fields of User entities.
@@ -482,10 +749,15 @@ type UserSelect struct {
 	fields []string
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (us *UserSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := us.path(ctx); err != nil {
+		return err
+	}
 	return us.sqlScan(ctx, v)
 }
 
@@ -581,6 +853,9 @@ func (us *UserSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (us *UserSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := us.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := us.sqlQuery().Query()
 	if err := us.driver.Query(ctx, query, args, rows); err != nil {
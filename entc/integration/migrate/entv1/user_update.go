@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv1/predicate"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv1/user"
@@ -18,18 +19,11 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	age          *int32
-	addage       *int32
-	name         *string
-	address      *string
-	clearaddress bool
-	renamed      *string
-	clearrenamed bool
-	blob         *[]byte
-	clearblob    bool
-	state        *user.State
-	clearstate   bool
-	predicates   []predicate.User
+	userMutation
+	predicates []predicate.User
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*User
 }
 
 // Where adds a new predicate for the builder.
@@ -38,6 +32,11 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Mutation returns the mutation of the builder.
+func (uu *UserUpdate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uu.userMutation, op: ent.OpUpdate}
+}
+
 // SetAge sets the age field.
 func (uu *UserUpdate) SetAge(i int32) *UserUpdate {
 	uu.age = &i
@@ -103,6 +102,27 @@ func (uu *UserUpdate) ClearRenamed() *UserUpdate {
 	return uu
 }
 
+// SetNickname sets the nickname field.
+func (uu *UserUpdate) SetNickname(s string) *UserUpdate {
+	uu.nickname = &s
+	return uu
+}
+
+// SetNillableNickname sets the nickname field if the given value is not nil.
+func (uu *UserUpdate) SetNillableNickname(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetNickname(*s)
+	}
+	return uu
+}
+
+// ClearNickname clears the value of nickname.
+func (uu *UserUpdate) ClearNickname() *UserUpdate {
+	uu.nickname = nil
+	uu.clearnickname = true
+	return uu
+}
+
 // SetBlob sets the blob field.
 func (uu *UserUpdate) SetBlob(b []byte) *UserUpdate {
 	uu.blob = &b
@@ -138,23 +158,56 @@ func (uu *UserUpdate) ClearState() *UserUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+func (uu *UserUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := uu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (uu *UserUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
 	if uu.name != nil {
 		if err := user.NameValidator(*uu.name); err != nil {
-			return 0, fmt.Errorf("entv1: validator failed for field \"name\": %v", err)
+			return nil, fmt.Errorf("entv1: validator failed for field \"name\": %v", err)
 		}
 	}
 	if uu.state != nil {
 		if err := user.StateValidator(*uu.state); err != nil {
-			return 0, fmt.Errorf("entv1: validator failed for field \"state\": %v", err)
+			return nil, fmt.Errorf("entv1: validator failed for field \"state\": %v", err)
 		}
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uu
+		intercepted.predicates = append(predicates, uu.predicates...)
+		uu = &intercepted
+	}
 	return uu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (uu *UserUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*User, error) {
+	var us []*User
+	uu.returning = &us
+	defer func() { uu.returning = nil }()
+	if _, err := uu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return us, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
+func (uu *UserUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := uu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -162,44 +215,48 @@ func (uu *UserUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
+func (uu *UserUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
+func (uu *UserUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(user.FieldID).From(sql.Table(user.Table))
+func (uu *UserUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(user.FieldID).From(sql.Table(user.Table)).SetDialect(uu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range uu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("entv1: failed reading id: %v", err)
+			return nil, fmt.Errorf("entv1: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := uu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -226,6 +283,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.clearrenamed {
 		builder.SetNull(user.FieldRenamed)
 	}
+	if value := uu.nickname; value != nil {
+		builder.Set(user.FieldNickname, *value)
+	}
+	if uu.clearnickname {
+		builder.SetNull(user.FieldNickname)
+	}
 	if value := uu.blob; value != nil {
 		builder.Set(user.FieldBlob, *value)
 	}
@@ -241,30 +304,47 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
+		}
+	}
+	if uu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(user.Columns...).
+			From(sql.Table(user.Table)).
+			Where(sql.InInts(user.FieldID, ids...)).
+			SetDialect(uu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var us Users
+		err := us.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("entv1: failed scanning rows into User: %v", err))
 		}
+		us.config(uu.config)
+		*uu.returning = us
 	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	id           int
-	age          *int32
-	addage       *int32
-	name         *string
-	address      *string
-	clearaddress bool
-	renamed      *string
-	clearrenamed bool
-	blob         *[]byte
-	clearblob    bool
-	state        *user.State
-	clearstate   bool
+	id     int
+	entity *User
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.User
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uuo.userMutation, op: ent.OpUpdateOne, id: &uuo.id}
 }
 
 // SetAge sets the age field.
@@ -332,6 +412,27 @@ func (uuo *UserUpdateOne) ClearRenamed() *UserUpdateOne {
 	return uuo
 }
 
+// SetNickname sets the nickname field.
+func (uuo *UserUpdateOne) SetNickname(s string) *UserUpdateOne {
+	uuo.nickname = &s
+	return uuo
+}
+
+// SetNillableNickname sets the nickname field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableNickname(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetNickname(*s)
+	}
+	return uuo
+}
+
+// ClearNickname clears the value of nickname.
+func (uuo *UserUpdateOne) ClearNickname() *UserUpdateOne {
+	uuo.nickname = nil
+	uuo.clearnickname = true
+	return uuo
+}
+
 // SetBlob sets the blob field.
 func (uuo *UserUpdateOne) SetBlob(b []byte) *UserUpdateOne {
 	uuo.blob = &b
@@ -366,8 +467,38 @@ func (uuo *UserUpdateOne) ClearState() *UserUpdateOne {
 	return uuo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (uuo *UserUpdateOne) SetChangedFrom(original *User) *UserUpdateOne {
+	u := uuo.entity
+	if u == nil || original == nil {
+		return uuo
+	}
+	for _, change := range DiffUser(original, u) {
+		switch change.Field {
+		case user.FieldAge:
+			uuo.SetAge(u.Age)
+		case user.FieldName:
+			uuo.SetName(u.Name)
+		case user.FieldAddress:
+			uuo.SetAddress(u.Address)
+		case user.FieldRenamed:
+			uuo.SetRenamed(u.Renamed)
+		case user.FieldNickname:
+			uuo.SetNickname(u.Nickname)
+		case user.FieldBlob:
+			uuo.SetBlob(u.Blob)
+		case user.FieldState:
+			uuo.SetState(u.State)
+		}
+	}
+	return uuo
+}
+
 // Save executes the query and returns the updated entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+func (uuo *UserUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
 	if uuo.name != nil {
 		if err := user.NameValidator(*uuo.name); err != nil {
 			return nil, fmt.Errorf("entv1: validator failed for field \"name\": %v", err)
@@ -378,12 +509,25 @@ func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
 			return nil, fmt.Errorf("entv1: validator failed for field \"state\": %v", err)
 		}
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uuo
+		intercepted.predicates = append(predicates, uuo.predicates...)
+		uuo = &intercepted
+	}
 	return uuo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	u, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	u, err := uuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -391,21 +535,28 @@ func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
 }
 
 // Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
+func (uuo *UserUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
-	selector := sql.Select(user.Columns...).From(sql.Table(user.Table))
+	selector := sql.Select(user.Columns...).From(sql.Table(user.Table)).SetDialect(uuo.driver.Dialect())
 	user.ID(uuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range uuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -467,6 +618,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		u.Renamed = value
 		builder.SetNull(user.FieldRenamed)
 	}
+	if value := uuo.nickname; value != nil {
+		builder.Set(user.FieldNickname, *value)
+		u.Nickname = *value
+	}
+	if uuo.clearnickname {
+		var value string
+		u.Nickname = value
+		builder.SetNull(user.FieldNickname)
+	}
 	if value := uuo.blob; value != nil {
 		builder.Set(user.FieldBlob, *value)
 		u.Blob = *value
@@ -36,7 +36,7 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	c := config{log: log.Println}
+	c := config{log: log.Println, inters: &inters{}}
 	c.options(opts...)
 	return &Client{
 		config: c,
@@ -57,13 +57,83 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 		if err != nil {
 			return nil, err
 		}
-		return NewClient(append(options, Driver(drv))...), nil
+		return open(append(options, Driver(drv))...)
 
 	default:
 		return nil, fmt.Errorf("unsupported driver: %q", driverName)
 	}
 }
 
+// Ping verifies that the connection to the database is still alive.
+func (c *Client) Ping(ctx context.Context) error {
+	switch c.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		rows := &sql.Rows{}
+		if err := c.driver.Query(ctx, "SELECT 1", []interface{}{}, rows); err != nil {
+			return err
+		}
+		return rows.Close()
+
+	default:
+		return fmt.Errorf("entv2: unsupported dialect: %q", c.driver.Dialect())
+	}
+}
+
+// Ready reports whether the client is ready for serving requests. It checks that the
+// database is reachable, and that its schema was migrated by the same version of the
+// generated code.
+func (c *Client) Ready(ctx context.Context) error {
+	if err := c.Ping(ctx); err != nil {
+		return fmt.Errorf("entv2: ping database: %v", err)
+	}
+	return c.CheckSchema(ctx)
+}
+
+// CheckSchema checks that the database schema was migrated by the same version of the
+// generated code (see migrate.Hash), and returns an *ErrSchemaVersion if it was not.
+// While the schema is in its expanded state (see migrate.ModeExpand), both the expanded
+// version and the previous one are accepted. Dialects without migration support are not
+// checked.
+func (c *Client) CheckSchema(ctx context.Context) error {
+	switch c.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		versions, err := c.Schema.Versions(ctx)
+		if err != nil {
+			return fmt.Errorf("entv2: reading schema versions: %v", err)
+		}
+		if len(versions) == 0 {
+			return &ErrSchemaVersion{Client: migrate.Hash}
+		}
+		for _, v := range versions {
+			if v == migrate.Hash {
+				return nil
+			}
+		}
+		return &ErrSchemaVersion{Database: versions[0], Client: migrate.Hash}
+
+	default:
+		return fmt.Errorf("entv2: unsupported dialect: %q", c.driver.Dialect())
+	}
+}
+
+// open creates a new client configured with the given options, and runs the
+// schema verification in case it was configured using the VerifySchema option.
+func open(opts ...Option) (*Client, error) {
+	c := NewClient(opts...)
+	if c.verify == nil {
+		return c, nil
+	}
+	switch err := c.CheckSchema(context.Background()); {
+	case err == nil:
+	case IsSchemaVersion(err) && !*c.verify:
+		c.log(err)
+	default:
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
 // Tx returns a new transactional client.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
@@ -73,7 +143,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if err != nil {
 		return nil, fmt.Errorf("entv2: starting a transaction: %v", err)
 	}
-	cfg := config{driver: tx, log: c.log, debug: c.debug}
+	cfg := config{driver: tx, log: c.log, debug: c.debug, slow: c.slow, inters: c.inters.clone()}
 	return &Tx{
 		config: cfg,
 		Group:  NewGroupClient(cfg),
@@ -93,7 +163,32 @@ func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := config{driver: dialect.Debug(c.driver, c.log), log: c.log, debug: true}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	cfg.debug = true
+	return &Client{
+		config: cfg,
+		Schema: migrate.NewSchema(cfg.driver),
+		Group:  NewGroupClient(cfg),
+		Pet:    NewPetClient(cfg),
+		User:   NewUserClient(cfg),
+	}
+}
+
+// With returns a shallow copy of the client configured with the given options, in addition
+// to the options of the client. The original client is not modified, and therefore, it's safe
+// to call With concurrently on a shared client (e.g. for a debug client of one request). The
+// driver of a transactional client (see Tx.Client) is not wrapped again by the logging options.
+//
+//	client.With(ent.Debug(), ent.Log(logger.Println)).
+//		Group.
+//		Query().
+//		Count(ctx)
+//
+func (c *Client) With(opts ...Option) *Client {
+	cfg := c.config
+	cfg.options(opts...)
+	cfg.inters = c.inters.clone()
 	return &Client{
 		config: cfg,
 		Schema: migrate.NewSchema(cfg.driver),
@@ -103,6 +198,17 @@ func (c *Client) Debug() *Client {
 	}
 }
 
+// Dialect returns the dialect of the client driver. It can be used to check
+// the features that are supported by the underlying database at runtime.
+//
+//	if client.Dialect().Supports(dialect.Upsert) {
+//		// ...
+//	}
+//
+func (c *Client) Dialect() dialect.Name {
+	return dialect.Name(c.driver.Dialect())
+}
+
 // Close closes the database connection and prevents new queries from starting.
 func (c *Client) Close() error {
 	return c.driver.Close()
@@ -113,11 +219,48 @@ type GroupClient struct {
 	config
 }
 
+// GroupQuerier is the read API of the GroupClient. It's implemented by the GroupClient, and it
+// can be used by services that depend only on reading Groups, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type GroupQuerier interface {
+	Get(ctx context.Context, id int) (*Group, error)
+	GetX(ctx context.Context, id int) *Group
+	GetNotFoundOK(ctx context.Context, id int) (*Group, error)
+	Exist(ctx context.Context, id int) (bool, error)
+	ExistX(ctx context.Context, id int) bool
+	Reload(ctx context.Context, nodes ...*Group) error
+	ReloadX(ctx context.Context, nodes ...*Group)
+}
+
+var _ GroupQuerier = (*GroupClient)(nil)
+
 // NewGroupClient returns a client for the Group from the given config.
 func NewGroupClient(c config) *GroupClient {
 	return &GroupClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the Group queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.Group.Intercept(func(ctx context.Context, q *GroupQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(group.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *GroupClient) Intercept(interceptors ...GroupInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
 // Create returns a create builder for Group.
 func (c *GroupClient) Create() *GroupCreate {
 	return &GroupCreate{config: c.config}
@@ -130,7 +273,7 @@ func (c *GroupClient) Update() *GroupUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *GroupClient) UpdateOne(gr *Group) *GroupUpdateOne {
-	return c.UpdateOneID(gr.ID)
+	return &GroupUpdateOne{config: c.config, id: gr.ID, entity: gr}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -172,16 +315,112 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 	return gr
 }
 
+// GetNotFoundOK is like Get, but returns a nil Group without an error, if there is no entity with the given id.
+func (c *GroupClient) GetNotFoundOK(ctx context.Context, id int) (*Group, error) {
+	gr, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return gr, err
+}
+
+// Exist reports if a Group entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *GroupClient) Exist(ctx context.Context, id int) (bool, error) {
+	return c.Query().Where(group.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *GroupClient) ExistX(ctx context.Context, id int) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Groups in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *GroupClient) Reload(ctx context.Context, nodes ...*Group) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]int, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(group.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int]*Group, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, gr := range nodes {
+		if _, ok := byID[gr.ID]; !ok {
+			return &ErrNotFound{group.Label}
+		}
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *GroupClient) ReloadX(ctx context.Context, nodes ...*Group) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
 // PetClient is a client for the Pet schema.
 type PetClient struct {
 	config
 }
 
+// PetQuerier is the read API of the PetClient. It's implemented by the PetClient, and it
+// can be used by services that depend only on reading Pets, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type PetQuerier interface {
+	Get(ctx context.Context, id int) (*Pet, error)
+	GetX(ctx context.Context, id int) *Pet
+	GetNotFoundOK(ctx context.Context, id int) (*Pet, error)
+	Exist(ctx context.Context, id int) (bool, error)
+	ExistX(ctx context.Context, id int) bool
+	Reload(ctx context.Context, nodes ...*Pet) error
+	ReloadX(ctx context.Context, nodes ...*Pet)
+}
+
+var _ PetQuerier = (*PetClient)(nil)
+
 // NewPetClient returns a client for the Pet from the given config.
 func NewPetClient(c config) *PetClient {
 	return &PetClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the Pet queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.Pet.Intercept(func(ctx context.Context, q *PetQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(pet.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *PetClient) Intercept(interceptors ...PetInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.Pet = append(c.inters.Pet, interceptors...)
+}
+
 // Create returns a create builder for Pet.
 func (c *PetClient) Create() *PetCreate {
 	return &PetCreate{config: c.config}
@@ -194,7 +433,7 @@ func (c *PetClient) Update() *PetUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *PetClient) UpdateOne(pe *Pet) *PetUpdateOne {
-	return c.UpdateOneID(pe.ID)
+	return &PetUpdateOne{config: c.config, id: pe.ID, entity: pe}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -236,16 +475,128 @@ func (c *PetClient) GetX(ctx context.Context, id int) *Pet {
 	return pe
 }
 
+// GetNotFoundOK is like Get, but returns a nil Pet without an error, if there is no entity with the given id.
+func (c *PetClient) GetNotFoundOK(ctx context.Context, id int) (*Pet, error) {
+	pe, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return pe, err
+}
+
+// Exist reports if a Pet entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *PetClient) Exist(ctx context.Context, id int) (bool, error) {
+	return c.Query().Where(pet.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *PetClient) ExistX(ctx context.Context, id int) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Pets in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *PetClient) Reload(ctx context.Context, nodes ...*Pet) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]int, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(pet.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int]*Pet, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, pe := range nodes {
+		if _, ok := byID[pe.ID]; !ok {
+			return &ErrNotFound{pet.Label}
+		}
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *PetClient) ReloadX(ctx context.Context, nodes ...*Pet) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
+// QueryOwner queries the owner edge of a Pet.
+func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
+	query := &UserQuery{config: c.config}
+	id := pe.ID
+	t1 := sql.Table(user.Table)
+	t2 := sql.Select(pet.OwnerColumn).
+		From(sql.Table(pet.OwnerTable)).
+		Where(sql.EQ(pet.FieldID, id))
+	query.sql = sql.Select().From(t1).Join(t2).On(t1.C(user.FieldID), t2.C(pet.OwnerColumn))
+	query.edgeCount = sql.Select().Count(pet.OwnerColumn).
+		From(sql.Table(pet.OwnerTable)).
+		Where(sql.EQ(pet.FieldID, id))
+
+	return query
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
 }
 
+// UserQuerier is the read API of the UserClient. It's implemented by the UserClient, and it
+// can be used by services that depend only on reading Users, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type UserQuerier interface {
+	Get(ctx context.Context, id int) (*User, error)
+	GetX(ctx context.Context, id int) *User
+	GetNotFoundOK(ctx context.Context, id int) (*User, error)
+	Exist(ctx context.Context, id int) (bool, error)
+	ExistX(ctx context.Context, id int) bool
+	Reload(ctx context.Context, nodes ...*User) error
+	ReloadX(ctx context.Context, nodes ...*User)
+}
+
+var _ UserQuerier = (*UserClient)(nil)
+
 // NewUserClient returns a client for the User from the given config.
 func NewUserClient(c config) *UserClient {
 	return &UserClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the User queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.User.Intercept(func(ctx context.Context, q *UserQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(user.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *UserClient) Intercept(interceptors ...UserInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a create builder for User.
 func (c *UserClient) Create() *UserCreate {
 	return &UserCreate{config: c.config}
@@ -258,7 +609,7 @@ func (c *UserClient) Update() *UserUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
-	return c.UpdateOneID(u.ID)
+	return &UserUpdateOne{config: c.config, id: u.ID, entity: u}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -299,3 +650,86 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	}
 	return u
 }
+
+// GetNotFoundOK is like Get, but returns a nil User without an error, if there is no entity with the given id.
+func (c *UserClient) GetNotFoundOK(ctx context.Context, id int) (*User, error) {
+	u, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return u, err
+}
+
+// Exist reports if a User entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *UserClient) Exist(ctx context.Context, id int) (bool, error) {
+	return c.Query().Where(user.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *UserClient) ExistX(ctx context.Context, id int) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Users in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *UserClient) Reload(ctx context.Context, nodes ...*User) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]int, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int]*User, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, u := range nodes {
+		if _, ok := byID[u.ID]; !ok {
+			return &ErrNotFound{user.Label}
+		}
+	}
+	for _, u := range nodes {
+		v := byID[u.ID]
+		u.Age = v.Age
+		u.Name = v.Name
+		u.Phone = v.Phone
+		u.Buffer = v.Buffer
+		u.Title = v.Title
+		u.NewName = v.NewName
+		u.DisplayName = v.DisplayName
+		u.Blob = v.Blob
+		u.State = v.State
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *UserClient) ReloadX(ctx context.Context, nodes ...*User) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
+// QueryPets queries the pets edge of a User.
+func (c *UserClient) QueryPets(u *User) *PetQuery {
+	query := &PetQuery{config: c.config}
+	id := u.ID
+	query.sql = sql.Select().From(sql.Table(pet.Table)).
+		Where(sql.EQ(user.PetsColumn, id))
+	query.edgeCount = sql.Select().Count().From(sql.Table(pet.Table)).
+		Where(sql.EQ(user.PetsColumn, id))
+
+	return query
+}
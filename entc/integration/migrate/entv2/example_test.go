@@ -79,6 +79,10 @@ func ExampleUser() {
 	defer drv.Close()
 	client := NewClient(Driver(drv))
 	// creating vertices for the user's edges.
+	pe0 := client.Pet.
+		Create().
+		SaveX(ctx)
+	log.Println("pet created:", pe0)
 
 	// create user vertex with its edges.
 	u := client.User.
@@ -89,12 +93,19 @@ func ExampleUser() {
 		SetBuffer(nil).
 		SetTitle("string").
 		SetNewName("string").
+		SetDisplayName("string").
 		SetBlob(nil).
 		SetState(user.StateLoggedIn).
+		AddPets(pe0).
 		SaveX(ctx)
 	log.Println("user created:", u)
 
 	// query edges.
+	pe0, err = u.QueryPets().First(ctx)
+	if err != nil {
+		log.Fatalf("failed querying pets: %v", err)
+	}
+	log.Println("pets found:", pe0)
 
 	// Output:
 }
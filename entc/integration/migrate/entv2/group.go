@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/group"
 )
 
 // Group is the model entity for the Group schema.
@@ -22,13 +23,25 @@ type Group struct {
 
 // FromRows scans the sql response data into Group.
 func (gr *Group) FromRows(rows *sql.Rows) error {
+	return gr.scan(rows, group.Columns)
+}
+
+// scan scans the given columns of the sql response data into Group.
+// Fields that their columns were not selected are left with their zero values.
+func (gr *Group) scan(rows *sql.Rows, columns []string) error {
 	var vgr struct {
 		ID int
 	}
-	// the order here should be the same as in the `group.Columns`.
-	if err := rows.Scan(
-		&vgr.ID,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case group.FieldID:
+			values[i] = &vgr.ID
+		default:
+			return fmt.Errorf("unexpected column %q for type Group", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	gr.ID = vgr.ID
@@ -53,6 +66,29 @@ func (gr *Group) Unwrap() *Group {
 	return gr
 }
 
+// Clone returns a deep copy of the Group, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (gr *Group) Clone() *Group {
+	return gr.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Group, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (gr *Group) clone(seen map[interface{}]interface{}) *Group {
+	if gr == nil {
+		return nil
+	}
+	if _c, ok := seen[gr]; ok {
+		return _c.(*Group)
+	}
+	_c := *gr
+	_c.config = config{}
+	seen[gr] = &_c
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (gr *Group) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -62,14 +98,27 @@ func (gr *Group) String() string {
 	return buf.String()
 }
 
+// DiffGroup returns the fields that have different values in the given Groups,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffGroup(a, b *Group) []FieldChange {
+	var changes []FieldChange
+	return changes
+}
+
 // Groups is a parsable slice of Group.
 type Groups []*Group
 
 // FromRows scans the sql response data into Groups.
 func (gr *Groups) FromRows(rows *sql.Rows) error {
+	return gr.scan(rows, group.Columns)
+}
+
+// scan scans the given columns of the sql response data into Groups.
+func (gr *Groups) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vgr := &Group{}
-		if err := vgr.FromRows(rows); err != nil {
+		if err := vgr.scan(rows, columns); err != nil {
 			return err
 		}
 		*gr = append(*gr, vgr)
@@ -9,6 +9,7 @@ package entv2
 import (
 	"context"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/group"
 )
@@ -16,16 +17,26 @@ import (
 // GroupCreate is the builder for creating a Group entity.
 type GroupCreate struct {
 	config
+	groupMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (gc *GroupCreate) Mutation() *GroupMutation {
+	return &GroupMutation{groupMutation: &gc.groupMutation, op: ent.OpCreate}
 }
 
 // Save creates the Group in the database.
-func (gc *GroupCreate) Save(ctx context.Context) (*Group, error) {
+func (gc *GroupCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Group, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return gc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (gc *GroupCreate) SaveX(ctx context.Context) *Group {
-	v, err := gc.Save(ctx)
+func (gc *GroupCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Group {
+	v, err := gc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/group"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/predicate"
@@ -18,7 +19,11 @@ import (
 // GroupUpdate is the builder for updating Group entities.
 type GroupUpdate struct {
 	config
+	groupMutation
 	predicates []predicate.Group
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Group
 }
 
 // Where adds a new predicate for the builder.
@@ -27,14 +32,52 @@ func (gu *GroupUpdate) Where(ps ...predicate.Group) *GroupUpdate {
 	return gu
 }
 
+// Mutation returns the mutation of the builder.
+func (gu *GroupUpdate) Mutation() *GroupMutation {
+	return &GroupMutation{groupMutation: &gu.groupMutation, op: ent.OpUpdate}
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
+func (gu *GroupUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := gu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (gu *GroupUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptGroup(ctx, gu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *gu
+		intercepted.predicates = append(predicates, gu.predicates...)
+		gu = &intercepted
+	}
 	return gu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (gu *GroupUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Group, error) {
+	var grs []*Group
+	gu.returning = &grs
+	defer func() { gu.returning = nil }()
+	if _, err := gu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return grs, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (gu *GroupUpdate) SaveX(ctx context.Context) int {
-	affected, err := gu.Save(ctx)
+func (gu *GroupUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := gu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -42,65 +85,122 @@ func (gu *GroupUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (gu *GroupUpdate) Exec(ctx context.Context) error {
-	_, err := gu.Save(ctx)
+func (gu *GroupUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := gu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (gu *GroupUpdate) ExecX(ctx context.Context) {
-	if err := gu.Exec(ctx); err != nil {
+func (gu *GroupUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := gu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(group.FieldID).From(sql.Table(group.Table))
+func (gu *GroupUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(group.FieldID).From(sql.Table(group.Table)).SetDialect(gu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range gu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = gu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("entv2: failed reading id: %v", err)
+			return nil, fmt.Errorf("entv2: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := gu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+	if gu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(group.Columns...).
+			From(sql.Table(group.Table)).
+			Where(sql.InInts(group.FieldID, ids...)).
+			SetDialect(gu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var grs Groups
+		err := grs.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("entv2: failed scanning rows into Group: %v", err))
+		}
+		grs.config(gu.config)
+		*gu.returning = grs
 	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // GroupUpdateOne is the builder for updating a single Group entity.
 type GroupUpdateOne struct {
 	config
-	id int
+	id     int
+	entity *Group
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Group
+	groupMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (guo *GroupUpdateOne) Mutation() *GroupMutation {
+	return &GroupMutation{groupMutation: &guo.groupMutation, op: ent.OpUpdateOne, id: &guo.id}
+}
+
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (guo *GroupUpdateOne) SetChangedFrom(original *Group) *GroupUpdateOne {
+	gr := guo.entity
+	if gr == nil || original == nil {
+		return guo
+	}
+	return guo
 }
 
 // Save executes the query and returns the updated entity.
-func (guo *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
+func (guo *GroupUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Group, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptGroup(ctx, guo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *guo
+		intercepted.predicates = append(predicates, guo.predicates...)
+		guo = &intercepted
+	}
 	return guo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (guo *GroupUpdateOne) SaveX(ctx context.Context) *Group {
-	gr, err := guo.Save(ctx)
+func (guo *GroupUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Group {
+	gr, err := guo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -108,21 +208,28 @@ func (guo *GroupUpdateOne) SaveX(ctx context.Context) *Group {
 }
 
 // Exec executes the query on the entity.
-func (guo *GroupUpdateOne) Exec(ctx context.Context) error {
-	_, err := guo.Save(ctx)
+func (guo *GroupUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := guo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
-	if err := guo.Exec(ctx); err != nil {
+func (guo *GroupUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := guo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
-	selector := sql.Select(group.Columns...).From(sql.Table(group.Table))
+	selector := sql.Select(group.Columns...).From(sql.Table(group.Table)).SetDialect(guo.driver.Dialect())
 	group.ID(guo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range guo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = guo.driver.Query(ctx, query, args, rows); err != nil {
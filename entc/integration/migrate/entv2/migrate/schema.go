@@ -28,13 +28,21 @@ var (
 	// PetsColumns holds the columns for the "pets" table.
 	PetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "owner_id", Type: field.TypeInt},
 	}
 	// PetsTable holds the schema information for the "pets" table.
 	PetsTable = &schema.Table{
-		Name:        "pets",
-		Columns:     PetsColumns,
-		PrimaryKey:  []*schema.Column{PetsColumns[0]},
-		ForeignKeys: []*schema.ForeignKey{},
+		Name:       "pets",
+		Columns:    PetsColumns,
+		PrimaryKey: []*schema.Column{PetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "pets_users_pets",
+				Columns: []*schema.Column{PetsColumns[1]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
@@ -45,6 +53,7 @@ var (
 		{Name: "buffer", Type: field.TypeBytes, Default: user.DefaultBuffer},
 		{Name: "title", Type: field.TypeString, Default: user.DefaultTitle},
 		{Name: "renamed", Type: field.TypeString, Nullable: true},
+		{Name: "display_name", Type: field.TypeString, Nullable: true, Renamed: []string{"nickname"}},
 		{Name: "blob", Type: field.TypeBytes, Nullable: true, Size: 1000},
 		{Name: "state", Type: field.TypeEnum, Nullable: true, Enums: []string{"logged_in", "logged_out", "online"}},
 	}
@@ -71,4 +80,5 @@ var (
 )
 
 func init() {
+	PetsTable.ForeignKeys[0].RefTable = UsersTable
 }
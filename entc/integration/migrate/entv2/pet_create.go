@@ -8,7 +8,9 @@ package entv2
 
 import (
 	"context"
+	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/pet"
 )
@@ -16,16 +18,46 @@ import (
 // PetCreate is the builder for creating a Pet entity.
 type PetCreate struct {
 	config
+	petMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (pc *PetCreate) Mutation() *PetMutation {
+	return &PetMutation{petMutation: &pc.petMutation, op: ent.OpCreate}
+}
+
+// SetOwnerID sets the owner edge to User by id.
+func (pc *PetCreate) SetOwnerID(id int) *PetCreate {
+	if pc.owner == nil {
+		pc.owner = make(map[int]struct{})
+	}
+	pc.owner[id] = struct{}{}
+	return pc
+}
+
+// SetOwner sets the owner edge to User.
+func (pc *PetCreate) SetOwner(u *User) *PetCreate {
+	return pc.SetOwnerID(u.ID)
 }
 
 // Save creates the Pet in the database.
-func (pc *PetCreate) Save(ctx context.Context) (*Pet, error) {
+func (pc *PetCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Pet, error) {
+	options := ent.NewCallOptions(opts...)
+	if len(pc.owner) > 1 {
+		return nil, errors.New("entv2: multiple assignments on a unique edge \"owner\"")
+	}
+	if pc.owner == nil {
+		return nil, errors.New("entv2: missing required edge \"owner\"")
+	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return pc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (pc *PetCreate) SaveX(ctx context.Context) *Pet {
-	v, err := pc.Save(ctx)
+func (pc *PetCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Pet {
+	v, err := pc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -42,6 +74,9 @@ func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
 		return nil, err
 	}
 	builder := sql.Insert(pet.Table).Default(pc.driver.Dialect())
+	for eid := range pc.owner {
+		builder.Set(pet.OwnerColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -12,21 +12,33 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/pet"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/predicate"
+	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/user"
 )
 
 // PetQuery is the builder for querying Pet entities.
 type PetQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit  *int
+	offset *int
+	order  []Order
+	unique []string
+	fields []string
+	hints  []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.Pet
+	// eager-loading edges.
+	withOwner *UserQuery
 	// intermediate queries.
 	sql *sql.Selector
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath func(context.Context) (*sql.Selector, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -53,6 +65,129 @@ func (pq *PetQuery) Order(o ...Order) *PetQuery {
 	return pq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored.
+func (pq *PetQuery) Hint(hints ...sql.Hint) *PetQuery {
+	pq.hints = append(pq.hints, hints...)
+	return pq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	pes, err := client.Pet.Query().
+//		Fields().
+//		All(ctx)
+//
+func (pq *PetQuery) Fields(fields ...string) *PetQuery {
+	pq.fields = append(pq.fields, fields...)
+	return pq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (pq *PetQuery) Scope(scopes ...pet.Scope) *PetQuery {
+	for _, s := range scopes {
+		pq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				pq.Order(Desc(o.Field))
+			} else {
+				pq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			pq.Limit(s.Limit)
+		}
+	}
+	return pq
+}
+
+// PetInterceptor is a query interceptor of the PetClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the PetClient.Intercept method.
+type PetInterceptor func(context.Context, *PetQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (pq *PetQuery) prepare(ctx context.Context) (*PetQuery, error) {
+	var interceptors []PetInterceptor
+	if pq.inters != nil {
+		pq.inters.mu.RLock()
+		interceptors = pq.inters.Pet
+		pq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && pq.sqlPath == nil {
+		return pq, nil
+	}
+	query := pq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptPet returns the predicates that the interceptors of the client add to a Pet query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptPet(ctx context.Context, cfg config) ([]predicate.Pet, error) {
+	query, err := (&PetQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
+// QueryOwner chains the current query on the owner edge.
+func (pq *PetQuery) QueryOwner() *UserQuery {
+	query := &UserQuery{config: pq.config}
+	query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+		prev, err := pq.prepare(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t1 := sql.Table(user.Table)
+		t2 := prev.sqlQuery(ctx)
+		t2.Select(t2.C(pet.OwnerColumn))
+		return sql.Select(t1.Columns(user.Columns...)...).
+			From(t1).
+			Join(t2).
+			On(t1.C(user.FieldID), t2.C(pet.OwnerColumn)), nil
+	}
+	return query
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to the "owner" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Pet.Query().
+//		WithOwner(func(q *entv2.UserQuery) {
+//			q.Order(entv2.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (pq *PetQuery) WithOwner(opts ...func(*UserQuery)) *PetQuery {
+	query := &UserQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withOwner = query
+	return pq
+}
+
 // First returns the first Pet entity in the query. Returns *ErrNotFound when no pet was found.
 func (pq *PetQuery) First(ctx context.Context) (*Pet, error) {
 	pes, err := pq.Limit(1).All(ctx)
@@ -148,22 +283,41 @@ func (pq *PetQuery) OnlyXID(ctx context.Context) int {
 }
 
 // All executes the query and returns a list of Pets.
-func (pq *PetQuery) All(ctx context.Context) ([]*Pet, error) {
-	return pq.sqlAll(ctx)
+func (pq *PetQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*Pet, error) {
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlAll(ctx)
 }
 
 // AllX is like All, but panics if an error occurs.
-func (pq *PetQuery) AllX(ctx context.Context) []*Pet {
-	pes, err := pq.All(ctx)
+func (pq *PetQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*Pet {
+	pes, err := pq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return pes
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (pq *PetQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return query.sqlQueryString(ctx)
+}
+
 // IDs executes the query and returns a list of Pet ids.
 func (pq *PetQuery) IDs(ctx context.Context) ([]int, error) {
-	return pq.sqlIDs(ctx)
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlIDs(ctx)
 }
 
 // IDsX is like IDs, but panics if an error occurs.
@@ -175,9 +329,42 @@ func (pq *PetQuery) IDsX(ctx context.Context) []int {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Pets that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (pq *PetQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if pq.sql != nil {
+		return nil, errors.New("entv2: BatchIDs is not supported on edge queries")
+	}
+	query := &PetQuery{
+		config:     pq.config,
+		predicates: append([]predicate.Pet{}, pq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(int)
+		if !ok {
+			return nil, fmt.Errorf("entv2: unexpected id type %T for Pet", after)
+		}
+		query.Where(pet.IDGT(id))
+	}
+	ids, err := query.Order(Asc(pet.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (pq *PetQuery) Count(ctx context.Context) (int, error) {
-	return pq.sqlCount(ctx)
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return query.sqlCount(ctx)
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -191,7 +378,11 @@ func (pq *PetQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (pq *PetQuery) Exist(ctx context.Context) (bool, error) {
-	return pq.sqlExist(ctx)
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	return query.sqlExist(ctx)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -212,9 +403,14 @@ func (pq *PetQuery) Clone() *PetQuery {
 		offset:     pq.offset,
 		order:      append([]Order{}, pq.order...),
 		unique:     append([]string{}, pq.unique...),
+		fields:     append([]string{}, pq.fields...),
+		hints:      append([]sql.Hint{}, pq.hints...),
+		edgeCount:  pq.edgeCount,
 		predicates: append([]predicate.Pet{}, pq.predicates...),
+		withOwner:  pq.withOwner,
 		// clone intermediate queries.
-		sql: pq.sql.Clone(),
+		sql:     pq.sql.Clone(),
+		sqlPath: pq.sqlPath,
 	}
 }
 
@@ -223,7 +419,14 @@ func (pq *PetQuery) Clone() *PetQuery {
 func (pq *PetQuery) GroupBy(field string, fields ...string) *PetGroupBy {
 	group := &PetGroupBy{config: pq.config}
 	group.fields = append([]string{field}, fields...)
-	group.sql = pq.sqlQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := pq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		group.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return group
 }
 
@@ -231,15 +434,22 @@ func (pq *PetQuery) GroupBy(field string, fields ...string) *PetGroupBy {
 func (pq *PetQuery) Select(field string, fields ...string) *PetSelect {
 	selector := &PetSelect{config: pq.config}
 	selector.fields = append([]string{field}, fields...)
-	selector.sql = pq.sqlQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := pq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		selector.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return selector
 }
 
 func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
 	rows := &sql.Rows{}
-	selector := pq.sqlQuery()
-	if unique := pq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := pq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := pq.driver.Query(ctx, query, args, rows); err != nil {
@@ -247,21 +457,140 @@ func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
 	}
 	defer rows.Close()
 	var pes Pets
-	if err := pes.FromRows(rows); err != nil {
+	if err := pes.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	pes.config(pq.config)
+	if query := pq.withOwner; query != nil {
+		if err := pq.sqlLoadOwner(ctx, query, pes); err != nil {
+			return nil, err
+		}
+	}
 	return pes, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (pq *PetQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := pq.sqlQuery(ctx)
+	if unique := pq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := pet.Columns
+	if fields := pq.fields; len(fields) > 0 {
+		columns = append([]string{pet.FieldID}, fields...)
+		for _, c := range fields {
+			if !pet.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("entv2: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (pq *PetQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := pq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadOwner loads the nodes of the owner edge of the given Pets using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (pq *PetQuery) sqlLoadOwner(ctx context.Context, query *UserQuery, nodes []*Pet) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[int]*Pet, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(pet.OwnerTable)
+	selector.Join(t1).On(t1.C(pet.OwnerColumn), selector.C(user.FieldID))
+	key := t1.C(pet.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := pq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []int
+		eids []int
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, nid)
+		eids = append(eids, eid)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[int]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Owner = edge
+	}
+	return nil
+}
+
 func (pq *PetQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := pq.sqlQuery()
+	selector := pq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{pet.FieldID}
 	if len(pq.unique) > 0 {
 		unique = pq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := pq.edgeCount; c != nil && len(pq.predicates) == 0 && len(pq.unique) == 0 &&
+		len(pq.hints) == 0 && pq.limit == nil && pq.offset == nil {
+		selector = c.Clone().SetDialect(pq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := pq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -278,11 +607,22 @@ func (pq *PetQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (pq *PetQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := pq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := pq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(pet.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := pq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := pq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("entv2: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (pq *PetQuery) sqlIDs(ctx context.Context) ([]int, error) {
@@ -297,13 +637,33 @@ func (pq *PetQuery) sqlIDs(ctx context.Context) ([]int, error) {
 	return ids, nil
 }
 
-func (pq *PetQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (pq *PetQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := pq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(pet.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (pq *PetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(pet.Table)
 	selector := sql.Select(t1.Columns(pet.Columns...)...).From(t1)
 	if pq.sql != nil {
 		selector = pq.sql
 		selector.Select(selector.Columns(pet.Columns...)...)
 	}
+	selector.SetDialect(pq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(pq.hints...)
 	for _, p := range pq.predicates {
 		p(selector)
 	}
@@ -328,6 +688,10 @@ type PetGroupBy struct {
 	fns    []Aggregate
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving *sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -336,8 +700,25 @@ func (pgb *PetGroupBy) Aggregate(fns ...Aggregate) *PetGroupBy {
 	return pgb
 }
 
+// Having adds a predicate on the aggregated values of the groups.
+// For example:
+//
+//	client.Pet.Query().
+//		GroupBy(field).
+//		Aggregate(entv2.Count()).
+//		Having(sql.GT(sql.Count("*"), 5)).
+//		Scan(ctx, &v)
+//
+func (pgb *PetGroupBy) Having(p *sql.Predicate) *PetGroupBy {
+	pgb.sqlHaving = p
+	return pgb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (pgb *PetGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := pgb.path(ctx); err != nil {
+		return err
+	}
 	return pgb.sqlScan(ctx, v)
 }
 
@@ -433,6 +814,9 @@ func (pgb *PetGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (pgb *PetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := pgb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := pgb.sqlQuery().Query()
 	if err := pgb.driver.Query(ctx, query, args, rows); err != nil {
@@ -449,7 +833,11 @@ func (pgb *PetGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range pgb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(pgb.fields...)
+	selector.Select(columns...).GroupBy(pgb.fields...)
+	if pgb.sqlHaving != nil {
+		selector.Having(pgb.sqlHaving)
+	}
+	return selector
 }
 
 // PetSelect is the builder for select fields of Pet entities.
@@ -458,10 +846,15 @@ type PetSelect struct {
 	fields []string
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (ps *PetSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ps.path(ctx); err != nil {
+		return err
+	}
 	return ps.sqlScan(ctx, v)
 }
 
@@ -557,6 +950,9 @@ func (ps *PetSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (ps *PetSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ps.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ps.sqlQuery().Query()
 	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
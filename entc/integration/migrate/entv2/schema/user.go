@@ -6,6 +6,7 @@ package schema
 
 import (
 	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
 	"github.com/facebookincubator/ent/schema/field"
 	"github.com/facebookincubator/ent/schema/index"
 )
@@ -36,6 +37,11 @@ func (User) Fields() []ent.Field {
 		field.String("new_name").
 			Optional().
 			StorageKey("renamed"),
+		// rename the column of the field, and keep
+		// its values ("nickname" to "display_name").
+		field.String("display_name").
+			Optional().
+			Renamed("nickname"),
 		// extending the blob size.
 		field.Bytes("blob").
 			Optional().
@@ -48,6 +54,14 @@ func (User) Fields() []ent.Field {
 	}
 }
 
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		// adding a new table with a required foreign-key.
+		edge.To("pets", Pet.Type),
+	}
+}
+
 func (User) Indexes() []ent.Index {
 	return []ent.Index{
 		// deleting old indexes (name, address),
@@ -64,3 +78,13 @@ type (
 	// Group schema.
 	Group struct{ ent.Schema }
 )
+
+// Edges of the Pet.
+func (Pet) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("pets").
+			Unique().
+			Required(),
+	}
+}
@@ -31,37 +31,71 @@ type User struct {
 	Title string `json:"title,omitempty"`
 	// NewName holds the value of the "new_name" field.
 	NewName string `json:"new_name,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"display_name,omitempty"`
 	// Blob holds the value of the "blob" field.
 	Blob []byte `json:"blob,omitempty"`
 	// State holds the value of the "state" field.
 	State user.State `json:"state,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges UserEdges `json:"edges"`
+}
+
+// UserEdges holds the relations/edges of other nodes in the graph.
+type UserEdges struct {
+	// Pets holds the value of the pets edge.
+	Pets []*Pet `json:"pets,omitempty"`
 }
 
 // FromRows scans the sql response data into User.
 func (u *User) FromRows(rows *sql.Rows) error {
+	return u.scan(rows, user.Columns)
+}
+
+// scan scans the given columns of the sql response data into User.
+// Fields that their columns were not selected are left with their zero values.
+func (u *User) scan(rows *sql.Rows, columns []string) error {
 	var vu struct {
-		ID      int
-		Age     sql.NullInt64
-		Name    sql.NullString
-		Phone   sql.NullString
-		Buffer  []byte
-		Title   sql.NullString
-		NewName sql.NullString
-		Blob    []byte
-		State   sql.NullString
-	}
-	// the order here should be the same as in the `user.Columns`.
-	if err := rows.Scan(
-		&vu.ID,
-		&vu.Age,
-		&vu.Name,
-		&vu.Phone,
-		&vu.Buffer,
-		&vu.Title,
-		&vu.NewName,
-		&vu.Blob,
-		&vu.State,
-	); err != nil {
+		ID          int
+		Age         sql.NullInt64
+		Name        sql.NullString
+		Phone       sql.NullString
+		Buffer      []byte
+		Title       sql.NullString
+		NewName     sql.NullString
+		DisplayName sql.NullString
+		Blob        []byte
+		State       sql.NullString
+	}
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			values[i] = &vu.ID
+		case user.FieldAge:
+			values[i] = &vu.Age
+		case user.FieldName:
+			values[i] = &vu.Name
+		case user.FieldPhone:
+			values[i] = &vu.Phone
+		case user.FieldBuffer:
+			values[i] = &vu.Buffer
+		case user.FieldTitle:
+			values[i] = &vu.Title
+		case user.FieldNewName:
+			values[i] = &vu.NewName
+		case user.FieldDisplayName:
+			values[i] = &vu.DisplayName
+		case user.FieldBlob:
+			values[i] = &vu.Blob
+		case user.FieldState:
+			values[i] = &vu.State
+		default:
+			return fmt.Errorf("unexpected column %q for type User", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	u.ID = vu.ID
@@ -71,11 +105,17 @@ func (u *User) FromRows(rows *sql.Rows) error {
 	u.Buffer = vu.Buffer
 	u.Title = vu.Title.String
 	u.NewName = vu.NewName.String
+	u.DisplayName = vu.DisplayName.String
 	u.Blob = vu.Blob
 	u.State = user.State(vu.State.String)
 	return nil
 }
 
+// QueryPets queries the pets edge of the User.
+func (u *User) QueryPets() *PetQuery {
+	return (&UserClient{u.config}).QueryPets(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that, you need to call User.Unwrap() before calling this method, if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -94,6 +134,41 @@ func (u *User) Unwrap() *User {
 	return u
 }
 
+// Clone returns a deep copy of the User, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (u *User) Clone() *User {
+	return u.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the User, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (u *User) clone(seen map[interface{}]interface{}) *User {
+	if u == nil {
+		return nil
+	}
+	if _c, ok := seen[u]; ok {
+		return _c.(*User)
+	}
+	_c := *u
+	_c.config = config{}
+	seen[u] = &_c
+	if v := u.Buffer; v != nil {
+		_c.Buffer = append([]byte(nil), v...)
+	}
+	if v := u.Blob; v != nil {
+		_c.Blob = append([]byte(nil), v...)
+	}
+	if nodes := u.Edges.Pets; nodes != nil {
+		_c.Edges.Pets = make([]*Pet, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Pets[_i] = _n.clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (u *User) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -105,20 +180,61 @@ func (u *User) String() string {
 	buf.WriteString(fmt.Sprintf(", buffer=%v", u.Buffer))
 	buf.WriteString(fmt.Sprintf(", title=%v", u.Title))
 	buf.WriteString(fmt.Sprintf(", new_name=%v", u.NewName))
+	buf.WriteString(fmt.Sprintf(", display_name=%v", u.DisplayName))
 	buf.WriteString(fmt.Sprintf(", blob=%v", u.Blob))
 	buf.WriteString(fmt.Sprintf(", state=%v", u.State))
 	buf.WriteString(")")
 	return buf.String()
 }
 
+// DiffUser returns the fields that have different values in the given Users,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffUser(a, b *User) []FieldChange {
+	var changes []FieldChange
+	if a.Age != b.Age {
+		changes = append(changes, FieldChange{Field: user.FieldAge, Old: a.Age, New: b.Age})
+	}
+	if a.Name != b.Name {
+		changes = append(changes, FieldChange{Field: user.FieldName, Old: a.Name, New: b.Name})
+	}
+	if a.Phone != b.Phone {
+		changes = append(changes, FieldChange{Field: user.FieldPhone, Old: a.Phone, New: b.Phone})
+	}
+	if !bytes.Equal(a.Buffer, b.Buffer) {
+		changes = append(changes, FieldChange{Field: user.FieldBuffer, Old: a.Buffer, New: b.Buffer})
+	}
+	if a.Title != b.Title {
+		changes = append(changes, FieldChange{Field: user.FieldTitle, Old: a.Title, New: b.Title})
+	}
+	if a.NewName != b.NewName {
+		changes = append(changes, FieldChange{Field: user.FieldNewName, Old: a.NewName, New: b.NewName})
+	}
+	if a.DisplayName != b.DisplayName {
+		changes = append(changes, FieldChange{Field: user.FieldDisplayName, Old: a.DisplayName, New: b.DisplayName})
+	}
+	if !bytes.Equal(a.Blob, b.Blob) {
+		changes = append(changes, FieldChange{Field: user.FieldBlob, Old: a.Blob, New: b.Blob})
+	}
+	if a.State != b.State {
+		changes = append(changes, FieldChange{Field: user.FieldState, Old: a.State, New: b.State})
+	}
+	return changes
+}
+
 // Users is a parsable slice of User.
 type Users []*User
 
 // FromRows scans the sql response data into Users.
 func (u *Users) FromRows(rows *sql.Rows) error {
+	return u.scan(rows, user.Columns)
+}
+
+// scan scans the given columns of the sql response data into Users.
+func (u *Users) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vu := &User{}
-		if err := vu.FromRows(rows); err != nil {
+		if err := vu.scan(rows, columns); err != nil {
 			return err
 		}
 		*u = append(*u, vu)
@@ -9,7 +9,10 @@ package user
 import (
 	"fmt"
 
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/predicate"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/schema"
+	"github.com/facebookincubator/ent/schema/field"
 )
 
 const (
@@ -29,6 +32,8 @@ const (
 	FieldTitle = "title"
 	// FieldNewName holds the string denoting the new_name vertex property in the database.
 	FieldNewName = "renamed"
+	// FieldDisplayName holds the string denoting the display_name vertex property in the database.
+	FieldDisplayName = "display_name"
 	// FieldBlob holds the string denoting the blob vertex property in the database.
 	FieldBlob = "blob"
 	// FieldState holds the string denoting the state vertex property in the database.
@@ -36,6 +41,13 @@ const (
 
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// PetsTable is the table the holds the pets relation/edge.
+	PetsTable = "pets"
+	// PetsInverseTable is the table name for the Pet entity.
+	// It exists in this package in order to avoid circular dependency with the "pet" package.
+	PetsInverseTable = "pets"
+	// PetsColumn is the table column denoting the pets relation/edge.
+	PetsColumn = "owner_id"
 )
 
 // Columns holds all SQL columns are user fields.
@@ -47,10 +59,21 @@ var Columns = []string{
 	FieldBuffer,
 	FieldTitle,
 	FieldNewName,
+	FieldDisplayName,
 	FieldBlob,
 	FieldState,
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	fields = schema.User{}.Fields()
 
@@ -65,6 +88,19 @@ var (
 	DefaultTitle = descTitle.Default.(string)
 )
 
+// Scope is a reusable named scope of the User queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.User
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
 // State defines the type for the state enum field.
 type State string
 
@@ -87,3 +123,99 @@ func StateValidator(state State) error {
 		return fmt.Errorf("user: invalid enum value for state field: %q", state)
 	}
 }
+
+// descriptor holds the runtime descriptor of the User type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "User",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeInt,
+		GoType:     "int",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "age",
+			StorageKey: FieldAge,
+			Type:       field.TypeInt,
+			GoType:     "int",
+		},
+		{
+			Name:       "name",
+			StorageKey: FieldName,
+			Type:       field.TypeString,
+			GoType:     "string",
+		},
+		{
+			Name:       "phone",
+			StorageKey: FieldPhone,
+			Type:       field.TypeString,
+			GoType:     "string",
+		},
+		{
+			Name:       "buffer",
+			StorageKey: FieldBuffer,
+			Type:       field.TypeBytes,
+			GoType:     "[]byte",
+			Default:    true,
+		},
+		{
+			Name:       "title",
+			StorageKey: FieldTitle,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Default:    true,
+		},
+		{
+			Name:       "new_name",
+			StorageKey: FieldNewName,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+		},
+		{
+			Name:       "display_name",
+			StorageKey: FieldDisplayName,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Optional:   true,
+		},
+		{
+			Name:       "blob",
+			StorageKey: FieldBlob,
+			Type:       field.TypeBytes,
+			GoType:     "[]byte",
+			Optional:   true,
+		},
+		{
+			Name:       "state",
+			StorageKey: FieldState,
+			Type:       field.TypeEnum,
+			GoType:     "user.State",
+			Enums:      []string{"logged_in", "logged_out", "online"},
+			Optional:   true,
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(State)
+				if !ok {
+					return fmt.Errorf("user: unexpected type %T for field state", v)
+				}
+				return StateValidator(vv)
+			},
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "pets",
+			Type:     "Pet",
+			Rel:      "O2M",
+			Optional: true,
+			Table:    "pets",
+			Columns:  []string{"owner_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the User type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
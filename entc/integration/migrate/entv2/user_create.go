@@ -11,21 +11,21 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/pet"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/user"
 )
 
 // UserCreate is the builder for creating a User entity.
 type UserCreate struct {
 	config
-	age      *int
-	name     *string
-	phone    *string
-	buffer   *[]byte
-	title    *string
-	new_name *string
-	blob     *[]byte
-	state    *user.State
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uc *UserCreate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uc.userMutation, op: ent.OpCreate}
 }
 
 // SetAge sets the age field.
@@ -80,6 +80,20 @@ func (uc *UserCreate) SetNillableNewName(s *string) *UserCreate {
 	return uc
 }
 
+// SetDisplayName sets the display_name field.
+func (uc *UserCreate) SetDisplayName(s string) *UserCreate {
+	uc.display_name = &s
+	return uc
+}
+
+// SetNillableDisplayName sets the display_name field if the given value is not nil.
+func (uc *UserCreate) SetNillableDisplayName(s *string) *UserCreate {
+	if s != nil {
+		uc.SetDisplayName(*s)
+	}
+	return uc
+}
+
 // SetBlob sets the blob field.
 func (uc *UserCreate) SetBlob(b []byte) *UserCreate {
 	uc.blob = &b
@@ -100,8 +114,29 @@ func (uc *UserCreate) SetNillableState(u *user.State) *UserCreate {
 	return uc
 }
 
+// AddPetIDs adds the pets edge to Pet by ids.
+func (uc *UserCreate) AddPetIDs(ids ...int) *UserCreate {
+	if uc.pets == nil {
+		uc.pets = make(map[int]struct{})
+	}
+	for i := range ids {
+		uc.pets[ids[i]] = struct{}{}
+	}
+	return uc
+}
+
+// AddPets adds the pets edges to Pet.
+func (uc *UserCreate) AddPets(p ...*Pet) *UserCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPetIDs(ids...)
+}
+
 // Save creates the User in the database.
-func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+func (uc *UserCreate) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
 	if uc.age == nil {
 		return nil, errors.New("entv2: missing required field \"age\"")
 	}
@@ -124,12 +159,15 @@ func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
 			return nil, fmt.Errorf("entv2: validator failed for field \"state\": %v", err)
 		}
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return uc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (uc *UserCreate) SaveX(ctx context.Context) *User {
-	v, err := uc.Save(ctx)
+func (uc *UserCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	v, err := uc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -170,6 +208,10 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 		builder.Set(user.FieldNewName, *value)
 		u.NewName = *value
 	}
+	if value := uc.display_name; value != nil {
+		builder.Set(user.FieldDisplayName, *value)
+		u.DisplayName = *value
+	}
 	if value := uc.blob; value != nil {
 		builder.Set(user.FieldBlob, *value)
 		u.Blob = *value
@@ -187,6 +229,26 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 		return nil, rollback(tx, err)
 	}
 	u.ID = int(id)
+	if len(uc.pets) > 0 {
+		p := sql.P()
+		for eid := range uc.pets {
+			p.Or().EQ(pet.FieldID, eid)
+		}
+		query, args := sql.Update(user.PetsTable).
+			Set(user.PetsColumn, id).
+			Where(sql.And(p, sql.IsNull(user.PetsColumn))).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		if int(affected) < len(uc.pets) {
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uc.pets))})
+		}
+	}
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
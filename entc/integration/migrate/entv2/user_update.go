@@ -10,7 +10,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/pet"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/predicate"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/user"
 )
@@ -18,19 +20,11 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	age           *int
-	addage        *int
-	name          *string
-	phone         *string
-	buffer        *[]byte
-	title         *string
-	new_name      *string
-	clearnew_name bool
-	blob          *[]byte
-	clearblob     bool
-	state         *user.State
-	clearstate    bool
-	predicates    []predicate.User
+	userMutation
+	predicates []predicate.User
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*User
 }
 
 // Where adds a new predicate for the builder.
@@ -39,6 +33,11 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Mutation returns the mutation of the builder.
+func (uu *UserUpdate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uu.userMutation, op: ent.OpUpdate}
+}
+
 // SetAge sets the age field.
 func (uu *UserUpdate) SetAge(i int) *UserUpdate {
 	uu.age = &i
@@ -109,6 +108,27 @@ func (uu *UserUpdate) ClearNewName() *UserUpdate {
 	return uu
 }
 
+// SetDisplayName sets the display_name field.
+func (uu *UserUpdate) SetDisplayName(s string) *UserUpdate {
+	uu.display_name = &s
+	return uu
+}
+
+// SetNillableDisplayName sets the display_name field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDisplayName(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetDisplayName(*s)
+	}
+	return uu
+}
+
+// ClearDisplayName clears the value of display_name.
+func (uu *UserUpdate) ClearDisplayName() *UserUpdate {
+	uu.display_name = nil
+	uu.cleardisplay_name = true
+	return uu
+}
+
 // SetBlob sets the blob field.
 func (uu *UserUpdate) SetBlob(b []byte) *UserUpdate {
 	uu.blob = &b
@@ -143,19 +163,98 @@ func (uu *UserUpdate) ClearState() *UserUpdate {
 	return uu
 }
 
+// AddPetIDs adds the pets edge to Pet by ids.
+func (uu *UserUpdate) AddPetIDs(ids ...int) *UserUpdate {
+	if uu.pets == nil {
+		uu.pets = make(map[int]struct{})
+	}
+	for i := range ids {
+		uu.pets[ids[i]] = struct{}{}
+	}
+	return uu
+}
+
+// AddPets adds the pets edges to Pet.
+func (uu *UserUpdate) AddPets(p ...*Pet) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddPetIDs(ids...)
+}
+
+// ClearPets clears all pets edges to Pet.
+func (uu *UserUpdate) ClearPets() *UserUpdate {
+	uu.clearedPets = true
+	return uu
+}
+
+// RemovePetIDs removes the pets edge to Pet by ids.
+func (uu *UserUpdate) RemovePetIDs(ids ...int) *UserUpdate {
+	if uu.removedPets == nil {
+		uu.removedPets = make(map[int]struct{})
+	}
+	for i := range ids {
+		uu.removedPets[ids[i]] = struct{}{}
+	}
+	return uu
+}
+
+// RemovePets removes pets edges to Pet.
+func (uu *UserUpdate) RemovePets(p ...*Pet) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemovePetIDs(ids...)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+func (uu *UserUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := uu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (uu *UserUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
 	if uu.state != nil {
 		if err := user.StateValidator(*uu.state); err != nil {
-			return 0, fmt.Errorf("entv2: validator failed for field \"state\": %v", err)
+			return nil, fmt.Errorf("entv2: validator failed for field \"state\": %v", err)
 		}
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uu
+		intercepted.predicates = append(predicates, uu.predicates...)
+		uu = &intercepted
+	}
 	return uu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (uu *UserUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*User, error) {
+	var us []*User
+	uu.returning = &us
+	defer func() { uu.returning = nil }()
+	if _, err := uu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return us, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
+func (uu *UserUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := uu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -163,44 +262,48 @@ func (uu *UserUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
+func (uu *UserUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
+func (uu *UserUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(user.FieldID).From(sql.Table(user.Table))
+func (uu *UserUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(user.FieldID).From(sql.Table(user.Table)).SetDialect(uu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range uu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("entv2: failed reading id: %v", err)
+			return nil, fmt.Errorf("entv2: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := uu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -230,6 +333,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.clearnew_name {
 		builder.SetNull(user.FieldNewName)
 	}
+	if value := uu.display_name; value != nil {
+		builder.Set(user.FieldDisplayName, *value)
+	}
+	if uu.cleardisplay_name {
+		builder.SetNull(user.FieldDisplayName)
+	}
 	if value := uu.blob; value != nil {
 		builder.Set(user.FieldBlob, *value)
 	}
@@ -245,31 +354,91 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
+		}
+	}
+	if uu.clearedPets {
+		query, args := sql.Update(user.PetsTable).
+			SetNull(user.PetsColumn).
+			Where(sql.InInts(user.PetsColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uu.removedPets) > 0 {
+		eids := make([]int, 0, len(uu.removedPets))
+		for eid := range uu.removedPets {
+			eids = append(eids, eid)
+		}
+		query, args := sql.Update(user.PetsTable).
+			SetNull(user.PetsColumn).
+			Where(sql.InInts(user.PetsColumn, ids...)).
+			Where(sql.InInts(pet.FieldID, eids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if len(uu.pets) > 0 {
+		for _, id := range ids {
+			p := sql.P()
+			for eid := range uu.pets {
+				p.Or().EQ(pet.FieldID, eid)
+			}
+			query, args := sql.Update(user.PetsTable).
+				Set(user.PetsColumn, id).
+				Where(sql.And(p, sql.IsNull(user.PetsColumn))).
+				Query()
+			if err := tx.Exec(ctx, query, args, &res); err != nil {
+				return nil, rollback(tx, err)
+			}
+			affected, err := res.RowsAffected()
+			if err != nil {
+				return nil, rollback(tx, err)
+			}
+			if int(affected) < len(uu.pets) {
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uu.pets))})
+			}
 		}
 	}
+	if uu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(user.Columns...).
+			From(sql.Table(user.Table)).
+			Where(sql.InInts(user.FieldID, ids...)).
+			SetDialect(uu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var us Users
+		err := us.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("entv2: failed scanning rows into User: %v", err))
+		}
+		us.config(uu.config)
+		*uu.returning = us
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	id            int
-	age           *int
-	addage        *int
-	name          *string
-	phone         *string
-	buffer        *[]byte
-	title         *string
-	new_name      *string
-	clearnew_name bool
-	blob          *[]byte
-	clearblob     bool
-	state         *user.State
-	clearstate    bool
+	id     int
+	entity *User
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.User
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uuo.userMutation, op: ent.OpUpdateOne, id: &uuo.id}
 }
 
 // SetAge sets the age field.
@@ -342,6 +511,27 @@ func (uuo *UserUpdateOne) ClearNewName() *UserUpdateOne {
 	return uuo
 }
 
+// SetDisplayName sets the display_name field.
+func (uuo *UserUpdateOne) SetDisplayName(s string) *UserUpdateOne {
+	uuo.display_name = &s
+	return uuo
+}
+
+// SetNillableDisplayName sets the display_name field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDisplayName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetDisplayName(*s)
+	}
+	return uuo
+}
+
+// ClearDisplayName clears the value of display_name.
+func (uuo *UserUpdateOne) ClearDisplayName() *UserUpdateOne {
+	uuo.display_name = nil
+	uuo.cleardisplay_name = true
+	return uuo
+}
+
 // SetBlob sets the blob field.
 func (uuo *UserUpdateOne) SetBlob(b []byte) *UserUpdateOne {
 	uuo.blob = &b
@@ -376,19 +566,112 @@ func (uuo *UserUpdateOne) ClearState() *UserUpdateOne {
 	return uuo
 }
 
+// AddPetIDs adds the pets edge to Pet by ids.
+func (uuo *UserUpdateOne) AddPetIDs(ids ...int) *UserUpdateOne {
+	if uuo.pets == nil {
+		uuo.pets = make(map[int]struct{})
+	}
+	for i := range ids {
+		uuo.pets[ids[i]] = struct{}{}
+	}
+	return uuo
+}
+
+// AddPets adds the pets edges to Pet.
+func (uuo *UserUpdateOne) AddPets(p ...*Pet) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPetIDs(ids...)
+}
+
+// ClearPets clears all pets edges to Pet.
+func (uuo *UserUpdateOne) ClearPets() *UserUpdateOne {
+	uuo.clearedPets = true
+	return uuo
+}
+
+// RemovePetIDs removes the pets edge to Pet by ids.
+func (uuo *UserUpdateOne) RemovePetIDs(ids ...int) *UserUpdateOne {
+	if uuo.removedPets == nil {
+		uuo.removedPets = make(map[int]struct{})
+	}
+	for i := range ids {
+		uuo.removedPets[ids[i]] = struct{}{}
+	}
+	return uuo
+}
+
+// RemovePets removes pets edges to Pet.
+func (uuo *UserUpdateOne) RemovePets(p ...*Pet) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePetIDs(ids...)
+}
+
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (uuo *UserUpdateOne) SetChangedFrom(original *User) *UserUpdateOne {
+	u := uuo.entity
+	if u == nil || original == nil {
+		return uuo
+	}
+	for _, change := range DiffUser(original, u) {
+		switch change.Field {
+		case user.FieldAge:
+			uuo.SetAge(u.Age)
+		case user.FieldName:
+			uuo.SetName(u.Name)
+		case user.FieldPhone:
+			uuo.SetPhone(u.Phone)
+		case user.FieldBuffer:
+			uuo.SetBuffer(u.Buffer)
+		case user.FieldTitle:
+			uuo.SetTitle(u.Title)
+		case user.FieldNewName:
+			uuo.SetNewName(u.NewName)
+		case user.FieldDisplayName:
+			uuo.SetDisplayName(u.DisplayName)
+		case user.FieldBlob:
+			uuo.SetBlob(u.Blob)
+		case user.FieldState:
+			uuo.SetState(u.State)
+		}
+	}
+	return uuo
+}
+
 // Save executes the query and returns the updated entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+func (uuo *UserUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
 	if uuo.state != nil {
 		if err := user.StateValidator(*uuo.state); err != nil {
 			return nil, fmt.Errorf("entv2: validator failed for field \"state\": %v", err)
 		}
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uuo
+		intercepted.predicates = append(predicates, uuo.predicates...)
+		uuo = &intercepted
+	}
 	return uuo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	u, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	u, err := uuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -396,21 +679,28 @@ func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
 }
 
 // Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
+func (uuo *UserUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
-	selector := sql.Select(user.Columns...).From(sql.Table(user.Table))
+	selector := sql.Select(user.Columns...).From(sql.Table(user.Table)).SetDialect(uuo.driver.Dialect())
 	user.ID(uuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range uuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -475,6 +765,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		u.NewName = value
 		builder.SetNull(user.FieldNewName)
 	}
+	if value := uuo.display_name; value != nil {
+		builder.Set(user.FieldDisplayName, *value)
+		u.DisplayName = *value
+	}
+	if uuo.cleardisplay_name {
+		var value string
+		u.DisplayName = value
+		builder.SetNull(user.FieldDisplayName)
+	}
 	if value := uuo.blob; value != nil {
 		builder.Set(user.FieldBlob, *value)
 		u.Blob = *value
@@ -499,6 +798,50 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
+	if uuo.clearedPets {
+		query, args := sql.Update(user.PetsTable).
+			SetNull(user.PetsColumn).
+			Where(sql.InInts(user.PetsColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uuo.removedPets) > 0 {
+		eids := make([]int, 0, len(uuo.removedPets))
+		for eid := range uuo.removedPets {
+			eids = append(eids, eid)
+		}
+		query, args := sql.Update(user.PetsTable).
+			SetNull(user.PetsColumn).
+			Where(sql.InInts(user.PetsColumn, ids...)).
+			Where(sql.InInts(pet.FieldID, eids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if len(uuo.pets) > 0 {
+		for _, id := range ids {
+			p := sql.P()
+			for eid := range uuo.pets {
+				p.Or().EQ(pet.FieldID, eid)
+			}
+			query, args := sql.Update(user.PetsTable).
+				Set(user.PetsColumn, id).
+				Where(sql.And(p, sql.IsNull(user.PetsColumn))).
+				Query()
+			if err := tx.Exec(ctx, query, args, &res); err != nil {
+				return nil, rollback(tx, err)
+			}
+			affected, err := res.RowsAffected()
+			if err != nil {
+				return nil, rollback(tx, err)
+			}
+			if int(affected) < len(uuo.pets) {
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uuo.pets))})
+			}
+		}
+	}
 	if err = tx.Commit(); err != nil {
 		return nil, err
 	}
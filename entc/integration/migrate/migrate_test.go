@@ -7,6 +7,7 @@ package migrate
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -40,24 +41,34 @@ func TestMySQL(t *testing.T) {
 			require.NoError(t, clientv1.Schema.Create(ctx, migratev1.WithGlobalUniqueID(true)))
 			SanityV1(t, clientv1)
 
-			// run migration and execute queries on v2.
+			// run the expand and the contract phases of the migration, and execute queries on v2.
 			clientv2 := entv2.NewClient(entv2.Driver(drv))
-			require.NoError(t, clientv2.Schema.Create(ctx, migratev2.WithGlobalUniqueID(true), migratev2.WithDropIndex(true), migratev2.WithDropColumn(true)))
+			require.NoError(t, clientv2.Schema.Create(ctx, migratev2.WithGlobalUniqueID(true), migratev2.WithMode(migratev2.ModeExpand)))
+			require.NoError(t, clientv2.Ready(ctx))
+			require.NoError(t, clientv1.Ready(ctx))
+			require.NoError(t, clientv2.Schema.Create(ctx, migratev2.WithGlobalUniqueID(true), migratev2.WithMode(migratev2.ModeContract)))
+			require.NoError(t, clientv2.Ready(ctx))
+			require.True(t, entv1.IsSchemaVersion(clientv1.Ready(ctx)))
 			SanityV2(t, clientv2)
 
 			// since "users" created in the migration of v1, it will occupy the range of 0 ... 1<<32-1,
 			// even though they are ordered differently in the migration of v2 (groups, pets, users).
-			idRange(t, clientv2.User.Create().SetAge(1).SetName("foo").SetPhone("phone").SaveX(ctx).ID, 0, 1<<32)
+			u := clientv2.User.Create().SetAge(1).SetName("foo").SetPhone("phone").SaveX(ctx)
+			idRange(t, u.ID, 0, 1<<32)
 			idRange(t, clientv2.Group.Create().SaveX(ctx).ID, 1<<32-1, 2<<32)
-			idRange(t, clientv2.Pet.Create().SaveX(ctx).ID, 2<<32-1, 3<<32)
+			idRange(t, clientv2.Pet.Create().SetOwner(u).SaveX(ctx).ID, 2<<32-1, 3<<32)
 
 			// sql specific predicates.
 			EqualFold(t, clientv2)
 			ContainsFold(t, clientv2)
+			RequiredEdge(t, clientv2)
 
 			// "renamed" field was renamed to "new_name".
 			exist := clientv2.User.Query().Where(user.NewName("renamed")).ExistX(ctx)
 			require.True(t, exist, "expect renamed column to have previous values")
+			// "nickname" column was renamed to "display_name".
+			exist = clientv2.User.Query().Where(user.DisplayName("a8m")).ExistX(ctx)
+			require.True(t, exist, "expect renamed column to keep its values")
 		})
 	}
 }
@@ -73,8 +84,9 @@ func TestSQLite(t *testing.T) {
 
 	SanityV2(t, client)
 	idRange(t, client.Group.Create().SaveX(ctx).ID, 0, 1<<32)
-	idRange(t, client.Pet.Create().SaveX(ctx).ID, 1<<32-1, 2<<32)
-	idRange(t, client.User.Create().SetAge(1).SetName("x").SetPhone("y").SaveX(ctx).ID, 2<<32, 3<<32-1)
+	u := client.User.Create().SetAge(1).SetName("x").SetPhone("y").SaveX(ctx)
+	idRange(t, client.Pet.Create().SetOwner(u).SaveX(ctx).ID, 1<<32-1, 2<<32)
+	idRange(t, u.ID, 2<<32, 3<<32-1)
 
 	// override the default behavior of LIKE in SQLite.
 	// https://www.sqlite.org/pragma.html#pragma_case_sensitive_like
@@ -82,11 +94,39 @@ func TestSQLite(t *testing.T) {
 	require.NoError(t, err)
 	EqualFold(t, client)
 	ContainsFold(t, client)
+	RequiredEdge(t, client)
+}
+
+func TestSQLite_BlueGreen(t *testing.T) {
+	const dsn = "file:bluegreen?mode=memory&cache=shared&_fk=1"
+	drv, err := sql.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	defer drv.Close()
+
+	ctx := context.Background()
+	clientv1 := entv1.NewClient(entv1.Driver(drv))
+	require.NoError(t, clientv1.Schema.Create(ctx))
+	require.NoError(t, clientv1.Ready(ctx))
+	clientv2 := entv2.NewClient(entv2.Driver(drv))
+	require.True(t, entv2.IsSchemaVersion(clientv2.Ready(ctx)), "v2 is not ready before the expand phase")
+
+	// both versions are served while the schema is in its expanded state.
+	require.NoError(t, clientv2.Schema.Create(ctx, migratev2.WithMode(migratev2.ModeExpand)))
+	require.NoError(t, clientv2.Ready(ctx))
+	require.NoError(t, clientv1.Ready(ctx))
+	verified, err := entv2.Open("sqlite3", dsn, entv2.VerifySchema(true))
+	require.NoError(t, err)
+	require.NoError(t, verified.Close())
+
+	// only v2 is served after the contract phase.
+	require.NoError(t, clientv2.Schema.Create(ctx, migratev2.WithMode(migratev2.ModeContract)))
+	require.NoError(t, clientv2.Ready(ctx))
+	require.True(t, entv1.IsSchemaVersion(clientv1.Ready(ctx)), "v1 is not ready after the contract phase")
 }
 
 func SanityV1(t *testing.T, client *entv1.Client) {
 	ctx := context.Background()
-	u := client.User.Create().SetAge(1).SetName("foo").SetRenamed("renamed").SaveX(ctx)
+	u := client.User.Create().SetAge(1).SetName("foo").SetRenamed("renamed").SetNickname("a8m").SaveX(ctx)
 	require.EqualValues(t, 1, u.Age)
 	require.Equal(t, "foo", u.Name)
 
@@ -162,6 +202,21 @@ func ContainsFold(t *testing.T, client *entv2.Client) {
 	require.Equal(t, 1, client.User.Query().Where(user.NameContainsFold("Raki")).CountX(ctx))
 }
 
+// RequiredEdge checks that the foreign-keys of required edges are created
+// without the SET NULL action, since their columns are not nullable.
+func RequiredEdge(t *testing.T, client *entv2.Client) {
+	ctx := context.Background()
+	t.Log("deleting the referenced row of a required edge")
+	u := client.User.Create().SetAge(1).SetName("owner").SetPhone("0000").SaveX(ctx)
+	p := client.Pet.Create().SetOwner(u).SaveX(ctx)
+	err := client.User.DeleteOne(u).Exec(ctx)
+	require.Error(t, err, "the pet references the user")
+	require.Contains(t, strings.ToLower(err.Error()), "foreign key", "the reference is restricted, and not set to null")
+	require.Equal(t, u.ID, p.QueryOwner().OnlyXID(ctx))
+	client.Pet.DeleteOne(p).ExecX(ctx)
+	client.User.DeleteOne(u).ExecX(ctx)
+}
+
 func idRange(t *testing.T, id, l, h int) {
 	require.Truef(t, id > l && id < h, "id %s should be between %d to %d", id, l, h)
 }
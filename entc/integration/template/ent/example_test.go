@@ -9,9 +9,10 @@ package ent
 import (
 	"context"
 	"log"
-	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+
+	"time"
 )
 
 // dsn for the database. In order to run the tests locally, run the following command:
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/template/ent/group"
 )
@@ -17,7 +18,12 @@ import (
 // GroupCreate is the builder for creating a Group entity.
 type GroupCreate struct {
 	config
-	max_users *int
+	groupMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (gc *GroupCreate) Mutation() *GroupMutation {
+	return &GroupMutation{groupMutation: &gc.groupMutation, op: ent.OpCreate}
 }
 
 // SetMaxUsers sets the max_users field.
@@ -27,16 +33,20 @@ func (gc *GroupCreate) SetMaxUsers(i int) *GroupCreate {
 }
 
 // Save creates the Group in the database.
-func (gc *GroupCreate) Save(ctx context.Context) (*Group, error) {
+func (gc *GroupCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Group, error) {
+	options := ent.NewCallOptions(opts...)
 	if gc.max_users == nil {
 		return nil, errors.New("ent: missing required field \"max_users\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return gc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (gc *GroupCreate) SaveX(ctx context.Context) *Group {
-	v, err := gc.Save(ctx)
+func (gc *GroupCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Group {
+	v, err := gc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
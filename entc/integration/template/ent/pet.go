@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/template/ent/pet"
 )
 
 // Pet is the model entity for the Pet schema.
@@ -23,21 +24,44 @@ type Pet struct {
 	Age int `json:"age,omitempty"`
 	// LicensedAt holds the value of the "licensed_at" field.
 	LicensedAt *time.Time `json:"licensed_at,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the PetQuery when eager-loading is set.
+	Edges PetEdges `json:"edges"`
+}
+
+// PetEdges holds the relations/edges of other nodes in the graph.
+type PetEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
 }
 
 // FromRows scans the sql response data into Pet.
 func (pe *Pet) FromRows(rows *sql.Rows) error {
+	return pe.scan(rows, pet.Columns)
+}
+
+// scan scans the given columns of the sql response data into Pet.
+// Fields that their columns were not selected are left with their zero values.
+func (pe *Pet) scan(rows *sql.Rows, columns []string) error {
 	var vpe struct {
 		ID         int
 		Age        sql.NullInt64
 		LicensedAt sql.NullTime
 	}
-	// the order here should be the same as in the `pet.Columns`.
-	if err := rows.Scan(
-		&vpe.ID,
-		&vpe.Age,
-		&vpe.LicensedAt,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pet.FieldID:
+			values[i] = &vpe.ID
+		case pet.FieldAge:
+			values[i] = &vpe.Age
+		case pet.FieldLicensedAt:
+			values[i] = &vpe.LicensedAt
+		default:
+			return fmt.Errorf("unexpected column %q for type Pet", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	pe.ID = vpe.ID
@@ -72,6 +96,34 @@ func (pe *Pet) Unwrap() *Pet {
 	return pe
 }
 
+// Clone returns a deep copy of the Pet, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (pe *Pet) Clone() *Pet {
+	return pe.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Pet, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (pe *Pet) clone(seen map[interface{}]interface{}) *Pet {
+	if pe == nil {
+		return nil
+	}
+	if _c, ok := seen[pe]; ok {
+		return _c.(*Pet)
+	}
+	_c := *pe
+	_c.config = config{}
+	seen[pe] = &_c
+	if v := pe.LicensedAt; v != nil {
+		_v := *v
+		_c.LicensedAt = &_v
+	}
+	_c.Edges.Owner = pe.Edges.Owner.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (pe *Pet) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -85,14 +137,33 @@ func (pe *Pet) String() string {
 	return buf.String()
 }
 
+// DiffPet returns the fields that have different values in the given Pets,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffPet(a, b *Pet) []FieldChange {
+	var changes []FieldChange
+	if a.Age != b.Age {
+		changes = append(changes, FieldChange{Field: pet.FieldAge, Old: a.Age, New: b.Age})
+	}
+	if (a.LicensedAt == nil) != (b.LicensedAt == nil) || a.LicensedAt != nil && !(*a.LicensedAt).Equal((*b.LicensedAt)) {
+		changes = append(changes, FieldChange{Field: pet.FieldLicensedAt, Old: a.LicensedAt, New: b.LicensedAt})
+	}
+	return changes
+}
+
 // Pets is a parsable slice of Pet.
 type Pets []*Pet
 
 // FromRows scans the sql response data into Pets.
 func (pe *Pets) FromRows(rows *sql.Rows) error {
+	return pe.scan(rows, pet.Columns)
+}
+
+// scan scans the given columns of the sql response data into Pets.
+func (pe *Pets) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vpe := &Pet{}
-		if err := vpe.FromRows(rows); err != nil {
+		if err := vpe.scan(rows, columns); err != nil {
 			return err
 		}
 		*pe = append(*pe, vpe)
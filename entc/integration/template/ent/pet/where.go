@@ -7,6 +7,10 @@
 package pet
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -114,6 +118,36 @@ func IDLTE(id int) predicate.Pet {
 	)
 }
 
+// IDInQuery applies the In predicate on the ID field, with the ids of the given query as a subquery.
+// It's used for filtering by the results of another query, without fetching its ids first. For example:
+//
+//	client.Pet.Query().Where(pet.IDInQuery(client.Pet.Query().Where(...)))
+//
+// The interceptors of the client are applied on the subquery with the context of the query, and
+// their errors fail the query.
+func IDInQuery(q predicate.Subquery) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		t, err := q.IDsSubquery(s.Context())
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(FieldID), t))
+	})
+}
+
+// IDNotInQuery applies the NotIn predicate on the ID field, with the ids of the given query as a subquery.
+func IDNotInQuery(q predicate.Subquery) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		t, err := q.IDsSubquery(s.Context())
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldID), t))
+	})
+}
+
 // Age applies equality check predicate on the "age" field. It's identical to AgeEQ.
 func Age(v int) predicate.Pet {
 	return predicate.Pet(
@@ -334,6 +368,22 @@ func LicensedAtNotNil() predicate.Pet {
 	)
 }
 
+// LicensedAtBetween applies the Between predicate on the "licensed_at" field.
+// The range is inclusive, and it's identical to LicensedAtGTE(a) and LicensedAtLTE(b).
+func LicensedAtBetween(a, b time.Time) predicate.Pet {
+	return predicate.Pet(
+		func(s *sql.Selector) {
+			s.Where(sql.Between(s.C(FieldLicensedAt), a, b))
+		},
+	)
+}
+
+// LicensedAtWithinLast applies a predicate on the "licensed_at" field, that checks if its value
+// is within the last d duration. It's identical to LicensedAtGTE(time.Now().Add(-d)).
+func LicensedAtWithinLast(d time.Duration) predicate.Pet {
+	return LicensedAtGTE(time.Now().Add(-d))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Pet {
 	return predicate.Pet(
@@ -344,20 +394,200 @@ func HasOwner() predicate.Pet {
 	)
 }
 
+// HasNoOwner applies a predicate that checks that the "owner" edge is empty. It's identical
+// to Not(HasOwner()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the Pet nodes.
+func HasNoOwner() predicate.Pet {
+	return predicate.Pet(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(sql.IsNull(t1.C(OwnerColumn)))
+		},
+	)
+}
+
 // HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
 func HasOwnerWith(preds ...predicate.User) predicate.Pet {
 	return predicate.Pet(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(FieldID).From(sql.Table(OwnerInverseTable))
+			t2 := sql.Select(FieldID).From(sql.Table(OwnerInverseTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(OwnerColumn), t2))
 		},
 	)
 }
 
+// PredicateFunc returns a predicate from the given function.
+// It allows adding custom SQL fragments to the Pet builders.
+func PredicateFunc(f func(*sql.Selector)) predicate.Pet {
+	return predicate.Pet(f)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.Pet, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.Pet, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.Pet, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldAge:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return AgeIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return AgeNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return AgeLTE(v), nil
+			}
+		}
+	case FieldLicensedAt:
+		switch op {
+		case "eq":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return LicensedAtEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return LicensedAtNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]time.Time); ok || convert(value, &vs) {
+				return LicensedAtIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]time.Time); ok || convert(value, &vs) {
+				return LicensedAtNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return LicensedAtGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return LicensedAtGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return LicensedAtLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(time.Time); ok || convert(value, &v) {
+				return LicensedAtLTE(v), nil
+			}
+		case "isnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return LicensedAtIsNil(), nil
+				}
+				return Not(LicensedAtIsNil()), nil
+			}
+		case "notnil":
+			if v, ok := value.(bool); ok {
+				if v {
+					return LicensedAtNotNil(), nil
+				}
+				return Not(LicensedAtNotNil()), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("pet: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Pet) predicate.Pet {
 	return predicate.Pet(
@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/template/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/template/ent/predicate"
@@ -27,13 +28,26 @@ func (pd *PetDelete) Where(ps ...predicate.Pet) *PetDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (pd *PetDelete) Exec(ctx context.Context) (int, error) {
+func (pd *PetDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	predicates, err := interceptPet(ctx, pd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *pd
+		intercepted.predicates = append(predicates, pd.predicates...)
+		pd = &intercepted
+	}
 	return pd.sqlExec(ctx)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pd *PetDelete) ExecX(ctx context.Context) int {
-	n, err := pd.Exec(ctx)
+func (pd *PetDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := pd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -42,10 +56,13 @@ func (pd *PetDelete) ExecX(ctx context.Context) int {
 
 func (pd *PetDelete) sqlExec(ctx context.Context) (int, error) {
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(pet.Table))
+	selector := sql.Select().From(sql.Table(pet.Table)).SetDialect(pd.driver.Dialect()).WithContext(ctx)
 	for _, p := range pd.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(pet.Table).FromSelect(selector).Query()
 	if err := pd.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -63,12 +80,12 @@ type PetDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (pdo *PetDeleteOne) Exec(ctx context.Context) error {
-	n, err := pdo.pd.Exec(ctx)
+func (pdo *PetDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := pdo.pd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{pet.Label}
 	default:
 		return nil
@@ -76,6 +93,8 @@ func (pdo *PetDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pdo *PetDeleteOne) ExecX(ctx context.Context) {
-	pdo.pd.ExecX(ctx)
+func (pdo *PetDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := pdo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/template/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/template/ent/user"
@@ -19,9 +20,12 @@ import (
 // UserCreate is the builder for creating a User entity.
 type UserCreate struct {
 	config
-	name    *string
-	pets    map[int]struct{}
-	friends map[int]struct{}
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uc *UserCreate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uc.userMutation, op: ent.OpCreate}
 }
 
 // SetName sets the name field.
@@ -71,16 +75,20 @@ func (uc *UserCreate) AddFriends(u ...*User) *UserCreate {
 }
 
 // Save creates the User in the database.
-func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+func (uc *UserCreate) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
 	if uc.name == nil {
 		return nil, errors.New("ent: missing required field \"name\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return uc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (uc *UserCreate) SaveX(ctx context.Context) *User {
-	v, err := uc.Save(ctx)
+func (uc *UserCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	v, err := uc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -127,20 +135,20 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(uc.pets) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uc.pets))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uc.pets))})
 		}
 	}
 	if len(uc.friends) > 0 {
-		for eid := range uc.friends {
 
-			query, args := sql.Insert(user.FriendsTable).
-				Columns(user.FriendsPrimaryKey[0], user.FriendsPrimaryKey[1]).
-				Values(id, eid).
-				Values(eid, id).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+		builder := sql.Insert(user.FriendsTable).
+			Columns(user.FriendsPrimaryKey[0], user.FriendsPrimaryKey[1])
+		for eid := range uc.friends {
+			builder.Values(id, eid)
+			builder.Values(eid, id)
+		}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if err := tx.Commit(); err != nil {
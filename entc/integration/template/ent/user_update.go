@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/template/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/template/ent/predicate"
@@ -19,12 +20,11 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	name           *string
-	pets           map[int]struct{}
-	friends        map[int]struct{}
-	removedPets    map[int]struct{}
-	removedFriends map[int]struct{}
-	predicates     []predicate.User
+	userMutation
+	predicates []predicate.User
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*User
 }
 
 // Where adds a new predicate for the builder.
@@ -33,6 +33,11 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Mutation returns the mutation of the builder.
+func (uu *UserUpdate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uu.userMutation, op: ent.OpUpdate}
+}
+
 // SetName sets the name field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.name = &s
@@ -79,6 +84,12 @@ func (uu *UserUpdate) AddFriends(u ...*User) *UserUpdate {
 	return uu.AddFriendIDs(ids...)
 }
 
+// ClearPets clears all pets edges to Pet.
+func (uu *UserUpdate) ClearPets() *UserUpdate {
+	uu.clearedPets = true
+	return uu
+}
+
 // RemovePetIDs removes the pets edge to Pet by ids.
 func (uu *UserUpdate) RemovePetIDs(ids ...int) *UserUpdate {
 	if uu.removedPets == nil {
@@ -99,6 +110,12 @@ func (uu *UserUpdate) RemovePets(p ...*Pet) *UserUpdate {
 	return uu.RemovePetIDs(ids...)
 }
 
+// ClearFriends clears all friends edges to User.
+func (uu *UserUpdate) ClearFriends() *UserUpdate {
+	uu.clearedFriends = true
+	return uu
+}
+
 // RemoveFriendIDs removes the friends edge to User by ids.
 func (uu *UserUpdate) RemoveFriendIDs(ids ...int) *UserUpdate {
 	if uu.removedFriends == nil {
@@ -120,13 +137,46 @@ func (uu *UserUpdate) RemoveFriends(u ...*User) *UserUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+func (uu *UserUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := uu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (uu *UserUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uu
+		intercepted.predicates = append(predicates, uu.predicates...)
+		uu = &intercepted
+	}
 	return uu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (uu *UserUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*User, error) {
+	var us []*User
+	uu.returning = &us
+	defer func() { uu.returning = nil }()
+	if _, err := uu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return us, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
+func (uu *UserUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := uu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -134,44 +184,48 @@ func (uu *UserUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
+func (uu *UserUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
+func (uu *UserUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(user.FieldID).From(sql.Table(user.Table))
+func (uu *UserUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(user.FieldID).From(sql.Table(user.Table)).SetDialect(uu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range uu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := uu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -183,11 +237,19 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if len(uu.removedPets) > 0 {
-		eids := make([]int, len(uu.removedPets))
+	if uu.clearedPets {
+		query, args := sql.Update(user.PetsTable).
+			SetNull(user.PetsColumn).
+			Where(sql.InInts(user.PetsColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uu.removedPets) > 0 {
+		eids := make([]int, 0, len(uu.removedPets))
 		for eid := range uu.removedPets {
 			eids = append(eids, eid)
 		}
@@ -197,7 +259,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(pet.FieldID, eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.pets) > 0 {
@@ -211,35 +273,37 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.And(p, sql.IsNull(user.PetsColumn))).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uu.pets) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uu.pets))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uu.pets))})
 			}
 		}
 	}
-	if len(uu.removedFriends) > 0 {
-		eids := make([]int, len(uu.removedFriends))
-		for eid := range uu.removedFriends {
-			eids = append(eids, eid)
-		}
+	if uu.clearedFriends {
 		query, args := sql.Delete(user.FriendsTable).
-			Where(sql.InInts(user.FriendsPrimaryKey[0], ids...)).
-			Where(sql.InInts(user.FriendsPrimaryKey[1], eids...)).
+			Where(sql.Or(sql.InInts(user.FriendsPrimaryKey[0], ids...), sql.InInts(user.FriendsPrimaryKey[1], ids...))).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
-		query, args = sql.Delete(user.FriendsTable).
-			Where(sql.InInts(user.FriendsPrimaryKey[1], ids...)).
-			Where(sql.InInts(user.FriendsPrimaryKey[0], eids...)).
+	} else if len(uu.removedFriends) > 0 {
+		eids := make([]int, 0, len(uu.removedFriends))
+		for eid := range uu.removedFriends {
+			eids = append(eids, eid)
+		}
+		query, args := sql.Delete(user.FriendsTable).
+			Where(sql.Or(
+				sql.And(sql.InInts(user.FriendsPrimaryKey[0], ids...), sql.InInts(user.FriendsPrimaryKey[1], eids...)),
+				sql.And(sql.InInts(user.FriendsPrimaryKey[1], ids...), sql.InInts(user.FriendsPrimaryKey[0], eids...)),
+			)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(uu.friends) > 0 {
@@ -256,24 +320,47 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
+		}
+	}
+	if uu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(user.Columns...).
+			From(sql.Table(user.Table)).
+			Where(sql.InInts(user.FieldID, ids...)).
+			SetDialect(uu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var us Users
+		err := us.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into User: %v", err))
 		}
+		us.config(uu.config)
+		*uu.returning = us
 	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	id             int
-	name           *string
-	pets           map[int]struct{}
-	friends        map[int]struct{}
-	removedPets    map[int]struct{}
-	removedFriends map[int]struct{}
+	id     int
+	entity *User
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.User
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uuo.userMutation, op: ent.OpUpdateOne, id: &uuo.id}
 }
 
 // SetName sets the name field.
@@ -322,6 +409,12 @@ func (uuo *UserUpdateOne) AddFriends(u ...*User) *UserUpdateOne {
 	return uuo.AddFriendIDs(ids...)
 }
 
+// ClearPets clears all pets edges to Pet.
+func (uuo *UserUpdateOne) ClearPets() *UserUpdateOne {
+	uuo.clearedPets = true
+	return uuo
+}
+
 // RemovePetIDs removes the pets edge to Pet by ids.
 func (uuo *UserUpdateOne) RemovePetIDs(ids ...int) *UserUpdateOne {
 	if uuo.removedPets == nil {
@@ -342,6 +435,12 @@ func (uuo *UserUpdateOne) RemovePets(p ...*Pet) *UserUpdateOne {
 	return uuo.RemovePetIDs(ids...)
 }
 
+// ClearFriends clears all friends edges to User.
+func (uuo *UserUpdateOne) ClearFriends() *UserUpdateOne {
+	uuo.clearedFriends = true
+	return uuo
+}
+
 // RemoveFriendIDs removes the friends edge to User by ids.
 func (uuo *UserUpdateOne) RemoveFriendIDs(ids ...int) *UserUpdateOne {
 	if uuo.removedFriends == nil {
@@ -362,14 +461,45 @@ func (uuo *UserUpdateOne) RemoveFriends(u ...*User) *UserUpdateOne {
 	return uuo.RemoveFriendIDs(ids...)
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (uuo *UserUpdateOne) SetChangedFrom(original *User) *UserUpdateOne {
+	u := uuo.entity
+	if u == nil || original == nil {
+		return uuo
+	}
+	for _, change := range DiffUser(original, u) {
+		switch change.Field {
+		case user.FieldName:
+			uuo.SetName(u.Name)
+		}
+	}
+	return uuo
+}
+
 // Save executes the query and returns the updated entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+func (uuo *UserUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptUser(ctx, uuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *uuo
+		intercepted.predicates = append(predicates, uuo.predicates...)
+		uuo = &intercepted
+	}
 	return uuo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	u, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	u, err := uuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -377,21 +507,28 @@ func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
 }
 
 // Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
+func (uuo *UserUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := uuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
+func (uuo *UserUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := uuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
-	selector := sql.Select(user.Columns...).From(sql.Table(user.Table))
+	selector := sql.Select(user.Columns...).From(sql.Table(user.Table)).SetDialect(uuo.driver.Dialect())
 	user.ID(uuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range uuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = uuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -433,8 +570,16 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if len(uuo.removedPets) > 0 {
-		eids := make([]int, len(uuo.removedPets))
+	if uuo.clearedPets {
+		query, args := sql.Update(user.PetsTable).
+			SetNull(user.PetsColumn).
+			Where(sql.InInts(user.PetsColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(uuo.removedPets) > 0 {
+		eids := make([]int, 0, len(uuo.removedPets))
 		for eid := range uuo.removedPets {
 			eids = append(eids, eid)
 		}
@@ -465,25 +610,27 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(uuo.pets) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uuo.pets))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"pets\" %v already connected to a different \"User\"", keys(uuo.pets))})
 			}
 		}
 	}
-	if len(uuo.removedFriends) > 0 {
-		eids := make([]int, len(uuo.removedFriends))
-		for eid := range uuo.removedFriends {
-			eids = append(eids, eid)
-		}
+	if uuo.clearedFriends {
 		query, args := sql.Delete(user.FriendsTable).
-			Where(sql.InInts(user.FriendsPrimaryKey[0], ids...)).
-			Where(sql.InInts(user.FriendsPrimaryKey[1], eids...)).
+			Where(sql.Or(sql.InInts(user.FriendsPrimaryKey[0], ids...), sql.InInts(user.FriendsPrimaryKey[1], ids...))).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
 			return nil, rollback(tx, err)
 		}
-		query, args = sql.Delete(user.FriendsTable).
-			Where(sql.InInts(user.FriendsPrimaryKey[1], ids...)).
-			Where(sql.InInts(user.FriendsPrimaryKey[0], eids...)).
+	} else if len(uuo.removedFriends) > 0 {
+		eids := make([]int, 0, len(uuo.removedFriends))
+		for eid := range uuo.removedFriends {
+			eids = append(eids, eid)
+		}
+		query, args := sql.Delete(user.FriendsTable).
+			Where(sql.Or(
+				sql.And(sql.InInts(user.FriendsPrimaryKey[0], ids...), sql.InInts(user.FriendsPrimaryKey[1], eids...)),
+				sql.And(sql.InInts(user.FriendsPrimaryKey[1], ids...), sql.InInts(user.FriendsPrimaryKey[0], eids...)),
+			)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
 			return nil, rollback(tx, err)
@@ -7,10 +7,16 @@ package integration
 import (
 	"context"
 	"math"
+	"net"
+	"net/url"
 	"testing"
 
+	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/entc/integration/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/fieldtype"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -82,3 +88,175 @@ func Types(t *testing.T, client *ent.Client) {
 	require.Equal(int32(math.MaxInt32), *ft.NillableInt32)
 	require.Equal(int64(math.MaxInt64), *ft.NillableInt64)
 }
+
+func GoTypes(t *testing.T, client *ent.Client) {
+	if client.Dialect() == dialect.Gremlin {
+		t.Skip("custom Go types are supported only by the SQL dialects")
+	}
+	ctx := context.Background()
+	require := require.New(t)
+
+	link, err := url.Parse("https://github.com/facebookincubator/ent")
+	require.NoError(err)
+	ft := client.FieldType.Create().
+		SetInt(1).
+		SetInt8(8).
+		SetInt16(16).
+		SetInt32(32).
+		SetInt64(64).
+		SetLink(schema.Link{URL: link}).
+		SetPriority(schema.Priority(2)).
+		SaveX(ctx)
+	ft = client.FieldType.GetX(ctx, ft.ID)
+	require.Equal(link.String(), ft.Link.String())
+	require.Equal(schema.Priority(2), ft.Priority)
+	require.Nil(ft.NullLink)
+
+	exist := client.FieldType.Query().Where(fieldtype.Link(schema.Link{URL: link})).ExistX(ctx)
+	require.True(exist)
+	exist = client.FieldType.Query().Where(fieldtype.PriorityGT(schema.Priority(2))).ExistX(ctx)
+	require.False(exist)
+
+	ft = client.FieldType.UpdateOne(ft).
+		SetNullLink(schema.Link{URL: link}).
+		SetPriority(schema.Priority(3)).
+		ClearLink().
+		SaveX(ctx)
+	ft = client.FieldType.GetX(ctx, ft.ID)
+	require.Nil(ft.Link.URL)
+	require.NotNil(ft.NullLink)
+	require.Equal(link.String(), ft.NullLink.String())
+	require.Equal(schema.Priority(3), ft.Priority)
+
+	t.Log("enum with a custom Go type")
+	ft = client.FieldType.UpdateOne(ft).SetRole(schema.RoleAdmin).SaveX(ctx)
+	require.Equal(schema.RoleAdmin, client.FieldType.GetX(ctx, ft.ID).Role)
+	require.True(client.FieldType.Query().Where(fieldtype.Role(schema.RoleAdmin)).ExistX(ctx))
+	require.False(client.FieldType.Query().Where(fieldtype.RoleNEQ(schema.RoleAdmin)).ExistX(ctx))
+	_, err = client.FieldType.UpdateOne(ft).SetRole(schema.Role(10)).Save(ctx)
+	require.EqualError(err, `ent: validator failed for field "role": fieldtype: invalid enum value for role field: "Role(10)"`)
+}
+
+func NillableStorage(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	ft := client.FieldType.Create().
+		SetInt(1).
+		SetInt8(8).
+		SetInt16(16).
+		SetInt32(32).
+		SetInt64(64).
+		SaveX(ctx)
+	require.Nil(ft.NullableIntOrNull(), "unset field should be stored as NULL")
+	require.Equal("default", *ft.NullableStringOrNull(), "default value should be applied")
+	ft = client.FieldType.GetX(ctx, ft.ID)
+	require.Nil(ft.NullableIntOrNull())
+	require.Zero(ft.NullableInt)
+	require.Equal("default", *ft.NullableStringOrNull())
+
+	zero := 0
+	ft = client.FieldType.UpdateOne(ft).
+		SetNullableIntOrNull(&zero).
+		SetNullableStringOrNull(nil).
+		SaveX(ctx)
+	require.Equal(0, *ft.NullableIntOrNull(), "zero value should be distinguished from NULL")
+	require.Nil(ft.NullableStringOrNull())
+	ft = client.FieldType.GetX(ctx, ft.ID)
+	require.Equal(0, *ft.NullableIntOrNull())
+	require.Nil(ft.NullableStringOrNull())
+	require.Empty(ft.NullableString)
+
+	ft = client.FieldType.UpdateOne(ft).
+		ClearNullableInt().
+		SaveX(ctx)
+	require.Nil(ft.NullableIntOrNull())
+	require.Nil(client.FieldType.GetX(ctx, ft.ID).NullableIntOrNull())
+
+	ft = client.FieldType.Create().
+		SetInt(1).
+		SetInt8(8).
+		SetInt16(16).
+		SetInt32(32).
+		SetInt64(64).
+		SetNullableStringOrNull(nil).
+		SaveX(ctx)
+	require.Nil(ft.NullableStringOrNull(), "explicit NULL should skip the default value")
+	require.Nil(client.FieldType.GetX(ctx, ft.ID).NullableStringOrNull())
+	require.Equal(2, client.FieldType.Query().Where(fieldtype.NullableStringIsNil()).CountX(ctx))
+
+	stale := client.FieldType.UpdateOne(ft).SetNullableString("a8m").SaveX(ctx)
+	client.FieldType.UpdateOne(stale).SetNullableStringOrNull(nil).ExecX(ctx)
+	require.Equal("a8m", *stale.NullableStringOrNull())
+	client.FieldType.ReloadX(ctx, stale)
+	require.Nil(stale.NullableStringOrNull(), "reload should update the NULL state of the field")
+	client.FieldType.UpdateOne(stale).SetNullableString("").ExecX(ctx)
+	client.FieldType.ReloadX(ctx, stale)
+	require.Empty(*stale.NullableStringOrNull())
+}
+
+func AddrTypes(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	ip := net.ParseIP("2001:db8::68")
+	mac, err := net.ParseMAC("00:00:5e:00:53:01")
+	require.NoError(err)
+	ft := client.FieldType.Create().
+		SetInt(1).
+		SetInt8(8).
+		SetInt16(16).
+		SetInt32(32).
+		SetInt64(64).
+		SetIP(ip).
+		SetMac(mac).
+		SaveX(ctx)
+	require.NotEqual(uuid.Nil, ft.UUID, "default value should be applied")
+	ft = client.FieldType.GetX(ctx, ft.ID)
+	require.NotEqual(uuid.Nil, ft.UUID)
+	require.True(ip.Equal(ft.IP))
+	require.Equal(mac, *ft.Mac)
+
+	id := uuid.New()
+	ft2 := client.FieldType.Create().
+		SetInt(1).
+		SetInt8(8).
+		SetInt16(16).
+		SetInt32(32).
+		SetInt64(64).
+		SetUUID(id).
+		SetIP(net.ParseIP("127.0.0.1")).
+		SaveX(ctx)
+	ft2 = client.FieldType.GetX(ctx, ft2.ID)
+	require.Equal(id, ft2.UUID)
+	require.Equal("127.0.0.1", ft2.IP.String())
+	require.Nil(ft2.Mac)
+
+	require.Equal(ft2.ID, client.FieldType.Query().Where(fieldtype.UUID(id)).OnlyXID(ctx))
+	require.Equal(2, client.FieldType.Query().Where(fieldtype.UUIDIn(id, ft.UUID)).CountX(ctx))
+	require.Equal(ft.ID, client.FieldType.Query().Where(fieldtype.IP(ip)).OnlyXID(ctx))
+	require.Equal(ft2.ID, client.FieldType.Query().Where(fieldtype.IPNotIn(ip)).OnlyXID(ctx))
+	require.Equal(ft.ID, client.FieldType.Query().Where(fieldtype.MacEQ(mac)).OnlyXID(ctx))
+	require.Equal(ft2.ID, client.FieldType.Query().Where(fieldtype.MacIsNil()).OnlyXID(ctx))
+
+	ft2 = client.FieldType.UpdateOne(ft2).
+		SetIP(ip).
+		SetMac(mac).
+		SaveX(ctx)
+	require.True(ip.Equal(ft2.IP))
+	require.Equal(2, client.FieldType.Query().Where(fieldtype.IPIn(ip), fieldtype.Mac(mac)).CountX(ctx))
+
+	ft2 = client.FieldType.UpdateOne(ft2).
+		SetIP(nil).
+		SetMac(nil).
+		SaveX(ctx)
+	require.Nil(ft2.IP)
+	ft2 = client.FieldType.GetX(ctx, ft2.ID)
+	require.Nil(ft2.IP, "nil addresses are stored as NULL")
+	require.Nil(ft2.Mac)
+	require.Equal(ft2.ID, client.FieldType.Query().Where(fieldtype.IPIsNil(), fieldtype.MacIsNil()).OnlyXID(ctx))
+	err = client.FieldType.UpdateOne(ft2).SetIP(net.IP{127, 0}).Exec(ctx)
+	require.Error(err, "malformed addresses are rejected")
+	err = client.FieldType.UpdateOne(ft2).SetMac(net.HardwareAddr{0, 1}).Exec(ctx)
+	require.Error(err, "malformed addresses are rejected")
+}
@@ -0,0 +1,33 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// Account holds the schema definition for the Account entity. Its table
+// is owned by another service, and it's not created by the migration.
+type Account struct {
+	ent.Schema
+}
+
+// Config of the Account.
+func (Account) Config() ent.Config {
+	return ent.Config{
+		Table:         "billing_accounts",
+		SkipMigration: true,
+	}
+}
+
+// Fields of the Account.
+func (Account) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("plan"),
+		field.Int("balance").
+			Default(0),
+	}
+}
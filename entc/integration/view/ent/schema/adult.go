@@ -0,0 +1,33 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// Adult holds the schema definition for the Adult entity. It's backed
+// by the "adults" view of the users table, and it's not migrated.
+type Adult struct {
+	ent.Schema
+}
+
+// Config of the Adult.
+func (Adult) Config() ent.Config {
+	return ent.Config{
+		Table:    "adults",
+		ReadOnly: true,
+	}
+}
+
+// Fields of the Adult.
+func (Adult) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Unique(),
+		field.Int("age"),
+	}
+}
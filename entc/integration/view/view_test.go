@@ -0,0 +1,72 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package view
+
+import (
+	"context"
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/view/ent"
+	"github.com/facebookincubator/ent/entc/integration/view/ent/account"
+	"github.com/facebookincubator/ent/entc/integration/view/ent/adult"
+	"github.com/facebookincubator/ent/entc/integration/view/ent/migrate"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnly(t *testing.T) {
+	drv, err := sql.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+	client := ent.NewClient(ent.Driver(drv))
+	require.NoError(t, client.Schema.Create(ctx))
+	require.Len(t, migrate.Tables, 1, "read-only tables should not be migrated")
+	var res sql.Result
+	err = drv.Exec(ctx, "CREATE VIEW `adults` AS SELECT `id`, `name`, `age` FROM `users` WHERE `age` >= 18", []interface{}{}, &res)
+	require.NoError(t, err)
+
+	client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.Create().SetName("alex").SetAge(10).SaveX(ctx)
+	require.Equal(t, 2, client.Adult.Query().CountX(ctx))
+	require.Equal(t, []string{"a8m", "nati"}, client.Adult.Query().Order(ent.Asc(adult.FieldName)).Select(adult.FieldName).StringsX(ctx))
+	nati := client.Adult.Query().Where(adult.AgeLT(30)).OnlyX(ctx)
+	require.Equal(t, "nati", nati.Name)
+
+	client.User.Update().AddAge(10).ExecX(ctx)
+	require.NoError(t, client.Adult.Reload(ctx, nati))
+	require.Equal(t, 38, nati.Age)
+	require.Equal(t, 3, client.Adult.Query().CountX(ctx))
+	require.True(t, client.Adult.Query().Where(adult.Name("alex")).ExistX(ctx))
+}
+
+func TestSkipMigration(t *testing.T) {
+	drv, err := sql.Open("sqlite3", "file:skip?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+	client := ent.NewClient(ent.Driver(drv))
+	require.NoError(t, client.Schema.Create(ctx))
+	for _, table := range migrate.Tables {
+		require.NotEqual(t, migrate.BillingAccountsTable.Name, table.Name, "skipped tables should not be migrated")
+	}
+	_, err = client.Account.Create().SetPlan("free").Save(ctx)
+	require.Error(t, err, "table should not be created by the migration")
+
+	// the table is created by its owner.
+	var res sql.Result
+	err = drv.Exec(ctx, "CREATE TABLE `billing_accounts` (`id` integer PRIMARY KEY AUTOINCREMENT, `plan` varchar(255) NOT NULL, `balance` integer NOT NULL)", []interface{}{}, &res)
+	require.NoError(t, err)
+	a := client.Account.Create().SetPlan("free").SaveX(ctx)
+	require.Equal(t, 0, a.Balance)
+	a = a.Update().SetPlan("pro").AddBalance(10).SaveX(ctx)
+	require.Equal(t, "pro", client.Account.GetX(ctx, a.ID).Plan)
+	require.Equal(t, 10, client.Account.Query().Where(account.Plan("pro")).OnlyX(ctx).Balance)
+	client.Account.DeleteOne(a).ExecX(ctx)
+	require.Zero(t, client.Account.Query().CountX(ctx))
+}
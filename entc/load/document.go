@@ -0,0 +1,200 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package load
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+
+	"gopkg.in/yaml.v2"
+)
+
+// document is a declarative definition of schemas, that is decoded from a JSON or a YAML file.
+type document struct {
+	Schemas []struct {
+		Name    string `yaml:"name"`
+		Table   string `yaml:"table"`
+		Fields  []*docField
+		Edges   []*docEdge
+		Indexes []*Index
+	}
+}
+
+// docField is a field definition in a schema document.
+type docField struct {
+	Name       string   `yaml:"name"`
+	Type       string   `yaml:"type"`
+	Tag        string   `yaml:"tag"`
+	Size       int      `yaml:"size"`
+	Enums      []string `yaml:"enums"`
+	Unique     bool     `yaml:"unique"`
+	Nillable   bool     `yaml:"nillable"`
+	Optional   bool     `yaml:"optional"`
+	Immutable  bool     `yaml:"immutable"`
+	Sensitive  bool     `yaml:"sensitive"`
+	StorageKey string   `yaml:"storage_key"`
+}
+
+// docEdge is an edge definition in a schema document. Edges with a ref are inverse edges.
+type docEdge struct {
+	Name       string   `yaml:"name"`
+	Type       string   `yaml:"type"`
+	Ref        string   `yaml:"ref"`
+	Tag        string   `yaml:"tag"`
+	Unique     bool     `yaml:"unique"`
+	Required   bool     `yaml:"required"`
+	OnDelete   string   `yaml:"on_delete"`
+	Table      string   `yaml:"table"`
+	Columns    []string `yaml:"columns"`
+	CountField string   `yaml:"count_field"`
+}
+
+// docTypes maps the type names of the document fields to their builders.
+var docTypes = map[string]func(string) ent.Field{
+	"bool":    func(name string) ent.Field { return field.Bool(name) },
+	"time":    func(name string) ent.Field { return field.Time(name) },
+	"bytes":   func(name string) ent.Field { return field.Bytes(name) },
+	"enum":    func(name string) ent.Field { return field.Enum(name) },
+	"string":  func(name string) ent.Field { return field.String(name) },
+	"text":    func(name string) ent.Field { return field.Text(name) },
+	"uuid":    func(name string) ent.Field { return field.UUID(name) },
+	"int":     func(name string) ent.Field { return field.Int(name) },
+	"int8":    func(name string) ent.Field { return field.Int8(name) },
+	"int16":   func(name string) ent.Field { return field.Int16(name) },
+	"int32":   func(name string) ent.Field { return field.Int32(name) },
+	"int64":   func(name string) ent.Field { return field.Int64(name) },
+	"uint":    func(name string) ent.Field { return field.Uint(name) },
+	"uint8":   func(name string) ent.Field { return field.Uint8(name) },
+	"uint16":  func(name string) ent.Field { return field.Uint16(name) },
+	"uint32":  func(name string) ent.Field { return field.Uint32(name) },
+	"uint64":  func(name string) ent.Field { return field.Uint64(name) },
+	"float32": func(name string) ent.Field { return field.Float32(name) },
+	"float64": func(name string) ent.Field { return field.Float(name) },
+}
+
+// LoadDocument loads the schemas of a declarative schema document (JSON or YAML) from the
+// given path. See DecodeDocument for the format of the document.
+func LoadDocument(path string) ([]*Schema, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read schema document: %v", err)
+	}
+	schemas, err := DecodeDocument(data)
+	if err != nil {
+		return nil, fmt.Errorf("schema document %q: %v", path, err)
+	}
+	return schemas, nil
+}
+
+// DecodeDocument decodes a declarative schema document (JSON or YAML) into the schemas it defines.
+// It's used for generating the code of schemas that are defined outside of Go packages (e.g. by
+// external design tools). Edges with a ref are inverse edges, and the table and the columns of
+// assoc edges are their storage key. For example:
+//
+//	schemas:
+//	- name: User
+//	  fields:
+//	  - name: name
+//	    type: string
+//	    unique: true
+//	  - name: age
+//	    type: int
+//	    optional: true
+//	  edges:
+//	  - name: pets
+//	    type: Pet
+//	  indexes:
+//	  - fields: [name, age]
+//	- name: Pet
+//	  edges:
+//	  - name: owner
+//	    type: User
+//	    ref: pets
+//	    unique: true
+//
+// Note that defaults, validators and custom Go types are not supported by schema documents,
+// because they are defined in Go code.
+func DecodeDocument(data []byte) ([]*Schema, error) {
+	doc := &document{}
+	if err := yaml.UnmarshalStrict(data, doc); err != nil {
+		return nil, fmt.Errorf("decode schema document: %v", err)
+	}
+	schemas := make([]*Schema, 0, len(doc.Schemas))
+	for _, ds := range doc.Schemas {
+		if ds.Name == "" {
+			return nil, fmt.Errorf("missing schema name")
+		}
+		s := &Schema{Name: ds.Name, Config: ent.Config{Table: ds.Table}, Indexes: ds.Indexes}
+		for i, df := range ds.Fields {
+			f, err := df.field()
+			if err != nil {
+				return nil, fmt.Errorf("schema %q: %v", s.Name, err)
+			}
+			f.Position = &Position{Index: i}
+			s.Fields = append(s.Fields, f)
+		}
+		for _, de := range ds.Edges {
+			if de.Name == "" || de.Type == "" {
+				return nil, fmt.Errorf("schema %q: missing name or type for edge", s.Name)
+			}
+			s.Edges = append(s.Edges, de.edge())
+		}
+		schemas = append(schemas, s)
+	}
+	return schemas, nil
+}
+
+// field returns the loaded field of the document field.
+func (df *docField) field() (*Field, error) {
+	newField, ok := docTypes[df.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q for field %q", df.Type, df.Name)
+	}
+	fd := newField(df.Name).Descriptor()
+	fd.Tag = df.Tag
+	if df.Size != 0 {
+		fd.Size = df.Size
+	}
+	fd.Enums = df.Enums
+	fd.Unique = df.Unique
+	fd.Nillable = df.Nillable
+	fd.Optional = df.Optional
+	fd.Immutable = df.Immutable
+	fd.Sensitive = df.Sensitive
+	fd.StorageKey = df.StorageKey
+	switch {
+	case df.Name == "":
+		return nil, fmt.Errorf("missing field name")
+	case df.Type == "enum" && len(df.Enums) == 0:
+		return nil, fmt.Errorf("missing values for enum field %q", df.Name)
+	case df.Type != "enum" && len(df.Enums) > 0:
+		return nil, fmt.Errorf("values are supported only by enum fields: %q", df.Name)
+	}
+	return NewField(fd)
+}
+
+// edge returns the loaded edge of the document edge.
+func (de *docEdge) edge() *Edge {
+	e := &Edge{
+		Name:       de.Name,
+		Type:       de.Type,
+		Tag:        de.Tag,
+		Unique:     de.Unique,
+		Required:   de.Required,
+		OnDelete:   de.OnDelete,
+		CountField: de.CountField,
+	}
+	if de.Ref != "" {
+		e.Inverse, e.RefName = true, de.Ref
+	}
+	if de.Table != "" || len(de.Columns) > 0 {
+		e.StorageKey = &edge.StorageKey{Table: de.Table, Columns: de.Columns}
+	}
+	return e
+}
@@ -0,0 +1,112 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package load
+
+import (
+	"math"
+	"testing"
+
+	"github.com/facebookincubator/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeDocument(t *testing.T) {
+	schemas, err := DecodeDocument([]byte(`
+schemas:
+- name: User
+  fields:
+  - name: name
+    type: text
+    unique: true
+  - name: nickname
+    type: string
+    size: 64
+    nillable: true
+    optional: true
+  - name: state
+    type: enum
+    enums: [on, off]
+  - name: password
+    type: string
+    sensitive: true
+    storage_key: pass
+  edges:
+  - name: pets
+    type: Pet
+  indexes:
+  - fields: [name, state]
+    unique: true
+- name: Pet
+  table: animals
+  edges:
+  - name: owner
+    type: User
+    ref: pets
+    unique: true
+    required: true
+`))
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+
+	user := schemas[0]
+	require.Equal(t, "User", user.Name)
+	require.Len(t, user.Fields, 4)
+	require.Equal(t, "name", user.Fields[0].Name)
+	require.Equal(t, field.TypeString, user.Fields[0].Info.Type)
+	require.Equal(t, int64(math.MaxInt32), *user.Fields[0].Size)
+	require.True(t, user.Fields[0].Unique)
+	require.Equal(t, int64(64), *user.Fields[1].Size)
+	require.True(t, user.Fields[1].Nillable)
+	require.True(t, user.Fields[1].Optional)
+	require.Equal(t, field.TypeEnum, user.Fields[2].Info.Type)
+	require.Equal(t, []string{"on", "off"}, user.Fields[2].Enums)
+	require.True(t, user.Fields[3].Sensitive)
+	require.Equal(t, "pass", user.Fields[3].StorageKey)
+	for i, f := range user.Fields {
+		require.Equal(t, i, f.Position.Index)
+	}
+	require.Len(t, user.Edges, 1)
+	require.Equal(t, "pets", user.Edges[0].Name)
+	require.False(t, user.Edges[0].Inverse)
+	require.Equal(t, []*Index{{Unique: true, Fields: []string{"name", "state"}}}, user.Indexes)
+
+	pet := schemas[1]
+	require.Equal(t, "animals", pet.Config.Table)
+	require.Len(t, pet.Edges, 1)
+	require.True(t, pet.Edges[0].Inverse)
+	require.Equal(t, "pets", pet.Edges[0].RefName)
+	require.True(t, pet.Edges[0].Unique)
+	require.True(t, pet.Edges[0].Required)
+}
+
+func TestDecodeDocument_Errors(t *testing.T) {
+	for _, doc := range []string{
+		"schemas: [{fields: [{name: age, type: int}]}]",
+		"schemas: [{name: User, fields: [{type: int}]}]",
+		"schemas: [{name: User, fields: [{name: age, type: complex}]}]",
+		"schemas: [{name: User, fields: [{name: state, type: enum}]}]",
+		"schemas: [{name: User, fields: [{name: age, type: int, enums: [a]}]}]",
+		"schemas: [{name: User, edges: [{name: pets}]}]",
+		"schemas: [{name: User, hooks: []}]",
+	} {
+		_, err := DecodeDocument([]byte(doc))
+		require.Error(t, err, doc)
+	}
+}
+
+func TestLoadDocument(t *testing.T) {
+	schemas, err := LoadDocument("./testdata/document/schema.json")
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+	require.Equal(t, "User", schemas[0].Name)
+	require.Len(t, schemas[0].Fields, 2)
+	require.Equal(t, "user_groups", schemas[0].Edges[0].StorageKey.Table)
+	require.Equal(t, "teams", schemas[1].Config.Table)
+	require.Equal(t, "groups", schemas[1].Edges[0].RefName)
+
+	_, err = LoadDocument("./testdata/document/missing.yaml")
+	require.Error(t, err)
+}
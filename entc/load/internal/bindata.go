@@ -98,7 +98,7 @@ func templateMainTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _schemaGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xdd\x6f\xe3\x38\x0e\x7f\x8e\xff\x0a\x4e\x80\x19\xd8\x45\xd6\x99\x5b\x1c\x0e\x38\x0f\xf2\xb0\x98\xed\x02\xbd\xbd\xf9\xc0\x4e\xef\x5e\x8a\xa2\xeb\xd8\x54\xa2\xa9\x2d\x7b\x24\xa5\xd3\x6e\xd1\xff\x7d\x41\x4a\xf2\x47\x9c\x74\xe7\x63\xdb\x97\x5a\x14\x49\x91\x3f\x52\x24\x95\xe5\x12\x5e\x37\xed\x9d\x96\x9b\xad\x85\x1f\x5f\xfe\xe3\xdf\x3f\xb4\x1a\x0d\x2a\x0b\xbf\xe4\x05\xae\x9b\xe6\x1a\xce\x54\x91\xc2\x4f\x55\x05\xcc\x64\x80\xf6\xf5\x0d\x96\x69\xb4\x5c\xc2\xf9\x56\x1a\x30\xcd\x4e\x17\x08\x45\x53\x22\x48\x03\x95\x2c\x50\x19\x2c\x61\xa7\x4a\xd4\x60\xb7\x08\x3f\xb5\x79\xb1\x45\xf8\x31\x7d\x19\x76\x41\x34\x3b\x55\x92\x0a\xa9\x98\xe5\xbf\x67\xaf\x4f\xdf\x7e\x38\x05\x21\x2b\x0c\x34\xdd\x34\x16\x4a\xa9\xb1\xb0\x8d\xbe\x83\x46\x80\x1d\x9c\x67\x35\x62\x1a\x45\x6d\x5e\x5c\xe7\x1b\x84\xaa\xc9\xcb\x28\x92\x75\xdb\x68\x0b\x71\x34\x9b\xa3\x2a\x9a\x52\xaa\xcd\xf2\xa3\x69\xd4\x3c\x9a\xcd\x45\x6d\xe9\x9f\x46\x51\x61\x61\xe7\x51\x34\x9b\x6f\xa4\xdd\xee\xd6\x69\xd1\xd4\x4b\xe1\x1d\x96\xaa\xd8\xad\x73\xdb\xe8\x25\x2a\xbb\x34\xc5\x16\xeb\x7c\x89\xe5\x06\xbf\x48\x60\xfe\x15\x4a\x85\xc4\xaa\x9c\x47\x49\x44\x30\x7c\x60\x1a\x68\xf4\x01\x30\x90\x2b\x40\x65\x53\xbf\x61\xb7\xb9\x85\xcf\xb9\x61\x3f\xb1\x04\xa1\x9b\x1a\x72\x28\x9a\xba\xad\x24\x81\x6d\x50\x83\xc7\x22\x8d\xec\x5d\x8b\x41\xa5\xb1\x7a\x57\x58\xb8\x8f\x66\x6f\xf3\x1a\x21\xfc\x19\xab\xa5\xda\x74\xcb\xdf\x09\xa5\x6c\xae\xf2\x1a\x17\x4d\x2d\x2d\xd6\xad\xbd\x9b\xff\x1e\xcd\x5e\x37\x4a\xc8\xc0\x47\x06\x0d\x08\x5e\xa8\x60\xca\x58\xec\xb4\xdc\xa0\x09\xca\x2f\x2e\x4f\x68\xbd\x77\x16\x81\x6a\xc6\x52\xbf\x10\x24\xa6\x97\xe2\xf5\x58\x8a\x51\xdb\x13\x3b\x53\x25\xde\x86\xe3\x2e\x2e\x4f\x78\x3d\x16\x93\x8e\x65\x2c\xf7\x81\xa1\xf1\x87\x5e\x5c\x9e\x0c\xd6\x41\xce\xa1\x77\x75\xe0\xd4\x07\x8e\xdb\xfb\xc6\x48\x2b\x1b\x05\x25\x9a\x42\xcb\x35\x1a\xc8\x81\xb9\xa1\x0d\x5b\x3e\x9d\x5d\xd8\x7d\x70\x3a\xb9\x3e\x3c\x03\xab\xa5\xb2\x00\xcb\xa5\x57\xc4\xb6\x07\x2d\x8e\x54\x49\x63\xd3\x68\xf6\x46\xde\x62\x79\xa6\x48\x64\xdd\x34\x15\xf0\x7d\x2a\x65\x91\x5b\x34\x20\xc5\x40\x80\x52\xa7\x26\xee\x1f\xa4\x72\x82\x52\x9d\x79\xbd\xee\xac\x9a\x48\xe3\xb3\x1c\xc9\x9d\xe5\xdc\x75\xd8\x4c\xb3\xd4\xd1\xbf\x21\x49\x9d\xe0\x91\x1c\xdd\x4f\xd2\xe3\x59\x7a\xa6\x44\xd3\xb3\x9d\xb0\xcf\xe9\xf9\x5d\x8b\xbc\xe1\xc5\xe8\xc0\xb1\xd8\x79\x3e\x50\x7e\xec\x34\x9b\xef\xe5\xf6\x07\xf9\xc7\xc0\xc6\x13\xa9\xec\xbf\xfe\x39\x91\x32\xf2\x8f\xbd\xc3\x4e\xd5\xae\xee\xae\x04\x5c\x5c\x8e\x8f\x0b\x97\x82\x98\xc6\x72\xff\x53\xf2\xd3\xae\x3b\x90\xe3\x0c\x93\xe3\x76\xcc\x34\x16\x7c\x2b\xab\x2a\x5f\x57\xf8\xa8\xa0\xf2\x4c\x63\xd1\x77\x2d\x25\x67\x5e\x3d\x2a\xda\x78\xa6\xb1\xe8\xcf\x28\xf2\x5d\x65\x1f\x37\xb7\x74\x4c\x7b\x8e\xb6\x65\x6e\x31\xc8\x1f\x73\x94\x99\xae\x0e\x2a\x38\xab\xeb\x9d\xed\x3c\x3e\xa2\x40\x06\xa6\xb1\xec\xff\xf3\x4a\x96\x54\xa2\x39\x44\x7c\x29\xa6\xb2\x37\x1d\xd3\x7e\x21\x69\x74\xbe\xc1\x5f\xf1\xee\x91\x3c\x32\x8e\xe9\xea\x1a\xef\xc6\xd2\x5d\x2d\x70\xf9\x34\x5e\x06\xe9\x50\x4d\x0e\xd4\xa0\x61\xd9\xda\xbb\x9a\xb7\x16\x35\x85\xd1\x5f\x30\x57\x0b\x4a\x14\x52\x61\x79\xb0\x2e\x0d\x75\xf5\xb7\xb2\xbb\x27\xde\xb5\x63\x37\xa3\xbb\xbd\x63\xbe\xe9\x7d\xa5\xab\x79\x48\xe1\xe4\x86\xbe\x6e\xea\x9a\xe6\x91\x3d\xc6\xc2\x91\xf7\x70\xbc\xde\xbc\xcf\xed\x76\x9f\xb7\xbd\xde\x5c\xb5\xb9\xdd\xee\xdd\xc6\x7a\x8d\x25\x15\x29\x9f\x26\xe1\xfe\x79\xf2\x01\x98\xb9\x85\x4d\x4b\x1f\x93\xbf\xa1\xf2\xb1\xdc\x81\xc2\xf7\xb7\x41\xf7\xa5\x41\xfb\x0d\x85\x3b\x7c\xcc\xa7\x51\x5c\x4d\x4f\xff\x0d\x85\x4f\x53\xd7\xd1\x7b\xe6\x23\x45\x6b\x0c\xef\xa1\x32\x75\xa6\x6e\x50\x1b\xdc\x67\x95\x8e\xbc\x7f\xfc\xa7\x9d\xd4\x93\xa8\x69\x4f\x3e\x10\x35\xd7\xe4\xa6\x61\x73\xf4\x6f\x88\x9b\x13\xec\x03\xe7\x3d\xed\xaa\xcd\x23\x9e\xfa\xa1\xa8\x2b\xfd\x7f\x39\x08\xed\x73\x1e\x1d\x43\xde\xe2\x67\x8e\x47\xa1\x91\x7b\x7f\xae\x82\x47\xa4\xdc\xb9\xc5\x5f\x6e\x4c\x69\x6d\xa3\xd3\x48\xec\x54\x11\x24\x63\x2c\xe1\x84\x38\xd2\x9f\x3b\x8e\xc4\x07\xf9\x3e\x9a\x29\x84\x6c\x05\x2f\x68\x79\x1f\xcd\x28\xb5\x32\x97\x06\x58\xa6\xe7\xf9\x66\x41\xb4\xbb\x16\xb3\x8e\x46\xd9\x18\xcd\x38\xab\x3b\x22\x2d\x88\xe8\x10\xcb\x1c\xd1\x2d\x88\xec\xf3\x20\x63\xb2\x5f\x10\x3d\xc4\x3c\x23\x7a\x58\xb8\x0d\xe1\xf5\xf3\x86\xf0\xfa\x1f\xa2\x99\x14\xa0\x51\x90\xc9\x6e\xe7\x15\x2f\x9f\xad\x40\xc9\x8a\xdc\x99\x29\x24\x32\xac\x3a\xf7\x35\x8a\x84\x45\x35\xda\x9d\x56\xa0\xb0\x47\xd6\x55\xc3\x29\xb4\xae\x9a\x3e\x8e\x2d\xcb\xc6\xa2\x0c\x33\xc9\x10\xdd\xd8\xcd\xb7\x0b\x40\xad\x69\x7d\x1f\xcd\x0c\x1b\xfd\x82\xe9\xf7\x23\xfc\xf8\x4f\xf4\x20\xd2\x60\x33\xde\x21\xca\x62\x14\x9c\xb0\xe3\x23\xc4\x03\x48\x36\xdc\x60\xca\x38\x24\x61\xab\x8f\x4b\x18\x23\xb2\xde\x86\x30\x33\x44\xb3\x6e\x52\xe8\x77\x03\x85\xad\x0c\xcd\x36\xeb\xac\xec\xda\x6f\x34\x1b\xf4\xcd\xcc\x6f\xf7\x14\xda\xef\x9b\x32\xef\x57\xa8\x62\x51\xa6\x3d\x35\x21\x26\x3f\x30\x64\xbd\xed\x61\x84\x70\x01\x67\xff\x86\xa3\x45\xc6\xfe\x8d\x86\x8d\x8e\xd3\x25\x8f\x11\x8c\x26\xac\xfa\x8c\x09\x79\x21\xab\x05\x88\xda\xa6\xa7\x14\x33\x11\xcf\x6b\x69\x0c\xdd\x50\xae\x0d\x92\x84\x44\xa3\x7d\x6a\x3c\xff\x34\x5f\x90\x2e\x8a\x59\xd2\xe9\xa6\xe9\x31\x5b\x01\x8f\x8d\xe4\x0e\x8d\x93\xc9\x2b\x47\x7f\xb6\x82\x97\x7c\x9c\x11\x4c\x87\x15\xbc\xa0\x8d\x61\x6a\x1a\xb1\x20\x33\x7c\x7e\xbe\xc9\xb5\xd9\xe6\x95\x7f\xec\xf1\xa3\x17\xb9\xad\x0f\x1e\x8f\x52\x59\xd4\xf4\x16\xa5\xaf\x06\x72\xf8\xcf\x87\x77\x6f\x49\x98\xcb\x5f\x91\x2b\x58\x53\xf6\x92\x68\xe9\x58\x48\x81\x17\x6e\xd6\x1f\xb1\xb0\xfe\x9f\x4f\xec\xd1\xa1\xb1\x09\x67\x53\x55\xf5\x27\x25\x10\xaf\xe1\xe2\x72\x7d\x67\x91\xf3\x7b\x98\xe3\x9c\xe2\x4e\x96\x5c\x75\x0f\xca\x2c\xcc\x21\x6e\x19\x27\xc3\xf2\x41\x8f\x1a\x8d\x85\x8d\xfd\xe3\x9d\xeb\xcb\x3b\xe1\x4f\x4e\x12\x46\x98\x45\x1c\xc6\x74\x60\xb6\x02\x93\xd2\x4d\x75\xb5\x34\xf0\xbe\xe2\xcd\x67\x87\x03\x8b\x5a\xb3\x0a\x57\x91\x3b\x35\xb9\x40\x2e\xdd\x41\x47\x77\xc6\x11\x35\xc3\xfc\xf0\xe0\x3c\xff\x94\xc1\xf3\x1b\x4a\x07\x77\x83\x49\xdc\xa5\x04\xa5\xcb\xd5\x02\x38\x27\x74\xae\x36\xc8\xc5\xc4\xb8\x2c\x48\x5d\xcb\x58\x41\xde\xb6\xa8\xca\xd8\x13\x16\x7d\xd9\x1e\x54\x94\x38\x49\x7c\x96\xf9\xc7\xee\xd0\x01\xff\x46\x7e\x4a\x17\x64\x79\xdb\x3b\xe1\x6d\x60\xc5\x7e\x43\x96\xb7\x23\x6b\xd9\xc1\xf0\x76\x1f\xb8\x78\x16\xcc\x7f\xc1\x5f\xa4\xc1\xb5\xce\x0c\x58\x87\x83\x80\xa8\x2e\xb4\x19\x53\xdd\x37\x93\x43\x35\x23\x72\x5f\xc7\x1e\x46\x05\x9e\x1a\x6a\xea\xf3\x38\x36\x89\xbf\x4d\x7d\xbe\xf0\xa7\xf1\x17\xd9\x36\x3e\x3b\x7d\xb5\x1f\x66\xba\xbf\x12\xb1\x81\x13\x97\xd3\x09\x4c\xb2\x6e\xff\x6e\xf0\x65\x20\x68\xf8\x85\x3d\x8a\x13\xbf\xcc\xbf\x20\x4a\x5f\x1d\x20\xb9\x80\x7a\x10\x1f\xf7\xb6\x27\x85\x7e\xa8\x18\x1a\xe1\x8d\xaf\x6f\x29\x46\x53\x13\xbe\xde\x06\x32\x82\xad\xf8\xb8\x00\xd1\x1b\xe1\x8e\x76\x3a\xa9\xac\x79\x13\xfa\xbe\x39\xce\x6e\x62\x3b\x60\xcd\x37\x98\xc3\xf6\x50\x91\xed\x1e\x5b\x2b\x78\x11\xbe\x9d\x52\xce\x3d\xdf\x54\x3e\x72\x5a\x85\x9f\x5b\x98\x68\xb5\xcb\xaa\xd9\xe0\xb7\x94\x0c\xe4\xa2\x57\xee\x33\x72\x98\xd9\x3e\x47\xc1\x08\x8f\x09\xc5\xe6\x28\xfc\x4f\x93\x04\x87\xe1\xff\x32\xf4\xff\xb6\x54\x38\x86\x7c\x80\x91\x79\xfe\x0a\xc0\xc1\xb4\xd6\xb5\xc3\x1e\x3e\xf8\xac\xf3\xd6\x0c\x5f\xb8\x9e\x9e\xab\xd2\x65\x7f\x20\xd4\x68\xb7\x4d\x09\x9f\xa5\xdd\x82\xc6\xa2\xb9\x41\x4d\x37\x1e\x95\xd9\x69\x04\xd5\x40\x9b\x2b\x59\x18\x7a\x2f\xd7\xae\x60\x48\xb5\xf1\xd7\x7e\x10\x2e\x51\xf6\x8d\xf6\x1e\x3c\x31\x81\x8b\xcb\xfe\x07\xb2\x87\x04\x62\x11\x46\xfb\x8e\xbc\xdf\x20\x4b\x14\xa8\x81\xd4\xc7\x89\xab\x9f\x02\x6e\x38\x6a\xce\xb8\x38\x79\x05\x37\xa3\x20\x90\xfc\x6a\x14\x83\xe7\xe7\xc1\x3b\x67\xbc\x0f\x85\x28\x17\x70\xc3\x17\x40\x04\x6c\x09\x3b\x97\x8b\x54\x91\x43\x38\xcb\x34\x38\xb0\xd8\x43\xd7\x75\xa4\x09\xb8\x8e\xfc\xbd\x50\x0e\xdb\xec\x64\xa0\x70\x7d\xd1\x01\x47\x8c\x4f\x81\xdb\xc8\x9b\x11\x74\x0e\x36\xf4\xfd\xf8\x20\x6a\x43\xe1\x29\x70\xa1\xd3\x4d\xa0\x0b\x1b\xdf\x0b\xde\xb8\xc5\x4f\xe0\x0b\x1d\xd9\x01\xc8\xcc\x4f\x88\x60\x70\xea\x00\x86\xb2\x6b\xf9\x8f\xa1\x18\xbc\x99\xe0\xc8\xf5\x76\x8a\xa2\x23\x7f\x2f\x86\xc3\xf6\x3b\x41\xd0\xf5\x4c\x87\xdf\x9b\xbe\x73\x3f\x09\x7e\xce\x9d\x03\xe8\x39\x23\x1e\xc7\xce\x79\xd1\x23\xc7\xee\x75\x43\xb4\x85\xe1\x18\x9d\x8c\x56\x64\x15\x35\x0a\x9b\xfe\x2a\x55\x19\x27\xf4\x04\x0a\xfb\xef\x2d\xcf\x2c\x33\x0b\x2b\xb0\xe9\x69\x85\x75\x3c\xaa\xc2\x36\x7a\x88\xfe\x0c\x00\x00\xff\xff\xd7\x50\x09\x8a\x52\x1c\x00\x00")
+var _schemaGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\xeb\x6f\xdc\x36\x12\xff\xbc\xfa\x2b\xe6\x0c\xc4\xd0\x1a\x5b\x39\x57\x14\x05\x6e\x83\x3d\xa0\x48\xdc\x83\xaf\x97\x07\x62\xf7\xbe\x18\x86\x2b\x4b\xc3\x5d\x26\x12\xa5\x90\x5c\x27\xae\xeb\xff\xbd\x18\x72\x28\x51\xda\x87\x9d\x04\x8e\x3f\xc4\x1a\xce\x8b\x3f\xce\x8b\xf4\xf1\x31\xbc\x6c\xda\x5b\x2d\x97\x2b\x0b\x3f\x3e\xff\xe7\xbf\x7e\x68\x35\x1a\x54\x16\x7e\xcd\x0b\xbc\x6e\x9a\x8f\x70\xaa\x8a\x0c\x7e\xa9\x2a\x70\x4c\x06\x68\x5d\xdf\x60\x99\x25\xc7\xc7\x70\xbe\x92\x06\x4c\xb3\xd6\x05\x42\xd1\x94\x08\xd2\x40\x25\x0b\x54\x06\x4b\x58\xab\x12\x35\xd8\x15\xc2\x2f\x6d\x5e\xac\x10\x7e\xcc\x9e\x87\x55\x10\xcd\x5a\x95\xa4\x42\x2a\xc7\xf2\xbf\xd3\x97\x27\x6f\xce\x4e\x40\xc8\x0a\x03\x4d\x37\x8d\x85\x52\x6a\x2c\x6c\xa3\x6f\xa1\x11\x60\x23\x7b\x56\x23\x66\x49\xd2\xe6\xc5\xc7\x7c\x89\x50\x35\x79\x99\x24\xb2\x6e\x1b\x6d\x21\x4d\x26\x07\xa8\x8a\xa6\x94\x6a\x79\xfc\xc1\x34\xea\x20\x99\x1c\x88\xda\xd2\x7f\x1a\x45\x85\x85\x3d\x48\x92\xc9\xc1\x52\xda\xd5\xfa\x3a\x2b\x9a\xfa\x58\xf0\x86\xa5\x2a\xd6\xd7\xb9\x6d\xf4\x31\x2a\x7b\x6c\x8a\x15\xd6\xf9\x31\x96\x4b\x7c\x94\xc0\xc1\x57\x28\x15\x12\xab\xf2\x20\x99\x26\x04\xc3\x99\xa3\x81\x46\x3e\x00\x03\xb9\x02\x54\x36\xe3\x05\xbb\xca\x2d\x7c\xce\x8d\xdb\x27\x96\x20\x74\x53\x43\x0e\x45\x53\xb7\x95\x24\xb0\x0d\x6a\x60\x2c\xb2\xc4\xde\xb6\x18\x54\x1a\xab\xd7\x85\x85\xbb\x64\xf2\x26\xaf\x11\xc2\x3f\x63\xb5\x54\xcb\xf0\x05\x7f\x10\x4a\xf3\x03\x95\xd7\x38\x6b\x6a\x69\xb1\x6e\xed\xed\xc1\x1f\xc9\xe4\x65\xa3\x84\x0c\x7c\xe4\x50\x44\x60\xa1\xc2\x51\x86\x62\x27\xe5\x12\x0d\x4b\xc1\xc5\xe5\x11\x7d\x8f\x6c\x11\xa8\x66\x28\xf5\x2b\x41\x12\xc4\x2e\x2e\x8f\xdc\xf7\x50\xca\xa1\x36\x12\x3b\x55\x25\x7e\x09\xe6\x2e\x2e\x8f\xdc\xf7\x50\x4c\x12\x69\x6c\xee\xcc\x41\xc3\x46\x2f\x2e\x8f\xa2\xef\x20\xe7\xd1\xbb\xda\x66\xf5\xff\x79\x25\x4b\x3a\x54\x32\x2c\x95\x65\x83\xb1\xd5\x9b\x8e\x65\x20\x7a\xef\x8e\xfc\x5d\x63\xa4\x95\x8d\x82\x12\x4d\xa1\xe5\x35\x1a\xc8\xc1\x19\x82\x36\x2c\x71\x26\xf8\x30\xe4\x73\xed\xe4\xfa\x93\x8d\x36\xec\x1c\x39\x3e\x66\x45\x6e\xdb\x41\x8b\x27\x55\xd2\xd8\x2c\x99\xbc\x96\x5f\xb0\x3c\x55\x24\x73\xdd\x34\x15\xb8\x54\x2c\x65\x91\x5b\x34\x20\x45\x24\x40\x51\x57\x13\xf7\x0f\x52\x79\x41\xa9\x4e\x59\xaf\xb7\x55\x13\x69\x68\xcb\x93\xbc\x2d\xbf\x5d\x0f\xeb\x66\x80\x7b\xfa\x37\xc4\xb7\x17\xdc\x11\xde\x9b\x11\xbe\x3b\xc4\x4f\x95\x68\x02\x13\xfd\x1c\xb9\x7d\x67\xe7\xb7\x2d\xba\x25\x16\x24\xa3\x43\xc1\xf3\x7c\x09\x8f\xb0\x68\xf3\xe5\x50\xee\x4c\xfe\x39\xf0\xf4\x48\x2a\xfb\xf3\x4f\x1b\x72\x46\xfe\x39\x32\x78\xa2\xd6\x75\x48\x0f\xfa\xb9\xb8\x1c\x9a\x64\x41\x24\xb6\xa1\xe4\xef\x4a\x7e\x5a\x47\x46\xdd\x99\xc3\x86\xc9\xb5\x63\x1b\x8a\xbe\x91\x55\x95\x5f\x57\xf8\x80\xa8\x62\xb6\xa1\xf0\xdb\x96\x42\x35\xaf\x1e\x10\x6e\x98\x6d\x28\xfc\x0a\x45\xbe\xae\x2c\x3c\x20\x5c\x7a\xb6\xa1\xec\xef\x6d\x99\x5b\xec\x35\xec\x90\x5d\x3b\xb6\xab\xad\x2a\x4e\xeb\x7a\x6d\xa3\x9d\xef\x50\x21\x03\xdb\x76\xd8\xce\x6c\xa3\xa9\x41\x3d\x00\xdb\x95\xf1\x7c\x43\x25\x67\xa8\x28\xd9\x6f\x1e\x70\xc1\x04\xb6\x3d\x15\x6a\xb3\x48\x3d\x50\xa5\x26\xe7\x3a\x57\x46\x34\xba\x46\x6d\xf6\x88\xdb\x88\x6d\xa8\x80\xb7\xfe\x1b\xde\xee\xcd\x0f\xde\xf9\xd5\x47\xbc\x1d\xca\xbf\x47\x4a\xd6\xf2\xa1\x70\xd7\x9e\x6d\x28\xfb\x4e\x63\x21\x0d\xd5\xd0\xbd\x7b\x6f\x03\xdb\x50\xfa\x55\x6e\xf1\xad\xaa\x6e\x61\x3f\xee\x2e\x76\x1a\x55\x8d\xfc\xee\x2a\x34\x73\x1f\x8d\x08\xc1\x36\x53\x07\xc2\xbe\x58\xc6\x9d\x68\x54\x32\xbf\x58\xd4\x2a\xaf\x42\xe1\x73\xb5\x0a\x4a\x14\x52\x61\xb9\xb5\x5f\xc4\xba\xfa\x6a\xd9\xd5\x2e\x86\x74\x57\xad\xea\xaa\xea\x90\x6f\xb3\x8a\x52\xb9\xdc\xa6\x70\xa3\x6a\xbe\x6c\xea\x9a\x46\xcc\x11\x63\xe1\xc9\x43\xde\x77\x1f\x97\xef\x72\xbb\x1a\xf3\xb6\x1f\x97\x57\x6d\x6e\x57\x43\xe6\x93\xfa\x1a\x4b\x6a\x1e\x7c\x5a\xcc\x8c\x4c\x1e\x30\x7b\x98\xdd\x54\xb2\xd9\x92\x1c\xf9\x1b\x3a\x92\x93\xdb\xda\x90\xd8\x7d\xfe\x02\x78\x04\x8c\xbb\x85\xf6\x36\xa2\x9d\x42\xe3\x83\x7d\x8f\x22\x38\xb8\x4b\x46\xa3\xb8\xda\xf4\xf0\x3d\x8a\xc0\x37\x18\xeb\x86\x82\x3b\xdb\xcf\x38\x95\xf6\xb5\x9e\x53\x75\x83\xda\xe0\x3e\x31\xe9\x59\x86\x72\xef\xf1\xd3\x5a\x6a\x2c\xf7\xc8\x69\x66\x19\x0a\xbe\x55\xaf\xb0\x42\x8b\x7b\x40\x69\xd4\x55\xe9\x78\x76\xd6\xba\x23\x9a\x6c\xb3\x88\xf0\x50\x9d\x7b\xd9\xac\x55\x9f\xa0\x5b\xad\x16\xc4\xe2\xc7\xd0\x81\xac\x8f\x63\x3f\x8e\x6d\x06\xb2\xa7\x7f\x43\x24\x7b\xc1\x3e\x94\xbb\xf3\x1b\xc1\xb9\xe7\xec\xc6\xf5\x60\x24\xb2\x19\xc4\xdd\x95\x61\x54\xe6\x1f\x71\x5d\xd8\x2e\xb1\x6d\x68\x7f\xa7\x51\x48\xba\x2b\xd4\x79\x7b\xe1\x85\x2e\xa9\x35\xb0\x48\xcb\xcb\x03\x21\x8f\xf1\x1b\xfc\x4c\x1e\x42\xa1\xd1\xcd\xc8\xb9\x0a\x78\xd2\x71\xfb\x0b\x99\xfb\xcd\x8f\xf3\xad\x6d\x74\x96\x88\xb5\x2a\x82\x64\x8a\x25\x87\xc6\xab\x8e\x63\xca\x29\x74\x97\x4c\x14\xc2\x7c\x01\x87\xf4\x79\x97\x4c\x26\xe7\xf9\x72\xce\xdb\x01\x2c\xb3\xf3\x7c\x39\x23\xea\x6d\x8b\x81\x4c\x54\x02\x31\x99\xb8\x9b\x5d\x44\xa6\x4f\xe2\xf6\xa7\x36\x0f\x64\xff\x49\x0b\x9c\x57\x73\x5e\xe0\x4f\x5a\x09\x99\x43\x4b\x58\x66\xe1\xd3\x2f\x89\xce\x8e\x5b\x12\xc1\x4e\xc8\x9a\x79\x77\xda\x29\x96\x59\xa0\x4e\x49\xb8\xcf\x86\x39\x59\xec\x3f\x69\xb1\x8f\x7f\xb7\xd8\x7f\xce\x92\xc9\x7d\x32\x91\x02\x34\x0a\x42\xc7\x9b\x7d\xe1\x3e\xff\xb1\x00\x25\x2b\x0a\xce\x89\x42\x22\xc3\xa2\x43\x5a\xa3\x98\x3a\x51\x8d\x76\xad\x15\x28\xe4\x4b\xc8\x1b\xfc\xec\x82\x66\xcb\x29\xba\x68\x79\xe0\x18\x9d\x6c\x2a\xca\x70\x45\x88\x0f\x32\xf5\xb7\xd5\x19\xa0\xd6\xf4\x7d\xe7\x1c\x17\x65\x76\xa2\x75\xec\x6c\x70\x49\x56\x33\x10\xb5\xa5\xe5\x46\x8b\xf4\xc0\x69\x84\x67\x9f\xe6\xf0\xec\xe6\x60\x06\x82\x8f\x91\x7e\x39\xd1\xda\x6f\xc7\x38\x14\x0e\x9d\xa1\xbb\xd1\xb9\xbb\x9f\x20\xe5\xce\x58\x34\xe3\x35\xba\xce\xcc\x46\xc1\x15\xd6\x38\xc2\xdc\x15\x23\x5e\x24\xfb\x44\x1b\x07\x54\x58\xec\xa3\x2a\x4c\xbc\xdd\x3a\x79\xc3\x34\x5a\x0f\x77\x81\x78\x3d\xd0\x68\xbd\x9b\xb7\x03\x83\x28\xb3\x8e\x16\x1b\xe0\xe8\x99\xc7\x06\x98\x46\x6c\xdd\xd0\x1c\xe9\xe9\x68\xe3\x60\xec\x18\x06\x11\xc9\x93\x67\x50\xe0\x54\x30\x8d\x14\x74\xc3\x65\x60\x10\x65\xd6\xd1\x88\x21\xcc\x8f\x9d\x02\x51\x66\x81\x46\xeb\xfd\x64\xce\x1c\x15\xaa\x54\x94\x59\x4f\x77\x59\x13\x4f\xe0\xf3\x88\x2d\xa6\x3b\x46\xbe\xe7\x74\xe6\xc8\x5f\xa6\x71\xec\x11\xd7\xe0\x4e\x34\xe7\xe3\x1b\xdc\x93\x3a\x5e\x9f\x76\x46\xb8\x90\x81\xc5\xc3\xe1\x5b\x4b\x63\xa8\xcc\x53\x5d\x07\x49\x42\xa2\xd1\x3c\x9d\x3e\xfb\x74\x30\x03\x23\x5c\x68\x4e\x47\xba\x69\xcb\x6b\x3c\x2b\x72\xa5\x50\xc3\xe1\x21\xa4\x46\x74\xae\xff\xf5\x17\xb1\x0d\x5d\xf4\xb4\x1e\x28\xf8\x37\x3c\x67\x62\x0c\x0b\x91\xa7\x8f\x4c\x38\xbe\xfd\x99\x19\xf4\x57\x21\xc8\x55\x09\xf1\xd5\x06\x72\x8d\xa0\x1a\x0b\x66\xdd\xd2\x23\x23\x95\x8c\x46\xc3\x7f\x1a\xd7\xe8\x9c\x32\xb3\x7d\x9b\xa3\x10\x0d\x9b\x0c\x64\x76\x7e\x03\x8c\xc7\x7a\x3f\x56\x2f\xcd\x16\x37\x3b\x63\xb4\xad\x07\x7d\xa6\xf7\x89\xf9\x82\xee\x8b\x3f\xff\x44\xf1\x46\x0f\x16\xd3\x17\x40\x0f\x12\x14\x4e\xcf\x9d\x67\x46\x38\x3a\x2c\xe0\x90\x16\xe2\x7a\x6b\xc4\x8c\x3c\xe6\xa2\xfb\x3a\xd7\x66\x95\x57\xfc\x1e\xe9\xde\x65\xd1\xbd\x2f\x45\xef\x9b\x52\x59\xd4\xf4\xa4\x4a\x46\x1b\xc8\xe1\xbf\x67\x6f\xdf\x50\xdb\x75\xc3\x4b\x91\x2b\xb8\x46\x28\x91\x44\xe9\x96\x63\x1b\xa7\x80\x85\x9b\xeb\x0f\x58\x58\xfe\x8f\xab\xf5\xc0\x68\x6a\x82\x6d\x9a\x89\xd8\xd2\x14\xd2\x6b\xb8\xb8\xbc\xbe\xb5\xe8\x8a\x76\x54\xb8\x8d\x2b\xb3\x5e\x3b\x6d\xd5\xbf\x79\xce\xc3\xbd\xca\x7f\xa6\xd3\xb8\xf5\x4a\xe5\x5f\xaa\x53\x7e\x5f\x76\xbd\xf9\xad\x60\xcb\xd3\xa9\x43\xd8\x89\x78\x8c\xc9\xe0\x7c\x01\x26\xa3\xf6\xe3\x0a\xba\x09\xbc\x2f\x00\x77\xb7\x0c\xd4\xda\x21\x4d\x3d\xca\xcc\x3a\x35\xb9\x40\xea\x7c\x9d\x8e\xce\xc6\x23\x3a\x0f\x83\xd3\xb5\x1e\xc3\x9d\x07\x43\xdb\xa1\x4c\xbe\x9a\x81\x8b\x09\x9d\xab\x25\x82\xb3\xee\x94\x9a\xcc\xd9\x85\x05\xe4\x6d\x8b\xaa\x4c\x99\x30\xeb\xc7\x9e\xa8\x4d\xa6\xd3\x29\x47\x19\xbf\xc7\xc6\x1b\xe0\x67\xdc\xa7\xdc\x82\x2c\xbf\xf4\x9b\xe0\x37\x61\xb7\x0d\x5e\x90\xe5\x97\x81\xb7\x6e\x83\xe1\x79\x39\xda\x22\x93\x66\x70\xe8\x7e\x23\x0d\xd1\x6c\x46\x5a\xc2\x68\x36\x21\x0c\xcc\x3c\x90\xdd\x97\xa3\xfb\x33\x9f\x33\xdd\x7f\xb9\x85\xbe\xc9\xd2\x42\xdf\x5e\xbb\xd9\x75\xee\x34\x85\x2f\x5a\xba\xf7\xa0\x46\x0f\x39\x31\xae\x7d\xad\x7c\x12\x68\x4d\x5c\x8c\x17\xae\x45\xf5\x8e\x4c\xbb\x9a\x40\x03\x76\xc6\x59\x99\x9a\x29\xd7\x86\x3e\xfa\xdd\x34\x6d\xb8\x63\xd8\x86\x73\x8d\x07\xb2\x38\x6f\x39\xc1\x53\x03\x47\x3e\x43\xa7\xb0\x91\x43\xe3\x4c\x77\xa9\x4d\x07\xed\xde\xa5\x07\x69\xf3\x9a\x28\x8f\x00\xe6\xab\xc3\x4d\xce\xa0\x8e\xa2\xcd\x59\x26\x17\x26\x7c\x2f\x89\x9d\x60\xe7\xeb\x2f\xd3\x64\xb2\xc5\x85\xaf\xf7\x81\xc2\xc1\x79\xf1\x61\x06\xa2\x77\xc2\x9b\xf6\x3a\x8d\xe8\x5c\xe8\x47\xdb\x61\xae\x26\x93\xad\xde\x7c\x83\x3b\xce\x9f\x89\x11\x59\xf7\x14\xb6\x80\xc3\xf0\xbb\x57\xea\x32\x89\x27\x98\x0f\x14\xd5\x93\xf0\x47\x0a\x47\xb4\x9a\xd3\x20\xfa\x0b\xc4\x1c\xe4\xac\x57\xce\x49\x14\xe7\x29\xa7\x15\x18\xc1\x98\xdc\x27\x7b\xe0\x7f\x9a\x20\xd8\x0e\xff\xe3\xd0\xdf\x02\xfe\xd7\x63\x7f\x9f\xec\x46\x3e\xc0\x78\x9f\x3c\x02\xc0\xbe\xc1\xf7\xcd\xbd\x87\x0f\x3e\xeb\xbc\x35\xf1\xfb\x23\xd3\x69\xe4\x70\xd1\x1f\x08\x35\xda\x55\x53\xc2\x67\x69\x57\xa0\xb1\x68\x6e\xe8\xef\xc4\x0d\xa0\x32\x6b\x37\x63\x41\x9b\x2b\x59\x18\x7a\xcd\xac\x7d\xc1\x90\x6a\xc9\x69\x1f\x1d\x97\x70\x93\x80\x4f\xf1\x3b\x60\xe2\x14\x2e\x2e\xfb\x3f\x2b\xdd\x4f\x21\x65\xd0\x23\xf2\xb8\xdd\x97\x28\x50\x03\xa9\x4f\x5d\xfb\xa7\xf3\xbf\x71\xa7\xe6\x9d\x4b\xa7\x2f\xe0\x66\x70\x08\x24\xbf\x18\x9c\xc1\xb3\xf3\xb0\x3b\xef\x3c\x1f\x85\x28\x67\x70\x43\x87\xc0\x61\x07\x4e\x09\xc7\x62\xda\x57\x47\x51\xb2\x78\x3a\x8d\x47\xa7\xae\xaf\x6f\x82\xeb\xc9\xdf\x0b\x65\x3c\x34\x8c\x8b\x66\xea\xbb\xbc\x07\x8e\x18\x9f\x02\xb7\xc1\x6e\x06\xd0\x79\xd8\x90\xa7\x8b\xad\xa8\xc5\xc2\x9b\xc0\x85\xbe\xbd\x01\x5d\x58\xf8\x5e\xf0\x58\xcf\x2e\xf8\xc2\x7c\xe1\x01\x74\xcc\x4f\x88\x60\xd8\xd4\x16\x0c\x83\x23\xfb\x51\x0c\xbb\xd9\xc0\xd1\xd5\xdb\x4d\x14\x3d\xf9\x7b\x31\x8c\xdb\xef\x06\x82\xae\x6a\x30\x7e\xaf\xfb\xce\xfd\x24\xf8\x39\xfd\xdb\xd0\xf3\x4e\xec\xc7\xce\x09\x6f\x22\x17\x0d\x46\x1b\xf0\x45\x6b\xdf\x8b\x61\xaf\x6a\x17\x90\xfd\x40\xc6\x68\x76\x22\x4f\x88\x68\xef\xd6\x36\x58\x23\x97\xf6\x63\xdb\xab\x89\x00\x26\x07\xfb\x3b\x97\x85\xf8\xd6\x35\x1d\x7c\x91\x93\x34\x08\xd9\xec\x37\xa9\xca\x74\x4a\x8f\x19\x61\xfd\x9d\xd5\xb4\x3c\xb1\xb0\x00\x9b\x9d\x54\x58\xa7\x83\x36\x67\x93\xfb\xe4\xef\x01\x00\xe7\x5c\xd9\xcc\x24\x25\x00\x00")
 
 func schemaGoBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +113,7 @@ func schemaGo() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "schema.go", size: 7250, mode: os.FileMode(420), modTime: time.Unix(1570093680, 0)}
+	info := bindataFileInfo{name: "schema.go", size: 9508, mode: os.FileMode(420), modTime: time.Unix(1792005324, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
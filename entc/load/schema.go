@@ -23,6 +23,7 @@ type Schema struct {
 	Fields       []*Field       `json:"fields,omitempty"`
 	Indexes      []*Index       `json:"indexes,omitempty"`
 	StructFields []*StructField `json:"struct_fields,omitempty"`
+	Validators   int            `json:"validators,omitempty"`
 }
 
 // Position describes a field position in the schema.
@@ -34,20 +35,26 @@ type Position struct {
 
 // Field represents an ent.Field that was loaded from a complied user package.
 type Field struct {
-	Name          string          `json:"name,omitempty"`
-	Info          *field.TypeInfo `json:"type,omitempty"`
-	Tag           string          `json:"tag,omitempty"`
-	Size          *int64          `json:"size,omitempty"`
-	Enums         []string        `json:"enums,omitempty"`
-	Unique        bool            `json:"unique,omitempty"`
-	Nillable      bool            `json:"nillable,omitempty"`
-	Optional      bool            `json:"optional,omitempty"`
-	Default       bool            `json:"default,omitempty"`
-	UpdateDefault bool            `json:"update_default,omitempty"`
-	Immutable     bool            `json:"immutable,omitempty"`
-	Validators    int             `json:"validators,omitempty"`
-	StorageKey    string          `json:"storage_key,omitempty"`
-	Position      *Position       `json:"position,omitempty"`
+	Name            string          `json:"name,omitempty"`
+	Info            *field.TypeInfo `json:"type,omitempty"`
+	Tag             string          `json:"tag,omitempty"`
+	Size            *int64          `json:"size,omitempty"`
+	Enums           []string        `json:"enums,omitempty"`
+	Unique          bool            `json:"unique,omitempty"`
+	Nillable        bool            `json:"nillable,omitempty"`
+	Optional        bool            `json:"optional,omitempty"`
+	Default         bool            `json:"default,omitempty"`
+	UpdateDefault   bool            `json:"update_default,omitempty"`
+	Immutable       bool            `json:"immutable,omitempty"`
+	NillableStorage bool            `json:"nillable_storage,omitempty"`
+	Sensitive       bool            `json:"sensitive,omitempty"`
+	Validators      int             `json:"validators,omitempty"`
+	Transformers    int             `json:"transformers,omitempty"`
+	StorageKey      string          `json:"storage_key,omitempty"`
+	Renamed         []string        `json:"renamed,omitempty"`
+	Precision       int             `json:"precision,omitempty"`
+	DateOnly        bool            `json:"date_only,omitempty"`
+	Position        *Position       `json:"position,omitempty"`
 }
 
 // StructField represents an external struct field defined in the schema.
@@ -62,33 +69,41 @@ type StructField struct {
 
 // Edge represents an ent.Edge that was loaded from a complied user package.
 type Edge struct {
-	Name     string `json:"name,omitempty"`
-	Type     string `json:"type,omitempty"`
-	Tag      string `json:"tag,omitempty"`
-	RefName  string `json:"ref_name,omitempty"`
-	Ref      *Edge  `json:"ref,omitempty"`
-	Unique   bool   `json:"unique,omitempty"`
-	Inverse  bool   `json:"inverse,omitempty"`
-	Required bool   `json:"required,omitempty"`
+	Name       string           `json:"name,omitempty"`
+	Type       string           `json:"type,omitempty"`
+	Tag        string           `json:"tag,omitempty"`
+	RefName    string           `json:"ref_name,omitempty"`
+	Ref        *Edge            `json:"ref,omitempty"`
+	Unique     bool             `json:"unique,omitempty"`
+	Inverse    bool             `json:"inverse,omitempty"`
+	Required   bool             `json:"required,omitempty"`
+	OnDelete   string           `json:"on_delete,omitempty"`
+	StorageKey *edge.StorageKey `json:"storage_key,omitempty"`
+	CountField string           `json:"count_field,omitempty"`
 }
 
 // Index represents an ent.Index that was loaded from a complied user package.
 type Index struct {
-	Unique bool     `json:"unique,omitempty"`
-	Edges  []string `json:"edges,omitempty"`
-	Fields []string `json:"fields,omitempty"`
+	Unique   bool           `json:"unique,omitempty"`
+	Type     string         `json:"type,omitempty"`
+	Edges    []string       `json:"edges,omitempty"`
+	Fields   []string       `json:"fields,omitempty"`
+	Prefixes map[string]int `json:"prefixes,omitempty"`
 }
 
 // NewEdge creates an loaded edge from edge descriptor.
 func NewEdge(ed *edge.Descriptor) *Edge {
 	ne := &Edge{
-		Tag:      ed.Tag,
-		Type:     ed.Type,
-		Name:     ed.Name,
-		Unique:   ed.Unique,
-		Inverse:  ed.Inverse,
-		Required: ed.Required,
-		RefName:  ed.RefName,
+		Tag:        ed.Tag,
+		Type:       ed.Type,
+		Name:       ed.Name,
+		Unique:     ed.Unique,
+		Inverse:    ed.Inverse,
+		Required:   ed.Required,
+		RefName:    ed.RefName,
+		OnDelete:   string(ed.OnDelete),
+		StorageKey: ed.StorageKey,
+		CountField: ed.CountField,
 	}
 	if ref := ed.Ref; ref != nil {
 		ne.Ref = NewEdge(ref)
@@ -98,23 +113,38 @@ func NewEdge(ed *edge.Descriptor) *Edge {
 
 // NewField creates an loaded field from edge descriptor.
 func NewField(fd *field.Descriptor) (*Field, error) {
+	if fd.Err != nil {
+		return nil, fmt.Errorf("field %q: %v", fd.Name, fd.Err)
+	}
 	sf := &Field{
-		Name:          fd.Name,
-		Info:          fd.Info,
-		Tag:           fd.Tag,
-		Enums:         fd.Enums,
-		Unique:        fd.Unique,
-		Nillable:      fd.Nillable,
-		Optional:      fd.Optional,
-		Immutable:     fd.Immutable,
-		StorageKey:    fd.StorageKey,
-		Validators:    len(fd.Validators),
-		Default:       fd.Default != nil,
-		UpdateDefault: fd.UpdateDefault != nil,
+		Name:            fd.Name,
+		Info:            fd.Info,
+		Tag:             fd.Tag,
+		Enums:           fd.Enums,
+		Unique:          fd.Unique,
+		Nillable:        fd.Nillable,
+		Optional:        fd.Optional,
+		Immutable:       fd.Immutable,
+		NillableStorage: fd.NillableStorage,
+		Sensitive:       fd.Sensitive,
+		StorageKey:      fd.StorageKey,
+		Renamed:         fd.Renamed,
+		Precision:       fd.Precision,
+		DateOnly:        fd.DateOnly,
+		Validators:      len(fd.Validators),
+		Transformers:    len(fd.Transformers),
+		Default:         fd.Default != nil,
+		UpdateDefault:   fd.UpdateDefault != nil,
 	}
 	if sf.Info == nil {
 		return nil, fmt.Errorf("missing type info for field %q", sf.Name)
 	}
+	if sf.Info.ValueScanner && (sf.Default || sf.UpdateDefault || sf.Validators > 0 || sf.Transformers > 0) {
+		return nil, fmt.Errorf("field %q: defaults, validators and transformers are not supported for GoType fields", sf.Name)
+	}
+	if sf.NillableStorage && (sf.Nillable || sf.Info.ValueScanner) {
+		return nil, fmt.Errorf("field %q: NillableStorage is not supported for Nillable and GoType fields", sf.Name)
+	}
 	if size := int64(fd.Size); size != 0 {
 		sf.Size = &size
 	}
@@ -145,11 +175,18 @@ func MarshalSchema(schema ent.Interface) (b []byte, err error) {
 	for _, idx := range indexes {
 		idx := idx.Descriptor()
 		s.Indexes = append(s.Indexes, &Index{
-			Edges:  idx.Edges,
-			Fields: idx.Fields,
-			Unique: idx.Unique,
+			Type:     idx.Type,
+			Edges:    idx.Edges,
+			Fields:   idx.Fields,
+			Unique:   idx.Unique,
+			Prefixes: idx.Prefixes,
 		})
 	}
+	validators, err := safeValidators(schema)
+	if err != nil {
+		return nil, fmt.Errorf("schema %q: %v", s.Name, err)
+	}
+	s.Validators = len(validators)
 	return json.Marshal(s)
 }
 
@@ -236,6 +273,17 @@ func safeMixin(schema ent.Interface) (mixin []ent.Mixin, err error) {
 	return schema.Mixin(), nil
 }
 
+// safeValidators wraps the schema.Validators method with recover to ensure no panics in marshaling.
+func safeValidators(schema ent.Interface) (validators []ent.Validator, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("schema.Validators panics: %v", v)
+			validators = nil
+		}
+	}()
+	return schema.Validators(), nil
+}
+
 func indirect(t reflect.Type) reflect.Type {
 	for t.Kind() == reflect.Ptr {
 		t = t.Elem()
@@ -250,3 +250,31 @@ func TestMarshalMixin(t *testing.T) {
 	require.False(t, schema.Fields[3].Position.MixedIn)
 	require.Equal(t, 0, schema.Fields[3].Position.Index)
 }
+
+type WithValidators struct {
+	ent.Schema
+}
+
+func (WithValidators) Validators() []ent.Validator {
+	return []ent.Validator{
+		func(ent.Mutation) error { return nil },
+		func(ent.Mutation) error { return nil },
+	}
+}
+
+func TestMarshalValidators(t *testing.T) {
+	buf, err := MarshalSchema(WithValidators{})
+	require.NoError(t, err)
+
+	schema := &Schema{}
+	err = json.Unmarshal(buf, schema)
+	require.NoError(t, err)
+	require.Equal(t, 2, schema.Validators)
+
+	buf, err = MarshalSchema(WithMixin{})
+	require.NoError(t, err)
+	schema = &Schema{}
+	err = json.Unmarshal(buf, schema)
+	require.NoError(t, err)
+	require.Zero(t, schema.Validators)
+}
@@ -0,0 +1,364 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entcache provides a caching layer for SQL drivers. It memoizes the results
+// of Query calls, keyed by the query and its arguments, in 2 levels:
+//
+//	- Context level. Results are cached in the context that was returned by NewContext,
+//	  and they are dropped together with it. For example, for the lifetime of a request.
+//
+//	- Driver level. Results are cached in the driver for a fixed TTL (see WithTTL).
+//
+// The cached entries are invalidated by the Exec calls of the driver (outside of a transaction),
+// or by committed transactions that executed statements. Entries are tagged with the tables they
+// were read from, and statements that are executed with a context returned by Invalidates evict
+// only the entries of the given tables. The code generated with the "entcache" feature executes
+// the mutations of each type this way. Other statements invalidate all entries.
+//
+//	drv := entcache.NewDriver(db, entcache.WithTTL(time.Minute))
+//	client := ent.NewClient(ent.Driver(drv))
+//
+package entcache
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+type (
+	// Driver is a dialect.Driver that caches the results of Query calls.
+	Driver struct {
+		dialect.Driver
+		ttl   time.Duration
+		mu    sync.Mutex
+		cache map[string]*entry
+		stats Stats
+		// gen is the generation of the cache. It's incremented on every
+		// eviction, and results of queries that were started in an older
+		// generation are not stored, since they may be stale.
+		gen uint64
+	}
+
+	// Stats holds the statistics of the cache.
+	Stats struct {
+		// Gets is the number of cache lookups.
+		Gets uint64
+		// Hits is the number of lookups that found a cached entry.
+		Hits uint64
+		// Evictions is the number of times the cache was invalidated.
+		Evictions uint64
+	}
+
+	// Option allows configuring the driver using functional options.
+	Option func(*Driver)
+
+	// entry is a cached query result.
+	entry struct {
+		columns []string
+		values  [][]interface{}
+		// tables the query reads from. An entry
+		// without tables is evicted on every Exec.
+		tables []string
+		expire time.Time
+	}
+)
+
+// NewDriver wraps the given SQL driver with a caching layer.
+// By default, only the context level cache is enabled.
+func NewDriver(drv dialect.Driver, opts ...Option) *Driver {
+	d := &Driver{Driver: drv, cache: make(map[string]*entry)}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// WithTTL enables the driver level cache, and sets the duration that entries are kept in it.
+func WithTTL(ttl time.Duration) Option {
+	return func(d *Driver) {
+		d.ttl = ttl
+	}
+}
+
+// Query implements the dialect.Query method. If the query result is cached in one
+// of the levels, it's loaded from the cache. Otherwise, the query is executed on
+// the underlying driver, and its result is stored in the cache.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	rows, ok := v.(*sql.Rows)
+	if !ok || skipped(ctx) {
+		return d.Driver.Query(ctx, query, args, v)
+	}
+	c, _ := ctx.Value(ctxKey{}).(*ctxCache)
+	if c == nil && d.ttl == 0 {
+		return d.Driver.Query(ctx, query, args, v)
+	}
+	key := fmt.Sprintf("%s %#v", query, args)
+	atomic.AddUint64(&d.stats.Gets, 1)
+	if e, ok := d.get(c, key); ok {
+		atomic.AddUint64(&d.stats.Hits, 1)
+		return e.scan(ctx, rows)
+	}
+	gen := d.generation()
+	if err := d.Driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	e, err := read(rows)
+	if err != nil {
+		return err
+	}
+	e.tables = tablesOf(query)
+	d.set(c, key, e, gen)
+	return e.scan(ctx, rows)
+}
+
+// Exec implements the dialect.Exec method, and invalidates the cache.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if err := d.Driver.Exec(ctx, query, args, v); err != nil {
+		return err
+	}
+	d.evict(ctx, invalidated(ctx))
+	return nil
+}
+
+// Tx wraps the transaction of the underlying driver, in order to invalidate
+// the cache when it's committed. Queries that are executed in transactions
+// are not cached.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, ctx: ctx, drv: d}, nil
+}
+
+// Evict invalidates the entries of the given tables in the driver level cache,
+// or all its entries if no tables were given.
+func (d *Driver) Evict(tables ...string) {
+	d.evict(context.Background(), tables)
+}
+
+// Stats returns a snapshot of the cache statistics.
+func (d *Driver) Stats() Stats {
+	return Stats{
+		Gets:      atomic.LoadUint64(&d.stats.Gets),
+		Hits:      atomic.LoadUint64(&d.stats.Hits),
+		Evictions: atomic.LoadUint64(&d.stats.Evictions),
+	}
+}
+
+// evict invalidates the entries of the given tables (or all entries, if no tables were given)
+// in the driver level cache and in the context level cache of the given context.
+func (d *Driver) evict(ctx context.Context, tables []string) {
+	d.mu.Lock()
+	d.gen++
+	if c, ok := ctx.Value(ctxKey{}).(*ctxCache); ok {
+		c.evict(tables)
+	}
+	for k, e := range d.cache {
+		if e.affected(tables) {
+			delete(d.cache, k)
+		}
+	}
+	d.mu.Unlock()
+	atomic.AddUint64(&d.stats.Evictions, 1)
+}
+
+// generation returns the current generation of the cache.
+func (d *Driver) generation() uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.gen
+}
+
+func (d *Driver) get(c *ctxCache, key string) (*entry, bool) {
+	if c != nil {
+		if e, ok := c.get(key); ok {
+			return e, true
+		}
+	}
+	if d.ttl == 0 {
+		return nil, false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	e, ok := d.cache[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expire) {
+		delete(d.cache, key)
+		return nil, false
+	}
+	return e, true
+}
+
+// set stores the entry in the cache levels, unless the cache was evicted
+// since the given generation, while the query of the entry was executed.
+func (d *Driver) set(c *ctxCache, key string, e *entry, gen uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.gen != gen {
+		return
+	}
+	if c != nil {
+		c.set(key, e)
+	}
+	if d.ttl == 0 {
+		return
+	}
+	d.cache[key] = &entry{columns: e.columns, values: e.values, tables: e.tables, expire: time.Now().Add(d.ttl)}
+}
+
+// Tx is a transaction that invalidates the cache of its driver when it's
+// committed, if statements were executed in it.
+type Tx struct {
+	dialect.Tx
+	ctx context.Context
+	drv *Driver
+	// all indicates if a statement that is not limited
+	// to specific tables was executed in the transaction.
+	all    bool
+	tables []string
+}
+
+// Exec implements the dialect.Exec method.
+func (t *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if err := t.Tx.Exec(ctx, query, args, v); err != nil {
+		return err
+	}
+	if tables := invalidated(ctx); len(tables) > 0 {
+		t.tables = append(t.tables, tables...)
+	} else {
+		t.all = true
+	}
+	return nil
+}
+
+// Commit commits the transaction, and invalidates the cache.
+func (t *Tx) Commit() error {
+	if err := t.Tx.Commit(); err != nil {
+		return err
+	}
+	switch {
+	case t.all:
+		t.drv.evict(t.ctx, nil)
+	case len(t.tables) > 0:
+		t.drv.evict(t.ctx, t.tables)
+	}
+	return nil
+}
+
+type (
+	ctxKey        struct{}
+	skipKey       struct{}
+	invalidateKey struct{}
+
+	// ctxCache is the context level cache.
+	ctxCache struct {
+		mu    sync.Mutex
+		cache map[string]*entry
+	}
+)
+
+// NewContext returns a new context with a context level cache attached. Query results
+// that are executed with this context are cached for its lifetime.
+func NewContext(parent context.Context) context.Context {
+	return context.WithValue(parent, ctxKey{}, &ctxCache{cache: make(map[string]*entry)})
+}
+
+// Skip returns a new context that skips the cache for queries executed with it.
+func Skip(parent context.Context) context.Context {
+	return context.WithValue(parent, skipKey{}, true)
+}
+
+func skipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipKey{}).(bool)
+	return skip
+}
+
+// Invalidates returns a new context that limits the invalidation of the statements that are executed
+// with it to the entries of the given tables. It's used by the generated code for invalidating only the
+// entries of the types that are affected by a mutation.
+//
+//	ctx = entcache.Invalidates(ctx, "users", "user_friends")
+//
+func Invalidates(parent context.Context, tables ...string) context.Context {
+	return context.WithValue(parent, invalidateKey{}, tables)
+}
+
+// invalidated returns the tables that are invalidated by statements that are
+// executed with the given context. A nil slice means that all are invalidated.
+func invalidated(ctx context.Context) []string {
+	tables, _ := ctx.Value(invalidateKey{}).([]string)
+	return tables
+}
+
+// tablesRe matches the table names that follow the FROM and JOIN keywords of a query.
+var tablesRe = regexp.MustCompile("(?i)\\b(?:FROM|JOIN)\\s+([`\"]?\\w+[`\"]?(?:\\.[`\"]?\\w+[`\"]?)?)")
+
+// tablesOf returns the tables that the given query reads from.
+func tablesOf(query string) []string {
+	var tables []string
+	for _, m := range tablesRe.FindAllStringSubmatch(query, -1) {
+		tables = append(tables, unqualify(m[1]))
+	}
+	return tables
+}
+
+// unqualify returns the table name without its quotes and its schema qualifier.
+func unqualify(table string) string {
+	table = strings.NewReplacer("`", "", `"`, "").Replace(table)
+	if i := strings.LastIndexByte(table, '.'); i != -1 {
+		return table[i+1:]
+	}
+	return table
+}
+
+// affected reports if the entry is affected by a mutation of the given
+// tables. Entries without tables are affected by all mutations.
+func (e *entry) affected(tables []string) bool {
+	if len(tables) == 0 || len(e.tables) == 0 {
+		return true
+	}
+	for _, t := range tables {
+		t = unqualify(t)
+		for _, et := range e.tables {
+			if t == et {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *ctxCache) get(key string) (*entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.cache[key]
+	return e, ok
+}
+
+func (c *ctxCache) set(key string, e *entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache[key] = e
+}
+
+func (c *ctxCache) evict(tables []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.cache {
+		if e.affected(tables) {
+			delete(c.cache, k)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file:entcache?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	var res sql.Result
+	require.NoError(t, db.Exec(ctx, "CREATE TABLE users (id integer PRIMARY KEY, name varchar(255), age integer NULL, active bool)", []interface{}{}, &res))
+	require.NoError(t, db.Exec(ctx, "INSERT INTO users (name, age, active) VALUES (?, ?, ?), (?, NULL, ?)", []interface{}{"a8m", 30, true, "nati", false}, &res))
+
+	drv := NewDriver(db, WithTTL(time.Minute))
+	query := func(ctx context.Context) (names []string, ages []*int, actives []bool) {
+		rows := &sql.Rows{}
+		require.NoError(t, drv.Query(ctx, "SELECT name, age, active FROM users ORDER BY id", []interface{}{}, rows))
+		defer rows.Close()
+		for rows.Next() {
+			var (
+				name   string
+				age    sql.NullInt64
+				active bool
+			)
+			require.NoError(t, rows.Scan(&name, &age, &active))
+			names = append(names, name)
+			actives = append(actives, active)
+			if age.Valid {
+				v := int(age.Int64)
+				ages = append(ages, &v)
+			} else {
+				ages = append(ages, nil)
+			}
+		}
+		require.NoError(t, rows.Err())
+		return
+	}
+	for i := 0; i < 2; i++ {
+		names, ages, actives := query(ctx)
+		require.Equal(t, []string{"a8m", "nati"}, names)
+		require.Equal(t, 30, *ages[0])
+		require.Nil(t, ages[1])
+		require.Equal(t, []bool{true, false}, actives)
+	}
+	require.Equal(t, Stats{Gets: 2, Hits: 1}, drv.Stats())
+
+	// exec statements invalidate the cache.
+	require.NoError(t, drv.Exec(ctx, "UPDATE users SET name = ? WHERE id = 1", []interface{}{"Ariel"}, &res))
+	names, _, _ := query(ctx)
+	require.Equal(t, []string{"Ariel", "nati"}, names)
+	require.Equal(t, Stats{Gets: 3, Hits: 1, Evictions: 1}, drv.Stats())
+
+	// transactions invalidate the cache on commit.
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, "UPDATE users SET name = ? WHERE id = 1", []interface{}{"a8m"}, &res))
+	names, _, _ = query(ctx)
+	require.Equal(t, []string{"Ariel", "nati"}, names, "served from cache before commit")
+	require.NoError(t, tx.Commit())
+	names, _, _ = query(ctx)
+	require.Equal(t, []string{"a8m", "nati"}, names)
+
+	// skip the cache.
+	stats := drv.Stats()
+	query(Skip(ctx))
+	require.Equal(t, stats, drv.Stats())
+}
+
+func TestDriver_Invalidates(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file:entcache_tables?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	var res sql.Result
+	require.NoError(t, db.Exec(ctx, "CREATE TABLE `users` (`id` integer PRIMARY KEY, `name` varchar(255))", []interface{}{}, &res))
+	require.NoError(t, db.Exec(ctx, "CREATE TABLE `pets` (`id` integer PRIMARY KEY, `owner_id` integer NULL)", []interface{}{}, &res))
+	require.NoError(t, db.Exec(ctx, "INSERT INTO `users` (`name`) VALUES (?)", []interface{}{"a8m"}, &res))
+
+	drv := NewDriver(db, WithTTL(time.Minute))
+	count := func(ctx context.Context, query string) (n int) {
+		rows := &sql.Rows{}
+		require.NoError(t, drv.Query(ctx, query, []interface{}{}, rows))
+		defer rows.Close()
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&n))
+		return n
+	}
+	const (
+		users = "SELECT COUNT(*) FROM `users`"
+		pets  = "SELECT COUNT(*) FROM `pets` JOIN `users` AS `t0` ON `pets`.`owner_id` = `t0`.`id`"
+	)
+	require.Equal(t, 1, count(ctx, users))
+	require.Equal(t, 0, count(ctx, pets))
+
+	// mutations of the pets table do not evict the entries of the users table.
+	require.NoError(t, drv.Exec(Invalidates(ctx, "pets"), "INSERT INTO `pets` (`owner_id`) VALUES (1)", []interface{}{}, &res))
+	require.NoError(t, db.Exec(ctx, "INSERT INTO `users` (`name`) VALUES (?)", []interface{}{"nati"}, &res))
+	require.Equal(t, 1, count(ctx, users), "served from cache")
+	require.Equal(t, 1, count(ctx, pets))
+	require.Equal(t, Stats{Gets: 4, Hits: 1, Evictions: 1}, drv.Stats())
+
+	// transactions invalidate the tables of their statements on commit.
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(Invalidates(ctx, "users"), "DELETE FROM `users` WHERE `id` = 2", []interface{}{}, &res))
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 1, count(ctx, users))
+	require.Equal(t, 1, count(ctx, pets))
+	require.Equal(t, Stats{Gets: 6, Hits: 1, Evictions: 2}, drv.Stats(), "entries that join users were evicted")
+}
+
+func TestDriver_Context(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file:entcache_ctx?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	var res sql.Result
+	require.NoError(t, db.Exec(ctx, "CREATE TABLE users (id integer PRIMARY KEY, name varchar(255))", []interface{}{}, &res))
+	require.NoError(t, db.Exec(ctx, "INSERT INTO users (name) VALUES (?)", []interface{}{"a8m"}, &res))
+
+	drv := NewDriver(db)
+	count := func(ctx context.Context) (n int) {
+		rows := &sql.Rows{}
+		require.NoError(t, drv.Query(ctx, "SELECT COUNT(*) FROM users WHERE name = ?", []interface{}{"a8m"}, rows))
+		defer rows.Close()
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&n))
+		return n
+	}
+	// without context level cache and ttl, queries are not cached.
+	require.Equal(t, 1, count(ctx))
+	require.Equal(t, Stats{}, drv.Stats())
+
+	cctx := NewContext(ctx)
+	require.Equal(t, 1, count(cctx))
+	require.NoError(t, db.Exec(ctx, "INSERT INTO users (name) VALUES (?)", []interface{}{"a8m"}, &res))
+	require.Equal(t, 1, count(cctx), "served from the context cache")
+	require.Equal(t, 2, count(NewContext(ctx)))
+	require.Equal(t, Stats{Gets: 3, Hits: 1}, drv.Stats())
+}
+
+// queryHook is a driver that calls a hook after executing queries.
+type queryHook struct {
+	dialect.Driver
+	hook func()
+}
+
+func (d *queryHook) Query(ctx context.Context, query string, args, v interface{}) error {
+	if err := d.Driver.Query(ctx, query, args, v); err != nil {
+		return err
+	}
+	d.hook()
+	return nil
+}
+
+func TestDriver_EvictDuringQuery(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file:entcache_gen?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	var res sql.Result
+	require.NoError(t, db.Exec(ctx, "CREATE TABLE users (id integer PRIMARY KEY, name varchar(255))", []interface{}{}, &res))
+	require.NoError(t, db.Exec(ctx, "INSERT INTO users (name) VALUES (?)", []interface{}{"a8m"}, &res))
+
+	hook := &queryHook{Driver: db, hook: func() {}}
+	drv := NewDriver(hook, WithTTL(time.Minute))
+	count := func(ctx context.Context) (n int) {
+		rows := &sql.Rows{}
+		require.NoError(t, drv.Query(ctx, "SELECT COUNT(*) FROM users", []interface{}{}, rows))
+		defer rows.Close()
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&n))
+		return n
+	}
+	// an eviction that happens while the query is executed,
+	// prevents its (possibly stale) result from being cached.
+	hook.hook = func() { drv.Evict("users") }
+	cctx := NewContext(ctx)
+	require.Equal(t, 1, count(cctx))
+	hook.hook = func() {}
+	require.Equal(t, 1, count(cctx))
+	require.Equal(t, Stats{Gets: 2, Evictions: 1}, drv.Stats(), "result was not cached")
+	require.Equal(t, 1, count(cctx))
+	require.Equal(t, Stats{Gets: 3, Hits: 1, Evictions: 1}, drv.Stats())
+}
@@ -0,0 +1,128 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entcache
+
+import (
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// read reads and closes the given rows, and returns their values as a cache entry.
+func read(rows *sql.Rows) (*entry, error) {
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	e := &entry{columns: columns}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		args := make([]interface{}, len(columns))
+		for i := range values {
+			args[i] = &values[i]
+		}
+		if err := rows.Scan(args...); err != nil {
+			return nil, err
+		}
+		e.values = append(e.values, values)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// entries is an in-memory database that serves the cached entries as standard sql.Rows,
+// in order to keep their scanning (and the conversion of their values) as in the original
+// rows. The entry of a query is passed to the database in the context of the query.
+var entries = stdsql.OpenDB(connector{})
+
+// entryKey is the context key of the entry that is served by the database.
+type entryKey struct{}
+
+// scan sets the given rows to iterate over the cached values of the entry.
+func (e *entry) scan(ctx context.Context, rows *sql.Rows) error {
+	r, err := entries.QueryContext(context.WithValue(ctx, entryKey{}, e), "")
+	if err != nil {
+		return err
+	}
+	*rows = sql.Rows{Rows: r}
+	return nil
+}
+
+type (
+	// connector and conn implement the database/sql/driver interfaces
+	// for querying the entries database.
+	connector struct{}
+	conn      struct{}
+
+	// rows implements the driver.Rows interface for cached entries.
+	rows struct {
+		*entry
+		cursor int
+	}
+)
+
+var errNotSupported = errors.New("entcache: operation is not supported by the cache")
+
+// Connect implements the driver.Connector interface.
+func (connector) Connect(context.Context) (driver.Conn, error) { return conn{}, nil }
+
+// Driver implements the driver.Connector interface.
+func (connector) Driver() driver.Driver { return connector{} }
+
+// Open implements the driver.Driver interface.
+func (connector) Open(string) (driver.Conn, error) { return conn{}, nil }
+
+// QueryContext implements the driver.QueryerContext interface.
+func (conn) QueryContext(ctx context.Context, _ string, _ []driver.NamedValue) (driver.Rows, error) {
+	e, ok := ctx.Value(entryKey{}).(*entry)
+	if !ok {
+		return nil, errNotSupported
+	}
+	return &rows{entry: e}, nil
+}
+
+// Prepare implements the driver.Conn interface.
+func (conn) Prepare(string) (driver.Stmt, error) { return nil, errNotSupported }
+
+// Begin implements the driver.Conn interface.
+func (conn) Begin() (driver.Tx, error) { return nil, errNotSupported }
+
+// Close implements the driver.Conn interface.
+func (conn) Close() error { return nil }
+
+// Columns implements the driver.Rows interface.
+func (r *rows) Columns() []string { return r.columns }
+
+// Close implements the driver.Rows interface.
+func (r *rows) Close() error { return nil }
+
+// Next implements the driver.Rows interface.
+func (r *rows) Next(dest []driver.Value) error {
+	if r.cursor >= len(r.values) {
+		return io.EOF
+	}
+	for i, v := range r.values[r.cursor] {
+		dest[i] = clone(v)
+	}
+	r.cursor++
+	return nil
+}
+
+// clone returns a copy of byte slices, in order to keep the cached values unmodified.
+func clone(v driver.Value) driver.Value {
+	if b, ok := v.([]byte); ok {
+		c := make([]byte, len(b))
+		copy(c, b)
+		return c
+	}
+	return v
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/examples/edgeindex/ent/city"
 )
 
 // City is the model entity for the City schema.
@@ -20,19 +21,41 @@ type City struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the CityQuery when eager-loading is set.
+	Edges CityEdges `json:"edges"`
+}
+
+// CityEdges holds the relations/edges of other nodes in the graph.
+type CityEdges struct {
+	// Streets holds the value of the streets edge.
+	Streets []*Street `json:"streets,omitempty"`
 }
 
 // FromRows scans the sql response data into City.
 func (c *City) FromRows(rows *sql.Rows) error {
+	return c.scan(rows, city.Columns)
+}
+
+// scan scans the given columns of the sql response data into City.
+// Fields that their columns were not selected are left with their zero values.
+func (c *City) scan(rows *sql.Rows, columns []string) error {
 	var vc struct {
 		ID   int
 		Name sql.NullString
 	}
-	// the order here should be the same as in the `city.Columns`.
-	if err := rows.Scan(
-		&vc.ID,
-		&vc.Name,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case city.FieldID:
+			values[i] = &vc.ID
+		case city.FieldName:
+			values[i] = &vc.Name
+		default:
+			return fmt.Errorf("unexpected column %q for type City", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	c.ID = vc.ID
@@ -63,6 +86,35 @@ func (c *City) Unwrap() *City {
 	return c
 }
 
+// Clone returns a deep copy of the City, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (c *City) Clone() *City {
+	return c.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the City, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (c *City) clone(seen map[interface{}]interface{}) *City {
+	if c == nil {
+		return nil
+	}
+	if _c, ok := seen[c]; ok {
+		return _c.(*City)
+	}
+	_c := *c
+	_c.config = config{}
+	seen[c] = &_c
+	if nodes := c.Edges.Streets; nodes != nil {
+		_c.Edges.Streets = make([]*Street, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Streets[_i] = _n.clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (c *City) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -73,14 +125,30 @@ func (c *City) String() string {
 	return buf.String()
 }
 
+// DiffCity returns the fields that have different values in the given Cities,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffCity(a, b *City) []FieldChange {
+	var changes []FieldChange
+	if a.Name != b.Name {
+		changes = append(changes, FieldChange{Field: city.FieldName, Old: a.Name, New: b.Name})
+	}
+	return changes
+}
+
 // Cities is a parsable slice of City.
 type Cities []*City
 
 // FromRows scans the sql response data into Cities.
 func (c *Cities) FromRows(rows *sql.Rows) error {
+	return c.scan(rows, city.Columns)
+}
+
+// scan scans the given columns of the sql response data into Cities.
+func (c *Cities) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vc := &City{}
-		if err := vc.FromRows(rows); err != nil {
+		if err := vc.scan(rows, columns); err != nil {
 			return err
 		}
 		*c = append(*c, vc)
@@ -6,6 +6,12 @@
 
 package city
 
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/examples/edgeindex/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the city type in the database.
 	Label = "city"
@@ -30,3 +36,60 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope is a reusable named scope of the City queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.City
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the City type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "City",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeInt,
+		GoType:     "int",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "name",
+			StorageKey: FieldName,
+			Type:       field.TypeString,
+			GoType:     "string",
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "streets",
+			Type:     "Street",
+			Rel:      "O2M",
+			Optional: true,
+			Table:    "streets",
+			Columns:  []string{"city_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the City type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
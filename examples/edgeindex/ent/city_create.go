@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/city"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/street"
@@ -19,8 +20,12 @@ import (
 // CityCreate is the builder for creating a City entity.
 type CityCreate struct {
 	config
-	name    *string
-	streets map[int]struct{}
+	cityMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (cc *CityCreate) Mutation() *CityMutation {
+	return &CityMutation{cityMutation: &cc.cityMutation, op: ent.OpCreate}
 }
 
 // SetName sets the name field.
@@ -50,16 +55,20 @@ func (cc *CityCreate) AddStreets(s ...*Street) *CityCreate {
 }
 
 // Save creates the City in the database.
-func (cc *CityCreate) Save(ctx context.Context) (*City, error) {
+func (cc *CityCreate) Save(ctx context.Context, opts ...ent.CallOption) (*City, error) {
+	options := ent.NewCallOptions(opts...)
 	if cc.name == nil {
 		return nil, errors.New("ent: missing required field \"name\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return cc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (cc *CityCreate) SaveX(ctx context.Context) *City {
-	v, err := cc.Save(ctx)
+func (cc *CityCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *City {
+	v, err := cc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -106,7 +115,7 @@ func (cc *CityCreate) sqlSave(ctx context.Context) (*City, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(cc.streets) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"streets\" %v already connected to a different \"City\"", keys(cc.streets))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"streets\" %v already connected to a different \"City\"", keys(cc.streets))})
 		}
 	}
 	if err := tx.Commit(); err != nil {
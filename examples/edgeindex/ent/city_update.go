@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/city"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/predicate"
@@ -19,10 +20,11 @@ import (
 // CityUpdate is the builder for updating City entities.
 type CityUpdate struct {
 	config
-	name           *string
-	streets        map[int]struct{}
-	removedStreets map[int]struct{}
-	predicates     []predicate.City
+	cityMutation
+	predicates []predicate.City
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*City
 }
 
 // Where adds a new predicate for the builder.
@@ -31,6 +33,11 @@ func (cu *CityUpdate) Where(ps ...predicate.City) *CityUpdate {
 	return cu
 }
 
+// Mutation returns the mutation of the builder.
+func (cu *CityUpdate) Mutation() *CityMutation {
+	return &CityMutation{cityMutation: &cu.cityMutation, op: ent.OpUpdate}
+}
+
 // SetName sets the name field.
 func (cu *CityUpdate) SetName(s string) *CityUpdate {
 	cu.name = &s
@@ -57,6 +64,12 @@ func (cu *CityUpdate) AddStreets(s ...*Street) *CityUpdate {
 	return cu.AddStreetIDs(ids...)
 }
 
+// ClearStreets clears all streets edges to Street.
+func (cu *CityUpdate) ClearStreets() *CityUpdate {
+	cu.clearedStreets = true
+	return cu
+}
+
 // RemoveStreetIDs removes the streets edge to Street by ids.
 func (cu *CityUpdate) RemoveStreetIDs(ids ...int) *CityUpdate {
 	if cu.removedStreets == nil {
@@ -78,13 +91,46 @@ func (cu *CityUpdate) RemoveStreets(s ...*Street) *CityUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (cu *CityUpdate) Save(ctx context.Context) (int, error) {
+func (cu *CityUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := cu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (cu *CityUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptCity(ctx, cu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cu
+		intercepted.predicates = append(predicates, cu.predicates...)
+		cu = &intercepted
+	}
 	return cu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (cu *CityUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*City, error) {
+	var cs []*City
+	cu.returning = &cs
+	defer func() { cu.returning = nil }()
+	if _, err := cu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (cu *CityUpdate) SaveX(ctx context.Context) int {
-	affected, err := cu.Save(ctx)
+func (cu *CityUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := cu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -92,44 +138,48 @@ func (cu *CityUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (cu *CityUpdate) Exec(ctx context.Context) error {
-	_, err := cu.Save(ctx)
+func (cu *CityUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := cu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cu *CityUpdate) ExecX(ctx context.Context) {
-	if err := cu.Exec(ctx); err != nil {
+func (cu *CityUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (cu *CityUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(city.FieldID).From(sql.Table(city.Table))
+func (cu *CityUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(city.FieldID).From(sql.Table(city.Table)).SetDialect(cu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range cu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = cu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := cu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -141,11 +191,19 @@ func (cu *CityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if len(cu.removedStreets) > 0 {
-		eids := make([]int, len(cu.removedStreets))
+	if cu.clearedStreets {
+		query, args := sql.Update(city.StreetsTable).
+			SetNull(city.StreetsColumn).
+			Where(sql.InInts(city.StreetsColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(cu.removedStreets) > 0 {
+		eids := make([]int, 0, len(cu.removedStreets))
 		for eid := range cu.removedStreets {
 			eids = append(eids, eid)
 		}
@@ -155,7 +213,7 @@ func (cu *CityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(street.FieldID, eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(cu.streets) > 0 {
@@ -169,30 +227,55 @@ func (cu *CityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.And(p, sql.IsNull(city.StreetsColumn))).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(cu.streets) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"streets\" %v already connected to a different \"City\"", keys(cu.streets))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"streets\" %v already connected to a different \"City\"", keys(cu.streets))})
 			}
 		}
 	}
+	if cu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(city.Columns...).
+			From(sql.Table(city.Table)).
+			Where(sql.InInts(city.FieldID, ids...)).
+			SetDialect(cu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var cs Cities
+		err := cs.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into City: %v", err))
+		}
+		cs.config(cu.config)
+		*cu.returning = cs
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // CityUpdateOne is the builder for updating a single City entity.
 type CityUpdateOne struct {
 	config
-	id             int
-	name           *string
-	streets        map[int]struct{}
-	removedStreets map[int]struct{}
+	id     int
+	entity *City
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.City
+	cityMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (cuo *CityUpdateOne) Mutation() *CityMutation {
+	return &CityMutation{cityMutation: &cuo.cityMutation, op: ent.OpUpdateOne, id: &cuo.id}
 }
 
 // SetName sets the name field.
@@ -221,6 +304,12 @@ func (cuo *CityUpdateOne) AddStreets(s ...*Street) *CityUpdateOne {
 	return cuo.AddStreetIDs(ids...)
 }
 
+// ClearStreets clears all streets edges to Street.
+func (cuo *CityUpdateOne) ClearStreets() *CityUpdateOne {
+	cuo.clearedStreets = true
+	return cuo
+}
+
 // RemoveStreetIDs removes the streets edge to Street by ids.
 func (cuo *CityUpdateOne) RemoveStreetIDs(ids ...int) *CityUpdateOne {
 	if cuo.removedStreets == nil {
@@ -241,14 +330,45 @@ func (cuo *CityUpdateOne) RemoveStreets(s ...*Street) *CityUpdateOne {
 	return cuo.RemoveStreetIDs(ids...)
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (cuo *CityUpdateOne) SetChangedFrom(original *City) *CityUpdateOne {
+	c := cuo.entity
+	if c == nil || original == nil {
+		return cuo
+	}
+	for _, change := range DiffCity(original, c) {
+		switch change.Field {
+		case city.FieldName:
+			cuo.SetName(c.Name)
+		}
+	}
+	return cuo
+}
+
 // Save executes the query and returns the updated entity.
-func (cuo *CityUpdateOne) Save(ctx context.Context) (*City, error) {
+func (cuo *CityUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*City, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptCity(ctx, cuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cuo
+		intercepted.predicates = append(predicates, cuo.predicates...)
+		cuo = &intercepted
+	}
 	return cuo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (cuo *CityUpdateOne) SaveX(ctx context.Context) *City {
-	c, err := cuo.Save(ctx)
+func (cuo *CityUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *City {
+	c, err := cuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -256,21 +376,28 @@ func (cuo *CityUpdateOne) SaveX(ctx context.Context) *City {
 }
 
 // Exec executes the query on the entity.
-func (cuo *CityUpdateOne) Exec(ctx context.Context) error {
-	_, err := cuo.Save(ctx)
+func (cuo *CityUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := cuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cuo *CityUpdateOne) ExecX(ctx context.Context) {
-	if err := cuo.Exec(ctx); err != nil {
+func (cuo *CityUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (cuo *CityUpdateOne) sqlSave(ctx context.Context) (c *City, err error) {
-	selector := sql.Select(city.Columns...).From(sql.Table(city.Table))
+	selector := sql.Select(city.Columns...).From(sql.Table(city.Table)).SetDialect(cuo.driver.Dialect())
 	city.ID(cuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range cuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = cuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -312,8 +439,16 @@ func (cuo *CityUpdateOne) sqlSave(ctx context.Context) (c *City, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if len(cuo.removedStreets) > 0 {
-		eids := make([]int, len(cuo.removedStreets))
+	if cuo.clearedStreets {
+		query, args := sql.Update(city.StreetsTable).
+			SetNull(city.StreetsColumn).
+			Where(sql.InInts(city.StreetsColumn, ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(cuo.removedStreets) > 0 {
+		eids := make([]int, 0, len(cuo.removedStreets))
 		for eid := range cuo.removedStreets {
 			eids = append(eids, eid)
 		}
@@ -344,7 +479,7 @@ func (cuo *CityUpdateOne) sqlSave(ctx context.Context) (c *City, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(cuo.streets) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"streets\" %v already connected to a different \"City\"", keys(cuo.streets))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"streets\" %v already connected to a different \"City\"", keys(cuo.streets))})
 			}
 		}
 	}
@@ -33,7 +33,7 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	c := config{log: log.Println}
+	c := config{log: log.Println, inters: &inters{}}
 	c.options(opts...)
 	return &Client{
 		config: c,
@@ -53,13 +53,83 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 		if err != nil {
 			return nil, err
 		}
-		return NewClient(append(options, Driver(drv))...), nil
+		return open(append(options, Driver(drv))...)
 
 	default:
 		return nil, fmt.Errorf("unsupported driver: %q", driverName)
 	}
 }
 
+// Ping verifies that the connection to the database is still alive.
+func (c *Client) Ping(ctx context.Context) error {
+	switch c.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		rows := &sql.Rows{}
+		if err := c.driver.Query(ctx, "SELECT 1", []interface{}{}, rows); err != nil {
+			return err
+		}
+		return rows.Close()
+
+	default:
+		return fmt.Errorf("ent: unsupported dialect: %q", c.driver.Dialect())
+	}
+}
+
+// Ready reports whether the client is ready for serving requests. It checks that the
+// database is reachable, and that its schema was migrated by the same version of the
+// generated code.
+func (c *Client) Ready(ctx context.Context) error {
+	if err := c.Ping(ctx); err != nil {
+		return fmt.Errorf("ent: ping database: %v", err)
+	}
+	return c.CheckSchema(ctx)
+}
+
+// CheckSchema checks that the database schema was migrated by the same version of the
+// generated code (see migrate.Hash), and returns an *ErrSchemaVersion if it was not.
+// While the schema is in its expanded state (see migrate.ModeExpand), both the expanded
+// version and the previous one are accepted. Dialects without migration support are not
+// checked.
+func (c *Client) CheckSchema(ctx context.Context) error {
+	switch c.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		versions, err := c.Schema.Versions(ctx)
+		if err != nil {
+			return fmt.Errorf("ent: reading schema versions: %v", err)
+		}
+		if len(versions) == 0 {
+			return &ErrSchemaVersion{Client: migrate.Hash}
+		}
+		for _, v := range versions {
+			if v == migrate.Hash {
+				return nil
+			}
+		}
+		return &ErrSchemaVersion{Database: versions[0], Client: migrate.Hash}
+
+	default:
+		return fmt.Errorf("ent: unsupported dialect: %q", c.driver.Dialect())
+	}
+}
+
+// open creates a new client configured with the given options, and runs the
+// schema verification in case it was configured using the VerifySchema option.
+func open(opts ...Option) (*Client, error) {
+	c := NewClient(opts...)
+	if c.verify == nil {
+		return c, nil
+	}
+	switch err := c.CheckSchema(context.Background()); {
+	case err == nil:
+	case IsSchemaVersion(err) && !*c.verify:
+		c.log(err)
+	default:
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
 // Tx returns a new transactional client.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
@@ -69,7 +139,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: tx, log: c.log, debug: c.debug}
+	cfg := config{driver: tx, log: c.log, debug: c.debug, slow: c.slow, inters: c.inters.clone()}
 	return &Tx{
 		config: cfg,
 		City:   NewCityClient(cfg),
@@ -88,7 +158,9 @@ func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := config{driver: dialect.Debug(c.driver, c.log), log: c.log, debug: true}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	cfg.debug = true
 	return &Client{
 		config: cfg,
 		Schema: migrate.NewSchema(cfg.driver),
@@ -97,6 +169,39 @@ func (c *Client) Debug() *Client {
 	}
 }
 
+// With returns a shallow copy of the client configured with the given options, in addition
+// to the options of the client. The original client is not modified, and therefore, it's safe
+// to call With concurrently on a shared client (e.g. for a debug client of one request). The
+// driver of a transactional client (see Tx.Client) is not wrapped again by the logging options.
+//
+//	client.With(ent.Debug(), ent.Log(logger.Println)).
+//		City.
+//		Query().
+//		Count(ctx)
+//
+func (c *Client) With(opts ...Option) *Client {
+	cfg := c.config
+	cfg.options(opts...)
+	cfg.inters = c.inters.clone()
+	return &Client{
+		config: cfg,
+		Schema: migrate.NewSchema(cfg.driver),
+		City:   NewCityClient(cfg),
+		Street: NewStreetClient(cfg),
+	}
+}
+
+// Dialect returns the dialect of the client driver. It can be used to check
+// the features that are supported by the underlying database at runtime.
+//
+//	if client.Dialect().Supports(dialect.Upsert) {
+//		// ...
+//	}
+//
+func (c *Client) Dialect() dialect.Name {
+	return dialect.Name(c.driver.Dialect())
+}
+
 // Close closes the database connection and prevents new queries from starting.
 func (c *Client) Close() error {
 	return c.driver.Close()
@@ -107,11 +212,48 @@ type CityClient struct {
 	config
 }
 
+// CityQuerier is the read API of the CityClient. It's implemented by the CityClient, and it
+// can be used by services that depend only on reading Cities, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type CityQuerier interface {
+	Get(ctx context.Context, id int) (*City, error)
+	GetX(ctx context.Context, id int) *City
+	GetNotFoundOK(ctx context.Context, id int) (*City, error)
+	Exist(ctx context.Context, id int) (bool, error)
+	ExistX(ctx context.Context, id int) bool
+	Reload(ctx context.Context, nodes ...*City) error
+	ReloadX(ctx context.Context, nodes ...*City)
+}
+
+var _ CityQuerier = (*CityClient)(nil)
+
 // NewCityClient returns a client for the City from the given config.
 func NewCityClient(c config) *CityClient {
 	return &CityClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the City queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.City.Intercept(func(ctx context.Context, q *CityQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(city.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *CityClient) Intercept(interceptors ...CityInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.City = append(c.inters.City, interceptors...)
+}
+
 // Create returns a create builder for City.
 func (c *CityClient) Create() *CityCreate {
 	return &CityCreate{config: c.config}
@@ -124,7 +266,7 @@ func (c *CityClient) Update() *CityUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *CityClient) UpdateOne(ci *City) *CityUpdateOne {
-	return c.UpdateOneID(ci.ID)
+	return &CityUpdateOne{config: c.config, id: ci.ID, entity: ci}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -166,12 +308,77 @@ func (c *CityClient) GetX(ctx context.Context, id int) *City {
 	return ci
 }
 
+// GetNotFoundOK is like Get, but returns a nil City without an error, if there is no entity with the given id.
+func (c *CityClient) GetNotFoundOK(ctx context.Context, id int) (*City, error) {
+	ci, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return ci, err
+}
+
+// Exist reports if a City entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *CityClient) Exist(ctx context.Context, id int) (bool, error) {
+	return c.Query().Where(city.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *CityClient) ExistX(ctx context.Context, id int) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Cities in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *CityClient) Reload(ctx context.Context, nodes ...*City) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]int, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(city.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int]*City, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, ci := range nodes {
+		if _, ok := byID[ci.ID]; !ok {
+			return &ErrNotFound{city.Label}
+		}
+	}
+	for _, ci := range nodes {
+		v := byID[ci.ID]
+		ci.Name = v.Name
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *CityClient) ReloadX(ctx context.Context, nodes ...*City) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
 // QueryStreets queries the streets edge of a City.
 func (c *CityClient) QueryStreets(ci *City) *StreetQuery {
 	query := &StreetQuery{config: c.config}
 	id := ci.ID
 	query.sql = sql.Select().From(sql.Table(street.Table)).
 		Where(sql.EQ(city.StreetsColumn, id))
+	query.edgeCount = sql.Select().Count().From(sql.Table(street.Table)).
+		Where(sql.EQ(city.StreetsColumn, id))
 
 	return query
 }
@@ -181,11 +388,48 @@ type StreetClient struct {
 	config
 }
 
+// StreetQuerier is the read API of the StreetClient. It's implemented by the StreetClient, and it
+// can be used by services that depend only on reading Streets, in order to mock it in unit tests.
+// It holds only terminal operations, because the query builders are concrete types that cannot be mocked.
+type StreetQuerier interface {
+	Get(ctx context.Context, id int) (*Street, error)
+	GetX(ctx context.Context, id int) *Street
+	GetNotFoundOK(ctx context.Context, id int) (*Street, error)
+	Exist(ctx context.Context, id int) (bool, error)
+	ExistX(ctx context.Context, id int) bool
+	Reload(ctx context.Context, nodes ...*Street) error
+	ReloadX(ctx context.Context, nodes ...*Street)
+}
+
+var _ StreetQuerier = (*StreetClient)(nil)
+
 // NewStreetClient returns a client for the Street from the given config.
 func NewStreetClient(c config) *StreetClient {
 	return &StreetClient{config: c}
 }
 
+// Intercept adds a list of query interceptors to the Street queries of the client. They are applied
+// on all queries of the type (including edge traversals and group-by queries), and they are shared with the
+// transactions of the client. They should be registered before the client is used. For example, limiting
+// the queries to the tenant of the request:
+//
+//	client.Street.Intercept(func(ctx context.Context, q *StreetQuery) error {
+//		tenant, ok := TenantFromContext(ctx)
+//		if !ok {
+//			return errors.New("missing tenant")
+//		}
+//		q.Where(street.TenantID(tenant))
+//		return nil
+//	})
+//
+// The predicates that are added by the interceptors are also applied on the updates and deletes of
+// the type, including UpdateOneID and DeleteOneID. Creations are not filtered by them.
+func (c *StreetClient) Intercept(interceptors ...StreetInterceptor) {
+	c.inters.mu.Lock()
+	defer c.inters.mu.Unlock()
+	c.inters.Street = append(c.inters.Street, interceptors...)
+}
+
 // Create returns a create builder for Street.
 func (c *StreetClient) Create() *StreetCreate {
 	return &StreetCreate{config: c.config}
@@ -198,7 +442,7 @@ func (c *StreetClient) Update() *StreetUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *StreetClient) UpdateOne(s *Street) *StreetUpdateOne {
-	return c.UpdateOneID(s.ID)
+	return &StreetUpdateOne{config: c.config, id: s.ID, entity: s}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -240,6 +484,69 @@ func (c *StreetClient) GetX(ctx context.Context, id int) *Street {
 	return s
 }
 
+// GetNotFoundOK is like Get, but returns a nil Street without an error, if there is no entity with the given id.
+func (c *StreetClient) GetNotFoundOK(ctx context.Context, id int) (*Street, error) {
+	s, err := c.Get(ctx, id)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return s, err
+}
+
+// Exist reports if a Street entity with the given id exists. Unlike Get, only
+// the id of the entity is selected from the storage, and its fields are not decoded.
+func (c *StreetClient) Exist(ctx context.Context, id int) (bool, error) {
+	return c.Query().Where(street.ID(id)).Exist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (c *StreetClient) ExistX(ctx context.Context, id int) bool {
+	exist, err := c.Exist(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Reload re-fetches the given Streets in a single query, and updates their fields in place.
+// It's useful after their rows were changed by other operations (e.g. bulk updates or external writes).
+// The edges and the additional struct fields of the entities are not changed, and if one of them no
+// longer exists, an ErrNotFound is returned without updating any of the entities.
+func (c *StreetClient) Reload(ctx context.Context, nodes ...*Street) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]int, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	fresh, err := c.Query().Where(street.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int]*Street, len(fresh))
+	for _, v := range fresh {
+		byID[v.ID] = v
+	}
+	for _, s := range nodes {
+		if _, ok := byID[s.ID]; !ok {
+			return &ErrNotFound{street.Label}
+		}
+	}
+	for _, s := range nodes {
+		v := byID[s.ID]
+		s.Name = v.Name
+	}
+	return nil
+}
+
+// ReloadX is like Reload, but panics if an error occurs.
+func (c *StreetClient) ReloadX(ctx context.Context, nodes ...*Street) {
+	if err := c.Reload(ctx, nodes...); err != nil {
+		panic(err)
+	}
+}
+
 // QueryCity queries the city edge of a Street.
 func (c *StreetClient) QueryCity(s *Street) *CityQuery {
 	query := &CityQuery{config: c.config}
@@ -249,6 +556,9 @@ func (c *StreetClient) QueryCity(s *Street) *CityQuery {
 		From(sql.Table(street.CityTable)).
 		Where(sql.EQ(street.FieldID, id))
 	query.sql = sql.Select().From(t1).Join(t2).On(t1.C(city.FieldID), t2.C(street.CityColumn))
+	query.edgeCount = sql.Select().Count(street.CityColumn).
+		From(sql.Table(street.CityTable)).
+		Where(sql.EQ(street.FieldID, id))
 
 	return query
 }
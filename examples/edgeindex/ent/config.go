@@ -7,6 +7,9 @@
 package ent
 
 import (
+	"sync"
+	"time"
+
 	"github.com/facebookincubator/ent/dialect"
 )
 
@@ -17,10 +20,42 @@ type Option func(*config)
 type config struct {
 	// driver used for executing database requests.
 	driver dialect.Driver
+	// base is the driver that was set by the Driver option, before it was wrapped
+	// by the debug and the slow-query drivers. It's nil in transactional clients.
+	base dialect.Driver
 	// debug enable a debug logging.
 	debug bool
-	// log used for logging on debug mode.
+	// log used for logging on debug mode and for slow queries.
 	log func(...interface{})
+	// slow is the duration threshold for logging slow queries.
+	// A zero value means that the logging is disabled.
+	slow time.Duration
+	// verify holds the schema verification mode of Open.
+	// A nil value means that the verification is disabled.
+	verify *bool
+	// inters holds the query interceptors of the client.
+	inters *inters
+}
+
+// inters holds the query interceptors of each type.
+type inters struct {
+	// mu guards the registration of interceptors on a client that is already in use.
+	mu     sync.RWMutex
+	City   []CityInterceptor
+	Street []StreetInterceptor
+}
+
+// clone returns a copy of the interceptors, that can be extended without changing the original.
+func (i *inters) clone() *inters {
+	if i == nil {
+		return nil
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return &inters{
+		City:   append([]CityInterceptor{}, i.City...),
+		Street: append([]StreetInterceptor{}, i.Street...),
+	}
 }
 
 // Options applies the options on the config object.
@@ -28,6 +63,15 @@ func (c *config) options(opts ...Option) {
 	for _, opt := range opts {
 		opt(c)
 	}
+	// the wrappers are rebuilt from the base driver, in order to not stack them (or
+	// keep the previous logger) when the options are extended using Client.With.
+	if c.base == nil {
+		return
+	}
+	c.driver = c.base
+	if c.slow > 0 {
+		c.driver = dialect.Slow(c.driver, c.slow, c.log)
+	}
 	if c.debug {
 		c.driver = dialect.Debug(c.driver, c.log)
 	}
@@ -40,16 +84,37 @@ func Debug() Option {
 	}
 }
 
-// Log sets the logging function for debug mode.
+// Log sets the logging function for debug mode and for slow queries.
 func Log(fn func(...interface{})) Option {
 	return func(c *config) {
 		c.log = fn
 	}
 }
 
+// SlowQueryThreshold enables the logging of the queries that took longer than the given
+// duration, with their arguments and the builder that executed them (see dialect.Slow).
+//
+//	client, err := ent.Open(dialect.MySQL, dsn, ent.Log(logger.Println), ent.SlowQueryThreshold(200*time.Millisecond))
+//
+func SlowQueryThreshold(d time.Duration) Option {
+	return func(c *config) {
+		c.slow = d
+	}
+}
+
+// VerifySchema configures Open to verify that the database schema was migrated by the
+// same version of the generated code. If the versions do not match, Open fails when strict
+// is true, or logs a warning otherwise. Note that the verification runs before any migration
+// that is executed by the client.
+func VerifySchema(strict bool) Option {
+	return func(c *config) {
+		c.verify = &strict
+	}
+}
+
 // Driver configures the client driver.
 func Driver(driver dialect.Driver) Option {
 	return func(c *config) {
-		c.driver = driver
+		c.driver, c.base = driver, driver
 	}
 }
@@ -7,9 +7,19 @@
 package predicate
 
 import (
+	"context"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 )
 
+// Subquery is implemented by the SQL query builders of the graph, and it's used by the IDInQuery
+// predicates, for filtering by the ids that are returned by another query in the same statement.
+type Subquery interface {
+	// IDsSubquery returns the selector of the ids of the query, after applying
+	// the interceptors of the client on it with the given context.
+	IDsSubquery(context.Context) (*sql.Selector, error)
+}
+
 // City is the predicate function for city builders.
 type City func(*sql.Selector)
 
@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/examples/edgeindex/ent/street"
 )
 
 // Street is the model entity for the Street schema.
@@ -20,19 +21,41 @@ type Street struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the StreetQuery when eager-loading is set.
+	Edges StreetEdges `json:"edges"`
+}
+
+// StreetEdges holds the relations/edges of other nodes in the graph.
+type StreetEdges struct {
+	// City holds the value of the city edge.
+	City *City `json:"city,omitempty"`
 }
 
 // FromRows scans the sql response data into Street.
 func (s *Street) FromRows(rows *sql.Rows) error {
+	return s.scan(rows, street.Columns)
+}
+
+// scan scans the given columns of the sql response data into Street.
+// Fields that their columns were not selected are left with their zero values.
+func (s *Street) scan(rows *sql.Rows, columns []string) error {
 	var vs struct {
 		ID   int
 		Name sql.NullString
 	}
-	// the order here should be the same as in the `street.Columns`.
-	if err := rows.Scan(
-		&vs.ID,
-		&vs.Name,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case street.FieldID:
+			values[i] = &vs.ID
+		case street.FieldName:
+			values[i] = &vs.Name
+		default:
+			return fmt.Errorf("unexpected column %q for type Street", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	s.ID = vs.ID
@@ -63,6 +86,30 @@ func (s *Street) Unwrap() *Street {
 	return s
 }
 
+// Clone returns a deep copy of the Street, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (s *Street) Clone() *Street {
+	return s.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Street, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (s *Street) clone(seen map[interface{}]interface{}) *Street {
+	if s == nil {
+		return nil
+	}
+	if _c, ok := seen[s]; ok {
+		return _c.(*Street)
+	}
+	_c := *s
+	_c.config = config{}
+	seen[s] = &_c
+	_c.Edges.City = s.Edges.City.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (s *Street) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -73,14 +120,30 @@ func (s *Street) String() string {
 	return buf.String()
 }
 
+// DiffStreet returns the fields that have different values in the given Streets,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffStreet(a, b *Street) []FieldChange {
+	var changes []FieldChange
+	if a.Name != b.Name {
+		changes = append(changes, FieldChange{Field: street.FieldName, Old: a.Name, New: b.Name})
+	}
+	return changes
+}
+
 // Streets is a parsable slice of Street.
 type Streets []*Street
 
 // FromRows scans the sql response data into Streets.
 func (s *Streets) FromRows(rows *sql.Rows) error {
+	return s.scan(rows, street.Columns)
+}
+
+// scan scans the given columns of the sql response data into Streets.
+func (s *Streets) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vs := &Street{}
-		if err := vs.FromRows(rows); err != nil {
+		if err := vs.scan(rows, columns); err != nil {
 			return err
 		}
 		*s = append(*s, vs)
@@ -6,6 +6,12 @@
 
 package street
 
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/examples/edgeindex/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the street type in the database.
 	Label = "street"
@@ -30,3 +36,62 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope is a reusable named scope of the Street queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.Street
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the Street type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "Street",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeInt,
+		GoType:     "int",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "name",
+			StorageKey: FieldName,
+			Type:       field.TypeString,
+			GoType:     "string",
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "city",
+			Type:     "City",
+			Rel:      "M2O",
+			Unique:   true,
+			Optional: true,
+			Inverse:  true,
+			Table:    "streets",
+			Columns:  []string{"city_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the Street type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
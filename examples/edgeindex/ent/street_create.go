@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/street"
 )
@@ -17,8 +18,12 @@ import (
 // StreetCreate is the builder for creating a Street entity.
 type StreetCreate struct {
 	config
-	name *string
-	city map[int]struct{}
+	streetMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (sc *StreetCreate) Mutation() *StreetMutation {
+	return &StreetMutation{streetMutation: &sc.streetMutation, op: ent.OpCreate}
 }
 
 // SetName sets the name field.
@@ -50,19 +55,23 @@ func (sc *StreetCreate) SetCity(c *City) *StreetCreate {
 }
 
 // Save creates the Street in the database.
-func (sc *StreetCreate) Save(ctx context.Context) (*Street, error) {
+func (sc *StreetCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Street, error) {
+	options := ent.NewCallOptions(opts...)
 	if sc.name == nil {
 		return nil, errors.New("ent: missing required field \"name\"")
 	}
 	if len(sc.city) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"city\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return sc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (sc *StreetCreate) SaveX(ctx context.Context) *Street {
-	v, err := sc.Save(ctx)
+func (sc *StreetCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Street {
+	v, err := sc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -83,6 +92,9 @@ func (sc *StreetCreate) sqlSave(ctx context.Context) (*Street, error) {
 		builder.Set(street.FieldName, *value)
 		s.Name = *value
 	}
+	for eid := range sc.city {
+		builder.Set(street.CityColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -92,17 +104,6 @@ func (sc *StreetCreate) sqlSave(ctx context.Context) (*Street, error) {
 		return nil, rollback(tx, err)
 	}
 	s.ID = int(id)
-	if len(sc.city) > 0 {
-		for eid := range sc.city {
-			query, args := sql.Update(street.CityTable).
-				Set(street.CityColumn, eid).
-				Where(sql.EQ(street.FieldID, id)).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
-		}
-	}
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/predicate"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/street"
@@ -27,13 +28,26 @@ func (sd *StreetDelete) Where(ps ...predicate.Street) *StreetDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (sd *StreetDelete) Exec(ctx context.Context) (int, error) {
+func (sd *StreetDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	predicates, err := interceptStreet(ctx, sd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *sd
+		intercepted.predicates = append(predicates, sd.predicates...)
+		sd = &intercepted
+	}
 	return sd.sqlExec(ctx)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (sd *StreetDelete) ExecX(ctx context.Context) int {
-	n, err := sd.Exec(ctx)
+func (sd *StreetDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := sd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -42,10 +56,13 @@ func (sd *StreetDelete) ExecX(ctx context.Context) int {
 
 func (sd *StreetDelete) sqlExec(ctx context.Context) (int, error) {
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(street.Table))
+	selector := sql.Select().From(sql.Table(street.Table)).SetDialect(sd.driver.Dialect()).WithContext(ctx)
 	for _, p := range sd.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(street.Table).FromSelect(selector).Query()
 	if err := sd.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -63,12 +80,12 @@ type StreetDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (sdo *StreetDeleteOne) Exec(ctx context.Context) error {
-	n, err := sdo.sd.Exec(ctx)
+func (sdo *StreetDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := sdo.sd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{street.Label}
 	default:
 		return nil
@@ -76,6 +93,8 @@ func (sdo *StreetDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (sdo *StreetDeleteOne) ExecX(ctx context.Context) {
-	sdo.sd.ExecX(ctx)
+func (sdo *StreetDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := sdo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
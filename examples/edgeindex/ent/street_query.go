@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/city"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/predicate"
@@ -21,13 +22,23 @@ import (
 // StreetQuery is the builder for querying Street entities.
 type StreetQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit  *int
+	offset *int
+	order  []Order
+	unique []string
+	fields []string
+	hints  []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.Street
+	// eager-loading edges.
+	withCity *CityQuery
 	// intermediate queries.
 	sql *sql.Selector
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath func(context.Context) (*sql.Selector, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -54,19 +65,129 @@ func (sq *StreetQuery) Order(o ...Order) *StreetQuery {
 	return sq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored.
+func (sq *StreetQuery) Hint(hints ...sql.Hint) *StreetQuery {
+	sq.hints = append(sq.hints, hints...)
+	return sq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	sSlice, err := client.Street.Query().
+//		Fields(street.FieldName).
+//		All(ctx)
+//
+func (sq *StreetQuery) Fields(fields ...string) *StreetQuery {
+	sq.fields = append(sq.fields, fields...)
+	return sq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (sq *StreetQuery) Scope(scopes ...street.Scope) *StreetQuery {
+	for _, s := range scopes {
+		sq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				sq.Order(Desc(o.Field))
+			} else {
+				sq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			sq.Limit(s.Limit)
+		}
+	}
+	return sq
+}
+
+// StreetInterceptor is a query interceptor of the StreetClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the StreetClient.Intercept method.
+type StreetInterceptor func(context.Context, *StreetQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (sq *StreetQuery) prepare(ctx context.Context) (*StreetQuery, error) {
+	var interceptors []StreetInterceptor
+	if sq.inters != nil {
+		sq.inters.mu.RLock()
+		interceptors = sq.inters.Street
+		sq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && sq.sqlPath == nil {
+		return sq, nil
+	}
+	query := sq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptStreet returns the predicates that the interceptors of the client add to a Street query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptStreet(ctx context.Context, cfg config) ([]predicate.Street, error) {
+	query, err := (&StreetQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryCity chains the current query on the city edge.
 func (sq *StreetQuery) QueryCity() *CityQuery {
 	query := &CityQuery{config: sq.config}
-	t1 := sql.Table(city.Table)
-	t2 := sq.sqlQuery()
-	t2.Select(t2.C(street.CityColumn))
-	query.sql = sql.Select(t1.Columns(city.Columns...)...).
-		From(t1).
-		Join(t2).
-		On(t1.C(city.FieldID), t2.C(street.CityColumn))
+	query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+		prev, err := sq.prepare(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t1 := sql.Table(city.Table)
+		t2 := prev.sqlQuery(ctx)
+		t2.Select(t2.C(street.CityColumn))
+		return sql.Select(t1.Columns(city.Columns...)...).
+			From(t1).
+			Join(t2).
+			On(t1.C(city.FieldID), t2.C(street.CityColumn)), nil
+	}
 	return query
 }
 
+// WithCity tells the query-builder to eager-load the nodes that are connected to the "city" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Street.Query().
+//		WithCity(func(q *ent.CityQuery) {
+//			q.Order(ent.Desc(city.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (sq *StreetQuery) WithCity(opts ...func(*CityQuery)) *StreetQuery {
+	query := &CityQuery{config: sq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withCity = query
+	return sq
+}
+
 // First returns the first Street entity in the query. Returns *ErrNotFound when no street was found.
 func (sq *StreetQuery) First(ctx context.Context) (*Street, error) {
 	sSlice, err := sq.Limit(1).All(ctx)
@@ -162,22 +283,41 @@ func (sq *StreetQuery) OnlyXID(ctx context.Context) int {
 }
 
 // All executes the query and returns a list of Streets.
-func (sq *StreetQuery) All(ctx context.Context) ([]*Street, error) {
-	return sq.sqlAll(ctx)
+func (sq *StreetQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*Street, error) {
+	query, err := sq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlAll(ctx)
 }
 
 // AllX is like All, but panics if an error occurs.
-func (sq *StreetQuery) AllX(ctx context.Context) []*Street {
-	sSlice, err := sq.All(ctx)
+func (sq *StreetQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*Street {
+	sSlice, err := sq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return sSlice
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (sq *StreetQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := sq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return query.sqlQueryString(ctx)
+}
+
 // IDs executes the query and returns a list of Street ids.
 func (sq *StreetQuery) IDs(ctx context.Context) ([]int, error) {
-	return sq.sqlIDs(ctx)
+	query, err := sq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlIDs(ctx)
 }
 
 // IDsX is like IDs, but panics if an error occurs.
@@ -189,9 +329,42 @@ func (sq *StreetQuery) IDsX(ctx context.Context) []int {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Streets that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (sq *StreetQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if sq.sql != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &StreetQuery{
+		config:     sq.config,
+		predicates: append([]predicate.Street{}, sq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(int)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for Street", after)
+		}
+		query.Where(street.IDGT(id))
+	}
+	ids, err := query.Order(Asc(street.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (sq *StreetQuery) Count(ctx context.Context) (int, error) {
-	return sq.sqlCount(ctx)
+	query, err := sq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return query.sqlCount(ctx)
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -205,7 +378,11 @@ func (sq *StreetQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (sq *StreetQuery) Exist(ctx context.Context) (bool, error) {
-	return sq.sqlExist(ctx)
+	query, err := sq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	return query.sqlExist(ctx)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -226,9 +403,14 @@ func (sq *StreetQuery) Clone() *StreetQuery {
 		offset:     sq.offset,
 		order:      append([]Order{}, sq.order...),
 		unique:     append([]string{}, sq.unique...),
+		fields:     append([]string{}, sq.fields...),
+		hints:      append([]sql.Hint{}, sq.hints...),
+		edgeCount:  sq.edgeCount,
 		predicates: append([]predicate.Street{}, sq.predicates...),
+		withCity:   sq.withCity,
 		// clone intermediate queries.
-		sql: sq.sql.Clone(),
+		sql:     sq.sql.Clone(),
+		sqlPath: sq.sqlPath,
 	}
 }
 
@@ -250,7 +432,14 @@ func (sq *StreetQuery) Clone() *StreetQuery {
 func (sq *StreetQuery) GroupBy(field string, fields ...string) *StreetGroupBy {
 	group := &StreetGroupBy{config: sq.config}
 	group.fields = append([]string{field}, fields...)
-	group.sql = sq.sqlQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := sq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		group.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return group
 }
 
@@ -269,15 +458,22 @@ func (sq *StreetQuery) GroupBy(field string, fields ...string) *StreetGroupBy {
 func (sq *StreetQuery) Select(field string, fields ...string) *StreetSelect {
 	selector := &StreetSelect{config: sq.config}
 	selector.fields = append([]string{field}, fields...)
-	selector.sql = sq.sqlQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := sq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		selector.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return selector
 }
 
 func (sq *StreetQuery) sqlAll(ctx context.Context) ([]*Street, error) {
 	rows := &sql.Rows{}
-	selector := sq.sqlQuery()
-	if unique := sq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := sq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := sq.driver.Query(ctx, query, args, rows); err != nil {
@@ -285,21 +481,140 @@ func (sq *StreetQuery) sqlAll(ctx context.Context) ([]*Street, error) {
 	}
 	defer rows.Close()
 	var sSlice Streets
-	if err := sSlice.FromRows(rows); err != nil {
+	if err := sSlice.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	sSlice.config(sq.config)
+	if query := sq.withCity; query != nil {
+		if err := sq.sqlLoadCity(ctx, query, sSlice); err != nil {
+			return nil, err
+		}
+	}
 	return sSlice, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (sq *StreetQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := sq.sqlQuery(ctx)
+	if unique := sq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := street.Columns
+	if fields := sq.fields; len(fields) > 0 {
+		columns = append([]string{street.FieldID}, fields...)
+		for _, c := range fields {
+			if !street.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (sq *StreetQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := sq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadCity loads the nodes of the city edge of the given Streets using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (sq *StreetQuery) sqlLoadCity(ctx context.Context, query *CityQuery, nodes []*Street) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[int]*Street, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(street.CityTable)
+	selector.Join(t1).On(t1.C(street.CityColumn), selector.C(city.FieldID))
+	key := t1.C(street.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(city.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := sq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []int
+		eids []int
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, nid)
+		eids = append(eids, eid)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.City{city.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[int]*City, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.City = edge
+	}
+	return nil
+}
+
 func (sq *StreetQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := sq.sqlQuery()
+	selector := sq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{street.FieldID}
 	if len(sq.unique) > 0 {
 		unique = sq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := sq.edgeCount; c != nil && len(sq.predicates) == 0 && len(sq.unique) == 0 &&
+		len(sq.hints) == 0 && sq.limit == nil && sq.offset == nil {
+		selector = c.Clone().SetDialect(sq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := sq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -316,11 +631,22 @@ func (sq *StreetQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (sq *StreetQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := sq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := sq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(street.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := sq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := sq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (sq *StreetQuery) sqlIDs(ctx context.Context) ([]int, error) {
@@ -335,13 +661,33 @@ func (sq *StreetQuery) sqlIDs(ctx context.Context) ([]int, error) {
 	return ids, nil
 }
 
-func (sq *StreetQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (sq *StreetQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := sq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(street.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (sq *StreetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(street.Table)
 	selector := sql.Select(t1.Columns(street.Columns...)...).From(t1)
 	if sq.sql != nil {
 		selector = sq.sql
 		selector.Select(selector.Columns(street.Columns...)...)
 	}
+	selector.SetDialect(sq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(sq.hints...)
 	for _, p := range sq.predicates {
 		p(selector)
 	}
@@ -366,6 +712,10 @@ type StreetGroupBy struct {
 	fns    []Aggregate
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving *sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -374,8 +724,25 @@ func (sgb *StreetGroupBy) Aggregate(fns ...Aggregate) *StreetGroupBy {
 	return sgb
 }
 
+// Having adds a predicate on the aggregated values of the groups.
+// For example:
+//
+//	client.Street.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5)).
+//		Scan(ctx, &v)
+//
+func (sgb *StreetGroupBy) Having(p *sql.Predicate) *StreetGroupBy {
+	sgb.sqlHaving = p
+	return sgb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (sgb *StreetGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := sgb.path(ctx); err != nil {
+		return err
+	}
 	return sgb.sqlScan(ctx, v)
 }
 
@@ -471,6 +838,9 @@ func (sgb *StreetGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (sgb *StreetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := sgb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := sgb.sqlQuery().Query()
 	if err := sgb.driver.Query(ctx, query, args, rows); err != nil {
@@ -487,7 +857,11 @@ func (sgb *StreetGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range sgb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(sgb.fields...)
+	selector.Select(columns...).GroupBy(sgb.fields...)
+	if sgb.sqlHaving != nil {
+		selector.Having(sgb.sqlHaving)
+	}
+	return selector
 }
 
 // StreetSelect is the builder for select fields of Street entities.
@@ -496,10 +870,15 @@ type StreetSelect struct {
 	fields []string
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (ss *StreetSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ss.path(ctx); err != nil {
+		return err
+	}
 	return ss.sqlScan(ctx, v)
 }
 
@@ -595,6 +974,9 @@ func (ss *StreetSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (ss *StreetSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ss.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ss.sqlQuery().Query()
 	if err := ss.driver.Query(ctx, query, args, rows); err != nil {
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/city"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/predicate"
@@ -20,10 +21,11 @@ import (
 // StreetUpdate is the builder for updating Street entities.
 type StreetUpdate struct {
 	config
-	name        *string
-	city        map[int]struct{}
-	clearedCity bool
-	predicates  []predicate.Street
+	streetMutation
+	predicates []predicate.Street
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Street
 }
 
 // Where adds a new predicate for the builder.
@@ -32,6 +34,11 @@ func (su *StreetUpdate) Where(ps ...predicate.Street) *StreetUpdate {
 	return su
 }
 
+// Mutation returns the mutation of the builder.
+func (su *StreetUpdate) Mutation() *StreetMutation {
+	return &StreetMutation{streetMutation: &su.streetMutation, op: ent.OpUpdate}
+}
+
 // SetName sets the name field.
 func (su *StreetUpdate) SetName(s string) *StreetUpdate {
 	su.name = &s
@@ -67,16 +74,49 @@ func (su *StreetUpdate) ClearCity() *StreetUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (su *StreetUpdate) Save(ctx context.Context) (int, error) {
+func (su *StreetUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := su.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (su *StreetUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(su.city) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"city\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"city\"")
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptStreet(ctx, su.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *su
+		intercepted.predicates = append(predicates, su.predicates...)
+		su = &intercepted
 	}
 	return su.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (su *StreetUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Street, error) {
+	var sSlice []*Street
+	su.returning = &sSlice
+	defer func() { su.returning = nil }()
+	if _, err := su.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return sSlice, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (su *StreetUpdate) SaveX(ctx context.Context) int {
-	affected, err := su.Save(ctx)
+func (su *StreetUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := su.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -84,44 +124,48 @@ func (su *StreetUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (su *StreetUpdate) Exec(ctx context.Context) error {
-	_, err := su.Save(ctx)
+func (su *StreetUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := su.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (su *StreetUpdate) ExecX(ctx context.Context) {
-	if err := su.Exec(ctx); err != nil {
+func (su *StreetUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := su.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (su *StreetUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(street.FieldID).From(sql.Table(street.Table))
+func (su *StreetUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(street.FieldID).From(sql.Table(street.Table)).SetDialect(su.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range su.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = su.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := su.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -133,16 +177,16 @@ func (su *StreetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if su.clearedCity {
+	if su.clearedCity && len(su.city) == 0 {
 		query, args := sql.Update(street.CityTable).
 			SetNull(street.CityColumn).
 			Where(sql.InInts(city.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(su.city) > 0 {
@@ -152,23 +196,48 @@ func (su *StreetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.InInts(street.FieldID, ids...)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 		}
 	}
+	if su.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(street.Columns...).
+			From(sql.Table(street.Table)).
+			Where(sql.InInts(street.FieldID, ids...)).
+			SetDialect(su.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var sSlice Streets
+		err := sSlice.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Street: %v", err))
+		}
+		sSlice.config(su.config)
+		*su.returning = sSlice
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // StreetUpdateOne is the builder for updating a single Street entity.
 type StreetUpdateOne struct {
 	config
-	id          int
-	name        *string
-	city        map[int]struct{}
-	clearedCity bool
+	id     int
+	entity *Street
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Street
+	streetMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (suo *StreetUpdateOne) Mutation() *StreetMutation {
+	return &StreetMutation{streetMutation: &suo.streetMutation, op: ent.OpUpdateOne, id: &suo.id}
 }
 
 // SetName sets the name field.
@@ -205,17 +274,48 @@ func (suo *StreetUpdateOne) ClearCity() *StreetUpdateOne {
 	return suo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (suo *StreetUpdateOne) SetChangedFrom(original *Street) *StreetUpdateOne {
+	s := suo.entity
+	if s == nil || original == nil {
+		return suo
+	}
+	for _, change := range DiffStreet(original, s) {
+		switch change.Field {
+		case street.FieldName:
+			suo.SetName(s.Name)
+		}
+	}
+	return suo
+}
+
 // Save executes the query and returns the updated entity.
-func (suo *StreetUpdateOne) Save(ctx context.Context) (*Street, error) {
+func (suo *StreetUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Street, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(suo.city) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"city\"")
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptStreet(ctx, suo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *suo
+		intercepted.predicates = append(predicates, suo.predicates...)
+		suo = &intercepted
+	}
 	return suo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (suo *StreetUpdateOne) SaveX(ctx context.Context) *Street {
-	s, err := suo.Save(ctx)
+func (suo *StreetUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Street {
+	s, err := suo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -223,21 +323,28 @@ func (suo *StreetUpdateOne) SaveX(ctx context.Context) *Street {
 }
 
 // Exec executes the query on the entity.
-func (suo *StreetUpdateOne) Exec(ctx context.Context) error {
-	_, err := suo.Save(ctx)
+func (suo *StreetUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := suo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (suo *StreetUpdateOne) ExecX(ctx context.Context) {
-	if err := suo.Exec(ctx); err != nil {
+func (suo *StreetUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := suo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (suo *StreetUpdateOne) sqlSave(ctx context.Context) (s *Street, err error) {
-	selector := sql.Select(street.Columns...).From(sql.Table(street.Table))
+	selector := sql.Select(street.Columns...).From(sql.Table(street.Table)).SetDialect(suo.driver.Dialect())
 	street.ID(suo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range suo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = suo.driver.Query(ctx, query, args, rows); err != nil {
@@ -279,7 +386,7 @@ func (suo *StreetUpdateOne) sqlSave(ctx context.Context) (s *Street, err error)
 			return nil, rollback(tx, err)
 		}
 	}
-	if suo.clearedCity {
+	if suo.clearedCity && len(suo.city) == 0 {
 		query, args := sql.Update(street.CityTable).
 			SetNull(street.CityColumn).
 			Where(sql.InInts(city.FieldID, ids...)).
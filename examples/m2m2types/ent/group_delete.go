@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/m2m2types/ent/group"
 	"github.com/facebookincubator/ent/examples/m2m2types/ent/predicate"
@@ -27,13 +28,26 @@ func (gd *GroupDelete) Where(ps ...predicate.Group) *GroupDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (gd *GroupDelete) Exec(ctx context.Context) (int, error) {
+func (gd *GroupDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	predicates, err := interceptGroup(ctx, gd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *gd
+		intercepted.predicates = append(predicates, gd.predicates...)
+		gd = &intercepted
+	}
 	return gd.sqlExec(ctx)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (gd *GroupDelete) ExecX(ctx context.Context) int {
-	n, err := gd.Exec(ctx)
+func (gd *GroupDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := gd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -42,10 +56,13 @@ func (gd *GroupDelete) ExecX(ctx context.Context) int {
 
 func (gd *GroupDelete) sqlExec(ctx context.Context) (int, error) {
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(group.Table))
+	selector := sql.Select().From(sql.Table(group.Table)).SetDialect(gd.driver.Dialect()).WithContext(ctx)
 	for _, p := range gd.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(group.Table).FromSelect(selector).Query()
 	if err := gd.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -63,12 +80,12 @@ type GroupDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (gdo *GroupDeleteOne) Exec(ctx context.Context) error {
-	n, err := gdo.gd.Exec(ctx)
+func (gdo *GroupDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := gdo.gd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{group.Label}
 	default:
 		return nil
@@ -76,6 +93,8 @@ func (gdo *GroupDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (gdo *GroupDeleteOne) ExecX(ctx context.Context) {
-	gdo.gd.ExecX(ctx)
+func (gdo *GroupDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := gdo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/m2m2types/ent/group"
 	"github.com/facebookincubator/ent/examples/m2m2types/ent/predicate"
@@ -18,10 +19,11 @@ import (
 // GroupUpdate is the builder for updating Group entities.
 type GroupUpdate struct {
 	config
-	name         *string
-	users        map[int]struct{}
-	removedUsers map[int]struct{}
-	predicates   []predicate.Group
+	groupMutation
+	predicates []predicate.Group
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Group
 }
 
 // Where adds a new predicate for the builder.
@@ -30,6 +32,11 @@ func (gu *GroupUpdate) Where(ps ...predicate.Group) *GroupUpdate {
 	return gu
 }
 
+// Mutation returns the mutation of the builder.
+func (gu *GroupUpdate) Mutation() *GroupMutation {
+	return &GroupMutation{groupMutation: &gu.groupMutation, op: ent.OpUpdate}
+}
+
 // SetName sets the name field.
 func (gu *GroupUpdate) SetName(s string) *GroupUpdate {
 	gu.name = &s
@@ -56,6 +63,12 @@ func (gu *GroupUpdate) AddUsers(u ...*User) *GroupUpdate {
 	return gu.AddUserIDs(ids...)
 }
 
+// ClearUsers clears all users edges to User.
+func (gu *GroupUpdate) ClearUsers() *GroupUpdate {
+	gu.clearedUsers = true
+	return gu
+}
+
 // RemoveUserIDs removes the users edge to User by ids.
 func (gu *GroupUpdate) RemoveUserIDs(ids ...int) *GroupUpdate {
 	if gu.removedUsers == nil {
@@ -77,13 +90,46 @@ func (gu *GroupUpdate) RemoveUsers(u ...*User) *GroupUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
+func (gu *GroupUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := gu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (gu *GroupUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptGroup(ctx, gu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *gu
+		intercepted.predicates = append(predicates, gu.predicates...)
+		gu = &intercepted
+	}
 	return gu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (gu *GroupUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Group, error) {
+	var grs []*Group
+	gu.returning = &grs
+	defer func() { gu.returning = nil }()
+	if _, err := gu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return grs, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (gu *GroupUpdate) SaveX(ctx context.Context) int {
-	affected, err := gu.Save(ctx)
+func (gu *GroupUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := gu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -91,44 +137,48 @@ func (gu *GroupUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (gu *GroupUpdate) Exec(ctx context.Context) error {
-	_, err := gu.Save(ctx)
+func (gu *GroupUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := gu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (gu *GroupUpdate) ExecX(ctx context.Context) {
-	if err := gu.Exec(ctx); err != nil {
+func (gu *GroupUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := gu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(group.FieldID).From(sql.Table(group.Table))
+func (gu *GroupUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(group.FieldID).From(sql.Table(group.Table)).SetDialect(gu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range gu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = gu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := gu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -140,11 +190,18 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if len(gu.removedUsers) > 0 {
-		eids := make([]int, len(gu.removedUsers))
+	if gu.clearedUsers {
+		query, args := sql.Delete(group.UsersTable).
+			Where(sql.InInts(group.UsersPrimaryKey[0], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(gu.removedUsers) > 0 {
+		eids := make([]int, 0, len(gu.removedUsers))
 		for eid := range gu.removedUsers {
 			eids = append(eids, eid)
 		}
@@ -153,7 +210,7 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(group.UsersPrimaryKey[1], eids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(gu.users) > 0 {
@@ -170,22 +227,47 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
+		}
+	}
+	if gu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(group.Columns...).
+			From(sql.Table(group.Table)).
+			Where(sql.InInts(group.FieldID, ids...)).
+			SetDialect(gu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var grs Groups
+		err := grs.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Group: %v", err))
 		}
+		grs.config(gu.config)
+		*gu.returning = grs
 	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // GroupUpdateOne is the builder for updating a single Group entity.
 type GroupUpdateOne struct {
 	config
-	id           int
-	name         *string
-	users        map[int]struct{}
-	removedUsers map[int]struct{}
+	id     int
+	entity *Group
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Group
+	groupMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (guo *GroupUpdateOne) Mutation() *GroupMutation {
+	return &GroupMutation{groupMutation: &guo.groupMutation, op: ent.OpUpdateOne, id: &guo.id}
 }
 
 // SetName sets the name field.
@@ -214,6 +296,12 @@ func (guo *GroupUpdateOne) AddUsers(u ...*User) *GroupUpdateOne {
 	return guo.AddUserIDs(ids...)
 }
 
+// ClearUsers clears all users edges to User.
+func (guo *GroupUpdateOne) ClearUsers() *GroupUpdateOne {
+	guo.clearedUsers = true
+	return guo
+}
+
 // RemoveUserIDs removes the users edge to User by ids.
 func (guo *GroupUpdateOne) RemoveUserIDs(ids ...int) *GroupUpdateOne {
 	if guo.removedUsers == nil {
@@ -234,14 +322,45 @@ func (guo *GroupUpdateOne) RemoveUsers(u ...*User) *GroupUpdateOne {
 	return guo.RemoveUserIDs(ids...)
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (guo *GroupUpdateOne) SetChangedFrom(original *Group) *GroupUpdateOne {
+	gr := guo.entity
+	if gr == nil || original == nil {
+		return guo
+	}
+	for _, change := range DiffGroup(original, gr) {
+		switch change.Field {
+		case group.FieldName:
+			guo.SetName(gr.Name)
+		}
+	}
+	return guo
+}
+
 // Save executes the query and returns the updated entity.
-func (guo *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
+func (guo *GroupUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Group, error) {
+	options := ent.NewCallOptions(opts...)
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptGroup(ctx, guo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *guo
+		intercepted.predicates = append(predicates, guo.predicates...)
+		guo = &intercepted
+	}
 	return guo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (guo *GroupUpdateOne) SaveX(ctx context.Context) *Group {
-	gr, err := guo.Save(ctx)
+func (guo *GroupUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Group {
+	gr, err := guo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -249,21 +368,28 @@ func (guo *GroupUpdateOne) SaveX(ctx context.Context) *Group {
 }
 
 // Exec executes the query on the entity.
-func (guo *GroupUpdateOne) Exec(ctx context.Context) error {
-	_, err := guo.Save(ctx)
+func (guo *GroupUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := guo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
-	if err := guo.Exec(ctx); err != nil {
+func (guo *GroupUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := guo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
-	selector := sql.Select(group.Columns...).From(sql.Table(group.Table))
+	selector := sql.Select(group.Columns...).From(sql.Table(group.Table)).SetDialect(guo.driver.Dialect())
 	group.ID(guo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range guo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = guo.driver.Query(ctx, query, args, rows); err != nil {
@@ -305,8 +431,15 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if len(guo.removedUsers) > 0 {
-		eids := make([]int, len(guo.removedUsers))
+	if guo.clearedUsers {
+		query, args := sql.Delete(group.UsersTable).
+			Where(sql.InInts(group.UsersPrimaryKey[0], ids...)).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
+		}
+	} else if len(guo.removedUsers) > 0 {
+		eids := make([]int, 0, len(guo.removedUsers))
 		for eid := range guo.removedUsers {
 			eids = append(eids, eid)
 		}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/m2m2types/ent/user"
 )
@@ -17,9 +18,12 @@ import (
 // UserCreate is the builder for creating a User entity.
 type UserCreate struct {
 	config
-	age    *int
-	name   *string
-	groups map[int]struct{}
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uc *UserCreate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uc.userMutation, op: ent.OpCreate}
 }
 
 // SetAge sets the age field.
@@ -55,19 +59,23 @@ func (uc *UserCreate) AddGroups(g ...*Group) *UserCreate {
 }
 
 // Save creates the User in the database.
-func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+func (uc *UserCreate) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
 	if uc.age == nil {
 		return nil, errors.New("ent: missing required field \"age\"")
 	}
 	if uc.name == nil {
 		return nil, errors.New("ent: missing required field \"name\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return uc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (uc *UserCreate) SaveX(ctx context.Context) *User {
-	v, err := uc.Save(ctx)
+func (uc *UserCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	v, err := uc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -102,15 +110,15 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 	}
 	u.ID = int(id)
 	if len(uc.groups) > 0 {
-		for eid := range uc.groups {
 
-			query, args := sql.Insert(user.GroupsTable).
-				Columns(user.GroupsPrimaryKey[1], user.GroupsPrimaryKey[0]).
-				Values(id, eid).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+		builder := sql.Insert(user.GroupsTable).
+			Columns(user.GroupsPrimaryKey[1], user.GroupsPrimaryKey[0])
+		for eid := range uc.groups {
+			builder.Values(id, eid)
+		}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if err := tx.Commit(); err != nil {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/m2mbidi/ent/user"
 )
@@ -17,9 +18,12 @@ import (
 // UserCreate is the builder for creating a User entity.
 type UserCreate struct {
 	config
-	age     *int
-	name    *string
-	friends map[int]struct{}
+	userMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (uc *UserCreate) Mutation() *UserMutation {
+	return &UserMutation{userMutation: &uc.userMutation, op: ent.OpCreate}
 }
 
 // SetAge sets the age field.
@@ -55,19 +59,23 @@ func (uc *UserCreate) AddFriends(u ...*User) *UserCreate {
 }
 
 // Save creates the User in the database.
-func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+func (uc *UserCreate) Save(ctx context.Context, opts ...ent.CallOption) (*User, error) {
+	options := ent.NewCallOptions(opts...)
 	if uc.age == nil {
 		return nil, errors.New("ent: missing required field \"age\"")
 	}
 	if uc.name == nil {
 		return nil, errors.New("ent: missing required field \"name\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return uc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (uc *UserCreate) SaveX(ctx context.Context) *User {
-	v, err := uc.Save(ctx)
+func (uc *UserCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *User {
+	v, err := uc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -102,16 +110,16 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 	}
 	u.ID = int(id)
 	if len(uc.friends) > 0 {
-		for eid := range uc.friends {
 
-			query, args := sql.Insert(user.FriendsTable).
-				Columns(user.FriendsPrimaryKey[0], user.FriendsPrimaryKey[1]).
-				Values(id, eid).
-				Values(eid, id).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+		builder := sql.Insert(user.FriendsTable).
+			Columns(user.FriendsPrimaryKey[0], user.FriendsPrimaryKey[1])
+		for eid := range uc.friends {
+			builder.Values(id, eid)
+			builder.Values(eid, id)
+		}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if err := tx.Commit(); err != nil {
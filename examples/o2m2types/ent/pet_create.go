@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/o2m2types/ent/pet"
 )
@@ -17,8 +18,12 @@ import (
 // PetCreate is the builder for creating a Pet entity.
 type PetCreate struct {
 	config
-	name  *string
-	owner map[int]struct{}
+	petMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (pc *PetCreate) Mutation() *PetMutation {
+	return &PetMutation{petMutation: &pc.petMutation, op: ent.OpCreate}
 }
 
 // SetName sets the name field.
@@ -50,19 +55,23 @@ func (pc *PetCreate) SetOwner(u *User) *PetCreate {
 }
 
 // Save creates the Pet in the database.
-func (pc *PetCreate) Save(ctx context.Context) (*Pet, error) {
+func (pc *PetCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Pet, error) {
+	options := ent.NewCallOptions(opts...)
 	if pc.name == nil {
 		return nil, errors.New("ent: missing required field \"name\"")
 	}
 	if len(pc.owner) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return pc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (pc *PetCreate) SaveX(ctx context.Context) *Pet {
-	v, err := pc.Save(ctx)
+func (pc *PetCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Pet {
+	v, err := pc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -83,6 +92,9 @@ func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
 		builder.Set(pet.FieldName, *value)
 		pe.Name = *value
 	}
+	for eid := range pc.owner {
+		builder.Set(pet.OwnerColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -92,17 +104,6 @@ func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
 		return nil, rollback(tx, err)
 	}
 	pe.ID = int(id)
-	if len(pc.owner) > 0 {
-		for eid := range pc.owner {
-			query, args := sql.Update(pet.OwnerTable).
-				Set(pet.OwnerColumn, eid).
-				Where(sql.EQ(pet.FieldID, id)).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
-		}
-	}
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
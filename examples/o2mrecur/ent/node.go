@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/examples/o2mrecur/ent/node"
 )
 
 // Node is the model entity for the Node schema.
@@ -20,19 +21,43 @@ type Node struct {
 	ID int `json:"id,omitempty"`
 	// Value holds the value of the "value" field.
 	Value int `json:"value,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the NodeQuery when eager-loading is set.
+	Edges NodeEdges `json:"edges"`
+}
+
+// NodeEdges holds the relations/edges of other nodes in the graph.
+type NodeEdges struct {
+	// Parent holds the value of the parent edge.
+	Parent *Node `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Node `json:"children,omitempty"`
 }
 
 // FromRows scans the sql response data into Node.
 func (n *Node) FromRows(rows *sql.Rows) error {
+	return n.scan(rows, node.Columns)
+}
+
+// scan scans the given columns of the sql response data into Node.
+// Fields that their columns were not selected are left with their zero values.
+func (n *Node) scan(rows *sql.Rows, columns []string) error {
 	var vn struct {
 		ID    int
 		Value sql.NullInt64
 	}
-	// the order here should be the same as in the `node.Columns`.
-	if err := rows.Scan(
-		&vn.ID,
-		&vn.Value,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case node.FieldID:
+			values[i] = &vn.ID
+		case node.FieldValue:
+			values[i] = &vn.Value
+		default:
+			return fmt.Errorf("unexpected column %q for type Node", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	n.ID = vn.ID
@@ -68,6 +93,36 @@ func (n *Node) Unwrap() *Node {
 	return n
 }
 
+// Clone returns a deep copy of the Node, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (n *Node) Clone() *Node {
+	return n.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Node, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (n *Node) clone(seen map[interface{}]interface{}) *Node {
+	if n == nil {
+		return nil
+	}
+	if _c, ok := seen[n]; ok {
+		return _c.(*Node)
+	}
+	_c := *n
+	_c.config = config{}
+	seen[n] = &_c
+	_c.Edges.Parent = n.Edges.Parent.clone(seen)
+	if nodes := n.Edges.Children; nodes != nil {
+		_c.Edges.Children = make([]*Node, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Children[_i] = _n.clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (n *Node) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -78,14 +133,30 @@ func (n *Node) String() string {
 	return buf.String()
 }
 
+// DiffNode returns the fields that have different values in the given Nodes,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffNode(a, b *Node) []FieldChange {
+	var changes []FieldChange
+	if a.Value != b.Value {
+		changes = append(changes, FieldChange{Field: node.FieldValue, Old: a.Value, New: b.Value})
+	}
+	return changes
+}
+
 // Nodes is a parsable slice of Node.
 type Nodes []*Node
 
 // FromRows scans the sql response data into Nodes.
 func (n *Nodes) FromRows(rows *sql.Rows) error {
+	return n.scan(rows, node.Columns)
+}
+
+// scan scans the given columns of the sql response data into Nodes.
+func (n *Nodes) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vn := &Node{}
-		if err := vn.FromRows(rows); err != nil {
+		if err := vn.scan(rows, columns); err != nil {
 			return err
 		}
 		*n = append(*n, vn)
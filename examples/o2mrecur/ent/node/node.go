@@ -6,6 +6,12 @@
 
 package node
 
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/examples/o2mrecur/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the node type in the database.
 	Label = "node"
@@ -31,3 +37,70 @@ var Columns = []string{
 	FieldID,
 	FieldValue,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope is a reusable named scope of the Node queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.Node
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the Node type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "Node",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeInt,
+		GoType:     "int",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "value",
+			StorageKey: FieldValue,
+			Type:       field.TypeInt,
+			GoType:     "int",
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "parent",
+			Type:     "Node",
+			Rel:      "M2O",
+			Unique:   true,
+			Optional: true,
+			Inverse:  true,
+			Table:    "nodes",
+			Columns:  []string{"parent_id"},
+		},
+		{
+			Name:     "children",
+			Type:     "Node",
+			Rel:      "O2M",
+			Optional: true,
+			Table:    "nodes",
+			Columns:  []string{"parent_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the Node type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
@@ -7,6 +7,11 @@
 package node
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/o2mrecur/ent/predicate"
 )
@@ -112,6 +117,36 @@ func IDLTE(id int) predicate.Node {
 	)
 }
 
+// IDInQuery applies the In predicate on the ID field, with the ids of the given query as a subquery.
+// It's used for filtering by the results of another query, without fetching its ids first. For example:
+//
+//	client.Node.Query().Where(node.IDInQuery(client.Node.Query().Where(...)))
+//
+// The interceptors of the client are applied on the subquery with the context of the query, and
+// their errors fail the query.
+func IDInQuery(q predicate.Subquery) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		t, err := q.IDsSubquery(s.Context())
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(FieldID), t))
+	})
+}
+
+// IDNotInQuery applies the NotIn predicate on the ID field, with the ids of the given query as a subquery.
+func IDNotInQuery(q predicate.Subquery) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		t, err := q.IDsSubquery(s.Context())
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldID), t))
+	})
+}
+
 // Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
 func Value(v int) predicate.Node {
 	return predicate.Node(
@@ -223,20 +258,55 @@ func HasParent() predicate.Node {
 	)
 }
 
+// HasNoParent applies a predicate that checks that the "parent" edge is empty. It's identical
+// to Not(HasParent()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the Node nodes.
+func HasNoParent() predicate.Node {
+	return predicate.Node(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(sql.IsNull(t1.C(ParentColumn)))
+		},
+	)
+}
+
 // HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
 func HasParentWith(preds ...predicate.Node) predicate.Node {
 	return predicate.Node(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(FieldID).From(sql.Table(ParentTable))
+			t2 := sql.Select(FieldID).From(sql.Table(ParentTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(ParentColumn), t2))
 		},
 	)
 }
 
+// HasParentWithRecursive applies the HasEdge predicate on the "parent" edge recursively. It matches the nodes
+// that reach a node with the given conditions by following the "parent" edge one or more times.
+// In SQL dialects, it's translated to a recursive common table expression (WITH RECURSIVE).
+func HasParentWithRecursive(preds ...predicate.Node) predicate.Node {
+	return predicate.Node(
+		func(s *sql.Selector) {
+			t1, t2 := s.Table(), sql.Table(Table)
+			t3 := sql.Select(FieldID).From(sql.Table(Table)).WithContext(s.Context())
+			for _, p := range preds {
+				p(t3)
+			}
+			s.AddError(t3.Err())
+			w := sql.WithRecursive("parent_recursive", FieldID)
+			w.As(
+				sql.Select(t2.C(FieldID)).From(t2).Where(sql.In(t2.C(ParentColumn), t3)).
+					Union(sql.Select(t2.C(FieldID)).From(t2).Join(w).On(t2.C(ParentColumn), w.C(FieldID))),
+			)
+			s.Where(sql.In(t1.C(FieldID), sql.Select(w.C(FieldID)).From(w).With(w)))
+		},
+	)
+}
+
 // HasChildren applies the HasEdge predicate on the "children" edge.
 func HasChildren() predicate.Node {
 	return predicate.Node(
@@ -254,20 +324,202 @@ func HasChildren() predicate.Node {
 	)
 }
 
+// HasNoChildren applies a predicate that checks that the "children" edge is empty. It's identical
+// to Not(HasChildren()), but it's checked using IS NULL for the foreign-keys that are stored in
+// the table of the Node nodes.
+func HasNoChildren() predicate.Node {
+	return predicate.Node(
+		func(s *sql.Selector) {
+			t1 := s.Table()
+			s.Where(
+				sql.NotIn(
+					t1.C(FieldID),
+					sql.Select(ChildrenColumn).
+						From(sql.Table(ChildrenTable)).
+						Where(sql.NotNull(ChildrenColumn)),
+				),
+			)
+		},
+	)
+}
+
 // HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
 func HasChildrenWith(preds ...predicate.Node) predicate.Node {
 	return predicate.Node(
 		func(s *sql.Selector) {
 			t1 := s.Table()
-			t2 := sql.Select(ChildrenColumn).From(sql.Table(ChildrenTable))
+			t2 := sql.Select(ChildrenColumn).From(sql.Table(ChildrenTable)).WithContext(s.Context())
 			for _, p := range preds {
 				p(t2)
 			}
+			s.AddError(t2.Err())
 			s.Where(sql.In(t1.C(FieldID), t2))
 		},
 	)
 }
 
+// HasChildrenWithRecursive applies the HasEdge predicate on the "children" edge recursively. It matches the nodes
+// that reach a node with the given conditions by following the "children" edge one or more times.
+// In SQL dialects, it's translated to a recursive common table expression (WITH RECURSIVE).
+func HasChildrenWithRecursive(preds ...predicate.Node) predicate.Node {
+	return predicate.Node(
+		func(s *sql.Selector) {
+			t1, t2 := s.Table(), sql.Table(Table)
+			t3 := sql.Select(FieldID).From(sql.Table(Table)).WithContext(s.Context())
+			for _, p := range preds {
+				p(t3)
+			}
+			s.AddError(t3.Err())
+			w := sql.WithRecursive("children_recursive", FieldID)
+			w.As(
+				sql.Select(t2.C(ChildrenColumn)).From(t2).Where(sql.And(sql.In(t2.C(FieldID), t3), sql.NotNull(t2.C(ChildrenColumn)))).
+					Union(sql.Select(t2.C(ChildrenColumn)).From(t2).Join(w).On(t2.C(FieldID), w.C(FieldID)).Where(sql.NotNull(t2.C(ChildrenColumn)))),
+			)
+			s.Where(sql.In(t1.C(FieldID), sql.Select(w.C(FieldID)).From(w).With(w)))
+		},
+	)
+}
+
+// ByChildrenCount orders the results by the number of "children" edges,
+// in descending order if desc is true. It's used as an order option of the
+// Node queries. For example:
+//
+//	client.Node.Query().Order(node.ByChildrenCount(true))
+//
+func ByChildrenCount(desc bool) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		t1 := s.Table()
+		t2 := sql.Table(ChildrenTable).As("children_count")
+		count := sql.Select(sql.Count("*")).
+			From(t2).
+			Where(sql.ColumnsEQ(t2.C(ChildrenColumn), t1.C(FieldID)))
+		query, _ := count.Query()
+		order := sql.Asc
+		if desc {
+			order = sql.Desc
+		}
+		s.OrderBy(order(fmt.Sprintf("(%s)", query)))
+	}
+}
+
+// PredicateFunc returns a predicate from the given function.
+// It allows adding custom SQL fragments to the Node builders.
+func PredicateFunc(f func(*sql.Selector)) predicate.Node {
+	return predicate.Node(f)
+}
+
+// FilterMap returns a predicate that is built from the given filter map (e.g. an API filter).
+// The keys of the map are field names with an optional operator suffix (e.g. "age" or "age.gt"),
+// and the values are the operands. Variadic operators (in, notin) expect a slice of the field type,
+// and niladic operators (isnil, notnil) expect a boolean value. Values of other types (e.g. the output
+// of json.Unmarshal) are converted to the field type using their JSON encoding. The predicates of the
+// keys are grouped with the AND operator.
+func FilterMap(m map[string]interface{}) (predicate.Node, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	predicates := make([]predicate.Node, 0, len(keys))
+	for _, k := range keys {
+		p, err := filter(k, m[k])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return And(predicates...), nil
+}
+
+// filter returns the predicate of the given filter key and value.
+func filter(key string, value interface{}) (predicate.Node, error) {
+	name, op := key, "eq"
+	if i := strings.IndexByte(key, '.'); i > 0 {
+		name, op = key[:i], strings.ToLower(key[i+1:])
+	}
+	switch name {
+	case FieldID:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return IDIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return IDNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return IDLTE(v), nil
+			}
+		}
+	case FieldValue:
+		switch op {
+		case "eq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueEQ(v), nil
+			}
+		case "neq":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueNEQ(v), nil
+			}
+		case "in":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return ValueIn(vs...), nil
+			}
+		case "notin":
+			if vs, ok := value.([]int); ok || convert(value, &vs) {
+				return ValueNotIn(vs...), nil
+			}
+		case "gt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueGT(v), nil
+			}
+		case "gte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueGTE(v), nil
+			}
+		case "lt":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueLT(v), nil
+			}
+		case "lte":
+			if v, ok := value.(int); ok || convert(value, &v) {
+				return ValueLTE(v), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("node: invalid filter %q with value of type %T", key, value)
+}
+
+// convert converts a filter value that is not of the field type (e.g. a value that was decoded by
+// json.Unmarshal, like float64 numbers, RFC 3339 time strings or []interface{} slices) to the type
+// of v, by encoding it to JSON and decoding it into v. It reports if the conversion succeeded.
+func convert(value, v interface{}) bool {
+	buf, err := json.Marshal(value)
+	return err == nil && json.Unmarshal(buf, v) == nil
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Node) predicate.Node {
 	return predicate.Node(
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/o2mrecur/ent/node"
 )
@@ -18,9 +19,12 @@ import (
 // NodeCreate is the builder for creating a Node entity.
 type NodeCreate struct {
 	config
-	value    *int
-	parent   map[int]struct{}
-	children map[int]struct{}
+	nodeMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (nc *NodeCreate) Mutation() *NodeMutation {
+	return &NodeMutation{nodeMutation: &nc.nodeMutation, op: ent.OpCreate}
 }
 
 // SetValue sets the value field.
@@ -72,19 +76,23 @@ func (nc *NodeCreate) AddChildren(n ...*Node) *NodeCreate {
 }
 
 // Save creates the Node in the database.
-func (nc *NodeCreate) Save(ctx context.Context) (*Node, error) {
+func (nc *NodeCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Node, error) {
+	options := ent.NewCallOptions(opts...)
 	if nc.value == nil {
 		return nil, errors.New("ent: missing required field \"value\"")
 	}
 	if len(nc.parent) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"parent\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return nc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (nc *NodeCreate) SaveX(ctx context.Context) *Node {
-	v, err := nc.Save(ctx)
+func (nc *NodeCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Node {
+	v, err := nc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -105,6 +113,9 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 		builder.Set(node.FieldValue, *value)
 		n.Value = *value
 	}
+	for eid := range nc.parent {
+		builder.Set(node.ParentColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -114,17 +125,6 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 		return nil, rollback(tx, err)
 	}
 	n.ID = int(id)
-	if len(nc.parent) > 0 {
-		for eid := range nc.parent {
-			query, args := sql.Update(node.ParentTable).
-				Set(node.ParentColumn, eid).
-				Where(sql.EQ(node.FieldID, id)).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
-		}
-	}
 	if len(nc.children) > 0 {
 		p := sql.P()
 		for eid := range nc.children {
@@ -142,7 +142,7 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(nc.children) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"children\" %v already connected to a different \"Node\"", keys(nc.children))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"children\" %v already connected to a different \"Node\"", keys(nc.children))})
 		}
 	}
 	if err := tx.Commit(); err != nil {
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/o2mrecur/ent/node"
 	"github.com/facebookincubator/ent/examples/o2mrecur/ent/predicate"
@@ -20,13 +21,24 @@ import (
 // NodeQuery is the builder for querying Node entities.
 type NodeQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit  *int
+	offset *int
+	order  []Order
+	unique []string
+	fields []string
+	hints  []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.Node
+	// eager-loading edges.
+	withParent   *NodeQuery
+	withChildren *NodeQuery
 	// intermediate queries.
 	sql *sql.Selector
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath func(context.Context) (*sql.Selector, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -53,32 +65,167 @@ func (nq *NodeQuery) Order(o ...Order) *NodeQuery {
 	return nq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored.
+func (nq *NodeQuery) Hint(hints ...sql.Hint) *NodeQuery {
+	nq.hints = append(nq.hints, hints...)
+	return nq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	ns, err := client.Node.Query().
+//		Fields(node.FieldValue).
+//		All(ctx)
+//
+func (nq *NodeQuery) Fields(fields ...string) *NodeQuery {
+	nq.fields = append(nq.fields, fields...)
+	return nq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (nq *NodeQuery) Scope(scopes ...node.Scope) *NodeQuery {
+	for _, s := range scopes {
+		nq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				nq.Order(Desc(o.Field))
+			} else {
+				nq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			nq.Limit(s.Limit)
+		}
+	}
+	return nq
+}
+
+// NodeInterceptor is a query interceptor of the NodeClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the NodeClient.Intercept method.
+type NodeInterceptor func(context.Context, *NodeQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (nq *NodeQuery) prepare(ctx context.Context) (*NodeQuery, error) {
+	var interceptors []NodeInterceptor
+	if nq.inters != nil {
+		nq.inters.mu.RLock()
+		interceptors = nq.inters.Node
+		nq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && nq.sqlPath == nil {
+		return nq, nil
+	}
+	query := nq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptNode returns the predicates that the interceptors of the client add to a Node query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptNode(ctx context.Context, cfg config) ([]predicate.Node, error) {
+	query, err := (&NodeQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryParent chains the current query on the parent edge.
 func (nq *NodeQuery) QueryParent() *NodeQuery {
 	query := &NodeQuery{config: nq.config}
-	t1 := sql.Table(node.Table)
-	t2 := nq.sqlQuery()
-	t2.Select(t2.C(node.ParentColumn))
-	query.sql = sql.Select(t1.Columns(node.Columns...)...).
-		From(t1).
-		Join(t2).
-		On(t1.C(node.FieldID), t2.C(node.ParentColumn))
+	query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+		prev, err := nq.prepare(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t1 := sql.Table(node.Table)
+		t2 := prev.sqlQuery(ctx)
+		t2.Select(t2.C(node.ParentColumn))
+		return sql.Select(t1.Columns(node.Columns...)...).
+			From(t1).
+			Join(t2).
+			On(t1.C(node.FieldID), t2.C(node.ParentColumn)), nil
+	}
 	return query
 }
 
 // QueryChildren chains the current query on the children edge.
 func (nq *NodeQuery) QueryChildren() *NodeQuery {
 	query := &NodeQuery{config: nq.config}
-	t1 := sql.Table(node.Table)
-	t2 := nq.sqlQuery()
-	t2.Select(t2.C(node.FieldID))
-	query.sql = sql.Select().
-		From(t1).
-		Join(t2).
-		On(t1.C(node.ChildrenColumn), t2.C(node.FieldID))
+	query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+		prev, err := nq.prepare(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t1 := sql.Table(node.Table)
+		t2 := prev.sqlQuery(ctx)
+		t2.Select(t2.C(node.FieldID))
+		return sql.Select().
+			From(t1).
+			Join(t2).
+			On(t1.C(node.ChildrenColumn), t2.C(node.FieldID)), nil
+	}
 	return query
 }
 
+// WithParent tells the query-builder to eager-load the nodes that are connected to the "parent" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Node.Query().
+//		WithParent(func(q *ent.NodeQuery) {
+//			q.Order(ent.Desc(node.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (nq *NodeQuery) WithParent(opts ...func(*NodeQuery)) *NodeQuery {
+	query := &NodeQuery{config: nq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withParent = query
+	return nq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to the "children" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Node.Query().
+//		WithChildren(func(q *ent.NodeQuery) {
+//			q.Order(ent.Desc(node.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (nq *NodeQuery) WithChildren(opts ...func(*NodeQuery)) *NodeQuery {
+	query := &NodeQuery{config: nq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withChildren = query
+	return nq
+}
+
 // First returns the first Node entity in the query. Returns *ErrNotFound when no node was found.
 func (nq *NodeQuery) First(ctx context.Context) (*Node, error) {
 	ns, err := nq.Limit(1).All(ctx)
@@ -174,22 +321,41 @@ func (nq *NodeQuery) OnlyXID(ctx context.Context) int {
 }
 
 // All executes the query and returns a list of Nodes.
-func (nq *NodeQuery) All(ctx context.Context) ([]*Node, error) {
-	return nq.sqlAll(ctx)
+func (nq *NodeQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*Node, error) {
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlAll(ctx)
 }
 
 // AllX is like All, but panics if an error occurs.
-func (nq *NodeQuery) AllX(ctx context.Context) []*Node {
-	ns, err := nq.All(ctx)
+func (nq *NodeQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*Node {
+	ns, err := nq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return ns
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (nq *NodeQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return query.sqlQueryString(ctx)
+}
+
 // IDs executes the query and returns a list of Node ids.
 func (nq *NodeQuery) IDs(ctx context.Context) ([]int, error) {
-	return nq.sqlIDs(ctx)
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlIDs(ctx)
 }
 
 // IDsX is like IDs, but panics if an error occurs.
@@ -201,9 +367,42 @@ func (nq *NodeQuery) IDsX(ctx context.Context) []int {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Nodes that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (nq *NodeQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if nq.sql != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &NodeQuery{
+		config:     nq.config,
+		predicates: append([]predicate.Node{}, nq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(int)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for Node", after)
+		}
+		query.Where(node.IDGT(id))
+	}
+	ids, err := query.Order(Asc(node.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (nq *NodeQuery) Count(ctx context.Context) (int, error) {
-	return nq.sqlCount(ctx)
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return query.sqlCount(ctx)
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -217,7 +416,11 @@ func (nq *NodeQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (nq *NodeQuery) Exist(ctx context.Context) (bool, error) {
-	return nq.sqlExist(ctx)
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	return query.sqlExist(ctx)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -233,14 +436,20 @@ func (nq *NodeQuery) ExistX(ctx context.Context) bool {
 // used to prepare common query builders and use them differently after the clone is made.
 func (nq *NodeQuery) Clone() *NodeQuery {
 	return &NodeQuery{
-		config:     nq.config,
-		limit:      nq.limit,
-		offset:     nq.offset,
-		order:      append([]Order{}, nq.order...),
-		unique:     append([]string{}, nq.unique...),
-		predicates: append([]predicate.Node{}, nq.predicates...),
+		config:       nq.config,
+		limit:        nq.limit,
+		offset:       nq.offset,
+		order:        append([]Order{}, nq.order...),
+		unique:       append([]string{}, nq.unique...),
+		fields:       append([]string{}, nq.fields...),
+		hints:        append([]sql.Hint{}, nq.hints...),
+		edgeCount:    nq.edgeCount,
+		predicates:   append([]predicate.Node{}, nq.predicates...),
+		withParent:   nq.withParent,
+		withChildren: nq.withChildren,
 		// clone intermediate queries.
-		sql: nq.sql.Clone(),
+		sql:     nq.sql.Clone(),
+		sqlPath: nq.sqlPath,
 	}
 }
 
@@ -262,7 +471,14 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 func (nq *NodeQuery) GroupBy(field string, fields ...string) *NodeGroupBy {
 	group := &NodeGroupBy{config: nq.config}
 	group.fields = append([]string{field}, fields...)
-	group.sql = nq.sqlQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := nq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		group.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return group
 }
 
@@ -281,15 +497,22 @@ func (nq *NodeQuery) GroupBy(field string, fields ...string) *NodeGroupBy {
 func (nq *NodeQuery) Select(field string, fields ...string) *NodeSelect {
 	selector := &NodeSelect{config: nq.config}
 	selector.fields = append([]string{field}, fields...)
-	selector.sql = nq.sqlQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := nq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		selector.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return selector
 }
 
 func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
 	rows := &sql.Rows{}
-	selector := nq.sqlQuery()
-	if unique := nq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := nq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := nq.driver.Query(ctx, query, args, rows); err != nil {
@@ -297,21 +520,217 @@ func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
 	}
 	defer rows.Close()
 	var ns Nodes
-	if err := ns.FromRows(rows); err != nil {
+	if err := ns.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	ns.config(nq.config)
+	if query := nq.withParent; query != nil {
+		if err := nq.sqlLoadParent(ctx, query, ns); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withChildren; query != nil {
+		if err := nq.sqlLoadChildren(ctx, query, ns); err != nil {
+			return nil, err
+		}
+	}
 	return ns, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (nq *NodeQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := nq.sqlQuery(ctx)
+	if unique := nq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := node.Columns
+	if fields := nq.fields; len(fields) > 0 {
+		columns = append([]string{node.FieldID}, fields...)
+		for _, c := range fields {
+			if !node.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (nq *NodeQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := nq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadParent loads the nodes of the parent edge of the given Nodes using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (nq *NodeQuery) sqlLoadParent(ctx context.Context, query *NodeQuery, nodes []*Node) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[int]*Node, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(node.ParentTable)
+	selector.Join(t1).On(t1.C(node.ParentColumn), selector.C(node.FieldID))
+	key := t1.C(node.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(node.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := nq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []int
+		eids []int
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, nid)
+		eids = append(eids, eid)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.Node{node.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[int]*Node, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Parent = edge
+	}
+	return nil
+}
+
+// sqlLoadChildren loads the nodes of the children edge of the given Nodes using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (nq *NodeQuery) sqlLoadChildren(ctx context.Context, query *NodeQuery, nodes []*Node) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[int]*Node, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	key := selector.C(node.ChildrenColumn)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(node.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := nq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []int
+		eids []int
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, nid)
+		eids = append(eids, eid)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.Node{node.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[int]*Node, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Children = append(node.Edges.Children, edge)
+	}
+	return nil
+}
+
 func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := nq.sqlQuery()
+	selector := nq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{node.FieldID}
 	if len(nq.unique) > 0 {
 		unique = nq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := nq.edgeCount; c != nil && len(nq.predicates) == 0 && len(nq.unique) == 0 &&
+		len(nq.hints) == 0 && nq.limit == nil && nq.offset == nil {
+		selector = c.Clone().SetDialect(nq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := nq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -328,11 +747,22 @@ func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (nq *NodeQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := nq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := nq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(node.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := nq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := nq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (nq *NodeQuery) sqlIDs(ctx context.Context) ([]int, error) {
@@ -347,13 +777,33 @@ func (nq *NodeQuery) sqlIDs(ctx context.Context) ([]int, error) {
 	return ids, nil
 }
 
-func (nq *NodeQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (nq *NodeQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := nq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(node.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (nq *NodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(node.Table)
 	selector := sql.Select(t1.Columns(node.Columns...)...).From(t1)
 	if nq.sql != nil {
 		selector = nq.sql
 		selector.Select(selector.Columns(node.Columns...)...)
 	}
+	selector.SetDialect(nq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(nq.hints...)
 	for _, p := range nq.predicates {
 		p(selector)
 	}
@@ -378,6 +828,10 @@ type NodeGroupBy struct {
 	fns    []Aggregate
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving *sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -386,8 +840,25 @@ func (ngb *NodeGroupBy) Aggregate(fns ...Aggregate) *NodeGroupBy {
 	return ngb
 }
 
+// Having adds a predicate on the aggregated values of the groups.
+// For example:
+//
+//	client.Node.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5)).
+//		Scan(ctx, &v)
+//
+func (ngb *NodeGroupBy) Having(p *sql.Predicate) *NodeGroupBy {
+	ngb.sqlHaving = p
+	return ngb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (ngb *NodeGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := ngb.path(ctx); err != nil {
+		return err
+	}
 	return ngb.sqlScan(ctx, v)
 }
 
@@ -483,6 +954,9 @@ func (ngb *NodeGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (ngb *NodeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ngb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ngb.sqlQuery().Query()
 	if err := ngb.driver.Query(ctx, query, args, rows); err != nil {
@@ -499,7 +973,11 @@ func (ngb *NodeGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range ngb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(ngb.fields...)
+	selector.Select(columns...).GroupBy(ngb.fields...)
+	if ngb.sqlHaving != nil {
+		selector.Having(ngb.sqlHaving)
+	}
+	return selector
 }
 
 // NodeSelect is the builder for select fields of Node entities.
@@ -508,10 +986,15 @@ type NodeSelect struct {
 	fields []string
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (ns *NodeSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ns.path(ctx); err != nil {
+		return err
+	}
 	return ns.sqlScan(ctx, v)
 }
 
@@ -607,6 +1090,9 @@ func (ns *NodeSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (ns *NodeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ns.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ns.sqlQuery().Query()
 	if err := ns.driver.Query(ctx, query, args, rows); err != nil {
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/examples/o2o2types/ent/card"
 )
 
 // Card is the model entity for the Card schema.
@@ -23,21 +24,44 @@ type Card struct {
 	Expired time.Time `json:"expired,omitempty"`
 	// Number holds the value of the "number" field.
 	Number string `json:"number,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the CardQuery when eager-loading is set.
+	Edges CardEdges `json:"edges"`
+}
+
+// CardEdges holds the relations/edges of other nodes in the graph.
+type CardEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
 }
 
 // FromRows scans the sql response data into Card.
 func (c *Card) FromRows(rows *sql.Rows) error {
+	return c.scan(rows, card.Columns)
+}
+
+// scan scans the given columns of the sql response data into Card.
+// Fields that their columns were not selected are left with their zero values.
+func (c *Card) scan(rows *sql.Rows, columns []string) error {
 	var vc struct {
 		ID      int
 		Expired sql.NullTime
 		Number  sql.NullString
 	}
-	// the order here should be the same as in the `card.Columns`.
-	if err := rows.Scan(
-		&vc.ID,
-		&vc.Expired,
-		&vc.Number,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case card.FieldID:
+			values[i] = &vc.ID
+		case card.FieldExpired:
+			values[i] = &vc.Expired
+		case card.FieldNumber:
+			values[i] = &vc.Number
+		default:
+			return fmt.Errorf("unexpected column %q for type Card", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	c.ID = vc.ID
@@ -69,6 +93,30 @@ func (c *Card) Unwrap() *Card {
 	return c
 }
 
+// Clone returns a deep copy of the Card, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (c *Card) Clone() *Card {
+	return c.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Card, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (c *Card) clone(seen map[interface{}]interface{}) *Card {
+	if c == nil {
+		return nil
+	}
+	if _c, ok := seen[c]; ok {
+		return _c.(*Card)
+	}
+	_c := *c
+	_c.config = config{}
+	seen[c] = &_c
+	_c.Edges.Owner = c.Edges.Owner.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (c *Card) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -80,14 +128,33 @@ func (c *Card) String() string {
 	return buf.String()
 }
 
+// DiffCard returns the fields that have different values in the given Cards,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffCard(a, b *Card) []FieldChange {
+	var changes []FieldChange
+	if !a.Expired.Equal(b.Expired) {
+		changes = append(changes, FieldChange{Field: card.FieldExpired, Old: a.Expired, New: b.Expired})
+	}
+	if a.Number != b.Number {
+		changes = append(changes, FieldChange{Field: card.FieldNumber, Old: a.Number, New: b.Number})
+	}
+	return changes
+}
+
 // Cards is a parsable slice of Card.
 type Cards []*Card
 
 // FromRows scans the sql response data into Cards.
 func (c *Cards) FromRows(rows *sql.Rows) error {
+	return c.scan(rows, card.Columns)
+}
+
+// scan scans the given columns of the sql response data into Cards.
+func (c *Cards) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vc := &Card{}
-		if err := vc.FromRows(rows); err != nil {
+		if err := vc.scan(rows, columns); err != nil {
 			return err
 		}
 		*c = append(*c, vc)
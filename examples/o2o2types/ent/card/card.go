@@ -6,6 +6,12 @@
 
 package card
 
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/examples/o2o2types/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
 const (
 	// Label holds the string label denoting the card type in the database.
 	Label = "card"
@@ -33,3 +39,67 @@ var Columns = []string{
 	FieldExpired,
 	FieldNumber,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope is a reusable named scope of the Card queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.Card
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the Card type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "Card",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeInt,
+		GoType:     "int",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "expired",
+			StorageKey: FieldExpired,
+			Type:       field.TypeTime,
+			GoType:     "time.Time",
+		},
+		{
+			Name:       "number",
+			StorageKey: FieldNumber,
+			Type:       field.TypeString,
+			GoType:     "string",
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:    "owner",
+			Type:    "User",
+			Rel:     "O2O",
+			Unique:  true,
+			Inverse: true,
+			Table:   "cards",
+			Columns: []string{"owner_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the Card type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
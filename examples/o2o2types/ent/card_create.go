@@ -9,9 +9,9 @@ package ent
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/o2o2types/ent/card"
 )
@@ -19,9 +19,12 @@ import (
 // CardCreate is the builder for creating a Card entity.
 type CardCreate struct {
 	config
-	expired *time.Time
-	number  *string
-	owner   map[int]struct{}
+	cardMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (cc *CardCreate) Mutation() *CardMutation {
+	return &CardMutation{cardMutation: &cc.cardMutation, op: ent.OpCreate}
 }
 
 // SetExpired sets the expired field.
@@ -51,7 +54,8 @@ func (cc *CardCreate) SetOwner(u *User) *CardCreate {
 }
 
 // Save creates the Card in the database.
-func (cc *CardCreate) Save(ctx context.Context) (*Card, error) {
+func (cc *CardCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Card, error) {
+	options := ent.NewCallOptions(opts...)
 	if cc.expired == nil {
 		return nil, errors.New("ent: missing required field \"expired\"")
 	}
@@ -64,12 +68,15 @@ func (cc *CardCreate) Save(ctx context.Context) (*Card, error) {
 	if cc.owner == nil {
 		return nil, errors.New("ent: missing required edge \"owner\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return cc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (cc *CardCreate) SaveX(ctx context.Context) *Card {
-	v, err := cc.Save(ctx)
+func (cc *CardCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Card {
+	v, err := cc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -94,6 +101,9 @@ func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
 		builder.Set(card.FieldNumber, *value)
 		c.Number = *value
 	}
+	for eid := range cc.owner {
+		builder.Set(card.OwnerColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -103,23 +113,6 @@ func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
 		return nil, rollback(tx, err)
 	}
 	c.ID = int(id)
-	if len(cc.owner) > 0 {
-		eid := keys(cc.owner)[0]
-		query, args := sql.Update(card.OwnerTable).
-			Set(card.OwnerColumn, eid).
-			Where(sql.EQ(card.FieldID, id).And().IsNull(card.OwnerColumn)).
-			Query()
-		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return nil, rollback(tx, err)
-		}
-		affected, err := res.RowsAffected()
-		if err != nil {
-			return nil, rollback(tx, err)
-		}
-		if int(affected) < len(cc.owner) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"owner\" %v already connected to a different \"Card\"", keys(cc.owner))})
-		}
-	}
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/o2o2types/ent/card"
 	"github.com/facebookincubator/ent/examples/o2o2types/ent/predicate"
@@ -27,13 +28,26 @@ func (cd *CardDelete) Where(ps ...predicate.Card) *CardDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (cd *CardDelete) Exec(ctx context.Context) (int, error) {
+func (cd *CardDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	predicates, err := interceptCard(ctx, cd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cd
+		intercepted.predicates = append(predicates, cd.predicates...)
+		cd = &intercepted
+	}
 	return cd.sqlExec(ctx)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cd *CardDelete) ExecX(ctx context.Context) int {
-	n, err := cd.Exec(ctx)
+func (cd *CardDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := cd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -42,10 +56,13 @@ func (cd *CardDelete) ExecX(ctx context.Context) int {
 
 func (cd *CardDelete) sqlExec(ctx context.Context) (int, error) {
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(card.Table))
+	selector := sql.Select().From(sql.Table(card.Table)).SetDialect(cd.driver.Dialect()).WithContext(ctx)
 	for _, p := range cd.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(card.Table).FromSelect(selector).Query()
 	if err := cd.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -63,12 +80,12 @@ type CardDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (cdo *CardDeleteOne) Exec(ctx context.Context) error {
-	n, err := cdo.cd.Exec(ctx)
+func (cdo *CardDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := cdo.cd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{card.Label}
 	default:
 		return nil
@@ -76,6 +93,8 @@ func (cdo *CardDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cdo *CardDeleteOne) ExecX(ctx context.Context) {
-	cdo.cd.ExecX(ctx)
+func (cdo *CardDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cdo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/o2o2types/ent/card"
 	"github.com/facebookincubator/ent/examples/o2o2types/ent/predicate"
@@ -21,11 +22,11 @@ import (
 // CardUpdate is the builder for updating Card entities.
 type CardUpdate struct {
 	config
-	expired      *time.Time
-	number       *string
-	owner        map[int]struct{}
-	clearedOwner bool
-	predicates   []predicate.Card
+	cardMutation
+	predicates []predicate.Card
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Card
 }
 
 // Where adds a new predicate for the builder.
@@ -34,6 +35,11 @@ func (cu *CardUpdate) Where(ps ...predicate.Card) *CardUpdate {
 	return cu
 }
 
+// Mutation returns the mutation of the builder.
+func (cu *CardUpdate) Mutation() *CardMutation {
+	return &CardMutation{cardMutation: &cu.cardMutation, op: ent.OpUpdate}
+}
+
 // SetExpired sets the expired field.
 func (cu *CardUpdate) SetExpired(t time.Time) *CardUpdate {
 	cu.expired = &t
@@ -67,19 +73,52 @@ func (cu *CardUpdate) ClearOwner() *CardUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (cu *CardUpdate) Save(ctx context.Context) (int, error) {
+func (cu *CardUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := cu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (cu *CardUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(cu.owner) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"owner\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
 	}
 	if cu.clearedOwner && cu.owner == nil {
-		return 0, errors.New("ent: clearing a unique edge \"owner\"")
+		return nil, errors.New("ent: clearing a unique edge \"owner\"")
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptCard(ctx, cu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cu
+		intercepted.predicates = append(predicates, cu.predicates...)
+		cu = &intercepted
 	}
 	return cu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (cu *CardUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Card, error) {
+	var cs []*Card
+	cu.returning = &cs
+	defer func() { cu.returning = nil }()
+	if _, err := cu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (cu *CardUpdate) SaveX(ctx context.Context) int {
-	affected, err := cu.Save(ctx)
+func (cu *CardUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := cu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -87,44 +126,48 @@ func (cu *CardUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (cu *CardUpdate) Exec(ctx context.Context) error {
-	_, err := cu.Save(ctx)
+func (cu *CardUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := cu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cu *CardUpdate) ExecX(ctx context.Context) {
-	if err := cu.Exec(ctx); err != nil {
+func (cu *CardUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(card.FieldID).From(sql.Table(card.Table))
+func (cu *CardUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(card.FieldID).From(sql.Table(card.Table)).SetDialect(cu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range cu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = cu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := cu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -139,51 +182,79 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if cu.clearedOwner {
+	if cu.clearedOwner && len(cu.owner) == 0 {
 		query, args := sql.Update(card.OwnerTable).
 			SetNull(card.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(cu.owner) > 0 {
 		for _, id := range ids {
 			eid := keys(cu.owner)[0]
+			p := sql.EQ(card.FieldID, id)
+			if !cu.clearedOwner {
+				p = p.And().IsNull(card.OwnerColumn)
+			}
 			query, args := sql.Update(card.OwnerTable).
 				Set(card.OwnerColumn, eid).
-				Where(sql.EQ(card.FieldID, id).And().IsNull(card.OwnerColumn)).
+				Where(p).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(cu.owner) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"owner\" %v already connected to a different \"Card\"", keys(cu.owner))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"owner\" %v already connected to a different \"Card\"", keys(cu.owner))})
 			}
 		}
 	}
+	if cu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(card.Columns...).
+			From(sql.Table(card.Table)).
+			Where(sql.InInts(card.FieldID, ids...)).
+			SetDialect(cu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var cs Cards
+		err := cs.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Card: %v", err))
+		}
+		cs.config(cu.config)
+		*cu.returning = cs
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // CardUpdateOne is the builder for updating a single Card entity.
 type CardUpdateOne struct {
 	config
-	id           int
-	expired      *time.Time
-	number       *string
-	owner        map[int]struct{}
-	clearedOwner bool
+	id     int
+	entity *Card
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Card
+	cardMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (cuo *CardUpdateOne) Mutation() *CardMutation {
+	return &CardMutation{cardMutation: &cuo.cardMutation, op: ent.OpUpdateOne, id: &cuo.id}
 }
 
 // SetExpired sets the expired field.
@@ -218,20 +289,53 @@ func (cuo *CardUpdateOne) ClearOwner() *CardUpdateOne {
 	return cuo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (cuo *CardUpdateOne) SetChangedFrom(original *Card) *CardUpdateOne {
+	c := cuo.entity
+	if c == nil || original == nil {
+		return cuo
+	}
+	for _, change := range DiffCard(original, c) {
+		switch change.Field {
+		case card.FieldExpired:
+			cuo.SetExpired(c.Expired)
+		case card.FieldNumber:
+			cuo.SetNumber(c.Number)
+		}
+	}
+	return cuo
+}
+
 // Save executes the query and returns the updated entity.
-func (cuo *CardUpdateOne) Save(ctx context.Context) (*Card, error) {
+func (cuo *CardUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Card, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(cuo.owner) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
 	}
 	if cuo.clearedOwner && cuo.owner == nil {
 		return nil, errors.New("ent: clearing a unique edge \"owner\"")
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptCard(ctx, cuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cuo
+		intercepted.predicates = append(predicates, cuo.predicates...)
+		cuo = &intercepted
+	}
 	return cuo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (cuo *CardUpdateOne) SaveX(ctx context.Context) *Card {
-	c, err := cuo.Save(ctx)
+func (cuo *CardUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Card {
+	c, err := cuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -239,21 +343,28 @@ func (cuo *CardUpdateOne) SaveX(ctx context.Context) *Card {
 }
 
 // Exec executes the query on the entity.
-func (cuo *CardUpdateOne) Exec(ctx context.Context) error {
-	_, err := cuo.Save(ctx)
+func (cuo *CardUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := cuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cuo *CardUpdateOne) ExecX(ctx context.Context) {
-	if err := cuo.Exec(ctx); err != nil {
+func (cuo *CardUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
-	selector := sql.Select(card.Columns...).From(sql.Table(card.Table))
+	selector := sql.Select(card.Columns...).From(sql.Table(card.Table)).SetDialect(cuo.driver.Dialect())
 	card.ID(cuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range cuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = cuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -299,7 +410,7 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if cuo.clearedOwner {
+	if cuo.clearedOwner && len(cuo.owner) == 0 {
 		query, args := sql.Update(card.OwnerTable).
 			SetNull(card.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
@@ -311,9 +422,13 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 	if len(cuo.owner) > 0 {
 		for _, id := range ids {
 			eid := keys(cuo.owner)[0]
+			p := sql.EQ(card.FieldID, id)
+			if !cuo.clearedOwner {
+				p = p.And().IsNull(card.OwnerColumn)
+			}
 			query, args := sql.Update(card.OwnerTable).
 				Set(card.OwnerColumn, eid).
-				Where(sql.EQ(card.FieldID, id).And().IsNull(card.OwnerColumn)).
+				Where(p).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
 				return nil, rollback(tx, err)
@@ -323,7 +438,7 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(cuo.owner) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"owner\" %v already connected to a different \"Card\"", keys(cuo.owner))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"owner\" %v already connected to a different \"Card\"", keys(cuo.owner))})
 			}
 		}
 	}
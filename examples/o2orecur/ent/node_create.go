@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/o2orecur/ent/node"
 )
@@ -18,9 +19,12 @@ import (
 // NodeCreate is the builder for creating a Node entity.
 type NodeCreate struct {
 	config
-	value *int
-	prev  map[int]struct{}
-	next  map[int]struct{}
+	nodeMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (nc *NodeCreate) Mutation() *NodeMutation {
+	return &NodeMutation{nodeMutation: &nc.nodeMutation, op: ent.OpCreate}
 }
 
 // SetValue sets the value field.
@@ -74,7 +78,8 @@ func (nc *NodeCreate) SetNext(n *Node) *NodeCreate {
 }
 
 // Save creates the Node in the database.
-func (nc *NodeCreate) Save(ctx context.Context) (*Node, error) {
+func (nc *NodeCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Node, error) {
+	options := ent.NewCallOptions(opts...)
 	if nc.value == nil {
 		return nil, errors.New("ent: missing required field \"value\"")
 	}
@@ -84,12 +89,15 @@ func (nc *NodeCreate) Save(ctx context.Context) (*Node, error) {
 	if len(nc.next) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"next\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return nc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (nc *NodeCreate) SaveX(ctx context.Context) *Node {
-	v, err := nc.Save(ctx)
+func (nc *NodeCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Node {
+	v, err := nc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -110,6 +118,9 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 		builder.Set(node.FieldValue, *value)
 		n.Value = *value
 	}
+	for eid := range nc.prev {
+		builder.Set(node.PrevColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -119,23 +130,6 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 		return nil, rollback(tx, err)
 	}
 	n.ID = int(id)
-	if len(nc.prev) > 0 {
-		eid := keys(nc.prev)[0]
-		query, args := sql.Update(node.PrevTable).
-			Set(node.PrevColumn, eid).
-			Where(sql.EQ(node.FieldID, id).And().IsNull(node.PrevColumn)).
-			Query()
-		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return nil, rollback(tx, err)
-		}
-		affected, err := res.RowsAffected()
-		if err != nil {
-			return nil, rollback(tx, err)
-		}
-		if int(affected) < len(nc.prev) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"prev\" %v already connected to a different \"Node\"", keys(nc.prev))})
-		}
-	}
 	if len(nc.next) > 0 {
 		eid := keys(nc.next)[0]
 		query, args := sql.Update(node.NextTable).
@@ -150,7 +144,7 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 			return nil, rollback(tx, err)
 		}
 		if int(affected) < len(nc.next) {
-			return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nc.next))})
+			return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nc.next))})
 		}
 	}
 	if err := tx.Commit(); err != nil {
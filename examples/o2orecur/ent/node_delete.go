@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/o2orecur/ent/node"
 	"github.com/facebookincubator/ent/examples/o2orecur/ent/predicate"
@@ -27,13 +28,26 @@ func (nd *NodeDelete) Where(ps ...predicate.Node) *NodeDelete {
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (nd *NodeDelete) Exec(ctx context.Context) (int, error) {
+func (nd *NodeDelete) Exec(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	options := ent.NewCallOptions(opts...)
+	if options.ValidationOnly {
+		return 0, nil
+	}
+	predicates, err := interceptNode(ctx, nd.config)
+	if err != nil {
+		return 0, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *nd
+		intercepted.predicates = append(predicates, nd.predicates...)
+		nd = &intercepted
+	}
 	return nd.sqlExec(ctx)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (nd *NodeDelete) ExecX(ctx context.Context) int {
-	n, err := nd.Exec(ctx)
+func (nd *NodeDelete) ExecX(ctx context.Context, opts ...ent.CallOption) int {
+	n, err := nd.Exec(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -42,10 +56,13 @@ func (nd *NodeDelete) ExecX(ctx context.Context) int {
 
 func (nd *NodeDelete) sqlExec(ctx context.Context) (int, error) {
 	var res sql.Result
-	selector := sql.Select().From(sql.Table(node.Table))
+	selector := sql.Select().From(sql.Table(node.Table)).SetDialect(nd.driver.Dialect()).WithContext(ctx)
 	for _, p := range nd.predicates {
 		p(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	query, args := sql.Delete(node.Table).FromSelect(selector).Query()
 	if err := nd.driver.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -63,12 +80,12 @@ type NodeDeleteOne struct {
 }
 
 // Exec executes the deletion query.
-func (ndo *NodeDeleteOne) Exec(ctx context.Context) error {
-	n, err := ndo.nd.Exec(ctx)
+func (ndo *NodeDeleteOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	n, err := ndo.nd.Exec(ctx, opts...)
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !ent.NewCallOptions(opts...).ValidationOnly:
 		return &ErrNotFound{node.Label}
 	default:
 		return nil
@@ -76,6 +93,8 @@ func (ndo *NodeDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ndo *NodeDeleteOne) ExecX(ctx context.Context) {
-	ndo.nd.ExecX(ctx)
+func (ndo *NodeDeleteOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := ndo.Exec(ctx, opts...); err != nil {
+		panic(err)
+	}
 }
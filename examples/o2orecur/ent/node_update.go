@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/o2orecur/ent/node"
 	"github.com/facebookincubator/ent/examples/o2orecur/ent/predicate"
@@ -19,13 +20,11 @@ import (
 // NodeUpdate is the builder for updating Node entities.
 type NodeUpdate struct {
 	config
-	value       *int
-	addvalue    *int
-	prev        map[int]struct{}
-	next        map[int]struct{}
-	clearedPrev bool
-	clearedNext bool
-	predicates  []predicate.Node
+	nodeMutation
+	predicates []predicate.Node
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Node
 }
 
 // Where adds a new predicate for the builder.
@@ -34,6 +33,11 @@ func (nu *NodeUpdate) Where(ps ...predicate.Node) *NodeUpdate {
 	return nu
 }
 
+// Mutation returns the mutation of the builder.
+func (nu *NodeUpdate) Mutation() *NodeMutation {
+	return &NodeMutation{nodeMutation: &nu.nodeMutation, op: ent.OpUpdate}
+}
+
 // SetValue sets the value field.
 func (nu *NodeUpdate) SetValue(i int) *NodeUpdate {
 	nu.value = &i
@@ -108,19 +112,52 @@ func (nu *NodeUpdate) ClearNext() *NodeUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (nu *NodeUpdate) Save(ctx context.Context) (int, error) {
+func (nu *NodeUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := nu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (nu *NodeUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(nu.prev) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"prev\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"prev\"")
 	}
 	if len(nu.next) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"next\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"next\"")
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptNode(ctx, nu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *nu
+		intercepted.predicates = append(predicates, nu.predicates...)
+		nu = &intercepted
 	}
 	return nu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (nu *NodeUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Node, error) {
+	var ns []*Node
+	nu.returning = &ns
+	defer func() { nu.returning = nil }()
+	if _, err := nu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return ns, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (nu *NodeUpdate) SaveX(ctx context.Context) int {
-	affected, err := nu.Save(ctx)
+func (nu *NodeUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := nu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -128,44 +165,48 @@ func (nu *NodeUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (nu *NodeUpdate) Exec(ctx context.Context) error {
-	_, err := nu.Save(ctx)
+func (nu *NodeUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := nu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (nu *NodeUpdate) ExecX(ctx context.Context) {
-	if err := nu.Exec(ctx); err != nil {
+func (nu *NodeUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := nu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(node.FieldID).From(sql.Table(node.Table))
+func (nu *NodeUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(node.FieldID).From(sql.Table(node.Table)).SetDialect(nu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range nu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = nu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := nu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -180,34 +221,38 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if nu.clearedPrev {
+	if nu.clearedPrev && len(nu.prev) == 0 {
 		query, args := sql.Update(node.PrevTable).
 			SetNull(node.PrevColumn).
 			Where(sql.InInts(node.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(nu.prev) > 0 {
 		for _, id := range ids {
 			eid := keys(nu.prev)[0]
+			p := sql.EQ(node.FieldID, id)
+			if !nu.clearedPrev {
+				p = p.And().IsNull(node.PrevColumn)
+			}
 			query, args := sql.Update(node.PrevTable).
 				Set(node.PrevColumn, eid).
-				Where(sql.EQ(node.FieldID, id).And().IsNull(node.PrevColumn)).
+				Where(p).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(nu.prev) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"prev\" %v already connected to a different \"Node\"", keys(nu.prev))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"prev\" %v already connected to a different \"Node\"", keys(nu.prev))})
 			}
 		}
 	}
@@ -217,7 +262,7 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Where(sql.InInts(node.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(nu.next) > 0 {
@@ -228,33 +273,55 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.EQ(node.FieldID, eid).And().IsNull(node.NextColumn)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			affected, err := res.RowsAffected()
 			if err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(nu.next) {
-				return 0, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nu.next))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nu.next))})
 			}
 		}
 	}
+	if nu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(node.Columns...).
+			From(sql.Table(node.Table)).
+			Where(sql.InInts(node.FieldID, ids...)).
+			SetDialect(nu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var ns Nodes
+		err := ns.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Node: %v", err))
+		}
+		ns.config(nu.config)
+		*nu.returning = ns
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // NodeUpdateOne is the builder for updating a single Node entity.
 type NodeUpdateOne struct {
 	config
-	id          int
-	value       *int
-	addvalue    *int
-	prev        map[int]struct{}
-	next        map[int]struct{}
-	clearedPrev bool
-	clearedNext bool
+	id     int
+	entity *Node
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Node
+	nodeMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (nuo *NodeUpdateOne) Mutation() *NodeMutation {
+	return &NodeMutation{nodeMutation: &nuo.nodeMutation, op: ent.OpUpdateOne, id: &nuo.id}
 }
 
 // SetValue sets the value field.
@@ -330,20 +397,51 @@ func (nuo *NodeUpdateOne) ClearNext() *NodeUpdateOne {
 	return nuo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (nuo *NodeUpdateOne) SetChangedFrom(original *Node) *NodeUpdateOne {
+	n := nuo.entity
+	if n == nil || original == nil {
+		return nuo
+	}
+	for _, change := range DiffNode(original, n) {
+		switch change.Field {
+		case node.FieldValue:
+			nuo.SetValue(n.Value)
+		}
+	}
+	return nuo
+}
+
 // Save executes the query and returns the updated entity.
-func (nuo *NodeUpdateOne) Save(ctx context.Context) (*Node, error) {
+func (nuo *NodeUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Node, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(nuo.prev) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"prev\"")
 	}
 	if len(nuo.next) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"next\"")
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptNode(ctx, nuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *nuo
+		intercepted.predicates = append(predicates, nuo.predicates...)
+		nuo = &intercepted
+	}
 	return nuo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (nuo *NodeUpdateOne) SaveX(ctx context.Context) *Node {
-	n, err := nuo.Save(ctx)
+func (nuo *NodeUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Node {
+	n, err := nuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -351,21 +449,28 @@ func (nuo *NodeUpdateOne) SaveX(ctx context.Context) *Node {
 }
 
 // Exec executes the query on the entity.
-func (nuo *NodeUpdateOne) Exec(ctx context.Context) error {
-	_, err := nuo.Save(ctx)
+func (nuo *NodeUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := nuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (nuo *NodeUpdateOne) ExecX(ctx context.Context) {
-	if err := nuo.Exec(ctx); err != nil {
+func (nuo *NodeUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := nuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
-	selector := sql.Select(node.Columns...).From(sql.Table(node.Table))
+	selector := sql.Select(node.Columns...).From(sql.Table(node.Table)).SetDialect(nuo.driver.Dialect())
 	node.ID(nuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range nuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = nuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -411,7 +516,7 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if nuo.clearedPrev {
+	if nuo.clearedPrev && len(nuo.prev) == 0 {
 		query, args := sql.Update(node.PrevTable).
 			SetNull(node.PrevColumn).
 			Where(sql.InInts(node.FieldID, ids...)).
@@ -423,9 +528,13 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 	if len(nuo.prev) > 0 {
 		for _, id := range ids {
 			eid := keys(nuo.prev)[0]
+			p := sql.EQ(node.FieldID, id)
+			if !nuo.clearedPrev {
+				p = p.And().IsNull(node.PrevColumn)
+			}
 			query, args := sql.Update(node.PrevTable).
 				Set(node.PrevColumn, eid).
-				Where(sql.EQ(node.FieldID, id).And().IsNull(node.PrevColumn)).
+				Where(p).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
 				return nil, rollback(tx, err)
@@ -435,7 +544,7 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(nuo.prev) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"prev\" %v already connected to a different \"Node\"", keys(nuo.prev))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"prev\" %v already connected to a different \"Node\"", keys(nuo.prev))})
 			}
 		}
 	}
@@ -463,7 +572,7 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 				return nil, rollback(tx, err)
 			}
 			if int(affected) < len(nuo.next) {
-				return nil, rollback(tx, &ErrConstraintFailed{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nuo.next))})
+				return nil, rollback(tx, &ConstraintError{msg: fmt.Sprintf("one of \"next\" %v already connected to a different \"Node\"", keys(nuo.next))})
 			}
 		}
 	}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/examples/start/ent/car"
 )
 
 // Car is the model entity for the Car schema.
@@ -23,21 +24,44 @@ type Car struct {
 	Model string `json:"model,omitempty"`
 	// RegisteredAt holds the value of the "registered_at" field.
 	RegisteredAt time.Time `json:"registered_at,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the CarQuery when eager-loading is set.
+	Edges CarEdges `json:"edges"`
+}
+
+// CarEdges holds the relations/edges of other nodes in the graph.
+type CarEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
 }
 
 // FromRows scans the sql response data into Car.
 func (c *Car) FromRows(rows *sql.Rows) error {
+	return c.scan(rows, car.Columns)
+}
+
+// scan scans the given columns of the sql response data into Car.
+// Fields that their columns were not selected are left with their zero values.
+func (c *Car) scan(rows *sql.Rows, columns []string) error {
 	var vc struct {
 		ID           int
 		Model        sql.NullString
 		RegisteredAt sql.NullTime
 	}
-	// the order here should be the same as in the `car.Columns`.
-	if err := rows.Scan(
-		&vc.ID,
-		&vc.Model,
-		&vc.RegisteredAt,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case car.FieldID:
+			values[i] = &vc.ID
+		case car.FieldModel:
+			values[i] = &vc.Model
+		case car.FieldRegisteredAt:
+			values[i] = &vc.RegisteredAt
+		default:
+			return fmt.Errorf("unexpected column %q for type Car", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	c.ID = vc.ID
@@ -69,6 +93,30 @@ func (c *Car) Unwrap() *Car {
 	return c
 }
 
+// Clone returns a deep copy of the Car, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (c *Car) Clone() *Car {
+	return c.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Car, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (c *Car) clone(seen map[interface{}]interface{}) *Car {
+	if c == nil {
+		return nil
+	}
+	if _c, ok := seen[c]; ok {
+		return _c.(*Car)
+	}
+	_c := *c
+	_c.config = config{}
+	seen[c] = &_c
+	_c.Edges.Owner = c.Edges.Owner.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (c *Car) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -80,14 +128,33 @@ func (c *Car) String() string {
 	return buf.String()
 }
 
+// DiffCar returns the fields that have different values in the given Cars,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffCar(a, b *Car) []FieldChange {
+	var changes []FieldChange
+	if a.Model != b.Model {
+		changes = append(changes, FieldChange{Field: car.FieldModel, Old: a.Model, New: b.Model})
+	}
+	if !a.RegisteredAt.Equal(b.RegisteredAt) {
+		changes = append(changes, FieldChange{Field: car.FieldRegisteredAt, Old: a.RegisteredAt, New: b.RegisteredAt})
+	}
+	return changes
+}
+
 // Cars is a parsable slice of Car.
 type Cars []*Car
 
 // FromRows scans the sql response data into Cars.
 func (c *Cars) FromRows(rows *sql.Rows) error {
+	return c.scan(rows, car.Columns)
+}
+
+// scan scans the given columns of the sql response data into Cars.
+func (c *Cars) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vc := &Car{}
-		if err := vc.FromRows(rows); err != nil {
+		if err := vc.scan(rows, columns); err != nil {
 			return err
 		}
 		*c = append(*c, vc)
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/start/ent/car"
 )
@@ -18,9 +19,12 @@ import (
 // CarCreate is the builder for creating a Car entity.
 type CarCreate struct {
 	config
-	model         *string
-	registered_at *time.Time
-	owner         map[int]struct{}
+	carMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (cc *CarCreate) Mutation() *CarMutation {
+	return &CarMutation{carMutation: &cc.carMutation, op: ent.OpCreate}
 }
 
 // SetModel sets the model field.
@@ -58,7 +62,8 @@ func (cc *CarCreate) SetOwner(u *User) *CarCreate {
 }
 
 // Save creates the Car in the database.
-func (cc *CarCreate) Save(ctx context.Context) (*Car, error) {
+func (cc *CarCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Car, error) {
+	options := ent.NewCallOptions(opts...)
 	if cc.model == nil {
 		return nil, errors.New("ent: missing required field \"model\"")
 	}
@@ -68,12 +73,15 @@ func (cc *CarCreate) Save(ctx context.Context) (*Car, error) {
 	if len(cc.owner) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return cc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (cc *CarCreate) SaveX(ctx context.Context) *Car {
-	v, err := cc.Save(ctx)
+func (cc *CarCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Car {
+	v, err := cc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -98,6 +106,9 @@ func (cc *CarCreate) sqlSave(ctx context.Context) (*Car, error) {
 		builder.Set(car.FieldRegisteredAt, *value)
 		c.RegisteredAt = *value
 	}
+	for eid := range cc.owner {
+		builder.Set(car.OwnerColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -107,17 +118,6 @@ func (cc *CarCreate) sqlSave(ctx context.Context) (*Car, error) {
 		return nil, rollback(tx, err)
 	}
 	c.ID = int(id)
-	if len(cc.owner) > 0 {
-		for eid := range cc.owner {
-			query, args := sql.Update(car.OwnerTable).
-				Set(car.OwnerColumn, eid).
-				Where(sql.EQ(car.FieldID, id)).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
-		}
-	}
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
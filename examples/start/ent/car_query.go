@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/start/ent/car"
 	"github.com/facebookincubator/ent/examples/start/ent/predicate"
@@ -21,13 +22,23 @@ import (
 // CarQuery is the builder for querying Car entities.
 type CarQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit  *int
+	offset *int
+	order  []Order
+	unique []string
+	fields []string
+	hints  []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.Car
+	// eager-loading edges.
+	withOwner *UserQuery
 	// intermediate queries.
 	sql *sql.Selector
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath func(context.Context) (*sql.Selector, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -54,19 +65,129 @@ func (cq *CarQuery) Order(o ...Order) *CarQuery {
 	return cq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored.
+func (cq *CarQuery) Hint(hints ...sql.Hint) *CarQuery {
+	cq.hints = append(cq.hints, hints...)
+	return cq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	cs, err := client.Car.Query().
+//		Fields(car.FieldModel, car.FieldRegisteredAt).
+//		All(ctx)
+//
+func (cq *CarQuery) Fields(fields ...string) *CarQuery {
+	cq.fields = append(cq.fields, fields...)
+	return cq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (cq *CarQuery) Scope(scopes ...car.Scope) *CarQuery {
+	for _, s := range scopes {
+		cq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				cq.Order(Desc(o.Field))
+			} else {
+				cq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			cq.Limit(s.Limit)
+		}
+	}
+	return cq
+}
+
+// CarInterceptor is a query interceptor of the CarClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the CarClient.Intercept method.
+type CarInterceptor func(context.Context, *CarQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (cq *CarQuery) prepare(ctx context.Context) (*CarQuery, error) {
+	var interceptors []CarInterceptor
+	if cq.inters != nil {
+		cq.inters.mu.RLock()
+		interceptors = cq.inters.Car
+		cq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && cq.sqlPath == nil {
+		return cq, nil
+	}
+	query := cq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptCar returns the predicates that the interceptors of the client add to a Car query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptCar(ctx context.Context, cfg config) ([]predicate.Car, error) {
+	query, err := (&CarQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryOwner chains the current query on the owner edge.
 func (cq *CarQuery) QueryOwner() *UserQuery {
 	query := &UserQuery{config: cq.config}
-	t1 := sql.Table(user.Table)
-	t2 := cq.sqlQuery()
-	t2.Select(t2.C(car.OwnerColumn))
-	query.sql = sql.Select(t1.Columns(user.Columns...)...).
-		From(t1).
-		Join(t2).
-		On(t1.C(user.FieldID), t2.C(car.OwnerColumn))
+	query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+		prev, err := cq.prepare(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t1 := sql.Table(user.Table)
+		t2 := prev.sqlQuery(ctx)
+		t2.Select(t2.C(car.OwnerColumn))
+		return sql.Select(t1.Columns(user.Columns...)...).
+			From(t1).
+			Join(t2).
+			On(t1.C(user.FieldID), t2.C(car.OwnerColumn)), nil
+	}
 	return query
 }
 
+// WithOwner tells the query-builder to eager-load the nodes that are connected to the "owner" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Car.Query().
+//		WithOwner(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (cq *CarQuery) WithOwner(opts ...func(*UserQuery)) *CarQuery {
+	query := &UserQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withOwner = query
+	return cq
+}
+
 // First returns the first Car entity in the query. Returns *ErrNotFound when no car was found.
 func (cq *CarQuery) First(ctx context.Context) (*Car, error) {
 	cs, err := cq.Limit(1).All(ctx)
@@ -162,22 +283,41 @@ func (cq *CarQuery) OnlyXID(ctx context.Context) int {
 }
 
 // All executes the query and returns a list of Cars.
-func (cq *CarQuery) All(ctx context.Context) ([]*Car, error) {
-	return cq.sqlAll(ctx)
+func (cq *CarQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*Car, error) {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlAll(ctx)
 }
 
 // AllX is like All, but panics if an error occurs.
-func (cq *CarQuery) AllX(ctx context.Context) []*Car {
-	cs, err := cq.All(ctx)
+func (cq *CarQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*Car {
+	cs, err := cq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return cs
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (cq *CarQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return query.sqlQueryString(ctx)
+}
+
 // IDs executes the query and returns a list of Car ids.
 func (cq *CarQuery) IDs(ctx context.Context) ([]int, error) {
-	return cq.sqlIDs(ctx)
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlIDs(ctx)
 }
 
 // IDsX is like IDs, but panics if an error occurs.
@@ -189,9 +329,42 @@ func (cq *CarQuery) IDsX(ctx context.Context) []int {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Cars that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (cq *CarQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if cq.sql != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &CarQuery{
+		config:     cq.config,
+		predicates: append([]predicate.Car{}, cq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(int)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for Car", after)
+		}
+		query.Where(car.IDGT(id))
+	}
+	ids, err := query.Order(Asc(car.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (cq *CarQuery) Count(ctx context.Context) (int, error) {
-	return cq.sqlCount(ctx)
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return query.sqlCount(ctx)
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -205,7 +378,11 @@ func (cq *CarQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (cq *CarQuery) Exist(ctx context.Context) (bool, error) {
-	return cq.sqlExist(ctx)
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	return query.sqlExist(ctx)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -226,9 +403,14 @@ func (cq *CarQuery) Clone() *CarQuery {
 		offset:     cq.offset,
 		order:      append([]Order{}, cq.order...),
 		unique:     append([]string{}, cq.unique...),
+		fields:     append([]string{}, cq.fields...),
+		hints:      append([]sql.Hint{}, cq.hints...),
+		edgeCount:  cq.edgeCount,
 		predicates: append([]predicate.Car{}, cq.predicates...),
+		withOwner:  cq.withOwner,
 		// clone intermediate queries.
-		sql: cq.sql.Clone(),
+		sql:     cq.sql.Clone(),
+		sqlPath: cq.sqlPath,
 	}
 }
 
@@ -250,7 +432,14 @@ func (cq *CarQuery) Clone() *CarQuery {
 func (cq *CarQuery) GroupBy(field string, fields ...string) *CarGroupBy {
 	group := &CarGroupBy{config: cq.config}
 	group.fields = append([]string{field}, fields...)
-	group.sql = cq.sqlQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := cq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		group.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return group
 }
 
@@ -269,15 +458,22 @@ func (cq *CarQuery) GroupBy(field string, fields ...string) *CarGroupBy {
 func (cq *CarQuery) Select(field string, fields ...string) *CarSelect {
 	selector := &CarSelect{config: cq.config}
 	selector.fields = append([]string{field}, fields...)
-	selector.sql = cq.sqlQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := cq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		selector.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return selector
 }
 
 func (cq *CarQuery) sqlAll(ctx context.Context) ([]*Car, error) {
 	rows := &sql.Rows{}
-	selector := cq.sqlQuery()
-	if unique := cq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := cq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := cq.driver.Query(ctx, query, args, rows); err != nil {
@@ -285,21 +481,140 @@ func (cq *CarQuery) sqlAll(ctx context.Context) ([]*Car, error) {
 	}
 	defer rows.Close()
 	var cs Cars
-	if err := cs.FromRows(rows); err != nil {
+	if err := cs.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	cs.config(cq.config)
+	if query := cq.withOwner; query != nil {
+		if err := cq.sqlLoadOwner(ctx, query, cs); err != nil {
+			return nil, err
+		}
+	}
 	return cs, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (cq *CarQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := cq.sqlQuery(ctx)
+	if unique := cq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := car.Columns
+	if fields := cq.fields; len(fields) > 0 {
+		columns = append([]string{car.FieldID}, fields...)
+		for _, c := range fields {
+			if !car.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (cq *CarQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := cq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadOwner loads the nodes of the owner edge of the given Cars using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (cq *CarQuery) sqlLoadOwner(ctx context.Context, query *UserQuery, nodes []*Car) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[int]*Car, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(car.OwnerTable)
+	selector.Join(t1).On(t1.C(car.OwnerColumn), selector.C(user.FieldID))
+	key := t1.C(car.FieldID)
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := cq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []int
+		eids []int
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, nid)
+		eids = append(eids, eid)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[int]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Owner = edge
+	}
+	return nil
+}
+
 func (cq *CarQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := cq.sqlQuery()
+	selector := cq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{car.FieldID}
 	if len(cq.unique) > 0 {
 		unique = cq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := cq.edgeCount; c != nil && len(cq.predicates) == 0 && len(cq.unique) == 0 &&
+		len(cq.hints) == 0 && cq.limit == nil && cq.offset == nil {
+		selector = c.Clone().SetDialect(cq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := cq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -316,11 +631,22 @@ func (cq *CarQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (cq *CarQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := cq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := cq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(car.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := cq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := cq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (cq *CarQuery) sqlIDs(ctx context.Context) ([]int, error) {
@@ -335,13 +661,33 @@ func (cq *CarQuery) sqlIDs(ctx context.Context) ([]int, error) {
 	return ids, nil
 }
 
-func (cq *CarQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (cq *CarQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := cq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(car.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (cq *CarQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(car.Table)
 	selector := sql.Select(t1.Columns(car.Columns...)...).From(t1)
 	if cq.sql != nil {
 		selector = cq.sql
 		selector.Select(selector.Columns(car.Columns...)...)
 	}
+	selector.SetDialect(cq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(cq.hints...)
 	for _, p := range cq.predicates {
 		p(selector)
 	}
@@ -366,6 +712,10 @@ type CarGroupBy struct {
 	fns    []Aggregate
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving *sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -374,8 +724,25 @@ func (cgb *CarGroupBy) Aggregate(fns ...Aggregate) *CarGroupBy {
 	return cgb
 }
 
+// Having adds a predicate on the aggregated values of the groups.
+// For example:
+//
+//	client.Car.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5)).
+//		Scan(ctx, &v)
+//
+func (cgb *CarGroupBy) Having(p *sql.Predicate) *CarGroupBy {
+	cgb.sqlHaving = p
+	return cgb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (cgb *CarGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := cgb.path(ctx); err != nil {
+		return err
+	}
 	return cgb.sqlScan(ctx, v)
 }
 
@@ -471,6 +838,9 @@ func (cgb *CarGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (cgb *CarGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := cgb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := cgb.sqlQuery().Query()
 	if err := cgb.driver.Query(ctx, query, args, rows); err != nil {
@@ -487,7 +857,11 @@ func (cgb *CarGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range cgb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(cgb.fields...)
+	selector.Select(columns...).GroupBy(cgb.fields...)
+	if cgb.sqlHaving != nil {
+		selector.Having(cgb.sqlHaving)
+	}
+	return selector
 }
 
 // CarSelect is the builder for select fields of Car entities.
@@ -496,10 +870,15 @@ type CarSelect struct {
 	fields []string
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (cs *CarSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cs.path(ctx); err != nil {
+		return err
+	}
 	return cs.sqlScan(ctx, v)
 }
 
@@ -595,6 +974,9 @@ func (cs *CarSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (cs *CarSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := cs.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := cs.sqlQuery().Query()
 	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
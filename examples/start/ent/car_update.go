@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/start/ent/car"
 	"github.com/facebookincubator/ent/examples/start/ent/predicate"
@@ -21,11 +22,11 @@ import (
 // CarUpdate is the builder for updating Car entities.
 type CarUpdate struct {
 	config
-	model         *string
-	registered_at *time.Time
-	owner         map[int]struct{}
-	clearedOwner  bool
-	predicates    []predicate.Car
+	carMutation
+	predicates []predicate.Car
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Car
 }
 
 // Where adds a new predicate for the builder.
@@ -34,6 +35,11 @@ func (cu *CarUpdate) Where(ps ...predicate.Car) *CarUpdate {
 	return cu
 }
 
+// Mutation returns the mutation of the builder.
+func (cu *CarUpdate) Mutation() *CarMutation {
+	return &CarMutation{carMutation: &cu.carMutation, op: ent.OpUpdate}
+}
+
 // SetModel sets the model field.
 func (cu *CarUpdate) SetModel(s string) *CarUpdate {
 	cu.model = &s
@@ -75,16 +81,49 @@ func (cu *CarUpdate) ClearOwner() *CarUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (cu *CarUpdate) Save(ctx context.Context) (int, error) {
+func (cu *CarUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := cu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (cu *CarUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(cu.owner) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"owner\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptCar(ctx, cu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cu
+		intercepted.predicates = append(predicates, cu.predicates...)
+		cu = &intercepted
 	}
 	return cu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (cu *CarUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Car, error) {
+	var cs []*Car
+	cu.returning = &cs
+	defer func() { cu.returning = nil }()
+	if _, err := cu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (cu *CarUpdate) SaveX(ctx context.Context) int {
-	affected, err := cu.Save(ctx)
+func (cu *CarUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := cu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -92,44 +131,48 @@ func (cu *CarUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (cu *CarUpdate) Exec(ctx context.Context) error {
-	_, err := cu.Save(ctx)
+func (cu *CarUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := cu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cu *CarUpdate) ExecX(ctx context.Context) {
-	if err := cu.Exec(ctx); err != nil {
+func (cu *CarUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(car.FieldID).From(sql.Table(car.Table))
+func (cu *CarUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(car.FieldID).From(sql.Table(car.Table)).SetDialect(cu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range cu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = cu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := cu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -144,16 +187,16 @@ func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if cu.clearedOwner {
+	if cu.clearedOwner && len(cu.owner) == 0 {
 		query, args := sql.Update(car.OwnerTable).
 			SetNull(car.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(cu.owner) > 0 {
@@ -163,24 +206,48 @@ func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.InInts(car.FieldID, ids...)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 		}
 	}
+	if cu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(car.Columns...).
+			From(sql.Table(car.Table)).
+			Where(sql.InInts(car.FieldID, ids...)).
+			SetDialect(cu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var cs Cars
+		err := cs.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Car: %v", err))
+		}
+		cs.config(cu.config)
+		*cu.returning = cs
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // CarUpdateOne is the builder for updating a single Car entity.
 type CarUpdateOne struct {
 	config
-	id            int
-	model         *string
-	registered_at *time.Time
-	owner         map[int]struct{}
-	clearedOwner  bool
+	id     int
+	entity *Car
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Car
+	carMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (cuo *CarUpdateOne) Mutation() *CarMutation {
+	return &CarMutation{carMutation: &cuo.carMutation, op: ent.OpUpdateOne, id: &cuo.id}
 }
 
 // SetModel sets the model field.
@@ -223,17 +290,50 @@ func (cuo *CarUpdateOne) ClearOwner() *CarUpdateOne {
 	return cuo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (cuo *CarUpdateOne) SetChangedFrom(original *Car) *CarUpdateOne {
+	c := cuo.entity
+	if c == nil || original == nil {
+		return cuo
+	}
+	for _, change := range DiffCar(original, c) {
+		switch change.Field {
+		case car.FieldModel:
+			cuo.SetModel(c.Model)
+		case car.FieldRegisteredAt:
+			cuo.SetRegisteredAt(c.RegisteredAt)
+		}
+	}
+	return cuo
+}
+
 // Save executes the query and returns the updated entity.
-func (cuo *CarUpdateOne) Save(ctx context.Context) (*Car, error) {
+func (cuo *CarUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Car, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(cuo.owner) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptCar(ctx, cuo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *cuo
+		intercepted.predicates = append(predicates, cuo.predicates...)
+		cuo = &intercepted
+	}
 	return cuo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (cuo *CarUpdateOne) SaveX(ctx context.Context) *Car {
-	c, err := cuo.Save(ctx)
+func (cuo *CarUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Car {
+	c, err := cuo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -241,21 +341,28 @@ func (cuo *CarUpdateOne) SaveX(ctx context.Context) *Car {
 }
 
 // Exec executes the query on the entity.
-func (cuo *CarUpdateOne) Exec(ctx context.Context) error {
-	_, err := cuo.Save(ctx)
+func (cuo *CarUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := cuo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (cuo *CarUpdateOne) ExecX(ctx context.Context) {
-	if err := cuo.Exec(ctx); err != nil {
+func (cuo *CarUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := cuo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (c *Car, err error) {
-	selector := sql.Select(car.Columns...).From(sql.Table(car.Table))
+	selector := sql.Select(car.Columns...).From(sql.Table(car.Table)).SetDialect(cuo.driver.Dialect())
 	car.ID(cuo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range cuo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = cuo.driver.Query(ctx, query, args, rows); err != nil {
@@ -301,7 +408,7 @@ func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (c *Car, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if cuo.clearedOwner {
+	if cuo.clearedOwner && len(cuo.owner) == 0 {
 		query, args := sql.Update(car.OwnerTable).
 			SetNull(car.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/examples/start/ent/group"
 )
 
 // Group is the model entity for the Group schema.
@@ -20,19 +21,41 @@ type Group struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the GroupQuery when eager-loading is set.
+	Edges GroupEdges `json:"edges"`
+}
+
+// GroupEdges holds the relations/edges of other nodes in the graph.
+type GroupEdges struct {
+	// Users holds the value of the users edge.
+	Users []*User `json:"users,omitempty"`
 }
 
 // FromRows scans the sql response data into Group.
 func (gr *Group) FromRows(rows *sql.Rows) error {
+	return gr.scan(rows, group.Columns)
+}
+
+// scan scans the given columns of the sql response data into Group.
+// Fields that their columns were not selected are left with their zero values.
+func (gr *Group) scan(rows *sql.Rows, columns []string) error {
 	var vgr struct {
 		ID   int
 		Name sql.NullString
 	}
-	// the order here should be the same as in the `group.Columns`.
-	if err := rows.Scan(
-		&vgr.ID,
-		&vgr.Name,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case group.FieldID:
+			values[i] = &vgr.ID
+		case group.FieldName:
+			values[i] = &vgr.Name
+		default:
+			return fmt.Errorf("unexpected column %q for type Group", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	gr.ID = vgr.ID
@@ -63,6 +86,35 @@ func (gr *Group) Unwrap() *Group {
 	return gr
 }
 
+// Clone returns a deep copy of the Group, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (gr *Group) Clone() *Group {
+	return gr.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Group, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (gr *Group) clone(seen map[interface{}]interface{}) *Group {
+	if gr == nil {
+		return nil
+	}
+	if _c, ok := seen[gr]; ok {
+		return _c.(*Group)
+	}
+	_c := *gr
+	_c.config = config{}
+	seen[gr] = &_c
+	if nodes := gr.Edges.Users; nodes != nil {
+		_c.Edges.Users = make([]*User, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Users[_i] = _n.clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (gr *Group) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -73,14 +125,30 @@ func (gr *Group) String() string {
 	return buf.String()
 }
 
+// DiffGroup returns the fields that have different values in the given Groups,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffGroup(a, b *Group) []FieldChange {
+	var changes []FieldChange
+	if a.Name != b.Name {
+		changes = append(changes, FieldChange{Field: group.FieldName, Old: a.Name, New: b.Name})
+	}
+	return changes
+}
+
 // Groups is a parsable slice of Group.
 type Groups []*Group
 
 // FromRows scans the sql response data into Groups.
 func (gr *Groups) FromRows(rows *sql.Rows) error {
+	return gr.scan(rows, group.Columns)
+}
+
+// scan scans the given columns of the sql response data into Groups.
+func (gr *Groups) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vgr := &Group{}
-		if err := vgr.FromRows(rows); err != nil {
+		if err := vgr.scan(rows, columns); err != nil {
 			return err
 		}
 		*gr = append(*gr, vgr)
@@ -7,7 +7,12 @@
 package group
 
 import (
+	"fmt"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/examples/start/ent/predicate"
 	"github.com/facebookincubator/ent/examples/start/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
 )
 
 const (
@@ -33,6 +38,16 @@ var Columns = []string{
 	FieldName,
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// UsersPrimaryKey and UsersColumn2 are the table columns denoting the
 	// primary key for the users relation (M2M).
@@ -47,3 +62,57 @@ var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator = descName.Validators[0].(func(string) error)
 )
+
+// Scope is a reusable named scope of the Group queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.Group
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the Group type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "Group",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeInt,
+		GoType:     "int",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "name",
+			StorageKey: FieldName,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(string)
+				if !ok {
+					return fmt.Errorf("group: unexpected type %T for field name", v)
+				}
+				return NameValidator(vv)
+			},
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "users",
+			Type:     "User",
+			Rel:      "M2M",
+			Optional: true,
+			Table:    "group_users",
+			Columns:  []string{"group_id", "user_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the Group type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
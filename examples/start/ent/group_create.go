@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/start/ent/group"
 )
@@ -18,8 +19,12 @@ import (
 // GroupCreate is the builder for creating a Group entity.
 type GroupCreate struct {
 	config
-	name  *string
-	users map[int]struct{}
+	groupMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (gc *GroupCreate) Mutation() *GroupMutation {
+	return &GroupMutation{groupMutation: &gc.groupMutation, op: ent.OpCreate}
 }
 
 // SetName sets the name field.
@@ -49,19 +54,23 @@ func (gc *GroupCreate) AddUsers(u ...*User) *GroupCreate {
 }
 
 // Save creates the Group in the database.
-func (gc *GroupCreate) Save(ctx context.Context) (*Group, error) {
+func (gc *GroupCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Group, error) {
+	options := ent.NewCallOptions(opts...)
 	if gc.name == nil {
 		return nil, errors.New("ent: missing required field \"name\"")
 	}
 	if err := group.NameValidator(*gc.name); err != nil {
 		return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return gc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (gc *GroupCreate) SaveX(ctx context.Context) *Group {
-	v, err := gc.Save(ctx)
+func (gc *GroupCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Group {
+	v, err := gc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -92,15 +101,15 @@ func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
 	}
 	gr.ID = int(id)
 	if len(gc.users) > 0 {
-		for eid := range gc.users {
 
-			query, args := sql.Insert(group.UsersTable).
-				Columns(group.UsersPrimaryKey[0], group.UsersPrimaryKey[1]).
-				Values(id, eid).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+		builder := sql.Insert(group.UsersTable).
+			Columns(group.UsersPrimaryKey[0], group.UsersPrimaryKey[1])
+		for eid := range gc.users {
+			builder.Values(id, eid)
+		}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if err := tx.Commit(); err != nil {
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/start/ent/group"
 	"github.com/facebookincubator/ent/examples/start/ent/predicate"
@@ -21,13 +22,23 @@ import (
 // GroupQuery is the builder for querying Group entities.
 type GroupQuery struct {
 	config
-	limit      *int
-	offset     *int
-	order      []Order
-	unique     []string
+	limit  *int
+	offset *int
+	order  []Order
+	unique []string
+	fields []string
+	hints  []sql.Hint
+	// edgeCount counts the edges of a node on their foreign-key or join-table
+	// column, and it's set by the edge queries of a node.
+	edgeCount  *sql.Selector
 	predicates []predicate.Group
+	// eager-loading edges.
+	withUsers *UserQuery
 	// intermediate queries.
 	sql *sql.Selector
+	// path of the query (e.g. the path of an edge query), that is built with the
+	// context of the query, after the interceptors of its source query are applied.
+	sqlPath func(context.Context) (*sql.Selector, error)
 }
 
 // Where adds a new predicate for the builder.
@@ -54,24 +65,134 @@ func (gq *GroupQuery) Order(o ...Order) *GroupQuery {
 	return gq
 }
 
+// Hint adds query hints to the SQL statements of the query, for fixing their execution plans. For example,
+// sql.UseIndex or sql.StraightJoin. Hints that are not supported by the dialect of the driver are ignored.
+func (gq *GroupQuery) Hint(hints ...sql.Hint) *GroupQuery {
+	gq.hints = append(gq.hints, hints...)
+	return gq
+}
+
+// Fields sets the fields that are loaded by the query. Only the columns (or properties) of the given
+// fields and the id are selected from the database, and the rest of the fields of the returned entities
+// are left with their zero values. It's useful for reducing the I/O of queries on wide types.
+//
+//	grs, err := client.Group.Query().
+//		Fields(group.FieldName).
+//		All(ctx)
+//
+func (gq *GroupQuery) Fields(fields ...string) *GroupQuery {
+	gq.fields = append(gq.fields, fields...)
+	return gq
+}
+
+// Scope applies the given named scopes on the query, in their order. The predicates of the scopes are
+// added to the query, their orders are added after the orders of the query, and their non-zero limits
+// replace the limit of the query.
+func (gq *GroupQuery) Scope(scopes ...group.Scope) *GroupQuery {
+	for _, s := range scopes {
+		gq.Where(s.Predicates...)
+		for _, o := range s.Order {
+			if o.Desc {
+				gq.Order(Desc(o.Field))
+			} else {
+				gq.Order(Asc(o.Field))
+			}
+		}
+		if s.Limit != 0 {
+			gq.Limit(s.Limit)
+		}
+	}
+	return gq
+}
+
+// GroupInterceptor is a query interceptor of the GroupClient. It's called with the context of the
+// query before it's executed, and it can add predicates derived from the context (e.g. the tenant of the
+// request) to the query, or fail it. Interceptors are registered using the GroupClient.Intercept method.
+type GroupInterceptor func(context.Context, *GroupQuery) error
+
+// prepare returns the query to execute, after building its path and applying the interceptors of the
+// client on it. The interceptors are applied on a clone of the query, and therefore, the query itself is
+// not modified and executing it multiple times does not stack the predicates that were added by them.
+func (gq *GroupQuery) prepare(ctx context.Context) (*GroupQuery, error) {
+	var interceptors []GroupInterceptor
+	if gq.inters != nil {
+		gq.inters.mu.RLock()
+		interceptors = gq.inters.Group
+		gq.inters.mu.RUnlock()
+	}
+	if len(interceptors) == 0 && gq.sqlPath == nil {
+		return gq, nil
+	}
+	query := gq.Clone()
+	if path := query.sqlPath; path != nil {
+		prev, err := path(ctx)
+		if err != nil {
+			return nil, err
+		}
+		query.sql, query.sqlPath = prev, nil
+	}
+	for _, inter := range interceptors {
+		if err := inter(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// interceptGroup returns the predicates that the interceptors of the client add to a Group query
+// with the given context. They are applied by the update and delete builders on the nodes they change, and
+// therefore, nodes that are filtered out of the queries by the interceptors are not changed either.
+func interceptGroup(ctx context.Context, cfg config) ([]predicate.Group, error) {
+	query, err := (&GroupQuery{config: cfg}).prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.predicates, nil
+}
+
 // QueryUsers chains the current query on the users edge.
 func (gq *GroupQuery) QueryUsers() *UserQuery {
 	query := &UserQuery{config: gq.config}
-	t1 := sql.Table(user.Table)
-	t2 := gq.sqlQuery()
-	t2.Select(t2.C(group.FieldID))
-	t3 := sql.Table(group.UsersTable)
-	t4 := sql.Select(t3.C(group.UsersPrimaryKey[1])).
-		From(t3).
-		Join(t2).
-		On(t3.C(group.UsersPrimaryKey[0]), t2.C(group.FieldID))
-	query.sql = sql.Select().
-		From(t1).
-		Join(t4).
-		On(t1.C(user.FieldID), t4.C(group.UsersPrimaryKey[1]))
+	query.sqlPath = func(ctx context.Context) (*sql.Selector, error) {
+		prev, err := gq.prepare(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t1 := sql.Table(user.Table)
+		t2 := prev.sqlQuery(ctx)
+		t2.Select(t2.C(group.FieldID))
+		t3 := sql.Table(group.UsersTable)
+		t4 := sql.Select(t3.C(group.UsersPrimaryKey[1])).
+			From(t3).
+			Join(t2).
+			On(t3.C(group.UsersPrimaryKey[0]), t2.C(group.FieldID))
+		return sql.Select().
+			From(t1).
+			Join(t4).
+			On(t1.C(user.FieldID), t4.C(group.UsersPrimaryKey[1])), nil
+	}
 	return query
 }
 
+// WithUsers tells the query-builder to eager-load the nodes that are connected to the "users" edge.
+// The optional arguments are used to configure the query builder of the edge. Its limit and offset are
+// applied on the edges of each node, and not on all the loaded nodes. For example:
+//
+//	client.Group.Query().
+//		WithUsers(func(q *ent.UserQuery) {
+//			q.Order(ent.Desc(user.FieldID)).Limit(5)
+//		}).
+//		All(ctx)
+//
+func (gq *GroupQuery) WithUsers(opts ...func(*UserQuery)) *GroupQuery {
+	query := &UserQuery{config: gq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withUsers = query
+	return gq
+}
+
 // First returns the first Group entity in the query. Returns *ErrNotFound when no group was found.
 func (gq *GroupQuery) First(ctx context.Context) (*Group, error) {
 	grs, err := gq.Limit(1).All(ctx)
@@ -167,22 +288,41 @@ func (gq *GroupQuery) OnlyXID(ctx context.Context) int {
 }
 
 // All executes the query and returns a list of Groups.
-func (gq *GroupQuery) All(ctx context.Context) ([]*Group, error) {
-	return gq.sqlAll(ctx)
+func (gq *GroupQuery) All(ctx context.Context, opts ...ent.CallOption) ([]*Group, error) {
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlAll(ctx)
 }
 
 // AllX is like All, but panics if an error occurs.
-func (gq *GroupQuery) AllX(ctx context.Context) []*Group {
-	grs, err := gq.All(ctx)
+func (gq *GroupQuery) AllX(ctx context.Context, opts ...ent.CallOption) []*Group {
+	grs, err := gq.All(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return grs
 }
 
+// QueryString returns the query (and its arguments) that is executed by All, without executing it.
+// For SQL dialects, the arguments are a slice of values, and for Gremlin, they are its bindings.
+// It is useful for logging queries, debugging them, or asserting them in tests.
+func (gq *GroupQuery) QueryString(ctx context.Context) (string, interface{}, error) {
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return query.sqlQueryString(ctx)
+}
+
 // IDs executes the query and returns a list of Group ids.
 func (gq *GroupQuery) IDs(ctx context.Context) ([]int, error) {
-	return gq.sqlIDs(ctx)
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.sqlIDs(ctx)
 }
 
 // IDsX is like IDs, but panics if an error occurs.
@@ -194,9 +334,42 @@ func (gq *GroupQuery) IDsX(ctx context.Context) []int {
 	return ids
 }
 
+// BatchIDs returns the ids of up to limit Groups that match the query and come after the
+// given id (or from the start, if it is nil), ordered by their ids. The query itself is not modified,
+// and therefore, it can be used for iterating over the query results in batches.
+func (gq *GroupQuery) BatchIDs(ctx context.Context, after interface{}, limit int) ([]interface{}, error) {
+	if gq.sql != nil {
+		return nil, errors.New("ent: BatchIDs is not supported on edge queries")
+	}
+	query := &GroupQuery{
+		config:     gq.config,
+		predicates: append([]predicate.Group{}, gq.predicates...),
+	}
+	if after != nil {
+		id, ok := after.(int)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected id type %T for Group", after)
+		}
+		query.Where(group.IDGT(id))
+	}
+	ids, err := query.Order(Asc(group.FieldID)).Limit(limit).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := make([]interface{}, len(ids))
+	for i := range ids {
+		batch[i] = ids[i]
+	}
+	return batch, nil
+}
+
 // Count returns the count of the given query.
 func (gq *GroupQuery) Count(ctx context.Context) (int, error) {
-	return gq.sqlCount(ctx)
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return query.sqlCount(ctx)
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -210,7 +383,11 @@ func (gq *GroupQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (gq *GroupQuery) Exist(ctx context.Context) (bool, error) {
-	return gq.sqlExist(ctx)
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return false, err
+	}
+	return query.sqlExist(ctx)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -231,9 +408,14 @@ func (gq *GroupQuery) Clone() *GroupQuery {
 		offset:     gq.offset,
 		order:      append([]Order{}, gq.order...),
 		unique:     append([]string{}, gq.unique...),
+		fields:     append([]string{}, gq.fields...),
+		hints:      append([]sql.Hint{}, gq.hints...),
+		edgeCount:  gq.edgeCount,
 		predicates: append([]predicate.Group{}, gq.predicates...),
+		withUsers:  gq.withUsers,
 		// clone intermediate queries.
-		sql: gq.sql.Clone(),
+		sql:     gq.sql.Clone(),
+		sqlPath: gq.sqlPath,
 	}
 }
 
@@ -255,7 +437,14 @@ func (gq *GroupQuery) Clone() *GroupQuery {
 func (gq *GroupQuery) GroupBy(field string, fields ...string) *GroupGroupBy {
 	group := &GroupGroupBy{config: gq.config}
 	group.fields = append([]string{field}, fields...)
-	group.sql = gq.sqlQuery()
+	group.path = func(ctx context.Context) error {
+		query, err := gq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		group.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return group
 }
 
@@ -274,15 +463,22 @@ func (gq *GroupQuery) GroupBy(field string, fields ...string) *GroupGroupBy {
 func (gq *GroupQuery) Select(field string, fields ...string) *GroupSelect {
 	selector := &GroupSelect{config: gq.config}
 	selector.fields = append([]string{field}, fields...)
-	selector.sql = gq.sqlQuery()
+	selector.path = func(ctx context.Context) error {
+		query, err := gq.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		selector.sql = query.sqlQuery(ctx)
+		return nil
+	}
 	return selector
 }
 
 func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 	rows := &sql.Rows{}
-	selector := gq.sqlQuery()
-	if unique := gq.unique; len(unique) == 0 {
-		selector.Distinct()
+	selector, columns, err := gq.sqlAllQuery(ctx)
+	if err != nil {
+		return nil, err
 	}
 	query, args := selector.Query()
 	if err := gq.driver.Query(ctx, query, args, rows); err != nil {
@@ -290,21 +486,140 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 	}
 	defer rows.Close()
 	var grs Groups
-	if err := grs.FromRows(rows); err != nil {
+	if err := grs.scan(rows, columns); err != nil {
 		return nil, err
 	}
 	grs.config(gq.config)
+	if query := gq.withUsers; query != nil {
+		if err := gq.sqlLoadUsers(ctx, query, grs); err != nil {
+			return nil, err
+		}
+	}
 	return grs, nil
 }
 
+// sqlAllQuery returns the selector of All, and the columns it selects.
+func (gq *GroupQuery) sqlAllQuery(ctx context.Context) (*sql.Selector, []string, error) {
+	selector := gq.sqlQuery(ctx)
+	if unique := gq.unique; len(unique) == 0 {
+		selector.Distinct()
+	}
+	columns := group.Columns
+	if fields := gq.fields; len(fields) > 0 {
+		columns = append([]string{group.FieldID}, fields...)
+		for _, c := range fields {
+			if !group.ValidColumn(c) {
+				return nil, nil, fmt.Errorf("ent: invalid field %q for query", c)
+			}
+		}
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if err := selector.Err(); err != nil {
+		return nil, nil, err
+	}
+	return selector, columns, nil
+}
+
+func (gq *GroupQuery) sqlQueryString(ctx context.Context) (string, interface{}, error) {
+	selector, _, err := gq.sqlAllQuery(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+// sqlLoadUsers loads the nodes of the users edge of the given Groups using the given query.
+// The ids of the edges are queried first, with the limit and the offset of the query applied on each node,
+// and then, the nodes of the edges are loaded by their ids.
+func (gq *GroupQuery) sqlLoadUsers(ctx context.Context, query *UserQuery, nodes []*Group) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	query, err := query.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, len(nodes))
+	byID := make(map[int]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	t1 := sql.Table(group.UsersTable)
+	selector.Join(t1).On(t1.C(group.UsersPrimaryKey[1]), selector.C(user.FieldID))
+	key := t1.C(group.UsersPrimaryKey[0])
+	selector.Where(sql.In(key, ids...))
+	selector.Select(sql.As(key, "node_id"), sql.As(selector.C(user.FieldID), "edge_id"))
+	if query.limit != nil || query.offset != nil {
+		selector = sql.PartitionLimit(selector, []string{key}, "node_id", "edge_id")
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := gq.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	// keys holds the ids of the nodes, and eids holds the ids of their edges, in the same order.
+	var (
+		keys []int
+		eids []int
+	)
+	for rows.Next() {
+		var (
+			nid int
+			eid int
+		)
+		if err := rows.Scan(&nid, &eid); err != nil {
+			return err
+		}
+		keys = append(keys, nid)
+		eids = append(eids, eid)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	query.predicates = []predicate.User{user.IDIn(eids...)}
+	query.order, query.limit, query.offset = nil, nil, nil
+	edges, err := query.sqlAll(ctx)
+	if err != nil {
+		return err
+	}
+	byEdgeID := make(map[int]*User, len(edges))
+	for _, edge := range edges {
+		byEdgeID[edge.ID] = edge
+	}
+	for i, eid := range eids {
+		node, edge := byID[keys[i]], byEdgeID[eid]
+		if node == nil || edge == nil {
+			continue
+		}
+		node.Edges.Users = append(node.Edges.Users, edge)
+	}
+	return nil
+}
+
 func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 	rows := &sql.Rows{}
-	selector := gq.sqlQuery()
+	selector := gq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	unique := []string{group.FieldID}
 	if len(gq.unique) > 0 {
 		unique = gq.unique
 	}
 	selector.Count(sql.Distinct(selector.Columns(unique...)...))
+	// the edges of a node are counted without joining their table, if no
+	// steps (e.g. predicates or limit) were added to the edge query.
+	if c := gq.edgeCount; c != nil && len(gq.predicates) == 0 && len(gq.unique) == 0 &&
+		len(gq.hints) == 0 && gq.limit == nil && gq.offset == nil {
+		selector = c.Clone().SetDialect(gq.driver.Dialect()).WithContext(ctx)
+	}
 	query, args := selector.Query()
 	if err := gq.driver.Query(ctx, query, args, rows); err != nil {
 		return 0, err
@@ -321,11 +636,22 @@ func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (gq *GroupQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := gq.sqlCount(ctx)
-	if err != nil {
+	rows := &sql.Rows{}
+	selector := gq.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return false, err
+	}
+	selector.Select(selector.C(group.FieldID))
+	// there is no need to count all rows, when only one is enough.
+	if limit := gq.limit; limit == nil || *limit > 1 {
+		selector.Limit(1)
+	}
+	query, args := selector.Query()
+	if err := gq.driver.Query(ctx, query, args, rows); err != nil {
 		return false, fmt.Errorf("ent: check existence: %v", err)
 	}
-	return n > 0, nil
+	defer rows.Close()
+	return rows.Next(), rows.Err()
 }
 
 func (gq *GroupQuery) sqlIDs(ctx context.Context) ([]int, error) {
@@ -340,13 +666,33 @@ func (gq *GroupQuery) sqlIDs(ctx context.Context) ([]int, error) {
 	return ids, nil
 }
 
-func (gq *GroupQuery) sqlQuery() *sql.Selector {
+// IDsSubquery returns the selector of the ids of the query, that is used as a subquery by the IDInQuery
+// predicates. The interceptors of the client are applied on the query with the given context, and their
+// errors are returned.
+func (gq *GroupQuery) IDsSubquery(ctx context.Context) (*sql.Selector, error) {
+	query, err := gq.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selector := query.sqlQuery(ctx)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	selector.Select(selector.C(group.FieldID))
+	return selector, nil
+}
+
+// sqlQuery returns the selector of the query. The predicates are applied on it with the given context,
+// and their errors are returned by the Err method of the selector.
+func (gq *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	t1 := sql.Table(group.Table)
 	selector := sql.Select(t1.Columns(group.Columns...)...).From(t1)
 	if gq.sql != nil {
 		selector = gq.sql
 		selector.Select(selector.Columns(group.Columns...)...)
 	}
+	selector.SetDialect(gq.driver.Dialect()).WithContext(ctx)
+	selector.Hint(gq.hints...)
 	for _, p := range gq.predicates {
 		p(selector)
 	}
@@ -371,6 +717,10 @@ type GroupGroupBy struct {
 	fns    []Aggregate
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
+	// predicates on the aggregated values.
+	sqlHaving *sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -379,8 +729,25 @@ func (ggb *GroupGroupBy) Aggregate(fns ...Aggregate) *GroupGroupBy {
 	return ggb
 }
 
+// Having adds a predicate on the aggregated values of the groups.
+// For example:
+//
+//	client.Group.Query().
+//		GroupBy(field).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT(sql.Count("*"), 5)).
+//		Scan(ctx, &v)
+//
+func (ggb *GroupGroupBy) Having(p *sql.Predicate) *GroupGroupBy {
+	ggb.sqlHaving = p
+	return ggb
+}
+
 // Scan applies the group-by query and scan the result into the given value.
 func (ggb *GroupGroupBy) Scan(ctx context.Context, v interface{}) error {
+	if err := ggb.path(ctx); err != nil {
+		return err
+	}
 	return ggb.sqlScan(ctx, v)
 }
 
@@ -476,6 +843,9 @@ func (ggb *GroupGroupBy) BoolsX(ctx context.Context) []bool {
 }
 
 func (ggb *GroupGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	if err := ggb.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := ggb.sqlQuery().Query()
 	if err := ggb.driver.Query(ctx, query, args, rows); err != nil {
@@ -492,7 +862,11 @@ func (ggb *GroupGroupBy) sqlQuery() *sql.Selector {
 	for _, fn := range ggb.fns {
 		columns = append(columns, fn.SQL(selector))
 	}
-	return selector.Select(columns...).GroupBy(ggb.fields...)
+	selector.Select(columns...).GroupBy(ggb.fields...)
+	if ggb.sqlHaving != nil {
+		selector.Having(ggb.sqlHaving)
+	}
+	return selector
 }
 
 // GroupSelect is the builder for select fields of Group entities.
@@ -501,10 +875,15 @@ type GroupSelect struct {
 	fields []string
 	// intermediate queries.
 	sql *sql.Selector
+	// path builds the intermediate queries, after the interceptors were applied.
+	path func(context.Context) error
 }
 
 // Scan applies the selector query and scan the result into the given value.
 func (gs *GroupSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := gs.path(ctx); err != nil {
+		return err
+	}
 	return gs.sqlScan(ctx, v)
 }
 
@@ -600,6 +979,9 @@ func (gs *GroupSelect) BoolsX(ctx context.Context) []bool {
 }
 
 func (gs *GroupSelect) sqlScan(ctx context.Context, v interface{}) error {
+	if err := gs.sql.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := gs.sqlQuery().Query()
 	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
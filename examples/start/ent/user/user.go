@@ -7,7 +7,12 @@
 package user
 
 import (
+	"fmt"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/examples/start/ent/predicate"
 	"github.com/facebookincubator/ent/examples/start/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
 )
 
 const (
@@ -43,6 +48,16 @@ var Columns = []string{
 	FieldName,
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// GroupsPrimaryKey and GroupsColumn2 are the table columns denoting the
 	// primary key for the groups relation (M2M).
@@ -62,3 +77,73 @@ var (
 	// DefaultName holds the default value on creation for the name field.
 	DefaultName = descName.Default.(string)
 )
+
+// Scope is a reusable named scope of the User queries. It bundles predicates, orders and a limit
+// in one place, and it's applied on the queries using their Scope method. The scopes that are declared
+// in the schema are generated as functions of this package.
+type Scope struct {
+	// Predicates are added to the queries that the scope is applied on.
+	Predicates []predicate.User
+	// Order holds the orders of the scope, that are added after the orders of the queries.
+	// Their fields are the field constants of this package (e.g. FieldID).
+	Order []ent.OrderField
+	// Limit replaces the limit of the queries, if it's not zero.
+	Limit int
+}
+
+// descriptor holds the runtime descriptor of the User type.
+var descriptor = &ent.TypeDescriptor{
+	Name:  "User",
+	Label: Label,
+	ID: &ent.FieldDescriptor{
+		Name:       "id",
+		StorageKey: FieldID,
+		Type:       field.TypeInt,
+		GoType:     "int",
+	},
+	Fields: []*ent.FieldDescriptor{
+		{
+			Name:       "age",
+			StorageKey: FieldAge,
+			Type:       field.TypeInt,
+			GoType:     "int",
+			Validator: func(v ent.Value) error {
+				vv, ok := v.(int)
+				if !ok {
+					return fmt.Errorf("user: unexpected type %T for field age", v)
+				}
+				return AgeValidator(vv)
+			},
+		},
+		{
+			Name:       "name",
+			StorageKey: FieldName,
+			Type:       field.TypeString,
+			GoType:     "string",
+			Default:    true,
+		},
+	},
+	Edges: []*ent.EdgeDescriptor{
+		{
+			Name:     "cars",
+			Type:     "Car",
+			Rel:      "O2M",
+			Optional: true,
+			Table:    "cars",
+			Columns:  []string{"owner_id"},
+		},
+		{
+			Name:     "groups",
+			Type:     "Group",
+			Rel:      "M2M",
+			Optional: true,
+			Inverse:  true,
+			Table:    "group_users",
+			Columns:  []string{"group_id", "user_id"},
+		},
+	},
+}
+
+// Descriptor returns the runtime descriptor of the User type, that describes its fields
+// and edges. The returned descriptor is shared by all callers, and it must not be modified.
+func Descriptor() *ent.TypeDescriptor { return descriptor }
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/traversal/ent/group"
 )
@@ -17,9 +18,12 @@ import (
 // GroupCreate is the builder for creating a Group entity.
 type GroupCreate struct {
 	config
-	name  *string
-	users map[int]struct{}
-	admin map[int]struct{}
+	groupMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (gc *GroupCreate) Mutation() *GroupMutation {
+	return &GroupMutation{groupMutation: &gc.groupMutation, op: ent.OpCreate}
 }
 
 // SetName sets the name field.
@@ -71,19 +75,23 @@ func (gc *GroupCreate) SetAdmin(u *User) *GroupCreate {
 }
 
 // Save creates the Group in the database.
-func (gc *GroupCreate) Save(ctx context.Context) (*Group, error) {
+func (gc *GroupCreate) Save(ctx context.Context, opts ...ent.CallOption) (*Group, error) {
+	options := ent.NewCallOptions(opts...)
 	if gc.name == nil {
 		return nil, errors.New("ent: missing required field \"name\"")
 	}
 	if len(gc.admin) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"admin\"")
 	}
+	if options.ValidationOnly {
+		return nil, nil
+	}
 	return gc.sqlSave(ctx)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (gc *GroupCreate) SaveX(ctx context.Context) *Group {
-	v, err := gc.Save(ctx)
+func (gc *GroupCreate) SaveX(ctx context.Context, opts ...ent.CallOption) *Group {
+	v, err := gc.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -104,6 +112,9 @@ func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
 		builder.Set(group.FieldName, *value)
 		gr.Name = *value
 	}
+	for eid := range gc.admin {
+		builder.Set(group.AdminColumn, eid)
+	}
 	query, args := builder.Query()
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return nil, rollback(tx, err)
@@ -114,26 +125,15 @@ func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
 	}
 	gr.ID = int(id)
 	if len(gc.users) > 0 {
-		for eid := range gc.users {
 
-			query, args := sql.Insert(group.UsersTable).
-				Columns(group.UsersPrimaryKey[0], group.UsersPrimaryKey[1]).
-				Values(id, eid).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+		builder := sql.Insert(group.UsersTable).
+			Columns(group.UsersPrimaryKey[0], group.UsersPrimaryKey[1])
+		for eid := range gc.users {
+			builder.Values(id, eid)
 		}
-	}
-	if len(gc.admin) > 0 {
-		for eid := range gc.admin {
-			query, args := sql.Update(group.AdminTable).
-				Set(group.AdminColumn, eid).
-				Where(sql.EQ(group.FieldID, id)).
-				Query()
-			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return nil, rollback(tx, err)
-			}
+		query, args := builder.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, rollback(tx, err)
 		}
 	}
 	if err := tx.Commit(); err != nil {
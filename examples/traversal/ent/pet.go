@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/examples/traversal/ent/pet"
 )
 
 // Pet is the model entity for the Pet schema.
@@ -20,19 +21,43 @@ type Pet struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges of other nodes in the graph.
+	// The values are being populated by the PetQuery when eager-loading is set.
+	Edges PetEdges `json:"edges"`
+}
+
+// PetEdges holds the relations/edges of other nodes in the graph.
+type PetEdges struct {
+	// Friends holds the value of the friends edge.
+	Friends []*Pet `json:"friends,omitempty"`
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
 }
 
 // FromRows scans the sql response data into Pet.
 func (pe *Pet) FromRows(rows *sql.Rows) error {
+	return pe.scan(rows, pet.Columns)
+}
+
+// scan scans the given columns of the sql response data into Pet.
+// Fields that their columns were not selected are left with their zero values.
+func (pe *Pet) scan(rows *sql.Rows, columns []string) error {
 	var vpe struct {
 		ID   int
 		Name sql.NullString
 	}
-	// the order here should be the same as in the `pet.Columns`.
-	if err := rows.Scan(
-		&vpe.ID,
-		&vpe.Name,
-	); err != nil {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pet.FieldID:
+			values[i] = &vpe.ID
+		case pet.FieldName:
+			values[i] = &vpe.Name
+		default:
+			return fmt.Errorf("unexpected column %q for type Pet", columns[i])
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
 		return err
 	}
 	pe.ID = vpe.ID
@@ -68,6 +93,36 @@ func (pe *Pet) Unwrap() *Pet {
 	return pe
 }
 
+// Clone returns a deep copy of the Pet, its fields and its loaded edges. The copy is
+// detached from the client (it has no config), and it's useful for taking a snapshot of the
+// entity (e.g. before it's mutated). Note that, values of JSON fields that are not slices, and
+// custom Go types are shared with the original entity.
+func (pe *Pet) Clone() *Pet {
+	return pe.clone(make(map[interface{}]interface{}))
+}
+
+// clone deep-copies the Pet, and uses the given map of cloned entities for copying
+// the cycles of the loaded edges.
+func (pe *Pet) clone(seen map[interface{}]interface{}) *Pet {
+	if pe == nil {
+		return nil
+	}
+	if _c, ok := seen[pe]; ok {
+		return _c.(*Pet)
+	}
+	_c := *pe
+	_c.config = config{}
+	seen[pe] = &_c
+	if nodes := pe.Edges.Friends; nodes != nil {
+		_c.Edges.Friends = make([]*Pet, len(nodes))
+		for _i, _n := range nodes {
+			_c.Edges.Friends[_i] = _n.clone(seen)
+		}
+	}
+	_c.Edges.Owner = pe.Edges.Owner.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (pe *Pet) String() string {
 	buf := bytes.NewBuffer(nil)
@@ -78,14 +133,30 @@ func (pe *Pet) String() string {
 	return buf.String()
 }
 
+// DiffPet returns the fields that have different values in the given Pets,
+// with the values of a as the old values and the values of b as the new values. Note that,
+// the ids of the entities are not compared.
+func DiffPet(a, b *Pet) []FieldChange {
+	var changes []FieldChange
+	if a.Name != b.Name {
+		changes = append(changes, FieldChange{Field: pet.FieldName, Old: a.Name, New: b.Name})
+	}
+	return changes
+}
+
 // Pets is a parsable slice of Pet.
 type Pets []*Pet
 
 // FromRows scans the sql response data into Pets.
 func (pe *Pets) FromRows(rows *sql.Rows) error {
+	return pe.scan(rows, pet.Columns)
+}
+
+// scan scans the given columns of the sql response data into Pets.
+func (pe *Pets) scan(rows *sql.Rows, columns []string) error {
 	for rows.Next() {
 		vpe := &Pet{}
-		if err := vpe.FromRows(rows); err != nil {
+		if err := vpe.scan(rows, columns); err != nil {
 			return err
 		}
 		*pe = append(*pe, vpe)
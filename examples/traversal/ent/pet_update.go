@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/examples/traversal/ent/pet"
 	"github.com/facebookincubator/ent/examples/traversal/ent/predicate"
@@ -20,12 +21,11 @@ import (
 // PetUpdate is the builder for updating Pet entities.
 type PetUpdate struct {
 	config
-	name           *string
-	friends        map[int]struct{}
-	owner          map[int]struct{}
-	removedFriends map[int]struct{}
-	clearedOwner   bool
-	predicates     []predicate.Pet
+	petMutation
+	predicates []predicate.Pet
+	// returning is set by SaveReturning, and holds the entities
+	// that were loaded by the storage update before it was committed.
+	returning *[]*Pet
 }
 
 // Where adds a new predicate for the builder.
@@ -34,6 +34,11 @@ func (pu *PetUpdate) Where(ps ...predicate.Pet) *PetUpdate {
 	return pu
 }
 
+// Mutation returns the mutation of the builder.
+func (pu *PetUpdate) Mutation() *PetMutation {
+	return &PetMutation{petMutation: &pu.petMutation, op: ent.OpUpdate}
+}
+
 // SetName sets the name field.
 func (pu *PetUpdate) SetName(s string) *PetUpdate {
 	pu.name = &s
@@ -82,6 +87,12 @@ func (pu *PetUpdate) SetOwner(u *User) *PetUpdate {
 	return pu.SetOwnerID(u.ID)
 }
 
+// ClearFriends clears all friends edges to Pet.
+func (pu *PetUpdate) ClearFriends() *PetUpdate {
+	pu.clearedFriends = true
+	return pu
+}
+
 // RemoveFriendIDs removes the friends edge to Pet by ids.
 func (pu *PetUpdate) RemoveFriendIDs(ids ...int) *PetUpdate {
 	if pu.removedFriends == nil {
@@ -109,16 +120,49 @@ func (pu *PetUpdate) ClearOwner() *PetUpdate {
 }
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
-func (pu *PetUpdate) Save(ctx context.Context) (int, error) {
+func (pu *PetUpdate) Save(ctx context.Context, opts ...ent.CallOption) (int, error) {
+	ids, err := pu.SaveIDs(ctx, opts...)
+	return len(ids), err
+}
+
+// SaveIDs executes the query and returns the ids of the rows/vertices matched by this operation.
+func (pu *PetUpdate) SaveIDs(ctx context.Context, opts ...ent.CallOption) ([]int, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(pu.owner) > 1 {
-		return 0, errors.New("ent: multiple assignments on a unique edge \"owner\"")
+		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
+	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptPet(ctx, pu.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *pu
+		intercepted.predicates = append(predicates, pu.predicates...)
+		pu = &intercepted
 	}
 	return pu.sqlSave(ctx)
 }
 
+// SaveReturning executes the query and returns the matched entities after they were updated.
+// The entities are loaded by the same operation that updates them (inside its transaction
+// in SQL), and therefore, they do not reflect changes that were committed after it.
+func (pu *PetUpdate) SaveReturning(ctx context.Context, opts ...ent.CallOption) ([]*Pet, error) {
+	var pes []*Pet
+	pu.returning = &pes
+	defer func() { pu.returning = nil }()
+	if _, err := pu.SaveIDs(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return pes, nil
+}
+
 // SaveX is like Save, but panics if an error occurs.
-func (pu *PetUpdate) SaveX(ctx context.Context) int {
-	affected, err := pu.Save(ctx)
+func (pu *PetUpdate) SaveX(ctx context.Context, opts ...ent.CallOption) int {
+	affected, err := pu.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -126,44 +170,48 @@ func (pu *PetUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (pu *PetUpdate) Exec(ctx context.Context) error {
-	_, err := pu.Save(ctx)
+func (pu *PetUpdate) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := pu.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pu *PetUpdate) ExecX(ctx context.Context) {
-	if err := pu.Exec(ctx); err != nil {
+func (pu *PetUpdate) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := pu.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
-func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	selector := sql.Select(pet.FieldID).From(sql.Table(pet.Table))
+func (pu *PetUpdate) sqlSave(ctx context.Context) (_ []int, err error) {
+	selector := sql.Select(pet.FieldID).From(sql.Table(pet.Table)).SetDialect(pu.driver.Dialect())
+	selector.WithContext(ctx)
 	for _, p := range pu.predicates {
 		p(selector)
 	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = pu.driver.Query(ctx, query, args, rows); err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer rows.Close()
 	var ids []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return 0, fmt.Errorf("ent: failed reading id: %v", err)
+			return nil, fmt.Errorf("ent: failed reading id: %v", err)
 		}
 		ids = append(ids, id)
 	}
 	if len(ids) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	tx, err := pu.driver.Tx(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var (
 		res     sql.Result
@@ -175,27 +223,29 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if !builder.Empty() {
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if len(pu.removedFriends) > 0 {
-		eids := make([]int, len(pu.removedFriends))
-		for eid := range pu.removedFriends {
-			eids = append(eids, eid)
-		}
+	if pu.clearedFriends {
 		query, args := sql.Delete(pet.FriendsTable).
-			Where(sql.InInts(pet.FriendsPrimaryKey[0], ids...)).
-			Where(sql.InInts(pet.FriendsPrimaryKey[1], eids...)).
+			Where(sql.Or(sql.InInts(pet.FriendsPrimaryKey[0], ids...), sql.InInts(pet.FriendsPrimaryKey[1], ids...))).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
+		}
+	} else if len(pu.removedFriends) > 0 {
+		eids := make([]int, 0, len(pu.removedFriends))
+		for eid := range pu.removedFriends {
+			eids = append(eids, eid)
 		}
-		query, args = sql.Delete(pet.FriendsTable).
-			Where(sql.InInts(pet.FriendsPrimaryKey[1], ids...)).
-			Where(sql.InInts(pet.FriendsPrimaryKey[0], eids...)).
+		query, args := sql.Delete(pet.FriendsTable).
+			Where(sql.Or(
+				sql.And(sql.InInts(pet.FriendsPrimaryKey[0], ids...), sql.InInts(pet.FriendsPrimaryKey[1], eids...)),
+				sql.And(sql.InInts(pet.FriendsPrimaryKey[1], ids...), sql.InInts(pet.FriendsPrimaryKey[0], eids...)),
+			)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(pu.friends) > 0 {
@@ -212,16 +262,16 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		query, args := builder.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
-	if pu.clearedOwner {
+	if pu.clearedOwner && len(pu.owner) == 0 {
 		query, args := sql.Update(pet.OwnerTable).
 			SetNull(pet.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
-			return 0, rollback(tx, err)
+			return nil, rollback(tx, err)
 		}
 	}
 	if len(pu.owner) > 0 {
@@ -231,25 +281,48 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				Where(sql.InInts(pet.FieldID, ids...)).
 				Query()
 			if err := tx.Exec(ctx, query, args, &res); err != nil {
-				return 0, rollback(tx, err)
+				return nil, rollback(tx, err)
 			}
 		}
 	}
+	if pu.returning != nil {
+		rows := &sql.Rows{}
+		query, args := sql.Select(pet.Columns...).
+			From(sql.Table(pet.Table)).
+			Where(sql.InInts(pet.FieldID, ids...)).
+			SetDialect(pu.driver.Dialect()).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, rollback(tx, err)
+		}
+		var pes Pets
+		err := pes.FromRows(rows)
+		rows.Close()
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("ent: failed scanning rows into Pet: %v", err))
+		}
+		pes.config(pu.config)
+		*pu.returning = pes
+	}
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return nil, err
 	}
-	return len(ids), nil
+	return ids, nil
 }
 
 // PetUpdateOne is the builder for updating a single Pet entity.
 type PetUpdateOne struct {
 	config
-	id             int
-	name           *string
-	friends        map[int]struct{}
-	owner          map[int]struct{}
-	removedFriends map[int]struct{}
-	clearedOwner   bool
+	id     int
+	entity *Pet
+	// predicates of the interceptors of the client, that are applied on the updated node.
+	predicates []predicate.Pet
+	petMutation
+}
+
+// Mutation returns the mutation of the builder.
+func (puo *PetUpdateOne) Mutation() *PetMutation {
+	return &PetMutation{petMutation: &puo.petMutation, op: ent.OpUpdateOne, id: &puo.id}
 }
 
 // SetName sets the name field.
@@ -300,6 +373,12 @@ func (puo *PetUpdateOne) SetOwner(u *User) *PetUpdateOne {
 	return puo.SetOwnerID(u.ID)
 }
 
+// ClearFriends clears all friends edges to Pet.
+func (puo *PetUpdateOne) ClearFriends() *PetUpdateOne {
+	puo.clearedFriends = true
+	return puo
+}
+
 // RemoveFriendIDs removes the friends edge to Pet by ids.
 func (puo *PetUpdateOne) RemoveFriendIDs(ids ...int) *PetUpdateOne {
 	if puo.removedFriends == nil {
@@ -326,17 +405,48 @@ func (puo *PetUpdateOne) ClearOwner() *PetUpdateOne {
 	return puo
 }
 
+// SetChangedFrom compares the entity that the builder was created from (using its Update
+// method) with the given snapshot of it, and sets only the fields that were changed. It's
+// a nop for builders that were not created from an entity (UpdateOneID).
+func (puo *PetUpdateOne) SetChangedFrom(original *Pet) *PetUpdateOne {
+	pe := puo.entity
+	if pe == nil || original == nil {
+		return puo
+	}
+	for _, change := range DiffPet(original, pe) {
+		switch change.Field {
+		case pet.FieldName:
+			puo.SetName(pe.Name)
+		}
+	}
+	return puo
+}
+
 // Save executes the query and returns the updated entity.
-func (puo *PetUpdateOne) Save(ctx context.Context) (*Pet, error) {
+func (puo *PetUpdateOne) Save(ctx context.Context, opts ...ent.CallOption) (*Pet, error) {
+	options := ent.NewCallOptions(opts...)
 	if len(puo.owner) > 1 {
 		return nil, errors.New("ent: multiple assignments on a unique edge \"owner\"")
 	}
+
+	if options.ValidationOnly {
+		return nil, nil
+	}
+	predicates, err := interceptPet(ctx, puo.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predicates) > 0 {
+		intercepted := *puo
+		intercepted.predicates = append(predicates, puo.predicates...)
+		puo = &intercepted
+	}
 	return puo.sqlSave(ctx)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (puo *PetUpdateOne) SaveX(ctx context.Context) *Pet {
-	pe, err := puo.Save(ctx)
+func (puo *PetUpdateOne) SaveX(ctx context.Context, opts ...ent.CallOption) *Pet {
+	pe, err := puo.Save(ctx, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -344,21 +454,28 @@ func (puo *PetUpdateOne) SaveX(ctx context.Context) *Pet {
 }
 
 // Exec executes the query on the entity.
-func (puo *PetUpdateOne) Exec(ctx context.Context) error {
-	_, err := puo.Save(ctx)
+func (puo *PetUpdateOne) Exec(ctx context.Context, opts ...ent.CallOption) error {
+	_, err := puo.Save(ctx, opts...)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (puo *PetUpdateOne) ExecX(ctx context.Context) {
-	if err := puo.Exec(ctx); err != nil {
+func (puo *PetUpdateOne) ExecX(ctx context.Context, opts ...ent.CallOption) {
+	if err := puo.Exec(ctx, opts...); err != nil {
 		panic(err)
 	}
 }
 
 func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
-	selector := sql.Select(pet.Columns...).From(sql.Table(pet.Table))
+	selector := sql.Select(pet.Columns...).From(sql.Table(pet.Table)).SetDialect(puo.driver.Dialect())
 	pet.ID(puo.id)(selector)
+	selector.WithContext(ctx)
+	for _, p := range puo.predicates {
+		p(selector)
+	}
+	if err = selector.Err(); err != nil {
+		return nil, err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err = puo.driver.Query(ctx, query, args, rows); err != nil {
@@ -400,21 +517,23 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if len(puo.removedFriends) > 0 {
-		eids := make([]int, len(puo.removedFriends))
-		for eid := range puo.removedFriends {
-			eids = append(eids, eid)
-		}
+	if puo.clearedFriends {
 		query, args := sql.Delete(pet.FriendsTable).
-			Where(sql.InInts(pet.FriendsPrimaryKey[0], ids...)).
-			Where(sql.InInts(pet.FriendsPrimaryKey[1], eids...)).
+			Where(sql.Or(sql.InInts(pet.FriendsPrimaryKey[0], ids...), sql.InInts(pet.FriendsPrimaryKey[1], ids...))).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
 			return nil, rollback(tx, err)
 		}
-		query, args = sql.Delete(pet.FriendsTable).
-			Where(sql.InInts(pet.FriendsPrimaryKey[1], ids...)).
-			Where(sql.InInts(pet.FriendsPrimaryKey[0], eids...)).
+	} else if len(puo.removedFriends) > 0 {
+		eids := make([]int, 0, len(puo.removedFriends))
+		for eid := range puo.removedFriends {
+			eids = append(eids, eid)
+		}
+		query, args := sql.Delete(pet.FriendsTable).
+			Where(sql.Or(
+				sql.And(sql.InInts(pet.FriendsPrimaryKey[0], ids...), sql.InInts(pet.FriendsPrimaryKey[1], eids...)),
+				sql.And(sql.InInts(pet.FriendsPrimaryKey[1], ids...), sql.InInts(pet.FriendsPrimaryKey[0], eids...)),
+			)).
 			Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
 			return nil, rollback(tx, err)
@@ -437,7 +556,7 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
 			return nil, rollback(tx, err)
 		}
 	}
-	if puo.clearedOwner {
+	if puo.clearedOwner && len(puo.owner) == 0 {
 		query, args := sql.Update(pet.OwnerTable).
 			SetNull(pet.OwnerColumn).
 			Where(sql.InInts(user.FieldID, ids...)).
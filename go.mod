@@ -24,4 +24,5 @@ require (
 	go.opencensus.io v0.22.0
 	golang.org/x/sync v0.0.0-20190423024810-112230192c58
 	golang.org/x/tools v0.0.0-20190514171724-faff00d7e7f6
+	gopkg.in/yaml.v2 v2.2.2
 )
@@ -10,16 +10,90 @@ import (
 
 // A Descriptor for edge configuration.
 type Descriptor struct {
-	Tag      string      // struct tag.
-	Type     string      // edge type.
-	Name     string      // edge name.
-	RefName  string      // ref name; inverse only.
-	Ref      *Descriptor // edge reference; to/from of the same type.
-	Unique   bool        // unique edge.
-	Inverse  bool        // inverse edge.
-	Required bool        // required on creation.
+	Tag        string      // struct tag.
+	Type       string      // edge type.
+	Name       string      // edge name.
+	RefName    string      // ref name; inverse only.
+	Ref        *Descriptor // edge reference; to/from of the same type.
+	Unique     bool        // unique edge.
+	Inverse    bool        // inverse edge.
+	Required   bool        // required on creation.
+	OnDelete   Action      // action on delete; assoc only.
+	StorageKey *StorageKey // storage key of the relation; assoc only.
+	CountField string      // counter field of the edge owner; assoc only.
+}
+
+// StorageKey holds the storage configuration of an edge relation. In SQL dialects, it's
+// the foreign-key column of the relation, or the join table (and its columns) of M2M edges.
+type StorageKey struct {
+	Table        string     // join table of M2M edges.
+	Columns      []string   // foreign-key column, or the 2 columns of the join table.
+	Indexes      [][]string // additional indexes of the join table.
+	ReverseIndex bool       // index the columns of the join table in reverse order.
+}
+
+// StorageOption configures the storage key of an edge.
+type StorageOption func(*StorageKey)
+
+// Table sets the name of the join table of M2M edges.
+func Table(name string) StorageOption {
+	return func(key *StorageKey) {
+		key.Table = name
+	}
+}
+
+// Column sets the name of the foreign-key column of O2O, O2M and M2O edges.
+func Column(name string) StorageOption {
+	return func(key *StorageKey) {
+		key.Columns = []string{name}
+	}
+}
+
+// Columns sets the names of the columns of the join table of M2M edges. The first column references
+// the type that holds the assoc edge (the "To" side), and the second references the edge type.
+func Columns(to, from string) StorageOption {
+	return func(key *StorageKey) {
+		key.Columns = []string{to, from}
+	}
+}
+
+// Index adds an index on the given columns of the join table of M2M edges. The join table has
+// a composite primary key on its 2 columns (in their order), and additional indexes are needed
+// for lookups that start from its second column (e.g. the traversals of the inverse edge).
+//
+//	edge.To("groups", Group.Type).
+//		StorageKey(edge.Columns("user_id", "group_id"), edge.Index("group_id"))
+//
+func Index(columns ...string) StorageOption {
+	return func(key *StorageKey) {
+		key.Indexes = append(key.Indexes, columns)
+	}
+}
+
+// ReverseIndex adds an index on the 2 columns of the join table of M2M edges, in reverse order.
+// Unlike Index, it doesn't require the names of the columns, and it can be used with their defaults.
+func ReverseIndex() StorageOption {
+	return func(key *StorageKey) {
+		key.ReverseIndex = true
+	}
 }
 
+// Action defines the action that is taken on the entities that reference a deleted
+// entity through an edge. In SQL, it's the ON DELETE option of the foreign-key.
+type Action string
+
+// Delete actions.
+const (
+	SetNull  Action = "SET NULL"
+	Cascade  Action = "CASCADE"
+	Restrict Action = "RESTRICT"
+	NoAction Action = "NO ACTION"
+	// AppCascade is a cascade that is executed by the generated code, and not by the database.
+	// The generated delete builders delete the referencing entities (using their delete builders)
+	// before deleting the referenced ones.
+	AppCascade Action = "APP CASCADE"
+)
+
 // To defines an association edge between two vertices.
 func To(name string, t interface{}) *assocBuilder {
 	return &assocBuilder{desc: &Descriptor{Name: name, Type: typ(t)}}
@@ -62,6 +136,55 @@ func (b *assocBuilder) StructTag(s string) *assocBuilder {
 	return b
 }
 
+// OnDelete sets the action that is taken on the entities that reference a deleted entity
+// through this edge. For example, for a User that has many pets (O2M), Cascade deletes the
+// pets of a user when it's deleted. For M2O edges, the action applies to the holder of the
+// edge when its referenced entity is deleted. The default is SetNull (Cascade for M2M edges).
+//
+//	edge.To("pets", Pet.Type).
+//		OnDelete(edge.Cascade)
+//
+func (b *assocBuilder) OnDelete(a Action) *assocBuilder {
+	b.desc.OnDelete = a
+	return b
+}
+
+// StorageKey sets the storage key of the edge relation, for matching the names of existing
+// tables and columns. It's defined on the assoc edge, and applies to its inverse edge as well.
+//
+//	edge.To("pets", Pet.Type).
+//		StorageKey(edge.Column("owner_id"))
+//
+//	edge.To("groups", Group.Type).
+//		StorageKey(edge.Table("user_groups"), edge.Columns("user_id", "group_id"))
+//
+func (b *assocBuilder) StorageKey(opts ...StorageOption) *assocBuilder {
+	if b.desc.StorageKey == nil {
+		b.desc.StorageKey = &StorageKey{}
+	}
+	for _, opt := range opts {
+		opt(b.desc.StorageKey)
+	}
+	return b
+}
+
+// CountField sets the name of an integer field of the edge owner that holds the number of its
+// neighbors (a denormalized counter). The generated SQL builders keep it in sync when edges are
+// added or removed, and when the neighbors are deleted, in the transaction of the change. Hence,
+// counts of hot paths can be read without running COUNT queries. It's supported only by non-unique
+// edges (O2M and M2M), and it's not maintained by raw SQL statements that bypass the builders.
+//
+//	field.Int("pets_count").
+//		Default(0),
+//
+//	edge.To("pets", Pet.Type).
+//		CountField("pets_count")
+//
+func (b *assocBuilder) CountField(name string) *assocBuilder {
+	b.desc.CountField = name
+	return b
+}
+
 // Assoc creates an inverse-edge with the same type.
 func (b *assocBuilder) From(name string) *inverseBuilder {
 	return &inverseBuilder{desc: &Descriptor{Name: name, Type: b.desc.Type, Inverse: true, Ref: b.desc}}
@@ -81,4 +81,39 @@ func TestEdge(t *testing.T) {
 		Descriptor()
 	assert.Equal("followers", from.Tag)
 	assert.Equal("following", from.Ref.Tag)
+
+	e = edge.To("pets", User.Type).
+		OnDelete(edge.Cascade).
+		Descriptor()
+	assert.Equal(edge.Cascade, e.OnDelete)
+
+	from = edge.To("children", Node.Type).
+		OnDelete(edge.AppCascade).
+		From("parent").
+		Unique().
+		Descriptor()
+	assert.Empty(from.OnDelete)
+	assert.Equal(edge.AppCascade, from.Ref.OnDelete)
+
+	e = edge.To("groups", User.Type).
+		StorageKey(edge.Table("user_groups"), edge.Columns("user_id", "group_id")).
+		Descriptor()
+	assert.Equal("user_groups", e.StorageKey.Table)
+	assert.Equal([]string{"user_id", "group_id"}, e.StorageKey.Columns)
+	assert.Empty(e.StorageKey.Indexes)
+	assert.False(e.StorageKey.ReverseIndex)
+
+	e = edge.To("groups", User.Type).
+		StorageKey(edge.Index("group_id"), edge.ReverseIndex()).
+		Descriptor()
+	assert.Equal([][]string{{"group_id"}}, e.StorageKey.Indexes)
+	assert.True(e.StorageKey.ReverseIndex)
+
+	from = edge.To("children", Node.Type).
+		StorageKey(edge.Column("parent_id")).
+		From("parent").
+		Unique().
+		Descriptor()
+	assert.Nil(from.StorageKey)
+	assert.Equal([]string{"parent_id"}, from.Ref.StorageKey.Columns)
 }
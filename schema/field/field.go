@@ -5,28 +5,41 @@
 package field
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"errors"
+	"fmt"
 	"math"
+	"net"
 	"reflect"
 	"regexp"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // A Descriptor for field configuration.
 type Descriptor struct {
-	Tag           string        // struct tag.
-	Size          int           // varchar size.
-	Name          string        // field name.
-	Info          *TypeInfo     // field type info.
-	Unique        bool          // unique index of field.
-	Nillable      bool          // nillable struct field.
-	Optional      bool          // nullable field in database.
-	Immutable     bool          // create-only field.
-	Default       interface{}   // default value on create.
-	UpdateDefault interface{}   // default value on update.
-	Validators    []interface{} // validator functions.
-	StorageKey    string        // sql column or gremlin property.
-	Enums         []string      // enum values.
+	Tag             string        // struct tag.
+	Size            int           // varchar size.
+	Name            string        // field name.
+	Info            *TypeInfo     // field type info.
+	Unique          bool          // unique index of field.
+	Nillable        bool          // nillable struct field.
+	Optional        bool          // nullable field in database.
+	Immutable       bool          // create-only field.
+	NillableStorage bool          // nullable field with zero-value and NULL distinction.
+	Sensitive       bool          // sensitive field (omitted from String and JSON).
+	Default         interface{}   // default value on create.
+	UpdateDefault   interface{}   // default value on update.
+	Validators      []interface{} // validator functions.
+	Transformers    []interface{} // transformer functions.
+	StorageKey      string        // sql column or gremlin property.
+	Renamed         []string      // previous storage keys of the field.
+	Enums           []string      // enum values.
+	Precision       int           // fractional seconds precision.
+	DateOnly        bool          // date without time.
+	Err             error         // error that occurred during the field construction.
 }
 
 // String returns a new Field with type string.
@@ -130,6 +143,60 @@ func Enum(name string) *enumBuilder {
 	}}
 }
 
+// UUID returns a new Field with type uuid.UUID. In MySQL and SQLite, it is stored in its 16-bytes
+// binary form (e.g. "BINARY(16)" in MySQL), and in Gremlin in its string form. For example:
+//
+//	field.UUID("uuid").
+//		Default(uuid.New)
+//
+func UUID(name string) *uuidBuilder {
+	return &uuidBuilder{&Descriptor{
+		Name: name,
+		Info: &TypeInfo{Type: TypeUUID, PkgPath: "github.com/google/uuid"},
+	}}
+}
+
+// IP returns a new Field with type net.IP. The addresses are stored in their string form
+// (e.g. "VARCHAR(45)" in MySQL), and therefore, IPv4 and IPv6 addresses can be stored in
+// the same column. Nil addresses are stored as NULL, and malformed addresses (that are not
+// 4 or 16 bytes long) are rejected by a built-in validator.
+func IP(name string) *ipBuilder {
+	return &ipBuilder{&Descriptor{
+		Name:       name,
+		Info:       &TypeInfo{Type: TypeIP, PkgPath: "net", Nillable: true},
+		Validators: []interface{}{validIP},
+	}}
+}
+
+// MAC returns a new Field with type net.HardwareAddr. The addresses are stored
+// in their string form (e.g. "VARCHAR(64)" in MySQL), that fits the 20-octet
+// addresses of IP over InfiniBand. Nil addresses are stored as NULL, and malformed
+// addresses (that are not 6, 8 or 20 bytes long) are rejected by a built-in validator.
+func MAC(name string) *macBuilder {
+	return &macBuilder{&Descriptor{
+		Name:       name,
+		Info:       &TypeInfo{Type: TypeMAC, PkgPath: "net", Nillable: true},
+		Validators: []interface{}{validMAC},
+	}}
+}
+
+// validIP rejects malformed IP addresses, since their string form
+// (e.g. "?0102") cannot be parsed back when they are read.
+func validIP(ip net.IP) error {
+	if ip != nil && len(ip) != net.IPv4len && len(ip) != net.IPv6len {
+		return fmt.Errorf("invalid IP address length: %d", len(ip))
+	}
+	return nil
+}
+
+// validMAC rejects malformed MAC addresses, that are not accepted by net.ParseMAC.
+func validMAC(mac net.HardwareAddr) error {
+	if n := len(mac); mac != nil && n != 6 && n != 8 && n != 20 {
+		return fmt.Errorf("invalid MAC address length: %d", n)
+	}
+	return nil
+}
+
 // stringBuilder is the builder for string fields.
 type stringBuilder struct {
 	desc *Descriptor
@@ -189,6 +256,18 @@ func (b *stringBuilder) Validate(fn func(string) error) *stringBuilder {
 	return b
 }
 
+// Transform adds a transformer for this field. Transformers are applied by the builders on the
+// field values before they are validated, in the order they were added. For example:
+//
+//	field.String("email").
+//		Transform(strings.TrimSpace).
+//		Transform(strings.ToLower)
+//
+func (b *stringBuilder) Transform(fn func(string) string) *stringBuilder {
+	b.desc.Transformers = append(b.desc.Transformers, fn)
+	return b
+}
+
 // Default sets the default value of the field.
 func (b *stringBuilder) Default(s string) *stringBuilder {
 	b.desc.Default = s
@@ -209,12 +288,28 @@ func (b *stringBuilder) Optional() *stringBuilder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *stringBuilder) NillableStorage() *stringBuilder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *stringBuilder) Immutable() *stringBuilder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *stringBuilder) Sensitive() *stringBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // Comment sets the comment of the field.
 func (b *stringBuilder) Comment(c string) *stringBuilder {
 	return b
@@ -226,6 +321,17 @@ func (b *stringBuilder) StructTag(s string) *stringBuilder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+//
+//	field.String("url").
+//		GoType(&Link{})
+//
+func (b *stringBuilder) GoType(typ interface{}) *stringBuilder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *stringBuilder) StorageKey(key string) *stringBuilder {
@@ -233,6 +339,13 @@ func (b *stringBuilder) StorageKey(key string) *stringBuilder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *stringBuilder) Renamed(keys ...string) *stringBuilder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *stringBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -257,12 +370,28 @@ func (b *timeBuilder) Optional() *timeBuilder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *timeBuilder) NillableStorage() *timeBuilder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *timeBuilder) Immutable() *timeBuilder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *timeBuilder) Sensitive() *timeBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // Comment sets the comment of the field.
 func (b *timeBuilder) Comment(c string) *timeBuilder {
 	return b
@@ -297,6 +426,23 @@ func (b *timeBuilder) UpdateDefault(f func() time.Time) *timeBuilder {
 	return b
 }
 
+// Precision sets the fractional seconds precision of the time column, in the range of 0 to 6.
+// For example, Precision(6) is stored as `timestamp(6)` in MySQL.
+func (b *timeBuilder) Precision(p int) *timeBuilder {
+	if p < 0 || p > 6 {
+		b.desc.Err = fmt.Errorf("precision %d of field %q is out of range [0, 6]", p, b.desc.Name)
+	}
+	b.desc.Precision = p
+	return b
+}
+
+// DateOnly indicates that the column stores only the date part of the time,
+// and it is stored as a `date` column in SQL dialects.
+func (b *timeBuilder) DateOnly() *timeBuilder {
+	b.desc.DateOnly = true
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *timeBuilder) StorageKey(key string) *timeBuilder {
@@ -304,6 +450,13 @@ func (b *timeBuilder) StorageKey(key string) *timeBuilder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *timeBuilder) Renamed(keys ...string) *timeBuilder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *timeBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -334,12 +487,28 @@ func (b *boolBuilder) Optional() *boolBuilder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *boolBuilder) NillableStorage() *boolBuilder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *boolBuilder) Immutable() *boolBuilder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *boolBuilder) Sensitive() *boolBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // Comment sets the comment of the field.
 func (b *boolBuilder) Comment(c string) *boolBuilder {
 	return b
@@ -358,6 +527,13 @@ func (b *boolBuilder) StorageKey(key string) *boolBuilder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *boolBuilder) Renamed(keys ...string) *boolBuilder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *boolBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -394,6 +570,13 @@ func (b *bytesBuilder) Immutable() *bytesBuilder {
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *bytesBuilder) Sensitive() *bytesBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // Comment sets the comment of the field.
 func (b *bytesBuilder) Comment(c string) *bytesBuilder {
 	return b
@@ -413,6 +596,17 @@ func (b *bytesBuilder) MaxLen(i int) *bytesBuilder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+//
+//	field.Bytes("hash").
+//		GoType(Hash{})
+//
+func (b *bytesBuilder) GoType(typ interface{}) *bytesBuilder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *bytesBuilder) StorageKey(key string) *bytesBuilder {
@@ -420,6 +614,13 @@ func (b *bytesBuilder) StorageKey(key string) *bytesBuilder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *bytesBuilder) Renamed(keys ...string) *bytesBuilder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *bytesBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -437,6 +638,13 @@ func (b *jsonsBuilder) StorageKey(key string) *jsonsBuilder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *jsonsBuilder) Renamed(keys ...string) *jsonsBuilder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *jsonsBuilder) Optional() *jsonsBuilder {
@@ -450,6 +658,13 @@ func (b *jsonsBuilder) Immutable() *jsonsBuilder {
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *jsonsBuilder) Sensitive() *jsonsBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // Comment sets the comment of the field.
 func (b *jsonsBuilder) Comment(c string) *jsonsBuilder {
 	return b
@@ -477,6 +692,36 @@ func (b *enumBuilder) Values(values ...string) *enumBuilder {
 	return b
 }
 
+// EnumValues is the interface implemented by the custom Go types of enum fields. EnumValues returns
+// the string representations of the valid values of the enum, as they are returned by their String method.
+type EnumValues interface {
+	fmt.Stringer
+	EnumValues() []string
+}
+
+// GoType sets the Go type of the enum field to an existing type that defines its values, instead
+// of generating a new one. The type must implement the EnumValues interface, the sql.Scanner interface
+// (using a pointer receiver) and the driver.Valuer interface. Types with an integer underlying type
+// are stored as integers, and the rest are stored as strings (or ENUM columns in MySQL).
+//
+//	field.Enum("role").
+//		GoType(role.Admin)
+//
+func (b *enumBuilder) GoType(typ EnumValues) *enumBuilder {
+	if b.desc.goType(typ); b.desc.Err != nil {
+		return b
+	}
+	b.desc.Enums = typ.EnumValues()
+	t := reflect.TypeOf(typ)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if k := t.Kind(); k >= reflect.Int && k <= reflect.Uint64 {
+		b.desc.Info.Type = TypeInt
+	}
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *enumBuilder) StorageKey(key string) *enumBuilder {
@@ -484,6 +729,13 @@ func (b *enumBuilder) StorageKey(key string) *enumBuilder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *enumBuilder) Renamed(keys ...string) *enumBuilder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *enumBuilder) Optional() *enumBuilder {
@@ -491,12 +743,28 @@ func (b *enumBuilder) Optional() *enumBuilder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *enumBuilder) NillableStorage() *enumBuilder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *enumBuilder) Immutable() *enumBuilder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *enumBuilder) Sensitive() *enumBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // Comment sets the comment of the field.
 func (b *enumBuilder) Comment(c string) *enumBuilder {
 	return b
@@ -519,3 +787,259 @@ func (b *enumBuilder) StructTag(s string) *enumBuilder {
 func (b *enumBuilder) Descriptor() *Descriptor {
 	return b.desc
 }
+
+// uuidBuilder is the builder for uuid fields.
+type uuidBuilder struct {
+	desc *Descriptor
+}
+
+// Unique makes the field unique within all vertices of this type.
+func (b *uuidBuilder) Unique() *uuidBuilder {
+	b.desc.Unique = true
+	return b
+}
+
+// Default sets the function that is applied to set the default value
+// of the field on creation. For example:
+//
+//	field.UUID("uuid").
+//		Default(uuid.New)
+//
+func (b *uuidBuilder) Default(f func() uuid.UUID) *uuidBuilder {
+	b.desc.Default = f
+	return b
+}
+
+// Nillable indicates that this field is a nillable.
+// Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
+func (b *uuidBuilder) Nillable() *uuidBuilder {
+	b.desc.Nillable = true
+	return b
+}
+
+// Optional indicates that this field is optional on create.
+// Unlike edges, fields are required by default.
+func (b *uuidBuilder) Optional() *uuidBuilder {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable indicates that this field cannot be updated.
+func (b *uuidBuilder) Immutable() *uuidBuilder {
+	b.desc.Immutable = true
+	return b
+}
+
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *uuidBuilder) Sensitive() *uuidBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *uuidBuilder) Comment(c string) *uuidBuilder {
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *uuidBuilder) StructTag(s string) *uuidBuilder {
+	b.desc.Tag = s
+	return b
+}
+
+// StorageKey sets the storage key of the field.
+// In SQL dialects is the column name and Gremlin is the property.
+func (b *uuidBuilder) StorageKey(key string) *uuidBuilder {
+	b.desc.StorageKey = key
+	return b
+}
+
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *uuidBuilder) Renamed(keys ...string) *uuidBuilder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *uuidBuilder) Descriptor() *Descriptor {
+	return b.desc
+}
+
+// ipBuilder is the builder for IP address fields.
+type ipBuilder struct {
+	desc *Descriptor
+}
+
+// Unique makes the field unique within all vertices of this type.
+func (b *ipBuilder) Unique() *ipBuilder {
+	b.desc.Unique = true
+	return b
+}
+
+// Default sets the default value of the field.
+func (b *ipBuilder) Default(v net.IP) *ipBuilder {
+	b.desc.Default = v
+	return b
+}
+
+// Nillable indicates that this field is a nillable.
+// Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
+func (b *ipBuilder) Nillable() *ipBuilder {
+	b.desc.Nillable = true
+	return b
+}
+
+// Optional indicates that this field is optional on create.
+// Unlike edges, fields are required by default.
+func (b *ipBuilder) Optional() *ipBuilder {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable indicates that this field cannot be updated.
+func (b *ipBuilder) Immutable() *ipBuilder {
+	b.desc.Immutable = true
+	return b
+}
+
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *ipBuilder) Sensitive() *ipBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *ipBuilder) Comment(c string) *ipBuilder {
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *ipBuilder) StructTag(s string) *ipBuilder {
+	b.desc.Tag = s
+	return b
+}
+
+// StorageKey sets the storage key of the field.
+// In SQL dialects is the column name and Gremlin is the property.
+func (b *ipBuilder) StorageKey(key string) *ipBuilder {
+	b.desc.StorageKey = key
+	return b
+}
+
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *ipBuilder) Renamed(keys ...string) *ipBuilder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *ipBuilder) Descriptor() *Descriptor {
+	return b.desc
+}
+
+// macBuilder is the builder for MAC address fields.
+type macBuilder struct {
+	desc *Descriptor
+}
+
+// Unique makes the field unique within all vertices of this type.
+func (b *macBuilder) Unique() *macBuilder {
+	b.desc.Unique = true
+	return b
+}
+
+// Default sets the default value of the field.
+func (b *macBuilder) Default(v net.HardwareAddr) *macBuilder {
+	b.desc.Default = v
+	return b
+}
+
+// Nillable indicates that this field is a nillable.
+// Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
+func (b *macBuilder) Nillable() *macBuilder {
+	b.desc.Nillable = true
+	return b
+}
+
+// Optional indicates that this field is optional on create.
+// Unlike edges, fields are required by default.
+func (b *macBuilder) Optional() *macBuilder {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable indicates that this field cannot be updated.
+func (b *macBuilder) Immutable() *macBuilder {
+	b.desc.Immutable = true
+	return b
+}
+
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *macBuilder) Sensitive() *macBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *macBuilder) Comment(c string) *macBuilder {
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *macBuilder) StructTag(s string) *macBuilder {
+	b.desc.Tag = s
+	return b
+}
+
+// StorageKey sets the storage key of the field.
+// In SQL dialects is the column name and Gremlin is the property.
+func (b *macBuilder) StorageKey(key string) *macBuilder {
+	b.desc.StorageKey = key
+	return b
+}
+
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *macBuilder) Renamed(keys ...string) *macBuilder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *macBuilder) Descriptor() *Descriptor {
+	return b.desc
+}
+
+var (
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	valuerType  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// goType sets the type info of the custom Go type, or records an
+// error in the descriptor if the type cannot be used as a field type.
+func (d *Descriptor) goType(typ interface{}) {
+	t := reflect.TypeOf(typ)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == nil:
+		d.Err = errors.New("missing type for GoType")
+	case !reflect.PtrTo(t).Implements(scannerType):
+		d.Err = fmt.Errorf("GoType %s must implement the sql.Scanner interface", t)
+	case !t.Implements(valuerType):
+		d.Err = fmt.Errorf("GoType %s must implement the driver.Valuer interface", t)
+	default:
+		d.Info = &TypeInfo{
+			Type:         d.Info.Type,
+			Ident:        t.String(),
+			PkgPath:      t.PkgPath(),
+			ValueScanner: true,
+		}
+	}
+}
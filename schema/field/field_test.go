@@ -5,8 +5,14 @@
 package field_test
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +20,7 @@ import (
 
 	"github.com/facebookincubator/ent/schema/field"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,6 +44,11 @@ func TestInt(t *testing.T) {
 	assert.False(t, fd.Immutable)
 	assert.Len(t, fd.Validators, 1)
 
+	fd = field.Int("age").NillableStorage().Descriptor()
+	assert.True(t, fd.Optional)
+	assert.True(t, fd.NillableStorage)
+	assert.False(t, fd.Nillable)
+
 	assert.Equal(t, field.TypeInt8, field.Int8("age").Descriptor().Info.Type)
 	assert.Equal(t, field.TypeInt16, field.Int16("age").Descriptor().Info.Type)
 	assert.Equal(t, field.TypeInt32, field.Int32("age").Descriptor().Info.Type)
@@ -74,6 +86,43 @@ func TestBytes(t *testing.T) {
 	assert.Equal(t, []byte("{}"), fd.Default)
 }
 
+func TestUUID(t *testing.T) {
+	fd := field.UUID("uuid").Default(uuid.New).Unique().Descriptor()
+	assert.Equal(t, "uuid", fd.Name)
+	assert.Equal(t, field.TypeUUID, fd.Info.Type)
+	assert.Equal(t, "uuid.UUID", fd.Info.String())
+	assert.Equal(t, "github.com/google/uuid", fd.Info.PkgPath)
+	assert.True(t, fd.Unique)
+	_, ok := fd.Default.(func() uuid.UUID)
+	assert.True(t, ok)
+}
+
+func TestAddr(t *testing.T) {
+	fd := field.IP("ip").Default(net.IPv4zero).Optional().Descriptor()
+	assert.Equal(t, field.TypeIP, fd.Info.Type)
+	assert.Equal(t, "net.IP", fd.Info.String())
+	assert.True(t, fd.Info.Nillable)
+	assert.True(t, fd.Optional)
+	assert.Equal(t, net.IPv4zero, fd.Default)
+
+	validIP := fd.Validators[0].(func(net.IP) error)
+	assert.NoError(t, validIP(nil))
+	assert.NoError(t, validIP(net.IPv4(127, 0, 0, 1)))
+	assert.NoError(t, validIP(net.IPv6loopback))
+	assert.Error(t, validIP(net.IP{1, 2}))
+
+	fd = field.MAC("mac").Nillable().Descriptor()
+	assert.Equal(t, field.TypeMAC, fd.Info.Type)
+	assert.Equal(t, "net.HardwareAddr", fd.Info.String())
+	assert.Equal(t, "net", fd.Info.PkgPath)
+	assert.True(t, fd.Nillable)
+	validMAC := fd.Validators[0].(func(net.HardwareAddr) error)
+	assert.NoError(t, validMAC(nil))
+	assert.NoError(t, validMAC(net.HardwareAddr{1, 2, 3, 4, 5, 6}))
+	assert.Error(t, validMAC(net.HardwareAddr{}))
+	assert.Error(t, validMAC(net.HardwareAddr{1, 2, 3}))
+}
+
 func TestString(t *testing.T) {
 	re := regexp.MustCompile("[a-zA-Z0-9]")
 	f := field.String("name").Unique().Match(re).Validate(func(string) error { return nil })
@@ -82,6 +131,19 @@ func TestString(t *testing.T) {
 	assert.Equal(t, "name", fd.Name)
 	assert.True(t, fd.Unique)
 	assert.Len(t, fd.Validators, 2)
+	assert.False(t, fd.Sensitive)
+
+	fd = field.String("password").Sensitive().Descriptor()
+	assert.True(t, fd.Sensitive)
+	assert.True(t, field.Bytes("token").Sensitive().Descriptor().Sensitive)
+
+	fd = field.String("email").Transform(strings.TrimSpace).Transform(strings.ToLower).Descriptor()
+	assert.Len(t, fd.Transformers, 2)
+	assert.Equal(t, "A8M", fd.Transformers[0].(func(string) string)(" A8M "))
+
+	fd = field.String("display_name").Renamed("old_name").Renamed("name").Descriptor()
+	assert.Equal(t, []string{"old_name", "name"}, fd.Renamed)
+	assert.Equal(t, []string{"count"}, field.Int("total").Renamed("count").Descriptor().Renamed)
 }
 
 func TestTime(t *testing.T) {
@@ -104,6 +166,27 @@ func TestTime(t *testing.T) {
 		Descriptor()
 	assert.Equal(t, "updated_at", fd.Name)
 	assert.Equal(t, now, fd.UpdateDefault.(func() time.Time)())
+
+	fd = field.Time("deleted_at").
+		Precision(6).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, 6, fd.Precision)
+	assert.False(t, fd.DateOnly)
+
+	fd = field.Time("deleted_at").
+		Precision(7).
+		Descriptor()
+	assert.EqualError(t, fd.Err, `precision 7 of field "deleted_at" is out of range [0, 6]`)
+	fd = field.Time("deleted_at").
+		Precision(-1).
+		Descriptor()
+	assert.Error(t, fd.Err)
+
+	fd = field.Time("birthday").
+		DateOnly().
+		Descriptor()
+	assert.True(t, fd.DateOnly)
 }
 
 func TestJSON(t *testing.T) {
@@ -135,6 +218,34 @@ func TestJSON(t *testing.T) {
 	require.Equal(t, "[]string", fd.Info.String())
 }
 
+func TestField_GoType(t *testing.T) {
+	fd := field.String("name").
+		GoType(sql.NullString{}).
+		Optional().
+		Descriptor()
+	require.NoError(t, fd.Err)
+	require.True(t, fd.Info.ValueScanner)
+	require.Equal(t, field.TypeString, fd.Info.Type)
+	require.Equal(t, "database/sql", fd.Info.PkgPath)
+	require.Equal(t, "sql.NullString", fd.Info.String())
+	require.False(t, fd.Info.Numeric())
+
+	fd = field.Int64("count").
+		GoType(&sql.NullInt64{}).
+		Descriptor()
+	require.NoError(t, fd.Err)
+	require.Equal(t, field.TypeInt64, fd.Info.Type)
+	require.Equal(t, "sql.NullInt64", fd.Info.String())
+	require.False(t, fd.Info.Numeric())
+
+	fd = field.String("url").
+		GoType(&url.URL{}).
+		Descriptor()
+	require.Error(t, fd.Err)
+	require.False(t, fd.Info.ValueScanner)
+	require.Equal(t, "string", fd.Info.String())
+}
+
 func TestField_Tag(t *testing.T) {
 	fd := field.Bool("expired").
 		StructTag(`json:"expired,omitempty"`).
@@ -153,3 +264,43 @@ func TestField_Enums(t *testing.T) {
 	require.Equal(t, "role", fd.Name)
 	require.Equal(t, []string{"user", "admin", "master"}, fd.Enums)
 }
+
+// Role is an enum type with an integer underlying type.
+type Role int
+
+const (
+	RoleUser Role = iota
+	RoleAdmin
+)
+
+func (r Role) String() string               { return [...]string{"user", "admin"}[r] }
+func (Role) EnumValues() []string           { return []string{"user", "admin"} }
+func (r *Role) Scan(v interface{}) error    { *r = Role(v.(int64)); return nil }
+func (r Role) Value() (driver.Value, error) { return int64(r), nil }
+
+// Level is an enum type with a string underlying type.
+type Level string
+
+func (l Level) String() string               { return string(l) }
+func (Level) EnumValues() []string           { return []string{"low", "high"} }
+func (l *Level) Scan(v interface{}) error    { *l = Level(fmt.Sprint(v)); return nil }
+func (l Level) Value() (driver.Value, error) { return string(l), nil }
+
+func TestField_EnumGoType(t *testing.T) {
+	fd := field.Enum("role").
+		GoType(RoleUser).
+		Descriptor()
+	require.NoError(t, fd.Err)
+	require.True(t, fd.Info.ValueScanner)
+	require.Equal(t, field.TypeInt, fd.Info.Type, "integer types are stored as ints")
+	require.Equal(t, "field_test.Role", fd.Info.String())
+	require.Equal(t, []string{"user", "admin"}, fd.Enums)
+
+	fd = field.Enum("level").
+		GoType(Level("low")).
+		Descriptor()
+	require.NoError(t, fd.Err)
+	require.Equal(t, field.TypeEnum, fd.Info.Type)
+	require.Equal(t, "field_test.Level", fd.Info.String())
+	require.Equal(t, []string{"low", "high"}, fd.Enums)
+}
@@ -183,12 +183,28 @@ func (b *intBuilder) Optional() *intBuilder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *intBuilder) NillableStorage() *intBuilder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *intBuilder) Immutable() *intBuilder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *intBuilder) Sensitive() *intBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *intBuilder) StructTag(s string) *intBuilder {
 	b.desc.Tag = s
@@ -201,6 +217,13 @@ func (b *intBuilder) Validate(fn func(int) error) *intBuilder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *intBuilder) GoType(typ interface{}) *intBuilder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *intBuilder) StorageKey(key string) *intBuilder {
@@ -208,6 +231,13 @@ func (b *intBuilder) StorageKey(key string) *intBuilder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *intBuilder) Renamed(keys ...string) *intBuilder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *intBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -287,12 +317,28 @@ func (b *uintBuilder) Optional() *uintBuilder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *uintBuilder) NillableStorage() *uintBuilder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *uintBuilder) Immutable() *uintBuilder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *uintBuilder) Sensitive() *uintBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *uintBuilder) StructTag(s string) *uintBuilder {
 	b.desc.Tag = s
@@ -305,6 +351,13 @@ func (b *uintBuilder) Validate(fn func(uint) error) *uintBuilder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *uintBuilder) GoType(typ interface{}) *uintBuilder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *uintBuilder) StorageKey(key string) *uintBuilder {
@@ -312,6 +365,13 @@ func (b *uintBuilder) StorageKey(key string) *uintBuilder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *uintBuilder) Renamed(keys ...string) *uintBuilder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *uintBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -396,12 +456,28 @@ func (b *int8Builder) Optional() *int8Builder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *int8Builder) NillableStorage() *int8Builder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *int8Builder) Immutable() *int8Builder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *int8Builder) Sensitive() *int8Builder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *int8Builder) StructTag(s string) *int8Builder {
 	b.desc.Tag = s
@@ -414,6 +490,13 @@ func (b *int8Builder) Validate(fn func(int8) error) *int8Builder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *int8Builder) GoType(typ interface{}) *int8Builder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *int8Builder) StorageKey(key string) *int8Builder {
@@ -421,6 +504,13 @@ func (b *int8Builder) StorageKey(key string) *int8Builder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *int8Builder) Renamed(keys ...string) *int8Builder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *int8Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -505,12 +595,28 @@ func (b *int16Builder) Optional() *int16Builder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *int16Builder) NillableStorage() *int16Builder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *int16Builder) Immutable() *int16Builder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *int16Builder) Sensitive() *int16Builder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *int16Builder) StructTag(s string) *int16Builder {
 	b.desc.Tag = s
@@ -523,6 +629,13 @@ func (b *int16Builder) Validate(fn func(int16) error) *int16Builder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *int16Builder) GoType(typ interface{}) *int16Builder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *int16Builder) StorageKey(key string) *int16Builder {
@@ -530,6 +643,13 @@ func (b *int16Builder) StorageKey(key string) *int16Builder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *int16Builder) Renamed(keys ...string) *int16Builder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *int16Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -614,12 +734,28 @@ func (b *int32Builder) Optional() *int32Builder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *int32Builder) NillableStorage() *int32Builder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *int32Builder) Immutable() *int32Builder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *int32Builder) Sensitive() *int32Builder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *int32Builder) StructTag(s string) *int32Builder {
 	b.desc.Tag = s
@@ -632,6 +768,13 @@ func (b *int32Builder) Validate(fn func(int32) error) *int32Builder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *int32Builder) GoType(typ interface{}) *int32Builder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *int32Builder) StorageKey(key string) *int32Builder {
@@ -639,6 +782,13 @@ func (b *int32Builder) StorageKey(key string) *int32Builder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *int32Builder) Renamed(keys ...string) *int32Builder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *int32Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -723,12 +873,28 @@ func (b *int64Builder) Optional() *int64Builder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *int64Builder) NillableStorage() *int64Builder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *int64Builder) Immutable() *int64Builder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *int64Builder) Sensitive() *int64Builder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *int64Builder) StructTag(s string) *int64Builder {
 	b.desc.Tag = s
@@ -741,6 +907,13 @@ func (b *int64Builder) Validate(fn func(int64) error) *int64Builder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *int64Builder) GoType(typ interface{}) *int64Builder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *int64Builder) StorageKey(key string) *int64Builder {
@@ -748,6 +921,13 @@ func (b *int64Builder) StorageKey(key string) *int64Builder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *int64Builder) Renamed(keys ...string) *int64Builder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *int64Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -827,12 +1007,28 @@ func (b *uint8Builder) Optional() *uint8Builder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *uint8Builder) NillableStorage() *uint8Builder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *uint8Builder) Immutable() *uint8Builder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *uint8Builder) Sensitive() *uint8Builder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *uint8Builder) StructTag(s string) *uint8Builder {
 	b.desc.Tag = s
@@ -845,6 +1041,13 @@ func (b *uint8Builder) Validate(fn func(uint8) error) *uint8Builder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *uint8Builder) GoType(typ interface{}) *uint8Builder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *uint8Builder) StorageKey(key string) *uint8Builder {
@@ -852,6 +1055,13 @@ func (b *uint8Builder) StorageKey(key string) *uint8Builder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *uint8Builder) Renamed(keys ...string) *uint8Builder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *uint8Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -931,12 +1141,28 @@ func (b *uint16Builder) Optional() *uint16Builder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *uint16Builder) NillableStorage() *uint16Builder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *uint16Builder) Immutable() *uint16Builder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *uint16Builder) Sensitive() *uint16Builder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *uint16Builder) StructTag(s string) *uint16Builder {
 	b.desc.Tag = s
@@ -949,6 +1175,13 @@ func (b *uint16Builder) Validate(fn func(uint16) error) *uint16Builder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *uint16Builder) GoType(typ interface{}) *uint16Builder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *uint16Builder) StorageKey(key string) *uint16Builder {
@@ -956,6 +1189,13 @@ func (b *uint16Builder) StorageKey(key string) *uint16Builder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *uint16Builder) Renamed(keys ...string) *uint16Builder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *uint16Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -1035,12 +1275,28 @@ func (b *uint32Builder) Optional() *uint32Builder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *uint32Builder) NillableStorage() *uint32Builder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *uint32Builder) Immutable() *uint32Builder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *uint32Builder) Sensitive() *uint32Builder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *uint32Builder) StructTag(s string) *uint32Builder {
 	b.desc.Tag = s
@@ -1053,6 +1309,13 @@ func (b *uint32Builder) Validate(fn func(uint32) error) *uint32Builder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *uint32Builder) GoType(typ interface{}) *uint32Builder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *uint32Builder) StorageKey(key string) *uint32Builder {
@@ -1060,6 +1323,13 @@ func (b *uint32Builder) StorageKey(key string) *uint32Builder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *uint32Builder) Renamed(keys ...string) *uint32Builder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *uint32Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -1139,12 +1409,28 @@ func (b *uint64Builder) Optional() *uint64Builder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *uint64Builder) NillableStorage() *uint64Builder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *uint64Builder) Immutable() *uint64Builder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *uint64Builder) Sensitive() *uint64Builder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *uint64Builder) StructTag(s string) *uint64Builder {
 	b.desc.Tag = s
@@ -1157,6 +1443,13 @@ func (b *uint64Builder) Validate(fn func(uint64) error) *uint64Builder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *uint64Builder) GoType(typ interface{}) *uint64Builder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *uint64Builder) StorageKey(key string) *uint64Builder {
@@ -1164,6 +1457,13 @@ func (b *uint64Builder) StorageKey(key string) *uint64Builder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *uint64Builder) Renamed(keys ...string) *uint64Builder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *uint64Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -1248,12 +1548,28 @@ func (b *float64Builder) Optional() *float64Builder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *float64Builder) NillableStorage() *float64Builder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *float64Builder) Immutable() *float64Builder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *float64Builder) Sensitive() *float64Builder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *float64Builder) StructTag(s string) *float64Builder {
 	b.desc.Tag = s
@@ -1266,6 +1582,13 @@ func (b *float64Builder) Validate(fn func(float64) error) *float64Builder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *float64Builder) GoType(typ interface{}) *float64Builder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *float64Builder) StorageKey(key string) *float64Builder {
@@ -1273,6 +1596,13 @@ func (b *float64Builder) StorageKey(key string) *float64Builder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *float64Builder) Renamed(keys ...string) *float64Builder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *float64Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -1357,12 +1687,28 @@ func (b *float32Builder) Optional() *float32Builder {
 	return b
 }
 
+// NillableStorage indicates that this field is optional, and that the NULL values of the field are
+// distinguished from its zero value. The struct field keeps the zero value for NULL, but the generated
+// {Field}OrNull accessor and the Set{Field}OrNull setter of the builders use nil for storing and reading NULL.
+func (b *float32Builder) NillableStorage() *float32Builder {
+	b.desc.Optional = true
+	b.desc.NillableStorage = true
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *float32Builder) Immutable() *float32Builder {
 	b.desc.Immutable = true
 	return b
 }
 
+// Sensitive fields are not printed by the String method, and they are omitted from the
+// JSON encoding of the generated struct. They are useful for storing passwords or tokens.
+func (b *float32Builder) Sensitive() *float32Builder {
+	b.desc.Sensitive = true
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *float32Builder) StructTag(s string) *float32Builder {
 	b.desc.Tag = s
@@ -1375,6 +1721,13 @@ func (b *float32Builder) Validate(fn func(float32) error) *float32Builder {
 	return b
 }
 
+// GoType overrides the default Go type of the field with a custom one. The type must implement
+// the sql.Scanner interface (using a pointer receiver) and the driver.Valuer interface.
+func (b *float32Builder) GoType(typ interface{}) *float32Builder {
+	b.desc.goType(typ)
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *float32Builder) StorageKey(key string) *float32Builder {
@@ -1382,6 +1735,13 @@ func (b *float32Builder) StorageKey(key string) *float32Builder {
 	return b
 }
 
+// Renamed adds previous storage keys of the field. In SQL dialects, the migration renames
+// the column of a previous key (if it exists) instead of dropping it and adding a new one.
+func (b *float32Builder) Renamed(keys ...string) *float32Builder {
+	b.desc.Renamed = append(b.desc.Renamed, keys...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *float32Builder) Descriptor() *Descriptor {
 	return b.desc
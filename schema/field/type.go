@@ -16,6 +16,9 @@ const (
 	TypeBytes
 	TypeEnum
 	TypeString
+	TypeUUID
+	TypeIP
+	TypeMAC
 	TypeInt8
 	TypeInt16
 	TypeInt32
@@ -63,10 +66,11 @@ func (t Type) ConstName() string {
 }
 
 type TypeInfo struct {
-	Type     Type
-	Ident    string
-	PkgPath  string
-	Nillable bool // slices or pointers.
+	Type         Type
+	Ident        string
+	PkgPath      string
+	Nillable     bool // slices or pointers.
+	ValueScanner bool // custom Go type that implements sql.Scanner and driver.Valuer.
 }
 
 // String returns the string representation of a type.
@@ -87,8 +91,9 @@ func (t TypeInfo) Valid() bool {
 }
 
 // Numeric reports if the given type is a numeric type.
+// Custom Go types are not numeric, even if their base types are.
 func (t TypeInfo) Numeric() bool {
-	return t.Type.Numeric()
+	return t.Type.Numeric() && !t.ValueScanner
 }
 
 var (
@@ -100,6 +105,9 @@ var (
 		TypeBytes:   "[]byte",
 		TypeEnum:    "string",
 		TypeString:  "string",
+		TypeUUID:    "uuid.UUID",
+		TypeIP:      "net.IP",
+		TypeMAC:     "net.HardwareAddr",
 		TypeInt:     "int",
 		TypeInt8:    "int8",
 		TypeInt16:   "int16",
@@ -118,5 +126,8 @@ var (
 		TypeTime:  "TypeTime",
 		TypeEnum:  "TypeEnum",
 		TypeBytes: "TypeBytes",
+		TypeUUID:  "TypeUUID",
+		TypeIP:    "TypeIP",
+		TypeMAC:   "TypeMAC",
 	}
 )
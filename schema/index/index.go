@@ -6,9 +6,11 @@ package index
 
 // A Descriptor for index configuration.
 type Descriptor struct {
-	Unique bool     // unique index.
-	Edges  []string // edge columns.
-	Fields []string // field columns.
+	Unique   bool           // unique index.
+	Type     string         // index type.
+	Edges    []string       // edge columns.
+	Fields   []string       // field columns.
+	Prefixes map[string]int // prefix lengths of fields.
 }
 
 // Builder for indexes on vertex columns and edges in the graph.
@@ -90,6 +92,40 @@ func (b *Builder) Unique() *Builder {
 	return b
 }
 
+// Type sets the type of the index (e.g. "BTREE", "HASH" or "FULLTEXT").
+// Note that index types are supported only by MySQL, and ignored by other dialects.
+//
+//	func (T) Indexes() []ent.Index {
+//
+//		// Full-text index on the "bio" field.
+//		index.Fields("bio").
+//			Type("FULLTEXT"),
+//	}
+//
+func (b *Builder) Type(typ string) *Builder {
+	b.desc.Type = typ
+	return b
+}
+
+// Prefix sets the prefix length of the given field in the index (key part). It's used for
+// indexing long VARCHAR or TEXT columns, that exceed the size limit of the index keys.
+// Note that prefix lengths are supported only by MySQL, and ignored by other dialects.
+//
+//	func (T) Indexes() []ent.Index {
+//
+//		// Index on the first 100 characters of the "description" field.
+//		index.Fields("description").
+//			Prefix("description", 100),
+//	}
+//
+func (b *Builder) Prefix(field string, length int) *Builder {
+	if b.desc.Prefixes == nil {
+		b.desc.Prefixes = make(map[string]int)
+	}
+	b.desc.Prefixes[field] = length
+	return b
+}
+
 // Descriptor implements the ent.Descriptor interface.
 func (b *Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -33,4 +33,11 @@ func TestIndex(t *testing.T) {
 	require.Equal(t, []string{"parent", "type"}, idx.Edges)
 	require.True(t, idx.Unique)
 	require.Equal(t, []string{"name", "address"}, idx.Fields)
+
+	idx = index.Fields("description").
+		Type("FULLTEXT").
+		Prefix("description", 100).
+		Descriptor()
+	require.Equal(t, "FULLTEXT", idx.Type)
+	require.Equal(t, map[string]int{"description": 100}, idx.Prefixes)
 }